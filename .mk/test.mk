@@ -6,6 +6,9 @@
 # in the Makefile
 COVERAGE_EXCLUSIONS="internal/db\|/mock/\|internal/auth/keycloak/client\|internal/proto\|pkg/api\|pkg/testkit"
 COVERAGE_PACKAGES=./internal/...,./pkg/...
+# package and test pattern of the cassettes to record with test-record
+PKG?=./...
+RUN?=.
 
 .PHONY: clean
 clean:: ## clean up environment
@@ -19,6 +22,10 @@ test: clean init-examples ## run tests in verbose mode
 test-silent: clean init-examples ## run tests in a silent mode (errors only output)
 	go test -json -race -v ./... | gotestfmt -hide "all"
 
+.PHONY: test-record
+test-record: ## record the provider API cassettes of the tests matching RUN in PKG
+	MINDER_RECORD_CASSETTES=1 go test -count=1 -run '$(RUN)' $(PKG)
+
 .PHONY: cover
 cover: init-examples ## display test coverage
 	go test -v -coverpkg=${COVERAGE_PACKAGES} -coverprofile=coverage.out.tmp -race ./...
@@ -4,14 +4,13 @@
 package artifact
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
-	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/mindersec/minder/cmd/cli/app"
 	"github.com/mindersec/minder/internal/util"
@@ -24,49 +23,72 @@ import (
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List artifacts from a provider",
-	Long:  `The artifact list subcommand will list artifacts from a provider.`,
-	RunE:  cli.GRPCClientWrapRunE(listCommand),
+	Long: `The artifact list subcommand will list artifacts from a provider.
+
+Use --repo to list only the artifacts built from a given repository.`,
+	RunE: listCommand,
 }
 
 // listCommand is the artifact list subcommand
-func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
-	client := minderv1.NewArtifactServiceClient(conn)
+func listCommand(cmd *cobra.Command, _ []string) error {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return fmt.Errorf("error binding flags: %w", err)
+	}
 
 	provider := viper.GetString("provider")
 	project := viper.GetString("project")
 	format := viper.GetString("output")
 	fromFilter := viper.GetString("from")
+	repo := viper.GetString("repo")
 
 	// Ensure the output format is supported
 	if !app.IsOutputFormatSupported(format) {
 		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
 	}
 
+	client, cleanup, err := cli.GetCLIClient(cmd, minderv1.NewArtifactServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer cleanup()
+
 	// No longer print usage on returned error, since we've parsed our inputs
 	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
 	cmd.SilenceUsage = true
 
-	artifactList, err := client.ListArtifacts(ctx, &minderv1.ListArtifactsRequest{
-		Context: &minderv1.Context{Provider: &provider, Project: &project},
-		From:    fromFilter,
-	},
-	)
-
-	if err != nil {
-		return cli.MessageAndError("Couldn't list artifacts", err)
+	var artifacts []*minderv1.Artifact
+	var resp proto.Message
+	if repo != "" {
+		repoResp, err := client.ListArtifactsByRepository(cmd.Context(), &minderv1.ListArtifactsByRepositoryRequest{
+			Context:        &minderv1.Context{Provider: &provider, Project: &project},
+			RepositoryName: repo,
+		})
+		if err != nil {
+			return cli.MessageAndError("Couldn't list artifacts", err)
+		}
+		artifacts, resp = repoResp.GetResults(), repoResp
+	} else {
+		listResp, err := client.ListArtifacts(cmd.Context(), &minderv1.ListArtifactsRequest{
+			Context: &minderv1.Context{Provider: &provider, Project: &project},
+			From:    fromFilter,
+		})
+		if err != nil {
+			return cli.MessageAndError("Couldn't list artifacts", err)
+		}
+		artifacts, resp = listResp.GetResults(), listResp
 	}
 
 	switch format {
 	case app.Table:
 		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(),
 			[]string{"ID", "Type", "Owner", "Name", "Repository", "Visibility", "Creation date"})
-		for _, artifact := range artifactList.Results {
+		for _, artifact := range artifacts {
 			t.AddRow(
 				artifact.ArtifactPk,
 				artifact.Type,
 				artifact.GetOwner(),
 				artifact.GetName(),
-				artifact.Repository,
+				artifactRepository(artifact, repo),
 				artifact.Visibility,
 				artifact.CreatedAt.AsTime().Format(time.RFC3339),
 			)
@@ -74,13 +96,13 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 		}
 		t.Render()
 	case app.JSON:
-		out, err := util.GetJsonFromProto(artifactList)
+		out, err := util.GetJsonFromProto(resp)
 		if err != nil {
 			return cli.MessageAndError("Error getting json from proto", err)
 		}
 		cmd.Println(out)
 	case app.YAML:
-		out, err := util.GetYamlFromProto(artifactList)
+		out, err := util.GetYamlFromProto(resp)
 		if err != nil {
 			return cli.MessageAndError("Error getting yaml from proto", err)
 		}
@@ -90,10 +112,26 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 	return nil
 }
 
+// artifactRepository returns the repository to show for an artifact. Listed
+// artifacts don't carry the repository name, so fall back to the requested
+// repository or the ID of the one the artifact originated from.
+func artifactRepository(artifact *minderv1.Artifact, repo string) string {
+	switch {
+	case artifact.GetRepository() != "":
+		return artifact.GetRepository()
+	case repo != "":
+		return repo
+	default:
+		return artifact.GetRepositoryId()
+	}
+}
+
 func init() {
 	ArtifactCmd.AddCommand(listCmd)
 	// Flags
 	listCmd.Flags().StringP("output", "o", app.Table,
 		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
 	listCmd.Flags().String("from", "", "Filter artifacts from a source, example: from=repository=owner/repo")
+	listCmd.Flags().StringP("repo", "r", "", "List the artifacts built from a repository, example: owner/repo")
+	listCmd.MarkFlagsMutuallyExclusive("from", "repo")
 }
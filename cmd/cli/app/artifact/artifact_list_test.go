@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestArtifactListCommand(t *testing.T) {
+	tests := []cli.CmdTestCase{
+		{
+			Name: "list artifacts - yaml output",
+			Args: []string{"artifact", "list", "-o", "yaml"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockArtifactServiceClient(ctrl)
+
+				resp := &minderv1.ListArtifactsResponse{}
+				cli.LoadFixture(t, "mock_artifact_list.json", resp)
+
+				client.EXPECT().
+					ListArtifacts(gomock.Any(), gomock.Any()).
+					Return(resp, nil).
+					Times(1)
+
+				return cli.WithRPCClient[minderv1.ArtifactServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "artifact_list.yaml",
+		},
+		{
+			Name: "list artifacts of a repository - yaml output",
+			Args: []string{"artifact", "list", "--repo", "org/repo", "-o", "yaml"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockArtifactServiceClient(ctrl)
+
+				resp := &minderv1.ListArtifactsByRepositoryResponse{}
+				cli.LoadFixture(t, "mock_artifact_list_repo.json", resp)
+
+				client.EXPECT().
+					ListArtifactsByRepository(gomock.Any(), gomock.Cond(func(req *minderv1.ListArtifactsByRepositoryRequest) bool {
+						return req.GetRepositoryName() == "org/repo"
+					})).
+					Return(resp, nil).
+					Times(1)
+
+				return cli.WithRPCClient[minderv1.ArtifactServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "artifact_list_repo.yaml",
+		},
+		{
+			Name:          "repo and from filters are mutually exclusive",
+			Args:          []string{"artifact", "list", "--repo", "org/repo", "--from", "repository=org/repo"},
+			ExpectedError: "none of the others can be",
+		},
+		{
+			Name: "server error handling",
+			Args: []string{"artifact", "list", "--repo", "org/repo"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockArtifactServiceClient(ctrl)
+
+				client.EXPECT().
+					ListArtifactsByRepository(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, "repository not found")).
+					Times(1)
+
+				return cli.WithRPCClient[minderv1.ArtifactServiceClient](context.Background(), client)
+			},
+			ExpectedError: "repository not found",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, ArtifactCmd)
+}
@@ -100,6 +100,8 @@ func outputDataSource(cmd *cobra.Command, format string, ds *minderv1.DataSource
 			functions = maps.Keys(driver.Rest.GetDef())
 		case *minderv1.DataSource_Structured:
 			functions = maps.Keys(driver.Structured.GetDef())
+		case *minderv1.DataSource_DepsDev:
+			functions = maps.Keys(driver.DepsDev.GetDef())
 		}
 		t.AddRow(ds.Name, ds.GetDriverType(), strings.Join(slices.Sorted(functions), ", "))
 		t.Render()
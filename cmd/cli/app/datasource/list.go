@@ -37,6 +37,13 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 	project := viper.GetString("project")
 	format := viper.GetString("output")
 
+	var visibility minderv1.Visibility
+	if v := viper.GetString("visibility"); v != "" {
+		if err := visibility.FromString(v); err != nil {
+			return cli.MessageAndError("Invalid visibility", err)
+		}
+	}
+
 	// No longer print usage on returned error, since we've parsed our inputs
 	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
 	cmd.SilenceUsage = true
@@ -45,6 +52,7 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 		Context: &minderv1.ContextV2{
 			ProjectId: project,
 		},
+		Visibility: visibility,
 	})
 	if err != nil {
 		return cli.MessageAndError("Failed to list data sources", err)
@@ -73,6 +81,8 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 				functions = maps.Keys(driver.Rest.GetDef())
 			case *minderv1.DataSource_Structured:
 				functions = maps.Keys(driver.Structured.GetDef())
+			case *minderv1.DataSource_DepsDev:
+				functions = maps.Keys(driver.DepsDev.GetDef())
 			}
 			t.AddRow(ds.Name, ds.GetDriverType(), strings.Join(slices.Sorted(functions), "\n"))
 		}
@@ -89,4 +99,5 @@ func init() {
 
 	listCmd.Flags().StringP("output", "o", app.Table,
 		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	listCmd.Flags().String("visibility", "", "Only list data sources with this visibility (one of private,subtree,global)")
 }
@@ -16,13 +16,20 @@ import (
 
 	"github.com/mindersec/minder/cmd/cli/app"
 	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 )
 
 // DocsCmd generates documentation
 var DocsCmd = &cobra.Command{
-	Use:    "docs",
-	Short:  "Generates documentation for the client",
-	Long:   `Generates documentation for the client.`,
+	Use:   "docs",
+	Short: "Generates documentation for the client",
+	Long: `Generates documentation for the client.
+
+With --api-output, the OpenAPI specification and the protobuf descriptors of
+the API served by the connected server are also written to a directory named
+after the server version, e.g. <api-output>/v0.1.0/minder.swagger.json and
+<api-output>/v0.1.0/minder.binpb, so that clients can be generated for the
+exact API deployed.`,
 	Hidden: true,
 	PreRunE: func(cmd *cobra.Command, _ []string) error {
 		if err := viper.BindPFlags(cmd.Flags()); err != nil {
@@ -30,7 +37,7 @@ var DocsCmd = &cobra.Command{
 		}
 		return nil
 	},
-	RunE: func(_ *cobra.Command, _ []string) error {
+	RunE: func(cmd *cobra.Command, _ []string) error {
 		// We auto-generate the docs daily, so don't include the date at the bottom.
 		app.RootCmd.DisableAutoGenTag = true
 		// We need to add header material, since GenMarkdownTree always
@@ -54,10 +61,59 @@ var DocsCmd = &cobra.Command{
 		if err := doc.GenMarkdown(app.ConfigHelpCmd, configHelpFile); err != nil {
 			return fmt.Errorf("unable to write markdown for config help: %w", err)
 		}
-		return doc.GenMarkdownTreeCustom(app.RootCmd, "./docs/docs/ref/cli", prefix, identity)
+		if err := doc.GenMarkdownTreeCustom(app.RootCmd, "./docs/docs/ref/cli", prefix, identity); err != nil {
+			return err
+		}
+
+		if apiOutput := viper.GetString("api-output"); apiOutput != "" {
+			return writeAPIBundle(cmd, apiOutput)
+		}
+		return nil
 	},
 }
 
+// writeAPIBundle writes the API definitions of the connected server to a
+// directory named after its version
+func writeAPIBundle(cmd *cobra.Command, dir string) error {
+	conn, err := cli.GrpcForCommand(cmd, viper.GetViper())
+	if err != nil {
+		return cli.MessageAndError("Error connecting to the server", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := cli.GetAppContext(cmd.Context(), viper.GetViper())
+	defer cancel()
+
+	client := minderv1.NewHealthServiceClient(conn)
+	resp, err := client.GetServerMetadata(ctx, &minderv1.GetServerMetadataRequest{})
+	if err != nil {
+		return cli.MessageAndError("Error getting the server metadata", err)
+	}
+
+	version := resp.GetVersion()
+	if version == "" || version != filepath.Base(version) || version == ".." {
+		return fmt.Errorf("unexpected server version %q", version)
+	}
+
+	versionDir := filepath.Join(dir, version)
+	if err := os.MkdirAll(versionDir, 0750); err != nil {
+		return fmt.Errorf("unable to create directory for api docs: %w", err)
+	}
+	if err := os.WriteFile(
+		filepath.Join(versionDir, "minder.swagger.json"), []byte(resp.GetOpenapiSpec()), 0600,
+	); err != nil {
+		return fmt.Errorf("unable to write openapi spec: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(versionDir, "minder.binpb"), resp.GetFileDescriptorSet(), 0600); err != nil {
+		return fmt.Errorf("unable to write protobuf descriptors: %w", err)
+	}
+
+	cmd.Printf("API definitions of server version %s written to %s\n", version, versionDir)
+	return nil
+}
+
 func init() {
 	app.RootCmd.AddCommand(DocsCmd)
+	DocsCmd.Flags().String("api-output", "",
+		"Directory to write the API definitions of the connected server to, in a subdirectory per server version")
 }
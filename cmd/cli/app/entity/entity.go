@@ -16,8 +16,9 @@ var EntityCmd = &cobra.Command{
 	Short: "Manage entities within a Minder project",
 	Long: `Manage entities within a Minder project.
 
-This command allows you to list, get, register, and delete entity instances
-connected to Minder for security analysis and policy enforcement.`,
+This command allows you to list, get, register, delete and set the owner of
+entity instances connected to Minder for security analysis and policy
+enforcement.`,
 	Example: `
   # List entities
     minder entity list --type repository
@@ -30,6 +31,9 @@ connected to Minder for security analysis and policy enforcement.`,
 
   # Delete an entity
     minder entity delete --id <entity-id>
+
+  # Set the team owning an entity
+    minder entity owner --id <entity-id> --team <team> --contact <email>
 `,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		return cmd.Usage()
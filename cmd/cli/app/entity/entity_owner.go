@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var ownerCmd = &cobra.Command{
+	Use:   "owner",
+	Short: "Set the owner of an entity",
+	Long: `The entity owner subcommand sets the team owning an entity. Alerts raised on
+the entity are routed to the contact of the owning team, and escalate to its
+escalation channel. Omitted fields are cleared.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %w", err)
+		}
+		return nil
+	},
+	RunE: ownerCommand,
+}
+
+// ownerCommand is the entity owner subcommand
+func ownerCommand(cmd *cobra.Command, _ []string) error {
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewEntityInstanceServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error creating gRPC client", err)
+	}
+	defer closeConn()
+
+	project := viper.GetString("project")
+	provider := viper.GetString("provider")
+	id := viper.GetString("id")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.SetEntityOwner(cmd.Context(), &minderv1.SetEntityOwnerRequest{
+		Context: &minderv1.ContextV2{
+			ProjectId: project,
+			Provider:  provider,
+		},
+		Id:                id,
+		Team:              viper.GetString("team"),
+		Contact:           viper.GetString("contact"),
+		EscalationChannel: viper.GetString("escalation-channel"),
+	})
+	if err != nil {
+		return cli.MessageAndError("Error setting entity owner", err)
+	}
+
+	cmd.Printf("Successfully set the owner of entity with ID: %s\n", resp.GetId())
+	return nil
+}
+
+func init() {
+	EntityCmd.AddCommand(ownerCmd)
+	// Flags
+	ownerCmd.Flags().StringP("id", "i", "", "ID of the entity")
+	ownerCmd.Flags().StringP("team", "t", "", "Name of the team owning the entity")
+	ownerCmd.Flags().StringP("contact", "c", "", "Email address the alerts are routed to")
+	ownerCmd.Flags().StringP("escalation-channel", "e", "", "Channel, e.g. a chat room, the alerts escalate to")
+	if err := ownerCmd.MarkFlagRequired("id"); err != nil {
+		panic(err)
+	}
+}
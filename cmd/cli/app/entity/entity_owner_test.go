@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestOwnerCommand(t *testing.T) {
+	const entityID = "00000000-0000-0000-0000-000000000001"
+
+	tests := []cli.CmdTestCase{
+		{
+			Name: "set owner - success",
+			Args: []string{"entity", "owner", "--id", entityID,
+				"--team", "platform", "--contact", "platform@example.com", "--escalation-channel", "#platform"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockEntityInstanceServiceClient(ctrl)
+				client.EXPECT().
+					SetEntityOwner(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *minderv1.SetEntityOwnerRequest, _ ...any) (
+						*minderv1.SetEntityOwnerResponse, error) {
+						if req.GetTeam() != "platform" || req.GetContact() != "platform@example.com" ||
+							req.GetEscalationChannel() != "#platform" {
+							return nil, status.Error(codes.InvalidArgument, "unexpected owner")
+						}
+						return &minderv1.SetEntityOwnerResponse{Id: req.GetId()}, nil
+					})
+				return cli.WithRPCClient[minderv1.EntityInstanceServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "owner_set.txt",
+		},
+		{
+			Name:          "missing required id flag",
+			Args:          []string{"entity", "owner", "--team", "platform"},
+			ExpectedError: "required flag(s) \"id\" not set",
+		},
+		{
+			Name: "grpc error",
+			Args: []string{"entity", "owner", "--id", entityID, "--contact", "not-an-email"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockEntityInstanceServiceClient(ctrl)
+				client.EXPECT().
+					SetEntityOwner(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.InvalidArgument, "contact: value must be a valid email address"))
+				return cli.WithRPCClient[minderv1.EntityInstanceServiceClient](context.Background(), client)
+			},
+			ExpectedError: "value must be a valid email address",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, EntityCmd)
+}
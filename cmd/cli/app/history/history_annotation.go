@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// annotationCmd is the root command for the annotation subcommands
+var annotationCmd = &cobra.Command{
+	Use:   "annotation",
+	Short: "Manage annotations of evaluation results",
+	Long: `The history annotation subcommands attach triage states, ticket links and
+notes to evaluation results. Annotations belong to the rule and entity pair of
+the evaluation, so they are also shown along with its later results.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+var annotationCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Annotate an evaluation result",
+	Long: `The history annotation create subcommand attaches an annotation to an
+evaluation result. Exactly one of --triage-state, --ticket or --note must be
+given. Triage states are one of open, acknowledged, in_progress, resolved,
+false_positive or accepted_risk.`,
+	RunE: cli.GRPCClientWrapRunE(annotationCreateCommand),
+}
+
+var annotationListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the annotations of an evaluation result",
+	Long: `The history annotation list subcommand lists the annotations of the rule and
+entity pair of an evaluation result, including those attached to its other
+evaluations.`,
+	RunE: cli.GRPCClientWrapRunE(annotationListCommand),
+}
+
+var annotationDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete an annotation",
+	Long:  `The history annotation delete subcommand deletes an annotation by its ID.`,
+	RunE:  cli.GRPCClientWrapRunE(annotationDeleteCommand),
+}
+
+// annotationCreateCommand is the history annotation "create" subcommand
+func annotationCreateCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewEvalResultsServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	req := &minderv1.CreateEvaluationAnnotationRequest{
+		Context:      &minderv1.Context{Project: &project},
+		EvaluationId: viper.GetString("id"),
+		Source:       viper.GetString("source"),
+	}
+	switch {
+	case cmd.Flags().Changed("triage-state"):
+		req.Kind = minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_TRIAGE_STATE
+		req.Value = viper.GetString("triage-state")
+	case cmd.Flags().Changed("ticket"):
+		req.Kind = minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_TICKET
+		req.Value = viper.GetString("ticket")
+	default:
+		req.Kind = minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_NOTE
+		req.Value = viper.GetString("note")
+	}
+
+	resp, err := client.CreateEvaluationAnnotation(ctx, req)
+	if err != nil {
+		return cli.MessageAndError("Error annotating evaluation", err)
+	}
+
+	return formatAndDisplayAnnotations(cmd, format, resp, resp.GetAnnotation())
+}
+
+// annotationListCommand is the history annotation "list" subcommand
+func annotationListCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewEvalResultsServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	resp, err := client.ListEvaluationAnnotations(ctx, &minderv1.ListEvaluationAnnotationsRequest{
+		Context:      &minderv1.Context{Project: &project},
+		EvaluationId: viper.GetString("id"),
+	})
+	if err != nil {
+		return cli.MessageAndError("Error listing annotations", err)
+	}
+
+	return formatAndDisplayAnnotations(cmd, format, resp, resp.GetAnnotations()...)
+}
+
+// annotationDeleteCommand is the history annotation "delete" subcommand
+func annotationDeleteCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewEvalResultsServiceClient(conn)
+
+	project := viper.GetString("project")
+	id := viper.GetString("id")
+
+	if _, err := client.DeleteEvaluationAnnotation(ctx, &minderv1.DeleteEvaluationAnnotationRequest{
+		Context: &minderv1.Context{Project: &project},
+		Id:      id,
+	}); err != nil {
+		return cli.MessageAndError("Error deleting annotation", err)
+	}
+
+	cmd.Printf("Deleted annotation %s\n", id)
+	return nil
+}
+
+func formatAndDisplayAnnotations(
+	cmd *cobra.Command,
+	format string,
+	resp proto.Message,
+	annotations ...*minderv1.EvaluationAnnotation,
+) error {
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(),
+			[]string{"ID", "Evaluation", "Kind", "Value", "Source", "Created By", "Created At"}).
+			SetAutoMerge(false).
+			SetEqualColumns(false)
+		for _, a := range annotations {
+			t.AddRow(
+				a.GetId(),
+				a.GetEvaluationId(),
+				strings.ToLower(strings.TrimPrefix(a.GetKind().String(), "EVALUATION_ANNOTATION_KIND_")),
+				a.GetValue(),
+				a.GetSource(),
+				a.GetCreatedBy(),
+				a.GetCreatedAt().AsTime().Format("2006-01-02 15:04:05"),
+			)
+		}
+		t.Render()
+	}
+	return nil
+}
+
+func init() {
+	historyCmd.AddCommand(annotationCmd)
+	annotationCmd.AddCommand(annotationCreateCmd, annotationListCmd, annotationDeleteCmd)
+	// Flags
+	annotationCreateCmd.Flags().StringP("id", "i", "", "ID of the evaluation to annotate")
+	annotationCreateCmd.Flags().String("triage-state", "", "Triage state of the finding")
+	annotationCreateCmd.Flags().String("ticket", "", "URL of the ticket tracking the finding")
+	annotationCreateCmd.Flags().String("note", "", "Free text note on the finding")
+	annotationCreateCmd.Flags().String("source", "", "System the annotation comes from, e.g. jira")
+	annotationCreateCmd.MarkFlagsOneRequired("triage-state", "ticket", "note")
+	annotationCreateCmd.MarkFlagsMutuallyExclusive("triage-state", "ticket", "note")
+	annotationListCmd.Flags().StringP("id", "i", "", "ID of the evaluation whose annotations to list")
+	annotationDeleteCmd.Flags().StringP("id", "i", "", "ID of the annotation to delete")
+
+	// Required
+	for _, c := range []*cobra.Command{annotationCreateCmd, annotationListCmd, annotationDeleteCmd} {
+		if err := c.MarkFlagRequired("id"); err != nil {
+			c.Printf("Error marking flag required: %s", err)
+			os.Exit(1)
+		}
+	}
+}
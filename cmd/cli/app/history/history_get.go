@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var getCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get an evaluation from the history",
+	Long: `The history get subcommand shows an evaluation result by its ID, along with
+the details of its remediation and alert and links to the pull requests, issues
+or security advisories they created.`,
+	RunE: cli.GRPCClientWrapRunE(getCommand),
+}
+
+// getCommand is the history "get" subcommand
+func getCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewEvalResultsServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.GetEvaluationHistory(ctx, &minderv1.GetEvaluationHistoryRequest{
+		Context: &minderv1.Context{Project: &project},
+		Id:      viper.GetString("id"),
+	})
+	if err != nil {
+		return cli.MessageAndError("Error getting evaluation", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		printEvaluation(cmd.OutOrStdout(), resp.GetEvaluation())
+	}
+
+	return nil
+}
+
+func printEvaluation(w io.Writer, eval *minderv1.EvaluationHistory) {
+	t := table.New(table.Simple, layouts.Default, w, []string{"Key", "Value"})
+
+	t.AddRow("ID", eval.GetId())
+	t.AddRow("Time", eval.GetEvaluatedAt().AsTime().Format(time.DateTime))
+	t.AddRow("Entity", fmt.Sprintf("%s [%s]", eval.GetEntity().GetName(), eval.GetEntity().GetType()))
+	t.AddRow("Profile", eval.GetRule().GetProfile())
+	t.AddRow("Rule", fmt.Sprintf("%s [%s]", eval.GetRule().GetName(), eval.GetRule().GetRuleType()))
+	t.AddRow("Status", statusWithDetails(eval.GetStatus().GetStatus(), eval.GetStatus().GetDetails()))
+	if rem := eval.GetRemediation(); rem != nil {
+		t.AddRow("Remediation", statusWithDetails(rem.GetStatus(), rem.GetDetails()))
+		if link := table.GetActionLink(rem.GetLink()); link != "" {
+			t.AddRow("Remediation Link", link)
+		}
+	}
+	if alert := eval.GetAlert(); alert != nil {
+		t.AddRow("Alert", statusWithDetails(alert.GetStatus(), alert.GetDetails()))
+		if link := table.GetActionLink(alert.GetLink()); link != "" {
+			t.AddRow("Alert Link", link)
+		}
+	}
+
+	t.Render()
+}
+
+func statusWithDetails(status, details string) string {
+	details = strings.TrimSpace(details)
+	if details == "" {
+		return status
+	}
+	return fmt.Sprintf("%s: %s", status, details)
+}
+
+func init() {
+	historyCmd.AddCommand(getCmd)
+	// Flags
+	getCmd.Flags().StringP("id", "i", "", "ID of the evaluation to get")
+	// Required
+	if err := getCmd.MarkFlagRequired("id"); err != nil {
+		getCmd.Printf("Error marking flag required: %s", err)
+		os.Exit(1)
+	}
+}
@@ -47,9 +47,11 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 	entityName := viper.GetStringSlice("entity-name")
 	entityType := viper.GetStringSlice("entity-type")
 	evalStatus := viper.GetStringSlice("eval-status")
+	errorCode := viper.GetStringSlice("error-code")
 	remediationStatus := viper.GetStringSlice("remediation-status")
 	alertStatus := viper.GetStringSlice("alert-status")
 	labels := viper.GetStringSlice("label")
+	search := viper.GetString("search")
 
 	// time range
 	from := viper.GetTime("from")
@@ -71,6 +73,10 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 		return err
 	}
 
+	if err := validatedFilter(errorCode, errorCodes); err != nil {
+		return err
+	}
+
 	if err := validatedFilter(remediationStatus, remediationStatuses); err != nil {
 		return err
 	}
@@ -90,9 +96,11 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 		EntityName:  entityName,
 		ProfileName: profileName,
 		Status:      evalStatus,
+		ErrorCode:   errorCode,
 		Remediation: remediationStatus,
 		Alert:       alertStatus,
 		LabelFilter: labels,
+		Search:      search,
 		From:        nil,
 		To:          nil,
 		Cursor:      cursorFromOptions(cursorStr, size),
@@ -149,7 +157,7 @@ func cursorFromOptions(cursorStr string, size uint32) *minderv1.Cursor {
 
 func printTable(w io.Writer, resp *minderv1.ListEvaluationHistoryResponse, emoji bool) {
 	historyTable := table.New(table.Simple, layouts.Default, w,
-		[]string{"Time", "Entity", "Rule", "Status"}).
+		[]string{"Time", "Entity", "Rule", "Status", "Remediation", "Alert"}).
 		SetAutoMerge(true)
 
 	renderRuleEvaluationStatusTable(resp.Data, historyTable, emoji)
@@ -228,6 +236,8 @@ func renderRuleEvaluationStatusTable(
 			layouts.NoColor(eval.Entity.Name),
 			layouts.NoColor(eval.Rule.Name),
 			table.GetStatusIcon(types.HistoryStatus(eval), emoji),
+			layouts.NoColor(table.GetActionLink(eval.GetRemediation().GetLink())),
+			layouts.NoColor(table.GetActionLink(eval.GetAlert().GetLink())),
 		)
 	}
 }
@@ -237,6 +247,7 @@ func init() {
 
 	basicMsg := "Filter evaluation history list by %s - one of %s"
 	evalFilterMsg := fmt.Sprintf(basicMsg, "evaluation status", strings.Join(evalStatuses, ", "))
+	errorCodeFilterMsg := fmt.Sprintf(basicMsg, "evaluation error code", strings.Join(errorCodes, ", "))
 	remediationFilterMsg := fmt.Sprintf(basicMsg, "remediation status", strings.Join(remediationStatuses, ", "))
 	alertFilterMsg := fmt.Sprintf(basicMsg, "alert status", strings.Join(alertStatuses, ", "))
 	entityTypesMsg := fmt.Sprintf(basicMsg, "entity type", strings.Join(entityTypes, ", "))
@@ -246,6 +257,7 @@ func init() {
 	listCmd.Flags().StringSlice("entity-name", nil, "Filter evaluation history list by entity name")
 	listCmd.Flags().StringSlice("entity-type", nil, entityTypesMsg)
 	listCmd.Flags().StringSlice("eval-status", nil, evalFilterMsg)
+	listCmd.Flags().StringSlice("error-code", nil, errorCodeFilterMsg)
 	listCmd.Flags().StringSlice("remediation-status", nil, remediationFilterMsg)
 	listCmd.Flags().StringSlice("alert-status", nil, alertFilterMsg)
 	listCmd.Flags().StringSliceP("label", "l", nil, "Filter evaluation history list by label")
@@ -253,6 +265,8 @@ func init() {
 		listCmd.Printf("Error hiding flag: %s", err)
 		os.Exit(1)
 	}
+	listCmd.Flags().String("search", "",
+		"Filter evaluation history list by words in the evaluation or remediation details, e.g. CVE-2024-1234")
 	listCmd.Flags().String("from", "", "Filter evaluation history list by time")
 	listCmd.Flags().String("to", "", "Filter evaluation history list by time")
 	listCmd.Flags().StringP("cursor", "c", "", "Fetch previous or next page from the list")
@@ -268,6 +282,20 @@ var evalStatuses = []string{
 	string(db.EvalStatusTypesError),
 	string(db.EvalStatusTypesSuccess),
 	string(db.EvalStatusTypesSkipped),
+	string(db.EvalStatusTypesExcepted),
+	string(db.EvalStatusTypesSuppressed),
+}
+
+var errorCodes = []string{
+	string(db.EvalErrorCodesUnknown),
+	string(db.EvalErrorCodesInternal),
+	string(db.EvalErrorCodesProviderUnavailable),
+	string(db.EvalErrorCodesRateLimited),
+	string(db.EvalErrorCodesPolicyCompileError),
+	string(db.EvalErrorCodesIngestionNotApplicable),
+	string(db.EvalErrorCodesIngestionFailed),
+	string(db.EvalErrorCodesInvalidRuleParameters),
+	string(db.EvalErrorCodesLimitExceeded),
 }
 
 var remediationStatuses = []string{
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var purgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Purge stale evaluations",
+	Long: `The history purge subcommand deletes the rule and entity pairs of the project
+which were not evaluated for a while, along with their evaluation history. These
+are left behind when a rule no longer applies to an entity, e.g. when the entity
+is no longer selected by the profile. Use --dry-run to see what would be purged.`,
+	RunE: cli.GRPCClientWrapRunE(purgeCommand),
+}
+
+// purgeCommand is the history "purge" subcommand
+func purgeCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewEvalResultsServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	req := &minderv1.PurgeStaleEvaluationsRequest{
+		Context: &minderv1.Context{Project: &project},
+		DryRun:  viper.GetBool("dry-run"),
+	}
+	if cmd.Flags().Lookup("older-than").Changed {
+		req.OlderThan = durationpb.New(viper.GetDuration("older-than"))
+	}
+
+	resp, err := client.PurgeStaleEvaluations(ctx, req)
+	if err != nil {
+		return cli.MessageAndError("Error purging stale evaluations", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		verb := "Purged"
+		if resp.GetDryRun() {
+			verb = "Would purge"
+		}
+		cmd.Printf("%s %d rule and entity pairs, with %d evaluations\n",
+			verb, resp.GetRuleEntities(), resp.GetEvaluations())
+	}
+
+	return nil
+}
+
+func init() {
+	historyCmd.AddCommand(purgeCmd)
+	// Flags
+	purgeCmd.Flags().Duration("older-than", 0,
+		"Purge the pairs last evaluated longer ago than this (e.g. 720h). Defaults to the server setting")
+	purgeCmd.Flags().Bool("dry-run", false, "Only report what would be purged")
+}
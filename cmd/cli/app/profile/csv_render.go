@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"encoding/csv"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// ruleEvaluationsCSVHeader is the header of the rule evaluations CSV export
+var ruleEvaluationsCSVHeader = []string{
+	"entity_type", "entity_name", "provider", "rule_type", "rule_name", "severity",
+	"status", "details", "remediation_status", "alert_status", "last_updated",
+	"remediation_link", "alert_link",
+}
+
+// RenderRuleEvaluationStatusCSV writes the rule evaluations as CSV, one row
+// per rule and entity.
+func RenderRuleEvaluationStatusCSV(out io.Writer, statuses []*minderv1.RuleEvaluationStatus) error {
+	slices.SortFunc(statuses, func(a, b *minderv1.RuleEvaluationStatus) int {
+		if sort := strings.Compare(a.EntityInfo["name"], b.EntityInfo["name"]); sort != 0 {
+			return sort
+		}
+		if sort := strings.Compare(a.Entity, b.Entity); sort != 0 {
+			return sort
+		}
+		return strings.Compare(RuleDisplayName(a), RuleDisplayName(b))
+	})
+
+	w := csv.NewWriter(out)
+	if err := w.Write(ruleEvaluationsCSVHeader); err != nil {
+		return err
+	}
+
+	for _, eval := range statuses {
+		lastUpdated := ""
+		if eval.GetLastUpdated() != nil {
+			lastUpdated = eval.GetLastUpdated().AsTime().Format(time.RFC3339)
+		}
+		severity := eval.GetSeverity().GetValue()
+
+		if err := w.Write([]string{
+			eval.GetEntity(),
+			eval.GetEntityInfo()["name"],
+			eval.GetEntityInfo()["provider"],
+			eval.GetRuleTypeName(),
+			RuleDisplayName(eval),
+			severity.AsString(),
+			eval.GetStatus(),
+			eval.GetDetails(),
+			eval.GetRemediationStatus(),
+			eval.GetAlert().GetStatus(),
+			lastUpdated,
+			eval.GetRemediationLink().GetUrl(),
+			eval.GetAlert().GetLink().GetUrl(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
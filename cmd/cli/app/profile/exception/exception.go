@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exception provides the CLI subcommand for managing exceptions to
+// profile rules
+package exception
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/cmd/cli/app/profile"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// exceptionCmd is the root command for the exception subcommands
+var exceptionCmd = &cobra.Command{
+	Use:   "exception",
+	Short: "Manage exceptions to profile rules",
+	Long: `The profile exception subcommand allows requesting and reviewing exceptions
+to the rules of a profile for a single entity. While an approved exception is
+active, failures of the rule for the entity are reported as "excepted".`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	profile.ProfileCmd.AddCommand(exceptionCmd)
+	// Flags
+	exceptionCmd.PersistentFlags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
+
+func newExceptionsTable(out io.Writer) table.Table {
+	return table.New(table.Simple, layouts.Default, out,
+		[]string{"ID", "Profile", "Rule", "Entity", "State", "Expires At"}).
+		SetAutoMerge(false).
+		SetEqualColumns(false)
+}
+
+func renderException(ex *minderv1.RuleException, t table.Table) {
+	state := strings.ToLower(strings.TrimPrefix(ex.GetState().String(), "RULE_EXCEPTION_STATE_"))
+	t.AddRow(
+		ex.GetId(),
+		ex.GetProfile(),
+		ex.GetRuleName(),
+		ex.GetEntity().GetName(),
+		state,
+		ex.GetExpiresAt().AsTime().Format("2006-01-02 15:04:05"),
+	)
+}
+
+func formatAndDisplayOutput(cmd *cobra.Command, format string, resp proto.Message, exceptions ...*minderv1.RuleException) error {
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := newExceptionsTable(cmd.OutOrStdout())
+		for _, ex := range exceptions {
+			renderException(ex, t)
+		}
+		t.Render()
+	}
+	return nil
+}
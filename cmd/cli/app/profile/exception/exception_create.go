@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exception
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Request an exception to a profile rule",
+	Long: `The profile exception create subcommand requests an exception to a rule of a
+profile for an entity. The exception is pending until it is approved or
+rejected by another user.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: createCommand,
+}
+
+// createCommand is the profile exception "create" subcommand
+func createCommand(cmd *cobra.Command, _ []string) error {
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	expiresIn := viper.GetDuration("expires-in")
+	if expiresIn <= 0 {
+		return cli.MessageAndError("Invalid expiration", fmt.Errorf("expires-in must be positive"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closer, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closer()
+
+	resp, err := client.CreateRuleException(cmd.Context(), &minderv1.CreateRuleExceptionRequest{
+		Context:  &minderv1.Context{Project: &project},
+		Profile:  viper.GetString("name"),
+		RuleName: viper.GetString("rule"),
+		Entity: &minderv1.EntityTypedId{
+			Type: minderv1.EntityFromString(viper.GetString("entity-type")),
+			Id:   viper.GetString("entity"),
+		},
+		Justification: viper.GetString("justification"),
+		ExpiresAt:     timestamppb.New(time.Now().Add(expiresIn)),
+	})
+	if err != nil {
+		return cli.MessageAndError("Error requesting exception", err)
+	}
+
+	return formatAndDisplayOutput(cmd, format, resp, resp.GetException())
+}
+
+func init() {
+	exceptionCmd.AddCommand(createCmd)
+	// Flags
+	createCmd.Flags().StringP("name", "n", "", "Name of the profile containing the rule")
+	createCmd.Flags().StringP("rule", "r", "", "Name of the rule in the profile")
+	createCmd.Flags().StringP("entity", "e", "", "ID of the entity to except from the rule")
+	createCmd.Flags().StringP("entity-type", "t", "",
+		fmt.Sprintf("the type of the entity (one of %s)", entities.KnownTypesCSV()))
+	createCmd.Flags().String("justification", "", "Reason for the exception")
+	createCmd.Flags().Duration("expires-in", 30*24*time.Hour, "Duration after which the exception expires")
+
+	// Required
+	for _, flag := range []string{"name", "rule", "entity", "justification"} {
+		if err := createCmd.MarkFlagRequired(flag); err != nil {
+			createCmd.Printf("Error marking flag required: %s", err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exception
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var approveCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "Approve an exception to a profile rule",
+	Long: `The profile exception approve subcommand approves a pending exception. An
+exception can't be approved by the user who requested it.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return reviewCommand(cmd, minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_APPROVED)
+	},
+}
+
+var rejectCmd = &cobra.Command{
+	Use:   "reject",
+	Short: "Reject an exception to a profile rule",
+	Long: `The profile exception reject subcommand rejects a pending exception. An
+exception can't be rejected by the user who requested it.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return reviewCommand(cmd, minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_REJECTED)
+	},
+}
+
+// reviewCommand implements the profile exception "approve" and "reject" subcommands
+func reviewCommand(cmd *cobra.Command, state minderv1.RuleExceptionState) error {
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closer, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closer()
+
+	resp, err := client.ReviewRuleException(cmd.Context(), &minderv1.ReviewRuleExceptionRequest{
+		Context: &minderv1.Context{Project: &project},
+		Id:      viper.GetString("id"),
+		State:   state,
+		Comment: viper.GetString("comment"),
+	})
+	if err != nil {
+		return cli.MessageAndError("Error reviewing exception", err)
+	}
+
+	return formatAndDisplayOutput(cmd, format, resp, resp.GetException())
+}
+
+func init() {
+	for _, c := range []*cobra.Command{approveCmd, rejectCmd} {
+		exceptionCmd.AddCommand(c)
+		// Flags
+		c.Flags().StringP("id", "i", "", "ID of the exception to review")
+		c.Flags().StringP("comment", "c", "", "Comment on the review")
+
+		// Required
+		if err := c.MarkFlagRequired("id"); err != nil {
+			c.Printf("Error marking flag required: %s", err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exception
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/cmd/cli/app/profile"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestExceptionCommands(t *testing.T) {
+	exceptionID := "3ff6a7a1-4a8a-4cbc-9c5c-4ba1a3c3d7a2"
+
+	tests := []cli.CmdTestCase{
+		{
+			Name:          "create failure missing required flags",
+			Args:          []string{"profile", "exception", "create", "-n", "test-profile", "-r", "secret_scanning"},
+			ExpectedError: `required flag(s) "entity", "justification" not set`,
+		},
+		{
+			Name: "create failure invalid expiration",
+			Args: []string{"profile", "exception", "create", "-n", "test-profile", "-r", "secret_scanning",
+				"-e", "repo-id", "--justification", "legacy", "--expires-in=-1h"},
+			ExpectedError: "expires-in must be positive",
+		},
+		{
+			Name: "create failure server error",
+			Args: []string{"profile", "exception", "create", "-n", "test-profile", "-r", "secret_scanning",
+				"-e", "repo-id", "--justification", "legacy"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					CreateRuleException(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, "rule not found"))
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			ExpectedError: "rule not found",
+		},
+		{
+			Name:          "approve failure missing required id flag",
+			Args:          []string{"profile", "exception", "approve"},
+			ExpectedError: `required flag(s) "id" not set`,
+		},
+		{
+			Name: "reject failure server error",
+			Args: []string{"profile", "exception", "reject", "-i", exceptionID},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					ReviewRuleException(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.PermissionDenied, "exceptions can't be reviewed by their requester"))
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			ExpectedError: "exceptions can't be reviewed by their requester",
+		},
+		{
+			Name:          "list failure invalid format",
+			Args:          []string{"profile", "exception", "list", "-o", "invalid"},
+			ExpectedError: "invalid argument",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, profile.ProfileCmd)
+}
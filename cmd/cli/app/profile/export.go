@@ -111,6 +111,7 @@ func exportRuleTypes(
 		prof.GetPipelineRun(),
 		prof.GetTaskRun(),
 		prof.GetBuild(),
+		prof.GetOrganization(),
 	)
 
 	ruletypes := make([]string, 0, len(rules))
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history <name>",
+	Short: "List the previous versions of a profile",
+	Long: `The profile history subcommand lists the previous versions of a profile within
+Minder. A version is recorded every time the profile is updated, along with
+who replaced it and when. Use the JSON or YAML output to see the full
+definition of each version, and the rollback subcommand to restore one.`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: historyCommand,
+}
+
+// historyCommand is the profile "history" subcommand
+func historyCommand(cmd *cobra.Command, args []string) error {
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	resp, err := client.ListProfileVersions(cmd.Context(), &minderv1.ListProfileVersionsRequest{
+		Context: &minderv1.Context{Project: &project},
+		Name:    args[0],
+	})
+	if err != nil {
+		return cli.MessageAndError("Error listing profile versions", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		if len(resp.GetVersions()) == 0 {
+			cmd.Printf("Profile %s has not been updated yet\n", args[0])
+			return nil
+		}
+		table := NewProfileVersionsTable(cmd.OutOrStdout())
+		for _, v := range resp.GetVersions() {
+			RenderProfileVersionsTable(v, table)
+		}
+		table.Render()
+	}
+	return nil
+}
+
+func init() {
+	ProfileCmd.AddCommand(historyCmd)
+	// Flags
+	historyCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestHistoryCommand(t *testing.T) {
+	replacedAt := timestamppb.New(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+
+	tests := []cli.CmdTestCase{
+		{
+			Name: "history table",
+			Args: []string{"profile", "history", "my-profile"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					ListProfileVersions(gomock.Any(), gomock.Any()).
+					Return(&minderv1.ListProfileVersionsResponse{
+						Versions: []*minderv1.ProfileVersion{
+							{
+								Version:   2,
+								Author:    "bob",
+								CreatedAt: replacedAt,
+								Profile: &minderv1.Profile{
+									Name:      "my-profile",
+									Alert:     ptr.Ptr("on"),
+									Remediate: ptr.Ptr("off"),
+									Repository: []*minderv1.Profile_Rule{
+										{Type: "secret_scanning"},
+										{Type: "branch_protection"},
+									},
+								},
+							},
+							{
+								Version:   1,
+								Author:    "alice",
+								CreatedAt: replacedAt,
+								Profile: &minderv1.Profile{
+									Name:       "my-profile",
+									Alert:      ptr.Ptr("off"),
+									Remediate:  ptr.Ptr("off"),
+									Repository: []*minderv1.Profile_Rule{{Type: "secret_scanning"}},
+								},
+							},
+						},
+					}, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "history_table.txt",
+		},
+		{
+			Name: "history not found",
+			Args: []string{"profile", "history", "unknown"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					ListProfileVersions(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, "profile \"unknown\" not found"))
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			ExpectedError: "not found",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, ProfileCmd)
+}
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestRollbackCommand(t *testing.T) {
+	tests := []cli.CmdTestCase{
+		{
+			Name: "rollback success",
+			Args: []string{"profile", "rollback", "my-profile", "--to", "1"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					RollbackProfile(gomock.Any(), gomock.Cond(func(req *minderv1.RollbackProfileRequest) bool {
+						return req.GetName() == "my-profile" && req.GetVersion() == 1
+					})).
+					Return(&minderv1.RollbackProfileResponse{
+						Profile: &minderv1.Profile{
+							Name:       "my-profile",
+							Repository: []*minderv1.Profile_Rule{{Type: "secret_scanning", Name: "secret_scanning"}},
+						},
+					}, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "rollback_success.txt",
+		},
+		{
+			Name:          "rollback invalid version",
+			Args:          []string{"profile", "rollback", "my-profile", "--to", "0"},
+			ExpectedError: "the version must be a positive number",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, ProfileCmd)
+}
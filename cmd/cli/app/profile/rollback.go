@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback <name>",
+	Short: "Restore a previous version of a profile",
+	Long: `The profile rollback subcommand restores a previous version of a profile within
+Minder, as listed by the history subcommand. The rollback is an update of the
+profile, so the definition it replaces is recorded as a new version.`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: rollbackCommand,
+}
+
+// rollbackCommand is the profile "rollback" subcommand
+func rollbackCommand(cmd *cobra.Command, args []string) error {
+	project := viper.GetString("project")
+	version := viper.GetInt32("to")
+
+	if version <= 0 {
+		return cli.MessageAndError("Error rolling back profile", fmt.Errorf("the version must be a positive number"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	resp, err := client.RollbackProfile(cmd.Context(), &minderv1.RollbackProfileRequest{
+		Context: &minderv1.Context{Project: &project},
+		Name:    args[0],
+		Version: version,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error rolling back profile", err)
+	}
+
+	cmd.Printf("Successfully rolled back profile %s to version %d\n", args[0], version)
+	table := NewProfileRulesTable(cmd.OutOrStdout())
+	RenderProfileRulesTable(resp.GetProfile(), table)
+	table.Render()
+	return nil
+}
+
+func init() {
+	ProfileCmd.AddCommand(rollbackCmd)
+	// Flags
+	rollbackCmd.Flags().Int32("to", 0, "Version of the profile to restore")
+
+	// Required
+	if err := rollbackCmd.MarkFlagRequired("to"); err != nil {
+		rollbackCmd.Printf("Error marking flag required: %s", err)
+		os.Exit(1)
+	}
+}
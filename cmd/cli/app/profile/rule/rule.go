@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rule provides the CLI subcommand for enabling and disabling the
+// rules of a profile
+package rule
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/cmd/cli/app/profile"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// ruleCmd is the root command for the rule subcommands
+var ruleCmd = &cobra.Command{
+	Use:   "rule",
+	Short: "Enable or disable the rules of a profile",
+	Long: `The profile rule subcommand allows disabling the rules of a profile without
+removing them from the profile. Disabled rules are reported as skipped until
+they are enabled again.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	profile.ProfileCmd.AddCommand(ruleCmd)
+	// Flags
+	ruleCmd.PersistentFlags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
+
+func newRulesTable(out io.Writer) table.Table {
+	return table.New(table.Simple, layouts.Default, out,
+		[]string{"Profile", "Rule", "Entity", "Disabled By", "Disabled At", "Reason"}).
+		SetAutoMerge(false).
+		SetEqualColumns(false)
+}
+
+func renderRule(rule *minderv1.ProfileRuleState, t table.Table) {
+	disabledAt := ""
+	if rule.GetDisabled() {
+		disabledAt = rule.GetDisabledAt().AsTime().Format("2006-01-02 15:04:05")
+	}
+	t.AddRow(
+		rule.GetProfile(),
+		rule.GetRuleName(),
+		rule.GetEntity().ToString(),
+		rule.GetDisabledBy(),
+		disabledAt,
+		rule.GetDisabledReason(),
+	)
+}
+
+func formatAndDisplayOutput(cmd *cobra.Command, format string, resp proto.Message, rules ...*minderv1.ProfileRuleState) error {
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := newRulesTable(cmd.OutOrStdout())
+		for _, rule := range rules {
+			renderRule(rule, t)
+		}
+		t.Render()
+	}
+	return nil
+}
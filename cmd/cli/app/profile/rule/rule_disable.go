@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var disableCmd = &cobra.Command{
+	Use:   "disable <profile> <rule>",
+	Short: "Disable a rule of a profile",
+	Long: `The profile rule disable subcommand disables a rule of a profile without
+removing it from the profile. The rule is reported as skipped until it is
+enabled again. Who disabled the rule, when and why is recorded.`,
+	Args: cobra.ExactArgs(2),
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: disableCommand,
+}
+
+// disableCommand is the profile rule "disable" subcommand
+func disableCommand(cmd *cobra.Command, args []string) error {
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closer, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closer()
+
+	resp, err := client.DisableProfileRule(cmd.Context(), &minderv1.DisableProfileRuleRequest{
+		Context:  &minderv1.Context{Project: &project},
+		Profile:  args[0],
+		RuleName: args[1],
+		Entity:   minderv1.EntityFromString(viper.GetString("entity-type")),
+		Reason:   viper.GetString("reason"),
+	})
+	if err != nil {
+		return cli.MessageAndError("Error disabling rule", err)
+	}
+
+	return formatAndDisplayOutput(cmd, format, resp, resp.GetRule())
+}
+
+func init() {
+	ruleCmd.AddCommand(disableCmd)
+	// Flags
+	disableCmd.Flags().String("reason", "", "Reason for disabling the rule")
+	disableCmd.Flags().StringP("entity-type", "t", "",
+		fmt.Sprintf("the entity type of the rule, if the profile has rules with the same name for several entity types (one of %s)",
+			entities.KnownTypesCSV()))
+
+	// Required
+	if err := disableCmd.MarkFlagRequired("reason"); err != nil {
+		disableCmd.Printf("Error marking flag required: %s", err)
+		os.Exit(1)
+	}
+}
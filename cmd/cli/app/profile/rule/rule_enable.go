@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var enableCmd = &cobra.Command{
+	Use:   "enable <profile> <rule>",
+	Short: "Enable a disabled rule of a profile",
+	Long: `The profile rule enable subcommand enables a previously disabled rule of a
+profile, so that it is evaluated again.`,
+	Args: cobra.ExactArgs(2),
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: enableCommand,
+}
+
+// enableCommand is the profile rule "enable" subcommand
+func enableCommand(cmd *cobra.Command, args []string) error {
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closer, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closer()
+
+	resp, err := client.EnableProfileRule(cmd.Context(), &minderv1.EnableProfileRuleRequest{
+		Context:  &minderv1.Context{Project: &project},
+		Profile:  args[0],
+		RuleName: args[1],
+		Entity:   minderv1.EntityFromString(viper.GetString("entity-type")),
+	})
+	if err != nil {
+		return cli.MessageAndError("Error enabling rule", err)
+	}
+
+	return formatAndDisplayOutput(cmd, format, resp, resp.GetRule())
+}
+
+func init() {
+	ruleCmd.AddCommand(enableCmd)
+	// Flags
+	enableCmd.Flags().StringP("entity-type", "t", "",
+		fmt.Sprintf("the entity type of the rule, if the profile has rules with the same name for several entity types (one of %s)",
+			entities.KnownTypesCSV()))
+}
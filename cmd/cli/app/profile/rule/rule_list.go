@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the disabled rules of the profiles",
+	Long: `The profile rule list subcommand lists the disabled rules of the profiles
+within Minder, along with who disabled them, when and why.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: listCommand,
+}
+
+// listCommand is the profile rule "list" subcommand
+func listCommand(cmd *cobra.Command, _ []string) error {
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closer, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closer()
+
+	resp, err := client.ListDisabledProfileRules(cmd.Context(), &minderv1.ListDisabledProfileRulesRequest{
+		Context: &minderv1.Context{Project: &project},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error listing disabled rules", err)
+	}
+
+	return formatAndDisplayOutput(cmd, format, resp, resp.GetRules()...)
+}
+
+func init() {
+	ruleCmd.AddCommand(listCmd)
+}
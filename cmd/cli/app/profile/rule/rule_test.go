@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/cmd/cli/app/profile"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestRuleCommands(t *testing.T) {
+	tests := []cli.CmdTestCase{
+		{
+			Name:          "disable failure missing reason",
+			Args:          []string{"profile", "rule", "disable", "test-profile", "secret_scanning"},
+			ExpectedError: `required flag(s) "reason" not set`,
+		},
+		{
+			Name:          "disable failure missing rule",
+			Args:          []string{"profile", "rule", "disable", "test-profile", "--reason", "too noisy"},
+			ExpectedError: "accepts 2 arg(s), received 1",
+		},
+		{
+			Name: "disable failure server error",
+			Args: []string{"profile", "rule", "disable", "test-profile", "secret_scanning", "--reason", "too noisy"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					DisableProfileRule(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, "rule not found"))
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			ExpectedError: "rule not found",
+		},
+		{
+			Name: "enable failure server error",
+			Args: []string{"profile", "rule", "enable", "test-profile", "secret_scanning"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					EnableProfileRule(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.InvalidArgument, "please specify the entity type"))
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			ExpectedError: "please specify the entity type",
+		},
+		{
+			Name:          "list failure invalid format",
+			Args:          []string{"profile", "rule", "list", "-o", "invalid"},
+			ExpectedError: "invalid argument",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, profile.ProfileCmd)
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	failOnFailure = "failure"
+	failOnError   = "error"
+	failOnAny     = "any"
+
+	// exitCodeFailure is the exit code of the status commands when --fail-on
+	// matches a failed evaluation
+	exitCodeFailure = 20
+	// exitCodeError is the exit code of the status commands when --fail-on
+	// matches an evaluation error
+	exitCodeError = 21
+
+	// ciStatusSchemaVersion is the version of the ci-json output, which
+	// changes whenever its schema does
+	ciStatusSchemaVersion = "v1"
+)
+
+var failOnValues = []string{failOnFailure, failOnError, failOnAny}
+
+// failOnHelp documents the --fail-on flag of the status commands
+var failOnHelp = fmt.Sprintf("Exit with code %d if the profile has failed evaluations (failure), "+
+	"with code %d if it has evaluation errors (error), or in both cases (any)", exitCodeFailure, exitCodeError)
+
+// ciStatus is the ci-json output of the status commands. Fields may only be
+// added to it without changing ciStatusSchemaVersion.
+type ciStatus struct {
+	SchemaVersion string         `json:"schema_version"`
+	ProfileID     string         `json:"profile_id"`
+	ProfileName   string         `json:"profile_name"`
+	Status        string         `json:"status"`
+	Counts        map[string]int `json:"counts"`
+	Rules         []ciRuleStatus `json:"rules"`
+}
+
+type ciRuleStatus struct {
+	RuleType   string `json:"rule_type"`
+	RuleName   string `json:"rule_name"`
+	EntityType string `json:"entity_type"`
+	EntityName string `json:"entity_name"`
+	Severity   string `json:"severity"`
+	Status     string `json:"status"`
+	Details    string `json:"details"`
+}
+
+func validateFailOn(failOn string) error {
+	if failOn != "" && !slices.Contains(failOnValues, failOn) {
+		return cli.MessageAndError(
+			fmt.Sprintf("--fail-on must be one of %s", strings.Join(failOnValues, ", ")),
+			fmt.Errorf("invalid argument"))
+	}
+	return nil
+}
+
+// checkFailOn returns an error carrying the exit code of the command if the
+// profile or one of its rule evaluations has the status --fail-on is set to.
+// Errors take precedence over failures.
+func checkFailOn(
+	failOn string, profileStatus *minderv1.ProfileStatus, evals []*minderv1.RuleEvaluationStatus,
+) error {
+	if failOn == "" {
+		return nil
+	}
+
+	statuses := []string{profileStatus.GetProfileStatus()}
+	for _, eval := range evals {
+		statuses = append(statuses, eval.GetStatus())
+	}
+
+	if failOn != failOnFailure && slices.Contains(statuses, failOnError) {
+		return &cli.ErrExitCode{
+			Code:    exitCodeError,
+			Message: fmt.Sprintf("profile %s has evaluation errors", profileStatus.GetProfileName()),
+		}
+	}
+	if failOn != failOnError && slices.Contains(statuses, failOnFailure) {
+		return &cli.ErrExitCode{
+			Code:    exitCodeFailure,
+			Message: fmt.Sprintf("profile %s has failed evaluations", profileStatus.GetProfileName()),
+		}
+	}
+	return nil
+}
+
+// renderCIStatus prints the ci-json output of the status commands
+func renderCIStatus(
+	cmd *cobra.Command, profileStatus *minderv1.ProfileStatus, evals []*minderv1.RuleEvaluationStatus,
+) error {
+	out := ciStatus{
+		SchemaVersion: ciStatusSchemaVersion,
+		ProfileID:     profileStatus.GetProfileId(),
+		ProfileName:   profileStatus.GetProfileName(),
+		Status:        profileStatus.GetProfileStatus(),
+		Counts:        map[string]int{},
+		Rules:         make([]ciRuleStatus, 0, len(evals)),
+	}
+
+	for _, eval := range evals {
+		severity := eval.GetSeverity().GetValue()
+		out.Counts[eval.GetStatus()]++
+		out.Rules = append(out.Rules, ciRuleStatus{
+			RuleType:   eval.GetRuleTypeName(),
+			RuleName:   eval.GetRuleName(),
+			EntityType: eval.GetEntity(),
+			EntityName: eval.GetEntityInfo()["name"],
+			Severity:   severity.AsString(),
+			Status:     eval.GetStatus(),
+			Details:    eval.GetDetails(),
+		})
+	}
+
+	// Sort the rules so the output is stable across runs
+	slices.SortFunc(out.Rules, func(a, b ciRuleStatus) int {
+		return strings.Compare(
+			strings.Join([]string{a.EntityName, a.EntityType, a.RuleType, a.RuleName}, "\x00"),
+			strings.Join([]string{b.EntityName, b.EntityType, b.RuleType, b.RuleName}, "\x00"),
+		)
+	})
+
+	encoded, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return cli.MessageAndError("Error encoding json", err)
+	}
+	cmd.Println(string(encoded))
+	return nil
+}
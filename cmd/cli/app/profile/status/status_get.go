@@ -24,7 +24,11 @@ import (
 var getCmd = &cobra.Command{
 	Use:   "get",
 	Short: "Get profile status",
-	Long:  `The profile status get subcommand lets you get profile status within Minder.`,
+	Long: `The profile status get subcommand lets you get profile status within Minder.
+
+Besides json, yaml and table, the status can be output as ci-json, a
+versioned summary for CI pipelines. With --fail-on, the command exits with a
+nonzero code if the profile has failed evaluations or evaluation errors.`,
 	PreRunE: func(cmd *cobra.Command, _ []string) error {
 		if err := viper.BindPFlags(cmd.Flags()); err != nil {
 			return fmt.Errorf("error binding flags: %s", err)
@@ -42,11 +46,15 @@ func getCommand(cmd *cobra.Command, _ []string) error {
 	entityId := viper.GetString("entity")
 	entityType := viper.GetString("entity-type")
 	format := viper.GetString("output")
+	failOn := viper.GetString("fail-on")
 
 	// Ensure the output format is supported
-	if !app.IsOutputFormatSupported(format) {
+	if format != app.CIJSON && !app.IsOutputFormatSupported(format) {
 		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
 	}
+	if err := validateFailOn(failOn); err != nil {
+		return err
+	}
 
 	// No longer print usage on returned error, since we've parsed our inputs
 	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
@@ -73,13 +81,13 @@ func getCommand(cmd *cobra.Command, _ []string) error {
 		if err != nil {
 			return cli.MessageAndError("Error getting profile status", err)
 		}
-		return formatAndDisplayOutput(cmd, format, resp, viper.GetBool("emoji"))
+		return formatAndDisplayOutput(cmd, format, resp, viper.GetBool("emoji"), failOn)
 	} else if profileName != "" {
 		resp, err := getProfileStatusByName(cmd.Context(), client, project, profileName, entity)
 		if err != nil {
 			return cli.MessageAndError("Error getting profile status", err)
 		}
-		return formatAndDisplayOutput(cmd, format, resp, viper.GetBool("emoji"))
+		return formatAndDisplayOutput(cmd, format, resp, viper.GetBool("emoji"), failOn)
 	}
 
 	return cli.MessageAndError("Error getting profile status", fmt.Errorf("profile id or profile name required"))
@@ -107,6 +115,7 @@ func getProfileStatusById(
 	return &minderv1.GetProfileStatusByIdResponse{
 		ProfileStatus:        resp.ProfileStatus,
 		RuleEvaluationStatus: resp.RuleEvaluationStatus,
+		CanaryRules:          resp.CanaryRules,
 	}, nil
 }
 
@@ -132,16 +141,18 @@ func getProfileStatusByName(
 	return &minderv1.GetProfileStatusByNameResponse{
 		ProfileStatus:        resp.ProfileStatus,
 		RuleEvaluationStatus: resp.RuleEvaluationStatus,
+		CanaryRules:          resp.CanaryRules,
 	}, nil
 }
 
 type protoWithProfileStatus interface {
 	proto.Message
 	GetProfileStatus() *minderv1.ProfileStatus
+	GetRuleEvaluationStatus() []*minderv1.RuleEvaluationStatus
 }
 
 func formatAndDisplayOutput(
-	cmd *cobra.Command, format string, resp protoWithProfileStatus, emoji bool) error {
+	cmd *cobra.Command, format string, resp protoWithProfileStatus, emoji bool, failOn string) error {
 	switch format {
 	case app.JSON:
 		out, err := util.GetJsonFromProto(resp)
@@ -159,8 +170,12 @@ func formatAndDisplayOutput(
 		table := profile.NewProfileStatusTable(cmd.OutOrStdout())
 		profile.RenderProfileStatusTable(resp.GetProfileStatus(), table, emoji)
 		table.Render()
+	case app.CIJSON:
+		if err := renderCIStatus(cmd, resp.GetProfileStatus(), resp.GetRuleEvaluationStatus()); err != nil {
+			return err
+		}
 	}
-	return nil
+	return checkFailOn(failOn, resp.GetProfileStatus(), resp.GetRuleEvaluationStatus())
 }
 
 func init() {
@@ -172,6 +187,7 @@ func init() {
 	getCmd.Flags().StringP("id", "i", "", "ID to get profile status for")
 	getCmd.Flags().StringP("name", "n", "", "Profile name to get profile status for")
 	getCmd.Flags().Bool("emoji", true, "Use emojis in the output")
+	getCmd.Flags().String("fail-on", "", failOnHelp)
 
 	getCmd.MarkFlagsOneRequired("id", "name")
 	// Required
@@ -121,6 +121,26 @@ func TestStatusGetCommand(t *testing.T) {
 			Args:          []string{"profile", "status", "get", "-i", testId, "-t", testEntityType},
 			ExpectedError: `required flag(s) "entity" not set`,
 		},
+		{
+			Name: "failure --fail-on any matches a failed evaluation",
+			Args: []string{
+				"profile", "status", "get", "-i", testId, "-e", testEntityName, "-t", testEntityType, "--fail-on", "any",
+			},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				mockResp := &minderv1.GetProfileStatusByIdResponse{}
+				cli.LoadFixture(t, "mock_profile_status.json", mockResp)
+
+				client.EXPECT().
+					GetProfileStatusById(gomock.Any(), gomock.Any()).
+					Return(mockResp, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			ExpectedError: "profile mock-profile has failed evaluations",
+		},
 		{
 			Name:          "failure missing required entity-type flag",
 			Args:          []string{"profile", "status", "get", "-i", testId, "-e", testEntityName},
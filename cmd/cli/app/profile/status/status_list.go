@@ -20,7 +20,16 @@ import (
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List profile status",
-	Long:  `The profile status list subcommand lets you list profile status within Minder.`,
+	Long: `The profile status list subcommand lets you list profile status within Minder.
+
+Besides json, yaml and table, the status can be exported as csv, with one row
+per rule and entity, or as ci-json, a versioned summary for CI pipelines. The
+csv and ci-json outputs always include the rule evaluations of all the
+entities matching the filters.
+
+With --fail-on, the command exits with a nonzero code if the profile has
+failed evaluations or evaluation errors, e.g. to block merges or deployments
+on the compliance state.`,
 	PreRunE: func(cmd *cobra.Command, _ []string) error {
 		if err := viper.BindPFlags(cmd.Flags()); err != nil {
 			return fmt.Errorf("error binding flags: %s", err)
@@ -36,13 +45,20 @@ func listCommand(cmd *cobra.Command, _ []string) error {
 	detailed := viper.GetBool("detailed")
 	ruleType := viper.GetString("ruleType")
 	ruleName := viper.GetString("ruleName")
+	evalStatus := viper.GetStringSlice("evalStatus")
+	entityName := viper.GetString("entityName")
+	severity := viper.GetStringSlice("severity")
 
 	format := viper.GetString("output")
+	failOn := viper.GetString("fail-on")
 
 	// Ensure the output format is supported
-	if !app.IsOutputFormatSupported(format) {
+	if format != app.CSV && format != app.CIJSON && !app.IsOutputFormatSupported(format) {
 		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
 	}
+	if err := validateFailOn(failOn); err != nil {
+		return err
+	}
 
 	// No longer print usage on returned error, since we've parsed our inputs
 	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
@@ -55,11 +71,14 @@ func listCommand(cmd *cobra.Command, _ []string) error {
 	defer closer()
 
 	resp, err := client.GetProfileStatusByName(cmd.Context(), &minderv1.GetProfileStatusByNameRequest{
-		Context:  &minderv1.Context{Project: &project},
-		Name:     profileName,
-		All:      detailed,
-		RuleType: ruleType,
-		RuleName: ruleName,
+		Context:        &minderv1.Context{Project: &project},
+		Name:           profileName,
+		All:            detailed || format == app.CSV || format == app.CIJSON,
+		RuleType:       ruleType,
+		RuleName:       ruleName,
+		Status:         evalStatus,
+		EntityNameGlob: entityName,
+		Severity:       severity,
 	})
 	if err != nil {
 		return cli.MessageAndError("Error getting profile status", err)
@@ -90,8 +109,16 @@ func listCommand(cmd *cobra.Command, _ []string) error {
 			profile.RenderRuleEvaluationStatusTable(resp.RuleEvaluationStatus, table, viper.GetBool("emoji"))
 			table.Render()
 		}
+	case app.CSV:
+		if err := profile.RenderRuleEvaluationStatusCSV(cmd.OutOrStdout(), resp.RuleEvaluationStatus); err != nil {
+			return cli.MessageAndError("Error writing csv", err)
+		}
+	case app.CIJSON:
+		if err := renderCIStatus(cmd, resp.ProfileStatus, resp.RuleEvaluationStatus); err != nil {
+			return err
+		}
 	}
-	return nil
+	return checkFailOn(failOn, resp.ProfileStatus, resp.RuleEvaluationStatus)
 }
 
 func init() {
@@ -100,9 +127,13 @@ func init() {
 	listCmd.Flags().BoolP("detailed", "d", false, "List all profile violations")
 	listCmd.Flags().StringP("ruleType", "r", "", "Filter profile status list by rule type")
 	listCmd.Flags().String("ruleName", "", "Filter profile status list by rule name")
+	listCmd.Flags().StringSlice("evalStatus", nil, "Filter profile status list by evaluation status, e.g. excepted")
+	listCmd.Flags().String("entityName", "", "Filter profile status list by entity name, \"*\" and \"?\" globs are supported")
+	listCmd.Flags().StringSlice("severity", nil, "Filter profile status list by rule severity, e.g. high")
 
 	listCmd.Flags().StringP("name", "n", "", "Profile name to list status for")
 	listCmd.Flags().Bool("emoji", true, "Use emojis in the output")
+	listCmd.Flags().String("fail-on", "", failOnHelp)
 
 	if err := listCmd.MarkFlagRequired("name"); err != nil {
 		listCmd.Printf("Error marking flag required: %s", err)
@@ -5,6 +5,7 @@ package status
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"go.uber.org/mock/gomock"
@@ -148,6 +149,92 @@ func TestStatusListCommand(t *testing.T) {
 			},
 			GoldenFileName: "status_list.yaml",
 		},
+		{
+			Name: "status list csv with filters",
+			Args: []string{
+				"profile", "status", "list", "-n", testName, "-o", "csv",
+				"--entityName", "acme-corp/*", "--severity", "high,critical",
+			},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				mockResp := &minderv1.GetProfileStatusByNameResponse{}
+				cli.LoadFixture(t, "mock_profile_status.json", mockResp)
+
+				client.EXPECT().
+					GetProfileStatusByName(gomock.Any(), gomock.Cond(func(req *minderv1.GetProfileStatusByNameRequest) bool {
+						return req.GetAll() &&
+							req.GetEntityNameGlob() == "acme-corp/*" &&
+							slices.Equal(req.GetSeverity(), []string{"high", "critical"})
+					})).
+					Return(mockResp, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "status_list.csv",
+		},
+		{
+			Name: "status list ci-json",
+			Args: []string{"profile", "status", "list", "-n", testName, "-o", "ci-json"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				mockResp := &minderv1.GetProfileStatusByNameResponse{}
+				cli.LoadFixture(t, "mock_profile_status.json", mockResp)
+
+				client.EXPECT().
+					GetProfileStatusByName(gomock.Any(), gomock.Cond(func(req *minderv1.GetProfileStatusByNameRequest) bool {
+						return req.GetAll()
+					})).
+					Return(mockResp, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "status_list_ci.json",
+		},
+		{
+			Name: "status list passes when --fail-on doesn't match",
+			Args: []string{"profile", "status", "list", "-n", testName, "--fail-on", "error"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				mockResp := &minderv1.GetProfileStatusByNameResponse{}
+				cli.LoadFixture(t, "mock_profile_status.json", mockResp)
+
+				client.EXPECT().
+					GetProfileStatusByName(gomock.Any(), gomock.Any()).
+					Return(mockResp, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "status_list_table.txt",
+		},
+		{
+			Name: "failure --fail-on matches a failed evaluation",
+			Args: []string{"profile", "status", "list", "-n", testName, "--fail-on", "failure"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				mockResp := &minderv1.GetProfileStatusByNameResponse{}
+				cli.LoadFixture(t, "mock_profile_status.json", mockResp)
+
+				client.EXPECT().
+					GetProfileStatusByName(gomock.Any(), gomock.Any()).
+					Return(mockResp, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			ExpectedError: "profile mock-profile has failed evaluations",
+		},
+		{
+			Name:          "failure invalid --fail-on",
+			Args:          []string{"profile", "status", "list", "-n", testName, "--fail-on", "warning"},
+			ExpectedError: "invalid argument",
+		},
 		{
 			Name:          "failure missing required name flag",
 			Args:          []string{"profile", "status", "list"},
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var shareCmd = &cobra.Command{
+	Use:   "share",
+	Short: "Create a link to share the profile status",
+	Long: `The profile status share subcommand creates an expiring link which gives
+read-only access to the status of a profile, without requiring a Minder account.
+
+Anyone with the link can see the status of the profile until it expires, so
+only share it with the people who need it. Use the ID of the link to revoke it
+earlier with the "profile status unshare" subcommand.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: shareCommand,
+}
+
+// shareCommand is the profile status "share" subcommand
+func shareCommand(cmd *cobra.Command, _ []string) error {
+	project := viper.GetString("project")
+	profileName := viper.GetString("name")
+	profileId := viper.GetString("id")
+	expiresIn := viper.GetDuration("expires-in")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closer, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closer()
+
+	if profileId == "" {
+		p, err := client.GetProfileByName(cmd.Context(), &minderv1.GetProfileByNameRequest{
+			Context: &minderv1.Context{Project: &project},
+			Name:    profileName,
+		})
+		if err != nil {
+			return cli.MessageAndError("Error getting profile by name", err)
+		}
+		profileId = p.GetProfile().GetId()
+	}
+
+	req := &minderv1.CreateProfileStatusShareLinkRequest{
+		Context: &minderv1.Context{Project: &project},
+		Id:      profileId,
+	}
+	if expiresIn != 0 {
+		req.ExpiresIn = durationpb.New(expiresIn)
+	}
+
+	resp, err := client.CreateProfileStatusShareLink(cmd.Context(), req)
+	if err != nil {
+		return cli.MessageAndError("Error creating profile status share link", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(), []string{"Key", "Value"})
+		if resp.GetUrl() != "" {
+			t.AddRow("URL", resp.GetUrl())
+		}
+		t.AddRow("ID", resp.GetId())
+		t.AddRow("Token", resp.GetToken())
+		t.AddRow("Expires At", resp.GetExpiresAt().AsTime().Format(time.DateTime))
+		t.Render()
+	}
+	return nil
+}
+
+func init() {
+	profileStatusCmd.AddCommand(shareCmd)
+	// Flags
+	shareCmd.Flags().StringP("id", "i", "", "ID of the profile whose status is shared")
+	shareCmd.Flags().StringP("name", "n", "", "Name of the profile whose status is shared")
+	shareCmd.Flags().Duration("expires-in", 0,
+		"How long the link is valid, e.g. 72h (defaults to the server setting)")
+
+	shareCmd.MarkFlagsOneRequired("id", "name")
+	shareCmd.MarkFlagsMutuallyExclusive("id", "name")
+}
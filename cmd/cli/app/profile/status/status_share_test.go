@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/cmd/cli/app/profile"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestStatusShareCommand(t *testing.T) {
+	testId := "11111111-1111-1111-1111-111111111111"
+	testName := "test-profile"
+
+	tests := []cli.CmdTestCase{
+		{
+			Name: "status share by id",
+			Args: []string{"profile", "status", "share", "-i", testId, "-o", "json"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				mockResp := &minderv1.CreateProfileStatusShareLinkResponse{}
+				cli.LoadFixture(t, "mock_profile_status_share.json", mockResp)
+
+				client.EXPECT().
+					CreateProfileStatusShareLink(gomock.Any(), gomock.Cond(func(req *minderv1.CreateProfileStatusShareLinkRequest) bool {
+						return req.GetId() == testId && req.GetExpiresIn() == nil
+					})).
+					Return(mockResp, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "status_share.json",
+		},
+		{
+			Name: "status share by name with expiration",
+			Args: []string{"profile", "status", "share", "-n", testName, "--expires-in", "72h", "-o", "yaml"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					GetProfileByName(gomock.Any(), gomock.Any()).
+					Return(&minderv1.GetProfileByNameResponse{
+						Profile: &minderv1.Profile{Id: &testId, Name: testName},
+					}, nil)
+
+				mockResp := &minderv1.CreateProfileStatusShareLinkResponse{}
+				cli.LoadFixture(t, "mock_profile_status_share.json", mockResp)
+
+				client.EXPECT().
+					CreateProfileStatusShareLink(gomock.Any(), gomock.Cond(func(req *minderv1.CreateProfileStatusShareLinkRequest) bool {
+						return req.GetId() == testId && req.GetExpiresIn().AsDuration() == 72*time.Hour
+					})).
+					Return(mockResp, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "status_share.yaml",
+		},
+		{
+			Name:          "failure missing both id and name",
+			Args:          []string{"profile", "status", "share"},
+			ExpectedError: `at least one of the flags in the group [id name] is required`,
+		},
+		{
+			Name: "failure server error",
+			Args: []string{"profile", "status", "share", "-i", testId},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					CreateProfileStatusShareLink(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.InvalidArgument, "expiration cannot be longer than 720h0m0s"))
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			ExpectedError: "expiration cannot be longer than 720h0m0s",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, profile.ProfileCmd)
+}
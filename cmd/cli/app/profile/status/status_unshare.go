@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var unshareCmd = &cobra.Command{
+	Use:   "unshare",
+	Short: "Revoke a link sharing the profile status",
+	Long: `The profile status unshare subcommand revokes a link created by the
+"profile status share" subcommand before it expires. The link can't be used
+anymore once revoked.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: unshareCommand,
+}
+
+// unshareCommand is the profile status "unshare" subcommand
+func unshareCommand(cmd *cobra.Command, _ []string) error {
+	project := viper.GetString("project")
+	linkId := viper.GetString("link-id")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closer, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closer()
+
+	_, err = client.RevokeProfileStatusShareLink(cmd.Context(), &minderv1.RevokeProfileStatusShareLinkRequest{
+		Context: &minderv1.Context{Project: &project},
+		Id:      linkId,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error revoking profile status share link", err)
+	}
+
+	cmd.Printf("Successfully revoked profile status share link %s\n", linkId)
+	return nil
+}
+
+func init() {
+	profileStatusCmd.AddCommand(unshareCmd)
+	// Flags
+	unshareCmd.Flags().String("link-id", "", "ID of the share link to revoke")
+
+	// Required
+	if err := unshareCmd.MarkFlagRequired("link-id"); err != nil {
+		unshareCmd.Printf("Error marking flag required: %s", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/cmd/cli/app/profile"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestStatusUnshareCommand(t *testing.T) {
+	linkId := "22222222-2222-2222-2222-222222222222"
+
+	tests := []cli.CmdTestCase{
+		{
+			Name: "status unshare",
+			Args: []string{"profile", "status", "unshare", "--link-id", linkId},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					RevokeProfileStatusShareLink(gomock.Any(), gomock.Cond(func(req *minderv1.RevokeProfileStatusShareLinkRequest) bool {
+						return req.GetId() == linkId
+					})).
+					Return(&minderv1.RevokeProfileStatusShareLinkResponse{}, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "status_unshare.txt",
+		},
+		{
+			Name:          "failure missing link id",
+			Args:          []string{"profile", "status", "unshare"},
+			ExpectedError: `required flag(s) "link-id" not set`,
+		},
+		{
+			Name: "failure link not found",
+			Args: []string{"profile", "status", "unshare", "--link-id", linkId},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					RevokeProfileStatusShareLink(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, "share link not found"))
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			ExpectedError: "share link not found",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, profile.ProfileCmd)
+}
@@ -45,6 +45,29 @@ func RenderProfileSettingsTable(p *minderv1.Profile, t table.Table) {
 	t.AddRow(p.GetName(), p.GetDisplayName(), p.GetAlert(), p.GetRemediate())
 }
 
+// NewProfileVersionsTable creates a new table for rendering the versions of a profile
+func NewProfileVersionsTable(out io.Writer) table.Table {
+	return table.New(table.Simple, layouts.Default, out,
+		[]string{"Version", "Replaced By", "Replaced At", "Alert", "Remediate", "Rules"}).
+		SetAutoMerge(false).
+		SetEqualColumns(false)
+}
+
+// RenderProfileVersionsTable renders a version of a profile
+func RenderProfileVersionsTable(v *minderv1.ProfileVersion, t table.Table) {
+	p := v.GetProfile()
+	rules := len(p.GetRepository()) + len(p.GetBuildEnvironment()) + len(p.GetArtifact()) +
+		len(p.GetPullRequest()) + len(p.GetRelease())
+	t.AddRow(
+		fmt.Sprintf("%d", v.GetVersion()),
+		v.GetAuthor(),
+		v.GetCreatedAt().AsTime().Format("2006-01-02 15:04:05"),
+		p.GetAlert(),
+		p.GetRemediate(),
+		fmt.Sprintf("%d", rules),
+	)
+}
+
 // NewProfileRulesTable creates a new table for rendering profiles
 func NewProfileRulesTable(out io.Writer) table.Table {
 	return table.New(table.Simple, layouts.Default, out,
@@ -94,7 +117,7 @@ func RenderProfileStatusTable(ps *minderv1.ProfileStatus, t table.Table, emoji b
 // NewRuleEvaluationsTable creates a new table for rendering rule evaluations
 func NewRuleEvaluationsTable(out io.Writer) table.Table {
 	return table.New(table.Simple, layouts.Default, out,
-		[]string{"Entity", "Rule", "Result", "Details"}).
+		[]string{"Entity", "Rule", "Result", "Details", "Links"}).
 		SetAutoMerge(true).
 		SetEqualColumns(false)
 }
@@ -119,16 +142,27 @@ func buildLabeledBlock(label, detail, url string) string {
 	return block
 }
 
-func buildDetailSummary(eval *minderv1.RuleEvaluationStatus) string {
+// buildDetailSummary builds the details of an evaluation. The URLs of the
+// remediation and the alert are left out if withLinks is false and the server
+// returned their links, which are then rendered in a column of their own.
+func buildDetailSummary(eval *minderv1.RuleEvaluationStatus, withLinks bool) string {
 	sections := make([]string, 0, 4)
 
 	if alert := eval.GetAlert(); alert != nil {
-		if section := buildLabeledBlock("Alert", alert.GetDetails(), alert.GetUrl()); section != "" {
+		url := alert.GetUrl()
+		if !withLinks && alert.GetLink() != nil {
+			url = ""
+		}
+		if section := buildLabeledBlock("Alert", alert.GetDetails(), url); section != "" {
 			sections = append(sections, section)
 		}
 	}
 
-	if section := buildLabeledBlock("Remediation", eval.GetRemediationDetails(), eval.GetRemediationUrl()); section != "" {
+	remediationURL := eval.GetRemediationUrl()
+	if !withLinks && eval.GetRemediationLink() != nil {
+		remediationURL = ""
+	}
+	if section := buildLabeledBlock("Remediation", eval.GetRemediationDetails(), remediationURL); section != "" {
 		sections = append(sections, section)
 	}
 
@@ -150,7 +184,20 @@ func RuleDisplayName(eval *minderv1.RuleEvaluationStatus) string {
 
 // FormatEvaluationReasoning returns a rendered reasoning block for CLI output.
 func FormatEvaluationReasoning(eval *minderv1.RuleEvaluationStatus) string {
-	return cmp.Or(buildDetailSummary(eval), "-")
+	return cmp.Or(buildDetailSummary(eval, true), "-")
+}
+
+// formatEvaluationLinks returns the links to the objects the remediation and
+// the alert of an evaluation created, one per line.
+func formatEvaluationLinks(eval *minderv1.RuleEvaluationStatus) string {
+	links := make([]string, 0, 2)
+	if link := table.GetActionLink(eval.GetRemediationLink()); link != "" {
+		links = append(links, link)
+	}
+	if link := table.GetActionLink(eval.GetAlert().GetLink()); link != "" {
+		links = append(links, link)
+	}
+	return strings.Join(links, "\n")
 }
 
 // RenderRuleEvaluationStatusTable renders the rule evaluations table.
@@ -169,12 +216,13 @@ func RenderRuleEvaluationStatusTable(
 	for _, eval := range statuses {
 		evalInfo := types.RuleEvalStatus(eval)
 		ruleName := RuleDisplayName(eval)
-		reasoning := FormatEvaluationReasoning(eval)
+		reasoning := cmp.Or(buildDetailSummary(eval, false), "-")
 		t.AddRowWithColor(
 			layouts.NoColor(fmt.Sprintf("%s\n[%s]", eval.EntityInfo["name"], eval.Entity)),
 			layouts.NoColor(ruleName),
 			table.GetStatusIcon(evalInfo, emoji),
 			layouts.NoColor(reasoning),
+			layouts.NoColor(formatEvaluationLinks(eval)),
 		)
 	}
 }
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deploykey is the root command for the deploy key subcommands
+package deploykey
+
+import (
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/cmd/cli/app/project"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// DeployKeyCmd is the root command for the deploy key subcommands
+var DeployKeyCmd = &cobra.Command{
+	Use:   "deploy-key",
+	Short: "Manage the SSH deploy keys of a project",
+	Long: `The minder project deploy-key commands manage the SSH deploy keys of a project.
+Minder generates the keys and keeps their private half; install the public half
+as a read-only deploy key on the repositories, and set the deploy_key of the git
+ingestion of the rules to clone them over SSH.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	project.ProjectCmd.AddCommand(DeployKeyCmd)
+	DeployKeyCmd.PersistentFlags().StringP("project", "j", "", "ID of the project")
+}
+
+func initializeTableForDeployKeys(out io.Writer) table.Table {
+	return table.New(table.Simple, layouts.Default, out,
+		[]string{"Name", "Fingerprint", "Created At"})
+}
+
+func addDeployKeyRow(t table.Table, key *minderv1.DeployKey) {
+	t.AddRow(
+		key.GetName(),
+		key.GetFingerprint(),
+		key.GetCreatedAt().AsTime().Format(time.RFC3339),
+	)
+}
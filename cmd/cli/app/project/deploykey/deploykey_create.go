@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploykey
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an SSH deploy key in a project",
+	Long: `The minder project deploy-key create command generates an SSH deploy key in a
+project and prints its public key, to be installed as a read-only deploy key on
+the repositories. The private key never leaves Minder.`,
+	RunE: cli.GRPCClientWrapRunE(CreateCommand),
+}
+
+// CreateCommand is the command for creating a deploy key
+func CreateCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+	format := viper.GetString("output")
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.CreateDeployKey(ctx, &minderv1.CreateDeployKeyRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name: name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error creating deploy key", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := initializeTableForDeployKeys(cmd.OutOrStdout())
+		addDeployKeyRow(t, resp.GetDeployKey())
+		t.Render()
+		cmd.Println("Install the following public key as a read-only deploy key on your repositories:")
+		cmd.Println(resp.GetDeployKey().GetPublicKey())
+	}
+	return nil
+}
+
+func init() {
+	DeployKeyCmd.AddCommand(createCmd)
+	createCmd.Flags().StringP("name", "n", "", "Name of the deploy key")
+	createCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	if err := createCmd.MarkFlagRequired("name"); err != nil {
+		createCmd.Print("Error marking `name` flag as required.")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploykey
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete an SSH deploy key of a project",
+	Long: `The minder project deploy-key delete command deletes an SSH deploy key of a
+project. Rules using the key fail to ingest until they are given another one;
+remember to remove the public key from the repositories too.`,
+	RunE: cli.GRPCClientWrapRunE(DeleteCommand),
+}
+
+// DeleteCommand is the command for deleting a deploy key
+func DeleteCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	_, err := client.DeleteDeployKey(ctx, &minderv1.DeleteDeployKeyRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name: name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error deleting deploy key", err)
+	}
+
+	cmd.Printf("Deleted deploy key %s\n", name)
+	return nil
+}
+
+func init() {
+	DeployKeyCmd.AddCommand(deleteCmd)
+	deleteCmd.Flags().StringP("name", "n", "", "Name of the deploy key")
+	if err := deleteCmd.MarkFlagRequired("name"); err != nil {
+		deleteCmd.Print("Error marking `name` flag as required.")
+		os.Exit(1)
+	}
+}
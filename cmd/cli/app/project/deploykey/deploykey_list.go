@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploykey
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the SSH deploy keys of a project",
+	Long: `The minder project deploy-key list command lists the SSH deploy keys of a
+project with their public keys.`,
+	RunE: cli.GRPCClientWrapRunE(ListCommand),
+}
+
+// ListCommand is the command for listing the deploy keys of a project
+func ListCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.ListDeployKeys(ctx, &minderv1.ListDeployKeysRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error listing deploy keys", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := initializeTableForDeployKeys(cmd.OutOrStdout())
+		for _, key := range resp.GetDeployKeys() {
+			addDeployKeyRow(t, key)
+		}
+		t.Render()
+	}
+	return nil
+}
+
+func init() {
+	DeployKeyCmd.AddCommand(listCmd)
+	listCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package operation is the root command for the pending operation subcommands
+package operation
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/cmd/cli/app/project"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// OperationCmd is the root command for the pending operation subcommands
+var OperationCmd = &cobra.Command{
+	Use:   "operation",
+	Short: "Manage the pending operations of a project",
+	Long: `The minder project operation commands manage the destructive operations
+waiting for the confirmation of a second admin, in the projects requiring it.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	project.ProjectCmd.AddCommand(OperationCmd)
+	OperationCmd.PersistentFlags().StringP("project", "j", "", "ID of the project")
+}
+
+func initializeTableForOperations(out io.Writer) table.Table {
+	return table.New(table.Simple, layouts.Default, out,
+		[]string{"ID", "Method", "State", "Requested By", "Reviewed By", "Expires At"})
+}
+
+func addOperationRow(t table.Table, op *minderv1.PendingOperation) {
+	method := op.GetMethod()
+	if i := strings.LastIndex(method, "/"); i >= 0 {
+		method = method[i+1:]
+	}
+	state := strings.ToLower(strings.TrimPrefix(op.GetState().String(), "PENDING_OPERATION_STATE_"))
+	t.AddRow(
+		op.GetId(),
+		method,
+		state,
+		op.GetRequestedBy(),
+		op.GetReviewedBy(),
+		op.GetExpiresAt().AsTime().Format(time.RFC3339),
+	)
+}
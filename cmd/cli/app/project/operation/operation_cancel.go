@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package operation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var cancelCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "Cancel a pending operation of a project",
+	Long: `The minder project operation cancel command cancels a pending operation of
+a project. Operations can be cancelled by the user who requested them, or by
+an admin of the project.`,
+	RunE: cli.GRPCClientWrapRunE(CancelCommand),
+}
+
+// CancelCommand is the command for cancelling a pending operation
+func CancelCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	project := viper.GetString("project")
+	id := viper.GetString("id")
+	format := viper.GetString("output")
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.CancelPendingOperation(ctx, &minderv1.CancelPendingOperationRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Id: id,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error cancelling pending operation", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := initializeTableForOperations(cmd.OutOrStdout())
+		addOperationRow(t, resp.GetOperation())
+		t.Render()
+	}
+	return nil
+}
+
+func init() {
+	OperationCmd.AddCommand(cancelCmd)
+	cancelCmd.Flags().StringP("id", "i", "", "ID of the pending operation to cancel")
+	cancelCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	if err := cancelCmd.MarkFlagRequired("id"); err != nil {
+		cancelCmd.Print("Error marking `id` flag as required.")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package operation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var confirmCmd = &cobra.Command{
+	Use:   "confirm",
+	Short: "Confirm a pending operation of a project",
+	Long: `The minder project operation confirm command performs a pending operation
+of a project. Operations can't be confirmed by the user who requested them.`,
+	RunE: cli.GRPCClientWrapRunE(ConfirmCommand),
+}
+
+// ConfirmCommand is the command for confirming a pending operation
+func ConfirmCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	project := viper.GetString("project")
+	id := viper.GetString("id")
+	format := viper.GetString("output")
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.ConfirmPendingOperation(ctx, &minderv1.ConfirmPendingOperationRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Id: id,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error confirming pending operation", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := initializeTableForOperations(cmd.OutOrStdout())
+		addOperationRow(t, resp.GetOperation())
+		t.Render()
+	}
+	return nil
+}
+
+func init() {
+	OperationCmd.AddCommand(confirmCmd)
+	confirmCmd.Flags().StringP("id", "i", "", "ID of the pending operation to confirm")
+	confirmCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	if err := confirmCmd.MarkFlagRequired("id"); err != nil {
+		confirmCmd.Print("Error marking `id` flag as required.")
+		os.Exit(1)
+	}
+}
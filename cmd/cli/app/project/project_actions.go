@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// projectActionsCmd is the command for setting the actions policy of a project
+var projectActionsCmd = &cobra.Command{
+	Use:   "actions",
+	Short: "Set the actions policy of a project",
+	Long: `The actions command sets the remediation and alert policy of a project,
+which applies to all the profiles evaluated in the project and its sub-projects.
+
+By default, the settings are only used by the profiles which don't set the
+action themselves. With --remediate-override or --alert-override, they replace
+the settings of every profile, e.g. to turn off all remediations during a
+freeze. The overrides of a parent project take precedence over the ones of
+its sub-projects.
+
+Running the command without --remediate nor --alert removes the policy.`,
+	RunE: cli.GRPCClientWrapRunE(actionsCommand),
+}
+
+func actionsCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	format := viper.GetString("output")
+	project := viper.GetString("project")
+
+	policy := &minderv1.ProjectActionsPolicy{}
+	if remediate := viper.GetString("remediate"); remediate != "" {
+		policy.Remediate = &minderv1.ProjectActionsPolicy_Action{
+			Value:    remediate,
+			Override: viper.GetBool("remediate-override"),
+		}
+	}
+	if alert := viper.GetString("alert"); alert != "" {
+		policy.Alert = &minderv1.ProjectActionsPolicy_Action{
+			Value:    alert,
+			Override: viper.GetBool("alert-override"),
+		}
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.PatchProject(ctx, &minderv1.PatchProjectRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Patch: &minderv1.ProjectPatch{
+			ActionsPolicy: policy,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"actions_policy"},
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error setting the project actions policy", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(), []string{"Action", "Value", "Override"})
+		actionsPolicy := resp.GetProject().GetActionsPolicy()
+		for _, a := range []struct {
+			name   string
+			action *minderv1.ProjectActionsPolicy_Action
+		}{
+			{"remediate", actionsPolicy.GetRemediate()},
+			{"alert", actionsPolicy.GetAlert()},
+		} {
+			if a.action == nil {
+				t.AddRow(a.name, "profile setting", "")
+				continue
+			}
+			t.AddRow(a.name, a.action.GetValue(), fmt.Sprintf("%t", a.action.GetOverride()))
+		}
+		t.Render()
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	return nil
+}
+
+func init() {
+	ProjectCmd.AddCommand(projectActionsCmd)
+
+	projectActionsCmd.Flags().StringP("project", "j", "", "The project to set the actions policy of")
+	projectActionsCmd.Flags().String("remediate", "", "The remediation setting (one of on, off, dry_run)")
+	projectActionsCmd.Flags().Bool("remediate-override", false, "Replace the remediation setting of every profile")
+	projectActionsCmd.Flags().String("alert", "", "The alert setting (one of on, off, dry_run)")
+	projectActionsCmd.Flags().Bool("alert-override", false, "Replace the alert setting of every profile")
+	projectActionsCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
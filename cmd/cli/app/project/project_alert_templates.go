@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// projectAlertTemplatesCmd is the command for setting the alert templates of
+// a project
+var projectAlertTemplatesCmd = &cobra.Command{
+	Use:   "alert-templates",
+	Short: "Set the alert templates of a project",
+	Long: `The alert-templates command sets the templates of the alerts of a project and
+its sub-projects, instead of the built-in wording. The templates are given as a
+YAML file with a template per alert type, e.g.:
+
+  security_advisory:
+    title: "{{.Rule}}: {{.FailureMessage}}"
+    body: "..."
+    labels: [triage]
+  pull_request_comment:
+    body: "{{.Message}}"
+
+The templates are validated by rendering them with sample data, which can be
+previewed with "minder dev render alert". Alert types without a template keep
+the built-in wording.
+
+Running the command without --file removes the templates.`,
+	RunE: cli.GRPCClientWrapRunE(alertTemplatesCommand),
+}
+
+func alertTemplatesCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	format := viper.GetString("output")
+	project := viper.GetString("project")
+
+	tmpls := &minderv1.ProjectAlertTemplates{}
+	if f := viper.GetString("file"); f != "" {
+		content, err := os.ReadFile(filepath.Clean(f))
+		if err != nil {
+			return cli.MessageAndError("Error reading the alert templates file", err)
+		}
+		parsed, err := templates.Parse(content)
+		if err != nil {
+			return cli.MessageAndError("Error parsing the alert templates", err)
+		}
+		if err := parsed.Validate(); err != nil {
+			return cli.MessageAndError("Error validating the alert templates", err)
+		}
+		if pb := parsed.ToPB(); pb != nil {
+			tmpls = pb
+		}
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.PatchProject(ctx, &minderv1.PatchProjectRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Patch: &minderv1.ProjectPatch{
+			AlertTemplates: tmpls,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"alert_templates"},
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error setting the project alert templates", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(), []string{"Alert Type", "Labels"})
+		if sa := resp.GetProject().GetAlertTemplates().GetSecurityAdvisory(); sa != nil {
+			t.AddRow("security_advisory", strings.Join(sa.GetLabels(), ", "))
+		}
+		if resp.GetProject().GetAlertTemplates().GetPullRequestComment() != nil {
+			t.AddRow("pull_request_comment", "")
+		}
+		t.Render()
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	return nil
+}
+
+func init() {
+	ProjectCmd.AddCommand(projectAlertTemplatesCmd)
+
+	projectAlertTemplatesCmd.Flags().StringP("project", "j", "", "The project to set the alert templates of")
+	projectAlertTemplatesCmd.Flags().StringP("file", "f", "", "The YAML file of the alert templates")
+	projectAlertTemplatesCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// projectOperationApprovalCmd is the command for setting whether the
+// destructive operations of a project require the approval of a second admin
+var projectOperationApprovalCmd = &cobra.Command{
+	Use:   "operation-approval",
+	Short: "Set whether destructive operations require a second admin",
+	Long: `The operation-approval command sets whether the destructive operations of a
+project and its sub-projects require the confirmation of a second admin.
+
+When enabled, deleting the project or one of its providers, and removing the
+repositories which are no longer registered in a provider, creates a pending
+operation instead. Another admin of the project must confirm the operation
+with "minder project operation confirm" within --window, otherwise it expires.
+
+Disabling the approval is itself an operation requiring a second admin.`,
+	RunE: cli.GRPCClientWrapRunE(operationApprovalCommand),
+}
+
+func operationApprovalCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	format := viper.GetString("output")
+	project := viper.GetString("project")
+
+	approval := &minderv1.ProjectOperationApproval{
+		Enabled: viper.GetBool("enabled"),
+	}
+	if window := viper.GetDuration("window"); window != 0 {
+		approval.Window = durationpb.New(window)
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.PatchProject(ctx, &minderv1.PatchProjectRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Patch: &minderv1.ProjectPatch{
+			OperationApproval: approval,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"operation_approval"},
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error setting the project operation approval", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(), []string{"Enabled", "Window"})
+		if oa := resp.GetProject().GetOperationApproval(); oa.GetEnabled() {
+			t.AddRow("true", oa.GetWindow().AsDuration().String())
+		} else {
+			t.AddRow("false", "")
+		}
+		t.Render()
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	return nil
+}
+
+func init() {
+	ProjectCmd.AddCommand(projectOperationApprovalCmd)
+
+	projectOperationApprovalCmd.Flags().StringP("project", "j", "", "The project to set the operation approval of")
+	projectOperationApprovalCmd.Flags().Bool("enabled", false, "Require a second admin to confirm destructive operations")
+	projectOperationApprovalCmd.Flags().Duration("window", 0,
+		"The time within which operations must be confirmed (default 24h)")
+	projectOperationApprovalCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// projectSigstoreTrustRootCmd is the command for setting the sigstore trust
+// root of a project
+var projectSigstoreTrustRootCmd = &cobra.Command{
+	Use:   "sigstore-trust-root",
+	Short: "Set the sigstore trust root of a project",
+	Long: `The sigstore-trust-root command sets the trust root of the private sigstore
+deployment, with its own Fulcio and Rekor instances, against which the
+artifact signatures of a project and its sub-projects are verified.
+
+The trust root is either distributed through a TUF repository, given with
+--tuf-url and the root.json of the repository with --tuf-root-file, or given as
+a trusted_root.json with --trusted-root-file. Rules selecting a sigstore
+instance explicitly keep using it.
+
+Running the command without flags removes the trust root, and the public
+sigstore instance is used again.`,
+	RunE: cli.GRPCClientWrapRunE(sigstoreTrustRootCommand),
+}
+
+func sigstoreTrustRootCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	format := viper.GetString("output")
+	project := viper.GetString("project")
+
+	trustRoot := &minderv1.ProjectSigstoreTrustRoot{
+		TufUrl: viper.GetString("tuf-url"),
+	}
+	if f := viper.GetString("tuf-root-file"); f != "" {
+		content, err := os.ReadFile(filepath.Clean(f))
+		if err != nil {
+			return cli.MessageAndError("Error reading the TUF root file", err)
+		}
+		trustRoot.TufRoot = string(content)
+	}
+	if f := viper.GetString("trusted-root-file"); f != "" {
+		content, err := os.ReadFile(filepath.Clean(f))
+		if err != nil {
+			return cli.MessageAndError("Error reading the trusted root file", err)
+		}
+		trustRoot.TrustedRoot = string(content)
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.PatchProject(ctx, &minderv1.PatchProjectRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Patch: &minderv1.ProjectPatch{
+			SigstoreTrustRoot: trustRoot,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"sigstore_trust_root"},
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error setting the project sigstore trust root", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(), []string{"Source"})
+		if tr := resp.GetProject().GetSigstoreTrustRoot(); tr.GetTufUrl() != "" {
+			t.AddRow(tr.GetTufUrl())
+		} else if tr != nil {
+			t.AddRow("trusted root")
+		}
+		t.Render()
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	return nil
+}
+
+func init() {
+	ProjectCmd.AddCommand(projectSigstoreTrustRootCmd)
+
+	projectSigstoreTrustRootCmd.Flags().StringP("project", "j", "", "The project to set the sigstore trust root of")
+	projectSigstoreTrustRootCmd.Flags().String("tuf-url", "", "The URL of the TUF repository distributing the trusted root")
+	projectSigstoreTrustRootCmd.Flags().String("tuf-root-file", "", "The root.json of the TUF repository")
+	projectSigstoreTrustRootCmd.Flags().String("trusted-root-file", "", "The trusted_root.json of the sigstore deployment")
+	projectSigstoreTrustRootCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	projectSigstoreTrustRootCmd.MarkFlagsRequiredTogether("tuf-url", "tuf-root-file")
+	projectSigstoreTrustRootCmd.MarkFlagsMutuallyExclusive("tuf-url", "trusted-root-file")
+}
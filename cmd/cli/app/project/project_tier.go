@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// projectTierCmd is the command for showing the tier of a project
+var projectTierCmd = &cobra.Command{
+	Use:   "tier",
+	Short: "Show the tier of a project and its usage",
+	Long: `The tier command shows the tier of a project, the limits of the tier and
+how much of them the project uses. Operations exceeding the limits of the tier
+fail asking to upgrade the project.`,
+	RunE: cli.GRPCClientWrapRunE(tierCommand),
+}
+
+// tierCommand is the command for showing the tier of a project
+func tierCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.GetProjectTier(ctx, &minderv1.GetProjectTierRequest{
+		Context: &minderv1.Context{Project: &project},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error getting project tier", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		tier := resp.GetTier()
+		if tier == nil {
+			cmd.Println("The project has no tier and is not limited")
+			return nil
+		}
+		cmd.Printf("Tier: %s\n", tier.GetName())
+		if tier.GetDescription() != "" {
+			cmd.Printf("Description: %s\n", tier.GetDescription())
+		}
+		if len(tier.GetFeatures()) > 0 {
+			cmd.Printf("Features: %s\n", strings.Join(tier.GetFeatures(), ", "))
+		}
+
+		usage := resp.GetUsage()
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(), []string{"Resource", "Used", "Limit"})
+		t.AddRow("Repositories", fmt.Sprintf("%d", usage.GetRepositories()), formatLimit(tier.MaxRepositories))
+		t.AddRow("Providers", fmt.Sprintf("%d", usage.GetProviders()), formatLimit(tier.MaxProviders))
+		t.AddRow("Rules", fmt.Sprintf("%d", usage.GetRules()), formatLimit(tier.MaxRules))
+		advanced := "not allowed"
+		if tier.GetAdvancedEngines() {
+			advanced = "allowed"
+		}
+		t.AddRow("Advanced engines", "-", advanced)
+		t.Render()
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	return nil
+}
+
+func formatLimit(limit *int32) string {
+	if limit == nil {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d", *limit)
+}
+
+func init() {
+	ProjectCmd.AddCommand(projectTierCmd)
+	projectTierCmd.Flags().StringP("project", "j", "", "The project to show the tier of")
+	projectTierCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
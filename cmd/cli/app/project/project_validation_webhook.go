@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// projectValidationWebhookCmd is the command for setting the validation
+// webhook of a project
+var projectValidationWebhookCmd = &cobra.Command{
+	Use:   "validation-webhook",
+	Short: "Set the validation webhook of a project",
+	Long: `The validation-webhook command sets the external webhook approving the
+changes to the profiles and rule types of a project and its sub-projects.
+
+Before a profile or rule type is created, updated or patched, the proposed
+change is POSTed as JSON to the webhook, which answers whether the change is
+allowed, with an optional message explaining a denial. When a secret is set,
+requests are signed with an HMAC-SHA256 of their body in the
+X-Minder-Signature-256 header. With --fail-open, changes are accepted when the
+webhook can't be reached; otherwise they are denied.
+
+The secret is never returned, and is kept when the webhook is updated without
+--secret. Running the command without --url removes the webhook.`,
+	RunE: cli.GRPCClientWrapRunE(validationWebhookCommand),
+}
+
+func validationWebhookCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	format := viper.GetString("output")
+	project := viper.GetString("project")
+
+	webhook := &minderv1.ProjectValidationWebhook{
+		Url:      viper.GetString("url"),
+		Secret:   viper.GetString("secret"),
+		FailOpen: viper.GetBool("fail-open"),
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.PatchProject(ctx, &minderv1.PatchProjectRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Patch: &minderv1.ProjectPatch{
+			ValidationWebhook: webhook,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"validation_webhook"},
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error setting the project validation webhook", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(), []string{"URL", "Fail Open"})
+		if wh := resp.GetProject().GetValidationWebhook(); wh != nil {
+			t.AddRow(wh.GetUrl(), fmt.Sprintf("%t", wh.GetFailOpen()))
+		}
+		t.Render()
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	return nil
+}
+
+func init() {
+	ProjectCmd.AddCommand(projectValidationWebhookCmd)
+
+	projectValidationWebhookCmd.Flags().StringP("project", "j", "", "The project to set the validation webhook of")
+	projectValidationWebhookCmd.Flags().String("url", "", "The URL of the validation webhook")
+	projectValidationWebhookCmd.Flags().String("secret", "", "The secret signing the requests to the webhook")
+	projectValidationWebhookCmd.Flags().Bool("fail-open", false, "Accept changes when the webhook can't be reached")
+	projectValidationWebhookCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package secret is the root command for the project secret subcommands
+package secret
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/cmd/cli/app/project"
+)
+
+// SecretCmd is the root command for the project secret subcommands
+var SecretCmd = &cobra.Command{
+	Use:   "secret",
+	Short: "Manage the secrets of a project",
+	Long: `The minder project secret commands manage the secrets of a project. Secrets
+can be referenced from rule parameters as {{ secret "name" }} and are resolved
+by the server at evaluation time. Their values are never returned.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	project.ProjectCmd.AddCommand(SecretCmd)
+	SecretCmd.PersistentFlags().StringP("project", "j", "", "ID of the project")
+}
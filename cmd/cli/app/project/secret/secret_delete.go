@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a secret of a project",
+	Long: `The minder project secret delete command deletes a secret of a project. Rules
+referencing the secret fail to evaluate until it is set again.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %w", err)
+		}
+		return nil
+	},
+	RunE: deleteCommand,
+}
+
+// deleteCommand is the command for deleting a secret
+func deleteCommand(cmd *cobra.Command, _ []string) error {
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProjectsServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error creating gRPC client", err)
+	}
+	defer closeConn()
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	_, err = client.DeleteProjectSecret(cmd.Context(), &minderv1.DeleteProjectSecretRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name: name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error deleting secret", err)
+	}
+
+	cmd.Printf("Deleted secret %s\n", name)
+	return nil
+}
+
+func init() {
+	SecretCmd.AddCommand(deleteCmd)
+	deleteCmd.Flags().StringP("name", "n", "", "Name of the secret")
+	if err := deleteCmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var setCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Create or replace a secret of a project",
+	Long: `The minder project secret set command creates or replaces a secret of a
+project. The value is read from a file, or from the standard input when the file
+is "-", so that it doesn't end up in the shell history.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %w", err)
+		}
+		return nil
+	},
+	RunE: setCommand,
+}
+
+// setCommand is the command for setting a secret
+func setCommand(cmd *cobra.Command, _ []string) error {
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProjectsServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error creating gRPC client", err)
+	}
+	defer closeConn()
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+
+	value, err := readValue(cmd, viper.GetString("value-file"))
+	if err != nil {
+		return cli.MessageAndError("Error reading the secret value", err)
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	_, err = client.SetProjectSecret(cmd.Context(), &minderv1.SetProjectSecretRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name:  name,
+		Value: value,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error setting secret", err)
+	}
+
+	cmd.Printf("Stored secret %s\n", name)
+	return nil
+}
+
+func readValue(cmd *cobra.Command, valueFile string) (string, error) {
+	var value []byte
+	var err error
+	if valueFile == "-" {
+		value, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		value, err = os.ReadFile(filepath.Clean(valueFile))
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(value), "\n"), nil
+}
+
+func init() {
+	SecretCmd.AddCommand(setCmd)
+	setCmd.Flags().StringP("name", "n", "", "Name of the secret")
+	setCmd.Flags().StringP("value-file", "f", "", "File containing the secret value, or - for the standard input")
+	for _, flag := range []string{"name", "value-file"} {
+		if err := setCmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestSecretCommands(t *testing.T) {
+	valueFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(valueFile, []byte("s3cr3t\n"), 0600))
+
+	tests := []cli.CmdTestCase{
+		{
+			Name: "set secret - success",
+			Args: []string{"project", "secret", "set", "--name", "api-token", "--value-file", valueFile},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					SetProjectSecret(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *minderv1.SetProjectSecretRequest, _ ...any) (
+						*minderv1.SetProjectSecretResponse, error) {
+						if req.GetName() != "api-token" || req.GetValue() != "s3cr3t" {
+							return nil, status.Error(codes.InvalidArgument, "unexpected secret")
+						}
+						return &minderv1.SetProjectSecretResponse{}, nil
+					})
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "set.txt",
+		},
+		{
+			Name:          "set secret - missing value file",
+			Args:          []string{"project", "secret", "set", "--name", "api-token"},
+			ExpectedError: "required flag(s) \"value-file\" not set",
+		},
+		{
+			Name: "list secrets",
+			Args: []string{"project", "secret", "list"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					ListProjectSecrets(gomock.Any(), gomock.Any()).
+					Return(&minderv1.ListProjectSecretsResponse{Names: []string{"api-token", "webhook-key"}}, nil)
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "list.table",
+		},
+		{
+			Name: "delete secret - not found",
+			Args: []string{"project", "secret", "delete", "--name", "missing"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					DeleteProjectSecret(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, "secret missing not found"))
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			ExpectedError: "secret missing not found",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, SecretCmd)
+}
@@ -105,11 +105,18 @@ func EnrollProviderCommand(ctx context.Context, cmd *cobra.Command, _ []string,
 
 	// the token only applies to the old flow
 	// TODO: allow for token to be passed in if the provider allows it, don't hardcode
-	userFlow, err := supportsToken(ctx, providerClient, provider, project)
+	authFlows, err := supportedAuthFlows(ctx, providerClient, provider, project)
 	if err != nil {
 		return cli.MessageAndError("Error checking provider support", err)
 	}
 
+	// providers without credentials, e.g. public package registries, are
+	// enrolled by just creating them
+	if slices.Equal(authFlows, []minderv1.AuthorizationFlow{minderv1.AuthorizationFlow_AUTHORIZATION_FLOW_NONE}) {
+		return enrollWithoutCredentials(ctx, cmd, providerClient, providerName, provider, project, config)
+	}
+
+	userFlow := slices.Contains(authFlows, minderv1.AuthorizationFlow_AUTHORIZATION_FLOW_USER_INPUT)
 	if token != "" && userFlow {
 		return enrollUsingToken(ctx, cmd, oauthClient, providerClient, providerName, provider, project, token, owner, config)
 	}
@@ -165,6 +172,31 @@ func enrollUsingToken(
 	return nil
 }
 
+func enrollWithoutCredentials(
+	ctx context.Context,
+	cmd *cobra.Command,
+	provClient minderv1.ProvidersServiceClient,
+	providerName string,
+	providerClass string,
+	project string,
+	providerConfig *structpb.Struct,
+) error {
+	_, err := provClient.CreateProvider(ctx, &minderv1.CreateProviderRequest{
+		Context: &minderv1.Context{Provider: &providerName, Project: &project},
+		Provider: &minderv1.Provider{
+			Name:   providerName,
+			Class:  providerClass,
+			Config: providerConfig,
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error creating provider", err)
+	}
+
+	cmd.Println("Provider enrolled successfully")
+	return nil
+}
+
 func enrollUsingOAuth2Flow(
 	ctx context.Context,
 	cmd *cobra.Command,
@@ -343,17 +375,17 @@ func providerConfigFromArg(configSource string, dashReader io.Reader) (*structpb
 	return structpb.NewStruct(config)
 }
 
-func supportsToken(
+func supportedAuthFlows(
 	ctx context.Context,
 	providerClient minderv1.ProvidersServiceClient,
 	providerClass string,
 	project string,
-) (bool, error) {
+) ([]minderv1.AuthorizationFlow, error) {
 	resp, err := providerClient.ListProviderClasses(ctx, &minderv1.ListProviderClassesRequest{
 		Context: &minderv1.Context{Project: &project},
 	})
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	for _, info := range resp.GetProviderClassInfos() {
@@ -361,13 +393,10 @@ func supportsToken(
 			continue
 		}
 
-		return slices.Contains(
-			info.GetSupportedAuthFlows(),
-			minderv1.AuthorizationFlow_AUTHORIZATION_FLOW_USER_INPUT,
-		), nil
+		return info.GetSupportedAuthFlows(), nil
 	}
 
-	return false, fmt.Errorf("provider class %s not supported", providerClass)
+	return nil, fmt.Errorf("provider class %s not supported", providerClass)
 }
 
 func init() {
@@ -378,7 +407,7 @@ func init() {
 	enrollCmd.Flags().BoolP("yes", "y", false, "Bypass any yes/no prompts when enrolling a new provider")
 	enrollCmd.Flags().StringP("class", "c", githubAppProvider.ToString(), "Provider class, defaults to github-app")
 	enrollCmd.Flags().StringP("provider-config", "f", "", "Path to the provider configuration (or - for stdin)")
-	enrollCmd.Flags().StringP("name", "n", "", "Name of the new provider. (Only when using a token)")
+	enrollCmd.Flags().StringP("name", "n", "", "Name of the new provider. (Only when using a token, or for providers without credentials)")
 
 	// Bind flags
 	if err := viper.BindPFlag("token", enrollCmd.Flags().Lookup("token")); err != nil {
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var shareCmd = &cobra.Command{
+	Use:   "share",
+	Short: "Share a provider with child projects",
+	Long: `The minder provider share commands share a provider enrolled in a project
+with some of its child projects.
+
+Providers which are not shared can be used by all the child projects of their
+project. Once a provider is shared, it can only be used by the child projects
+it is shared with (and their own children). The entities a child project
+registers with a shared provider belong to the child project only.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+var shareAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Share a provider with a child project",
+	Long:  `The minder provider share add command shares a provider with a child project.`,
+	RunE:  cli.GRPCClientWrapRunE(AddShareCommand),
+}
+
+var shareRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Stop sharing a provider with a child project",
+	Long: `The minder provider share remove command stops sharing a provider with a
+child project. The child project must have deleted the entities it registered
+with the provider first.`,
+	RunE: cli.GRPCClientWrapRunE(RemoveShareCommand),
+}
+
+var shareListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the child projects a provider is shared with",
+	Long:  `The minder provider share list command lists the child projects a provider is shared with.`,
+	RunE:  cli.GRPCClientWrapRunE(ListSharesCommand),
+}
+
+func init() {
+	ProviderCmd.AddCommand(shareCmd)
+	shareCmd.AddCommand(shareAddCmd)
+	shareCmd.AddCommand(shareRemoveCmd)
+	shareCmd.AddCommand(shareListCmd)
+
+	shareCmd.PersistentFlags().StringP("name", "n", "", "Name of the provider to share")
+	if err := shareCmd.MarkPersistentFlagRequired("name"); err != nil {
+		panic(err)
+	}
+
+	for _, cmd := range []*cobra.Command{shareAddCmd, shareRemoveCmd} {
+		cmd.Flags().StringP("child-project", "c", "", "ID of the child project")
+		if err := cmd.MarkFlagRequired("child-project"); err != nil {
+			panic(err)
+		}
+	}
+
+	shareListCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
+
+// AddShareCommand shares a provider with a child project
+func AddShareCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProvidersServiceClient(conn)
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+	child := viper.GetString("child-project")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.ShareProvider(ctx, &minderv1.ShareProviderRequest{
+		Context:   &minderv1.Context{Project: &project},
+		Name:      name,
+		ProjectId: child,
+	})
+	if err != nil {
+		return cli.MessageAndError("Failed to share provider", err)
+	}
+
+	cmd.Printf("Provider %s shared with project %s\n", name, shareProjectName(resp.GetShare()))
+	return nil
+}
+
+// RemoveShareCommand stops sharing a provider with a child project
+func RemoveShareCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProvidersServiceClient(conn)
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+	child := viper.GetString("child-project")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	_, err := client.UnshareProvider(ctx, &minderv1.UnshareProviderRequest{
+		Context:   &minderv1.Context{Project: &project},
+		Name:      name,
+		ProjectId: child,
+	})
+	if err != nil {
+		return cli.MessageAndError("Failed to unshare provider", err)
+	}
+
+	cmd.Printf("Provider %s is no longer shared with project %s\n", name, child)
+	return nil
+}
+
+// ListSharesCommand lists the child projects a provider is shared with
+func ListSharesCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProvidersServiceClient(conn)
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.ListProviderShares(ctx, &minderv1.ListProviderSharesRequest{
+		Context: &minderv1.Context{Project: &project},
+		Name:    name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Failed to list provider shares", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return err
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return err
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(), []string{"Project ID", "Project", "Shared At"})
+		for _, share := range resp.GetShares() {
+			t.AddRow(share.GetProjectId(), share.GetProjectName(), share.GetCreatedAt().AsTime().Format(time.RFC3339))
+		}
+		t.Render()
+	}
+
+	return nil
+}
+
+func shareProjectName(share *minderv1.ProviderShare) string {
+	if share.GetProjectName() == "" {
+		return share.GetProjectId()
+	}
+	return fmt.Sprintf("%s (%s)", share.GetProjectName(), share.GetProjectId())
+}
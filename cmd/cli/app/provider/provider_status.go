@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Check the health of the credentials of a provider",
+	Long: `The minder provider status command checks the health of the credentials of
+a provider: whether an authenticated API call succeeds, when its token expires
+and whether the scopes granted to the token changed.
+
+Use --verbose to show the result of each individual check.`,
+	RunE: cli.GRPCClientWrapRunE(StatusProviderCommand),
+}
+
+func init() {
+	ProviderCmd.AddCommand(statusCmd)
+
+	statusCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	statusCmd.Flags().StringP("name", "n", "", "Name of the provider to check")
+	if err := statusCmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+}
+
+// StatusProviderCommand checks the health of the credentials of a provider
+func StatusProviderCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProvidersServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+	name := viper.GetString("name")
+	verbose := viper.GetBool("verbose")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.GetProviderStatus(ctx, &minderv1.GetProviderStatusRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name: name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Failed to get provider status", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp.GetStatus())
+		if err != nil {
+			return err
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp.GetStatus())
+		if err != nil {
+			return err
+		}
+		cmd.Println(out)
+	case app.Table:
+		st := resp.GetStatus()
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(), []string{"Key", "Value"})
+		t.AddRow("Name", st.GetName())
+		t.AddRow("Class", st.GetClass())
+		t.AddRow("Healthy", fmt.Sprintf("%t", st.GetHealthy()))
+		t.AddRow("Last Successful Call", formatStatusTime(st.GetLastSuccessfulCall(), "never"))
+		t.AddRow("Token Expires At", formatStatusTime(st.GetTokenExpiresAt(), "does not expire"))
+		if len(st.GetScopes()) > 0 {
+			t.AddRow("Scopes", strings.Join(st.GetScopes(), ", "))
+		}
+		if st.GetScopesChangedAt() != nil {
+			t.AddRow("Scopes Changed At", formatStatusTime(st.GetScopesChangedAt(), ""))
+		}
+		t.Render()
+
+		if verbose {
+			ct := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(), []string{"Check", "Status", "Message"})
+			for _, chk := range st.GetChecks() {
+				ct.AddRow(chk.GetName(), checkStatusAsString(chk.GetStatus()), chk.GetMessage())
+			}
+			ct.Render()
+		}
+		return nil
+	default:
+		return fmt.Errorf("output format %s not supported", format)
+	}
+
+	return nil
+}
+
+func formatStatusTime(ts *timestamppb.Timestamp, unset string) string {
+	if ts == nil {
+		return unset
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
+
+func checkStatusAsString(st minderv1.ProviderHealthCheckStatus) string {
+	return strings.ToLower(strings.TrimPrefix(st.String(), "PROVIDER_HEALTH_CHECK_STATUS_"))
+}
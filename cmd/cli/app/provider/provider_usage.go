@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var usageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Show the API calls, webhooks and evaluations of providers",
+	Long: `The minder provider usage command shows the API calls, webhooks and
+evaluations of the providers of a project over a period of time, the most used
+providers first.
+
+By default the total usage of each provider is shown. Use --name to show the
+usage of a single provider per hour, or per day with --granularity day.`,
+	RunE: cli.GRPCClientWrapRunE(UsageProviderCommand),
+}
+
+func init() {
+	ProviderCmd.AddCommand(usageCmd)
+
+	usageCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	usageCmd.Flags().StringP("name", "n", "", "Name of the provider to show the usage of")
+	usageCmd.Flags().Duration("since", 24*time.Hour, "Period of time to show the usage for, e.g. 72h")
+	usageCmd.Flags().String("granularity", "hour", "Size of the usage buckets (one of hour,day)")
+}
+
+// UsageProviderCommand shows the usage of the providers of a project
+func UsageProviderCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProvidersServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+	name := viper.GetString("name")
+	since := viper.GetDuration("since")
+	granularity := viper.GetString("granularity")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.GetProviderUsage(ctx, &minderv1.GetProviderUsageRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name:        name,
+		Since:       timestamppb.New(time.Now().Add(-since)),
+		Granularity: granularity,
+	})
+	if err != nil {
+		return cli.MessageAndError("Failed to get provider usage", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return err
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return err
+		}
+		cmd.Println(out)
+	case app.Table:
+		if name != "" {
+			t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(),
+				[]string{"Start", "API Calls", "Webhooks", "Evaluations"})
+			for _, pu := range resp.GetProviders() {
+				for _, b := range pu.GetBuckets() {
+					addUsageRow(t, b.GetStart().AsTime().Format(time.RFC3339), b)
+				}
+				addUsageRow(t, "Total", pu.GetTotal())
+			}
+			t.Render()
+			return nil
+		}
+
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(),
+			[]string{"Provider", "Class", "API Calls", "Webhooks", "Evaluations"})
+		for _, pu := range resp.GetProviders() {
+			total := pu.GetTotal()
+			t.AddRow(
+				pu.GetName(),
+				pu.GetClass(),
+				fmt.Sprintf("%d", total.GetApiCalls()),
+				fmt.Sprintf("%d", total.GetWebhooks()),
+				fmt.Sprintf("%d", total.GetEvaluations()),
+			)
+		}
+		t.Render()
+	}
+
+	return nil
+}
+
+func addUsageRow(t table.Table, label string, b *minderv1.ProviderUsageBucket) {
+	t.AddRow(
+		label,
+		fmt.Sprintf("%d", b.GetApiCalls()),
+		fmt.Sprintf("%d", b.GetWebhooks()),
+		fmt.Sprintf("%d", b.GetEvaluations()),
+	)
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quickstart
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/mindersec/minder/internal/util/cli"
+)
+
+// answers are the answers to the quickstart steps, read from a YAML file so
+// that the quickstart can run without prompts
+type answers struct {
+	Provider answersProvider `yaml:"provider"`
+	// Repositories are the names or shell patterns of the repositories to
+	// register, e.g. owner/*
+	Repositories []string `yaml:"repositories"`
+	// RuleTypes are the paths of the rule types to create, instead of the
+	// quickstart rule type
+	RuleTypes []string `yaml:"rule_types"`
+	// Profile is the path of the profile to create, instead of the
+	// quickstart profile
+	Profile string `yaml:"profile"`
+}
+
+// answersProvider are the answers to the enrollment step. The token of the
+// legacy GitHub provider isn't part of the answers, so it doesn't end up in
+// files; it is read from --token or MINDER_TOKEN instead.
+type answersProvider struct {
+	// SkipEnrollment skips the enrollment, for providers enrolled already
+	SkipEnrollment bool   `yaml:"skip_enrollment"`
+	Class          string `yaml:"class"`
+	Name           string `yaml:"name"`
+	Owner          string `yaml:"owner"`
+	// Config is the path of the provider configuration
+	Config string `yaml:"config"`
+}
+
+// loadAnswers reads and validates an answers file. The paths in the file are
+// relative to the file.
+func loadAnswers(fpath string) (*answers, error) {
+	content, err := os.ReadFile(filepath.Clean(fpath))
+	if err != nil {
+		return nil, fmt.Errorf("error reading answers file: %w", err)
+	}
+	return parseAnswers(bytes.NewReader(content), filepath.Dir(fpath))
+}
+
+func parseAnswers(r io.Reader, dir string) (*answers, error) {
+	var ans answers
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&ans); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("error parsing answers file: %w", err)
+	}
+
+	for _, repo := range ans.Repositories {
+		if strings.ContainsAny(repo, "*?[") {
+			if _, err := path.Match(repo, ""); err != nil {
+				return nil, fmt.Errorf("invalid repository pattern %s: %w", repo, err)
+			}
+			continue
+		}
+		if err := cli.ValidateRepositoryName(repo); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, ruleType := range ans.RuleTypes {
+		ans.RuleTypes[i] = relativeTo(dir, ruleType)
+	}
+	ans.Profile = relativeTo(dir, ans.Profile)
+	ans.Provider.Config = relativeTo(dir, ans.Provider.Config)
+
+	return &ans, nil
+}
+
+func relativeTo(dir, fpath string) string {
+	if fpath == "" || fpath == "-" || filepath.IsAbs(fpath) {
+		return fpath
+	}
+	return filepath.Join(dir, fpath)
+}
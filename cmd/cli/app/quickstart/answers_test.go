@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quickstart
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAnswers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    *answers
+		wantErr string
+	}{
+		{
+			name: "valid answers",
+			input: `
+provider:
+  class: github
+  owner: my-org
+  config: provider.yaml
+repositories:
+  - my-org/*
+  - other-org/repo
+rule_types:
+  - rules/secret_scanning.yaml
+  - /abs/rule.yaml
+profile: profile.yaml
+`,
+			want: &answers{
+				Provider: answersProvider{
+					Class:  "github",
+					Owner:  "my-org",
+					Config: "conf/provider.yaml",
+				},
+				Repositories: []string{"my-org/*", "other-org/repo"},
+				RuleTypes:    []string{"conf/rules/secret_scanning.yaml", "/abs/rule.yaml"},
+				Profile:      "conf/profile.yaml",
+			},
+		},
+		{
+			name:  "empty answers",
+			input: "",
+			want:  &answers{},
+		},
+		{
+			name:    "unknown field",
+			input:   "repos: [my-org/repo]",
+			wantErr: "field repos not found",
+		},
+		{
+			name:    "invalid repository name",
+			input:   "repositories: [repo]",
+			wantErr: "invalid repository name",
+		},
+		{
+			name:    "invalid repository pattern",
+			input:   "repositories: ['my-org/[']",
+			wantErr: "invalid repository pattern",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseAnswers(strings.NewReader(tt.input), "conf")
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
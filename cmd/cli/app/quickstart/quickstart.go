@@ -9,7 +9,9 @@ import (
 	"context"
 	"embed"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -132,9 +134,24 @@ In case you have registered new repositories during this flow, the profile will
 var content embed.FS
 
 var cmd = &cobra.Command{
-	Use:    "quickstart",
-	Short:  "Quickstart minder",
-	Long:   "The quickstart command provide the means to quickly get started with minder",
+	Use:   "quickstart",
+	Short: "Quickstart minder",
+	Long: `The quickstart command provide the means to quickly get started with minder
+
+The steps can be answered with a YAML file passed with --file, and run without
+prompts with --non-interactive, e.g. for scripted onboarding:
+
+  provider:
+    class: github        # or skip_enrollment: true
+    owner: my-org
+  repositories:
+    - my-org/*
+  rule_types:            # defaults to the quickstart rule type
+    - rules/secret_scanning.yaml
+  profile: profile.yaml  # defaults to the quickstart profile
+
+Repositories may be shell patterns. Paths are relative to the answers file.
+The enrollment token is read from --token or MINDER_TOKEN.`,
 	Hidden: true,
 	RunE:   cli.GRPCClientWrapRunE(quickstartCommand),
 }
@@ -155,24 +172,44 @@ func quickstartCommand(
 
 	project := viper.GetString("project")
 	provider := viper.GetString("provider")
+	answersFile := viper.GetString("file")
+	nonInteractive := viper.GetBool("non-interactive")
+
+	if nonInteractive && answersFile == "" {
+		return cli.MessageAndError("--non-interactive requires an answers file", fmt.Errorf("invalid argument"))
+	}
+
+	var ans *answers
+	if answersFile != "" {
+		ans, err = loadAnswers(answersFile)
+		if err != nil {
+			return cli.MessageAndError("Error loading answers", err)
+		}
+	}
 
 	// No longer print usage on returned error, since we've parsed our inputs
 	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
 	cmd.SilenceUsage = true
 
+	confirm := func(msg string) bool {
+		if nonInteractive {
+			cmd.Println(msg)
+			return true
+		}
+		return cli.PrintYesNoPrompt(cmd, msg, "Proceed?", "Quickstart operation cancelled.", true)
+	}
+
 	// Confirm user wants to go through the quickstart process
-	yes := cli.PrintYesNoPrompt(cmd,
-		stepPromptMsgWelcome,
-		"Proceed?",
-		"Quickstart operation cancelled.",
-		true)
-	if !yes {
+	if !confirm(stepPromptMsgWelcome) {
 		return nil
 	}
 
 	// Ensure user is logged in
 	userClient := minderv1.NewUserServiceClient(conn)
 	_, err = userClient.GetUser(cmd.Context(), &minderv1.GetUserRequest{})
+	if err != nil && nonInteractive && status.Code(err) == codes.Unauthenticated {
+		return cli.MessageAndError("Not logged in, please run \"minder auth login\" first", err)
+	}
 	if err != nil {
 		err = loginPromptErrWrapper(cmd, conn, err)
 		if err != nil {
@@ -193,45 +230,55 @@ func quickstartCommand(
 		ruleClient = minderv1.NewRuleTypeServiceClient(conn)
 	}
 
-	// Step 1 - Confirm enrolling
-	yes = cli.PrintYesNoPrompt(cmd,
-		stepPromptMsgEnroll,
-		"Proceed?",
-		"Quickstart operation cancelled.",
-		true)
-	if !yes {
-		return nil
+	// The enrollment and registration commands read their options from viper
+	if ans != nil {
+		provider = applyProviderAnswers(ans.Provider, provider, nonInteractive)
 	}
 
-	// New context so we don't time out between steps
-	ctx, cancel := getQuickstartContext(cmd.Context(), viper.GetViper())
-	defer cancel()
+	// Step 1 - Confirm enrolling
+	if ans != nil && ans.Provider.SkipEnrollment {
+		cmd.Printf("Skipping the enrollment of provider %s\n", provider)
+	} else {
+		if !confirm(stepPromptMsgEnroll) {
+			return nil
+		}
 
-	// Enroll provider
-	err = minderprov.EnrollProviderCommand(ctx, cmd, []string{}, conn)
-	if err != nil {
-		return cli.MessageAndError("Error enrolling provider", err)
+		// New context so we don't time out between steps
+		ctx, cancel := getQuickstartContext(cmd.Context(), viper.GetViper())
+		defer cancel()
+
+		// Enroll provider
+		err = minderprov.EnrollProviderCommand(ctx, cmd, []string{}, conn)
+		if err != nil {
+			return cli.MessageAndError("Error enrolling provider", err)
+		}
 	}
 
 	// Step 2 - Confirm repository registration
-	yes = cli.PrintYesNoPrompt(cmd,
-		stepPromptMsgRegister,
-		"Proceed?",
-		"Quickstart operation cancelled.",
-		true)
-	if !yes {
+	if !confirm(stepPromptMsgRegister) {
 		return nil
 	}
 
 	// New context so we don't time out between steps
-	ctx, cancel = getQuickstartContext(cmd.Context(), viper.GetViper())
+	ctx, cancel := getQuickstartContext(cmd.Context(), viper.GetViper())
 	defer cancel()
 
-	// Prompt to register repositories
-	cmd.SetContext(ctx)
-	err = repo.RegisterCmd(cmd, []string{})
-	if err != nil {
-		return cli.MessageAndError("Error registering repositories", err)
+	if ans != nil {
+		// Register the repositories of the answers instead of prompting
+		viper.Set("provider", provider)
+		viper.Set("name", ans.Repositories)
+		viper.Set("all", false)
+	}
+
+	if ans != nil && len(ans.Repositories) == 0 {
+		cmd.Println("No repositories to register")
+	} else {
+		// Prompt to register repositories
+		cmd.SetContext(ctx)
+		err = repo.RegisterCmd(cmd, []string{})
+		if err != nil {
+			return cli.MessageAndError("Error registering repositories", err)
+		}
 	}
 
 	// New context so we don't time out between steps
@@ -252,7 +299,31 @@ func quickstartCommand(
 		registeredRepos = append(registeredRepos, r)
 	}
 
-	return loadCatalog(cmd, ruleClient, profileClient, provider, project, registeredRepos)
+	return loadCatalog(cmd, ruleClient, profileClient, provider, project, registeredRepos, ans, confirm)
+}
+
+// applyProviderAnswers sets the enrollment options of the answers, and
+// returns the name of the provider to use in the next steps
+func applyProviderAnswers(ans answersProvider, provider string, nonInteractive bool) string {
+	if ans.Class != "" {
+		viper.Set("provider", ans.Class)
+		provider = ans.Class
+	}
+	if ans.Name != "" {
+		viper.Set("name", ans.Name)
+		provider = ans.Name
+	}
+	if ans.Owner != "" {
+		viper.Set("owner", ans.Owner)
+	}
+	if ans.Config != "" {
+		viper.Set("provider-config", ans.Config)
+	}
+	if nonInteractive {
+		viper.Set("yes", true)
+		viper.Set("skip-browser", true)
+	}
+	return provider
 }
 
 // loadCatalog loads and applies the quickstart rule type and profile catalog
@@ -266,78 +337,47 @@ func loadCatalog(
 	provider string,
 	project string,
 	registeredRepos []string,
+	ans *answers,
+	confirm func(msg string) bool,
 ) error {
 	// Step 3 - Confirm rule type creation
-	yes := cli.PrintYesNoPrompt(cmd,
-		stepPromptMsgRuleType,
-		"Proceed?",
-		"Quickstart operation cancelled.",
-		true)
-	if !yes {
+	if !confirm(stepPromptMsgRuleType) {
 		return nil
 	}
 
-	// Creating the rule type
+	// Creating the rule types
 	cmd.Println("Creating rule type...")
 
-	// Load the rule type from the embedded file system
-	reader, err := content.Open("embed/secret_scanning.yaml")
-	if err != nil {
-		return cli.MessageAndError("error opening rule type", err)
+	// Load the rule type from the embedded file system, unless the answers
+	// list other rule types
+	ruleTypes, embedded := []string{"embed/secret_scanning.yaml"}, true
+	if ans != nil && len(ans.RuleTypes) > 0 {
+		ruleTypes, embedded = ans.RuleTypes, false
 	}
 
-	rt := &minderv1.RuleType{}
-
-	if err := minderv1.ParseResource(reader, rt); err != nil {
-		return cli.MessageAndError("error parsing rule type", err)
-	}
-
-	if rt.Context == nil {
-		rt.Context = &minderv1.Context{}
-	}
-
-	rt.Context = &minderv1.Context{
-		Provider: &provider,
-		Project:  &project,
-	}
-
-	// New context so we don't time out between steps
-	ctx, cancel := getQuickstartContext(cmd.Context(), viper.GetViper())
-	defer cancel()
-
-	// Create the rule type in minder
-	_, err = ruleClient.CreateRuleType(ctx, &minderv1.CreateRuleTypeRequest{
-		RuleType: rt,
-	})
-	if err != nil {
-		if st, ok := status.FromError(err); ok {
-			if st.Code() != codes.AlreadyExists {
-				return fmt.Errorf("error creating rule type from: %w", err)
-			}
-			cmd.Println("Rule type secret_scanning already exists")
-		} else {
-			return cli.MessageAndError("error creating rule type", err)
+	for _, ruleType := range ruleTypes {
+		if err := createRuleType(cmd, ruleClient, provider, project, ruleType, embedded); err != nil {
+			return err
 		}
 	}
 
 	// Step 4 - Confirm profile creation
-	yes = cli.PrintYesNoPrompt(cmd,
-		fmt.Sprintf(stepPromptMsgProfile, strings.Join(registeredRepos[:], "\n")),
-		"Proceed?",
-		"Quickstart operation cancelled.",
-		true)
-	if !yes {
+	if !confirm(fmt.Sprintf(stepPromptMsgProfile, strings.Join(registeredRepos[:], "\n"))) {
 		return nil
 	}
 
 	// Creating the profile
 	cmd.Println("Creating profile...")
-	reader, err = content.Open("embed/profile.yaml")
+	profilePath, embedded := "embed/profile.yaml", true
+	if ans != nil && ans.Profile != "" {
+		profilePath, embedded = ans.Profile, false
+	}
+	reader, err := openResource(profilePath, embedded)
 	if err != nil {
 		return cli.MessageAndError("error opening profile", err)
 	}
+	defer reader.Close()
 
-	// Load the profile from the embedded file system
 	p, err := profiles.ParseYAML(reader)
 	if err != nil {
 		return cli.MessageAndError("error parsing profile", err)
@@ -353,7 +393,7 @@ func loadCatalog(
 	}
 
 	// New context so we don't time out between steps
-	ctx, cancel = getQuickstartContext(cmd.Context(), viper.GetViper())
+	ctx, cancel := getQuickstartContext(cmd.Context(), viper.GetViper())
 	defer cancel()
 
 	alreadyExists := false
@@ -389,6 +429,63 @@ func loadCatalog(
 	return nil
 }
 
+// createRuleType creates the rule type of the given file, unless it exists
+// already
+func createRuleType(
+	cmd *cobra.Command,
+	ruleClient minderv1.RuleTypeServiceClient,
+	provider string,
+	project string,
+	fpath string,
+	embedded bool,
+) error {
+	reader, err := openResource(fpath, embedded)
+	if err != nil {
+		return cli.MessageAndError("error opening rule type", err)
+	}
+	defer reader.Close()
+
+	rt := &minderv1.RuleType{}
+
+	if err := minderv1.ParseResource(reader, rt); err != nil {
+		return cli.MessageAndError("error parsing rule type", err)
+	}
+
+	rt.Context = &minderv1.Context{
+		Provider: &provider,
+		Project:  &project,
+	}
+
+	// New context so we don't time out between steps
+	ctx, cancel := getQuickstartContext(cmd.Context(), viper.GetViper())
+	defer cancel()
+
+	// Create the rule type in minder
+	_, err = ruleClient.CreateRuleType(ctx, &minderv1.CreateRuleTypeRequest{
+		RuleType: rt,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() != codes.AlreadyExists {
+				return fmt.Errorf("error creating rule type from: %w", err)
+			}
+			cmd.Printf("Rule type %s already exists\n", rt.GetName())
+		} else {
+			return cli.MessageAndError("error creating rule type", err)
+		}
+	}
+	return nil
+}
+
+// openResource opens a quickstart file from the embedded file system, or a
+// file of the answers
+func openResource(fpath string, embedded bool) (io.ReadCloser, error) {
+	if embedded {
+		return content.Open(fpath)
+	}
+	return os.Open(filepath.Clean(fpath))
+}
+
 func init() {
 	app.RootCmd.AddCommand(cmd)
 	// Flags
@@ -396,6 +493,8 @@ func init() {
 	cmd.Flags().StringP("project", "j", "", "ID of the project")
 	cmd.Flags().StringP("token", "t", "", "Personal Access Token (PAT) to use for enrollment")
 	cmd.Flags().StringP("owner", "o", "", "Owner to filter on for provider resources")
+	cmd.Flags().StringP("file", "f", "", "Path to a YAML file answering the quickstart steps")
+	cmd.Flags().Bool("non-interactive", false, "Run the quickstart without prompts, using the answers file")
 	// Bind flags
 	if err := viper.BindPFlag("token", cmd.Flags().Lookup("token")); err != nil {
 		cmd.Printf("error: %s", err)
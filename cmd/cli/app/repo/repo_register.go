@@ -7,7 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"path"
 	"slices"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -24,7 +27,11 @@ import (
 var repoRegisterCmd = &cobra.Command{
 	Use:   "register",
 	Short: "Register a repository",
-	Long:  `The repo register subcommand is used to register a repo within Minder.`,
+	Long: `The repo register subcommand is used to register a repo within Minder.
+
+Repositories are selected interactively, unless they are given with --name.
+The names may be shell patterns, e.g. owner/* to register all the
+repositories of owner.`,
 
 	PreRunE: func(cmd *cobra.Command, _ []string) error {
 		if err := viper.BindPFlags(cmd.Flags()); err != nil {
@@ -89,6 +96,12 @@ func RegisterCmd(cmd *cobra.Command, _ []string) error {
 	}
 
 	for _, repo := range inputRepoList {
+		if isRepoPattern(repo) {
+			if _, err := path.Match(repo, ""); err != nil {
+				return cli.MessageAndError("Invalid repository pattern", err)
+			}
+			continue
+		}
 		if err := cli.ValidateRepositoryName(repo); err != nil {
 			return cli.MessageAndError("Invalid repository name", err)
 		}
@@ -127,19 +140,7 @@ func RegisterCmd(cmd *cobra.Command, _ []string) error {
 	var selectedRepos []*minderv1.UpstreamRepositoryRef
 	if len(inputRepoList) > 0 {
 		// Repositories are provided as --name options
-		for _, repo := range inputRepoList {
-			// Repo was already registered, report it to
-			// user and move on
-			if registeredRepos[repo] != nil {
-				cmd.Printf("Repository %s is already registered\n", repo)
-			}
-
-			// Repo was not already registered, add it to
-			// those to process.
-			if repoRef := unregisteredRepos[repo]; repoRef != nil {
-				selectedRepos = append(selectedRepos, repoRef)
-			}
-		}
+		selectedRepos = selectReposByName(cmd, inputRepoList, registeredRepos, unregisteredRepos)
 	} else {
 		cmd.Printf(
 			"Found %d remote repositories: %d registered and %d unregistered.\n",
@@ -192,6 +193,59 @@ func fetchRepos(
 	return resp.Results, nil
 }
 
+// isRepoPattern returns true if the repository name is a shell pattern
+func isRepoPattern(name string) bool {
+	return strings.ContainsAny(name, "*?[")
+}
+
+// selectReposByName selects the unregistered repositories with the given
+// names, or matching the given patterns.
+func selectReposByName(
+	cmd *cobra.Command,
+	names []string,
+	registeredRepos map[string]*minderv1.UpstreamRepositoryRef,
+	unregisteredRepos map[string]*minderv1.UpstreamRepositoryRef,
+) []*minderv1.UpstreamRepositoryRef {
+	unregisteredNames := slices.Sorted(maps.Keys(unregisteredRepos))
+	selected := make(map[string]bool)
+
+	var selectedRepos []*minderv1.UpstreamRepositoryRef
+	for _, name := range names {
+		if isRepoPattern(name) {
+			matched := false
+			for _, repoName := range unregisteredNames {
+				if ok, _ := path.Match(name, repoName); !ok {
+					continue
+				}
+				matched = true
+				if !selected[repoName] {
+					selected[repoName] = true
+					selectedRepos = append(selectedRepos, unregisteredRepos[repoName])
+				}
+			}
+			if !matched {
+				cmd.Printf("No unregistered repository matches %s\n", name)
+			}
+			continue
+		}
+
+		// Repo was already registered, report it to
+		// user and move on
+		if registeredRepos[name] != nil {
+			cmd.Printf("Repository %s is already registered\n", name)
+		}
+
+		// Repo was not already registered, add it to
+		// those to process.
+		if repoRef := unregisteredRepos[name]; repoRef != nil && !selected[name] {
+			selected[name] = true
+			selectedRepos = append(selectedRepos, repoRef)
+		}
+	}
+
+	return selectedRepos
+}
+
 func selectReposInteractively(
 	cmd *cobra.Command,
 	unregisteredRepos map[string]*minderv1.UpstreamRepositoryRef,
@@ -427,6 +481,7 @@ func printWarnings(cmd *cobra.Command, warnings []string) {
 func init() {
 	RepoCmd.AddCommand(repoRegisterCmd)
 	// Flags
-	repoRegisterCmd.Flags().StringSliceP("name", "n", []string{}, "List of repository names to register, i.e owner/repo,owner/repo")
+	repoRegisterCmd.Flags().StringSliceP("name", "n", []string{},
+		"List of repository names or patterns to register, i.e owner/repo,owner/*")
 	repoRegisterCmd.Flags().BoolP("all", "a", false, "Register all unregistered repositories")
 }
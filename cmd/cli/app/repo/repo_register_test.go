@@ -48,6 +48,38 @@ func TestRegisterCommand(t *testing.T) {
 			},
 			GoldenFileName: "register_single.table",
 		},
+		{
+			Name: "register repos matching a pattern",
+			Args: []string{"repo", "register", "-n", "mock-owner/*", "-p", "github"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockRepositoryServiceClient(ctrl)
+
+				mockRemoteResp := &minderv1.ListRemoteRepositoriesFromProviderResponse{}
+				cli.LoadFixture(t, "mock_repo_register_remote.json", mockRemoteResp)
+
+				client.EXPECT().
+					ListRemoteRepositoriesFromProvider(gomock.Any(), gomock.Any()).
+					Return(mockRemoteResp, nil).
+					Times(1)
+
+				mockRegisterResp := &minderv1.RegisterRepositoryResponse{}
+				cli.LoadFixture(t, "mock_repo_register_success.json", mockRegisterResp)
+
+				client.EXPECT().
+					RegisterRepository(gomock.Any(), gomock.Any()).
+					Return(mockRegisterResp, nil).
+					Times(1)
+
+				return cli.WithRPCClient[minderv1.RepositoryServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "register_single.table",
+		},
+		{
+			Name:          "fails on malformed repository pattern",
+			Args:          []string{"repo", "register", "-n", "mock-owner/["},
+			ExpectedError: "syntax error in pattern",
+		},
 		{
 			Name:          "fails when using mutually exclusive flags",
 			Args:          []string{"repo", "register", "-n", repoName, "--all"},
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Compare registered repositories with the upstream ones",
+	Long: `The repo sync subcommand compares the repositories registered in Minder with the
+ones available in the provider, and lists the repositories which are not registered,
+or were renamed, archived or deleted upstream.
+
+With --apply, the differences are reconciled: missing repositories are registered,
+renamed repositories are updated, and archived or deleted repositories are removed
+from Minder.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %w", err)
+		}
+
+		format := viper.GetString("output")
+
+		// Ensure the output format is supported
+		if !app.IsOutputFormatSupported(format) {
+			return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+		}
+
+		return nil
+	},
+	RunE: syncCommand,
+}
+
+func syncCommand(cmd *cobra.Command, _ []string) error {
+	provider := viper.GetString("provider")
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+	apply := viper.GetBool("apply")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, cleanup, err := getRepoClient(cmd)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer cleanup()
+
+	resp, err := client.SyncRepositories(cmd.Context(), &minderv1.SyncRepositoriesRequest{
+		Context: &minderv1.Context{Provider: &provider, Project: &project},
+		Apply:   apply,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error syncing repositories", err)
+	}
+
+	switch format {
+	case app.Table:
+		if len(resp.GetChanges()) == 0 {
+			cmd.Println("Registered repositories are in sync with upstream")
+			return nil
+		}
+
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(),
+			[]string{"Change", "Name", "Upstream Name", "Provider", "Status"})
+		for _, change := range resp.GetChanges() {
+			t.AddRow(
+				change.GetKind(),
+				change.GetName(),
+				change.GetUpstreamName(),
+				change.GetProvider(),
+				syncChangeStatus(change),
+			)
+		}
+		t.Render()
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	}
+	return nil
+}
+
+func syncChangeStatus(change *minderv1.RepositorySyncChange) string {
+	switch {
+	case change.GetApplied():
+		return "applied"
+	case change.GetError() != "":
+		return fmt.Sprintf("failed: %s", change.GetError())
+	default:
+		return "pending"
+	}
+}
+
+func init() {
+	RepoCmd.AddCommand(syncCmd)
+	// Flags
+	syncCmd.Flags().Bool("apply", false, "Reconcile the registered repositories with the upstream ones")
+	syncCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestSyncCommand(t *testing.T) {
+	tests := []cli.CmdTestCase{
+		{
+			Name: "sync repositories - json output",
+			Args: []string{"repo", "sync", "-o", "json"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockRepositoryServiceClient(ctrl)
+
+				mockResp := &minderv1.SyncRepositoriesResponse{}
+				cli.LoadFixture(t, "mock_repo_sync.json", mockResp)
+
+				client.EXPECT().
+					SyncRepositories(gomock.Any(), gomock.Cond(func(req *minderv1.SyncRepositoriesRequest) bool {
+						return !req.GetApply()
+					})).
+					Return(mockResp, nil).
+					Times(1)
+
+				return cli.WithRPCClient[minderv1.RepositoryServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "sync_json.txt",
+		},
+		{
+			Name: "sync repositories - apply",
+			Args: []string{"repo", "sync", "--apply", "-o", "table"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockRepositoryServiceClient(ctrl)
+
+				mockResp := &minderv1.SyncRepositoriesResponse{}
+				cli.LoadFixture(t, "mock_repo_sync_empty.json", mockResp)
+
+				client.EXPECT().
+					SyncRepositories(gomock.Any(), gomock.Cond(func(req *minderv1.SyncRepositoriesRequest) bool {
+						return req.GetApply()
+					})).
+					Return(mockResp, nil).
+					Times(1)
+
+				return cli.WithRPCClient[minderv1.RepositoryServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "sync_empty.txt",
+		},
+		{
+			Name:          "fails on invalid output format",
+			Args:          []string{"repo", "sync", "-o", "invalid_format"},
+			ExpectedError: "invalid argument",
+		},
+		{
+			Name: "server error handling",
+			Args: []string{"repo", "sync"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockRepositoryServiceClient(ctrl)
+				client.EXPECT().
+					SyncRepositories(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.Internal, "internal server error")).
+					Times(1)
+
+				return cli.WithRPCClient[minderv1.RepositoryServiceClient](context.Background(), client)
+			},
+			ExpectedError: "internal server error",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, RepoCmd)
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var timelineCmd = &cobra.Command{
+	Use:   "timeline <repo>",
+	Short: "Show the timeline of a repository",
+	Long: `The repo timeline subcommand shows the events of a registered repository, newest
+first: its registration, the refreshes of its properties, its evaluations and
+the remediations and alerts acted on them. The repository is given by name
+(owner/name format) or by ID.`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %w", err)
+		}
+
+		format := viper.GetString("output")
+
+		// Ensure the output format is supported
+		if !app.IsOutputFormatSupported(format) {
+			return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+		}
+
+		return nil
+	},
+	RunE: timelineCommand,
+}
+
+// timelineCommand is the repo timeline subcommand
+func timelineCommand(cmd *cobra.Command, args []string) error {
+	provider := viper.GetString("provider")
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+	cursor := viper.GetString("cursor")
+	size := viper.GetUint32("size")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	repoID := args[0]
+	if _, err := uuid.Parse(repoID); err != nil {
+		client, cleanup, err := getRepoClient(cmd)
+		if err != nil {
+			return cli.MessageAndError("Error connecting to server", err)
+		}
+		defer cleanup()
+
+		resp, err := client.GetRepositoryByName(cmd.Context(), &minderv1.GetRepositoryByNameRequest{
+			Context: &minderv1.Context{Provider: &provider, Project: &project},
+			Name:    repoID,
+		})
+		if err != nil {
+			return cli.MessageAndError("Error getting repo by name", err)
+		}
+		repoID = resp.GetRepository().GetId()
+	}
+
+	client, cleanup, err := cli.GetCLIClient(cmd, minderv1.NewEntityInstanceServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer cleanup()
+
+	req := &minderv1.ListEntityTimelineRequest{
+		Context: &minderv1.ContextV2{ProjectId: project, Provider: provider},
+		Id:      repoID,
+	}
+	if cursor != "" || size != 0 {
+		req.Cursor = &minderv1.Cursor{Cursor: cursor, Size: size}
+	}
+
+	resp, err := client.ListEntityTimeline(cmd.Context(), req)
+	if err != nil {
+		return cli.MessageAndError("Error getting repo timeline", err)
+	}
+
+	switch format {
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(),
+			[]string{"Time", "Event", "Rule", "Status", "Details"})
+		for _, event := range resp.GetEvents() {
+			rule := event.GetRuleName()
+			if event.GetProfile() != "" {
+				rule = event.GetProfile() + "/" + rule
+			}
+			t.AddRow(
+				event.GetOccurredAt().AsTime().Format(time.DateTime),
+				event.GetKind(),
+				rule,
+				event.GetStatus(),
+				event.GetDetails(),
+			)
+		}
+		t.Render()
+		if next := resp.GetPage().GetNext(); next != nil {
+			cmd.Printf("Older events: %s\n", cli.CursorStyle.Render(next.GetCursor()))
+		}
+		if prev := resp.GetPage().GetPrev(); prev != nil {
+			cmd.Printf("Newer events: %s\n", cli.CursorStyle.Render(prev.GetCursor()))
+		}
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	}
+
+	return nil
+}
+
+func init() {
+	RepoCmd.AddCommand(timelineCmd)
+	// Flags
+	timelineCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	timelineCmd.Flags().StringP("cursor", "c", "", "Fetch previous or next page of events")
+	timelineCmd.Flags().Uint32P("size", "s", 0, "Change the number of events fetched")
+}
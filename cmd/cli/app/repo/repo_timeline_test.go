@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestTimelineCommand(t *testing.T) {
+	const (
+		repoID   = "12345678-1234-1234-1234-123456789012"
+		repoName = "mock-owner/mock-repo"
+	)
+
+	timelineResp := func(t *testing.T) *minderv1.ListEntityTimelineResponse {
+		t.Helper()
+		resp := &minderv1.ListEntityTimelineResponse{}
+		cli.LoadFixture(t, "mock_repo_timeline.json", resp)
+		return resp
+	}
+
+	tests := []cli.CmdTestCase{
+		{
+			Name: "timeline by name - json output",
+			Args: []string{"repo", "timeline", repoName, "-o", "json"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				repoClient := mockv1.NewMockRepositoryServiceClient(ctrl)
+				repoClient.EXPECT().
+					GetRepositoryByName(gomock.Any(), gomock.Any()).
+					Return(&minderv1.GetRepositoryByNameResponse{
+						Repository: &minderv1.Repository{Id: proto.String(repoID)},
+					}, nil)
+
+				entityClient := mockv1.NewMockEntityInstanceServiceClient(ctrl)
+				entityClient.EXPECT().
+					ListEntityTimeline(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *minderv1.ListEntityTimelineRequest, _ ...any) (
+						*minderv1.ListEntityTimelineResponse, error) {
+						if req.GetId() != repoID {
+							return nil, status.Error(codes.NotFound, "unexpected id")
+						}
+						return timelineResp(t), nil
+					})
+
+				ctx := cli.WithRPCClient[minderv1.RepositoryServiceClient](context.Background(), repoClient)
+				return cli.WithRPCClient[minderv1.EntityInstanceServiceClient](ctx, entityClient)
+			},
+			GoldenFileName: "timeline_json.txt",
+		},
+		{
+			Name: "timeline by id skips the name lookup",
+			Args: []string{"repo", "timeline", repoID, "-o", "json"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				entityClient := mockv1.NewMockEntityInstanceServiceClient(ctrl)
+				entityClient.EXPECT().
+					ListEntityTimeline(gomock.Any(), gomock.Any()).
+					Return(timelineResp(t), nil)
+
+				return cli.WithRPCClient[minderv1.EntityInstanceServiceClient](context.Background(), entityClient)
+			},
+			GoldenFileName: "timeline_json.txt",
+		},
+		{
+			Name:          "fails without a repository",
+			Args:          []string{"repo", "timeline"},
+			ExpectedError: "accepts 1 arg(s), received 0",
+		},
+		{
+			Name: "server error handling",
+			Args: []string{"repo", "timeline", repoID},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				entityClient := mockv1.NewMockEntityInstanceServiceClient(ctrl)
+				entityClient.EXPECT().
+					ListEntityTimeline(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, "entity not found"))
+
+				return cli.WithRPCClient[minderv1.EntityInstanceServiceClient](context.Background(), entityClient)
+			},
+			ExpectedError: "entity not found",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, RepoCmd)
+}
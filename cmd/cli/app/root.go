@@ -72,6 +72,14 @@ const (
 	YAML = "yaml"
 	// Table is the table format for output
 	Table = "table"
+	// CSV is the csv format for output. It is only supported by the
+	// commands listing rows of the same shape, so it isn't part of
+	// SupportedOutputFormats.
+	CSV = "csv"
+	// CIJSON is a versioned json summary for CI pipelines, whose schema
+	// only changes with its version. It is only supported by the profile
+	// status commands, so it isn't part of SupportedOutputFormats.
+	CIJSON = "ci-json"
 )
 
 // Execute adds all child commands to the root command and sets flags appropriately.
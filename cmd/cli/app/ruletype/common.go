@@ -4,8 +4,10 @@
 package ruletype
 
 import (
+	"bytes"
 	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -22,13 +24,17 @@ import (
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 )
 
+// ruleTypeSignatureSuffix is appended to the path of a rule type file to
+// find its sigstore signature, as written by cosign sign-blob --bundle
+const ruleTypeSignatureSuffix = ".sigstore.json"
+
 func execOnOneRuleType(
 	ctx context.Context,
 	t table.Table,
 	f string,
 	dashOpen io.Reader,
 	proj string,
-	exec func(context.Context, string, *minderv1.RuleType) (*minderv1.RuleType, error),
+	exec func(context.Context, string, *minderv1.RuleType, *minderv1.RuleTypeSignature) (*minderv1.RuleType, error),
 ) error {
 	reader, closer, err := util.OpenFileArg(f, dashOpen)
 	if err != nil {
@@ -36,11 +42,25 @@ func execOnOneRuleType(
 	}
 	defer closer()
 
+	// The content is kept as is, since it is what the signature covers
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("error reading rule type: %w", err)
+	}
+
 	r := &minderv1.RuleType{}
-	if err := minderv1.ParseResource(reader, r); err != nil {
+	if err := minderv1.ParseResource(bytes.NewReader(content), r); err != nil {
 		return fmt.Errorf("error parsing rule type: %w", err)
 	}
 
+	var sig *minderv1.RuleTypeSignature
+	if f != "-" {
+		sig, err = readRuleTypeSignature(os.ReadFile, f, content)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Override the YAML specified project with the command line argument
 	if proj != "" {
 		if r.Context == nil {
@@ -51,7 +71,7 @@ func execOnOneRuleType(
 	}
 
 	// create a rule
-	rt, err := exec(ctx, f, r)
+	rt, err := exec(ctx, f, r, sig)
 	if err != nil {
 		return err
 	}
@@ -68,6 +88,25 @@ func execOnOneRuleType(
 	return nil
 }
 
+// readRuleTypeSignature reads the signature of the rule type file f, if
+// any, along with the content of the file which it signs
+func readRuleTypeSignature(
+	readFile func(string) ([]byte, error),
+	f string,
+	content []byte,
+) (*minderv1.RuleTypeSignature, error) {
+	sigBundle, err := readFile(f + ruleTypeSignatureSuffix)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading signature of %s: %w", f, err)
+	}
+	return &minderv1.RuleTypeSignature{
+		Content: content,
+		Bundle:  string(sigBundle),
+	}, nil
+}
+
 func validateFilesArg(files []string) error {
 	if files == nil {
 		return fmt.Errorf("error: file must be set")
@@ -91,6 +130,10 @@ func printWarnings(cmd *cobra.Command, warnings []string) {
 }
 
 func shouldSkipFile(f string) bool {
+	// signatures are sent along with the rule types they sign
+	if strings.HasSuffix(f, ruleTypeSignatureSuffix) {
+		return true
+	}
 	// if the file is not json or yaml, skip it
 	// Get file extension
 	ext := filepath.Ext(f)
@@ -21,7 +21,12 @@ import (
 var applyCmd = &cobra.Command{
 	Use:   "apply [files...]",
 	Short: "Apply a rule type",
-	Long:  `The ruletype apply subcommand lets you create or update rule types for a project within Minder.`,
+	Long: `The ruletype apply subcommand lets you create or update rule types for a project within Minder.
+
+The signature of a rule type file, as written by cosign sign-blob --bundle, is
+read from the file with the .sigstore.json suffix next to it, e.g.
+rule_type.yaml.sigstore.json, and sent along with the rule type. The server
+rejects the unsigned rule types if it requires signatures.`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		fileFlag, err := cmd.Flags().GetStringArray("file")
 		if err != nil {
@@ -74,9 +79,12 @@ func applyCommand(cmd *cobra.Command, args []string) error {
 
 	table := initializeTableForList(cmd.OutOrStdout())
 
-	applyFunc := func(ctx context.Context, fileName string, rt *minderv1.RuleType) (*minderv1.RuleType, error) {
+	applyFunc := func(
+		ctx context.Context, fileName string, rt *minderv1.RuleType, sig *minderv1.RuleTypeSignature,
+	) (*minderv1.RuleType, error) {
 		createResp, err := client.CreateRuleType(ctx, &minderv1.CreateRuleTypeRequest{
-			RuleType: rt,
+			RuleType:  rt,
+			Signature: sig,
 		})
 
 		if err == nil {
@@ -95,7 +103,8 @@ func applyCommand(cmd *cobra.Command, args []string) error {
 		}
 
 		updateResp, err := client.UpdateRuleType(ctx, &minderv1.UpdateRuleTypeRequest{
-			RuleType: rt,
+			RuleType:  rt,
+			Signature: sig,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("error updating rule type from %s: %w", fileName, err)
@@ -20,7 +20,12 @@ import (
 var createCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a rule type",
-	Long:  `The ruletype create subcommand lets you create new rule types for a project within Minder.`,
+	Long: `The ruletype create subcommand lets you create new rule types for a project within Minder.
+
+The signature of a rule type file, as written by cosign sign-blob --bundle, is
+read from the file with the .sigstore.json suffix next to it, e.g.
+rule_type.yaml.sigstore.json, and sent along with the rule type. The server
+rejects the unsigned rule types if it requires signatures.`,
 	PreRunE: func(cmd *cobra.Command, _ []string) error {
 		if err := viper.BindPFlags(cmd.Flags()); err != nil {
 			return fmt.Errorf("error binding flags: %w", err)
@@ -62,9 +67,12 @@ func createCommand(cmd *cobra.Command, _ []string) error {
 
 	table := initializeTableForList(cmd.OutOrStdout())
 
-	createFunc := func(ctx context.Context, _ string, rt *minderv1.RuleType) (*minderv1.RuleType, error) {
+	createFunc := func(
+		ctx context.Context, _ string, rt *minderv1.RuleType, sig *minderv1.RuleTypeSignature,
+	) (*minderv1.RuleType, error) {
 		resprt, err := client.CreateRuleType(ctx, &minderv1.CreateRuleTypeRequest{
-			RuleType: rt,
+			RuleType:  rt,
+			Signature: sig,
 		})
 		if err != nil {
 			return nil, err
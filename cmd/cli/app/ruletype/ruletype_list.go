@@ -57,8 +57,16 @@ func listCommand(cmd *cobra.Command, _ []string) error {
 
 	format := viper.GetString("output")
 
+	var visibility minderv1.Visibility
+	if v := viper.GetString("visibility"); v != "" {
+		if err := visibility.FromString(v); err != nil {
+			return cli.MessageAndError("Invalid visibility", err)
+		}
+	}
+
 	resp, err := client.ListRuleTypes(cmd.Context(), &minderv1.ListRuleTypesRequest{
-		Context: &minderv1.Context{Project: &project},
+		Context:    &minderv1.Context{Project: &project},
+		Visibility: visibility,
 	})
 	if err != nil {
 		return cli.MessageAndError("Error listing rule types", err)
@@ -112,4 +120,5 @@ func init() {
 	// Flags
 	listCmd.Flags().StringP("output", "o", app.Table,
 		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	listCmd.Flags().String("visibility", "", "Only list rule types with this visibility (one of private,subtree,global)")
 }
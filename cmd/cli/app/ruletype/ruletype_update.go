@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ruletype
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var updateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update rule types from a repository",
+	Long: `The ruletype update subcommand updates the rule types of a project to their
+latest definitions in a git repository, such as the upstream repository of rule
+types at https://github.com/mindersec/minder-rules-and-profiles.
+
+The rule types are read from the files under the --path directory of the
+repository whose path relative to it matches the --match pattern, e.g. github/*.
+Only the rule types which already exist in the project are updated, all at once:
+either all the updates succeed or none is applied. The changes and the profiles
+using the changed rule types are shown before the update is applied.
+
+The signature of a rule type file, as written by cosign sign-blob --bundle, is
+read from the file with the .sigstore.json suffix next to it, e.g.
+rule_type.yaml.sigstore.json, and sent along with the rule type. The server
+rejects the unsigned rule types if it requires signatures.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %w", err)
+		}
+		if _, err := path.Match(viper.GetString("match"), ""); err != nil {
+			return fmt.Errorf("invalid --match pattern: %w", err)
+		}
+		return nil
+	},
+	RunE: updateCommand,
+}
+
+func updateCommand(cmd *cobra.Command, _ []string) error {
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	project := viper.GetString("project")
+	repoURL := viper.GetString("from-repo")
+
+	fs, err := cloneRuleTypesRepo(cmd.Context(), repoURL, viper.GetString("ref"))
+	if err != nil {
+		return cli.MessageAndError(fmt.Sprintf("Error cloning %s", repoURL), err)
+	}
+	ruleTypes, signatures, err := ruleTypesFromFS(fs, viper.GetString("path"), viper.GetString("match"))
+	if err != nil {
+		return cli.MessageAndError("Error reading rule types", err)
+	}
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewRuleTypeServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	existing, err := client.ListRuleTypes(cmd.Context(), &minderv1.ListRuleTypesRequest{
+		Context: &minderv1.Context{Project: &project},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error listing rule types", err)
+	}
+	installed := make(map[string]bool, len(existing.GetRuleTypes()))
+	for _, rt := range existing.GetRuleTypes() {
+		installed[rt.GetName()] = true
+	}
+
+	toUpdate := make([]*minderv1.RuleType, 0, len(ruleTypes))
+	toUpdateSignatures := make(map[string]*minderv1.RuleTypeSignature)
+	for _, rt := range ruleTypes {
+		if !installed[rt.GetName()] {
+			cmd.PrintErrf("Skipping rule type %s: not in the project, use `minder ruletype apply` to create it\n",
+				rt.GetName())
+			continue
+		}
+		toUpdate = append(toUpdate, rt)
+		if sig, ok := signatures[rt.GetName()]; ok {
+			toUpdateSignatures[rt.GetName()] = sig
+		}
+	}
+	if len(toUpdate) == 0 {
+		cmd.Println("No rule type of the project matches, nothing to update.")
+		return nil
+	}
+
+	req := &minderv1.BulkUpdateRuleTypesRequest{
+		Context:    &minderv1.Context{Project: &project},
+		RuleTypes:  toUpdate,
+		DryRun:     true,
+		Signatures: toUpdateSignatures,
+	}
+	plan, err := client.BulkUpdateRuleTypes(cmd.Context(), req)
+	if err != nil {
+		return cli.MessageAndError("Error computing the rule type updates", err)
+	}
+
+	changed := renderRuleTypeUpdates(cmd.OutOrStdout(), plan.GetUpdates())
+	for _, update := range plan.GetUpdates() {
+		printWarnings(cmd, update.GetWarnings())
+	}
+	switch {
+	case changed == 0:
+		cmd.Println("All rule types are up to date.")
+		return nil
+	case viper.GetBool("dry-run"):
+		cmd.Printf("Dry run: %d rule type(s) would be updated.\n", changed)
+		return nil
+	}
+
+	if !viper.GetBool("yes") {
+		yes := cli.PrintYesNoPrompt(cmd,
+			fmt.Sprintf("You are about to update %d rule type(s).", changed),
+			"Are you sure?",
+			"Rule type update cancelled.",
+			false)
+		if !yes {
+			return nil
+		}
+	}
+
+	req.DryRun = false
+	if _, err := client.BulkUpdateRuleTypes(cmd.Context(), req); err != nil {
+		return cli.MessageAndError("Error updating rule types", err)
+	}
+	cmd.Printf("Updated %d rule type(s).\n", changed)
+	return nil
+}
+
+// cloneRuleTypesRepo makes a shallow clone of the repository in memory. The
+// default branch is cloned if ref is empty.
+func cloneRuleTypesRepo(ctx context.Context, url, ref string) (billy.Filesystem, error) {
+	opts := &git.CloneOptions{
+		URL:          url,
+		SingleBranch: true,
+		Depth:        1,
+		Tags:         git.NoTags,
+	}
+	if ref != "" {
+		opts.ReferenceName = plumbing.NewBranchReferenceName(ref)
+	}
+
+	fs := memfs.New()
+	if _, err := git.CloneContext(ctx, memory.NewStorage(), fs, opts); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+// ruleTypesFromFS reads the rule types of the files under dir whose path
+// relative to dir matches the pattern, along with their signatures by rule
+// type name. Rule type tests and the files which aren't rule types are
+// skipped.
+func ruleTypesFromFS(
+	fs billy.Filesystem, dir, pattern string,
+) ([]*minderv1.RuleType, map[string]*minderv1.RuleTypeSignature, error) {
+	var ruleTypes []*minderv1.RuleType
+	signatures := make(map[string]*minderv1.RuleTypeSignature)
+	err := util.Walk(fs, dir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel := strings.TrimPrefix(strings.TrimPrefix(filePath, path.Clean(dir)), "/")
+		if ok, _ := path.Match(pattern, rel); !ok || isRuleTypeTestFile(rel) ||
+			strings.HasSuffix(rel, ruleTypeSignatureSuffix) {
+			return nil
+		}
+		switch path.Ext(rel) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+
+		content, err := util.ReadFile(fs, filePath)
+		if err != nil {
+			return err
+		}
+
+		rt := &minderv1.RuleType{}
+		if err := minderv1.ParseResource(bytes.NewReader(content), rt); err != nil {
+			if minderv1.YouMayHaveTheWrongResource(err) {
+				return nil
+			}
+			return fmt.Errorf("error parsing rule type %s: %w", rel, err)
+		}
+		ruleTypes = append(ruleTypes, rt)
+
+		sig, err := readRuleTypeSignature(func(name string) ([]byte, error) {
+			return util.ReadFile(fs, name)
+		}, filePath, content)
+		if err != nil {
+			return err
+		}
+		if sig != nil {
+			signatures[rt.GetName()] = sig
+		}
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("directory %s not found in the repository", dir)
+	}
+	return ruleTypes, signatures, err
+}
+
+// isRuleTypeTestFile returns true for the tests of the rule types, such as
+// github/secret_scanning.test.yaml
+func isRuleTypeTestFile(rel string) bool {
+	ext := path.Ext(rel)
+	return strings.HasSuffix(strings.TrimSuffix(rel, ext), ".test")
+}
+
+// renderRuleTypeUpdates renders the changes of a bulk update, returning the
+// number of rule types which change
+func renderRuleTypeUpdates(out io.Writer, updates []*minderv1.RuleTypeUpdate) int {
+	t := table.New(table.Simple, layouts.Default, out,
+		[]string{"Name", "Changes", "Affected Profiles"})
+
+	changed := 0
+	for _, update := range updates {
+		changes := "unchanged"
+		profiles := ""
+		if len(update.GetChangedFields()) > 0 {
+			changed++
+			changes = strings.Join(update.GetChangedFields(), "\n")
+			profiles = strings.Join(update.GetAffectedProfiles(), "\n")
+		}
+		t.AddRow(update.GetName(), changes, profiles)
+	}
+	t.Render()
+	return changed
+}
+
+func init() {
+	ruleTypeCmd.AddCommand(updateCmd)
+	// Flags
+	updateCmd.Flags().String("from-repo", "", "URL of the git repository to read the rule types from")
+	updateCmd.Flags().String("ref", "", "Branch of the repository to read the rule types from, the default branch if empty")
+	updateCmd.Flags().String("path", "rule-types", "Directory of the repository containing the rule types")
+	updateCmd.Flags().String("match", "*/*",
+		"Pattern the paths of the rule type files relative to --path must match, e.g. github/*")
+	updateCmd.Flags().Bool("dry-run", false, "Only show the changes, without updating the rule types")
+	updateCmd.Flags().BoolP("yes", "y", false, "Bypass the confirmation prompt")
+	// Required
+	if err := updateCmd.MarkFlagRequired("from-repo"); err != nil {
+		updateCmd.Printf("Error marking flag required: %s", err)
+		os.Exit(1)
+	}
+}
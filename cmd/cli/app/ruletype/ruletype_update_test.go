@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ruletype
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+// ruleTypeSigBundle is the signature of a rule type. It is only sent to the
+// server, which verifies it.
+const ruleTypeSigBundle = `{"mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json"}`
+
+// newRuleTypesRepo creates a git repository with rule types laid out as in
+// the upstream repository of rule types
+func newRuleTypesRepo(t *testing.T) string {
+	t.Helper()
+
+	ruleType, err := os.ReadFile(filepath.Join("fixture", "rule_type_apply.yaml"))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"rule-types/github/applied_rule.yaml":               string(ruleType),
+		"rule-types/github/applied_rule.test.yaml":          "tests: []\n",
+		"rule-types/github/applied_rule.yaml.sigstore.json": ruleTypeSigBundle,
+		"rule-types/github/new_rule.yaml":                   strings.Replace(string(ruleType), "name: applied_rule", "name: new_rule", 1),
+		"rule-types/gitlab/applied_rule.yaml":               "not a rule type",
+		"profiles/github/profile.yaml":                      "version: v1\ntype: profile\nname: profile\n",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0750))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0600))
+	}
+
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, wt.AddGlob("."))
+	_, err = wt.Commit("Add rule types", &git.CommitOptions{
+		Author: &object.Signature{Name: "Minder", Email: "minder@example.com"},
+	})
+	require.NoError(t, err)
+	return dir
+}
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestUpdateCommand(t *testing.T) {
+	repo := newRuleTypesRepo(t)
+
+	installed := &minderv1.ListRuleTypesResponse{
+		RuleTypes: []*minderv1.RuleType{{Name: "applied_rule"}},
+	}
+	changes := &minderv1.BulkUpdateRuleTypesResponse{
+		Updates: []*minderv1.RuleTypeUpdate{{
+			Name:             "applied_rule",
+			ChangedFields:    []string{"description", "def.eval"},
+			AffectedProfiles: []string{"acme-profile", "other-profile"},
+		}},
+	}
+	isDryRun := func(dryRun bool) gomock.Matcher {
+		return gomock.Cond(func(req *minderv1.BulkUpdateRuleTypesRequest) bool {
+			sig := req.GetSignatures()["applied_rule"]
+			return req.GetDryRun() == dryRun &&
+				len(req.GetRuleTypes()) == 1 && req.GetRuleTypes()[0].GetName() == "applied_rule" &&
+				len(req.GetSignatures()) == 1 && sig.GetBundle() == ruleTypeSigBundle &&
+				strings.Contains(string(sig.GetContent()), "name: applied_rule")
+		})
+	}
+
+	tests := []cli.CmdTestCase{
+		{
+			Name: "dry run shows the changes",
+			Args: []string{"ruletype", "update", "--from-repo", repo, "--match", "github/*", "--dry-run"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockRuleTypeServiceClient(ctrl)
+				client.EXPECT().ListRuleTypes(gomock.Any(), gomock.Any()).Return(installed, nil)
+				client.EXPECT().BulkUpdateRuleTypes(gomock.Any(), isDryRun(true)).Return(changes, nil)
+				return cli.WithRPCClient[minderv1.RuleTypeServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "update_dry_run.table",
+		},
+		{
+			Name: "changes are applied",
+			Args: []string{"ruletype", "update", "--from-repo", repo, "--match", "github/*", "--yes"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockRuleTypeServiceClient(ctrl)
+				client.EXPECT().ListRuleTypes(gomock.Any(), gomock.Any()).Return(installed, nil)
+				gomock.InOrder(
+					client.EXPECT().BulkUpdateRuleTypes(gomock.Any(), isDryRun(true)).Return(changes, nil),
+					client.EXPECT().BulkUpdateRuleTypes(gomock.Any(), isDryRun(false)).Return(changes, nil),
+				)
+				return cli.WithRPCClient[minderv1.RuleTypeServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "update_apply.table",
+		},
+		{
+			Name: "rule types are up to date",
+			Args: []string{"ruletype", "update", "--from-repo", repo, "--match", "github/*", "--yes"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockRuleTypeServiceClient(ctrl)
+				client.EXPECT().ListRuleTypes(gomock.Any(), gomock.Any()).Return(installed, nil)
+				client.EXPECT().BulkUpdateRuleTypes(gomock.Any(), isDryRun(true)).Return(
+					&minderv1.BulkUpdateRuleTypesResponse{
+						Updates: []*minderv1.RuleTypeUpdate{{Name: "applied_rule"}},
+					}, nil)
+				return cli.WithRPCClient[minderv1.RuleTypeServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "update_unchanged.table",
+		},
+		{
+			Name:          "unknown directory",
+			Args:          []string{"ruletype", "update", "--from-repo", repo, "--path", "rules"},
+			ExpectedError: "directory rules not found",
+		},
+		{
+			Name:          "repository is required",
+			Args:          []string{"ruletype", "update"},
+			ExpectedError: "required flag(s) \"from-repo\" not set",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, ruleTypeCmd)
+}
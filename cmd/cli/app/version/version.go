@@ -5,24 +5,110 @@
 package version
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/mindersec/minder/cmd/cli/app"
 	"github.com/mindersec/minder/internal/constants"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
 	"github.com/mindersec/minder/internal/util/cli/useragent"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 )
 
 // VersionCmd is the version command
 var VersionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print minder CLI version",
-	Long:  `The minder version command prints the version of the minder CLI.`,
-	Run: func(cmd *cobra.Command, _ []string) {
-		cmd.Println(constants.VerboseCLIVersion)
-		cmd.Printf("User Agent: %s\n", useragent.GetUserAgent())
+	Long: `The minder version command prints the version of the minder CLI.
+
+With --server, it also prints the version of the server and its capabilities:
+the enabled feature flags, the provider classes which can be enrolled, the
+rule evaluation engines and the limits of the server. In the json and yaml
+output formats, only the server capabilities are printed.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return cli.MessageAndError("Error binding flags", err)
+		}
+
+		server := viper.GetBool("server")
+		// Only the server capabilities are printed in the json and yaml formats
+		if !server || viper.GetString("output") == app.Table {
+			cmd.Println(constants.VerboseCLIVersion)
+			cmd.Printf("User Agent: %s\n", useragent.GetUserAgent())
+		}
+
+		if !server {
+			return nil
+		}
+		return serverCapabilitiesCommand(cmd)
 	},
 }
 
+func serverCapabilitiesCommand(cmd *cobra.Command) error {
+	format := viper.GetString("output")
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	conn, err := cli.GrpcForCommand(cmd, viper.GetViper())
+	if err != nil {
+		return cli.MessageAndError("Error connecting to the server", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := cli.GetAppContext(cmd.Context(), viper.GetViper())
+	defer cancel()
+
+	client := minderv1.NewHealthServiceClient(conn)
+	resp, err := client.GetServerCapabilities(ctx, &minderv1.GetServerCapabilitiesRequest{})
+	if err != nil {
+		return cli.MessageAndError("Error getting server capabilities", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		limits := resp.GetLimits()
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(), []string{"Key", "Value"})
+		t.AddRow("Server Version", resp.GetVersion())
+		t.AddRow("Feature Flags", strings.Join(resp.GetFeatureFlags(), ", "))
+		t.AddRow("Provider Classes", strings.Join(resp.GetProviderClasses(), ", "))
+		t.AddRow("Evaluation Engines", strings.Join(resp.GetEvaluationEngines(), ", "))
+		t.AddRow("Rule Evaluation Timeout", limits.GetRuleEvaluation().GetTimeout())
+		t.AddRow("Max Rego Memory Bytes", fmt.Sprintf("%d", limits.GetRuleEvaluation().GetMaxRegoMemoryBytes()))
+		t.AddRow("Max Data Source Calls", fmt.Sprintf("%d", limits.GetRuleEvaluation().GetMaxDataSourceCalls()))
+		t.AddRow("Max Evaluation History Page Size", fmt.Sprintf("%d", limits.GetMaxEvaluationHistoryPageSize()))
+		t.AddRow("Max Share Link Expiration", limits.GetMaxShareLinkExpiration().AsDuration().String())
+		t.Render()
+	}
+
+	return nil
+}
+
 func init() {
 	app.RootCmd.AddCommand(VersionCmd)
+	VersionCmd.Flags().Bool("server", false, "Also print the version and capabilities of the server")
+	VersionCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format of the server capabilities (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
 }
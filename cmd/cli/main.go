@@ -15,9 +15,14 @@ import (
 	_ "github.com/mindersec/minder/cmd/cli/app/entity"
 	_ "github.com/mindersec/minder/cmd/cli/app/history"
 	_ "github.com/mindersec/minder/cmd/cli/app/profile"
+	_ "github.com/mindersec/minder/cmd/cli/app/profile/exception"
+	_ "github.com/mindersec/minder/cmd/cli/app/profile/rule"
 	_ "github.com/mindersec/minder/cmd/cli/app/profile/status"
 	_ "github.com/mindersec/minder/cmd/cli/app/project"
+	_ "github.com/mindersec/minder/cmd/cli/app/project/deploykey"
+	_ "github.com/mindersec/minder/cmd/cli/app/project/operation"
 	_ "github.com/mindersec/minder/cmd/cli/app/project/role"
+	_ "github.com/mindersec/minder/cmd/cli/app/project/secret"
 	_ "github.com/mindersec/minder/cmd/cli/app/provider"
 	_ "github.com/mindersec/minder/cmd/cli/app/quickstart"
 	_ "github.com/mindersec/minder/cmd/cli/app/repo"
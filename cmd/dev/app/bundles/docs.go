@@ -0,0 +1,307 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundles
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+	"github.com/mindersec/minder/pkg/profiles"
+)
+
+// CmdDocs is the docs command
+func CmdDocs() *cobra.Command {
+	var docsCmd = &cobra.Command{
+		Use:   "docs input",
+		Short: "generate documentation for a mindpak bundle",
+		Args:  cobra.ExactArgs(1),
+		Long: `
+The 'bundle docs' subcommand generates markdown documentation from the rule
+types and profiles of a bundle. The documentation lists the rules with their
+severities, parameters and remediation behavior, and the rules enabled by each
+profile, so that it can be published along with the bundle.
+
+Arguments:
+
+input: Directory containing bundle profiles and rule types
+`,
+		RunE:         docsCmdRun,
+		SilenceUsage: true,
+	}
+
+	docsCmd.Flags().StringP("output", "o", "", "file to write the documentation to, defaults to stdout")
+
+	return docsCmd
+}
+
+func docsCmdRun(cmd *cobra.Command, args []string) error {
+	bundle, err := mindpak.NewBundleFromDirectory(args[0])
+	if err != nil {
+		return err
+	}
+
+	docs, err := bundleDocs(bundle)
+	if err != nil {
+		return err
+	}
+
+	output := cmd.Flag("output").Value.String()
+	if output == "" {
+		_, err = io.WriteString(cmd.OutOrStdout(), docs)
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Clean(output), []byte(docs), 0600); err != nil {
+		return fmt.Errorf("error writing documentation: %w", err)
+	}
+	return nil
+}
+
+// bundleDocs renders the markdown documentation of the bundle
+func bundleDocs(bundle *mindpak.Bundle) (string, error) {
+	bundleReader := reader.NewBundleReader(bundle)
+
+	var ruleTypes []*minderv1.RuleType
+	if err := bundleReader.ForEachRuleType(func(rt *minderv1.RuleType) error {
+		ruleTypes = append(ruleTypes, rt)
+		return nil
+	}); err != nil {
+		return "", err
+	}
+	slices.SortFunc(ruleTypes, func(a, b *minderv1.RuleType) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	profileList := make([]*minderv1.Profile, 0, len(bundle.Files.Profiles))
+	for _, file := range bundle.Files.Profiles {
+		profile, err := bundleReader.GetProfile(file.Name)
+		if err != nil {
+			return "", err
+		}
+		profileList = append(profileList, profile)
+	}
+	slices.SortFunc(profileList, func(a, b *minderv1.Profile) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	var sb strings.Builder
+	sb.WriteString("# " + bundleTitle(bundleReader.GetMetadata()) + "\n")
+
+	if len(ruleTypes) > 0 {
+		writeRuleTypes(&sb, ruleTypes)
+	}
+
+	if len(profileList) > 0 {
+		severities := make(map[string]string, len(ruleTypes))
+		for _, rt := range ruleTypes {
+			severities[rt.GetName()] = rt.GetSeverity().InitializedStringValue()
+		}
+		if err := writeProfiles(&sb, profileList, severities); err != nil {
+			return "", err
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func bundleTitle(metadata *mindpak.Metadata) string {
+	if metadata == nil || metadata.Name == "" {
+		return "Bundle documentation"
+	}
+
+	title := metadata.Name
+	if metadata.Namespace != "" {
+		title = metadata.Namespace + "/" + title
+	}
+	if metadata.Version != "" {
+		title += "@" + metadata.Version
+	}
+	return title
+}
+
+func writeRuleTypes(sb *strings.Builder, ruleTypes []*minderv1.RuleType) {
+	sb.WriteString("\n## Rule types\n\n")
+	sb.WriteString("| Rule type | Entity | Severity | Remediation | Alert |\n")
+	sb.WriteString("|---|---|---|---|---|\n")
+	for _, rt := range ruleTypes {
+		fmt.Fprintf(sb, "| [%s](#%s) | %s | %s | %s | %s |\n",
+			cell(rt.GetName()),
+			anchor(rt.GetName()),
+			cell(rt.GetDef().GetInEntity()),
+			rt.GetSeverity().InitializedStringValue(),
+			cell(orNone(rt.GetDef().GetRemediate().GetType())),
+			cell(orNone(rt.GetDef().GetAlert().GetType())),
+		)
+	}
+
+	for _, rt := range ruleTypes {
+		fmt.Fprintf(sb, "\n### %s\n", rt.GetName())
+		if rt.GetDisplayName() != "" {
+			fmt.Fprintf(sb, "\n**%s**\n", rt.GetDisplayName())
+		}
+		if desc := strings.TrimSpace(rt.GetDescription()); desc != "" {
+			sb.WriteString("\n" + desc + "\n")
+		}
+
+		sb.WriteString("\n")
+		fmt.Fprintf(sb, "- Entity: %s\n", rt.GetDef().GetInEntity())
+		fmt.Fprintf(sb, "- Severity: %s\n", rt.GetSeverity().InitializedStringValue())
+		fmt.Fprintf(sb, "- Remediation: %s\n", orNone(rt.GetDef().GetRemediate().GetType()))
+		fmt.Fprintf(sb, "- Alert: %s\n", orNone(rt.GetDef().GetAlert().GetType()))
+
+		writeSchema(sb, "Rule definition", rt.GetDef().GetRuleSchema())
+		writeSchema(sb, "Rule parameters", rt.GetDef().GetParamSchema())
+
+		if guidance := strings.TrimSpace(rt.GetGuidance()); guidance != "" {
+			sb.WriteString("\n#### Guidance\n\n" + guidance + "\n")
+		}
+	}
+}
+
+// writeSchema renders the properties of a JSON schema as a table
+func writeSchema(sb *strings.Builder, title string, schema *structpb.Struct) {
+	properties := schema.GetFields()["properties"].GetStructValue().GetFields()
+	if len(properties) == 0 {
+		return
+	}
+
+	required := map[string]bool{}
+	for _, r := range schema.GetFields()["required"].GetListValue().GetValues() {
+		required[r.GetStringValue()] = true
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	fmt.Fprintf(sb, "\n#### %s\n\n", title)
+	sb.WriteString("| Name | Type | Required | Default | Description |\n")
+	sb.WriteString("|---|---|---|---|---|\n")
+	for _, name := range names {
+		prop := properties[name].GetStructValue().GetFields()
+		def := ""
+		if v, ok := prop["default"]; ok {
+			def = "`" + compactJSON(v) + "`"
+		}
+		fmt.Fprintf(sb, "| %s | %s | %s | %s | %s |\n",
+			cell(name),
+			cell(prop["type"].GetStringValue()),
+			yesNo(required[name]),
+			cell(def),
+			cell(prop["description"].GetStringValue()),
+		)
+	}
+}
+
+func writeProfiles(sb *strings.Builder, profileList []*minderv1.Profile, severities map[string]string) error {
+	sb.WriteString("\n## Profiles\n")
+
+	for _, p := range profileList {
+		fmt.Fprintf(sb, "\n### %s\n", p.GetName())
+		if p.GetDisplayName() != "" {
+			fmt.Fprintf(sb, "\n**%s**\n", p.GetDisplayName())
+		}
+
+		sb.WriteString("\n")
+		fmt.Fprintf(sb, "- Remediation: %s\n", actionState(p.GetRemediate(), "off"))
+		fmt.Fprintf(sb, "- Alerts: %s\n", actionState(p.GetAlert(), "on"))
+
+		rules := map[minderv1.Entity][]*minderv1.Profile_Rule{}
+		if err := profiles.TraverseRuleTypesForEntities(p, func(entity minderv1.Entity, rule *minderv1.Profile_Rule) error {
+			rules[entity] = append(rules[entity], rule)
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		entities := make([]minderv1.Entity, 0, len(rules))
+		for entity := range rules {
+			entities = append(entities, entity)
+		}
+		slices.Sort(entities)
+
+		for _, entity := range entities {
+			fmt.Fprintf(sb, "\n#### %s rules\n\n", entity.ToString())
+			sb.WriteString("| Rule type | Name | Severity | Definition | Parameters |\n")
+			sb.WriteString("|---|---|---|---|---|\n")
+			for _, rule := range rules[entity] {
+				severity, ok := severities[rule.GetType()]
+				if !ok {
+					severity = "unknown"
+				}
+				fmt.Fprintf(sb, "| %s | %s | %s | %s | %s |\n",
+					cell(rule.GetType()),
+					cell(rule.GetName()),
+					severity,
+					structCell(rule.GetDef()),
+					structCell(rule.GetParams()),
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// actionState describes a profile action setting, which defaults to def
+// when not set
+func actionState(value, def string) string {
+	if value == "" {
+		return def + " (default)"
+	}
+	return value
+}
+
+func structCell(s *structpb.Struct) string {
+	if len(s.GetFields()) == 0 {
+		return ""
+	}
+	return "`" + cell(compactJSON(structpb.NewStructValue(s))) + "`"
+}
+
+func compactJSON(v *structpb.Value) string {
+	out, err := json.Marshal(v.AsInterface())
+	if err != nil {
+		return ""
+	}
+	return string(out)
+}
+
+// cell escapes a string so that it fits in a single markdown table cell
+func cell(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+// anchor returns the markdown heading anchor of a rule type
+func anchor(name string) string {
+	return strings.ToLower(name)
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/mindpak"
+)
+
+func TestBundleDocs(t *testing.T) {
+	t.Parallel()
+
+	bundle, err := mindpak.NewBundleFromDirectory("../../../../pkg/mindpak/testdata/t1")
+	require.NoError(t, err)
+
+	docs, err := bundleDocs(bundle)
+	require.NoError(t, err)
+
+	require.Contains(t, docs, "# Bundle documentation\n")
+	require.Contains(t, docs, "| [secret_scanning](#secret_scanning) | repository | unknown | rest | security_advisory |\n")
+	require.Contains(t, docs, "#### Rule definition\n")
+	require.Contains(t, docs, "| skip_private_repos | boolean | no | `true` | "+
+		"If true, this rule will be marked as skipped for private repositories |\n")
+	require.Contains(t, docs, "#### Guidance\n")
+
+	require.Contains(t, docs, "### branch-protection-github-profile\n")
+	require.Contains(t, docs, "- Remediation: off\n- Alerts: off\n")
+	require.Contains(t, docs, "#### repository rules\n")
+	require.Contains(t, docs, "| branch_protection_allow_deletions |  | unknown | "+
+		"`{\"allow_deletions\":false}` | `{\"branch\":\"\"}` |\n")
+}
+
+func TestCell(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, `a \| b c`, cell("a | b\n  c"))
+}
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fixtures provides the command serving canned provider API
+// responses, to test rule types against deterministic fixtures
+package fixtures
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// CmdServeFixtures is the command serving fixtures
+func CmdServeFixtures() *cobra.Command {
+	var serveCmd = &cobra.Command{
+		Use:   "serve-fixtures",
+		Short: "serve canned provider API responses over localhost",
+		Long: `The 'serve-fixtures' subcommand serves canned provider API responses read
+from the YAML fixture files of a directory, so that rule types can be tested
+against deterministic responses, e.g. in CI, by pointing the provider
+endpoint at the server.
+
+Each fixture file holds a list of fixtures, each matching a request and
+giving the response to serve:
+
+  - request:
+      method: GET
+      path: /repos/*/minder/branches/main/protection
+      query:
+        per_page: "100"
+    response:
+      status: 200
+      headers:
+        X-RateLimit-Remaining: "5000"
+      body:
+        required_linear_history:
+          enabled: true
+
+The request method defaults to GET. The path is matched as a shell pattern,
+and every query parameter given must be set to the given value. Requests are
+served the first matching fixture, in the lexical order of the files and
+then in the order of the file, and are answered with a 404 status if none
+matches. The body is served as JSON, unless it is a string; alternatively,
+body_file names a file, relative to the fixture file, holding the body.
+
+To test a rule type against the fixtures of a GitHub provider, pass a
+provider configuration setting the endpoint to 'ruletype test':
+
+  github_app:
+    endpoint: http://127.0.0.1:8089/`,
+		RunE:         serveFixturesCmdRun,
+		SilenceUsage: true,
+	}
+
+	serveCmd.Flags().StringP("dir", "d", "", "directory holding the fixture files")
+	serveCmd.Flags().String("addr", "127.0.0.1:8089", "address to serve the fixtures on")
+
+	if err := serveCmd.MarkFlagRequired("dir"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+		os.Exit(1)
+	}
+
+	return serveCmd
+}
+
+func serveFixturesCmdRun(cmd *cobra.Command, _ []string) error {
+	dir := cmd.Flag("dir").Value.String()
+	addr := cmd.Flag("addr").Value.String()
+
+	fixtures, err := loadFixtures(dir)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer cancel()
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %w", addr, err)
+	}
+
+	srv := &http.Server{
+		Handler:           newFixtureHandler(fixtures, cmd.ErrOrStderr()),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	cmd.Printf("Serving %d fixtures on http://%s/\n", len(fixtures), lis.Addr())
+	if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("error serving fixtures: %w", err)
+	}
+	return nil
+}
+
+// fixture is a canned response and the requests it is served to
+type fixture struct {
+	Request struct {
+		Method string            `yaml:"method"`
+		Path   string            `yaml:"path"`
+		Query  map[string]string `yaml:"query"`
+	} `yaml:"request"`
+	Response struct {
+		Status   int               `yaml:"status"`
+		Headers  map[string]string `yaml:"headers"`
+		Body     any               `yaml:"body"`
+		BodyFile string            `yaml:"body_file"`
+	} `yaml:"response"`
+
+	// source locates the fixture in the fixture files, for logging
+	source string
+	// body is the response body to serve
+	body []byte
+}
+
+// loadFixtures reads the fixtures of the YAML files of a directory, in the
+// lexical order of the files.
+func loadFixtures(dir string) ([]*fixture, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading fixture directory: %w", err)
+	}
+
+	var fixtures []*fixture
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		fileFixtures, err := loadFixtureFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		fixtures = append(fixtures, fileFixtures...)
+	}
+
+	return fixtures, nil
+}
+
+func loadFixtureFile(fpath string) ([]*fixture, error) {
+	content, err := os.ReadFile(filepath.Clean(fpath))
+	if err != nil {
+		return nil, fmt.Errorf("error reading fixture file: %w", err)
+	}
+
+	var fixtures []*fixture
+	if err := yaml.Unmarshal(content, &fixtures); err != nil {
+		return nil, fmt.Errorf("error parsing fixture file %s: %w", fpath, err)
+	}
+
+	for i, f := range fixtures {
+		f.source = fmt.Sprintf("%s[%d]", filepath.Base(fpath), i)
+		if err := f.prepare(filepath.Dir(fpath)); err != nil {
+			return nil, fmt.Errorf("invalid fixture %s: %w", f.source, err)
+		}
+	}
+
+	return fixtures, nil
+}
+
+// prepare validates the fixture, sets its defaults and encodes its body
+func (f *fixture) prepare(dir string) error {
+	if f.Request.Path == "" {
+		return errors.New("request path is required")
+	}
+	if _, err := path.Match(f.Request.Path, "/"); err != nil {
+		return fmt.Errorf("invalid request path: %w", err)
+	}
+	if f.Request.Method == "" {
+		f.Request.Method = http.MethodGet
+	}
+	f.Request.Method = strings.ToUpper(f.Request.Method)
+	if f.Response.Status == 0 {
+		f.Response.Status = http.StatusOK
+	}
+
+	switch {
+	case f.Response.BodyFile != "" && f.Response.Body != nil:
+		return errors.New("only one of body and body_file may be set")
+	case f.Response.BodyFile != "":
+		body, err := os.ReadFile(filepath.Join(dir, filepath.Clean(f.Response.BodyFile)))
+		if err != nil {
+			return fmt.Errorf("error reading body file: %w", err)
+		}
+		f.body = body
+	case f.Response.Body == nil:
+	default:
+		if s, ok := f.Response.Body.(string); ok {
+			f.body = []byte(s)
+			break
+		}
+		body, err := json.Marshal(f.Response.Body)
+		if err != nil {
+			return fmt.Errorf("error encoding body: %w", err)
+		}
+		f.body = body
+		if _, ok := f.Response.Headers["Content-Type"]; !ok {
+			if f.Response.Headers == nil {
+				f.Response.Headers = map[string]string{}
+			}
+			f.Response.Headers["Content-Type"] = "application/json"
+		}
+	}
+
+	return nil
+}
+
+// matches returns whether the fixture is served to the request
+func (f *fixture) matches(r *http.Request) bool {
+	if f.Request.Method != r.Method {
+		return false
+	}
+	if ok, _ := path.Match(f.Request.Path, r.URL.Path); !ok {
+		return false
+	}
+	query := r.URL.Query()
+	for key, value := range f.Request.Query {
+		if !slices.Contains(query[key], value) {
+			return false
+		}
+	}
+	return true
+}
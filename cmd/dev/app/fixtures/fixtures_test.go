@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fixtures
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const repoFixtures = `
+- request:
+    path: /repos/*/minder/branches/main/protection
+  response:
+    body:
+      required_linear_history:
+        enabled: true
+- request:
+    path: /repos/stacklok/minder/contents/*
+    query:
+      ref: main
+  response:
+    body_file: readme.json
+- request:
+    method: post
+    path: /repos/stacklok/minder/issues
+  response:
+    status: 201
+    headers:
+      Content-Type: text/plain
+    body: created
+`
+
+const fallbackFixtures = `
+- request:
+    path: /repos/stacklok/minder/branches/main/protection
+  response:
+    status: 500
+`
+
+func TestFixtureHandler(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a_repo.yaml"), []byte(repoFixtures), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b_fallback.yml"), []byte(fallbackFixtures), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "readme.json"), []byte(`{"name":"README.md"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not a fixture"), 0o600))
+
+	fixtures, err := loadFixtures(dir)
+	require.NoError(t, err)
+	require.Len(t, fixtures, 4)
+
+	srv := httptest.NewServer(newFixtureHandler(fixtures, io.Discard))
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		wantStatus  int
+		wantType    string
+		wantBody    string
+		wantPartial bool
+	}{
+		{
+			name:       "first matching fixture is served",
+			method:     http.MethodGet,
+			path:       "/repos/stacklok/minder/branches/main/protection",
+			wantStatus: http.StatusOK,
+			wantType:   "application/json",
+			wantBody:   `{"required_linear_history":{"enabled":true}}`,
+		},
+		{
+			name:       "body read from a file",
+			method:     http.MethodGet,
+			path:       "/repos/stacklok/minder/contents/README.md?ref=main",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"name":"README.md"}`,
+		},
+		{
+			name:        "query parameters must match",
+			method:      http.MethodGet,
+			path:        "/repos/stacklok/minder/contents/README.md?ref=other",
+			wantStatus:  http.StatusNotFound,
+			wantType:    "application/json",
+			wantBody:    "no fixture matches",
+			wantPartial: true,
+		},
+		{
+			name:       "method and string body",
+			method:     http.MethodPost,
+			path:       "/repos/stacklok/minder/issues",
+			wantStatus: http.StatusCreated,
+			wantType:   "text/plain",
+			wantBody:   "created",
+		},
+		{
+			name:        "method must match",
+			method:      http.MethodGet,
+			path:        "/repos/stacklok/minder/issues",
+			wantStatus:  http.StatusNotFound,
+			wantBody:    "no fixture matches GET /repos/stacklok/minder/issues",
+			wantPartial: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequest(tt.method, srv.URL+tt.path, nil)
+			require.NoError(t, err)
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.wantStatus, resp.StatusCode)
+			if tt.wantType != "" {
+				require.Equal(t, tt.wantType, resp.Header.Get("Content-Type"))
+			}
+			if tt.wantPartial {
+				require.Contains(t, string(body), tt.wantBody)
+			} else {
+				require.Equal(t, tt.wantBody, string(body))
+			}
+		})
+	}
+}
+
+func TestLoadFixturesRejectsInvalidFixtures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		fixtures string
+	}{
+		{
+			name:     "missing path",
+			fixtures: "- response:\n    status: 200\n",
+		},
+		{
+			name:     "malformed path pattern",
+			fixtures: "- request:\n    path: /repos/[\n",
+		},
+		{
+			name:     "body and body file",
+			fixtures: "- request:\n    path: /\n  response:\n    body: a\n    body_file: a.json\n",
+		},
+		{
+			name:     "missing body file",
+			fixtures: "- request:\n    path: /\n  response:\n    body_file: missing.json\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "fixtures.yaml"), []byte(tt.fixtures), 0o600))
+
+			_, err := loadFixtures(dir)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// fixtureHandler serves the first fixture matching each request
+type fixtureHandler struct {
+	fixtures []*fixture
+	log      io.Writer
+}
+
+func newFixtureHandler(fixtures []*fixture, log io.Writer) *fixtureHandler {
+	return &fixtureHandler{
+		fixtures: fixtures,
+		log:      log,
+	}
+}
+
+func (h *fixtureHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	for _, f := range h.fixtures {
+		if !f.matches(r) {
+			continue
+		}
+
+		fmt.Fprintf(h.log, "%s %s: %s\n", r.Method, r.URL.RequestURI(), f.source)
+		for key, value := range f.Response.Headers {
+			w.Header().Set(key, value)
+		}
+		w.WriteHeader(f.Response.Status)
+		_, _ = w.Write(f.body)
+		return
+	}
+
+	fmt.Fprintf(h.log, "%s %s: no matching fixture\n", r.Method, r.URL.RequestURI())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"message": fmt.Sprintf("no fixture matches %s %s", r.Method, r.URL.Path),
+	})
+}
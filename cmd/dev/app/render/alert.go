@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
+)
+
+const builtinWording = "<built-in>"
+
+// cmdRenderAlert is the command previewing the alert templates of a project
+func cmdRenderAlert() *cobra.Command {
+	var alertCmd = &cobra.Command{
+		Use:   "alert",
+		Short: "preview the alert templates of a project",
+		Long: `The 'alert' subcommand validates the alert templates set on projects with
+'minder project alert-templates', and renders them with sample data.
+
+The sample data can be overridden with a YAML or JSON file, e.g. to check how
+the templates render with a long guidance.`,
+		RunE:         renderAlertCmdRun,
+		SilenceUsage: true,
+	}
+
+	alertCmd.Flags().StringP("file", "f", "", "YAML file with the alert templates")
+	alertCmd.Flags().StringP("data", "d", "", "YAML or JSON file overriding the sample data")
+	alertCmd.Flags().String("type", "", "the alert type to render (security_advisory or pull_request_comment), all by default")
+
+	if err := alertCmd.MarkFlagRequired("file"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+		os.Exit(1)
+	}
+
+	return alertCmd
+}
+
+func renderAlertCmdRun(cmd *cobra.Command, _ []string) error {
+	tmplFile := cmd.Flag("file").Value.String()
+	dataFile := cmd.Flag("data").Value.String()
+	alertType := cmd.Flag("type").Value.String()
+
+	content, err := os.ReadFile(filepath.Clean(tmplFile))
+	if err != nil {
+		return fmt.Errorf("error reading alert templates: %w", err)
+	}
+	tmpls, err := templates.Parse(content)
+	if err != nil {
+		return err
+	}
+	if err := tmpls.Validate(); err != nil {
+		return err
+	}
+
+	var data []byte
+	if dataFile != "" {
+		data, err = os.ReadFile(filepath.Clean(dataFile))
+		if err != nil {
+			return fmt.Errorf("error reading data: %w", err)
+		}
+	}
+
+	out, err := renderAlert(tmpls, alertType, data)
+	if err != nil {
+		return err
+	}
+
+	cmd.Print(out)
+	return nil
+}
+
+func renderAlert(tmpls *templates.Templates, alertType string, data []byte) (string, error) {
+	ctx := context.Background()
+	var out strings.Builder
+
+	if sa := tmpls.SecurityAdvisory; sa != nil && (alertType == "" || alertType == "security_advisory") {
+		saData := templates.SampleSecurityAdvisoryData()
+		if err := overrideSampleData(data, &saData); err != nil {
+			return "", err
+		}
+		title, body, err := sa.Render(ctx, saData, builtinWording, builtinWording)
+		if err != nil {
+			return "", fmt.Errorf("error rendering security advisory: %w", err)
+		}
+		fmt.Fprintf(&out, "# security_advisory\n\n## Title\n\n%s\n\n## Body\n\n%s\n\n", title, body)
+	}
+	if prc := tmpls.PullRequestComment; prc != nil && (alertType == "" || alertType == "pull_request_comment") {
+		prData := templates.SamplePullRequestCommentData()
+		if err := overrideSampleData(data, &prData); err != nil {
+			return "", err
+		}
+		body, err := prc.Render(ctx, prData)
+		if err != nil {
+			return "", fmt.Errorf("error rendering pull request comment: %w", err)
+		}
+		fmt.Fprintf(&out, "# pull_request_comment\n\n## Body\n\n%s\n\n", body)
+	}
+
+	if out.Len() == 0 {
+		return "", fmt.Errorf("no template set for alert type %q", alertType)
+	}
+	return out.String(), nil
+}
+
+// overrideSampleData overrides the fields of the sample data set in the data
+// file, whose keys are the field names, e.g. Guidance
+func overrideSampleData(data []byte, sample any) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	// YAML is a superset of JSON, so this handles both formats, and going
+	// through JSON matches the keys to the field names
+	fields := map[string]any{}
+	if err := yaml.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("error parsing data: %w", err)
+	}
+	asJSON, err := json.Marshal(fields)
+	if err != nil {
+		return fmt.Errorf("error parsing data: %w", err)
+	}
+	if err := json.Unmarshal(asJSON, sample); err != nil {
+		return fmt.Errorf("error parsing data: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package render provides the root command for rendering templates
+package render
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mindersec/minder/internal/util"
+)
+
+// maxRenderSize mirrors the limits applied when rendering remediation content
+const maxRenderSize = 1 << 20
+
+// CmdRender is the root command for the render subcommand
+func CmdRender() *cobra.Command {
+	var renderCmd = &cobra.Command{
+		Use:   "render",
+		Short: "render a remediation or alert template",
+		Long: `The 'render' subcommand renders a template the same way Minder renders
+remediation and alert content, with the same function library available.
+This allows testing complex templates without running a full evaluation.
+
+The data passed to the template is read from a YAML or JSON file.`,
+		RunE:         renderCmdRun,
+		SilenceUsage: true,
+	}
+
+	renderCmd.Flags().StringP("template", "t", "", "file containing the template to render")
+	renderCmd.Flags().StringP("data", "d", "", "YAML or JSON file with the data passed to the template")
+	renderCmd.Flags().Bool("html", false, "render as an HTML template, as done for pull request and issue bodies")
+
+	if err := renderCmd.MarkFlagRequired("template"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+		os.Exit(1)
+	}
+
+	renderCmd.AddCommand(cmdRenderAlert())
+
+	return renderCmd
+}
+
+func renderCmdRun(cmd *cobra.Command, _ []string) error {
+	tmplFile := cmd.Flag("template").Value.String()
+	dataFile := cmd.Flag("data").Value.String()
+	asHTML, err := cmd.Flags().GetBool("html")
+	if err != nil {
+		return fmt.Errorf("error reading html flag: %w", err)
+	}
+
+	tmplContent, err := os.ReadFile(filepath.Clean(tmplFile))
+	if err != nil {
+		return fmt.Errorf("error reading template: %w", err)
+	}
+
+	data := map[string]any{}
+	if dataFile != "" {
+		dataContent, err := os.ReadFile(filepath.Clean(dataFile))
+		if err != nil {
+			return fmt.Errorf("error reading data: %w", err)
+		}
+		// YAML is a superset of JSON, so this handles both formats
+		if err := yaml.Unmarshal(dataContent, &data); err != nil {
+			return fmt.Errorf("error parsing data: %w", err)
+		}
+	}
+
+	out, err := renderTemplate(string(tmplContent), data, asHTML)
+	if err != nil {
+		return err
+	}
+
+	cmd.Print(out)
+	return nil
+}
+
+func renderTemplate(tmplContent string, data map[string]any, asHTML bool) (string, error) {
+	newTemplate := util.NewSafeTextTemplate
+	if asHTML {
+		newTemplate = util.NewSafeHTMLTemplate
+	}
+
+	tmpl, err := newTemplate(&tmplContent, "render")
+	if err != nil {
+		return "", fmt.Errorf("error parsing template: %w", err)
+	}
+
+	out, err := tmpl.Render(context.Background(), data, maxRenderSize)
+	if err != nil {
+		return "", fmt.Errorf("error rendering template: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	t.Parallel()
+
+	data := map[string]any{
+		"ecosystems": []any{"gomod", "npm"},
+	}
+
+	out, err := renderTemplate(`{{ range .ecosystems }}- {{ upper . }}
+{{ end }}`, data, false)
+	require.NoError(t, err)
+	require.Equal(t, "- GOMOD\n- NPM\n", out)
+
+	out, err = renderTemplate(`<b>{{ index .ecosystems 0 }}</b>`, map[string]any{
+		"ecosystems": []any{"<script>"},
+	}, true)
+	require.NoError(t, err)
+	require.Equal(t, "<b>&lt;script&gt;</b>", out)
+
+	_, err = renderTemplate(`{{ .missing }}`, data, false)
+	require.Error(t, err)
+}
+
+func TestRenderAlert(t *testing.T) {
+	t.Parallel()
+
+	tmpls := &templates.Templates{
+		SecurityAdvisory: &templates.SecurityAdvisory{
+			Title:  "{{.Rule}} failed on {{.Repository}}",
+			Labels: []string{"triage"},
+		},
+		PullRequestComment: &templates.PullRequestComment{Body: "{{.Message}}"},
+	}
+
+	out, err := renderAlert(tmpls, "security_advisory", []byte(`Repository: acme/gadgets`))
+	require.NoError(t, err)
+	require.Equal(t, `# security_advisory
+
+## Title
+
+[triage] secret_scanning failed on acme/gadgets
+
+## Body
+
+<built-in>
+
+`, out)
+
+	out, err = renderAlert(tmpls, "", nil)
+	require.NoError(t, err)
+	require.Contains(t, out, "# security_advisory")
+	require.Contains(t, out, "# pull_request_comment")
+
+	_, err = renderAlert(&templates.Templates{}, "pull_request_comment", nil)
+	require.Error(t, err)
+}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/mindersec/minder/cmd/dev/app/bundles"
 	"github.com/mindersec/minder/cmd/dev/app/datasource"
+	"github.com/mindersec/minder/cmd/dev/app/fixtures"
 	"github.com/mindersec/minder/cmd/dev/app/image"
+	"github.com/mindersec/minder/cmd/dev/app/render"
 	"github.com/mindersec/minder/cmd/dev/app/rule_type"
 	"github.com/mindersec/minder/cmd/dev/app/test"
 	"github.com/mindersec/minder/cmd/dev/app/testserver"
@@ -32,6 +34,8 @@ https://mindersec.github.io/`,
 	cmd.AddCommand(testserver.CmdTestServer())
 	cmd.AddCommand(bundles.CmdBundle())
 	cmd.AddCommand(datasource.CmdDataSource())
+	cmd.AddCommand(render.CmdRender())
+	cmd.AddCommand(fixtures.CmdServeFixtures())
 
 	return cmd
 }
@@ -25,6 +25,7 @@ import (
 	engif "github.com/mindersec/minder/internal/engine/interfaces"
 	"github.com/mindersec/minder/internal/engine/options"
 	entModels "github.com/mindersec/minder/internal/entities/models"
+	"github.com/mindersec/minder/internal/providers/azuredevops"
 	"github.com/mindersec/minder/internal/providers/credentials"
 	"github.com/mindersec/minder/internal/providers/dockerhub"
 	"github.com/mindersec/minder/internal/providers/github/clients"
@@ -417,8 +418,17 @@ func getProvider(pstr string, token string, providerConfigFile string) (provifv1
 
 	switch pstr {
 	case "github":
+		appCfg := &minderv1.GitHubAppProviderConfig{}
+		if len(cfgbytes) > 0 {
+			// the provider config may point the provider at another endpoint,
+			// such as the one of `mindev serve-fixtures`
+			if _, appCfg, err = clients.ParseAndMergeV1AppConfig(cfgbytes); err != nil {
+				return nil, fmt.Errorf("error parsing github provider config: %w", err)
+			}
+		}
+
 		client, err := clients.NewGitHubAppProvider(
-			&minderv1.GitHubAppProviderConfig{},
+			appCfg,
 			&serverconfig.ProviderConfig{
 				GitHubApp: &serverconfig.GitHubAppConfig{AppName: "test"},
 			},
@@ -461,6 +471,19 @@ func getProvider(pstr string, token string, providerConfigFile string) (provifv1
 			return nil, fmt.Errorf("error instantiating gitlab provider: %w", err)
 		}
 		return client, nil
+	case "azure-devops":
+		// read provider config
+		cfg, err := azuredevops.ParseV1Config(cfgbytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing azure devops provider config: %w", err)
+		}
+
+		// We may pass a "fake" webhook URL here as it is not used in the test
+		client, err := azuredevops.New(credentials.NewAzureDevOpsTokenCredential(token), cfg, "fake", "fake")
+		if err != nil {
+			return nil, fmt.Errorf("error instantiating azure devops provider: %w", err)
+		}
+		return client, nil
 	default:
 		return nil, fmt.Errorf("unknown or unsupported provider: %s", pstr)
 	}
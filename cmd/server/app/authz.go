@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/authz"
+	"github.com/mindersec/minder/pkg/config"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// authzCmd represents the authz command
+var authzCmd = &cobra.Command{
+	Use:   "authz",
+	Short: "Authorization model tool",
+	Long: `Compare the authorization model deployed to OpenFGA with the one of this
+version of Minder, and update it after verifying the results of the checks.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+// newModelMigrator reads the configuration and returns the migrator of the
+// authorization model of the configured store
+func newModelMigrator(cmd *cobra.Command) (context.Context, *authz.ModelMigrator) {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		cliErrorf(cmd, "error binding flags: %s\n", err)
+	}
+	cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
+	if err != nil {
+		cliErrorf(cmd, "unable to read config: %s\n", err)
+	}
+
+	if cfg.Authz.Embedded.Enabled {
+		cliErrorf(cmd, "The embedded authorization server writes the model of this version on startup\n")
+	}
+
+	ctx := serverconfig.LoggerFromConfigFlags(cfg.LoggingConfig).WithContext(context.Background())
+	m, err := authz.NewModelMigrator(ctx, &cfg.Authz, zerolog.Ctx(ctx))
+	if err != nil {
+		cliErrorf(cmd, "unable to create authz model migrator: %s\n", err)
+	}
+	return ctx, m
+}
+
+// printModelPlan prints the changes between the deployed and the new model
+func printModelPlan(cmd *cobra.Command, plan *authz.ModelPlan) {
+	switch {
+	case plan.DeployedModelID == "":
+		cmd.Println("No authorization model deployed yet")
+	case plan.UpToDate():
+		cmd.Printf("Deployed authorization model %s is up to date\n", plan.DeployedModelID)
+		return
+	default:
+		cmd.Printf("Deployed authorization model %s differs:\n", plan.DeployedModelID)
+	}
+	for _, c := range plan.Changes {
+		cmd.Printf("  %s\n", c)
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(authzCmd)
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/authz"
+)
+
+// maxPrintedMismatches is the number of mismatched checks listed by apply
+const maxPrintedMismatches = 50
+
+// authzApplyCmd represents the `authz apply` command
+var authzApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Update the deployed authorization model",
+	Long: `Writes the authorization model of this version of Minder to OpenFGA if it
+differs from the deployed one, then shadow-tests it: the checks of the users on
+the projects they have a role in, and on their sub-projects, are run with both
+models for up to --sample-size stored relationships.
+
+If any check has a different result, the deployed model is written again so
+that it remains the latest one of the store, and the command fails, unless
+--accept-mismatches is set.
+
+Servers switch to the latest model when they start. Servers pinning a model
+with authz.model_id switch once it is set to the new model.`,
+	RunE: authzApplyCommand,
+}
+
+func authzApplyCommand(cmd *cobra.Command, _ []string) error {
+	ctx, m := newModelMigrator(cmd)
+
+	plan, err := m.Plan(ctx)
+	if err != nil {
+		cliErrorf(cmd, "error while planning the authz model: %s\n", err)
+	}
+	printModelPlan(cmd, plan)
+	if plan.UpToDate() {
+		return nil
+	}
+
+	if !confirm(cmd, "Running this command will change the authorization model") {
+		return nil
+	}
+
+	res, err := m.Apply(ctx, plan, authz.ApplyOptions{
+		SampleSize:       viper.GetInt("sample-size"),
+		AcceptMismatches: viper.GetBool("accept-mismatches"),
+	})
+	if err != nil {
+		cliErrorf(cmd, "error while applying the authz model: %s\n", err)
+	}
+
+	cmd.Printf("Wrote authorization model %s, compared %d checks with the deployed model\n", res.ModelID, res.Checks)
+	for i, mm := range res.Mismatches {
+		if i == maxPrintedMismatches {
+			cmd.Printf("  ... and %d more\n", len(res.Mismatches)-i)
+			break
+		}
+		cmd.Printf("  %s\n", mm)
+	}
+
+	if res.RolledBack {
+		cliErrorf(cmd, "%d check(s) differ, restored the deployed model %s; "+
+			"run again with --accept-mismatches if the changes are expected\n",
+			len(res.Mismatches), plan.DeployedModelID)
+	}
+	cmd.Printf("Authorization model %s is the latest model of the store\n", res.ModelID)
+	return nil
+}
+
+func init() {
+	authzCmd.AddCommand(authzApplyCmd)
+	authzApplyCmd.Flags().BoolP("yes", "y", false, "Answer yes to all questions")
+	authzApplyCmd.Flags().Int("sample-size", 1000, "Maximum number of stored relationships to shadow-test")
+	authzApplyCmd.Flags().Bool("accept-mismatches", false, "Switch to the new model even if some checks differ")
+}
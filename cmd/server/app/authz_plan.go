@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// authzPlanCmd represents the `authz plan` command
+var authzPlanCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Show the changes to the deployed authorization model",
+	Long: `Compares the authorization model deployed to OpenFGA, which is the one pinned
+with authz.model_id or else the latest one of the store, with the one of this
+version of Minder, and lists the types and relations added (+), removed (-)
+or changed (~).
+
+With --fail-on-changes, the command exits with a non-zero status if the
+deployed model is not up to date, e.g. to check for drift continuously.`,
+	RunE: authzPlanCommand,
+}
+
+func authzPlanCommand(cmd *cobra.Command, _ []string) error {
+	ctx, m := newModelMigrator(cmd)
+
+	plan, err := m.Plan(ctx)
+	if err != nil {
+		cliErrorf(cmd, "error while planning the authz model: %s\n", err)
+	}
+	printModelPlan(cmd, plan)
+
+	if !plan.UpToDate() && viper.GetBool("fail-on-changes") {
+		cliErrorf(cmd, "The deployed authorization model is not up to date\n")
+	}
+	return nil
+}
+
+func init() {
+	authzCmd.AddCommand(authzPlanCmd)
+	authzPlanCmd.Flags().Bool("fail-on-changes", false, "Exit with a non-zero status if the deployed model is not up to date")
+}
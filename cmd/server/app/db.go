@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// dbCmd groups together the database maintenance commands
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Database maintenance tools",
+	Long:  `Use the db commands to check the health of the Minder database.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(dbCmd)
+}
@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/database"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/pkg/config"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+var dbVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the database schema and data invariants",
+	Long: `Checks that the database is fully migrated, that no rows are orphaned,
+that its enum types match the ones known to this version of Minder and that
+all its indexes are usable. Orphaned rows are deleted when --fix is set,
+the other problems must be fixed by an operator.
+
+The command exits with a non-zero status if any problem remains.`,
+	RunE: dbVerifyCommand,
+}
+
+func dbVerifyCommand(cmd *cobra.Command, _ []string) error {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return fmt.Errorf("error binding flags: %s", err)
+	}
+	cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
+	if err != nil {
+		cliErrorf(cmd, "unable to read config: %s", err)
+	}
+
+	ctx := serverconfig.LoggerFromConfigFlags(cfg.LoggingConfig).WithContext(context.Background())
+
+	dbConn, connString, err := cfg.Database.GetDBConnection(ctx)
+	if err != nil {
+		cliErrorf(cmd, "unable to connect to database: %s", err)
+	}
+	defer dbConn.Close()
+
+	m, err := database.NewFromConnectionString(connString)
+	if err != nil {
+		cliErrorf(cmd, "error while creating migration instance: %s", err)
+	}
+	latest, err := database.LatestVersion()
+	if err != nil {
+		cliErrorf(cmd, "error while reading the migrations: %s", err)
+	}
+
+	problems, err := verifyMigrations(cmd.OutOrStdout(), m, latest)
+	if err != nil {
+		cliErrorf(cmd, "error while verifying the migrations: %s", err)
+	}
+	dataProblems, err := verifyDatabase(ctx, cmd.OutOrStdout(), db.NewStore(dbConn), viper.GetBool("fix"))
+	if err != nil {
+		cliErrorf(cmd, "error while verifying the database: %s", err)
+	}
+	problems += dataProblems
+
+	if problems > 0 {
+		cliErrorf(cmd, "Found %d problem(s)\n", problems)
+	}
+	cmd.Println("No problems found")
+	return nil
+}
+
+// verifyMigrations reports whether the database is cleanly migrated to the
+// latest migration, returning the number of problems found.
+func verifyMigrations(out io.Writer, m database.Migrator, latest uint) (int, error) {
+	version, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		fmt.Fprintf(out, "[FAIL] migrations: no migration applied, latest is %d; run `minder-server migrate up`\n", latest)
+		return 1, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case dirty:
+		fmt.Fprintf(out, "[FAIL] migrations: migration %d failed and left the database dirty; "+
+			"fix the schema manually before migrating again\n", version)
+	case version < latest:
+		fmt.Fprintf(out, "[FAIL] migrations: version %d is behind the latest migration %d; "+
+			"run `minder-server migrate up`\n", version, latest)
+	case version > latest:
+		fmt.Fprintf(out, "[FAIL] migrations: version %d is ahead of the latest migration %d known to this binary\n",
+			version, latest)
+	default:
+		fmt.Fprintf(out, "[OK] migrations: version %d\n", version)
+		return 0, nil
+	}
+	return 1, nil
+}
+
+// verifyDatabase checks the data and the schema of the database, returning
+// the number of problems which remain. Orphaned rows are deleted if fix is
+// set, as nothing can reference them.
+func verifyDatabase(ctx context.Context, out io.Writer, store db.Store, fix bool) (int, error) {
+	problems := 0
+
+	orphans := []struct {
+		name   string
+		count  func(context.Context) (int64, error)
+		delete func(context.Context) (int64, error)
+	}{
+		// rule instances go first, as removing them can orphan evaluations
+		{"rule instances without profile", store.CountOrphanedRuleInstances, store.DeleteOrphanedRuleInstances},
+		{"evaluations without rule instance or entity",
+			store.CountOrphanedEvaluationRuleEntities, store.DeleteOrphanedEvaluationRuleEntities},
+	}
+	for _, o := range orphans {
+		count, err := o.count(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("error counting %s: %w", o.name, err)
+		}
+		if count == 0 {
+			fmt.Fprintf(out, "[OK] %s: none\n", o.name)
+			continue
+		}
+		if !fix {
+			fmt.Fprintf(out, "[FAIL] %s: %d found; rerun with --fix to delete them\n", o.name, count)
+			problems++
+			continue
+		}
+		deleted, err := o.delete(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("error deleting %s: %w", o.name, err)
+		}
+		fmt.Fprintf(out, "[FIXED] %s: %d deleted\n", o.name, deleted)
+	}
+
+	drift, err := enumDrift(ctx, store)
+	if err != nil {
+		return 0, err
+	}
+	if len(drift) == 0 {
+		fmt.Fprintln(out, "[OK] enums: match the schema of this version")
+	}
+	for _, d := range drift {
+		fmt.Fprintf(out, "[FAIL] enums: %s\n", d)
+	}
+	problems += len(drift)
+
+	indexes, err := store.ListInvalidIndexes(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error listing invalid indexes: %w", err)
+	}
+	if len(indexes) == 0 {
+		fmt.Fprintln(out, "[OK] indexes: all valid")
+	}
+	for _, idx := range indexes {
+		fmt.Fprintf(out, "[FAIL] indexes: %s on table %s is invalid; run `REINDEX INDEX CONCURRENTLY %s`\n",
+			idx.IndexName, idx.TableName, idx.IndexName)
+	}
+	problems += len(indexes)
+
+	return problems, nil
+}
+
+// expectedEnums returns the values of the enum types known to this version,
+// keyed by the name of their database type.
+func expectedEnums() map[string][]string {
+	return map[string][]string{
+		"action_type":              enumValues(db.AllActionTypeValues()),
+		"alert_status_types":       enumValues(db.AllAlertStatusTypesValues()),
+		"authorization_flow":       enumValues(db.AllAuthorizationFlowValues()),
+		"entities":                 enumValues(db.AllEntitiesValues()),
+		"eval_error_codes":         enumValues(db.AllEvalErrorCodesValues()),
+		"eval_status_types":        enumValues(db.AllEvalStatusTypesValues()),
+		"migration_phase_state":    enumValues(db.AllMigrationPhaseStateValues()),
+		"pending_operation_state":  enumValues(db.AllPendingOperationStateValues()),
+		"provider_class":           enumValues(db.AllProviderClassValues()),
+		"provider_type":            enumValues(db.AllProviderTypeValues()),
+		"release_status":           enumValues(db.AllReleaseStatusValues()),
+		"remediation_status_types": enumValues(db.AllRemediationStatusTypesValues()),
+		"rule_exception_state":     enumValues(db.AllRuleExceptionStateValues()),
+		"severity":                 enumValues(db.AllSeverityValues()),
+		"visibility":               enumValues(db.AllVisibilityValues()),
+	}
+}
+
+func enumValues[T ~string](values []T) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, string(v))
+	}
+	return out
+}
+
+// enumDrift compares the enum types of the database with the ones known to
+// this version, describing each difference.
+func enumDrift(ctx context.Context, store db.Store) ([]string, error) {
+	rows, err := store.ListEnumValues(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing enum values: %w", err)
+	}
+	actual := make(map[string][]string)
+	for _, row := range rows {
+		actual[row.TypeName] = append(actual[row.TypeName], row.Value)
+	}
+	expected := expectedEnums()
+
+	var drift []string
+	for typeName, values := range expected {
+		dbValues, ok := actual[typeName]
+		if !ok {
+			drift = append(drift, fmt.Sprintf("type %s is missing", typeName))
+			continue
+		}
+		for _, v := range values {
+			if !slices.Contains(dbValues, v) {
+				drift = append(drift, fmt.Sprintf("type %s is missing value %q", typeName, v))
+			}
+		}
+		for _, v := range dbValues {
+			if !slices.Contains(values, v) {
+				drift = append(drift, fmt.Sprintf("type %s has unknown value %q", typeName, v))
+			}
+		}
+	}
+	for typeName := range actual {
+		if _, ok := expected[typeName]; !ok {
+			drift = append(drift, fmt.Sprintf("type %s is unknown", typeName))
+		}
+	}
+	sort.Strings(drift)
+	return drift, nil
+}
+
+func init() {
+	dbCmd.AddCommand(dbVerifyCmd)
+	dbVerifyCmd.Flags().Bool("fix", false, "Delete the orphaned rows found")
+}
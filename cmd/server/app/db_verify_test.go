@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+)
+
+type fakeMigrator struct {
+	version uint
+	dirty   bool
+	err     error
+}
+
+func (*fakeMigrator) Up() error       { return nil }
+func (*fakeMigrator) Down() error     { return nil }
+func (*fakeMigrator) Steps(int) error { return nil }
+func (f *fakeMigrator) Version() (uint, bool, error) {
+	return f.version, f.dirty, f.err
+}
+
+func TestVerifyMigrations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		migrator *fakeMigrator
+		problems int
+		output   string
+	}{
+		{name: "up to date", migrator: &fakeMigrator{version: 10}, output: "[OK] migrations: version 10"},
+		{name: "behind", migrator: &fakeMigrator{version: 9}, problems: 1, output: "behind the latest migration 10"},
+		{name: "dirty", migrator: &fakeMigrator{version: 10, dirty: true}, problems: 1, output: "left the database dirty"},
+		{name: "not migrated", migrator: &fakeMigrator{err: migrate.ErrNilVersion}, problems: 1, output: "no migration applied"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var out bytes.Buffer
+			problems, err := verifyMigrations(&out, tt.migrator, 10)
+			require.NoError(t, err)
+			require.Equal(t, tt.problems, problems)
+			require.Contains(t, out.String(), tt.output)
+		})
+	}
+}
+
+func TestVerifyDatabase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		fix      bool
+		setup    func(store *mockdb.MockStore)
+		problems int
+		output   []string
+	}{
+		{
+			name: "healthy",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().CountOrphanedRuleInstances(gomock.Any()).Return(int64(0), nil)
+				store.EXPECT().CountOrphanedEvaluationRuleEntities(gomock.Any()).Return(int64(0), nil)
+				store.EXPECT().ListEnumValues(gomock.Any()).Return(expectedEnumRows(), nil)
+				store.EXPECT().ListInvalidIndexes(gomock.Any()).Return(nil, nil)
+			},
+			output: []string{"[OK] enums", "[OK] indexes"},
+		},
+		{
+			name: "orphans without fix",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().CountOrphanedRuleInstances(gomock.Any()).Return(int64(2), nil)
+				store.EXPECT().CountOrphanedEvaluationRuleEntities(gomock.Any()).Return(int64(3), nil)
+				store.EXPECT().ListEnumValues(gomock.Any()).Return(expectedEnumRows(), nil)
+				store.EXPECT().ListInvalidIndexes(gomock.Any()).Return(nil, nil)
+			},
+			problems: 2,
+			output:   []string{"rule instances without profile: 2 found", "rerun with --fix"},
+		},
+		{
+			name: "orphans with fix",
+			fix:  true,
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().CountOrphanedRuleInstances(gomock.Any()).Return(int64(2), nil)
+				store.EXPECT().DeleteOrphanedRuleInstances(gomock.Any()).Return(int64(2), nil)
+				store.EXPECT().CountOrphanedEvaluationRuleEntities(gomock.Any()).Return(int64(0), nil)
+				store.EXPECT().ListEnumValues(gomock.Any()).Return(expectedEnumRows(), nil)
+				store.EXPECT().ListInvalidIndexes(gomock.Any()).Return(nil, nil)
+			},
+			output: []string{"[FIXED] rule instances without profile: 2 deleted"},
+		},
+		{
+			name: "enum drift and invalid index",
+			fix:  true,
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().CountOrphanedRuleInstances(gomock.Any()).Return(int64(0), nil)
+				store.EXPECT().CountOrphanedEvaluationRuleEntities(gomock.Any()).Return(int64(0), nil)
+				rows := append(expectedEnumRows(), db.ListEnumValuesRow{TypeName: "severity", Value: "catastrophic"})
+				store.EXPECT().ListEnumValues(gomock.Any()).Return(rows, nil)
+				store.EXPECT().ListInvalidIndexes(gomock.Any()).Return([]db.ListInvalidIndexesRow{
+					{IndexName: "rule_instances_profile_id_idx", TableName: "rule_instances"},
+				}, nil)
+			},
+			problems: 2,
+			output: []string{
+				`type severity has unknown value "catastrophic"`,
+				"REINDEX INDEX CONCURRENTLY rule_instances_profile_id_idx",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+			tt.setup(store)
+
+			var out bytes.Buffer
+			problems, err := verifyDatabase(context.Background(), &out, store, tt.fix)
+			require.NoError(t, err)
+			require.Equal(t, tt.problems, problems)
+			for _, o := range tt.output {
+				require.Contains(t, out.String(), o)
+			}
+		})
+	}
+}
+
+func expectedEnumRows() []db.ListEnumValuesRow {
+	var rows []db.ListEnumValuesRow
+	for typeName, values := range expectedEnums() {
+		for _, v := range values {
+			rows = append(rows, db.ListEnumValuesRow{TypeName: typeName, Value: v})
+		}
+	}
+	return rows
+}
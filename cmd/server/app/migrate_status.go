@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/database"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/pkg/config"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "show the pending migrations",
+	Long: `Command to show the database version and the pending migrations. With
+--wide, it also shows the phases of the expand/contract migrations which didn't
+complete yet.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
+		if err != nil {
+			return fmt.Errorf("unable to read config: %w", err)
+		}
+
+		ctx := serverconfig.LoggerFromConfigFlags(cfg.LoggingConfig).WithContext(context.Background())
+
+		dbConn, connString, err := cfg.Database.GetDBConnection(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to connect to database: %w", err)
+		}
+		defer dbConn.Close()
+
+		m, err := database.NewFromConnectionString(connString)
+		if err != nil {
+			cliErrorf(cmd, "Error while creating migration instance: %v\n", err)
+		}
+		pm, err := database.NewPhasedMigrator(m, dbConn, db.NewStore(dbConn))
+		if err != nil {
+			cliErrorf(cmd, "Error while loading migrations: %v\n", err)
+		}
+		status, err := pm.Status(ctx)
+		if err != nil {
+			cliErrorf(cmd, "Error while getting migration status: %v\n", err)
+		}
+
+		wide, err := cmd.Flags().GetBool("wide")
+		if err != nil {
+			cmd.Printf("Error while getting wide flag: %v", err)
+		}
+		printMigrationStatus(cmd.OutOrStdout(), status, wide)
+		return nil
+	},
+}
+
+func printMigrationStatus(out io.Writer, status database.Status, wide bool) {
+	fmt.Fprintf(out, "Version=%v dirty=%v\n", status.Version, status.Dirty)
+
+	pending := 0
+	for _, m := range status.Migrations {
+		if !m.Applied {
+			pending++
+			kind := "expand"
+			if m.Contract {
+				kind = "contract"
+			}
+			fmt.Fprintf(out, "pending migration %d %s (%s)\n", m.Version, m.Name, kind)
+		}
+		if !wide {
+			continue
+		}
+		for _, p := range m.Phases {
+			if p.State == string(db.MigrationPhaseStateCompleted) {
+				continue
+			}
+			fmt.Fprintf(out, "%s phase %s of migration %d: %s", p.Hook, p.Name, m.Version, p.State)
+			if p.LastError != "" {
+				fmt.Fprintf(out, " (%s)", p.LastError)
+			}
+			fmt.Fprintln(out)
+		}
+	}
+	if pending == 0 {
+		fmt.Fprintln(out, "No pending migrations")
+	}
+}
+
+func init() {
+	migrateCmd.AddCommand(statusCmd)
+	statusCmd.Flags().Bool("wide", false, "Also show the phases which didn't complete")
+}
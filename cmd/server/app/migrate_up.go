@@ -6,10 +6,8 @@ package app
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
-	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres" // nolint
 	_ "github.com/golang-migrate/migrate/v4/source/file"       // nolint
 	"github.com/rs/zerolog"
@@ -18,6 +16,7 @@ import (
 
 	"github.com/mindersec/minder/database"
 	"github.com/mindersec/minder/internal/authz"
+	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/pkg/config"
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
 )
@@ -26,7 +25,12 @@ import (
 var upCmd = &cobra.Command{
 	Use:   "up",
 	Short: "migrate the database to the latest version",
-	Long:  `Command to upgrade database`,
+	Long: `Command to upgrade database. Migrations are applied one at a time, along
+with the phases of the expand/contract migrations attached to them.
+
+Contract migrations remove the parts of the schema which previous migrations
+replaced, so the command stops before them unless --contract is set, which
+should only be done once no running server uses those parts anymore.`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
 		if err != nil {
@@ -57,19 +61,26 @@ var upCmd = &cobra.Command{
 		if err != nil {
 			cmd.Printf("Error while getting num-steps flag: %v", err)
 		}
-
-		if usteps == 0 {
-			err = m.Up()
-		} else {
-			err = m.Steps(int(usteps))
+		contract, err := cmd.Flags().GetBool("contract")
+		if err != nil {
+			cmd.Printf("Error while getting contract flag: %v", err)
 		}
 
+		pm, err := database.NewPhasedMigrator(m, dbConn, db.NewStore(dbConn))
+		if err != nil {
+			cliErrorf(cmd, "Error while loading migrations: %v\n", err)
+		}
+		res, err := pm.Up(ctx, database.UpOptions{Steps: usteps, Contract: contract})
 		if err != nil {
-			if !errors.Is(err, migrate.ErrNoChange) {
-				cliErrorf(cmd, "Error while migrating database: %v\n", err)
-			} else {
-				cmd.Println("Database already up-to-date")
-			}
+			cliErrorf(cmd, "Error while migrating database: %v\n", err)
+		}
+
+		if len(res.Applied) == 0 && res.Blocked == nil {
+			cmd.Println("Database already up-to-date")
+		}
+		if res.Blocked != nil {
+			cmd.Printf("Stopped before contract migration %d (%s), run again with --contract "+
+				"once no running server uses the schema it removes\n", res.Blocked.Version, res.Blocked.Name)
 		}
 
 		cmd.Println("Database migration completed successfully")
@@ -82,6 +93,11 @@ var upCmd = &cobra.Command{
 			cmd.Printf("Version=%v dirty=%v\n", version, dirty)
 		}
 
+		if cfg.Authz.Embedded.Enabled {
+			cmd.Println("Skipping authorization store, the embedded server ensures it on startup")
+			return nil
+		}
+
 		cmd.Println("Ensuring authorization store...")
 		l := zerolog.Ctx(ctx)
 
@@ -104,4 +120,5 @@ var upCmd = &cobra.Command{
 
 func init() {
 	migrateCmd.AddCommand(upCmd)
+	upCmd.Flags().Bool("contract", false, "Apply the contract migrations")
 }
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/authz"
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/projects/transfer"
+	"github.com/mindersec/minder/pkg/config"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/engine/selectors"
+	"github.com/mindersec/minder/pkg/eventer"
+	"github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+// projectCmd groups together the project commands
+var projectCmd = &cobra.Command{
+	Use:   "project",
+	Short: "Tools for migrating projects between deployments",
+	Long: `Use export to write a project and its sub-projects to an encrypted archive,
+and import to load the archive into a project of another deployment.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+var projectExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a project and its sub-projects to an encrypted archive",
+	Long: `Exports the rule types, profiles, data sources, providers, entities and the
+latest evaluation history of a project and its sub-projects. Provider
+credentials are not exported. The archive is encrypted with the base64 encoded
+32 bytes key read from --key-file.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runProjectCommand(cmd, func(ctx context.Context, _ *serverconfig.Config, store db.Store) error {
+			projectID, err := uuid.Parse(viper.GetString("project"))
+			if err != nil {
+				return fmt.Errorf("invalid project ID: %w", err)
+			}
+			key, err := readTransferKey(viper.GetString("key-file"))
+			if err != nil {
+				return err
+			}
+
+			exporter := transfer.NewExporter(store, datasourceservice.NewDataSourceService(store))
+			archive, err := exporter.Export(ctx, projectID)
+			if err != nil {
+				return err
+			}
+			sealed, err := transfer.Seal(archive, key)
+			if err != nil {
+				return err
+			}
+
+			output := viper.GetString("output")
+			if err := os.WriteFile(filepath.Clean(output), sealed, 0600); err != nil {
+				return fmt.Errorf("error writing archive: %w", err)
+			}
+			cmd.Printf("Exported %d projects to %s\n", len(archive.Projects), output)
+			return nil
+		})
+	},
+}
+
+var projectImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import an encrypted archive into a project",
+	Long: `Imports an archive created by export. The root project of the archive is
+imported into the project given with --project, and its sub-projects are
+created below it. Everything is imported in a single transaction.
+
+The imported providers have no credentials, so they must be enrolled again
+before their entities are reconciled. The evaluation history is not imported.
+Use --dry-run to show the content of the archive without importing it.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		key, err := readTransferKey(viper.GetString("key-file"))
+		if err != nil {
+			cliErrorf(cmd, "%s", err)
+		}
+		sealed, err := os.ReadFile(filepath.Clean(viper.GetString("input")))
+		if err != nil {
+			cliErrorf(cmd, "error reading archive: %s", err)
+		}
+		archive, err := transfer.Open(sealed, key)
+		if err != nil {
+			cliErrorf(cmd, "%s", err)
+		}
+
+		if viper.GetBool("dry-run") {
+			printArchiveSummary(cmd.OutOrStdout(), archive)
+			return nil
+		}
+
+		return runProjectCommand(cmd, func(ctx context.Context, cfg *serverconfig.Config, store db.Store) error {
+			projectID, err := uuid.Parse(viper.GetString("project"))
+			if err != nil {
+				return fmt.Errorf("invalid project ID: %w", err)
+			}
+
+			authzClient, err := authz.NewAuthzClient(&cfg.Authz, zerolog.Ctx(ctx))
+			if err != nil {
+				return fmt.Errorf("error while creating authz client: %w", err)
+			}
+			if err := authzClient.PrepareForRun(ctx); err != nil {
+				return fmt.Errorf("error preparing authz client: %w", err)
+			}
+			evt, err := eventer.New(ctx, nil, &cfg.Events)
+			if err != nil {
+				return fmt.Errorf("unable to setup eventer: %w", err)
+			}
+			defer evt.Close()
+
+			importer := transfer.NewImporter(
+				store,
+				authzClient,
+				ruletypes.NewRuleTypeService(nil),
+				profiles.NewProfileService(evt, selectors.NewEnv()),
+				datasourceservice.NewDataSourceService(store),
+			)
+			res, err := importer.Import(ctx, archive, projectID)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Imported %d projects and %d entities\n", len(res.Projects), res.Entities)
+			for _, prov := range res.Providers {
+				cmd.Printf("Provider %s of project %s must be enrolled again\n", prov.Name, prov.ProjectID)
+			}
+			for _, projectID := range res.Webhooks {
+				cmd.Printf("Validation webhook of project %s must be configured again with its secret\n", projectID)
+			}
+			return nil
+		})
+	},
+}
+
+func runProjectCommand(
+	cmd *cobra.Command,
+	run func(ctx context.Context, cfg *serverconfig.Config, store db.Store) error,
+) error {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return fmt.Errorf("error binding flags: %s", err)
+	}
+	cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
+	if err != nil {
+		cliErrorf(cmd, "unable to read config: %s", err)
+	}
+
+	ctx := serverconfig.LoggerFromConfigFlags(cfg.LoggingConfig).WithContext(context.Background())
+
+	store, closer, err := wireUpDB(ctx, cfg)
+	if err != nil {
+		cliErrorf(cmd, "unable to connect to database: %s", err)
+	}
+	defer closer()
+
+	if err := run(ctx, cfg, store); err != nil {
+		cliErrorf(cmd, "error transferring project: %s", err)
+	}
+	return nil
+}
+
+func readTransferKey(path string) ([]byte, error) {
+	key, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("error reading key: %w", err)
+	}
+	return bytes.TrimSpace(key), nil
+}
+
+func printArchiveSummary(out io.Writer, archive *transfer.Archive) {
+	fmt.Fprintf(out, "Archive exported at %s\n", archive.ExportedAt.Format("2006-01-02 15:04:05 MST"))
+	for _, p := range archive.Projects {
+		fmt.Fprintf(out, "project %s: data_sources=%d rule_types=%d profiles=%d providers=%d entities=%d evaluations=%d\n",
+			p.Name,
+			len(p.DataSources),
+			len(p.RuleTypes),
+			len(p.Profiles),
+			len(p.Providers),
+			len(p.Entities),
+			len(p.History),
+		)
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(projectCmd)
+	projectCmd.AddCommand(projectExportCmd, projectImportCmd)
+
+	projectCmd.PersistentFlags().String("key-file", "", "File holding the base64 encoded archive key")
+	projectExportCmd.Flags().String("project", "", "ID of the project to export")
+	projectExportCmd.Flags().StringP("output", "o", "", "File to write the archive to")
+	projectImportCmd.Flags().String("project", "", "ID of the project to import into")
+	projectImportCmd.Flags().StringP("input", "i", "", "File to read the archive from")
+	projectImportCmd.Flags().Bool("dry-run", false, "Show the content of the archive without importing it")
+
+	if err := projectCmd.MarkPersistentFlagRequired("key-file"); err != nil {
+		panic(err)
+	}
+	for flag, c := range map[string]*cobra.Command{
+		"output": projectExportCmd,
+		"input":  projectImportCmd,
+	} {
+		if err := c.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+	if err := projectExportCmd.MarkFlagRequired("project"); err != nil {
+		panic(err)
+	}
+}
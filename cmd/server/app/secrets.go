@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/secrets"
+	"github.com/mindersec/minder/pkg/config"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// secretsCmd groups together the project secret commands
+var secretsCmd = &cobra.Command{
+	Use:   "secrets",
+	Short: "Manage project secrets referenced from rule parameters",
+	Long: `Project secrets can be referenced from rule parameters as {{ secret "name" }}
+and are resolved at evaluation time. Secret values are never printed.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+var secretsSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Create or replace a project secret",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runSecretsCommand(cmd, func(ctx context.Context, svc secrets.Service, projectID uuid.UUID) error {
+			valueFile := viper.GetString("value-file")
+			value, err := os.ReadFile(filepath.Clean(valueFile))
+			if err != nil {
+				return fmt.Errorf("unable to read secret value: %w", err)
+			}
+			name := viper.GetString("name")
+			if err := svc.SetSecret(ctx, projectID, name, strings.TrimRight(string(value), "\n")); err != nil {
+				return err
+			}
+			cmd.Printf("Secret %s stored\n", name)
+			return nil
+		})
+	},
+}
+
+var secretsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the names of the project secrets",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runSecretsCommand(cmd, func(ctx context.Context, svc secrets.Service, projectID uuid.UUID) error {
+			names, err := svc.ListSecrets(ctx, projectID)
+			if err != nil {
+				return err
+			}
+			for _, name := range names {
+				cmd.Println(name)
+			}
+			return nil
+		})
+	},
+}
+
+var secretsDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a project secret",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runSecretsCommand(cmd, func(ctx context.Context, svc secrets.Service, projectID uuid.UUID) error {
+			name := viper.GetString("name")
+			if err := svc.DeleteSecret(ctx, projectID, name); err != nil {
+				return err
+			}
+			cmd.Printf("Secret %s deleted\n", name)
+			return nil
+		})
+	},
+}
+
+func runSecretsCommand(
+	cmd *cobra.Command,
+	run func(ctx context.Context, svc secrets.Service, projectID uuid.UUID) error,
+) error {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return fmt.Errorf("error binding flags: %s", err)
+	}
+	cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
+	if err != nil {
+		cliErrorf(cmd, "unable to read config: %s", err)
+	}
+
+	projectID, err := uuid.Parse(viper.GetString("project"))
+	if err != nil {
+		cliErrorf(cmd, "invalid project ID: %s", err)
+	}
+
+	ctx := serverconfig.LoggerFromConfigFlags(cfg.LoggingConfig).WithContext(context.Background())
+
+	store, closer, err := wireUpDB(ctx, cfg)
+	if err != nil {
+		cliErrorf(cmd, "unable to connect to database: %s", err)
+	}
+	defer closer()
+
+	cryptoEngine, err := crypto.NewEngineFromConfig(cfg)
+	if err != nil {
+		cliErrorf(cmd, "unable to instantiate crypto engine: %s", err)
+	}
+
+	if err := run(ctx, secrets.NewSecretService(store, cryptoEngine), projectID); err != nil {
+		cliErrorf(cmd, "error managing secrets: %s", err)
+	}
+	return nil
+}
+
+func init() {
+	RootCmd.AddCommand(secretsCmd)
+	secretsCmd.AddCommand(secretsSetCmd, secretsListCmd, secretsDeleteCmd)
+
+	secretsCmd.PersistentFlags().String("project", "", "ID of the project owning the secrets")
+	secretsSetCmd.Flags().String("name", "", "Name of the secret")
+	secretsSetCmd.Flags().String("value-file", "", "File containing the secret value")
+	secretsDeleteCmd.Flags().String("name", "", "Name of the secret")
+
+	if err := secretsCmd.MarkPersistentFlagRequired("project"); err != nil {
+		panic(err)
+	}
+	for _, c := range []*cobra.Command{secretsSetCmd, secretsDeleteCmd} {
+		if err := c.MarkFlagRequired("name"); err != nil {
+			panic(err)
+		}
+	}
+	if err := secretsSetCmd.MarkFlagRequired("value-file"); err != nil {
+		panic(err)
+	}
+}
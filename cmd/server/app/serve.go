@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/rs/zerolog"
@@ -15,17 +16,21 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/mindersec/minder/database"
 	"github.com/mindersec/minder/internal/auth"
 	"github.com/mindersec/minder/internal/auth/githubactions"
 	"github.com/mindersec/minder/internal/auth/jwt"
 	"github.com/mindersec/minder/internal/auth/jwt/dynamic"
 	"github.com/mindersec/minder/internal/auth/jwt/merged"
 	"github.com/mindersec/minder/internal/auth/keycloak"
+	"github.com/mindersec/minder/internal/auth/mtls"
 	"github.com/mindersec/minder/internal/authz"
+	"github.com/mindersec/minder/internal/controlplane"
 	cpmetrics "github.com/mindersec/minder/internal/controlplane/metrics"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/logger"
 	"github.com/mindersec/minder/internal/metrics/meters"
+	"github.com/mindersec/minder/internal/projectcache"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	provtelemetry "github.com/mindersec/minder/internal/providers/telemetry"
 	"github.com/mindersec/minder/internal/service"
@@ -33,6 +38,10 @@ import (
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
 )
 
+// phaseGateInterval is how often the server checks again whether the pending
+// migration phases completed
+const phaseGateInterval = time.Minute
+
 var serveCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "Start the minder platform",
@@ -66,7 +75,10 @@ var serveCmd = &cobra.Command{
 			}
 		}(dbConn)
 
-		store := db.NewStore(dbConn)
+		store, err := projectcache.NewStore(db.NewStore(dbConn), &cfg.ProjectCache)
+		if err != nil {
+			return fmt.Errorf("unable to create project cache: %w", err)
+		}
 
 		// webhook config validation
 		webhookURL := cfg.WebhookConfig.ExternalWebhookURL
@@ -115,11 +127,25 @@ var serveCmd = &cobra.Command{
 		jwtValidators = append(jwtValidators, dynamicJwt)
 		jwt := merged.Validator{Validators: jwtValidators}
 
+		if cfg.Authz.Embedded.Enabled {
+			if err := authz.StartEmbeddedServer(ctx, &cfg.Authz.Embedded); err != nil {
+				return fmt.Errorf("unable to start embedded authz server: %w", err)
+			}
+		}
+
 		authzc, err := authz.NewAuthzClient(&cfg.Authz, l)
 		if err != nil {
 			return fmt.Errorf("unable to create authz client: %w", err)
 		}
 
+		// The embedded server may start from an empty datastore, so
+		// the store and model are ensured on every start.
+		if cfg.Authz.Embedded.Enabled {
+			if err := authzc.MigrateUp(ctx); err != nil {
+				return fmt.Errorf("unable to migrate embedded authz store: %w", err)
+			}
+		}
+
 		if err := authzc.PrepareForRun(ctx); err != nil {
 			return fmt.Errorf("unable to prepare authz client for run: %w", err)
 		}
@@ -133,6 +159,17 @@ var serveCmd = &cobra.Command{
 			return fmt.Errorf("unable to create identity client: %w", err)
 		}
 
+		// Service accounts authenticate with a client certificate
+		if cfg.MTLS.Enabled {
+			serviceAccounts, err := mtls.NewServiceAccounts(&cfg.MTLS)
+			if err != nil {
+				return fmt.Errorf("unable to create service accounts identity provider: %w", err)
+			}
+			if err := idClient.Register(serviceAccounts); err != nil {
+				return fmt.Errorf("unable to register service accounts identity provider: %w", err)
+			}
+		}
+
 		// Register issuer_claim as an additional URL alias in the IdentityClient so
 		// that tokens with either the internal or external issuer URL can be validated.
 		if cfg.Identity.Server.IssuerClaim != "" {
@@ -162,6 +199,9 @@ var serveCmd = &cobra.Command{
 			providerMetrics,
 			[]message.HandlerMiddleware{telemetryMiddleware.TelemetryStoreMiddleware},
 			&meters.ExportingMeterFactory{},
+			// The migration phases run next to the servers, which only use
+			// what the phases build once they completed
+			controlplane.WithPhaseGate(database.NewPhaseGate(store, phaseGateInterval)),
 		)
 	},
 }
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/auth"
+	noopauth "github.com/mindersec/minder/internal/auth/jwt/noop"
+	"github.com/mindersec/minder/internal/authz"
+	cpmetrics "github.com/mindersec/minder/internal/controlplane/metrics"
+	"github.com/mindersec/minder/internal/db/standalone"
+	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/metrics/meters"
+	"github.com/mindersec/minder/internal/providers/ratecache"
+	provtelemetry "github.com/mindersec/minder/internal/providers/telemetry"
+	"github.com/mindersec/minder/internal/service"
+	"github.com/mindersec/minder/pkg/config"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+var standaloneCmd = &cobra.Command{
+	Use:   "standalone",
+	Short: "Start a self-contained minder platform for demos and testing",
+	Long: `Starts the minder platform without any external service, for laptop demos
+and integration tests.
+
+In standalone mode:
+- the data is stored in an embedded PostgreSQL database, whose binaries are
+  downloaded once into --postgres-cache-dir; use --offline to fail instead of
+  downloading, or --postgres-binaries-dir to use a local installation,
+- authorization is handled by an embedded, in-memory OpenFGA server,
+- events are delivered in-process,
+- every request is authenticated as the user given by --user, whatever the
+  token; set MINDER_AUTH_TOKEN to any value to use the minder CLI.
+
+All the data lives in temporary storage and is lost when the server stops.
+Standalone mode is insecure and must not be used in production.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer cancel()
+
+		cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
+		if err != nil {
+			return fmt.Errorf("unable to read config: %w", err)
+		}
+
+		ctx = serverconfig.LoggerFromConfigFlags(cfg.LoggingConfig).WithContext(ctx)
+		l := zerolog.Ctx(ctx)
+		l.Warn().Msg("Starting minder in standalone mode, do not use in production")
+
+		user, err := cmd.Flags().GetString("user")
+		if err != nil {
+			return fmt.Errorf("error getting user flag: %w", err)
+		}
+		grpcAddr, err := cmd.Flags().GetString("authz-grpc-address")
+		if err != nil {
+			return fmt.Errorf("error getting authz-grpc-address flag: %w", err)
+		}
+		httpAddr, err := cmd.Flags().GetString("authz-http-address")
+		if err != nil {
+			return fmt.Errorf("error getting authz-http-address flag: %w", err)
+		}
+
+		dbCfg, err := standaloneDBConfig(cmd)
+		if err != nil {
+			return err
+		}
+		store, td, err := standalone.Start(dbCfg)
+		if err != nil {
+			return fmt.Errorf("unable to spawn embedded store: %w", err)
+		}
+		defer td()
+
+		cfg.Authz = serverconfig.AuthzConfig{
+			StoreName:     "minder",
+			Auth:          serverconfig.OpenFGAAuth{Method: "none"},
+			AdminDeleters: cfg.Authz.AdminDeleters,
+			Embedded: serverconfig.EmbeddedAuthzConfig{
+				Enabled:     true,
+				GRPCAddress: grpcAddr,
+				HTTPAddress: httpAddr,
+				Datastore:   serverconfig.EmbeddedAuthzDatastoreConfig{Engine: "memory"},
+			},
+		}
+		if err := authz.StartEmbeddedServer(ctx, &cfg.Authz.Embedded); err != nil {
+			return fmt.Errorf("unable to start embedded authz server: %w", err)
+		}
+		authzc, err := authz.NewAuthzClient(&cfg.Authz, l)
+		if err != nil {
+			return fmt.Errorf("unable to create authz client: %w", err)
+		}
+		if err := authzc.MigrateUp(ctx); err != nil {
+			return fmt.Errorf("unable to create authz store: %w", err)
+		}
+		if err := authzc.PrepareForRun(ctx); err != nil {
+			return fmt.Errorf("unable to prepare authz client for run: %w", err)
+		}
+
+		cfg.Events.Driver = constants.GoChannelDriver
+
+		restClientCache := ratecache.NewRestClientCache(ctx)
+		defer restClientCache.Close()
+
+		telemetryMiddleware := logger.NewTelemetryStoreWMMiddleware(l)
+		return service.AllInOneServerService(
+			ctx,
+			cfg,
+			store,
+			noopauth.NewJwtValidator(user),
+			restClientCache,
+			authzc,
+			&auth.IdentityClient{},
+			auth.NewNoopIdentityManager(),
+			cpmetrics.NewMetrics(),
+			provtelemetry.NewProviderMetrics(),
+			[]message.HandlerMiddleware{telemetryMiddleware.TelemetryStoreMiddleware},
+			&meters.ExportingMeterFactory{},
+		)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(standaloneCmd)
+
+	standaloneCmd.Flags().String("user", "standalone",
+		"Subject every request is authenticated as")
+	standaloneCmd.Flags().String("authz-grpc-address", "127.0.0.1:8091",
+		"Address the embedded authorization server listens on for gRPC")
+	standaloneCmd.Flags().String("authz-http-address", "127.0.0.1:8092",
+		"Address the embedded authorization server listens on for HTTP")
+	standaloneCmd.Flags().String("postgres-cache-dir", "",
+		"Directory the PostgreSQL binaries are downloaded to and cached in (default: minder/postgres in the user cache directory)")
+	standaloneCmd.Flags().String("postgres-binaries-dir", "",
+		"Directory of a local PostgreSQL installation to use instead of downloaded binaries")
+	standaloneCmd.Flags().String("postgres-repository-url", standalone.DefaultRepositoryURL,
+		"Maven repository, or mirror, the PostgreSQL binaries are downloaded from")
+	standaloneCmd.Flags().Bool("offline", false,
+		"Fail instead of downloading the PostgreSQL binaries when they are not cached")
+}
+
+// standaloneDBConfig reads the configuration of the embedded database from
+// the flags
+func standaloneDBConfig(cmd *cobra.Command) (standalone.Config, error) {
+	var cfg standalone.Config
+	var err error
+	if cfg.CacheDir, err = cmd.Flags().GetString("postgres-cache-dir"); err != nil {
+		return cfg, fmt.Errorf("error getting postgres-cache-dir flag: %w", err)
+	}
+	if cfg.BinariesDir, err = cmd.Flags().GetString("postgres-binaries-dir"); err != nil {
+		return cfg, fmt.Errorf("error getting postgres-binaries-dir flag: %w", err)
+	}
+	if cfg.RepositoryURL, err = cmd.Flags().GetString("postgres-repository-url"); err != nil {
+		return cfg, fmt.Errorf("error getting postgres-repository-url flag: %w", err)
+	}
+	if cfg.Offline, err = cmd.Flags().GetBool("offline"); err != nil {
+		return cfg, fmt.Errorf("error getting offline flag: %w", err)
+	}
+	return cfg, nil
+}
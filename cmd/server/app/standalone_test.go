@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/db/standalone"
+)
+
+// TestStandaloneOffline checks that the standalone mode fails right away,
+// without trying to download them, when the PostgreSQL binaries are not
+// cached and the server runs offline
+func TestStandaloneOffline(t *testing.T) {
+	// The flags of the command are global, this test can't run in parallel
+	flags := standaloneCmd.Flags()
+	require.NoError(t, flags.Set("offline", "true"))
+	require.NoError(t, flags.Set("postgres-cache-dir", t.TempDir()))
+	t.Cleanup(func() {
+		_ = flags.Set("offline", "false")
+		_ = flags.Set("postgres-cache-dir", "")
+	})
+
+	standaloneCmd.SetContext(context.Background())
+	err := standaloneCmd.RunE(standaloneCmd, nil)
+	require.ErrorIs(t, err, standalone.ErrBinariesNotFound)
+}
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/pkg/config"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// tierCmd groups together the project tier commands
+var tierCmd = &cobra.Command{
+	Use:   "tier",
+	Short: "Manage the project tiers",
+	Long: `Project tiers limit the repositories, providers and rules of the projects
+they are assigned to, and whether their rule types can use the advanced
+evaluation engines. Sub-projects inherit the tier of their closest ancestor.
+Projects without a tier are not limited.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+var tierSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Create or replace a project tier",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runTierCommand(cmd, func(ctx context.Context, store db.Store) error {
+			tier, err := store.UpsertProjectTier(ctx, db.UpsertProjectTierParams{
+				Name:            viper.GetString("name"),
+				Description:     viper.GetString("description"),
+				MaxRepositories: tierLimit(viper.GetInt32("max-repositories")),
+				MaxProviders:    tierLimit(viper.GetInt32("max-providers")),
+				MaxRules:        tierLimit(viper.GetInt32("max-rules")),
+				AdvancedEngines: viper.GetBool("advanced-engines"),
+				Features:        viper.GetStringSlice("feature"),
+			})
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Tier %s stored\n", tier.Name)
+			return nil
+		})
+	},
+}
+
+var tierListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the project tiers",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runTierCommand(cmd, func(ctx context.Context, store db.Store) error {
+			tiers, err := store.ListProjectTiers(ctx)
+			if err != nil {
+				return err
+			}
+			for _, tier := range tiers {
+				cmd.Printf("%s: repositories=%s providers=%s rules=%s advanced_engines=%t features=[%s]\n",
+					tier.Name,
+					formatTierLimit(tier.MaxRepositories),
+					formatTierLimit(tier.MaxProviders),
+					formatTierLimit(tier.MaxRules),
+					tier.AdvancedEngines,
+					strings.Join(tier.Features, ","),
+				)
+			}
+			return nil
+		})
+	},
+}
+
+var tierDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a project tier, unassigning it from its projects",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runTierCommand(cmd, func(ctx context.Context, store db.Store) error {
+			name := viper.GetString("name")
+			deleted, err := store.DeleteProjectTier(ctx, name)
+			if err != nil {
+				return err
+			}
+			if deleted == 0 {
+				return fmt.Errorf("tier %s not found", name)
+			}
+			cmd.Printf("Tier %s deleted\n", name)
+			return nil
+		})
+	},
+}
+
+var tierAssignCmd = &cobra.Command{
+	Use:   "assign",
+	Short: "Assign a tier to a project and its sub-projects",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runTierCommand(cmd, func(ctx context.Context, store db.Store) error {
+			projectID, err := uuid.Parse(viper.GetString("project"))
+			if err != nil {
+				return fmt.Errorf("invalid project ID: %w", err)
+			}
+			name := viper.GetString("name")
+			if err := store.AssignProjectTier(ctx, db.AssignProjectTierParams{
+				ProjectID: projectID,
+				Tier:      name,
+			}); err != nil {
+				return err
+			}
+			cmd.Printf("Tier %s assigned to project %s\n", name, projectID)
+			return nil
+		})
+	},
+}
+
+var tierUnassignCmd = &cobra.Command{
+	Use:   "unassign",
+	Short: "Remove the tier assigned to a project",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runTierCommand(cmd, func(ctx context.Context, store db.Store) error {
+			projectID, err := uuid.Parse(viper.GetString("project"))
+			if err != nil {
+				return fmt.Errorf("invalid project ID: %w", err)
+			}
+			if _, err := store.UnassignProjectTier(ctx, projectID); err != nil {
+				return err
+			}
+			cmd.Printf("Tier unassigned from project %s\n", projectID)
+			return nil
+		})
+	},
+}
+
+func runTierCommand(cmd *cobra.Command, run func(ctx context.Context, store db.Store) error) error {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return fmt.Errorf("error binding flags: %s", err)
+	}
+	cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
+	if err != nil {
+		cliErrorf(cmd, "unable to read config: %s", err)
+	}
+
+	ctx := serverconfig.LoggerFromConfigFlags(cfg.LoggingConfig).WithContext(context.Background())
+
+	store, closer, err := wireUpDB(ctx, cfg)
+	if err != nil {
+		cliErrorf(cmd, "unable to connect to database: %s", err)
+	}
+	defer closer()
+
+	if err := run(ctx, store); err != nil {
+		cliErrorf(cmd, "error managing tiers: %s", err)
+	}
+	return nil
+}
+
+// tierLimit converts the negative limits, which are the flag defaults, to
+// unlimited
+func tierLimit(limit int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: limit, Valid: limit >= 0}
+}
+
+func formatTierLimit(limit sql.NullInt32) string {
+	if !limit.Valid {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d", limit.Int32)
+}
+
+func init() {
+	RootCmd.AddCommand(tierCmd)
+	tierCmd.AddCommand(tierSetCmd, tierListCmd, tierDeleteCmd, tierAssignCmd, tierUnassignCmd)
+
+	tierSetCmd.Flags().String("name", "", "Name of the tier")
+	tierSetCmd.Flags().String("description", "", "Description of the tier")
+	tierSetCmd.Flags().Int32("max-repositories", -1, "Maximum number of repositories per project, unlimited if negative")
+	tierSetCmd.Flags().Int32("max-providers", -1, "Maximum number of providers per project, unlimited if negative")
+	tierSetCmd.Flags().Int32("max-rules", -1, "Maximum number of rules per project, unlimited if negative")
+	tierSetCmd.Flags().Bool("advanced-engines", true,
+		"Whether rule types can use the advanced evaluation engines (vulncheck, trusty, homoglyphs)")
+	tierSetCmd.Flags().StringSlice("feature", nil, "Feature the tier entitles the projects to, can be repeated")
+	tierDeleteCmd.Flags().String("name", "", "Name of the tier")
+	tierAssignCmd.Flags().String("project", "", "ID of the project")
+	tierAssignCmd.Flags().String("name", "", "Name of the tier")
+	tierUnassignCmd.Flags().String("project", "", "ID of the project")
+
+	for _, c := range []*cobra.Command{tierSetCmd, tierDeleteCmd, tierAssignCmd} {
+		if err := c.MarkFlagRequired("name"); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range []*cobra.Command{tierAssignCmd, tierUnassignCmd} {
+		if err := c.MarkFlagRequired("project"); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -6,6 +6,10 @@ package database
 
 import (
 	"embed"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/source"
@@ -38,3 +42,58 @@ func NewFromConnectionString(connString string) (Migrator, error) {
 	d := migrationsFromSource()
 	return migrate.NewWithSourceInstance("iofs", d, connString)
 }
+
+// Migration describes an embedded migration
+type Migration struct {
+	Version uint
+	Name    string
+	// Contract migrations remove the parts of the schema which previous
+	// migrations replaced, so they can only be applied once no running
+	// server uses those parts anymore.
+	Contract bool
+}
+
+// contractPrefix starts the names of the contract migrations, e.g.
+// 000150_contract_drop_profile_labels.up.sql
+const contractPrefix = "contract_"
+
+// Migrations lists the embedded migrations, in the order they are applied.
+func Migrations() ([]Migration, error) {
+	d := migrationsFromSource()
+	defer d.Close()
+
+	version, err := d.First()
+	if err != nil {
+		return nil, fmt.Errorf("error reading first migration: %w", err)
+	}
+	var migrations []Migration
+	for {
+		r, name, err := d.ReadUp(version)
+		if err != nil {
+			return nil, fmt.Errorf("error reading migration %d: %w", version, err)
+		}
+		_ = r.Close()
+		migrations = append(migrations, Migration{
+			Version:  version,
+			Name:     name,
+			Contract: strings.HasPrefix(name, contractPrefix),
+		})
+
+		version, err = d.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return migrations, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("error reading migration after %d: %w", migrations[len(migrations)-1].Version, err)
+		}
+	}
+}
+
+// LatestVersion returns the version of the latest embedded migration, which is
+// the version a fully migrated database is at.
+func LatestVersion() (uint, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return 0, err
+	}
+	return migrations[len(migrations)-1].Version, nil
+}
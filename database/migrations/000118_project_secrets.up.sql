@@ -0,0 +1,15 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Project-scoped secrets which can be referenced from rule parameters.
+-- Values are encrypted with the server crypto engine and are never returned
+-- by the read APIs.
+CREATE TABLE project_secrets (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    encrypted_value JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (project_id, name)
+);
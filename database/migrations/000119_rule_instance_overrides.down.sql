@@ -0,0 +1,4 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE rule_instances DROP COLUMN overrides;
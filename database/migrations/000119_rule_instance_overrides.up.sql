@@ -0,0 +1,6 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Add overrides column to rule_instances to store the per-entity overrides
+-- of the rule definition and parameters.
+ALTER TABLE rule_instances ADD COLUMN overrides JSONB NOT NULL DEFAULT '[]';
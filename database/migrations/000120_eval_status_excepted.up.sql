@@ -0,0 +1,6 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Add `excepted` evaluation status, used for failures covered by an
+-- approved rule exception
+ALTER TYPE eval_status_types ADD VALUE 'excepted';
@@ -0,0 +1,6 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Add `suppressed` evaluation status, used for failures silenced by a
+-- per-entity override of the rule
+ALTER TYPE eval_status_types ADD VALUE 'suppressed';
@@ -0,0 +1,10 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+ALTER TABLE evaluation_statuses DROP COLUMN error_code;
+
+DROP TYPE eval_error_codes;
+
+COMMIT;
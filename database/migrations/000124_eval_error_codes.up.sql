@@ -0,0 +1,22 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- eval_error_codes classifies the cause of evaluations with the error status
+CREATE TYPE eval_error_codes AS ENUM (
+    'unknown',
+    'internal',
+    'provider_unavailable',
+    'rate_limited',
+    'policy_compile_error',
+    'ingestion_not_applicable',
+    'ingestion_failed',
+    'invalid_rule_parameters',
+    'limit_exceeded'
+);
+
+-- error_code is only set for evaluations with the error status
+ALTER TABLE evaluation_statuses ADD COLUMN error_code eval_error_codes;
+
+COMMIT;
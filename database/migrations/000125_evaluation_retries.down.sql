@@ -0,0 +1,10 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+ALTER TABLE evaluation_statuses DROP COLUMN IF EXISTS retry_attempt;
+
+DROP TABLE IF EXISTS evaluation_retries;
+
+COMMIT;
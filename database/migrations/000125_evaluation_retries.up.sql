@@ -0,0 +1,25 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Re-evaluations of entities scheduled after a transient evaluation error,
+-- e.g. a rate limit or an unavailable provider. attempt is the number of the
+-- scheduled retry, starting at 1.
+CREATE TABLE evaluation_retries (
+    entity_instance_id UUID NOT NULL PRIMARY KEY REFERENCES entity_instances(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    attempt INTEGER NOT NULL,
+    error_code eval_error_codes NOT NULL,
+    next_retry_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX evaluation_retries_next_retry_at_idx ON evaluation_retries(next_retry_at);
+
+-- retry_attempt is the number of the automatic retry of the evaluation, or 0
+-- if the evaluation was not a retry
+ALTER TABLE evaluation_statuses ADD COLUMN retry_attempt INTEGER NOT NULL DEFAULT 0;
+
+COMMIT;
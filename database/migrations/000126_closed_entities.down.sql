@@ -0,0 +1,8 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+DROP TABLE IF EXISTS closed_entities;
+
+COMMIT;
@@ -0,0 +1,17 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Entities which were closed upstream, e.g. closed pull requests. They are
+-- kept, along with their evaluation history, until they are reopened or
+-- purged once the retention window has passed.
+CREATE TABLE closed_entities (
+    entity_instance_id UUID NOT NULL PRIMARY KEY REFERENCES entity_instances(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    closed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX closed_entities_closed_at_idx ON closed_entities(closed_at);
+
+COMMIT;
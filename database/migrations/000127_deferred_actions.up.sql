@@ -0,0 +1,17 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Entities whose actions were deferred by a freeze. They are re-evaluated
+-- once the freeze has ended, so that the deferred remediations and alerts are
+-- executed.
+CREATE TABLE deferred_actions (
+    entity_instance_id UUID NOT NULL PRIMARY KEY REFERENCES entity_instances(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    deferred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX deferred_actions_deferred_at_idx ON deferred_actions(deferred_at);
+
+COMMIT;
@@ -0,0 +1,15 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Token buckets of the per-project API request quotas. tokens is the number
+-- of requests the project could make at updated_at; the bucket is refilled
+-- lazily when a request is made.
+CREATE TABLE api_quota_buckets (
+    project_id UUID NOT NULL PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMIT;
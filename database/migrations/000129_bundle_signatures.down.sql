@@ -0,0 +1,8 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+ALTER TABLE bundles DROP COLUMN IF EXISTS signature_verification;
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- The result of the verification of the signature of the bundle, as of the
+-- last time a project subscribed to it or imported a profile from it.
+ALTER TABLE bundles ADD COLUMN signature_verification JSONB;
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+ALTER TABLE data_sources DROP COLUMN IF EXISTS visibility;
+ALTER TABLE rule_type DROP COLUMN IF EXISTS visibility;
+DROP TYPE IF EXISTS visibility;
+
+COMMIT;
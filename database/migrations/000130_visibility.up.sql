@@ -0,0 +1,15 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- visibility controls which projects can use a rule type or a data source:
+-- only the project which owns it (private), the project and its
+-- descendants (subtree) or any project (global). Existing rule types and
+-- data sources keep being shared with the subtree, as they were so far.
+CREATE TYPE visibility AS ENUM ('private', 'subtree', 'global');
+
+ALTER TABLE rule_type ADD COLUMN visibility visibility NOT NULL DEFAULT 'subtree';
+ALTER TABLE data_sources ADD COLUMN visibility visibility NOT NULL DEFAULT 'subtree';
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+ALTER TABLE rule_instances DROP COLUMN IF EXISTS disabled_reason;
+ALTER TABLE rule_instances DROP COLUMN IF EXISTS disabled_by;
+ALTER TABLE rule_instances DROP COLUMN IF EXISTS disabled_at;
+
+COMMIT;
@@ -0,0 +1,14 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Rule instances can be disabled without removing them from the profile.
+-- Disabled rules are reported as skipped. The columns record who disabled
+-- the rule, when and why, and are left untouched when the profile is
+-- updated.
+ALTER TABLE rule_instances ADD COLUMN disabled_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE rule_instances ADD COLUMN disabled_by TEXT;
+ALTER TABLE rule_instances ADD COLUMN disabled_reason TEXT;
+
+COMMIT;
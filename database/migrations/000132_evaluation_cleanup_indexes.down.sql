@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+DROP INDEX IF EXISTS evaluation_statuses_evaluation_time_idx;
+DROP INDEX IF EXISTS evaluation_statuses_rule_entity_id_idx;
+
+COMMIT;
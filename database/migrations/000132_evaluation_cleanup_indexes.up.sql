@@ -0,0 +1,12 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Deleting a rule and entity pair cascades to its evaluation history, which
+-- is looked up by rule_entity_id. The evaluation time is used to find the
+-- pairs which were not evaluated for a while.
+CREATE INDEX IF NOT EXISTS evaluation_statuses_rule_entity_id_idx ON evaluation_statuses(rule_entity_id);
+CREATE INDEX IF NOT EXISTS evaluation_statuses_evaluation_time_idx ON evaluation_statuses(evaluation_time);
+
+COMMIT;
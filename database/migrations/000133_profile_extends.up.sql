@@ -0,0 +1,19 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- A profile may extend other profiles of its project, whose rules are merged
+-- into its own. The position keeps the order in which the bases are listed,
+-- as later bases take precedence over earlier ones. A profile cannot be
+-- deleted while other profiles extend it.
+CREATE TABLE IF NOT EXISTS profile_extends (
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    base_profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE RESTRICT,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (profile_id, base_profile_id)
+);
+
+CREATE INDEX IF NOT EXISTS profile_extends_base_profile_id_idx ON profile_extends(base_profile_id);
+
+COMMIT;
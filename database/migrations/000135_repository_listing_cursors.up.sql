@@ -0,0 +1,17 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Cursors of the repository listings of providers which were interrupted
+-- before reaching the last page. Listing the repositories of a provider for
+-- a project resumes from the stored cursor, if any.
+CREATE TABLE repository_listing_cursors (
+    provider_id UUID NOT NULL REFERENCES providers(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    next_cursor TEXT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider_id, project_id)
+);
+
+COMMIT;
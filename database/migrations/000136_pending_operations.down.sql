@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+DROP TABLE IF EXISTS pending_operations;
+DROP TYPE IF EXISTS pending_operation_state;
+
+COMMIT;
@@ -0,0 +1,26 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+CREATE TYPE pending_operation_state AS ENUM ('pending', 'confirmed', 'cancelled');
+
+-- Destructive operations waiting for the confirmation of a second admin, in
+-- projects requiring the approval of such operations. The request of the
+-- operation is replayed once it is confirmed.
+CREATE TABLE pending_operations (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    method TEXT NOT NULL,
+    request JSONB NOT NULL,
+    state pending_operation_state NOT NULL DEFAULT 'pending',
+    requested_by TEXT NOT NULL,
+    reviewed_by TEXT,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX pending_operations_project_idx ON pending_operations(project_id);
+
+COMMIT;
@@ -0,0 +1,22 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Result of the latest credential health check of each provider. The
+-- scopes are kept to detect when the permissions granted to the credential
+-- change, and alerted_expiry records the token expiry which was already
+-- alerted on, so that each expiring token is alerted on only once.
+CREATE TABLE provider_health (
+    provider_id UUID PRIMARY KEY REFERENCES providers(id) ON DELETE CASCADE,
+    checks JSONB NOT NULL DEFAULT '[]'::jsonb,
+    healthy BOOLEAN NOT NULL,
+    last_success_at TIMESTAMP WITH TIME ZONE,
+    token_expires_at TIMESTAMP WITH TIME ZONE,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    scopes_changed_at TIMESTAMP WITH TIME ZONE,
+    alerted_expiry TIMESTAMP WITH TIME ZONE,
+    checked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMIT;
@@ -0,0 +1,19 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Every update of a profile records the definition it replaces, along with
+-- who made the update and when, so that changes can be investigated and
+-- rolled back. Versions are numbered per profile, starting at 1.
+CREATE TABLE profile_versions (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    definition JSONB NOT NULL,
+    author TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (profile_id, version)
+);
+
+COMMIT;
@@ -0,0 +1,236 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Rules can be marked as canary with a sampling percentage: they are only
+-- evaluated on a deterministic subset of the entities, never remediate, and
+-- their results are left out of the profile status, so that new rules can be
+-- validated on a fraction of the entities first.
+
+BEGIN;
+
+ALTER TABLE rule_instances ADD COLUMN canary_percentage INTEGER
+    CHECK (canary_percentage BETWEEN 1 AND 100);
+
+-- The latest evaluation statuses rolled up into the profile status: all but
+-- the ones of the canary rules.
+CREATE VIEW rollup_evaluation_statuses AS
+SELECT les.rule_entity_id, les.profile_id, es.status
+FROM latest_evaluation_statuses AS les
+INNER JOIN evaluation_statuses AS es ON es.id = les.evaluation_history_id
+INNER JOIN evaluation_rule_entities AS ere ON ere.id = les.rule_entity_id
+INNER JOIN rule_instances AS ri ON ri.id = ere.rule_id
+WHERE ri.canary_percentage IS NULL;
+
+CREATE OR REPLACE FUNCTION update_profile_status() RETURNS TRIGGER AS $$
+DECLARE
+    v_status eval_status_types;
+    v_new_status eval_status_types;
+    v_other_error boolean;
+    v_other_failed boolean;
+    v_other_success boolean;
+    v_other_skipped boolean;
+    v_other_excepted boolean;
+    v_other_suppressed boolean;
+    v_pending boolean;
+BEGIN
+  -- Fetch the status for the latest evaluation
+  SELECT les.status INTO v_new_status
+  FROM rollup_evaluation_statuses les
+  WHERE les.profile_id = NEW.profile_id
+  AND les.rule_entity_id = NEW.rule_entity_id;
+
+  -- Canary rules are left out of the profile status
+  IF v_new_status IS NULL THEN
+    RETURN NULL;
+  END IF;
+
+  -- The next seven statements calculate whether there are, for this
+  -- profile, any rules in evaluations in status 'error', 'failure',
+  -- 'success', 'skipped', 'excepted', and 'suppressed', respectively.
+  -- This allows to write the subsequent CASE statement in a more compact
+  -- and readable fashion.
+  --
+  -- The consequence is that this version of the stored procedure adds
+  -- some load w.r.t. to previous one by unconditionally executing
+  -- these statements, but this should not be a problem, as all seven
+  -- queries hit the same rows, so they'll likely hit the cache.
+
+  -- These queries use the rollup_evaluation_statuses view to ensure that
+  -- we exclude historical statuses and the statuses of canary rules.
+
+  SELECT EXISTS (
+       SELECT 1 FROM rollup_evaluation_statuses les
+       WHERE les.profile_id = NEW.profile_id
+         AND les.status = 'error'
+  ) INTO v_other_error;
+
+  SELECT EXISTS (
+      SELECT 1 FROM rollup_evaluation_statuses les
+      WHERE les.profile_id = NEW.profile_id
+        AND les.status = 'failure'
+  ) INTO v_other_failed;
+
+  SELECT EXISTS (
+      SELECT 1 FROM rollup_evaluation_statuses les
+      WHERE les.profile_id = NEW.profile_id
+        AND les.status = 'success'
+  ) INTO v_other_success;
+
+  SELECT EXISTS (
+      SELECT 1 FROM rollup_evaluation_statuses les
+      WHERE les.profile_id = NEW.profile_id
+        AND les.status = 'skipped'
+  ) INTO v_other_skipped;
+
+  SELECT EXISTS (
+      SELECT 1 FROM rollup_evaluation_statuses les
+      WHERE les.profile_id = NEW.profile_id
+        AND les.status = 'excepted'
+  ) INTO v_other_excepted;
+
+  SELECT EXISTS (
+      SELECT 1 FROM rollup_evaluation_statuses les
+      WHERE les.profile_id = NEW.profile_id
+        AND les.status = 'suppressed'
+  ) INTO v_other_suppressed;
+
+  SELECT NOT EXISTS (
+      SELECT 1 FROM rollup_evaluation_statuses les
+      WHERE les.profile_id = NEW.profile_id
+  ) INTO v_pending;
+
+  CASE
+      -- A single rule in error state means policy is in error state
+      WHEN v_new_status = 'error' THEN
+          v_status := 'error';
+
+      -- No rule in error state and at least one rule in failure state
+      -- means policy is in error state
+      WHEN v_new_status = 'failure' AND v_other_error THEN
+          v_status := 'error';
+      WHEN v_new_status = 'failure' THEN
+          v_status := 'failure';
+
+      -- No rule in error or failure state and at least one rule in
+      -- suppressed state means policy is in suppressed state
+      WHEN v_new_status = 'suppressed' AND v_other_error THEN
+          v_status := 'error';
+      WHEN v_new_status = 'suppressed' AND v_other_failed THEN
+          v_status := 'failure';
+      WHEN v_new_status = 'suppressed' THEN
+          v_status := 'suppressed';
+
+      -- No rule in error, failure, or suppressed state and at least one
+      -- rule in excepted state means policy is in excepted state
+      WHEN v_new_status = 'excepted' AND v_other_error THEN
+          v_status := 'error';
+      WHEN v_new_status = 'excepted' AND v_other_failed THEN
+          v_status := 'failure';
+      WHEN v_new_status = 'excepted' AND v_other_suppressed THEN
+          v_status := 'suppressed';
+      WHEN v_new_status = 'excepted' THEN
+          v_status := 'excepted';
+
+      -- No rule in error, failure, suppressed, or excepted state and at
+      -- least one rule in success state means policy is in success state
+      WHEN v_new_status = 'success' AND v_other_error THEN
+          v_status := 'error';
+      WHEN v_new_status = 'success' AND v_other_failed THEN
+          v_status := 'failure';
+      WHEN v_new_status = 'success' AND v_other_suppressed THEN
+          v_status := 'suppressed';
+      WHEN v_new_status = 'success' AND v_other_excepted THEN
+          v_status := 'excepted';
+      WHEN v_new_status = 'success' THEN
+          v_status := 'success';
+
+      -- No rule in any other state and at least one rule in skipped
+      -- state means policy is in skipped state
+      WHEN v_new_status = 'skipped' AND v_other_error THEN
+          v_status := 'error';
+      WHEN v_new_status = 'skipped' AND v_other_failed THEN
+          v_status := 'failure';
+      WHEN v_new_status = 'skipped' AND v_other_suppressed THEN
+          v_status := 'suppressed';
+      WHEN v_new_status = 'skipped' AND v_other_excepted THEN
+          v_status := 'excepted';
+      WHEN v_new_status = 'skipped' AND v_other_success THEN
+          v_status := 'success';
+      WHEN v_new_status = 'skipped' THEN
+          v_status := 'skipped';
+
+    -- This should never happen, if yes, make it visible
+    ELSE
+      v_status := 'error';
+      RAISE WARNING 'default case should not happen';
+  END CASE;
+
+  -- This turned out to be very useful during debugging
+  --     RAISE LOG '% % % % % % % % % % => %',
+  --       v_other_error,
+  --       v_other_failed,
+  --       v_other_success,
+  --       v_other_skipped,
+  --       v_other_excepted,
+  --       v_other_suppressed,
+  --       v_pending,
+  --       NEW.evaluation_history_id,
+  --       NEW.profile_id,
+  --       v_new_status,
+  --       v_status;
+
+  UPDATE profile_status
+     SET profile_status = v_status, last_updated = NOW()
+   WHERE profile_id = NEW.profile_id;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Trigger function for deletions
+CREATE OR REPLACE FUNCTION update_profile_status_on_delete() RETURNS TRIGGER AS $$
+DECLARE
+    v_status eval_status_types;
+BEGIN
+    SELECT CASE
+       WHEN EXISTS (
+           SELECT 1 FROM rollup_evaluation_statuses les
+           WHERE les.profile_id = OLD.profile_id AND les.status = 'error'
+       ) THEN 'error'
+       WHEN EXISTS (
+           SELECT 1 FROM rollup_evaluation_statuses les
+           WHERE les.profile_id = OLD.profile_id AND les.status = 'failure'
+       ) THEN 'failure'
+       WHEN NOT EXISTS (
+           SELECT 1 FROM rollup_evaluation_statuses
+           WHERE profile_id = OLD.profile_id
+       ) THEN 'pending'
+       WHEN NOT EXISTS (
+           SELECT 1 FROM rollup_evaluation_statuses les
+           WHERE les.profile_id = OLD.profile_id AND les.status != 'skipped'
+       ) THEN 'skipped'
+       WHEN EXISTS (
+           SELECT 1 FROM rollup_evaluation_statuses les
+           WHERE les.profile_id = OLD.profile_id AND les.status = 'suppressed'
+       ) THEN 'suppressed'
+       WHEN EXISTS (
+           SELECT 1 FROM rollup_evaluation_statuses les
+           WHERE les.profile_id = OLD.profile_id AND les.status = 'excepted'
+       ) THEN 'excepted'
+       WHEN NOT EXISTS (
+           SELECT 1 FROM rollup_evaluation_statuses les
+           WHERE les.profile_id = OLD.profile_id AND les.status NOT IN ('success', 'skipped')
+       ) THEN 'success'
+       ELSE (
+           'error' -- This should never happen, if yes, make it visible
+           )
+       END INTO v_status;
+
+    UPDATE profile_status SET profile_status = v_status, last_updated = NOW()
+    WHERE profile_id = OLD.profile_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMIT;
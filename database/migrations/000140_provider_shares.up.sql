@@ -0,0 +1,19 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Providers can be explicitly shared with some of the child projects of the
+-- project they are enrolled in. Once a provider is shared, only the shared
+-- child projects (and their own children) can use it, rather than every
+-- project below the provider's project.
+CREATE TABLE provider_shares (
+    provider_id UUID NOT NULL REFERENCES providers(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider_id, project_id)
+);
+
+CREATE INDEX provider_shares_project_id_idx ON provider_shares(project_id);
+
+COMMIT;
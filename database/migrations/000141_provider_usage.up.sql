@@ -0,0 +1,20 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Usage of each provider, accounted in hourly buckets. Each server instance
+-- periodically adds the usage it recorded to the bucket, so the counts are
+-- the sum across all instances.
+CREATE TABLE provider_usage (
+    provider_id UUID NOT NULL REFERENCES providers(id) ON DELETE CASCADE,
+    bucket TIMESTAMP WITH TIME ZONE NOT NULL,
+    api_calls BIGINT NOT NULL DEFAULT 0,
+    webhooks BIGINT NOT NULL DEFAULT 0,
+    evaluations BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (provider_id, bucket)
+);
+
+CREATE INDEX provider_usage_bucket_idx ON provider_usage(bucket);
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+DROP FUNCTION IF EXISTS effective_project_tier(UUID);
+DROP TABLE IF EXISTS project_tier_assignments;
+DROP TABLE IF EXISTS project_tiers;
+
+COMMIT;
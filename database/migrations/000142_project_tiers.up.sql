@@ -0,0 +1,43 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Tiers bundle the limits and the features projects are entitled to. A NULL
+-- limit means that the tier does not limit that resource.
+CREATE TABLE project_tiers (
+    name TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    max_repositories INTEGER CHECK (max_repositories >= 0),
+    max_providers INTEGER CHECK (max_providers >= 0),
+    max_rules INTEGER CHECK (max_rules >= 0),
+    advanced_engines BOOLEAN NOT NULL DEFAULT TRUE,
+    features TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE project_tier_assignments (
+    project_id UUID PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+    tier TEXT NOT NULL REFERENCES project_tiers(name) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX project_tier_assignments_tier_idx ON project_tier_assignments(tier);
+
+-- The tier of a project is the one assigned to it or, when none is, the one
+-- of its closest ancestor.
+CREATE FUNCTION effective_project_tier(p_project_id UUID) RETURNS TEXT AS $$
+    WITH RECURSIVE ancestors AS (
+        SELECT id, parent_id, 0 AS depth FROM projects WHERE id = p_project_id
+        UNION ALL
+        SELECT p.id, p.parent_id, a.depth + 1 FROM projects p
+        INNER JOIN ancestors a ON p.id = a.parent_id
+    )
+    SELECT pta.tier FROM ancestors a
+    INNER JOIN project_tier_assignments pta ON pta.project_id = a.id
+    ORDER BY a.depth ASC
+    LIMIT 1;
+$$ LANGUAGE sql STABLE;
+
+COMMIT;
@@ -0,0 +1,21 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+CREATE TYPE migration_phase_state AS ENUM ('running', 'completed', 'failed');
+
+-- Phases are the steps of expand/contract migrations which run outside of the
+-- schema migrations, e.g. batched backfills of the columns added by a
+-- migration. Phases which never started have no row.
+CREATE TABLE migration_phases (
+    name TEXT PRIMARY KEY,
+    version BIGINT NOT NULL,
+    state migration_phase_state NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+DROP TABLE IF EXISTS evaluation_annotations;
+DROP TYPE IF EXISTS evaluation_annotation_kind;
+
+COMMIT;
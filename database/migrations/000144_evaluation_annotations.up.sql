@@ -0,0 +1,27 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+CREATE TYPE evaluation_annotation_kind AS ENUM ('triage_state', 'ticket', 'note');
+
+-- Annotations attached to rule evaluation results by external systems or
+-- analysts, e.g. to triage the findings. Annotations belong to the rule and
+-- entity pair of the evaluation, so that they outlive the evaluation once it
+-- is purged from the history.
+CREATE TABLE evaluation_annotations (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    rule_entity_id UUID NOT NULL REFERENCES evaluation_rule_entities(id) ON DELETE CASCADE,
+    evaluation_id UUID REFERENCES evaluation_statuses(id) ON DELETE SET NULL,
+    kind evaluation_annotation_kind NOT NULL,
+    value TEXT NOT NULL,
+    source TEXT NOT NULL DEFAULT '',
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX evaluation_annotations_rule_entity_idx ON evaluation_annotations(rule_entity_id);
+CREATE INDEX evaluation_annotations_evaluation_idx ON evaluation_annotations(evaluation_id);
+
+COMMIT;
@@ -0,0 +1,17 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- SSH deploy keys generated by Minder for the projects, which git ingestions
+-- use to clone private repositories over SSH. The private keys are encrypted
+-- with the server crypto engine and never leave the server; the public keys
+-- are installed by the users on their repositories.
+CREATE TABLE project_deploy_keys (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    fingerprint TEXT NOT NULL,
+    encrypted_private_key JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (project_id, name)
+);
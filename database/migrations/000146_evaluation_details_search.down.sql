@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+DROP INDEX IF EXISTS evaluation_statuses_details_search_idx;
+DROP INDEX IF EXISTS remediation_events_details_search_idx;
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The full-text search indexes over the details of the evaluations and of
+-- the remediations are built concurrently by the post hook phase of this
+-- migration, index_evaluation_details_search, as building them in this
+-- transaction would block the evaluations while the indexes are built.
+-- Servers only allow searching once the phase completed.
+SELECT 1;
@@ -0,0 +1,6 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Add the `npm` and `pypi` package registry provider classes
+ALTER TYPE provider_class ADD VALUE 'npm';
+ALTER TYPE provider_class ADD VALUE 'pypi';
@@ -0,0 +1,10 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+DROP TABLE IF EXISTS alert_groups;
+
+ALTER TABLE profiles DROP COLUMN IF EXISTS alert_grouping_window;
+
+COMMIT;
@@ -0,0 +1,31 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- The window, in seconds, within which the alerts raised by the rules of a
+-- profile for the same entity are grouped. Zero disables the grouping.
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS alert_grouping_window BIGINT NOT NULL DEFAULT 0;
+
+-- The alerts grouped for an entity and profile. The failing rules are the
+-- rules listed by the consolidated alert; the pending rules are the failures
+-- raised since the last notification, which are sent once the grouping window
+-- of the profile elapsed. Groups with pending failures are flushed at
+-- flush_after, by re-evaluating the entity.
+CREATE TABLE IF NOT EXISTS alert_groups (
+    entity_instance_id UUID NOT NULL REFERENCES entity_instances(id) ON DELETE CASCADE,
+    profile_id UUID NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    failing_rules TEXT[] NOT NULL DEFAULT '{}',
+    pending_rules TEXT[] NOT NULL DEFAULT '{}',
+    -- the metadata of the consolidated alert, e.g. its security advisory ID
+    alert_metadata JSONB,
+    last_notified_at TIMESTAMP WITH TIME ZONE,
+    flush_after TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (entity_instance_id, profile_id)
+);
+
+CREATE INDEX IF NOT EXISTS alert_groups_flush_after_idx ON alert_groups(flush_after)
+    WHERE flush_after IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,18 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- The entities whose evaluation was skipped because their project used more
+-- than its share of the instance. They are evaluated again once evaluate_after
+-- is reached, which is the end of the usage window they were throttled in.
+CREATE TABLE IF NOT EXISTS throttled_evaluations (
+    entity_instance_id UUID NOT NULL PRIMARY KEY REFERENCES entity_instances(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    throttled_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    evaluate_after TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS throttled_evaluations_evaluate_after_idx ON throttled_evaluations(evaluate_after);
+
+COMMIT;
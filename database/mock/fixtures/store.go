@@ -232,6 +232,17 @@ func WithSuccessfulDeleteEntity(entID, projectID uuid.UUID) func(*mockdb.MockSto
 	}
 }
 
+func WithSuccessfulCloseEntity(entID, projectID uuid.UUID) func(*mockdb.MockStore) {
+	return func(mockStore *mockdb.MockStore) {
+		mockStore.EXPECT().
+			CloseEntity(gomock.Any(), db.CloseEntityParams{
+				EntityInstanceID: entID,
+				ProjectID:        projectID,
+			}).
+			Return(nil)
+	}
+}
+
 func WithFailedGetEntitiesByProjectHierarchy(
 	err error,
 ) func(*mockdb.MockStore) {
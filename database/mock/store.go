@@ -14,6 +14,7 @@ import (
 	sql "database/sql"
 	json "encoding/json"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	db "github.com/mindersec/minder/internal/db"
@@ -59,6 +60,20 @@ func (mr *MockStoreMockRecorder) AddDataSourceFunction(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDataSourceFunction", reflect.TypeOf((*MockStore)(nil).AddDataSourceFunction), ctx, arg)
 }
 
+// AddProviderUsage mocks base method.
+func (m *MockStore) AddProviderUsage(ctx context.Context, arg db.AddProviderUsageParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProviderUsage", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddProviderUsage indicates an expected call of AddProviderUsage.
+func (mr *MockStoreMockRecorder) AddProviderUsage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProviderUsage", reflect.TypeOf((*MockStore)(nil).AddProviderUsage), ctx, arg)
+}
+
 // AddRuleTypeDataSourceReference mocks base method.
 func (m *MockStore) AddRuleTypeDataSourceReference(ctx context.Context, arg db.AddRuleTypeDataSourceReferenceParams) (db.RuleTypeDataSource, error) {
 	m.ctrl.T.Helper()
@@ -74,6 +89,20 @@ func (mr *MockStoreMockRecorder) AddRuleTypeDataSourceReference(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRuleTypeDataSourceReference", reflect.TypeOf((*MockStore)(nil).AddRuleTypeDataSourceReference), ctx, arg)
 }
 
+// AssignProjectTier mocks base method.
+func (m *MockStore) AssignProjectTier(ctx context.Context, arg db.AssignProjectTierParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignProjectTier", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignProjectTier indicates an expected call of AssignProjectTier.
+func (mr *MockStoreMockRecorder) AssignProjectTier(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignProjectTier", reflect.TypeOf((*MockStore)(nil).AssignProjectTier), ctx, arg)
+}
+
 // BeginTransaction mocks base method.
 func (m *MockStore) BeginTransaction() (*sql.Tx, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +147,35 @@ func (mr *MockStoreMockRecorder) CheckHealth() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHealth", reflect.TypeOf((*MockStore)(nil).CheckHealth))
 }
 
+// ClaimDueEvaluationRetries mocks base method.
+func (m *MockStore) ClaimDueEvaluationRetries(ctx context.Context, arg db.ClaimDueEvaluationRetriesParams) ([]db.EvaluationRetry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueEvaluationRetries", ctx, arg)
+	ret0, _ := ret[0].([]db.EvaluationRetry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueEvaluationRetries indicates an expected call of ClaimDueEvaluationRetries.
+func (mr *MockStoreMockRecorder) ClaimDueEvaluationRetries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueEvaluationRetries", reflect.TypeOf((*MockStore)(nil).ClaimDueEvaluationRetries), ctx, arg)
+}
+
+// CloseEntity mocks base method.
+func (m *MockStore) CloseEntity(ctx context.Context, arg db.CloseEntityParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseEntity", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseEntity indicates an expected call of CloseEntity.
+func (mr *MockStoreMockRecorder) CloseEntity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseEntity", reflect.TypeOf((*MockStore)(nil).CloseEntity), ctx, arg)
+}
+
 // Commit mocks base method.
 func (m *MockStore) Commit(tx *sql.Tx) error {
 	m.ctrl.T.Helper()
@@ -132,6 +190,20 @@ func (mr *MockStoreMockRecorder) Commit(tx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockStore)(nil).Commit), tx)
 }
 
+// CompleteMigrationPhase mocks base method.
+func (m *MockStore) CompleteMigrationPhase(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMigrationPhase", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteMigrationPhase indicates an expected call of CompleteMigrationPhase.
+func (mr *MockStoreMockRecorder) CompleteMigrationPhase(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMigrationPhase", reflect.TypeOf((*MockStore)(nil).CompleteMigrationPhase), ctx, name)
+}
+
 // CountEntitiesByType mocks base method.
 func (m *MockStore) CountEntitiesByType(ctx context.Context, entityType db.Entities) (int64, error) {
 	m.ctrl.T.Helper()
@@ -162,6 +234,36 @@ func (mr *MockStoreMockRecorder) CountEntitiesByTypeAndProject(ctx, arg any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEntitiesByTypeAndProject", reflect.TypeOf((*MockStore)(nil).CountEntitiesByTypeAndProject), ctx, arg)
 }
 
+// CountOrphanedEvaluationRuleEntities mocks base method.
+func (m *MockStore) CountOrphanedEvaluationRuleEntities(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOrphanedEvaluationRuleEntities", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOrphanedEvaluationRuleEntities indicates an expected call of CountOrphanedEvaluationRuleEntities.
+func (mr *MockStoreMockRecorder) CountOrphanedEvaluationRuleEntities(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOrphanedEvaluationRuleEntities", reflect.TypeOf((*MockStore)(nil).CountOrphanedEvaluationRuleEntities), ctx)
+}
+
+// CountOrphanedRuleInstances mocks base method.
+func (m *MockStore) CountOrphanedRuleInstances(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOrphanedRuleInstances", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOrphanedRuleInstances indicates an expected call of CountOrphanedRuleInstances.
+func (mr *MockStoreMockRecorder) CountOrphanedRuleInstances(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOrphanedRuleInstances", reflect.TypeOf((*MockStore)(nil).CountOrphanedRuleInstances), ctx)
+}
+
 // CountProfilesByEntityType mocks base method.
 func (m *MockStore) CountProfilesByEntityType(ctx context.Context) ([]db.CountProfilesByEntityTypeRow, error) {
 	m.ctrl.T.Helper()
@@ -207,6 +309,51 @@ func (mr *MockStoreMockRecorder) CountProfilesByProjectID(ctx, projectID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountProfilesByProjectID", reflect.TypeOf((*MockStore)(nil).CountProfilesByProjectID), ctx, projectID)
 }
 
+// CountProvidersByProjectID mocks base method.
+func (m *MockStore) CountProvidersByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountProvidersByProjectID", ctx, projectID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountProvidersByProjectID indicates an expected call of CountProvidersByProjectID.
+func (mr *MockStoreMockRecorder) CountProvidersByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountProvidersByProjectID", reflect.TypeOf((*MockStore)(nil).CountProvidersByProjectID), ctx, projectID)
+}
+
+// CountRuleInstancesByProjectID mocks base method.
+func (m *MockStore) CountRuleInstancesByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRuleInstancesByProjectID", ctx, projectID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRuleInstancesByProjectID indicates an expected call of CountRuleInstancesByProjectID.
+func (mr *MockStoreMockRecorder) CountRuleInstancesByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRuleInstancesByProjectID", reflect.TypeOf((*MockStore)(nil).CountRuleInstancesByProjectID), ctx, projectID)
+}
+
+// CountStaleEvaluationRuleEntities mocks base method.
+func (m *MockStore) CountStaleEvaluationRuleEntities(ctx context.Context, arg db.CountStaleEvaluationRuleEntitiesParams) (db.CountStaleEvaluationRuleEntitiesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountStaleEvaluationRuleEntities", ctx, arg)
+	ret0, _ := ret[0].(db.CountStaleEvaluationRuleEntitiesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountStaleEvaluationRuleEntities indicates an expected call of CountStaleEvaluationRuleEntities.
+func (mr *MockStoreMockRecorder) CountStaleEvaluationRuleEntities(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountStaleEvaluationRuleEntities", reflect.TypeOf((*MockStore)(nil).CountStaleEvaluationRuleEntities), ctx, arg)
+}
+
 // CountUsers mocks base method.
 func (m *MockStore) CountUsers(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -281,6 +428,21 @@ func (mr *MockStoreMockRecorder) CreateEntityWithID(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntityWithID", reflect.TypeOf((*MockStore)(nil).CreateEntityWithID), ctx, arg)
 }
 
+// CreateEvaluationAnnotation mocks base method.
+func (m *MockStore) CreateEvaluationAnnotation(ctx context.Context, arg db.CreateEvaluationAnnotationParams) (db.EvaluationAnnotation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvaluationAnnotation", ctx, arg)
+	ret0, _ := ret[0].(db.EvaluationAnnotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvaluationAnnotation indicates an expected call of CreateEvaluationAnnotation.
+func (mr *MockStoreMockRecorder) CreateEvaluationAnnotation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvaluationAnnotation", reflect.TypeOf((*MockStore)(nil).CreateEvaluationAnnotation), ctx, arg)
+}
+
 // CreateInvitation mocks base method.
 func (m *MockStore) CreateInvitation(ctx context.Context, arg db.CreateInvitationParams) (db.UserInvite, error) {
 	m.ctrl.T.Helper()
@@ -311,6 +473,21 @@ func (mr *MockStoreMockRecorder) CreateOrEnsureEntityByID(ctx, arg any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrEnsureEntityByID", reflect.TypeOf((*MockStore)(nil).CreateOrEnsureEntityByID), ctx, arg)
 }
 
+// CreatePendingOperation mocks base method.
+func (m *MockStore) CreatePendingOperation(ctx context.Context, arg db.CreatePendingOperationParams) (db.PendingOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePendingOperation", ctx, arg)
+	ret0, _ := ret[0].(db.PendingOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePendingOperation indicates an expected call of CreatePendingOperation.
+func (mr *MockStoreMockRecorder) CreatePendingOperation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePendingOperation", reflect.TypeOf((*MockStore)(nil).CreatePendingOperation), ctx, arg)
+}
+
 // CreateProfile mocks base method.
 func (m *MockStore) CreateProfile(ctx context.Context, arg db.CreateProfileParams) (db.Profile, error) {
 	m.ctrl.T.Helper()
@@ -326,6 +503,20 @@ func (mr *MockStoreMockRecorder) CreateProfile(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProfile", reflect.TypeOf((*MockStore)(nil).CreateProfile), ctx, arg)
 }
 
+// CreateProfileBase mocks base method.
+func (m *MockStore) CreateProfileBase(ctx context.Context, arg db.CreateProfileBaseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProfileBase", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProfileBase indicates an expected call of CreateProfileBase.
+func (mr *MockStoreMockRecorder) CreateProfileBase(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProfileBase", reflect.TypeOf((*MockStore)(nil).CreateProfileBase), ctx, arg)
+}
+
 // CreateProfileForEntity mocks base method.
 func (m *MockStore) CreateProfileForEntity(ctx context.Context, arg db.CreateProfileForEntityParams) (db.EntityProfile, error) {
 	m.ctrl.T.Helper()
@@ -341,6 +532,36 @@ func (mr *MockStoreMockRecorder) CreateProfileForEntity(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProfileForEntity", reflect.TypeOf((*MockStore)(nil).CreateProfileForEntity), ctx, arg)
 }
 
+// CreateProfileStatusShareLink mocks base method.
+func (m *MockStore) CreateProfileStatusShareLink(ctx context.Context, arg db.CreateProfileStatusShareLinkParams) (db.ProfileStatusShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProfileStatusShareLink", ctx, arg)
+	ret0, _ := ret[0].(db.ProfileStatusShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProfileStatusShareLink indicates an expected call of CreateProfileStatusShareLink.
+func (mr *MockStoreMockRecorder) CreateProfileStatusShareLink(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProfileStatusShareLink", reflect.TypeOf((*MockStore)(nil).CreateProfileStatusShareLink), ctx, arg)
+}
+
+// CreateProfileVersion mocks base method.
+func (m *MockStore) CreateProfileVersion(ctx context.Context, arg db.CreateProfileVersionParams) (db.ProfileVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProfileVersion", ctx, arg)
+	ret0, _ := ret[0].(db.ProfileVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProfileVersion indicates an expected call of CreateProfileVersion.
+func (mr *MockStoreMockRecorder) CreateProfileVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProfileVersion", reflect.TypeOf((*MockStore)(nil).CreateProfileVersion), ctx, arg)
+}
+
 // CreateProject mocks base method.
 func (m *MockStore) CreateProject(ctx context.Context, arg db.CreateProjectParams) (db.Project, error) {
 	m.ctrl.T.Helper()
@@ -356,6 +577,21 @@ func (mr *MockStoreMockRecorder) CreateProject(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockStore)(nil).CreateProject), ctx, arg)
 }
 
+// CreateProjectDeployKey mocks base method.
+func (m *MockStore) CreateProjectDeployKey(ctx context.Context, arg db.CreateProjectDeployKeyParams) (db.ProjectDeployKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectDeployKey", ctx, arg)
+	ret0, _ := ret[0].(db.ProjectDeployKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectDeployKey indicates an expected call of CreateProjectDeployKey.
+func (mr *MockStoreMockRecorder) CreateProjectDeployKey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectDeployKey", reflect.TypeOf((*MockStore)(nil).CreateProjectDeployKey), ctx, arg)
+}
+
 // CreateProjectWithID mocks base method.
 func (m *MockStore) CreateProjectWithID(ctx context.Context, arg db.CreateProjectWithIDParams) (db.Project, error) {
 	m.ctrl.T.Helper()
@@ -386,6 +622,50 @@ func (mr *MockStoreMockRecorder) CreateProvider(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProvider", reflect.TypeOf((*MockStore)(nil).CreateProvider), ctx, arg)
 }
 
+// CreateProviderShare mocks base method.
+func (m *MockStore) CreateProviderShare(ctx context.Context, arg db.CreateProviderShareParams) (db.ProviderShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProviderShare", ctx, arg)
+	ret0, _ := ret[0].(db.ProviderShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProviderShare indicates an expected call of CreateProviderShare.
+func (mr *MockStoreMockRecorder) CreateProviderShare(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProviderShare", reflect.TypeOf((*MockStore)(nil).CreateProviderShare), ctx, arg)
+}
+
+// CreateRuleException mocks base method.
+func (m *MockStore) CreateRuleException(ctx context.Context, arg db.CreateRuleExceptionParams) (db.RuleException, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRuleException", ctx, arg)
+	ret0, _ := ret[0].(db.RuleException)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRuleException indicates an expected call of CreateRuleException.
+func (mr *MockStoreMockRecorder) CreateRuleException(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRuleException", reflect.TypeOf((*MockStore)(nil).CreateRuleException), ctx, arg)
+}
+
+// CreateRuleExceptionEvent mocks base method.
+func (m *MockStore) CreateRuleExceptionEvent(ctx context.Context, arg db.CreateRuleExceptionEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRuleExceptionEvent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRuleExceptionEvent indicates an expected call of CreateRuleExceptionEvent.
+func (mr *MockStoreMockRecorder) CreateRuleExceptionEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRuleExceptionEvent", reflect.TypeOf((*MockStore)(nil).CreateRuleExceptionEvent), ctx, arg)
+}
+
 // CreateRuleType mocks base method.
 func (m *MockStore) CreateRuleType(ctx context.Context, arg db.CreateRuleTypeParams) (db.RuleType, error) {
 	m.ctrl.T.Helper()
@@ -461,6 +741,48 @@ func (mr *MockStoreMockRecorder) CreateUser(ctx, identitySubject any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, identitySubject)
 }
 
+// DeferActions mocks base method.
+func (m *MockStore) DeferActions(ctx context.Context, arg db.DeferActionsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeferActions", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeferActions indicates an expected call of DeferActions.
+func (mr *MockStoreMockRecorder) DeferActions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeferActions", reflect.TypeOf((*MockStore)(nil).DeferActions), ctx, arg)
+}
+
+// DeferThrottledEvaluation mocks base method.
+func (m *MockStore) DeferThrottledEvaluation(ctx context.Context, arg db.DeferThrottledEvaluationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeferThrottledEvaluation", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeferThrottledEvaluation indicates an expected call of DeferThrottledEvaluation.
+func (mr *MockStoreMockRecorder) DeferThrottledEvaluation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeferThrottledEvaluation", reflect.TypeOf((*MockStore)(nil).DeferThrottledEvaluation), ctx, arg)
+}
+
+// DeleteAlertGroup mocks base method.
+func (m *MockStore) DeleteAlertGroup(ctx context.Context, arg db.DeleteAlertGroupParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertGroup", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertGroup indicates an expected call of DeleteAlertGroup.
+func (mr *MockStoreMockRecorder) DeleteAlertGroup(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertGroup", reflect.TypeOf((*MockStore)(nil).DeleteAlertGroup), ctx, arg)
+}
+
 // DeleteAllPropertiesForEntity mocks base method.
 func (m *MockStore) DeleteAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -534,6 +856,21 @@ func (mr *MockStoreMockRecorder) DeleteEntity(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntity", reflect.TypeOf((*MockStore)(nil).DeleteEntity), ctx, arg)
 }
 
+// DeleteEvaluationAnnotation mocks base method.
+func (m *MockStore) DeleteEvaluationAnnotation(ctx context.Context, arg db.DeleteEvaluationAnnotationParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvaluationAnnotation", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEvaluationAnnotation indicates an expected call of DeleteEvaluationAnnotation.
+func (mr *MockStoreMockRecorder) DeleteEvaluationAnnotation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvaluationAnnotation", reflect.TypeOf((*MockStore)(nil).DeleteEvaluationAnnotation), ctx, arg)
+}
+
 // DeleteEvaluationHistoryByIDs mocks base method.
 func (m *MockStore) DeleteEvaluationHistoryByIDs(ctx context.Context, evaluationids []uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
@@ -564,6 +901,49 @@ func (mr *MockStoreMockRecorder) DeleteEvaluationOutputsByEvaluationIDs(ctx, eva
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvaluationOutputsByEvaluationIDs", reflect.TypeOf((*MockStore)(nil).DeleteEvaluationOutputsByEvaluationIDs), ctx, evaluationids)
 }
 
+// DeleteEvaluationRetry mocks base method.
+func (m *MockStore) DeleteEvaluationRetry(ctx context.Context, entityInstanceID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvaluationRetry", ctx, entityInstanceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEvaluationRetry indicates an expected call of DeleteEvaluationRetry.
+func (mr *MockStoreMockRecorder) DeleteEvaluationRetry(ctx, entityInstanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvaluationRetry", reflect.TypeOf((*MockStore)(nil).DeleteEvaluationRetry), ctx, entityInstanceID)
+}
+
+// DeleteEvaluationRuleEntitiesByIDs mocks base method.
+func (m *MockStore) DeleteEvaluationRuleEntitiesByIDs(ctx context.Context, ruleentityids []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvaluationRuleEntitiesByIDs", ctx, ruleentityids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEvaluationRuleEntitiesByIDs indicates an expected call of DeleteEvaluationRuleEntitiesByIDs.
+func (mr *MockStoreMockRecorder) DeleteEvaluationRuleEntitiesByIDs(ctx, ruleentityids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvaluationRuleEntitiesByIDs", reflect.TypeOf((*MockStore)(nil).DeleteEvaluationRuleEntitiesByIDs), ctx, ruleentityids)
+}
+
+// DeleteExpiredProfileStatusShareLinks mocks base method.
+func (m *MockStore) DeleteExpiredProfileStatusShareLinks(ctx context.Context, projectID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredProfileStatusShareLinks", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredProfileStatusShareLinks indicates an expected call of DeleteExpiredProfileStatusShareLinks.
+func (mr *MockStoreMockRecorder) DeleteExpiredProfileStatusShareLinks(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredProfileStatusShareLinks", reflect.TypeOf((*MockStore)(nil).DeleteExpiredProfileStatusShareLinks), ctx, projectID)
+}
+
 // DeleteExpiredSessionStates mocks base method.
 func (m *MockStore) DeleteExpiredSessionStates(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -622,6 +1002,36 @@ func (mr *MockStoreMockRecorder) DeleteNonUpdatedRules(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNonUpdatedRules", reflect.TypeOf((*MockStore)(nil).DeleteNonUpdatedRules), ctx, arg)
 }
 
+// DeleteOrphanedEvaluationRuleEntities mocks base method.
+func (m *MockStore) DeleteOrphanedEvaluationRuleEntities(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanedEvaluationRuleEntities", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphanedEvaluationRuleEntities indicates an expected call of DeleteOrphanedEvaluationRuleEntities.
+func (mr *MockStoreMockRecorder) DeleteOrphanedEvaluationRuleEntities(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanedEvaluationRuleEntities", reflect.TypeOf((*MockStore)(nil).DeleteOrphanedEvaluationRuleEntities), ctx)
+}
+
+// DeleteOrphanedRuleInstances mocks base method.
+func (m *MockStore) DeleteOrphanedRuleInstances(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanedRuleInstances", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphanedRuleInstances indicates an expected call of DeleteOrphanedRuleInstances.
+func (mr *MockStoreMockRecorder) DeleteOrphanedRuleInstances(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanedRuleInstances", reflect.TypeOf((*MockStore)(nil).DeleteOrphanedRuleInstances), ctx)
+}
+
 // DeleteProfile mocks base method.
 func (m *MockStore) DeleteProfile(ctx context.Context, arg db.DeleteProfileParams) error {
 	m.ctrl.T.Helper()
@@ -636,6 +1046,20 @@ func (mr *MockStoreMockRecorder) DeleteProfile(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProfile", reflect.TypeOf((*MockStore)(nil).DeleteProfile), ctx, arg)
 }
 
+// DeleteProfileBases mocks base method.
+func (m *MockStore) DeleteProfileBases(ctx context.Context, profileID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProfileBases", ctx, profileID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProfileBases indicates an expected call of DeleteProfileBases.
+func (mr *MockStoreMockRecorder) DeleteProfileBases(ctx, profileID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProfileBases", reflect.TypeOf((*MockStore)(nil).DeleteProfileBases), ctx, profileID)
+}
+
 // DeleteProfileForEntity mocks base method.
 func (m *MockStore) DeleteProfileForEntity(ctx context.Context, arg db.DeleteProfileForEntityParams) error {
 	m.ctrl.T.Helper()
@@ -650,6 +1074,21 @@ func (mr *MockStoreMockRecorder) DeleteProfileForEntity(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProfileForEntity", reflect.TypeOf((*MockStore)(nil).DeleteProfileForEntity), ctx, arg)
 }
 
+// DeleteProfileStatusShareLink mocks base method.
+func (m *MockStore) DeleteProfileStatusShareLink(ctx context.Context, arg db.DeleteProfileStatusShareLinkParams) (db.ProfileStatusShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProfileStatusShareLink", ctx, arg)
+	ret0, _ := ret[0].(db.ProfileStatusShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProfileStatusShareLink indicates an expected call of DeleteProfileStatusShareLink.
+func (mr *MockStoreMockRecorder) DeleteProfileStatusShareLink(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProfileStatusShareLink", reflect.TypeOf((*MockStore)(nil).DeleteProfileStatusShareLink), ctx, arg)
+}
+
 // DeleteProject mocks base method.
 func (m *MockStore) DeleteProject(ctx context.Context, id uuid.UUID) ([]db.DeleteProjectRow, error) {
 	m.ctrl.T.Helper()
@@ -665,12 +1104,57 @@ func (mr *MockStoreMockRecorder) DeleteProject(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockStore)(nil).DeleteProject), ctx, id)
 }
 
-// DeleteProperty mocks base method.
-func (m *MockStore) DeleteProperty(ctx context.Context, arg db.DeletePropertyParams) error {
+// DeleteProjectDeployKey mocks base method.
+func (m *MockStore) DeleteProjectDeployKey(ctx context.Context, arg db.DeleteProjectDeployKeyParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProperty", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "DeleteProjectDeployKey", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectDeployKey indicates an expected call of DeleteProjectDeployKey.
+func (mr *MockStoreMockRecorder) DeleteProjectDeployKey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectDeployKey", reflect.TypeOf((*MockStore)(nil).DeleteProjectDeployKey), ctx, arg)
+}
+
+// DeleteProjectSecret mocks base method.
+func (m *MockStore) DeleteProjectSecret(ctx context.Context, arg db.DeleteProjectSecretParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectSecret", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectSecret indicates an expected call of DeleteProjectSecret.
+func (mr *MockStoreMockRecorder) DeleteProjectSecret(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectSecret", reflect.TypeOf((*MockStore)(nil).DeleteProjectSecret), ctx, arg)
+}
+
+// DeleteProjectTier mocks base method.
+func (m *MockStore) DeleteProjectTier(ctx context.Context, name string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectTier", ctx, name)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectTier indicates an expected call of DeleteProjectTier.
+func (mr *MockStoreMockRecorder) DeleteProjectTier(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectTier", reflect.TypeOf((*MockStore)(nil).DeleteProjectTier), ctx, name)
+}
+
+// DeleteProperty mocks base method.
+func (m *MockStore) DeleteProperty(ctx context.Context, arg db.DeletePropertyParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProperty", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // DeleteProperty indicates an expected call of DeleteProperty.
@@ -693,6 +1177,50 @@ func (mr *MockStoreMockRecorder) DeleteProvider(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProvider", reflect.TypeOf((*MockStore)(nil).DeleteProvider), ctx, arg)
 }
 
+// DeleteProviderShare mocks base method.
+func (m *MockStore) DeleteProviderShare(ctx context.Context, arg db.DeleteProviderShareParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProviderShare", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProviderShare indicates an expected call of DeleteProviderShare.
+func (mr *MockStoreMockRecorder) DeleteProviderShare(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProviderShare", reflect.TypeOf((*MockStore)(nil).DeleteProviderShare), ctx, arg)
+}
+
+// DeleteProviderUsageBefore mocks base method.
+func (m *MockStore) DeleteProviderUsageBefore(ctx context.Context, bucket time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProviderUsageBefore", ctx, bucket)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProviderUsageBefore indicates an expected call of DeleteProviderUsageBefore.
+func (mr *MockStoreMockRecorder) DeleteProviderUsageBefore(ctx, bucket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProviderUsageBefore", reflect.TypeOf((*MockStore)(nil).DeleteProviderUsageBefore), ctx, bucket)
+}
+
+// DeleteRepositoryListingCursor mocks base method.
+func (m *MockStore) DeleteRepositoryListingCursor(ctx context.Context, arg db.DeleteRepositoryListingCursorParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRepositoryListingCursor", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRepositoryListingCursor indicates an expected call of DeleteRepositoryListingCursor.
+func (mr *MockStoreMockRecorder) DeleteRepositoryListingCursor(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepositoryListingCursor", reflect.TypeOf((*MockStore)(nil).DeleteRepositoryListingCursor), ctx, arg)
+}
+
 // DeleteRuleInstanceOfProfileInProject mocks base method.
 func (m *MockStore) DeleteRuleInstanceOfProfileInProject(ctx context.Context, arg db.DeleteRuleInstanceOfProfileInProjectParams) error {
 	m.ctrl.T.Helper()
@@ -791,6 +1319,36 @@ func (mr *MockStoreMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), ctx, id)
 }
 
+// DisableRuleInstance mocks base method.
+func (m *MockStore) DisableRuleInstance(ctx context.Context, arg db.DisableRuleInstanceParams) (db.RuleInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableRuleInstance", ctx, arg)
+	ret0, _ := ret[0].(db.RuleInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableRuleInstance indicates an expected call of DisableRuleInstance.
+func (mr *MockStoreMockRecorder) DisableRuleInstance(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableRuleInstance", reflect.TypeOf((*MockStore)(nil).DisableRuleInstance), ctx, arg)
+}
+
+// EnableRuleInstance mocks base method.
+func (m *MockStore) EnableRuleInstance(ctx context.Context, id uuid.UUID) (db.RuleInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableRuleInstance", ctx, id)
+	ret0, _ := ret[0].(db.RuleInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableRuleInstance indicates an expected call of EnableRuleInstance.
+func (mr *MockStoreMockRecorder) EnableRuleInstance(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableRuleInstance", reflect.TypeOf((*MockStore)(nil).EnableRuleInstance), ctx, id)
+}
+
 // EnqueueFlush mocks base method.
 func (m *MockStore) EnqueueFlush(ctx context.Context, arg db.EnqueueFlushParams) (db.FlushCache, error) {
 	m.ctrl.T.Helper()
@@ -821,6 +1379,20 @@ func (mr *MockStoreMockRecorder) EntityExistsAfterID(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EntityExistsAfterID", reflect.TypeOf((*MockStore)(nil).EntityExistsAfterID), ctx, arg)
 }
 
+// FailMigrationPhase mocks base method.
+func (m *MockStore) FailMigrationPhase(ctx context.Context, arg db.FailMigrationPhaseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailMigrationPhase", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailMigrationPhase indicates an expected call of FailMigrationPhase.
+func (mr *MockStoreMockRecorder) FailMigrationPhase(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailMigrationPhase", reflect.TypeOf((*MockStore)(nil).FailMigrationPhase), ctx, arg)
+}
+
 // FindProviders mocks base method.
 func (m *MockStore) FindProviders(ctx context.Context, arg db.FindProvidersParams) ([]db.Provider, error) {
 	m.ctrl.T.Helper()
@@ -851,6 +1423,21 @@ func (mr *MockStoreMockRecorder) FlushCache(ctx, entityInstanceID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushCache", reflect.TypeOf((*MockStore)(nil).FlushCache), ctx, entityInstanceID)
 }
 
+// GetAPIQuotaRetryAfter mocks base method.
+func (m *MockStore) GetAPIQuotaRetryAfter(ctx context.Context, arg db.GetAPIQuotaRetryAfterParams) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIQuotaRetryAfter", ctx, arg)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIQuotaRetryAfter indicates an expected call of GetAPIQuotaRetryAfter.
+func (mr *MockStoreMockRecorder) GetAPIQuotaRetryAfter(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIQuotaRetryAfter", reflect.TypeOf((*MockStore)(nil).GetAPIQuotaRetryAfter), ctx, arg)
+}
+
 // GetAccessTokenByEnrollmentNonce mocks base method.
 func (m *MockStore) GetAccessTokenByEnrollmentNonce(ctx context.Context, arg db.GetAccessTokenByEnrollmentNonceParams) (db.ProviderAccessToken, error) {
 	m.ctrl.T.Helper()
@@ -911,6 +1498,36 @@ func (mr *MockStoreMockRecorder) GetAccessTokenSinceDate(ctx, arg any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessTokenSinceDate", reflect.TypeOf((*MockStore)(nil).GetAccessTokenSinceDate), ctx, arg)
 }
 
+// GetActiveRuleException mocks base method.
+func (m *MockStore) GetActiveRuleException(ctx context.Context, arg db.GetActiveRuleExceptionParams) (db.RuleException, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveRuleException", ctx, arg)
+	ret0, _ := ret[0].(db.RuleException)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveRuleException indicates an expected call of GetActiveRuleException.
+func (mr *MockStoreMockRecorder) GetActiveRuleException(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveRuleException", reflect.TypeOf((*MockStore)(nil).GetActiveRuleException), ctx, arg)
+}
+
+// GetAlertGroup mocks base method.
+func (m *MockStore) GetAlertGroup(ctx context.Context, arg db.GetAlertGroupParams) (db.AlertGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlertGroup", ctx, arg)
+	ret0, _ := ret[0].(db.AlertGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlertGroup indicates an expected call of GetAlertGroup.
+func (mr *MockStoreMockRecorder) GetAlertGroup(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertGroup", reflect.TypeOf((*MockStore)(nil).GetAlertGroup), ctx, arg)
+}
+
 // GetAllPropertiesForEntity mocks base method.
 func (m *MockStore) GetAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) ([]db.Property, error) {
 	m.ctrl.T.Helper()
@@ -986,6 +1603,21 @@ func (mr *MockStoreMockRecorder) GetDataSourceByName(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataSourceByName", reflect.TypeOf((*MockStore)(nil).GetDataSourceByName), ctx, arg)
 }
 
+// GetEffectiveProjectTier mocks base method.
+func (m *MockStore) GetEffectiveProjectTier(ctx context.Context, projectID uuid.UUID) (db.ProjectTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffectiveProjectTier", ctx, projectID)
+	ret0, _ := ret[0].(db.ProjectTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveProjectTier indicates an expected call of GetEffectiveProjectTier.
+func (mr *MockStoreMockRecorder) GetEffectiveProjectTier(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveProjectTier", reflect.TypeOf((*MockStore)(nil).GetEffectiveProjectTier), ctx, projectID)
+}
+
 // GetEntitiesByProjectHierarchy mocks base method.
 func (m *MockStore) GetEntitiesByProjectHierarchy(ctx context.Context, projects []uuid.UUID) ([]db.EntityInstance, error) {
 	m.ctrl.T.Helper()
@@ -1106,6 +1738,21 @@ func (mr *MockStoreMockRecorder) GetEvaluationOutput(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluationOutput", reflect.TypeOf((*MockStore)(nil).GetEvaluationOutput), ctx, id)
 }
 
+// GetEvaluationRetry mocks base method.
+func (m *MockStore) GetEvaluationRetry(ctx context.Context, entityInstanceID uuid.UUID) (db.EvaluationRetry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvaluationRetry", ctx, entityInstanceID)
+	ret0, _ := ret[0].(db.EvaluationRetry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvaluationRetry indicates an expected call of GetEvaluationRetry.
+func (mr *MockStoreMockRecorder) GetEvaluationRetry(ctx, entityInstanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluationRetry", reflect.TypeOf((*MockStore)(nil).GetEvaluationRetry), ctx, entityInstanceID)
+}
+
 // GetFeatureInProject mocks base method.
 func (m *MockStore) GetFeatureInProject(ctx context.Context, arg db.GetFeatureInProjectParams) (json.RawMessage, error) {
 	m.ctrl.T.Helper()
@@ -1226,6 +1873,21 @@ func (mr *MockStoreMockRecorder) GetInvitationsByEmailAndProject(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitationsByEmailAndProject", reflect.TypeOf((*MockStore)(nil).GetInvitationsByEmailAndProject), ctx, arg)
 }
 
+// GetLatestEvalCheckpointForRuleEntity mocks base method.
+func (m *MockStore) GetLatestEvalCheckpointForRuleEntity(ctx context.Context, arg db.GetLatestEvalCheckpointForRuleEntityParams) (db.GetLatestEvalCheckpointForRuleEntityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestEvalCheckpointForRuleEntity", ctx, arg)
+	ret0, _ := ret[0].(db.GetLatestEvalCheckpointForRuleEntityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestEvalCheckpointForRuleEntity indicates an expected call of GetLatestEvalCheckpointForRuleEntity.
+func (mr *MockStoreMockRecorder) GetLatestEvalCheckpointForRuleEntity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestEvalCheckpointForRuleEntity", reflect.TypeOf((*MockStore)(nil).GetLatestEvalCheckpointForRuleEntity), ctx, arg)
+}
+
 // GetLatestEvalStateForRuleEntity mocks base method.
 func (m *MockStore) GetLatestEvalStateForRuleEntity(ctx context.Context, arg db.GetLatestEvalStateForRuleEntityParams) (db.EvaluationStatus, error) {
 	m.ctrl.T.Helper()
@@ -1241,6 +1903,21 @@ func (mr *MockStoreMockRecorder) GetLatestEvalStateForRuleEntity(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestEvalStateForRuleEntity", reflect.TypeOf((*MockStore)(nil).GetLatestEvalStateForRuleEntity), ctx, arg)
 }
 
+// GetMigrationPhase mocks base method.
+func (m *MockStore) GetMigrationPhase(ctx context.Context, name string) (db.MigrationPhase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationPhase", ctx, name)
+	ret0, _ := ret[0].(db.MigrationPhase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMigrationPhase indicates an expected call of GetMigrationPhase.
+func (mr *MockStoreMockRecorder) GetMigrationPhase(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationPhase", reflect.TypeOf((*MockStore)(nil).GetMigrationPhase), ctx, name)
+}
+
 // GetParentProjects mocks base method.
 func (m *MockStore) GetParentProjects(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -1271,6 +1948,21 @@ func (mr *MockStoreMockRecorder) GetParentProjectsUntil(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParentProjectsUntil", reflect.TypeOf((*MockStore)(nil).GetParentProjectsUntil), ctx, arg)
 }
 
+// GetPendingOperationByIDAndLock mocks base method.
+func (m *MockStore) GetPendingOperationByIDAndLock(ctx context.Context, arg db.GetPendingOperationByIDAndLockParams) (db.PendingOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOperationByIDAndLock", ctx, arg)
+	ret0, _ := ret[0].(db.PendingOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingOperationByIDAndLock indicates an expected call of GetPendingOperationByIDAndLock.
+func (mr *MockStoreMockRecorder) GetPendingOperationByIDAndLock(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOperationByIDAndLock", reflect.TypeOf((*MockStore)(nil).GetPendingOperationByIDAndLock), ctx, arg)
+}
+
 // GetProfileByID mocks base method.
 func (m *MockStore) GetProfileByID(ctx context.Context, arg db.GetProfileByIDParams) (db.Profile, error) {
 	m.ctrl.T.Helper()
@@ -1301,6 +1993,21 @@ func (mr *MockStoreMockRecorder) GetProfileByIDAndLock(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileByIDAndLock", reflect.TypeOf((*MockStore)(nil).GetProfileByIDAndLock), ctx, arg)
 }
 
+// GetProfileByName mocks base method.
+func (m *MockStore) GetProfileByName(ctx context.Context, arg db.GetProfileByNameParams) (db.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfileByName", ctx, arg)
+	ret0, _ := ret[0].(db.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfileByName indicates an expected call of GetProfileByName.
+func (mr *MockStoreMockRecorder) GetProfileByName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileByName", reflect.TypeOf((*MockStore)(nil).GetProfileByName), ctx, arg)
+}
+
 // GetProfileByNameAndLock mocks base method.
 func (m *MockStore) GetProfileByNameAndLock(ctx context.Context, arg db.GetProfileByNameAndLockParams) (db.Profile, error) {
 	m.ctrl.T.Helper()
@@ -1391,6 +2098,36 @@ func (mr *MockStoreMockRecorder) GetProfileStatusByProject(ctx, projectID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileStatusByProject", reflect.TypeOf((*MockStore)(nil).GetProfileStatusByProject), ctx, projectID)
 }
 
+// GetProfileStatusShareLink mocks base method.
+func (m *MockStore) GetProfileStatusShareLink(ctx context.Context, arg db.GetProfileStatusShareLinkParams) (db.ProfileStatusShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfileStatusShareLink", ctx, arg)
+	ret0, _ := ret[0].(db.ProfileStatusShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfileStatusShareLink indicates an expected call of GetProfileStatusShareLink.
+func (mr *MockStoreMockRecorder) GetProfileStatusShareLink(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileStatusShareLink", reflect.TypeOf((*MockStore)(nil).GetProfileStatusShareLink), ctx, arg)
+}
+
+// GetProfileVersion mocks base method.
+func (m *MockStore) GetProfileVersion(ctx context.Context, arg db.GetProfileVersionParams) (db.ProfileVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfileVersion", ctx, arg)
+	ret0, _ := ret[0].(db.ProfileVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfileVersion indicates an expected call of GetProfileVersion.
+func (mr *MockStoreMockRecorder) GetProfileVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileVersion", reflect.TypeOf((*MockStore)(nil).GetProfileVersion), ctx, arg)
+}
+
 // GetProjectByID mocks base method.
 func (m *MockStore) GetProjectByID(ctx context.Context, id uuid.UUID) (db.Project, error) {
 	m.ctrl.T.Helper()
@@ -1421,6 +2158,21 @@ func (mr *MockStoreMockRecorder) GetProjectByName(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectByName", reflect.TypeOf((*MockStore)(nil).GetProjectByName), ctx, name)
 }
 
+// GetProjectDeployKeyByName mocks base method.
+func (m *MockStore) GetProjectDeployKeyByName(ctx context.Context, arg db.GetProjectDeployKeyByNameParams) (db.ProjectDeployKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectDeployKeyByName", ctx, arg)
+	ret0, _ := ret[0].(db.ProjectDeployKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectDeployKeyByName indicates an expected call of GetProjectDeployKeyByName.
+func (mr *MockStoreMockRecorder) GetProjectDeployKeyByName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDeployKeyByName", reflect.TypeOf((*MockStore)(nil).GetProjectDeployKeyByName), ctx, arg)
+}
+
 // GetProjectIDBySessionState mocks base method.
 func (m *MockStore) GetProjectIDBySessionState(ctx context.Context, sessionState string) (db.GetProjectIDBySessionStateRow, error) {
 	m.ctrl.T.Helper()
@@ -1436,6 +2188,36 @@ func (mr *MockStoreMockRecorder) GetProjectIDBySessionState(ctx, sessionState an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectIDBySessionState", reflect.TypeOf((*MockStore)(nil).GetProjectIDBySessionState), ctx, sessionState)
 }
 
+// GetProjectSecretByName mocks base method.
+func (m *MockStore) GetProjectSecretByName(ctx context.Context, arg db.GetProjectSecretByNameParams) (db.ProjectSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectSecretByName", ctx, arg)
+	ret0, _ := ret[0].(db.ProjectSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectSecretByName indicates an expected call of GetProjectSecretByName.
+func (mr *MockStoreMockRecorder) GetProjectSecretByName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectSecretByName", reflect.TypeOf((*MockStore)(nil).GetProjectSecretByName), ctx, arg)
+}
+
+// GetProjectTierByName mocks base method.
+func (m *MockStore) GetProjectTierByName(ctx context.Context, name string) (db.ProjectTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectTierByName", ctx, name)
+	ret0, _ := ret[0].(db.ProjectTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectTierByName indicates an expected call of GetProjectTierByName.
+func (mr *MockStoreMockRecorder) GetProjectTierByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectTierByName", reflect.TypeOf((*MockStore)(nil).GetProjectTierByName), ctx, name)
+}
+
 // GetProperty mocks base method.
 func (m *MockStore) GetProperty(ctx context.Context, arg db.GetPropertyParams) (db.Property, error) {
 	m.ctrl.T.Helper()
@@ -1496,6 +2278,21 @@ func (mr *MockStoreMockRecorder) GetProviderByName(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProviderByName", reflect.TypeOf((*MockStore)(nil).GetProviderByName), ctx, arg)
 }
 
+// GetProviderHealth mocks base method.
+func (m *MockStore) GetProviderHealth(ctx context.Context, providerID uuid.UUID) (db.ProviderHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProviderHealth", ctx, providerID)
+	ret0, _ := ret[0].(db.ProviderHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProviderHealth indicates an expected call of GetProviderHealth.
+func (mr *MockStoreMockRecorder) GetProviderHealth(ctx, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProviderHealth", reflect.TypeOf((*MockStore)(nil).GetProviderHealth), ctx, providerID)
+}
+
 // GetQuerierWithTransaction mocks base method.
 func (m *MockStore) GetQuerierWithTransaction(tx *sql.Tx) db.ExtendQuerier {
 	m.ctrl.T.Helper()
@@ -1510,6 +2307,21 @@ func (mr *MockStoreMockRecorder) GetQuerierWithTransaction(tx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuerierWithTransaction", reflect.TypeOf((*MockStore)(nil).GetQuerierWithTransaction), tx)
 }
 
+// GetRepositoryListingCursor mocks base method.
+func (m *MockStore) GetRepositoryListingCursor(ctx context.Context, arg db.GetRepositoryListingCursorParams) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoryListingCursor", ctx, arg)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoryListingCursor indicates an expected call of GetRepositoryListingCursor.
+func (mr *MockStoreMockRecorder) GetRepositoryListingCursor(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryListingCursor", reflect.TypeOf((*MockStore)(nil).GetRepositoryListingCursor), ctx, arg)
+}
+
 // GetRootProjectByID mocks base method.
 func (m *MockStore) GetRootProjectByID(ctx context.Context, id uuid.UUID) (db.Project, error) {
 	m.ctrl.T.Helper()
@@ -1540,6 +2352,51 @@ func (mr *MockStoreMockRecorder) GetRuleEvaluationByProfileIdAndRuleType(ctx, pr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleEvaluationByProfileIdAndRuleType", reflect.TypeOf((*MockStore)(nil).GetRuleEvaluationByProfileIdAndRuleType), ctx, profileID, ruleName, entityID, ruleTypeName)
 }
 
+// GetRuleExceptionByIDAndLock mocks base method.
+func (m *MockStore) GetRuleExceptionByIDAndLock(ctx context.Context, arg db.GetRuleExceptionByIDAndLockParams) (db.RuleException, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleExceptionByIDAndLock", ctx, arg)
+	ret0, _ := ret[0].(db.RuleException)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleExceptionByIDAndLock indicates an expected call of GetRuleExceptionByIDAndLock.
+func (mr *MockStoreMockRecorder) GetRuleExceptionByIDAndLock(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleExceptionByIDAndLock", reflect.TypeOf((*MockStore)(nil).GetRuleExceptionByIDAndLock), ctx, arg)
+}
+
+// GetRuleInstanceByProfileName mocks base method.
+func (m *MockStore) GetRuleInstanceByProfileName(ctx context.Context, arg db.GetRuleInstanceByProfileNameParams) (db.RuleInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleInstanceByProfileName", ctx, arg)
+	ret0, _ := ret[0].(db.RuleInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleInstanceByProfileName indicates an expected call of GetRuleInstanceByProfileName.
+func (mr *MockStoreMockRecorder) GetRuleInstanceByProfileName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleInstanceByProfileName", reflect.TypeOf((*MockStore)(nil).GetRuleInstanceByProfileName), ctx, arg)
+}
+
+// GetRuleInstancesByProfileName mocks base method.
+func (m *MockStore) GetRuleInstancesByProfileName(ctx context.Context, arg db.GetRuleInstancesByProfileNameParams) ([]db.RuleInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleInstancesByProfileName", ctx, arg)
+	ret0, _ := ret[0].([]db.RuleInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleInstancesByProfileName indicates an expected call of GetRuleInstancesByProfileName.
+func (mr *MockStoreMockRecorder) GetRuleInstancesByProfileName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleInstancesByProfileName", reflect.TypeOf((*MockStore)(nil).GetRuleInstancesByProfileName), ctx, arg)
+}
+
 // GetRuleInstancesEntityInProjects mocks base method.
 func (m *MockStore) GetRuleInstancesEntityInProjects(ctx context.Context, arg db.GetRuleInstancesEntityInProjectsParams) ([]db.RuleInstance, error) {
 	m.ctrl.T.Helper()
@@ -1868,6 +2725,36 @@ func (mr *MockStoreMockRecorder) ListAllRootProjects(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRootProjects", reflect.TypeOf((*MockStore)(nil).ListAllRootProjects), ctx)
 }
 
+// ListCanaryRuleResults mocks base method.
+func (m *MockStore) ListCanaryRuleResults(ctx context.Context, profileID uuid.UUID) ([]db.ListCanaryRuleResultsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCanaryRuleResults", ctx, profileID)
+	ret0, _ := ret[0].([]db.ListCanaryRuleResultsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCanaryRuleResults indicates an expected call of ListCanaryRuleResults.
+func (mr *MockStoreMockRecorder) ListCanaryRuleResults(ctx, profileID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCanaryRuleResults", reflect.TypeOf((*MockStore)(nil).ListCanaryRuleResults), ctx, profileID)
+}
+
+// ListClosedEntitiesBefore mocks base method.
+func (m *MockStore) ListClosedEntitiesBefore(ctx context.Context, arg db.ListClosedEntitiesBeforeParams) ([]db.ClosedEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClosedEntitiesBefore", ctx, arg)
+	ret0, _ := ret[0].([]db.ClosedEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClosedEntitiesBefore indicates an expected call of ListClosedEntitiesBefore.
+func (mr *MockStoreMockRecorder) ListClosedEntitiesBefore(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClosedEntitiesBefore", reflect.TypeOf((*MockStore)(nil).ListClosedEntitiesBefore), ctx, arg)
+}
+
 // ListDataSourceFunctions mocks base method.
 func (m *MockStore) ListDataSourceFunctions(ctx context.Context, arg db.ListDataSourceFunctionsParams) ([]db.DataSourcesFunction, error) {
 	m.ctrl.T.Helper()
@@ -1898,21 +2785,111 @@ func (mr *MockStoreMockRecorder) ListDataSources(ctx, projects any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDataSources", reflect.TypeOf((*MockStore)(nil).ListDataSources), ctx, projects)
 }
 
-// ListEntitiesAfterID mocks base method.
-func (m *MockStore) ListEntitiesAfterID(ctx context.Context, arg db.ListEntitiesAfterIDParams) ([]db.EntityInstance, error) {
+// ListDisabledRuleInstances mocks base method.
+func (m *MockStore) ListDisabledRuleInstances(ctx context.Context, projectID uuid.UUID) ([]db.ListDisabledRuleInstancesRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntitiesAfterID", ctx, arg)
-	ret0, _ := ret[0].([]db.EntityInstance)
+	ret := m.ctrl.Call(m, "ListDisabledRuleInstances", ctx, projectID)
+	ret0, _ := ret[0].([]db.ListDisabledRuleInstancesRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntitiesAfterID indicates an expected call of ListEntitiesAfterID.
-func (mr *MockStoreMockRecorder) ListEntitiesAfterID(ctx, arg any) *gomock.Call {
+// ListDisabledRuleInstances indicates an expected call of ListDisabledRuleInstances.
+func (mr *MockStoreMockRecorder) ListDisabledRuleInstances(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisabledRuleInstances", reflect.TypeOf((*MockStore)(nil).ListDisabledRuleInstances), ctx, projectID)
+}
+
+// ListEntitiesAfterID mocks base method.
+func (m *MockStore) ListEntitiesAfterID(ctx context.Context, arg db.ListEntitiesAfterIDParams) ([]db.EntityInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntitiesAfterID", ctx, arg)
+	ret0, _ := ret[0].([]db.EntityInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntitiesAfterID indicates an expected call of ListEntitiesAfterID.
+func (mr *MockStoreMockRecorder) ListEntitiesAfterID(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntitiesAfterID", reflect.TypeOf((*MockStore)(nil).ListEntitiesAfterID), ctx, arg)
 }
 
+// ListEntityProjectsByProvider mocks base method.
+func (m *MockStore) ListEntityProjectsByProvider(ctx context.Context, providerID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntityProjectsByProvider", ctx, providerID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntityProjectsByProvider indicates an expected call of ListEntityProjectsByProvider.
+func (mr *MockStoreMockRecorder) ListEntityProjectsByProvider(ctx, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntityProjectsByProvider", reflect.TypeOf((*MockStore)(nil).ListEntityProjectsByProvider), ctx, providerID)
+}
+
+// ListEntityTimeline mocks base method.
+func (m *MockStore) ListEntityTimeline(ctx context.Context, arg db.ListEntityTimelineParams) ([]db.ListEntityTimelineRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntityTimeline", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEntityTimelineRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntityTimeline indicates an expected call of ListEntityTimeline.
+func (mr *MockStoreMockRecorder) ListEntityTimeline(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntityTimeline", reflect.TypeOf((*MockStore)(nil).ListEntityTimeline), ctx, arg)
+}
+
+// ListEnumValues mocks base method.
+func (m *MockStore) ListEnumValues(ctx context.Context) ([]db.ListEnumValuesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnumValues", ctx)
+	ret0, _ := ret[0].([]db.ListEnumValuesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnumValues indicates an expected call of ListEnumValues.
+func (mr *MockStoreMockRecorder) ListEnumValues(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnumValues", reflect.TypeOf((*MockStore)(nil).ListEnumValues), ctx)
+}
+
+// ListEvaluationAnnotationsByEvaluationIDs mocks base method.
+func (m *MockStore) ListEvaluationAnnotationsByEvaluationIDs(ctx context.Context, evaluationIds []uuid.UUID) ([]db.EvaluationAnnotation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvaluationAnnotationsByEvaluationIDs", ctx, evaluationIds)
+	ret0, _ := ret[0].([]db.EvaluationAnnotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvaluationAnnotationsByEvaluationIDs indicates an expected call of ListEvaluationAnnotationsByEvaluationIDs.
+func (mr *MockStoreMockRecorder) ListEvaluationAnnotationsByEvaluationIDs(ctx, evaluationIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationAnnotationsByEvaluationIDs", reflect.TypeOf((*MockStore)(nil).ListEvaluationAnnotationsByEvaluationIDs), ctx, evaluationIds)
+}
+
+// ListEvaluationAnnotationsForFindings mocks base method.
+func (m *MockStore) ListEvaluationAnnotationsForFindings(ctx context.Context, evaluationIds []uuid.UUID) ([]db.ListEvaluationAnnotationsForFindingsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvaluationAnnotationsForFindings", ctx, evaluationIds)
+	ret0, _ := ret[0].([]db.ListEvaluationAnnotationsForFindingsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvaluationAnnotationsForFindings indicates an expected call of ListEvaluationAnnotationsForFindings.
+func (mr *MockStoreMockRecorder) ListEvaluationAnnotationsForFindings(ctx, evaluationIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationAnnotationsForFindings", reflect.TypeOf((*MockStore)(nil).ListEvaluationAnnotationsForFindings), ctx, evaluationIds)
+}
+
 // ListEvaluationHistory mocks base method.
 func (m *MockStore) ListEvaluationHistory(ctx context.Context, arg db.ListEvaluationHistoryParams) ([]db.ListEvaluationHistoryRow, error) {
 	m.ctrl.T.Helper()
@@ -1958,6 +2935,21 @@ func (mr *MockStoreMockRecorder) ListFlushCache(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlushCache", reflect.TypeOf((*MockStore)(nil).ListFlushCache), ctx)
 }
 
+// ListInvalidIndexes mocks base method.
+func (m *MockStore) ListInvalidIndexes(ctx context.Context) ([]db.ListInvalidIndexesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvalidIndexes", ctx)
+	ret0, _ := ret[0].([]db.ListInvalidIndexesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvalidIndexes indicates an expected call of ListInvalidIndexes.
+func (mr *MockStoreMockRecorder) ListInvalidIndexes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvalidIndexes", reflect.TypeOf((*MockStore)(nil).ListInvalidIndexes), ctx)
+}
+
 // ListInvitationsForProject mocks base method.
 func (m *MockStore) ListInvitationsForProject(ctx context.Context, project uuid.UUID) ([]db.ListInvitationsForProjectRow, error) {
 	m.ctrl.T.Helper()
@@ -1973,6 +2965,36 @@ func (mr *MockStoreMockRecorder) ListInvitationsForProject(ctx, project any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitationsForProject", reflect.TypeOf((*MockStore)(nil).ListInvitationsForProject), ctx, project)
 }
 
+// ListLatestEvaluationsByProject mocks base method.
+func (m *MockStore) ListLatestEvaluationsByProject(ctx context.Context, projectID uuid.UUID) ([]db.ListLatestEvaluationsByProjectRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLatestEvaluationsByProject", ctx, projectID)
+	ret0, _ := ret[0].([]db.ListLatestEvaluationsByProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLatestEvaluationsByProject indicates an expected call of ListLatestEvaluationsByProject.
+func (mr *MockStoreMockRecorder) ListLatestEvaluationsByProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLatestEvaluationsByProject", reflect.TypeOf((*MockStore)(nil).ListLatestEvaluationsByProject), ctx, projectID)
+}
+
+// ListMigrationPhases mocks base method.
+func (m *MockStore) ListMigrationPhases(ctx context.Context) ([]db.MigrationPhase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMigrationPhases", ctx)
+	ret0, _ := ret[0].([]db.MigrationPhase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMigrationPhases indicates an expected call of ListMigrationPhases.
+func (mr *MockStoreMockRecorder) ListMigrationPhases(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMigrationPhases", reflect.TypeOf((*MockStore)(nil).ListMigrationPhases), ctx)
+}
+
 // ListOldestRuleEvaluationsByEntityID mocks base method.
 func (m *MockStore) ListOldestRuleEvaluationsByEntityID(ctx context.Context, entityIds []uuid.UUID) ([]db.ListOldestRuleEvaluationsByEntityIDRow, error) {
 	m.ctrl.T.Helper()
@@ -2003,6 +3025,51 @@ func (mr *MockStoreMockRecorder) ListOldestRuleEvaluationsByRepositoryId(ctx, re
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOldestRuleEvaluationsByRepositoryId", reflect.TypeOf((*MockStore)(nil).ListOldestRuleEvaluationsByRepositoryId), ctx, repositoryIds)
 }
 
+// ListPendingOperations mocks base method.
+func (m *MockStore) ListPendingOperations(ctx context.Context, projectID uuid.UUID) ([]db.PendingOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingOperations", ctx, projectID)
+	ret0, _ := ret[0].([]db.PendingOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingOperations indicates an expected call of ListPendingOperations.
+func (mr *MockStoreMockRecorder) ListPendingOperations(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingOperations", reflect.TypeOf((*MockStore)(nil).ListPendingOperations), ctx, projectID)
+}
+
+// ListProfileBases mocks base method.
+func (m *MockStore) ListProfileBases(ctx context.Context, profileIds []uuid.UUID) ([]db.ListProfileBasesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProfileBases", ctx, profileIds)
+	ret0, _ := ret[0].([]db.ListProfileBasesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProfileBases indicates an expected call of ListProfileBases.
+func (mr *MockStoreMockRecorder) ListProfileBases(ctx, profileIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfileBases", reflect.TypeOf((*MockStore)(nil).ListProfileBases), ctx, profileIds)
+}
+
+// ListProfileVersions mocks base method.
+func (m *MockStore) ListProfileVersions(ctx context.Context, profileID uuid.UUID) ([]db.ProfileVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProfileVersions", ctx, profileID)
+	ret0, _ := ret[0].([]db.ProfileVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProfileVersions indicates an expected call of ListProfileVersions.
+func (mr *MockStoreMockRecorder) ListProfileVersions(ctx, profileID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfileVersions", reflect.TypeOf((*MockStore)(nil).ListProfileVersions), ctx, profileID)
+}
+
 // ListProfilesByProjectIDAndLabel mocks base method.
 func (m *MockStore) ListProfilesByProjectIDAndLabel(ctx context.Context, arg db.ListProfilesByProjectIDAndLabelParams) ([]db.ListProfilesByProjectIDAndLabelRow, error) {
 	m.ctrl.T.Helper()
@@ -2018,6 +3085,21 @@ func (mr *MockStoreMockRecorder) ListProfilesByProjectIDAndLabel(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfilesByProjectIDAndLabel", reflect.TypeOf((*MockStore)(nil).ListProfilesByProjectIDAndLabel), ctx, arg)
 }
 
+// ListProfilesExtending mocks base method.
+func (m *MockStore) ListProfilesExtending(ctx context.Context, baseProfileID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProfilesExtending", ctx, baseProfileID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProfilesExtending indicates an expected call of ListProfilesExtending.
+func (mr *MockStoreMockRecorder) ListProfilesExtending(ctx, baseProfileID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfilesExtending", reflect.TypeOf((*MockStore)(nil).ListProfilesExtending), ctx, baseProfileID)
+}
+
 // ListProfilesInstantiatingRuleType mocks base method.
 func (m *MockStore) ListProfilesInstantiatingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -2033,6 +3115,96 @@ func (mr *MockStoreMockRecorder) ListProfilesInstantiatingRuleType(ctx, ruleType
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfilesInstantiatingRuleType", reflect.TypeOf((*MockStore)(nil).ListProfilesInstantiatingRuleType), ctx, ruleTypeID)
 }
 
+// ListProjectDeployKeys mocks base method.
+func (m *MockStore) ListProjectDeployKeys(ctx context.Context, projectID uuid.UUID) ([]db.ProjectDeployKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectDeployKeys", ctx, projectID)
+	ret0, _ := ret[0].([]db.ProjectDeployKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectDeployKeys indicates an expected call of ListProjectDeployKeys.
+func (mr *MockStoreMockRecorder) ListProjectDeployKeys(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectDeployKeys", reflect.TypeOf((*MockStore)(nil).ListProjectDeployKeys), ctx, projectID)
+}
+
+// ListProjectSecrets mocks base method.
+func (m *MockStore) ListProjectSecrets(ctx context.Context, projectID uuid.UUID) ([]db.ProjectSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectSecrets", ctx, projectID)
+	ret0, _ := ret[0].([]db.ProjectSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectSecrets indicates an expected call of ListProjectSecrets.
+func (mr *MockStoreMockRecorder) ListProjectSecrets(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectSecrets", reflect.TypeOf((*MockStore)(nil).ListProjectSecrets), ctx, projectID)
+}
+
+// ListProjectTiers mocks base method.
+func (m *MockStore) ListProjectTiers(ctx context.Context) ([]db.ProjectTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectTiers", ctx)
+	ret0, _ := ret[0].([]db.ProjectTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectTiers indicates an expected call of ListProjectTiers.
+func (mr *MockStoreMockRecorder) ListProjectTiers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectTiers", reflect.TypeOf((*MockStore)(nil).ListProjectTiers), ctx)
+}
+
+// ListProjectsUsingRuleType mocks base method.
+func (m *MockStore) ListProjectsUsingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsUsingRuleType", ctx, ruleTypeID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsUsingRuleType indicates an expected call of ListProjectsUsingRuleType.
+func (mr *MockStoreMockRecorder) ListProjectsUsingRuleType(ctx, ruleTypeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsUsingRuleType", reflect.TypeOf((*MockStore)(nil).ListProjectsUsingRuleType), ctx, ruleTypeID)
+}
+
+// ListProviderShares mocks base method.
+func (m *MockStore) ListProviderShares(ctx context.Context, providerID uuid.UUID) ([]db.ProviderShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProviderShares", ctx, providerID)
+	ret0, _ := ret[0].([]db.ProviderShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProviderShares indicates an expected call of ListProviderShares.
+func (mr *MockStoreMockRecorder) ListProviderShares(ctx, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProviderShares", reflect.TypeOf((*MockStore)(nil).ListProviderShares), ctx, providerID)
+}
+
+// ListProviderUsage mocks base method.
+func (m *MockStore) ListProviderUsage(ctx context.Context, arg db.ListProviderUsageParams) ([]db.ListProviderUsageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProviderUsage", ctx, arg)
+	ret0, _ := ret[0].([]db.ListProviderUsageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProviderUsage indicates an expected call of ListProviderUsage.
+func (mr *MockStoreMockRecorder) ListProviderUsage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProviderUsage", reflect.TypeOf((*MockStore)(nil).ListProviderUsage), ctx, arg)
+}
+
 // ListProvidersByProjectID mocks base method.
 func (m *MockStore) ListProvidersByProjectID(ctx context.Context, projects []uuid.UUID) ([]db.Provider, error) {
 	m.ctrl.T.Helper()
@@ -2078,6 +3250,51 @@ func (mr *MockStoreMockRecorder) ListRuleEvaluationsByProfileId(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleEvaluationsByProfileId", reflect.TypeOf((*MockStore)(nil).ListRuleEvaluationsByProfileId), ctx, arg)
 }
 
+// ListRuleExceptionEvents mocks base method.
+func (m *MockStore) ListRuleExceptionEvents(ctx context.Context, exceptionIds []uuid.UUID) ([]db.RuleExceptionEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuleExceptionEvents", ctx, exceptionIds)
+	ret0, _ := ret[0].([]db.RuleExceptionEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuleExceptionEvents indicates an expected call of ListRuleExceptionEvents.
+func (mr *MockStoreMockRecorder) ListRuleExceptionEvents(ctx, exceptionIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleExceptionEvents", reflect.TypeOf((*MockStore)(nil).ListRuleExceptionEvents), ctx, exceptionIds)
+}
+
+// ListRuleExceptions mocks base method.
+func (m *MockStore) ListRuleExceptions(ctx context.Context, arg db.ListRuleExceptionsParams) ([]db.ListRuleExceptionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuleExceptions", ctx, arg)
+	ret0, _ := ret[0].([]db.ListRuleExceptionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuleExceptions indicates an expected call of ListRuleExceptions.
+func (mr *MockStoreMockRecorder) ListRuleExceptions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleExceptions", reflect.TypeOf((*MockStore)(nil).ListRuleExceptions), ctx, arg)
+}
+
+// ListRuleTypeFailureCounts mocks base method.
+func (m *MockStore) ListRuleTypeFailureCounts(ctx context.Context, arg db.ListRuleTypeFailureCountsParams) ([]db.ListRuleTypeFailureCountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuleTypeFailureCounts", ctx, arg)
+	ret0, _ := ret[0].([]db.ListRuleTypeFailureCountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuleTypeFailureCounts indicates an expected call of ListRuleTypeFailureCounts.
+func (mr *MockStoreMockRecorder) ListRuleTypeFailureCounts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleTypeFailureCounts", reflect.TypeOf((*MockStore)(nil).ListRuleTypeFailureCounts), ctx, arg)
+}
+
 // ListRuleTypesByProject mocks base method.
 func (m *MockStore) ListRuleTypesByProject(ctx context.Context, projectID uuid.UUID) ([]db.RuleType, error) {
 	m.ctrl.T.Helper()
@@ -2108,6 +3325,21 @@ func (mr *MockStoreMockRecorder) ListRuleTypesReferencesByDataSource(ctx, dataSo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleTypesReferencesByDataSource", reflect.TypeOf((*MockStore)(nil).ListRuleTypesReferencesByDataSource), ctx, dataSourcesID)
 }
 
+// ListStaleEvaluationRuleEntities mocks base method.
+func (m *MockStore) ListStaleEvaluationRuleEntities(ctx context.Context, arg db.ListStaleEvaluationRuleEntitiesParams) ([]db.ListStaleEvaluationRuleEntitiesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStaleEvaluationRuleEntities", ctx, arg)
+	ret0, _ := ret[0].([]db.ListStaleEvaluationRuleEntitiesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaleEvaluationRuleEntities indicates an expected call of ListStaleEvaluationRuleEntities.
+func (mr *MockStoreMockRecorder) ListStaleEvaluationRuleEntities(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaleEvaluationRuleEntities", reflect.TypeOf((*MockStore)(nil).ListStaleEvaluationRuleEntities), ctx, arg)
+}
+
 // ListTokensToMigrate mocks base method.
 func (m *MockStore) ListTokensToMigrate(ctx context.Context, arg db.ListTokensToMigrateParams) ([]db.ProviderAccessToken, error) {
 	m.ctrl.T.Helper()
@@ -2168,6 +3400,51 @@ func (mr *MockStoreMockRecorder) OrphanProject(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrphanProject", reflect.TypeOf((*MockStore)(nil).OrphanProject), ctx, arg)
 }
 
+// PopDeferredActions mocks base method.
+func (m *MockStore) PopDeferredActions(ctx context.Context, size int64) ([]db.DeferredAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopDeferredActions", ctx, size)
+	ret0, _ := ret[0].([]db.DeferredAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopDeferredActions indicates an expected call of PopDeferredActions.
+func (mr *MockStoreMockRecorder) PopDeferredActions(ctx, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopDeferredActions", reflect.TypeOf((*MockStore)(nil).PopDeferredActions), ctx, size)
+}
+
+// PopDueAlertGroups mocks base method.
+func (m *MockStore) PopDueAlertGroups(ctx context.Context, size int64) ([]db.AlertGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopDueAlertGroups", ctx, size)
+	ret0, _ := ret[0].([]db.AlertGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopDueAlertGroups indicates an expected call of PopDueAlertGroups.
+func (mr *MockStoreMockRecorder) PopDueAlertGroups(ctx, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopDueAlertGroups", reflect.TypeOf((*MockStore)(nil).PopDueAlertGroups), ctx, size)
+}
+
+// PopDueThrottledEvaluations mocks base method.
+func (m *MockStore) PopDueThrottledEvaluations(ctx context.Context, size int64) ([]db.ThrottledEvaluation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopDueThrottledEvaluations", ctx, size)
+	ret0, _ := ret[0].([]db.ThrottledEvaluation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopDueThrottledEvaluations indicates an expected call of PopDueThrottledEvaluations.
+func (mr *MockStoreMockRecorder) PopDueThrottledEvaluations(ctx, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopDueThrottledEvaluations", reflect.TypeOf((*MockStore)(nil).PopDueThrottledEvaluations), ctx, size)
+}
+
 // ReleaseLock mocks base method.
 func (m *MockStore) ReleaseLock(ctx context.Context, arg db.ReleaseLockParams) error {
 	m.ctrl.T.Helper()
@@ -2182,6 +3459,35 @@ func (mr *MockStoreMockRecorder) ReleaseLock(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLock", reflect.TypeOf((*MockStore)(nil).ReleaseLock), ctx, arg)
 }
 
+// ReopenEntity mocks base method.
+func (m *MockStore) ReopenEntity(ctx context.Context, entityInstanceID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenEntity", ctx, entityInstanceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReopenEntity indicates an expected call of ReopenEntity.
+func (mr *MockStoreMockRecorder) ReopenEntity(ctx, entityInstanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenEntity", reflect.TypeOf((*MockStore)(nil).ReopenEntity), ctx, entityInstanceID)
+}
+
+// ReviewRuleException mocks base method.
+func (m *MockStore) ReviewRuleException(ctx context.Context, arg db.ReviewRuleExceptionParams) (db.RuleException, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewRuleException", ctx, arg)
+	ret0, _ := ret[0].(db.RuleException)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewRuleException indicates an expected call of ReviewRuleException.
+func (mr *MockStoreMockRecorder) ReviewRuleException(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewRuleException", reflect.TypeOf((*MockStore)(nil).ReviewRuleException), ctx, arg)
+}
+
 // Rollback mocks base method.
 func (m *MockStore) Rollback(tx *sql.Tx) error {
 	m.ctrl.T.Helper()
@@ -2196,6 +3502,34 @@ func (mr *MockStoreMockRecorder) Rollback(tx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockStore)(nil).Rollback), tx)
 }
 
+// SetBundleSignatureVerification mocks base method.
+func (m *MockStore) SetBundleSignatureVerification(ctx context.Context, arg db.SetBundleSignatureVerificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBundleSignatureVerification", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBundleSignatureVerification indicates an expected call of SetBundleSignatureVerification.
+func (mr *MockStoreMockRecorder) SetBundleSignatureVerification(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBundleSignatureVerification", reflect.TypeOf((*MockStore)(nil).SetBundleSignatureVerification), ctx, arg)
+}
+
+// SetProviderHealthAlertedExpiry mocks base method.
+func (m *MockStore) SetProviderHealthAlertedExpiry(ctx context.Context, arg db.SetProviderHealthAlertedExpiryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProviderHealthAlertedExpiry", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProviderHealthAlertedExpiry indicates an expected call of SetProviderHealthAlertedExpiry.
+func (mr *MockStoreMockRecorder) SetProviderHealthAlertedExpiry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProviderHealthAlertedExpiry", reflect.TypeOf((*MockStore)(nil).SetProviderHealthAlertedExpiry), ctx, arg)
+}
+
 // SetSubscriptionBundleVersion mocks base method.
 func (m *MockStore) SetSubscriptionBundleVersion(ctx context.Context, arg db.SetSubscriptionBundleVersionParams) error {
 	m.ctrl.T.Helper()
@@ -2210,6 +3544,51 @@ func (mr *MockStoreMockRecorder) SetSubscriptionBundleVersion(ctx, arg any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubscriptionBundleVersion", reflect.TypeOf((*MockStore)(nil).SetSubscriptionBundleVersion), ctx, arg)
 }
 
+// StartMigrationPhase mocks base method.
+func (m *MockStore) StartMigrationPhase(ctx context.Context, arg db.StartMigrationPhaseParams) (db.MigrationPhase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartMigrationPhase", ctx, arg)
+	ret0, _ := ret[0].(db.MigrationPhase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartMigrationPhase indicates an expected call of StartMigrationPhase.
+func (mr *MockStoreMockRecorder) StartMigrationPhase(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMigrationPhase", reflect.TypeOf((*MockStore)(nil).StartMigrationPhase), ctx, arg)
+}
+
+// TakeAPIQuotaToken mocks base method.
+func (m *MockStore) TakeAPIQuotaToken(ctx context.Context, arg db.TakeAPIQuotaTokenParams) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeAPIQuotaToken", ctx, arg)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeAPIQuotaToken indicates an expected call of TakeAPIQuotaToken.
+func (mr *MockStoreMockRecorder) TakeAPIQuotaToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeAPIQuotaToken", reflect.TypeOf((*MockStore)(nil).TakeAPIQuotaToken), ctx, arg)
+}
+
+// UnassignProjectTier mocks base method.
+func (m *MockStore) UnassignProjectTier(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignProjectTier", ctx, projectID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnassignProjectTier indicates an expected call of UnassignProjectTier.
+func (mr *MockStoreMockRecorder) UnassignProjectTier(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignProjectTier", reflect.TypeOf((*MockStore)(nil).UnassignProjectTier), ctx, projectID)
+}
+
 // UpdateDataSource mocks base method.
 func (m *MockStore) UpdateDataSource(ctx context.Context, arg db.UpdateDataSourceParams) (db.DataSource, error) {
 	m.ctrl.T.Helper()
@@ -2254,6 +3633,34 @@ func (mr *MockStoreMockRecorder) UpdateEncryptedSecret(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEncryptedSecret", reflect.TypeOf((*MockStore)(nil).UpdateEncryptedSecret), ctx, arg)
 }
 
+// UpdateEntityName mocks base method.
+func (m *MockStore) UpdateEntityName(ctx context.Context, arg db.UpdateEntityNameParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEntityName", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEntityName indicates an expected call of UpdateEntityName.
+func (mr *MockStoreMockRecorder) UpdateEntityName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntityName", reflect.TypeOf((*MockStore)(nil).UpdateEntityName), ctx, arg)
+}
+
+// UpdateEntityOriginatedFrom mocks base method.
+func (m *MockStore) UpdateEntityOriginatedFrom(ctx context.Context, arg db.UpdateEntityOriginatedFromParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEntityOriginatedFrom", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEntityOriginatedFrom indicates an expected call of UpdateEntityOriginatedFrom.
+func (mr *MockStoreMockRecorder) UpdateEntityOriginatedFrom(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntityOriginatedFrom", reflect.TypeOf((*MockStore)(nil).UpdateEntityOriginatedFrom), ctx, arg)
+}
+
 // UpdateInvitationRole mocks base method.
 func (m *MockStore) UpdateInvitationRole(ctx context.Context, arg db.UpdateInvitationRoleParams) (db.UserInvite, error) {
 	m.ctrl.T.Helper()
@@ -2283,6 +3690,20 @@ func (mr *MockStoreMockRecorder) UpdateLease(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLease", reflect.TypeOf((*MockStore)(nil).UpdateLease), ctx, arg)
 }
 
+// UpdatePendingOperationState mocks base method.
+func (m *MockStore) UpdatePendingOperationState(ctx context.Context, arg db.UpdatePendingOperationStateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePendingOperationState", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePendingOperationState indicates an expected call of UpdatePendingOperationState.
+func (mr *MockStoreMockRecorder) UpdatePendingOperationState(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePendingOperationState", reflect.TypeOf((*MockStore)(nil).UpdatePendingOperationState), ctx, arg)
+}
+
 // UpdateProfile mocks base method.
 func (m *MockStore) UpdateProfile(ctx context.Context, arg db.UpdateProfileParams) (db.Profile, error) {
 	m.ctrl.T.Helper()
@@ -2372,6 +3793,21 @@ func (mr *MockStoreMockRecorder) UpsertAccessToken(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAccessToken", reflect.TypeOf((*MockStore)(nil).UpsertAccessToken), ctx, arg)
 }
 
+// UpsertAlertGroup mocks base method.
+func (m *MockStore) UpsertAlertGroup(ctx context.Context, arg db.UpsertAlertGroupParams) (db.AlertGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAlertGroup", ctx, arg)
+	ret0, _ := ret[0].(db.AlertGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAlertGroup indicates an expected call of UpsertAlertGroup.
+func (mr *MockStoreMockRecorder) UpsertAlertGroup(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAlertGroup", reflect.TypeOf((*MockStore)(nil).UpsertAlertGroup), ctx, arg)
+}
+
 // UpsertBundle mocks base method.
 func (m *MockStore) UpsertBundle(ctx context.Context, arg db.UpsertBundleParams) error {
 	m.ctrl.T.Helper()
@@ -2400,6 +3836,21 @@ func (mr *MockStoreMockRecorder) UpsertEvaluationOutput(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertEvaluationOutput", reflect.TypeOf((*MockStore)(nil).UpsertEvaluationOutput), ctx, arg)
 }
 
+// UpsertEvaluationRetry mocks base method.
+func (m *MockStore) UpsertEvaluationRetry(ctx context.Context, arg db.UpsertEvaluationRetryParams) (db.EvaluationRetry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertEvaluationRetry", ctx, arg)
+	ret0, _ := ret[0].(db.EvaluationRetry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertEvaluationRetry indicates an expected call of UpsertEvaluationRetry.
+func (mr *MockStoreMockRecorder) UpsertEvaluationRetry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertEvaluationRetry", reflect.TypeOf((*MockStore)(nil).UpsertEvaluationRetry), ctx, arg)
+}
+
 // UpsertInstallationID mocks base method.
 func (m *MockStore) UpsertInstallationID(ctx context.Context, arg db.UpsertInstallationIDParams) (db.ProviderGithubAppInstallation, error) {
 	m.ctrl.T.Helper()
@@ -2444,6 +3895,36 @@ func (mr *MockStoreMockRecorder) UpsertProfileForEntity(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProfileForEntity", reflect.TypeOf((*MockStore)(nil).UpsertProfileForEntity), ctx, arg)
 }
 
+// UpsertProjectSecret mocks base method.
+func (m *MockStore) UpsertProjectSecret(ctx context.Context, arg db.UpsertProjectSecretParams) (db.ProjectSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProjectSecret", ctx, arg)
+	ret0, _ := ret[0].(db.ProjectSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProjectSecret indicates an expected call of UpsertProjectSecret.
+func (mr *MockStoreMockRecorder) UpsertProjectSecret(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProjectSecret", reflect.TypeOf((*MockStore)(nil).UpsertProjectSecret), ctx, arg)
+}
+
+// UpsertProjectTier mocks base method.
+func (m *MockStore) UpsertProjectTier(ctx context.Context, arg db.UpsertProjectTierParams) (db.ProjectTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProjectTier", ctx, arg)
+	ret0, _ := ret[0].(db.ProjectTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProjectTier indicates an expected call of UpsertProjectTier.
+func (mr *MockStoreMockRecorder) UpsertProjectTier(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProjectTier", reflect.TypeOf((*MockStore)(nil).UpsertProjectTier), ctx, arg)
+}
+
 // UpsertProperty mocks base method.
 func (m *MockStore) UpsertProperty(ctx context.Context, arg db.UpsertPropertyParams) (db.Property, error) {
 	m.ctrl.T.Helper()
@@ -2474,6 +3955,35 @@ func (mr *MockStoreMockRecorder) UpsertPropertyValueV1(ctx, params any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPropertyValueV1", reflect.TypeOf((*MockStore)(nil).UpsertPropertyValueV1), ctx, params)
 }
 
+// UpsertProviderHealth mocks base method.
+func (m *MockStore) UpsertProviderHealth(ctx context.Context, arg db.UpsertProviderHealthParams) (db.ProviderHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProviderHealth", ctx, arg)
+	ret0, _ := ret[0].(db.ProviderHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProviderHealth indicates an expected call of UpsertProviderHealth.
+func (mr *MockStoreMockRecorder) UpsertProviderHealth(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProviderHealth", reflect.TypeOf((*MockStore)(nil).UpsertProviderHealth), ctx, arg)
+}
+
+// UpsertRepositoryListingCursor mocks base method.
+func (m *MockStore) UpsertRepositoryListingCursor(ctx context.Context, arg db.UpsertRepositoryListingCursorParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRepositoryListingCursor", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRepositoryListingCursor indicates an expected call of UpsertRepositoryListingCursor.
+func (mr *MockStoreMockRecorder) UpsertRepositoryListingCursor(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRepositoryListingCursor", reflect.TypeOf((*MockStore)(nil).UpsertRepositoryListingCursor), ctx, arg)
+}
+
 // UpsertRuleInstance mocks base method.
 func (m *MockStore) UpsertRuleInstance(ctx context.Context, arg db.UpsertRuleInstanceParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
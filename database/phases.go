@@ -0,0 +1,413 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+)
+
+// Large tables can't be changed by a single transactional migration without
+// locking them for a long time. Such changes are split in expand/contract
+// steps instead:
+//
+//  1. an expand migration adds the new parts of the schema, e.g. a nullable
+//     column, which the servers of the previous version ignore,
+//  2. a post hook phase of the expand migration backfills the new parts in
+//     small batches, while servers keep running,
+//  3. servers start reading and writing the new parts once the phase
+//     completed, see PhaseGate,
+//  4. a contract migration removes the old parts of the schema once no
+//     running server uses them anymore. Contract migrations are only applied
+//     when explicitly requested, and only once all the previous phases
+//     completed.
+
+// HookPoint tells when a phase runs, relatively to the migration it is
+// attached to.
+type HookPoint string
+
+const (
+	// PreHook phases run before their migration is applied, e.g. to check
+	// that the data is ready for it.
+	PreHook HookPoint = "pre"
+	// PostHook phases run after their migration is applied, e.g. to backfill
+	// the columns the migration added.
+	PostHook HookPoint = "post"
+)
+
+// phasesVersion is the migration which creates the table tracking the state
+// of the phases, phases can only be attached to later migrations.
+const phasesVersion = 143
+
+// PhasePending is the state of the phases which never started
+const PhasePending = "pending"
+
+// Phase is a step of an expand/contract migration which runs outside of the
+// transactional schema migrations. Phases may be interrupted and run again,
+// so they must be idempotent, and should work in small batches to avoid
+// locking large tables.
+type Phase struct {
+	// Name identifies the phase, servers use it to check whether the phase
+	// completed.
+	Name        string
+	Description string
+	// Version is the migration the phase is attached to
+	Version uint
+	Hook    HookPoint
+	Run     func(ctx context.Context, conn *sql.DB) error
+}
+
+// SearchIndexesPhase builds the full-text search indexes over the details of
+// the evaluations. Servers only search the details once it completed, as
+// searching without the indexes scans the whole evaluation history.
+const SearchIndexesPhase = "index_evaluation_details_search"
+
+// phases are the phases of the embedded migrations, in the order they run.
+var phases = []Phase{
+	{
+		Name:        SearchIndexesPhase,
+		Description: "Build the full-text search indexes over the evaluation and remediation details",
+		Version:     146,
+		Hook:        PostHook,
+		Run:         createSearchIndexes,
+	},
+}
+
+// PhaseStatus is the state of a phase in the database
+type PhaseStatus struct {
+	Phase
+	// State is one of the db.MigrationPhaseState values, or PhasePending
+	State     string
+	LastError string
+}
+
+// MigrationStatus tells whether a migration is applied, along with the state
+// of its phases.
+type MigrationStatus struct {
+	Migration
+	Applied bool
+	Phases  []PhaseStatus
+}
+
+// Status is the state of the migrations of the database
+type Status struct {
+	Version    uint
+	Dirty      bool
+	Migrations []MigrationStatus
+}
+
+// UpOptions configures PhasedMigrator.Up
+type UpOptions struct {
+	// Steps limits the number of migrations to apply, all the pending
+	// migrations are applied if 0.
+	Steps uint
+	// Contract allows applying contract migrations
+	Contract bool
+}
+
+// UpResult describes the migrations applied by PhasedMigrator.Up
+type UpResult struct {
+	Applied []Migration
+	// Blocked is the contract migration Up stopped at, if any
+	Blocked *Migration
+}
+
+// PhasedMigrator applies the migrations one at a time, running the phases
+// attached to them.
+type PhasedMigrator struct {
+	migrator   Migrator
+	conn       *sql.DB
+	store      db.MigrationPhasesStore
+	migrations []Migration
+	phases     []Phase
+	states     map[string]db.MigrationPhase
+}
+
+// NewPhasedMigrator creates a PhasedMigrator for the embedded migrations and
+// phases.
+func NewPhasedMigrator(m Migrator, conn *sql.DB, store db.MigrationPhasesStore) (*PhasedMigrator, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePhases(migrations, phases); err != nil {
+		return nil, err
+	}
+	return &PhasedMigrator{
+		migrator:   m,
+		conn:       conn,
+		store:      store,
+		migrations: migrations,
+		phases:     phases,
+	}, nil
+}
+
+func validatePhases(migrations []Migration, phases []Phase) error {
+	versions := make(map[uint]bool, len(migrations))
+	for _, m := range migrations {
+		versions[m.Version] = true
+	}
+	names := make(map[string]bool, len(phases))
+	for _, p := range phases {
+		switch {
+		case p.Name == "":
+			return fmt.Errorf("phase of migration %d has no name", p.Version)
+		case names[p.Name]:
+			return fmt.Errorf("duplicate phase %s", p.Name)
+		case p.Hook != PreHook && p.Hook != PostHook:
+			return fmt.Errorf("phase %s has invalid hook %q", p.Name, p.Hook)
+		case !versions[p.Version]:
+			return fmt.Errorf("phase %s is attached to unknown migration %d", p.Name, p.Version)
+		case p.Version <= phasesVersion:
+			return fmt.Errorf("phase %s must be attached to a migration after %d", p.Name, phasesVersion)
+		case p.Run == nil:
+			return fmt.Errorf("phase %s has nothing to run", p.Name)
+		}
+		names[p.Name] = true
+	}
+	return nil
+}
+
+// Up applies the pending migrations. Before that, it resumes the post hook
+// phases of the applied migrations which didn't complete. Up stops before the
+// first contract migration unless they are allowed.
+func (p *PhasedMigrator) Up(ctx context.Context, opts UpOptions) (UpResult, error) {
+	var result UpResult
+
+	version, dirty, err := p.version()
+	if err != nil {
+		return result, err
+	}
+	if dirty {
+		return result, fmt.Errorf("migration %d left the database dirty", version)
+	}
+
+	for _, phase := range p.phases {
+		if phase.Hook == PostHook && phase.Version <= version {
+			if err := p.runPhase(ctx, phase); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	for _, m := range p.migrations {
+		if m.Version <= version {
+			continue
+		}
+		if opts.Steps > 0 && uint(len(result.Applied)) == opts.Steps {
+			break
+		}
+		if m.Contract {
+			if !opts.Contract {
+				result.Blocked = &m
+				break
+			}
+			if err := p.checkPhasesCompleted(ctx, m.Version); err != nil {
+				return result, err
+			}
+		}
+
+		if err := p.runPhases(ctx, m.Version, PreHook); err != nil {
+			return result, err
+		}
+		zerolog.Ctx(ctx).Info().Uint("version", m.Version).Str("name", m.Name).Msg("applying migration")
+		if err := p.migrator.Steps(1); err != nil {
+			return result, fmt.Errorf("error applying migration %d: %w", m.Version, err)
+		}
+		result.Applied = append(result.Applied, m)
+		if err := p.runPhases(ctx, m.Version, PostHook); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// Status returns the state of the migrations of the database and of their
+// phases.
+func (p *PhasedMigrator) Status(ctx context.Context) (Status, error) {
+	version, dirty, err := p.version()
+	if err != nil {
+		return Status{}, err
+	}
+	status := Status{Version: version, Dirty: dirty}
+
+	// the phases table doesn't exist before its migration
+	states := map[string]db.MigrationPhase{}
+	if len(p.phases) > 0 && version >= phasesVersion {
+		if states, err = p.phaseStates(ctx); err != nil {
+			return Status{}, err
+		}
+	}
+
+	for _, m := range p.migrations {
+		ms := MigrationStatus{Migration: m, Applied: m.Version <= version}
+		for _, phase := range p.phases {
+			if phase.Version != m.Version {
+				continue
+			}
+			ps := PhaseStatus{Phase: phase, State: PhasePending}
+			if state, ok := states[phase.Name]; ok {
+				ps.State = string(state.State)
+				ps.LastError = state.LastError
+			}
+			ms.Phases = append(ms.Phases, ps)
+		}
+		status.Migrations = append(status.Migrations, ms)
+	}
+	return status, nil
+}
+
+func (p *PhasedMigrator) version() (uint, bool, error) {
+	version, dirty, err := p.migrator.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, fmt.Errorf("error getting migration version: %w", err)
+	}
+	return version, dirty, nil
+}
+
+func (p *PhasedMigrator) phaseStates(ctx context.Context) (map[string]db.MigrationPhase, error) {
+	if p.states != nil {
+		return p.states, nil
+	}
+	rows, err := p.store.ListMigrationPhases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing migration phases: %w", err)
+	}
+	p.states = make(map[string]db.MigrationPhase, len(rows))
+	for _, row := range rows {
+		p.states[row.Name] = row
+	}
+	return p.states, nil
+}
+
+func (p *PhasedMigrator) runPhases(ctx context.Context, version uint, hook HookPoint) error {
+	for _, phase := range p.phases {
+		if phase.Version == version && phase.Hook == hook {
+			if err := p.runPhase(ctx, phase); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *PhasedMigrator) runPhase(ctx context.Context, phase Phase) error {
+	states, err := p.phaseStates(ctx)
+	if err != nil {
+		return err
+	}
+	if states[phase.Name].State == db.MigrationPhaseStateCompleted {
+		return nil
+	}
+
+	logger := zerolog.Ctx(ctx).With().Str("phase", phase.Name).Uint("version", phase.Version).Logger()
+	logger.Info().Str("hook", string(phase.Hook)).Msg("running migration phase")
+	state, err := p.store.StartMigrationPhase(ctx, db.StartMigrationPhaseParams{
+		Name:    phase.Name,
+		Version: int64(phase.Version),
+	})
+	if err != nil {
+		return fmt.Errorf("error starting phase %s: %w", phase.Name, err)
+	}
+
+	if runErr := phase.Run(ctx, p.conn); runErr != nil {
+		if err := p.store.FailMigrationPhase(ctx, db.FailMigrationPhaseParams{
+			Name:      phase.Name,
+			LastError: runErr.Error(),
+		}); err != nil {
+			logger.Error().Err(err).Msg("error recording the failure of the phase")
+		}
+		return fmt.Errorf("phase %s failed: %w", phase.Name, runErr)
+	}
+
+	if err := p.store.CompleteMigrationPhase(ctx, phase.Name); err != nil {
+		return fmt.Errorf("error completing phase %s: %w", phase.Name, err)
+	}
+	state.State = db.MigrationPhaseStateCompleted
+	states[phase.Name] = state
+	logger.Info().Msg("migration phase completed")
+	return nil
+}
+
+// checkPhasesCompleted ensures that the phases of the migrations before a
+// contract migration completed, as it may drop what they migrate from.
+func (p *PhasedMigrator) checkPhasesCompleted(ctx context.Context, version uint) error {
+	for _, phase := range p.phases {
+		if phase.Version >= version {
+			continue
+		}
+		states, err := p.phaseStates(ctx)
+		if err != nil {
+			return err
+		}
+		if states[phase.Name].State != db.MigrationPhaseStateCompleted {
+			return fmt.Errorf("contract migration %d requires phase %s to complete first", version, phase.Name)
+		}
+	}
+	return nil
+}
+
+// PhaseGate tells servers whether phases completed, so that they only read or
+// write the expanded parts of the schema once they are ready, e.g.
+//
+//	if in.GetSearch() != "" && !gate.Completed(ctx, SearchIndexesPhase) {
+//
+// Completed phases are cached, as phases don't go back, while the others are
+// checked again once the refresh interval elapsed.
+type PhaseGate struct {
+	store    db.MigrationPhasesStore
+	interval time.Duration
+
+	mu        sync.Mutex
+	completed map[string]bool
+	checkedAt map[string]time.Time
+}
+
+// NewPhaseGate creates a PhaseGate checking the phases in the given store
+func NewPhaseGate(store db.MigrationPhasesStore, interval time.Duration) *PhaseGate {
+	return &PhaseGate{
+		store:     store,
+		interval:  interval,
+		completed: make(map[string]bool),
+		checkedAt: make(map[string]time.Time),
+	}
+}
+
+// Completed returns whether the phase completed. Errors are logged and
+// reported as the phase not having completed, to keep servers on the
+// previous schema.
+func (g *PhaseGate) Completed(ctx context.Context, name string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.completed[name] {
+		return true
+	}
+	if checkedAt, ok := g.checkedAt[name]; ok && time.Since(checkedAt) < g.interval {
+		return false
+	}
+	g.checkedAt[name] = time.Now()
+
+	phase, err := g.store.GetMigrationPhase(ctx, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false
+	} else if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("phase", name).Msg("error checking migration phase")
+		return false
+	}
+	g.completed[name] = phase.State == db.MigrationPhaseStateCompleted
+	return g.completed[name]
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+)
+
+type fakeMigrator struct {
+	version uint
+	calls   *[]string
+}
+
+func (*fakeMigrator) Up() error   { return nil }
+func (*fakeMigrator) Down() error { return nil }
+func (f *fakeMigrator) Steps(n int) error {
+	f.version += uint(n)
+	*f.calls = append(*f.calls, "migrate")
+	return nil
+}
+func (f *fakeMigrator) Version() (uint, bool, error) {
+	return f.version, false, nil
+}
+
+func TestMigrations(t *testing.T) {
+	t.Parallel()
+
+	migrations, err := Migrations()
+	require.NoError(t, err)
+	require.Equal(t, uint(1), migrations[0].Version)
+
+	latest, err := LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, migrations[len(migrations)-1].Version, latest)
+	require.NoError(t, validatePhases(migrations, phases))
+}
+
+func TestPhasedMigratorUp(t *testing.T) {
+	t.Parallel()
+
+	migrations := []Migration{
+		{Version: 144, Name: "add_labels"},
+		{Version: 145, Name: "contract_drop_tags", Contract: true},
+	}
+
+	tests := []struct {
+		name        string
+		opts        UpOptions
+		setup       func(store *mockdb.MockStore)
+		runErr      error
+		wantCalls   []string
+		wantApplied int
+		wantBlocked bool
+		wantErr     string
+	}{
+		{
+			name: "stops before contract migration",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().ListMigrationPhases(gomock.Any()).Return(nil, nil)
+				store.EXPECT().StartMigrationPhase(gomock.Any(), db.StartMigrationPhaseParams{
+					Name: "backfill_labels", Version: 144,
+				}).Return(db.MigrationPhase{Name: "backfill_labels"}, nil)
+				store.EXPECT().CompleteMigrationPhase(gomock.Any(), "backfill_labels").Return(nil)
+			},
+			wantCalls:   []string{"migrate", "backfill_labels"},
+			wantApplied: 1,
+			wantBlocked: true,
+		},
+		{
+			name: "applies contract migration once phases completed",
+			opts: UpOptions{Contract: true},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().ListMigrationPhases(gomock.Any()).Return(nil, nil)
+				store.EXPECT().StartMigrationPhase(gomock.Any(), gomock.Any()).
+					Return(db.MigrationPhase{Name: "backfill_labels"}, nil)
+				store.EXPECT().CompleteMigrationPhase(gomock.Any(), "backfill_labels").Return(nil)
+			},
+			wantCalls:   []string{"migrate", "backfill_labels", "migrate"},
+			wantApplied: 2,
+		},
+		{
+			name: "failed phase blocks contract migration",
+			opts: UpOptions{Contract: true},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().ListMigrationPhases(gomock.Any()).Return(nil, nil)
+				store.EXPECT().StartMigrationPhase(gomock.Any(), gomock.Any()).
+					Return(db.MigrationPhase{Name: "backfill_labels"}, nil)
+				store.EXPECT().FailMigrationPhase(gomock.Any(), db.FailMigrationPhaseParams{
+					Name: "backfill_labels", LastError: "lock timeout",
+				}).Return(nil)
+			},
+			runErr:      errors.New("lock timeout"),
+			wantCalls:   []string{"migrate", "backfill_labels"},
+			wantApplied: 1,
+			wantErr:     "phase backfill_labels failed: lock timeout",
+		},
+		{
+			name: "completed phases are skipped",
+			opts: UpOptions{Steps: 1},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().ListMigrationPhases(gomock.Any()).Return([]db.MigrationPhase{
+					{Name: "backfill_labels", State: db.MigrationPhaseStateCompleted},
+				}, nil)
+			},
+			wantCalls:   []string{"migrate"},
+			wantApplied: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+			tt.setup(store)
+
+			var calls []string
+			pm := &PhasedMigrator{
+				migrator:   &fakeMigrator{version: 143, calls: &calls},
+				store:      store,
+				migrations: migrations,
+				phases: []Phase{{
+					Name:    "backfill_labels",
+					Version: 144,
+					Hook:    PostHook,
+					Run: func(_ context.Context, _ *sql.DB) error {
+						calls = append(calls, "backfill_labels")
+						return tt.runErr
+					},
+				}},
+			}
+
+			res, err := pm.Up(context.Background(), tt.opts)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantCalls, calls)
+			require.Len(t, res.Applied, tt.wantApplied)
+			require.Equal(t, tt.wantBlocked, res.Blocked != nil)
+		})
+	}
+}
+
+func TestPhaseGate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	gomock.InOrder(
+		store.EXPECT().GetMigrationPhase(gomock.Any(), "backfill_labels").
+			Return(db.MigrationPhase{}, sql.ErrNoRows),
+		store.EXPECT().GetMigrationPhase(gomock.Any(), "backfill_labels").
+			Return(db.MigrationPhase{State: db.MigrationPhaseStateCompleted}, nil),
+	)
+
+	gate := NewPhaseGate(store, time.Hour)
+	require.False(t, gate.Completed(context.Background(), "backfill_labels"))
+	// pending phases are only checked again after the interval
+	require.False(t, gate.Completed(context.Background(), "backfill_labels"))
+
+	gate.checkedAt["backfill_labels"] = time.Now().Add(-2 * time.Hour)
+	require.True(t, gate.Completed(context.Background(), "backfill_labels"))
+	// completed phases are cached
+	require.True(t, gate.Completed(context.Background(), "backfill_labels"))
+}
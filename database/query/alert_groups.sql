@@ -0,0 +1,54 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- name: GetAlertGroup :one
+SELECT * FROM alert_groups
+WHERE entity_instance_id = $1 AND profile_id = $2;
+
+-- name: UpsertAlertGroup :one
+INSERT INTO alert_groups (
+    entity_instance_id,
+    profile_id,
+    project_id,
+    failing_rules,
+    pending_rules,
+    alert_metadata,
+    last_notified_at,
+    flush_after
+) VALUES (
+    $1,
+    $2,
+    $3,
+    sqlc.arg(failing_rules)::text[],
+    sqlc.arg(pending_rules)::text[],
+    sqlc.narg(alert_metadata)::jsonb,
+    sqlc.narg(last_notified_at),
+    sqlc.narg(flush_after)
+)
+ON CONFLICT (entity_instance_id, profile_id) DO UPDATE SET
+    failing_rules = EXCLUDED.failing_rules,
+    pending_rules = EXCLUDED.pending_rules,
+    alert_metadata = EXCLUDED.alert_metadata,
+    last_notified_at = EXCLUDED.last_notified_at,
+    flush_after = EXCLUDED.flush_after
+RETURNING *;
+
+-- name: DeleteAlertGroup :exec
+DELETE FROM alert_groups
+WHERE entity_instance_id = $1 AND profile_id = $2;
+
+-- PopDueAlertGroups clears the flush time of the groups whose grouping
+-- window elapsed, and returns them so that their entities are re-evaluated.
+-- A re-evaluation which is lost is not an issue: the pending failures are
+-- kept, and sent by the next evaluation of the entity.
+
+-- name: PopDueAlertGroups :many
+UPDATE alert_groups SET flush_after = NULL
+WHERE (entity_instance_id, profile_id) IN (
+    SELECT ag.entity_instance_id, ag.profile_id FROM alert_groups AS ag
+    WHERE ag.flush_after <= NOW()
+    ORDER BY ag.flush_after
+    LIMIT sqlc.arg(size)::bigint
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
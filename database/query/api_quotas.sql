@@ -0,0 +1,35 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- TakeAPIQuotaToken refills the token bucket of a project for the time
+-- elapsed since its last update, and takes a token from it. No row is
+-- returned, and the bucket is left unchanged, if there is no token left.
+
+-- name: TakeAPIQuotaToken :one
+INSERT INTO api_quota_buckets AS b (project_id, tokens)
+VALUES (sqlc.arg(project_id), sqlc.arg(burst)::float8 - 1)
+ON CONFLICT (project_id) DO UPDATE
+SET tokens = LEAST(
+        sqlc.arg(burst)::float8,
+        b.tokens + sqlc.arg(rate)::float8 * EXTRACT(EPOCH FROM NOW() - b.updated_at)::float8
+    ) - 1,
+    updated_at = NOW()
+WHERE LEAST(
+    sqlc.arg(burst)::float8,
+    b.tokens + sqlc.arg(rate)::float8 * EXTRACT(EPOCH FROM NOW() - b.updated_at)::float8
+) >= 1
+RETURNING b.tokens;
+
+-- GetAPIQuotaRetryAfter returns the number of seconds until the token bucket
+-- of a project has a token again.
+
+-- name: GetAPIQuotaRetryAfter :one
+SELECT GREATEST(
+    0,
+    (1 - LEAST(
+        sqlc.arg(burst)::float8,
+        b.tokens + sqlc.arg(rate)::float8 * EXTRACT(EPOCH FROM NOW() - b.updated_at)::float8
+    )) / sqlc.arg(rate)::float8
+)::float8 AS retry_after
+FROM api_quota_buckets AS b
+WHERE b.project_id = sqlc.arg(project_id);
@@ -1,8 +1,8 @@
 -- CreateDataSource creates a new datasource in a given project.
 
 -- name: CreateDataSource :one
-INSERT INTO data_sources (project_id, name, display_name, subscription_id, metadata)
-VALUES ($1, $2, $3, sqlc.narg(subscription_id), sqlc.arg(metadata)::json) RETURNING *;
+INSERT INTO data_sources (project_id, name, display_name, subscription_id, metadata, visibility)
+VALUES ($1, $2, $3, sqlc.narg(subscription_id), sqlc.arg(metadata)::json, sqlc.arg(visibility)) RETURNING *;
 
 -- AddDataSourceFunction adds a function to a datasource.
 
@@ -14,7 +14,7 @@ VALUES ($1, $2, $3, $4, $5) RETURNING *;
 
 -- name: UpdateDataSource :one
 UPDATE data_sources
-SET display_name = $3, metadata = sqlc.arg(metadata)::json
+SET display_name = $3, metadata = sqlc.arg(metadata)::json, visibility = sqlc.arg(visibility)
 WHERE id = $1 AND project_id = $2
 RETURNING *;
 
@@ -46,32 +46,49 @@ WHERE data_source_id = $1 AND project_id = $2
 RETURNING *;
 
 -- GetDataSource retrieves a datasource by its id and a project hierarchy.
+-- The first project of the hierarchy is the project doing the lookup,
+-- private datasources of its ancestors are not returned.
 --
 -- Note that to get a datasource for a given project, one can simply
 -- pass one project id in the project_id array.
 
 -- name: GetDataSource :one
 SELECT * FROM data_sources
-WHERE id = $1 AND project_id = ANY(sqlc.arg(projects)::uuid[]);
+WHERE id = $1 AND (
+    project_id = (sqlc.arg(projects)::uuid[])[1]
+    OR (project_id = ANY(sqlc.arg(projects)::uuid[]) AND visibility != 'private')
+);
 
 -- GetDataSourceByName retrieves a datasource by its name and
--- a project hierarchy.
+-- a project hierarchy. The first project of the hierarchy is the project
+-- doing the lookup, private datasources of its ancestors are not
+-- returned. Global datasources of other hierarchies are only returned if
+-- include_global is set. The datasource closest to the project wins.
 --
 -- Note that to get a datasource for a given project, one can simply
 -- pass one project id in the project_id array.
 
 -- name: GetDataSourceByName :one
 SELECT * FROM data_sources
-WHERE name = $1 AND project_id = ANY(sqlc.arg(projects)::uuid[]);
+WHERE name = $1 AND (
+    project_id = (sqlc.arg(projects)::uuid[])[1]
+    OR (project_id = ANY(sqlc.arg(projects)::uuid[]) AND visibility != 'private')
+    OR (sqlc.arg(include_global)::boolean AND visibility = 'global')
+)
+ORDER BY array_position(sqlc.arg(projects)::uuid[], project_id) NULLS LAST, created_at
+LIMIT 1;
 
 -- ListDataSources retrieves all datasources for project hierarchy.
+-- The first project of the hierarchy is the project doing the lookup,
+-- private datasources of its ancestors are not returned.
 --
 -- Note that to get a datasource for a given project, one can simply
 -- pass one project id in the project_id array.
 
 -- name: ListDataSources :many
 SELECT * FROM data_sources
-WHERE project_id = ANY(sqlc.arg(projects)::uuid[]);
+WHERE project_id = (sqlc.arg(projects)::uuid[])[1]
+OR (project_id = ANY(sqlc.arg(projects)::uuid[]) AND visibility != 'private');
 
 -- ListDataSourceFunctions retrieves all functions for a datasource.
 
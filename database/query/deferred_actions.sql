@@ -0,0 +1,24 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- name: DeferActions :exec
+INSERT INTO deferred_actions (
+    entity_instance_id,
+    project_id
+) VALUES ($1, $2)
+ON CONFLICT (entity_instance_id) DO NOTHING;
+
+-- PopDeferredActions removes and returns the entities whose actions were
+-- deferred for the longest time. A re-evaluation which is lost is not an
+-- issue, as the deferred actions left the previous action statuses intact:
+-- the next evaluation of the entity executes them.
+
+-- name: PopDeferredActions :many
+DELETE FROM deferred_actions
+WHERE entity_instance_id IN (
+    SELECT da.entity_instance_id FROM deferred_actions AS da
+    ORDER BY da.deferred_at
+    LIMIT sqlc.arg(size)::bigint
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
@@ -45,6 +45,40 @@ RETURNING *;
 DELETE FROM entity_instances
 WHERE id = $1 AND project_id = $2;
 
+-- UpdateEntityName renames an entity of a project, e.g. after the upstream
+-- repository was renamed.
+-- name: UpdateEntityName :exec
+UPDATE entity_instances
+SET name = $3
+WHERE id = $1 AND project_id = $2;
+
+-- UpdateEntityOriginatedFrom links an entity of a project to the entity it
+-- originated from, e.g. an artifact to the repository that built it.
+-- name: UpdateEntityOriginatedFrom :exec
+UPDATE entity_instances
+SET originated_from = $3
+WHERE id = $1 AND project_id = $2;
+
+-- CloseEntity records that an entity was closed upstream, e.g. a closed pull
+-- request. The entity is kept until it is reopened or purged.
+-- name: CloseEntity :exec
+INSERT INTO closed_entities (entity_instance_id, project_id)
+VALUES ($1, $2)
+ON CONFLICT (entity_instance_id) DO NOTHING;
+
+-- ReopenEntity removes the record of an entity being closed, e.g. after the
+-- pull request was reopened.
+-- name: ReopenEntity :exec
+DELETE FROM closed_entities WHERE entity_instance_id = $1;
+
+-- ListClosedEntitiesBefore returns the entities which were closed before the
+-- given time, oldest first.
+-- name: ListClosedEntitiesBefore :many
+SELECT * FROM closed_entities
+WHERE closed_at < sqlc.arg(closed_before)
+ORDER BY closed_at
+LIMIT sqlc.arg(size)::bigint;
+
 -- GetEntityByID retrieves an entity by its ID for a project or hierarchy of projects.
 -- name: GetEntityByID :one
 SELECT * FROM entity_instances
@@ -115,6 +149,13 @@ WHERE entity_instances.entity_type = $1;
 SELECT COUNT(*) FROM entity_instances
 WHERE entity_instances.entity_type = $1 AND entity_instances.project_id = $2;
 
+-- ListEntityProjectsByProvider lists the projects which registered entities
+-- with a provider.
+
+-- name: ListEntityProjectsByProvider :many
+SELECT DISTINCT entity_instances.project_id FROM entity_instances
+WHERE entity_instances.provider_id = $1;
+
 -- name: GetProperty :one
 SELECT * FROM properties
 WHERE entity_id = $1 AND key = $2;
@@ -1,10 +1,19 @@
--- GetFeatureInProject verifies if a feature is available for a specific project.
--- It returns the settings for the feature if it is available.
+-- GetFeatureInProject verifies if a feature is available for a specific project,
+-- either because the project is entitled to it or because the tier of the
+-- project includes it. It returns the settings for the feature if it is available.
 
 -- name: GetFeatureInProject :one
-SELECT f.settings FROM entitlements e
-INNER JOIN features f ON f.name = e.feature
-WHERE e.project_id = sqlc.arg(project_id)::UUID AND e.feature = sqlc.arg(feature)::TEXT;
+SELECT f.settings FROM features f
+WHERE f.name = sqlc.arg(feature)::TEXT AND (
+    EXISTS (
+        SELECT 1 FROM entitlements e
+        WHERE e.feature = f.name AND e.project_id = sqlc.arg(project_id)::UUID
+    ) OR EXISTS (
+        SELECT 1 FROM project_tiers pt
+        WHERE pt.name = effective_project_tier(sqlc.arg(project_id)::UUID)
+            AND f.name = ANY(pt.features)
+    )
+);
 
 -- name: GetEntitlementFeaturesByProjectID :many
 SELECT feature
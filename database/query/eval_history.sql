@@ -8,6 +8,16 @@ JOIN evaluation_statuses AS eh ON les.evaluation_history_id = eh.id
 WHERE re.rule_id = $1 AND re.entity_instance_id = $2
 FOR UPDATE;
 
+-- GetLatestEvalCheckpointForRuleEntity returns the status and checkpoint of
+-- the latest evaluation of a rule for an entity. Unlike
+-- GetLatestEvalStateForRuleEntity it does not lock the evaluation.
+
+-- name: GetLatestEvalCheckpointForRuleEntity :one
+SELECT eh.status, eh.checkpoint FROM evaluation_rule_entities AS re
+JOIN latest_evaluation_statuses AS les ON les.rule_entity_id = re.id
+JOIN evaluation_statuses AS eh ON les.evaluation_history_id = eh.id
+WHERE re.rule_id = $1 AND re.entity_instance_id = $2;
+
 -- name: InsertEvaluationRuleEntity :one
 INSERT INTO evaluation_rule_entities(
     rule_id,
@@ -25,11 +35,15 @@ INSERT INTO evaluation_statuses(
     rule_entity_id,
     status,
     details,
+    error_code,
+    retry_attempt,
     checkpoint
 ) VALUES (
     $1,
     $2,
     $3,
+    $4,
+    $5,
     sqlc.arg(checkpoint)::jsonb
 )
 RETURNING id;
@@ -90,12 +104,16 @@ SELECT s.id::uuid AS evaluation_id,
     -- evaluation status and details
     s.status AS evaluation_status,
     s.details AS evaluation_details,
-    -- remediation status and details
+    s.error_code AS evaluation_error_code,
+    s.retry_attempt AS evaluation_retry_attempt,
+    -- remediation status, details and metadata
     re.status AS remediation_status,
     re.details AS remediation_details,
-    -- alert status and details
+    re.metadata AS remediation_metadata,
+    -- alert status, details and metadata
     ae.status AS alert_status,
-    ae.details AS alert_details
+    ae.details AS alert_details,
+    ae.metadata AS alert_metadata
 FROM evaluation_statuses s
     JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
     JOIN rule_instances ri ON ere.rule_id = ri.id
@@ -123,12 +141,16 @@ SELECT s.id::uuid AS evaluation_id,
        -- evaluation status and details
        s.status AS evaluation_status,
        s.details AS evaluation_details,
-       -- remediation status and details
+       s.error_code AS evaluation_error_code,
+       s.retry_attempt AS evaluation_retry_attempt,
+       -- remediation status, details and metadata
        re.status AS remediation_status,
        re.details AS remediation_details,
-       -- alert status and details
+       re.metadata AS remediation_metadata,
+       -- alert status, details and metadata
        ae.status AS alert_status,
        ae.details AS alert_details,
+       ae.metadata AS alert_metadata,
        -- evaluation output
        eo.output AS eval_output
   FROM evaluation_statuses s
@@ -150,6 +172,7 @@ SELECT s.id::uuid AS evaluation_id,
    AND (sqlc.slice(remediations)::remediation_status_types[] IS NULL OR re.status = ANY(sqlc.slice(remediations)::remediation_status_types[]))
    AND (sqlc.slice(alerts)::alert_status_types[] IS NULL OR ae.status = ANY(sqlc.slice(alerts)::alert_status_types[]))
    AND (sqlc.slice(statuses)::eval_status_types[] IS NULL OR s.status = ANY(sqlc.slice(statuses)::eval_status_types[]))
+   AND (sqlc.slice(errorCodes)::eval_error_codes[] IS NULL OR s.error_code = ANY(sqlc.slice(errorCodes)::eval_error_codes[]))
    -- exclusion filters
    AND (sqlc.slice(notEntityTypes)::entities[] IS NULL OR ere.entity_type != ALL(sqlc.slice(notEntityTypes)::entities[]))
    AND (sqlc.slice(notEntityNames)::text[] IS NULL OR ei.name != ALL(sqlc.slice(notEntityNames)::text[]))
@@ -157,9 +180,14 @@ SELECT s.id::uuid AS evaluation_id,
    AND (sqlc.slice(notRemediations)::remediation_status_types[] IS NULL OR re.status != ALL(sqlc.slice(notRemediations)::remediation_status_types[]))
    AND (sqlc.slice(notAlerts)::alert_status_types[] IS NULL OR ae.status != ALL(sqlc.slice(notAlerts)::alert_status_types[]))
    AND (sqlc.slice(notStatuses)::eval_status_types[] IS NULL OR s.status != ALL(sqlc.slice(notStatuses)::eval_status_types[]))
+   AND (sqlc.slice(notErrorCodes)::eval_error_codes[] IS NULL OR s.error_code IS NULL OR s.error_code != ALL(sqlc.slice(notErrorCodes)::eval_error_codes[]))
    -- time range filter
    AND (sqlc.narg(fromts)::timestamp without time zone IS NULL OR s.evaluation_time >= sqlc.narg(fromts))
    AND (sqlc.narg(tots)::timestamp without time zone IS NULL OR  s.evaluation_time < sqlc.narg(tots))
+   -- full-text search over the details, matching the search indexes
+   AND (sqlc.narg(search)::text IS NULL
+	OR to_tsvector('simple', s.details) @@ websearch_to_tsquery('simple', sqlc.narg(search)::text)
+	OR to_tsvector('simple', re.details) @@ websearch_to_tsquery('simple', sqlc.narg(search)::text))
    -- implicit filter by project id
    AND j.id = sqlc.arg(projectId)
    -- implicit filter by profile labels
@@ -197,3 +225,155 @@ SELECT s.evaluation_time,
 -- name: DeleteEvaluationHistoryByIDs :execrows
 DELETE FROM evaluation_statuses s
  WHERE s.id = ANY(sqlc.slice(evaluationIds)::uuid[]);
+
+-- ListStaleEvaluationRuleEntities lists the rule and entity pairs which were
+-- not evaluated since the threshold, such as the pairs of the entities which
+-- are no longer selected by a profile, along with the size of their history.
+-- The project filter is optional.
+-- name: ListStaleEvaluationRuleEntities :many
+SELECT ere.id,
+       ri.project_id,
+       s.evaluation_time AS last_evaluated,
+       (SELECT COUNT(*) FROM evaluation_statuses h WHERE h.rule_entity_id = ere.id) AS evaluations
+  FROM evaluation_rule_entities ere
+       JOIN rule_instances ri ON ri.id = ere.rule_id
+       JOIN latest_evaluation_statuses l ON l.rule_entity_id = ere.id
+       JOIN evaluation_statuses s ON s.id = l.evaluation_history_id
+ WHERE s.evaluation_time < sqlc.arg(threshold)
+   AND (sqlc.narg(project_id)::uuid IS NULL OR ri.project_id = sqlc.narg(project_id)::uuid)
+ ORDER BY s.evaluation_time ASC, ere.id ASC
+ LIMIT sqlc.arg(size)::integer;
+
+-- CountStaleEvaluationRuleEntities counts the rule and entity pairs listed
+-- by ListStaleEvaluationRuleEntities, and the evaluations in their history.
+-- name: CountStaleEvaluationRuleEntities :one
+SELECT COUNT(DISTINCT ere.id) AS rule_entities,
+       COUNT(h.id) AS evaluations
+  FROM evaluation_rule_entities ere
+       JOIN rule_instances ri ON ri.id = ere.rule_id
+       JOIN latest_evaluation_statuses l ON l.rule_entity_id = ere.id
+       JOIN evaluation_statuses s ON s.id = l.evaluation_history_id
+       JOIN evaluation_statuses h ON h.rule_entity_id = ere.id
+ WHERE s.evaluation_time < sqlc.arg(threshold)
+   AND (sqlc.narg(project_id)::uuid IS NULL OR ri.project_id = sqlc.narg(project_id)::uuid);
+
+-- DeleteEvaluationRuleEntitiesByIDs deletes rule and entity pairs, which
+-- cascades to their whole evaluation history.
+-- name: DeleteEvaluationRuleEntitiesByIDs :execrows
+DELETE FROM evaluation_rule_entities ere
+ WHERE ere.id = ANY(sqlc.slice(ruleEntityIds)::uuid[]);
+
+-- ListEntityTimeline lists the events of an entity: its registration, the
+-- refreshes of its properties, its evaluations and the remediations and alerts
+-- acted on them. Skipped and unavailable actions are left out. The cursors
+-- work as for ListEvaluationHistory.
+-- name: ListEntityTimeline :many
+SELECT t.kind::text AS kind,
+       t.occurred_at::timestamptz AS occurred_at,
+       t.status::text AS status,
+       t.details::text AS details,
+       t.evaluation_id::uuid AS evaluation_id,
+       t.rule_type::text AS rule_type,
+       t.rule_name::text AS rule_name,
+       t.profile_name::text AS profile_name
+  FROM (
+        SELECT 'registration' AS kind,
+               ei.created_at AS occurred_at,
+               '' AS status,
+               '' AS details,
+               NULL::uuid AS evaluation_id,
+               '' AS rule_type,
+               '' AS rule_name,
+               '' AS profile_name
+          FROM entity_instances ei
+         WHERE ei.id = sqlc.arg(entity_id)
+        UNION ALL
+        SELECT 'property_refresh', pr.updated_at, '', string_agg(pr.key, ', ' ORDER BY pr.key), NULL::uuid, '', '', ''
+          FROM properties pr
+         WHERE pr.entity_id = sqlc.arg(entity_id)
+         GROUP BY pr.updated_at
+        UNION ALL
+        SELECT 'evaluation', s.evaluation_time, s.status::text, s.details, s.id, rt.name, ri.name, p.name
+          FROM evaluation_statuses s
+          JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+          JOIN rule_instances ri ON ere.rule_id = ri.id
+          JOIN rule_type rt ON ri.rule_type_id = rt.id
+          JOIN profiles p ON ri.profile_id = p.id
+         WHERE ere.entity_instance_id = sqlc.arg(entity_id)
+        UNION ALL
+        SELECT 'remediation', re.created_at::timestamptz, re.status::text, re.details, s.id, rt.name, ri.name, p.name
+          FROM remediation_events re
+          JOIN evaluation_statuses s ON s.id = re.evaluation_id
+          JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+          JOIN rule_instances ri ON ere.rule_id = ri.id
+          JOIN rule_type rt ON ri.rule_type_id = rt.id
+          JOIN profiles p ON ri.profile_id = p.id
+         WHERE ere.entity_instance_id = sqlc.arg(entity_id)
+           AND re.status NOT IN ('skipped', 'not_available')
+        UNION ALL
+        SELECT 'alert', ae.created_at::timestamptz, ae.status::text, ae.details, s.id, rt.name, ri.name, p.name
+          FROM alert_events ae
+          JOIN evaluation_statuses s ON s.id = ae.evaluation_id
+          JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+          JOIN rule_instances ri ON ere.rule_id = ri.id
+          JOIN rule_type rt ON ri.rule_type_id = rt.id
+          JOIN profiles p ON ri.profile_id = p.id
+         WHERE ere.entity_instance_id = sqlc.arg(entity_id)
+           AND ae.status NOT IN ('skipped', 'not_available')
+       ) t
+ WHERE (sqlc.narg(next)::timestamptz IS NULL OR sqlc.narg(next) > t.occurred_at)
+   AND (sqlc.narg(prev)::timestamptz IS NULL OR sqlc.narg(prev) < t.occurred_at)
+ ORDER BY
+ CASE WHEN sqlc.narg(next)::timestamptz IS NULL THEN t.occurred_at END ASC,
+ CASE WHEN sqlc.narg(prev)::timestamptz IS NULL THEN t.occurred_at END DESC
+ LIMIT sqlc.arg(size)::bigint;
+
+-- ListLatestEvaluationsByProject lists the latest evaluation of each rule and
+-- entity pair of a project, e.g. to archive a summary of its history.
+
+-- name: ListLatestEvaluationsByProject :many
+SELECT p.name AS profile_name,
+       ri.name AS rule_name,
+       rt.name AS rule_type_name,
+       ei.entity_type,
+       ei.name AS entity_name,
+       s.status,
+       s.details,
+       s.evaluation_time
+  FROM latest_evaluation_statuses les
+  JOIN evaluation_statuses s ON s.id = les.evaluation_history_id
+  JOIN evaluation_rule_entities ere ON ere.id = les.rule_entity_id
+  JOIN rule_instances ri ON ri.id = ere.rule_id
+  JOIN rule_type rt ON rt.id = ri.rule_type_id
+  JOIN profiles p ON p.id = ri.profile_id
+  JOIN entity_instances ei ON ei.id = ere.entity_instance_id
+ WHERE ri.project_id = $1
+ ORDER BY p.name, ri.name, ei.name;
+
+-- ListRuleTypeFailureCounts counts, for each rule type name, the entities
+-- which failed its evaluation since the start of the recent window, and the
+-- entities which failed it in the baseline preceding the window. The
+-- entities and projects evaluated in the window, and the last update of the
+-- rule types of that name, give context to the counts.
+-- name: ListRuleTypeFailureCounts :many
+SELECT rt.name AS rule_type_name,
+       COUNT(DISTINCT ere.entity_instance_id) FILTER (
+           WHERE s.status = 'failure' AND s.evaluation_time >= sqlc.arg(window_start)
+       ) AS recent_failures,
+       COUNT(DISTINCT ere.entity_instance_id) FILTER (
+           WHERE s.status = 'failure' AND s.evaluation_time < sqlc.arg(window_start)
+       ) AS baseline_failures,
+       COUNT(DISTINCT ere.entity_instance_id) FILTER (
+           WHERE s.evaluation_time >= sqlc.arg(window_start)
+       ) AS recent_evaluated,
+       COUNT(DISTINCT ri.project_id) FILTER (
+           WHERE s.status = 'failure' AND s.evaluation_time >= sqlc.arg(window_start)
+       ) AS recent_projects,
+       MAX(rt.updated_at)::timestamp AS rule_type_updated_at
+  FROM evaluation_statuses s
+       JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+       JOIN rule_instances ri ON ri.id = ere.rule_id
+       JOIN rule_type rt ON rt.id = ri.rule_type_id
+ WHERE s.evaluation_time >= sqlc.arg(baseline_start)
+ GROUP BY rt.name
+ ORDER BY rt.name;
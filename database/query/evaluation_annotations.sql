@@ -0,0 +1,57 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- CreateEvaluationAnnotation attaches an annotation to an evaluation of the
+-- project, and to its rule and entity pair. No row is returned if the
+-- evaluation doesn't belong to the project.
+-- name: CreateEvaluationAnnotation :one
+INSERT INTO evaluation_annotations (
+    project_id,
+    rule_entity_id,
+    evaluation_id,
+    kind,
+    value,
+    source,
+    created_by
+)
+SELECT ri.project_id,
+       s.rule_entity_id,
+       s.id,
+       sqlc.arg(kind)::evaluation_annotation_kind,
+       sqlc.arg(value)::text,
+       sqlc.arg(source)::text,
+       sqlc.arg(created_by)::text
+  FROM evaluation_statuses s
+       JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+       JOIN rule_instances ri ON ri.id = ere.rule_id
+ WHERE s.id = sqlc.arg(evaluation_id)
+   AND ri.project_id = sqlc.arg(project_id)
+RETURNING *;
+
+-- ListEvaluationAnnotationsByEvaluationIDs lists the annotations attached to
+-- the given evaluations, oldest first.
+-- name: ListEvaluationAnnotationsByEvaluationIDs :many
+SELECT * FROM evaluation_annotations
+ WHERE evaluation_id = ANY(sqlc.arg(evaluation_ids)::uuid[])
+ ORDER BY created_at;
+
+-- ListEvaluationAnnotationsForFindings lists the annotations of the rule and
+-- entity pairs of the given evaluations, along with the evaluation of the
+-- pair they were listed for, oldest first.
+-- name: ListEvaluationAnnotationsForFindings :many
+SELECT s.id AS finding_evaluation_id,
+       a.id,
+       a.evaluation_id,
+       a.kind,
+       a.value,
+       a.source,
+       a.created_by,
+       a.created_at
+  FROM evaluation_statuses s
+       JOIN evaluation_annotations a ON a.rule_entity_id = s.rule_entity_id
+ WHERE s.id = ANY(sqlc.arg(evaluation_ids)::uuid[])
+ ORDER BY a.created_at;
+
+-- name: DeleteEvaluationAnnotation :execrows
+DELETE FROM evaluation_annotations
+ WHERE id = $1 AND project_id = $2;
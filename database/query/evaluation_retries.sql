@@ -0,0 +1,37 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- name: GetEvaluationRetry :one
+SELECT * FROM evaluation_retries WHERE entity_instance_id = $1;
+
+-- name: UpsertEvaluationRetry :one
+INSERT INTO evaluation_retries (
+    entity_instance_id,
+    project_id,
+    attempt,
+    error_code,
+    next_retry_at
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (entity_instance_id) DO UPDATE
+SET attempt = $3, error_code = $4, next_retry_at = $5, updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteEvaluationRetry :exec
+DELETE FROM evaluation_retries WHERE entity_instance_id = $1;
+
+-- ClaimDueEvaluationRetries returns the retries which are due, and pushes
+-- their next retry time by the lease interval, so that they are not claimed
+-- again while the re-evaluation is in flight. The executor reschedules or
+-- deletes the retry once the entity has been evaluated.
+
+-- name: ClaimDueEvaluationRetries :many
+UPDATE evaluation_retries
+SET next_retry_at = NOW() + (sqlc.arg(lease)::TEXT || ' seconds')::interval
+WHERE entity_instance_id IN (
+    SELECT er.entity_instance_id FROM evaluation_retries AS er
+    WHERE er.next_retry_at <= NOW()
+    ORDER BY er.next_retry_at
+    LIMIT sqlc.arg(size)::bigint
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
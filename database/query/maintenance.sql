@@ -0,0 +1,48 @@
+-- CountOrphanedRuleInstances counts the rule instances whose profile no longer
+-- exists. Orphaned rows can only exist if foreign keys were missing or
+-- disabled at some point, e.g. during a failed upgrade.
+
+-- name: CountOrphanedRuleInstances :one
+SELECT COUNT(*) FROM rule_instances ri
+WHERE NOT EXISTS (SELECT 1 FROM profiles p WHERE p.id = ri.profile_id);
+
+-- name: DeleteOrphanedRuleInstances :execrows
+DELETE FROM rule_instances ri
+WHERE NOT EXISTS (SELECT 1 FROM profiles p WHERE p.id = ri.profile_id);
+
+-- CountOrphanedEvaluationRuleEntities counts the evaluated rule and entity
+-- pairs whose rule instance or entity no longer exists, along with their
+-- evaluation history.
+
+-- name: CountOrphanedEvaluationRuleEntities :one
+SELECT COUNT(*) FROM evaluation_rule_entities ere
+WHERE NOT EXISTS (SELECT 1 FROM entity_instances ei WHERE ei.id = ere.entity_instance_id)
+    OR NOT EXISTS (SELECT 1 FROM rule_instances ri WHERE ri.id = ere.rule_id);
+
+-- name: DeleteOrphanedEvaluationRuleEntities :execrows
+DELETE FROM evaluation_rule_entities ere
+WHERE NOT EXISTS (SELECT 1 FROM entity_instances ei WHERE ei.id = ere.entity_instance_id)
+    OR NOT EXISTS (SELECT 1 FROM rule_instances ri WHERE ri.id = ere.rule_id);
+
+-- ListEnumValues lists the values of the enum types of the current schema,
+-- in their declaration order.
+
+-- name: ListEnumValues :many
+SELECT t.typname::text AS type_name, e.enumlabel::text AS value
+FROM pg_catalog.pg_type t
+INNER JOIN pg_catalog.pg_enum e ON e.enumtypid = t.oid
+INNER JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+WHERE n.nspname = current_schema()
+ORDER BY t.typname, e.enumsortorder;
+
+-- ListInvalidIndexes lists the indexes of the current schema which can't be
+-- used, usually because building them concurrently failed.
+
+-- name: ListInvalidIndexes :many
+SELECT c.relname::text AS index_name, t.relname::text AS table_name
+FROM pg_catalog.pg_index i
+INNER JOIN pg_catalog.pg_class c ON c.oid = i.indexrelid
+INNER JOIN pg_catalog.pg_class t ON t.oid = i.indrelid
+INNER JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = current_schema() AND (NOT i.indisvalid OR NOT i.indisready)
+ORDER BY c.relname;
@@ -0,0 +1,25 @@
+-- name: ListMigrationPhases :many
+SELECT * FROM migration_phases ORDER BY version, name;
+
+-- name: GetMigrationPhase :one
+SELECT * FROM migration_phases WHERE name = $1;
+
+-- StartMigrationPhase records that a phase is running, resetting the outcome
+-- of its previous runs.
+
+-- name: StartMigrationPhase :one
+INSERT INTO migration_phases (name, version, state)
+VALUES (sqlc.arg(name), sqlc.arg(version), 'running')
+ON CONFLICT (name) DO UPDATE
+SET state = 'running', last_error = '', started_at = NOW(), completed_at = NULL, updated_at = NOW()
+RETURNING *;
+
+-- name: CompleteMigrationPhase :exec
+UPDATE migration_phases
+SET state = 'completed', completed_at = NOW(), updated_at = NOW()
+WHERE name = $1;
+
+-- name: FailMigrationPhase :exec
+UPDATE migration_phases
+SET state = 'failed', last_error = sqlc.arg(last_error), updated_at = NOW()
+WHERE name = sqlc.arg(name);
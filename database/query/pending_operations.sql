@@ -0,0 +1,27 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- name: CreatePendingOperation :one
+INSERT INTO pending_operations (
+    project_id,
+    method,
+    request,
+    requested_by,
+    expires_at
+) VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetPendingOperationByIDAndLock :one
+SELECT * FROM pending_operations
+WHERE id = $1 AND project_id = $2
+FOR UPDATE;
+
+-- name: UpdatePendingOperationState :exec
+UPDATE pending_operations
+SET state = $2, reviewed_by = $3, updated_at = NOW()
+WHERE id = $1;
+
+-- name: ListPendingOperations :many
+SELECT * FROM pending_operations
+WHERE project_id = $1
+ORDER BY created_at;
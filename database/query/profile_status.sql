@@ -107,4 +107,29 @@ WHERE les.profile_id = $1
     AND (ei.name = sqlc.narg(entity_name) OR sqlc.narg(entity_name) IS NULL)
     AND (rt.name = sqlc.narg(rule_type_name) OR sqlc.narg(rule_type_name) IS NULL)
     AND (lower(ri.name) = lower(sqlc.narg(rule_name)) OR sqlc.narg(rule_name) IS NULL)
+    AND (sqlc.slice(statuses)::eval_status_types[] IS NULL OR ed.eval_status = ANY(sqlc.slice(statuses)::eval_status_types[]))
+    AND (ei.name LIKE sqlc.narg(entity_name_pattern) OR sqlc.narg(entity_name_pattern) IS NULL)
+    AND (sqlc.slice(severities)::severity[] IS NULL OR rt.severity_value = ANY(sqlc.slice(severities)::severity[]))
 ;
+
+-- name: ListCanaryRuleResults :many
+-- ListCanaryRuleResults aggregates the latest evaluations of the canary rules
+-- of a profile, which are left out of the profile status.
+SELECT
+    ri.name AS rule_name,
+    ri.entity_type,
+    rt.name AS rule_type_name,
+    ri.canary_percentage::integer AS canary_percentage,
+    COUNT(es.id) FILTER (WHERE es.status = 'success')::bigint AS success_count,
+    COUNT(es.id) FILTER (WHERE es.status IN ('failure', 'excepted', 'suppressed'))::bigint AS failure_count,
+    COUNT(es.id) FILTER (WHERE es.status = 'error')::bigint AS error_count,
+    COUNT(es.id) FILTER (WHERE es.status = 'skipped')::bigint AS skipped_count
+FROM rule_instances AS ri
+         INNER JOIN rule_type rt ON rt.id = ri.rule_type_id
+         LEFT JOIN evaluation_rule_entities ere ON ere.rule_id = ri.id
+         LEFT JOIN latest_evaluation_statuses les ON les.rule_entity_id = ere.id
+         LEFT JOIN evaluation_statuses es ON es.id = les.evaluation_history_id
+WHERE ri.profile_id = $1
+    AND ri.canary_percentage IS NOT NULL
+GROUP BY ri.id, rt.name
+ORDER BY ri.name;
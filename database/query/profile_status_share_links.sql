@@ -0,0 +1,26 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- name: CreateProfileStatusShareLink :one
+INSERT INTO profile_status_share_links (
+    project_id,
+    profile_id,
+    expires_at
+) VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetProfileStatusShareLink :one
+SELECT * FROM profile_status_share_links
+WHERE id = $1 AND project_id = $2;
+
+-- name: DeleteProfileStatusShareLink :one
+DELETE FROM profile_status_share_links
+WHERE id = $1 AND project_id = $2
+RETURNING *;
+
+-- DeleteExpiredProfileStatusShareLinks removes the expired links of a
+-- project, which can't be used anymore.
+
+-- name: DeleteExpiredProfileStatusShareLinks :exec
+DELETE FROM profile_status_share_links
+WHERE project_id = $1 AND expires_at < NOW();
@@ -0,0 +1,24 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- CreateProfileVersion records the definition of a profile which is being
+-- replaced by an update. The profile is expected to be locked by the caller,
+-- so that the version numbers don't clash.
+
+-- name: CreateProfileVersion :one
+INSERT INTO profile_versions (profile_id, version, definition, author)
+SELECT sqlc.arg(profile_id), COALESCE(MAX(version), 0) + 1, sqlc.arg(definition), sqlc.arg(author)
+FROM profile_versions
+WHERE profile_id = sqlc.arg(profile_id)
+RETURNING *;
+
+-- ListProfileVersions lists the versions of a profile, the most recent first.
+
+-- name: ListProfileVersions :many
+SELECT * FROM profile_versions
+WHERE profile_id = sqlc.arg(profile_id)
+ORDER BY version DESC;
+
+-- name: GetProfileVersion :one
+SELECT * FROM profile_versions
+WHERE profile_id = sqlc.arg(profile_id) AND version = sqlc.arg(version);
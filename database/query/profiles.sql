@@ -6,8 +6,10 @@ INSERT INTO profiles (
     name,
     subscription_id,
     display_name,
-    labels
-) VALUES ($1, $2, $3, $4, sqlc.narg(subscription_id), sqlc.arg(display_name), COALESCE(sqlc.arg(labels)::text[], '{}'::text[])) RETURNING *;
+    labels,
+    alert_grouping_window
+) VALUES ($1, $2, $3, $4, sqlc.narg(subscription_id), sqlc.arg(display_name), COALESCE(sqlc.arg(labels)::text[], '{}'::text[]),
+    sqlc.arg(alert_grouping_window)) RETURNING *;
 
 -- name: UpdateProfile :one
 UPDATE profiles SET
@@ -15,7 +17,8 @@ UPDATE profiles SET
     alert = $4,
     updated_at = NOW(),
     display_name = sqlc.arg(display_name),
-    labels = COALESCE(sqlc.arg(labels)::TEXT[], '{}'::TEXT[])
+    labels = COALESCE(sqlc.arg(labels)::TEXT[], '{}'::TEXT[]),
+    alert_grouping_window = sqlc.arg(alert_grouping_window)
 WHERE id = $1 AND project_id = $2 RETURNING *;
 
 -- name: CreateProfileForEntity :one
@@ -90,6 +93,9 @@ SELECT * FROM profiles WHERE id = $1 AND project_id = $2;
 -- name: GetProfileByIDAndLock :one
 SELECT * FROM profiles WHERE id = $1 AND project_id = $2 FOR UPDATE;
 
+-- name: GetProfileByName :one
+SELECT * FROM profiles WHERE lower(name) = lower(sqlc.arg(name)) AND project_id = $1;
+
 -- name: GetProfileByNameAndLock :one
 SELECT * FROM profiles WHERE lower(name) = lower(sqlc.arg(name)) AND project_id = $1 FOR UPDATE;
 
@@ -164,3 +170,25 @@ SELECT sqlc.embed(profiles),
 FROM profiles
 LEFT JOIN helper ON profiles.id = helper.profid
 WHERE id = ANY(sqlc.arg(profile_ids)::UUID[]);
+
+-- name: CreateProfileBase :exec
+INSERT INTO profile_extends (profile_id, base_profile_id, position)
+VALUES ($1, $2, $3);
+
+-- name: DeleteProfileBases :exec
+DELETE FROM profile_extends WHERE profile_id = $1;
+
+-- ListProfileBases lists the names of the profiles extended by the given
+-- profiles, in the order they are extended.
+
+-- name: ListProfileBases :many
+SELECT pe.profile_id, p.name
+FROM profile_extends AS pe
+JOIN profiles AS p ON p.id = pe.base_profile_id
+WHERE pe.profile_id = ANY(sqlc.arg(profile_ids)::UUID[])
+ORDER BY pe.profile_id, pe.position;
+
+-- name: ListProfilesExtending :many
+SELECT pe.profile_id
+FROM profile_extends AS pe
+WHERE pe.base_profile_id = $1;
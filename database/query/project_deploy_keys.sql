@@ -0,0 +1,17 @@
+-- name: CreateProjectDeployKey :one
+INSERT INTO project_deploy_keys (project_id, name, public_key, fingerprint, encrypted_private_key)
+VALUES (sqlc.arg(project_id), sqlc.arg(name), sqlc.arg(public_key), sqlc.arg(fingerprint), sqlc.arg(encrypted_private_key))
+RETURNING *;
+
+-- name: GetProjectDeployKeyByName :one
+SELECT * FROM project_deploy_keys
+WHERE project_id = sqlc.arg(project_id) AND name = sqlc.arg(name);
+
+-- name: ListProjectDeployKeys :many
+SELECT * FROM project_deploy_keys
+WHERE project_id = sqlc.arg(project_id)
+ORDER BY name;
+
+-- name: DeleteProjectDeployKey :execrows
+DELETE FROM project_deploy_keys
+WHERE project_id = sqlc.arg(project_id) AND name = sqlc.arg(name);
@@ -0,0 +1,19 @@
+-- name: UpsertProjectSecret :one
+INSERT INTO project_secrets (project_id, name, encrypted_value)
+VALUES (sqlc.arg(project_id), sqlc.arg(name), sqlc.arg(encrypted_value))
+ON CONFLICT (project_id, name) DO UPDATE
+SET encrypted_value = sqlc.arg(encrypted_value), updated_at = NOW()
+RETURNING *;
+
+-- name: GetProjectSecretByName :one
+SELECT * FROM project_secrets
+WHERE project_id = sqlc.arg(project_id) AND name = sqlc.arg(name);
+
+-- name: ListProjectSecrets :many
+SELECT * FROM project_secrets
+WHERE project_id = sqlc.arg(project_id)
+ORDER BY name;
+
+-- name: DeleteProjectSecret :execrows
+DELETE FROM project_secrets
+WHERE project_id = sqlc.arg(project_id) AND name = sqlc.arg(name);
@@ -0,0 +1,44 @@
+-- name: UpsertProjectTier :one
+INSERT INTO project_tiers (name, description, max_repositories, max_providers, max_rules, advanced_engines, features)
+VALUES (
+    sqlc.arg(name),
+    sqlc.arg(description),
+    sqlc.narg(max_repositories),
+    sqlc.narg(max_providers),
+    sqlc.narg(max_rules),
+    sqlc.arg(advanced_engines),
+    COALESCE(sqlc.arg(features)::text[], '{}')
+)
+ON CONFLICT (name) DO UPDATE SET
+    description = EXCLUDED.description,
+    max_repositories = EXCLUDED.max_repositories,
+    max_providers = EXCLUDED.max_providers,
+    max_rules = EXCLUDED.max_rules,
+    advanced_engines = EXCLUDED.advanced_engines,
+    features = EXCLUDED.features,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetProjectTierByName :one
+SELECT * FROM project_tiers WHERE name = $1;
+
+-- name: ListProjectTiers :many
+SELECT * FROM project_tiers ORDER BY name;
+
+-- name: DeleteProjectTier :execrows
+DELETE FROM project_tiers WHERE name = $1;
+
+-- name: AssignProjectTier :exec
+INSERT INTO project_tier_assignments (project_id, tier)
+VALUES (sqlc.arg(project_id), sqlc.arg(tier))
+ON CONFLICT (project_id) DO UPDATE SET tier = EXCLUDED.tier, created_at = NOW();
+
+-- name: UnassignProjectTier :execrows
+DELETE FROM project_tier_assignments WHERE project_id = $1;
+
+-- GetEffectiveProjectTier returns the tier assigned to the project or, when
+-- none is, the one of its closest ancestor.
+
+-- name: GetEffectiveProjectTier :one
+SELECT * FROM project_tiers
+WHERE name = effective_project_tier(sqlc.arg(project_id)::UUID);
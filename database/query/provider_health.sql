@@ -0,0 +1,34 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- GetProviderHealth returns the result of the latest health check of a provider.
+
+-- name: GetProviderHealth :one
+SELECT * FROM provider_health WHERE provider_id = sqlc.arg(provider_id);
+
+-- UpsertProviderHealth stores the result of a health check of a provider.
+-- The time of the last successful API call is kept when the check failed.
+
+-- name: UpsertProviderHealth :one
+INSERT INTO provider_health (
+    provider_id, checks, healthy, last_success_at, token_expires_at, scopes, scopes_changed_at, checked_at
+) VALUES (
+    sqlc.arg(provider_id), sqlc.arg(checks), sqlc.arg(healthy), sqlc.narg(last_success_at),
+    sqlc.narg(token_expires_at), sqlc.arg(scopes)::text[], sqlc.narg(scopes_changed_at), NOW()
+)
+ON CONFLICT (provider_id) DO UPDATE
+SET checks = sqlc.arg(checks),
+    healthy = sqlc.arg(healthy),
+    last_success_at = COALESCE(sqlc.narg(last_success_at), provider_health.last_success_at),
+    token_expires_at = sqlc.narg(token_expires_at),
+    scopes = sqlc.arg(scopes)::text[],
+    scopes_changed_at = COALESCE(sqlc.narg(scopes_changed_at), provider_health.scopes_changed_at),
+    checked_at = NOW()
+RETURNING *;
+
+-- SetProviderHealthAlertedExpiry records that the expiry of the token of a
+-- provider was alerted on.
+
+-- name: SetProviderHealthAlertedExpiry :exec
+UPDATE provider_health SET alerted_expiry = sqlc.arg(alerted_expiry)
+WHERE provider_id = sqlc.arg(provider_id);
@@ -0,0 +1,15 @@
+-- name: CreateProviderShare :one
+INSERT INTO provider_shares (provider_id, project_id)
+VALUES ($1, $2)
+ON CONFLICT (provider_id, project_id) DO NOTHING
+RETURNING *;
+
+-- name: DeleteProviderShare :execrows
+DELETE FROM provider_shares
+WHERE provider_id = $1 AND project_id = $2;
+
+-- name: ListProviderShares :many
+SELECT * FROM provider_shares
+WHERE provider_id = $1
+ORDER BY created_at ASC;
+
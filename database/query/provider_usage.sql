@@ -0,0 +1,25 @@
+-- name: AddProviderUsage :exec
+INSERT INTO provider_usage (provider_id, bucket, api_calls, webhooks, evaluations)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (provider_id, bucket) DO UPDATE SET
+    api_calls = provider_usage.api_calls + EXCLUDED.api_calls,
+    webhooks = provider_usage.webhooks + EXCLUDED.webhooks,
+    evaluations = provider_usage.evaluations + EXCLUDED.evaluations;
+
+-- ListProviderUsage returns the usage of the given providers since the given
+-- time, summed over buckets of the given granularity ('hour' or 'day', in UTC).
+
+-- name: ListProviderUsage :many
+SELECT provider_id,
+    date_trunc(sqlc.arg(granularity)::text, bucket, 'UTC')::timestamptz AS bucket,
+    SUM(api_calls)::bigint AS api_calls,
+    SUM(webhooks)::bigint AS webhooks,
+    SUM(evaluations)::bigint AS evaluations
+FROM provider_usage
+WHERE provider_id = ANY(sqlc.arg(provider_ids)::uuid[])
+    AND bucket >= sqlc.arg(since)
+GROUP BY 1, 2
+ORDER BY 1, 2;
+
+-- name: DeleteProviderUsageBefore :execrows
+DELETE FROM provider_usage WHERE bucket < $1;
@@ -25,13 +25,24 @@ SELECT * FROM providers WHERE id = $1 AND project_id = $2;
 
 -- FindProviders allows us to take a trait and filter
 -- providers by it. It also optionally takes a name, in case we want to
--- filter by name as well.
+-- filter by name as well. When the project the providers are looked up for
+-- is given, the providers of its parent projects which are explicitly shared
+-- with other projects only are left out.
 
 -- name: FindProviders :many
 SELECT * FROM providers
 WHERE project_id = ANY(sqlc.arg(projects)::uuid[])
     AND (sqlc.narg('trait')::provider_type = ANY(implements) OR sqlc.narg('trait')::provider_type IS NULL)
-    AND (lower(name) = lower(sqlc.narg('name')::text) OR sqlc.narg('name')::text IS NULL);
+    AND (lower(name) = lower(sqlc.narg('name')::text) OR sqlc.narg('name')::text IS NULL)
+    AND (
+        sqlc.narg('project_id')::uuid IS NULL
+        OR providers.project_id = sqlc.narg('project_id')::uuid
+        OR NOT EXISTS (SELECT 1 FROM provider_shares ps WHERE ps.provider_id = providers.id)
+        OR EXISTS (
+            SELECT 1 FROM provider_shares ps
+            WHERE ps.provider_id = providers.id AND ps.project_id = ANY(sqlc.arg(projects)::uuid[])
+        )
+    );
 
 -- ListProvidersByProjectID allows us to list all providers
 -- for a given array of projects.
@@ -62,4 +73,7 @@ UPDATE providers
 
 -- name: DeleteProvider :exec
 DELETE FROM providers
-   WHERE id = $1 AND project_id = sqlc.arg('project_id');
\ No newline at end of file
+   WHERE id = $1 AND project_id = sqlc.arg('project_id');
+
+-- name: CountProvidersByProjectID :one
+SELECT COUNT(*) FROM providers WHERE project_id = $1;
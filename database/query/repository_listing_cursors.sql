@@ -0,0 +1,25 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- GetRepositoryListingCursor returns the cursor to resume the repository
+-- listing of a provider for a project from.
+
+-- name: GetRepositoryListingCursor :one
+SELECT next_cursor FROM repository_listing_cursors
+WHERE provider_id = sqlc.arg(provider_id) AND project_id = sqlc.arg(project_id);
+
+-- UpsertRepositoryListingCursor stores the cursor of the next page of the
+-- repository listing of a provider for a project.
+
+-- name: UpsertRepositoryListingCursor :exec
+INSERT INTO repository_listing_cursors (provider_id, project_id, next_cursor)
+VALUES (sqlc.arg(provider_id), sqlc.arg(project_id), sqlc.arg(next_cursor))
+ON CONFLICT (provider_id, project_id) DO UPDATE
+SET next_cursor = sqlc.arg(next_cursor), updated_at = NOW();
+
+-- DeleteRepositoryListingCursor removes the cursor of the repository listing
+-- of a provider for a project once the listing is complete.
+
+-- name: DeleteRepositoryListingCursor :exec
+DELETE FROM repository_listing_cursors
+WHERE provider_id = sqlc.arg(provider_id) AND project_id = sqlc.arg(project_id);
@@ -0,0 +1,62 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- name: CreateRuleException :one
+INSERT INTO rule_exceptions (
+    project_id,
+    rule_id,
+    entity_instance_id,
+    justification,
+    requested_by,
+    expires_at
+) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetRuleExceptionByIDAndLock :one
+SELECT * FROM rule_exceptions
+WHERE id = $1 AND project_id = $2
+FOR UPDATE;
+
+-- name: ReviewRuleException :one
+UPDATE rule_exceptions
+SET state = $2, reviewed_by = $3, review_comment = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListRuleExceptions :many
+SELECT re.id, re.project_id, re.rule_id, re.entity_instance_id, re.justification,
+    re.state, re.requested_by, re.reviewed_by, re.review_comment, re.expires_at,
+    re.created_at, re.updated_at,
+    p.name AS profile_name,
+    ri.name AS rule_name,
+    rt.name AS rule_type_name,
+    ei.name AS entity_name,
+    ei.entity_type
+FROM rule_exceptions AS re
+JOIN rule_instances AS ri ON ri.id = re.rule_id
+JOIN profiles AS p ON p.id = ri.profile_id
+JOIN rule_type AS rt ON rt.id = ri.rule_type_id
+JOIN entity_instances AS ei ON ei.id = re.entity_instance_id
+WHERE re.project_id = $1
+AND (sqlc.narg(id)::UUID IS NULL OR re.id = sqlc.narg(id)::UUID)
+ORDER BY re.created_at;
+
+-- GetActiveRuleException returns the approved and unexpired exception
+-- covering the given rule and entity, if any.
+-- name: GetActiveRuleException :one
+SELECT * FROM rule_exceptions
+WHERE rule_id = $1
+AND entity_instance_id = $2
+AND state = 'approved'
+AND expires_at > NOW()
+ORDER BY expires_at DESC
+LIMIT 1;
+
+-- name: CreateRuleExceptionEvent :exec
+INSERT INTO rule_exception_events (exception_id, state, actor, comment)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListRuleExceptionEvents :many
+SELECT * FROM rule_exception_events
+WHERE exception_id = ANY(sqlc.arg(exception_ids)::UUID[])
+ORDER BY created_at;
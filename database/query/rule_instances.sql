@@ -10,6 +10,8 @@ INSERT INTO rule_instances (
     def,
     params,
     project_id,
+    overrides,
+    canary_percentage,
     created_at,
     updated_at
 ) VALUES(
@@ -20,6 +22,8 @@ INSERT INTO rule_instances (
     $5,
     $6,
     $7,
+    $8,
+    sqlc.narg(canary_percentage),
     NOW(),
     NOW()
 )
@@ -27,6 +31,8 @@ ON CONFLICT (profile_id, entity_type, lower(name)) DO UPDATE SET
     rule_type_id = $2,
     def = $5,
     params = $6,
+    overrides = $8,
+    canary_percentage = sqlc.narg(canary_percentage),
     updated_at = NOW()
 RETURNING id;
 
@@ -52,5 +58,55 @@ WHERE name = $1
 AND entity_type = $2
 AND profile_id = $3;
 
+-- ListProjectsUsingRuleType lists the projects with rule instances of a
+-- rule type.
+
+-- name: ListProjectsUsingRuleType :many
+SELECT DISTINCT project_id FROM rule_instances WHERE rule_type_id = $1;
+
 -- name: DeleteRuleInstanceOfProfileInProject :exec
 DELETE FROM rule_instances WHERE project_id = $1 AND profile_id = $2 AND rule_type_id = $3;
+
+-- name: GetRuleInstanceByProfileName :one
+SELECT ri.* FROM rule_instances AS ri
+JOIN profiles AS p ON p.id = ri.profile_id
+WHERE ri.project_id = $1
+AND lower(p.name) = lower(sqlc.arg(profile_name))
+AND ri.entity_type = $2
+AND lower(ri.name) = lower(sqlc.arg(rule_name));
+
+-- name: GetRuleInstancesByProfileName :many
+SELECT ri.* FROM rule_instances AS ri
+JOIN profiles AS p ON p.id = ri.profile_id
+WHERE ri.project_id = $1
+AND lower(p.name) = lower(sqlc.arg(profile_name))
+AND lower(ri.name) = lower(sqlc.arg(rule_name));
+
+-- DisableRuleInstance disables a rule instance, recording who disabled it
+-- and why. Disabled rules are reported as skipped.
+
+-- name: DisableRuleInstance :one
+UPDATE rule_instances
+SET disabled_at = NOW(),
+    disabled_by = sqlc.arg(disabled_by),
+    disabled_reason = sqlc.arg(disabled_reason)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: EnableRuleInstance :one
+UPDATE rule_instances
+SET disabled_at = NULL,
+    disabled_by = NULL,
+    disabled_reason = NULL
+WHERE id = $1
+RETURNING *;
+
+-- name: ListDisabledRuleInstances :many
+SELECT ri.*, p.name AS profile_name FROM rule_instances AS ri
+JOIN profiles AS p ON p.id = ri.profile_id
+WHERE ri.project_id = $1
+AND ri.disabled_at IS NOT NULL
+ORDER BY p.name, ri.name;
+
+-- name: CountRuleInstancesByProjectID :one
+SELECT COUNT(*) FROM rule_instances WHERE project_id = $1;
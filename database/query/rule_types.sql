@@ -10,7 +10,8 @@ INSERT INTO rule_type (
     display_name,
     release_phase,
     short_failure_message,
-    rego_version
+    rego_version,
+    visibility
 ) VALUES (
     $1,
     $2,
@@ -22,7 +23,8 @@ INSERT INTO rule_type (
     sqlc.arg(display_name),
     sqlc.arg(release_phase),
     sqlc.arg(short_failure_message),
-    sqlc.arg(rego_version)
+    sqlc.arg(rego_version),
+    sqlc.arg(visibility)
 ) RETURNING *;
 
 -- name: ListRuleTypesByProject :many
@@ -31,15 +33,33 @@ SELECT * FROM rule_type WHERE project_id = $1;
 -- name: GetRuleTypeByID :one
 SELECT * FROM rule_type WHERE id = $1;
 
+-- GetRuleTypeByName retrieves a rule type by name which is visible from a
+-- project hierarchy. The first project of the hierarchy is the project
+-- doing the lookup, which sees its own rule types and the ones its
+-- ancestors share with their subtree. Global rule types of other
+-- hierarchies are only returned if include_global is set. The rule type
+-- closest to the project wins.
+--
+-- Note that to get a rule type for a given project, one can simply
+-- pass one project id in the projects array.
+
 -- name: GetRuleTypeByName :one
-SELECT * FROM rule_type WHERE  project_id = ANY(sqlc.arg(projects)::uuid[]) AND lower(name) = lower(sqlc.arg(name));
+SELECT * FROM rule_type
+WHERE lower(name) = lower(sqlc.arg(name))
+AND (
+    project_id = (sqlc.arg(projects)::uuid[])[1]
+    OR (project_id = ANY(sqlc.arg(projects)::uuid[]) AND visibility != 'private')
+    OR (sqlc.arg(include_global)::boolean AND visibility = 'global')
+)
+ORDER BY array_position(sqlc.arg(projects)::uuid[], project_id) NULLS LAST, created_at
+LIMIT 1;
 
 -- name: DeleteRuleType :exec
 DELETE FROM rule_type WHERE id = $1;
 
 -- name: UpdateRuleType :one
 UPDATE rule_type
-    SET description = $2, definition = sqlc.arg(definition)::jsonb, severity_value = sqlc.arg(severity_value), display_name = sqlc.arg(display_name), release_phase = sqlc.arg(release_phase), short_failure_message = sqlc.arg(short_failure_message), rego_version = sqlc.arg(rego_version)
+    SET description = $2, definition = sqlc.arg(definition)::jsonb, severity_value = sqlc.arg(severity_value), display_name = sqlc.arg(display_name), release_phase = sqlc.arg(release_phase), short_failure_message = sqlc.arg(short_failure_message), rego_version = sqlc.arg(rego_version), visibility = sqlc.arg(visibility)
     WHERE id = $1
     RETURNING *;
 
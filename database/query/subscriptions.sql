@@ -10,6 +10,13 @@ ON CONFLICT DO NOTHING;
 -- name: GetBundle :one
 SELECT * FROM bundles WHERE namespace = $1 AND name = $2;
 
+-- SetBundleSignatureVerification records the result of the verification of
+-- the signature of a bundle.
+
+-- name: SetBundleSignatureVerification :exec
+UPDATE bundles SET signature_verification = sqlc.arg(signature_verification)
+WHERE namespace = sqlc.arg(namespace) AND name = sqlc.arg(name);
+
 -- Subscriptions --
 
 -- name: CreateSubscription :one
@@ -0,0 +1,28 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- An entity throttled again keeps its first evaluation time, so that it is
+-- not postponed indefinitely by the events of a busy project.
+
+-- name: DeferThrottledEvaluation :exec
+INSERT INTO throttled_evaluations (
+    entity_instance_id,
+    project_id,
+    evaluate_after
+) VALUES ($1, $2, $3)
+ON CONFLICT (entity_instance_id) DO NOTHING;
+
+-- PopDueThrottledEvaluations removes and returns the throttled entities which
+-- can be evaluated again. A re-evaluation which is lost is not an issue: the
+-- entity is evaluated again on its next event, or by the reminder service.
+
+-- name: PopDueThrottledEvaluations :many
+DELETE FROM throttled_evaluations
+WHERE entity_instance_id IN (
+    SELECT te.entity_instance_id FROM throttled_evaluations AS te
+    WHERE te.evaluate_after <= NOW()
+    ORDER BY te.evaluate_after
+    LIMIT sqlc.arg(size)::bigint
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
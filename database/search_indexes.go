@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// searchIndexes are the full-text search indexes over the details of the
+// evaluations and of the remediations. The expressions must match the ones
+// of the ListEvaluationHistory query for the indexes to be used. The simple
+// configuration doesn't stem words, so that identifiers such as CVE numbers
+// are matched exactly.
+var searchIndexes = []struct {
+	name  string
+	table string
+	expr  string
+}{
+	{"evaluation_statuses_details_search_idx", "evaluation_statuses", "to_tsvector('simple', details)"},
+	{"remediation_events_details_search_idx", "remediation_events", "to_tsvector('simple', details)"},
+}
+
+// createSearchIndexes builds the full-text search indexes concurrently, so
+// that evaluations keep being written meanwhile. A concurrent build which
+// failed leaves an invalid index behind, which is dropped and built again.
+func createSearchIndexes(ctx context.Context, conn *sql.DB) error {
+	for _, idx := range searchIndexes {
+		var valid bool
+		err := conn.QueryRowContext(ctx,
+			`SELECT i.indisvalid FROM pg_index i JOIN pg_class c ON c.oid = i.indexrelid WHERE c.relname = $1`,
+			idx.name).Scan(&valid)
+		switch {
+		case err == nil && valid:
+			continue
+		case err == nil:
+			if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP INDEX CONCURRENTLY IF EXISTS %s", idx.name)); err != nil {
+				return fmt.Errorf("error dropping invalid index %s: %w", idx.name, err)
+			}
+		case !errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("error checking index %s: %w", idx.name, err)
+		}
+
+		// nolint:gosec // the statement is built from constants
+		stmt := fmt.Sprintf("CREATE INDEX CONCURRENTLY IF NOT EXISTS %s ON %s USING GIN (%s)",
+			idx.name, idx.table, idx.expr)
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("error creating index %s: %w", idx.name, err)
+		}
+	}
+	return nil
+}
@@ -8,6 +8,8 @@ package dbadapter
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 
 	"github.com/mindersec/minder/internal/db"
 	engineerrors "github.com/mindersec/minder/pkg/engine/errors"
@@ -20,6 +22,10 @@ func ErrorAsEvalStatus(err error) db.EvalStatusTypes {
 		return db.EvalStatusTypesFailure
 	} else if errors.Is(err, interfaces.ErrEvaluationSkipped) {
 		return db.EvalStatusTypesSkipped
+	} else if errors.Is(err, engineerrors.ErrEvaluationExcepted) {
+		return db.EvalStatusTypesExcepted
+	} else if errors.Is(err, engineerrors.ErrEvaluationSuppressed) {
+		return db.EvalStatusTypesSuppressed
 	} else if err != nil {
 		return db.EvalStatusTypesError
 	}
@@ -41,6 +47,43 @@ func ErrorAsEvalDetails(err error) string {
 	return ""
 }
 
+// ErrorAsEvalErrorCode returns the code classifying the cause of a given
+// evaluation error. Only errors resulting in the error status have a code.
+func ErrorAsEvalErrorCode(err error) db.NullEvalErrorCodes {
+	if ErrorAsEvalStatus(err) != db.EvalStatusTypesError {
+		return db.NullEvalErrorCodes{}
+	}
+
+	var rateLimitErr *engineerrors.RateLimitError
+	var urlErr *url.Error
+	var opErr *net.OpError
+
+	code := db.EvalErrorCodesUnknown
+	switch {
+	case errors.Is(err, engineerrors.ErrInternal):
+		code = db.EvalErrorCodesInternal
+	case errors.Is(err, engineerrors.ErrEvaluationLimitExceeded):
+		code = db.EvalErrorCodesLimitExceeded
+	case errors.As(err, &rateLimitErr):
+		code = db.EvalErrorCodesRateLimited
+	case errors.Is(err, engineerrors.ErrProviderUnavailable),
+		errors.Is(err, engineerrors.ErrServerError),
+		errors.As(err, &urlErr),
+		errors.As(err, &opErr):
+		code = db.EvalErrorCodesProviderUnavailable
+	case errors.Is(err, engineerrors.ErrPolicyCompile):
+		code = db.EvalErrorCodesPolicyCompileError
+	case errors.Is(err, engineerrors.ErrIngestionNotApplicable):
+		code = db.EvalErrorCodesIngestionNotApplicable
+	case errors.Is(err, engineerrors.ErrInvalidRuleParameters):
+		code = db.EvalErrorCodesInvalidRuleParameters
+	case errors.Is(err, engineerrors.ErrIngestionFailed):
+		code = db.EvalErrorCodesIngestionFailed
+	}
+
+	return db.NullEvalErrorCodes{EvalErrorCodes: code, Valid: true}
+}
+
 // ErrorAsRemediationStatus returns the remediation status for a given error
 func ErrorAsRemediationStatus(err error) db.RemediationStatusTypes {
 	if err == nil {
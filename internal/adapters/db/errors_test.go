@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dbadapter
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/db"
+	engineerrors "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+func TestErrorAsEvalErrorCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want db.NullEvalErrorCodes
+	}{
+		{
+			name: "success",
+			err:  nil,
+			want: db.NullEvalErrorCodes{},
+		},
+		{
+			name: "failure",
+			err:  engineerrors.NewErrEvaluationFailed("failed"),
+			want: db.NullEvalErrorCodes{},
+		},
+		{
+			name: "unknown",
+			err:  errors.New("boom"),
+			want: errorCode(db.EvalErrorCodesUnknown),
+		},
+		{
+			name: "internal",
+			err:  engineerrors.ErrInternal,
+			want: errorCode(db.EvalErrorCodesInternal),
+		},
+		{
+			name: "limit exceeded",
+			err:  engineerrors.NewErrEvaluationLimitExceeded("too slow"),
+			want: errorCode(db.EvalErrorCodesLimitExceeded),
+		},
+		{
+			name: "rate limited while ingesting",
+			err: fmt.Errorf("%w: %w", engineerrors.ErrIngestionFailed,
+				engineerrors.NewRateLimitError(errors.New("slow down"), 10, 0, time.Now())),
+			want: errorCode(db.EvalErrorCodesRateLimited),
+		},
+		{
+			name: "provider unavailable",
+			err:  fmt.Errorf("%w: bad gateway", engineerrors.ErrProviderUnavailable),
+			want: errorCode(db.EvalErrorCodesProviderUnavailable),
+		},
+		{
+			name: "provider unreachable while ingesting",
+			err: fmt.Errorf("%w: %w", engineerrors.ErrIngestionFailed,
+				&url.Error{Op: "Get", URL: "https://example.com", Err: errors.New("connection refused")}),
+			want: errorCode(db.EvalErrorCodesProviderUnavailable),
+		},
+		{
+			name: "policy compile error",
+			err:  fmt.Errorf("%w: could not prepare Rego", engineerrors.ErrPolicyCompile),
+			want: errorCode(db.EvalErrorCodesPolicyCompileError),
+		},
+		{
+			name: "ingestion not applicable",
+			err: fmt.Errorf("%w: %w", engineerrors.ErrIngestionFailed,
+				engineerrors.ErrIngestionNotApplicable),
+			want: errorCode(db.EvalErrorCodesIngestionNotApplicable),
+		},
+		{
+			name: "ingestion failed",
+			err:  fmt.Errorf("%w: %w", engineerrors.ErrIngestionFailed, errors.New("bad json")),
+			want: errorCode(db.EvalErrorCodesIngestionFailed),
+		},
+		{
+			name: "invalid rule parameters",
+			err:  fmt.Errorf("%w: rule parameters validation failed", engineerrors.ErrInvalidRuleParameters),
+			want: errorCode(db.EvalErrorCodesInvalidRuleParameters),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, ErrorAsEvalErrorCode(tt.err))
+		})
+	}
+}
+
+func errorCode(code db.EvalErrorCodes) db.NullEvalErrorCodes {
+	return db.NullEvalErrorCodes{EvalErrorCodes: code, Valid: true}
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomaly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/email"
+)
+
+// alert logs the anomalies and notifies the alert contact of them, unless
+// the same anomaly of the rule type was alerted on within the cooldown
+func (d *Detector) alert(ctx context.Context, anomalies []Anomaly) error {
+	now := d.now()
+	for _, a := range anomalies {
+		key := string(a.Kind) + "/" + a.RuleType
+		d.mu.Lock()
+		last, ok := d.alerted[key]
+		if ok && now.Sub(last) < d.cfg.AlertCooldown {
+			d.mu.Unlock()
+			continue
+		}
+		d.alerted[key] = now
+		d.mu.Unlock()
+
+		zerolog.Ctx(ctx).Warn().
+			Str("anomaly", string(a.Kind)).
+			Str("rule_type", a.RuleType).
+			Msg(a.Summary)
+
+		subject := fmt.Sprintf("Minder alert: %s of rule type %s", a.Kind, a.RuleType)
+		if err := d.notify(subject, a.Summary+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Detector) notify(subject, text string) error {
+	if d.cfg.AlertContact == "" || d.pub == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(email.MailEventPayload{
+		Address:  d.cfg.AlertContact,
+		Subject:  subject,
+		BodyText: text,
+		BodyHTML: "<pre>" + template.HTMLEscapeString(text) + "</pre>",
+	})
+	if err != nil {
+		return fmt.Errorf("error marshalling payload for email event: %w", err)
+	}
+
+	if err := d.pub.Publish(email.TopicQueueInviteEmail, message.NewMessage(uuid.New().String(), payload)); err != nil {
+		return fmt.Errorf("error publishing anomaly alert: %w", err)
+	}
+	return nil
+}
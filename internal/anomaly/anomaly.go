@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package anomaly detects anomalies in the evaluations of the rule types,
+// such as a sudden spike of failures across many entities or a regression of
+// the evaluation latency, and alerts the operators on them. These anomalies
+// usually point to a change of an upstream API or to a broken rule type
+// update rather than to the entities themselves.
+package anomaly
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+// Kind is the kind of an anomaly
+type Kind string
+
+const (
+	// KindFailureSpike is a sudden increase of the entities failing a rule type
+	KindFailureSpike Kind = "failure_spike"
+	// KindLatencyRegression is an increase of the evaluation latency of a rule type
+	KindLatencyRegression Kind = "latency_regression"
+)
+
+// minBaselineIntervals is the number of intervals the latency of a rule type
+// must be measured over before it is compared to its baseline
+const minBaselineIntervals = 4
+
+// baselineWeight is the weight of the latest interval in the latency
+// baseline, which is an exponentially weighted moving average
+const baselineWeight = 0.2
+
+// Anomaly is an anomaly detected in the evaluations of a rule type
+type Anomaly struct {
+	Kind     Kind
+	RuleType string
+	// Summary describes the anomaly for the operators
+	Summary string
+}
+
+type latency struct {
+	count     int64
+	total     time.Duration
+	baseline  time.Duration
+	intervals int
+}
+
+// Detector analyzes the evaluations at regular intervals. Failures are read
+// from the evaluation history, while latencies are recorded in memory, so
+// each server instance reports on the evaluations it performs itself.
+type Detector struct {
+	store db.EvalHistoryStore
+	pub   interfaces.Publisher
+	cfg   *serverconfig.AnomalyDetectionConfig
+	now   func() time.Time
+
+	mu        sync.Mutex
+	latencies map[string]*latency
+	alerted   map[string]time.Time
+}
+
+// NewDetector creates a new anomaly detector. Alerts are published on pub.
+func NewDetector(
+	store db.EvalHistoryStore,
+	pub interfaces.Publisher,
+	cfg *serverconfig.AnomalyDetectionConfig,
+) *Detector {
+	return &Detector{
+		store:     store,
+		pub:       pub,
+		cfg:       cfg,
+		now:       time.Now,
+		latencies: make(map[string]*latency),
+		alerted:   make(map[string]time.Time),
+	}
+}
+
+// RecordEvaluation records how long the evaluation of a rule type took. A nil
+// detector does nothing, so that callers don't need to check whether anomaly
+// detection is enabled.
+func (d *Detector) RecordEvaluation(ruleType string, duration time.Duration) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	l, ok := d.latencies[ruleType]
+	if !ok {
+		l = &latency{}
+		d.latencies[ruleType] = l
+	}
+	l.count++
+	l.total += duration
+}
+
+// Run analyzes the evaluations at regular intervals until the context is
+// cancelled, and alerts on the anomalies
+func (d *Detector) Run(ctx context.Context) error {
+	if d.cfg.Interval <= 0 {
+		return fmt.Errorf("invalid anomaly detection interval: %s", d.cfg.Interval)
+	}
+
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			anomalies, err := d.Analyze(ctx)
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error analyzing evaluations")
+			}
+			if err := d.alert(ctx, anomalies); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error alerting on anomalies")
+			}
+		}
+	}
+}
+
+// Analyze returns the anomalies of the evaluations since the previous
+// analysis. Latency anomalies are returned even if the failures could not be
+// analyzed.
+func (d *Detector) Analyze(ctx context.Context) ([]Anomaly, error) {
+	anomalies := d.latencyRegressions()
+
+	spikes, err := d.failureSpikes(ctx)
+	if err != nil {
+		return anomalies, err
+	}
+	return append(spikes, anomalies...), nil
+}
+
+func (d *Detector) failureSpikes(ctx context.Context) ([]Anomaly, error) {
+	now := d.now()
+	windowStart := now.Add(-d.cfg.Window)
+	baselineStart := windowStart.Add(-d.cfg.Baseline)
+
+	counts, err := d.store.ListRuleTypeFailureCounts(ctx, db.ListRuleTypeFailureCountsParams{
+		WindowStart:   windowStart,
+		BaselineStart: baselineStart,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error counting failures: %w", err)
+	}
+
+	var anomalies []Anomaly
+	for _, c := range counts {
+		if c.RecentFailures < d.cfg.MinFailingEntities ||
+			float64(c.RecentFailures) < d.cfg.FailureSpikeFactor*float64(max(c.BaselineFailures, 1)) {
+			continue
+		}
+
+		summary := fmt.Sprintf("%d of the %d entities evaluated in %d projects failed rule type %q in the last %s, "+
+			"against %d in the %s before.",
+			c.RecentFailures, c.RecentEvaluated, c.RecentProjects, c.RuleTypeName, d.cfg.Window,
+			c.BaselineFailures, d.cfg.Baseline)
+		if c.RuleTypeUpdatedAt.After(baselineStart) {
+			summary += fmt.Sprintf(" A rule type of this name was updated at %s, check whether the update broke it.",
+				c.RuleTypeUpdatedAt.Format(time.RFC3339))
+		} else {
+			summary += " No rule type of this name was updated recently, check whether the provider API changed."
+		}
+		anomalies = append(anomalies, Anomaly{
+			Kind:     KindFailureSpike,
+			RuleType: c.RuleTypeName,
+			Summary:  summary,
+		})
+	}
+	return anomalies, nil
+}
+
+// latencyRegressions compares the average latency of each rule type since
+// the previous analysis to its baseline, and folds it into the baseline.
+// Rule types evaluated too few times are compared once they were evaluated
+// enough.
+func (d *Detector) latencyRegressions() []Anomaly {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var anomalies []Anomaly
+	for ruleType, l := range d.latencies {
+		if l.count < d.cfg.MinLatencySamples {
+			continue
+		}
+		average := l.total / time.Duration(l.count)
+
+		if l.intervals >= minBaselineIntervals &&
+			float64(average) > d.cfg.LatencyRegressionFactor*float64(l.baseline) {
+			anomalies = append(anomalies, Anomaly{
+				Kind:     KindLatencyRegression,
+				RuleType: ruleType,
+				Summary: fmt.Sprintf("The %d evaluations of rule type %q took %s on average, against %s before.",
+					l.count, ruleType, average.Round(time.Millisecond), l.baseline.Round(time.Millisecond)),
+			})
+		}
+
+		if l.intervals == 0 {
+			l.baseline = average
+		} else {
+			l.baseline = time.Duration(baselineWeight*float64(average) + (1-baselineWeight)*float64(l.baseline))
+		}
+		l.intervals++
+		l.count = 0
+		l.total = 0
+	}
+
+	sort.Slice(anomalies, func(i, j int) bool {
+		return anomalies[i].RuleType < anomalies[j].RuleType
+	})
+	return anomalies
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomaly
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func testConfig() *serverconfig.AnomalyDetectionConfig {
+	return &serverconfig.AnomalyDetectionConfig{
+		Interval:                15 * time.Minute,
+		Window:                  time.Hour,
+		Baseline:                24 * time.Hour,
+		FailureSpikeFactor:      3,
+		MinFailingEntities:      10,
+		LatencyRegressionFactor: 2,
+		MinLatencySamples:       2,
+		AlertCooldown:           6 * time.Hour,
+	}
+}
+
+func TestFailureSpikes(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		counts      []db.ListRuleTypeFailureCountsRow
+		wantTypes   []string
+		wantSummary string
+	}{
+		{
+			name: "spike after rule type update",
+			counts: []db.ListRuleTypeFailureCountsRow{{
+				RuleTypeName:      "secret_scanning",
+				RecentFailures:    40,
+				BaselineFailures:  2,
+				RecentEvaluated:   50,
+				RecentProjects:    7,
+				RuleTypeUpdatedAt: now.Add(-30 * time.Minute),
+			}},
+			wantTypes:   []string{"secret_scanning"},
+			wantSummary: "was updated at 2024-10-01T11:30:00Z",
+		},
+		{
+			name: "spike without rule type update",
+			counts: []db.ListRuleTypeFailureCountsRow{{
+				RuleTypeName:      "branch_protection_enabled",
+				RecentFailures:    12,
+				RecentEvaluated:   12,
+				RecentProjects:    3,
+				RuleTypeUpdatedAt: now.Add(-30 * 24 * time.Hour),
+			}},
+			wantTypes:   []string{"branch_protection_enabled"},
+			wantSummary: "check whether the provider API changed",
+		},
+		{
+			name: "too few failing entities",
+			counts: []db.ListRuleTypeFailureCountsRow{{
+				RuleTypeName:   "secret_scanning",
+				RecentFailures: 5,
+			}},
+		},
+		{
+			name: "failures in line with the baseline",
+			counts: []db.ListRuleTypeFailureCountsRow{{
+				RuleTypeName:     "secret_scanning",
+				RecentFailures:   40,
+				BaselineFailures: 30,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockEvalHistoryStore(ctrl)
+			store.EXPECT().ListRuleTypeFailureCounts(gomock.Any(), db.ListRuleTypeFailureCountsParams{
+				WindowStart:   now.Add(-time.Hour),
+				BaselineStart: now.Add(-25 * time.Hour),
+			}).Return(tt.counts, nil)
+
+			d := NewDetector(store, nil, testConfig())
+			d.now = func() time.Time { return now }
+
+			anomalies, err := d.Analyze(context.Background())
+			require.NoError(t, err)
+			var types []string
+			for _, a := range anomalies {
+				require.Equal(t, KindFailureSpike, a.Kind)
+				require.Contains(t, a.Summary, tt.wantSummary)
+				types = append(types, a.RuleType)
+			}
+			require.Equal(t, tt.wantTypes, types)
+		})
+	}
+}
+
+func TestLatencyRegressions(t *testing.T) {
+	t.Parallel()
+
+	d := NewDetector(nil, nil, testConfig())
+
+	// establish the baseline
+	for range minBaselineIntervals {
+		d.RecordEvaluation("secret_scanning", 100*time.Millisecond)
+		d.RecordEvaluation("secret_scanning", 100*time.Millisecond)
+		require.Empty(t, d.latencyRegressions())
+	}
+
+	// too few samples are not compared
+	d.RecordEvaluation("secret_scanning", time.Second)
+	require.Empty(t, d.latencyRegressions())
+
+	d.RecordEvaluation("secret_scanning", time.Second)
+	anomalies := d.latencyRegressions()
+	require.Len(t, anomalies, 1)
+	require.Equal(t, KindLatencyRegression, anomalies[0].Kind)
+	require.Equal(t, "The 2 evaluations of rule type \"secret_scanning\" took 1s on average, against 100ms before.",
+		anomalies[0].Summary)
+
+	// a nil detector ignores evaluations
+	var nilDetector *Detector
+	nilDetector.RecordEvaluation("secret_scanning", time.Second)
+}
+
+func TestAlertCooldown(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	d := NewDetector(nil, nil, testConfig())
+	d.now = func() time.Time { return now }
+
+	spike := Anomaly{Kind: KindFailureSpike, RuleType: "secret_scanning"}
+	require.NoError(t, d.alert(context.Background(), []Anomaly{spike}))
+	require.Equal(t, now, d.alerted["failure_spike/secret_scanning"])
+
+	d.now = func() time.Time { return now.Add(time.Hour) }
+	require.NoError(t, d.alert(context.Background(), []Anomaly{spike}))
+	require.Equal(t, now, d.alerted["failure_spike/secret_scanning"])
+
+	d.now = func() time.Time { return now.Add(7 * time.Hour) }
+	require.NoError(t, d.alert(context.Background(), []Anomaly{spike}))
+	require.Equal(t, now.Add(7*time.Hour), d.alerted["failure_spike/secret_scanning"])
+}
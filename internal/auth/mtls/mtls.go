@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mtls provides an implementation of the IdentityProvider for the
+// service accounts which authenticate with a client certificate.
+package mtls
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/mindersec/minder/internal/auth"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// ProviderName is the name of the service accounts identity provider
+const ProviderName = "serviceaccount"
+
+// ServiceAccounts is an implementation of the auth.IdentityProvider interface
+// for the service accounts configured in the mTLS configuration.
+type ServiceAccounts struct {
+	cfg *serverconfig.MTLSConfig
+}
+
+var _ auth.IdentityProvider = (*ServiceAccounts)(nil)
+
+var providerUrl = url.URL{
+	Scheme: "mtls",
+	Host:   ProviderName,
+}
+
+// NewServiceAccounts creates a new service accounts identity provider
+func NewServiceAccounts(cfg *serverconfig.MTLSConfig) (*ServiceAccounts, error) {
+	if err := validateServiceAccounts(cfg.ServiceAccounts); err != nil {
+		return nil, err
+	}
+	return &ServiceAccounts{cfg: cfg}, nil
+}
+
+func validateServiceAccounts(accounts []serverconfig.ServiceAccountConfig) error {
+	names := make(map[string]bool, len(accounts))
+	subjects := make(map[string]bool, len(accounts))
+	for _, sa := range accounts {
+		if sa.Name == "" || sa.Subject == "" {
+			return errors.New("service accounts must have a name and a subject")
+		}
+		// OpenFGA doesn't allow these characters in the subjects
+		if strings.ContainsAny(sa.Name, ":#/ ") {
+			return fmt.Errorf("invalid service account name %q", sa.Name)
+		}
+		if names[sa.Name] {
+			return fmt.Errorf("duplicate service account %q", sa.Name)
+		}
+		if subjects[sa.Subject] {
+			return fmt.Errorf("duplicate service account subject %q", sa.Subject)
+		}
+		names[sa.Name] = true
+		subjects[sa.Subject] = true
+	}
+	return nil
+}
+
+// String implements auth.IdentityProvider.
+func (*ServiceAccounts) String() string {
+	return ProviderName
+}
+
+// URL implements auth.IdentityProvider.
+func (*ServiceAccounts) URL() url.URL {
+	return providerUrl
+}
+
+// Resolve implements auth.IdentityProvider.
+func (s *ServiceAccounts) Resolve(_ context.Context, id string) (*auth.Identity, error) {
+	for _, sa := range s.cfg.ServiceAccounts {
+		if sa.Name == id {
+			return &auth.Identity{
+				UserID:    sa.Name,
+				HumanName: sa.Name,
+				Provider:  s,
+			}, nil
+		}
+	}
+	return nil, auth.ErrNotFound
+}
+
+// ResolveFederated implements auth.IdentityProvider.
+func (*ServiceAccounts) ResolveFederated(_ context.Context, _, _ string) (*auth.Identity, error) {
+	return nil, auth.ErrNotFound
+}
+
+// Validate implements auth.IdentityProvider. Service accounts authenticate
+// with a client certificate, never with a token.
+func (*ServiceAccounts) Validate(_ context.Context, _ jwt.Token) (*auth.Identity, error) {
+	return nil, errors.New("service accounts do not authenticate with tokens")
+}
+
+// AccountForCertificate returns the name of the service account the verified
+// client certificate maps to, or an empty string if there is none.
+func AccountForCertificate(cfg *serverconfig.MTLSConfig, cert *x509.Certificate) string {
+	if cert == nil {
+		return ""
+	}
+
+	candidates := make([]string, 0, len(cert.URIs)+len(cert.DNSNames)+len(cert.EmailAddresses)+1)
+	for _, u := range cert.URIs {
+		candidates = append(candidates, u.String())
+	}
+	candidates = append(candidates, cert.DNSNames...)
+	candidates = append(candidates, cert.EmailAddresses...)
+	if cert.Subject.CommonName != "" {
+		candidates = append(candidates, cert.Subject.CommonName)
+	}
+
+	for _, candidate := range candidates {
+		for _, sa := range cfg.ServiceAccounts {
+			if sa.Subject == candidate {
+				return sa.Name
+			}
+		}
+	}
+	return ""
+}
+
+// ServerTLSConfig returns the TLS configuration of the listeners. Client
+// certificates are verified if given, but not required, so that the users
+// and the webhooks can still connect without one.
+func ServerTLSConfig(cfg *serverconfig.MTLSConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(cfg.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %w", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("no certificates found in client CA file")
+	}
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+	}, nil
+}
+
+// LoopbackTLSConfig returns the TLS configuration used by the server to call
+// itself, e.g. from the HTTP gateway to the gRPC listener. The listener
+// address rarely matches the names of the certificate, so instead of the
+// usual verification, the peer must present the server's own certificate.
+func LoopbackTLSConfig(server *tls.Config) *tls.Config {
+	own := server.Certificates[0].Certificate[0]
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// #nosec G402 -- the certificate is pinned in VerifyPeerCertificate
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], own) {
+				return errors.New("peer did not present the server certificate")
+			}
+			return nil
+		},
+	}
+}
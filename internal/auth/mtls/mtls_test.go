@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/auth"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+var testConfig = &serverconfig.MTLSConfig{
+	Enabled: true,
+	ServiceAccounts: []serverconfig.ServiceAccountConfig{
+		{Name: "reconciler", Subject: "spiffe://example.com/ns/minder/sa/reconciler"},
+		{Name: "backup", Subject: "backup.example.com"},
+		{Name: "legacy", Subject: "legacy-client"},
+	},
+}
+
+func TestNewServiceAccounts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		accounts []serverconfig.ServiceAccountConfig
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			accounts: testConfig.ServiceAccounts,
+		},
+		{
+			name:     "missing subject",
+			accounts: []serverconfig.ServiceAccountConfig{{Name: "reconciler"}},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid name",
+			accounts: []serverconfig.ServiceAccountConfig{{Name: "ns:reconciler", Subject: "a"}},
+			wantErr:  true,
+		},
+		{
+			name: "duplicate name",
+			accounts: []serverconfig.ServiceAccountConfig{
+				{Name: "reconciler", Subject: "a"},
+				{Name: "reconciler", Subject: "b"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate subject",
+			accounts: []serverconfig.ServiceAccountConfig{
+				{Name: "reconciler", Subject: "a"},
+				{Name: "backup", Subject: "a"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewServiceAccounts(&serverconfig.MTLSConfig{ServiceAccounts: tt.accounts})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	sa, err := NewServiceAccounts(testConfig)
+	require.NoError(t, err)
+
+	id, err := sa.Resolve(context.Background(), "reconciler")
+	require.NoError(t, err)
+	require.Equal(t, "serviceaccount/reconciler", id.String())
+	require.Equal(t, "serviceaccount/reconciler", id.Human())
+
+	_, err = sa.Resolve(context.Background(), "unknown")
+	require.ErrorIs(t, err, auth.ErrNotFound)
+
+	idClient, err := auth.NewIdentityClient(sa)
+	require.NoError(t, err)
+	id, err = idClient.Resolve(context.Background(), "serviceaccount/backup")
+	require.NoError(t, err)
+	require.Equal(t, "backup", id.UserID)
+}
+
+func TestAccountForCertificate(t *testing.T) {
+	t.Parallel()
+
+	spiffe, err := url.Parse("spiffe://example.com/ns/minder/sa/reconciler")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		cert *x509.Certificate
+		want string
+	}{
+		{
+			name: "uri san",
+			cert: &x509.Certificate{URIs: []*url.URL{spiffe}, Subject: pkix.Name{CommonName: "legacy-client"}},
+			want: "reconciler",
+		},
+		{
+			name: "dns san",
+			cert: &x509.Certificate{DNSNames: []string{"other.example.com", "backup.example.com"}},
+			want: "backup",
+		},
+		{
+			name: "common name",
+			cert: &x509.Certificate{Subject: pkix.Name{CommonName: "legacy-client"}},
+			want: "legacy",
+		},
+		{
+			name: "no match",
+			cert: &x509.Certificate{Subject: pkix.Name{CommonName: "someone"}},
+		},
+		{
+			name: "no certificate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, AccountForCertificate(testConfig, tt.cert))
+		})
+	}
+}
+
+func writeCertificate(t *testing.T, dir string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "minder"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return certFile, keyFile
+}
+
+func TestServerTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := writeCertificate(t, dir)
+
+	cfg, err := ServerTLSConfig(&serverconfig.MTLSConfig{
+		CertFile:     certFile,
+		KeyFile:      keyFile,
+		ClientCAFile: certFile,
+	})
+	require.NoError(t, err)
+	require.Len(t, cfg.Certificates, 1)
+
+	loopback := LoopbackTLSConfig(cfg)
+	require.NoError(t, loopback.VerifyPeerCertificate(cfg.Certificates[0].Certificate, nil))
+	otherCert, _ := writeCertificate(t, t.TempDir())
+	other, err := os.ReadFile(otherCert)
+	require.NoError(t, err)
+	block, _ := pem.Decode(other)
+	require.Error(t, loopback.VerifyPeerCertificate([][]byte{block.Bytes}, nil))
+
+	_, err = ServerTLSConfig(&serverconfig.MTLSConfig{
+		CertFile:     certFile,
+		KeyFile:      keyFile,
+		ClientCAFile: keyFile,
+	})
+	require.Error(t, err)
+}
@@ -7,10 +7,10 @@ package authz
 import (
 	"context"
 	_ "embed"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -25,6 +25,10 @@ import (
 	srvconfig "github.com/mindersec/minder/pkg/config/server"
 )
 
+// maxBatchCheckSize is the number of checks sent in each batch check request,
+// which is the default maximum of the OpenFGA server
+const maxBatchCheckSize = 50
+
 var (
 	// ErrStoreNotFound denotes the error where the store wasn't found via the
 	// given configuration.
@@ -67,7 +71,7 @@ func NewAuthzClient(cfg *srvconfig.AuthzConfig, l *zerolog.Logger) (Client, erro
 // Note that this assumes the configuration has already been validated.
 func (a *ClientWrapper) initAuthzClient() error {
 	clicfg := &fgaclient.ClientConfiguration{
-		ApiUrl: a.cfg.ApiUrl,
+		ApiUrl: a.cfg.GetApiUrl(),
 		Credentials: &credentials.Credentials{
 			// We use our own bearer auth round tripper so we can refresh the token
 			Method: credentials.CredentialsMethodNone,
@@ -100,7 +104,8 @@ func (a *ClientWrapper) initAuthzClient() error {
 
 // PrepareForRun initializes the authz client based on the configuration.
 // This is handy when migrations have already been done and helps us auto-discover
-// the store ID and model.
+// the store ID and model. The latest model is used unless one is pinned in the
+// configuration.
 func (a *ClientWrapper) PrepareForRun(ctx context.Context) error {
 	storeID, err := a.findStoreByName(ctx)
 	if err != nil {
@@ -112,9 +117,12 @@ func (a *ClientWrapper) PrepareForRun(ctx context.Context) error {
 		return fmt.Errorf("unable to store authz ID: %w", err)
 	}
 
-	modelID, err := a.findLatestModel(ctx)
-	if err != nil {
-		return fmt.Errorf("unable to find authz model: %w", err)
+	modelID := a.cfg.ModelID
+	if modelID == "" {
+		modelID, err = a.findLatestModel(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to find authz model: %w", err)
+		}
 	}
 
 	if err := a.cli.SetAuthorizationModelId(modelID); err != nil {
@@ -218,12 +226,12 @@ func (a *ClientWrapper) findLatestModel(ctx context.Context) (string, error) {
 
 // writeModel writes the authz model to the configured store
 func (a *ClientWrapper) writeModel(ctx context.Context) (string, error) {
-	var body fgasdk.WriteAuthorizationModelRequest
-	if err := json.Unmarshal([]byte(authzModel), &body); err != nil {
-		return "", fmt.Errorf("failed to unmarshal authz model: %w", err)
+	body, err := embeddedModel()
+	if err != nil {
+		return "", err
 	}
 
-	data, err := a.cli.WriteAuthorizationModel(ctx).Body(body).Execute()
+	data, err := a.cli.WriteAuthorizationModel(ctx).Body(*body).Execute()
 	if err != nil {
 		return "", fmt.Errorf("error while writing authz model: %w", err)
 	}
@@ -258,6 +266,57 @@ func (a *ClientWrapper) Check(ctx context.Context, action string, project uuid.U
 	return ErrNotAuthorized
 }
 
+// BatchCheck checks if the user is authorized to perform each of the given
+// actions on its project. The checks are sent with the batch check API, in
+// batches of at most maxBatchCheckSize checks sent in parallel.
+func (a *ClientWrapper) BatchCheck(ctx context.Context, checks []ProjectCheck) ([]bool, error) {
+	if len(checks) == 0 {
+		return nil, nil
+	}
+
+	id := auth.IdentityFromContext(ctx)
+	if id.String() == "" {
+		return nil, fmt.Errorf("no user token found in context")
+	}
+	userString := getUserForTuple(id.String())
+
+	// The index of each check is its correlation ID, so that the same
+	// check may be requested more than once
+	items := make([]fgaclient.ClientBatchCheckItem, 0, len(checks))
+	for i, check := range checks {
+		items = append(items, fgaclient.ClientBatchCheckItem{
+			User:          userString,
+			Relation:      check.Action,
+			Object:        getProjectForTuple(check.Project),
+			CorrelationId: strconv.Itoa(i),
+		})
+	}
+
+	maxBatchSize := int32(maxBatchCheckSize)
+	resp, err := a.cli.BatchCheck(ctx).Options(fgaclient.BatchCheckOptions{
+		MaxBatchSize: &maxBatchSize,
+	}).Body(fgaclient.ClientBatchCheckRequest{
+		Checks: items,
+	}).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("OpenFGA error for %s: %w", userString, err)
+	}
+
+	results := resp.GetResult()
+	allowed := make([]bool, len(checks))
+	for i := range checks {
+		result, ok := results[strconv.Itoa(i)]
+		if !ok {
+			return nil, fmt.Errorf("OpenFGA returned no result for check %d", i)
+		}
+		if result.Error != nil {
+			return nil, fmt.Errorf("OpenFGA error for check %d: %s", i, result.Error.GetMessage())
+		}
+		allowed[i] = result.GetAllowed()
+	}
+	return allowed, nil
+}
+
 // Write persists the given role for the given user and project
 func (a *ClientWrapper) Write(ctx context.Context, user string, role Role, project uuid.UUID) error {
 	return a.write(ctx, fgasdk.TupleKey{
@@ -396,90 +455,52 @@ func (a *ClientWrapper) AssignmentsToProject(ctx context.Context, project uuid.U
 	return assignments, nil
 }
 
-// ProjectsForUser lists the projects that the given user has access to
+// ProjectsForUser lists the projects that the given user has a role in,
+// directly or inherited from a parent project. OpenFGA resolves the
+// inheritance, so that there is one listing per role whatever the number of
+// projects. The listings are streamed, as ListObjects is capped by the server.
 func (a *ClientWrapper) ProjectsForUser(ctx context.Context, sub string) ([]uuid.UUID, error) {
-	u := getUserForTuple(sub)
-
-	var pagesize int32 = 50
-	var contTok *string = nil
-
-	projs := map[string]any{}
-	projectObj := "project:"
-
-	for {
-		resp, err := a.cli.Read(ctx).Options(fgaclient.ClientReadOptions{
-			PageSize:          &pagesize,
-			ContinuationToken: contTok,
-		}).Body(fgaclient.ClientReadRequest{
-			User:   &u,
-			Object: &projectObj,
-		}).Execute()
-		if err != nil {
-			return nil, fmt.Errorf("unable to read authorization tuples: %w", err)
-		}
-
-		for _, t := range resp.GetTuples() {
-			k := t.GetKey()
-
-			projs[k.GetObject()] = struct{}{}
-		}
-
-		if resp.GetContinuationToken() == "" {
-			break
-		}
-
-		contTok = &resp.ContinuationToken
-	}
-
+	seen := map[uuid.UUID]struct{}{}
 	out := []uuid.UUID{}
-	for proj := range projs {
-		u, err := uuid.Parse(getProjectFromTuple(proj))
-		if err != nil {
-			continue
-		}
-
-		out = append(out, u)
-
-		children, err := a.traverseProjectsForParent(ctx, u)
+	for role := range AllRolesDescriptions {
+		projects, err := a.listProjects(ctx, getUserForTuple(sub), role.String())
 		if err != nil {
 			return nil, err
 		}
-
-		out = append(out, children...)
+		for _, proj := range projects {
+			if _, ok := seen[proj]; ok {
+				continue
+			}
+			seen[proj] = struct{}{}
+			out = append(out, proj)
+		}
 	}
 
 	return out, nil
 }
 
-// traverseProjectsForParent is a recursive function that traverses the project
-// hierarchy to find all projects that the parent project has access to.
-func (a *ClientWrapper) traverseProjectsForParent(ctx context.Context, parent uuid.UUID) ([]uuid.UUID, error) {
-	projects := []uuid.UUID{}
-
-	resp, err := a.cli.ListObjects(ctx).Body(fgaclient.ClientListObjectsRequest{
-		User:     getProjectForTuple(parent),
-		Relation: "parent",
+// listProjects streams the projects the user has the given relation on
+func (a *ClientWrapper) listProjects(ctx context.Context, user, relation string) ([]uuid.UUID, error) {
+	resp, err := a.cli.StreamedListObjects(ctx).Body(fgaclient.ClientStreamedListObjectsRequest{
 		Type:     "project",
+		Relation: relation,
+		User:     user,
 	}).Execute()
-
 	if err != nil {
-		return nil, fmt.Errorf("unable to read authorization tuples: %w", err)
+		return nil, fmt.Errorf("unable to list authorization objects: %w", err)
 	}
+	defer resp.Close()
 
-	for _, obj := range resp.GetObjects() {
-		u, err := uuid.Parse(getProjectFromTuple(obj))
+	projects := []uuid.UUID{}
+	for obj := range resp.Objects {
+		u, err := uuid.Parse(getProjectFromTuple(obj.GetObject()))
 		if err != nil {
 			continue
 		}
 		projects = append(projects, u)
 	}
-
-	for _, proj := range projects {
-		children, err := a.traverseProjectsForParent(ctx, proj)
-		if err != nil {
-			return nil, err
-		}
-		projects = append(projects, children...)
+	if err := <-resp.Errors; err != nil {
+		return nil, fmt.Errorf("unable to list authorization objects: %w", err)
 	}
 
 	return projects, nil
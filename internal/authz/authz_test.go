@@ -7,6 +7,7 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
+	"fmt"
 	"sync"
 	"testing"
 
@@ -168,6 +169,16 @@ func TestVerifyMultipleProjects(t *testing.T) {
 	// verify user-1 cannot operate on project 3
 	assert.Error(t, c.Check(userctx, "get", prj3), "expected user-1 to not be able to operate on project 3")
 
+	// verify all the projects at once
+	allowed, err := c.BatchCheck(userctx, []authz.ProjectCheck{
+		{Action: "get", Project: prj1},
+		{Action: "get", Project: prj2},
+		{Action: "get", Project: prj3},
+		{Action: "role_assignment_create", Project: prj2},
+	})
+	assert.NoError(t, err, "failed to batch check projects")
+	assert.Equal(t, []bool{true, true, false, false}, allowed, "unexpected batch check results")
+
 	// ensure projects for user returns the projects
 	projects, err := c.ProjectsForUser(userctx, "user-1")
 	assert.NoError(t, err, "failed to get projects for user")
@@ -205,7 +216,175 @@ func TestVerifyMultipleProjects(t *testing.T) {
 	assert.Len(t, assignments, 0, "expected 0 assignments to project")
 }
 
-func newOpenFGAServerAndClient(t *testing.T) (authz.Client, func()) {
+func TestVerifyInheritedProjects(t *testing.T) {
+	t.Parallel()
+
+	c, stopFunc := newOpenFGAServerAndClient(t)
+	defer stopFunc()
+
+	ctx := context.Background()
+	require.NoError(t, c.MigrateUp(ctx), "failed to migrate up")
+	require.NoError(t, c.PrepareForRun(ctx), "failed to prepare for run")
+
+	// user-1 administers the parent and views one of the children directly
+	parent, child, grandchild := uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, c.Write(ctx, "user-1", authz.RoleAdmin, parent), "failed to write project")
+	require.NoError(t, c.Adopt(ctx, parent, child), "failed to adopt project")
+	require.NoError(t, c.Adopt(ctx, child, grandchild), "failed to adopt project")
+	require.NoError(t, c.Write(ctx, "user-1", authz.RoleViewer, child), "failed to write project")
+
+	userctx := auth.WithIdentityContext(ctx, &auth.Identity{
+		UserID: "user-1",
+	})
+
+	// ensure projects for user returns the whole hierarchy once
+	projects, err := c.ProjectsForUser(userctx, "user-1")
+	require.NoError(t, err, "failed to get projects for user")
+	assert.ElementsMatch(t, []uuid.UUID{parent, child, grandchild}, projects)
+
+	// the admin role is inherited by the sub-projects
+	allowed, err := c.BatchCheck(userctx, []authz.ProjectCheck{
+		{Action: authz.RoleAdmin.String(), Project: grandchild},
+		{Action: authz.RoleViewer.String(), Project: child},
+		{Action: "role_assignment_create", Project: grandchild},
+	})
+	require.NoError(t, err, "failed to batch check projects")
+	assert.Equal(t, []bool{true, true, true}, allowed, "unexpected batch check results")
+
+	// once orphaned, the project is no longer accessible
+	require.NoError(t, c.Orphan(ctx, child, grandchild), "failed to orphan project")
+	allowed, err = c.BatchCheck(userctx, []authz.ProjectCheck{
+		{Action: "get", Project: grandchild},
+		{Action: "get", Project: child},
+	})
+	require.NoError(t, err, "failed to batch check projects")
+	assert.Equal(t, []bool{false, true}, allowed, "unexpected batch check results")
+}
+
+func TestProjectsForUserManyProjects(t *testing.T) {
+	t.Parallel()
+
+	c, stopFunc := newOpenFGAServerAndClient(t)
+	defer stopFunc()
+
+	ctx := context.Background()
+	require.NoError(t, c.MigrateUp(ctx), "failed to migrate up")
+	require.NoError(t, c.PrepareForRun(ctx), "failed to prepare for run")
+
+	// the results of ListObjects are capped to 10 in the tests: user-1 has
+	// a role in many more projects, and one of them has as many sub-projects
+	want := []uuid.UUID{}
+	for range 51 {
+		prj := uuid.New()
+		require.NoError(t, c.Write(ctx, "user-1", authz.RoleViewer, prj), "failed to write project")
+		want = append(want, prj)
+	}
+	for range 51 {
+		child := uuid.New()
+		require.NoError(t, c.Adopt(ctx, want[0], child), "failed to adopt project")
+		want = append(want, child)
+	}
+
+	userctx := auth.WithIdentityContext(ctx, &auth.Identity{
+		UserID: "user-1",
+	})
+
+	projects, err := c.ProjectsForUser(userctx, "user-1")
+	require.NoError(t, err, "failed to get projects for user")
+	assert.ElementsMatch(t, want, projects)
+}
+
+// newProjectHierarchy creates a parent project administered by user-1 with
+// the given number of sub-projects
+func newProjectHierarchy(b *testing.B, c authz.Client, children int) []uuid.UUID {
+	b.Helper()
+
+	ctx := context.Background()
+	require.NoError(b, c.MigrateUp(ctx), "failed to migrate up")
+	require.NoError(b, c.PrepareForRun(ctx), "failed to prepare for run")
+
+	parent := uuid.New()
+	require.NoError(b, c.Write(ctx, "user-1", authz.RoleAdmin, parent), "failed to write project")
+	projects := []uuid.UUID{parent}
+	for range children {
+		child := uuid.New()
+		require.NoError(b, c.Adopt(ctx, parent, child), "failed to adopt project")
+		projects = append(projects, child)
+	}
+	return projects
+}
+
+func BenchmarkCheckProjects(b *testing.B) {
+	c, stopFunc := newOpenFGAServerAndClient(b)
+	defer stopFunc()
+
+	projects := newProjectHierarchy(b, c, 100)
+	userctx := auth.WithIdentityContext(context.Background(), &auth.Identity{
+		UserID: "user-1",
+	})
+
+	b.Run("sequential", func(b *testing.B) {
+		for range b.N {
+			for _, prj := range projects {
+				require.NoError(b, c.Check(userctx, "get", prj))
+			}
+		}
+	})
+
+	b.Run("batch", func(b *testing.B) {
+		checks := make([]authz.ProjectCheck, 0, len(projects))
+		for _, prj := range projects {
+			checks = append(checks, authz.ProjectCheck{Action: "get", Project: prj})
+		}
+		for range b.N {
+			_, err := c.BatchCheck(userctx, checks)
+			require.NoError(b, err)
+		}
+	})
+}
+
+func BenchmarkProjectsForUser(b *testing.B) {
+	for _, children := range []int{10, 100, 500} {
+		b.Run(fmt.Sprintf("%d sub-projects", children), func(b *testing.B) {
+			c, stopFunc := newOpenFGAServerAndClient(b)
+			defer stopFunc()
+
+			newProjectHierarchy(b, c, children)
+			userctx := auth.WithIdentityContext(context.Background(), &auth.Identity{
+				UserID: "user-1",
+			})
+
+			for range b.N {
+				projects, err := c.ProjectsForUser(userctx, "user-1")
+				require.NoError(b, err)
+				require.Len(b, projects, children+1)
+			}
+		})
+	}
+}
+
+func newOpenFGAServerAndClient(t testing.TB) (authz.Client, func()) {
+	t.Helper()
+
+	apiURL, stopFunc := startOpenFGAServer(t)
+
+	testw := zerolog.NewTestWriter(t)
+	l := zerolog.New(testw)
+
+	c, err := authz.NewAuthzClient(&srvconfig.AuthzConfig{
+		ApiUrl:    apiURL,
+		StoreName: "minder",
+		Auth: srvconfig.OpenFGAAuth{
+			Method: "none",
+		},
+	}, &l)
+	require.NoError(t, err, "failed to create authz client")
+
+	return c, stopFunc
+}
+
+// startOpenFGAServer starts an in-memory OpenFGA server, returning its API URL
+func startOpenFGAServer(t testing.TB) (string, func()) {
 	t.Helper()
 
 	fgaServerRunMux.Lock()
@@ -213,6 +392,9 @@ func newOpenFGAServerAndClient(t *testing.T) (authz.Client, func()) {
 	cfg := testutils.MustDefaultConfigWithRandomPorts()
 	cfg.Log.Level = "error"
 	cfg.Datastore.Engine = "memory"
+	// a low cap on the results of ListObjects makes any truncation of the
+	// listings visible in the tests
+	cfg.ListObjectsMaxResults = 10
 
 	loggr := logger.MustNewLogger("text", "error", "ISO8601")
 	serverCtx := &run.ServerContext{Logger: loggr}
@@ -226,19 +408,7 @@ func newOpenFGAServerAndClient(t *testing.T) (authz.Client, func()) {
 
 	testutils.EnsureServiceHealthy(t, cfg.GRPC.Addr, cfg.HTTP.Addr, nil)
 
-	testw := zerolog.NewTestWriter(t)
-	l := zerolog.New(testw)
-
-	c, err := authz.NewAuthzClient(&srvconfig.AuthzConfig{
-		ApiUrl:    "http://" + cfg.HTTP.Addr,
-		StoreName: "minder",
-		Auth: srvconfig.OpenFGAAuth{
-			Method: "none",
-		},
-	}, &l)
-	require.NoError(t, err, "failed to create authz client")
-
-	return c, func() {
+	return "http://" + cfg.HTTP.Addr, func() {
 		cancel()
 		fgaServerRunMux.Unlock()
 	}
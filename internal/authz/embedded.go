@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	fgarun "github.com/openfga/openfga/cmd/run"
+	fgalogger "github.com/openfga/openfga/pkg/logger"
+	fgaconfig "github.com/openfga/openfga/pkg/server/config"
+	fgamigrate "github.com/openfga/openfga/pkg/storage/migrate"
+
+	srvconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+const (
+	// embeddedStartupTimeout is how long to wait for the embedded
+	// authorization server to become healthy
+	embeddedStartupTimeout = 30 * time.Second
+	// embeddedMigrationTimeout is how long to wait for the datastore of the
+	// embedded authorization server to be reachable when migrating it
+	embeddedMigrationTimeout = time.Minute
+)
+
+// StartEmbeddedServer runs an OpenFGA server in-process until the context is
+// cancelled, and waits for it to become healthy. Persistent datastores are
+// migrated to the latest schema before the server starts.
+//
+// The store and model are not created by this function, callers are expected
+// to run MigrateUp on a client pointed at the embedded server.
+func StartEmbeddedServer(ctx context.Context, cfg *srvconfig.EmbeddedAuthzConfig) error {
+	fgaLogger := fgalogger.MustNewLogger("text", "error", "ISO8601")
+
+	if cfg.Datastore.Engine != "memory" {
+		err := fgamigrate.RunMigrations(fgamigrate.MigrationConfig{
+			Engine:  cfg.Datastore.Engine,
+			URI:     cfg.Datastore.URI,
+			Timeout: embeddedMigrationTimeout,
+			Logger:  fgaLogger,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to migrate embedded authz datastore: %w", err)
+		}
+	}
+
+	fgaCfg := fgaconfig.DefaultConfig()
+	fgaCfg.Datastore.Engine = cfg.Datastore.Engine
+	fgaCfg.Datastore.URI = cfg.Datastore.URI
+	fgaCfg.GRPC.Addr = cfg.GRPCAddress
+	fgaCfg.HTTP.Addr = cfg.HTTPAddress
+	fgaCfg.Playground.Enabled = false
+	fgaCfg.Metrics.Enabled = false
+	fgaCfg.Log.Level = "error"
+
+	serverCtx := &fgarun.ServerContext{Logger: fgaLogger}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- serverCtx.Run(ctx, fgaCfg)
+	}()
+
+	healthURL := "http://" + cfg.HTTPAddress + "/healthz"
+	deadline := time.Now().Add(embeddedStartupTimeout)
+	for time.Now().Before(deadline) {
+		select {
+		case err := <-errCh:
+			return fmt.Errorf("embedded authz server stopped: %w", err)
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(250 * time.Millisecond):
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthURL, nil)
+		if err != nil {
+			return fmt.Errorf("unable to create health check request: %w", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			continue
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			return nil
+		}
+	}
+	return fmt.Errorf("embedded authz server did not become healthy within %s", embeddedStartupTimeout)
+}
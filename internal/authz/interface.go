@@ -79,12 +79,24 @@ func ParseRole(r string) (Role, error) {
 	return rr, nil
 }
 
+// ProjectCheck is the check of whether an action may be performed on a project
+type ProjectCheck struct {
+	Action  string
+	Project uuid.UUID
+}
+
 // Client provides an abstract interface which simplifies interacting with
 // OpenFGA and supports no-op and fake implementations.
 type Client interface {
 	// Check returns a NotAuthorized if the action is not allowed on the resource, or nil if it is allowed
 	Check(ctx context.Context, action string, project uuid.UUID) error
 
+	// BatchCheck returns whether the user in the context is allowed to
+	// perform each of the actions on its project, in the order of the
+	// checks. The checks are sent in batches rather than one by one, so it
+	// should be preferred over Check when authorizing many projects.
+	BatchCheck(ctx context.Context, checks []ProjectCheck) ([]bool, error)
+
 	// Write stores an authorization tuple allowing user (an OAuth2 subject) to
 	// act in the specified role on the project.
 	//
@@ -0,0 +1,503 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	fgasdk "github.com/openfga/go-sdk"
+	fgaclient "github.com/openfga/go-sdk/client"
+	"github.com/rs/zerolog"
+
+	srvconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// ModelChangeKind is the kind of change of a type or relation between the
+// deployed authorization model and the one of this version of Minder
+type ModelChangeKind string
+
+const (
+	// ModelChangeAdded is a type or relation only in the new model
+	ModelChangeAdded ModelChangeKind = "added"
+	// ModelChangeRemoved is a type or relation only in the deployed model
+	ModelChangeRemoved ModelChangeKind = "removed"
+	// ModelChangeChanged is a relation defined differently in both models
+	ModelChangeChanged ModelChangeKind = "changed"
+)
+
+// ModelChange is a difference between the deployed authorization model and
+// the one of this version of Minder. Relation is empty for changes of a
+// whole type.
+type ModelChange struct {
+	Kind     ModelChangeKind
+	Type     string
+	Relation string
+}
+
+// String returns the change as shown to operators, e.g. "+ project#viewer"
+func (c ModelChange) String() string {
+	prefix := map[ModelChangeKind]string{
+		ModelChangeAdded:   "+",
+		ModelChangeRemoved: "-",
+		ModelChangeChanged: "~",
+	}[c.Kind]
+	if c.Relation == "" {
+		return fmt.Sprintf("%s type %s", prefix, c.Type)
+	}
+	return fmt.Sprintf("%s %s#%s", prefix, c.Type, c.Relation)
+}
+
+// ModelPlan is the difference between the deployed authorization model and
+// the one of this version of Minder
+type ModelPlan struct {
+	// DeployedModelID is the ID of the deployed model, which is the one
+	// pinned in the configuration or else the latest one of the store. It is
+	// empty if no model was deployed yet.
+	DeployedModelID string
+	// Changes are the changes of the types and relations, sorted by type
+	// and relation
+	Changes []ModelChange
+}
+
+// UpToDate returns true if the deployed model is the one of this version
+func (p *ModelPlan) UpToDate() bool {
+	return p.DeployedModelID != "" && len(p.Changes) == 0
+}
+
+// ShadowMismatch is a check whose result differs between the deployed model
+// and the new one
+type ShadowMismatch struct {
+	User     string
+	Relation string
+	Object   string
+	// Deployed is the result of the check with the deployed model
+	Deployed bool
+}
+
+// String returns the mismatch as shown to operators
+func (m ShadowMismatch) String() string {
+	return fmt.Sprintf("%s#%s@%s: %t -> %t", m.Object, m.Relation, m.User, m.Deployed, !m.Deployed)
+}
+
+// ApplyOptions are the options to apply the authorization model
+type ApplyOptions struct {
+	// SampleSize is the maximum number of stored relationships whose checks
+	// are compared between the deployed and the new model
+	SampleSize int
+	// AcceptMismatches switches to the new model even if some checks have a
+	// different result than with the deployed model
+	AcceptMismatches bool
+}
+
+// ApplyResult is the outcome of applying the authorization model
+type ApplyResult struct {
+	// ModelID is the ID of the new model. It is empty if the deployed model
+	// was up to date.
+	ModelID string
+	// Checks is the number of checks compared between both models
+	Checks int
+	// Mismatches are the checks whose result differs between both models
+	Mismatches []ShadowMismatch
+	// RolledBack is true if the deployed model was written again as the
+	// latest model of the store because of mismatches
+	RolledBack bool
+}
+
+// ModelMigrator updates the authorization model of a deployed OpenFGA store
+// to the one of this version of Minder. Unlike MigrateUp, the new model is
+// only switched to once the checks on the stored relationships are verified
+// to give the same results with both models.
+type ModelMigrator struct {
+	*ClientWrapper
+}
+
+// NewModelMigrator returns a ModelMigrator for the configured store, which
+// must already exist
+func NewModelMigrator(ctx context.Context, cfg *srvconfig.AuthzConfig, l *zerolog.Logger) (*ModelMigrator, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	cliWrap := &ClientWrapper{cfg: cfg, l: l}
+	if err := cliWrap.initAuthzClient(); err != nil {
+		return nil, err
+	}
+
+	if !cliWrap.StoreIDProvided() {
+		storeID, err := cliWrap.findStoreByName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find authz store: %w", err)
+		}
+		if err := cliWrap.cli.SetStoreId(storeID); err != nil {
+			return nil, fmt.Errorf("unable to store authz ID: %w", err)
+		}
+	}
+
+	return &ModelMigrator{ClientWrapper: cliWrap}, nil
+}
+
+// Plan compares the deployed authorization model with the one of this
+// version of Minder
+func (m *ModelMigrator) Plan(ctx context.Context) (*ModelPlan, error) {
+	desired, err := embeddedModel()
+	if err != nil {
+		return nil, err
+	}
+
+	deployed, err := m.deployedModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if deployed == nil {
+		plan := &ModelPlan{}
+		for _, td := range desired.TypeDefinitions {
+			plan.Changes = append(plan.Changes, ModelChange{Kind: ModelChangeAdded, Type: td.Type})
+		}
+		return plan, nil
+	}
+
+	return &ModelPlan{
+		DeployedModelID: deployed.Id,
+		Changes:         diffModels(deployed.TypeDefinitions, desired.TypeDefinitions),
+	}, nil
+}
+
+// Apply writes the authorization model of this version of Minder if it
+// differs from the deployed one, and compares the results of the checks on a
+// sample of the stored relationships with both models. If some results
+// differ and the mismatches are not accepted, or if the checks fail, the
+// deployed model is written again so that it remains the latest model of the
+// store.
+//
+// Servers switch to the latest model when they start, unless a model is
+// pinned in their configuration.
+func (m *ModelMigrator) Apply(ctx context.Context, plan *ModelPlan, opts ApplyOptions) (result *ApplyResult, retErr error) {
+	if plan.UpToDate() {
+		return &ApplyResult{}, nil
+	}
+
+	modelID, err := m.writeModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &ApplyResult{ModelID: modelID}
+	// Nothing to compare the new model to on a new store
+	if plan.DeployedModelID == "" {
+		return res, nil
+	}
+
+	// The new model is now the latest one, which servers would switch to if
+	// they restarted. Unless the checks are verified, the deployed model is
+	// written again, even if the checks could not be run.
+	keep := false
+	defer func() {
+		if keep {
+			return
+		}
+		if err := m.rewriteModel(context.WithoutCancel(ctx), plan.DeployedModelID); err != nil {
+			result, retErr = nil, errors.Join(retErr, fmt.Errorf("error restoring the deployed model: %w", err))
+			return
+		}
+		res.RolledBack = true
+	}()
+
+	checks, err := m.shadowChecks(ctx, sharedProjectRelations(plan), opts.SampleSize)
+	if err != nil {
+		return nil, err
+	}
+	res.Checks = len(checks)
+
+	deployedResults, err := m.batchCheck(ctx, plan.DeployedModelID, checks)
+	if err != nil {
+		return nil, fmt.Errorf("error checking with the deployed model: %w", err)
+	}
+	newResults, err := m.batchCheck(ctx, modelID, checks)
+	if err != nil {
+		return nil, fmt.Errorf("error checking with the new model: %w", err)
+	}
+	for i, check := range checks {
+		if deployedResults[i] != newResults[i] {
+			res.Mismatches = append(res.Mismatches, ShadowMismatch{
+				User:     check.User,
+				Relation: check.Relation,
+				Object:   check.Object,
+				Deployed: deployedResults[i],
+			})
+		}
+	}
+
+	keep = len(res.Mismatches) == 0 || opts.AcceptMismatches
+	return res, nil
+}
+
+// deployedModel returns the deployed authorization model, or nil if no model
+// was written to the store yet
+func (m *ModelMigrator) deployedModel(ctx context.Context) (*fgasdk.AuthorizationModel, error) {
+	if m.cfg.ModelID != "" {
+		resp, err := m.cli.ReadAuthorizationModel(ctx).Options(fgaclient.ClientReadAuthorizationModelOptions{
+			AuthorizationModelId: &m.cfg.ModelID,
+		}).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("error while reading authz model %s: %w", m.cfg.ModelID, err)
+		}
+		return resp.AuthorizationModel, nil
+	}
+
+	resp, err := m.cli.ReadAuthorizationModels(ctx).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("error while reading authz models: %w", err)
+	}
+	// models are listed from the latest one
+	if len(resp.AuthorizationModels) == 0 {
+		return nil, nil
+	}
+	return &resp.AuthorizationModels[0], nil
+}
+
+// rewriteModel writes the given model again, so that it becomes the latest
+// model of the store
+func (m *ModelMigrator) rewriteModel(ctx context.Context, modelID string) error {
+	resp, err := m.cli.ReadAuthorizationModel(ctx).Options(fgaclient.ClientReadAuthorizationModelOptions{
+		AuthorizationModelId: &modelID,
+	}).Execute()
+	if err != nil {
+		return err
+	}
+	model := resp.GetAuthorizationModel()
+	_, err = m.cli.WriteAuthorizationModel(ctx).Body(fgasdk.WriteAuthorizationModelRequest{
+		TypeDefinitions: model.TypeDefinitions,
+		SchemaVersion:   model.SchemaVersion,
+		Conditions:      model.Conditions,
+	}).Execute()
+	return err
+}
+
+// sharedProjectRelations returns the relations of the project type in both
+// the deployed and the new model, which are the ones which can be checked
+// with both models
+func sharedProjectRelations(plan *ModelPlan) []string {
+	desired, err := embeddedModel()
+	if err != nil {
+		return nil
+	}
+	var relations []string
+	for _, td := range desired.TypeDefinitions {
+		if td.Type == "project" && td.Relations != nil {
+			relations = slices.Sorted(maps.Keys(*td.Relations))
+		}
+	}
+	for _, c := range plan.Changes {
+		if c.Type != "project" || c.Kind == ModelChangeChanged {
+			continue
+		}
+		if c.Relation == "" {
+			return nil
+		}
+		relations = slices.DeleteFunc(relations, func(rel string) bool { return rel == c.Relation })
+	}
+	return relations
+}
+
+// shadowChecks returns the checks to compare between both models: each of
+// the given relations for the users with a role on a project, on that
+// project and on its direct children. At most sampleSize stored
+// relationships are read.
+func (m *ModelMigrator) shadowChecks(
+	ctx context.Context, relations []string, sampleSize int,
+) ([]fgaclient.ClientBatchCheckItem, error) {
+	usersOf := map[string][]string{}
+	var children [][2]string
+	var token *string
+	for read := 0; read < sampleSize; {
+		pageSize := int32(min(sampleSize-read, 100))
+		resp, err := m.cli.Read(ctx).Body(fgaclient.ClientReadRequest{}).Options(fgaclient.ClientReadOptions{
+			PageSize:          &pageSize,
+			ContinuationToken: token,
+		}).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("error while reading relationships: %w", err)
+		}
+		for _, t := range resp.GetTuples() {
+			switch {
+			case strings.HasPrefix(t.Key.User, "user:"):
+				usersOf[t.Key.Object] = append(usersOf[t.Key.Object], t.Key.User)
+			case t.Key.Relation == "parent":
+				children = append(children, [2]string{t.Key.User, t.Key.Object})
+			}
+		}
+		read += len(resp.GetTuples())
+		if resp.GetContinuationToken() == "" || len(resp.GetTuples()) == 0 {
+			break
+		}
+		token = &resp.ContinuationToken
+	}
+
+	seen := map[[2]string]struct{}{}
+	var checks []fgaclient.ClientBatchCheckItem
+	addChecks := func(user, object string) {
+		if _, ok := seen[[2]string{user, object}]; ok {
+			return
+		}
+		seen[[2]string{user, object}] = struct{}{}
+		for _, rel := range relations {
+			checks = append(checks, fgaclient.ClientBatchCheckItem{
+				User:          user,
+				Relation:      rel,
+				Object:        object,
+				CorrelationId: strconv.Itoa(len(checks)),
+			})
+		}
+	}
+	for _, object := range slices.Sorted(maps.Keys(usersOf)) {
+		for _, user := range usersOf[object] {
+			addChecks(user, object)
+		}
+	}
+	for _, pc := range children {
+		for _, user := range usersOf[pc[0]] {
+			addChecks(user, pc[1])
+		}
+	}
+	return checks, nil
+}
+
+// batchCheck runs the checks with the given model
+func (m *ModelMigrator) batchCheck(
+	ctx context.Context, modelID string, checks []fgaclient.ClientBatchCheckItem,
+) ([]bool, error) {
+	if len(checks) == 0 {
+		return nil, nil
+	}
+
+	maxBatchSize := int32(maxBatchCheckSize)
+	resp, err := m.cli.BatchCheck(ctx).Options(fgaclient.BatchCheckOptions{
+		AuthorizationModelId: &modelID,
+		MaxBatchSize:         &maxBatchSize,
+	}).Body(fgaclient.ClientBatchCheckRequest{
+		Checks: checks,
+	}).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	results := resp.GetResult()
+	allowed := make([]bool, len(checks))
+	for i, check := range checks {
+		result, ok := results[check.CorrelationId]
+		if !ok {
+			return nil, fmt.Errorf("OpenFGA returned no result for check %d", i)
+		}
+		if result.Error != nil {
+			return nil, fmt.Errorf("OpenFGA error for check %d: %s", i, result.Error.GetMessage())
+		}
+		allowed[i] = result.GetAllowed()
+	}
+	return allowed, nil
+}
+
+// embeddedModel returns the authorization model of this version of Minder
+func embeddedModel() (*fgasdk.WriteAuthorizationModelRequest, error) {
+	var body fgasdk.WriteAuthorizationModelRequest
+	if err := json.Unmarshal([]byte(authzModel), &body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal authz model: %w", err)
+	}
+	return &body, nil
+}
+
+// diffModels returns the changes of the types and relations between the
+// deployed and the desired type definitions
+func diffModels(deployed, desired []fgasdk.TypeDefinition) []ModelChange {
+	deployedTypes := typeRelations(deployed)
+	desiredTypes := typeRelations(desired)
+
+	var changes []ModelChange
+	for _, typ := range slices.Sorted(maps.Keys(desiredTypes)) {
+		oldRels, ok := deployedTypes[typ]
+		if !ok {
+			changes = append(changes, ModelChange{Kind: ModelChangeAdded, Type: typ})
+			continue
+		}
+		newRels := desiredTypes[typ]
+		for _, rel := range slices.Sorted(maps.Keys(newRels)) {
+			if oldRel, ok := oldRels[rel]; !ok {
+				changes = append(changes, ModelChange{Kind: ModelChangeAdded, Type: typ, Relation: rel})
+			} else if oldRel != newRels[rel] {
+				changes = append(changes, ModelChange{Kind: ModelChangeChanged, Type: typ, Relation: rel})
+			}
+		}
+		for _, rel := range slices.Sorted(maps.Keys(oldRels)) {
+			if _, ok := newRels[rel]; !ok {
+				changes = append(changes, ModelChange{Kind: ModelChangeRemoved, Type: typ, Relation: rel})
+			}
+		}
+	}
+	for _, typ := range slices.Sorted(maps.Keys(deployedTypes)) {
+		if _, ok := desiredTypes[typ]; !ok {
+			changes = append(changes, ModelChange{Kind: ModelChangeRemoved, Type: typ})
+		}
+	}
+	return changes
+}
+
+// typeRelations returns the definition of each relation of each type, in a
+// comparable form which ignores the source information of the model
+func typeRelations(tds []fgasdk.TypeDefinition) map[string]map[string]string {
+	out := make(map[string]map[string]string, len(tds))
+	for _, td := range tds {
+		rels := map[string]string{}
+		if td.Relations != nil {
+			for name, userset := range *td.Relations {
+				def := struct {
+					Userset fgasdk.Userset              `json:"userset"`
+					Types   *[]fgasdk.RelationReference `json:"types,omitempty"`
+				}{Userset: userset}
+				if td.Metadata != nil && td.Metadata.Relations != nil {
+					if md, ok := (*td.Metadata.Relations)[name]; ok && md.DirectlyRelatedUserTypes != nil &&
+						len(*md.DirectlyRelatedUserTypes) > 0 {
+						def.Types = md.DirectlyRelatedUserTypes
+					}
+				}
+				rels[name] = normalizedJSON(def)
+			}
+		}
+		out[td.Type] = rels
+	}
+	return out
+}
+
+// normalizedJSON returns the JSON of the value without the empty strings,
+// which the server returns for the unset fields of a model
+func normalizedJSON(v any) string {
+	// Marshalling the generated types of the model can't fail
+	raw, _ := json.Marshal(v)
+	var generic any
+	_ = json.Unmarshal(raw, &generic)
+	out, _ := json.Marshal(dropEmptyStrings(generic))
+	return string(out)
+}
+
+func dropEmptyStrings(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if e == "" {
+				delete(v, k)
+			} else {
+				v[k] = dropEmptyStrings(e)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = dropEmptyStrings(e)
+		}
+	}
+	return v
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	fgasdk "github.com/openfga/go-sdk"
+	fgaclient "github.com/openfga/go-sdk/client"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/authz"
+	srvconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestModelMigrator(t *testing.T) {
+	t.Parallel()
+
+	apiURL, stopFunc := startOpenFGAServer(t)
+	defer stopFunc()
+
+	ctx := context.Background()
+	l := zerolog.New(zerolog.NewTestWriter(t))
+	cfg := &srvconfig.AuthzConfig{
+		ApiUrl:    apiURL,
+		StoreName: "minder",
+		Auth:      srvconfig.OpenFGAAuth{Method: "none"},
+	}
+
+	_, err := authz.NewModelMigrator(ctx, cfg, &l)
+	require.ErrorIs(t, err, authz.ErrStoreNotFound, "expected the store to be required")
+
+	c, err := authz.NewAuthzClient(cfg, &l)
+	require.NoError(t, err)
+	require.NoError(t, c.MigrateUp(ctx), "failed to migrate up")
+	require.NoError(t, c.PrepareForRun(ctx), "failed to prepare for run")
+
+	prj, child := uuid.New(), uuid.New()
+	require.NoError(t, c.Write(ctx, "user-1", authz.RoleAdmin, prj), "failed to write project")
+	require.NoError(t, c.Adopt(ctx, prj, child), "failed to adopt project")
+
+	m, err := authz.NewModelMigrator(ctx, cfg, &l)
+	require.NoError(t, err)
+
+	plan, err := m.Plan(ctx)
+	require.NoError(t, err)
+	assert.True(t, plan.UpToDate(), "expected the migrated model to be up to date")
+	res, err := m.Apply(ctx, plan, authz.ApplyOptions{SampleSize: 100})
+	require.NoError(t, err)
+	assert.Empty(t, res.ModelID, "expected no model to be written")
+
+	// deploy a model where the get permission is no longer granted by the roles
+	oldModelID := writeModifiedModel(ctx, t, apiURL, func(rels *map[string]fgasdk.Userset, md *map[string]fgasdk.RelationMetadata) {
+		(*rels)["get"] = fgasdk.Userset{This: &map[string]interface{}{}}
+		(*md)["get"] = fgasdk.RelationMetadata{
+			DirectlyRelatedUserTypes: &[]fgasdk.RelationReference{{Type: "user"}},
+		}
+	})
+
+	plan, err = m.Plan(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, oldModelID, plan.DeployedModelID)
+	assert.Equal(t, []authz.ModelChange{
+		{Kind: authz.ModelChangeChanged, Type: "project", Relation: "get"},
+	}, plan.Changes)
+
+	// the checks of the admin on both projects differ, so the switch is undone
+	res, err = m.Apply(ctx, plan, authz.ApplyOptions{SampleSize: 100})
+	require.NoError(t, err)
+	assert.True(t, res.RolledBack, "expected the deployed model to be restored")
+	assert.ElementsMatch(t, []authz.ShadowMismatch{
+		{User: "user:user-1", Relation: "get", Object: "project:" + prj.String(), Deployed: false},
+		{User: "user:user-1", Relation: "get", Object: "project:" + child.String(), Deployed: false},
+	}, res.Mismatches)
+
+	plan, err = m.Plan(ctx)
+	require.NoError(t, err)
+	assert.Len(t, plan.Changes, 1, "expected the deployed model to remain")
+
+	// pinning a model plans against it, even if it isn't the latest one
+	pinnedCfg := *cfg
+	pinnedCfg.ModelID = oldModelID
+	pinned, err := authz.NewModelMigrator(ctx, &pinnedCfg, &l)
+	require.NoError(t, err)
+	pinnedPlan, err := pinned.Plan(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, oldModelID, pinnedPlan.DeployedModelID)
+
+	// accepting the mismatches switches to the new model
+	res, err = m.Apply(ctx, plan, authz.ApplyOptions{SampleSize: 100, AcceptMismatches: true})
+	require.NoError(t, err)
+	assert.False(t, res.RolledBack, "expected the new model to remain")
+	assert.NotEmpty(t, res.ModelID)
+
+	plan, err = m.Plan(ctx)
+	require.NoError(t, err)
+	assert.True(t, plan.UpToDate(), "expected the new model to be deployed")
+	assert.Equal(t, res.ModelID, plan.DeployedModelID)
+
+	require.NoError(t, c.PrepareForRun(ctx), "failed to prepare for run")
+	userctx := auth.WithIdentityContext(ctx, &auth.Identity{UserID: "user-1"})
+	assert.NoError(t, c.Check(userctx, "get", child), "expected the new model to be used")
+}
+
+func TestModelMigratorRestoresOnError(t *testing.T) {
+	t.Parallel()
+
+	apiURL, stopFunc := startOpenFGAServer(t)
+	defer stopFunc()
+
+	ctx := context.Background()
+	l := zerolog.New(zerolog.NewTestWriter(t))
+	cfg := &srvconfig.AuthzConfig{
+		ApiUrl:    apiURL,
+		StoreName: "minder",
+		Auth:      srvconfig.OpenFGAAuth{Method: "none"},
+	}
+
+	c, err := authz.NewAuthzClient(cfg, &l)
+	require.NoError(t, err)
+	require.NoError(t, c.MigrateUp(ctx), "failed to migrate up")
+	require.NoError(t, c.PrepareForRun(ctx), "failed to prepare for run")
+	require.NoError(t, c.Write(ctx, "user-1", authz.RoleAdmin, uuid.New()), "failed to write project")
+
+	// deploy a model without the get permission
+	oldModelID := writeModifiedModel(ctx, t, apiURL, func(rels *map[string]fgasdk.Userset, md *map[string]fgasdk.RelationMetadata) {
+		delete(*rels, "get")
+		delete(*md, "get")
+	})
+
+	m, err := authz.NewModelMigrator(ctx, cfg, &l)
+	require.NoError(t, err)
+
+	// a plan which claims the permission only changed makes checking it
+	// with the deployed model fail
+	_, err = m.Apply(ctx, &authz.ModelPlan{
+		DeployedModelID: oldModelID,
+		Changes:         []authz.ModelChange{{Kind: authz.ModelChangeChanged, Type: "project", Relation: "get"}},
+	}, authz.ApplyOptions{SampleSize: 100})
+	require.ErrorContains(t, err, "error checking with the deployed model")
+
+	plan, err := m.Plan(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []authz.ModelChange{
+		{Kind: authz.ModelChangeAdded, Type: "project", Relation: "get"},
+	}, plan.Changes, "expected the deployed model to remain the latest one")
+}
+
+// writeModifiedModel writes the model of this version, with the relations
+// of the project type changed by modify, as the latest model of the store
+func writeModifiedModel(
+	ctx context.Context, t *testing.T, apiURL string,
+	modify func(rels *map[string]fgasdk.Userset, md *map[string]fgasdk.RelationMetadata),
+) string {
+	t.Helper()
+
+	cli, err := fgaclient.NewSdkClient(&fgaclient.ClientConfiguration{ApiUrl: apiURL})
+	require.NoError(t, err)
+	stores, err := cli.ListStores(ctx).Execute()
+	require.NoError(t, err)
+	require.Len(t, stores.Stores, 1)
+	require.NoError(t, cli.SetStoreId(stores.Stores[0].Id))
+
+	var model fgasdk.WriteAuthorizationModelRequest
+	require.NoError(t, json.Unmarshal([]byte(authzModel), &model))
+	for _, td := range model.TypeDefinitions {
+		if td.Type == "project" {
+			modify(td.Relations, td.Metadata.Relations)
+		}
+	}
+
+	resp, err := cli.WriteAuthorizationModel(ctx).Body(model).Execute()
+	require.NoError(t, err)
+	return resp.GetAuthorizationModelId()
+}
@@ -32,6 +32,15 @@ func (n *NoopClient) Check(ctx context.Context, action string, project uuid.UUID
 	return authz.ErrNotAuthorized
 }
 
+// BatchCheck implements authz.Client
+func (n *NoopClient) BatchCheck(ctx context.Context, checks []authz.ProjectCheck) ([]bool, error) {
+	allowed := make([]bool, len(checks))
+	for i, check := range checks {
+		allowed[i] = n.Check(ctx, check.Action, check.Project) == nil
+	}
+	return allowed, nil
+}
+
 // Write_ implements authz.Client
 func (*NoopClient) Write(_ context.Context, _ string, _ authz.Role, _ uuid.UUID) error {
 	return nil
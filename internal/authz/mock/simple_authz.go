@@ -38,6 +38,15 @@ func (n *SimpleClient) Check(_ context.Context, _ string, project uuid.UUID) err
 	return authz.ErrNotAuthorized
 }
 
+// BatchCheck implements authz.Client
+func (n *SimpleClient) BatchCheck(ctx context.Context, checks []authz.ProjectCheck) ([]bool, error) {
+	allowed := make([]bool, len(checks))
+	for i, check := range checks {
+		allowed[i] = n.Check(ctx, check.Action, check.Project) == nil
+	}
+	return allowed, nil
+}
+
 // Write implements authz.Client
 func (n *SimpleClient) Write(_ context.Context, id string, role authz.Role, project uuid.UUID) error {
 	n.Allowed = append(n.Allowed, project)
@@ -6,14 +6,13 @@ package controlplane
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"regexp"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 
+	actionmeta "github.com/mindersec/minder/internal/engine/actions/metadata"
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	"github.com/mindersec/minder/internal/util"
@@ -25,8 +24,6 @@ const (
 	githubURL       = "https://github.com"
 )
 
-var validRepoSlugRe = regexp.MustCompile(`(?i)^[-a-z0-9_\.]+\/[-a-z0-9_\.]+$`)
-
 var (
 	// ErrNoProjectInContext is returned when no project is found in the context
 	ErrNoProjectInContext = errors.New("no project found in context")
@@ -56,62 +53,46 @@ func providerError(err error) error {
 	return fmt.Errorf("provider error: %w", err)
 }
 
-// getRemediationURLFromMetadata returns the "remediation URL". For now, this is
-// the URL link to the PR
+// getRemediationURLFromMetadata returns the "remediation URL", which is the
+// link to the pull request or the issue opened by the remediation
 func getRemediationURLFromMetadata(data []byte, repoSlug string) (string, error) {
-	if !validRepoSlugRe.MatchString(repoSlug) {
+	if !actionmeta.ValidRepoSlug(repoSlug) {
 		return "", fmt.Errorf("invalid repository slug")
 	}
 
 	// If no data, it means no PR is tracked.
 	// So no error and we return an empty string.
-	if len(data) == 0 {
-		return "", nil
-	}
-
-	prData := &struct {
-		Number int `json:"pr_number"`
-	}{}
-
-	if err := json.Unmarshal(data, prData); err != nil {
-		return "", fmt.Errorf("unmarshalling pull request metadata: %w", err)
-	}
-
-	// No pull request found
-	if prData.Number == 0 {
-		return "", nil
+	link, err := actionmeta.RemediationLink(data, repoSlug)
+	if err != nil || link == nil {
+		return "", err
 	}
-
-	return fmt.Sprintf("%s/%s/pull/%d", githubURL, repoSlug, prData.Number), nil
+	return link.URL, nil
 }
 
 // getAlertURLFromMetadata is a helper function to get the alert URL from the alert metadata
 func getAlertURLFromMetadata(data []byte, repoSlug string) (string, error) {
-	if !validRepoSlugRe.MatchString(repoSlug) {
+	if !actionmeta.ValidRepoSlug(repoSlug) {
 		return "", fmt.Errorf("invalid repository slug")
 	}
 
 	// If there is no metadata, we know there is no alert
-	if len(data) == 0 {
-		return "", nil
-	}
-
-	// Define a struct to match the JSON structure
-	jsonMeta := struct {
-		GhsaId string `json:"ghsa_id"`
-	}{}
-
-	if err := json.Unmarshal(data, &jsonMeta); err != nil {
+	link, err := actionmeta.AlertLink(data, repoSlug)
+	if err != nil || link == nil {
 		return "", err
 	}
+	return link.URL, nil
+}
 
-	if jsonMeta.GhsaId == "" {
-		return "", nil
+// actionLinkToPB converts the link to the object created by an action
+func actionLinkToPB(link *actionmeta.Link) *pb.ActionLink {
+	if link == nil {
+		return nil
+	}
+	return &pb.ActionLink{
+		Kind: string(link.Kind),
+		Ref:  link.Ref,
+		Url:  link.URL,
 	}
-
-	return fmt.Sprintf(
-		"%s/%s/security/advisories/%s", githubURL, repoSlug, jsonMeta.GhsaId,
-	), nil
 }
 
 // GetProjectID retrieves the project ID from the request context.
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/mod/semver"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/mindersec/minder/internal/constants"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// deprecationHeader is the metadata key marking the responses of
+	// deprecated RPCs. The HTTP gateway forwards it as the Deprecation header.
+	deprecationHeader = "deprecation"
+	// sunsetHeader is the metadata key with the date after which a deprecated
+	// RPC may be removed. The HTTP gateway forwards it as the Sunset header.
+	sunsetHeader = "sunset"
+	// warningHeader is the metadata key with the warnings for the client, e.g.
+	// about deprecated RPCs or fields. The CLI prints them.
+	warningHeader = "minder-warning"
+
+	// sunsetLayout is the layout of the sunset date of the RPC options
+	sunsetLayout = "2006-01-02"
+)
+
+// cliUserAgentRe matches the version of the CLI in its user agent
+var cliUserAgentRe = regexp.MustCompile(`minder-cli/(\S+)`)
+
+// DeprecationInterceptor is a server interceptor that adds the deprecation
+// headers to the responses of the RPCs deprecated in their options.
+func DeprecationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+
+	deprecation := getRpcOptions(ctx).GetDeprecation()
+	if deprecation == nil {
+		return handler(ctx, req)
+	}
+
+	md := metadata.Pairs(
+		deprecationHeader, "true",
+		warningHeader, deprecationWarning(info.FullMethod, deprecation),
+	)
+	if sunset, err := time.Parse(sunsetLayout, deprecation.GetSunset()); err == nil {
+		md.Append(sunsetHeader, sunset.UTC().Format(http.TimeFormat))
+	}
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error setting deprecation headers")
+	}
+
+	return handler(ctx, req)
+}
+
+// deprecationWarning returns the warning for the client calling a deprecated RPC
+func deprecationWarning(method string, deprecation *pb.RpcDeprecation) string {
+	name := method[strings.LastIndex(method, "/")+1:]
+	warning := fmt.Sprintf("%s is deprecated", name)
+	if deprecation.GetSunset() != "" {
+		warning += fmt.Sprintf(" and may be removed after %s", deprecation.GetSunset())
+	}
+	if deprecation.GetReplacement() != "" {
+		warning += fmt.Sprintf(", use %s instead", deprecation.GetReplacement())
+	}
+	return warning
+}
+
+// CompatibilityInterceptor is a server interceptor that keeps older clients
+// working for at least one minor version after a deprecation: it upgrades
+// the deprecated fields of their requests, and warns the clients older than
+// that.
+func CompatibilityInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+
+	var warnings []string
+	if msg, ok := req.(proto.Message); ok && upgradeLegacyProvider(msg) {
+		warnings = append(warnings, "the provider field is deprecated, set the provider of the context instead")
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, userAgent := range md.Get("user-agent") {
+			if warning := clientVersionWarning(userAgent, constants.CLIVersion); warning != "" {
+				warnings = append(warnings, warning)
+			}
+		}
+	}
+
+	if len(warnings) > 0 {
+		md := metadata.MD{}
+		md.Append(warningHeader, warnings...)
+		if err := grpc.SetHeader(ctx, md); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("error setting compatibility warnings")
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// upgradeLegacyProvider moves the deprecated provider field of a request to
+// the provider of its context, unless the context sets one already. It
+// returns true if the request used the deprecated field.
+func upgradeLegacyProvider(msg proto.Message) bool {
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	legacy := fields.ByName("provider")
+	ctxField := fields.ByName("context")
+	if legacy == nil || legacy.Kind() != protoreflect.StringKind || ctxField == nil ||
+		ctxField.Message() == nil || ctxField.Message().FullName() != "minder.v1.Context" {
+		return false
+	}
+	if opts, ok := legacy.Options().(*descriptorpb.FieldOptions); !ok || !opts.GetDeprecated() {
+		return false
+	}
+
+	provider := m.Get(legacy).String()
+	if provider == "" {
+		return false
+	}
+
+	pbCtx, ok := m.Mutable(ctxField).Message().Interface().(*pb.Context)
+	if !ok {
+		return false
+	}
+	if pbCtx.GetProvider() == "" {
+		pbCtx.Provider = &provider
+	}
+	m.Clear(legacy)
+	return true
+}
+
+// clientVersionWarning returns a warning for the CLIs older than the previous
+// minor version of the server, which the compatibility shims don't cover.
+func clientVersionWarning(userAgent, serverVersion string) string {
+	match := cliUserAgentRe.FindStringSubmatch(userAgent)
+	if match == nil {
+		return ""
+	}
+	clientMajor, clientMinor, ok := minorRelease(match[1])
+	if !ok {
+		return ""
+	}
+	serverMajor, serverMinor, ok := minorRelease(serverVersion)
+	if !ok {
+		return ""
+	}
+
+	if clientMajor < serverMajor || (clientMajor == serverMajor && clientMinor < serverMinor-1) {
+		return fmt.Sprintf("minder CLI %s is older than the versions supported by the server %s, please upgrade",
+			match[1], serverVersion)
+	}
+	return ""
+}
+
+// minorRelease returns the major and minor release numbers of a version.
+// Minder is released as v0.0.N before 1.0, so N counts as the minor release
+// of such versions.
+func minorRelease(version string) (int, int, bool) {
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	if !semver.IsValid(version) {
+		return 0, 0, false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(semver.Canonical(version), "v"), ".", 3)
+	major, _ := strconv.Atoi(parts[0])
+	minor, _ := strconv.Atoi(parts[1])
+	if major == 0 && minor == 0 {
+		patch, _ := strconv.Atoi(strings.FieldsFunc(parts[2], func(r rune) bool { return r == '-' || r == '+' })[0])
+		return 0, patch, true
+	}
+	return major, minor, true
+}
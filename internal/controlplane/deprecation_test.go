@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// headerStream records the headers set by the interceptors
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestDeprecationInterceptor(t *testing.T) {
+	t.Parallel()
+
+	stream := &headerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	ctx = withRpcOptions(ctx, &pb.RpcOptions{
+		Deprecation: &pb.RpcDeprecation{
+			Sunset:      "2027-04-30",
+			Replacement: "VerifyProviderCredential",
+		},
+	})
+
+	_, err := DeprecationInterceptor(ctx, nil,
+		&grpc.UnaryServerInfo{FullMethod: "/minder.v1.OAuthService/VerifyProviderTokenFrom"},
+		func(_ context.Context, _ any) (any, error) { return nil, nil })
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"true"}, stream.header.Get(deprecationHeader))
+	require.Equal(t, []string{"Fri, 30 Apr 2027 00:00:00 GMT"}, stream.header.Get(sunsetHeader))
+	require.Equal(t, []string{
+		"VerifyProviderTokenFrom is deprecated and may be removed after 2027-04-30, use VerifyProviderCredential instead",
+	}, stream.header.Get(warningHeader))
+
+	// RPCs which aren't deprecated have no deprecation headers
+	stream = &headerStream{}
+	ctx = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	ctx = withRpcOptions(ctx, &pb.RpcOptions{})
+	_, err = DeprecationInterceptor(ctx, nil,
+		&grpc.UnaryServerInfo{FullMethod: "/minder.v1.OAuthService/VerifyProviderCredential"},
+		func(_ context.Context, _ any) (any, error) { return nil, nil })
+	require.NoError(t, err)
+	require.Empty(t, stream.header)
+}
+
+func TestUpgradeLegacyProvider(t *testing.T) {
+	t.Parallel()
+
+	provider := "github-app"
+	tests := []struct {
+		name     string
+		req      proto.Message
+		want     proto.Message
+		upgraded bool
+	}{
+		{
+			name:     "legacy provider moved to the context",
+			req:      &pb.ListArtifactsRequest{Provider: "github"},
+			want:     &pb.ListArtifactsRequest{Context: &pb.Context{Provider: proto.String("github")}},
+			upgraded: true,
+		},
+		{
+			name: "provider of the context is kept",
+			req: &pb.ListArtifactsRequest{
+				Provider: "github",
+				Context:  &pb.Context{Provider: &provider},
+			},
+			want:     &pb.ListArtifactsRequest{Context: &pb.Context{Provider: &provider}},
+			upgraded: true,
+		},
+		{
+			name: "no legacy provider",
+			req:  &pb.ListArtifactsRequest{Context: &pb.Context{Provider: &provider}},
+			want: &pb.ListArtifactsRequest{Context: &pb.Context{Provider: &provider}},
+		},
+		{
+			name: "request without a legacy provider field",
+			req:  &pb.GetVersionRequest{},
+			want: &pb.GetVersionRequest{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.upgraded, upgradeLegacyProvider(tt.req))
+			require.True(t, proto.Equal(tt.want, tt.req), "got %v, want %v", tt.req, tt.want)
+		})
+	}
+}
+
+func TestClientVersionWarning(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		userAgent     string
+		serverVersion string
+		warn          bool
+	}{
+		{
+			name:          "same version",
+			userAgent:     "minder-cli/v0.0.80 (linux) amd64 (go1.23) grpc-go/1.70.0",
+			serverVersion: "v0.0.80",
+		},
+		{
+			name:          "previous minor version",
+			userAgent:     "minder-cli/v0.0.79 (linux) amd64 (go1.23) grpc-go/1.70.0",
+			serverVersion: "v0.0.80",
+		},
+		{
+			name:          "older version",
+			userAgent:     "minder-cli/v0.0.78 (linux) amd64 (go1.23) grpc-go/1.70.0",
+			serverVersion: "v0.0.80",
+			warn:          true,
+		},
+		{
+			name:          "older major version",
+			userAgent:     "minder-cli/v1.9.0 (linux) amd64 (go1.23) grpc-go/1.70.0",
+			serverVersion: "v2.0.0",
+			warn:          true,
+		},
+		{
+			name:          "previous minor version after 1.0",
+			userAgent:     "minder-cli/v1.4.2 (linux) amd64 (go1.23) grpc-go/1.70.0",
+			serverVersion: "v1.5.0",
+		},
+		{
+			name:          "development server",
+			userAgent:     "minder-cli/v0.0.1 (linux) amd64 (go1.23) grpc-go/1.70.0",
+			serverVersion: "no-info",
+		},
+		{
+			name:          "other client",
+			userAgent:     "grpc-go/1.70.0",
+			serverVersion: "v0.0.80",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			warning := clientVersionWarning(tt.userAgent, tt.serverVersion)
+			if tt.warn {
+				require.Contains(t, warning, "please upgrade")
+			} else {
+				require.Empty(t, warning)
+			}
+		})
+	}
+}
@@ -10,9 +10,14 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/mindersec/minder/internal/constants"
+	"github.com/mindersec/minder/internal/engine/eval"
+	"github.com/mindersec/minder/pkg/api"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/flags"
 )
 
 // PaginationLimit is the maximum number of items that can be returned in a single page
@@ -34,3 +39,56 @@ func (*Server) GetVersion(_ context.Context, _ *pb.GetVersionRequest) (*pb.GetVe
 		Commit:  constants.Revision,
 	}, nil
 }
+
+// GetServerMetadata returns the version of the Minder server and the
+// definitions of the API it serves
+func (*Server) GetServerMetadata(
+	_ context.Context, _ *pb.GetServerMetadataRequest,
+) (*pb.GetServerMetadataResponse, error) {
+	descriptors, err := proto.Marshal(api.FileDescriptorSet())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal file descriptors: %v", err)
+	}
+	return &pb.GetServerMetadataResponse{
+		Version:           constants.CLIVersion,
+		Commit:            constants.Revision,
+		OpenapiSpec:       api.OpenAPISpec,
+		FileDescriptorSet: descriptors,
+	}, nil
+}
+
+// GetServerCapabilities returns the features and limits of the Minder server.
+// Feature flags are evaluated without a project, so flags only enabled for
+// some projects or users are not listed.
+func (s *Server) GetServerCapabilities(
+	ctx context.Context, _ *pb.GetServerCapabilitiesRequest,
+) (*pb.GetServerCapabilitiesResponse, error) {
+	featureFlags := make([]string, 0, len(flags.Experiments))
+	for _, flag := range flags.Experiments {
+		if flags.Bool(ctx, s.featureFlags, flag) {
+			featureFlags = append(featureFlags, string(flag))
+		}
+	}
+
+	classes := s.providerManager.ListSupportedClasses()
+	providerClasses := make([]string, 0, len(classes))
+	for _, class := range classes {
+		providerClasses = append(providerClasses, string(class))
+	}
+
+	return &pb.GetServerCapabilitiesResponse{
+		Version:           constants.CLIVersion,
+		FeatureFlags:      featureFlags,
+		ProviderClasses:   providerClasses,
+		EvaluationEngines: eval.SupportedEvalTypes(),
+		Limits: &pb.ServerLimits{
+			RuleEvaluation: &pb.RuleType_Definition_Limits{
+				Timeout:            s.cfg.RuleLimits.Timeout.String(),
+				MaxRegoMemoryBytes: s.cfg.RuleLimits.MaxRegoMemoryBytes,
+				MaxDataSourceCalls: s.cfg.RuleLimits.MaxDataSourceCalls,
+			},
+			MaxEvaluationHistoryPageSize: maxPageSize,
+			MaxShareLinkExpiration:       durationpb.New(s.cfg.ProfileStatusSharing.MaxExpiration),
+		},
+	}, nil
+}
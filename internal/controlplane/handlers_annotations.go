@@ -0,0 +1,282 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/url"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// triageStates are the valid values of triage state annotations
+var triageStates = []string{
+	"open",
+	"acknowledged",
+	"in_progress",
+	"resolved",
+	"false_positive",
+	"accepted_risk",
+}
+
+// CreateEvaluationAnnotation attaches an annotation to an evaluation result.
+// The annotation is also attached to the rule and entity pair of the
+// evaluation, so that it is returned along with the later results.
+func (s *Server) CreateEvaluationAnnotation(
+	ctx context.Context,
+	in *minderv1.CreateEvaluationAnnotationRequest,
+) (*minderv1.CreateEvaluationAnnotationResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	evalID, err := uuid.Parse(in.GetEvaluationId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid evaluation id: %q", in.GetEvaluationId())
+	}
+	kind, err := evaluationAnnotationKindFromPB(in.GetKind())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateEvaluationAnnotationValue(kind, in.GetValue()); err != nil {
+		return nil, err
+	}
+
+	annotation, err := s.store.CreateEvaluationAnnotation(ctx, db.CreateEvaluationAnnotationParams{
+		Kind:         kind,
+		Value:        in.GetValue(),
+		Source:       in.GetSource(),
+		CreatedBy:    auth.IdentityFromContext(ctx).String(),
+		EvaluationID: evalID,
+		ProjectID:    entityCtx.Project.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.NotFound, "evaluation not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating annotation: %v", err)
+	}
+
+	return &minderv1.CreateEvaluationAnnotationResponse{
+		Annotation: &minderv1.EvaluationAnnotation{
+			Id:           annotation.ID.String(),
+			EvaluationId: evalID.String(),
+			Kind:         in.GetKind(),
+			Value:        annotation.Value,
+			Source:       annotation.Source,
+			CreatedBy:    annotation.CreatedBy,
+			CreatedAt:    timestamppb.New(annotation.CreatedAt),
+		},
+	}, nil
+}
+
+// ListEvaluationAnnotations lists the annotations of the rule and entity pair
+// of an evaluation result
+func (s *Server) ListEvaluationAnnotations(
+	ctx context.Context,
+	in *minderv1.ListEvaluationAnnotationsRequest,
+) (*minderv1.ListEvaluationAnnotationsResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	evalID, err := uuid.Parse(in.GetEvaluationId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid evaluation id: %q", in.GetEvaluationId())
+	}
+
+	// the evaluation is looked up first to make sure it belongs to the project
+	if _, err := s.store.GetEvaluationHistory(ctx, db.GetEvaluationHistoryParams{
+		EvaluationID: evalID,
+		ProjectID:    entityCtx.Project.ID,
+	}); errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.NotFound, "evaluation not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting evaluation: %v", err)
+	}
+
+	annotations, err := s.listFindingAnnotations(ctx, []uuid.UUID{evalID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing annotations: %v", err)
+	}
+	return &minderv1.ListEvaluationAnnotationsResponse{
+		Annotations: annotations[evalID],
+	}, nil
+}
+
+// DeleteEvaluationAnnotation deletes an annotation of the project
+func (s *Server) DeleteEvaluationAnnotation(
+	ctx context.Context,
+	in *minderv1.DeleteEvaluationAnnotationRequest,
+) (*minderv1.DeleteEvaluationAnnotationResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	annotationID, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid annotation id: %q", in.GetId())
+	}
+
+	deleted, err := s.store.DeleteEvaluationAnnotation(ctx, db.DeleteEvaluationAnnotationParams{
+		ID:        annotationID,
+		ProjectID: entityCtx.Project.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error deleting annotation: %v", err)
+	}
+	if deleted == 0 {
+		return nil, util.UserVisibleError(codes.NotFound, "annotation not found")
+	}
+	return &minderv1.DeleteEvaluationAnnotationResponse{}, nil
+}
+
+// listFindingAnnotations lists the annotations of the rule and entity pairs
+// of the given evaluations, keyed by evaluation
+func (s *Server) listFindingAnnotations(
+	ctx context.Context,
+	evalIDs []uuid.UUID,
+) (map[uuid.UUID][]*minderv1.EvaluationAnnotation, error) {
+	rows, err := s.store.ListEvaluationAnnotationsForFindings(ctx, evalIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[uuid.UUID][]*minderv1.EvaluationAnnotation, len(evalIDs))
+	for _, row := range rows {
+		out[row.FindingEvaluationID] = append(out[row.FindingEvaluationID], evaluationAnnotationToPB(db.EvaluationAnnotation{
+			ID:           row.ID,
+			EvaluationID: row.EvaluationID,
+			Kind:         row.Kind,
+			Value:        row.Value,
+			Source:       row.Source,
+			CreatedBy:    row.CreatedBy,
+			CreatedAt:    row.CreatedAt,
+		}))
+	}
+	return out, nil
+}
+
+// attachHistoryAnnotations adds the annotations of each evaluation to the
+// evaluation history. Annotations are not essential to the history, so
+// errors are only logged.
+func attachHistoryAnnotations(
+	ctx context.Context,
+	store db.EvalHistoryStore,
+	evals []*minderv1.EvaluationHistory,
+) {
+	if len(evals) == 0 {
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(evals))
+	for _, eval := range evals {
+		id, err := uuid.Parse(eval.GetId())
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	annotations, err := store.ListEvaluationAnnotationsByEvaluationIDs(ctx, ids)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error listing evaluation annotations")
+		return
+	}
+
+	byEval := make(map[string][]*minderv1.EvaluationAnnotation, len(evals))
+	for _, a := range annotations {
+		byEval[a.EvaluationID.UUID.String()] = append(byEval[a.EvaluationID.UUID.String()], evaluationAnnotationToPB(a))
+	}
+	for _, eval := range evals {
+		eval.Annotations = byEval[eval.GetId()]
+	}
+}
+
+// latestTriageState returns the value of the latest triage state annotation
+// of annotations sorted oldest first
+func latestTriageState(annotations []*minderv1.EvaluationAnnotation) string {
+	for _, a := range slices.Backward(annotations) {
+		if a.GetKind() == minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_TRIAGE_STATE {
+			return a.GetValue()
+		}
+	}
+	return ""
+}
+
+func evaluationAnnotationToPB(a db.EvaluationAnnotation) *minderv1.EvaluationAnnotation {
+	out := &minderv1.EvaluationAnnotation{
+		Id:        a.ID.String(),
+		Kind:      evaluationAnnotationKindToPB(a.Kind),
+		Value:     a.Value,
+		Source:    a.Source,
+		CreatedBy: a.CreatedBy,
+		CreatedAt: timestamppb.New(a.CreatedAt),
+	}
+	if a.EvaluationID.Valid {
+		out.EvaluationId = a.EvaluationID.UUID.String()
+	}
+	return out
+}
+
+func evaluationAnnotationKindFromPB(kind minderv1.EvaluationAnnotationKind) (db.EvaluationAnnotationKind, error) {
+	switch kind {
+	case minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_TRIAGE_STATE:
+		return db.EvaluationAnnotationKindTriageState, nil
+	case minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_TICKET:
+		return db.EvaluationAnnotationKindTicket, nil
+	case minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_NOTE:
+		return db.EvaluationAnnotationKindNote, nil
+	default:
+		return "", util.UserVisibleError(codes.InvalidArgument, "invalid annotation kind: %s", kind)
+	}
+}
+
+func evaluationAnnotationKindToPB(kind db.EvaluationAnnotationKind) minderv1.EvaluationAnnotationKind {
+	switch kind {
+	case db.EvaluationAnnotationKindTriageState:
+		return minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_TRIAGE_STATE
+	case db.EvaluationAnnotationKindTicket:
+		return minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_TICKET
+	case db.EvaluationAnnotationKindNote:
+		return minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_NOTE
+	default:
+		return minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_UNSPECIFIED
+	}
+}
+
+// validateEvaluationAnnotationValue checks that triage states are known and
+// that tickets are absolute http(s) URLs
+func validateEvaluationAnnotationValue(kind db.EvaluationAnnotationKind, value string) error {
+	switch kind {
+	case db.EvaluationAnnotationKindTriageState:
+		if !slices.Contains(triageStates, value) {
+			return util.UserVisibleError(codes.InvalidArgument, "invalid triage state %q, must be one of %v",
+				value, triageStates)
+		}
+	case db.EvaluationAnnotationKindTicket:
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return util.UserVisibleError(codes.InvalidArgument, "ticket must be an http(s) URL: %q", value)
+		}
+	case db.EvaluationAnnotationKindNote:
+		// notes are free text
+	}
+	return nil
+}
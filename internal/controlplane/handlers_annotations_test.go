@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestCreateEvaluationAnnotation(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	evalID := uuid.New()
+	annotationID := uuid.New()
+
+	tests := []struct {
+		name              string
+		kind              minderv1.EvaluationAnnotationKind
+		value             string
+		setupMocks        func(*mockdb.MockStore)
+		expectedErrorCode codes.Code
+	}{
+		{
+			name:  "triage state",
+			kind:  minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_TRIAGE_STATE,
+			value: "false_positive",
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateEvaluationAnnotation(gomock.Any(), db.CreateEvaluationAnnotationParams{
+					Kind:         db.EvaluationAnnotationKindTriageState,
+					Value:        "false_positive",
+					Source:       "jira",
+					CreatedBy:    "alice",
+					EvaluationID: evalID,
+					ProjectID:    projectID,
+				}).Return(db.EvaluationAnnotation{
+					ID:           annotationID,
+					ProjectID:    projectID,
+					EvaluationID: uuid.NullUUID{UUID: evalID, Valid: true},
+					Kind:         db.EvaluationAnnotationKindTriageState,
+					Value:        "false_positive",
+					Source:       "jira",
+					CreatedBy:    "alice",
+					CreatedAt:    time.Now(),
+				}, nil)
+			},
+			expectedErrorCode: codes.OK,
+		},
+		{
+			name:              "unknown triage state",
+			kind:              minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_TRIAGE_STATE,
+			value:             "ignored",
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name:              "ticket is not a URL",
+			kind:              minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_TICKET,
+			value:             "PROJ-123",
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name:  "evaluation of another project",
+			kind:  minderv1.EvaluationAnnotationKind_EVALUATION_ANNOTATION_KIND_NOTE,
+			value: "looking into it",
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateEvaluationAnnotation(gomock.Any(), gomock.Any()).
+					Return(db.EvaluationAnnotation{}, sql.ErrNoRows)
+			},
+			expectedErrorCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID}, nil).AnyTimes()
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockStore)
+			}
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			ctx = auth.WithIdentityContext(ctx, &auth.Identity{UserID: "alice"})
+
+			resp, err := srv.CreateEvaluationAnnotation(ctx, &minderv1.CreateEvaluationAnnotationRequest{
+				EvaluationId: evalID.String(),
+				Kind:         tt.kind,
+				Value:        tt.value,
+				Source:       "jira",
+			})
+			if tt.expectedErrorCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedErrorCode, st.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, annotationID.String(), resp.GetAnnotation().GetId())
+			require.Equal(t, evalID.String(), resp.GetAnnotation().GetEvaluationId())
+			require.Equal(t, tt.kind, resp.GetAnnotation().GetKind())
+			require.Equal(t, "alice", resp.GetAnnotation().GetCreatedBy())
+		})
+	}
+}
+
+func TestListFindingAnnotations(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	evalID := uuid.New()
+	earlierEvalID := uuid.New()
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockStore.EXPECT().ListEvaluationAnnotationsForFindings(gomock.Any(), []uuid.UUID{evalID}).
+		Return([]db.ListEvaluationAnnotationsForFindingsRow{
+			{
+				FindingEvaluationID: evalID,
+				ID:                  uuid.New(),
+				EvaluationID:        uuid.NullUUID{UUID: earlierEvalID, Valid: true},
+				Kind:                db.EvaluationAnnotationKindTriageState,
+				Value:               "acknowledged",
+			},
+			{
+				FindingEvaluationID: evalID,
+				ID:                  uuid.New(),
+				Kind:                db.EvaluationAnnotationKindTicket,
+				Value:               "https://tickets.example.com/PROJ-123",
+			},
+			{
+				FindingEvaluationID: evalID,
+				ID:                  uuid.New(),
+				EvaluationID:        uuid.NullUUID{UUID: evalID, Valid: true},
+				Kind:                db.EvaluationAnnotationKindTriageState,
+				Value:               "resolved",
+			},
+		}, nil)
+
+	srv := Server{store: mockStore}
+	annotations, err := srv.listFindingAnnotations(context.Background(), []uuid.UUID{evalID})
+	require.NoError(t, err)
+	require.Len(t, annotations[evalID], 3)
+	require.Equal(t, earlierEvalID.String(), annotations[evalID][0].GetEvaluationId())
+	// the evaluation of the ticket was purged from the history
+	require.Empty(t, annotations[evalID][1].GetEvaluationId())
+	require.Equal(t, "resolved", latestTriageState(annotations[evalID]))
+}
+
+func TestDeleteEvaluationAnnotation(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	annotationID := uuid.New()
+
+	tests := []struct {
+		name              string
+		deleted           int64
+		expectedErrorCode codes.Code
+	}{
+		{
+			name:              "deleted",
+			deleted:           1,
+			expectedErrorCode: codes.OK,
+		},
+		{
+			name:              "not found",
+			deleted:           0,
+			expectedErrorCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID}, nil).AnyTimes()
+			mockStore.EXPECT().DeleteEvaluationAnnotation(gomock.Any(), db.DeleteEvaluationAnnotationParams{
+				ID:        annotationID,
+				ProjectID: projectID,
+			}).Return(tt.deleted, nil)
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			_, err := srv.DeleteEvaluationAnnotation(ctx, &minderv1.DeleteEvaluationAnnotationRequest{
+				Id: annotationID.String(),
+			})
+			if tt.expectedErrorCode != codes.OK {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedErrorCode, st.Code())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -124,6 +124,7 @@ func (s *Server) GetArtifactByName(ctx context.Context, in *pb.GetArtifactByName
 	logger.BusinessRecord(ctx).Artifact = ewp.Entity.ID
 	if ewp.Entity.OriginatedFrom != uuid.Nil {
 		logger.BusinessRecord(ctx).Repository = ewp.Entity.OriginatedFrom
+		pbArtifact.RepositoryId = ewp.Entity.OriginatedFrom.String()
 	}
 
 	return &pb.GetArtifactByNameResponse{
@@ -184,6 +185,7 @@ func (s *Server) GetArtifactById(ctx context.Context, in *pb.GetArtifactByIdRequ
 	logger.BusinessRecord(ctx).Artifact = ewp.Entity.ID
 	if ewp.Entity.OriginatedFrom != uuid.Nil {
 		logger.BusinessRecord(ctx).Repository = ewp.Entity.OriginatedFrom
+		pbArtifact.RepositoryId = ewp.Entity.OriginatedFrom.String()
 	}
 
 	return &pb.GetArtifactByIdResponse{
@@ -192,6 +194,137 @@ func (s *Server) GetArtifactById(ctx context.Context, in *pb.GetArtifactByIdRequ
 	}, nil
 }
 
+// ListArtifactsByRepository lists the artifacts which originated from a repository
+func (s *Server) ListArtifactsByRepository(
+	ctx context.Context, in *pb.ListArtifactsByRepositoryRequest,
+) (*pb.ListArtifactsByRepositoryResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	repo, err := s.getArtifactSourceRepository(ctx, in, projectID, entityCtx.Provider.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := s.providerStore.GetByID(ctx, repo.ProviderID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get provider: %v", err)
+	}
+
+	artifactEnts, err := s.store.GetEntitiesByType(ctx, db.GetEntitiesByTypeParams{
+		EntityType: db.EntitiesArtifact,
+		ProviderID: repo.ProviderID,
+		Projects:   []uuid.UUID{projectID},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get artifact entities: %v", err)
+	}
+
+	results := []*pb.Artifact{}
+	for _, ent := range artifactEnts {
+		if !ent.OriginatedFrom.Valid || ent.OriginatedFrom.UUID != repo.ID {
+			continue
+		}
+		results = append(results, artifactEntityToPB(ent, provider.Name, projectID))
+	}
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).ProviderID = repo.ProviderID
+	logger.BusinessRecord(ctx).Project = projectID
+	logger.BusinessRecord(ctx).Repository = repo.ID
+
+	return &pb.ListArtifactsByRepositoryResponse{
+		RepositoryId: repo.ID.String(),
+		Results:      results,
+	}, nil
+}
+
+// getArtifactSourceRepository returns the repository entity a request to list
+// artifacts by repository refers to, either by its ID or by its name.
+func (s *Server) getArtifactSourceRepository(
+	ctx context.Context, in *pb.ListArtifactsByRepositoryRequest, projectID uuid.UUID, providerName string,
+) (*db.EntityInstance, error) {
+	if in.GetRepositoryId() != "" {
+		repoID, err := uuid.Parse(in.GetRepositoryId())
+		if err != nil {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid repository ID")
+		}
+
+		repo, err := s.store.GetEntityByID(ctx, repoID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "repository not found")
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot get repository: %v", err)
+		}
+
+		// Don't leak the existence of entities from other projects
+		if repo.ProjectID != projectID || repo.EntityType != db.EntitiesRepository {
+			return nil, status.Errorf(codes.NotFound, "repository not found")
+		}
+		return &repo, nil
+	}
+
+	if in.GetRepositoryName() == "" {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "either a repository ID or name is required")
+	}
+	if len(strings.Split(in.GetRepositoryName(), "/")) != 2 {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid repository name, needs to have the format: owner/name")
+	}
+
+	var providerID uuid.UUID
+	if providerName != "" {
+		provider, err := s.providerStore.GetByName(ctx, projectID, providerName)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, util.UserVisibleError(codes.NotFound, "provider not found")
+			}
+			return nil, status.Errorf(codes.Internal, "cannot get provider: %v", err)
+		}
+		providerID = provider.ID
+	}
+
+	repos, err := s.store.GetTypedEntitiesByPropertyV1(
+		ctx,
+		db.EntitiesRepository,
+		properties.PropertyName,
+		in.GetRepositoryName(),
+		db.GetTypedEntitiesOptions{
+			ProjectID:  projectID,
+			ProviderID: providerID,
+		},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot search repository: %v", err)
+	}
+	if len(repos) == 0 {
+		return nil, status.Errorf(codes.NotFound, "repository not found")
+	}
+
+	return &repos[0], nil
+}
+
+// artifactEntityToPB converts an artifact entity to its protobuf representation
+// without fetching its properties.
+func artifactEntityToPB(ent db.EntityInstance, providerName string, projectID uuid.UUID) *pb.Artifact {
+	artifact := &pb.Artifact{
+		ArtifactPk: ent.ID.String(),
+		Context: &pb.Context{
+			Provider: &providerName,
+			Project:  ptr.Ptr(projectID.String()),
+		},
+		Owner:      "", // Will be populated by properties
+		Name:       ent.Name,
+		Type:       "", // Will be populated by properties
+		Visibility: "", // Will be populated by properties
+		Repository: "", // Will be populated by properties
+		CreatedAt:  timestamppb.New(ent.CreatedAt),
+	}
+	if ent.OriginatedFrom.Valid {
+		artifact.RepositoryId = ent.OriginatedFrom.UUID.String()
+	}
+	return artifact
+}
+
 type artifactSource string
 
 const (
@@ -267,35 +400,54 @@ func (filter *artifactListFilter) listArtifacts(
 		return nil, fmt.Errorf("failed to get artifact entities: %w", err)
 	}
 
+	repoIDs, err := filter.filteredRepositoryIDs(ctx, provider.ID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Filter by repository if needed and convert to protobuf
 	results := []*pb.Artifact{}
 	for _, ent := range artifactEnts {
 		// Apply repository filter if specified
-		if len(filter.repoSlubList) > 0 {
-			// Check if artifact originates from one of the filtered repos
-			if !ent.OriginatedFrom.Valid {
-				continue
-			}
-			// We need to check if the originated_from repository matches the filter
-			// For now, skip filtering - this requires loading the parent repo
-			// TODO: Implement efficient repo filtering
+		if repoIDs != nil && (!ent.OriginatedFrom.Valid || !repoIDs[ent.OriginatedFrom.UUID]) {
+			continue
 		}
 
-		// The entity name is the artifact name directly
-		results = append(results, &pb.Artifact{
-			ArtifactPk: ent.ID.String(),
-			Context: &pb.Context{
-				Provider: &providerName,
-				Project:  ptr.Ptr(projectID.String()),
-			},
-			Owner:      "", // Will be populated by properties
-			Name:       ent.Name,
-			Type:       "", // Will be populated by properties
-			Visibility: "", // Will be populated by properties
-			Repository: "", // Will be populated by properties
-			CreatedAt:  timestamppb.New(ent.CreatedAt),
-		})
+		results = append(results, artifactEntityToPB(ent, providerName, projectID))
 	}
 
 	return results, nil
 }
+
+// filteredRepositoryIDs returns the IDs of the repositories to filter the
+// artifacts by, or nil if the artifacts are not filtered by repository.
+// Repositories which are not registered are ignored.
+func (filter *artifactListFilter) filteredRepositoryIDs(
+	ctx context.Context, providerID uuid.UUID, projectID uuid.UUID,
+) (map[uuid.UUID]bool, error) {
+	if len(filter.repoSlubList) == 0 {
+		return nil, nil
+	}
+
+	repoIDs := make(map[uuid.UUID]bool, len(filter.repoSlubList))
+	for _, repoSlug := range filter.repoSlubList {
+		repos, err := filter.store.GetTypedEntitiesByPropertyV1(
+			ctx,
+			db.EntitiesRepository,
+			properties.PropertyName,
+			repoSlug,
+			db.GetTypedEntitiesOptions{
+				ProjectID:  projectID,
+				ProviderID: providerID,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get repository %s: %w", repoSlug, err)
+		}
+		for _, repo := range repos {
+			repoIDs[repo.ID] = true
+		}
+	}
+
+	return repoIDs, nil
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+func TestServer_ListArtifactsByRepository(t *testing.T) {
+	t.Parallel()
+
+	repo := db.EntityInstance{
+		ID:         uuid.New(),
+		EntityType: db.EntitiesRepository,
+		Name:       repoOwnerAndName,
+		ProjectID:  projectID,
+		ProviderID: provider.ID,
+	}
+	linked := db.EntityInstance{
+		ID:             uuid.New(),
+		EntityType:     db.EntitiesArtifact,
+		Name:           "api-gateway-image",
+		ProjectID:      projectID,
+		ProviderID:     provider.ID,
+		OriginatedFrom: uuid.NullUUID{UUID: repo.ID, Valid: true},
+	}
+	otherRepo := db.EntityInstance{
+		ID:             uuid.New(),
+		EntityType:     db.EntitiesArtifact,
+		Name:           "another-image",
+		ProjectID:      projectID,
+		ProviderID:     provider.ID,
+		OriginatedFrom: uuid.NullUUID{UUID: uuid.New(), Valid: true},
+	}
+	unlinked := db.EntityInstance{
+		ID:         uuid.New(),
+		EntityType: db.EntitiesArtifact,
+		Name:       "unlinked-image",
+		ProjectID:  projectID,
+		ProviderID: provider.ID,
+	}
+
+	withArtifacts := func(store *mockdb.MockStore) {
+		store.EXPECT().
+			GetEntitiesByType(gomock.Any(), db.GetEntitiesByTypeParams{
+				EntityType: db.EntitiesArtifact,
+				ProviderID: provider.ID,
+				Projects:   []uuid.UUID{projectID},
+			}).
+			Return([]db.EntityInstance{linked, otherRepo, unlinked}, nil)
+	}
+
+	scenarios := []struct {
+		Name          string
+		Request       *pb.ListArtifactsByRepositoryRequest
+		StoreSetup    func(store *mockdb.MockStore)
+		ExpectedError string
+	}{
+		{
+			Name:    "List by repository ID",
+			Request: &pb.ListArtifactsByRepositoryRequest{RepositoryId: repo.ID.String()},
+			StoreSetup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEntityByID(gomock.Any(), repo.ID).
+					Return(repo, nil)
+				withArtifacts(store)
+			},
+		},
+		{
+			Name:    "List by repository name",
+			Request: &pb.ListArtifactsByRepositoryRequest{RepositoryName: repoOwnerAndName},
+			StoreSetup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTypedEntitiesByPropertyV1(gomock.Any(), db.EntitiesRepository,
+						properties.PropertyName, repoOwnerAndName, gomock.Any()).
+					Return([]db.EntityInstance{repo}, nil)
+				withArtifacts(store)
+			},
+		},
+		{
+			Name:    "Repository of another project is not found",
+			Request: &pb.ListArtifactsByRepositoryRequest{RepositoryId: repo.ID.String()},
+			StoreSetup: func(store *mockdb.MockStore) {
+				foreign := repo
+				foreign.ProjectID = uuid.New()
+				store.EXPECT().
+					GetEntityByID(gomock.Any(), repo.ID).
+					Return(foreign, nil)
+			},
+			ExpectedError: "repository not found",
+		},
+		{
+			Name:    "Unknown repository ID is not found",
+			Request: &pb.ListArtifactsByRepositoryRequest{RepositoryId: repo.ID.String()},
+			StoreSetup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEntityByID(gomock.Any(), repo.ID).
+					Return(db.EntityInstance{}, sql.ErrNoRows)
+			},
+			ExpectedError: "repository not found",
+		},
+		{
+			Name:    "Unknown repository name is not found",
+			Request: &pb.ListArtifactsByRepositoryRequest{RepositoryName: repoOwnerAndName},
+			StoreSetup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTypedEntitiesByPropertyV1(gomock.Any(), db.EntitiesRepository,
+						properties.PropertyName, repoOwnerAndName, gomock.Any()).
+					Return([]db.EntityInstance{}, nil)
+			},
+			ExpectedError: "repository not found",
+		},
+		{
+			Name:          "Invalid repository name",
+			Request:       &pb.ListArtifactsByRepositoryRequest{RepositoryName: repoName},
+			ExpectedError: "invalid repository name",
+		},
+		{
+			Name:          "Repository is required",
+			Request:       &pb.ListArtifactsByRepositoryRequest{},
+			ExpectedError: "either a repository ID or name is required",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			server := createServer(ctrl, nil, false, nil)
+			store, ok := server.store.(*mockdb.MockStore)
+			require.True(t, ok)
+			if scenario.StoreSetup != nil {
+				scenario.StoreSetup(store)
+			}
+
+			res, err := server.ListArtifactsByRepository(ctx, scenario.Request)
+			if scenario.ExpectedError != "" {
+				require.Nil(t, res)
+				require.ErrorContains(t, err, scenario.ExpectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, repo.ID.String(), res.GetRepositoryId())
+			require.Len(t, res.GetResults(), 1)
+			require.Equal(t, linked.ID.String(), res.GetResults()[0].GetArtifactPk())
+			require.Equal(t, linked.Name, res.GetResults()[0].GetName())
+			require.Equal(t, repo.ID.String(), res.GetResults()[0].GetRepositoryId())
+			require.Equal(t, provider.Name, res.GetResults()[0].GetContext().GetProvider())
+		})
+	}
+}
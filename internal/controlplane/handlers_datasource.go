@@ -5,6 +5,7 @@ package controlplane
 
 import (
 	"context"
+	"slices"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -114,7 +115,7 @@ func (s *Server) GetDataSourceByName(ctx context.Context,
 
 // ListDataSources lists all data sources
 func (s *Server) ListDataSources(ctx context.Context,
-	_ *minderv1.ListDataSourcesRequest) (*minderv1.ListDataSourcesResponse, error) {
+	in *minderv1.ListDataSourcesRequest) (*minderv1.ListDataSourcesResponse, error) {
 
 	// Get the project ID from the request context
 	entityCtx := engcontext.EntityFromContext(ctx)
@@ -131,6 +132,13 @@ func (s *Server) ListDataSources(ctx context.Context,
 		return nil, err
 	}
 
+	// Filter by visibility if requested
+	if in.GetVisibility() != minderv1.Visibility_VISIBILITY_UNSPECIFIED {
+		ret = slices.DeleteFunc(ret, func(ds *minderv1.DataSource) bool {
+			return ds.GetVisibility() != in.GetVisibility()
+		})
+	}
+
 	// Return the response
 	return &minderv1.ListDataSourcesResponse{DataSources: ret}, nil
 }
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/deploykeys"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// CreateDeployKey generates an SSH deploy key for the project
+func (s *Server) CreateDeployKey(
+	ctx context.Context,
+	in *minderv1.CreateDeployKeyRequest,
+) (*minderv1.CreateDeployKeyResponse, error) {
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+
+	key, err := s.deployKeys().CreateDeployKey(ctx, projectID, in.GetName())
+	if errors.Is(err, deploykeys.ErrInvalidDeployKeyName) {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err)
+	} else if errors.Is(err, deploykeys.ErrDeployKeyExists) {
+		return nil, util.UserVisibleError(codes.AlreadyExists, "deploy key %s already exists", in.GetName())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating deploy key: %v", err)
+	}
+
+	return &minderv1.CreateDeployKeyResponse{
+		DeployKey: deployKeyToPB(key),
+	}, nil
+}
+
+// ListDeployKeys lists the SSH deploy keys of the project
+func (s *Server) ListDeployKeys(
+	ctx context.Context,
+	_ *minderv1.ListDeployKeysRequest,
+) (*minderv1.ListDeployKeysResponse, error) {
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+
+	keys, err := s.deployKeys().ListDeployKeys(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing deploy keys: %v", err)
+	}
+
+	resp := &minderv1.ListDeployKeysResponse{
+		DeployKeys: make([]*minderv1.DeployKey, 0, len(keys)),
+	}
+	for _, key := range keys {
+		resp.DeployKeys = append(resp.DeployKeys, deployKeyToPB(&key))
+	}
+	return resp, nil
+}
+
+// DeleteDeployKey deletes an SSH deploy key of the project
+func (s *Server) DeleteDeployKey(
+	ctx context.Context,
+	in *minderv1.DeleteDeployKeyRequest,
+) (*minderv1.DeleteDeployKeyResponse, error) {
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+
+	err := s.deployKeys().DeleteDeployKey(ctx, projectID, in.GetName())
+	if errors.Is(err, deploykeys.ErrDeployKeyNotFound) {
+		return nil, util.UserVisibleError(codes.NotFound, "deploy key %s not found", in.GetName())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error deleting deploy key: %v", err)
+	}
+
+	return &minderv1.DeleteDeployKeyResponse{}, nil
+}
+
+func (s *Server) deployKeys() deploykeys.Service {
+	return deploykeys.NewDeployKeyService(s.store, s.cryptoEngine)
+}
+
+func deployKeyToPB(key *db.ProjectDeployKey) *minderv1.DeployKey {
+	return &minderv1.DeployKey{
+		Name:        key.Name,
+		PublicKey:   key.PublicKey,
+		Fingerprint: key.Fingerprint,
+		CreatedAt:   timestamppb.New(key.CreatedAt),
+	}
+}
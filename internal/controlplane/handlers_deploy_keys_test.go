@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestCreateDeployKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		keyName  string
+		setup    func(*mockdb.MockStore)
+		wantCode codes.Code
+	}{
+		{
+			name:    "creates the key",
+			keyName: "private-repos",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateProjectDeployKey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateProjectDeployKeyParams) (db.ProjectDeployKey, error) {
+						return db.ProjectDeployKey{
+							ProjectID:           arg.ProjectID,
+							Name:                arg.Name,
+							PublicKey:           arg.PublicKey,
+							Fingerprint:         arg.Fingerprint,
+							EncryptedPrivateKey: arg.EncryptedPrivateKey,
+						}, nil
+					})
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "invalid name",
+			keyName:  "not a name",
+			setup:    func(*mockdb.MockStore) {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:    "name taken",
+			keyName: "private-repos",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateProjectDeployKey(gomock.Any(), gomock.Any()).
+					Return(db.ProjectDeployKey{}, &pq.Error{Code: "23505"}) // unique_violation
+			},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			tt.setup(mockStore)
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: uuid.New()},
+			})
+
+			resp, err := srv.CreateDeployKey(ctx, &minderv1.CreateDeployKeyRequest{Name: tt.keyName})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+			require.Equal(t, tt.keyName, resp.GetDeployKey().GetName())
+			require.Contains(t, resp.GetDeployKey().GetPublicKey(), "ssh-ed25519 ")
+			require.NotEmpty(t, resp.GetDeployKey().GetFingerprint())
+		})
+	}
+}
+
+func TestDeleteDeployKey(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	projectID := uuid.New()
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockStore.EXPECT().DeleteProjectDeployKey(gomock.Any(), db.DeleteProjectDeployKeyParams{
+		ProjectID: projectID,
+		Name:      "private-repos",
+	}).Return(int64(1), nil)
+	mockStore.EXPECT().DeleteProjectDeployKey(gomock.Any(), db.DeleteProjectDeployKeyParams{
+		ProjectID: projectID,
+		Name:      "missing",
+	}).Return(int64(0), nil)
+
+	srv := newDefaultServer(t, mockStore, nil, nil, nil)
+	ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: projectID},
+	})
+
+	_, err := srv.DeleteDeployKey(ctx, &minderv1.DeleteDeployKeyRequest{Name: "private-repos"})
+	require.NoError(t, err)
+
+	_, err = srv.DeleteDeployKey(ctx, &minderv1.DeleteDeployKeyRequest{Name: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
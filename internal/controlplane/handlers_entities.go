@@ -6,6 +6,7 @@ package controlplane
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/google/uuid"
@@ -22,6 +23,7 @@ import (
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/entities/properties"
 	"github.com/mindersec/minder/pkg/eventer/constants"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
 // ReconcileEntityRegistration reconciles the registration of an entity.
@@ -58,35 +60,14 @@ func (s *Server) ReconcileEntityRegistration(
 	}
 
 	for providerID, providerT := range provs {
-		repos, err := s.fetchRepositoriesForProvider(ctx, projectID, providerID, providerT.Name, providerT.Provider)
+		err := s.registerRepositoriesForProvider(ctx, &l, projectID, providerID, providerT.Name, providerT.Provider)
 		if err != nil {
 			l.Error().
 				Str("providerName", providerT.Name).
 				Str("projectID", projectID.String()).
 				Err(err).
-				Msg("error fetching repositories for provider")
+				Msg("error registering repositories for provider")
 			errorProvs = append(errorProvs, providerT.Name)
-			continue
-		}
-
-		for _, repo := range repos {
-			if repo.Repo.Registered {
-				continue
-			}
-
-			msg, err := createEntityMessage(ctx, &l, projectID, providerID, repo.Entity.GetEntity().GetProperties())
-			if err != nil {
-				l.Error().Err(err).
-					Int64("repoID", repo.Repo.RepoId).
-					Str("providerName", providerT.Name).
-					Msg("error creating registration entity message")
-				// This message will not be sent, but we can continue with the rest.
-				continue
-			}
-
-			if err := s.publishEntityMessage(&l, msg); err != nil {
-				l.Error().Err(err).Str("messageID", msg.UUID).Msg("error publishing register entities message")
-			}
 		}
 	}
 
@@ -98,6 +79,61 @@ func (s *Server) ReconcileEntityRegistration(
 	return &pb.ReconcileEntityRegistrationResponse{}, nil
 }
 
+// registerRepositoriesForProvider publishes a registration message for every
+// repository of a provider which is not registered yet. Repositories are
+// listed one page at a time, and the cursor of the next page is stored after
+// each page, so registering the repositories of a large organization resumes
+// where it stopped if it fails.
+func (s *Server) registerRepositoriesForProvider(
+	ctx context.Context,
+	l *zerolog.Logger,
+	projectID uuid.UUID,
+	providerID uuid.UUID,
+	providerName string,
+	provider v1.Provider,
+) error {
+	repoLister, err := v1.As[v1.RepoLister](provider)
+	if err != nil {
+		return fmt.Errorf("error instantiating repo lister: %w", err)
+	}
+
+	registered, err := s.registeredUpstreamIDs(ctx, projectID, providerID)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := s.repositoryListingCursor(ctx, projectID, providerID)
+	if err != nil {
+		return err
+	}
+
+	return s.forEachRemoteRepositoryPage(ctx, repoLister, projectID, cursor,
+		func(repos []*pb.Repository, next string) error {
+			for _, repo := range repos {
+				uid, ok := upstreamIDOf(ctx, repo.GetProperties(), projectID, providerID)
+				if !ok || registered[uid] {
+					continue
+				}
+
+				msg, err := createEntityMessage(ctx, l, projectID, providerID, repo.GetProperties())
+				if err != nil {
+					l.Error().Err(err).
+						Int64("repoID", repo.RepoId).
+						Str("providerName", providerName).
+						Msg("error creating registration entity message")
+					// This message will not be sent, but we can continue with the rest.
+					continue
+				}
+
+				if err := s.publishEntityMessage(l, msg); err != nil {
+					l.Error().Err(err).Str("messageID", msg.UUID).Msg("error publishing register entities message")
+				}
+			}
+
+			return s.storeRepositoryListingCursor(ctx, projectID, providerID, next)
+		})
+}
+
 func (s *Server) publishEntityMessage(l *zerolog.Logger, msg *message.Message) error {
 	l.Info().Str("messageID", msg.UUID).Msg("publishing register entities message for execution")
 	return s.evt.Publish(constants.TopicQueueReconcileEntityAdd, msg)
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	mockdb "github.com/mindersec/minder/database/mock"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	mockgh "github.com/mindersec/minder/internal/providers/github/mock"
@@ -29,6 +30,7 @@ func TestServer_ReconcileEntityRegistration(t *testing.T) {
 		RepoServiceSetup repoMockBuilder
 		GitHubSetup      githubMockBuilder
 		ProviderSetup    func(ctrl *gomock.Controller) *mockmanager.MockProviderManager
+		StoreSetup       func(store *mockdb.MockStore)
 		EventerSetup     func(ctrl *gomock.Controller) *mockevents.MockInterface
 		EntityType       pb.Entity
 		ProviderFails    bool
@@ -38,7 +40,11 @@ func TestServer_ReconcileEntityRegistration(t *testing.T) {
 		{
 			Name:        "[positive] successful reconciliation",
 			EntityType:  pb.Entity_ENTITY_REPOSITORIES,
-			GitHubSetup: newGitHub(withSuccessfulListAllRepositories),
+			GitHubSetup: newGitHub(withSuccessfulListRepositories),
+			StoreSetup: func(store *mockdb.MockStore) {
+				withNoRepositoryListingCursor(store)
+				withCompletedRepositoryListing(store)
+			},
 			RepoServiceSetup: rf.NewRepoService(
 				rf.WithSuccessfulListRepositories(
 					simpleDbRepository(repoName, remoteRepoId),
@@ -62,10 +68,52 @@ func TestServer_ReconcileEntityRegistration(t *testing.T) {
 				return events
 			},
 		},
+		{
+			Name:       "[positive] registration resumes from the stored cursor",
+			EntityType: pb.Entity_ENTITY_REPOSITORIES,
+			GitHubSetup: newGitHub(func(mock githubMock) {
+				mock.EXPECT().
+					ListRepositoriesPage(gomock.Any(), "2").
+					Return([]*pb.Repository{&existingRepo2}, "3", nil)
+				mock.EXPECT().
+					ListRepositoriesPage(gomock.Any(), "3").
+					Return([]*pb.Repository{}, "", nil)
+			}),
+			StoreSetup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRepositoryListingCursor(gomock.Any(), db.GetRepositoryListingCursorParams{
+						ProviderID: provider.ID,
+						ProjectID:  projectID,
+					}).
+					Return("2", nil)
+				store.EXPECT().
+					UpsertRepositoryListingCursor(gomock.Any(), db.UpsertRepositoryListingCursorParams{
+						ProviderID: provider.ID,
+						ProjectID:  projectID,
+						NextCursor: "3",
+					}).
+					Return(nil)
+				withCompletedRepositoryListing(store)
+			},
+			RepoServiceSetup: rf.NewRepoService(
+				rf.WithSuccessfulListRepositories(
+					simpleDbRepository(repoName, remoteRepoId),
+				),
+			),
+			ProviderSetup: func(ctrl *gomock.Controller) *mockmanager.MockProviderManager {
+				return mockmanager.NewMockProviderManager(ctrl)
+			},
+			EventerSetup: func(ctrl *gomock.Controller) *mockevents.MockInterface {
+				events := mockevents.NewMockInterface(ctrl)
+				events.EXPECT().Publish(gomock.Any(), gomock.Any()).Times(1)
+				return events
+			},
+		},
 		{
 			Name:        "[negative] failed to list repositories",
 			EntityType:  pb.Entity_ENTITY_REPOSITORIES,
-			GitHubSetup: newGitHub(withFailedListAllRepositories(errDefault)),
+			GitHubSetup: newGitHub(withFailedListRepositories(errDefault)),
+			StoreSetup:  withNoRepositoryListingCursor,
 			RepoServiceSetup: rf.NewRepoService(
 				rf.WithSuccessfulListRepositories(
 					simpleDbRepository(repoName, remoteRepoId),
@@ -110,7 +158,7 @@ func TestServer_ReconcileEntityRegistration(t *testing.T) {
 					gomock.Eq(db.ProviderTypeRepoLister),
 					gomock.Eq(""),
 				).Return(map[uuid.UUID]manager.NameProviderTuple{
-					uuid.New(): {
+					provider.ID: {
 						Name:     provider.Name,
 						Provider: prov,
 					},
@@ -127,6 +175,11 @@ func TestServer_ReconcileEntityRegistration(t *testing.T) {
 			if scenario.EventerSetup != nil {
 				server.evt = scenario.EventerSetup(ctrl)
 			}
+			if scenario.StoreSetup != nil {
+				store, ok := server.store.(*mockdb.MockStore)
+				require.True(t, ok)
+				scenario.StoreSetup(store)
+			}
 
 			projectIDStr := projectID.String()
 			req := &pb.ReconcileEntityRegistrationRequest{
@@ -8,13 +8,18 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	"github.com/mindersec/minder/internal/entities/models"
+	"github.com/mindersec/minder/internal/history"
 	"github.com/mindersec/minder/internal/logger"
 	"github.com/mindersec/minder/internal/util"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -244,6 +249,156 @@ func (s *Server) RegisterEntity(
 	}, nil
 }
 
+// SetEntityOwner sets the ownership properties of an entity, which alerts
+// raised on the entity are routed to
+func (s *Server) SetEntityOwner(
+	ctx context.Context,
+	in *pb.SetEntityOwnerRequest,
+) (*pb.SetEntityOwnerResponse, error) {
+	entityID, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid entity ID")
+	}
+
+	projectID := GetProjectID(ctx)
+
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error starting transaction: %v", err)
+	}
+	defer s.store.Rollback(tx)
+
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	ent, err := qtx.GetEntityByID(ctx, entityID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && ent.ProjectID != projectID) {
+		return nil, util.UserVisibleError(codes.NotFound, "entity not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting entity: %v", err)
+	}
+
+	owner := map[string]string{
+		properties.PropertyOwnerTeam:              in.GetTeam(),
+		properties.PropertyOwnerContact:           in.GetContact(),
+		properties.PropertyOwnerEscalationChannel: in.GetEscalationChannel(),
+	}
+	for key, value := range owner {
+		if value == "" {
+			err = qtx.DeleteProperty(ctx, db.DeletePropertyParams{EntityID: ent.ID, Key: key})
+		} else {
+			_, err = qtx.UpsertPropertyValueV1(ctx, db.UpsertPropertyValueV1Params{
+				EntityID: ent.ID,
+				Key:      key,
+				Value:    value,
+			})
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error setting property %s: %v", key, err)
+		}
+	}
+
+	if err := s.store.Commit(tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "error committing transaction: %v", err)
+	}
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).Project = projectID
+	logger.BusinessRecord(ctx).Entity = entityID
+
+	return &pb.SetEntityOwnerResponse{Id: ent.ID.String()}, nil
+}
+
+// ListEntityTimeline returns the events of an entity, newest first
+func (s *Server) ListEntityTimeline(
+	ctx context.Context,
+	in *pb.ListEntityTimelineRequest,
+) (*pb.ListEntityTimelineResponse, error) {
+	// Parse entity ID
+	entityID, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid entity ID")
+	}
+
+	projectID := GetProjectID(ctx)
+
+	// process cursor
+	cursor := &history.DefaultCursor
+	size := defaultPageSize
+	if in.GetCursor() != nil {
+		parsedCursor, err := history.ParseListEvaluationCursor(in.GetCursor().GetCursor())
+		if err != nil {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid cursor: %s", err)
+		}
+		cursor = parsedCursor
+		if in.GetCursor().GetSize() != 0 {
+			size = in.GetCursor().GetSize()
+		}
+	}
+	if size > maxPageSize {
+		return nil, util.UserVisibleError(codes.InvalidArgument,
+			"requested page size was %d, max is %d", size, maxPageSize)
+	}
+
+	ent, err := s.store.GetEntityByID(ctx, entityID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && ent.ProjectID != projectID) {
+		return nil, util.UserVisibleError(codes.NotFound, "entity not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting entity: %v", err)
+	}
+
+	params := db.ListEntityTimelineParams{
+		EntityID: ent.ID,
+		Size:     int64(size),
+	}
+	if cursor.Direction == history.Prev {
+		params.Prev = sql.NullTime{Time: cursor.Time, Valid: true}
+	} else {
+		params.Next = sql.NullTime{Time: cursor.Time, Valid: true}
+	}
+
+	rows, err := s.store.ListEntityTimeline(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing entity timeline: %v", err)
+	}
+	if cursor.Direction == history.Prev {
+		slices.Reverse(rows)
+	}
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).Project = projectID
+	logger.BusinessRecord(ctx).Entity = entityID
+
+	resp := &pb.ListEntityTimelineResponse{
+		Events: make([]*pb.EntityTimelineEvent, 0, len(rows)),
+	}
+	for _, row := range rows {
+		event := &pb.EntityTimelineEvent{
+			Kind:       row.Kind,
+			OccurredAt: timestamppb.New(row.OccurredAt),
+			Status:     row.Status,
+			Details:    row.Details,
+			RuleType:   row.RuleType,
+			RuleName:   row.RuleName,
+			Profile:    row.ProfileName,
+		}
+		if row.EvaluationID != uuid.Nil {
+			event.EvaluationId = row.EvaluationID.String()
+		}
+		resp.Events = append(resp.Events, event)
+	}
+
+	if len(rows) > 0 {
+		newest := rows[0]
+		oldest := rows[len(rows)-1]
+		resp.Page = &pb.CursorPage{
+			Next: makeCursor([]byte(fmt.Sprintf("+%d", oldest.OccurredAt.UnixMicro())), size),
+			Prev: makeCursor([]byte(fmt.Sprintf("-%d", newest.OccurredAt.UnixMicro())), size),
+		}
+	}
+
+	return resp, nil
+}
+
 // parseIdentifyingProperties converts proto properties to Properties object
 func parseIdentifyingProperties(req *pb.RegisterEntityRequest) (*properties.Properties, error) {
 	identifyingProps := req.GetIdentifyingProperties()
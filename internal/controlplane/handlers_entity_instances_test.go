@@ -9,6 +9,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -18,11 +19,13 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	mockdb "github.com/mindersec/minder/database/mock"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	"github.com/mindersec/minder/internal/entities/models"
 	mockentitysvc "github.com/mindersec/minder/internal/entities/service/mock"
 	"github.com/mindersec/minder/internal/entities/service/validators"
+	"github.com/mindersec/minder/internal/history"
 	mockproviders "github.com/mindersec/minder/internal/providers/mock"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/entities/properties"
@@ -410,3 +413,201 @@ func TestParseIdentifyingProperties(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_ListEntityTimeline(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	entityID := uuid.New()
+	evalID := uuid.New()
+	registeredAt := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	evaluatedAt := registeredAt.Add(time.Hour)
+
+	tests := []struct {
+		name         string
+		request      *pb.ListEntityTimelineRequest
+		setupMocks   func(*mockdb.MockStore)
+		wantCode     codes.Code
+		validateResp func(*testing.T, *pb.ListEntityTimelineResponse)
+	}{
+		{
+			name:    "lists events newest first",
+			request: &pb.ListEntityTimelineRequest{Id: entityID.String()},
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEntityByID(gomock.Any(), entityID).
+					Return(db.EntityInstance{ID: entityID, ProjectID: projectID}, nil)
+				store.EXPECT().ListEntityTimeline(gomock.Any(), db.ListEntityTimelineParams{
+					EntityID: entityID,
+					Next:     sql.NullTime{Time: history.DefaultCursor.Time, Valid: true},
+					Size:     int64(defaultPageSize),
+				}).Return([]db.ListEntityTimelineRow{
+					{
+						Kind:         "evaluation",
+						OccurredAt:   evaluatedAt,
+						Status:       "failure",
+						EvaluationID: evalID,
+						RuleType:     "secret_scanning",
+						RuleName:     "secret_scanning",
+						ProfileName:  "security",
+					},
+					{Kind: "registration", OccurredAt: registeredAt},
+				}, nil)
+			},
+			validateResp: func(t *testing.T, resp *pb.ListEntityTimelineResponse) {
+				t.Helper()
+				require.Len(t, resp.GetEvents(), 2)
+				assert.Equal(t, "evaluation", resp.GetEvents()[0].GetKind())
+				assert.Equal(t, evalID.String(), resp.GetEvents()[0].GetEvaluationId())
+				assert.Equal(t, "security", resp.GetEvents()[0].GetProfile())
+				assert.Equal(t, "registration", resp.GetEvents()[1].GetKind())
+				assert.Empty(t, resp.GetEvents()[1].GetEvaluationId())
+
+				next, err := history.ParseListEvaluationCursor(resp.GetPage().GetNext().GetCursor())
+				require.NoError(t, err)
+				assert.Equal(t, history.Next, next.Direction)
+				assert.True(t, registeredAt.Equal(next.Time))
+				prev, err := history.ParseListEvaluationCursor(resp.GetPage().GetPrev().GetCursor())
+				require.NoError(t, err)
+				assert.Equal(t, history.Prev, prev.Direction)
+				assert.True(t, evaluatedAt.Equal(prev.Time))
+			},
+		},
+		{
+			name:     "invalid entity id",
+			request:  &pb.ListEntityTimelineRequest{Id: "not-a-uuid"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:    "entity of another project",
+			request: &pb.ListEntityTimelineRequest{Id: entityID.String()},
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEntityByID(gomock.Any(), entityID).
+					Return(db.EntityInstance{ID: entityID, ProjectID: uuid.New()}, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "page size too large",
+			request: &pb.ListEntityTimelineRequest{
+				Id:     entityID.String(),
+				Cursor: &pb.Cursor{Size: maxPageSize + 1},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(store)
+			}
+
+			server := &Server{store: store}
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			resp, err := server.ListEntityTimeline(ctx, tt.request)
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a gRPC status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+			require.NoError(t, err)
+			tt.validateResp(t, resp)
+		})
+	}
+}
+
+func TestServer_SetEntityOwner(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	entityID := uuid.New()
+
+	tests := []struct {
+		name       string
+		request    *pb.SetEntityOwnerRequest
+		setupMocks func(*mockdb.MockStore)
+		wantCode   codes.Code
+	}{
+		{
+			name: "sets the owner and clears the empty fields",
+			request: &pb.SetEntityOwnerRequest{
+				Id:      entityID.String(),
+				Team:    "platform",
+				Contact: "platform@example.com",
+			},
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEntityByID(gomock.Any(), entityID).
+					Return(db.EntityInstance{ID: entityID, ProjectID: projectID}, nil)
+				store.EXPECT().UpsertPropertyValueV1(gomock.Any(), db.UpsertPropertyValueV1Params{
+					EntityID: entityID,
+					Key:      properties.PropertyOwnerTeam,
+					Value:    "platform",
+				}).Return(db.Property{}, nil)
+				store.EXPECT().UpsertPropertyValueV1(gomock.Any(), db.UpsertPropertyValueV1Params{
+					EntityID: entityID,
+					Key:      properties.PropertyOwnerContact,
+					Value:    "platform@example.com",
+				}).Return(db.Property{}, nil)
+				store.EXPECT().DeleteProperty(gomock.Any(), db.DeletePropertyParams{
+					EntityID: entityID,
+					Key:      properties.PropertyOwnerEscalationChannel,
+				}).Return(nil)
+				store.EXPECT().Commit(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "invalid entity id",
+			request:  &pb.SetEntityOwnerRequest{Id: "not-a-uuid"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:    "entity of another project",
+			request: &pb.SetEntityOwnerRequest{Id: entityID.String(), Team: "platform"},
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEntityByID(gomock.Any(), entityID).
+					Return(db.EntityInstance{ID: entityID, ProjectID: uuid.New()}, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().BeginTransaction().AnyTimes()
+			store.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(store).AnyTimes()
+			store.EXPECT().Rollback(gomock.Any()).AnyTimes()
+			if tt.setupMocks != nil {
+				tt.setupMocks(store)
+			}
+
+			server := &Server{store: store}
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			resp, err := server.SetEntityOwner(ctx, tt.request)
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a gRPC status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entityID.String(), resp.GetId())
+		})
+	}
+}
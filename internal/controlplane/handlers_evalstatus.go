@@ -11,16 +11,20 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
+	"github.com/sqlc-dev/pqtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/mindersec/minder/database"
 	"github.com/mindersec/minder/internal/db"
+	actionmeta "github.com/mindersec/minder/internal/engine/actions/metadata"
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	entmodels "github.com/mindersec/minder/internal/entities/models"
 	propSvc "github.com/mindersec/minder/internal/entities/properties/service"
@@ -87,12 +91,16 @@ func (s *Server) GetEvaluationHistory(
 			Profile:  eval.ProfileName,
 		},
 		Status: &minderv1.EvaluationHistoryStatus{
-			Status:  string(eval.EvaluationStatus),
-			Details: eval.EvaluationDetails,
+			Status:       string(eval.EvaluationStatus),
+			Details:      eval.EvaluationDetails,
+			ErrorCode:    string(eval.EvaluationErrorCode.EvalErrorCodes),
+			RetryAttempt: eval.EvaluationRetryAttempt,
 		},
-		Alert:       getAlert(eval.AlertStatus, eval.AlertDetails.String),
-		Remediation: getRemediation(eval.RemediationStatus, eval.RemediationDetails.String),
 	}
+	repoSlug := entityRepoSlug(pbEval.Entity.Type, pbEval.Entity.Name)
+	pbEval.Alert = getAlert(ctx, eval.AlertStatus, eval.AlertDetails.String, eval.AlertMetadata, repoSlug)
+	pbEval.Remediation = getRemediation(ctx, eval.RemediationStatus, eval.RemediationDetails.String,
+		eval.RemediationMetadata, repoSlug)
 
 	if in.GetIncludeOutputs() {
 		output, err := s.store.GetEvaluationOutput(ctx, eval.EvaluationID)
@@ -107,6 +115,8 @@ func (s *Server) GetEvaluationHistory(
 		}
 	}
 
+	attachHistoryAnnotations(ctx, s.store, []*minderv1.EvaluationHistory{pbEval})
+
 	return &minderv1.GetEvaluationHistoryResponse{Evaluation: pbEval}, nil
 }
 
@@ -149,6 +159,7 @@ func (s *Server) ListEvaluationHistory(
 	opts = append(opts, FilterOptsFromStrings(in.GetProfileName(), history.WithProfileName)...)
 	opts = append(opts, FilterOptsFromStrings(in.GetLabelFilter(), history.WithLabel)...)
 	opts = append(opts, FilterOptsFromStrings(in.GetStatus(), history.WithStatus)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetErrorCode(), history.WithErrorCode)...)
 	opts = append(opts, FilterOptsFromStrings(in.GetRemediation(), history.WithRemediation)...)
 	opts = append(opts, FilterOptsFromStrings(in.GetAlert(), history.WithAlert)...)
 
@@ -158,6 +169,15 @@ func (s *Server) ListEvaluationHistory(
 	if in.GetTo() != nil {
 		opts = append(opts, history.WithTo(in.GetTo().AsTime()))
 	}
+	if in.GetSearch() != "" {
+		if s.phaseGate != nil && !s.phaseGate.Completed(ctx, database.SearchIndexesPhase) {
+			return nil, util.UserVisibleError(
+				codes.FailedPrecondition,
+				"searching the evaluation details is not available until the search indexes are built",
+			)
+		}
+		opts = append(opts, history.WithSearch(in.GetSearch()))
+	}
 
 	// we always filter by project id
 	opts = append(opts, history.WithProjectID(GetProjectID(ctx)))
@@ -178,10 +198,11 @@ func (s *Server) ListEvaluationHistory(
 		return nil, status.Error(codes.Internal, evalErrMsg)
 	}
 	defer s.store.Rollback(tx)
+	qtx := s.store.GetQuerierWithTransaction(tx)
 
 	result, err := s.history.ListEvaluationHistory(
 		ctx,
-		s.store.GetQuerierWithTransaction(tx),
+		qtx,
 		cursor,
 		size,
 		filter,
@@ -197,6 +218,7 @@ func (s *Server) ListEvaluationHistory(
 	if err != nil {
 		return nil, err
 	}
+	attachHistoryAnnotations(ctx, qtx, data)
 
 	if err := s.store.Commit(tx); err != nil {
 		// non: fatal - this handler just refreshes properties but doesn't write any new data
@@ -222,6 +244,38 @@ func (s *Server) ListEvaluationHistory(
 	return resp, nil
 }
 
+// PurgeStaleEvaluations purges the rule and entity pairs of the project
+// which were not evaluated for a while, along with their history
+func (s *Server) PurgeStaleEvaluations(
+	ctx context.Context,
+	in *minderv1.PurgeStaleEvaluationsRequest,
+) (*minderv1.PurgeStaleEvaluationsResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	olderThan := s.cfg.EvaluationCleanup.MaxAge
+	if in.GetOlderThan() != nil {
+		olderThan = in.GetOlderThan().AsDuration()
+	}
+	if olderThan <= 0 {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "older_than must be positive")
+	}
+
+	cleaner := history.NewCleaner(s.store, &s.cfg.EvaluationCleanup)
+	report, err := cleaner.Cleanup(ctx, entityCtx.Project.ID, time.Now().Add(-olderThan), in.GetDryRun())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error purging stale evaluations: %v", err)
+	}
+
+	return &minderv1.PurgeStaleEvaluationsResponse{
+		RuleEntities: report.RuleEntities,
+		Evaluations:  report.Evaluations,
+		DryRun:       report.DryRun,
+	}, nil
+}
+
 func fromEvaluationHistoryRows(
 	ctx context.Context,
 	rows []*history.OneEvalHistoryAndEntity,
@@ -238,8 +292,10 @@ func fromEvaluationHistoryRows(
 		}
 
 		evalStatus := &minderv1.EvaluationHistoryStatus{
-			Status:  string(row.EvalHistoryRow.EvaluationStatus),
-			Details: row.EvalHistoryRow.EvaluationDetails,
+			Status:       string(row.EvalHistoryRow.EvaluationStatus),
+			Details:      row.EvalHistoryRow.EvaluationDetails,
+			ErrorCode:    string(row.EvalHistoryRow.EvaluationErrorCode.EvalErrorCodes),
+			RetryAttempt: row.EvalHistoryRow.EvaluationRetryAttempt,
 		}
 
 		if row.EvalHistoryRow.EvalOutput.Valid {
@@ -263,18 +319,24 @@ func fromEvaluationHistoryRows(
 				Severity: ruleSeverity,
 				Profile:  row.EvalHistoryRow.ProfileName,
 			},
-			Status:      evalStatus,
-			Alert:       getAlert(row.EvalHistoryRow.AlertStatus, row.EvalHistoryRow.AlertDetails.String),
-			Remediation: getRemediation(row.EvalHistoryRow.RemediationStatus, row.EvalHistoryRow.RemediationDetails.String),
+			Status: evalStatus,
 		}
+		repoSlug := entityRepoSlug(entityType, entityName)
+		res[i].Alert = getAlert(ctx, row.EvalHistoryRow.AlertStatus, row.EvalHistoryRow.AlertDetails.String,
+			row.EvalHistoryRow.AlertMetadata, repoSlug)
+		res[i].Remediation = getRemediation(ctx, row.EvalHistoryRow.RemediationStatus,
+			row.EvalHistoryRow.RemediationDetails.String, row.EvalHistoryRow.RemediationMetadata, repoSlug)
 	}
 
 	return res, nil
 }
 
 func getRemediation(
+	ctx context.Context,
 	remediationStatus db.NullRemediationStatusTypes,
 	remediationDetails string,
+	remediationMetadata pqtype.NullRawMessage,
+	repoSlug string,
 ) *minderv1.EvaluationHistoryRemediation {
 	var remediation *minderv1.EvaluationHistoryRemediation
 	if remediationStatus.Valid {
@@ -282,13 +344,22 @@ func getRemediation(
 			Status:  string(remediationStatus.RemediationStatusTypes),
 			Details: remediationDetails,
 		}
+		link, err := actionmeta.RemediationLink(remediationMetadata.RawMessage, repoSlug)
+		if err != nil {
+			// A failure parsing the metadata points to a corrupt record. Log but don't err.
+			zerolog.Ctx(ctx).Error().Err(err).Msg("error parsing remediation metadata")
+		}
+		remediation.Link = actionLinkToPB(link)
 	}
 	return remediation
 }
 
 func getAlert(
+	ctx context.Context,
 	alertStatus db.NullAlertStatusTypes,
 	alertDetails string,
+	alertMetadata pqtype.NullRawMessage,
+	repoSlug string,
 ) *minderv1.EvaluationHistoryAlert {
 	var alert *minderv1.EvaluationHistoryAlert
 	if alertStatus.Valid {
@@ -296,10 +367,33 @@ func getAlert(
 			Status:  string(alertStatus.AlertStatusTypes),
 			Details: alertDetails,
 		}
+		link, err := actionmeta.AlertLink(alertMetadata.RawMessage, repoSlug)
+		if err != nil {
+			// A failure parsing the metadata points to a corrupt record. Log but don't err.
+			zerolog.Ctx(ctx).Error().Err(err).Msg("error parsing alert metadata")
+		}
+		alert.Link = actionLinkToPB(link)
 	}
 	return alert
 }
 
+// entityRepoSlug returns the owner/name slug of the repository in which the
+// actions on an entity create objects, or an empty string if it isn't known
+// from the entity name
+func entityRepoSlug(entityType minderv1.Entity, entityName string) string {
+	switch entityType {
+	case minderv1.Entity_ENTITY_REPOSITORIES:
+		return entityName
+	case minderv1.Entity_ENTITY_PULL_REQUESTS:
+		// pull requests are named owner/name/number
+		if i := strings.LastIndex(entityName, "/"); i > 0 {
+			return entityName[:i]
+		}
+	default:
+	}
+	return ""
+}
+
 func makeCursor(cursor []byte, size uint32) *minderv1.Cursor {
 	return &minderv1.Cursor{
 		Cursor: base64.StdEncoding.EncodeToString(cursor),
@@ -405,6 +499,16 @@ func (s *Server) sortEntitiesEvaluationStatus(
 					"error reading evaluations from profile %q: %v", profileID.String(), err)
 		}
 
+		evalIDs := make([]uuid.UUID, 0, len(evals))
+		for _, e := range evals {
+			evalIDs = append(evalIDs, e.RuleEvaluationID)
+		}
+		annotations, err := s.listFindingAnnotations(ctx, evalIDs)
+		if err != nil {
+			// Annotations are not essential to the results. Log but don't err.
+			zerolog.Ctx(ctx).Error().Err(err).Msg("error listing evaluation annotations")
+		}
+
 		for _, e := range evals {
 			// Filter by rule type name
 			if _, ok := rtIndex[e.RuleTypeName]; !ok && len(rtIndex) > 0 {
@@ -442,6 +546,8 @@ func (s *Server) sortEntitiesEvaluationStatus(
 				if _, ok := statusByEntity[entString]; !ok {
 					statusByEntity[entString] = make(map[uuid.UUID][]*minderv1.RuleEvaluationStatus)
 				}
+				stat.Annotations = annotations[e.RuleEvaluationID]
+				stat.TriageState = latestTriageState(stat.Annotations)
 				statusByEntity[entString][profileID] = append(statusByEntity[entString][profileID], stat)
 			}
 		}
@@ -658,11 +764,17 @@ func (s *Server) buildRuleEvaluationStatusFromDBEvaluation(
 		RemediationUrl:         remediationURL,
 		RuleDisplayName:        nString,
 		RuleTypeName:           eval.RuleTypeName,
-		Alert:                  buildEvalResultAlertFromLRERow(&eval, efp),
+		Alert:                  buildEvalResultAlertFromLRERow(ctx, &eval, efp),
 		Severity:               sev,
 		ReleasePhase:           rp,
 	}
 
+	remediationLink, err := actionmeta.RemediationLink(eval.RemMetadata, entityRepoSlug(efp.Entity.Type, efp.Entity.Name))
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error parsing remediation metadata")
+	}
+	res.RemediationLink = actionLinkToPB(remediationLink)
+
 	if eval.EvalOutput.Valid {
 		res.Output = &structpb.Value{}
 		if err := protojson.Unmarshal(eval.EvalOutput.RawMessage, res.Output); err != nil {
@@ -689,7 +801,7 @@ func buildEntityFromEvaluation(efp *entmodels.EntityWithProperties) *minderv1.En
 // buildEvalResultAlertFromLRERow build the evaluation result alert from a
 // database row.
 func buildEvalResultAlertFromLRERow(
-	eval *db.ListRuleEvaluationsByProfileIdRow, ent *entmodels.EntityWithProperties,
+	ctx context.Context, eval *db.ListRuleEvaluationsByProfileIdRow, ent *entmodels.EntityWithProperties,
 ) *minderv1.EvalResultAlert {
 	era := &minderv1.EvalResultAlert{
 		Status:      string(eval.AlertStatus),
@@ -704,6 +816,12 @@ func buildEvalResultAlertFromLRERow(
 		if err == nil {
 			era.Url = urlString
 		}
+
+		link, err := actionmeta.AlertLink(eval.AlertMetadata, entityRepoSlug(ent.Entity.Type, ent.Entity.Name))
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("error parsing alert metadata")
+		}
+		era.Link = actionLinkToPB(link)
 	}
 
 	return era
@@ -747,7 +865,7 @@ func getRuleEvalEntityInfo(
 	case db.EntitiesArtifact:
 		entityInfo["artifact_id"] = efp.Entity.ID.String()
 	case db.EntitiesBuildEnvironment, db.EntitiesPullRequest, db.EntitiesRelease,
-		db.EntitiesPipelineRun, db.EntitiesTaskRun, db.EntitiesBuild:
+		db.EntitiesPipelineRun, db.EntitiesTaskRun, db.EntitiesBuild, db.EntitiesOrganization:
 		// We only need to handle the above two types specially for historical compatibility.
 	}
 
@@ -772,6 +890,8 @@ func dbEntityToEntity(dbEnt db.Entities) minderv1.Entity {
 		return minderv1.Entity_ENTITY_TASK_RUN
 	case db.EntitiesBuild:
 		return minderv1.Entity_ENTITY_BUILD
+	case db.EntitiesOrganization:
+		return minderv1.Entity_ENTITY_ORGANIZATION
 	default:
 		return minderv1.Entity_ENTITY_UNSPECIFIED
 	}
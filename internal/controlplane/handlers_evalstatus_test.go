@@ -14,10 +14,14 @@ import (
 	"github.com/sqlc-dev/pqtype"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/mindersec/minder/database"
 	mockdb "github.com/mindersec/minder/database/mock"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/engcontext"
@@ -57,6 +61,11 @@ func TestBuildEvalResultAlertFromLRERow(t *testing.T) {
 				LastUpdated: timestamppb.New(d),
 				Details:     "details go here",
 				Url:         "https://github.com/example/test/security/advisories/GHAS-advisory_ID_here",
+				Link: &minderv1.ActionLink{
+					Kind: "security_advisory",
+					Ref:  "GHAS-advisory_ID_here",
+					Url:  "https://github.com/example/test/security/advisories/GHAS-advisory_ID_here",
+				},
 			},
 		},
 		{
@@ -96,17 +105,22 @@ func TestBuildEvalResultAlertFromLRERow(t *testing.T) {
 				LastUpdated: timestamppb.New(d),
 				Details:     "details go here",
 				Url:         "",
+				Link: &minderv1.ActionLink{
+					Kind: "security_advisory",
+					Ref:  "GHAS-advisory_ID_here",
+				},
 			},
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			res := buildEvalResultAlertFromLRERow(tc.sut, tc.efp)
+			res := buildEvalResultAlertFromLRERow(context.Background(), tc.sut, tc.efp)
 
 			require.Equal(t, tc.expect.Details, res.Details)
 			require.Equal(t, tc.expect.LastUpdated, res.LastUpdated)
 			require.Equal(t, tc.expect.Status, res.Status)
 			require.Equal(t, tc.expect.Url, res.Url)
+			require.True(t, proto.Equal(tc.expect.Link, res.Link), "unexpected link %v", res.Link)
 		})
 	}
 }
@@ -159,6 +173,11 @@ func TestDBEntityToEntity(t *testing.T) {
 			input:  db.EntitiesBuild,
 			output: minderv1.Entity_ENTITY_BUILD,
 		},
+		{
+			name:   "organization",
+			input:  db.EntitiesOrganization,
+			output: minderv1.Entity_ENTITY_ORGANIZATION,
+		},
 		{
 			name:   "default",
 			input:  db.Entities("whatever"),
@@ -479,6 +498,9 @@ func TestGetEvaluationHistoryIncludeOutputs(t *testing.T) {
 			mockStore.EXPECT().
 				GetEvaluationOutput(gomock.Any(), evalID).
 				Return(tt.outputRow, tt.outputErr)
+			mockStore.EXPECT().
+				ListEvaluationAnnotationsByEvaluationIDs(gomock.Any(), []uuid.UUID{evalID}).
+				Return(nil, nil)
 
 			server := Server{store: mockStore}
 
@@ -698,6 +720,9 @@ func TestListEvaluationResultsIncludeOutputs(t *testing.T) {
 						EvalOutput:            tt.evalOutput,
 					},
 				}, nil)
+			mockStore.EXPECT().
+				ListEvaluationAnnotationsForFindings(gomock.Any(), gomock.Any()).
+				Return(nil, nil)
 
 			server := Server{store: mockStore, props: mockProps}
 			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
@@ -791,6 +816,9 @@ func TestListEvaluationHistoryIncludeOutputs(t *testing.T) {
 			mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore)
 			mockStore.EXPECT().Rollback(gomock.Any()).Return(nil)
 			mockStore.EXPECT().Commit(gomock.Any()).Return(nil)
+			mockStore.EXPECT().
+				ListEvaluationAnnotationsByEvaluationIDs(gomock.Any(), []uuid.UUID{evalID}).
+				Return(nil, nil)
 
 			mockHist.EXPECT().
 				ListEvaluationHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), tt.includeOutputs).
@@ -948,6 +976,9 @@ func TestListEvaluationResultsEntityInfo(t *testing.T) {
 						Provider:              "github",
 					},
 				}, nil)
+			mockStore.EXPECT().
+				ListEvaluationAnnotationsForFindings(gomock.Any(), gomock.Any()).
+				Return(nil, nil)
 
 			server := Server{store: mockStore, props: mockProps}
 			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
@@ -975,3 +1006,82 @@ func TestListEvaluationResultsEntityInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestListEvaluationHistorySearchPhaseGate(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+
+	tests := []struct {
+		name       string
+		gated      bool
+		phaseState db.MigrationPhaseState
+		expectErr  codes.Code
+	}{
+		{
+			name: "search is allowed without a phase gate",
+		},
+		{
+			name:       "search is allowed once the search indexes are built",
+			gated:      true,
+			phaseState: db.MigrationPhaseStateCompleted,
+		},
+		{
+			name:       "search is unavailable while the search indexes are built",
+			gated:      true,
+			phaseState: db.MigrationPhaseStateRunning,
+			expectErr:  codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockHist := mockhistory.NewMockEvaluationHistoryService(ctrl)
+			server := Server{store: mockStore, history: mockHist}
+
+			if tt.gated {
+				mockPhases := mockdb.NewMockMigrationPhasesStore(ctrl)
+				mockPhases.EXPECT().
+					GetMigrationPhase(gomock.Any(), database.SearchIndexesPhase).
+					Return(db.MigrationPhase{Name: database.SearchIndexesPhase, State: tt.phaseState}, nil)
+				server.phaseGate = database.NewPhaseGate(mockPhases, time.Minute)
+			}
+
+			if tt.expectErr == codes.OK {
+				mockStore.EXPECT().BeginTransaction().Return(nil, nil)
+				mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore)
+				mockStore.EXPECT().Rollback(gomock.Any()).Return(nil)
+				mockStore.EXPECT().Commit(gomock.Any()).Return(nil)
+				mockHist.EXPECT().
+					ListEvaluationHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), false).
+					DoAndReturn(func(
+						_ context.Context, _ db.ExtendQuerier, _ *history.ListEvaluationCursor,
+						_ uint32, filter history.ListEvaluationFilter, _ bool,
+					) (*history.ListEvaluationHistoryResult, error) {
+						require.Equal(t, "token leaked", filter.GetSearch())
+						return &history.ListEvaluationHistoryResult{}, nil
+					})
+			}
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			_, err := server.ListEvaluationHistory(ctx, &minderv1.ListEvaluationHistoryRequest{
+				Search: "token leaked",
+			})
+
+			if tt.expectErr != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tt.expectErr, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,314 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// CreateRuleException requests an exception to a rule of a profile for an
+// entity. The exception is pending until it is reviewed.
+func (s *Server) CreateRuleException(
+	ctx context.Context,
+	in *minderv1.CreateRuleExceptionRequest,
+) (*minderv1.CreateRuleExceptionResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+	projectID := entityCtx.Project.ID
+
+	if err := validateEntityType(in.GetEntity()); err != nil {
+		return nil, err
+	}
+	entityID, err := uuid.Parse(in.GetEntity().GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid entity id: %q", in.GetEntity().GetId())
+	}
+	expiresAt := in.GetExpiresAt().AsTime()
+	if !expiresAt.After(time.Now()) {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "expiration time must be in the future")
+	}
+
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error starting transaction: %v", err)
+	}
+	defer s.store.Rollback(tx)
+
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	ent, err := qtx.GetEntityByID(ctx, entityID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && ent.ProjectID != projectID) {
+		return nil, util.UserVisibleError(codes.NotFound, "entity not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting entity: %v", err)
+	}
+	if in.GetEntity().GetType() != minderv1.Entity_ENTITY_UNSPECIFIED &&
+		entities.EntityTypeToDB(in.GetEntity().GetType()) != ent.EntityType {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "entity %s is not of type %s",
+			entityID, in.GetEntity().GetType())
+	}
+
+	rule, err := qtx.GetRuleInstanceByProfileName(ctx, db.GetRuleInstanceByProfileNameParams{
+		ProjectID:   projectID,
+		EntityType:  ent.EntityType,
+		ProfileName: in.GetProfile(),
+		RuleName:    in.GetRuleName(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.NotFound, "rule %q for %s not found in profile %q",
+			in.GetRuleName(), ent.EntityType, in.GetProfile())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting rule: %v", err)
+	}
+
+	requestedBy := auth.IdentityFromContext(ctx).String()
+	exception, err := qtx.CreateRuleException(ctx, db.CreateRuleExceptionParams{
+		ProjectID:        projectID,
+		RuleID:           rule.ID,
+		EntityInstanceID: ent.ID,
+		Justification:    in.GetJustification(),
+		RequestedBy:      requestedBy,
+		ExpiresAt:        expiresAt,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating exception: %v", err)
+	}
+
+	if err := qtx.CreateRuleExceptionEvent(ctx, db.CreateRuleExceptionEventParams{
+		ExceptionID: exception.ID,
+		State:       exception.State,
+		Actor:       requestedBy,
+		Comment:     in.GetJustification(),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "error recording exception event: %v", err)
+	}
+
+	if err := s.store.Commit(tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "error committing transaction: %v", err)
+	}
+
+	out, err := s.getRuleException(ctx, projectID, exception.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &minderv1.CreateRuleExceptionResponse{Exception: out}, nil
+}
+
+// ReviewRuleException approves or rejects a pending exception. Exceptions
+// can't be reviewed by the user who requested them.
+func (s *Server) ReviewRuleException(
+	ctx context.Context,
+	in *minderv1.ReviewRuleExceptionRequest,
+) (*minderv1.ReviewRuleExceptionResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+	projectID := entityCtx.Project.ID
+
+	exceptionID, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid exception id: %q", in.GetId())
+	}
+
+	var newState db.RuleExceptionState
+	switch in.GetState() {
+	case minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_APPROVED:
+		newState = db.RuleExceptionStateApproved
+	case minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_REJECTED:
+		newState = db.RuleExceptionStateRejected
+	default:
+		return nil, util.UserVisibleError(codes.InvalidArgument, "exceptions can only be approved or rejected")
+	}
+
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error starting transaction: %v", err)
+	}
+	defer s.store.Rollback(tx)
+
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	exception, err := qtx.GetRuleExceptionByIDAndLock(ctx, db.GetRuleExceptionByIDAndLockParams{
+		ID:        exceptionID,
+		ProjectID: projectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.NotFound, "exception not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting exception: %v", err)
+	}
+
+	if exception.State != db.RuleExceptionStatePending {
+		return nil, util.UserVisibleError(codes.FailedPrecondition, "exception is already %s", exception.State)
+	}
+	if !exception.ExpiresAt.After(time.Now()) {
+		return nil, util.UserVisibleError(codes.FailedPrecondition, "exception has expired")
+	}
+	reviewer := auth.IdentityFromContext(ctx).String()
+	if reviewer == exception.RequestedBy {
+		return nil, util.UserVisibleError(codes.PermissionDenied, "exceptions can't be reviewed by their requester")
+	}
+
+	if _, err := qtx.ReviewRuleException(ctx, db.ReviewRuleExceptionParams{
+		ID:            exception.ID,
+		State:         newState,
+		ReviewedBy:    sql.NullString{String: reviewer, Valid: true},
+		ReviewComment: maybeNullString(in.GetComment()),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "error reviewing exception: %v", err)
+	}
+
+	if err := qtx.CreateRuleExceptionEvent(ctx, db.CreateRuleExceptionEventParams{
+		ExceptionID: exception.ID,
+		State:       newState,
+		Actor:       reviewer,
+		Comment:     in.GetComment(),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "error recording exception event: %v", err)
+	}
+
+	if err := s.store.Commit(tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "error committing transaction: %v", err)
+	}
+
+	out, err := s.getRuleException(ctx, projectID, exception.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &minderv1.ReviewRuleExceptionResponse{Exception: out}, nil
+}
+
+// ListRuleExceptions lists the exceptions of the project, along with their
+// history
+func (s *Server) ListRuleExceptions(
+	ctx context.Context,
+	_ *minderv1.ListRuleExceptionsRequest,
+) (*minderv1.ListRuleExceptionsResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	exceptions, err := s.listRuleExceptions(ctx, entityCtx.Project.ID, uuid.NullUUID{})
+	if err != nil {
+		return nil, err
+	}
+	return &minderv1.ListRuleExceptionsResponse{Exceptions: exceptions}, nil
+}
+
+func (s *Server) getRuleException(
+	ctx context.Context,
+	projectID uuid.UUID,
+	exceptionID uuid.UUID,
+) (*minderv1.RuleException, error) {
+	exceptions, err := s.listRuleExceptions(ctx, projectID, uuid.NullUUID{UUID: exceptionID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(exceptions) == 0 {
+		return nil, util.UserVisibleError(codes.NotFound, "exception not found")
+	}
+	return exceptions[0], nil
+}
+
+func (s *Server) listRuleExceptions(
+	ctx context.Context,
+	projectID uuid.UUID,
+	exceptionID uuid.NullUUID,
+) ([]*minderv1.RuleException, error) {
+	rows, err := s.store.ListRuleExceptions(ctx, db.ListRuleExceptionsParams{
+		ProjectID: projectID,
+		ID:        exceptionID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing exceptions: %v", err)
+	}
+	if len(rows) == 0 {
+		return []*minderv1.RuleException{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	events, err := s.store.ListRuleExceptionEvents(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing exception events: %v", err)
+	}
+	history := make(map[uuid.UUID][]*minderv1.RuleExceptionEvent, len(rows))
+	for _, ev := range events {
+		history[ev.ExceptionID] = append(history[ev.ExceptionID], &minderv1.RuleExceptionEvent{
+			State:     ruleExceptionStateToPB(ev.State, time.Time{}),
+			Actor:     ev.Actor,
+			Comment:   ev.Comment,
+			CreatedAt: timestamppb.New(ev.CreatedAt),
+		})
+	}
+
+	out := make([]*minderv1.RuleException, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &minderv1.RuleException{
+			Id: row.ID.String(),
+			Context: &minderv1.Context{
+				Project: ptr.Ptr(row.ProjectID.String()),
+			},
+			Profile:  row.ProfileName,
+			RuleName: row.RuleName,
+			RuleType: row.RuleTypeName,
+			Entity: &minderv1.EntityTypedId{
+				Type: entities.EntityTypeFromDB(row.EntityType),
+				Id:   row.EntityInstanceID.String(),
+				Name: row.EntityName,
+			},
+			Justification: row.Justification,
+			State:         ruleExceptionStateToPB(row.State, row.ExpiresAt),
+			RequestedBy:   row.RequestedBy,
+			ReviewedBy:    row.ReviewedBy.String,
+			ReviewComment: row.ReviewComment.String,
+			ExpiresAt:     timestamppb.New(row.ExpiresAt),
+			CreatedAt:     timestamppb.New(row.CreatedAt),
+			UpdatedAt:     timestamppb.New(row.UpdatedAt),
+			History:       history[row.ID],
+		})
+	}
+	return out, nil
+}
+
+// ruleExceptionStateToPB maps the state of an exception to its API value.
+// Approved exceptions past their expiration time are reported as expired; a
+// zero expiration time skips that check.
+func ruleExceptionStateToPB(state db.RuleExceptionState, expiresAt time.Time) minderv1.RuleExceptionState {
+	switch state {
+	case db.RuleExceptionStatePending:
+		return minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_PENDING
+	case db.RuleExceptionStateApproved:
+		if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+			return minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_EXPIRED
+		}
+		return minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_APPROVED
+	case db.RuleExceptionStateRejected:
+		return minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_REJECTED
+	default:
+		return minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestCreateRuleException(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	entityID := uuid.New()
+	ruleID := uuid.New()
+	exceptionID := uuid.New()
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	validRequest := func() *minderv1.CreateRuleExceptionRequest {
+		return &minderv1.CreateRuleExceptionRequest{
+			Profile:  "my-profile",
+			RuleName: "branch_protection",
+			Entity: &minderv1.EntityTypedId{
+				Type: minderv1.Entity_ENTITY_REPOSITORIES,
+				Id:   entityID.String(),
+			},
+			Justification: "legacy repository",
+			ExpiresAt:     timestamppb.New(expiresAt),
+		}
+	}
+
+	tests := []struct {
+		name              string
+		request           func() *minderv1.CreateRuleExceptionRequest
+		setupMocks        func(*mockdb.MockStore)
+		expectedErrorCode codes.Code
+	}{
+		{
+			name:    "happy path",
+			request: validRequest,
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEntityByID(gomock.Any(), entityID).Return(db.EntityInstance{
+					ID:         entityID,
+					EntityType: db.EntitiesRepository,
+					Name:       "acme/legacy",
+					ProjectID:  projectID,
+				}, nil)
+				store.EXPECT().GetRuleInstanceByProfileName(gomock.Any(), db.GetRuleInstanceByProfileNameParams{
+					ProjectID:   projectID,
+					EntityType:  db.EntitiesRepository,
+					ProfileName: "my-profile",
+					RuleName:    "branch_protection",
+				}).Return(db.RuleInstance{ID: ruleID}, nil)
+				store.EXPECT().CreateRuleException(gomock.Any(), db.CreateRuleExceptionParams{
+					ProjectID:        projectID,
+					RuleID:           ruleID,
+					EntityInstanceID: entityID,
+					Justification:    "legacy repository",
+					RequestedBy:      "alice",
+					ExpiresAt:        expiresAt.UTC(),
+				}).Return(db.RuleException{
+					ID:    exceptionID,
+					State: db.RuleExceptionStatePending,
+				}, nil)
+				store.EXPECT().CreateRuleExceptionEvent(gomock.Any(), db.CreateRuleExceptionEventParams{
+					ExceptionID: exceptionID,
+					State:       db.RuleExceptionStatePending,
+					Actor:       "alice",
+					Comment:     "legacy repository",
+				}).Return(nil)
+				store.EXPECT().ListRuleExceptions(gomock.Any(), db.ListRuleExceptionsParams{
+					ProjectID: projectID,
+					ID:        uuid.NullUUID{UUID: exceptionID, Valid: true},
+				}).Return([]db.ListRuleExceptionsRow{{
+					ID:               exceptionID,
+					ProjectID:        projectID,
+					EntityInstanceID: entityID,
+					State:            db.RuleExceptionStatePending,
+					ExpiresAt:        expiresAt,
+					ProfileName:      "my-profile",
+					RuleName:         "branch_protection",
+					EntityName:       "acme/legacy",
+					EntityType:       db.EntitiesRepository,
+				}}, nil)
+				store.EXPECT().ListRuleExceptionEvents(gomock.Any(), []uuid.UUID{exceptionID}).
+					Return([]db.RuleExceptionEvent{{
+						ExceptionID: exceptionID,
+						State:       db.RuleExceptionStatePending,
+						Actor:       "alice",
+					}}, nil)
+			},
+			expectedErrorCode: codes.OK,
+		},
+		{
+			name: "expiration in the past",
+			request: func() *minderv1.CreateRuleExceptionRequest {
+				req := validRequest()
+				req.ExpiresAt = timestamppb.New(time.Now().Add(-time.Hour))
+				return req
+			},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name:    "entity in another project",
+			request: validRequest,
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEntityByID(gomock.Any(), entityID).Return(db.EntityInstance{
+					ID:         entityID,
+					EntityType: db.EntitiesRepository,
+					ProjectID:  uuid.New(),
+				}, nil)
+			},
+			expectedErrorCode: codes.NotFound,
+		},
+		{
+			name:    "rule not in profile",
+			request: validRequest,
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEntityByID(gomock.Any(), entityID).Return(db.EntityInstance{
+					ID:         entityID,
+					EntityType: db.EntitiesRepository,
+					ProjectID:  projectID,
+				}, nil)
+				store.EXPECT().GetRuleInstanceByProfileName(gomock.Any(), gomock.Any()).
+					Return(db.RuleInstance{}, sql.ErrNoRows)
+			},
+			expectedErrorCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID}, nil).AnyTimes()
+			mockStore.EXPECT().BeginTransaction().AnyTimes()
+			mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore).AnyTimes()
+			mockStore.EXPECT().Rollback(gomock.Any()).AnyTimes()
+			mockStore.EXPECT().Commit(gomock.Any()).AnyTimes()
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockStore)
+			}
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			ctx = auth.WithIdentityContext(ctx, &auth.Identity{UserID: "alice"})
+
+			resp, err := srv.CreateRuleException(ctx, tt.request())
+			if tt.expectedErrorCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedErrorCode, st.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, exceptionID.String(), resp.GetException().GetId())
+			require.Equal(t, minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_PENDING, resp.GetException().GetState())
+			require.Equal(t, "acme/legacy", resp.GetException().GetEntity().GetName())
+			require.Len(t, resp.GetException().GetHistory(), 1)
+		})
+	}
+}
+
+func TestReviewRuleException(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	exceptionID := uuid.New()
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	pending := db.RuleException{
+		ID:          exceptionID,
+		ProjectID:   projectID,
+		State:       db.RuleExceptionStatePending,
+		RequestedBy: "alice",
+		ExpiresAt:   expiresAt,
+	}
+
+	tests := []struct {
+		name              string
+		reviewer          string
+		state             minderv1.RuleExceptionState
+		exception         db.RuleException
+		expectedErrorCode codes.Code
+	}{
+		{
+			name:              "approve",
+			reviewer:          "bob",
+			state:             minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_APPROVED,
+			exception:         pending,
+			expectedErrorCode: codes.OK,
+		},
+		{
+			name:              "requester can't review",
+			reviewer:          "alice",
+			state:             minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_APPROVED,
+			exception:         pending,
+			expectedErrorCode: codes.PermissionDenied,
+		},
+		{
+			name:     "already reviewed",
+			reviewer: "bob",
+			state:    minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_REJECTED,
+			exception: db.RuleException{
+				ID:          exceptionID,
+				State:       db.RuleExceptionStateApproved,
+				RequestedBy: "alice",
+				ExpiresAt:   expiresAt,
+			},
+			expectedErrorCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "expired",
+			reviewer: "bob",
+			state:    minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_APPROVED,
+			exception: db.RuleException{
+				ID:          exceptionID,
+				State:       db.RuleExceptionStatePending,
+				RequestedBy: "alice",
+				ExpiresAt:   time.Now().Add(-time.Hour),
+			},
+			expectedErrorCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID}, nil).AnyTimes()
+			mockStore.EXPECT().BeginTransaction().AnyTimes()
+			mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore).AnyTimes()
+			mockStore.EXPECT().Rollback(gomock.Any()).AnyTimes()
+			mockStore.EXPECT().Commit(gomock.Any()).AnyTimes()
+			mockStore.EXPECT().GetRuleExceptionByIDAndLock(gomock.Any(), db.GetRuleExceptionByIDAndLockParams{
+				ID:        exceptionID,
+				ProjectID: projectID,
+			}).Return(tt.exception, nil)
+			if tt.expectedErrorCode == codes.OK {
+				mockStore.EXPECT().ReviewRuleException(gomock.Any(), db.ReviewRuleExceptionParams{
+					ID:            exceptionID,
+					State:         db.RuleExceptionStateApproved,
+					ReviewedBy:    sql.NullString{String: tt.reviewer, Valid: true},
+					ReviewComment: sql.NullString{String: "ok", Valid: true},
+				}).Return(tt.exception, nil)
+				mockStore.EXPECT().CreateRuleExceptionEvent(gomock.Any(), db.CreateRuleExceptionEventParams{
+					ExceptionID: exceptionID,
+					State:       db.RuleExceptionStateApproved,
+					Actor:       tt.reviewer,
+					Comment:     "ok",
+				}).Return(nil)
+				mockStore.EXPECT().ListRuleExceptions(gomock.Any(), gomock.Any()).
+					Return([]db.ListRuleExceptionsRow{{
+						ID:        exceptionID,
+						State:     db.RuleExceptionStateApproved,
+						ExpiresAt: expiresAt,
+					}}, nil)
+				mockStore.EXPECT().ListRuleExceptionEvents(gomock.Any(), []uuid.UUID{exceptionID}).
+					Return(nil, nil)
+			}
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			ctx = auth.WithIdentityContext(ctx, &auth.Identity{UserID: tt.reviewer})
+
+			resp, err := srv.ReviewRuleException(ctx, &minderv1.ReviewRuleExceptionRequest{
+				Id:      exceptionID.String(),
+				State:   tt.state,
+				Comment: "ok",
+			})
+			if tt.expectedErrorCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedErrorCode, st.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_APPROVED, resp.GetException().GetState())
+		})
+	}
+}
+
+func TestRuleExceptionStateToPB(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_APPROVED,
+		ruleExceptionStateToPB(db.RuleExceptionStateApproved, time.Now().Add(time.Hour)))
+	require.Equal(t, minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_EXPIRED,
+		ruleExceptionStateToPB(db.RuleExceptionStateApproved, time.Now().Add(-time.Hour)))
+	require.Equal(t, minderv1.RuleExceptionState_RULE_EXCEPTION_STATE_REJECTED,
+		ruleExceptionStateToPB(db.RuleExceptionStateRejected, time.Now().Add(-time.Hour)))
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/projects/features"
 	"github.com/mindersec/minder/internal/providers"
 	"github.com/mindersec/minder/internal/providers/credentials"
 	"github.com/mindersec/minder/internal/providers/github/service"
@@ -75,6 +76,10 @@ func (s *Server) GetAuthorizationURL(
 		!flags.Bool(ctx, s.featureFlags, flags.GitLabProvider) {
 		return nil, util.UserVisibleError(codes.Unimplemented, "GitLab provider is not enabled")
 	}
+	if providerClass == string(db.ProviderClassAzureDevops) &&
+		!flags.Bool(ctx, s.featureFlags, flags.AzureDevOpsProvider) {
+		return nil, util.UserVisibleError(codes.Unimplemented, "Azure DevOps provider is not enabled")
+	}
 
 	// Telemetry logging
 	logger.BusinessRecord(ctx).Provider = providerName
@@ -229,6 +234,9 @@ func (s *Server) alllowedRedirectURL(redirectUrl *url.URL) bool {
 	if providerCfg.GitLab != nil && strings.HasPrefix(providerCfg.GitLab.RedirectURI, hostUrlString) {
 		return true
 	}
+	if providerCfg.AzureDevOps != nil && strings.HasPrefix(providerCfg.AzureDevOps.RedirectURI, hostUrlString) {
+		return true
+	}
 
 	if slices.ContainsFunc(s.cfg.HTTPServer.CORS.AllowOrigins, func(u string) bool {
 		return u == hostUrlString || u+"/" == hostUrlString
@@ -346,6 +354,7 @@ func (s *Server) processOAuthCallback(
 	}
 
 	var errConfig providers.ErrProviderInvalidConfig
+	var upgradeErr *features.RequiresUpgradeError
 
 	p, err := s.sessionService.CreateProviderFromSessionState(ctx, db.ProviderClass(provider), &encryptedToken, state)
 	if db.ErrIsUniqueViolation(err) {
@@ -354,6 +363,9 @@ func (s *Server) processOAuthCallback(
 	} else if errors.As(err, &errConfig) {
 		return newHttpError(http.StatusBadRequest, "Invalid provider config").SetContents(
 			"The provider configuration is invalid: %s", errConfig.Details)
+	} else if errors.As(err, &upgradeErr) {
+		return newHttpError(http.StatusForbidden, "Upgrade required").SetContents(
+			"The project cannot enroll the provider: %s", upgradeErr)
 	} else if err != nil {
 		return fmt.Errorf("error creating provider: %w", err)
 	}
@@ -453,6 +465,11 @@ func (s *Server) processAppCallback(
 				return newHttpError(http.StatusForbidden, "User token mismatch").SetContents(
 					"The provided login token was associated with a different GitHub user.")
 			}
+			var upgradeErr *features.RequiresUpgradeError
+			if errors.As(err, &upgradeErr) {
+				return newHttpError(http.StatusForbidden, "Upgrade required").SetContents(
+					"The project cannot enroll the GitHub App: %s", upgradeErr)
+			}
 			return fmt.Errorf("error creating GitHub App provider: %w", err)
 		}
 
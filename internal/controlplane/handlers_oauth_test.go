@@ -505,6 +505,7 @@ func TestProviderCallback(t *testing.T) {
 		store.EXPECT().GetParentProjects(gomock.Any(), projectID).Return([]uuid.UUID{projectID}, nil)
 		store.EXPECT().FindProviders(gomock.Any(), gomock.Any()).
 			Return([]db.Provider{}, nil)
+		store.EXPECT().GetEffectiveProjectTier(gomock.Any(), projectID).Return(db.ProjectTier{}, sql.ErrNoRows)
 		store.EXPECT().CreateProvider(gomock.Any(), gomock.Any()).Return(db.Provider{}, nil)
 	}
 
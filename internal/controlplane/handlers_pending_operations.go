@@ -0,0 +1,409 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/authz"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/projects"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// OperationApprovalInterceptor is a server interceptor that records the
+// destructive operations on projects requiring the approval of such
+// operations as pending operations, instead of performing them. Pending
+// operations are performed once confirmed by a second admin.
+func OperationApprovalInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+
+	msg, ok := req.(proto.Message)
+	if !ok || !requiresApproval(msg) {
+		return handler(ctx, req)
+	}
+
+	server, ok := info.Server.(*Server)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "error casting server for request handling")
+	}
+
+	op, err := server.createPendingOperation(ctx, info.FullMethod, msg)
+	if err != nil {
+		return nil, err
+	}
+	if op == nil {
+		return handler(ctx, req)
+	}
+
+	return nil, util.UserVisibleError(codes.FailedPrecondition,
+		"this operation requires the confirmation of another admin: pending operation %s must be confirmed before %s",
+		op.ID, op.ExpiresAt.UTC().Format(time.RFC3339))
+}
+
+// requiresApproval returns true if the request is a destructive operation,
+// which projects may require a second admin to confirm.
+func requiresApproval(req proto.Message) bool {
+	switch r := req.(type) {
+	case *minderv1.DeleteProjectRequest,
+		*minderv1.DeleteProviderRequest,
+		*minderv1.DeleteProviderByIDRequest:
+		return true
+	case *minderv1.SyncRepositoriesRequest:
+		// Syncing deletes the repositories archived or deleted upstream only
+		// when the changes are applied
+		return r.GetApply()
+	case *minderv1.PatchProjectRequest:
+		// Otherwise, a single admin could disable the approvals to perform a
+		// destructive operation alone
+		return !r.GetPatch().GetOperationApproval().GetEnabled() &&
+			slices.ContainsFunc(r.GetUpdateMask().GetPaths(), func(path string) bool {
+				return path == "operation_approval" || strings.HasPrefix(path, "operation_approval.")
+			})
+	default:
+		return false
+	}
+}
+
+// createPendingOperation records the operation as pending if the project
+// requires the approval of destructive operations. It returns nil if the
+// project doesn't.
+func (s *Server) createPendingOperation(
+	ctx context.Context,
+	method string,
+	req proto.Message,
+) (*db.PendingOperation, error) {
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+
+	approval, err := projects.GetOperationApproval(ctx, s.store, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting operation approval: %v", err)
+	}
+	if approval == nil {
+		return nil, nil
+	}
+
+	request, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error marshalling request: %v", err)
+	}
+
+	op, err := s.store.CreatePendingOperation(ctx, db.CreatePendingOperationParams{
+		ProjectID:   projectID,
+		Method:      method,
+		Request:     request,
+		RequestedBy: auth.IdentityFromContext(ctx).String(),
+		ExpiresAt:   time.Now().Add(approval.Window),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating pending operation: %v", err)
+	}
+
+	zerolog.Ctx(ctx).Info().Str("operation_id", op.ID.String()).Str("method", method).
+		Msg("destructive operation pending confirmation")
+	return &op, nil
+}
+
+// ListPendingOperations lists the destructive operations of the project
+// waiting for, or which received, the confirmation of a second admin
+func (s *Server) ListPendingOperations(
+	ctx context.Context,
+	_ *minderv1.ListPendingOperationsRequest,
+) (*minderv1.ListPendingOperationsResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	ops, err := s.store.ListPendingOperations(ctx, entityCtx.Project.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing pending operations: %v", err)
+	}
+
+	out := make([]*minderv1.PendingOperation, 0, len(ops))
+	for _, op := range ops {
+		pbOp, err := pendingOperationToPB(op)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, pbOp)
+	}
+	return &minderv1.ListPendingOperationsResponse{Operations: out}, nil
+}
+
+// ConfirmPendingOperation performs a pending operation. Operations can't be
+// confirmed by the user who requested them. If the operation fails, it stays
+// pending, so that it can be confirmed again.
+func (s *Server) ConfirmPendingOperation(
+	ctx context.Context,
+	in *minderv1.ConfirmPendingOperationRequest,
+) (*minderv1.ConfirmPendingOperationResponse, error) {
+	reviewer := auth.IdentityFromContext(ctx).String()
+	op, err := s.reviewPendingOperation(ctx, in.GetId(), db.PendingOperationStateConfirmed,
+		func(op *db.PendingOperation) error {
+			if reviewer == op.RequestedBy {
+				return util.UserVisibleError(codes.PermissionDenied, "operations can't be confirmed by their requester")
+			}
+			if !op.ExpiresAt.After(time.Now()) {
+				return util.UserVisibleError(codes.FailedPrecondition, "operation has expired")
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := pendingOperationRequest(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// The operation is performed as the handler would have, had it not been
+	// intercepted
+	opCtx := engcontext.WithEntityContext(ctx, &engcontext.EntityContext{
+		Project: engcontext.Project{
+			ID: op.ProjectID,
+		},
+		Provider: engcontext.Provider{
+			Name: getProviderFromRequest(req),
+		},
+	})
+	resp, err := s.performOperation(opCtx, req)
+	if err != nil {
+		if rerr := s.store.UpdatePendingOperationState(ctx, db.UpdatePendingOperationStateParams{
+			ID:    op.ID,
+			State: db.PendingOperationStatePending,
+		}); rerr != nil {
+			zerolog.Ctx(ctx).Error().Err(rerr).Str("operation_id", op.ID.String()).
+				Msg("error resetting failed pending operation")
+		}
+		return nil, err
+	}
+
+	response, err := messageToStruct(resp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error converting response: %v", err)
+	}
+
+	// The operation may have deleted its own record, e.g. along with its
+	// project, so it is returned as confirmed rather than read back
+	pbOp, err := pendingOperationToPB(*op)
+	if err != nil {
+		return nil, err
+	}
+	return &minderv1.ConfirmPendingOperationResponse{
+		Operation: pbOp,
+		Response:  response,
+	}, nil
+}
+
+// CancelPendingOperation cancels a pending operation. Operations can be
+// cancelled by the user who requested them, or by an admin.
+func (s *Server) CancelPendingOperation(
+	ctx context.Context,
+	in *minderv1.CancelPendingOperationRequest,
+) (*minderv1.CancelPendingOperationResponse, error) {
+	reviewer := auth.IdentityFromContext(ctx).String()
+	op, err := s.reviewPendingOperation(ctx, in.GetId(), db.PendingOperationStateCancelled,
+		func(op *db.PendingOperation) error {
+			if reviewer == op.RequestedBy {
+				return nil
+			}
+			relation := minderv1.RelationAsName(minderv1.Relation_RELATION_DELETE)
+			if err := s.authzClient.Check(ctx, relation, op.ProjectID); errors.Is(err, authz.ErrNotAuthorized) {
+				return util.UserVisibleError(codes.PermissionDenied,
+					"operations can only be cancelled by their requester or an admin")
+			} else if err != nil {
+				return status.Errorf(codes.Internal, "error checking authorization: %v", err)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	pbOp, err := pendingOperationToPB(*op)
+	if err != nil {
+		return nil, err
+	}
+	return &minderv1.CancelPendingOperationResponse{Operation: pbOp}, nil
+}
+
+// reviewPendingOperation moves a pending operation of the project of the
+// context to the given state, once check accepts the operation. It returns
+// the operation in its new state.
+func (s *Server) reviewPendingOperation(
+	ctx context.Context,
+	id string,
+	state db.PendingOperationState,
+	check func(*db.PendingOperation) error,
+) (*db.PendingOperation, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	opID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid operation id: %q", id)
+	}
+
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error starting transaction: %v", err)
+	}
+	defer s.store.Rollback(tx)
+
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	op, err := qtx.GetPendingOperationByIDAndLock(ctx, db.GetPendingOperationByIDAndLockParams{
+		ID:        opID,
+		ProjectID: entityCtx.Project.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.NotFound, "operation not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting operation: %v", err)
+	}
+
+	if op.State != db.PendingOperationStatePending {
+		return nil, util.UserVisibleError(codes.FailedPrecondition, "operation is already %s", op.State)
+	}
+	if err := check(&op); err != nil {
+		return nil, err
+	}
+
+	reviewer := sql.NullString{String: auth.IdentityFromContext(ctx).String(), Valid: true}
+	if err := qtx.UpdatePendingOperationState(ctx, db.UpdatePendingOperationStateParams{
+		ID:         op.ID,
+		State:      state,
+		ReviewedBy: reviewer,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "error updating operation: %v", err)
+	}
+
+	if err := s.store.Commit(tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "error committing transaction: %v", err)
+	}
+
+	op.State = state
+	op.ReviewedBy = reviewer
+	op.UpdatedAt = time.Now()
+	return &op, nil
+}
+
+// performOperation performs a confirmed operation
+func (s *Server) performOperation(ctx context.Context, req proto.Message) (proto.Message, error) {
+	switch r := req.(type) {
+	case *minderv1.DeleteProjectRequest:
+		return s.DeleteProject(ctx, r)
+	case *minderv1.DeleteProviderRequest:
+		return s.DeleteProvider(ctx, r)
+	case *minderv1.DeleteProviderByIDRequest:
+		return s.DeleteProviderByID(ctx, r)
+	case *minderv1.SyncRepositoriesRequest:
+		return s.SyncRepositories(ctx, r)
+	case *minderv1.PatchProjectRequest:
+		return s.PatchProject(ctx, r)
+	default:
+		return nil, status.Errorf(codes.Internal, "unsupported pending operation %T", req)
+	}
+}
+
+// pendingOperationRequest decodes the request of a pending operation, based
+// on the input of its method
+func pendingOperationRequest(op *db.PendingOperation) (proto.Message, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(op.Method, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unknown method %s: %v", op.Method, err)
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "%s is not a method", op.Method)
+	}
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unknown request of method %s: %v", op.Method, err)
+	}
+
+	req := msgType.New().Interface()
+	if err := protojson.Unmarshal(op.Request, req); err != nil {
+		return nil, status.Errorf(codes.Internal, "error unmarshalling request: %v", err)
+	}
+	return req, nil
+}
+
+func pendingOperationToPB(op db.PendingOperation) (*minderv1.PendingOperation, error) {
+	request := &structpb.Struct{}
+	if err := protojson.Unmarshal(op.Request, request); err != nil {
+		return nil, status.Errorf(codes.Internal, "error unmarshalling request: %v", err)
+	}
+
+	return &minderv1.PendingOperation{
+		Id: op.ID.String(),
+		Context: &minderv1.Context{
+			Project: ptr.Ptr(op.ProjectID.String()),
+		},
+		Method:      op.Method,
+		Request:     request,
+		State:       pendingOperationStateToPB(op.State, op.ExpiresAt),
+		RequestedBy: op.RequestedBy,
+		ReviewedBy:  op.ReviewedBy.String,
+		ExpiresAt:   timestamppb.New(op.ExpiresAt),
+		CreatedAt:   timestamppb.New(op.CreatedAt),
+		UpdatedAt:   timestamppb.New(op.UpdatedAt),
+	}, nil
+}
+
+// pendingOperationStateToPB maps the state of an operation to its API value.
+// Pending operations past their expiration time are reported as expired.
+func pendingOperationStateToPB(state db.PendingOperationState, expiresAt time.Time) minderv1.PendingOperationState {
+	switch state {
+	case db.PendingOperationStatePending:
+		if !expiresAt.After(time.Now()) {
+			return minderv1.PendingOperationState_PENDING_OPERATION_STATE_EXPIRED
+		}
+		return minderv1.PendingOperationState_PENDING_OPERATION_STATE_PENDING
+	case db.PendingOperationStateConfirmed:
+		return minderv1.PendingOperationState_PENDING_OPERATION_STATE_CONFIRMED
+	case db.PendingOperationStateCancelled:
+		return minderv1.PendingOperationState_PENDING_OPERATION_STATE_CANCELLED
+	default:
+		return minderv1.PendingOperationState_PENDING_OPERATION_STATE_UNSPECIFIED
+	}
+}
+
+func messageToStruct(msg proto.Message) (*structpb.Struct, error) {
+	raw, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	out := &structpb.Struct{}
+	if err := protojson.Unmarshal(raw, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
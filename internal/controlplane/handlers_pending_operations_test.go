@@ -0,0 +1,345 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/auth"
+	mockauthz "github.com/mindersec/minder/internal/authz/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/projects"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const patchProjectMethod = "/minder.v1.ProjectsService/PatchProject"
+
+func TestRequiresApproval(t *testing.T) {
+	t.Parallel()
+
+	disableApproval := &minderv1.PatchProjectRequest{
+		Patch:      &minderv1.ProjectPatch{OperationApproval: &minderv1.ProjectOperationApproval{}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"operation_approval.enabled"}},
+	}
+	enableApproval := &minderv1.PatchProjectRequest{
+		Patch: &minderv1.ProjectPatch{
+			OperationApproval: &minderv1.ProjectOperationApproval{Enabled: true},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"operation_approval"}},
+	}
+
+	for name, tt := range map[string]struct {
+		req  proto.Message
+		want bool
+	}{
+		"delete project":          {req: &minderv1.DeleteProjectRequest{}, want: true},
+		"delete provider":         {req: &minderv1.DeleteProviderRequest{}, want: true},
+		"delete provider by id":   {req: &minderv1.DeleteProviderByIDRequest{}, want: true},
+		"apply repository sync":   {req: &minderv1.SyncRepositoriesRequest{Apply: true}, want: true},
+		"preview repository sync": {req: &minderv1.SyncRepositoriesRequest{}},
+		"disable approvals":       {req: disableApproval, want: true},
+		"enable approvals":        {req: enableApproval},
+		"patch display name": {req: &minderv1.PatchProjectRequest{
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+		}},
+		"delete repository": {req: &minderv1.DeleteRepositoryByIdRequest{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, requiresApproval(tt.req))
+		})
+	}
+}
+
+func TestOperationApprovalInterceptor(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	opID := uuid.New()
+
+	tests := []struct {
+		name          string
+		approval      *projects.OperationApproval
+		expectHandler bool
+	}{
+		{
+			name:          "approvals not required",
+			expectHandler: true,
+		},
+		{
+			name:     "approvals required",
+			approval: &projects.OperationApproval{Window: time.Hour},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			meta, err := projects.SerializeMetadata(&projects.Metadata{OperationApproval: tt.approval})
+			require.NoError(t, err)
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetParentProjects(gomock.Any(), projectID).Return([]uuid.UUID{projectID}, nil)
+			mockStore.EXPECT().GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID, Metadata: meta}, nil)
+			if tt.approval != nil {
+				mockStore.EXPECT().CreatePendingOperation(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreatePendingOperationParams) (db.PendingOperation, error) {
+						require.Equal(t, projectID, arg.ProjectID)
+						require.Equal(t, "/minder.v1.ProjectsService/DeleteProject", arg.Method)
+						require.Equal(t, "alice", arg.RequestedBy)
+						require.WithinDuration(t, time.Now().Add(time.Hour), arg.ExpiresAt, time.Minute)
+						return db.PendingOperation{ID: opID, ExpiresAt: arg.ExpiresAt}, nil
+					})
+			}
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			ctx = auth.WithIdentityContext(ctx, &auth.Identity{UserID: "alice"})
+
+			called := false
+			_, err = OperationApprovalInterceptor(ctx, &minderv1.DeleteProjectRequest{},
+				&grpc.UnaryServerInfo{Server: srv, FullMethod: "/minder.v1.ProjectsService/DeleteProject"},
+				func(_ context.Context, _ any) (any, error) {
+					called = true
+					return &minderv1.DeleteProjectResponse{}, nil
+				})
+			require.Equal(t, tt.expectHandler, called)
+			if tt.expectHandler {
+				require.NoError(t, err)
+				return
+			}
+			require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			require.Contains(t, err.Error(), opID.String())
+		})
+	}
+}
+
+func TestConfirmPendingOperation(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	opID := uuid.New()
+
+	// Disabling the approvals is itself a pending operation
+	request, err := protojson.Marshal(&minderv1.PatchProjectRequest{
+		Patch:      &minderv1.ProjectPatch{OperationApproval: &minderv1.ProjectOperationApproval{}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"operation_approval"}},
+	})
+	require.NoError(t, err)
+	meta, err := projects.SerializeMetadata(&projects.Metadata{
+		OperationApproval: &projects.OperationApproval{Window: time.Hour},
+	})
+	require.NoError(t, err)
+
+	pending := db.PendingOperation{
+		ID:          opID,
+		ProjectID:   projectID,
+		Method:      patchProjectMethod,
+		Request:     request,
+		State:       db.PendingOperationStatePending,
+		RequestedBy: "alice",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name              string
+		reviewer          string
+		operation         func() db.PendingOperation
+		expectedErrorCode codes.Code
+	}{
+		{
+			name:              "confirm",
+			reviewer:          "bob",
+			operation:         func() db.PendingOperation { return pending },
+			expectedErrorCode: codes.OK,
+		},
+		{
+			name:              "requester can't confirm",
+			reviewer:          "alice",
+			operation:         func() db.PendingOperation { return pending },
+			expectedErrorCode: codes.PermissionDenied,
+		},
+		{
+			name:     "already cancelled",
+			reviewer: "bob",
+			operation: func() db.PendingOperation {
+				op := pending
+				op.State = db.PendingOperationStateCancelled
+				return op
+			},
+			expectedErrorCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "expired",
+			reviewer: "bob",
+			operation: func() db.PendingOperation {
+				op := pending
+				op.ExpiresAt = time.Now().Add(-time.Minute)
+				return op
+			},
+			expectedErrorCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID, Metadata: meta}, nil).AnyTimes()
+			mockStore.EXPECT().BeginTransaction().AnyTimes()
+			mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore).AnyTimes()
+			mockStore.EXPECT().Rollback(gomock.Any()).AnyTimes()
+			mockStore.EXPECT().Commit(gomock.Any()).AnyTimes()
+			mockStore.EXPECT().GetPendingOperationByIDAndLock(gomock.Any(), db.GetPendingOperationByIDAndLockParams{
+				ID:        opID,
+				ProjectID: projectID,
+			}).Return(tt.operation(), nil)
+			if tt.expectedErrorCode == codes.OK {
+				mockStore.EXPECT().UpdatePendingOperationState(gomock.Any(), db.UpdatePendingOperationStateParams{
+					ID:         opID,
+					State:      db.PendingOperationStateConfirmed,
+					ReviewedBy: sql.NullString{String: tt.reviewer, Valid: true},
+				}).Return(nil)
+				mockStore.EXPECT().UpdateProjectMeta(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpdateProjectMetaParams) (db.Project, error) {
+						return db.Project{ID: projectID, Metadata: arg.Metadata}, nil
+					})
+			}
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			ctx = auth.WithIdentityContext(ctx, &auth.Identity{UserID: tt.reviewer})
+
+			resp, err := srv.ConfirmPendingOperation(ctx, &minderv1.ConfirmPendingOperationRequest{
+				Id: opID.String(),
+			})
+			if tt.expectedErrorCode != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tt.expectedErrorCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, minderv1.PendingOperationState_PENDING_OPERATION_STATE_CONFIRMED,
+				resp.GetOperation().GetState())
+			require.Equal(t, tt.reviewer, resp.GetOperation().GetReviewedBy())
+			require.NotNil(t, resp.GetResponse().GetFields()["project"])
+		})
+	}
+}
+
+func TestCancelPendingOperation(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	opID := uuid.New()
+
+	tests := []struct {
+		name              string
+		reviewer          string
+		admin             bool
+		expectedErrorCode codes.Code
+	}{
+		{
+			name:              "requester cancels",
+			reviewer:          "alice",
+			expectedErrorCode: codes.OK,
+		},
+		{
+			name:              "admin cancels",
+			reviewer:          "bob",
+			admin:             true,
+			expectedErrorCode: codes.OK,
+		},
+		{
+			name:              "other users can't cancel",
+			reviewer:          "bob",
+			expectedErrorCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID}, nil).AnyTimes()
+			mockStore.EXPECT().BeginTransaction().AnyTimes()
+			mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore).AnyTimes()
+			mockStore.EXPECT().Rollback(gomock.Any()).AnyTimes()
+			mockStore.EXPECT().Commit(gomock.Any()).AnyTimes()
+			mockStore.EXPECT().GetPendingOperationByIDAndLock(gomock.Any(), gomock.Any()).
+				Return(db.PendingOperation{
+					ID:          opID,
+					ProjectID:   projectID,
+					Method:      patchProjectMethod,
+					Request:     []byte(`{}`),
+					State:       db.PendingOperationStatePending,
+					RequestedBy: "alice",
+					ExpiresAt:   time.Now().Add(time.Hour),
+				}, nil)
+			if tt.expectedErrorCode == codes.OK {
+				mockStore.EXPECT().UpdatePendingOperationState(gomock.Any(), db.UpdatePendingOperationStateParams{
+					ID:         opID,
+					State:      db.PendingOperationStateCancelled,
+					ReviewedBy: sql.NullString{String: tt.reviewer, Valid: true},
+				}).Return(nil)
+			}
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+			authzClient := &mockauthz.SimpleClient{}
+			if tt.admin {
+				authzClient.Allowed = []uuid.UUID{projectID}
+			}
+			srv.authzClient = authzClient
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			ctx = auth.WithIdentityContext(ctx, &auth.Identity{UserID: tt.reviewer})
+
+			resp, err := srv.CancelPendingOperation(ctx, &minderv1.CancelPendingOperationRequest{
+				Id: opID.String(),
+			})
+			if tt.expectedErrorCode != codes.OK {
+				require.Equal(t, tt.expectedErrorCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, minderv1.PendingOperationState_PENDING_OPERATION_STATE_CANCELLED,
+				resp.GetOperation().GetState())
+		})
+	}
+}
@@ -18,10 +18,12 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/mindersec/minder/internal/db"
+	actionmeta "github.com/mindersec/minder/internal/engine/actions/metadata"
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	"github.com/mindersec/minder/internal/engine/entities"
 	propSvc "github.com/mindersec/minder/internal/entities/properties/service"
 	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/projects"
 	ghprop "github.com/mindersec/minder/internal/providers/github/properties"
 	"github.com/mindersec/minder/internal/util"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -52,6 +54,12 @@ func (s *Server) CreateProfile(ctx context.Context,
 		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
 	}
 
+	err = s.validatePolicyChange(ctx, entityCtx.Project.ID,
+		projects.PolicyChangeKindProfile, projects.PolicyChangeOperationCreate, "", in)
+	if err != nil {
+		return nil, err
+	}
+
 	newProfile, err := db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minderv1.Profile, error) {
 		return s.profiles.CreateProfile(ctx, entityCtx.Project.ID, uuid.Nil, in, qtx)
 	})
@@ -128,6 +136,9 @@ func (s *Server) ListProfiles(ctx context.Context,
 		profile := profileMap[prfName]
 		resp.Profiles = append(resp.Profiles, profile)
 	}
+	if err := prof.PopulateExtends(ctx, s.store, resp.Profiles...); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get extended profiles: %s", err)
+	}
 
 	// Telemetry logging
 	logger.BusinessRecord(ctx).Project = entityCtx.Project.ID
@@ -207,6 +218,9 @@ func (s *Server) GetProfileByName(ctx context.Context,
 
 	// This should be only one profile
 	for _, profile := range pols {
+		if err := prof.PopulateExtends(ctx, s.store, profile); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get extended profiles: %s", err)
+		}
 		return &minderv1.GetProfileByNameResponse{
 			Profile: profile,
 		}, nil
@@ -238,6 +252,9 @@ func getProfilePBFromDB(
 
 	// This should be only one profile
 	for _, profile := range pols {
+		if err := prof.PopulateExtends(ctx, querier, profile); err != nil {
+			return nil, err
+		}
 		return profile, nil
 	}
 
@@ -355,6 +372,13 @@ func (s *Server) getRuleEvalStatus(
 		ReleasePhase:           releasePhase,
 	}
 
+	remediationLink, err := actionmeta.RemediationLink(
+		dbRuleEvalStat.RemMetadata, entityRepoSlug(efp.Entity.Type, efp.Entity.Name))
+	if err != nil {
+		l.Err(err).Msg("error parsing remediation metadata")
+	}
+	st.RemediationLink = actionLinkToPB(remediationLink)
+
 	// If the alert is on and its metadata is valid, parse it and set the URL
 	if st.Alert.Status == string(db.AlertStatusTypesOn) {
 		// Due to the fact that this code was written around the old history tables
@@ -380,7 +404,7 @@ func (s *Server) getRuleEvalStatus(
 				repoPath = fmt.Sprintf("%s/%s", prRepoOwner, prRepoName)
 			}
 		case db.EntitiesBuildEnvironment, db.EntitiesRelease, db.EntitiesPipelineRun,
-			db.EntitiesTaskRun, db.EntitiesBuild:
+			db.EntitiesTaskRun, db.EntitiesBuild, db.EntitiesOrganization:
 			zerolog.Ctx(ctx).Warn().Msgf("attempting to set alerts for unsupported entity type: %v", dbRuleEvalStat.EntityType)
 		default:
 			zerolog.Ctx(ctx).Error().Msgf("unknown entity type: %v", dbRuleEvalStat.EntityType)
@@ -394,6 +418,12 @@ func (s *Server) getRuleEvalStatus(
 		} else {
 			st.Alert.Url = alertURL
 		}
+
+		alertLink, err := actionmeta.AlertLink(dbRuleEvalStat.AlertMetadata, repoPath)
+		if err != nil {
+			l.Err(err).Msg("error parsing alert metadata")
+		}
+		st.Alert.Link = actionLinkToPB(alertLink)
 	}
 	return st, nil
 }
@@ -450,6 +480,12 @@ func (s *Server) PatchProfile(ctx context.Context, ppr *minderv1.PatchProfileReq
 		return nil, util.UserVisibleError(codes.InvalidArgument, "profile ID must be specified")
 	}
 
+	err = s.validatePolicyChange(ctx, entityCtx.Project.ID,
+		projects.PolicyChangeKindProfile, projects.PolicyChangeOperationPatch, ppr.GetId(), patch)
+	if err != nil {
+		return nil, err
+	}
+
 	patchedProfile, err := db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minderv1.Profile, error) {
 		return s.profiles.PatchProfile(ctx, entityCtx.Project.ID, ppr.GetId(), patch, ppr.GetUpdateMask(), qtx)
 	})
@@ -475,6 +511,12 @@ func (s *Server) UpdateProfile(ctx context.Context,
 		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
 	}
 
+	err = s.validatePolicyChange(ctx, entityCtx.Project.ID,
+		projects.PolicyChangeKindProfile, projects.PolicyChangeOperationUpdate, "", in)
+	if err != nil {
+		return nil, err
+	}
+
 	updatedProfile, err := db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minderv1.Profile, error) {
 		return s.profiles.UpdateProfile(ctx, entityCtx.Project.ID, uuid.Nil, in, qtx)
 	})
@@ -527,6 +569,7 @@ func (s *Server) GetProfileStatusByName(
 	return &minderv1.GetProfileStatusByNameResponse{
 		ProfileStatus:        resp.ProfileStatus,
 		RuleEvaluationStatus: resp.RuleEvaluationStatus,
+		CanaryRules:          resp.CanaryRules,
 	}, nil
 }
 
@@ -567,6 +610,7 @@ func (s *Server) GetProfileStatusById(
 	return &minderv1.GetProfileStatusByIdResponse{
 		ProfileStatus:        resp.ProfileStatus,
 		RuleEvaluationStatus: resp.RuleEvaluationStatus,
+		CanaryRules:          resp.CanaryRules,
 	}, nil
 }
 
@@ -595,14 +639,26 @@ func (s *Server) processProfileStatusByName(
 	maybeEntityName := maybeNullString(req.GetEntity().GetName())
 	ruleType := maybeNullString(req.GetRuleType())
 	ruleName := maybeNullString(req.GetRuleName())
+	statuses, err := evalStatusFilter(req.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+	severities, err := severityFilter(req.GetSeverity())
+	if err != nil {
+		return nil, err
+	}
+	entityNamePattern := maybeNullString(globToLikePattern(req.GetEntityNameGlob()))
 
-	if req.GetAll() || maybeEntityID.Valid || maybeEntityName.Valid {
+	if req.GetAll() || maybeEntityID.Valid || maybeEntityName.Valid || entityNamePattern.Valid {
 		dbRuleEvaluationStatuses, err := s.store.ListRuleEvaluationsByProfileId(ctx, db.ListRuleEvaluationsByProfileIdParams{
-			ProfileID:    profileID,
-			EntityID:     maybeEntityID,
-			EntityName:   maybeEntityName,
-			RuleTypeName: ruleType,
-			RuleName:     ruleName,
+			ProfileID:         profileID,
+			EntityID:          maybeEntityID,
+			EntityName:        maybeEntityName,
+			RuleTypeName:      ruleType,
+			RuleName:          ruleName,
+			Statuses:          statuses,
+			EntityNamePattern: entityNamePattern,
+			Severities:        severities,
 		})
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
 			return nil, status.Errorf(codes.Unknown, "failed to list rule evaluation status: %s", err)
@@ -613,6 +669,11 @@ func (s *Server) processProfileStatusByName(
 		)
 	}
 
+	canaryRules, err := s.getCanaryRuleStatuses(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &minderv1.GetProfileStatusByNameResponse{
 		ProfileStatus: &minderv1.ProfileStatus{
 			ProfileId:     profileID.String(),
@@ -621,6 +682,7 @@ func (s *Server) processProfileStatusByName(
 			LastUpdated:   lastUpdated,
 		},
 		RuleEvaluationStatus: ruleEvaluationStatuses,
+		CanaryRules:          canaryRules,
 	}, nil
 }
 
@@ -650,14 +712,26 @@ func (s *Server) processProfileStatusById(
 	maybeEntityName := maybeNullString(req.GetEntity().GetName())
 	ruleType := maybeNullString(req.GetRuleType())
 	ruleName := maybeNullString(req.GetRuleName())
+	statuses, err := evalStatusFilter(req.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+	severities, err := severityFilter(req.GetSeverity())
+	if err != nil {
+		return nil, err
+	}
+	entityNamePattern := maybeNullString(globToLikePattern(req.GetEntityNameGlob()))
 
-	if req.GetAll() || maybeEntityID.Valid || maybeEntityName.Valid {
+	if req.GetAll() || maybeEntityID.Valid || maybeEntityName.Valid || entityNamePattern.Valid {
 		dbRuleEvaluationStatuses, err := s.store.ListRuleEvaluationsByProfileId(ctx, db.ListRuleEvaluationsByProfileIdParams{
-			ProfileID:    profileID,
-			EntityID:     maybeEntityID,
-			EntityName:   maybeEntityName,
-			RuleTypeName: ruleType,
-			RuleName:     ruleName,
+			ProfileID:         profileID,
+			EntityID:          maybeEntityID,
+			EntityName:        maybeEntityName,
+			RuleTypeName:      ruleType,
+			RuleName:          ruleName,
+			Statuses:          statuses,
+			EntityNamePattern: entityNamePattern,
+			Severities:        severities,
 		})
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
 			return nil, status.Errorf(codes.Unknown, "failed to list rule evaluation status: %s", err)
@@ -668,6 +742,11 @@ func (s *Server) processProfileStatusById(
 		)
 	}
 
+	canaryRules, err := s.getCanaryRuleStatuses(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &minderv1.GetProfileStatusByIdResponse{
 		ProfileStatus: &minderv1.ProfileStatus{
 			ProfileId:     profileID.String(),
@@ -676,9 +755,37 @@ func (s *Server) processProfileStatusById(
 			LastUpdated:   lastUpdated,
 		},
 		RuleEvaluationStatus: ruleEvaluationStatuses,
+		CanaryRules:          canaryRules,
 	}, nil
 }
 
+// getCanaryRuleStatuses aggregates the results of the canary rules of the
+// profile, which are not part of the profile status
+func (s *Server) getCanaryRuleStatuses(
+	ctx context.Context,
+	profileID uuid.UUID,
+) ([]*minderv1.CanaryRuleStatus, error) {
+	rows, err := s.store.ListCanaryRuleResults(ctx, profileID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "failed to list canary rule results: %s", err)
+	}
+
+	canaryRules := make([]*minderv1.CanaryRuleStatus, 0, len(rows))
+	for _, row := range rows {
+		canaryRules = append(canaryRules, &minderv1.CanaryRuleStatus{
+			RuleName:     row.RuleName,
+			RuleTypeName: row.RuleTypeName,
+			Entity:       entities.EntityTypeFromDB(row.EntityType),
+			Percentage:   row.CanaryPercentage,
+			Success:      row.SuccessCount,
+			Failure:      row.FailureCount,
+			Error:        row.ErrorCount,
+			Skipped:      row.SkippedCount,
+		})
+	}
+	return canaryRules, nil
+}
+
 func validateEntityType(e *minderv1.EntityTypedId) error {
 	if e != nil {
 		if !e.GetType().IsValid() {
@@ -690,6 +797,73 @@ func validateEntityType(e *minderv1.EntityTypedId) error {
 	return nil
 }
 
+// evalStatusFilter converts the evaluation statuses to filter profile status
+// on to their database type, rejecting unknown statuses.
+func evalStatusFilter(statuses []string) ([]db.EvalStatusTypes, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	res := make([]db.EvalStatusTypes, 0, len(statuses))
+	for _, s := range statuses {
+		st := db.EvalStatusTypes(s)
+		switch st {
+		case db.EvalStatusTypesSuccess, db.EvalStatusTypesFailure, db.EvalStatusTypesError,
+			db.EvalStatusTypesSkipped, db.EvalStatusTypesPending, db.EvalStatusTypesExcepted,
+			db.EvalStatusTypesSuppressed:
+			res = append(res, st)
+		default:
+			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid evaluation status %q", s)
+		}
+	}
+	return res, nil
+}
+
+// severityFilter converts the rule severities to filter profile status on
+// to their database type, rejecting unknown severities.
+func severityFilter(severities []string) ([]db.Severity, error) {
+	if len(severities) == 0 {
+		return nil, nil
+	}
+
+	res := make([]db.Severity, 0, len(severities))
+	for _, s := range severities {
+		sev := db.Severity(strings.ToLower(s))
+		switch sev {
+		case db.SeverityUnknown, db.SeverityInfo, db.SeverityLow, db.SeverityMedium,
+			db.SeverityHigh, db.SeverityCritical:
+			res = append(res, sev)
+		default:
+			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid severity %q", s)
+		}
+	}
+	return res, nil
+}
+
+// globToLikePattern converts a glob, where "*" matches any sequence of
+// characters and "?" a single one, to a SQL LIKE pattern.
+func globToLikePattern(glob string) string {
+	if glob == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteRune('%')
+		case '?':
+			b.WriteRune('_')
+		case '%', '_', '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
 func maybeNullString(s string) sql.NullString {
 	return sql.NullString{
 		String: s,
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/reconcilers"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// DisableProfileRule disables a rule of a profile without removing it from
+// the profile. Disabled rules are reported as skipped until re-enabled.
+func (s *Server) DisableProfileRule(
+	ctx context.Context,
+	in *minderv1.DisableProfileRuleRequest,
+) (*minderv1.DisableProfileRuleResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+	projectID := entityCtx.Project.ID
+
+	disabledBy := auth.IdentityFromContext(ctx).String()
+	rule, err := s.toggleProfileRule(ctx, projectID, in.GetProfile(), in.GetRuleName(), in.GetEntity(),
+		func(qtx db.Querier, id uuid.UUID) (db.RuleInstance, error) {
+			return qtx.DisableRuleInstance(ctx, db.DisableRuleInstanceParams{
+				ID:             id,
+				DisabledBy:     sql.NullString{String: disabledBy, Valid: true},
+				DisabledReason: sql.NullString{String: in.GetReason(), Valid: true},
+			})
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.DisableProfileRuleResponse{
+		Rule: profileRuleStateToPB(in.GetProfile(), rule),
+	}, nil
+}
+
+// EnableProfileRule enables a previously disabled rule of a profile
+func (s *Server) EnableProfileRule(
+	ctx context.Context,
+	in *minderv1.EnableProfileRuleRequest,
+) (*minderv1.EnableProfileRuleResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+	projectID := entityCtx.Project.ID
+
+	rule, err := s.toggleProfileRule(ctx, projectID, in.GetProfile(), in.GetRuleName(), in.GetEntity(),
+		func(qtx db.Querier, id uuid.UUID) (db.RuleInstance, error) {
+			return qtx.EnableRuleInstance(ctx, id)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.EnableProfileRuleResponse{
+		Rule: profileRuleStateToPB(in.GetProfile(), rule),
+	}, nil
+}
+
+// ListDisabledProfileRules lists the disabled rules of the profiles of the
+// project
+func (s *Server) ListDisabledProfileRules(
+	ctx context.Context,
+	_ *minderv1.ListDisabledProfileRulesRequest,
+) (*minderv1.ListDisabledProfileRulesResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	rows, err := s.store.ListDisabledRuleInstances(ctx, entityCtx.Project.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing disabled rules: %v", err)
+	}
+
+	out := make([]*minderv1.ProfileRuleState, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, profileRuleStateToPB(row.ProfileName, db.RuleInstance{
+			Name:           row.Name,
+			EntityType:     row.EntityType,
+			DisabledAt:     row.DisabledAt,
+			DisabledBy:     row.DisabledBy,
+			DisabledReason: row.DisabledReason,
+		}))
+	}
+	return &minderv1.ListDisabledProfileRulesResponse{Rules: out}, nil
+}
+
+// toggleProfileRule looks up the rule of the profile and applies the toggle
+// to it. The entities of the project are then re-evaluated, so that the
+// status of the rule reflects the change.
+func (s *Server) toggleProfileRule(
+	ctx context.Context,
+	projectID uuid.UUID,
+	profileName string,
+	ruleName string,
+	entity minderv1.Entity,
+	toggle func(qtx db.Querier, id uuid.UUID) (db.RuleInstance, error),
+) (db.RuleInstance, error) {
+	if entity != minderv1.Entity_ENTITY_UNSPECIFIED && !entity.IsValid() {
+		return db.RuleInstance{}, util.UserVisibleError(codes.InvalidArgument,
+			"invalid entity type %s, please use one of %s", entity, entities.KnownTypesCSV())
+	}
+
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		return db.RuleInstance{}, status.Errorf(codes.Internal, "error starting transaction: %v", err)
+	}
+	defer s.store.Rollback(tx)
+
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	rules, err := qtx.GetRuleInstancesByProfileName(ctx, db.GetRuleInstancesByProfileNameParams{
+		ProjectID:   projectID,
+		ProfileName: profileName,
+		RuleName:    ruleName,
+	})
+	if err != nil {
+		return db.RuleInstance{}, status.Errorf(codes.Internal, "error getting rule: %v", err)
+	}
+	if entity != minderv1.Entity_ENTITY_UNSPECIFIED {
+		rules = filterRulesByEntity(rules, entities.EntityTypeToDB(entity))
+	}
+	switch {
+	case len(rules) == 0:
+		return db.RuleInstance{}, util.UserVisibleError(codes.NotFound, "rule %q not found in profile %q",
+			ruleName, profileName)
+	case len(rules) > 1:
+		return db.RuleInstance{}, util.UserVisibleError(codes.InvalidArgument,
+			"profile %q has rules named %q for several entity types, please specify the entity type",
+			profileName, ruleName)
+	}
+
+	rule, err := toggle(qtx, rules[0].ID)
+	if err != nil {
+		return db.RuleInstance{}, status.Errorf(codes.Internal, "error updating rule: %v", err)
+	}
+
+	if err := s.store.Commit(tx); err != nil {
+		return db.RuleInstance{}, status.Errorf(codes.Internal, "error committing transaction: %v", err)
+	}
+
+	s.publishProfileInit(ctx, projectID)
+
+	return rule, nil
+}
+
+// publishProfileInit triggers the evaluation of the profiles of the project.
+// Errors are logged, since the rules are evaluated again on the next event.
+func (s *Server) publishProfileInit(ctx context.Context, projectID uuid.UUID) {
+	msg, err := reconcilers.NewProfileInitMessage(projectID)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error creating reconciler event")
+		return
+	}
+	if err := s.evt.Publish(constants.TopicQueueReconcileProfileInit, msg); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error publishing reconciler event")
+	}
+}
+
+func filterRulesByEntity(rules []db.RuleInstance, entityType db.Entities) []db.RuleInstance {
+	out := make([]db.RuleInstance, 0, len(rules))
+	for _, r := range rules {
+		if r.EntityType == entityType {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func profileRuleStateToPB(profileName string, rule db.RuleInstance) *minderv1.ProfileRuleState {
+	out := &minderv1.ProfileRuleState{
+		Profile:        profileName,
+		RuleName:       rule.Name,
+		Entity:         entities.EntityTypeFromDB(rule.EntityType),
+		Disabled:       rule.DisabledAt.Valid,
+		DisabledBy:     rule.DisabledBy.String,
+		DisabledReason: rule.DisabledReason.String,
+	}
+	if rule.DisabledAt.Valid {
+		out.DisabledAt = timestamppb.New(rule.DisabledAt.Time)
+	}
+	return out
+}
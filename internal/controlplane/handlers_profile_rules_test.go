@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	stubeventer "github.com/mindersec/minder/internal/events/stubs"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func TestDisableProfileRule(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	repoRuleID := uuid.New()
+	prRuleID := uuid.New()
+	disabledAt := time.Now()
+
+	repoRule := db.RuleInstance{ID: repoRuleID, Name: "branch_protection", EntityType: db.EntitiesRepository}
+	prRule := db.RuleInstance{ID: prRuleID, Name: "branch_protection", EntityType: db.EntitiesPullRequest}
+
+	tests := []struct {
+		name              string
+		entity            minderv1.Entity
+		rules             []db.RuleInstance
+		expectedRuleID    uuid.UUID
+		expectedErrorCode codes.Code
+	}{
+		{
+			name:           "happy path",
+			rules:          []db.RuleInstance{repoRule},
+			expectedRuleID: repoRuleID,
+		},
+		{
+			name:              "rule not in profile",
+			rules:             []db.RuleInstance{},
+			expectedErrorCode: codes.NotFound,
+		},
+		{
+			name:              "rule name for several entity types",
+			rules:             []db.RuleInstance{repoRule, prRule},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name:           "entity type selects the rule",
+			entity:         minderv1.Entity_ENTITY_PULL_REQUESTS,
+			rules:          []db.RuleInstance{repoRule, prRule},
+			expectedRuleID: prRuleID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID}, nil).AnyTimes()
+			mockStore.EXPECT().BeginTransaction().AnyTimes()
+			mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore).AnyTimes()
+			mockStore.EXPECT().Rollback(gomock.Any()).AnyTimes()
+			mockStore.EXPECT().Commit(gomock.Any()).AnyTimes()
+			mockStore.EXPECT().GetRuleInstancesByProfileName(gomock.Any(), db.GetRuleInstancesByProfileNameParams{
+				ProjectID:   projectID,
+				ProfileName: "my-profile",
+				RuleName:    "branch_protection",
+			}).Return(tt.rules, nil)
+			if tt.expectedRuleID != uuid.Nil {
+				mockStore.EXPECT().DisableRuleInstance(gomock.Any(), db.DisableRuleInstanceParams{
+					ID:             tt.expectedRuleID,
+					DisabledBy:     sql.NullString{String: "alice", Valid: true},
+					DisabledReason: sql.NullString{String: "too noisy", Valid: true},
+				}).Return(db.RuleInstance{
+					ID:             tt.expectedRuleID,
+					Name:           "branch_protection",
+					EntityType:     db.EntitiesRepository,
+					DisabledAt:     sql.NullTime{Time: disabledAt, Valid: true},
+					DisabledBy:     sql.NullString{String: "alice", Valid: true},
+					DisabledReason: sql.NullString{String: "too noisy", Valid: true},
+				}, nil)
+			}
+
+			evts := &stubeventer.StubEventer{}
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+			srv.evt = evts
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			ctx = auth.WithIdentityContext(ctx, &auth.Identity{UserID: "alice"})
+
+			resp, err := srv.DisableProfileRule(ctx, &minderv1.DisableProfileRuleRequest{
+				Profile:  "my-profile",
+				RuleName: "branch_protection",
+				Entity:   tt.entity,
+				Reason:   "too noisy",
+			})
+			if tt.expectedErrorCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedErrorCode, st.Code())
+				require.Empty(t, evts.Sent)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.GetRule().GetDisabled())
+			require.Equal(t, "alice", resp.GetRule().GetDisabledBy())
+			require.Equal(t, "too noisy", resp.GetRule().GetDisabledReason())
+			require.Equal(t, "my-profile", resp.GetRule().GetProfile())
+			require.Equal(t, []string{constants.TopicQueueReconcileProfileInit}, evts.Topics)
+		})
+	}
+}
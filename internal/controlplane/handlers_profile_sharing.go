@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// profileStatusShare is the content of a profile status share link. It is
+// encrypted with the crypto engine, so it can't be forged or altered. The ID
+// references the stored link, which is deleted when the link is revoked.
+type profileStatusShare struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"project_id"`
+	ProfileID uuid.UUID `json:"profile_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CreateProfileStatusShareLink creates an expiring link which gives read-only
+// access to the status of a profile without authentication
+func (s *Server) CreateProfileStatusShareLink(
+	ctx context.Context,
+	in *minderv1.CreateProfileStatusShareLinkRequest,
+) (*minderv1.CreateProfileStatusShareLinkResponse, error) {
+	if in.GetId() == "" {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "profile id cannot be empty")
+	}
+
+	cfg := s.cfg.ProfileStatusSharing
+	expiresIn := cfg.DefaultExpiration
+	if in.GetExpiresIn() != nil {
+		expiresIn = in.GetExpiresIn().AsDuration()
+	}
+	if expiresIn <= 0 {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "expiration must be positive")
+	}
+	if cfg.MaxExpiration > 0 && expiresIn > cfg.MaxExpiration {
+		return nil, util.UserVisibleError(codes.InvalidArgument,
+			"expiration cannot be longer than %s", cfg.MaxExpiration)
+	}
+
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+	profile, err := s.store.GetProfileStatusByIdAndProject(ctx, db.GetProfileStatusByIdAndProjectParams{
+		ProjectID: projectID,
+		ID:        uuid.MustParse(in.GetId()),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, util.UserVisibleError(codes.NotFound, "profile %q status not found", in.GetId())
+		}
+		return nil, status.Errorf(codes.Unknown, "failed to get profile: %s", err)
+	}
+
+	// The expired links can't be used anymore, clean them up while here
+	if err := s.store.DeleteExpiredProfileStatusShareLinks(ctx, projectID); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error deleting expired profile status share links")
+	}
+
+	link, err := s.store.CreateProfileStatusShareLink(ctx, db.CreateProfileStatusShareLinkParams{
+		ProjectID: projectID,
+		ProfileID: profile.ID,
+		ExpiresAt: time.Now().Add(expiresIn).UTC().Truncate(time.Second),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error storing share link: %v", err)
+	}
+
+	share := profileStatusShare{
+		ID:        link.ID,
+		ProjectID: projectID,
+		ProfileID: profile.ID,
+		ExpiresAt: link.ExpiresAt,
+	}
+	token, err := encodeProfileStatusShare(s.cryptoEngine, &share)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating share link: %v", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Str("profile_id", profile.ID.String()).
+		Str("share_link_id", link.ID.String()).
+		Time("expires_at", share.ExpiresAt).
+		Msg("created profile status share link")
+
+	return &minderv1.CreateProfileStatusShareLinkResponse{
+		Id:        link.ID.String(),
+		Token:     token,
+		Url:       profileStatusShareURL(cfg.BaseURL, token),
+		ExpiresAt: timestamppb.New(share.ExpiresAt),
+	}, nil
+}
+
+// RevokeProfileStatusShareLink revokes a profile status share link before it
+// expires
+func (s *Server) RevokeProfileStatusShareLink(
+	ctx context.Context,
+	in *minderv1.RevokeProfileStatusShareLinkRequest,
+) (*minderv1.RevokeProfileStatusShareLinkResponse, error) {
+	linkID, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid share link id")
+	}
+
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+	_, err = s.store.DeleteProfileStatusShareLink(ctx, db.DeleteProfileStatusShareLinkParams{
+		ID:        linkID,
+		ProjectID: projectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.NotFound, "share link %q not found", in.GetId())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error revoking share link: %v", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Str("share_link_id", linkID.String()).
+		Msg("revoked profile status share link")
+
+	return &minderv1.RevokeProfileStatusShareLinkResponse{}, nil
+}
+
+// GetSharedProfileStatus returns the status of the profile referenced by a
+// share link. It doesn't require authentication.
+func (s *Server) GetSharedProfileStatus(
+	ctx context.Context,
+	in *minderv1.GetSharedProfileStatusRequest,
+) (*minderv1.GetSharedProfileStatusResponse, error) {
+	share, err := decodeProfileStatusShare(s.cryptoEngine, in.GetToken())
+	if err != nil {
+		zerolog.Ctx(ctx).Debug().Err(err).Msg("invalid profile status share link")
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid share link")
+	}
+	if time.Now().After(share.ExpiresAt) {
+		return nil, util.UserVisibleError(codes.PermissionDenied, "share link has expired")
+	}
+
+	// The link must still be stored: it is deleted when revoked
+	link, err := s.store.GetProfileStatusShareLink(ctx, db.GetProfileStatusShareLinkParams{
+		ID:        share.ID,
+		ProjectID: share.ProjectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.PermissionDenied, "share link has been revoked")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting share link: %v", err)
+	}
+	if link.ProfileID != share.ProfileID {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid share link")
+	}
+
+	dbProfileStatus, err := s.store.GetProfileStatusByIdAndProject(ctx, db.GetProfileStatusByIdAndProjectParams{
+		ProjectID: share.ProjectID,
+		ID:        share.ProfileID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, util.UserVisibleError(codes.NotFound, "shared profile status not found")
+		}
+		return nil, status.Errorf(codes.Unknown, "failed to get profile: %s", err)
+	}
+
+	// The link is not tied to a user, so scope the request to the project
+	// the link was created in.
+	ctx = engcontext.WithEntityContext(ctx, &engcontext.EntityContext{
+		Project: engcontext.Project{ID: share.ProjectID},
+	})
+	resp, err := s.processProfileStatusById(ctx, dbProfileStatus.Name, dbProfileStatus.ID,
+		timestamppb.New(dbProfileStatus.LastUpdated), string(dbProfileStatus.ProfileStatus),
+		&minderv1.GetProfileStatusByIdRequest{All: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.GetSharedProfileStatusResponse{
+		ProfileStatus:        resp.ProfileStatus,
+		RuleEvaluationStatus: resp.RuleEvaluationStatus,
+		ExpiresAt:            timestamppb.New(share.ExpiresAt),
+	}, nil
+}
+
+func encodeProfileStatusShare(engine crypto.Engine, share *profileStatusShare) (string, error) {
+	payload, err := json.Marshal(share)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling share: %w", err)
+	}
+	encrypted, err := engine.EncryptString(string(payload))
+	if err != nil {
+		return "", err
+	}
+	serialized, err := encrypted.Serialize()
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(serialized), nil
+}
+
+func decodeProfileStatusShare(engine crypto.Engine, token string) (*profileStatusShare, error) {
+	serialized, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding token: %w", err)
+	}
+	encrypted, err := crypto.DeserializeEncryptedData(serialized)
+	if err != nil {
+		return nil, err
+	}
+	// Only accept authenticated encryption, the fallback algorithm doesn't
+	// detect tampering.
+	if encrypted.Algorithm != crypto.DefaultAlgorithm {
+		return nil, fmt.Errorf("unexpected algorithm %q", encrypted.Algorithm)
+	}
+	payload, err := engine.DecryptString(encrypted)
+	if err != nil {
+		return nil, err
+	}
+	var share profileStatusShare
+	if err := json.Unmarshal([]byte(payload), &share); err != nil {
+		return nil, fmt.Errorf("error unmarshalling share: %w", err)
+	}
+	return &share, nil
+}
+
+func profileStatusShareURL(baseURL, token string) string {
+	if baseURL == "" {
+		return ""
+	}
+	u, err := url.JoinPath(baseURL, "/api/v1/shared/profile_status", token)
+	if err != nil {
+		return ""
+	}
+	return u
+}
@@ -0,0 +1,433 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/authz"
+	"github.com/mindersec/minder/internal/authz/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestProfileStatusShareLink(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	profile := db.GetProfileStatusByIdAndProjectRow{
+		ID:            uuid.New(),
+		Name:          "acme-baseline",
+		ProfileStatus: db.EvalStatusTypesFailure,
+		LastUpdated:   time.Now(),
+	}
+	sharingCfg := serverconfig.ProfileStatusSharingConfig{
+		DefaultExpiration: time.Hour,
+		MaxExpiration:     24 * time.Hour,
+		BaseURL:           "https://api.example.com",
+	}
+	linkID := uuid.New()
+	createShareLink := func(
+		_ context.Context, arg db.CreateProfileStatusShareLinkParams,
+	) (db.ProfileStatusShareLink, error) {
+		return db.ProfileStatusShareLink{
+			ID:        linkID,
+			ProjectID: arg.ProjectID,
+			ProfileID: arg.ProfileID,
+			ExpiresAt: arg.ExpiresAt,
+		}, nil
+	}
+
+	tests := []struct {
+		name      string
+		expiresIn *durationpb.Duration
+		profileID uuid.UUID
+		setup     func(store *mockdb.MockStore)
+		wantErr   string
+	}{
+		{
+			name:      "creates a link with the default expiration",
+			profileID: profile.ID,
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetProfileStatusByIdAndProject(gomock.Any(), db.GetProfileStatusByIdAndProjectParams{
+						ProjectID: projectID,
+						ID:        profile.ID,
+					}).
+					Return(profile, nil)
+				store.EXPECT().
+					DeleteExpiredProfileStatusShareLinks(gomock.Any(), projectID).
+					Return(nil)
+				store.EXPECT().
+					CreateProfileStatusShareLink(gomock.Any(), gomock.Any()).
+					DoAndReturn(createShareLink)
+			},
+		},
+		{
+			name:      "creates a link with a custom expiration",
+			expiresIn: durationpb.New(12 * time.Hour),
+			profileID: profile.ID,
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetProfileStatusByIdAndProject(gomock.Any(), gomock.Any()).
+					Return(profile, nil)
+				store.EXPECT().
+					DeleteExpiredProfileStatusShareLinks(gomock.Any(), projectID).
+					Return(nil)
+				store.EXPECT().
+					CreateProfileStatusShareLink(gomock.Any(), gomock.Any()).
+					DoAndReturn(createShareLink)
+			},
+		},
+		{
+			name:      "expiration exceeds the maximum",
+			expiresIn: durationpb.New(48 * time.Hour),
+			profileID: profile.ID,
+			wantErr:   "expiration cannot be longer than 24h0m0s",
+		},
+		{
+			name:      "negative expiration",
+			expiresIn: durationpb.New(-time.Hour),
+			profileID: profile.ID,
+			wantErr:   "expiration must be positive",
+		},
+		{
+			name:      "profile not found",
+			profileID: uuid.New(),
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetProfileStatusByIdAndProject(gomock.Any(), gomock.Any()).
+					Return(db.GetProfileStatusByIdAndProjectRow{}, sql.ErrNoRows)
+			},
+			wantErr: "status not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			if tt.setup != nil {
+				tt.setup(store)
+			}
+			srv := newDefaultServer(t, store, nil, nil, nil)
+			srv.cfg.ProfileStatusSharing = sharingCfg
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			res, err := srv.CreateProfileStatusShareLink(ctx, &minderv1.CreateProfileStatusShareLinkRequest{
+				Id:        tt.profileID.String(),
+				ExpiresIn: tt.expiresIn,
+			})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, res.GetToken())
+			require.Equal(t, "https://api.example.com/api/v1/shared/profile_status/"+res.GetToken(), res.GetUrl())
+
+			expiresIn := sharingCfg.DefaultExpiration
+			if tt.expiresIn != nil {
+				expiresIn = tt.expiresIn.AsDuration()
+			}
+			require.WithinDuration(t, time.Now().Add(expiresIn), res.GetExpiresAt().AsTime(), time.Minute)
+
+			share, err := decodeProfileStatusShare(srv.cryptoEngine, res.GetToken())
+			require.NoError(t, err)
+			require.Equal(t, linkID, share.ID)
+			require.Equal(t, linkID.String(), res.GetId())
+			require.Equal(t, projectID, share.ProjectID)
+			require.Equal(t, profile.ID, share.ProfileID)
+		})
+	}
+}
+
+func TestGetSharedProfileStatus(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	linkID := uuid.New()
+	profile := db.GetProfileStatusByIdAndProjectRow{
+		ID:            uuid.New(),
+		Name:          "acme-baseline",
+		ProfileStatus: db.EvalStatusTypesFailure,
+		LastUpdated:   time.Now(),
+	}
+
+	tests := []struct {
+		name    string
+		token   func(t *testing.T, srv *Server) string
+		setup   func(store *mockdb.MockStore)
+		wantErr string
+	}{
+		{
+			name: "returns the profile status",
+			token: func(t *testing.T, srv *Server) string {
+				t.Helper()
+				return shareToken(t, srv, linkID, projectID, profile.ID, time.Now().Add(time.Hour))
+			},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetProfileStatusShareLink(gomock.Any(), db.GetProfileStatusShareLinkParams{
+						ID:        linkID,
+						ProjectID: projectID,
+					}).
+					Return(db.ProfileStatusShareLink{ID: linkID, ProjectID: projectID, ProfileID: profile.ID}, nil)
+				store.EXPECT().
+					GetProfileStatusByIdAndProject(gomock.Any(), db.GetProfileStatusByIdAndProjectParams{
+						ProjectID: projectID,
+						ID:        profile.ID,
+					}).
+					Return(profile, nil)
+				store.EXPECT().
+					ListRuleEvaluationsByProfileId(gomock.Any(), gomock.Any()).
+					Return([]db.ListRuleEvaluationsByProfileIdRow{}, nil)
+				store.EXPECT().
+					ListCanaryRuleResults(gomock.Any(), profile.ID).
+					Return([]db.ListCanaryRuleResultsRow{}, nil)
+			},
+		},
+		{
+			name: "expired link",
+			token: func(t *testing.T, srv *Server) string {
+				t.Helper()
+				return shareToken(t, srv, linkID, projectID, profile.ID, time.Now().Add(-time.Minute))
+			},
+			wantErr: "share link has expired",
+		},
+		{
+			name: "revoked link",
+			token: func(t *testing.T, srv *Server) string {
+				t.Helper()
+				return shareToken(t, srv, linkID, projectID, profile.ID, time.Now().Add(time.Hour))
+			},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetProfileStatusShareLink(gomock.Any(), gomock.Any()).
+					Return(db.ProfileStatusShareLink{}, sql.ErrNoRows)
+			},
+			wantErr: "share link has been revoked",
+		},
+		{
+			name: "link of another profile",
+			token: func(t *testing.T, srv *Server) string {
+				t.Helper()
+				return shareToken(t, srv, linkID, projectID, profile.ID, time.Now().Add(time.Hour))
+			},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetProfileStatusShareLink(gomock.Any(), gomock.Any()).
+					Return(db.ProfileStatusShareLink{ID: linkID, ProjectID: projectID, ProfileID: uuid.New()}, nil)
+			},
+			wantErr: "invalid share link",
+		},
+		{
+			name: "tampered link",
+			token: func(t *testing.T, srv *Server) string {
+				t.Helper()
+				token := shareToken(t, srv, linkID, projectID, profile.ID, time.Now().Add(time.Hour))
+				return token[:len(token)-4] + "AAAA"
+			},
+			wantErr: "invalid share link",
+		},
+		{
+			name: "garbage link",
+			token: func(_ *testing.T, _ *Server) string {
+				return "not-a-token"
+			},
+			wantErr: "invalid share link",
+		},
+		{
+			name: "deleted profile",
+			token: func(t *testing.T, srv *Server) string {
+				t.Helper()
+				return shareToken(t, srv, linkID, projectID, profile.ID, time.Now().Add(time.Hour))
+			},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetProfileStatusShareLink(gomock.Any(), gomock.Any()).
+					Return(db.ProfileStatusShareLink{ID: linkID, ProjectID: projectID, ProfileID: profile.ID}, nil)
+				store.EXPECT().
+					GetProfileStatusByIdAndProject(gomock.Any(), gomock.Any()).
+					Return(db.GetProfileStatusByIdAndProjectRow{}, sql.ErrNoRows)
+			},
+			wantErr: "shared profile status not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			if tt.setup != nil {
+				tt.setup(store)
+			}
+			srv := newDefaultServer(t, store, nil, nil, nil)
+
+			res, err := srv.GetSharedProfileStatus(context.Background(), &minderv1.GetSharedProfileStatusRequest{
+				Token: tt.token(t, srv),
+			})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, profile.ID.String(), res.GetProfileStatus().GetProfileId())
+			require.Equal(t, profile.Name, res.GetProfileStatus().GetProfileName())
+			require.Equal(t, string(profile.ProfileStatus), res.GetProfileStatus().GetProfileStatus())
+		})
+	}
+}
+
+func TestRevokeProfileStatusShareLink(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	linkID := uuid.New()
+
+	tests := []struct {
+		name    string
+		id      string
+		setup   func(store *mockdb.MockStore)
+		wantErr string
+	}{
+		{
+			name: "revokes the link",
+			id:   linkID.String(),
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteProfileStatusShareLink(gomock.Any(), db.DeleteProfileStatusShareLinkParams{
+						ID:        linkID,
+						ProjectID: projectID,
+					}).
+					Return(db.ProfileStatusShareLink{ID: linkID}, nil)
+			},
+		},
+		{
+			name: "link not found",
+			id:   linkID.String(),
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteProfileStatusShareLink(gomock.Any(), gomock.Any()).
+					Return(db.ProfileStatusShareLink{}, sql.ErrNoRows)
+			},
+			wantErr: "not found",
+		},
+		{
+			name:    "invalid id",
+			id:      "not-a-uuid",
+			wantErr: "invalid share link id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			if tt.setup != nil {
+				tt.setup(store)
+			}
+			srv := newDefaultServer(t, store, nil, nil, nil)
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			_, err := srv.RevokeProfileStatusShareLink(ctx, &minderv1.RevokeProfileStatusShareLinkRequest{
+				Id: tt.id,
+			})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// viewerAuthzClient only grants the relations a project viewer holds
+type viewerAuthzClient struct {
+	mock.SimpleClient
+}
+
+func (v *viewerAuthzClient) Check(ctx context.Context, action string, project uuid.UUID) error {
+	switch action {
+	case "get", "profile_get", "profile_status_get":
+		return v.SimpleClient.Check(ctx, action, project)
+	}
+	return authz.ErrNotAuthorized
+}
+
+// TestProfileStatusShareLinkViewerRejected checks that viewers, who can read
+// the profile status, can't publish it through a share link or revoke one
+func TestProfileStatusShareLinkViewerRejected(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	for _, method := range []string{
+		minderv1.ProfileService_CreateProfileStatusShareLink_FullMethodName,
+		minderv1.ProfileService_RevokeProfileStatusShareLink_FullMethodName,
+	} {
+		t.Run(method, func(t *testing.T) {
+			t.Parallel()
+
+			info := &grpc.UnaryServerInfo{
+				FullMethod: method,
+				Server: &Server{
+					authzClient: &viewerAuthzClient{
+						SimpleClient: mock.SimpleClient{Allowed: []uuid.UUID{projectID}},
+					},
+					cfg: &serverconfig.Config{},
+				},
+			}
+			opts, err := optionsForMethod(info)
+			require.NoError(t, err)
+
+			ctx := withRpcOptions(context.Background(), opts)
+			ctx = engcontext.WithEntityContext(ctx, &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			ctx = auth.WithIdentityContext(ctx, &auth.Identity{UserID: "viewer"})
+			_, err = ProjectAuthorizationInterceptor(ctx, nil, info, func(context.Context, any) (any, error) {
+				t.Fatal("handler called for a viewer")
+				return nil, nil
+			})
+			require.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+	}
+}
+
+func shareToken(t *testing.T, srv *Server, linkID, projectID, profileID uuid.UUID, expiresAt time.Time) string {
+	t.Helper()
+	token, err := encodeProfileStatusShare(srv.cryptoEngine, &profileStatusShare{
+		ID:        linkID,
+		ProjectID: projectID,
+		ProfileID: profileID,
+		ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+	return token
+}
@@ -1196,6 +1196,43 @@ func TestGetProfileStatusByName(t *testing.T) {
 		require.Equal(t, expectedProfileName, resp.ProfileStatus.ProfileName, "Profile name should match")
 	})
 
+	t.Run("Invalid evaluation status filter", func(t *testing.T) {
+		t.Parallel()
+		req := &minderv1.GetProfileStatusByNameRequest{
+			Name:   expectedProfileName,
+			All:    true,
+			Status: []string{"excepted", "ignored"},
+		}
+
+		_, err := s.GetProfileStatusByName(ctx, req)
+		require.ErrorContains(t, err, `invalid evaluation status "ignored"`)
+	})
+
+	t.Run("Filter by entity name glob and severity", func(t *testing.T) {
+		t.Parallel()
+		req := &minderv1.GetProfileStatusByNameRequest{
+			Name:           expectedProfileName,
+			EntityNameGlob: "acme-corp/*",
+			Severity:       []string{"high", "CRITICAL"},
+		}
+
+		resp, err := s.GetProfileStatusByName(ctx, req)
+		require.NoError(t, err)
+		require.Empty(t, resp.RuleEvaluationStatus)
+	})
+
+	t.Run("Invalid severity filter", func(t *testing.T) {
+		t.Parallel()
+		req := &minderv1.GetProfileStatusByNameRequest{
+			Name:     expectedProfileName,
+			All:      true,
+			Severity: []string{"high", "urgent"},
+		}
+
+		_, err := s.GetProfileStatusByName(ctx, req)
+		require.ErrorContains(t, err, `invalid severity "urgent"`)
+	})
+
 	// TODO: add test case for requesting evaluation details
 }
 
@@ -1253,9 +1290,36 @@ func TestGetProfileStatusById(t *testing.T) {
 		require.Equal(t, expectedProfileName, resp.ProfileStatus.ProfileName, "Profile name should match")
 	})
 
+	t.Run("Invalid evaluation status filter", func(t *testing.T) {
+		t.Parallel()
+		req := &minderv1.GetProfileStatusByIdRequest{
+			Id:     dbProfile.ID.String(),
+			All:    true,
+			Status: []string{"excepted", "ignored"},
+		}
+
+		_, err := s.GetProfileStatusById(ctx, req)
+		require.ErrorContains(t, err, `invalid evaluation status "ignored"`)
+	})
+
 	// TODO: add test case for requesting evaluation details
 }
 
+func TestGlobToLikePattern(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"":                  "",
+		"acme-corp/*":       "acme-corp/%",
+		"acme-corp/repo-?":  "acme-corp/repo-_",
+		"acme_corp/100%":    `acme\_corp/100\%`,
+		`acme\corp/*-tools`: `acme\\corp/%-tools`,
+	}
+	for glob, want := range tests {
+		require.Equal(t, want, globToLikePattern(glob), glob)
+	}
+}
+
 type deleteProfileTestCase struct {
 	name    string
 	req     *minderv1.DeleteProfileRequest
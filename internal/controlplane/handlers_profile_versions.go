@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/projects"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// ListProfileVersions lists the previous definitions of a profile, the most
+// recent first
+func (s *Server) ListProfileVersions(
+	ctx context.Context,
+	in *minderv1.ListProfileVersionsRequest,
+) (*minderv1.ListProfileVersionsResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	profile, err := s.getProfileByName(ctx, entityCtx.Project.ID, in.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.store.ListProfileVersions(ctx, profile.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing profile versions: %v", err)
+	}
+
+	out := make([]*minderv1.ProfileVersion, 0, len(versions))
+	for i := range versions {
+		v, err := profileVersionToPB(&versions[i])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error decoding profile version %d: %v", versions[i].Version, err)
+		}
+		out = append(out, v)
+	}
+	return &minderv1.ListProfileVersionsResponse{Versions: out}, nil
+}
+
+// RollbackProfile restores a previous definition of a profile. The rollback
+// goes through the same validation as any other update of the profile, and
+// records the definition it replaces as a new version, so it can be reverted
+// as well.
+func (s *Server) RollbackProfile(
+	ctx context.Context,
+	in *minderv1.RollbackProfileRequest,
+) (*minderv1.RollbackProfileResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+	projectID := entityCtx.Project.ID
+
+	profile, err := s.getProfileByName(ctx, projectID, in.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.store.GetProfileVersion(ctx, db.GetProfileVersionParams{
+		ProfileID: profile.ID,
+		Version:   in.GetVersion(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, util.UserVisibleError(codes.NotFound, "version %d of profile %q not found",
+				in.GetVersion(), in.GetName())
+		}
+		return nil, status.Errorf(codes.Internal, "error getting profile version: %v", err)
+	}
+
+	restored := &minderv1.Profile{}
+	if err := protojson.Unmarshal(version.Definition, restored); err != nil {
+		return nil, status.Errorf(codes.Internal, "error decoding profile version: %v", err)
+	}
+	// the profile is updated in place, whatever was recorded in the version
+	restored.Id = ptr.Ptr(profile.ID.String())
+	restored.Name = profile.Name
+	restored.Context = nil
+
+	err = s.validatePolicyChange(ctx, projectID,
+		projects.PolicyChangeKindProfile, projects.PolicyChangeOperationUpdate, "", restored)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedProfile, err := db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minderv1.Profile, error) {
+		return s.profiles.UpdateProfile(ctx, projectID, uuid.Nil, restored, qtx)
+	})
+	if err != nil {
+		// assumption: service layer sets sensible errors
+		return nil, err
+	}
+
+	return &minderv1.RollbackProfileResponse{Profile: updatedProfile}, nil
+}
+
+func (s *Server) getProfileByName(ctx context.Context, projectID uuid.UUID, name string) (db.Profile, error) {
+	profile, err := s.store.GetProfileByName(ctx, db.GetProfileByNameParams{
+		ProjectID: projectID,
+		Name:      name,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.Profile{}, util.UserVisibleError(codes.NotFound, "profile %q not found", name)
+		}
+		return db.Profile{}, status.Errorf(codes.Internal, "error getting profile: %v", err)
+	}
+	return profile, nil
+}
+
+func profileVersionToPB(v *db.ProfileVersion) (*minderv1.ProfileVersion, error) {
+	profile := &minderv1.Profile{}
+	if err := protojson.Unmarshal(v.Definition, profile); err != nil {
+		return nil, err
+	}
+	return &minderv1.ProfileVersion{
+		Version:   v.Version,
+		Author:    v.Author,
+		CreatedAt: timestamppb.New(v.CreatedAt),
+		Profile:   profile,
+	}, nil
+}
@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mock_profiles "github.com/mindersec/minder/pkg/profiles/mock"
+)
+
+func TestListProfileVersions(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	profileID := uuid.New()
+	replacedAt := time.Now()
+
+	previous := &minderv1.Profile{
+		Name:       "my-profile",
+		Remediate:  ptr.Ptr("off"),
+		Repository: []*minderv1.Profile_Rule{{Type: "secret_scanning", Name: "secret_scanning"}},
+	}
+	definition, err := protojson.Marshal(previous)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		setup             func(*mockdb.MockStore)
+		expectedVersions  int
+		expectedErrorCode codes.Code
+	}{
+		{
+			name: "lists the versions",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProfileByName(gomock.Any(), db.GetProfileByNameParams{
+					ProjectID: projectID,
+					Name:      "my-profile",
+				}).Return(db.Profile{ID: profileID, Name: "my-profile"}, nil)
+				store.EXPECT().ListProfileVersions(gomock.Any(), profileID).
+					Return([]db.ProfileVersion{
+						{ProfileID: profileID, Version: 2, Definition: definition, Author: "bob", CreatedAt: replacedAt},
+						{ProfileID: profileID, Version: 1, Definition: definition, Author: "alice", CreatedAt: replacedAt},
+					}, nil)
+			},
+			expectedVersions: 2,
+		},
+		{
+			name: "profile not found",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProfileByName(gomock.Any(), gomock.Any()).
+					Return(db.Profile{}, sql.ErrNoRows)
+			},
+			expectedErrorCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID}, nil).AnyTimes()
+			tt.setup(mockStore)
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			resp, err := srv.ListProfileVersions(ctx, &minderv1.ListProfileVersionsRequest{Name: "my-profile"})
+			if tt.expectedErrorCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedErrorCode, st.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.GetVersions(), tt.expectedVersions)
+			require.Equal(t, int32(2), resp.GetVersions()[0].GetVersion())
+			require.Equal(t, "bob", resp.GetVersions()[0].GetAuthor())
+			require.True(t, proto.Equal(previous, resp.GetVersions()[0].GetProfile()))
+		})
+	}
+}
+
+func TestRollbackProfile(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	profileID := uuid.New()
+
+	definition, err := protojson.Marshal(&minderv1.Profile{
+		Id:         ptr.Ptr(profileID.String()),
+		Name:       "my-profile",
+		Context:    &minderv1.Context{Project: ptr.Ptr(projectID.String())},
+		Remediate:  ptr.Ptr("off"),
+		Repository: []*minderv1.Profile_Rule{{Type: "secret_scanning", Name: "secret_scanning"}},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		version           int32
+		setup             func(*mockdb.MockStore, *mock_profiles.MockProfileService)
+		expectedErrorCode codes.Code
+	}{
+		{
+			name:    "restores the version",
+			version: 1,
+			setup: func(store *mockdb.MockStore, profiles *mock_profiles.MockProfileService) {
+				store.EXPECT().GetProfileVersion(gomock.Any(), db.GetProfileVersionParams{
+					ProfileID: profileID,
+					Version:   1,
+				}).Return(db.ProfileVersion{ProfileID: profileID, Version: 1, Definition: definition}, nil)
+				store.EXPECT().GetParentProjects(gomock.Any(), projectID).Return([]uuid.UUID{projectID}, nil)
+				store.EXPECT().BeginTransaction()
+				store.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(store)
+				store.EXPECT().Rollback(gomock.Any())
+				store.EXPECT().Commit(gomock.Any())
+				profiles.EXPECT().UpdateProfile(gomock.Any(), projectID, uuid.Nil, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID, p *minderv1.Profile, _ db.Querier) (*minderv1.Profile, error) {
+						require.Equal(t, profileID.String(), p.GetId())
+						require.Nil(t, p.GetContext())
+						require.Equal(t, "secret_scanning", p.GetRepository()[0].GetType())
+						return p, nil
+					})
+			},
+		},
+		{
+			name:    "version not found",
+			version: 3,
+			setup: func(store *mockdb.MockStore, _ *mock_profiles.MockProfileService) {
+				store.EXPECT().GetProfileVersion(gomock.Any(), gomock.Any()).
+					Return(db.ProfileVersion{}, sql.ErrNoRows)
+			},
+			expectedErrorCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockProfiles := mock_profiles.NewMockProfileService(ctrl)
+			mockStore.EXPECT().GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID, Metadata: []byte(`{}`)}, nil).AnyTimes()
+			mockStore.EXPECT().GetProfileByName(gomock.Any(), db.GetProfileByNameParams{
+				ProjectID: projectID,
+				Name:      "my-profile",
+			}).Return(db.Profile{ID: profileID, Name: "my-profile"}, nil)
+			tt.setup(mockStore, mockProfiles)
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+			srv.profiles = mockProfiles
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			resp, err := srv.RollbackProfile(ctx, &minderv1.RollbackProfileRequest{
+				Name:    "my-profile",
+				Version: tt.version,
+			})
+			if tt.expectedErrorCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedErrorCode, st.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, profileID.String(), resp.GetProfile().GetId())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/secrets"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// SetProjectSecret creates or replaces a secret of the project
+func (s *Server) SetProjectSecret(
+	ctx context.Context,
+	in *minderv1.SetProjectSecretRequest,
+) (*minderv1.SetProjectSecretResponse, error) {
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+
+	err := s.projectSecrets().SetSecret(ctx, projectID, in.GetName(), in.GetValue())
+	if errors.Is(err, secrets.ErrInvalidSecretName) {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error storing secret: %v", err)
+	}
+
+	return &minderv1.SetProjectSecretResponse{}, nil
+}
+
+// ListProjectSecrets lists the names of the secrets of the project
+func (s *Server) ListProjectSecrets(
+	ctx context.Context,
+	_ *minderv1.ListProjectSecretsRequest,
+) (*minderv1.ListProjectSecretsResponse, error) {
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+
+	names, err := s.projectSecrets().ListSecrets(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing secrets: %v", err)
+	}
+
+	return &minderv1.ListProjectSecretsResponse{Names: names}, nil
+}
+
+// DeleteProjectSecret deletes a secret of the project
+func (s *Server) DeleteProjectSecret(
+	ctx context.Context,
+	in *minderv1.DeleteProjectSecretRequest,
+) (*minderv1.DeleteProjectSecretResponse, error) {
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+
+	err := s.projectSecrets().DeleteSecret(ctx, projectID, in.GetName())
+	if errors.Is(err, secrets.ErrSecretNotFound) {
+		return nil, util.UserVisibleError(codes.NotFound, "secret %s not found", in.GetName())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error deleting secret: %v", err)
+	}
+
+	return &minderv1.DeleteProjectSecretResponse{}, nil
+}
+
+func (s *Server) projectSecrets() secrets.Service {
+	return secrets.NewSecretService(s.store, s.cryptoEngine)
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestSetProjectSecret(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		secretName string
+		setup      func(*mockdb.MockStore)
+		wantCode   codes.Code
+	}{
+		{
+			name:       "stores the encrypted secret",
+			secretName: "api-token",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertProjectSecret(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpsertProjectSecretParams) (db.ProjectSecret, error) {
+						require.Equal(t, "api-token", arg.Name)
+						require.NotContains(t, string(arg.EncryptedValue), "s3cr3t")
+						return db.ProjectSecret{ProjectID: arg.ProjectID, Name: arg.Name}, nil
+					})
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:       "invalid name",
+			secretName: "not a name",
+			setup:      func(*mockdb.MockStore) {},
+			wantCode:   codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			tt.setup(mockStore)
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: uuid.New()},
+			})
+
+			_, err := srv.SetProjectSecret(ctx, &minderv1.SetProjectSecretRequest{
+				Name:  tt.secretName,
+				Value: "s3cr3t",
+			})
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestListProjectSecrets(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	projectID := uuid.New()
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockStore.EXPECT().ListProjectSecrets(gomock.Any(), projectID).Return([]db.ProjectSecret{
+		{ProjectID: projectID, Name: "api-token", EncryptedValue: []byte(`{"encrypted_data":"x"}`)},
+		{ProjectID: projectID, Name: "webhook-key", EncryptedValue: []byte(`{"encrypted_data":"y"}`)},
+	}, nil)
+
+	srv := newDefaultServer(t, mockStore, nil, nil, nil)
+	ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: projectID},
+	})
+
+	resp, err := srv.ListProjectSecrets(ctx, &minderv1.ListProjectSecretsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"api-token", "webhook-key"}, resp.GetNames())
+}
+
+func TestDeleteProjectSecret(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	projectID := uuid.New()
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockStore.EXPECT().DeleteProjectSecret(gomock.Any(), db.DeleteProjectSecretParams{
+		ProjectID: projectID,
+		Name:      "api-token",
+	}).Return(int64(1), nil)
+	mockStore.EXPECT().DeleteProjectSecret(gomock.Any(), db.DeleteProjectSecretParams{
+		ProjectID: projectID,
+		Name:      "missing",
+	}).Return(int64(0), nil)
+
+	srv := newDefaultServer(t, mockStore, nil, nil, nil)
+	ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: projectID},
+	})
+
+	_, err := srv.DeleteProjectSecret(ctx, &minderv1.DeleteProjectSecretRequest{Name: "api-token"})
+	require.NoError(t, err)
+
+	_, err = srv.DeleteProjectSecret(ctx, &minderv1.DeleteProjectSecretRequest{Name: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
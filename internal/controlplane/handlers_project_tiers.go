@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/projects/features"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// GetProjectTier returns the tier of the project, with its limits and the
+// current usage of the project.
+func (s *Server) GetProjectTier(
+	ctx context.Context,
+	_ *minderv1.GetProjectTierRequest,
+) (*minderv1.GetProjectTierResponse, error) {
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+
+	tier, err := features.ProjectTier(ctx, s.store, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting project tier: %v", err)
+	}
+
+	repos, err := s.store.CountEntitiesByTypeAndProject(ctx, db.CountEntitiesByTypeAndProjectParams{
+		EntityType: db.EntitiesRepository,
+		ProjectID:  projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error counting repositories: %v", err)
+	}
+	provs, err := s.store.CountProvidersByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error counting providers: %v", err)
+	}
+	rules, err := s.store.CountRuleInstancesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error counting rules: %v", err)
+	}
+
+	return &minderv1.GetProjectTierResponse{
+		Tier: projectTierToPB(tier),
+		Usage: &minderv1.ProjectTierUsage{
+			Repositories: repos,
+			Providers:    provs,
+			Rules:        rules,
+		},
+	}, nil
+}
+
+func projectTierToPB(tier *db.ProjectTier) *minderv1.ProjectTier {
+	if tier == nil {
+		return nil
+	}
+	return &minderv1.ProjectTier{
+		Name:            tier.Name,
+		Description:     tier.Description,
+		MaxRepositories: nullInt32ToPtr(tier.MaxRepositories),
+		MaxProviders:    nullInt32ToPtr(tier.MaxProviders),
+		MaxRules:        nullInt32ToPtr(tier.MaxRules),
+		AdvancedEngines: tier.AdvancedEngines,
+		Features:        tier.Features,
+	}
+}
+
+func nullInt32ToPtr(n sql.NullInt32) *int32 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int32
+}
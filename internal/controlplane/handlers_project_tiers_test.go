@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestGetProjectTier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		tier     db.ProjectTier
+		tierErr  error
+		expected *minderv1.ProjectTier
+	}{
+		{
+			name: "project with tier",
+			tier: db.ProjectTier{
+				Name:            "free",
+				Description:     "Free tier",
+				MaxRepositories: sql.NullInt32{Int32: 10, Valid: true},
+				Features:        []string{"private_repositories_enabled"},
+			},
+			expected: &minderv1.ProjectTier{
+				Name:            "free",
+				Description:     "Free tier",
+				MaxRepositories: ptr.Ptr[int32](10),
+				Features:        []string{"private_repositories_enabled"},
+			},
+		},
+		{
+			name:    "project without tier",
+			tierErr: sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectID := uuid.New()
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetEffectiveProjectTier(gomock.Any(), projectID).Return(tt.tier, tt.tierErr)
+			mockStore.EXPECT().CountEntitiesByTypeAndProject(gomock.Any(), db.CountEntitiesByTypeAndProjectParams{
+				EntityType: db.EntitiesRepository,
+				ProjectID:  projectID,
+			}).Return(int64(4), nil)
+			mockStore.EXPECT().CountProvidersByProjectID(gomock.Any(), projectID).Return(int64(1), nil)
+			mockStore.EXPECT().CountRuleInstancesByProjectID(gomock.Any(), projectID).Return(int64(12), nil)
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			resp, err := srv.GetProjectTier(ctx, &minderv1.GetProjectTierRequest{})
+			require.NoError(t, err)
+			if tt.expected == nil {
+				require.Nil(t, resp.GetTier())
+			} else {
+				require.Equal(t, tt.expected.GetName(), resp.GetTier().GetName())
+				require.Equal(t, tt.expected.MaxRepositories, resp.GetTier().MaxRepositories)
+				require.Nil(t, resp.GetTier().MaxProviders)
+				require.Equal(t, tt.expected.GetFeatures(), resp.GetTier().GetFeatures())
+			}
+			require.Equal(t, int64(4), resp.GetUsage().GetRepositories())
+			require.Equal(t, int64(1), resp.GetUsage().GetProviders())
+			require.Equal(t, int64(12), resp.GetUsage().GetRules())
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// GetProjectUsage returns the usage of the project in the current window of
+// the per-project usage tracking. The usage is the one accounted by the server
+// instance handling the request.
+func (s *Server) GetProjectUsage(
+	ctx context.Context,
+	_ *minderv1.GetProjectUsageRequest,
+) (*minderv1.GetProjectUsageResponse, error) {
+	if s.usageTracker == nil {
+		return nil, util.UserVisibleError(codes.FailedPrecondition, "per-project usage tracking is not enabled")
+	}
+
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+	report := s.usageTracker.ProjectReport(projectID)
+
+	resp := &minderv1.GetProjectUsageResponse{
+		WindowStart: timestamppb.New(report.WindowStart),
+		Window:      durationpb.New(report.Window),
+	}
+	var evalTime time.Duration
+	for _, pu := range report.Projects {
+		// the event share and throttling are the same for all the providers
+		resp.EventShare = pu.EventShare
+		resp.Throttled = pu.Throttled
+		resp.Events += pu.Events
+		resp.ApiCalls += pu.APICalls
+		evalTime += pu.EvaluationTime
+
+		// API calls are not bound to a provider
+		if pu.ProviderID == uuid.Nil {
+			continue
+		}
+		resp.Providers = append(resp.Providers, &minderv1.ProjectProviderUsage{
+			ProviderId:     pu.ProviderID.String(),
+			Events:         pu.Events,
+			EvaluationTime: durationpb.New(pu.EvaluationTime),
+		})
+	}
+	resp.EvaluationTime = durationpb.New(evalTime)
+
+	return resp, nil
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/metrics/meters"
+	"github.com/mindersec/minder/internal/usage"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestGetProjectUsage(t *testing.T) {
+	t.Parallel()
+
+	tracker, err := usage.NewTracker(&serverconfig.FairnessConfig{
+		Enabled: true,
+		Window:  10 * time.Minute,
+	}, &meters.NoopMeterFactory{})
+	require.NoError(t, err)
+
+	projectID := uuid.New()
+	providerID := uuid.New()
+	tracker.RecordEvaluation(context.Background(), projectID, providerID, 2*time.Second)
+	tracker.RecordAPICall(context.Background(), projectID)
+	// the usage of other projects is not returned
+	tracker.RecordEvaluation(context.Background(), uuid.New(), uuid.New(), time.Second)
+
+	ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: projectID},
+	})
+
+	srv := &Server{usageTracker: tracker}
+	resp, err := srv.GetProjectUsage(ctx, &minderv1.GetProjectUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resp.GetEvents())
+	require.Equal(t, int64(1), resp.GetApiCalls())
+	require.Equal(t, 2*time.Second, resp.GetEvaluationTime().AsDuration())
+	require.Equal(t, 10*time.Minute, resp.GetWindow().AsDuration())
+	require.InDelta(t, 0.5, resp.GetEventShare(), 0.001)
+	require.Len(t, resp.GetProviders(), 1)
+	require.Equal(t, providerID.String(), resp.GetProviders()[0].GetProviderId())
+
+	// usage tracking is opt-in
+	_, err = (&Server{}).GetProjectUsage(ctx, &minderv1.GetProjectUsageRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/mindersec/minder/internal/auth"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	"github.com/mindersec/minder/internal/projects"
 	"github.com/mindersec/minder/internal/projects/features"
@@ -58,6 +60,11 @@ func (s *Server) ListProjects(
 		}
 
 		var description, displayName string
+		var actionsPolicy *minderv1.ProjectActionsPolicy
+		var validationWebhook *minderv1.ProjectValidationWebhook
+		var operationApproval *minderv1.ProjectOperationApproval
+		var sigstoreTrustRoot *minderv1.ProjectSigstoreTrustRoot
+		var alertTemplates *minderv1.ProjectAlertTemplates
 		meta, err := projects.ParseMetadata(&project)
 		// ignore error if we can't parse the metadata. This information is not critical... yet.
 		if err != nil {
@@ -67,15 +74,25 @@ func (s *Server) ListProjects(
 		} else {
 			description = meta.Public.Description
 			displayName = meta.Public.DisplayName
+			actionsPolicy = meta.ActionsPolicy.ToPB()
+			validationWebhook = meta.ValidationWebhook.ToPB()
+			operationApproval = meta.OperationApproval.ToPB()
+			sigstoreTrustRoot = meta.SigstoreTrustRoot.ToPB()
+			alertTemplates = meta.AlertTemplates.ToPB()
 		}
 
 		resp.Projects = append(resp.Projects, &minderv1.Project{
-			ProjectId:   project.ID.String(),
-			Name:        project.Name,
-			Description: description,
-			DisplayName: displayName,
-			CreatedAt:   timestamppb.New(project.CreatedAt),
-			UpdatedAt:   timestamppb.New(project.UpdatedAt),
+			ProjectId:         project.ID.String(),
+			Name:              project.Name,
+			Description:       description,
+			DisplayName:       displayName,
+			CreatedAt:         timestamppb.New(project.CreatedAt),
+			UpdatedAt:         timestamppb.New(project.UpdatedAt),
+			ActionsPolicy:     actionsPolicy,
+			ValidationWebhook: validationWebhook,
+			OperationApproval: operationApproval,
+			SigstoreTrustRoot: sigstoreTrustRoot,
+			AlertTemplates:    alertTemplates,
 		})
 	}
 	return &resp, nil
@@ -289,12 +306,17 @@ func (s *Server) UpdateProject(
 
 	return &minderv1.UpdateProjectResponse{
 		Project: &minderv1.Project{
-			ProjectId:   outproj.ID.String(),
-			Name:        outproj.Name,
-			Description: meta.Public.Description,
-			DisplayName: meta.Public.DisplayName,
-			CreatedAt:   timestamppb.New(outproj.CreatedAt),
-			UpdatedAt:   timestamppb.New(outproj.UpdatedAt),
+			ProjectId:         outproj.ID.String(),
+			Name:              outproj.Name,
+			Description:       meta.Public.Description,
+			DisplayName:       meta.Public.DisplayName,
+			CreatedAt:         timestamppb.New(outproj.CreatedAt),
+			UpdatedAt:         timestamppb.New(outproj.UpdatedAt),
+			ActionsPolicy:     meta.ActionsPolicy.ToPB(),
+			ValidationWebhook: meta.ValidationWebhook.ToPB(),
+			OperationApproval: meta.OperationApproval.ToPB(),
+			SigstoreTrustRoot: meta.SigstoreTrustRoot.ToPB(),
+			AlertTemplates:    meta.AlertTemplates.ToPB(),
 		},
 	}, nil
 }
@@ -332,11 +354,52 @@ func (s *Server) PatchProject(
 
 	req.GetUpdateMask().Normalize()
 	for _, path := range req.GetUpdateMask().GetPaths() {
+		// The actions policy, the validation webhook, the operation
+		// approval, the sigstore trust root and the alert templates are
+		// patched as a whole
+		if strings.HasPrefix(path, "actions_policy.") {
+			path = "actions_policy"
+		}
+		if strings.HasPrefix(path, "validation_webhook.") {
+			path = "validation_webhook"
+		}
+		if strings.HasPrefix(path, "operation_approval.") {
+			path = "operation_approval"
+		}
+		if strings.HasPrefix(path, "sigstore_trust_root.") {
+			path = "sigstore_trust_root"
+		}
+		if strings.HasPrefix(path, "alert_templates.") {
+			path = "alert_templates"
+		}
 		switch path {
 		case "display_name":
 			meta.Public.DisplayName = req.GetPatch().GetDisplayName()
 		case "description":
 			meta.Public.Description = req.GetPatch().GetDescription()
+		case "actions_policy":
+			meta.ActionsPolicy = projects.ActionsPolicyFromPB(req.GetPatch().GetActionsPolicy())
+		case "validation_webhook":
+			meta.ValidationWebhook, err = projects.ValidationWebhookFromPB(
+				req.GetPatch().GetValidationWebhook(), meta.ValidationWebhook, s.cryptoEngine)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "error setting validation webhook: %v", err)
+			}
+		case "operation_approval":
+			meta.OperationApproval, err = projects.OperationApprovalFromPB(req.GetPatch().GetOperationApproval())
+			if err != nil {
+				return nil, util.UserVisibleError(codes.InvalidArgument, "invalid operation approval: %v", err)
+			}
+		case "sigstore_trust_root":
+			meta.SigstoreTrustRoot, err = projects.SigstoreTrustRootFromPB(req.GetPatch().GetSigstoreTrustRoot())
+			if err != nil {
+				return nil, util.UserVisibleError(codes.InvalidArgument, "%v", err)
+			}
+		case "alert_templates":
+			meta.AlertTemplates, err = templates.FromPB(req.GetPatch().GetAlertTemplates())
+			if err != nil {
+				return nil, util.UserVisibleError(codes.InvalidArgument, "%v", err)
+			}
 		}
 	}
 
@@ -360,12 +423,17 @@ func (s *Server) PatchProject(
 
 	return &minderv1.PatchProjectResponse{
 		Project: &minderv1.Project{
-			ProjectId:   outproj.ID.String(),
-			Name:        outproj.Name,
-			Description: meta.Public.Description,
-			DisplayName: meta.Public.DisplayName,
-			CreatedAt:   timestamppb.New(outproj.CreatedAt),
-			UpdatedAt:   timestamppb.New(outproj.UpdatedAt),
+			ProjectId:         outproj.ID.String(),
+			Name:              outproj.Name,
+			Description:       meta.Public.Description,
+			DisplayName:       meta.Public.DisplayName,
+			CreatedAt:         timestamppb.New(outproj.CreatedAt),
+			UpdatedAt:         timestamppb.New(outproj.UpdatedAt),
+			ActionsPolicy:     meta.ActionsPolicy.ToPB(),
+			ValidationWebhook: meta.ValidationWebhook.ToPB(),
+			OperationApproval: meta.OperationApproval.ToPB(),
+			SigstoreTrustRoot: meta.SigstoreTrustRoot.ToPB(),
+			AlertTemplates:    meta.AlertTemplates.ToPB(),
 		},
 	}, nil
 }
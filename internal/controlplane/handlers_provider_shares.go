@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// ShareProvider shares a provider with a child project of the project it is
+// enrolled in. The provider keeps belonging to its project: the entities the
+// child project registers with it belong to the child project only.
+func (s *Server) ShareProvider(
+	ctx context.Context,
+	req *minderv1.ShareProviderRequest,
+) (*minderv1.ShareProviderResponse, error) {
+	dbProv, childID, err := s.getSharedProvider(ctx, req.GetName(), req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := s.store.GetProjectByID(ctx, childID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, util.UserVisibleError(codes.NotFound, "project %s not found", childID)
+		}
+		return nil, status.Errorf(codes.Internal, "error getting project: %v", err)
+	}
+
+	share, err := s.providerStore.Share(ctx, dbProv, childID)
+	if err != nil {
+		// assumption: the provider store sets sensible errors
+		return nil, err
+	}
+
+	return &minderv1.ShareProviderResponse{
+		Share: &minderv1.ProviderShare{
+			ProjectId:   child.ID.String(),
+			ProjectName: child.Name,
+			CreatedAt:   timestamppb.New(share.CreatedAt),
+		},
+	}, nil
+}
+
+// UnshareProvider stops sharing a provider with a child project.
+func (s *Server) UnshareProvider(
+	ctx context.Context,
+	req *minderv1.UnshareProviderRequest,
+) (*minderv1.UnshareProviderResponse, error) {
+	dbProv, childID, err := s.getSharedProvider(ctx, req.GetName(), req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.providerStore.Unshare(ctx, dbProv, childID); err != nil {
+		// assumption: the provider store sets sensible errors
+		return nil, err
+	}
+
+	return &minderv1.UnshareProviderResponse{}, nil
+}
+
+// ListProviderShares lists the child projects a provider is shared with.
+func (s *Server) ListProviderShares(
+	ctx context.Context,
+	req *minderv1.ListProviderSharesRequest,
+) (*minderv1.ListProviderSharesResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	dbProv, err := s.providerStore.GetByNameInSpecificProject(ctx, projectID, req.GetName())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, util.UserVisibleError(codes.NotFound, "provider not found")
+		}
+		return nil, status.Errorf(codes.Internal, "error getting provider: %v", err)
+	}
+
+	shares, err := s.providerStore.ListShares(ctx, dbProv.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing provider shares: %v", err)
+	}
+
+	out := make([]*minderv1.ProviderShare, 0, len(shares))
+	for _, share := range shares {
+		pbShare := &minderv1.ProviderShare{
+			ProjectId: share.ProjectID.String(),
+			CreatedAt: timestamppb.New(share.CreatedAt),
+		}
+		// the name is only informative, so a missing project isn't an error
+		if project, err := s.store.GetProjectByID(ctx, share.ProjectID); err == nil {
+			pbShare.ProjectName = project.Name
+		}
+		out = append(out, pbShare)
+	}
+
+	return &minderv1.ListProviderSharesResponse{Shares: out}, nil
+}
+
+// getSharedProvider returns the provider of the project in context with the
+// given name, along with the parsed ID of the child project
+func (s *Server) getSharedProvider(
+	ctx context.Context,
+	name string,
+	childProjectID string,
+) (*db.Provider, uuid.UUID, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	childID, err := uuid.Parse(childProjectID)
+	if err != nil {
+		return nil, uuid.Nil, util.UserVisibleError(codes.InvalidArgument, "invalid project ID: %s", childProjectID)
+	}
+
+	// only the project the provider is enrolled in can share it
+	dbProv, err := s.providerStore.GetByNameInSpecificProject(ctx, projectID, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, uuid.Nil, util.UserVisibleError(codes.NotFound, "provider not found")
+		}
+		return nil, uuid.Nil, status.Errorf(codes.Internal, "error getting provider: %v", err)
+	}
+
+	return dbProv, childID, nil
+}
@@ -0,0 +1,323 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestShareProvider(t *testing.T) {
+	t.Parallel()
+
+	parentID := uuid.New()
+	childID := uuid.New()
+	siblingID := uuid.New()
+	nephewID := uuid.New()
+	provider := db.Provider{ID: uuid.New(), Name: "github-app-acme", ProjectID: parentID}
+
+	tests := []struct {
+		name              string
+		projectID         string
+		setup             func(*mockdb.MockStore)
+		expectedErrorCode codes.Code
+	}{
+		{
+			name:      "shares with a child project",
+			projectID: childID.String(),
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).Return(provider, nil)
+				store.EXPECT().GetProjectByID(gomock.Any(), childID).
+					Return(db.Project{ID: childID, Name: "team-a"}, nil)
+				store.EXPECT().GetParentProjects(gomock.Any(), childID).
+					Return([]uuid.UUID{childID, parentID}, nil).Times(2)
+				store.EXPECT().ListProviderShares(gomock.Any(), provider.ID).Return(nil, nil)
+				// the entities of the provider's own project are not affected
+				store.EXPECT().ListEntityProjectsByProvider(gomock.Any(), provider.ID).
+					Return([]uuid.UUID{parentID, childID}, nil)
+				store.EXPECT().CreateProviderShare(gomock.Any(), db.CreateProviderShareParams{
+					ProviderID: provider.ID,
+					ProjectID:  childID,
+				}).Return(db.ProviderShare{ProviderID: provider.ID, ProjectID: childID, CreatedAt: time.Now()}, nil)
+			},
+		},
+		{
+			name:      "shares with another child project",
+			projectID: childID.String(),
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).Return(provider, nil)
+				store.EXPECT().GetProjectByID(gomock.Any(), childID).
+					Return(db.Project{ID: childID, Name: "team-a"}, nil)
+				store.EXPECT().GetParentProjects(gomock.Any(), childID).
+					Return([]uuid.UUID{childID, parentID}, nil)
+				store.EXPECT().ListProviderShares(gomock.Any(), provider.ID).
+					Return([]db.ProviderShare{{ProviderID: provider.ID, ProjectID: siblingID}}, nil)
+				// the child of the sibling can still use the provider
+				store.EXPECT().ListEntityProjectsByProvider(gomock.Any(), provider.ID).
+					Return([]uuid.UUID{nephewID}, nil)
+				store.EXPECT().GetParentProjects(gomock.Any(), nephewID).
+					Return([]uuid.UUID{nephewID, siblingID, parentID}, nil)
+				store.EXPECT().CreateProviderShare(gomock.Any(), gomock.Any()).
+					Return(db.ProviderShare{ProviderID: provider.ID, ProjectID: childID, CreatedAt: time.Now()}, nil)
+			},
+		},
+		{
+			name:      "unshared descendant has entities",
+			projectID: childID.String(),
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).Return(provider, nil)
+				store.EXPECT().GetProjectByID(gomock.Any(), childID).
+					Return(db.Project{ID: childID, Name: "team-a"}, nil)
+				store.EXPECT().GetParentProjects(gomock.Any(), childID).
+					Return([]uuid.UUID{childID, parentID}, nil)
+				store.EXPECT().ListProviderShares(gomock.Any(), provider.ID).Return(nil, nil)
+				// sharing with the child would hide the provider from the
+				// child of the sibling
+				store.EXPECT().ListEntityProjectsByProvider(gomock.Any(), provider.ID).
+					Return([]uuid.UUID{nephewID}, nil)
+				store.EXPECT().GetParentProjects(gomock.Any(), nephewID).
+					Return([]uuid.UUID{nephewID, siblingID, parentID}, nil)
+			},
+			expectedErrorCode: codes.FailedPrecondition,
+		},
+		{
+			name:      "already shared",
+			projectID: childID.String(),
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).Return(provider, nil)
+				store.EXPECT().GetProjectByID(gomock.Any(), childID).
+					Return(db.Project{ID: childID, Name: "team-a"}, nil)
+				store.EXPECT().GetParentProjects(gomock.Any(), childID).
+					Return([]uuid.UUID{childID, parentID}, nil)
+				store.EXPECT().ListProviderShares(gomock.Any(), provider.ID).
+					Return([]db.ProviderShare{{ProviderID: provider.ID, ProjectID: childID}}, nil)
+			},
+			expectedErrorCode: codes.AlreadyExists,
+		},
+		{
+			name:      "shared concurrently",
+			projectID: childID.String(),
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).Return(provider, nil)
+				store.EXPECT().GetProjectByID(gomock.Any(), childID).
+					Return(db.Project{ID: childID, Name: "team-a"}, nil)
+				store.EXPECT().GetParentProjects(gomock.Any(), childID).
+					Return([]uuid.UUID{childID, parentID}, nil)
+				store.EXPECT().ListProviderShares(gomock.Any(), provider.ID).Return(nil, nil)
+				store.EXPECT().ListEntityProjectsByProvider(gomock.Any(), provider.ID).Return(nil, nil)
+				store.EXPECT().CreateProviderShare(gomock.Any(), gomock.Any()).
+					Return(db.ProviderShare{}, sql.ErrNoRows)
+			},
+			expectedErrorCode: codes.AlreadyExists,
+		},
+		{
+			name:      "project outside of the hierarchy",
+			projectID: childID.String(),
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).Return(provider, nil)
+				store.EXPECT().GetProjectByID(gomock.Any(), childID).
+					Return(db.Project{ID: childID, Name: "elsewhere"}, nil)
+				store.EXPECT().GetParentProjects(gomock.Any(), childID).
+					Return([]uuid.UUID{childID}, nil)
+			},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name:      "own project",
+			projectID: parentID.String(),
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).Return(provider, nil)
+				store.EXPECT().GetProjectByID(gomock.Any(), parentID).
+					Return(db.Project{ID: parentID}, nil)
+			},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name:      "provider not found",
+			projectID: childID.String(),
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).
+					Return(db.Provider{}, sql.ErrNoRows)
+			},
+			expectedErrorCode: codes.NotFound,
+		},
+		{
+			name:              "invalid project ID",
+			projectID:         "not-a-uuid",
+			setup:             func(_ *mockdb.MockStore) {},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			tt.setup(mockStore)
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: parentID},
+			})
+
+			resp, err := srv.ShareProvider(ctx, &minderv1.ShareProviderRequest{
+				Name:      provider.Name,
+				ProjectId: tt.projectID,
+			})
+			if tt.expectedErrorCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedErrorCode, st.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, childID.String(), resp.GetShare().GetProjectId())
+			require.Equal(t, "team-a", resp.GetShare().GetProjectName())
+		})
+	}
+}
+
+func TestUnshareProvider(t *testing.T) {
+	t.Parallel()
+
+	parentID := uuid.New()
+	childID := uuid.New()
+	siblingID := uuid.New()
+	grandchildID := uuid.New()
+	provider := db.Provider{ID: uuid.New(), Name: "github-app-acme", ProjectID: parentID}
+	deleteParams := db.DeleteProviderShareParams{
+		ProviderID: provider.ID,
+		ProjectID:  childID,
+	}
+
+	tests := []struct {
+		name              string
+		setup             func(*mockdb.MockStore)
+		expectedErrorCode codes.Code
+	}{
+		{
+			name: "unshares the provider",
+			setup: func(store *mockdb.MockStore) {
+				// the provider is not shared anymore, every project can use it
+				store.EXPECT().ListProviderShares(gomock.Any(), provider.ID).
+					Return([]db.ProviderShare{{ProviderID: provider.ID, ProjectID: childID}}, nil)
+				store.EXPECT().DeleteProviderShare(gomock.Any(), deleteParams).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "other shares still cover the entities",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().ListProviderShares(gomock.Any(), provider.ID).
+					Return([]db.ProviderShare{
+						{ProviderID: provider.ID, ProjectID: childID},
+						{ProviderID: provider.ID, ProjectID: siblingID},
+					}, nil)
+				store.EXPECT().ListEntityProjectsByProvider(gomock.Any(), provider.ID).
+					Return([]uuid.UUID{parentID, siblingID}, nil)
+				store.EXPECT().GetParentProjects(gomock.Any(), siblingID).
+					Return([]uuid.UUID{siblingID, parentID}, nil)
+				store.EXPECT().DeleteProviderShare(gomock.Any(), deleteParams).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "child project still has entities",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().ListProviderShares(gomock.Any(), provider.ID).
+					Return([]db.ProviderShare{
+						{ProviderID: provider.ID, ProjectID: childID},
+						{ProviderID: provider.ID, ProjectID: siblingID},
+					}, nil)
+				store.EXPECT().ListEntityProjectsByProvider(gomock.Any(), provider.ID).
+					Return([]uuid.UUID{grandchildID}, nil)
+				store.EXPECT().GetParentProjects(gomock.Any(), grandchildID).
+					Return([]uuid.UUID{grandchildID, childID, parentID}, nil)
+			},
+			expectedErrorCode: codes.FailedPrecondition,
+		},
+		{
+			name: "provider not shared with the project",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().ListProviderShares(gomock.Any(), provider.ID).
+					Return([]db.ProviderShare{{ProviderID: provider.ID, ProjectID: siblingID}}, nil)
+			},
+			expectedErrorCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).Return(provider, nil)
+			tt.setup(mockStore)
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: parentID},
+			})
+
+			_, err := srv.UnshareProvider(ctx, &minderv1.UnshareProviderRequest{
+				Name:      provider.Name,
+				ProjectId: childID.String(),
+			})
+			if tt.expectedErrorCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedErrorCode, st.Code())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestListProviderShares(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	parentID := uuid.New()
+	childID := uuid.New()
+	provider := db.Provider{ID: uuid.New(), Name: "github-app-acme", ProjectID: parentID}
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockStore.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).Return(provider, nil)
+	mockStore.EXPECT().ListProviderShares(gomock.Any(), provider.ID).
+		Return([]db.ProviderShare{{ProviderID: provider.ID, ProjectID: childID, CreatedAt: time.Now()}}, nil)
+	mockStore.EXPECT().GetProjectByID(gomock.Any(), childID).
+		Return(db.Project{ID: childID, Name: "team-a"}, nil)
+
+	srv := newDefaultServer(t, mockStore, nil, nil, nil)
+	ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: parentID},
+	})
+
+	resp, err := srv.ListProviderShares(ctx, &minderv1.ListProviderSharesRequest{Name: provider.Name})
+	require.NoError(t, err)
+	require.Len(t, resp.GetShares(), 1)
+	require.Equal(t, childID.String(), resp.GetShares()[0].GetProjectId())
+	require.Equal(t, "team-a", resp.GetShares()[0].GetProjectName())
+}
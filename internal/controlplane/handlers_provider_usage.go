@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	defaultProviderUsagePeriod      = 24 * time.Hour
+	defaultProviderUsageGranularity = "hour"
+)
+
+// GetProviderUsage returns the API calls, webhooks and evaluations of the
+// providers of the project over time, the most used providers first.
+func (s *Server) GetProviderUsage(
+	ctx context.Context,
+	req *minderv1.GetProviderUsageRequest,
+) (*minderv1.GetProviderUsageResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	var provs []db.Provider
+	if req.GetName() != "" {
+		prov, err := s.providerStore.GetByNameInSpecificProject(ctx, projectID, req.GetName())
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, util.UserVisibleError(codes.NotFound, "provider not found")
+			}
+			return nil, status.Errorf(codes.Internal, "error getting provider: %v", err)
+		}
+		provs = []db.Provider{*prov}
+	} else {
+		var err error
+		provs, err = s.store.ListProvidersByProjectID(ctx, []uuid.UUID{projectID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error listing providers: %v", err)
+		}
+	}
+
+	since := time.Now().Add(-defaultProviderUsagePeriod)
+	if req.GetSince() != nil {
+		since = req.GetSince().AsTime()
+	}
+	granularity := req.GetGranularity()
+	if granularity == "" {
+		granularity = defaultProviderUsageGranularity
+	}
+
+	usage := make(map[uuid.UUID]*minderv1.ProviderUsage, len(provs))
+	ids := make([]uuid.UUID, 0, len(provs))
+	for _, prov := range provs {
+		ids = append(ids, prov.ID)
+		usage[prov.ID] = &minderv1.ProviderUsage{
+			Name:  prov.Name,
+			Class: string(prov.Class),
+			Total: &minderv1.ProviderUsageBucket{Start: timestamppb.New(since)},
+		}
+	}
+
+	rows, err := s.store.ListProviderUsage(ctx, db.ListProviderUsageParams{
+		Granularity: granularity,
+		ProviderIds: ids,
+		Since:       since,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing provider usage: %v", err)
+	}
+
+	for _, row := range rows {
+		pu, ok := usage[row.ProviderID]
+		if !ok {
+			continue
+		}
+		pu.Buckets = append(pu.Buckets, &minderv1.ProviderUsageBucket{
+			Start:       timestamppb.New(row.Bucket),
+			ApiCalls:    row.ApiCalls,
+			Webhooks:    row.Webhooks,
+			Evaluations: row.Evaluations,
+		})
+		pu.Total.ApiCalls += row.ApiCalls
+		pu.Total.Webhooks += row.Webhooks
+		pu.Total.Evaluations += row.Evaluations
+	}
+
+	out := make([]*minderv1.ProviderUsage, 0, len(usage))
+	for _, pu := range usage {
+		out = append(out, pu)
+	}
+	slices.SortFunc(out, func(a, b *minderv1.ProviderUsage) int {
+		return cmp.Or(
+			cmp.Compare(b.Total.ApiCalls, a.Total.ApiCalls),
+			cmp.Compare(b.Total.Evaluations, a.Total.Evaluations),
+			strings.Compare(a.Name, b.Name),
+		)
+	})
+
+	return &minderv1.GetProviderUsageResponse{Providers: out}, nil
+}
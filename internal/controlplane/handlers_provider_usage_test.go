@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestGetProviderUsage(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	quiet := db.Provider{ID: uuid.New(), Name: "github-app-quiet", Class: db.ProviderClassGithubApp, ProjectID: projectID}
+	busy := db.Provider{ID: uuid.New(), Name: "github-app-busy", Class: db.ProviderClassGithubApp, ProjectID: projectID}
+	since := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		request           *minderv1.GetProviderUsageRequest
+		setup             func(*mockdb.MockStore)
+		expectedProviders []string
+		expectedErrorCode codes.Code
+	}{
+		{
+			name:    "all providers of the project, most used first",
+			request: &minderv1.GetProviderUsageRequest{Since: timestamppb.New(since), Granularity: "day"},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().ListProvidersByProjectID(gomock.Any(), []uuid.UUID{projectID}).
+					Return([]db.Provider{quiet, busy}, nil)
+				store.EXPECT().ListProviderUsage(gomock.Any(), db.ListProviderUsageParams{
+					Granularity: "day",
+					ProviderIds: []uuid.UUID{quiet.ID, busy.ID},
+					Since:       since,
+				}).Return([]db.ListProviderUsageRow{
+					{ProviderID: busy.ID, Bucket: since, ApiCalls: 500, Webhooks: 20, Evaluations: 30},
+					{ProviderID: busy.ID, Bucket: since.Add(24 * time.Hour), ApiCalls: 700, Webhooks: 10, Evaluations: 40},
+					{ProviderID: quiet.ID, Bucket: since, ApiCalls: 5, Webhooks: 1, Evaluations: 2},
+				}, nil)
+			},
+			expectedProviders: []string{busy.Name, quiet.Name},
+		},
+		{
+			name:    "single provider",
+			request: &minderv1.GetProviderUsageRequest{Name: quiet.Name, Since: timestamppb.New(since)},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).Return(quiet, nil)
+				store.EXPECT().ListProviderUsage(gomock.Any(), db.ListProviderUsageParams{
+					Granularity: "hour",
+					ProviderIds: []uuid.UUID{quiet.ID},
+					Since:       since,
+				}).Return(nil, nil)
+			},
+			expectedProviders: []string{quiet.Name},
+		},
+		{
+			name:    "provider not found",
+			request: &minderv1.GetProviderUsageRequest{Name: "missing"},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).
+					Return(db.Provider{}, sql.ErrNoRows)
+			},
+			expectedErrorCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			tt.setup(mockStore)
+
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			resp, err := srv.GetProviderUsage(ctx, tt.request)
+			if tt.expectedErrorCode != codes.OK {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.expectedErrorCode, st.Code())
+				return
+			}
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(resp.GetProviders()))
+			for _, pu := range resp.GetProviders() {
+				names = append(names, pu.GetName())
+			}
+			require.Equal(t, tt.expectedProviders, names)
+
+			first := resp.GetProviders()[0]
+			if first.GetName() == busy.Name {
+				require.Len(t, first.GetBuckets(), 2)
+				require.Equal(t, int64(1200), first.GetTotal().GetApiCalls())
+				require.Equal(t, int64(30), first.GetTotal().GetWebhooks())
+				require.Equal(t, int64(70), first.GetTotal().GetEvaluations())
+			} else {
+				require.Empty(t, first.GetBuckets())
+				require.Zero(t, first.GetTotal().GetApiCalls())
+			}
+			require.True(t, since.Equal(first.GetTotal().GetStart().AsTime()))
+		})
+	}
+}
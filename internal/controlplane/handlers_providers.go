@@ -16,11 +16,14 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/mindersec/minder/internal/crypto"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/projects/features"
 	"github.com/mindersec/minder/internal/providers"
+	"github.com/mindersec/minder/internal/providers/health"
 	"github.com/mindersec/minder/internal/util"
 	cursorutil "github.com/mindersec/minder/internal/util/cursor"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -65,6 +68,8 @@ func (s *Server) CreateProvider(
 	} else if errors.As(err, &configErr) {
 		zerolog.Ctx(ctx).Error().Err(err).Msg("provider config does not validate")
 		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid provider config: %s", configErr.Details)
+	} else if st := features.UpgradeStatus(err); st != nil {
+		return nil, st
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "error creating provider: %v", err)
 	}
@@ -102,6 +107,86 @@ func (s *Server) GetProvider(ctx context.Context, req *minderv1.GetProviderReque
 	}, nil
 }
 
+// GetProviderStatus checks the health of the credentials of a provider.
+func (s *Server) GetProviderStatus(
+	ctx context.Context, req *minderv1.GetProviderStatusRequest,
+) (*minderv1.GetProviderStatusResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	dbProv, err := s.providerStore.GetByNameInSpecificProject(ctx, projectID, req.GetName())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, util.UserVisibleError(codes.NotFound, "provider not found")
+		}
+		return nil, status.Errorf(codes.Internal, "error getting provider: %v", err)
+	}
+
+	checker := health.NewChecker(s.store, s.providerManager, s.evt, &s.cfg.ProviderHealth)
+	provHealth, err := checker.Check(ctx, dbProv)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error checking provider health: %v", err)
+	}
+
+	provStatus, err := providerStatusToProto(dbProv, provHealth)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error converting provider health: %v", err)
+	}
+
+	return &minderv1.GetProviderStatusResponse{
+		Status: provStatus,
+	}, nil
+}
+
+func providerStatusToProto(dbProv *db.Provider, provHealth *db.ProviderHealth) (*minderv1.ProviderStatus, error) {
+	checks, err := health.Checks(provHealth)
+	if err != nil {
+		return nil, err
+	}
+
+	provStatus := &minderv1.ProviderStatus{
+		Name:      dbProv.Name,
+		Class:     string(dbProv.Class),
+		Healthy:   provHealth.Healthy,
+		Scopes:    provHealth.Scopes,
+		CheckedAt: timestamppb.New(provHealth.CheckedAt),
+		Checks:    make([]*minderv1.ProviderHealthCheck, 0, len(checks)),
+	}
+	if provHealth.LastSuccessAt.Valid {
+		provStatus.LastSuccessfulCall = timestamppb.New(provHealth.LastSuccessAt.Time)
+	}
+	if provHealth.TokenExpiresAt.Valid {
+		provStatus.TokenExpiresAt = timestamppb.New(provHealth.TokenExpiresAt.Time)
+	}
+	if provHealth.ScopesChangedAt.Valid {
+		provStatus.ScopesChangedAt = timestamppb.New(provHealth.ScopesChangedAt.Time)
+	}
+
+	for _, chk := range checks {
+		provStatus.Checks = append(provStatus.Checks, &minderv1.ProviderHealthCheck{
+			Name:    chk.Name,
+			Status:  healthCheckStatusToProto(chk.Status),
+			Message: chk.Message,
+		})
+	}
+	return provStatus, nil
+}
+
+func healthCheckStatusToProto(checkStatus health.Status) minderv1.ProviderHealthCheckStatus {
+	switch checkStatus {
+	case health.StatusOK:
+		return minderv1.ProviderHealthCheckStatus_PROVIDER_HEALTH_CHECK_STATUS_OK
+	case health.StatusWarning:
+		return minderv1.ProviderHealthCheckStatus_PROVIDER_HEALTH_CHECK_STATUS_WARNING
+	case health.StatusFailed:
+		return minderv1.ProviderHealthCheckStatus_PROVIDER_HEALTH_CHECK_STATUS_FAILED
+	case health.StatusSkipped:
+		return minderv1.ProviderHealthCheckStatus_PROVIDER_HEALTH_CHECK_STATUS_SKIPPED
+	default:
+		return minderv1.ProviderHealthCheckStatus_PROVIDER_HEALTH_CHECK_STATUS_UNSPECIFIED
+	}
+}
+
 // ListProviders lists the providers available in a specific project.
 func (s *Server) ListProviders(ctx context.Context, req *minderv1.ListProvidersRequest) (*minderv1.ListProvidersResponse, error) {
 	entityCtx := engcontext.EntityFromContext(ctx)
@@ -288,6 +288,8 @@ func TestCreateProvider(t *testing.T) {
 			jsonConfig, err := scenario.expected.Config.MarshalJSON()
 			require.NoError(t, err)
 
+			fakeServer.mockStore.EXPECT().GetEffectiveProjectTier(gomock.Any(), projectID).
+				Return(db.ProjectTier{}, sql.ErrNoRows)
 			fakeServer.mockStore.EXPECT().CreateProvider(gomock.Any(), partialCreateParamsMatcher{
 				t: t,
 				value: db.CreateProviderParams{
@@ -398,6 +400,8 @@ func TestCreateProviderFailures(t *testing.T) {
 			Provider: engcontext.Provider{Name: providerName},
 		})
 
+		fakeServer.mockStore.EXPECT().GetEffectiveProjectTier(gomock.Any(), projectID).
+			Return(db.ProjectTier{}, sql.ErrNoRows)
 		fakeServer.mockStore.EXPECT().CreateProvider(gomock.Any(), gomock.Any()).
 			Return(db.Provider{}, &pq.Error{Code: "23505"}) // unique_violation
 
@@ -419,6 +423,54 @@ func TestCreateProviderFailures(t *testing.T) {
 		assert.Equal(t, codes.AlreadyExists, st.Code())
 	})
 
+	t.Run("provider-limit-of-tier", func(t *testing.T) {
+		t.Parallel()
+
+		projectID := uuid.New()
+		projectIDStr := projectID.String()
+
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		fakeServer := testServer(t, ctrl)
+		providerName := "test-provider-over-limit"
+
+		user := openid.New()
+		assert.NoError(t, user.Set("sub", "testuser"))
+
+		ctx := context.Background()
+		ctx = jwt.WithAuthTokenContext(ctx, user)
+		ctx = engcontext.WithEntityContext(ctx, &engcontext.EntityContext{
+			Project:  engcontext.Project{ID: projectID},
+			Provider: engcontext.Provider{Name: providerName},
+		})
+
+		fakeServer.mockStore.EXPECT().GetEffectiveProjectTier(gomock.Any(), projectID).
+			Return(db.ProjectTier{Name: "free", MaxProviders: sql.NullInt32{Int32: 1, Valid: true}}, nil)
+		fakeServer.mockStore.EXPECT().CountProvidersByProjectID(gomock.Any(), projectID).
+			Return(int64(1), nil)
+		fakeServer.mockStore.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).
+			Return(db.Provider{}, sql.ErrNoRows)
+
+		resp, err := fakeServer.server.CreateProvider(ctx, &minder.CreateProviderRequest{
+			Context: &minder.Context{
+				Project:  &projectIDStr,
+				Provider: &providerName,
+			},
+			Provider: &minder.Provider{
+				Name:  providerName,
+				Class: string(db.ProviderClassGithub),
+			},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Contains(t, st.Message(), `requires upgrade: tier "free" allows at most 1 providers`)
+	})
+
 	t.Run("dockerhub-does-not-validate", func(t *testing.T) {
 		t.Parallel()
 
@@ -128,9 +128,10 @@ func TestServer_CreateRepositoryReconciliationTask(t *testing.T) {
 					Return([]uuid.UUID{projId}, nil)
 				store.EXPECT().
 					FindProviders(gomock.Any(), db.FindProvidersParams{
-						Name:     sql.NullString{String: "", Valid: false},
-						Projects: []uuid.UUID{projId},
-						Trait:    db.NullProviderType{},
+						Name:      sql.NullString{String: "", Valid: false},
+						Projects:  []uuid.UUID{projId},
+						Trait:     db.NullProviderType{},
+						ProjectID: uuid.NullUUID{UUID: projId, Valid: true},
 					}).Return([]db.Provider{{Name: ghProvider}}, nil)
 				store.EXPECT().
 					GetEntityByID(gomock.Any(), repoUuid).
@@ -331,8 +332,9 @@ func setupTestingEntityContextValidation(store *mockdb.MockStore, projId uuid.UU
 		Return([]uuid.UUID{projId}, nil)
 	store.EXPECT().
 		FindProviders(gomock.Any(), db.FindProvidersParams{
-			Name:     sql.NullString{String: prov, Valid: true},
-			Projects: []uuid.UUID{projId},
-			Trait:    db.NullProviderType{},
+			Name:      sql.NullString{String: prov, Valid: true},
+			Projects:  []uuid.UUID{projId},
+			Trait:     db.NullProviderType{},
+			ProjectID: uuid.NullUUID{UUID: projId, Valid: true},
 		}).Return([]db.Provider{{Name: prov, ID: provId}}, nil)
 }
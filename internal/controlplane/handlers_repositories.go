@@ -70,6 +70,9 @@ func (s *Server) RegisterRepository(
 		if errors.Is(err, repositories.ErrPrivateRepoForbidden) || errors.Is(err, repositories.ErrArchivedRepoForbidden) {
 			return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err.Error())
 		}
+		if st := features.UpgradeStatus(err); st != nil {
+			return nil, st
+		}
 		return nil, util.UserVisibleError(codes.Internal, "unable to register repository: %v", err)
 	}
 
@@ -390,6 +393,31 @@ func (s *Server) fetchRepositoriesForProvider(
 		return nil, err
 	}
 
+	registered, err := s.registeredUpstreamIDs(ctx, projectID, providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		uid, ok := upstreamIDOf(ctx, result.Entity.GetEntity().GetProperties(), projectID, providerID)
+		if !ok {
+			continue
+		}
+
+		result.Repo.Registered = registered[uid]
+		result.Entity.Registered = registered[uid]
+	}
+
+	return results, nil
+}
+
+// registeredUpstreamIDs returns the upstream IDs of the repositories
+// registered for a provider in a project.
+func (s *Server) registeredUpstreamIDs(
+	ctx context.Context,
+	projectID uuid.UUID,
+	providerID uuid.UUID,
+) (map[string]bool, error) {
 	registeredRepos, err := s.repos.ListRepositories(
 		ctx,
 		projectID,
@@ -421,39 +449,44 @@ func (s *Server) fetchRepositoriesForProvider(
 		registered[uidP.GetString()] = true
 	}
 
-	for _, result := range results {
-		uprops := result.Entity.GetEntity().GetProperties()
-		upropsMap := uprops.AsMap()
-		if upropsMap == nil {
-			zerolog.Ctx(ctx).Warn().
-				Str("provider_id", providerID.String()).
-				Str("project_id", projectID.String()).
-				Msg("upstream repository entry has no properties")
-			continue
-		}
-		uidAny, ok := upropsMap[properties.PropertyUpstreamID]
-		if !ok {
-			zerolog.Ctx(ctx).Warn().
-				Str("provider_id", providerID.String()).
-				Str("project_id", projectID.String()).
-				Msg("upstream repository entry has no upstream ID")
-			continue
-		}
+	return registered, nil
+}
 
-		uid, ok := uidAny.(string)
-		if !ok {
-			zerolog.Ctx(ctx).Warn().
-				Str("provider_id", providerID.String()).
-				Str("project_id", projectID.String()).
-				Msg("upstream repository entry has invalid upstream ID")
-			continue
-		}
+// upstreamIDOf returns the upstream ID in the properties of an upstream
+// repository, logging why it is missing otherwise.
+func upstreamIDOf(
+	ctx context.Context,
+	uprops *structpb.Struct,
+	projectID uuid.UUID,
+	providerID uuid.UUID,
+) (string, bool) {
+	upropsMap := uprops.AsMap()
+	if upropsMap == nil {
+		zerolog.Ctx(ctx).Warn().
+			Str("provider_id", providerID.String()).
+			Str("project_id", projectID.String()).
+			Msg("upstream repository entry has no properties")
+		return "", false
+	}
+	uidAny, ok := upropsMap[properties.PropertyUpstreamID]
+	if !ok {
+		zerolog.Ctx(ctx).Warn().
+			Str("provider_id", providerID.String()).
+			Str("project_id", projectID.String()).
+			Msg("upstream repository entry has no upstream ID")
+		return "", false
+	}
 
-		result.Repo.Registered = registered[uid]
-		result.Entity.Registered = registered[uid]
+	uid, ok := uidAny.(string)
+	if !ok {
+		zerolog.Ctx(ctx).Warn().
+			Str("provider_id", providerID.String()).
+			Str("project_id", projectID.String()).
+			Msg("upstream repository entry has invalid upstream ID")
+		return "", false
 	}
 
-	return results, nil
+	return uid, true
 }
 
 func (s *Server) listRemoteRepositoriesForProvider(
@@ -462,14 +495,33 @@ func (s *Server) listRemoteRepositoriesForProvider(
 	repoLister v1.RepoLister,
 	projectID uuid.UUID,
 ) ([]*UpstreamRepoAndEntityRef, error) {
-	tmoutCtx, cancel := context.WithTimeout(ctx, github.ExpensiveRestCallTimeout)
-	defer cancel()
-
-	remoteRepos, err := repoLister.ListAllRepositories(tmoutCtx)
+	results := []*UpstreamRepoAndEntityRef{}
+	err := s.forEachRemoteRepositoryPage(ctx, repoLister, projectID, "",
+		func(repos []*pb.Repository, _ string) error {
+			for _, remoteRepo := range repos {
+				results = append(results, upstreamRepoAndEntityRef(provName, projectID, remoteRepo))
+			}
+			return nil
+		})
 	if err != nil {
-		return nil, fmt.Errorf("cannot list repositories: %v", err)
+		return nil, err
 	}
 
+	return results, nil
+}
+
+// forEachRemoteRepositoryPage lists the repositories of a provider one page
+// at a time, starting at the page the cursor points to, and calls fn with the
+// repositories of each page the project can register and the cursor of the
+// next page. Pages are dropped once fn returns, so listing a large
+// organization doesn't hold all of its repositories in memory.
+func (s *Server) forEachRemoteRepositoryPage(
+	ctx context.Context,
+	repoLister v1.RepoLister,
+	projectID uuid.UUID,
+	cursor string,
+	fn func(repos []*pb.Repository, next string) error,
+) error {
 	allowsPrivateRepos := features.ProjectAllowsPrivateRepos(ctx, s.store, projectID)
 	if !allowsPrivateRepos {
 		zerolog.Ctx(ctx).Info().Msg("filtering out private repositories")
@@ -477,45 +529,127 @@ func (s *Server) listRemoteRepositoriesForProvider(
 		zerolog.Ctx(ctx).Info().Msg("including private repositories")
 	}
 
-	results := make([]*UpstreamRepoAndEntityRef, 0, len(remoteRepos))
+	for {
+		repos, next, err := listRemoteRepositoriesPage(ctx, repoLister, cursor)
+		if err != nil {
+			return fmt.Errorf("cannot list repositories: %v", err)
+		}
 
-	for idx, rem := range remoteRepos {
-		// Skip private repositories
-		if rem.IsPrivate && !allowsPrivateRepos {
-			continue
+		allowed := make([]*pb.Repository, 0, len(repos))
+		for _, rem := range repos {
+			// Skip private repositories
+			if rem.IsPrivate && !allowsPrivateRepos {
+				continue
+			}
+			allowed = append(allowed, rem)
 		}
-		remoteRepo := remoteRepos[idx]
 
-		var props *structpb.Struct
-		if remoteRepo.Properties != nil {
-			props = remoteRepo.Properties
+		if err := fn(allowed, next); err != nil {
+			return err
+		}
+		if next == "" {
+			return nil
 		}
+		cursor = next
+	}
+}
 
-		repo := &UpstreamRepoAndEntityRef{
-			Repo: &pb.UpstreamRepositoryRef{
-				Context: &pb.Context{
-					Provider: &provName,
-					Project:  ptr.Ptr(projectID.String()),
-				},
-				Owner:  remoteRepo.Owner,
-				Name:   remoteRepo.Name,
-				RepoId: remoteRepo.RepoId,
+func listRemoteRepositoriesPage(
+	ctx context.Context,
+	repoLister v1.RepoLister,
+	cursor string,
+) ([]*pb.Repository, string, error) {
+	tmoutCtx, cancel := context.WithTimeout(ctx, github.ExpensiveRestCallTimeout)
+	defer cancel()
+
+	return repoLister.ListRepositoriesPage(tmoutCtx, cursor)
+}
+
+// repositoryListingCursor returns the cursor to resume the repository listing
+// of a provider from, which is empty if the last listing completed.
+func (s *Server) repositoryListingCursor(
+	ctx context.Context,
+	projectID uuid.UUID,
+	providerID uuid.UUID,
+) (string, error) {
+	cursor, err := s.store.GetRepositoryListingCursor(ctx, db.GetRepositoryListingCursorParams{
+		ProviderID: providerID,
+		ProjectID:  projectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot get repository listing cursor: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Str("provider_id", providerID.String()).
+		Str("project_id", projectID.String()).
+		Str("cursor", cursor).
+		Msg("resuming repository listing")
+
+	return cursor, nil
+}
+
+// storeRepositoryListingCursor stores the cursor of the next page of the
+// repository listing of a provider, or clears it after the last page.
+func (s *Server) storeRepositoryListingCursor(
+	ctx context.Context,
+	projectID uuid.UUID,
+	providerID uuid.UUID,
+	next string,
+) error {
+	var err error
+	if next == "" {
+		err = s.store.DeleteRepositoryListingCursor(ctx, db.DeleteRepositoryListingCursorParams{
+			ProviderID: providerID,
+			ProjectID:  projectID,
+		})
+	} else {
+		err = s.store.UpsertRepositoryListingCursor(ctx, db.UpsertRepositoryListingCursorParams{
+			ProviderID: providerID,
+			ProjectID:  projectID,
+			NextCursor: next,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("cannot store repository listing cursor: %w", err)
+	}
+	return nil
+}
+
+func upstreamRepoAndEntityRef(
+	provName string,
+	projectID uuid.UUID,
+	remoteRepo *pb.Repository,
+) *UpstreamRepoAndEntityRef {
+	var props *structpb.Struct
+	if remoteRepo.Properties != nil {
+		props = remoteRepo.Properties
+	}
+
+	return &UpstreamRepoAndEntityRef{
+		Repo: &pb.UpstreamRepositoryRef{
+			Context: &pb.Context{
+				Provider: &provName,
+				Project:  ptr.Ptr(projectID.String()),
 			},
-			Entity: &pb.RegistrableUpstreamEntityRef{
-				Entity: &pb.UpstreamEntityRef{
-					Context: &pb.ContextV2{
-						Provider:  provName,
-						ProjectId: projectID.String(),
-					},
-					Type:       pb.Entity_ENTITY_REPOSITORIES,
-					Properties: props,
+			Owner:  remoteRepo.Owner,
+			Name:   remoteRepo.Name,
+			RepoId: remoteRepo.RepoId,
+		},
+		Entity: &pb.RegistrableUpstreamEntityRef{
+			Entity: &pb.UpstreamEntityRef{
+				Context: &pb.ContextV2{
+					Provider:  provName,
+					ProjectId: projectID.String(),
 				},
+				Type:       pb.Entity_ENTITY_REPOSITORIES,
+				Properties: props,
 			},
-		}
-		results = append(results, repo)
+		},
 	}
-
-	return results, nil
 }
 
 // TODO: move out of controlplane
@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/entities/models"
+	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/providers"
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Kinds of differences between registered and upstream repositories
+const (
+	repoSyncMissing  = "missing"
+	repoSyncRenamed  = "renamed"
+	repoSyncArchived = "archived"
+	repoSyncDeleted  = "deleted"
+)
+
+// SyncRepositories compares the repositories registered in a project with
+// the ones available in its providers, and optionally reconciles them by
+// registering the missing repositories, renaming the renamed ones and
+// deleting the ones which were archived or deleted upstream.
+func (s *Server) SyncRepositories(
+	ctx context.Context,
+	in *pb.SyncRepositoriesRequest,
+) (*pb.SyncRepositoriesResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	projectID := entityCtx.Project.ID
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).Project = projectID
+
+	providerName := in.GetContext().GetProvider()
+	provs, errorProvs, err := s.providerManager.BulkInstantiateByTrait(
+		ctx, projectID, db.ProviderTypeRepoLister, providerName)
+	if err != nil {
+		pErr := providers.ErrProviderNotFoundBy{}
+		if errors.As(err, &pErr) {
+			return nil, util.UserVisibleError(codes.NotFound, "no suitable provider found, please enroll a provider")
+		}
+		return nil, providerError(err)
+	}
+
+	out := &pb.SyncRepositoriesResponse{
+		Changes: []*pb.RepositorySyncChange{},
+	}
+
+	synced := 0
+	for providerID, providerT := range provs {
+		changes, err := s.syncRepositoriesForProvider(
+			ctx, projectID, providerID, providerT.Name, providerT.Provider, in.GetApply())
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).
+				Msgf("error syncing repositories for provider %s in project %s", providerT.Name, projectID)
+			errorProvs = append(errorProvs, providerT.Name)
+			continue
+		}
+		out.Changes = append(out.Changes, changes...)
+		synced++
+	}
+
+	// If all providers failed, return an error
+	if len(errorProvs) > 0 && synced == 0 {
+		return nil, util.UserVisibleError(codes.Internal, "cannot sync repositories for providers: %v", errorProvs)
+	}
+
+	return out, nil
+}
+
+// syncRepositoriesForProvider computes, and applies if requested, the
+// changes needed to reconcile the repositories registered for a provider
+// with the upstream ones.
+//
+// Upstream repositories are listed one page at a time. When the changes are
+// applied, the cursor of the next page is stored after each page so that a
+// failed sync resumes where it stopped; registered repositories which were
+// listed before resuming are then fetched one by one instead.
+func (s *Server) syncRepositoriesForProvider(
+	ctx context.Context,
+	projectID uuid.UUID,
+	providerID uuid.UUID,
+	providerName string,
+	provider v1.Provider,
+	apply bool,
+) ([]*pb.RepositorySyncChange, error) {
+	repoLister, err := v1.As[v1.RepoLister](provider)
+	if err != nil {
+		return nil, fmt.Errorf("error instantiating repo lister: %w", err)
+	}
+
+	registeredRepos, err := s.registeredRepositoriesForProvider(ctx, projectID, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list registered repositories: %w", err)
+	}
+
+	changes := []*pb.RepositorySyncChange{}
+	record := func(kind string, repo *models.EntityWithProperties, name string, upstreamProps *properties.Properties) {
+		change := &pb.RepositorySyncChange{
+			Kind:     kind,
+			Provider: providerName,
+			Name:     name,
+		}
+		if repo != nil {
+			change.Name = repo.Entity.Name
+			change.RepositoryId = repo.Entity.ID.String()
+			if kind == repoSyncRenamed {
+				change.UpstreamName = name
+			}
+		}
+		if apply {
+			if err := s.applyRepositorySyncChange(ctx, projectID, providerID, kind, repo, name, upstreamProps); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).
+					Str("kind", kind).
+					Str("name", change.Name).
+					Msg("error applying repository sync change")
+				change.Error = "cannot apply change"
+			} else {
+				change.Applied = true
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	registered := make(map[string]*models.EntityWithProperties, len(registeredRepos))
+	for _, repo := range registeredRepos {
+		uid := repo.Properties.GetProperty(properties.PropertyUpstreamID).GetString()
+		if uid == "" {
+			zerolog.Ctx(ctx).Warn().
+				Str("entity_id", repo.Entity.ID.String()).
+				Str("entity_name", repo.Entity.Name).
+				Msg("repository has no upstream ID")
+			continue
+		}
+		registered[uid] = repo
+	}
+
+	// Only syncs which apply their changes resume, since the changes of
+	// the pages listed before are already applied.
+	cursor := ""
+	if apply {
+		cursor, err = s.repositoryListingCursor(ctx, projectID, providerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	listed := make(map[string]bool, len(registered))
+	err = s.forEachRemoteRepositoryPage(ctx, repoLister, projectID, cursor,
+		func(repos []*pb.Repository, next string) error {
+			for _, upstream := range repos {
+				props := properties.NewProperties(upstream.GetProperties().AsMap())
+				uid := props.GetProperty(properties.PropertyUpstreamID).GetString()
+				if uid == "" {
+					continue
+				}
+				name, err := provider.GetEntityName(pb.Entity_ENTITY_REPOSITORIES, props)
+				if err != nil {
+					zerolog.Ctx(ctx).Warn().Err(err).
+						Str("upstream_id", uid).
+						Msg("cannot get upstream repository name")
+					continue
+				}
+
+				repo, ok := registered[uid]
+				if !ok {
+					record(repoSyncMissing, nil, name, props)
+					continue
+				}
+				listed[uid] = true
+				if name != repo.Entity.Name {
+					record(repoSyncRenamed, repo, name, props)
+				}
+			}
+
+			if !apply {
+				return nil
+			}
+			return s.storeRepositoryListingCursor(ctx, projectID, providerID, next)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, repo := range registeredRepos {
+		uid := repo.Properties.GetProperty(properties.PropertyUpstreamID).GetString()
+		if uid == "" || listed[uid] {
+			continue
+		}
+
+		// Archived repositories are not listed by the provider, so
+		// fetch the ones missing from the listing to find out why.
+		upstreamProps, err := provider.FetchAllProperties(ctx, repo.Properties, pb.Entity_ENTITY_REPOSITORIES, nil)
+		if errors.Is(err, v1.ErrEntityNotFound) ||
+			(err == nil && upstreamProps.GetProperty(properties.PropertyUpstreamID).GetString() != uid) {
+			record(repoSyncDeleted, repo, "", nil)
+			continue
+		} else if err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).
+				Str("entity_id", repo.Entity.ID.String()).
+				Str("entity_name", repo.Entity.Name).
+				Msg("cannot fetch upstream repository")
+			continue
+		}
+		if upstreamProps.GetProperty(properties.RepoPropertyIsArchived).GetBool() {
+			record(repoSyncArchived, repo, "", nil)
+			continue
+		}
+
+		name, err := provider.GetEntityName(pb.Entity_ENTITY_REPOSITORIES, upstreamProps)
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).
+				Str("entity_id", repo.Entity.ID.String()).
+				Msg("cannot get upstream repository name")
+			continue
+		}
+		if name != repo.Entity.Name {
+			record(repoSyncRenamed, repo, name, upstreamProps)
+		}
+	}
+
+	return changes, nil
+}
+
+// registeredRepositoriesForProvider returns the repositories registered for
+// a provider with their stored properties. Unlike the repository service,
+// it doesn't refresh the properties, which fails for deleted repositories.
+func (s *Server) registeredRepositoriesForProvider(
+	ctx context.Context,
+	projectID uuid.UUID,
+	providerID uuid.UUID,
+) ([]*models.EntityWithProperties, error) {
+	ents, err := s.store.GetEntitiesByType(ctx, db.GetEntitiesByTypeParams{
+		EntityType: db.EntitiesRepository,
+		ProviderID: providerID,
+		Projects:   []uuid.UUID{projectID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching repositories: %w", err)
+	}
+
+	repos := make([]*models.EntityWithProperties, 0, len(ents))
+	for _, ent := range ents {
+		ewp, err := s.props.EntityWithPropertiesByID(ctx, ent.ID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching properties for repository: %w", err)
+		}
+		repos = append(repos, ewp)
+	}
+
+	return repos, nil
+}
+
+func (s *Server) applyRepositorySyncChange(
+	ctx context.Context,
+	projectID uuid.UUID,
+	providerID uuid.UUID,
+	kind string,
+	repo *models.EntityWithProperties,
+	name string,
+	upstreamProps *properties.Properties,
+) error {
+	switch kind {
+	case repoSyncMissing:
+		provider, err := s.providerStore.GetByID(ctx, providerID)
+		if err != nil {
+			return fmt.Errorf("cannot get provider: %w", err)
+		}
+		_, err = s.repos.CreateRepository(ctx, provider, projectID, upstreamProps)
+		return err
+	case repoSyncRenamed:
+		return s.repos.RenameRepository(ctx, repo.Entity.ID, projectID, name, upstreamProps)
+	case repoSyncArchived, repoSyncDeleted:
+		return s.repos.DeleteByID(ctx, repo.Entity.ID, projectID)
+	default:
+		return fmt.Errorf("unknown repository sync change: %s", kind)
+	}
+}
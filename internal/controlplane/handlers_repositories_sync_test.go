@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/entities/models"
+	mockprops "github.com/mindersec/minder/internal/entities/properties/service/mock"
+	ghprops "github.com/mindersec/minder/internal/providers/github/properties"
+	"github.com/mindersec/minder/internal/providers/manager"
+	mockmanager "github.com/mindersec/minder/internal/providers/manager/mock"
+	mockrepo "github.com/mindersec/minder/internal/repositories/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func TestServer_SyncRepositories(t *testing.T) {
+	t.Parallel()
+
+	renamedID := uuid.New()
+	deletedID := uuid.New()
+	archivedID := uuid.New()
+
+	registered := []*models.EntityWithProperties{
+		syncDbRepository(renamedID, "old-name", remoteRepoId),
+		syncDbRepository(deletedID, "gone", 888),
+		syncDbRepository(archivedID, "legacy", 999),
+	}
+
+	withUpstreamLookups := func(mock githubMock) {
+		mock.EXPECT().
+			GetEntityName(pb.Entity_ENTITY_REPOSITORIES, gomock.Any()).
+			DoAndReturn(func(_ pb.Entity, props *properties.Properties) (string, error) {
+				return fmt.Sprintf("%s/%s",
+					props.GetProperty(ghprops.RepoPropertyOwner).GetString(),
+					props.GetProperty(ghprops.RepoPropertyName).GetString()), nil
+			}).AnyTimes()
+		mock.EXPECT().
+			FetchAllProperties(gomock.Any(), registered[1].Properties, pb.Entity_ENTITY_REPOSITORIES, gomock.Any()).
+			Return(nil, provinfv1.ErrEntityNotFound)
+		mock.EXPECT().
+			FetchAllProperties(gomock.Any(), registered[2].Properties, pb.Entity_ENTITY_REPOSITORIES, gomock.Any()).
+			Return(properties.NewProperties(map[string]any{
+				properties.PropertyUpstreamID:     "999",
+				properties.RepoPropertyIsArchived: true,
+				ghprops.RepoPropertyName:          "legacy",
+				ghprops.RepoPropertyOwner:         repoOwner,
+			}), nil)
+	}
+
+	expectedChanges := []*pb.RepositorySyncChange{
+		{Kind: repoSyncRenamed, Provider: provider.Name, Name: "acme-corp/old-name",
+			UpstreamName: repoOwnerAndName, RepositoryId: renamedID.String()},
+		{Kind: repoSyncMissing, Provider: provider.Name, Name: "acme-corp/another-repo"},
+		{Kind: repoSyncDeleted, Provider: provider.Name, Name: "acme-corp/gone", RepositoryId: deletedID.String()},
+		{Kind: repoSyncArchived, Provider: provider.Name, Name: "acme-corp/legacy", RepositoryId: archivedID.String()},
+	}
+
+	scenarios := []struct {
+		Name             string
+		Apply            bool
+		RepoServiceSetup repoMockBuilder
+		GitHubSetup      githubMockBuilder
+		StoreSetup       func(store *mockdb.MockStore)
+		ExpectedChanges  []*pb.RepositorySyncChange
+		ExpectedError    string
+	}{
+		{
+			Name:            "Sync reports the changes without applying them",
+			GitHubSetup:     newGitHub(withSuccessfulListRepositories, withUpstreamLookups),
+			ExpectedChanges: expectedChanges,
+		},
+		{
+			Name:        "Sync applies the changes",
+			Apply:       true,
+			GitHubSetup: newGitHub(withSuccessfulListRepositories, withUpstreamLookups),
+			StoreSetup: func(store *mockdb.MockStore) {
+				withNoRepositoryListingCursor(store)
+				withCompletedRepositoryListing(store)
+			},
+			RepoServiceSetup: func(ctrl *gomock.Controller) repoServiceMock {
+				svc := mockrepo.NewMockRepositoryService(ctrl)
+				svc.EXPECT().
+					RenameRepository(gomock.Any(), renamedID, projectID, repoOwnerAndName, gomock.Any()).
+					Return(nil)
+				svc.EXPECT().
+					DeleteByID(gomock.Any(), deletedID, projectID).
+					Return(nil)
+				svc.EXPECT().
+					DeleteByID(gomock.Any(), archivedID, projectID).
+					Return(errDefault)
+				svc.EXPECT().
+					CreateRepository(gomock.Any(), gomock.Any(), projectID, gomock.Any()).
+					Return(&existingRepo2, nil)
+				return svc
+			},
+			ExpectedChanges: []*pb.RepositorySyncChange{
+				{Kind: repoSyncRenamed, Provider: provider.Name, Name: "acme-corp/old-name",
+					UpstreamName: repoOwnerAndName, RepositoryId: renamedID.String(), Applied: true},
+				{Kind: repoSyncMissing, Provider: provider.Name, Name: "acme-corp/another-repo", Applied: true},
+				{Kind: repoSyncDeleted, Provider: provider.Name, Name: "acme-corp/gone",
+					RepositoryId: deletedID.String(), Applied: true},
+				{Kind: repoSyncArchived, Provider: provider.Name, Name: "acme-corp/legacy",
+					RepositoryId: archivedID.String(), Error: "cannot apply change"},
+			},
+		},
+		{
+			Name:          "Sync fails when all providers error",
+			GitHubSetup:   newGitHub(withFailedListRepositories(errDefault)),
+			ExpectedError: "cannot sync repositories for providers: [github]",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			prov := scenario.GitHubSetup(ctrl)
+			mgr := mockmanager.NewMockProviderManager(ctrl)
+			mgr.EXPECT().BulkInstantiateByTrait(
+				gomock.Any(),
+				gomock.Eq(projectID),
+				gomock.Eq(db.ProviderTypeRepoLister),
+				gomock.Eq(""),
+			).Return(map[uuid.UUID]manager.NameProviderTuple{
+				provider.ID: {
+					Name:     provider.Name,
+					Provider: prov,
+				},
+			}, []string{}, nil)
+
+			server := createServer(ctrl, scenario.RepoServiceSetup, false, mgr)
+
+			store, ok := server.store.(*mockdb.MockStore)
+			require.True(t, ok)
+			ents := make([]db.EntityInstance, 0, len(registered))
+			for _, repo := range registered {
+				ents = append(ents, db.EntityInstance{ID: repo.Entity.ID, Name: repo.Entity.Name})
+			}
+			store.EXPECT().
+				GetEntitiesByType(gomock.Any(), db.GetEntitiesByTypeParams{
+					EntityType: db.EntitiesRepository,
+					ProviderID: provider.ID,
+					Projects:   []uuid.UUID{projectID},
+				}).
+				Return(ents, nil).AnyTimes()
+			if scenario.StoreSetup != nil {
+				scenario.StoreSetup(store)
+			}
+
+			propSvc := mockprops.NewMockPropertiesService(ctrl)
+			for _, repo := range registered {
+				propSvc.EXPECT().
+					EntityWithPropertiesByID(gomock.Any(), repo.Entity.ID, gomock.Any()).
+					Return(repo, nil).AnyTimes()
+			}
+			server.props = propSvc
+
+			res, err := server.SyncRepositories(ctx, &pb.SyncRepositoriesRequest{
+				Apply: scenario.Apply,
+			})
+			if scenario.ExpectedError != "" {
+				require.Nil(t, res)
+				require.ErrorContains(t, err, scenario.ExpectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Empty(t, cmp.Diff(scenario.ExpectedChanges, res.GetChanges(), protocmp.Transform()))
+		})
+	}
+}
+
+func syncDbRepository(id uuid.UUID, name string, upstreamID int64) *models.EntityWithProperties {
+	props := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: fmt.Sprintf("%d", upstreamID),
+		ghprops.RepoPropertyName:      name,
+		ghprops.RepoPropertyOwner:     repoOwner,
+	})
+	return models.NewEntityWithPropertiesFromInstance(models.EntityInstance{
+		ID:   id,
+		Type: pb.Entity_ENTITY_REPOSITORIES,
+		Name: fmt.Sprintf("%s/%s", repoOwner, name),
+	}, props)
+}
@@ -315,12 +315,12 @@ func TestServer_ListRemoteRepositoriesFromProvider(t *testing.T) {
 	}{
 		{
 			Name:          "List remote repositories fails when all providers error",
-			GitHubSetup:   newGitHub(withFailedListAllRepositories(errDefault)),
+			GitHubSetup:   newGitHub(withFailedListRepositories(errDefault)),
 			ExpectedError: "cannot list repositories for providers: [github]",
 		},
 		{
 			Name:        "List remote repositories succeeds when all providers succeed",
-			GitHubSetup: newGitHub(withSuccessfulListAllRepositories),
+			GitHubSetup: newGitHub(withSuccessfulListRepositories),
 			RepoServiceSetup: rf.NewRepoService(
 				rf.WithSuccessfulListRepositories(
 					simpleDbRepository(repoName, remoteRepoId),
@@ -333,7 +333,7 @@ func TestServer_ListRemoteRepositoriesFromProvider(t *testing.T) {
 		},
 		{
 			Name:        "List remote repositories fails when db fails",
-			GitHubSetup: newGitHub(withSuccessfulListAllRepositories),
+			GitHubSetup: newGitHub(withSuccessfulListRepositories),
 			RepoServiceSetup: rf.NewRepoService(
 				rf.WithFailedListRepositories(errors.New("oops")),
 			),
@@ -634,20 +634,38 @@ func newGitHub(opts ...func(mock githubMock)) githubMockBuilder {
 	}
 }
 
-func withSuccessfulListAllRepositories(mock githubMock) {
+func withSuccessfulListRepositories(mock githubMock) {
 	mock.EXPECT().
-		ListAllRepositories(gomock.Any()).
-		Return([]*pb.Repository{&existingRepo, &existingRepo2}, nil)
+		ListRepositoriesPage(gomock.Any(), "").
+		Return([]*pb.Repository{&existingRepo, &existingRepo2}, "", nil)
 }
 
-func withFailedListAllRepositories(err error) func(githubMock) {
+func withFailedListRepositories(err error) func(githubMock) {
 	return func(mock githubMock) {
 		mock.EXPECT().
-			ListAllRepositories(gomock.Any()).
-			Return(nil, err)
+			ListRepositoriesPage(gomock.Any(), gomock.Any()).
+			Return(nil, "", err)
 	}
 }
 
+func withNoRepositoryListingCursor(store *mockdb.MockStore) {
+	store.EXPECT().
+		GetRepositoryListingCursor(gomock.Any(), db.GetRepositoryListingCursorParams{
+			ProviderID: provider.ID,
+			ProjectID:  projectID,
+		}).
+		Return("", sql.ErrNoRows)
+}
+
+func withCompletedRepositoryListing(store *mockdb.MockStore) {
+	store.EXPECT().
+		DeleteRepositoryListingCursor(gomock.Any(), db.DeleteRepositoryListingCursorParams{
+			ProviderID: provider.ID,
+			ProjectID:  projectID,
+		}).
+		Return(nil)
+}
+
 func createServer(
 	ctrl *gomock.Controller,
 	repoServiceSetup repoMockBuilder,
@@ -26,6 +26,8 @@ import (
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	regoeval "github.com/mindersec/minder/internal/engine/eval/rego"
 	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/projects"
+	"github.com/mindersec/minder/internal/projects/features"
 	"github.com/mindersec/minder/internal/util"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/ruletypes"
@@ -46,7 +48,7 @@ var (
 // ListRuleTypes is a method to list all rule types for a given context
 func (s *Server) ListRuleTypes(
 	ctx context.Context,
-	_ *minderv1.ListRuleTypesRequest,
+	in *minderv1.ListRuleTypesRequest,
 ) (*minderv1.ListRuleTypesResponse, error) {
 	entityCtx := engcontext.EntityFromContext(ctx)
 
@@ -60,10 +62,21 @@ func (s *Server) ListRuleTypes(
 		return nil, status.Errorf(codes.Unknown, "failed to get rule types: %s", err)
 	}
 
+	var visibility db.Visibility
+	if in.GetVisibility() != minderv1.Visibility_VISIBILITY_UNSPECIFIED {
+		visibility, err = ruletypes.GetDBVisibilityFromPBVisibility(in.GetVisibility())
+		if err != nil {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid visibility: %s", err)
+		}
+	}
+
 	resp := &minderv1.ListRuleTypesResponse{}
 
 	for idx := range lrt {
 		rt := lrt[idx]
+		if visibility != "" && rt.Visibility != visibility {
+			continue
+		}
 		rtpb, err := ruletypes.RuleTypePBFromDB(&rt)
 		if err != nil {
 			return nil, fmt.Errorf("cannot convert rule type %s to pb: %v", rt.Name, err)
@@ -143,6 +156,19 @@ func (s *Server) GetRuleTypeById(
 		return nil, status.Errorf(codes.Unknown, "failed to get rule type: %s", err)
 	}
 
+	// Rule types of other projects can only be read if they are shared with
+	// this project. We don't tell apart the ones which are not shared from
+	// the ones which don't exist.
+	if rtdb.ProjectID != entityCtx.Project.ID {
+		hierarchy, err := s.store.GetParentProjects(ctx, entityCtx.Project.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Unknown, "failed to get parent projects: %s", err)
+		}
+		if !rtdb.Visibility.VisibleFrom(rtdb.ProjectID, hierarchy) {
+			return nil, util.UserVisibleError(codes.NotFound, "rule type %s not found", in.GetId())
+		}
+	}
+
 	rt, err := ruletypes.RuleTypePBFromDB(&rtdb)
 	if err != nil {
 		return nil, fmt.Errorf("cannot convert rule type %s to pb: %v", rtdb.Name, err)
@@ -170,33 +196,48 @@ func (s *Server) CreateRuleType(
 
 	projectID := entityCtx.Project.ID
 
-	if err := validateSizeAndUTF8(crt.RuleType.Guidance); err != nil {
+	ruleType, err := s.verifyRuleTypeSignature(ctx, crt.GetRuleType(), crt.GetSignature())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateSizeAndUTF8(ruleType.Guidance); err != nil {
 		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err)
 	}
-	if err := sanitizeMarkdown(crt.RuleType.Guidance); err != nil {
+	if err := sanitizeMarkdown(ruleType.Guidance); err != nil {
 		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err)
 	}
-	if err := validateMarkdown(crt.RuleType.Guidance); err != nil {
+	if err := validateMarkdown(ruleType.Guidance); err != nil {
 		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err)
 	}
 
+	err = s.validatePolicyChange(ctx, projectID,
+		projects.PolicyChangeKindRuleType, projects.PolicyChangeOperationCreate, "", ruleType)
+	if err != nil {
+		return nil, err
+	}
+
 	newRuleType, err := db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minderv1.RuleType, error) {
-		return s.ruleTypes.CreateRuleType(ctx, projectID, uuid.Nil, crt.GetRuleType(), qtx)
+		return s.ruleTypes.CreateRuleType(ctx, projectID, uuid.Nil, ruleType, qtx)
 	})
 	if err != nil {
 		if errors.Is(err, ruletypes.ErrRuleTypeInvalid) {
 			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid rule type definition: %s", err)
 		} else if errors.Is(err, ruletypes.ErrRuleAlreadyExists) {
-			return nil, util.UserVisibleError(codes.AlreadyExists, "rule type %s already exists", crt.RuleType.GetName())
+			return nil, util.UserVisibleError(codes.AlreadyExists, "rule type %s already exists", ruleType.GetName())
+		} else if errors.Is(err, ruletypes.ErrVisibilityNotAllowed) {
+			return nil, util.UserVisibleError(codes.PermissionDenied, "%s", err)
 		} else if errors.Is(err, ruletypes.ErrDataSourceNotFound) {
 			return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err.Error())
+		} else if st := features.UpgradeStatus(err); st != nil {
+			return nil, st
 		}
 		return nil, status.Errorf(codes.Unknown, "failed to create rule type: %s", err)
 	}
 
 	return &minderv1.CreateRuleTypeResponse{
 		RuleType: newRuleType,
-		Warnings: regoVersionWarnings(crt.GetRuleType()),
+		Warnings: regoVersionWarnings(ruleType),
 	}, nil
 }
 
@@ -213,34 +254,191 @@ func (s *Server) UpdateRuleType(
 
 	projectID := entityCtx.Project.ID
 
-	if err := validateSizeAndUTF8(urt.RuleType.Guidance); err != nil {
+	ruleType, err := s.verifyRuleTypeSignature(ctx, urt.GetRuleType(), urt.GetSignature())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateSizeAndUTF8(ruleType.Guidance); err != nil {
 		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err)
 	}
-	if err := sanitizeMarkdown(urt.RuleType.Guidance); err != nil {
+	if err := sanitizeMarkdown(ruleType.Guidance); err != nil {
 		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err)
 	}
-	if err := validateMarkdown(urt.RuleType.Guidance); err != nil {
+	if err := validateMarkdown(ruleType.Guidance); err != nil {
 		return nil, util.UserVisibleError(codes.InvalidArgument, "%s", err)
 	}
 
+	err = s.validatePolicyChange(ctx, projectID,
+		projects.PolicyChangeKindRuleType, projects.PolicyChangeOperationUpdate, "", ruleType)
+	if err != nil {
+		return nil, err
+	}
+
 	updatedRuleType, err := db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minderv1.RuleType, error) {
-		return s.ruleTypes.UpdateRuleType(ctx, projectID, uuid.Nil, urt.GetRuleType(), qtx)
+		return s.ruleTypes.UpdateRuleType(ctx, projectID, uuid.Nil, ruleType, qtx)
 	})
 	if err != nil {
 		if errors.Is(err, ruletypes.ErrRuleTypeInvalid) {
 			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid rule type definition: %s", err)
 		} else if errors.Is(err, ruletypes.ErrRuleNotFound) {
-			return nil, status.Errorf(codes.NotFound, "rule type %s not found", urt.RuleType.GetName())
+			return nil, status.Errorf(codes.NotFound, "rule type %s not found", ruleType.GetName())
+		} else if errors.Is(err, ruletypes.ErrVisibilityNotAllowed) {
+			return nil, util.UserVisibleError(codes.PermissionDenied, "%s", err)
+		} else if st := features.UpgradeStatus(err); st != nil {
+			return nil, st
 		}
 		return nil, status.Errorf(codes.Unknown, "failed to update rule type: %s", err)
 	}
 
 	return &minderv1.UpdateRuleTypeResponse{
 		RuleType: updatedRuleType,
-		Warnings: regoVersionWarnings(urt.GetRuleType()),
+		Warnings: regoVersionWarnings(ruleType),
+	}, nil
+}
+
+// BulkUpdateRuleTypes updates many rule types of a project at once. The
+// changes are first computed by updating all the rule types in a transaction
+// which is rolled back, so that dry runs fully validate the updates. The
+// changed rule types are then updated in a single transaction.
+func (s *Server) BulkUpdateRuleTypes(
+	ctx context.Context,
+	in *minderv1.BulkUpdateRuleTypesRequest,
+) (*minderv1.BulkUpdateRuleTypesResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	projectID := entityCtx.Project.ID
+
+	ruleTypes := make([]*minderv1.RuleType, 0, len(in.GetRuleTypes()))
+	seen := make(map[string]bool, len(in.GetRuleTypes()))
+	for _, rt := range in.GetRuleTypes() {
+		if seen[rt.GetName()] {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "rule type %s is given more than once", rt.GetName())
+		}
+		seen[rt.GetName()] = true
+
+		rt, err := s.verifyRuleTypeSignature(ctx, rt, in.GetSignatures()[rt.GetName()])
+		if err != nil {
+			return nil, err
+		}
+		ruleTypes = append(ruleTypes, rt)
+
+		if err := validateSizeAndUTF8(rt.GetGuidance()); err != nil {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "rule type %s: %s", rt.GetName(), err)
+		}
+		if err := sanitizeMarkdown(rt.GetGuidance()); err != nil {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "rule type %s: %s", rt.GetName(), err)
+		}
+		if err := validateMarkdown(rt.GetGuidance()); err != nil {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "rule type %s: %s", rt.GetName(), err)
+		}
+	}
+
+	updates, err := s.updateRuleTypes(ctx, projectID, ruleTypes, false)
+	if err != nil {
+		return nil, err
+	}
+	if in.GetDryRun() {
+		return &minderv1.BulkUpdateRuleTypesResponse{Updates: updates}, nil
+	}
+
+	changed := make([]*minderv1.RuleType, 0, len(updates))
+	for i, update := range updates {
+		if len(update.GetChangedFields()) == 0 {
+			continue
+		}
+		rt := ruleTypes[i]
+		err = s.validatePolicyChange(ctx, projectID,
+			projects.PolicyChangeKindRuleType, projects.PolicyChangeOperationUpdate, "", rt)
+		if err != nil {
+			return nil, err
+		}
+		changed = append(changed, rt)
+	}
+
+	if len(changed) > 0 {
+		if _, err := s.updateRuleTypes(ctx, projectID, changed, true); err != nil {
+			return nil, err
+		}
+	}
+
+	return &minderv1.BulkUpdateRuleTypesResponse{
+		Updates: updates,
+		Applied: true,
 	}, nil
 }
 
+// updateRuleTypes updates the rule types in a transaction, returning their
+// changes. The transaction is only committed if commit is true.
+func (s *Server) updateRuleTypes(
+	ctx context.Context,
+	projectID uuid.UUID,
+	ruleTypes []*minderv1.RuleType,
+	commit bool,
+) ([]*minderv1.RuleTypeUpdate, error) {
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error starting transaction: %v", err)
+	}
+	defer s.store.Rollback(tx)
+
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	updates := make([]*minderv1.RuleTypeUpdate, 0, len(ruleTypes))
+	for _, rt := range ruleTypes {
+		rtdb, err := qtx.GetRuleTypeByName(ctx, db.GetRuleTypeByNameParams{
+			Projects: []uuid.UUID{projectID},
+			Name:     rt.GetName(),
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, util.UserVisibleError(codes.NotFound, "rule type %s not found", rt.GetName())
+			}
+			return nil, status.Errorf(codes.Unknown, "failed to get rule type: %s", err)
+		}
+		oldRuleType, err := ruletypes.RuleTypePBFromDB(&rtdb)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot convert rule type %s to pb: %v", rtdb.Name, err)
+		}
+
+		updatedRuleType, err := s.ruleTypes.UpdateRuleType(ctx, projectID, uuid.Nil, rt, qtx)
+		if err != nil {
+			if errors.Is(err, ruletypes.ErrRuleTypeInvalid) {
+				return nil, util.UserVisibleError(codes.InvalidArgument,
+					"invalid rule type definition of %s: %s", rt.GetName(), err)
+			} else if errors.Is(err, ruletypes.ErrVisibilityNotAllowed) {
+				return nil, util.UserVisibleError(codes.PermissionDenied, "rule type %s: %s", rt.GetName(), err)
+			} else if st := features.UpgradeStatus(err); st != nil {
+				return nil, st
+			}
+			return nil, status.Errorf(codes.Unknown, "failed to update rule type %s: %s", rt.GetName(), err)
+		}
+
+		profiles, err := qtx.ListProfilesInstantiatingRuleType(ctx, rtdb.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.Unknown, "failed to get profiles: %s", err)
+		}
+
+		updates = append(updates, &minderv1.RuleTypeUpdate{
+			Name:             rt.GetName(),
+			ChangedFields:    ruletypes.ChangedFields(oldRuleType, updatedRuleType),
+			AffectedProfiles: profiles,
+			Warnings:         regoVersionWarnings(rt),
+		})
+	}
+
+	if commit {
+		if err := s.store.Commit(tx); err != nil {
+			return nil, status.Errorf(codes.Internal, "error committing transaction: %v", err)
+		}
+	}
+	return updates, nil
+}
+
 func regoVersionWarnings(ruleType *minderv1.RuleType) []string {
 	eval := ruleType.GetDef().GetEval()
 	if eval.GetType() != regoeval.RegoEvalType || eval.GetRego().GetDef() == "" {
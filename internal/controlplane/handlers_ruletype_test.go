@@ -5,13 +5,18 @@ package controlplane
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	mockdb "github.com/mindersec/minder/database/mock"
 	df "github.com/mindersec/minder/database/mock/fixtures"
@@ -19,6 +24,7 @@ import (
 	db "github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/ruletypes"
 	sf "github.com/mindersec/minder/pkg/ruletypes/mock/fixtures"
 )
 
@@ -76,6 +82,7 @@ func TestCreateRuleType(t *testing.T) {
 			mockStoreFunc: df.NewMockStore(
 				df.WithTransaction(),
 				WithSuccessfulGetProjectByID(projectID),
+				WithValidationWebhook(projectID, ""),
 			),
 			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(
 				sf.WithSuccessfulCreateRuleType,
@@ -89,6 +96,7 @@ func TestCreateRuleType(t *testing.T) {
 			mockStoreFunc: df.NewMockStore(
 				df.WithTransaction(),
 				WithSuccessfulGetProjectByID(projectID),
+				WithValidationWebhook(projectID, ""),
 			),
 			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(
 				sf.WithSuccessfulCreateRuleType,
@@ -103,6 +111,7 @@ func TestCreateRuleType(t *testing.T) {
 			mockStoreFunc: df.NewMockStore(
 				df.WithTransaction(),
 				WithSuccessfulGetProjectByID(projectID),
+				WithValidationWebhook(projectID, ""),
 			),
 			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(
 				sf.WithSuccessfulCreateRuleType,
@@ -111,6 +120,18 @@ func TestCreateRuleType(t *testing.T) {
 				RuleType: ruleTypeWithRego(regoV1Definition),
 			},
 		},
+		{
+			name: "validation webhook can't be reached",
+			mockStoreFunc: df.NewMockStore(
+				WithSuccessfulGetProjectByID(projectID),
+				WithValidationWebhook(projectID, "http://127.0.0.1:1/validate"),
+			),
+			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(),
+			request: &minderv1.CreateRuleTypeRequest{
+				RuleType: &minderv1.RuleType{},
+			},
+			error: true,
+		},
 		{
 			name: "guidance sanitize error",
 			mockStoreFunc: df.NewMockStore(
@@ -217,6 +238,7 @@ func TestUpdateRuleType(t *testing.T) {
 			mockStoreFunc: df.NewMockStore(
 				df.WithTransaction(),
 				WithSuccessfulGetProjectByID(projectID),
+				WithValidationWebhook(projectID, ""),
 			),
 			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(
 				sf.WithSuccessfulUpdateRuleType,
@@ -230,6 +252,7 @@ func TestUpdateRuleType(t *testing.T) {
 			mockStoreFunc: df.NewMockStore(
 				df.WithTransaction(),
 				WithSuccessfulGetProjectByID(projectID),
+				WithValidationWebhook(projectID, ""),
 			),
 			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(
 				sf.WithSuccessfulUpdateRuleType,
@@ -239,6 +262,18 @@ func TestUpdateRuleType(t *testing.T) {
 			},
 			expectedWarnings: []string{regoV0DeprecationWarning},
 		},
+		{
+			name: "validation webhook can't be reached",
+			mockStoreFunc: df.NewMockStore(
+				WithSuccessfulGetProjectByID(projectID),
+				WithValidationWebhook(projectID, "http://127.0.0.1:1/validate"),
+			),
+			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(),
+			request: &minderv1.UpdateRuleTypeRequest{
+				RuleType: &minderv1.RuleType{},
+			},
+			error: true,
+		},
 		{
 			name: "guidance sanitize error",
 			mockStoreFunc: df.NewMockStore(
@@ -327,6 +362,167 @@ func TestUpdateRuleType(t *testing.T) {
 	}
 }
 
+func TestBulkUpdateRuleTypes(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	ruleTypeID := uuid.New()
+	existing := db.RuleType{
+		ID:            ruleTypeID,
+		Name:          "secret_scanning",
+		ProjectID:     projectID,
+		Description:   "Verifies that secret scanning is enabled",
+		Definition:    []byte(ruleDefJSON),
+		SeverityValue: db.SeverityUnknown,
+		ReleasePhase:  db.ReleaseStatusGa,
+	}
+	withExistingRuleType := func(mockStore *mockdb.MockStore) {
+		mockStore.EXPECT().
+			GetRuleTypeByName(gomock.Any(), db.GetRuleTypeByNameParams{
+				Projects: []uuid.UUID{projectID},
+				Name:     existing.Name,
+			}).
+			Return(existing, nil).
+			AnyTimes()
+		mockStore.EXPECT().
+			ListProfilesInstantiatingRuleType(gomock.Any(), ruleTypeID).
+			Return([]string{"acme-profile"}, nil).
+			AnyTimes()
+	}
+	withUpdatedDescription := func(mock sf.RuleTypeSvcMock) {
+		mock.EXPECT().
+			UpdateRuleType(gomock.Any(), projectID, uuid.Nil, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ uuid.UUID, rt *minderv1.RuleType, _ db.Querier) (*minderv1.RuleType, error) {
+				updated := existing
+				updated.Description = rt.GetDescription()
+				return ruletypes.RuleTypePBFromDB(&updated)
+			}).
+			AnyTimes()
+	}
+	updated := &minderv1.RuleType{Name: existing.Name, Description: "Secret scanning must be enabled"}
+
+	tests := []struct {
+		name                string
+		mockStoreFunc       df.MockStoreBuilder
+		ruleTypeServiceFunc sf.RuleTypeSvcMockBuilder
+		request             *minderv1.BulkUpdateRuleTypesRequest
+		expected            *minderv1.BulkUpdateRuleTypesResponse
+		expectedCode        codes.Code
+	}{
+		{
+			name: "dry run rolls the changes back",
+			mockStoreFunc: df.NewMockStore(
+				df.WithRollbackTransaction(),
+				WithSuccessfulGetProjectByID(projectID),
+				withExistingRuleType,
+			),
+			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(withUpdatedDescription),
+			request: &minderv1.BulkUpdateRuleTypesRequest{
+				RuleTypes: []*minderv1.RuleType{updated},
+				DryRun:    true,
+			},
+			expected: &minderv1.BulkUpdateRuleTypesResponse{
+				Updates: []*minderv1.RuleTypeUpdate{{
+					Name:             existing.Name,
+					ChangedFields:    []string{"description"},
+					AffectedProfiles: []string{"acme-profile"},
+				}},
+			},
+		},
+		{
+			name: "changes are applied",
+			mockStoreFunc: df.NewMockStore(
+				df.WithRollbackTransaction(),
+				df.WithTransaction(),
+				WithSuccessfulGetProjectByID(projectID),
+				WithValidationWebhook(projectID, ""),
+				withExistingRuleType,
+			),
+			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(withUpdatedDescription),
+			request: &minderv1.BulkUpdateRuleTypesRequest{
+				RuleTypes: []*minderv1.RuleType{updated},
+			},
+			expected: &minderv1.BulkUpdateRuleTypesResponse{
+				Updates: []*minderv1.RuleTypeUpdate{{
+					Name:             existing.Name,
+					ChangedFields:    []string{"description"},
+					AffectedProfiles: []string{"acme-profile"},
+				}},
+				Applied: true,
+			},
+		},
+		{
+			name: "unchanged rule types are not updated",
+			mockStoreFunc: df.NewMockStore(
+				df.WithRollbackTransaction(),
+				WithSuccessfulGetProjectByID(projectID),
+				withExistingRuleType,
+			),
+			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(withUpdatedDescription),
+			request: &minderv1.BulkUpdateRuleTypesRequest{
+				RuleTypes: []*minderv1.RuleType{{Name: existing.Name, Description: existing.Description}},
+			},
+			expected: &minderv1.BulkUpdateRuleTypesResponse{
+				Updates: []*minderv1.RuleTypeUpdate{{
+					Name:             existing.Name,
+					AffectedProfiles: []string{"acme-profile"},
+				}},
+				Applied: true,
+			},
+		},
+		{
+			name: "unknown rule type",
+			mockStoreFunc: df.NewMockStore(
+				df.WithRollbackTransaction(),
+				WithSuccessfulGetProjectByID(projectID),
+				func(mockStore *mockdb.MockStore) {
+					mockStore.EXPECT().
+						GetRuleTypeByName(gomock.Any(), gomock.Any()).
+						Return(db.RuleType{}, sql.ErrNoRows)
+				},
+			),
+			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(),
+			request: &minderv1.BulkUpdateRuleTypesRequest{
+				RuleTypes: []*minderv1.RuleType{{Name: "unknown"}},
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "rule type given twice",
+			mockStoreFunc: df.NewMockStore(
+				WithSuccessfulGetProjectByID(projectID),
+			),
+			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(),
+			request: &minderv1.BulkUpdateRuleTypesRequest{
+				RuleTypes: []*minderv1.RuleType{updated, updated},
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			srv := newDefaultServer(t, tt.mockStoreFunc(ctrl), nil, nil, nil)
+			srv.ruleTypes = tt.ruleTypeServiceFunc(ctrl)
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			resp, err := srv.BulkUpdateRuleTypes(ctx, tt.request)
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.expected, resp), "unexpected response %v", resp)
+		})
+	}
+}
+
 func TestDeleteRuleType(t *testing.T) {
 	t.Parallel()
 
@@ -461,12 +657,15 @@ func TestListRuleTypes(t *testing.T) {
 
 	projectID := uuid.New()
 	ruleTypeList := []db.RuleType{
-		{ID: uuid.New(), Name: "rule1", ProjectID: projectID, Definition: []byte(ruleDefJSON)},
-		{ID: uuid.New(), Name: "rule2", ProjectID: projectID, Definition: []byte(ruleDefJSON)},
+		{ID: uuid.New(), Name: "rule1", ProjectID: projectID, Definition: []byte(ruleDefJSON),
+			Visibility: db.VisibilitySubtree},
+		{ID: uuid.New(), Name: "rule2", ProjectID: projectID, Definition: []byte(ruleDefJSON),
+			Visibility: db.VisibilityPrivate},
 	}
 	tests := []struct {
 		name          string
 		mockStoreFunc df.MockStoreBuilder
+		visibility    minderv1.Visibility
 		ruleTypes     []db.RuleType
 		error         bool
 	}{
@@ -478,6 +677,15 @@ func TestListRuleTypes(t *testing.T) {
 			),
 			ruleTypes: ruleTypeList,
 		},
+		{
+			name: "success filtering by visibility",
+			mockStoreFunc: df.NewMockStore(
+				WithSuccessfulGetProjectByID(projectID),
+				WithSuccessfulListRuleTypesByProject(projectID, ruleTypeList),
+			),
+			visibility: minderv1.Visibility_VISIBILITY_PRIVATE,
+			ruleTypes:  ruleTypeList[1:],
+		},
 		{
 			name: "success with no rule types",
 			mockStoreFunc: df.NewMockStore(
@@ -525,7 +733,7 @@ func TestListRuleTypes(t *testing.T) {
 				Provider: engcontext.Provider{Name: "testing"},
 			})
 
-			resp, err := srv.ListRuleTypes(ctx, &minderv1.ListRuleTypesRequest{})
+			resp, err := srv.ListRuleTypes(ctx, &minderv1.ListRuleTypesRequest{Visibility: tt.visibility})
 			if tt.error {
 				require.Error(t, err)
 				require.Nil(t, resp)
@@ -535,6 +743,9 @@ func TestListRuleTypes(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, resp)
 			require.Len(t, resp.RuleTypes, len(tt.ruleTypes))
+			for i, rt := range resp.RuleTypes {
+				require.Equal(t, tt.ruleTypes[i].Name, rt.GetName())
+			}
 		})
 	}
 }
@@ -570,3 +781,20 @@ func WithSuccessfulGetProjectByID(projectID uuid.UUID) func(*mockdb.MockStore) {
 			Return(db.Project{ID: projectID}, nil)
 	}
 }
+
+// WithValidationWebhook sets up a project hierarchy made of the given project,
+// with the given validation webhook URL. An empty URL sets no webhook.
+func WithValidationWebhook(projectID uuid.UUID, url string) func(*mockdb.MockStore) {
+	return func(mockStore *mockdb.MockStore) {
+		meta := []byte(`{}`)
+		if url != "" {
+			meta = []byte(fmt.Sprintf(`{"validation_webhook": {"url": %q}}`, url))
+		}
+		mockStore.EXPECT().
+			GetParentProjects(gomock.Any(), projectID).
+			Return([]uuid.UUID{projectID}, nil)
+		mockStore.EXPECT().
+			GetProjectByID(gomock.Any(), projectID).
+			Return(db.Project{ID: projectID, Metadata: meta}, nil)
+	}
+}
@@ -46,6 +46,17 @@ func (s *Server) TokenValidationInterceptor(ctx context.Context, req interface{}
 		return handler(ctx, req)
 	}
 
+	// Service accounts authenticate with a client certificate instead of a token
+	saIdentity, err := s.certificateIdentity(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid client certificate: %v", err)
+	} else if saIdentity != nil {
+		ctx = auth.WithIdentityContext(ctx, saIdentity)
+		loginSHA := sha256.Sum256([]byte(saIdentity.String()))
+		logger.BusinessRecord(ctx).LoginHash = hex.EncodeToString(loginSHA[:])
+		return handler(ctx, req)
+	}
+
 	token, err := gauth.AuthFromMD(ctx, "bearer")
 	if err != nil {
 		if statusErr, ok := status.FromError(err); ok && statusErr.Code() != codes.Unauthenticated {
@@ -181,6 +181,11 @@ func (s *Server) getUserDependencies(ctx context.Context, user db.User) ([]*pb.P
 		return nil, nil, err
 	}
 
+	roles, err := s.userRolesInProjects(ctx, projs)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "error getting roles in projects: %v", err)
+	}
+
 	var projectRoles []*pb.ProjectRole
 	var deprecatedPrjs []*pb.Project
 	for _, proj := range projs {
@@ -202,12 +207,6 @@ func (s *Server) getUserDependencies(ctx context.Context, user db.User) ([]*pb.P
 			pDescr = meta.Public.Description
 		}
 
-		// Get all role assignments for this project
-		as, err := s.authzClient.AssignmentsToProject(ctx, proj)
-		if err != nil {
-			return nil, nil, status.Errorf(codes.Internal, "error getting role assignments: %v", err)
-		}
-
 		// TODO: Delete once all use ProjectRoles
 		deprecatedPrjs = append(deprecatedPrjs, &pb.Project{
 			ProjectId:   proj.String(),
@@ -219,19 +218,7 @@ func (s *Server) getUserDependencies(ctx context.Context, user db.User) ([]*pb.P
 		})
 
 		var projectRole *pb.Role
-		if len(as) != 0 {
-			// Find the role for the user
-			var roleString string
-			for _, a := range as {
-				if a.Subject == user.IdentitySubject {
-					roleString = a.Role
-				}
-			}
-			// Parse role
-			authzRole, err := authz.ParseRole(roleString)
-			if err != nil {
-				return nil, nil, status.Errorf(codes.Internal, "failed to parse role: %v", err)
-			}
+		if authzRole, ok := roles[proj]; ok {
 			projectRole = &pb.Role{
 				Name:        authzRole.String(),
 				DisplayName: authz.AllRolesDisplayName[authzRole],
@@ -256,6 +243,32 @@ func (s *Server) getUserDependencies(ctx context.Context, user db.User) ([]*pb.P
 	return projectRoles, deprecatedPrjs, nil
 }
 
+// userRolesInProjects returns the role of the user in the context in each of
+// the projects, direct or inherited from a parent project, checking all the
+// roles in all the projects at once. When the user has several roles in a
+// project, the first one of authz.AllRolesSorted is returned.
+func (s *Server) userRolesInProjects(ctx context.Context, projs []uuid.UUID) (map[uuid.UUID]authz.Role, error) {
+	checks := make([]authz.ProjectCheck, 0, len(projs)*len(authz.AllRolesSorted))
+	for _, proj := range projs {
+		for _, role := range authz.AllRolesSorted {
+			checks = append(checks, authz.ProjectCheck{Action: role.String(), Project: proj})
+		}
+	}
+
+	allowed, err := s.authzClient.BatchCheck(ctx, checks)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make(map[uuid.UUID]authz.Role, len(projs))
+	for i, check := range checks {
+		if _, ok := roles[check.Project]; !ok && allowed[i] {
+			roles[check.Project] = authz.Role(check.Action)
+		}
+	}
+	return roles, nil
+}
+
 // GetUser is a service for getting personal user details
 func (s *Server) GetUser(ctx context.Context, _ *pb.GetUserRequest) (*pb.GetUserResponse, error) {
 	// user is always authorized to get themselves
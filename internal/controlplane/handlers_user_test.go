@@ -374,6 +374,56 @@ func TestDeleteUserDBMock(t *testing.T) {
 	assert.NotNil(t, response)
 }
 
+func TestGetUser(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockJwtValidator := mockjwt.NewMockValidator(ctrl)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"authorization": "bearer some-access-token",
+	}))
+
+	project := uuid.New()
+	deletedProject := uuid.New()
+
+	tokenResult, _ := openid.NewBuilder().Subject("subject1").Build()
+	mockJwtValidator.EXPECT().ParseAndValidate(gomock.Any()).Return(tokenResult, nil)
+	mockStore.EXPECT().
+		GetUserBySubject(gomock.Any(), "subject1").
+		Return(db.User{ID: 1, IdentitySubject: "subject1"}, nil)
+	mockStore.EXPECT().
+		GetProjectByID(gomock.Any(), project).
+		Return(db.Project{ID: project, Name: "acme"}, nil)
+	// projects deleted while listing them are skipped
+	mockStore.EXPECT().
+		GetProjectByID(gomock.Any(), deletedProject).
+		Return(db.Project{}, sql.ErrNoRows)
+
+	server := &Server{
+		store:       mockStore,
+		jwt:         mockJwtValidator,
+		authzClient: &mock.SimpleClient{Allowed: []uuid.UUID{project, deletedProject}},
+	}
+
+	response, err := server.GetUser(ctx, &pb.GetUserRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, response.ProjectRoles, 1)
+	assert.Equal(t, project.String(), response.ProjectRoles[0].GetProject().GetProjectId())
+	assert.Equal(t, "acme", response.ProjectRoles[0].GetProject().GetName())
+	assert.Equal(t, authz.RoleAdmin.String(), response.ProjectRoles[0].GetRole().GetName())
+
+	// nolint: staticcheck
+	deprecatedPrjs := response.Projects
+	require.Len(t, deprecatedPrjs, 1)
+	assert.Equal(t, project.String(), deprecatedPrjs[0].GetProjectId())
+	assert.Equal(t, "acme", deprecatedPrjs[0].GetName())
+}
+
 func TestDeleteUser_gRPC(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/auth/mtls"
+)
+
+const (
+	// serviceAccountHeader carries the service account of the client
+	// certificate presented to the HTTP listener on the gateway requests
+	serviceAccountHeader = "x-minder-service-account"
+	// gatewaySecretHeader carries the secret which proves that the service
+	// account header was set by the gateway of this server
+	gatewaySecretHeader = "x-minder-gateway-secret"
+)
+
+// certificateIdentity returns the identity of the service account the
+// client certificate of the request maps to, or nil if there is none. The
+// certificate is either the one presented to the gRPC listener, or the one
+// presented to the HTTP listener and forwarded by the gateway.
+func (s *Server) certificateIdentity(ctx context.Context) (*auth.Identity, error) {
+	if !s.cfg.MTLS.Enabled {
+		return nil, nil
+	}
+
+	name := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 {
+			name = mtls.AccountForCertificate(&s.cfg.MTLS, tlsInfo.State.VerifiedChains[0][0])
+		}
+	}
+	if name == "" {
+		name = s.gatewayServiceAccount(ctx)
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	return s.idClient.Resolve(ctx, mtls.ProviderName+"/"+name)
+}
+
+// gatewayServiceAccount returns the service account forwarded by the
+// gateway, if the request carries the secret of this server.
+func (s *Server) gatewayServiceAccount(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	secrets := md.Get(gatewaySecretHeader)
+	names := md.Get(serviceAccountHeader)
+	if len(secrets) != 1 || len(names) != 1 {
+		return ""
+	}
+	if subtle.ConstantTimeCompare([]byte(secrets[0]), []byte(s.gatewaySecret)) != 1 {
+		return ""
+	}
+	return names[0]
+}
+
+// gatewayMetadata forwards the service account of the verified client
+// certificate presented to the HTTP listener to the gRPC handlers.
+func (s *Server) gatewayMetadata(_ context.Context, r *http.Request) metadata.MD {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+		return nil
+	}
+	name := mtls.AccountForCertificate(&s.cfg.MTLS, r.TLS.VerifiedChains[0][0])
+	if name == "" {
+		return nil
+	}
+	return metadata.Pairs(serviceAccountHeader, name, gatewaySecretHeader, s.gatewaySecret)
+}
+
+// gatewayIncomingHeaderMatcher forwards the HTTP headers as the gateway does
+// by default, except for the ones reserved to the service accounts, so that
+// the HTTP callers can't set them.
+func gatewayIncomingHeaderMatcher(key string) (string, bool) {
+	md, ok := runtime.DefaultHeaderMatcher(key)
+	if ok && (strings.EqualFold(md, serviceAccountHeader) || strings.EqualFold(md, gatewaySecretHeader)) {
+		return "", false
+	}
+	return md, ok
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/auth/mtls"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func newMTLSServer(t *testing.T) *Server {
+	t.Helper()
+
+	cfg := &serverconfig.Config{
+		MTLS: serverconfig.MTLSConfig{
+			Enabled: true,
+			ServiceAccounts: []serverconfig.ServiceAccountConfig{
+				{Name: "reconciler", Subject: "reconciler.example.com"},
+			},
+		},
+	}
+	sa, err := mtls.NewServiceAccounts(&cfg.MTLS)
+	require.NoError(t, err)
+	idClient, err := auth.NewIdentityClient(sa)
+	require.NoError(t, err)
+
+	return &Server{cfg: cfg, idClient: idClient, gatewaySecret: "gateway-secret"}
+}
+
+func verifiedState(commonName string) tls.ConnectionState {
+	return tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: commonName}}}},
+	}
+}
+
+func TestCertificateIdentity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ctx  func() context.Context
+		want string
+	}{
+		{
+			name: "no certificate",
+			ctx:  context.Background,
+		},
+		{
+			name: "grpc client certificate",
+			ctx: func() context.Context {
+				return peer.NewContext(context.Background(), &peer.Peer{
+					AuthInfo: credentials.TLSInfo{State: verifiedState("reconciler.example.com")},
+				})
+			},
+			want: "serviceaccount/reconciler",
+		},
+		{
+			name: "unmapped client certificate",
+			ctx: func() context.Context {
+				return peer.NewContext(context.Background(), &peer.Peer{
+					AuthInfo: credentials.TLSInfo{State: verifiedState("someone.example.com")},
+				})
+			},
+		},
+		{
+			name: "forwarded by the gateway",
+			ctx: func() context.Context {
+				return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+					serviceAccountHeader, "reconciler", gatewaySecretHeader, "gateway-secret"))
+			},
+			want: "serviceaccount/reconciler",
+		},
+		{
+			name: "forwarded without the gateway secret",
+			ctx: func() context.Context {
+				return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+					serviceAccountHeader, "reconciler", gatewaySecretHeader, "guess"))
+			},
+		},
+		{
+			name: "several forwarded service accounts",
+			ctx: func() context.Context {
+				return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+					serviceAccountHeader, "reconciler", serviceAccountHeader, "admin",
+					gatewaySecretHeader, "gateway-secret"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := newMTLSServer(t).certificateIdentity(tt.ctx())
+			require.NoError(t, err)
+			require.Equal(t, tt.want, id.String())
+		})
+	}
+}
+
+func TestCertificateIdentityDisabled(t *testing.T) {
+	t.Parallel()
+
+	s := newMTLSServer(t)
+	s.cfg.MTLS.Enabled = false
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: verifiedState("reconciler.example.com")},
+	})
+
+	id, err := s.certificateIdentity(ctx)
+	require.NoError(t, err)
+	require.Nil(t, id)
+}
+
+func TestGatewayMetadata(t *testing.T) {
+	t.Parallel()
+
+	s := newMTLSServer(t)
+
+	req := httptest.NewRequest("GET", "/api/v1/projects", nil)
+	req.TLS = nil
+	require.Nil(t, s.gatewayMetadata(context.Background(), req))
+
+	state := verifiedState("reconciler.example.com")
+	req.TLS = &state
+	md := s.gatewayMetadata(context.Background(), req)
+	require.Equal(t, []string{"reconciler"}, md.Get(serviceAccountHeader))
+	require.Equal(t, []string{"gateway-secret"}, md.Get(gatewaySecretHeader))
+
+	for _, header := range []string{"Grpc-Metadata-X-Minder-Service-Account", "Grpc-Metadata-X-Minder-Gateway-Secret"} {
+		_, ok := gatewayIncomingHeaderMatcher(header)
+		require.False(t, ok, header)
+	}
+	key, ok := gatewayIncomingHeaderMatcher("Grpc-Metadata-Request-Id")
+	require.True(t, ok)
+	require.Equal(t, "Request-Id", key)
+}
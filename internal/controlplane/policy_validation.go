@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	"github.com/mindersec/minder/internal/projects"
+	"github.com/mindersec/minder/internal/util"
+)
+
+// validationWebhookTimeout bounds the time a validation webhook may take to
+// approve or deny a change.
+const validationWebhookTimeout = 10 * time.Second
+
+// validatePolicyChange submits a proposed profile or rule type to the
+// validation webhooks of the project and its ancestors, and returns a user
+// visible error unless they all approve it.
+func (s *Server) validatePolicyChange(
+	ctx context.Context, projectID uuid.UUID, kind, operation, objectID string, object proto.Message,
+) error {
+	webhooks, err := projects.GetValidationWebhooks(ctx, s.store, projectID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error getting validation webhooks: %v", err)
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	encoded, err := protojson.Marshal(object)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error encoding %s: %v", kind, err)
+	}
+	change := &projects.PolicyChange{
+		ProjectID: projectID,
+		Kind:      kind,
+		Operation: operation,
+		ObjectID:  objectID,
+		Object:    encoded,
+	}
+
+	// Webhooks are configured by users, so they may not reach the internal network
+	client := &http.Client{
+		Transport: rego.LimitedDialer(nil),
+		Timeout:   validationWebhookTimeout,
+	}
+
+	for _, webhook := range webhooks {
+		result, err := webhook.Validate(ctx, client, s.cryptoEngine, change)
+		if err != nil {
+			logger := zerolog.Ctx(ctx).Warn().Err(err).
+				Str("project_id", projectID.String()).
+				Str("webhook", webhook.URL)
+			if webhook.FailOpen {
+				logger.Msg("validation webhook failed, accepting change")
+				continue
+			}
+			logger.Msg("validation webhook failed, denying change")
+			return util.UserVisibleError(codes.Unavailable, "the change could not be validated by the validation webhook")
+		}
+		if !result.Allowed {
+			if result.Message == "" {
+				return util.UserVisibleError(codes.FailedPrecondition, "the change was denied by the validation webhook")
+			}
+			return util.UserVisibleError(codes.FailedPrecondition,
+				"the change was denied by the validation webhook: %s", result.Message)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+
+	"github.com/mindersec/minder/internal/pipeline"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+const (
+	// pprofPath serves the Go runtime profiles on the metrics server
+	pprofPath = "/debug/pprof/"
+	// pipelinePath serves the evaluation pipeline report on the metrics server
+	pipelinePath = "/admin/pipeline"
+)
+
+// registerProfilingHandlers serves the pprof profiles and the evaluation
+// pipeline report on the mux, behind the bearer token of the configuration
+func registerProfilingHandlers(
+	mux *http.ServeMux,
+	cfg *serverconfig.ProfilingConfig,
+	monitor *pipeline.Monitor,
+) error {
+	token, err := cfg.GetToken()
+	if err != nil {
+		return fmt.Errorf("could not read profiling token: %w", err)
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return errors.New("profiling is enabled, but no token is configured")
+	}
+
+	authn := func(h http.Handler) http.Handler {
+		return withBearerToken(token, h)
+	}
+
+	mux.Handle(pprofPath, authn(http.HandlerFunc(pprof.Index)))
+	mux.Handle(pprofPath+"cmdline", authn(http.HandlerFunc(pprof.Cmdline)))
+	mux.Handle(pprofPath+"profile", authn(http.HandlerFunc(pprof.Profile)))
+	mux.Handle(pprofPath+"symbol", authn(http.HandlerFunc(pprof.Symbol)))
+	mux.Handle(pprofPath+"trace", authn(http.HandlerFunc(pprof.Trace)))
+	if monitor != nil {
+		mux.Handle(pipelinePath, authn(monitor.Handler()))
+	}
+	return nil
+}
+
+// withBearerToken rejects the requests which don't carry the token in their
+// authorization header
+func withBearerToken(token string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
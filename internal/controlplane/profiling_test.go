@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/pipeline"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestRegisterProfilingHandlers(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	err := registerProfilingHandlers(mux, &serverconfig.ProfilingConfig{
+		Enabled: true,
+		Token:   "s3cr3t\n",
+	}, pipeline.NewMonitor())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		path         string
+		auth         string
+		expectedCode int
+	}{
+		{
+			name:         "pprof index with token",
+			path:         pprofPath,
+			auth:         "Bearer s3cr3t",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "pipeline report with token",
+			path:         pipelinePath,
+			auth:         "Bearer s3cr3t",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "pprof index without token",
+			path:         pprofPath,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "pipeline report with wrong token",
+			path:         pipelinePath,
+			auth:         "Bearer nope",
+			expectedCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+			require.Equal(t, tt.expectedCode, rec.Code)
+		})
+	}
+}
+
+func TestRegisterProfilingHandlersRequiresToken(t *testing.T) {
+	t.Parallel()
+
+	err := registerProfilingHandlers(http.NewServeMux(), &serverconfig.ProfilingConfig{Enabled: true}, nil)
+	require.Error(t, err)
+}
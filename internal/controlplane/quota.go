@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+)
+
+// retryAfterHeader is the metadata key with the number of seconds a client
+// should wait before retrying a request rejected because of its quota. The
+// HTTP gateway forwards it as the Retry-After header.
+const retryAfterHeader = "retry-after"
+
+// QuotaInterceptor is a server interceptor that enforces the API request
+// quotas of the projects, when they are enabled. Requests exceeding the quota
+// of their project are rejected with RESOURCE_EXHAUSTED.
+func QuotaInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+
+	server, ok := info.Server.(*Server)
+	if !ok || server.quotaLimiter == nil {
+		return handler(ctx, req)
+	}
+
+	projectID := engcontext.EntityFromContext(ctx).Project.ID
+	if projectID == uuid.Nil {
+		return handler(ctx, req)
+	}
+
+	allowed, retryAfter, err := server.quotaLimiter.Allow(ctx, projectID)
+	if err != nil {
+		// Don't turn a database hiccup into an outage of the whole API
+		zerolog.Ctx(ctx).Error().Err(err).Str("project_id", projectID.String()).
+			Msg("error checking api quota, allowing request")
+		return handler(ctx, req)
+	}
+	if !allowed {
+		secs := strconv.FormatInt(int64(retryAfter.Seconds()), 10)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, secs)); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("error setting retry-after header")
+		}
+		return nil, status.Errorf(codes.ResourceExhausted,
+			"project %s exceeded its API request quota, retry after %s seconds", projectID, secs)
+	}
+
+	return handler(ctx, req)
+}
+
+// gatewayOutgoingHeaderMatcher forwards the retry-after, deprecation and
+// sunset metadata as the standard HTTP headers, and the rest of the metadata
+// as the gateway does by default.
+func gatewayOutgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case retryAfterHeader:
+		return "Retry-After", true
+	case deprecationHeader:
+		return "Deprecation", true
+	case sunsetHeader:
+		return "Sunset", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/quota"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestQuotaInterceptor(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+
+	tests := []struct {
+		name       string
+		project    uuid.UUID
+		buildStubs func(quotas *mockdb.MockAPIQuotasStore)
+		wantCode   codes.Code
+		retryAfter []string
+	}{
+		{
+			name:    "token available",
+			project: projectID,
+			buildStubs: func(quotas *mockdb.MockAPIQuotasStore) {
+				quotas.EXPECT().TakeAPIQuotaToken(gomock.Any(), gomock.Any()).
+					Return(float64(9), nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:    "quota exhausted",
+			project: projectID,
+			buildStubs: func(quotas *mockdb.MockAPIQuotasStore) {
+				quotas.EXPECT().TakeAPIQuotaToken(gomock.Any(), gomock.Any()).
+					Return(float64(0), sql.ErrNoRows)
+				quotas.EXPECT().GetAPIQuotaRetryAfter(gomock.Any(), gomock.Any()).
+					Return(2.5, nil)
+			},
+			wantCode:   codes.ResourceExhausted,
+			retryAfter: []string{"3"},
+		},
+		{
+			name:    "limiter error allows the request",
+			project: projectID,
+			buildStubs: func(quotas *mockdb.MockAPIQuotasStore) {
+				quotas.EXPECT().TakeAPIQuotaToken(gomock.Any(), gomock.Any()).
+					Return(float64(0), errors.New("boom"))
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:       "request without a project",
+			project:    uuid.Nil,
+			buildStubs: func(*mockdb.MockAPIQuotasStore) {},
+			wantCode:   codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			quotas := mockdb.NewMockAPIQuotasStore(ctrl)
+			tt.buildStubs(quotas)
+
+			limiter, err := quota.NewLimiter(quotas, mockdb.NewMockEntitlementsStore(ctrl),
+				&serverconfig.APIQuotaConfig{Enabled: true, Rate: 1, Burst: 10, TierCacheTTL: time.Minute})
+			require.NoError(t, err)
+
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			ctx = engcontext.WithEntityContext(ctx, &engcontext.EntityContext{
+				Project: engcontext.Project{ID: tt.project},
+			})
+
+			called := false
+			_, err = QuotaInterceptor(ctx, nil,
+				&grpc.UnaryServerInfo{Server: &Server{quotaLimiter: limiter}},
+				func(_ context.Context, _ any) (any, error) {
+					called = true
+					return nil, nil
+				})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			require.Equal(t, tt.wantCode == codes.OK, called)
+			require.Equal(t, tt.retryAfter, stream.header.Get(retryAfterHeader))
+		})
+	}
+}
+
+func TestQuotaInterceptorDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: uuid.New()},
+	})
+
+	called := false
+	_, err := QuotaInterceptor(ctx, nil,
+		&grpc.UnaryServerInfo{Server: &Server{}},
+		func(_ context.Context, _ any) (any, error) {
+			called = true
+			return nil, nil
+		})
+	require.NoError(t, err)
+	require.True(t, called)
+}
+
+func TestGatewayOutgoingHeaderMatcher(t *testing.T) {
+	t.Parallel()
+
+	for key, want := range map[string]string{
+		retryAfterHeader:  "Retry-After",
+		deprecationHeader: "Deprecation",
+		sunsetHeader:      "Sunset",
+		warningHeader:     "Grpc-Metadata-minder-warning",
+	} {
+		got, ok := gatewayOutgoingHeaderMatcher(key)
+		require.True(t, ok)
+		require.Equal(t, want, got)
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// verifyRuleTypeSignature verifies the signature of a rule type created or
+// updated through the API, if the signatures of the rule types are verified.
+// The rule type of a verified signature is read from the signed content and
+// returned with the context of the request, so that the verified bytes are
+// the ones which are loaded.
+func (s *Server) verifyRuleTypeSignature(
+	ctx context.Context,
+	rt *minderv1.RuleType,
+	sig *minderv1.RuleTypeSignature,
+) (*minderv1.RuleType, error) {
+	if s.ruleTypeSignatures == nil {
+		return rt, nil
+	}
+	required := s.cfg.RuleTypeSignatures.Required
+
+	if sig == nil {
+		if required {
+			return nil, util.UserVisibleError(codes.FailedPrecondition,
+				"rule type %s is not signed", rt.GetName())
+		}
+		return rt, nil
+	}
+
+	verification := s.ruleTypeSignatures.VerifyJSON(sig.GetContent(), []byte(sig.GetBundle()))
+	if !verification.Verified {
+		if required {
+			return nil, util.UserVisibleError(codes.FailedPrecondition,
+				"rule type %s is not signed by a trusted identity: %s", rt.GetName(), verification.Error)
+		}
+		zerolog.Ctx(ctx).Warn().Str("rule_type", rt.GetName()).Str("reason", verification.Error).
+			Msg("rule type signature could not be verified")
+		return rt, nil
+	}
+
+	signed := &minderv1.RuleType{}
+	if err := minderv1.ParseResource(bytes.NewReader(sig.GetContent()), signed); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument,
+			"invalid signed content of rule type %s: %s", rt.GetName(), err)
+	}
+	signed.Context = rt.GetContext()
+	if !proto.Equal(signed, rt) {
+		return nil, util.UserVisibleError(codes.InvalidArgument,
+			"rule type %s does not match its signed content", rt.GetName())
+	}
+
+	zerolog.Ctx(ctx).Info().Str("rule_type", rt.GetName()).
+		Str("issuer", verification.Issuer).Str("subject", verification.Subject).
+		Msg("verified rule type signature")
+	return signed, nil
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/internal/marketplaces"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// ruleTypeSigBundle is a structurally valid sigstore bundle, the fake
+// verifier doesn't check the signature
+const ruleTypeSigBundle = `{
+  "mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json",
+  "verificationMaterial": {"publicKey": {"hint": "test"}},
+  "messageSignature": {
+    "messageDigest": {"algorithm": "SHA2_256", "digest": "AAAA"},
+    "signature": "AAAA"
+  }
+}`
+
+const signedRuleType = `
+version: v1
+type: rule-type
+name: signed_rule
+display_name: Signed rule
+guidance: Signed guidance
+def:
+  in_entity: repository
+  rule_schema: {}
+  ingest:
+    type: git
+    git: {}
+  eval:
+    type: jq
+    jq:
+      - ingested:
+          def: .abc
+        profile:
+          def: .xyz
+`
+
+// fakeBlobVerifier records the verified content, and fails if err is set
+type fakeBlobVerifier struct {
+	content []byte
+	err     error
+}
+
+func (f *fakeBlobVerifier) VerifyBlob(
+	blob io.Reader, _ *bundle.Bundle, _ []verify.CertificateIdentity,
+) (*verify.VerificationResult, error) {
+	content, err := io.ReadAll(blob)
+	if err != nil {
+		return nil, err
+	}
+	f.content = content
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &verify.VerificationResult{}, nil
+}
+
+func parsedRuleType(t *testing.T, content string) *minderv1.RuleType {
+	t.Helper()
+
+	rt := &minderv1.RuleType{}
+	require.NoError(t, minderv1.ParseResource(bytes.NewReader([]byte(content)), rt))
+	return rt
+}
+
+func TestVerifyRuleTypeSignature(t *testing.T) {
+	t.Parallel()
+
+	project := "a0f3b1e4-5d8c-4c6e-9f0a-2b3c4d5e6f70"
+	ruleCtx := &minderv1.Context{Project: &project}
+
+	tests := []struct {
+		name      string
+		noChecker bool
+		required  bool
+		verifyErr error
+		// modify changes the rule type of the request
+		modify    func(rt *minderv1.RuleType)
+		signature *minderv1.RuleTypeSignature
+		wantCode  codes.Code
+		// wantSigned is true if the verified content is loaded
+		wantSigned bool
+	}{
+		{
+			name:      "signatures are not verified",
+			noChecker: true,
+			required:  true,
+		},
+		{
+			name:     "unsigned rule type is rejected when signatures are required",
+			required: true,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "unsigned rule type is accepted when signatures are optional",
+		},
+		{
+			name:       "signed rule type is loaded from the signed content",
+			required:   true,
+			signature:  &minderv1.RuleTypeSignature{Content: []byte(signedRuleType), Bundle: ruleTypeSigBundle},
+			wantSigned: true,
+		},
+		{
+			name:     "rule type not matching its signed content is rejected",
+			required: true,
+			modify: func(rt *minderv1.RuleType) {
+				rt.Guidance = "Tampered guidance"
+			},
+			signature: &minderv1.RuleTypeSignature{Content: []byte(signedRuleType), Bundle: ruleTypeSigBundle},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "untrusted signature is rejected when signatures are required",
+			required:  true,
+			verifyErr: errors.New("no matching certificate identity"),
+			signature: &minderv1.RuleTypeSignature{Content: []byte(signedRuleType), Bundle: ruleTypeSigBundle},
+			wantCode:  codes.FailedPrecondition,
+		},
+		{
+			name:      "untrusted signature is accepted when signatures are optional",
+			verifyErr: errors.New("no matching certificate identity"),
+			signature: &minderv1.RuleTypeSignature{Content: []byte(signedRuleType), Bundle: ruleTypeSigBundle},
+		},
+		{
+			name:      "invalid signature bundle is rejected when signatures are required",
+			required:  true,
+			signature: &minderv1.RuleTypeSignature{Content: []byte(signedRuleType), Bundle: "not a bundle"},
+			wantCode:  codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := serverconfig.BundleSignaturesConfig{
+				Required: tt.required,
+				Identities: []serverconfig.SignerIdentityConfig{{
+					Issuer:        "https://token.actions.githubusercontent.com",
+					SubjectRegexp: "^https://github.com/mindersec/",
+				}},
+			}
+			verifier := &fakeBlobVerifier{err: tt.verifyErr}
+			srv := &Server{cfg: &serverconfig.Config{RuleTypeSignatures: cfg}}
+			if !tt.noChecker {
+				checker, err := marketplaces.NewSignatureCheckerWithVerifier(&cfg, verifier)
+				require.NoError(t, err)
+				srv.ruleTypeSignatures = checker
+			}
+
+			rt := parsedRuleType(t, signedRuleType)
+			rt.Context = ruleCtx
+			if tt.modify != nil {
+				tt.modify(rt)
+			}
+
+			got, err := srv.verifyRuleTypeSignature(context.Background(), rt, tt.signature)
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(rt, got))
+			if tt.wantSigned {
+				require.NotSame(t, rt, got, "the rule type should be read from the signed content")
+				require.Equal(t, signedRuleType, string(verifier.content))
+				require.Equal(t, project, got.GetContext().GetProject())
+			} else {
+				require.Same(t, rt, got)
+			}
+		})
+	}
+}
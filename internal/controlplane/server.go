@@ -5,6 +5,8 @@ package controlplane
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -34,15 +36,18 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 
+	"github.com/mindersec/minder/database"
 	"github.com/mindersec/minder/internal/api"
 	"github.com/mindersec/minder/internal/assets"
 	"github.com/mindersec/minder/internal/auth"
 	"github.com/mindersec/minder/internal/auth/jwt"
+	"github.com/mindersec/minder/internal/auth/mtls"
 	"github.com/mindersec/minder/internal/authz"
 	"github.com/mindersec/minder/internal/constants"
 	"github.com/mindersec/minder/internal/controlplane/metrics"
@@ -54,15 +59,22 @@ import (
 	"github.com/mindersec/minder/internal/history"
 	"github.com/mindersec/minder/internal/invites"
 	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/marketplaces"
+	"github.com/mindersec/minder/internal/pipeline"
+	"github.com/mindersec/minder/internal/projectcache"
 	"github.com/mindersec/minder/internal/projects"
 	"github.com/mindersec/minder/internal/providers"
 	ghprov "github.com/mindersec/minder/internal/providers/github"
 	"github.com/mindersec/minder/internal/providers/github/service"
 	"github.com/mindersec/minder/internal/providers/github/webhook"
 	"github.com/mindersec/minder/internal/providers/manager"
+	registrywebhook "github.com/mindersec/minder/internal/providers/registry/webhook"
 	"github.com/mindersec/minder/internal/providers/session"
+	providerusage "github.com/mindersec/minder/internal/providers/usage"
+	"github.com/mindersec/minder/internal/quota"
 	reposvc "github.com/mindersec/minder/internal/repositories"
 	"github.com/mindersec/minder/internal/roles"
+	"github.com/mindersec/minder/internal/usage"
 	"github.com/mindersec/minder/internal/util"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
@@ -116,6 +128,18 @@ type Server struct {
 	projectCreator      projects.ProjectCreator
 	projectDeleter      projects.ProjectDeleter
 	idManager           auth.IdentityManager
+	usageTracker        *usage.Tracker
+	providerUsage       *providerusage.Recorder
+	quotaLimiter        *quota.Limiter
+	pipelineMonitor     *pipeline.Monitor
+	// phaseGate tells whether the migration phases completed, and is nil if
+	// the schema is always fully migrated, e.g. in tests
+	phaseGate *database.PhaseGate
+	// ruleTypeSignatures verifies the signatures of the rule types, and is
+	// nil if they are not verified
+	ruleTypeSignatures *marketplaces.SignatureChecker
+	// gatewaySecret authenticates the metadata set by the HTTP gateway
+	gatewaySecret string
 
 	// Implementations for service registration
 	pb.UnimplementedHealthServiceServer
@@ -134,6 +158,57 @@ type Server struct {
 	pb.UnimplementedEntityInstanceServiceServer
 }
 
+// ServerOption is a functional option for the server
+type ServerOption func(*Server)
+
+// WithUsageTracker reports the usage of the projects. A nil tracker disables
+// the project usage endpoint.
+func WithUsageTracker(t *usage.Tracker) ServerOption {
+	return func(s *Server) {
+		s.usageTracker = t
+	}
+}
+
+// WithProviderUsage accounts the GitHub webhooks delivered to each
+// installation. A nil recorder accounts nothing.
+func WithProviderUsage(r *providerusage.Recorder) ServerOption {
+	return func(s *Server) {
+		s.providerUsage = r
+	}
+}
+
+// WithQuotaLimiter enforces the API quotas of the projects. A nil limiter
+// disables the quotas.
+func WithQuotaLimiter(l *quota.Limiter) ServerOption {
+	return func(s *Server) {
+		s.quotaLimiter = l
+	}
+}
+
+// WithPipelineMonitor serves the report of the evaluation pipeline next to
+// the profiles. A nil monitor serves no report.
+func WithPipelineMonitor(m *pipeline.Monitor) ServerOption {
+	return func(s *Server) {
+		s.pipelineMonitor = m
+	}
+}
+
+// WithRuleTypeSignatures verifies the signatures of the rule types created
+// through the API. A nil checker verifies nothing.
+func WithRuleTypeSignatures(c *marketplaces.SignatureChecker) ServerOption {
+	return func(s *Server) {
+		s.ruleTypeSignatures = c
+	}
+}
+
+// WithPhaseGate only enables the features relying on the migration phases
+// once the phases completed. A nil gate enables them right away.
+func WithPhaseGate(g *database.PhaseGate) ServerOption {
+	return func(s *Server) {
+		s.phaseGate = g
+	}
+}
+
 // NewServer creates a new server instance
 func NewServer(
 	store db.Store,
@@ -163,8 +238,9 @@ func NewServer(
 	entityService entitySvc.EntityService,
 	entityCreator entitySvc.EntityCreator,
 	featureFlagClient flags.Interface,
+	opts ...ServerOption,
 ) *Server {
-	return &Server{
+	s := &Server{
 		store:               store,
 		cfg:                 cfg,
 		evt:                 evt,
@@ -193,7 +269,12 @@ func NewServer(
 		idManager:           idManager,
 		projectCreator:      projectCreator,
 		projectDeleter:      projectDeleter,
+		gatewaySecret:       rand.Text(),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *Server) initTracer() (*sdktrace.TracerProvider, error) {
@@ -261,20 +342,35 @@ func (s *Server) StartGRPCServer(ctx context.Context) error {
 	interceptors := []grpc.UnaryServerInterceptor{
 		// TODO: this has no test coverage!
 		util.SanitizingInterceptor(),
+		CompatibilityInterceptor,
 		api.ProtoValidationInterceptor(validator),
 		// This adds `Grpc-Metadata-Request-Id` to the
 		// response.
 		logger.RequestIDInterceptor("request-id"),
 		logger.Interceptor(s.cfg.LoggingConfig),
+		projectcache.Interceptor,
 		s.TokenValidationInterceptor,
 		EntityContextProjectInterceptor,
 		ProjectAuthorizationInterceptor,
+		OperationApprovalInterceptor,
+		QuotaInterceptor,
+		UsageInterceptor,
 		VersionHeaderInterceptor(),
+		DeprecationInterceptor,
 		recovery.UnaryServerInterceptor(recovery.WithRecoveryHandlerContext(recoveryHandler)),
 	}
 
+	creds := insecure.NewCredentials()
+	if s.cfg.MTLS.Enabled {
+		tlsConfig, err := mtls.ServerTLSConfig(&s.cfg.MTLS)
+		if err != nil {
+			return fmt.Errorf("failed to create TLS config: %w", err)
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
 	options := []grpc.ServerOption{
-		grpc.Creds(insecure.NewCredentials()),
+		grpc.Creds(creds),
 		grpc.ChainUnaryInterceptor(interceptors...),
 	}
 
@@ -345,8 +441,23 @@ func (s *Server) StartHTTPServer(ctx context.Context) error {
 		})
 	}
 
-	gwmux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	var tlsConfig *tls.Config
+	gwcreds := insecure.NewCredentials()
+	if s.cfg.MTLS.Enabled {
+		var err error
+		tlsConfig, err = mtls.ServerTLSConfig(&s.cfg.MTLS)
+		if err != nil {
+			return fmt.Errorf("failed to create TLS config: %w", err)
+		}
+		gwcreds = credentials.NewTLS(mtls.LoopbackTLSConfig(tlsConfig))
+	}
+
+	gwmux := runtime.NewServeMux(
+		runtime.WithOutgoingHeaderMatcher(gatewayOutgoingHeaderMatcher),
+		runtime.WithIncomingHeaderMatcher(gatewayIncomingHeaderMatcher),
+		runtime.WithMetadata(s.gatewayMetadata),
+	)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(gwcreds)}
 
 	// register the services (declared within register_handlers.go)
 	RegisterGatewayHTTPHandlers(ctx, gwmux, s.cfg.GRPCServer.GetAddress(), opts)
@@ -386,15 +497,25 @@ func (s *Server) StartHTTPServer(ctx context.Context) error {
 		}
 
 		zerolog.Ctx(ctx).Debug().Str("class-path", path).Msg("registering provider class webhook handler")
-		mux.Handle(path, otelmw(withMiddleware(handler)))
+		mux.Handle(path, otelmw(withMiddleware(s.providerUsage.WebhookMiddleware(handler))))
 	}
 
 	// GitHub is a special case, as it has a separate handler for app events and uses a noop handler
 	// for marketplace events
 	appHandler := webhook.HandleGitHubAppWebhook(s.store, s.ghProviders, s.mt, s.evt)
-	mux.Handle("/api/v1/ghapp/", otelmw(withMiddleware(appHandler)))
+	mux.Handle("/api/v1/ghapp/", otelmw(withMiddleware(s.providerUsage.WebhookMiddleware(appHandler))))
 	mux.Handle("/api/v1/gh-marketplace/", otelmw(withMiddleware(webhook.NoopWebhookHandler(s.mt))))
 
+	// Container registries other than GitHub notify about image pushes through
+	// a generic webhook, which is only served when it's configured
+	if s.cfg.WebhookConfig.Registry.Enabled {
+		registryHandler, err := registrywebhook.HandleRegistryWebhook(s.store, s.mt, s.evt, &s.cfg.WebhookConfig.Registry)
+		if err != nil {
+			return fmt.Errorf("failed to create registry webhook handler: %w", err)
+		}
+		mux.Handle(registrywebhook.Path, otelmw(withMiddleware(registryHandler)))
+	}
+
 	mux.Handle("/static/", fs)
 
 	errch := make(chan error)
@@ -405,6 +526,7 @@ func (s *Server) StartHTTPServer(ctx context.Context) error {
 		Addr:              s.cfg.HTTPServer.GetAddress(),
 		Handler:           mux,
 		ReadHeaderTimeout: readHeaderTimeout,
+		TLSConfig:         tlsConfig,
 	}
 
 	// start the metrics server if enabled
@@ -418,7 +540,14 @@ func (s *Server) StartHTTPServer(ctx context.Context) error {
 
 	// start the HTTP server
 	go func() {
-		if err := server.ListenAndServe(); err != nil {
+		var err error
+		if tlsConfig != nil {
+			// The certificate is already part of the TLS configuration
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil {
 			errch <- fmt.Errorf("failed to serve: %w", err)
 		}
 	}()
@@ -491,6 +620,11 @@ func (s *Server) startMetricServer(ctx context.Context) error {
 	handler := promhttp.Handler()
 	mux := http.NewServeMux()
 	mux.Handle(metricsPath, handler)
+	if s.cfg.Profiling.Enabled {
+		if err := registerProfilingHandlers(mux, &s.cfg.Profiling, s.pipelineMonitor); err != nil {
+			return err
+		}
+	}
 
 	ch := make(chan error)
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
@@ -23,6 +24,9 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
 
 	mockdb "github.com/mindersec/minder/database/mock"
 	"github.com/mindersec/minder/internal/auth"
@@ -31,14 +35,17 @@ import (
 	"github.com/mindersec/minder/internal/constants"
 	"github.com/mindersec/minder/internal/controlplane/metrics"
 	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
 	mock_service "github.com/mindersec/minder/internal/entities/properties/service/mock"
 	"github.com/mindersec/minder/internal/providers"
 	ghclient "github.com/mindersec/minder/internal/providers/github/clients"
 	ghService "github.com/mindersec/minder/internal/providers/github/service"
+	mockmanager "github.com/mindersec/minder/internal/providers/manager/mock"
 	mock_reposvc "github.com/mindersec/minder/internal/repositories/mock"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/eventer"
+	"github.com/mindersec/minder/pkg/flags"
 )
 
 const bufSize = 1024 * 1024
@@ -235,6 +242,55 @@ func TestGetVersion(t *testing.T) {
 	require.Equal(t, constants.Revision, resp.Commit)
 }
 
+func TestGetServerMetadata(t *testing.T) {
+	t.Parallel()
+
+	server := &Server{}
+
+	resp, err := server.GetServerMetadata(context.Background(), &pb.GetServerMetadataRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, constants.CLIVersion, resp.GetVersion())
+	require.Equal(t, constants.Revision, resp.GetCommit())
+	require.True(t, json.Valid([]byte(resp.GetOpenapiSpec())))
+
+	var descriptors descriptorpb.FileDescriptorSet
+	require.NoError(t, proto.Unmarshal(resp.GetFileDescriptorSet(), &descriptors))
+	files, err := protodesc.NewFiles(&descriptors)
+	require.NoError(t, err, "descriptors should be complete")
+	_, err = files.FindDescriptorByName("minder.v1.HealthService")
+	require.NoError(t, err)
+}
+
+func TestGetServerCapabilities(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	providerManager := mockmanager.NewMockProviderManager(ctrl)
+	providerManager.EXPECT().ListSupportedClasses().
+		Return([]db.ProviderClass{db.ProviderClassGithub, db.ProviderClassGithubApp})
+
+	cfg := serverconfig.DefaultConfigForTest()
+	server := &Server{
+		cfg:             cfg,
+		providerManager: providerManager,
+		featureFlags: &flags.FakeClient{Data: map[string]any{
+			string(flags.ProjectCreateDelete): true,
+			string(flags.GitLabProvider):      false,
+		}},
+	}
+
+	resp, err := server.GetServerCapabilities(context.Background(), &pb.GetServerCapabilitiesRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, constants.CLIVersion, resp.GetVersion())
+	require.Equal(t, []string{string(flags.ProjectCreateDelete)}, resp.GetFeatureFlags())
+	require.Equal(t, []string{"github", "github-app"}, resp.GetProviderClasses())
+	require.Contains(t, resp.GetEvaluationEngines(), "rego")
+	require.Equal(t, cfg.RuleLimits.MaxDataSourceCalls, resp.GetLimits().GetRuleEvaluation().GetMaxDataSourceCalls())
+	require.Equal(t, cfg.ProfileStatusSharing.MaxExpiration, resp.GetLimits().GetMaxShareLinkExpiration().AsDuration())
+}
+
 func TestVersionHeaderInterceptor(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+)
+
+// UsageInterceptor is a server interceptor that accounts the API calls made
+// on behalf of each project, when per-project usage tracking is enabled
+func UsageInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+
+	server, ok := info.Server.(*Server)
+	if !ok || server.usageTracker == nil {
+		return handler(ctx, req)
+	}
+
+	if projectID := engcontext.EntityFromContext(ctx).Project.ID; projectID != uuid.Nil {
+		server.usageTracker.RecordAPICall(ctx, projectID)
+	}
+
+	return handler(ctx, req)
+}
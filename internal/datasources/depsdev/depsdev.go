@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package depsdev implements a package reputation data source backed by the
+// deps.dev API.
+//
+// Each function of the data source takes the ecosystem, name and, optionally,
+// version of a package and returns its reputation data. If the version is
+// not given, the default version of the package is used.
+//
+// An example of the output is:
+//
+//	{
+//	  "found": true,
+//	  "system": "NPM",
+//	  "name": "left-pad",
+//	  "version": "1.3.0",
+//	  "published_at": "2018-04-09T01:43:28Z",
+//	  "deprecated": true,
+//	  "licenses": ["WTFPL"],
+//	  "advisories": [],
+//	  "source_repository": "github.com/stevemao/left-pad",
+//	  "scorecard": {
+//	    "score": 3.2,
+//	    "checks": {
+//	      "Maintained": 0
+//	    }
+//	  }
+//	}
+//
+// When deps.dev doesn't know about the package, "found" is false and the
+// remaining reputation fields are left out. When the package has no known
+// source repository, or the repository has no scorecard, "scorecard" is null.
+package depsdev
+
+import (
+	"errors"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+)
+
+type depsDevDataSource struct {
+	handlers map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef
+}
+
+// ensure that depsDevDataSource implements the v1datasources.DataSource interface
+var _ v1datasources.DataSource = (*depsDevDataSource)(nil)
+
+// GetFuncs implements the v1datasources.DataSource interface.
+func (d *depsDevDataSource) GetFuncs() map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef {
+	return d.handlers
+}
+
+// NewDepsDevDataSource builds a new deps.dev data source.
+func NewDepsDevDataSource(
+	ds *minderv1.DepsDevDataSource,
+	opts ...v1datasources.Option,
+) (v1datasources.DataSource, error) {
+	if ds == nil {
+		return nil, errors.New("deps_dev data source is nil")
+	}
+
+	if ds.GetDef() == nil {
+		return nil, errors.New("deps_dev data source definition is nil")
+	}
+
+	dOpts := &v1datasources.Options{}
+	for _, opt := range opts {
+		opt(dOpts)
+	}
+
+	out := &depsDevDataSource{
+		handlers: make(map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef, len(ds.GetDef())),
+	}
+
+	for key, handlerCfg := range ds.GetDef() {
+		handler, err := newHandlerFromDef(handlerCfg, dOpts.TestOnlyTransport)
+		if err != nil {
+			return nil, err
+		}
+
+		out.handlers[v1datasources.DataSourceFuncKey(key)] = handler
+	}
+
+	return out, nil
+}
@@ -0,0 +1,337 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package depsdev
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	"github.com/mindersec/minder/internal/util/schemaupdate"
+	"github.com/mindersec/minder/internal/util/schemavalidate"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	// DefaultEndpoint is the base URL of the public deps.dev API
+	DefaultEndpoint = "https://api.deps.dev"
+
+	// MaxBytesLimit is the maximum number of bytes to read from a response body
+	MaxBytesLimit int64 = 1 << 20
+
+	requestTimeout = 5 * time.Second
+)
+
+// ecosystemSystems maps the ecosystem names used by Minder to the package
+// systems known by deps.dev.
+var ecosystemSystems = map[string]string{
+	"npm":      "NPM",
+	"go":       "GO",
+	"pypi":     "PYPI",
+	"cargo":    "CARGO",
+	"maven":    "MAVEN",
+	"nuget":    "NUGET",
+	"rubygems": "RUBYGEMS",
+}
+
+// argsSchema is the schema of the arguments all the functions of the data
+// source take. The ecosystem accepts either the short name (e.g. "npm") or
+// the dependency ecosystem enum name (e.g. "DEP_ECOSYSTEM_NPM").
+var argsSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"ecosystem": map[string]any{
+			"type":        "string",
+			"description": "The ecosystem of the package, e.g. npm, go or pypi",
+		},
+		"name": map[string]any{
+			"type":        "string",
+			"description": "The name of the package",
+		},
+		"version": map[string]any{
+			"type":        "string",
+			"description": "The version of the package, defaults to the latest release",
+		},
+	},
+	"required": []any{"ecosystem", "name"},
+}
+
+var _ v1datasources.DataSourceFuncDef = (*depsDevHandler)(nil)
+
+type depsDevHandler struct {
+	rawInputSchema *structpb.Struct
+	inputSchema    *jsonschema.Schema
+	endpoint       string
+	// used only to allow requests to localhost during tests
+	testOnlyTransport http.RoundTripper
+}
+
+func newHandlerFromDef(
+	def *minderv1.DepsDevDataSource_Def,
+	testOnlyTransport http.RoundTripper,
+) (*depsDevHandler, error) {
+	if def == nil {
+		return nil, errors.New("deps_dev data source handler definition is nil")
+	}
+
+	rawSchema, err := structpb.NewStruct(argsSchema)
+	if err != nil {
+		return nil, fmt.Errorf("cannot build args schema: %w", err)
+	}
+
+	schema, err := schemavalidate.CompileSchemaFromPB(rawSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	return &depsDevHandler{
+		rawInputSchema:    rawSchema,
+		inputSchema:       schema,
+		endpoint:          strings.TrimSuffix(cmp.Or(def.GetEndpoint(), DefaultEndpoint), "/"),
+		testOnlyTransport: testOnlyTransport,
+	}, nil
+}
+
+func (h *depsDevHandler) GetArgsSchema() *structpb.Struct {
+	return h.rawInputSchema
+}
+
+func (h *depsDevHandler) ValidateArgs(args any) error {
+	mapobj, ok := args.(map[string]any)
+	if !ok {
+		return errors.New("args is not a map")
+	}
+
+	return schemavalidate.ValidateAgainstSchema(h.inputSchema, mapobj)
+}
+
+func (h *depsDevHandler) ValidateUpdate(argsSchema *structpb.Struct) error {
+	if argsSchema == nil {
+		return errors.New("update schema cannot be nil")
+	}
+
+	return schemaupdate.ValidateSchemaUpdate(h.rawInputSchema, argsSchema)
+}
+
+func (h *depsDevHandler) Call(ctx context.Context, _ *interfaces.Ingested, args any) (any, error) {
+	argsMap, ok := args.(map[string]any)
+	if !ok {
+		return nil, errors.New("args is not a map")
+	}
+
+	ecosystem, _ := argsMap["ecosystem"].(string)
+	name, _ := argsMap["name"].(string)
+	version, _ := argsMap["version"].(string)
+	if name == "" {
+		return nil, errors.New("package name is empty")
+	}
+
+	system, err := systemForEcosystem(ecosystem)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := h.testOnlyTransport
+	if transport == nil {
+		transport = rego.LimitedDialer(nil)
+	}
+	cli := &http.Client{
+		Timeout: requestTimeout,
+		// Don't allow calling non-public addresses.
+		Transport: transport,
+	}
+
+	out := map[string]any{
+		"found":   false,
+		"system":  system,
+		"name":    name,
+		"version": version,
+	}
+
+	if version == "" {
+		version, err = h.getDefaultVersion(ctx, cli, system, name)
+		if errors.Is(err, errNotFound) || (err == nil && version == "") {
+			return out, nil
+		} else if err != nil {
+			return nil, err
+		}
+		out["version"] = version
+	}
+
+	var ver versionResponse
+	err = h.get(ctx, cli, &ver, "v3", "systems", system, "packages", name, "versions", version)
+	if errors.Is(err, errNotFound) {
+		return out, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	advisories := make([]any, 0, len(ver.AdvisoryKeys))
+	for _, adv := range ver.AdvisoryKeys {
+		advisories = append(advisories, adv.ID)
+	}
+	licenses := make([]any, 0, len(ver.Licenses))
+	for _, l := range ver.Licenses {
+		licenses = append(licenses, l)
+	}
+
+	out["found"] = true
+	out["published_at"] = ver.PublishedAt
+	out["deprecated"] = ver.IsDeprecated
+	out["licenses"] = licenses
+	out["advisories"] = advisories
+	out["source_repository"] = ""
+	out["scorecard"] = nil
+
+	repo := ver.sourceRepository()
+	if repo == "" {
+		return out, nil
+	}
+	out["source_repository"] = repo
+
+	var proj projectResponse
+	err = h.get(ctx, cli, &proj, "v3", "projects", repo)
+	if errors.Is(err, errNotFound) {
+		return out, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if proj.Scorecard != nil {
+		checks := make(map[string]any, len(proj.Scorecard.Checks))
+		for _, c := range proj.Scorecard.Checks {
+			checks[c.Name] = c.Score
+		}
+		out["scorecard"] = map[string]any{
+			"score":  proj.Scorecard.OverallScore,
+			"checks": checks,
+		}
+	}
+
+	return out, nil
+}
+
+func (h *depsDevHandler) getDefaultVersion(
+	ctx context.Context, cli *http.Client, system, name string,
+) (string, error) {
+	var pkg packageResponse
+	if err := h.get(ctx, cli, &pkg, "v3", "systems", system, "packages", name); err != nil {
+		return "", err
+	}
+
+	for _, v := range pkg.Versions {
+		if v.IsDefault {
+			return v.VersionKey.Version, nil
+		}
+	}
+
+	return "", nil
+}
+
+var errNotFound = errors.New("not found")
+
+// get fetches the given path segments from the deps.dev API and decodes the
+// JSON response into out. The segments are escaped, since package names
+// may contain slashes (e.g. "@types/node").
+func (h *depsDevHandler) get(ctx context.Context, cli *http.Client, out any, segments ...string) error {
+	escaped := make([]string, 0, len(segments))
+	for _, s := range segments {
+		escaped = append(escaped, url.PathEscape(s))
+	}
+	endpoint := h.endpoint + "/" + strings.Join(escaped, "/")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from deps.dev: %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, MaxBytesLimit)).Decode(out); err != nil {
+		return fmt.Errorf("cannot decode deps.dev response: %w", err)
+	}
+
+	return nil
+}
+
+func systemForEcosystem(ecosystem string) (string, error) {
+	key := strings.TrimPrefix(strings.ToLower(ecosystem), "dep_ecosystem_")
+	system, ok := ecosystemSystems[key]
+	if !ok {
+		return "", fmt.Errorf("unsupported ecosystem %q", ecosystem)
+	}
+	return system, nil
+}
+
+type versionKey struct {
+	Version string `json:"version"`
+}
+
+type packageResponse struct {
+	Versions []struct {
+		VersionKey versionKey `json:"versionKey"`
+		IsDefault  bool       `json:"isDefault"`
+	} `json:"versions"`
+}
+
+type versionResponse struct {
+	PublishedAt  string   `json:"publishedAt"`
+	IsDeprecated bool     `json:"isDeprecated"`
+	Licenses     []string `json:"licenses"`
+	AdvisoryKeys []struct {
+		ID string `json:"id"`
+	} `json:"advisoryKeys"`
+	RelatedProjects []struct {
+		ProjectKey struct {
+			ID string `json:"id"`
+		} `json:"projectKey"`
+		RelationType string `json:"relationType"`
+	} `json:"relatedProjects"`
+}
+
+// sourceRepository returns the project deps.dev considers the source
+// repository of the version, if any.
+func (v *versionResponse) sourceRepository() string {
+	for _, p := range v.RelatedProjects {
+		if p.RelationType == "SOURCE_REPO" {
+			return p.ProjectKey.ID
+		}
+	}
+	return ""
+}
+
+type projectResponse struct {
+	Scorecard *struct {
+		OverallScore float64 `json:"overallScore"`
+		Checks       []struct {
+			Name  string  `json:"name"`
+			Score float64 `json:"score"`
+		} `json:"checks"`
+	} `json:"scorecard"`
+}
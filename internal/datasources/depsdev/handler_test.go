@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package depsdev
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+)
+
+const (
+	leftPadPackage = `{"versions": [
+		{"versionKey": {"version": "1.2.0"}, "isDefault": false},
+		{"versionKey": {"version": "1.3.0"}, "isDefault": true}
+	]}`
+	leftPadVersion = `{
+		"publishedAt": "2018-04-09T01:43:28Z",
+		"isDeprecated": true,
+		"licenses": ["WTFPL"],
+		"advisoryKeys": [{"id": "GHSA-xxxx-yyyy-zzzz"}],
+		"relatedProjects": [
+			{"projectKey": {"id": "github.com/stevemao/left-pad"}, "relationType": "SOURCE_REPO"}
+		]
+	}`
+	leftPadProject = `{"scorecard": {
+		"overallScore": 3.2,
+		"checks": [{"name": "Maintained", "score": 0}, {"name": "License", "score": 10}]
+	}}`
+	typesNodeVersion = `{"publishedAt": "2024-01-01T00:00:00Z", "licenses": ["MIT"]}`
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	responses := map[string]string{
+		"/v3/systems/NPM/packages/left-pad":                      leftPadPackage,
+		"/v3/systems/NPM/packages/left-pad/versions/1.3.0":       leftPadVersion,
+		"/v3/projects/github.com%2Fstevemao%2Fleft-pad":          leftPadProject,
+		"/v3/systems/NPM/packages/@types%2Fnode/versions/20.0.0": typesNodeVersion,
+		"/v3/systems/PYPI/packages/broken/versions/1.0.0":        `{not json`,
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.EscapedPath()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDepsDevHandler_Call(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	tests := []struct {
+		name    string
+		args    map[string]any
+		want    map[string]any
+		wantErr string
+	}{
+		{
+			name: "default version with scorecard",
+			args: map[string]any{"ecosystem": "npm", "name": "left-pad"},
+			want: map[string]any{
+				"found":             true,
+				"system":            "NPM",
+				"name":              "left-pad",
+				"version":           "1.3.0",
+				"published_at":      "2018-04-09T01:43:28Z",
+				"deprecated":        true,
+				"licenses":          []any{"WTFPL"},
+				"advisories":        []any{"GHSA-xxxx-yyyy-zzzz"},
+				"source_repository": "github.com/stevemao/left-pad",
+				"scorecard": map[string]any{
+					"score":  3.2,
+					"checks": map[string]any{"Maintained": 0.0, "License": 10.0},
+				},
+			},
+		},
+		{
+			name: "dependency ecosystem enum name and scoped package",
+			args: map[string]any{"ecosystem": "DEP_ECOSYSTEM_NPM", "name": "@types/node", "version": "20.0.0"},
+			want: map[string]any{
+				"found":             true,
+				"system":            "NPM",
+				"name":              "@types/node",
+				"version":           "20.0.0",
+				"published_at":      "2024-01-01T00:00:00Z",
+				"deprecated":        false,
+				"licenses":          []any{"MIT"},
+				"advisories":        []any{},
+				"source_repository": "",
+				"scorecard":         nil,
+			},
+		},
+		{
+			name: "unknown package",
+			args: map[string]any{"ecosystem": "pypi", "name": "does-not-exist", "version": "0.1.0"},
+			want: map[string]any{
+				"found":   false,
+				"system":  "PYPI",
+				"name":    "does-not-exist",
+				"version": "0.1.0",
+			},
+		},
+		{
+			name:    "unsupported ecosystem",
+			args:    map[string]any{"ecosystem": "cocoapods", "name": "AFNetworking"},
+			wantErr: `unsupported ecosystem "cocoapods"`,
+		},
+		{
+			name:    "invalid response",
+			args:    map[string]any{"ecosystem": "PyPI", "name": "broken", "version": "1.0.0"},
+			wantErr: "cannot decode deps.dev response",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ds, err := NewDepsDevDataSource(&minderv1.DepsDevDataSource{
+				Def: map[string]*minderv1.DepsDevDataSource_Def{
+					"package": {Endpoint: srv.URL},
+				},
+			}, v1datasources.WithTestOnlyTransport(http.DefaultTransport))
+			require.NoError(t, err)
+
+			fn := ds.GetFuncs()["package"]
+			require.NotNil(t, fn)
+			require.NoError(t, fn.ValidateArgs(tt.args))
+
+			got, err := fn.Call(context.Background(), nil, tt.args)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDepsDevHandler_ValidateArgs(t *testing.T) {
+	t.Parallel()
+
+	h, err := newHandlerFromDef(&minderv1.DepsDevDataSource_Def{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, DefaultEndpoint, h.endpoint)
+
+	require.NoError(t, h.ValidateArgs(map[string]any{"ecosystem": "go", "name": "golang.org/x/net"}))
+	require.Error(t, h.ValidateArgs(map[string]any{"ecosystem": "go"}))
+	require.Error(t, h.ValidateArgs(map[string]any{"name": "golang.org/x/net"}))
+	require.Error(t, h.ValidateArgs("golang.org/x/net"))
+
+	require.NoError(t, h.ValidateUpdate(h.GetArgsSchema()))
+}
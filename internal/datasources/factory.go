@@ -7,6 +7,7 @@ package datasources
 import (
 	"fmt"
 
+	"github.com/mindersec/minder/internal/datasources/depsdev"
 	"github.com/mindersec/minder/internal/datasources/rest"
 	"github.com/mindersec/minder/internal/datasources/structured"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -34,6 +35,8 @@ func BuildFromProtobuf(
 		return structured.NewStructDataSource(ds.GetStructured())
 	case *minderv1.DataSource_Rest:
 		return rest.NewRestDataSource(ds.GetRest(), provider, opts...)
+	case *minderv1.DataSource_DepsDev:
+		return depsdev.NewDepsDevDataSource(ds.GetDepsDev(), opts...)
 	default:
 		return nil, fmt.Errorf("unknown data source type: %T", ds)
 	}
@@ -37,6 +37,10 @@ func dataSourceDBToProtobuf(ds db.DataSource, dsfuncs []db.DataSourcesFunction)
 		return nil, errors.New("data source is invalid and has no defintions")
 	}
 
+	if err := outds.Visibility.FromString(string(ds.Visibility)); err != nil {
+		outds.Visibility = minderv1.Visibility_VISIBILITY_UNSPECIFIED
+	}
+
 	var metadata DataSourceMetadata
 	if ds.Metadata.Valid {
 		if err := json.Unmarshal(ds.Metadata.RawMessage, &metadata); err != nil {
@@ -58,6 +62,11 @@ func dataSourceDBToProtobuf(ds db.DataSource, dsfuncs []db.DataSourcesFunction)
 		}
 		outds.GetRest().ProviderAuth = metadata.ProviderAuth
 		return dataSourceRestDBToProtobuf(outds, dsfuncs)
+	case v1datasources.DataSourceDriverDepsDev:
+		outds.Driver = &minderv1.DataSource_DepsDev{
+			DepsDev: &minderv1.DepsDevDataSource{},
+		}
+		return dataSourceDepsDevDBToProtobuf(outds, dsfuncs)
 	default:
 		return nil, fmt.Errorf("unknown data source type: %s", dsfType)
 	}
@@ -80,6 +89,22 @@ func dataSourceRestDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSource
 	return ds, nil
 }
 
+func dataSourceDepsDevDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSourcesFunction) (*minderv1.DataSource, error) {
+	ds.GetDepsDev().Def = make(map[string]*minderv1.DepsDevDataSource_Def, len(dsfuncs))
+
+	for _, dsf := range dsfuncs {
+		key := dsf.Name
+		dsfToParse := &minderv1.DepsDevDataSource_Def{}
+		if err := protojson.Unmarshal(dsf.Definition, dsfToParse); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal data source definition for %s: %w", key, err)
+		}
+
+		ds.GetDepsDev().Def[key] = dsfToParse
+	}
+
+	return ds, nil
+}
+
 func dataSourceStructDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSourcesFunction) (*minderv1.DataSource, error) {
 	ds.GetStructured().Def = make(map[string]*minderv1.StructDataSource_Def, len(dsfuncs))
 
@@ -108,6 +133,8 @@ func metadataForDataSource(ds *minderv1.DataSource) (json.RawMessage, error) {
 		metadata.ProviderAuth = ds.GetRest().GetProviderAuth()
 	case *minderv1.DataSource_Structured:
 		metadata.Type = v1datasources.DataSourceDriverStruct
+	case *minderv1.DataSource_DepsDev:
+		metadata.Type = v1datasources.DataSourceDriverDepsDev
 	default:
 		return nil, fmt.Errorf("unknown datasource driver %T", ds.Driver)
 	}
@@ -14,15 +14,19 @@ import (
 
 	"github.com/mindersec/minder/internal/datasources"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/projects/features"
 	"github.com/mindersec/minder/internal/util"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 )
 
 var (
-	getByNameQuery = func(ctx context.Context, tx db.ExtendQuerier, projs []uuid.UUID, name string) (db.DataSource, error) {
+	getByNameQuery = func(
+		ctx context.Context, tx db.ExtendQuerier, projs []uuid.UUID, name string, includeGlobal bool,
+	) (db.DataSource, error) {
 		ds, err := tx.GetDataSourceByName(ctx, db.GetDataSourceByNameParams{
-			Name:     name,
-			Projects: projs,
+			Name:          name,
+			Projects:      projs,
+			IncludeGlobal: includeGlobal,
 		})
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
@@ -148,7 +152,7 @@ func (d *dataSourceService) instantiateDataSource(
 	}
 
 	ds, err := d.GetByName(ctx, ref.GetName(), uuid.Nil,
-		ReadBuilder().withHierarchy(projectHierarchy).WithTransaction(tx))
+		ReadBuilder().withHierarchy(projectHierarchy).withGlobal().WithTransaction(tx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get data source by name: %w", err)
 	}
@@ -171,6 +175,54 @@ func listRelevantProjects(
 	return []uuid.UUID{project}, nil
 }
 
+// dataSourceVisibility returns the database visibility of the data source,
+// checking that the project is allowed to share it that widely.
+func dataSourceVisibility(
+	ctx context.Context, tx db.ExtendQuerier, projectID uuid.UUID, ds *minderv1.DataSource,
+) (db.Visibility, error) {
+	visibility, err := ds.GetVisibility().Enum().InitializedStringValue()
+	if err != nil {
+		return "", util.UserVisibleError(codes.InvalidArgument, "invalid visibility: %v", err)
+	}
+
+	if db.Visibility(visibility) == db.VisibilityGlobal &&
+		!features.ProjectAllowsGlobalVisibility(ctx, tx, projectID) {
+		return "", util.UserVisibleError(codes.PermissionDenied,
+			"global data sources are not enabled for project %s", projectID)
+	}
+
+	return db.Visibility(visibility), nil
+}
+
+// validateVisibilityUpdate checks that narrowing the visibility of a data
+// source doesn't hide it from the projects of the rule types using it.
+func validateVisibilityUpdate(
+	ctx context.Context, tx db.ExtendQuerier, existingDS *db.DataSource, visibility db.Visibility,
+) error {
+	if !visibility.Narrows(existingDS.Visibility) {
+		return nil
+	}
+
+	refs, err := tx.ListRuleTypesReferencesByDataSource(ctx, existingDS.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list rule types referencing data source: %w", err)
+	}
+
+	for _, ref := range refs {
+		hierarchy, err := tx.GetParentProjects(ctx, ref.ProjectID)
+		if err != nil {
+			return fmt.Errorf("failed to get project hierarchy: %w", err)
+		}
+		if !visibility.VisibleFrom(existingDS.ProjectID, hierarchy) {
+			return util.UserVisibleError(codes.FailedPrecondition,
+				"cannot make data source %s %s: it is used by rule type %s in project %s",
+				existingDS.Name, visibility, ref.RuleTypeID, ref.ProjectID)
+		}
+	}
+
+	return nil
+}
+
 func validateDataSourceFunctionsUpdate(
 	existingDS *db.DataSource, existingFunctions []db.DataSourcesFunction, newDS *minderv1.DataSource,
 ) error {
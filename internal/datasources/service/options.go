@@ -65,6 +65,9 @@ type ReadOptions struct {
 
 	// Use the actual project hierarchy to search for the data source.
 	hierarchy []uuid.UUID
+
+	// Also search the global data sources of other project hierarchies.
+	global bool
 }
 
 // ReadBuilder is a function that returns a new ReadOptions struct
@@ -109,6 +112,24 @@ func (o *ReadOptions) withHierarchy(projs []uuid.UUID) *ReadOptions {
 	return o
 }
 
+// withGlobal allows the service to find the global data sources of other
+// project hierarchies. This is left internal, as it's only meant for
+// resolving the data sources referenced by rule types.
+func (o *ReadOptions) withGlobal() *ReadOptions {
+	if o == nil {
+		o = &ReadOptions{}
+	}
+	o.global = true
+	return o
+}
+
+func (o *ReadOptions) canSearchGlobal() bool {
+	if o == nil {
+		return false
+	}
+	return o.global
+}
+
 func (o *ReadOptions) canSearchHierarchical() bool {
 	if o == nil {
 		return false
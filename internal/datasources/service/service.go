@@ -103,7 +103,7 @@ func (d *dataSourceService) GetByName(
 	return d.getDataSourceSomehow(
 		ctx, project, opts, func(ctx context.Context, tx db.ExtendQuerier, projs []uuid.UUID,
 		) (db.DataSource, error) {
-			return getByNameQuery(ctx, tx, projs, name)
+			return getByNameQuery(ctx, tx, projs, name, opts.canSearchGlobal())
 		})
 }
 
@@ -227,6 +227,11 @@ func (d *dataSourceService) Create(
 		return nil, ErrDataSourceAlreadyExists
 	}
 
+	visibility, err := dataSourceVisibility(ctx, tx, projectID, ds)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create data source record
 	metadataBytes, err := metadataForDataSource(ds)
 	if err != nil {
@@ -238,6 +243,7 @@ func (d *dataSourceService) Create(
 		DisplayName:    ds.GetName(),
 		SubscriptionID: uuid.NullUUID{UUID: subscriptionID, Valid: subscriptionID != uuid.Nil},
 		Metadata:       metadataBytes,
+		Visibility:     visibility,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create data source: %w", err)
@@ -296,7 +302,7 @@ func (d *dataSourceService) Update(
 	// Validate the subscription ID if present
 	existingDS, err := getDataSourceFromDb(ctx, projectID, ReadBuilder().WithTransaction(tx), tx,
 		func(ctx context.Context, tx db.ExtendQuerier, projs []uuid.UUID) (db.DataSource, error) {
-			return getByNameQuery(ctx, tx, projs, ds.GetName())
+			return getByNameQuery(ctx, tx, projs, ds.GetName(), false)
 		})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get existing data source from DB: %w", err)
@@ -314,6 +320,14 @@ func (d *dataSourceService) Update(
 		return nil, err
 	}
 
+	visibility, err := dataSourceVisibility(ctx, tx, projectID, ds)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateVisibilityUpdate(ctx, tx, existingDS, visibility); err != nil {
+		return nil, err
+	}
+
 	metadataBytes, err := metadataForDataSource(ds)
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize metadata: %w", err)
@@ -323,6 +337,7 @@ func (d *dataSourceService) Update(
 		ProjectID:   projectID,
 		DisplayName: ds.GetName(),
 		Metadata:    metadataBytes,
+		Visibility:  visibility,
 	}); err != nil {
 		return nil, fmt.Errorf("failed to update data source: %w", err)
 	}
@@ -559,6 +574,23 @@ func addDataSourceFunctions(
 				return fmt.Errorf("failed to create data source function: %w", err)
 			}
 		}
+	case *minderv1.DataSource_DepsDev:
+		for name, def := range drv.DepsDev.GetDef() {
+			defBytes, err := protojson.Marshal(def)
+			if err != nil {
+				return fmt.Errorf("failed to marshal deps.dev definition: %w", err)
+			}
+
+			if _, err := tx.AddDataSourceFunction(ctx, db.AddDataSourceFunctionParams{
+				DataSourceID: dsID,
+				ProjectID:    projectID,
+				Name:         name,
+				Type:         v1datasources.DataSourceDriverDepsDev,
+				Definition:   defBytes,
+			}); err != nil {
+				return fmt.Errorf("failed to create data source function: %w", err)
+			}
+		}
 	default:
 		return fmt.Errorf("unsupported data source driver type: %T", drv)
 	}
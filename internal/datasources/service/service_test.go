@@ -1516,6 +1516,63 @@ func TestUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Making data source private fails when used by another project",
+			args: args{
+				ds: &minderv1.DataSource{
+					Id:         uuid.New().String(),
+					Name:       "updated_ds",
+					Visibility: minderv1.Visibility_VISIBILITY_PRIVATE,
+					Context: &minderv1.ContextV2{
+						ProjectId: projectID.String(),
+					},
+					Driver: &minderv1.DataSource_Rest{
+						Rest: &minderv1.RestDataSource{
+							Def: map[string]*minderv1.RestDataSource_Def{
+								"test_function": {
+									Endpoint: "http://example.com/updated",
+									InputSchema: func() *structpb.Struct {
+										s, _ := structpb.NewStruct(map[string]any{})
+										return s
+									}(),
+								},
+							},
+						},
+					},
+				},
+				opts: &Options{},
+			},
+			setup: func(mockDB *mockdb.MockStore) {
+				childProjectID := uuid.New()
+				mockDB.EXPECT().GetDataSourceByName(gomock.Any(), gomock.Any()).
+					Return(db.DataSource{
+						ID:         uuid.New(),
+						Name:       "test_ds",
+						ProjectID:  projectID,
+						Visibility: db.VisibilitySubtree,
+					}, nil)
+				mockDB.EXPECT().ListDataSourceFunctions(gomock.Any(), gomock.Any()).
+					Return([]db.DataSourcesFunction{
+						{
+							ID:           uuid.New(),
+							DataSourceID: uuid.New(),
+							Name:         "test_function",
+							Type:         v1.DataSourceDriverRest,
+							Definition:   restDriverToJson(t, &minderv1.RestDataSource_Def{}),
+						},
+					}, nil)
+				mockDB.EXPECT().ListRuleTypesReferencesByDataSource(gomock.Any(), gomock.Any()).
+					Return([]db.RuleTypeDataSource{
+						{
+							RuleTypeID: uuid.New(),
+							ProjectID:  childProjectID,
+						},
+					}, nil)
+				mockDB.EXPECT().GetParentProjects(gomock.Any(), childProjectID).
+					Return([]uuid.UUID{childProjectID, projectID}, nil)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
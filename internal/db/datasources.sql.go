@@ -73,8 +73,8 @@ func (q *Queries) AddRuleTypeDataSourceReference(ctx context.Context, arg AddRul
 
 const createDataSource = `-- name: CreateDataSource :one
 
-INSERT INTO data_sources (project_id, name, display_name, subscription_id, metadata)
-VALUES ($1, $2, $3, $4, $5::json) RETURNING id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata
+INSERT INTO data_sources (project_id, name, display_name, subscription_id, metadata, visibility)
+VALUES ($1, $2, $3, $4, $5::json, $6) RETURNING id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata, visibility
 `
 
 type CreateDataSourceParams struct {
@@ -83,6 +83,7 @@ type CreateDataSourceParams struct {
 	DisplayName    string          `json:"display_name"`
 	SubscriptionID uuid.NullUUID   `json:"subscription_id"`
 	Metadata       json.RawMessage `json:"metadata"`
+	Visibility     Visibility      `json:"visibility"`
 }
 
 // CreateDataSource creates a new datasource in a given project.
@@ -93,6 +94,7 @@ func (q *Queries) CreateDataSource(ctx context.Context, arg CreateDataSourcePara
 		arg.DisplayName,
 		arg.SubscriptionID,
 		arg.Metadata,
+		arg.Visibility,
 	)
 	var i DataSource
 	err := row.Scan(
@@ -104,6 +106,7 @@ func (q *Queries) CreateDataSource(ctx context.Context, arg CreateDataSourcePara
 		&i.UpdatedAt,
 		&i.SubscriptionID,
 		&i.Metadata,
+		&i.Visibility,
 	)
 	return i, err
 }
@@ -111,7 +114,7 @@ func (q *Queries) CreateDataSource(ctx context.Context, arg CreateDataSourcePara
 const deleteDataSource = `-- name: DeleteDataSource :one
 DELETE FROM data_sources
 WHERE id = $1 AND project_id = $2
-RETURNING id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata
+RETURNING id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata, visibility
 `
 
 type DeleteDataSourceParams struct {
@@ -131,6 +134,7 @@ func (q *Queries) DeleteDataSource(ctx context.Context, arg DeleteDataSourcePara
 		&i.UpdatedAt,
 		&i.SubscriptionID,
 		&i.Metadata,
+		&i.Visibility,
 	)
 	return i, err
 }
@@ -227,8 +231,11 @@ func (q *Queries) DeleteRuleTypeDataSource(ctx context.Context, arg DeleteRuleTy
 
 const getDataSource = `-- name: GetDataSource :one
 
-SELECT id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata FROM data_sources
-WHERE id = $1 AND project_id = ANY($2::uuid[])
+SELECT id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata, visibility FROM data_sources
+WHERE id = $1 AND (
+    project_id = ($2::uuid[])[1]
+    OR (project_id = ANY($2::uuid[]) AND visibility != 'private')
+)
 `
 
 type GetDataSourceParams struct {
@@ -237,6 +244,8 @@ type GetDataSourceParams struct {
 }
 
 // GetDataSource retrieves a datasource by its id and a project hierarchy.
+// The first project of the hierarchy is the project doing the lookup,
+// private datasources of its ancestors are not returned.
 //
 // Note that to get a datasource for a given project, one can simply
 // pass one project id in the project_id array.
@@ -252,28 +261,39 @@ func (q *Queries) GetDataSource(ctx context.Context, arg GetDataSourceParams) (D
 		&i.UpdatedAt,
 		&i.SubscriptionID,
 		&i.Metadata,
+		&i.Visibility,
 	)
 	return i, err
 }
 
 const getDataSourceByName = `-- name: GetDataSourceByName :one
 
-SELECT id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata FROM data_sources
-WHERE name = $1 AND project_id = ANY($2::uuid[])
+SELECT id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata, visibility FROM data_sources
+WHERE name = $1 AND (
+    project_id = ($2::uuid[])[1]
+    OR (project_id = ANY($2::uuid[]) AND visibility != 'private')
+    OR ($3::boolean AND visibility = 'global')
+)
+ORDER BY array_position($2::uuid[], project_id) NULLS LAST, created_at
+LIMIT 1
 `
 
 type GetDataSourceByNameParams struct {
-	Name     string      `json:"name"`
-	Projects []uuid.UUID `json:"projects"`
+	Name          string      `json:"name"`
+	Projects      []uuid.UUID `json:"projects"`
+	IncludeGlobal bool        `json:"include_global"`
 }
 
 // GetDataSourceByName retrieves a datasource by its name and
-// a project hierarchy.
+// a project hierarchy. The first project of the hierarchy is the project
+// doing the lookup, private datasources of its ancestors are not
+// returned. Global datasources of other hierarchies are only returned if
+// include_global is set. The datasource closest to the project wins.
 //
 // Note that to get a datasource for a given project, one can simply
 // pass one project id in the project_id array.
 func (q *Queries) GetDataSourceByName(ctx context.Context, arg GetDataSourceByNameParams) (DataSource, error) {
-	row := q.db.QueryRowContext(ctx, getDataSourceByName, arg.Name, pq.Array(arg.Projects))
+	row := q.db.QueryRowContext(ctx, getDataSourceByName, arg.Name, pq.Array(arg.Projects), arg.IncludeGlobal)
 	var i DataSource
 	err := row.Scan(
 		&i.ID,
@@ -284,6 +304,7 @@ func (q *Queries) GetDataSourceByName(ctx context.Context, arg GetDataSourceByNa
 		&i.UpdatedAt,
 		&i.SubscriptionID,
 		&i.Metadata,
+		&i.Visibility,
 	)
 	return i, err
 }
@@ -334,11 +355,14 @@ func (q *Queries) ListDataSourceFunctions(ctx context.Context, arg ListDataSourc
 
 const listDataSources = `-- name: ListDataSources :many
 
-SELECT id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata FROM data_sources
-WHERE project_id = ANY($1::uuid[])
+SELECT id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata, visibility FROM data_sources
+WHERE project_id = ($1::uuid[])[1]
+OR (project_id = ANY($1::uuid[]) AND visibility != 'private')
 `
 
 // ListDataSources retrieves all datasources for project hierarchy.
+// The first project of the hierarchy is the project doing the lookup,
+// private datasources of its ancestors are not returned.
 //
 // Note that to get a datasource for a given project, one can simply
 // pass one project id in the project_id array.
@@ -360,6 +384,7 @@ func (q *Queries) ListDataSources(ctx context.Context, projects []uuid.UUID) ([]
 			&i.UpdatedAt,
 			&i.SubscriptionID,
 			&i.Metadata,
+			&i.Visibility,
 		); err != nil {
 			return nil, err
 		}
@@ -407,9 +432,9 @@ func (q *Queries) ListRuleTypesReferencesByDataSource(ctx context.Context, dataS
 const updateDataSource = `-- name: UpdateDataSource :one
 
 UPDATE data_sources
-SET display_name = $3, metadata = $4::json
+SET display_name = $3, metadata = $4::json, visibility = $5
 WHERE id = $1 AND project_id = $2
-RETURNING id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata
+RETURNING id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata, visibility
 `
 
 type UpdateDataSourceParams struct {
@@ -417,6 +442,7 @@ type UpdateDataSourceParams struct {
 	ProjectID   uuid.UUID       `json:"project_id"`
 	DisplayName string          `json:"display_name"`
 	Metadata    json.RawMessage `json:"metadata"`
+	Visibility  Visibility      `json:"visibility"`
 }
 
 // UpdateDataSource updates a datasource in a given project.
@@ -426,6 +452,7 @@ func (q *Queries) UpdateDataSource(ctx context.Context, arg UpdateDataSourcePara
 		arg.ProjectID,
 		arg.DisplayName,
 		arg.Metadata,
+		arg.Visibility,
 	)
 	var i DataSource
 	err := row.Scan(
@@ -437,6 +464,7 @@ func (q *Queries) UpdateDataSource(ctx context.Context, arg UpdateDataSourcePara
 		&i.UpdatedAt,
 		&i.SubscriptionID,
 		&i.Metadata,
+		&i.Visibility,
 	)
 	return i, err
 }
@@ -0,0 +1,494 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The interfaces below split the sqlc-generated Querier into per-domain
+// stores, following the query files in database/query. Code which only needs
+// a single domain should depend on the narrow interface, which keeps the
+// dependency explicit, makes the test mocks much smaller and allows plugging
+// in a different backend for a single domain.
+//
+// When adding a query, add its method to the interface of the domain it
+// belongs to: the assertions at the end of this file fail to compile if a
+// Querier method is not part of any domain.
+
+//go:generate go run go.uber.org/mock/mockgen -package mockdb -destination=../../database/mock/domains.go github.com/mindersec/minder/internal/db APIQuotasStore,AlertGroupsStore,DataSourcesStore,DeferredActionsStore,EntitiesStore,EntitlementsStore,EvalHistoryStore,EvalRetriesStore,EvalStatusStore,ExecutionLockStore,InvitationsStore,MaintenanceStore,MigrationPhasesStore,PendingOperationsStore,ProfileStatusShareLinksStore,ProfilesStore,ProjectDeployKeysStore,ProjectSecretsStore,ProjectsStore,ProvidersStore,RuleExceptionsStore,RuleTypesStore,SessionsStore,SubscriptionsStore,ThrottledEvaluationsStore,UsersStore
+
+// APIQuotasStore provides access to the token buckets of the per-project API quotas
+type APIQuotasStore interface {
+	GetAPIQuotaRetryAfter(ctx context.Context, arg GetAPIQuotaRetryAfterParams) (float64, error)
+	TakeAPIQuotaToken(ctx context.Context, arg TakeAPIQuotaTokenParams) (float64, error)
+}
+
+// AlertGroupsStore provides access to the alerts grouped per entity and profile
+type AlertGroupsStore interface {
+	DeleteAlertGroup(ctx context.Context, arg DeleteAlertGroupParams) error
+	GetAlertGroup(ctx context.Context, arg GetAlertGroupParams) (AlertGroup, error)
+	PopDueAlertGroups(ctx context.Context, size int64) ([]AlertGroup, error)
+	UpsertAlertGroup(ctx context.Context, arg UpsertAlertGroupParams) (AlertGroup, error)
+}
+
+// DataSourcesStore provides access to the data sources and their functions
+type DataSourcesStore interface {
+	AddDataSourceFunction(ctx context.Context, arg AddDataSourceFunctionParams) (DataSourcesFunction, error)
+	AddRuleTypeDataSourceReference(ctx context.Context, arg AddRuleTypeDataSourceReferenceParams) (RuleTypeDataSource, error)
+	CreateDataSource(ctx context.Context, arg CreateDataSourceParams) (DataSource, error)
+	DeleteDataSource(ctx context.Context, arg DeleteDataSourceParams) (DataSource, error)
+	DeleteDataSourceFunction(ctx context.Context, arg DeleteDataSourceFunctionParams) (DataSourcesFunction, error)
+	DeleteDataSourceFunctions(ctx context.Context, arg DeleteDataSourceFunctionsParams) ([]DataSourcesFunction, error)
+	DeleteRuleTypeDataSource(ctx context.Context, arg DeleteRuleTypeDataSourceParams) error
+	GetDataSource(ctx context.Context, arg GetDataSourceParams) (DataSource, error)
+	GetDataSourceByName(ctx context.Context, arg GetDataSourceByNameParams) (DataSource, error)
+	ListDataSourceFunctions(ctx context.Context, arg ListDataSourceFunctionsParams) ([]DataSourcesFunction, error)
+	ListDataSources(ctx context.Context, projects []uuid.UUID) ([]DataSource, error)
+	ListRuleTypesReferencesByDataSource(ctx context.Context, dataSourcesID uuid.UUID) ([]RuleTypeDataSource, error)
+	UpdateDataSource(ctx context.Context, arg UpdateDataSourceParams) (DataSource, error)
+	UpdateDataSourceFunction(ctx context.Context, arg UpdateDataSourceFunctionParams) (DataSourcesFunction, error)
+}
+
+// DeferredActionsStore provides access to the entities whose actions were deferred by a freeze
+type DeferredActionsStore interface {
+	DeferActions(ctx context.Context, arg DeferActionsParams) error
+	PopDeferredActions(ctx context.Context, size int64) ([]DeferredAction, error)
+}
+
+// EntitiesStore provides access to the entity instances and their properties
+type EntitiesStore interface {
+	CloseEntity(ctx context.Context, arg CloseEntityParams) error
+	CountEntitiesByType(ctx context.Context, entityType Entities) (int64, error)
+	CountEntitiesByTypeAndProject(ctx context.Context, arg CountEntitiesByTypeAndProjectParams) (int64, error)
+	CreateEntity(ctx context.Context, arg CreateEntityParams) (EntityInstance, error)
+	CreateEntityWithID(ctx context.Context, arg CreateEntityWithIDParams) (EntityInstance, error)
+	CreateOrEnsureEntityByID(ctx context.Context, arg CreateOrEnsureEntityByIDParams) (EntityInstance, error)
+	DeleteAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) error
+	DeleteEntity(ctx context.Context, arg DeleteEntityParams) error
+	DeleteProperty(ctx context.Context, arg DeletePropertyParams) error
+	EntityExistsAfterID(ctx context.Context, arg EntityExistsAfterIDParams) (bool, error)
+	GetAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) ([]Property, error)
+	GetEntitiesByProjectHierarchy(ctx context.Context, projects []uuid.UUID) ([]EntityInstance, error)
+	GetEntitiesByProvider(ctx context.Context, providerID uuid.UUID) ([]EntityInstance, error)
+	GetEntitiesByType(ctx context.Context, arg GetEntitiesByTypeParams) ([]EntityInstance, error)
+	GetEntityByID(ctx context.Context, id uuid.UUID) (EntityInstance, error)
+	GetEntityByName(ctx context.Context, arg GetEntityByNameParams) (EntityInstance, error)
+	GetProperty(ctx context.Context, arg GetPropertyParams) (Property, error)
+	GetTypedEntitiesByProperty(ctx context.Context, arg GetTypedEntitiesByPropertyParams) ([]EntityInstance, error)
+	ListClosedEntitiesBefore(ctx context.Context, arg ListClosedEntitiesBeforeParams) ([]ClosedEntity, error)
+	ListEntitiesAfterID(ctx context.Context, arg ListEntitiesAfterIDParams) ([]EntityInstance, error)
+	ListEntityProjectsByProvider(ctx context.Context, providerID uuid.UUID) ([]uuid.UUID, error)
+	ReopenEntity(ctx context.Context, entityInstanceID uuid.UUID) error
+	UpdateEntityName(ctx context.Context, arg UpdateEntityNameParams) error
+	UpdateEntityOriginatedFrom(ctx context.Context, arg UpdateEntityOriginatedFromParams) error
+	UpsertProperty(ctx context.Context, arg UpsertPropertyParams) (Property, error)
+}
+
+// EntitlementsStore provides access to the features enabled for each project and to the project tiers
+type EntitlementsStore interface {
+	AssignProjectTier(ctx context.Context, arg AssignProjectTierParams) error
+	CreateEntitlements(ctx context.Context, arg CreateEntitlementsParams) error
+	DeleteProjectTier(ctx context.Context, name string) (int64, error)
+	GetEffectiveProjectTier(ctx context.Context, projectID uuid.UUID) (ProjectTier, error)
+	GetEntitlementFeaturesByProjectID(ctx context.Context, projectID uuid.UUID) ([]string, error)
+	GetFeatureInProject(ctx context.Context, arg GetFeatureInProjectParams) (json.RawMessage, error)
+	GetProjectTierByName(ctx context.Context, name string) (ProjectTier, error)
+	ListProjectTiers(ctx context.Context) ([]ProjectTier, error)
+	UnassignProjectTier(ctx context.Context, projectID uuid.UUID) (int64, error)
+	UpsertProjectTier(ctx context.Context, arg UpsertProjectTierParams) (ProjectTier, error)
+}
+
+// EvalHistoryStore provides access to the history of the rule evaluations, their outputs and annotations
+type EvalHistoryStore interface {
+	CountStaleEvaluationRuleEntities(ctx context.Context, arg CountStaleEvaluationRuleEntitiesParams) (CountStaleEvaluationRuleEntitiesRow, error)
+	CreateEvaluationAnnotation(ctx context.Context, arg CreateEvaluationAnnotationParams) (EvaluationAnnotation, error)
+	DeleteEvaluationAnnotation(ctx context.Context, arg DeleteEvaluationAnnotationParams) (int64, error)
+	DeleteEvaluationHistoryByIDs(ctx context.Context, evaluationids []uuid.UUID) (int64, error)
+	DeleteEvaluationOutputsByEvaluationIDs(ctx context.Context, evaluationids []uuid.UUID) (int64, error)
+	DeleteEvaluationRuleEntitiesByIDs(ctx context.Context, ruleentityids []uuid.UUID) (int64, error)
+	GetEvaluationHistory(ctx context.Context, arg GetEvaluationHistoryParams) (GetEvaluationHistoryRow, error)
+	GetEvaluationOutput(ctx context.Context, id uuid.UUID) (EvaluationOutput, error)
+	GetLatestEvalCheckpointForRuleEntity(ctx context.Context, arg GetLatestEvalCheckpointForRuleEntityParams) (GetLatestEvalCheckpointForRuleEntityRow, error)
+	GetLatestEvalStateForRuleEntity(ctx context.Context, arg GetLatestEvalStateForRuleEntityParams) (EvaluationStatus, error)
+	InsertAlertEvent(ctx context.Context, arg InsertAlertEventParams) error
+	InsertEvaluationRuleEntity(ctx context.Context, arg InsertEvaluationRuleEntityParams) (uuid.UUID, error)
+	InsertEvaluationStatus(ctx context.Context, arg InsertEvaluationStatusParams) (uuid.UUID, error)
+	InsertRemediationEvent(ctx context.Context, arg InsertRemediationEventParams) error
+	ListEntityTimeline(ctx context.Context, arg ListEntityTimelineParams) ([]ListEntityTimelineRow, error)
+	ListEvaluationAnnotationsByEvaluationIDs(ctx context.Context, evaluationIds []uuid.UUID) ([]EvaluationAnnotation, error)
+	ListEvaluationAnnotationsForFindings(ctx context.Context, evaluationIds []uuid.UUID) ([]ListEvaluationAnnotationsForFindingsRow, error)
+	ListEvaluationHistory(ctx context.Context, arg ListEvaluationHistoryParams) ([]ListEvaluationHistoryRow, error)
+	ListEvaluationHistoryStaleRecords(ctx context.Context, arg ListEvaluationHistoryStaleRecordsParams) ([]ListEvaluationHistoryStaleRecordsRow, error)
+	ListLatestEvaluationsByProject(ctx context.Context, projectID uuid.UUID) ([]ListLatestEvaluationsByProjectRow, error)
+	ListRuleTypeFailureCounts(ctx context.Context, arg ListRuleTypeFailureCountsParams) ([]ListRuleTypeFailureCountsRow, error)
+	ListStaleEvaluationRuleEntities(ctx context.Context, arg ListStaleEvaluationRuleEntitiesParams) ([]ListStaleEvaluationRuleEntitiesRow, error)
+	UpsertEvaluationOutput(ctx context.Context, arg UpsertEvaluationOutputParams) error
+	UpsertLatestEvaluationStatus(ctx context.Context, arg UpsertLatestEvaluationStatusParams) error
+}
+
+// EvalRetriesStore provides access to the scheduled retries of evaluations which ended in a transient error
+type EvalRetriesStore interface {
+	ClaimDueEvaluationRetries(ctx context.Context, arg ClaimDueEvaluationRetriesParams) ([]EvaluationRetry, error)
+	DeleteEvaluationRetry(ctx context.Context, entityInstanceID uuid.UUID) error
+	GetEvaluationRetry(ctx context.Context, entityInstanceID uuid.UUID) (EvaluationRetry, error)
+	UpsertEvaluationRetry(ctx context.Context, arg UpsertEvaluationRetryParams) (EvaluationRetry, error)
+}
+
+// EvalStatusStore provides access to the latest status of the rule evaluations
+type EvalStatusStore interface {
+	GetProfileStatusByIdAndProject(ctx context.Context, arg GetProfileStatusByIdAndProjectParams) (GetProfileStatusByIdAndProjectRow, error)
+	GetProfileStatusByNameAndProject(ctx context.Context, arg GetProfileStatusByNameAndProjectParams) (GetProfileStatusByNameAndProjectRow, error)
+	GetProfileStatusByProject(ctx context.Context, projectID uuid.UUID) ([]GetProfileStatusByProjectRow, error)
+	ListCanaryRuleResults(ctx context.Context, profileID uuid.UUID) ([]ListCanaryRuleResultsRow, error)
+	ListOldestRuleEvaluationsByEntityID(ctx context.Context, entityIds []uuid.UUID) ([]ListOldestRuleEvaluationsByEntityIDRow, error)
+	ListOldestRuleEvaluationsByRepositoryId(ctx context.Context, repositoryIds []uuid.UUID) ([]ListOldestRuleEvaluationsByRepositoryIdRow, error)
+	ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRuleEvaluationsByProfileIdParams) ([]ListRuleEvaluationsByProfileIdRow, error)
+}
+
+// ExecutionLockStore provides access to the locks and the flush cache serializing the evaluations of an entity
+type ExecutionLockStore interface {
+	EnqueueFlush(ctx context.Context, arg EnqueueFlushParams) (FlushCache, error)
+	FlushCache(ctx context.Context, entityInstanceID uuid.UUID) (FlushCache, error)
+	ListFlushCache(ctx context.Context) ([]FlushCache, error)
+	LockIfThresholdNotExceeded(ctx context.Context, arg LockIfThresholdNotExceededParams) (EntityExecutionLock, error)
+	ReleaseLock(ctx context.Context, arg ReleaseLockParams) error
+	UpdateLease(ctx context.Context, arg UpdateLeaseParams) error
+}
+
+// InvitationsStore provides access to the invitations to join a project
+type InvitationsStore interface {
+	CreateInvitation(ctx context.Context, arg CreateInvitationParams) (UserInvite, error)
+	DeleteInvitation(ctx context.Context, code string) (UserInvite, error)
+	GetInvitationByCode(ctx context.Context, code string) (GetInvitationByCodeRow, error)
+	GetInvitationsByEmail(ctx context.Context, email string) ([]GetInvitationsByEmailRow, error)
+	GetInvitationsByEmailAndProject(ctx context.Context, arg GetInvitationsByEmailAndProjectParams) ([]GetInvitationsByEmailAndProjectRow, error)
+	ListInvitationsForProject(ctx context.Context, project uuid.UUID) ([]ListInvitationsForProjectRow, error)
+	UpdateInvitationRole(ctx context.Context, arg UpdateInvitationRoleParams) (UserInvite, error)
+}
+
+// MaintenanceStore provides access to the consistency checks of the database
+// schema and data
+type MaintenanceStore interface {
+	CountOrphanedEvaluationRuleEntities(ctx context.Context) (int64, error)
+	CountOrphanedRuleInstances(ctx context.Context) (int64, error)
+	DeleteOrphanedEvaluationRuleEntities(ctx context.Context) (int64, error)
+	DeleteOrphanedRuleInstances(ctx context.Context) (int64, error)
+	ListEnumValues(ctx context.Context) ([]ListEnumValuesRow, error)
+	ListInvalidIndexes(ctx context.Context) ([]ListInvalidIndexesRow, error)
+}
+
+// MigrationPhasesStore provides access to the state of the phases of the
+// expand/contract migrations
+type MigrationPhasesStore interface {
+	CompleteMigrationPhase(ctx context.Context, name string) error
+	FailMigrationPhase(ctx context.Context, arg FailMigrationPhaseParams) error
+	GetMigrationPhase(ctx context.Context, name string) (MigrationPhase, error)
+	ListMigrationPhases(ctx context.Context) ([]MigrationPhase, error)
+	StartMigrationPhase(ctx context.Context, arg StartMigrationPhaseParams) (MigrationPhase, error)
+}
+
+// PendingOperationsStore provides access to the destructive operations waiting for the
+// confirmation of a second admin
+type PendingOperationsStore interface {
+	CreatePendingOperation(ctx context.Context, arg CreatePendingOperationParams) (PendingOperation, error)
+	GetPendingOperationByIDAndLock(ctx context.Context, arg GetPendingOperationByIDAndLockParams) (PendingOperation, error)
+	ListPendingOperations(ctx context.Context, projectID uuid.UUID) ([]PendingOperation, error)
+	UpdatePendingOperationState(ctx context.Context, arg UpdatePendingOperationStateParams) error
+}
+
+// ProfileStatusShareLinksStore provides access to the links sharing the status of profiles
+type ProfileStatusShareLinksStore interface {
+	CreateProfileStatusShareLink(ctx context.Context, arg CreateProfileStatusShareLinkParams) (ProfileStatusShareLink, error)
+	DeleteExpiredProfileStatusShareLinks(ctx context.Context, projectID uuid.UUID) error
+	DeleteProfileStatusShareLink(ctx context.Context, arg DeleteProfileStatusShareLinkParams) (ProfileStatusShareLink, error)
+	GetProfileStatusShareLink(ctx context.Context, arg GetProfileStatusShareLinkParams) (ProfileStatusShareLink, error)
+}
+
+// ProfilesStore provides access to the profiles, their selectors and their rule instances
+type ProfilesStore interface {
+	BulkGetProfilesByID(ctx context.Context, profileIds []uuid.UUID) ([]BulkGetProfilesByIDRow, error)
+	CountProfilesByEntityType(ctx context.Context) ([]CountProfilesByEntityTypeRow, error)
+	CountProfilesByName(ctx context.Context, name string) (int64, error)
+	CountProfilesByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error)
+	CountRuleInstancesByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error)
+	CreateProfile(ctx context.Context, arg CreateProfileParams) (Profile, error)
+	CreateProfileBase(ctx context.Context, arg CreateProfileBaseParams) error
+	CreateProfileForEntity(ctx context.Context, arg CreateProfileForEntityParams) (EntityProfile, error)
+	CreateProfileVersion(ctx context.Context, arg CreateProfileVersionParams) (ProfileVersion, error)
+	CreateSelector(ctx context.Context, arg CreateSelectorParams) (ProfileSelector, error)
+	DeleteNonUpdatedRules(ctx context.Context, arg DeleteNonUpdatedRulesParams) error
+	DeleteProfile(ctx context.Context, arg DeleteProfileParams) error
+	DeleteProfileBases(ctx context.Context, profileID uuid.UUID) error
+	DeleteProfileForEntity(ctx context.Context, arg DeleteProfileForEntityParams) error
+	DeleteRuleInstanceOfProfileInProject(ctx context.Context, arg DeleteRuleInstanceOfProfileInProjectParams) error
+	DeleteSelector(ctx context.Context, id uuid.UUID) error
+	DeleteSelectorsByProfileID(ctx context.Context, profileID uuid.UUID) error
+	DisableRuleInstance(ctx context.Context, arg DisableRuleInstanceParams) (RuleInstance, error)
+	EnableRuleInstance(ctx context.Context, id uuid.UUID) (RuleInstance, error)
+	GetProfileByID(ctx context.Context, arg GetProfileByIDParams) (Profile, error)
+	GetProfileByIDAndLock(ctx context.Context, arg GetProfileByIDAndLockParams) (Profile, error)
+	GetProfileByName(ctx context.Context, arg GetProfileByNameParams) (Profile, error)
+	GetProfileByNameAndLock(ctx context.Context, arg GetProfileByNameAndLockParams) (Profile, error)
+	GetProfileByProjectAndID(ctx context.Context, arg GetProfileByProjectAndIDParams) ([]GetProfileByProjectAndIDRow, error)
+	GetProfileByProjectAndName(ctx context.Context, arg GetProfileByProjectAndNameParams) ([]GetProfileByProjectAndNameRow, error)
+	GetProfileVersion(ctx context.Context, arg GetProfileVersionParams) (ProfileVersion, error)
+	GetRuleInstanceByProfileName(ctx context.Context, arg GetRuleInstanceByProfileNameParams) (RuleInstance, error)
+	GetRuleInstancesByProfileName(ctx context.Context, arg GetRuleInstancesByProfileNameParams) ([]RuleInstance, error)
+	GetRuleInstancesEntityInProjects(ctx context.Context, arg GetRuleInstancesEntityInProjectsParams) ([]RuleInstance, error)
+	GetRuleInstancesForProfile(ctx context.Context, profileID uuid.UUID) ([]RuleInstance, error)
+	GetRuleTypeIDByRuleNameEntityProfile(ctx context.Context, arg GetRuleTypeIDByRuleNameEntityProfileParams) (uuid.UUID, error)
+	GetSelectorByID(ctx context.Context, id uuid.UUID) (ProfileSelector, error)
+	GetSelectorsByProfileID(ctx context.Context, profileID uuid.UUID) ([]ProfileSelector, error)
+	ListDisabledRuleInstances(ctx context.Context, projectID uuid.UUID) ([]ListDisabledRuleInstancesRow, error)
+	ListProfileBases(ctx context.Context, profileIds []uuid.UUID) ([]ListProfileBasesRow, error)
+	ListProfileVersions(ctx context.Context, profileID uuid.UUID) ([]ProfileVersion, error)
+	ListProfilesByProjectIDAndLabel(ctx context.Context, arg ListProfilesByProjectIDAndLabelParams) ([]ListProfilesByProjectIDAndLabelRow, error)
+	ListProfilesExtending(ctx context.Context, baseProfileID uuid.UUID) ([]uuid.UUID, error)
+	ListProfilesInstantiatingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]string, error)
+	ListProjectsUsingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]uuid.UUID, error)
+	UpdateProfile(ctx context.Context, arg UpdateProfileParams) (Profile, error)
+	UpdateSelector(ctx context.Context, arg UpdateSelectorParams) (ProfileSelector, error)
+	UpsertProfileForEntity(ctx context.Context, arg UpsertProfileForEntityParams) (EntityProfile, error)
+	UpsertRuleInstance(ctx context.Context, arg UpsertRuleInstanceParams) (uuid.UUID, error)
+}
+
+// ProjectDeployKeysStore provides access to the SSH deploy keys of the projects
+type ProjectDeployKeysStore interface {
+	CreateProjectDeployKey(ctx context.Context, arg CreateProjectDeployKeyParams) (ProjectDeployKey, error)
+	DeleteProjectDeployKey(ctx context.Context, arg DeleteProjectDeployKeyParams) (int64, error)
+	GetProjectDeployKeyByName(ctx context.Context, arg GetProjectDeployKeyByNameParams) (ProjectDeployKey, error)
+	ListProjectDeployKeys(ctx context.Context, projectID uuid.UUID) ([]ProjectDeployKey, error)
+}
+
+// ProjectSecretsStore provides access to the secrets of the projects
+type ProjectSecretsStore interface {
+	DeleteProjectSecret(ctx context.Context, arg DeleteProjectSecretParams) (int64, error)
+	GetProjectSecretByName(ctx context.Context, arg GetProjectSecretByNameParams) (ProjectSecret, error)
+	ListProjectSecrets(ctx context.Context, projectID uuid.UUID) ([]ProjectSecret, error)
+	UpsertProjectSecret(ctx context.Context, arg UpsertProjectSecretParams) (ProjectSecret, error)
+}
+
+// ProjectsStore provides access to the project hierarchy
+type ProjectsStore interface {
+	CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error)
+	CreateProjectWithID(ctx context.Context, arg CreateProjectWithIDParams) (Project, error)
+	DeleteProject(ctx context.Context, id uuid.UUID) ([]DeleteProjectRow, error)
+	GetChildrenProjects(ctx context.Context, id uuid.UUID) ([]GetChildrenProjectsRow, error)
+	GetImmediateChildrenProjects(ctx context.Context, parentID uuid.UUID) ([]Project, error)
+	GetParentProjects(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
+	GetParentProjectsUntil(ctx context.Context, arg GetParentProjectsUntilParams) ([]uuid.UUID, error)
+	GetProjectByID(ctx context.Context, id uuid.UUID) (Project, error)
+	GetProjectByName(ctx context.Context, name string) (Project, error)
+	GetRootProjectByID(ctx context.Context, id uuid.UUID) (Project, error)
+	ListAllRootProjects(ctx context.Context) ([]Project, error)
+	OrphanProject(ctx context.Context, arg OrphanProjectParams) (Project, error)
+	UpdateProjectMeta(ctx context.Context, arg UpdateProjectMetaParams) (Project, error)
+}
+
+// ProvidersStore provides access to the providers, their credentials and their health, the GitHub
+// App installations and the cursors of their repository listings
+type ProvidersStore interface {
+	AddProviderUsage(ctx context.Context, arg AddProviderUsageParams) error
+	CountProvidersByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error)
+	CreateProvider(ctx context.Context, arg CreateProviderParams) (Provider, error)
+	CreateProviderShare(ctx context.Context, arg CreateProviderShareParams) (ProviderShare, error)
+	DeleteInstallationIDByAppID(ctx context.Context, appInstallationID int64) error
+	DeleteProvider(ctx context.Context, arg DeleteProviderParams) error
+	DeleteProviderShare(ctx context.Context, arg DeleteProviderShareParams) (int64, error)
+	DeleteProviderUsageBefore(ctx context.Context, bucket time.Time) (int64, error)
+	DeleteRepositoryListingCursor(ctx context.Context, arg DeleteRepositoryListingCursorParams) error
+	FindProviders(ctx context.Context, arg FindProvidersParams) ([]Provider, error)
+	GetAccessTokenByEnrollmentNonce(ctx context.Context, arg GetAccessTokenByEnrollmentNonceParams) (ProviderAccessToken, error)
+	GetAccessTokenByProjectID(ctx context.Context, arg GetAccessTokenByProjectIDParams) (ProviderAccessToken, error)
+	GetAccessTokenByProvider(ctx context.Context, provider string) ([]ProviderAccessToken, error)
+	GetAccessTokenSinceDate(ctx context.Context, arg GetAccessTokenSinceDateParams) (ProviderAccessToken, error)
+	GetInstallationIDByAppID(ctx context.Context, appInstallationID int64) (ProviderGithubAppInstallation, error)
+	GetInstallationIDByEnrollmentNonce(ctx context.Context, arg GetInstallationIDByEnrollmentNonceParams) (ProviderGithubAppInstallation, error)
+	GetInstallationIDByProviderID(ctx context.Context, providerID uuid.NullUUID) (ProviderGithubAppInstallation, error)
+	GetProviderByID(ctx context.Context, id uuid.UUID) (Provider, error)
+	GetProviderByIDAndProject(ctx context.Context, arg GetProviderByIDAndProjectParams) (Provider, error)
+	GetProviderByName(ctx context.Context, arg GetProviderByNameParams) (Provider, error)
+	GetProviderHealth(ctx context.Context, providerID uuid.UUID) (ProviderHealth, error)
+	GetRepositoryListingCursor(ctx context.Context, arg GetRepositoryListingCursorParams) (string, error)
+	GetUnclaimedInstallationsByUser(ctx context.Context, ghID sql.NullString) ([]ProviderGithubAppInstallation, error)
+	GlobalListProviders(ctx context.Context) ([]Provider, error)
+	GlobalListProvidersByClass(ctx context.Context, class ProviderClass) ([]Provider, error)
+	ListProvidersByProjectID(ctx context.Context, projects []uuid.UUID) ([]Provider, error)
+	ListProviderShares(ctx context.Context, providerID uuid.UUID) ([]ProviderShare, error)
+	ListProviderUsage(ctx context.Context, arg ListProviderUsageParams) ([]ListProviderUsageRow, error)
+	ListProvidersByProjectIDPaginated(ctx context.Context, arg ListProvidersByProjectIDPaginatedParams) ([]Provider, error)
+	ListTokensToMigrate(ctx context.Context, arg ListTokensToMigrateParams) ([]ProviderAccessToken, error)
+	SetProviderHealthAlertedExpiry(ctx context.Context, arg SetProviderHealthAlertedExpiryParams) error
+	UpdateEncryptedSecret(ctx context.Context, arg UpdateEncryptedSecretParams) error
+	UpdateProvider(ctx context.Context, arg UpdateProviderParams) error
+	UpsertAccessToken(ctx context.Context, arg UpsertAccessTokenParams) (ProviderAccessToken, error)
+	UpsertInstallationID(ctx context.Context, arg UpsertInstallationIDParams) (ProviderGithubAppInstallation, error)
+	UpsertProviderHealth(ctx context.Context, arg UpsertProviderHealthParams) (ProviderHealth, error)
+	UpsertRepositoryListingCursor(ctx context.Context, arg UpsertRepositoryListingCursorParams) error
+}
+
+// RuleExceptionsStore provides access to the exceptions to profile rules and
+// their audit history
+type RuleExceptionsStore interface {
+	CreateRuleException(ctx context.Context, arg CreateRuleExceptionParams) (RuleException, error)
+	CreateRuleExceptionEvent(ctx context.Context, arg CreateRuleExceptionEventParams) error
+	GetActiveRuleException(ctx context.Context, arg GetActiveRuleExceptionParams) (RuleException, error)
+	GetRuleExceptionByIDAndLock(ctx context.Context, arg GetRuleExceptionByIDAndLockParams) (RuleException, error)
+	ListRuleExceptionEvents(ctx context.Context, exceptionIds []uuid.UUID) ([]RuleExceptionEvent, error)
+	ListRuleExceptions(ctx context.Context, arg ListRuleExceptionsParams) ([]ListRuleExceptionsRow, error)
+	ReviewRuleException(ctx context.Context, arg ReviewRuleExceptionParams) (RuleException, error)
+}
+
+// RuleTypesStore provides access to the rule types
+type RuleTypesStore interface {
+	CreateRuleType(ctx context.Context, arg CreateRuleTypeParams) (RuleType, error)
+	DeleteRuleType(ctx context.Context, id uuid.UUID) error
+	GetRuleTypeByID(ctx context.Context, id uuid.UUID) (RuleType, error)
+	GetRuleTypeByName(ctx context.Context, arg GetRuleTypeByNameParams) (RuleType, error)
+	GetRuleTypeNameByID(ctx context.Context, id uuid.UUID) (string, error)
+	GetRuleTypesByEntityInHierarchy(ctx context.Context, arg GetRuleTypesByEntityInHierarchyParams) ([]RuleType, error)
+	ListRuleTypesByProject(ctx context.Context, projectID uuid.UUID) ([]RuleType, error)
+	UpdateRuleType(ctx context.Context, arg UpdateRuleTypeParams) (RuleType, error)
+}
+
+// SessionsStore provides access to the provider enrollment sessions
+type SessionsStore interface {
+	CreateSessionState(ctx context.Context, arg CreateSessionStateParams) (SessionStore, error)
+	DeleteExpiredSessionStates(ctx context.Context) (int64, error)
+	DeleteSessionStateByProjectID(ctx context.Context, arg DeleteSessionStateByProjectIDParams) error
+	GetProjectIDBySessionState(ctx context.Context, sessionState string) (GetProjectIDBySessionStateRow, error)
+}
+
+// SubscriptionsStore provides access to the bundles and the project subscriptions to them
+type SubscriptionsStore interface {
+	CreateSubscription(ctx context.Context, arg CreateSubscriptionParams) (Subscription, error)
+	GetBundle(ctx context.Context, arg GetBundleParams) (Bundle, error)
+	GetSubscriptionByProjectBundle(ctx context.Context, arg GetSubscriptionByProjectBundleParams) (Subscription, error)
+	SetBundleSignatureVerification(ctx context.Context, arg SetBundleSignatureVerificationParams) error
+	SetSubscriptionBundleVersion(ctx context.Context, arg SetSubscriptionBundleVersionParams) error
+	UpsertBundle(ctx context.Context, arg UpsertBundleParams) error
+}
+
+// ThrottledEvaluationsStore provides access to the entities whose evaluation was throttled
+type ThrottledEvaluationsStore interface {
+	DeferThrottledEvaluation(ctx context.Context, arg DeferThrottledEvaluationParams) error
+	PopDueThrottledEvaluations(ctx context.Context, size int64) ([]ThrottledEvaluation, error)
+}
+
+// UsersStore provides access to the users
+type UsersStore interface {
+	CountUsers(ctx context.Context) (int64, error)
+	CreateUser(ctx context.Context, identitySubject string) (User, error)
+	DeleteUser(ctx context.Context, id int32) error
+	GetUserByID(ctx context.Context, id int32) (User, error)
+	GetUserBySubject(ctx context.Context, identitySubject string) (User, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+}
+
+// DomainStores is the union of all the per-domain stores
+type DomainStores interface {
+	APIQuotasStore
+	AlertGroupsStore
+	DataSourcesStore
+	DeferredActionsStore
+	EntitiesStore
+	EntitlementsStore
+	EvalHistoryStore
+	EvalRetriesStore
+	EvalStatusStore
+	ExecutionLockStore
+	InvitationsStore
+	MaintenanceStore
+	MigrationPhasesStore
+	PendingOperationsStore
+	ProfileStatusShareLinksStore
+	ProfilesStore
+	ProjectDeployKeysStore
+	ProjectSecretsStore
+	ProjectsStore
+	ProvidersStore
+	RuleExceptionsStore
+	RuleTypesStore
+	SessionsStore
+	SubscriptionsStore
+	ThrottledEvaluationsStore
+	UsersStore
+}
+
+// Stores is the composition root of the per-domain stores. By default all the
+// domains are backed by the same Querier, but each of them can be replaced by
+// an alternative implementation.
+type Stores struct {
+	APIQuotas               APIQuotasStore
+	AlertGroups             AlertGroupsStore
+	DataSources             DataSourcesStore
+	DeferredActions         DeferredActionsStore
+	Entities                EntitiesStore
+	Entitlements            EntitlementsStore
+	EvalHistory             EvalHistoryStore
+	EvalRetries             EvalRetriesStore
+	EvalStatus              EvalStatusStore
+	ExecutionLock           ExecutionLockStore
+	Invitations             InvitationsStore
+	Maintenance             MaintenanceStore
+	MigrationPhases         MigrationPhasesStore
+	PendingOperations       PendingOperationsStore
+	ProfileStatusShareLinks ProfileStatusShareLinksStore
+	Profiles                ProfilesStore
+	ProjectDeployKeys       ProjectDeployKeysStore
+	ProjectSecrets          ProjectSecretsStore
+	Projects                ProjectsStore
+	Providers               ProvidersStore
+	RuleExceptions          RuleExceptionsStore
+	RuleTypes               RuleTypesStore
+	Sessions                SessionsStore
+	Subscriptions           SubscriptionsStore
+	ThrottledEvaluations    ThrottledEvaluationsStore
+	Users                   UsersStore
+}
+
+// NewStores creates the per-domain stores backed by the given querier
+func NewStores(q Querier) *Stores {
+	return &Stores{
+		APIQuotas:               q,
+		AlertGroups:             q,
+		DataSources:             q,
+		DeferredActions:         q,
+		Entities:                q,
+		Entitlements:            q,
+		EvalHistory:             q,
+		EvalRetries:             q,
+		EvalStatus:              q,
+		ExecutionLock:           q,
+		Invitations:             q,
+		Maintenance:             q,
+		MigrationPhases:         q,
+		PendingOperations:       q,
+		ProfileStatusShareLinks: q,
+		Profiles:                q,
+		ProjectDeployKeys:       q,
+		ProjectSecrets:          q,
+		Projects:                q,
+		Providers:               q,
+		RuleExceptions:          q,
+		RuleTypes:               q,
+		Sessions:                q,
+		Subscriptions:           q,
+		ThrottledEvaluations:    q,
+		Users:                   q,
+	}
+}
+
+var (
+	// every Querier method must belong to a domain...
+	_ Querier = DomainStores(nil)
+	// ...and every domain must be implemented by the Querier
+	_ DomainStores = Querier(nil)
+)
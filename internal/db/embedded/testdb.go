@@ -7,7 +7,6 @@ package embedded
 import (
 	"database/sql"
 	"fmt"
-	"net"
 	"os"
 	"sync"
 
@@ -18,6 +17,7 @@ import (
 	"github.com/mindersec/minder/database"
 	"github.com/mindersec/minder/internal/crypto"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/util/rand"
 )
 
 // CancelFunc is a function that can be called to clean up resources.
@@ -51,7 +51,7 @@ func ensurePostgres() (*embeddedpostgres.Config, CancelFunc, error) {
 	var newInstance = sharedPostgres{
 		done: make(chan struct{}),
 	}
-	port, err := pickUnusedPort()
+	port, err := rand.GetRandomLocalPort()
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to pick a port: %w", err)
 	}
@@ -65,7 +65,7 @@ func ensurePostgres() (*embeddedpostgres.Config, CancelFunc, error) {
 		}
 	}
 	newInstance.cfg = embeddedpostgres.DefaultConfig().
-		Port(uint32(port)).
+		Port(port).
 		RuntimePath(tmpName).
 		StartParameters(map[string]string{"max_connections": "500"})
 
@@ -148,18 +148,3 @@ func GetFakeStore() (db.Store, CancelFunc, error) {
 
 	return db.NewStore(sqlDB), cancel, nil
 }
-
-func pickUnusedPort() (uint32, error) {
-	l, err := net.Listen("tcp", "localhost:0")
-	if err != nil {
-		return 0, err
-	}
-	defer l.Close()
-	// largest TCP port is 2^16, overflow should not happen
-	port := l.Addr().(*net.TCPAddr).Port
-	if port < 0 {
-		return 0, fmt.Errorf("invalid port %d", port)
-	}
-	// nolint: gosec
-	return uint32(port), nil
-}
@@ -8,11 +8,30 @@ package db
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
 
+const closeEntity = `-- name: CloseEntity :exec
+INSERT INTO closed_entities (entity_instance_id, project_id)
+VALUES ($1, $2)
+ON CONFLICT (entity_instance_id) DO NOTHING
+`
+
+type CloseEntityParams struct {
+	EntityInstanceID uuid.UUID `json:"entity_instance_id"`
+	ProjectID        uuid.UUID `json:"project_id"`
+}
+
+// CloseEntity records that an entity was closed upstream, e.g. a closed pull
+// request. The entity is kept until it is reopened or purged.
+func (q *Queries) CloseEntity(ctx context.Context, arg CloseEntityParams) error {
+	_, err := q.db.ExecContext(ctx, closeEntity, arg.EntityInstanceID, arg.ProjectID)
+	return err
+}
+
 const countEntitiesByType = `-- name: CountEntitiesByType :one
 
 SELECT COUNT(*) FROM entity_instances
@@ -542,6 +561,43 @@ func (q *Queries) GetTypedEntitiesByProperty(ctx context.Context, arg GetTypedEn
 	return items, nil
 }
 
+const listClosedEntitiesBefore = `-- name: ListClosedEntitiesBefore :many
+SELECT entity_instance_id, project_id, closed_at FROM closed_entities
+WHERE closed_at < $1
+ORDER BY closed_at
+LIMIT $2::bigint
+`
+
+type ListClosedEntitiesBeforeParams struct {
+	ClosedBefore time.Time `json:"closed_before"`
+	Size         int64     `json:"size"`
+}
+
+// ListClosedEntitiesBefore returns the entities which were closed before the
+// given time, oldest first.
+func (q *Queries) ListClosedEntitiesBefore(ctx context.Context, arg ListClosedEntitiesBeforeParams) ([]ClosedEntity, error) {
+	rows, err := q.db.QueryContext(ctx, listClosedEntitiesBefore, arg.ClosedBefore, arg.Size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ClosedEntity{}
+	for rows.Next() {
+		var i ClosedEntity
+		if err := rows.Scan(&i.EntityInstanceID, &i.ProjectID, &i.ClosedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listEntitiesAfterID = `-- name: ListEntitiesAfterID :many
 
 SELECT id, entity_type, name, project_id, provider_id, created_at, originated_from FROM entity_instances
@@ -590,6 +646,86 @@ func (q *Queries) ListEntitiesAfterID(ctx context.Context, arg ListEntitiesAfter
 	return items, nil
 }
 
+const listEntityProjectsByProvider = `-- name: ListEntityProjectsByProvider :many
+
+SELECT DISTINCT entity_instances.project_id FROM entity_instances
+WHERE entity_instances.provider_id = $1
+`
+
+// ListEntityProjectsByProvider lists the projects which registered entities
+// with a provider.
+func (q *Queries) ListEntityProjectsByProvider(ctx context.Context, providerID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listEntityProjectsByProvider, providerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var project_id uuid.UUID
+		if err := rows.Scan(&project_id); err != nil {
+			return nil, err
+		}
+		items = append(items, project_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reopenEntity = `-- name: ReopenEntity :exec
+DELETE FROM closed_entities WHERE entity_instance_id = $1
+`
+
+// ReopenEntity removes the record of an entity being closed, e.g. after the
+// pull request was reopened.
+func (q *Queries) ReopenEntity(ctx context.Context, entityInstanceID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, reopenEntity, entityInstanceID)
+	return err
+}
+
+const updateEntityName = `-- name: UpdateEntityName :exec
+UPDATE entity_instances
+SET name = $3
+WHERE id = $1 AND project_id = $2
+`
+
+type UpdateEntityNameParams struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"project_id"`
+	Name      string    `json:"name"`
+}
+
+// UpdateEntityName renames an entity of a project, e.g. after the upstream
+// repository was renamed.
+func (q *Queries) UpdateEntityName(ctx context.Context, arg UpdateEntityNameParams) error {
+	_, err := q.db.ExecContext(ctx, updateEntityName, arg.ID, arg.ProjectID, arg.Name)
+	return err
+}
+
+const updateEntityOriginatedFrom = `-- name: UpdateEntityOriginatedFrom :exec
+UPDATE entity_instances
+SET originated_from = $3
+WHERE id = $1 AND project_id = $2
+`
+
+type UpdateEntityOriginatedFromParams struct {
+	ID             uuid.UUID     `json:"id"`
+	ProjectID      uuid.UUID     `json:"project_id"`
+	OriginatedFrom uuid.NullUUID `json:"originated_from"`
+}
+
+// UpdateEntityOriginatedFrom links an entity of a project to the entity it
+// originated from, e.g. an artifact to the repository that built it.
+func (q *Queries) UpdateEntityOriginatedFrom(ctx context.Context, arg UpdateEntityOriginatedFromParams) error {
+	_, err := q.db.ExecContext(ctx, updateEntityOriginatedFrom, arg.ID, arg.ProjectID, arg.OriginatedFrom)
+	return err
+}
+
 const upsertProperty = `-- name: UpsertProperty :one
 INSERT INTO properties (
     entity_id,
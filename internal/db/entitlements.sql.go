@@ -60,20 +60,29 @@ func (q *Queries) GetEntitlementFeaturesByProjectID(ctx context.Context, project
 
 const getFeatureInProject = `-- name: GetFeatureInProject :one
 
-SELECT f.settings FROM entitlements e
-INNER JOIN features f ON f.name = e.feature
-WHERE e.project_id = $1::UUID AND e.feature = $2::TEXT
+SELECT f.settings FROM features f
+WHERE f.name = $1::TEXT AND (
+    EXISTS (
+        SELECT 1 FROM entitlements e
+        WHERE e.feature = f.name AND e.project_id = $2::UUID
+    ) OR EXISTS (
+        SELECT 1 FROM project_tiers pt
+        WHERE pt.name = effective_project_tier($2::UUID)
+            AND f.name = ANY(pt.features)
+    )
+)
 `
 
 type GetFeatureInProjectParams struct {
-	ProjectID uuid.UUID `json:"project_id"`
 	Feature   string    `json:"feature"`
+	ProjectID uuid.UUID `json:"project_id"`
 }
 
-// GetFeatureInProject verifies if a feature is available for a specific project.
-// It returns the settings for the feature if it is available.
+// GetFeatureInProject verifies if a feature is available for a specific project,
+// either because the project is entitled to it or because the tier of the
+// project includes it. It returns the settings for the feature if it is available.
 func (q *Queries) GetFeatureInProject(ctx context.Context, arg GetFeatureInProjectParams) (json.RawMessage, error) {
-	row := q.db.QueryRowContext(ctx, getFeatureInProject, arg.ProjectID, arg.Feature)
+	row := q.db.QueryRowContext(ctx, getFeatureInProject, arg.Feature, arg.ProjectID)
 	var settings json.RawMessage
 	err := row.Scan(&settings)
 	return settings, err
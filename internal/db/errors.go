@@ -15,3 +15,9 @@ func ErrIsUniqueViolation(err error) bool {
 	pgErr, ok := errors.AsType[*pq.Error](err)
 	return ok && pgErr.Code == pqerror.UniqueViolation
 }
+
+// ErrIsForeignKeyViolation returns true if the error is a foreign key violation
+func ErrIsForeignKeyViolation(err error) bool {
+	pgErr, ok := errors.AsType[*pq.Error](err)
+	return ok && pgErr.Code == pqerror.ForeignKeyViolation
+}
@@ -16,6 +16,37 @@ import (
 	"github.com/sqlc-dev/pqtype"
 )
 
+const countStaleEvaluationRuleEntities = `-- name: CountStaleEvaluationRuleEntities :one
+SELECT COUNT(DISTINCT ere.id) AS rule_entities,
+       COUNT(h.id) AS evaluations
+  FROM evaluation_rule_entities ere
+       JOIN rule_instances ri ON ri.id = ere.rule_id
+       JOIN latest_evaluation_statuses l ON l.rule_entity_id = ere.id
+       JOIN evaluation_statuses s ON s.id = l.evaluation_history_id
+       JOIN evaluation_statuses h ON h.rule_entity_id = ere.id
+ WHERE s.evaluation_time < $1
+   AND ($2::uuid IS NULL OR ri.project_id = $2::uuid)
+`
+
+type CountStaleEvaluationRuleEntitiesParams struct {
+	Threshold time.Time     `json:"threshold"`
+	ProjectID uuid.NullUUID `json:"project_id"`
+}
+
+type CountStaleEvaluationRuleEntitiesRow struct {
+	RuleEntities int64 `json:"rule_entities"`
+	Evaluations  int64 `json:"evaluations"`
+}
+
+// CountStaleEvaluationRuleEntities counts the rule and entity pairs listed
+// by ListStaleEvaluationRuleEntities, and the evaluations in their history.
+func (q *Queries) CountStaleEvaluationRuleEntities(ctx context.Context, arg CountStaleEvaluationRuleEntitiesParams) (CountStaleEvaluationRuleEntitiesRow, error) {
+	row := q.db.QueryRowContext(ctx, countStaleEvaluationRuleEntities, arg.Threshold, arg.ProjectID)
+	var i CountStaleEvaluationRuleEntitiesRow
+	err := row.Scan(&i.RuleEntities, &i.Evaluations)
+	return i, err
+}
+
 const deleteEvaluationHistoryByIDs = `-- name: DeleteEvaluationHistoryByIDs :execrows
 DELETE FROM evaluation_statuses s
  WHERE s.id = ANY($1::uuid[])
@@ -29,6 +60,21 @@ func (q *Queries) DeleteEvaluationHistoryByIDs(ctx context.Context, evaluationid
 	return result.RowsAffected()
 }
 
+const deleteEvaluationRuleEntitiesByIDs = `-- name: DeleteEvaluationRuleEntitiesByIDs :execrows
+DELETE FROM evaluation_rule_entities ere
+ WHERE ere.id = ANY($1::uuid[])
+`
+
+// DeleteEvaluationRuleEntitiesByIDs deletes rule and entity pairs, which
+// cascades to their whole evaluation history.
+func (q *Queries) DeleteEvaluationRuleEntitiesByIDs(ctx context.Context, ruleentityids []uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteEvaluationRuleEntitiesByIDs, pq.Array(ruleentityids))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getEvaluationHistory = `-- name: GetEvaluationHistory :one
 SELECT s.id::uuid AS evaluation_id,
     s.evaluation_time as evaluated_at,
@@ -46,12 +92,16 @@ SELECT s.id::uuid AS evaluation_id,
     -- evaluation status and details
     s.status AS evaluation_status,
     s.details AS evaluation_details,
-    -- remediation status and details
+    s.error_code AS evaluation_error_code,
+    s.retry_attempt AS evaluation_retry_attempt,
+    -- remediation status, details and metadata
     re.status AS remediation_status,
     re.details AS remediation_details,
-    -- alert status and details
+    re.metadata AS remediation_metadata,
+    -- alert status, details and metadata
     ae.status AS alert_status,
-    ae.details AS alert_details
+    ae.details AS alert_details,
+    ae.metadata AS alert_metadata
 FROM evaluation_statuses s
     JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
     JOIN rule_instances ri ON ere.rule_id = ri.id
@@ -70,22 +120,26 @@ type GetEvaluationHistoryParams struct {
 }
 
 type GetEvaluationHistoryRow struct {
-	EvaluationID       uuid.UUID                  `json:"evaluation_id"`
-	EvaluatedAt        time.Time                  `json:"evaluated_at"`
-	EntityType         Entities                   `json:"entity_type"`
-	EntityID           uuid.UUID                  `json:"entity_id"`
-	EntityName         string                     `json:"entity_name"`
-	ProjectID          uuid.UUID                  `json:"project_id"`
-	RuleType           string                     `json:"rule_type"`
-	RuleName           string                     `json:"rule_name"`
-	RuleSeverity       Severity                   `json:"rule_severity"`
-	ProfileName        string                     `json:"profile_name"`
-	EvaluationStatus   EvalStatusTypes            `json:"evaluation_status"`
-	EvaluationDetails  string                     `json:"evaluation_details"`
-	RemediationStatus  NullRemediationStatusTypes `json:"remediation_status"`
-	RemediationDetails sql.NullString             `json:"remediation_details"`
-	AlertStatus        NullAlertStatusTypes       `json:"alert_status"`
-	AlertDetails       sql.NullString             `json:"alert_details"`
+	EvaluationID           uuid.UUID                  `json:"evaluation_id"`
+	EvaluatedAt            time.Time                  `json:"evaluated_at"`
+	EntityType             Entities                   `json:"entity_type"`
+	EntityID               uuid.UUID                  `json:"entity_id"`
+	EntityName             string                     `json:"entity_name"`
+	ProjectID              uuid.UUID                  `json:"project_id"`
+	RuleType               string                     `json:"rule_type"`
+	RuleName               string                     `json:"rule_name"`
+	RuleSeverity           Severity                   `json:"rule_severity"`
+	ProfileName            string                     `json:"profile_name"`
+	EvaluationStatus       EvalStatusTypes            `json:"evaluation_status"`
+	EvaluationDetails      string                     `json:"evaluation_details"`
+	EvaluationErrorCode    NullEvalErrorCodes         `json:"evaluation_error_code"`
+	EvaluationRetryAttempt int32                      `json:"evaluation_retry_attempt"`
+	RemediationStatus      NullRemediationStatusTypes `json:"remediation_status"`
+	RemediationDetails     sql.NullString             `json:"remediation_details"`
+	RemediationMetadata    pqtype.NullRawMessage      `json:"remediation_metadata"`
+	AlertStatus            NullAlertStatusTypes       `json:"alert_status"`
+	AlertDetails           sql.NullString             `json:"alert_details"`
+	AlertMetadata          pqtype.NullRawMessage      `json:"alert_metadata"`
 }
 
 func (q *Queries) GetEvaluationHistory(ctx context.Context, arg GetEvaluationHistoryParams) (GetEvaluationHistoryRow, error) {
@@ -104,17 +158,49 @@ func (q *Queries) GetEvaluationHistory(ctx context.Context, arg GetEvaluationHis
 		&i.ProfileName,
 		&i.EvaluationStatus,
 		&i.EvaluationDetails,
+		&i.EvaluationErrorCode,
+		&i.EvaluationRetryAttempt,
 		&i.RemediationStatus,
 		&i.RemediationDetails,
+		&i.RemediationMetadata,
 		&i.AlertStatus,
 		&i.AlertDetails,
+		&i.AlertMetadata,
 	)
 	return i, err
 }
 
+const getLatestEvalCheckpointForRuleEntity = `-- name: GetLatestEvalCheckpointForRuleEntity :one
+
+SELECT eh.status, eh.checkpoint FROM evaluation_rule_entities AS re
+JOIN latest_evaluation_statuses AS les ON les.rule_entity_id = re.id
+JOIN evaluation_statuses AS eh ON les.evaluation_history_id = eh.id
+WHERE re.rule_id = $1 AND re.entity_instance_id = $2
+`
+
+type GetLatestEvalCheckpointForRuleEntityParams struct {
+	RuleID           uuid.UUID `json:"rule_id"`
+	EntityInstanceID uuid.UUID `json:"entity_instance_id"`
+}
+
+type GetLatestEvalCheckpointForRuleEntityRow struct {
+	Status     EvalStatusTypes `json:"status"`
+	Checkpoint json.RawMessage `json:"checkpoint"`
+}
+
+// GetLatestEvalCheckpointForRuleEntity returns the status and checkpoint of
+// the latest evaluation of a rule for an entity. Unlike
+// GetLatestEvalStateForRuleEntity it does not lock the evaluation.
+func (q *Queries) GetLatestEvalCheckpointForRuleEntity(ctx context.Context, arg GetLatestEvalCheckpointForRuleEntityParams) (GetLatestEvalCheckpointForRuleEntityRow, error) {
+	row := q.db.QueryRowContext(ctx, getLatestEvalCheckpointForRuleEntity, arg.RuleID, arg.EntityInstanceID)
+	var i GetLatestEvalCheckpointForRuleEntityRow
+	err := row.Scan(&i.Status, &i.Checkpoint)
+	return i, err
+}
+
 const getLatestEvalStateForRuleEntity = `-- name: GetLatestEvalStateForRuleEntity :one
 
-SELECT eh.id, eh.rule_entity_id, eh.status, eh.details, eh.evaluation_time, eh.checkpoint FROM evaluation_rule_entities AS re
+SELECT eh.id, eh.rule_entity_id, eh.status, eh.details, eh.evaluation_time, eh.checkpoint, eh.error_code, eh.retry_attempt FROM evaluation_rule_entities AS re
 JOIN latest_evaluation_statuses AS les ON les.rule_entity_id = re.id
 JOIN evaluation_statuses AS eh ON les.evaluation_history_id = eh.id
 WHERE re.rule_id = $1 AND re.entity_instance_id = $2
@@ -138,6 +224,8 @@ func (q *Queries) GetLatestEvalStateForRuleEntity(ctx context.Context, arg GetLa
 		&i.Details,
 		&i.EvaluationTime,
 		&i.Checkpoint,
+		&i.ErrorCode,
+		&i.RetryAttempt,
 	)
 	return i, err
 }
@@ -204,21 +292,27 @@ INSERT INTO evaluation_statuses(
     rule_entity_id,
     status,
     details,
+    error_code,
+    retry_attempt,
     checkpoint
 ) VALUES (
     $1,
     $2,
     $3,
-    $4::jsonb
+    $4,
+    $5,
+    $6::jsonb
 )
 RETURNING id
 `
 
 type InsertEvaluationStatusParams struct {
-	RuleEntityID uuid.UUID       `json:"rule_entity_id"`
-	Status       EvalStatusTypes `json:"status"`
-	Details      string          `json:"details"`
-	Checkpoint   json.RawMessage `json:"checkpoint"`
+	RuleEntityID uuid.UUID          `json:"rule_entity_id"`
+	Status       EvalStatusTypes    `json:"status"`
+	Details      string             `json:"details"`
+	ErrorCode    NullEvalErrorCodes `json:"error_code"`
+	RetryAttempt int32              `json:"retry_attempt"`
+	Checkpoint   json.RawMessage    `json:"checkpoint"`
 }
 
 func (q *Queries) InsertEvaluationStatus(ctx context.Context, arg InsertEvaluationStatusParams) (uuid.UUID, error) {
@@ -226,6 +320,8 @@ func (q *Queries) InsertEvaluationStatus(ctx context.Context, arg InsertEvaluati
 		arg.RuleEntityID,
 		arg.Status,
 		arg.Details,
+		arg.ErrorCode,
+		arg.RetryAttempt,
 		arg.Checkpoint,
 	)
 	var id uuid.UUID
@@ -264,6 +360,127 @@ func (q *Queries) InsertRemediationEvent(ctx context.Context, arg InsertRemediat
 	return err
 }
 
+const listEntityTimeline = `-- name: ListEntityTimeline :many
+SELECT t.kind::text AS kind,
+       t.occurred_at::timestamptz AS occurred_at,
+       t.status::text AS status,
+       t.details::text AS details,
+       t.evaluation_id::uuid AS evaluation_id,
+       t.rule_type::text AS rule_type,
+       t.rule_name::text AS rule_name,
+       t.profile_name::text AS profile_name
+  FROM (
+        SELECT 'registration' AS kind,
+               ei.created_at AS occurred_at,
+               '' AS status,
+               '' AS details,
+               NULL::uuid AS evaluation_id,
+               '' AS rule_type,
+               '' AS rule_name,
+               '' AS profile_name
+          FROM entity_instances ei
+         WHERE ei.id = $1
+        UNION ALL
+        SELECT 'property_refresh', pr.updated_at, '', string_agg(pr.key, ', ' ORDER BY pr.key), NULL::uuid, '', '', ''
+          FROM properties pr
+         WHERE pr.entity_id = $1
+         GROUP BY pr.updated_at
+        UNION ALL
+        SELECT 'evaluation', s.evaluation_time, s.status::text, s.details, s.id, rt.name, ri.name, p.name
+          FROM evaluation_statuses s
+          JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+          JOIN rule_instances ri ON ere.rule_id = ri.id
+          JOIN rule_type rt ON ri.rule_type_id = rt.id
+          JOIN profiles p ON ri.profile_id = p.id
+         WHERE ere.entity_instance_id = $1
+        UNION ALL
+        SELECT 'remediation', re.created_at::timestamptz, re.status::text, re.details, s.id, rt.name, ri.name, p.name
+          FROM remediation_events re
+          JOIN evaluation_statuses s ON s.id = re.evaluation_id
+          JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+          JOIN rule_instances ri ON ere.rule_id = ri.id
+          JOIN rule_type rt ON ri.rule_type_id = rt.id
+          JOIN profiles p ON ri.profile_id = p.id
+         WHERE ere.entity_instance_id = $1
+           AND re.status NOT IN ('skipped', 'not_available')
+        UNION ALL
+        SELECT 'alert', ae.created_at::timestamptz, ae.status::text, ae.details, s.id, rt.name, ri.name, p.name
+          FROM alert_events ae
+          JOIN evaluation_statuses s ON s.id = ae.evaluation_id
+          JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+          JOIN rule_instances ri ON ere.rule_id = ri.id
+          JOIN rule_type rt ON ri.rule_type_id = rt.id
+          JOIN profiles p ON ri.profile_id = p.id
+         WHERE ere.entity_instance_id = $1
+           AND ae.status NOT IN ('skipped', 'not_available')
+       ) t
+ WHERE ($2::timestamptz IS NULL OR $2 > t.occurred_at)
+   AND ($3::timestamptz IS NULL OR $3 < t.occurred_at)
+ ORDER BY
+ CASE WHEN $2::timestamptz IS NULL THEN t.occurred_at END ASC,
+ CASE WHEN $3::timestamptz IS NULL THEN t.occurred_at END DESC
+ LIMIT $4::bigint
+`
+
+type ListEntityTimelineParams struct {
+	EntityID uuid.UUID    `json:"entity_id"`
+	Next     sql.NullTime `json:"next"`
+	Prev     sql.NullTime `json:"prev"`
+	Size     int64        `json:"size"`
+}
+
+type ListEntityTimelineRow struct {
+	Kind         string    `json:"kind"`
+	OccurredAt   time.Time `json:"occurred_at"`
+	Status       string    `json:"status"`
+	Details      string    `json:"details"`
+	EvaluationID uuid.UUID `json:"evaluation_id"`
+	RuleType     string    `json:"rule_type"`
+	RuleName     string    `json:"rule_name"`
+	ProfileName  string    `json:"profile_name"`
+}
+
+// ListEntityTimeline lists the events of an entity: its registration, the
+// refreshes of its properties, its evaluations and the remediations and alerts
+// acted on them. Skipped and unavailable actions are left out. The cursors
+// work as for ListEvaluationHistory.
+func (q *Queries) ListEntityTimeline(ctx context.Context, arg ListEntityTimelineParams) ([]ListEntityTimelineRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEntityTimeline,
+		arg.EntityID,
+		arg.Next,
+		arg.Prev,
+		arg.Size,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEntityTimelineRow{}
+	for rows.Next() {
+		var i ListEntityTimelineRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.OccurredAt,
+			&i.Status,
+			&i.Details,
+			&i.EvaluationID,
+			&i.RuleType,
+			&i.RuleName,
+			&i.ProfileName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listEvaluationHistory = `-- name: ListEvaluationHistory :many
 SELECT s.id::uuid AS evaluation_id,
        s.evaluation_time as evaluated_at,
@@ -280,12 +497,16 @@ SELECT s.id::uuid AS evaluation_id,
        -- evaluation status and details
        s.status AS evaluation_status,
        s.details AS evaluation_details,
-       -- remediation status and details
+       s.error_code AS evaluation_error_code,
+       s.retry_attempt AS evaluation_retry_attempt,
+       -- remediation status, details and metadata
        re.status AS remediation_status,
        re.details AS remediation_details,
-       -- alert status and details
+       re.metadata AS remediation_metadata,
+       -- alert status, details and metadata
        ae.status AS alert_status,
        ae.details AS alert_details,
+       ae.metadata AS alert_metadata,
        -- evaluation output
        eo.output AS eval_output
   FROM evaluation_statuses s
@@ -307,29 +528,35 @@ SELECT s.id::uuid AS evaluation_id,
    AND ($7::remediation_status_types[] IS NULL OR re.status = ANY($7::remediation_status_types[]))
    AND ($8::alert_status_types[] IS NULL OR ae.status = ANY($8::alert_status_types[]))
    AND ($9::eval_status_types[] IS NULL OR s.status = ANY($9::eval_status_types[]))
+   AND ($10::eval_error_codes[] IS NULL OR s.error_code = ANY($10::eval_error_codes[]))
    -- exclusion filters
-   AND ($10::entities[] IS NULL OR ere.entity_type != ALL($10::entities[]))
-   AND ($11::text[] IS NULL OR ei.name != ALL($11::text[]))
-   AND ($12::text[] IS NULL OR p.name != ALL($12::text[]))
-   AND ($13::remediation_status_types[] IS NULL OR re.status != ALL($13::remediation_status_types[]))
-   AND ($14::alert_status_types[] IS NULL OR ae.status != ALL($14::alert_status_types[]))
-   AND ($15::eval_status_types[] IS NULL OR s.status != ALL($15::eval_status_types[]))
+   AND ($11::entities[] IS NULL OR ere.entity_type != ALL($11::entities[]))
+   AND ($12::text[] IS NULL OR ei.name != ALL($12::text[]))
+   AND ($13::text[] IS NULL OR p.name != ALL($13::text[]))
+   AND ($14::remediation_status_types[] IS NULL OR re.status != ALL($14::remediation_status_types[]))
+   AND ($15::alert_status_types[] IS NULL OR ae.status != ALL($15::alert_status_types[]))
+   AND ($16::eval_status_types[] IS NULL OR s.status != ALL($16::eval_status_types[]))
+   AND ($17::eval_error_codes[] IS NULL OR s.error_code IS NULL OR s.error_code != ALL($17::eval_error_codes[]))
    -- time range filter
-   AND ($16::timestamp without time zone IS NULL OR s.evaluation_time >= $16)
-   AND ($17::timestamp without time zone IS NULL OR  s.evaluation_time < $17)
+   AND ($18::timestamp without time zone IS NULL OR s.evaluation_time >= $18)
+   AND ($19::timestamp without time zone IS NULL OR  s.evaluation_time < $19)
+   -- full-text search over the details, matching the search indexes
+   AND ($20::text IS NULL
+	OR to_tsvector('simple', s.details) @@ websearch_to_tsquery('simple', $20::text)
+	OR to_tsvector('simple', re.details) @@ websearch_to_tsquery('simple', $20::text))
    -- implicit filter by project id
-   AND j.id = $18
+   AND j.id = $21
    -- implicit filter by profile labels
-   AND (($19::text[] IS NULL AND p.labels = array[]::text[]) -- include only unlabelled records
-	OR (($19::text[] IS NOT NULL AND $19::text[] = array['*']::text[]) -- include all labels
-	    OR ($19::text[] IS NOT NULL AND p.labels && $19::text[]) -- include only specified labels
+   AND (($22::text[] IS NULL AND p.labels = array[]::text[]) -- include only unlabelled records
+	OR (($22::text[] IS NOT NULL AND $22::text[] = array['*']::text[]) -- include all labels
+	    OR ($22::text[] IS NOT NULL AND p.labels && $22::text[]) -- include only specified labels
 	)
    )
-   AND ($20::text[] IS NULL OR NOT p.labels && $20::text[]) -- exclude only specified labels
+   AND ($23::text[] IS NULL OR NOT p.labels && $23::text[]) -- exclude only specified labels
  ORDER BY
  CASE WHEN $2::timestamp without time zone IS NULL THEN s.evaluation_time END ASC,
  CASE WHEN $3::timestamp without time zone IS NULL THEN s.evaluation_time END DESC
- LIMIT $21::bigint
+ LIMIT $24::bigint
 `
 
 type ListEvaluationHistoryParams struct {
@@ -342,14 +569,17 @@ type ListEvaluationHistoryParams struct {
 	Remediations    []RemediationStatusTypes `json:"remediations"`
 	Alerts          []AlertStatusTypes       `json:"alerts"`
 	Statuses        []EvalStatusTypes        `json:"statuses"`
+	Errorcodes      []EvalErrorCodes         `json:"errorcodes"`
 	Notentitytypes  []Entities               `json:"notentitytypes"`
 	Notentitynames  []string                 `json:"notentitynames"`
 	Notprofilenames []string                 `json:"notprofilenames"`
 	Notremediations []RemediationStatusTypes `json:"notremediations"`
 	Notalerts       []AlertStatusTypes       `json:"notalerts"`
 	Notstatuses     []EvalStatusTypes        `json:"notstatuses"`
+	Noterrorcodes   []EvalErrorCodes         `json:"noterrorcodes"`
 	Fromts          sql.NullTime             `json:"fromts"`
 	Tots            sql.NullTime             `json:"tots"`
+	Search          sql.NullString           `json:"search"`
 	Projectid       uuid.UUID                `json:"projectid"`
 	Labels          []string                 `json:"labels"`
 	Notlabels       []string                 `json:"notlabels"`
@@ -357,23 +587,27 @@ type ListEvaluationHistoryParams struct {
 }
 
 type ListEvaluationHistoryRow struct {
-	EvaluationID       uuid.UUID                  `json:"evaluation_id"`
-	EvaluatedAt        time.Time                  `json:"evaluated_at"`
-	EntityType         Entities                   `json:"entity_type"`
-	EntityID           uuid.UUID                  `json:"entity_id"`
-	ProjectID          uuid.UUID                  `json:"project_id"`
-	RuleType           string                     `json:"rule_type"`
-	RuleName           string                     `json:"rule_name"`
-	RuleSeverity       Severity                   `json:"rule_severity"`
-	ProfileName        string                     `json:"profile_name"`
-	ProfileLabels      []string                   `json:"profile_labels"`
-	EvaluationStatus   EvalStatusTypes            `json:"evaluation_status"`
-	EvaluationDetails  string                     `json:"evaluation_details"`
-	RemediationStatus  NullRemediationStatusTypes `json:"remediation_status"`
-	RemediationDetails sql.NullString             `json:"remediation_details"`
-	AlertStatus        NullAlertStatusTypes       `json:"alert_status"`
-	AlertDetails       sql.NullString             `json:"alert_details"`
-	EvalOutput         pqtype.NullRawMessage      `json:"eval_output"`
+	EvaluationID           uuid.UUID                  `json:"evaluation_id"`
+	EvaluatedAt            time.Time                  `json:"evaluated_at"`
+	EntityType             Entities                   `json:"entity_type"`
+	EntityID               uuid.UUID                  `json:"entity_id"`
+	ProjectID              uuid.UUID                  `json:"project_id"`
+	RuleType               string                     `json:"rule_type"`
+	RuleName               string                     `json:"rule_name"`
+	RuleSeverity           Severity                   `json:"rule_severity"`
+	ProfileName            string                     `json:"profile_name"`
+	ProfileLabels          []string                   `json:"profile_labels"`
+	EvaluationStatus       EvalStatusTypes            `json:"evaluation_status"`
+	EvaluationDetails      string                     `json:"evaluation_details"`
+	EvaluationErrorCode    NullEvalErrorCodes         `json:"evaluation_error_code"`
+	EvaluationRetryAttempt int32                      `json:"evaluation_retry_attempt"`
+	RemediationStatus      NullRemediationStatusTypes `json:"remediation_status"`
+	RemediationDetails     sql.NullString             `json:"remediation_details"`
+	RemediationMetadata    pqtype.NullRawMessage      `json:"remediation_metadata"`
+	AlertStatus            NullAlertStatusTypes       `json:"alert_status"`
+	AlertDetails           sql.NullString             `json:"alert_details"`
+	AlertMetadata          pqtype.NullRawMessage      `json:"alert_metadata"`
+	EvalOutput             pqtype.NullRawMessage      `json:"eval_output"`
 }
 
 func (q *Queries) ListEvaluationHistory(ctx context.Context, arg ListEvaluationHistoryParams) ([]ListEvaluationHistoryRow, error) {
@@ -387,14 +621,17 @@ func (q *Queries) ListEvaluationHistory(ctx context.Context, arg ListEvaluationH
 		pq.Array(arg.Remediations),
 		pq.Array(arg.Alerts),
 		pq.Array(arg.Statuses),
+		pq.Array(arg.Errorcodes),
 		pq.Array(arg.Notentitytypes),
 		pq.Array(arg.Notentitynames),
 		pq.Array(arg.Notprofilenames),
 		pq.Array(arg.Notremediations),
 		pq.Array(arg.Notalerts),
 		pq.Array(arg.Notstatuses),
+		pq.Array(arg.Noterrorcodes),
 		arg.Fromts,
 		arg.Tots,
+		arg.Search,
 		arg.Projectid,
 		pq.Array(arg.Labels),
 		pq.Array(arg.Notlabels),
@@ -420,10 +657,14 @@ func (q *Queries) ListEvaluationHistory(ctx context.Context, arg ListEvaluationH
 			pq.Array(&i.ProfileLabels),
 			&i.EvaluationStatus,
 			&i.EvaluationDetails,
+			&i.EvaluationErrorCode,
+			&i.EvaluationRetryAttempt,
 			&i.RemediationStatus,
 			&i.RemediationDetails,
+			&i.RemediationMetadata,
 			&i.AlertStatus,
 			&i.AlertDetails,
+			&i.AlertMetadata,
 			&i.EvalOutput,
 		); err != nil {
 			return nil, err
@@ -502,6 +743,205 @@ func (q *Queries) ListEvaluationHistoryStaleRecords(ctx context.Context, arg Lis
 	return items, nil
 }
 
+const listLatestEvaluationsByProject = `-- name: ListLatestEvaluationsByProject :many
+
+SELECT p.name AS profile_name,
+       ri.name AS rule_name,
+       rt.name AS rule_type_name,
+       ei.entity_type,
+       ei.name AS entity_name,
+       s.status,
+       s.details,
+       s.evaluation_time
+  FROM latest_evaluation_statuses les
+  JOIN evaluation_statuses s ON s.id = les.evaluation_history_id
+  JOIN evaluation_rule_entities ere ON ere.id = les.rule_entity_id
+  JOIN rule_instances ri ON ri.id = ere.rule_id
+  JOIN rule_type rt ON rt.id = ri.rule_type_id
+  JOIN profiles p ON p.id = ri.profile_id
+  JOIN entity_instances ei ON ei.id = ere.entity_instance_id
+ WHERE ri.project_id = $1
+ ORDER BY p.name, ri.name, ei.name
+`
+
+type ListLatestEvaluationsByProjectRow struct {
+	ProfileName    string          `json:"profile_name"`
+	RuleName       string          `json:"rule_name"`
+	RuleTypeName   string          `json:"rule_type_name"`
+	EntityType     Entities        `json:"entity_type"`
+	EntityName     string          `json:"entity_name"`
+	Status         EvalStatusTypes `json:"status"`
+	Details        string          `json:"details"`
+	EvaluationTime time.Time       `json:"evaluation_time"`
+}
+
+// ListLatestEvaluationsByProject lists the latest evaluation of each rule and
+// entity pair of a project, e.g. to archive a summary of its history.
+func (q *Queries) ListLatestEvaluationsByProject(ctx context.Context, projectID uuid.UUID) ([]ListLatestEvaluationsByProjectRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLatestEvaluationsByProject, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLatestEvaluationsByProjectRow{}
+	for rows.Next() {
+		var i ListLatestEvaluationsByProjectRow
+		if err := rows.Scan(
+			&i.ProfileName,
+			&i.RuleName,
+			&i.RuleTypeName,
+			&i.EntityType,
+			&i.EntityName,
+			&i.Status,
+			&i.Details,
+			&i.EvaluationTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRuleTypeFailureCounts = `-- name: ListRuleTypeFailureCounts :many
+SELECT rt.name AS rule_type_name,
+       COUNT(DISTINCT ere.entity_instance_id) FILTER (
+           WHERE s.status = 'failure' AND s.evaluation_time >= $1
+       ) AS recent_failures,
+       COUNT(DISTINCT ere.entity_instance_id) FILTER (
+           WHERE s.status = 'failure' AND s.evaluation_time < $1
+       ) AS baseline_failures,
+       COUNT(DISTINCT ere.entity_instance_id) FILTER (
+           WHERE s.evaluation_time >= $1
+       ) AS recent_evaluated,
+       COUNT(DISTINCT ri.project_id) FILTER (
+           WHERE s.status = 'failure' AND s.evaluation_time >= $1
+       ) AS recent_projects,
+       MAX(rt.updated_at)::timestamp AS rule_type_updated_at
+  FROM evaluation_statuses s
+       JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+       JOIN rule_instances ri ON ri.id = ere.rule_id
+       JOIN rule_type rt ON rt.id = ri.rule_type_id
+ WHERE s.evaluation_time >= $2
+ GROUP BY rt.name
+ ORDER BY rt.name
+`
+
+type ListRuleTypeFailureCountsParams struct {
+	WindowStart   time.Time `json:"window_start"`
+	BaselineStart time.Time `json:"baseline_start"`
+}
+
+type ListRuleTypeFailureCountsRow struct {
+	RuleTypeName      string    `json:"rule_type_name"`
+	RecentFailures    int64     `json:"recent_failures"`
+	BaselineFailures  int64     `json:"baseline_failures"`
+	RecentEvaluated   int64     `json:"recent_evaluated"`
+	RecentProjects    int64     `json:"recent_projects"`
+	RuleTypeUpdatedAt time.Time `json:"rule_type_updated_at"`
+}
+
+// ListRuleTypeFailureCounts counts, for each rule type name, the entities
+// which failed its evaluation since the start of the recent window, and the
+// entities which failed it in the baseline preceding the window. The
+// entities and projects evaluated in the window, and the last update of the
+// rule types of that name, give context to the counts.
+func (q *Queries) ListRuleTypeFailureCounts(ctx context.Context, arg ListRuleTypeFailureCountsParams) ([]ListRuleTypeFailureCountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRuleTypeFailureCounts, arg.WindowStart, arg.BaselineStart)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRuleTypeFailureCountsRow{}
+	for rows.Next() {
+		var i ListRuleTypeFailureCountsRow
+		if err := rows.Scan(
+			&i.RuleTypeName,
+			&i.RecentFailures,
+			&i.BaselineFailures,
+			&i.RecentEvaluated,
+			&i.RecentProjects,
+			&i.RuleTypeUpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStaleEvaluationRuleEntities = `-- name: ListStaleEvaluationRuleEntities :many
+SELECT ere.id,
+       ri.project_id,
+       s.evaluation_time AS last_evaluated,
+       (SELECT COUNT(*) FROM evaluation_statuses h WHERE h.rule_entity_id = ere.id) AS evaluations
+  FROM evaluation_rule_entities ere
+       JOIN rule_instances ri ON ri.id = ere.rule_id
+       JOIN latest_evaluation_statuses l ON l.rule_entity_id = ere.id
+       JOIN evaluation_statuses s ON s.id = l.evaluation_history_id
+ WHERE s.evaluation_time < $1
+   AND ($2::uuid IS NULL OR ri.project_id = $2::uuid)
+ ORDER BY s.evaluation_time ASC, ere.id ASC
+ LIMIT $3::integer
+`
+
+type ListStaleEvaluationRuleEntitiesParams struct {
+	Threshold time.Time     `json:"threshold"`
+	ProjectID uuid.NullUUID `json:"project_id"`
+	Size      int32         `json:"size"`
+}
+
+type ListStaleEvaluationRuleEntitiesRow struct {
+	ID            uuid.UUID `json:"id"`
+	ProjectID     uuid.UUID `json:"project_id"`
+	LastEvaluated time.Time `json:"last_evaluated"`
+	Evaluations   int64     `json:"evaluations"`
+}
+
+// ListStaleEvaluationRuleEntities lists the rule and entity pairs which were
+// not evaluated since the threshold, such as the pairs of the entities which
+// are no longer selected by a profile, along with the size of their history.
+// The project filter is optional.
+func (q *Queries) ListStaleEvaluationRuleEntities(ctx context.Context, arg ListStaleEvaluationRuleEntitiesParams) ([]ListStaleEvaluationRuleEntitiesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listStaleEvaluationRuleEntities, arg.Threshold, arg.ProjectID, arg.Size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStaleEvaluationRuleEntitiesRow{}
+	for rows.Next() {
+		var i ListStaleEvaluationRuleEntitiesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.LastEvaluated,
+			&i.Evaluations,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertLatestEvaluationStatus = `-- name: UpsertLatestEvaluationStatus :exec
 INSERT INTO latest_evaluation_statuses(
     rule_entity_id,
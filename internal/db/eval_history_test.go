@@ -607,6 +607,43 @@ func TestListEvaluationHistoryFilters(t *testing.T) {
 			},
 		},
 
+		// error code filter
+		{
+			name: "error code filter include",
+			params: ListEvaluationHistoryParams{
+				Next: sql.NullTime{
+					Time:  time.UnixMicro(999999999999999999).UTC(),
+					Valid: true,
+				},
+				Errorcodes: []EvalErrorCodes{EvalErrorCodesRateLimited},
+				Projectid:  proj.ID,
+				Size:       5,
+			},
+			checkf: func(t *testing.T, rows []ListEvaluationHistoryRow) {
+				t.Helper()
+				require.Len(t, rows, 0)
+			},
+		},
+		{
+			name: "error code filter exclude",
+			params: ListEvaluationHistoryParams{
+				Next: sql.NullTime{
+					Time:  time.UnixMicro(999999999999999999).UTC(),
+					Valid: true,
+				},
+				Noterrorcodes: []EvalErrorCodes{EvalErrorCodesRateLimited},
+				Projectid:     proj.ID,
+				Size:          5,
+			},
+			checkf: func(t *testing.T, rows []ListEvaluationHistoryRow) {
+				t.Helper()
+				require.Len(t, rows, 1)
+				row := rows[0]
+				require.Equal(t, es1, row.EvaluationID)
+				require.False(t, row.EvaluationErrorCode.Valid)
+			},
+		},
+
 		// time range filter
 		{
 			name: "time range filter from +1h",
@@ -58,6 +58,14 @@ func (ns NullActionType) Value() (driver.Value, error) {
 	return string(ns.ActionType), nil
 }
 
+func AllActionTypeValues() []ActionType {
+	return []ActionType{
+		ActionTypeOn,
+		ActionTypeOff,
+		ActionTypeDryRun,
+	}
+}
+
 type AlertStatusTypes string
 
 const (
@@ -103,6 +111,16 @@ func (ns NullAlertStatusTypes) Value() (driver.Value, error) {
 	return string(ns.AlertStatusTypes), nil
 }
 
+func AllAlertStatusTypesValues() []AlertStatusTypes {
+	return []AlertStatusTypes{
+		AlertStatusTypesOn,
+		AlertStatusTypesOff,
+		AlertStatusTypesError,
+		AlertStatusTypesSkipped,
+		AlertStatusTypesNotAvailable,
+	}
+}
+
 type AuthorizationFlow string
 
 const (
@@ -147,6 +165,15 @@ func (ns NullAuthorizationFlow) Value() (driver.Value, error) {
 	return string(ns.AuthorizationFlow), nil
 }
 
+func AllAuthorizationFlowValues() []AuthorizationFlow {
+	return []AuthorizationFlow{
+		AuthorizationFlowUserInput,
+		AuthorizationFlowOauth2AuthorizationCodeFlow,
+		AuthorizationFlowGithubAppFlow,
+		AuthorizationFlowNone,
+	}
+}
+
 type Entities string
 
 const (
@@ -158,6 +185,7 @@ const (
 	EntitiesPipelineRun      Entities = "pipeline_run"
 	EntitiesTaskRun          Entities = "task_run"
 	EntitiesBuild            Entities = "build"
+	EntitiesOrganization     Entities = "organization"
 )
 
 func (e *Entities) Scan(src interface{}) error {
@@ -195,14 +223,93 @@ func (ns NullEntities) Value() (driver.Value, error) {
 	return string(ns.Entities), nil
 }
 
+func AllEntitiesValues() []Entities {
+	return []Entities{
+		EntitiesRepository,
+		EntitiesBuildEnvironment,
+		EntitiesArtifact,
+		EntitiesPullRequest,
+		EntitiesRelease,
+		EntitiesPipelineRun,
+		EntitiesTaskRun,
+		EntitiesBuild,
+		EntitiesOrganization,
+	}
+}
+
+type EvalErrorCodes string
+
+const (
+	EvalErrorCodesUnknown                EvalErrorCodes = "unknown"
+	EvalErrorCodesInternal               EvalErrorCodes = "internal"
+	EvalErrorCodesProviderUnavailable    EvalErrorCodes = "provider_unavailable"
+	EvalErrorCodesRateLimited            EvalErrorCodes = "rate_limited"
+	EvalErrorCodesPolicyCompileError     EvalErrorCodes = "policy_compile_error"
+	EvalErrorCodesIngestionNotApplicable EvalErrorCodes = "ingestion_not_applicable"
+	EvalErrorCodesIngestionFailed        EvalErrorCodes = "ingestion_failed"
+	EvalErrorCodesInvalidRuleParameters  EvalErrorCodes = "invalid_rule_parameters"
+	EvalErrorCodesLimitExceeded          EvalErrorCodes = "limit_exceeded"
+)
+
+func (e *EvalErrorCodes) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EvalErrorCodes(s)
+	case string:
+		*e = EvalErrorCodes(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EvalErrorCodes: %T", src)
+	}
+	return nil
+}
+
+type NullEvalErrorCodes struct {
+	EvalErrorCodes EvalErrorCodes `json:"eval_error_codes"`
+	Valid          bool           `json:"valid"` // Valid is true if EvalErrorCodes is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEvalErrorCodes) Scan(value interface{}) error {
+	if value == nil {
+		ns.EvalErrorCodes, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EvalErrorCodes.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEvalErrorCodes) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EvalErrorCodes), nil
+}
+
+func AllEvalErrorCodesValues() []EvalErrorCodes {
+	return []EvalErrorCodes{
+		EvalErrorCodesUnknown,
+		EvalErrorCodesInternal,
+		EvalErrorCodesProviderUnavailable,
+		EvalErrorCodesRateLimited,
+		EvalErrorCodesPolicyCompileError,
+		EvalErrorCodesIngestionNotApplicable,
+		EvalErrorCodesIngestionFailed,
+		EvalErrorCodesInvalidRuleParameters,
+		EvalErrorCodesLimitExceeded,
+	}
+}
+
 type EvalStatusTypes string
 
 const (
-	EvalStatusTypesSuccess EvalStatusTypes = "success"
-	EvalStatusTypesFailure EvalStatusTypes = "failure"
-	EvalStatusTypesError   EvalStatusTypes = "error"
-	EvalStatusTypesSkipped EvalStatusTypes = "skipped"
-	EvalStatusTypesPending EvalStatusTypes = "pending"
+	EvalStatusTypesSuccess    EvalStatusTypes = "success"
+	EvalStatusTypesFailure    EvalStatusTypes = "failure"
+	EvalStatusTypesError      EvalStatusTypes = "error"
+	EvalStatusTypesSkipped    EvalStatusTypes = "skipped"
+	EvalStatusTypesPending    EvalStatusTypes = "pending"
+	EvalStatusTypesExcepted   EvalStatusTypes = "excepted"
+	EvalStatusTypesSuppressed EvalStatusTypes = "suppressed"
 )
 
 func (e *EvalStatusTypes) Scan(src interface{}) error {
@@ -240,14 +347,182 @@ func (ns NullEvalStatusTypes) Value() (driver.Value, error) {
 	return string(ns.EvalStatusTypes), nil
 }
 
+func AllEvalStatusTypesValues() []EvalStatusTypes {
+	return []EvalStatusTypes{
+		EvalStatusTypesSuccess,
+		EvalStatusTypesFailure,
+		EvalStatusTypesError,
+		EvalStatusTypesSkipped,
+		EvalStatusTypesPending,
+		EvalStatusTypesExcepted,
+		EvalStatusTypesSuppressed,
+	}
+}
+
+type EvaluationAnnotationKind string
+
+const (
+	EvaluationAnnotationKindTriageState EvaluationAnnotationKind = "triage_state"
+	EvaluationAnnotationKindTicket      EvaluationAnnotationKind = "ticket"
+	EvaluationAnnotationKindNote        EvaluationAnnotationKind = "note"
+)
+
+func (e *EvaluationAnnotationKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EvaluationAnnotationKind(s)
+	case string:
+		*e = EvaluationAnnotationKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EvaluationAnnotationKind: %T", src)
+	}
+	return nil
+}
+
+type NullEvaluationAnnotationKind struct {
+	EvaluationAnnotationKind EvaluationAnnotationKind `json:"evaluation_annotation_kind"`
+	Valid                    bool                     `json:"valid"` // Valid is true if EvaluationAnnotationKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEvaluationAnnotationKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.EvaluationAnnotationKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EvaluationAnnotationKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEvaluationAnnotationKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EvaluationAnnotationKind), nil
+}
+
+func AllEvaluationAnnotationKindValues() []EvaluationAnnotationKind {
+	return []EvaluationAnnotationKind{
+		EvaluationAnnotationKindTriageState,
+		EvaluationAnnotationKindTicket,
+		EvaluationAnnotationKindNote,
+	}
+}
+
+type MigrationPhaseState string
+
+const (
+	MigrationPhaseStateRunning   MigrationPhaseState = "running"
+	MigrationPhaseStateCompleted MigrationPhaseState = "completed"
+	MigrationPhaseStateFailed    MigrationPhaseState = "failed"
+)
+
+func (e *MigrationPhaseState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = MigrationPhaseState(s)
+	case string:
+		*e = MigrationPhaseState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for MigrationPhaseState: %T", src)
+	}
+	return nil
+}
+
+type NullMigrationPhaseState struct {
+	MigrationPhaseState MigrationPhaseState `json:"migration_phase_state"`
+	Valid               bool                `json:"valid"` // Valid is true if MigrationPhaseState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullMigrationPhaseState) Scan(value interface{}) error {
+	if value == nil {
+		ns.MigrationPhaseState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.MigrationPhaseState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullMigrationPhaseState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.MigrationPhaseState), nil
+}
+
+func AllMigrationPhaseStateValues() []MigrationPhaseState {
+	return []MigrationPhaseState{
+		MigrationPhaseStateRunning,
+		MigrationPhaseStateCompleted,
+		MigrationPhaseStateFailed,
+	}
+}
+
+type PendingOperationState string
+
+const (
+	PendingOperationStatePending   PendingOperationState = "pending"
+	PendingOperationStateConfirmed PendingOperationState = "confirmed"
+	PendingOperationStateCancelled PendingOperationState = "cancelled"
+)
+
+func (e *PendingOperationState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PendingOperationState(s)
+	case string:
+		*e = PendingOperationState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PendingOperationState: %T", src)
+	}
+	return nil
+}
+
+type NullPendingOperationState struct {
+	PendingOperationState PendingOperationState `json:"pending_operation_state"`
+	Valid                 bool                  `json:"valid"` // Valid is true if PendingOperationState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPendingOperationState) Scan(value interface{}) error {
+	if value == nil {
+		ns.PendingOperationState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PendingOperationState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPendingOperationState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PendingOperationState), nil
+}
+
+func AllPendingOperationStateValues() []PendingOperationState {
+	return []PendingOperationState{
+		PendingOperationStatePending,
+		PendingOperationStateConfirmed,
+		PendingOperationStateCancelled,
+	}
+}
+
 type ProviderClass string
 
 const (
-	ProviderClassGithub    ProviderClass = "github"
-	ProviderClassGithubApp ProviderClass = "github-app"
-	ProviderClassGhcr      ProviderClass = "ghcr"
-	ProviderClassDockerhub ProviderClass = "dockerhub"
-	ProviderClassGitlab    ProviderClass = "gitlab"
+	ProviderClassGithub      ProviderClass = "github"
+	ProviderClassGithubApp   ProviderClass = "github-app"
+	ProviderClassGhcr        ProviderClass = "ghcr"
+	ProviderClassDockerhub   ProviderClass = "dockerhub"
+	ProviderClassGitlab      ProviderClass = "gitlab"
+	ProviderClassAzureDevops ProviderClass = "azure-devops"
+	ProviderClassNpm         ProviderClass = "npm"
+	ProviderClassPypi        ProviderClass = "pypi"
 )
 
 func (e *ProviderClass) Scan(src interface{}) error {
@@ -285,6 +560,19 @@ func (ns NullProviderClass) Value() (driver.Value, error) {
 	return string(ns.ProviderClass), nil
 }
 
+func AllProviderClassValues() []ProviderClass {
+	return []ProviderClass{
+		ProviderClassGithub,
+		ProviderClassGithubApp,
+		ProviderClassGhcr,
+		ProviderClassDockerhub,
+		ProviderClassGitlab,
+		ProviderClassAzureDevops,
+		ProviderClassNpm,
+		ProviderClassPypi,
+	}
+}
+
 type ProviderType string
 
 const (
@@ -331,6 +619,17 @@ func (ns NullProviderType) Value() (driver.Value, error) {
 	return string(ns.ProviderType), nil
 }
 
+func AllProviderTypeValues() []ProviderType {
+	return []ProviderType{
+		ProviderTypeGithub,
+		ProviderTypeRest,
+		ProviderTypeGit,
+		ProviderTypeOci,
+		ProviderTypeRepoLister,
+		ProviderTypeImageLister,
+	}
+}
+
 type ReleaseStatus string
 
 const (
@@ -375,6 +674,15 @@ func (ns NullReleaseStatus) Value() (driver.Value, error) {
 	return string(ns.ReleaseStatus), nil
 }
 
+func AllReleaseStatusValues() []ReleaseStatus {
+	return []ReleaseStatus{
+		ReleaseStatusAlpha,
+		ReleaseStatusBeta,
+		ReleaseStatusGa,
+		ReleaseStatusDeprecated,
+	}
+}
+
 type RemediationStatusTypes string
 
 const (
@@ -421,6 +729,68 @@ func (ns NullRemediationStatusTypes) Value() (driver.Value, error) {
 	return string(ns.RemediationStatusTypes), nil
 }
 
+func AllRemediationStatusTypesValues() []RemediationStatusTypes {
+	return []RemediationStatusTypes{
+		RemediationStatusTypesSuccess,
+		RemediationStatusTypesFailure,
+		RemediationStatusTypesError,
+		RemediationStatusTypesSkipped,
+		RemediationStatusTypesNotAvailable,
+		RemediationStatusTypesPending,
+	}
+}
+
+type RuleExceptionState string
+
+const (
+	RuleExceptionStatePending  RuleExceptionState = "pending"
+	RuleExceptionStateApproved RuleExceptionState = "approved"
+	RuleExceptionStateRejected RuleExceptionState = "rejected"
+)
+
+func (e *RuleExceptionState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RuleExceptionState(s)
+	case string:
+		*e = RuleExceptionState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RuleExceptionState: %T", src)
+	}
+	return nil
+}
+
+type NullRuleExceptionState struct {
+	RuleExceptionState RuleExceptionState `json:"rule_exception_state"`
+	Valid              bool               `json:"valid"` // Valid is true if RuleExceptionState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRuleExceptionState) Scan(value interface{}) error {
+	if value == nil {
+		ns.RuleExceptionState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RuleExceptionState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRuleExceptionState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RuleExceptionState), nil
+}
+
+func AllRuleExceptionStateValues() []RuleExceptionState {
+	return []RuleExceptionState{
+		RuleExceptionStatePending,
+		RuleExceptionStateApproved,
+		RuleExceptionStateRejected,
+	}
+}
+
 type Severity string
 
 const (
@@ -467,6 +837,68 @@ func (ns NullSeverity) Value() (driver.Value, error) {
 	return string(ns.Severity), nil
 }
 
+func AllSeverityValues() []Severity {
+	return []Severity{
+		SeverityUnknown,
+		SeverityInfo,
+		SeverityLow,
+		SeverityMedium,
+		SeverityHigh,
+		SeverityCritical,
+	}
+}
+
+type Visibility string
+
+const (
+	VisibilityPrivate Visibility = "private"
+	VisibilitySubtree Visibility = "subtree"
+	VisibilityGlobal  Visibility = "global"
+)
+
+func (e *Visibility) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = Visibility(s)
+	case string:
+		*e = Visibility(s)
+	default:
+		return fmt.Errorf("unsupported scan type for Visibility: %T", src)
+	}
+	return nil
+}
+
+type NullVisibility struct {
+	Visibility Visibility `json:"visibility"`
+	Valid      bool       `json:"valid"` // Valid is true if Visibility is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullVisibility) Scan(value interface{}) error {
+	if value == nil {
+		ns.Visibility, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.Visibility.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullVisibility) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.Visibility), nil
+}
+
+func AllVisibilityValues() []Visibility {
+	return []Visibility{
+		VisibilityPrivate,
+		VisibilitySubtree,
+		VisibilityGlobal,
+	}
+}
+
 type AlertEvent struct {
 	ID           uuid.UUID        `json:"id"`
 	EvaluationID uuid.UUID        `json:"evaluation_id"`
@@ -476,10 +908,34 @@ type AlertEvent struct {
 	CreatedAt    time.Time        `json:"created_at"`
 }
 
+type AlertGroup struct {
+	EntityInstanceID uuid.UUID             `json:"entity_instance_id"`
+	ProfileID        uuid.UUID             `json:"profile_id"`
+	ProjectID        uuid.UUID             `json:"project_id"`
+	FailingRules     []string              `json:"failing_rules"`
+	PendingRules     []string              `json:"pending_rules"`
+	AlertMetadata    pqtype.NullRawMessage `json:"alert_metadata"`
+	LastNotifiedAt   sql.NullTime          `json:"last_notified_at"`
+	FlushAfter       sql.NullTime          `json:"flush_after"`
+}
+
+type ApiQuotaBucket struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Tokens    float64   `json:"tokens"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 type Bundle struct {
-	ID        uuid.UUID `json:"id"`
-	Namespace string    `json:"namespace"`
-	Name      string    `json:"name"`
+	ID                    uuid.UUID             `json:"id"`
+	Namespace             string                `json:"namespace"`
+	Name                  string                `json:"name"`
+	SignatureVerification pqtype.NullRawMessage `json:"signature_verification"`
+}
+
+type ClosedEntity struct {
+	EntityInstanceID uuid.UUID `json:"entity_instance_id"`
+	ProjectID        uuid.UUID `json:"project_id"`
+	ClosedAt         time.Time `json:"closed_at"`
 }
 
 type DataSource struct {
@@ -491,6 +947,7 @@ type DataSource struct {
 	UpdatedAt      time.Time             `json:"updated_at"`
 	SubscriptionID uuid.NullUUID         `json:"subscription_id"`
 	Metadata       pqtype.NullRawMessage `json:"metadata"`
+	Visibility     Visibility            `json:"visibility"`
 }
 
 type DataSourcesFunction struct {
@@ -504,6 +961,12 @@ type DataSourcesFunction struct {
 	ProjectID    uuid.UUID       `json:"project_id"`
 }
 
+type DeferredAction struct {
+	EntityInstanceID uuid.UUID `json:"entity_instance_id"`
+	ProjectID        uuid.UUID `json:"project_id"`
+	DeferredAt       time.Time `json:"deferred_at"`
+}
+
 type Entitlement struct {
 	ID        uuid.UUID `json:"id"`
 	Feature   string    `json:"feature"`
@@ -540,12 +1003,34 @@ type EntityProfile struct {
 	Migrated        bool            `json:"migrated"`
 }
 
+type EvaluationAnnotation struct {
+	ID           uuid.UUID                `json:"id"`
+	ProjectID    uuid.UUID                `json:"project_id"`
+	RuleEntityID uuid.UUID                `json:"rule_entity_id"`
+	EvaluationID uuid.NullUUID            `json:"evaluation_id"`
+	Kind         EvaluationAnnotationKind `json:"kind"`
+	Value        string                   `json:"value"`
+	Source       string                   `json:"source"`
+	CreatedBy    string                   `json:"created_by"`
+	CreatedAt    time.Time                `json:"created_at"`
+}
+
 type EvaluationOutput struct {
 	ID     uuid.UUID             `json:"id"`
 	Output pqtype.NullRawMessage `json:"output"`
 	Debug  sql.NullString        `json:"debug"`
 }
 
+type EvaluationRetry struct {
+	EntityInstanceID uuid.UUID      `json:"entity_instance_id"`
+	ProjectID        uuid.UUID      `json:"project_id"`
+	Attempt          int32          `json:"attempt"`
+	ErrorCode        EvalErrorCodes `json:"error_code"`
+	NextRetryAt      time.Time      `json:"next_retry_at"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+}
+
 type EvaluationRuleEntity struct {
 	ID               uuid.UUID `json:"id"`
 	RuleID           uuid.UUID `json:"rule_id"`
@@ -554,12 +1039,14 @@ type EvaluationRuleEntity struct {
 }
 
 type EvaluationStatus struct {
-	ID             uuid.UUID       `json:"id"`
-	RuleEntityID   uuid.UUID       `json:"rule_entity_id"`
-	Status         EvalStatusTypes `json:"status"`
-	Details        string          `json:"details"`
-	EvaluationTime time.Time       `json:"evaluation_time"`
-	Checkpoint     json.RawMessage `json:"checkpoint"`
+	ID             uuid.UUID          `json:"id"`
+	RuleEntityID   uuid.UUID          `json:"rule_entity_id"`
+	Status         EvalStatusTypes    `json:"status"`
+	Details        string             `json:"details"`
+	EvaluationTime time.Time          `json:"evaluation_time"`
+	Checkpoint     json.RawMessage    `json:"checkpoint"`
+	ErrorCode      NullEvalErrorCodes `json:"error_code"`
+	RetryAttempt   int32              `json:"retry_attempt"`
 }
 
 type Feature struct {
@@ -583,19 +1070,49 @@ type LatestEvaluationStatus struct {
 	ProfileID           uuid.UUID `json:"profile_id"`
 }
 
+type MigrationPhase struct {
+	Name        string              `json:"name"`
+	Version     int64               `json:"version"`
+	State       MigrationPhaseState `json:"state"`
+	LastError   string              `json:"last_error"`
+	StartedAt   time.Time           `json:"started_at"`
+	CompletedAt sql.NullTime        `json:"completed_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+type PendingOperation struct {
+	ID          uuid.UUID             `json:"id"`
+	ProjectID   uuid.UUID             `json:"project_id"`
+	Method      string                `json:"method"`
+	Request     json.RawMessage       `json:"request"`
+	State       PendingOperationState `json:"state"`
+	RequestedBy string                `json:"requested_by"`
+	ReviewedBy  sql.NullString        `json:"reviewed_by"`
+	ExpiresAt   time.Time             `json:"expires_at"`
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
 type Profile struct {
-	ID             uuid.UUID      `json:"id"`
-	Name           string         `json:"name"`
-	Provider       sql.NullString `json:"provider"`
-	ProjectID      uuid.UUID      `json:"project_id"`
-	Remediate      NullActionType `json:"remediate"`
-	Alert          NullActionType `json:"alert"`
-	CreatedAt      time.Time      `json:"created_at"`
-	UpdatedAt      time.Time      `json:"updated_at"`
-	ProviderID     uuid.NullUUID  `json:"provider_id"`
-	SubscriptionID uuid.NullUUID  `json:"subscription_id"`
-	DisplayName    string         `json:"display_name"`
-	Labels         []string       `json:"labels"`
+	ID                  uuid.UUID      `json:"id"`
+	Name                string         `json:"name"`
+	Provider            sql.NullString `json:"provider"`
+	ProjectID           uuid.UUID      `json:"project_id"`
+	Remediate           NullActionType `json:"remediate"`
+	Alert               NullActionType `json:"alert"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+	ProviderID          uuid.NullUUID  `json:"provider_id"`
+	SubscriptionID      uuid.NullUUID  `json:"subscription_id"`
+	DisplayName         string         `json:"display_name"`
+	Labels              []string       `json:"labels"`
+	AlertGroupingWindow int64          `json:"alert_grouping_window"`
+}
+
+type ProfileExtend struct {
+	ProfileID     uuid.UUID `json:"profile_id"`
+	BaseProfileID uuid.UUID `json:"base_profile_id"`
+	Position      int32     `json:"position"`
 }
 
 type ProfileSelector struct {
@@ -613,6 +1130,23 @@ type ProfileStatus struct {
 	LastUpdated   time.Time       `json:"last_updated"`
 }
 
+type ProfileStatusShareLink struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"project_id"`
+	ProfileID uuid.UUID `json:"profile_id"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type ProfileVersion struct {
+	ID         uuid.UUID       `json:"id"`
+	ProfileID  uuid.UUID       `json:"profile_id"`
+	Version    int32           `json:"version"`
+	Definition json.RawMessage `json:"definition"`
+	Author     string          `json:"author"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
 type ProfilesWithEntityProfile struct {
 	ID              uuid.NullUUID         `json:"id"`
 	Entity          NullEntities          `json:"entity"`
@@ -634,6 +1168,43 @@ type Project struct {
 	UpdatedAt      time.Time       `json:"updated_at"`
 }
 
+type ProjectDeployKey struct {
+	ID                  uuid.UUID       `json:"id"`
+	ProjectID           uuid.UUID       `json:"project_id"`
+	Name                string          `json:"name"`
+	PublicKey           string          `json:"public_key"`
+	Fingerprint         string          `json:"fingerprint"`
+	EncryptedPrivateKey json.RawMessage `json:"encrypted_private_key"`
+	CreatedAt           time.Time       `json:"created_at"`
+}
+
+type ProjectSecret struct {
+	ID             uuid.UUID       `json:"id"`
+	ProjectID      uuid.UUID       `json:"project_id"`
+	Name           string          `json:"name"`
+	EncryptedValue json.RawMessage `json:"encrypted_value"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+type ProjectTier struct {
+	Name            string        `json:"name"`
+	Description     string        `json:"description"`
+	MaxRepositories sql.NullInt32 `json:"max_repositories"`
+	MaxProviders    sql.NullInt32 `json:"max_providers"`
+	MaxRules        sql.NullInt32 `json:"max_rules"`
+	AdvancedEngines bool          `json:"advanced_engines"`
+	Features        []string      `json:"features"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+}
+
+type ProjectTierAssignment struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Tier      string    `json:"tier"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type Property struct {
 	ID        uuid.UUID       `json:"id"`
 	EntityID  uuid.UUID       `json:"entity_id"`
@@ -680,6 +1251,32 @@ type ProviderGithubAppInstallation struct {
 	IsOrg             bool           `json:"is_org"`
 }
 
+type ProviderHealth struct {
+	ProviderID      uuid.UUID       `json:"provider_id"`
+	Checks          json.RawMessage `json:"checks"`
+	Healthy         bool            `json:"healthy"`
+	LastSuccessAt   sql.NullTime    `json:"last_success_at"`
+	TokenExpiresAt  sql.NullTime    `json:"token_expires_at"`
+	Scopes          []string        `json:"scopes"`
+	ScopesChangedAt sql.NullTime    `json:"scopes_changed_at"`
+	AlertedExpiry   sql.NullTime    `json:"alerted_expiry"`
+	CheckedAt       time.Time       `json:"checked_at"`
+}
+
+type ProviderShare struct {
+	ProviderID uuid.UUID `json:"provider_id"`
+	ProjectID  uuid.UUID `json:"project_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ProviderUsage struct {
+	ProviderID  uuid.UUID `json:"provider_id"`
+	Bucket      time.Time `json:"bucket"`
+	ApiCalls    int64     `json:"api_calls"`
+	Webhooks    int64     `json:"webhooks"`
+	Evaluations int64     `json:"evaluations"`
+}
+
 type RemediationEvent struct {
 	ID           uuid.UUID              `json:"id"`
 	EvaluationID uuid.UUID              `json:"evaluation_id"`
@@ -689,17 +1286,59 @@ type RemediationEvent struct {
 	CreatedAt    time.Time              `json:"created_at"`
 }
 
+type RepositoryListingCursor struct {
+	ProviderID uuid.UUID `json:"provider_id"`
+	ProjectID  uuid.UUID `json:"project_id"`
+	NextCursor string    `json:"next_cursor"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type RollupEvaluationStatus struct {
+	RuleEntityID uuid.UUID       `json:"rule_entity_id"`
+	ProfileID    uuid.UUID       `json:"profile_id"`
+	Status       EvalStatusTypes `json:"status"`
+}
+
+type RuleException struct {
+	ID               uuid.UUID          `json:"id"`
+	ProjectID        uuid.UUID          `json:"project_id"`
+	RuleID           uuid.UUID          `json:"rule_id"`
+	EntityInstanceID uuid.UUID          `json:"entity_instance_id"`
+	Justification    string             `json:"justification"`
+	State            RuleExceptionState `json:"state"`
+	RequestedBy      string             `json:"requested_by"`
+	ReviewedBy       sql.NullString     `json:"reviewed_by"`
+	ReviewComment    sql.NullString     `json:"review_comment"`
+	ExpiresAt        time.Time          `json:"expires_at"`
+	CreatedAt        time.Time          `json:"created_at"`
+	UpdatedAt        time.Time          `json:"updated_at"`
+}
+
+type RuleExceptionEvent struct {
+	ID          uuid.UUID          `json:"id"`
+	ExceptionID uuid.UUID          `json:"exception_id"`
+	State       RuleExceptionState `json:"state"`
+	Actor       string             `json:"actor"`
+	Comment     string             `json:"comment"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
 type RuleInstance struct {
-	ID         uuid.UUID       `json:"id"`
-	ProfileID  uuid.UUID       `json:"profile_id"`
-	RuleTypeID uuid.UUID       `json:"rule_type_id"`
-	Name       string          `json:"name"`
-	EntityType Entities        `json:"entity_type"`
-	Def        json.RawMessage `json:"def"`
-	Params     json.RawMessage `json:"params"`
-	CreatedAt  time.Time       `json:"created_at"`
-	UpdatedAt  time.Time       `json:"updated_at"`
-	ProjectID  uuid.UUID       `json:"project_id"`
+	ID               uuid.UUID       `json:"id"`
+	ProfileID        uuid.UUID       `json:"profile_id"`
+	RuleTypeID       uuid.UUID       `json:"rule_type_id"`
+	Name             string          `json:"name"`
+	EntityType       Entities        `json:"entity_type"`
+	Def              json.RawMessage `json:"def"`
+	Params           json.RawMessage `json:"params"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	ProjectID        uuid.UUID       `json:"project_id"`
+	Overrides        json.RawMessage `json:"overrides"`
+	DisabledAt       sql.NullTime    `json:"disabled_at"`
+	DisabledBy       sql.NullString  `json:"disabled_by"`
+	DisabledReason   sql.NullString  `json:"disabled_reason"`
+	CanaryPercentage sql.NullInt32   `json:"canary_percentage"`
 }
 
 type RuleType struct {
@@ -719,6 +1358,7 @@ type RuleType struct {
 	ReleasePhase        ReleaseStatus   `json:"release_phase"`
 	ShortFailureMessage string          `json:"short_failure_message"`
 	RegoVersion         string          `json:"rego_version"`
+	Visibility          Visibility      `json:"visibility"`
 }
 
 type RuleTypeDataSource struct {
@@ -748,6 +1388,13 @@ type Subscription struct {
 	CurrentVersion string    `json:"current_version"`
 }
 
+type ThrottledEvaluation struct {
+	EntityInstanceID uuid.UUID `json:"entity_instance_id"`
+	ProjectID        uuid.UUID `json:"project_id"`
+	ThrottledAt      time.Time `json:"throttled_at"`
+	EvaluateAfter    time.Time `json:"evaluate_after"`
+}
+
 type User struct {
 	ID              int32     `json:"id"`
 	IdentitySubject string    `json:"identity_subject"`
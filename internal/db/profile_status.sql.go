@@ -117,6 +117,72 @@ func (q *Queries) GetProfileStatusByProject(ctx context.Context, projectID uuid.
 	return items, nil
 }
 
+const listCanaryRuleResults = `-- name: ListCanaryRuleResults :many
+SELECT
+    ri.name AS rule_name,
+    ri.entity_type,
+    rt.name AS rule_type_name,
+    ri.canary_percentage::integer AS canary_percentage,
+    COUNT(es.id) FILTER (WHERE es.status = 'success')::bigint AS success_count,
+    COUNT(es.id) FILTER (WHERE es.status IN ('failure', 'excepted', 'suppressed'))::bigint AS failure_count,
+    COUNT(es.id) FILTER (WHERE es.status = 'error')::bigint AS error_count,
+    COUNT(es.id) FILTER (WHERE es.status = 'skipped')::bigint AS skipped_count
+FROM rule_instances AS ri
+         INNER JOIN rule_type rt ON rt.id = ri.rule_type_id
+         LEFT JOIN evaluation_rule_entities ere ON ere.rule_id = ri.id
+         LEFT JOIN latest_evaluation_statuses les ON les.rule_entity_id = ere.id
+         LEFT JOIN evaluation_statuses es ON es.id = les.evaluation_history_id
+WHERE ri.profile_id = $1
+    AND ri.canary_percentage IS NOT NULL
+GROUP BY ri.id, rt.name
+ORDER BY ri.name
+`
+
+type ListCanaryRuleResultsRow struct {
+	RuleName         string   `json:"rule_name"`
+	EntityType       Entities `json:"entity_type"`
+	RuleTypeName     string   `json:"rule_type_name"`
+	CanaryPercentage int32    `json:"canary_percentage"`
+	SuccessCount     int64    `json:"success_count"`
+	FailureCount     int64    `json:"failure_count"`
+	ErrorCount       int64    `json:"error_count"`
+	SkippedCount     int64    `json:"skipped_count"`
+}
+
+// ListCanaryRuleResults aggregates the latest evaluations of the canary rules
+// of a profile, which are left out of the profile status.
+func (q *Queries) ListCanaryRuleResults(ctx context.Context, profileID uuid.UUID) ([]ListCanaryRuleResultsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCanaryRuleResults, profileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCanaryRuleResultsRow{}
+	for rows.Next() {
+		var i ListCanaryRuleResultsRow
+		if err := rows.Scan(
+			&i.RuleName,
+			&i.EntityType,
+			&i.RuleTypeName,
+			&i.CanaryPercentage,
+			&i.SuccessCount,
+			&i.FailureCount,
+			&i.ErrorCount,
+			&i.SkippedCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOldestRuleEvaluationsByEntityID = `-- name: ListOldestRuleEvaluationsByEntityID :many
 
 SELECT ere.entity_instance_id, MIN(es.evaluation_time)::timestamp AS oldest_last_updated
@@ -269,15 +335,21 @@ WHERE les.profile_id = $1
     AND (ei.name = $4 OR $4 IS NULL)
     AND (rt.name = $5 OR $5 IS NULL)
     AND (lower(ri.name) = lower($6) OR $6 IS NULL)
+    AND ($7::eval_status_types[] IS NULL OR ed.eval_status = ANY($7::eval_status_types[]))
+    AND (ei.name LIKE $8 OR $8 IS NULL)
+    AND ($9::severity[] IS NULL OR rt.severity_value = ANY($9::severity[]))
 `
 
 type ListRuleEvaluationsByProfileIdParams struct {
-	ProfileID      uuid.UUID      `json:"profile_id"`
-	IncludeOutputs bool           `json:"include_outputs"`
-	EntityID       uuid.NullUUID  `json:"entity_id"`
-	EntityName     sql.NullString `json:"entity_name"`
-	RuleTypeName   sql.NullString `json:"rule_type_name"`
-	RuleName       sql.NullString `json:"rule_name"`
+	ProfileID         uuid.UUID         `json:"profile_id"`
+	IncludeOutputs    bool              `json:"include_outputs"`
+	EntityID          uuid.NullUUID     `json:"entity_id"`
+	EntityName        sql.NullString    `json:"entity_name"`
+	RuleTypeName      sql.NullString    `json:"rule_type_name"`
+	RuleName          sql.NullString    `json:"rule_name"`
+	Statuses          []EvalStatusTypes `json:"statuses"`
+	EntityNamePattern sql.NullString    `json:"entity_name_pattern"`
+	Severities        []Severity        `json:"severities"`
 }
 
 type ListRuleEvaluationsByProfileIdRow struct {
@@ -316,6 +388,9 @@ func (q *Queries) ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRu
 		arg.EntityName,
 		arg.RuleTypeName,
 		arg.RuleName,
+		pq.Array(arg.Statuses),
+		arg.EntityNamePattern,
+		pq.Array(arg.Severities),
 	)
 	if err != nil {
 		return nil, err
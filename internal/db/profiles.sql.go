@@ -23,7 +23,7 @@ WITH helper AS(
     WHERE pr.id = ANY($1::UUID[])
     GROUP BY pr.id
 )
-SELECT profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels,
+SELECT profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels, profiles.alert_grouping_window,
        helper.selectors::profile_selector[] AS profiles_with_selectors
 FROM profiles
 LEFT JOIN helper ON profiles.id = helper.profid
@@ -57,6 +57,7 @@ func (q *Queries) BulkGetProfilesByID(ctx context.Context, profileIds []uuid.UUI
 			&i.Profile.SubscriptionID,
 			&i.Profile.DisplayName,
 			pq.Array(&i.Profile.Labels),
+			&i.Profile.AlertGroupingWindow,
 			pq.Array(&i.ProfilesWithSelectors),
 		); err != nil {
 			return nil, err
@@ -139,18 +140,21 @@ INSERT INTO profiles (
     name,
     subscription_id,
     display_name,
-    labels
-) VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7::text[], '{}'::text[])) RETURNING id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels
+    labels,
+    alert_grouping_window
+) VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7::text[], '{}'::text[]),
+    $8) RETURNING id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels, alert_grouping_window
 `
 
 type CreateProfileParams struct {
-	ProjectID      uuid.UUID      `json:"project_id"`
-	Remediate      NullActionType `json:"remediate"`
-	Alert          NullActionType `json:"alert"`
-	Name           string         `json:"name"`
-	SubscriptionID uuid.NullUUID  `json:"subscription_id"`
-	DisplayName    string         `json:"display_name"`
-	Labels         []string       `json:"labels"`
+	ProjectID           uuid.UUID      `json:"project_id"`
+	Remediate           NullActionType `json:"remediate"`
+	Alert               NullActionType `json:"alert"`
+	Name                string         `json:"name"`
+	SubscriptionID      uuid.NullUUID  `json:"subscription_id"`
+	DisplayName         string         `json:"display_name"`
+	Labels              []string       `json:"labels"`
+	AlertGroupingWindow int64          `json:"alert_grouping_window"`
 }
 
 func (q *Queries) CreateProfile(ctx context.Context, arg CreateProfileParams) (Profile, error) {
@@ -162,6 +166,7 @@ func (q *Queries) CreateProfile(ctx context.Context, arg CreateProfileParams) (P
 		arg.SubscriptionID,
 		arg.DisplayName,
 		pq.Array(arg.Labels),
+		arg.AlertGroupingWindow,
 	)
 	var i Profile
 	err := row.Scan(
@@ -177,10 +182,27 @@ func (q *Queries) CreateProfile(ctx context.Context, arg CreateProfileParams) (P
 		&i.SubscriptionID,
 		&i.DisplayName,
 		pq.Array(&i.Labels),
+		&i.AlertGroupingWindow,
 	)
 	return i, err
 }
 
+const createProfileBase = `-- name: CreateProfileBase :exec
+INSERT INTO profile_extends (profile_id, base_profile_id, position)
+VALUES ($1, $2, $3)
+`
+
+type CreateProfileBaseParams struct {
+	ProfileID     uuid.UUID `json:"profile_id"`
+	BaseProfileID uuid.UUID `json:"base_profile_id"`
+	Position      int32     `json:"position"`
+}
+
+func (q *Queries) CreateProfileBase(ctx context.Context, arg CreateProfileBaseParams) error {
+	_, err := q.db.ExecContext(ctx, createProfileBase, arg.ProfileID, arg.BaseProfileID, arg.Position)
+	return err
+}
+
 const createProfileForEntity = `-- name: CreateProfileForEntity :one
 INSERT INTO entity_profiles (
     entity,
@@ -231,6 +253,15 @@ func (q *Queries) DeleteProfile(ctx context.Context, arg DeleteProfileParams) er
 	return err
 }
 
+const deleteProfileBases = `-- name: DeleteProfileBases :exec
+DELETE FROM profile_extends WHERE profile_id = $1
+`
+
+func (q *Queries) DeleteProfileBases(ctx context.Context, profileID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteProfileBases, profileID)
+	return err
+}
+
 const deleteProfileForEntity = `-- name: DeleteProfileForEntity :exec
 DELETE FROM entity_profiles WHERE profile_id = $1 AND entity = $2
 `
@@ -246,7 +277,7 @@ func (q *Queries) DeleteProfileForEntity(ctx context.Context, arg DeleteProfileF
 }
 
 const getProfileByID = `-- name: GetProfileByID :one
-SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels FROM profiles WHERE id = $1 AND project_id = $2
+SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels, alert_grouping_window FROM profiles WHERE id = $1 AND project_id = $2
 `
 
 type GetProfileByIDParams struct {
@@ -270,12 +301,13 @@ func (q *Queries) GetProfileByID(ctx context.Context, arg GetProfileByIDParams)
 		&i.SubscriptionID,
 		&i.DisplayName,
 		pq.Array(&i.Labels),
+		&i.AlertGroupingWindow,
 	)
 	return i, err
 }
 
 const getProfileByIDAndLock = `-- name: GetProfileByIDAndLock :one
-SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels FROM profiles WHERE id = $1 AND project_id = $2 FOR UPDATE
+SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels, alert_grouping_window FROM profiles WHERE id = $1 AND project_id = $2 FOR UPDATE
 `
 
 type GetProfileByIDAndLockParams struct {
@@ -299,12 +331,43 @@ func (q *Queries) GetProfileByIDAndLock(ctx context.Context, arg GetProfileByIDA
 		&i.SubscriptionID,
 		&i.DisplayName,
 		pq.Array(&i.Labels),
+		&i.AlertGroupingWindow,
+	)
+	return i, err
+}
+
+const getProfileByName = `-- name: GetProfileByName :one
+SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels, alert_grouping_window FROM profiles WHERE lower(name) = lower($2) AND project_id = $1
+`
+
+type GetProfileByNameParams struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Name      string    `json:"name"`
+}
+
+func (q *Queries) GetProfileByName(ctx context.Context, arg GetProfileByNameParams) (Profile, error) {
+	row := q.db.QueryRowContext(ctx, getProfileByName, arg.ProjectID, arg.Name)
+	var i Profile
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Provider,
+		&i.ProjectID,
+		&i.Remediate,
+		&i.Alert,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ProviderID,
+		&i.SubscriptionID,
+		&i.DisplayName,
+		pq.Array(&i.Labels),
+		&i.AlertGroupingWindow,
 	)
 	return i, err
 }
 
 const getProfileByNameAndLock = `-- name: GetProfileByNameAndLock :one
-SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels FROM profiles WHERE lower(name) = lower($2) AND project_id = $1 FOR UPDATE
+SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels, alert_grouping_window FROM profiles WHERE lower(name) = lower($2) AND project_id = $1 FOR UPDATE
 `
 
 type GetProfileByNameAndLockParams struct {
@@ -328,6 +391,7 @@ func (q *Queries) GetProfileByNameAndLock(ctx context.Context, arg GetProfileByN
 		&i.SubscriptionID,
 		&i.DisplayName,
 		pq.Array(&i.Labels),
+		&i.AlertGroupingWindow,
 	)
 	return i, err
 }
@@ -343,7 +407,7 @@ WITH helper AS(
     GROUP BY pr.id
 )
 SELECT
-    profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels,
+    profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels, profiles.alert_grouping_window,
     profiles_with_entity_profiles.id, profiles_with_entity_profiles.entity, profiles_with_entity_profiles.profile_id, profiles_with_entity_profiles.contextual_rules, profiles_with_entity_profiles.created_at, profiles_with_entity_profiles.updated_at, profiles_with_entity_profiles.migrated, profiles_with_entity_profiles.profid,
     helper.selectors::profile_selector[] AS profiles_with_selectors
 FROM profiles
@@ -385,6 +449,7 @@ func (q *Queries) GetProfileByProjectAndID(ctx context.Context, arg GetProfileBy
 			&i.Profile.SubscriptionID,
 			&i.Profile.DisplayName,
 			pq.Array(&i.Profile.Labels),
+			&i.Profile.AlertGroupingWindow,
 			&i.ProfilesWithEntityProfile.ID,
 			&i.ProfilesWithEntityProfile.Entity,
 			&i.ProfilesWithEntityProfile.ProfileID,
@@ -419,7 +484,7 @@ WITH helper AS(
     GROUP BY pr.id
 )
 SELECT
-    profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels,
+    profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels, profiles.alert_grouping_window,
     profiles_with_entity_profiles.id, profiles_with_entity_profiles.entity, profiles_with_entity_profiles.profile_id, profiles_with_entity_profiles.contextual_rules, profiles_with_entity_profiles.created_at, profiles_with_entity_profiles.updated_at, profiles_with_entity_profiles.migrated, profiles_with_entity_profiles.profid,
     helper.selectors::profile_selector[] AS profiles_with_selectors
 FROM profiles
@@ -461,6 +526,7 @@ func (q *Queries) GetProfileByProjectAndName(ctx context.Context, arg GetProfile
 			&i.Profile.SubscriptionID,
 			&i.Profile.DisplayName,
 			pq.Array(&i.Profile.Labels),
+			&i.Profile.AlertGroupingWindow,
 			&i.ProfilesWithEntityProfile.ID,
 			&i.ProfilesWithEntityProfile.Entity,
 			&i.ProfilesWithEntityProfile.ProfileID,
@@ -484,6 +550,45 @@ func (q *Queries) GetProfileByProjectAndName(ctx context.Context, arg GetProfile
 	return items, nil
 }
 
+const listProfileBases = `-- name: ListProfileBases :many
+
+SELECT pe.profile_id, p.name
+FROM profile_extends AS pe
+JOIN profiles AS p ON p.id = pe.base_profile_id
+WHERE pe.profile_id = ANY($1::UUID[])
+ORDER BY pe.profile_id, pe.position
+`
+
+type ListProfileBasesRow struct {
+	ProfileID uuid.UUID `json:"profile_id"`
+	Name      string    `json:"name"`
+}
+
+// ListProfileBases lists the names of the profiles extended by the given
+// profiles, in the order they are extended.
+func (q *Queries) ListProfileBases(ctx context.Context, profileIds []uuid.UUID) ([]ListProfileBasesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProfileBases, pq.Array(profileIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListProfileBasesRow{}
+	for rows.Next() {
+		var i ListProfileBasesRow
+		if err := rows.Scan(&i.ProfileID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProfilesByProjectIDAndLabel = `-- name: ListProfilesByProjectIDAndLabel :many
 WITH helper AS(
      SELECT pr.id as profid,
@@ -494,7 +599,7 @@ WITH helper AS(
       WHERE pr.project_id = $1
       GROUP BY pr.id
 )
-SELECT profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels,
+SELECT profiles.id, profiles.name, profiles.provider, profiles.project_id, profiles.remediate, profiles.alert, profiles.created_at, profiles.updated_at, profiles.provider_id, profiles.subscription_id, profiles.display_name, profiles.labels, profiles.alert_grouping_window,
        profiles_with_entity_profiles.id, profiles_with_entity_profiles.entity, profiles_with_entity_profiles.profile_id, profiles_with_entity_profiles.contextual_rules, profiles_with_entity_profiles.created_at, profiles_with_entity_profiles.updated_at, profiles_with_entity_profiles.migrated, profiles_with_entity_profiles.profid,
        helper.selectors::profile_selector[] AS profiles_with_selectors
 FROM profiles
@@ -551,6 +656,7 @@ func (q *Queries) ListProfilesByProjectIDAndLabel(ctx context.Context, arg ListP
 			&i.Profile.SubscriptionID,
 			&i.Profile.DisplayName,
 			pq.Array(&i.Profile.Labels),
+			&i.Profile.AlertGroupingWindow,
 			&i.ProfilesWithEntityProfile.ID,
 			&i.ProfilesWithEntityProfile.Entity,
 			&i.ProfilesWithEntityProfile.ProfileID,
@@ -574,6 +680,35 @@ func (q *Queries) ListProfilesByProjectIDAndLabel(ctx context.Context, arg ListP
 	return items, nil
 }
 
+const listProfilesExtending = `-- name: ListProfilesExtending :many
+SELECT pe.profile_id
+FROM profile_extends AS pe
+WHERE pe.base_profile_id = $1
+`
+
+func (q *Queries) ListProfilesExtending(ctx context.Context, baseProfileID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listProfilesExtending, baseProfileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var profile_id uuid.UUID
+		if err := rows.Scan(&profile_id); err != nil {
+			return nil, err
+		}
+		items = append(items, profile_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProfilesInstantiatingRuleType = `-- name: ListProfilesInstantiatingRuleType :many
 SELECT DISTINCT(p.name)
 FROM profiles AS p
@@ -610,17 +745,19 @@ UPDATE profiles SET
     alert = $4,
     updated_at = NOW(),
     display_name = $5,
-    labels = COALESCE($6::TEXT[], '{}'::TEXT[])
-WHERE id = $1 AND project_id = $2 RETURNING id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels
+    labels = COALESCE($6::TEXT[], '{}'::TEXT[]),
+    alert_grouping_window = $7
+WHERE id = $1 AND project_id = $2 RETURNING id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels, alert_grouping_window
 `
 
 type UpdateProfileParams struct {
-	ID          uuid.UUID      `json:"id"`
-	ProjectID   uuid.UUID      `json:"project_id"`
-	Remediate   NullActionType `json:"remediate"`
-	Alert       NullActionType `json:"alert"`
-	DisplayName string         `json:"display_name"`
-	Labels      []string       `json:"labels"`
+	ID                  uuid.UUID      `json:"id"`
+	ProjectID           uuid.UUID      `json:"project_id"`
+	Remediate           NullActionType `json:"remediate"`
+	Alert               NullActionType `json:"alert"`
+	DisplayName         string         `json:"display_name"`
+	Labels              []string       `json:"labels"`
+	AlertGroupingWindow int64          `json:"alert_grouping_window"`
 }
 
 func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) (Profile, error) {
@@ -631,6 +768,7 @@ func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) (P
 		arg.Alert,
 		arg.DisplayName,
 		pq.Array(arg.Labels),
+		arg.AlertGroupingWindow,
 	)
 	var i Profile
 	err := row.Scan(
@@ -646,6 +784,7 @@ func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) (P
 		&i.SubscriptionID,
 		&i.DisplayName,
 		pq.Array(&i.Labels),
+		&i.AlertGroupingWindow,
 	)
 	return i, err
 }
@@ -78,6 +78,7 @@ func createRuleInstance(t *testing.T, profileId uuid.UUID, ruleTypeID uuid.UUID,
 		Def:        []byte("{}"),
 		Params:     []byte("{}"),
 		ProjectID:  projectID,
+		Overrides:  []byte("[]"),
 	})
 	require.NoError(t, err)
 	require.NotEmpty(t, ruleInstance)
@@ -126,6 +127,7 @@ func createRandomRuleInstance(
 			ProjectID:  projectID,
 			Def:        json.RawMessage(`{}`),
 			Params:     json.RawMessage(`{}`),
+			Overrides:  json.RawMessage(`[]`),
 		},
 	)
 
@@ -3327,6 +3329,98 @@ func TestCreateProfileStatusStoredProcedure(t *testing.T) {
 			},
 			expectedStatusAfterModify: EvalStatusTypesSuccess,
 		},
+		{
+			name: "Success and excepted results in excepted",
+			ruleStatusSetupFn: func(profile Profile, ruleEntityID1 uuid.UUID, _ uuid.UUID) {
+				upsertEvalHistoryStatus(
+					t,
+					profile.ID,
+					ruleEntityID1,
+					EvalStatusTypesSuccess,
+					"",
+				)
+			},
+			expectedStatusAfterSetup: EvalStatusTypesSuccess,
+			ruleStatusModifyFn: func(profile Profile, _ uuid.UUID, ruleEntityID2 uuid.UUID) {
+				upsertEvalHistoryStatus(
+					t,
+					profile.ID,
+					ruleEntityID2,
+					EvalStatusTypesExcepted,
+					"",
+				)
+			},
+			expectedStatusAfterModify: EvalStatusTypesExcepted,
+		},
+		{
+			name: "Excepted and success results in excepted",
+			ruleStatusSetupFn: func(profile Profile, ruleEntityID1 uuid.UUID, _ uuid.UUID) {
+				upsertEvalHistoryStatus(
+					t,
+					profile.ID,
+					ruleEntityID1,
+					EvalStatusTypesExcepted,
+					"",
+				)
+			},
+			expectedStatusAfterSetup: EvalStatusTypesExcepted,
+			ruleStatusModifyFn: func(profile Profile, _ uuid.UUID, ruleEntityID2 uuid.UUID) {
+				upsertEvalHistoryStatus(
+					t,
+					profile.ID,
+					ruleEntityID2,
+					EvalStatusTypesSuccess,
+					"",
+				)
+			},
+			expectedStatusAfterModify: EvalStatusTypesExcepted,
+		},
+		{
+			name: "Excepted and suppressed results in suppressed",
+			ruleStatusSetupFn: func(profile Profile, ruleEntityID1 uuid.UUID, _ uuid.UUID) {
+				upsertEvalHistoryStatus(
+					t,
+					profile.ID,
+					ruleEntityID1,
+					EvalStatusTypesExcepted,
+					"",
+				)
+			},
+			expectedStatusAfterSetup: EvalStatusTypesExcepted,
+			ruleStatusModifyFn: func(profile Profile, _ uuid.UUID, ruleEntityID2 uuid.UUID) {
+				upsertEvalHistoryStatus(
+					t,
+					profile.ID,
+					ruleEntityID2,
+					EvalStatusTypesSuppressed,
+					"",
+				)
+			},
+			expectedStatusAfterModify: EvalStatusTypesSuppressed,
+		},
+		{
+			name: "Suppressed and failure results in failure",
+			ruleStatusSetupFn: func(profile Profile, ruleEntityID1 uuid.UUID, _ uuid.UUID) {
+				upsertEvalHistoryStatus(
+					t,
+					profile.ID,
+					ruleEntityID1,
+					EvalStatusTypesSuppressed,
+					"",
+				)
+			},
+			expectedStatusAfterSetup: EvalStatusTypesSuppressed,
+			ruleStatusModifyFn: func(profile Profile, _ uuid.UUID, ruleEntityID2 uuid.UUID) {
+				upsertEvalHistoryStatus(
+					t,
+					profile.ID,
+					ruleEntityID2,
+					EvalStatusTypesFailure,
+					"",
+				)
+			},
+			expectedStatusAfterModify: EvalStatusTypesFailure,
+		},
 	}
 
 	randomEntities := createTestRandomEntities(t)
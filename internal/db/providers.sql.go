@@ -14,6 +14,17 @@ import (
 	"github.com/lib/pq"
 )
 
+const countProvidersByProjectID = `-- name: CountProvidersByProjectID :one
+SELECT COUNT(*) FROM providers WHERE project_id = $1
+`
+
+func (q *Queries) CountProvidersByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countProvidersByProjectID, projectID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createProvider = `-- name: CreateProvider :one
 INSERT INTO providers (
     name,
@@ -80,19 +91,36 @@ SELECT id, name, version, project_id, implements, definition, created_at, update
 WHERE project_id = ANY($1::uuid[])
     AND ($2::provider_type = ANY(implements) OR $2::provider_type IS NULL)
     AND (lower(name) = lower($3::text) OR $3::text IS NULL)
+    AND (
+        $4::uuid IS NULL
+        OR providers.project_id = $4::uuid
+        OR NOT EXISTS (SELECT 1 FROM provider_shares ps WHERE ps.provider_id = providers.id)
+        OR EXISTS (
+            SELECT 1 FROM provider_shares ps
+            WHERE ps.provider_id = providers.id AND ps.project_id = ANY($1::uuid[])
+        )
+    )
 `
 
 type FindProvidersParams struct {
-	Projects []uuid.UUID      `json:"projects"`
-	Trait    NullProviderType `json:"trait"`
-	Name     sql.NullString   `json:"name"`
+	Projects  []uuid.UUID      `json:"projects"`
+	Trait     NullProviderType `json:"trait"`
+	Name      sql.NullString   `json:"name"`
+	ProjectID uuid.NullUUID    `json:"project_id"`
 }
 
 // FindProviders allows us to take a trait and filter
 // providers by it. It also optionally takes a name, in case we want to
-// filter by name as well.
+// filter by name as well. When the project the providers are looked up for
+// is given, the providers of its parent projects which are explicitly shared
+// with other projects only are left out.
 func (q *Queries) FindProviders(ctx context.Context, arg FindProvidersParams) ([]Provider, error) {
-	rows, err := q.db.QueryContext(ctx, findProviders, pq.Array(arg.Projects), arg.Trait, arg.Name)
+	rows, err := q.db.QueryContext(ctx, findProviders,
+		pq.Array(arg.Projects),
+		arg.Trait,
+		arg.Name,
+		arg.ProjectID,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -15,18 +16,42 @@ import (
 type Querier interface {
 	// AddDataSourceFunction adds a function to a datasource.
 	AddDataSourceFunction(ctx context.Context, arg AddDataSourceFunctionParams) (DataSourcesFunction, error)
+	AddProviderUsage(ctx context.Context, arg AddProviderUsageParams) error
 	// AddRuleTypeDataSourceReference adds a link between one rule type
 	// and one data source it uses.
 	//
 	AddRuleTypeDataSourceReference(ctx context.Context, arg AddRuleTypeDataSourceReferenceParams) (RuleTypeDataSource, error)
+	AssignProjectTier(ctx context.Context, arg AssignProjectTierParams) error
 	BulkGetProfilesByID(ctx context.Context, profileIds []uuid.UUID) ([]BulkGetProfilesByIDRow, error)
+	// ClaimDueEvaluationRetries returns the retries which are due, and pushes
+	// their next retry time by the lease interval, so that they are not claimed
+	// again while the re-evaluation is in flight. The executor reschedules or
+	// deletes the retry once the entity has been evaluated.
+	ClaimDueEvaluationRetries(ctx context.Context, arg ClaimDueEvaluationRetriesParams) ([]EvaluationRetry, error)
+	// CloseEntity records that an entity was closed upstream, e.g. a closed pull
+	// request. The entity is kept until it is reopened or purged.
+	CloseEntity(ctx context.Context, arg CloseEntityParams) error
+	CompleteMigrationPhase(ctx context.Context, name string) error
 	// CountEntitiesByType counts all entities of a given type (across all projects/providers).
 	CountEntitiesByType(ctx context.Context, entityType Entities) (int64, error)
 	// CountEntitiesByTypeAndProject counts entities of a given type for a specific project.
 	CountEntitiesByTypeAndProject(ctx context.Context, arg CountEntitiesByTypeAndProjectParams) (int64, error)
+	// CountOrphanedEvaluationRuleEntities counts the evaluated rule and entity
+	// pairs whose rule instance or entity no longer exists, along with their
+	// evaluation history.
+	CountOrphanedEvaluationRuleEntities(ctx context.Context) (int64, error)
+	// CountOrphanedRuleInstances counts the rule instances whose profile no longer
+	// exists. Orphaned rows can only exist if foreign keys were missing or
+	// disabled at some point, e.g. during a failed upgrade.
+	CountOrphanedRuleInstances(ctx context.Context) (int64, error)
 	CountProfilesByEntityType(ctx context.Context) ([]CountProfilesByEntityTypeRow, error)
 	CountProfilesByName(ctx context.Context, name string) (int64, error)
 	CountProfilesByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error)
+	CountProvidersByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error)
+	CountRuleInstancesByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error)
+	// CountStaleEvaluationRuleEntities counts the rule and entity pairs listed
+	// by ListStaleEvaluationRuleEntities, and the evaluations in their history.
+	CountStaleEvaluationRuleEntities(ctx context.Context, arg CountStaleEvaluationRuleEntitiesParams) (CountStaleEvaluationRuleEntitiesRow, error)
 	CountUsers(ctx context.Context) (int64, error)
 	// CreateDataSource creates a new datasource in a given project.
 	CreateDataSource(ctx context.Context, arg CreateDataSourceParams) (DataSource, error)
@@ -35,6 +60,12 @@ type Querier interface {
 	CreateEntity(ctx context.Context, arg CreateEntityParams) (EntityInstance, error)
 	// CreateEntityWithID adds an entry to the entities table with a specific ID so it can be tracked by Minder.
 	CreateEntityWithID(ctx context.Context, arg CreateEntityWithIDParams) (EntityInstance, error)
+	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	// CreateEvaluationAnnotation attaches an annotation to an evaluation of the
+	// project, and to its rule and entity pair. No row is returned if the
+	// evaluation doesn't belong to the project.
+	CreateEvaluationAnnotation(ctx context.Context, arg CreateEvaluationAnnotationParams) (EvaluationAnnotation, error)
 	// CreateInvitation creates a new invitation. The code is a secret that is sent
 	// to the invitee, and the email is the address to which the invitation will be
 	// sent. The role is the role that the invitee will have when they accept the
@@ -43,17 +74,45 @@ type Querier interface {
 	CreateInvitation(ctx context.Context, arg CreateInvitationParams) (UserInvite, error)
 	// CreateOrEnsureEntityByID adds an entry to the entity_instances table if it does not exist, or returns the existing entry.
 	CreateOrEnsureEntityByID(ctx context.Context, arg CreateOrEnsureEntityByIDParams) (EntityInstance, error)
+	// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	CreatePendingOperation(ctx context.Context, arg CreatePendingOperationParams) (PendingOperation, error)
 	CreateProfile(ctx context.Context, arg CreateProfileParams) (Profile, error)
+	CreateProfileBase(ctx context.Context, arg CreateProfileBaseParams) error
 	CreateProfileForEntity(ctx context.Context, arg CreateProfileForEntityParams) (EntityProfile, error)
+	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	CreateProfileStatusShareLink(ctx context.Context, arg CreateProfileStatusShareLinkParams) (ProfileStatusShareLink, error)
+	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	// CreateProfileVersion records the definition of a profile which is being
+	// replaced by an update. The profile is expected to be locked by the caller,
+	// so that the version numbers don't clash.
+	CreateProfileVersion(ctx context.Context, arg CreateProfileVersionParams) (ProfileVersion, error)
 	CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error)
+	CreateProjectDeployKey(ctx context.Context, arg CreateProjectDeployKeyParams) (ProjectDeployKey, error)
 	CreateProjectWithID(ctx context.Context, arg CreateProjectWithIDParams) (Project, error)
 	CreateProvider(ctx context.Context, arg CreateProviderParams) (Provider, error)
+	CreateProviderShare(ctx context.Context, arg CreateProviderShareParams) (ProviderShare, error)
+	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	CreateRuleException(ctx context.Context, arg CreateRuleExceptionParams) (RuleException, error)
+	CreateRuleExceptionEvent(ctx context.Context, arg CreateRuleExceptionEventParams) error
 	CreateRuleType(ctx context.Context, arg CreateRuleTypeParams) (RuleType, error)
 	CreateSelector(ctx context.Context, arg CreateSelectorParams) (ProfileSelector, error)
 	CreateSessionState(ctx context.Context, arg CreateSessionStateParams) (SessionStore, error)
 	// Subscriptions --
 	CreateSubscription(ctx context.Context, arg CreateSubscriptionParams) (Subscription, error)
 	CreateUser(ctx context.Context, identitySubject string) (User, error)
+	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	DeferActions(ctx context.Context, arg DeferActionsParams) error
+	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	// An entity throttled again keeps its first evaluation time, so that it is
+	// not postponed indefinitely by the events of a busy project.
+	DeferThrottledEvaluation(ctx context.Context, arg DeferThrottledEvaluationParams) error
+	DeleteAlertGroup(ctx context.Context, arg DeleteAlertGroupParams) error
 	DeleteAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) error
 	DeleteDataSource(ctx context.Context, arg DeleteDataSourceParams) (DataSource, error)
 	DeleteDataSourceFunction(ctx context.Context, arg DeleteDataSourceFunctionParams) (DataSourcesFunction, error)
@@ -62,8 +121,16 @@ type Querier interface {
 	DeleteDataSourceFunctions(ctx context.Context, arg DeleteDataSourceFunctionsParams) ([]DataSourcesFunction, error)
 	// DeleteEntity removes an entity from the entity_instances table for a project.
 	DeleteEntity(ctx context.Context, arg DeleteEntityParams) error
+	DeleteEvaluationAnnotation(ctx context.Context, arg DeleteEvaluationAnnotationParams) (int64, error)
 	DeleteEvaluationHistoryByIDs(ctx context.Context, evaluationids []uuid.UUID) (int64, error)
 	DeleteEvaluationOutputsByEvaluationIDs(ctx context.Context, evaluationids []uuid.UUID) (int64, error)
+	DeleteEvaluationRetry(ctx context.Context, entityInstanceID uuid.UUID) error
+	// DeleteEvaluationRuleEntitiesByIDs deletes rule and entity pairs, which
+	// cascades to their whole evaluation history.
+	DeleteEvaluationRuleEntitiesByIDs(ctx context.Context, ruleentityids []uuid.UUID) (int64, error)
+	// DeleteExpiredProfileStatusShareLinks removes the expired links of a
+	// project, which can't be used anymore.
+	DeleteExpiredProfileStatusShareLinks(ctx context.Context, projectID uuid.UUID) error
 	DeleteExpiredSessionStates(ctx context.Context) (int64, error)
 	DeleteInstallationIDByAppID(ctx context.Context, appInstallationID int64) error
 	// DeleteInvitation deletes an invitation by its code. This is intended to be
@@ -71,11 +138,23 @@ type Querier interface {
 	// it or the sponsor has decided to revoke it.
 	DeleteInvitation(ctx context.Context, code string) (UserInvite, error)
 	DeleteNonUpdatedRules(ctx context.Context, arg DeleteNonUpdatedRulesParams) error
+	DeleteOrphanedEvaluationRuleEntities(ctx context.Context) (int64, error)
+	DeleteOrphanedRuleInstances(ctx context.Context) (int64, error)
 	DeleteProfile(ctx context.Context, arg DeleteProfileParams) error
+	DeleteProfileBases(ctx context.Context, profileID uuid.UUID) error
 	DeleteProfileForEntity(ctx context.Context, arg DeleteProfileForEntityParams) error
+	DeleteProfileStatusShareLink(ctx context.Context, arg DeleteProfileStatusShareLinkParams) (ProfileStatusShareLink, error)
 	DeleteProject(ctx context.Context, id uuid.UUID) ([]DeleteProjectRow, error)
+	DeleteProjectDeployKey(ctx context.Context, arg DeleteProjectDeployKeyParams) (int64, error)
+	DeleteProjectSecret(ctx context.Context, arg DeleteProjectSecretParams) (int64, error)
+	DeleteProjectTier(ctx context.Context, name string) (int64, error)
 	DeleteProperty(ctx context.Context, arg DeletePropertyParams) error
 	DeleteProvider(ctx context.Context, arg DeleteProviderParams) error
+	DeleteProviderShare(ctx context.Context, arg DeleteProviderShareParams) (int64, error)
+	DeleteProviderUsageBefore(ctx context.Context, bucket time.Time) (int64, error)
+	// DeleteRepositoryListingCursor removes the cursor of the repository listing
+	// of a provider for a project once the listing is complete.
+	DeleteRepositoryListingCursor(ctx context.Context, arg DeleteRepositoryListingCursorParams) error
 	DeleteRuleInstanceOfProfileInProject(ctx context.Context, arg DeleteRuleInstanceOfProfileInProjectParams) error
 	DeleteRuleType(ctx context.Context, id uuid.UUID) error
 	DeleteRuleTypeDataSource(ctx context.Context, arg DeleteRuleTypeDataSourceParams) error
@@ -83,32 +162,56 @@ type Querier interface {
 	DeleteSelectorsByProfileID(ctx context.Context, profileID uuid.UUID) error
 	DeleteSessionStateByProjectID(ctx context.Context, arg DeleteSessionStateByProjectIDParams) error
 	DeleteUser(ctx context.Context, id int32) error
+	// DisableRuleInstance disables a rule instance, recording who disabled it
+	// and why. Disabled rules are reported as skipped.
+	DisableRuleInstance(ctx context.Context, arg DisableRuleInstanceParams) (RuleInstance, error)
+	EnableRuleInstance(ctx context.Context, id uuid.UUID) (RuleInstance, error)
 	EnqueueFlush(ctx context.Context, arg EnqueueFlushParams) (FlushCache, error)
 	// EntityExistsAfterID checks if any entity of a given type exists after a cursor ID.
 	EntityExistsAfterID(ctx context.Context, arg EntityExistsAfterIDParams) (bool, error)
+	FailMigrationPhase(ctx context.Context, arg FailMigrationPhaseParams) error
 	// FindProviders allows us to take a trait and filter
 	// providers by it. It also optionally takes a name, in case we want to
-	// filter by name as well.
+	// filter by name as well. When the project the providers are looked up for
+	// is given, the providers of its parent projects which are explicitly shared
+	// with other projects only are left out.
 	FindProviders(ctx context.Context, arg FindProvidersParams) ([]Provider, error)
 	FlushCache(ctx context.Context, entityInstanceID uuid.UUID) (FlushCache, error)
+	// GetAPIQuotaRetryAfter returns the number of seconds until the token bucket
+	// of a project has a token again.
+	GetAPIQuotaRetryAfter(ctx context.Context, arg GetAPIQuotaRetryAfterParams) (float64, error)
 	GetAccessTokenByEnrollmentNonce(ctx context.Context, arg GetAccessTokenByEnrollmentNonceParams) (ProviderAccessToken, error)
 	GetAccessTokenByProjectID(ctx context.Context, arg GetAccessTokenByProjectIDParams) (ProviderAccessToken, error)
 	GetAccessTokenByProvider(ctx context.Context, provider string) ([]ProviderAccessToken, error)
 	GetAccessTokenSinceDate(ctx context.Context, arg GetAccessTokenSinceDateParams) (ProviderAccessToken, error)
+	// GetActiveRuleException returns the approved and unexpired exception
+	// covering the given rule and entity, if any.
+	GetActiveRuleException(ctx context.Context, arg GetActiveRuleExceptionParams) (RuleException, error)
+	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	GetAlertGroup(ctx context.Context, arg GetAlertGroupParams) (AlertGroup, error)
 	GetAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) ([]Property, error)
 	GetBundle(ctx context.Context, arg GetBundleParams) (Bundle, error)
 	GetChildrenProjects(ctx context.Context, id uuid.UUID) ([]GetChildrenProjectsRow, error)
 	// GetDataSource retrieves a datasource by its id and a project hierarchy.
+	// The first project of the hierarchy is the project doing the lookup,
+	// private datasources of its ancestors are not returned.
 	//
 	// Note that to get a datasource for a given project, one can simply
 	// pass one project id in the project_id array.
 	GetDataSource(ctx context.Context, arg GetDataSourceParams) (DataSource, error)
 	// GetDataSourceByName retrieves a datasource by its name and
-	// a project hierarchy.
+	// a project hierarchy. The first project of the hierarchy is the project
+	// doing the lookup, private datasources of its ancestors are not
+	// returned. Global datasources of other hierarchies are only returned if
+	// include_global is set. The datasource closest to the project wins.
 	//
 	// Note that to get a datasource for a given project, one can simply
 	// pass one project id in the project_id array.
 	GetDataSourceByName(ctx context.Context, arg GetDataSourceByNameParams) (DataSource, error)
+	// GetEffectiveProjectTier returns the tier assigned to the project or, when
+	// none is, the one of its closest ancestor.
+	GetEffectiveProjectTier(ctx context.Context, projectID uuid.UUID) (ProjectTier, error)
 	// GetEntitiesByProjectHierarchy retrieves all entities for a project or hierarchy of projects.
 	GetEntitiesByProjectHierarchy(ctx context.Context, projects []uuid.UUID) ([]EntityInstance, error)
 	// GetEntitiesByProvider retrieves all entities of a given provider.
@@ -124,8 +227,12 @@ type Querier interface {
 	GetEntityByName(ctx context.Context, arg GetEntityByNameParams) (EntityInstance, error)
 	GetEvaluationHistory(ctx context.Context, arg GetEvaluationHistoryParams) (GetEvaluationHistoryRow, error)
 	GetEvaluationOutput(ctx context.Context, id uuid.UUID) (EvaluationOutput, error)
-	// GetFeatureInProject verifies if a feature is available for a specific project.
-	// It returns the settings for the feature if it is available.
+	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	GetEvaluationRetry(ctx context.Context, entityInstanceID uuid.UUID) (EvaluationRetry, error)
+	// GetFeatureInProject verifies if a feature is available for a specific project,
+	// either because the project is entitled to it or because the tier of the
+	// project includes it. It returns the settings for the feature if it is available.
 	GetFeatureInProject(ctx context.Context, arg GetFeatureInProjectParams) (json.RawMessage, error)
 	// GetImmediateChildrenProjects is a query that returns all the immediate children of a project.
 	GetImmediateChildrenProjects(ctx context.Context, parentID uuid.UUID) ([]Project, error)
@@ -147,22 +254,34 @@ type Querier interface {
 	GetInvitationsByEmail(ctx context.Context, email string) ([]GetInvitationsByEmailRow, error)
 	// GetInvitationsByEmailAndProject retrieves all invitations by email and project.
 	GetInvitationsByEmailAndProject(ctx context.Context, arg GetInvitationsByEmailAndProjectParams) ([]GetInvitationsByEmailAndProjectRow, error)
+	// GetLatestEvalCheckpointForRuleEntity returns the status and checkpoint of
+	// the latest evaluation of a rule for an entity. Unlike
+	// GetLatestEvalStateForRuleEntity it does not lock the evaluation.
+	GetLatestEvalCheckpointForRuleEntity(ctx context.Context, arg GetLatestEvalCheckpointForRuleEntityParams) (GetLatestEvalCheckpointForRuleEntityRow, error)
 	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
 	// SPDX-License-Identifier: Apache-2.0
 	GetLatestEvalStateForRuleEntity(ctx context.Context, arg GetLatestEvalStateForRuleEntityParams) (EvaluationStatus, error)
+	GetMigrationPhase(ctx context.Context, name string) (MigrationPhase, error)
 	GetParentProjects(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
 	GetParentProjectsUntil(ctx context.Context, arg GetParentProjectsUntilParams) ([]uuid.UUID, error)
+	GetPendingOperationByIDAndLock(ctx context.Context, arg GetPendingOperationByIDAndLockParams) (PendingOperation, error)
 	GetProfileByID(ctx context.Context, arg GetProfileByIDParams) (Profile, error)
 	GetProfileByIDAndLock(ctx context.Context, arg GetProfileByIDAndLockParams) (Profile, error)
+	GetProfileByName(ctx context.Context, arg GetProfileByNameParams) (Profile, error)
 	GetProfileByNameAndLock(ctx context.Context, arg GetProfileByNameAndLockParams) (Profile, error)
 	GetProfileByProjectAndID(ctx context.Context, arg GetProfileByProjectAndIDParams) ([]GetProfileByProjectAndIDRow, error)
 	GetProfileByProjectAndName(ctx context.Context, arg GetProfileByProjectAndNameParams) ([]GetProfileByProjectAndNameRow, error)
 	GetProfileStatusByIdAndProject(ctx context.Context, arg GetProfileStatusByIdAndProjectParams) (GetProfileStatusByIdAndProjectRow, error)
 	GetProfileStatusByNameAndProject(ctx context.Context, arg GetProfileStatusByNameAndProjectParams) (GetProfileStatusByNameAndProjectRow, error)
 	GetProfileStatusByProject(ctx context.Context, projectID uuid.UUID) ([]GetProfileStatusByProjectRow, error)
+	GetProfileStatusShareLink(ctx context.Context, arg GetProfileStatusShareLinkParams) (ProfileStatusShareLink, error)
+	GetProfileVersion(ctx context.Context, arg GetProfileVersionParams) (ProfileVersion, error)
 	GetProjectByID(ctx context.Context, id uuid.UUID) (Project, error)
 	GetProjectByName(ctx context.Context, name string) (Project, error)
+	GetProjectDeployKeyByName(ctx context.Context, arg GetProjectDeployKeyByNameParams) (ProjectDeployKey, error)
 	GetProjectIDBySessionState(ctx context.Context, sessionState string) (GetProjectIDBySessionStateRow, error)
+	GetProjectSecretByName(ctx context.Context, arg GetProjectSecretByNameParams) (ProjectSecret, error)
+	GetProjectTierByName(ctx context.Context, name string) (ProjectTier, error)
 	GetProperty(ctx context.Context, arg GetPropertyParams) (Property, error)
 	GetProviderByID(ctx context.Context, id uuid.UUID) (Provider, error)
 	GetProviderByIDAndProject(ctx context.Context, arg GetProviderByIDAndProjectParams) (Provider, error)
@@ -171,10 +290,31 @@ type Querier interface {
 	// if it exists in the project or any of its ancestors. It'll return the first
 	// provider that matches the name.
 	GetProviderByName(ctx context.Context, arg GetProviderByNameParams) (Provider, error)
+	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	// GetProviderHealth returns the result of the latest health check of a provider.
+	GetProviderHealth(ctx context.Context, providerID uuid.UUID) (ProviderHealth, error)
+	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	// GetRepositoryListingCursor returns the cursor to resume the repository
+	// listing of a provider for a project from.
+	GetRepositoryListingCursor(ctx context.Context, arg GetRepositoryListingCursorParams) (string, error)
 	GetRootProjectByID(ctx context.Context, id uuid.UUID) (Project, error)
+	GetRuleExceptionByIDAndLock(ctx context.Context, arg GetRuleExceptionByIDAndLockParams) (RuleException, error)
+	GetRuleInstanceByProfileName(ctx context.Context, arg GetRuleInstanceByProfileNameParams) (RuleInstance, error)
+	GetRuleInstancesByProfileName(ctx context.Context, arg GetRuleInstancesByProfileNameParams) ([]RuleInstance, error)
 	GetRuleInstancesEntityInProjects(ctx context.Context, arg GetRuleInstancesEntityInProjectsParams) ([]RuleInstance, error)
 	GetRuleInstancesForProfile(ctx context.Context, profileID uuid.UUID) ([]RuleInstance, error)
 	GetRuleTypeByID(ctx context.Context, id uuid.UUID) (RuleType, error)
+	// GetRuleTypeByName retrieves a rule type by name which is visible from a
+	// project hierarchy. The first project of the hierarchy is the project
+	// doing the lookup, which sees its own rule types and the ones its
+	// ancestors share with their subtree. Global rule types of other
+	// hierarchies are only returned if include_global is set. The rule type
+	// closest to the project wins.
+	//
+	// Note that to get a rule type for a given project, one can simply
+	// pass one project id in the projects array.
 	GetRuleTypeByName(ctx context.Context, arg GetRuleTypeByNameParams) (RuleType, error)
 	// intended as a temporary transition query
 	// this will be removed once rule_instances is used consistently in the engine
@@ -197,24 +337,58 @@ type Querier interface {
 	InsertEvaluationStatus(ctx context.Context, arg InsertEvaluationStatusParams) (uuid.UUID, error)
 	InsertRemediationEvent(ctx context.Context, arg InsertRemediationEventParams) error
 	ListAllRootProjects(ctx context.Context) ([]Project, error)
+	// ListCanaryRuleResults aggregates the latest evaluations of the canary rules
+	// of a profile, which are left out of the profile status.
+	ListCanaryRuleResults(ctx context.Context, profileID uuid.UUID) ([]ListCanaryRuleResultsRow, error)
+	// ListClosedEntitiesBefore returns the entities which were closed before the
+	// given time, oldest first.
+	ListClosedEntitiesBefore(ctx context.Context, arg ListClosedEntitiesBeforeParams) ([]ClosedEntity, error)
 	// ListDataSourceFunctions retrieves all functions for a datasource.
 	ListDataSourceFunctions(ctx context.Context, arg ListDataSourceFunctionsParams) ([]DataSourcesFunction, error)
 	// ListDataSources retrieves all datasources for project hierarchy.
+	// The first project of the hierarchy is the project doing the lookup,
+	// private datasources of its ancestors are not returned.
 	//
 	// Note that to get a datasource for a given project, one can simply
 	// pass one project id in the project_id array.
 	ListDataSources(ctx context.Context, projects []uuid.UUID) ([]DataSource, error)
+	ListDisabledRuleInstances(ctx context.Context, projectID uuid.UUID) ([]ListDisabledRuleInstancesRow, error)
 	// ListEntitiesAfterID retrieves entities of a given type after a cursor ID, for pagination.
 	// This is used for cursor-based iteration over all entities (e.g., in the reminder service).
 	ListEntitiesAfterID(ctx context.Context, arg ListEntitiesAfterIDParams) ([]EntityInstance, error)
+	// ListEntityProjectsByProvider lists the projects which registered entities
+	// with a provider.
+	ListEntityProjectsByProvider(ctx context.Context, providerID uuid.UUID) ([]uuid.UUID, error)
+	// ListEntityTimeline lists the events of an entity: its registration, the
+	// refreshes of its properties, its evaluations and the remediations and alerts
+	// acted on them. Skipped and unavailable actions are left out. The cursors
+	// work as for ListEvaluationHistory.
+	ListEntityTimeline(ctx context.Context, arg ListEntityTimelineParams) ([]ListEntityTimelineRow, error)
+	// ListEnumValues lists the values of the enum types of the current schema,
+	// in their declaration order.
+	ListEnumValues(ctx context.Context) ([]ListEnumValuesRow, error)
+	// ListEvaluationAnnotationsByEvaluationIDs lists the annotations attached to
+	// the given evaluations, oldest first.
+	ListEvaluationAnnotationsByEvaluationIDs(ctx context.Context, evaluationIds []uuid.UUID) ([]EvaluationAnnotation, error)
+	// ListEvaluationAnnotationsForFindings lists the annotations of the rule and
+	// entity pairs of the given evaluations, along with the evaluation of the
+	// pair they were listed for, oldest first.
+	ListEvaluationAnnotationsForFindings(ctx context.Context, evaluationIds []uuid.UUID) ([]ListEvaluationAnnotationsForFindingsRow, error)
 	ListEvaluationHistory(ctx context.Context, arg ListEvaluationHistoryParams) ([]ListEvaluationHistoryRow, error)
 	ListEvaluationHistoryStaleRecords(ctx context.Context, arg ListEvaluationHistoryStaleRecordsParams) ([]ListEvaluationHistoryStaleRecordsRow, error)
 	ListFlushCache(ctx context.Context) ([]FlushCache, error)
+	// ListInvalidIndexes lists the indexes of the current schema which can't be
+	// used, usually because building them concurrently failed.
+	ListInvalidIndexes(ctx context.Context) ([]ListInvalidIndexesRow, error)
 	// ListInvitationsForProject collects the information visible to project
 	// administrators after an invitation has been issued.  In particular, it
 	// *does not* report the invitation code, which is a secret intended for
 	// the invitee.
 	ListInvitationsForProject(ctx context.Context, project uuid.UUID) ([]ListInvitationsForProjectRow, error)
+	// ListLatestEvaluationsByProject lists the latest evaluation of each rule and
+	// entity pair of a project, e.g. to archive a summary of its history.
+	ListLatestEvaluationsByProject(ctx context.Context, projectID uuid.UUID) ([]ListLatestEvaluationsByProjectRow, error)
+	ListMigrationPhases(ctx context.Context) ([]MigrationPhase, error)
 	// ListOldestRuleEvaluationsByEntityID returns the oldest evaluation time for each entity.
 	// cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
 	ListOldestRuleEvaluationsByEntityID(ctx context.Context, entityIds []uuid.UUID) ([]ListOldestRuleEvaluationsByEntityIDRow, error)
@@ -222,8 +396,25 @@ type Querier interface {
 	// cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
 	// DEPRECATED: Use ListOldestRuleEvaluationsByEntityID instead
 	ListOldestRuleEvaluationsByRepositoryId(ctx context.Context, repositoryIds []uuid.UUID) ([]ListOldestRuleEvaluationsByRepositoryIdRow, error)
+	ListPendingOperations(ctx context.Context, projectID uuid.UUID) ([]PendingOperation, error)
+	// ListProfileBases lists the names of the profiles extended by the given
+	// profiles, in the order they are extended.
+	ListProfileBases(ctx context.Context, profileIds []uuid.UUID) ([]ListProfileBasesRow, error)
+	// ListProfileVersions lists the versions of a profile, the most recent first.
+	ListProfileVersions(ctx context.Context, profileID uuid.UUID) ([]ProfileVersion, error)
 	ListProfilesByProjectIDAndLabel(ctx context.Context, arg ListProfilesByProjectIDAndLabelParams) ([]ListProfilesByProjectIDAndLabelRow, error)
+	ListProfilesExtending(ctx context.Context, baseProfileID uuid.UUID) ([]uuid.UUID, error)
 	ListProfilesInstantiatingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]string, error)
+	ListProjectDeployKeys(ctx context.Context, projectID uuid.UUID) ([]ProjectDeployKey, error)
+	ListProjectSecrets(ctx context.Context, projectID uuid.UUID) ([]ProjectSecret, error)
+	ListProjectTiers(ctx context.Context) ([]ProjectTier, error)
+	// ListProjectsUsingRuleType lists the projects with rule instances of a
+	// rule type.
+	ListProjectsUsingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]uuid.UUID, error)
+	ListProviderShares(ctx context.Context, providerID uuid.UUID) ([]ProviderShare, error)
+	// ListProviderUsage returns the usage of the given providers since the given
+	// time, summed over buckets of the given granularity ('hour' or 'day', in UTC).
+	ListProviderUsage(ctx context.Context, arg ListProviderUsageParams) ([]ListProviderUsageRow, error)
 	// ListProvidersByProjectID allows us to list all providers
 	// for a given array of projects.
 	ListProvidersByProjectID(ctx context.Context, projects []uuid.UUID) ([]Provider, error)
@@ -231,11 +422,24 @@ type Querier interface {
 	// with pagination taken into account. In this case, the cursor is the creation date.
 	ListProvidersByProjectIDPaginated(ctx context.Context, arg ListProvidersByProjectIDPaginatedParams) ([]Provider, error)
 	ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRuleEvaluationsByProfileIdParams) ([]ListRuleEvaluationsByProfileIdRow, error)
+	ListRuleExceptionEvents(ctx context.Context, exceptionIds []uuid.UUID) ([]RuleExceptionEvent, error)
+	ListRuleExceptions(ctx context.Context, arg ListRuleExceptionsParams) ([]ListRuleExceptionsRow, error)
+	// ListRuleTypeFailureCounts counts, for each rule type name, the entities
+	// which failed its evaluation since the start of the recent window, and the
+	// entities which failed it in the baseline preceding the window. The
+	// entities and projects evaluated in the window, and the last update of the
+	// rule types of that name, give context to the counts.
+	ListRuleTypeFailureCounts(ctx context.Context, arg ListRuleTypeFailureCountsParams) ([]ListRuleTypeFailureCountsRow, error)
 	ListRuleTypesByProject(ctx context.Context, projectID uuid.UUID) ([]RuleType, error)
 	// ListRuleTypesReferencesByDataSource retrieves all rule types
 	// referencing a given data source in a given project.
 	//
 	ListRuleTypesReferencesByDataSource(ctx context.Context, dataSourcesID uuid.UUID) ([]RuleTypeDataSource, error)
+	// ListStaleEvaluationRuleEntities lists the rule and entity pairs which were
+	// not evaluated since the threshold, such as the pairs of the entities which
+	// are no longer selected by a profile, along with the size of their history.
+	// The project filter is optional.
+	ListStaleEvaluationRuleEntities(ctx context.Context, arg ListStaleEvaluationRuleEntitiesParams) ([]ListStaleEvaluationRuleEntitiesRow, error)
 	// When doing a key/algorithm rotation, identify the secrets which need to be
 	// rotated. The criteria for rotation are:
 	// 1) The encrypted_access_token is NULL (this should be removed when we make
@@ -254,28 +458,70 @@ type Querier interface {
 	LockIfThresholdNotExceeded(ctx context.Context, arg LockIfThresholdNotExceededParams) (EntityExecutionLock, error)
 	// OrphanProject is a query that sets the parent_id of a project to NULL.
 	OrphanProject(ctx context.Context, arg OrphanProjectParams) (Project, error)
+	// PopDeferredActions removes and returns the entities whose actions were
+	// deferred for the longest time. A re-evaluation which is lost is not an
+	// issue, as the deferred actions left the previous action statuses intact:
+	// the next evaluation of the entity executes them.
+	PopDeferredActions(ctx context.Context, size int64) ([]DeferredAction, error)
+	// PopDueAlertGroups clears the flush time of the groups whose grouping
+	// window elapsed, and returns them so that their entities are re-evaluated.
+	// A re-evaluation which is lost is not an issue: the pending failures are
+	// kept, and sent by the next evaluation of the entity.
+	PopDueAlertGroups(ctx context.Context, size int64) ([]AlertGroup, error)
+	// PopDueThrottledEvaluations removes and returns the throttled entities which
+	// can be evaluated again. A re-evaluation which is lost is not an issue: the
+	// entity is evaluated again on its next event, or by the reminder service.
+	PopDueThrottledEvaluations(ctx context.Context, size int64) ([]ThrottledEvaluation, error)
 	// ReleaseLock is used to release a lock on an entity. It will delete the
 	// entity_execution_lock record if the lock is held by the given locked_by
 	// value.
 	ReleaseLock(ctx context.Context, arg ReleaseLockParams) error
+	// ReopenEntity removes the record of an entity being closed, e.g. after the
+	// pull request was reopened.
+	ReopenEntity(ctx context.Context, entityInstanceID uuid.UUID) error
+	ReviewRuleException(ctx context.Context, arg ReviewRuleExceptionParams) (RuleException, error)
+	// SetBundleSignatureVerification records the result of the verification of
+	// the signature of a bundle.
+	SetBundleSignatureVerification(ctx context.Context, arg SetBundleSignatureVerificationParams) error
+	// SetProviderHealthAlertedExpiry records that the expiry of the token of a
+	// provider was alerted on.
+	SetProviderHealthAlertedExpiry(ctx context.Context, arg SetProviderHealthAlertedExpiryParams) error
 	SetSubscriptionBundleVersion(ctx context.Context, arg SetSubscriptionBundleVersionParams) error
+	// StartMigrationPhase records that a phase is running, resetting the outcome
+	// of its previous runs.
+	StartMigrationPhase(ctx context.Context, arg StartMigrationPhaseParams) (MigrationPhase, error)
+	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
+	// SPDX-License-Identifier: Apache-2.0
+	// TakeAPIQuotaToken refills the token bucket of a project for the time
+	// elapsed since its last update, and takes a token from it. No row is
+	// returned, and the bucket is left unchanged, if there is no token left.
+	TakeAPIQuotaToken(ctx context.Context, arg TakeAPIQuotaTokenParams) (float64, error)
+	UnassignProjectTier(ctx context.Context, projectID uuid.UUID) (int64, error)
 	// UpdateDataSource updates a datasource in a given project.
 	UpdateDataSource(ctx context.Context, arg UpdateDataSourceParams) (DataSource, error)
 	// UpdateDataSourceFunction updates a function in a datasource. We're
 	// only able to update the type and definition of the function.
 	UpdateDataSourceFunction(ctx context.Context, arg UpdateDataSourceFunctionParams) (DataSourcesFunction, error)
 	UpdateEncryptedSecret(ctx context.Context, arg UpdateEncryptedSecretParams) error
+	// UpdateEntityName renames an entity of a project, e.g. after the upstream
+	// repository was renamed.
+	UpdateEntityName(ctx context.Context, arg UpdateEntityNameParams) error
+	// UpdateEntityOriginatedFrom links an entity of a project to the entity it
+	// originated from, e.g. an artifact to the repository that built it.
+	UpdateEntityOriginatedFrom(ctx context.Context, arg UpdateEntityOriginatedFromParams) error
 	// UpdateInvitationRole updates an invitation by its code. This is intended to be
 	// called by a user who has issued an invitation and then decided to change the
 	// role of the invitee.
 	UpdateInvitationRole(ctx context.Context, arg UpdateInvitationRoleParams) (UserInvite, error)
 	UpdateLease(ctx context.Context, arg UpdateLeaseParams) error
+	UpdatePendingOperationState(ctx context.Context, arg UpdatePendingOperationStateParams) error
 	UpdateProfile(ctx context.Context, arg UpdateProfileParams) (Profile, error)
 	UpdateProjectMeta(ctx context.Context, arg UpdateProjectMetaParams) (Project, error)
 	UpdateProvider(ctx context.Context, arg UpdateProviderParams) error
 	UpdateRuleType(ctx context.Context, arg UpdateRuleTypeParams) (RuleType, error)
 	UpdateSelector(ctx context.Context, arg UpdateSelectorParams) (ProfileSelector, error)
 	UpsertAccessToken(ctx context.Context, arg UpsertAccessTokenParams) (ProviderAccessToken, error)
+	UpsertAlertGroup(ctx context.Context, arg UpsertAlertGroupParams) (AlertGroup, error)
 	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
 	// SPDX-License-Identifier: Apache-2.0
 	// Bundles --
@@ -283,10 +529,19 @@ type Querier interface {
 	// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
 	// SPDX-License-Identifier: Apache-2.0
 	UpsertEvaluationOutput(ctx context.Context, arg UpsertEvaluationOutputParams) error
+	UpsertEvaluationRetry(ctx context.Context, arg UpsertEvaluationRetryParams) (EvaluationRetry, error)
 	UpsertInstallationID(ctx context.Context, arg UpsertInstallationIDParams) (ProviderGithubAppInstallation, error)
 	UpsertLatestEvaluationStatus(ctx context.Context, arg UpsertLatestEvaluationStatusParams) error
 	UpsertProfileForEntity(ctx context.Context, arg UpsertProfileForEntityParams) (EntityProfile, error)
+	UpsertProjectSecret(ctx context.Context, arg UpsertProjectSecretParams) (ProjectSecret, error)
+	UpsertProjectTier(ctx context.Context, arg UpsertProjectTierParams) (ProjectTier, error)
 	UpsertProperty(ctx context.Context, arg UpsertPropertyParams) (Property, error)
+	// UpsertProviderHealth stores the result of a health check of a provider.
+	// The time of the last successful API call is kept when the check failed.
+	UpsertProviderHealth(ctx context.Context, arg UpsertProviderHealthParams) (ProviderHealth, error)
+	// UpsertRepositoryListingCursor stores the cursor of the next page of the
+	// repository listing of a provider for a project.
+	UpsertRepositoryListingCursor(ctx context.Context, arg UpsertRepositoryListingCursorParams) error
 	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
 	// SPDX-License-Identifier: Apache-2.0
 	UpsertRuleInstance(ctx context.Context, arg UpsertRuleInstanceParams) (uuid.UUID, error)
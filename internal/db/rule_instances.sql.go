@@ -7,12 +7,25 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
 
+const countRuleInstancesByProjectID = `-- name: CountRuleInstancesByProjectID :one
+SELECT COUNT(*) FROM rule_instances WHERE project_id = $1
+`
+
+func (q *Queries) CountRuleInstancesByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countRuleInstancesByProjectID, projectID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const deleteNonUpdatedRules = `-- name: DeleteNonUpdatedRules :exec
 DELETE FROM rule_instances
 WHERE profile_id = $1
@@ -46,8 +59,178 @@ func (q *Queries) DeleteRuleInstanceOfProfileInProject(ctx context.Context, arg
 	return err
 }
 
+const disableRuleInstance = `-- name: DisableRuleInstance :one
+
+UPDATE rule_instances
+SET disabled_at = NOW(),
+    disabled_by = $1,
+    disabled_reason = $2
+WHERE id = $3
+RETURNING id, profile_id, rule_type_id, name, entity_type, def, params, created_at, updated_at, project_id, overrides, disabled_at, disabled_by, disabled_reason, canary_percentage
+`
+
+type DisableRuleInstanceParams struct {
+	DisabledBy     sql.NullString `json:"disabled_by"`
+	DisabledReason sql.NullString `json:"disabled_reason"`
+	ID             uuid.UUID      `json:"id"`
+}
+
+// DisableRuleInstance disables a rule instance, recording who disabled it
+// and why. Disabled rules are reported as skipped.
+func (q *Queries) DisableRuleInstance(ctx context.Context, arg DisableRuleInstanceParams) (RuleInstance, error) {
+	row := q.db.QueryRowContext(ctx, disableRuleInstance, arg.DisabledBy, arg.DisabledReason, arg.ID)
+	var i RuleInstance
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.RuleTypeID,
+		&i.Name,
+		&i.EntityType,
+		&i.Def,
+		&i.Params,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ProjectID,
+		&i.Overrides,
+		&i.DisabledAt,
+		&i.DisabledBy,
+		&i.DisabledReason,
+		&i.CanaryPercentage,
+	)
+	return i, err
+}
+
+const enableRuleInstance = `-- name: EnableRuleInstance :one
+UPDATE rule_instances
+SET disabled_at = NULL,
+    disabled_by = NULL,
+    disabled_reason = NULL
+WHERE id = $1
+RETURNING id, profile_id, rule_type_id, name, entity_type, def, params, created_at, updated_at, project_id, overrides, disabled_at, disabled_by, disabled_reason, canary_percentage
+`
+
+func (q *Queries) EnableRuleInstance(ctx context.Context, id uuid.UUID) (RuleInstance, error) {
+	row := q.db.QueryRowContext(ctx, enableRuleInstance, id)
+	var i RuleInstance
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.RuleTypeID,
+		&i.Name,
+		&i.EntityType,
+		&i.Def,
+		&i.Params,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ProjectID,
+		&i.Overrides,
+		&i.DisabledAt,
+		&i.DisabledBy,
+		&i.DisabledReason,
+		&i.CanaryPercentage,
+	)
+	return i, err
+}
+
+const getRuleInstanceByProfileName = `-- name: GetRuleInstanceByProfileName :one
+SELECT ri.id, ri.profile_id, ri.rule_type_id, ri.name, ri.entity_type, ri.def, ri.params, ri.created_at, ri.updated_at, ri.project_id, ri.overrides, ri.disabled_at, ri.disabled_by, ri.disabled_reason, ri.canary_percentage FROM rule_instances AS ri
+JOIN profiles AS p ON p.id = ri.profile_id
+WHERE ri.project_id = $1
+AND lower(p.name) = lower($3)
+AND ri.entity_type = $2
+AND lower(ri.name) = lower($4)
+`
+
+type GetRuleInstanceByProfileNameParams struct {
+	ProjectID   uuid.UUID `json:"project_id"`
+	EntityType  Entities  `json:"entity_type"`
+	ProfileName string    `json:"profile_name"`
+	RuleName    string    `json:"rule_name"`
+}
+
+func (q *Queries) GetRuleInstanceByProfileName(ctx context.Context, arg GetRuleInstanceByProfileNameParams) (RuleInstance, error) {
+	row := q.db.QueryRowContext(ctx, getRuleInstanceByProfileName,
+		arg.ProjectID,
+		arg.EntityType,
+		arg.ProfileName,
+		arg.RuleName,
+	)
+	var i RuleInstance
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.RuleTypeID,
+		&i.Name,
+		&i.EntityType,
+		&i.Def,
+		&i.Params,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ProjectID,
+		&i.Overrides,
+		&i.DisabledAt,
+		&i.DisabledBy,
+		&i.DisabledReason,
+		&i.CanaryPercentage,
+	)
+	return i, err
+}
+
+const getRuleInstancesByProfileName = `-- name: GetRuleInstancesByProfileName :many
+SELECT ri.id, ri.profile_id, ri.rule_type_id, ri.name, ri.entity_type, ri.def, ri.params, ri.created_at, ri.updated_at, ri.project_id, ri.overrides, ri.disabled_at, ri.disabled_by, ri.disabled_reason, ri.canary_percentage FROM rule_instances AS ri
+JOIN profiles AS p ON p.id = ri.profile_id
+WHERE ri.project_id = $1
+AND lower(p.name) = lower($2)
+AND lower(ri.name) = lower($3)
+`
+
+type GetRuleInstancesByProfileNameParams struct {
+	ProjectID   uuid.UUID `json:"project_id"`
+	ProfileName string    `json:"profile_name"`
+	RuleName    string    `json:"rule_name"`
+}
+
+func (q *Queries) GetRuleInstancesByProfileName(ctx context.Context, arg GetRuleInstancesByProfileNameParams) ([]RuleInstance, error) {
+	rows, err := q.db.QueryContext(ctx, getRuleInstancesByProfileName, arg.ProjectID, arg.ProfileName, arg.RuleName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RuleInstance{}
+	for rows.Next() {
+		var i RuleInstance
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.RuleTypeID,
+			&i.Name,
+			&i.EntityType,
+			&i.Def,
+			&i.Params,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ProjectID,
+			&i.Overrides,
+			&i.DisabledAt,
+			&i.DisabledBy,
+			&i.DisabledReason,
+			&i.CanaryPercentage,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRuleInstancesEntityInProjects = `-- name: GetRuleInstancesEntityInProjects :many
-SELECT id, profile_id, rule_type_id, name, entity_type, def, params, created_at, updated_at, project_id FROM rule_instances
+SELECT id, profile_id, rule_type_id, name, entity_type, def, params, created_at, updated_at, project_id, overrides, disabled_at, disabled_by, disabled_reason, canary_percentage FROM rule_instances
 WHERE entity_type = $1
 AND project_id = ANY($2::UUID[])
 `
@@ -77,6 +260,11 @@ func (q *Queries) GetRuleInstancesEntityInProjects(ctx context.Context, arg GetR
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ProjectID,
+			&i.Overrides,
+			&i.DisabledAt,
+			&i.DisabledBy,
+			&i.DisabledReason,
+			&i.CanaryPercentage,
 		); err != nil {
 			return nil, err
 		}
@@ -92,7 +280,7 @@ func (q *Queries) GetRuleInstancesEntityInProjects(ctx context.Context, arg GetR
 }
 
 const getRuleInstancesForProfile = `-- name: GetRuleInstancesForProfile :many
-SELECT id, profile_id, rule_type_id, name, entity_type, def, params, created_at, updated_at, project_id FROM rule_instances WHERE profile_id = $1
+SELECT id, profile_id, rule_type_id, name, entity_type, def, params, created_at, updated_at, project_id, overrides, disabled_at, disabled_by, disabled_reason, canary_percentage FROM rule_instances WHERE profile_id = $1
 `
 
 func (q *Queries) GetRuleInstancesForProfile(ctx context.Context, profileID uuid.UUID) ([]RuleInstance, error) {
@@ -115,6 +303,11 @@ func (q *Queries) GetRuleInstancesForProfile(ctx context.Context, profileID uuid
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ProjectID,
+			&i.Overrides,
+			&i.DisabledAt,
+			&i.DisabledBy,
+			&i.DisabledReason,
+			&i.CanaryPercentage,
 		); err != nil {
 			return nil, err
 		}
@@ -151,6 +344,103 @@ func (q *Queries) GetRuleTypeIDByRuleNameEntityProfile(ctx context.Context, arg
 	return rule_type_id, err
 }
 
+const listDisabledRuleInstances = `-- name: ListDisabledRuleInstances :many
+SELECT ri.id, ri.profile_id, ri.rule_type_id, ri.name, ri.entity_type, ri.def, ri.params, ri.created_at, ri.updated_at, ri.project_id, ri.overrides, ri.disabled_at, ri.disabled_by, ri.disabled_reason, ri.canary_percentage, p.name AS profile_name FROM rule_instances AS ri
+JOIN profiles AS p ON p.id = ri.profile_id
+WHERE ri.project_id = $1
+AND ri.disabled_at IS NOT NULL
+ORDER BY p.name, ri.name
+`
+
+type ListDisabledRuleInstancesRow struct {
+	ID               uuid.UUID       `json:"id"`
+	ProfileID        uuid.UUID       `json:"profile_id"`
+	RuleTypeID       uuid.UUID       `json:"rule_type_id"`
+	Name             string          `json:"name"`
+	EntityType       Entities        `json:"entity_type"`
+	Def              json.RawMessage `json:"def"`
+	Params           json.RawMessage `json:"params"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	ProjectID        uuid.UUID       `json:"project_id"`
+	Overrides        json.RawMessage `json:"overrides"`
+	DisabledAt       sql.NullTime    `json:"disabled_at"`
+	DisabledBy       sql.NullString  `json:"disabled_by"`
+	DisabledReason   sql.NullString  `json:"disabled_reason"`
+	CanaryPercentage sql.NullInt32   `json:"canary_percentage"`
+	ProfileName      string          `json:"profile_name"`
+}
+
+func (q *Queries) ListDisabledRuleInstances(ctx context.Context, projectID uuid.UUID) ([]ListDisabledRuleInstancesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDisabledRuleInstances, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDisabledRuleInstancesRow{}
+	for rows.Next() {
+		var i ListDisabledRuleInstancesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.RuleTypeID,
+			&i.Name,
+			&i.EntityType,
+			&i.Def,
+			&i.Params,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ProjectID,
+			&i.Overrides,
+			&i.DisabledAt,
+			&i.DisabledBy,
+			&i.DisabledReason,
+			&i.CanaryPercentage,
+			&i.ProfileName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProjectsUsingRuleType = `-- name: ListProjectsUsingRuleType :many
+
+SELECT DISTINCT project_id FROM rule_instances WHERE rule_type_id = $1
+`
+
+// ListProjectsUsingRuleType lists the projects with rule instances of a
+// rule type.
+func (q *Queries) ListProjectsUsingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectsUsingRuleType, ruleTypeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var project_id uuid.UUID
+		if err := rows.Scan(&project_id); err != nil {
+			return nil, err
+		}
+		items = append(items, project_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertRuleInstance = `-- name: UpsertRuleInstance :one
 
 INSERT INTO rule_instances (
@@ -161,6 +451,8 @@ INSERT INTO rule_instances (
     def,
     params,
     project_id,
+    overrides,
+    canary_percentage,
     created_at,
     updated_at
 ) VALUES(
@@ -171,6 +463,8 @@ INSERT INTO rule_instances (
     $5,
     $6,
     $7,
+    $8,
+    $9,
     NOW(),
     NOW()
 )
@@ -178,18 +472,22 @@ ON CONFLICT (profile_id, entity_type, lower(name)) DO UPDATE SET
     rule_type_id = $2,
     def = $5,
     params = $6,
+    overrides = $8,
+    canary_percentage = $9,
     updated_at = NOW()
 RETURNING id
 `
 
 type UpsertRuleInstanceParams struct {
-	ProfileID  uuid.UUID       `json:"profile_id"`
-	RuleTypeID uuid.UUID       `json:"rule_type_id"`
-	Name       string          `json:"name"`
-	EntityType Entities        `json:"entity_type"`
-	Def        json.RawMessage `json:"def"`
-	Params     json.RawMessage `json:"params"`
-	ProjectID  uuid.UUID       `json:"project_id"`
+	ProfileID        uuid.UUID       `json:"profile_id"`
+	RuleTypeID       uuid.UUID       `json:"rule_type_id"`
+	Name             string          `json:"name"`
+	EntityType       Entities        `json:"entity_type"`
+	Def              json.RawMessage `json:"def"`
+	Params           json.RawMessage `json:"params"`
+	ProjectID        uuid.UUID       `json:"project_id"`
+	Overrides        json.RawMessage `json:"overrides"`
+	CanaryPercentage sql.NullInt32   `json:"canary_percentage"`
 }
 
 // SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
@@ -203,6 +501,8 @@ func (q *Queries) UpsertRuleInstance(ctx context.Context, arg UpsertRuleInstance
 		arg.Def,
 		arg.Params,
 		arg.ProjectID,
+		arg.Overrides,
+		arg.CanaryPercentage,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
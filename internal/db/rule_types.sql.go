@@ -25,7 +25,8 @@ INSERT INTO rule_type (
     display_name,
     release_phase,
     short_failure_message,
-    rego_version
+    rego_version,
+    visibility
 ) VALUES (
     $1,
     $2,
@@ -37,8 +38,9 @@ INSERT INTO rule_type (
     $8,
     $9,
     $10,
-    $11
-) RETURNING id, name, provider, project_id, description, guidance, definition, created_at, updated_at, severity_value, provider_id, subscription_id, display_name, release_phase, short_failure_message, rego_version
+    $11,
+    $12
+) RETURNING id, name, provider, project_id, description, guidance, definition, created_at, updated_at, severity_value, provider_id, subscription_id, display_name, release_phase, short_failure_message, rego_version, visibility
 `
 
 type CreateRuleTypeParams struct {
@@ -53,6 +55,7 @@ type CreateRuleTypeParams struct {
 	ReleasePhase        ReleaseStatus   `json:"release_phase"`
 	ShortFailureMessage string          `json:"short_failure_message"`
 	RegoVersion         string          `json:"rego_version"`
+	Visibility          Visibility      `json:"visibility"`
 }
 
 func (q *Queries) CreateRuleType(ctx context.Context, arg CreateRuleTypeParams) (RuleType, error) {
@@ -68,6 +71,7 @@ func (q *Queries) CreateRuleType(ctx context.Context, arg CreateRuleTypeParams)
 		arg.ReleasePhase,
 		arg.ShortFailureMessage,
 		arg.RegoVersion,
+		arg.Visibility,
 	)
 	var i RuleType
 	err := row.Scan(
@@ -87,6 +91,7 @@ func (q *Queries) CreateRuleType(ctx context.Context, arg CreateRuleTypeParams)
 		&i.ReleasePhase,
 		&i.ShortFailureMessage,
 		&i.RegoVersion,
+		&i.Visibility,
 	)
 	return i, err
 }
@@ -101,7 +106,7 @@ func (q *Queries) DeleteRuleType(ctx context.Context, id uuid.UUID) error {
 }
 
 const getRuleTypeByID = `-- name: GetRuleTypeByID :one
-SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at, severity_value, provider_id, subscription_id, display_name, release_phase, short_failure_message, rego_version FROM rule_type WHERE id = $1
+SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at, severity_value, provider_id, subscription_id, display_name, release_phase, short_failure_message, rego_version, visibility FROM rule_type WHERE id = $1
 `
 
 func (q *Queries) GetRuleTypeByID(ctx context.Context, id uuid.UUID) (RuleType, error) {
@@ -124,21 +129,41 @@ func (q *Queries) GetRuleTypeByID(ctx context.Context, id uuid.UUID) (RuleType,
 		&i.ReleasePhase,
 		&i.ShortFailureMessage,
 		&i.RegoVersion,
+		&i.Visibility,
 	)
 	return i, err
 }
 
 const getRuleTypeByName = `-- name: GetRuleTypeByName :one
-SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at, severity_value, provider_id, subscription_id, display_name, release_phase, short_failure_message, rego_version FROM rule_type WHERE  project_id = ANY($1::uuid[]) AND lower(name) = lower($2)
+
+SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at, severity_value, provider_id, subscription_id, display_name, release_phase, short_failure_message, rego_version, visibility FROM rule_type
+WHERE lower(name) = lower($1)
+AND (
+    project_id = ($2::uuid[])[1]
+    OR (project_id = ANY($2::uuid[]) AND visibility != 'private')
+    OR ($3::boolean AND visibility = 'global')
+)
+ORDER BY array_position($2::uuid[], project_id) NULLS LAST, created_at
+LIMIT 1
 `
 
 type GetRuleTypeByNameParams struct {
-	Projects []uuid.UUID `json:"projects"`
-	Name     string      `json:"name"`
+	Name          string      `json:"name"`
+	Projects      []uuid.UUID `json:"projects"`
+	IncludeGlobal bool        `json:"include_global"`
 }
 
+// GetRuleTypeByName retrieves a rule type by name which is visible from a
+// project hierarchy. The first project of the hierarchy is the project
+// doing the lookup, which sees its own rule types and the ones its
+// ancestors share with their subtree. Global rule types of other
+// hierarchies are only returned if include_global is set. The rule type
+// closest to the project wins.
+//
+// Note that to get a rule type for a given project, one can simply
+// pass one project id in the projects array.
 func (q *Queries) GetRuleTypeByName(ctx context.Context, arg GetRuleTypeByNameParams) (RuleType, error) {
-	row := q.db.QueryRowContext(ctx, getRuleTypeByName, pq.Array(arg.Projects), arg.Name)
+	row := q.db.QueryRowContext(ctx, getRuleTypeByName, arg.Name, pq.Array(arg.Projects), arg.IncludeGlobal)
 	var i RuleType
 	err := row.Scan(
 		&i.ID,
@@ -157,6 +182,7 @@ func (q *Queries) GetRuleTypeByName(ctx context.Context, arg GetRuleTypeByNamePa
 		&i.ReleasePhase,
 		&i.ShortFailureMessage,
 		&i.RegoVersion,
+		&i.Visibility,
 	)
 	return i, err
 }
@@ -176,7 +202,7 @@ func (q *Queries) GetRuleTypeNameByID(ctx context.Context, id uuid.UUID) (string
 }
 
 const getRuleTypesByEntityInHierarchy = `-- name: GetRuleTypesByEntityInHierarchy :many
-SELECT rt.id, rt.name, rt.provider, rt.project_id, rt.description, rt.guidance, rt.definition, rt.created_at, rt.updated_at, rt.severity_value, rt.provider_id, rt.subscription_id, rt.display_name, rt.release_phase, rt.short_failure_message, rt.rego_version FROM rule_type AS rt
+SELECT rt.id, rt.name, rt.provider, rt.project_id, rt.description, rt.guidance, rt.definition, rt.created_at, rt.updated_at, rt.severity_value, rt.provider_id, rt.subscription_id, rt.display_name, rt.release_phase, rt.short_failure_message, rt.rego_version, rt.visibility FROM rule_type AS rt
 JOIN rule_instances AS ri ON ri.rule_type_id = rt.id
 WHERE ri.entity_type = $1
 AND ri.project_id = ANY($2::uuid[])
@@ -213,6 +239,7 @@ func (q *Queries) GetRuleTypesByEntityInHierarchy(ctx context.Context, arg GetRu
 			&i.ReleasePhase,
 			&i.ShortFailureMessage,
 			&i.RegoVersion,
+			&i.Visibility,
 		); err != nil {
 			return nil, err
 		}
@@ -228,7 +255,7 @@ func (q *Queries) GetRuleTypesByEntityInHierarchy(ctx context.Context, arg GetRu
 }
 
 const listRuleTypesByProject = `-- name: ListRuleTypesByProject :many
-SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at, severity_value, provider_id, subscription_id, display_name, release_phase, short_failure_message, rego_version FROM rule_type WHERE project_id = $1
+SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at, severity_value, provider_id, subscription_id, display_name, release_phase, short_failure_message, rego_version, visibility FROM rule_type WHERE project_id = $1
 `
 
 func (q *Queries) ListRuleTypesByProject(ctx context.Context, projectID uuid.UUID) ([]RuleType, error) {
@@ -257,6 +284,7 @@ func (q *Queries) ListRuleTypesByProject(ctx context.Context, projectID uuid.UUI
 			&i.ReleasePhase,
 			&i.ShortFailureMessage,
 			&i.RegoVersion,
+			&i.Visibility,
 		); err != nil {
 			return nil, err
 		}
@@ -273,9 +301,9 @@ func (q *Queries) ListRuleTypesByProject(ctx context.Context, projectID uuid.UUI
 
 const updateRuleType = `-- name: UpdateRuleType :one
 UPDATE rule_type
-    SET description = $2, definition = $3::jsonb, severity_value = $4, display_name = $5, release_phase = $6, short_failure_message = $7, rego_version = $8
+    SET description = $2, definition = $3::jsonb, severity_value = $4, display_name = $5, release_phase = $6, short_failure_message = $7, rego_version = $8, visibility = $9
     WHERE id = $1
-    RETURNING id, name, provider, project_id, description, guidance, definition, created_at, updated_at, severity_value, provider_id, subscription_id, display_name, release_phase, short_failure_message, rego_version
+    RETURNING id, name, provider, project_id, description, guidance, definition, created_at, updated_at, severity_value, provider_id, subscription_id, display_name, release_phase, short_failure_message, rego_version, visibility
 `
 
 type UpdateRuleTypeParams struct {
@@ -287,6 +315,7 @@ type UpdateRuleTypeParams struct {
 	ReleasePhase        ReleaseStatus   `json:"release_phase"`
 	ShortFailureMessage string          `json:"short_failure_message"`
 	RegoVersion         string          `json:"rego_version"`
+	Visibility          Visibility      `json:"visibility"`
 }
 
 func (q *Queries) UpdateRuleType(ctx context.Context, arg UpdateRuleTypeParams) (RuleType, error) {
@@ -299,6 +328,7 @@ func (q *Queries) UpdateRuleType(ctx context.Context, arg UpdateRuleTypeParams)
 		arg.ReleasePhase,
 		arg.ShortFailureMessage,
 		arg.RegoVersion,
+		arg.Visibility,
 	)
 	var i RuleType
 	err := row.Scan(
@@ -318,6 +348,7 @@ func (q *Queries) UpdateRuleType(ctx context.Context, arg UpdateRuleTypeParams)
 		&i.ReleasePhase,
 		&i.ShortFailureMessage,
 		&i.RegoVersion,
+		&i.Visibility,
 	)
 	return i, err
 }
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package standalone runs the embedded PostgreSQL database of the standalone
+// server mode.
+//
+// The PostgreSQL binaries are downloaded once from a Maven repository into a
+// cache directory, and extracted from the cache on each start. To run without
+// network access, either populate the cache beforehand (by starting once while
+// online, or by copying the archive from another machine), point the server at
+// a mirror of the repository, or use the binaries of a local PostgreSQL
+// installation.
+package standalone
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres" // nolint
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+
+	"github.com/mindersec/minder/database"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/util/rand"
+)
+
+// PostgresVersion is the version of PostgreSQL downloaded for the standalone
+// server mode
+const PostgresVersion = embeddedpostgres.V16
+
+// DefaultRepositoryURL is the Maven repository the PostgreSQL binaries are
+// downloaded from by default
+const DefaultRepositoryURL = "https://repo1.maven.org/maven2"
+
+// ErrBinariesNotFound is returned when running offline and no PostgreSQL
+// binaries are available locally
+var ErrBinariesNotFound = errors.New("postgres binaries not found")
+
+// Config configures the embedded PostgreSQL database
+type Config struct {
+	// CacheDir is the directory the archive of the PostgreSQL binaries is
+	// downloaded to, and read from on the next starts. Defaults to
+	// minder/postgres in the user cache directory.
+	CacheDir string
+	// BinariesDir is the directory of a local PostgreSQL installation, with
+	// initdb, pg_ctl and postgres in its bin directory. When set, the
+	// binaries are neither downloaded nor read from the cache.
+	BinariesDir string
+	// RepositoryURL is the Maven repository, or a mirror of it, the
+	// PostgreSQL binaries are downloaded from when they are not cached
+	RepositoryURL string
+	// Offline fails the start instead of downloading the PostgreSQL binaries
+	// when they are not cached
+	Offline bool
+}
+
+// CancelFunc stops the database and removes its data
+type CancelFunc func()
+
+// Start starts an embedded PostgreSQL database with its data in a temporary
+// directory, migrates it to the latest schema and returns a store for it.
+// The returned cancel function must be called to stop the database.
+func Start(cfg Config) (db.Store, CancelFunc, error) {
+	pgCfg, err := postgresConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runtimeDir, err := os.MkdirTemp("", "minder-standalone-db")
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create runtime directory: %w", err)
+	}
+	removeRuntimeDir := func() {
+		_ = os.RemoveAll(runtimeDir)
+	}
+
+	port, err := rand.GetRandomLocalPort()
+	if err != nil {
+		removeRuntimeDir()
+		return nil, nil, fmt.Errorf("unable to pick a port: %w", err)
+	}
+
+	pgCfg = pgCfg.
+		Port(port).
+		Database("minder").
+		RuntimePath(runtimeDir).
+		StartTimeout(time.Minute)
+	postgres := embeddedpostgres.NewDatabase(pgCfg)
+	if err := postgres.Start(); err != nil {
+		removeRuntimeDir()
+		return nil, nil, fmt.Errorf("unable to start postgres: %w", err)
+	}
+	cancel := func() {
+		if err := postgres.Stop(); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to stop postgres: %v\n", err)
+		}
+		removeRuntimeDir()
+	}
+
+	connURL := pgCfg.GetConnectionURL() + "?sslmode=disable"
+	sqlDB, err := sql.Open("postgres", connURL)
+	if err != nil {
+		cancel()
+		return nil, nil, fmt.Errorf("unable to open database: %w", err)
+	}
+	if err := sqlDB.Ping(); err != nil {
+		cancel()
+		return nil, nil, fmt.Errorf("unable to ping database: %w", err)
+	}
+
+	mig, err := database.NewFromConnectionString(connURL)
+	if err != nil {
+		cancel()
+		return nil, nil, fmt.Errorf("unable to create migration: %w", err)
+	}
+	if err := mig.Up(); err != nil {
+		cancel()
+		return nil, nil, fmt.Errorf("unable to run migration: %w", err)
+	}
+
+	return db.NewStore(sqlDB), func() {
+		_ = sqlDB.Close()
+		cancel()
+	}, nil
+}
+
+// postgresConfig returns the configuration of the embedded PostgreSQL
+// database, checking that its binaries are available when running offline
+func postgresConfig(cfg Config) (embeddedpostgres.Config, error) {
+	pgCfg := embeddedpostgres.DefaultConfig().Version(PostgresVersion)
+
+	if cfg.BinariesDir != "" {
+		if _, err := os.Stat(filepath.Join(cfg.BinariesDir, "bin", "pg_ctl")); err != nil {
+			return pgCfg, fmt.Errorf("%w: no bin/pg_ctl in %s", ErrBinariesNotFound, cfg.BinariesDir)
+		}
+		return pgCfg.BinariesPath(cfg.BinariesDir), nil
+	}
+
+	cacheDir := cfg.CacheDir
+	if cacheDir == "" {
+		userCache, err := os.UserCacheDir()
+		if err != nil {
+			return pgCfg, fmt.Errorf("unable to get user cache directory: %w", err)
+		}
+		cacheDir = filepath.Join(userCache, "minder", "postgres")
+	}
+	if err := os.MkdirAll(cacheDir, 0750); err != nil {
+		return pgCfg, fmt.Errorf("unable to create cache directory: %w", err)
+	}
+	// The binaries are extracted next to the archive, and kept between
+	// starts
+	binariesDir := filepath.Join(cacheDir, string(PostgresVersion))
+	pgCfg = pgCfg.
+		CachePath(cacheDir).
+		BinariesPath(binariesDir)
+
+	if _, err := os.Stat(filepath.Join(binariesDir, "bin", "pg_ctl")); err == nil {
+		return pgCfg, nil
+	}
+	if cfg.Offline {
+		cached, err := filepath.Glob(filepath.Join(cacheDir,
+			fmt.Sprintf("embedded-postgres-binaries-*-%s.txz", PostgresVersion)))
+		if err != nil {
+			return pgCfg, fmt.Errorf("unable to search cache directory: %w", err)
+		}
+		if len(cached) == 0 {
+			return pgCfg, fmt.Errorf("%w: no PostgreSQL %s archive in %s, start once online or set the binaries directory",
+				ErrBinariesNotFound, PostgresVersion, cacheDir)
+		}
+	}
+
+	repositoryURL := cfg.RepositoryURL
+	if repositoryURL == "" {
+		repositoryURL = DefaultRepositoryURL
+	}
+	return pgCfg.BinaryRepositoryURL(repositoryURL), nil
+}
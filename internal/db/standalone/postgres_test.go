@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package standalone
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, dir string) Config
+		wantErr error
+	}{
+		{
+			name: "offline without cached binaries",
+			setup: func(_ *testing.T, dir string) Config {
+				return Config{CacheDir: dir, Offline: true}
+			},
+			wantErr: ErrBinariesNotFound,
+		},
+		{
+			name: "offline with a cached archive",
+			setup: func(t *testing.T, dir string) Config {
+				t.Helper()
+				archive := filepath.Join(dir, "embedded-postgres-binaries-linux-amd64-"+string(PostgresVersion)+".txz")
+				require.NoError(t, os.WriteFile(archive, nil, 0600))
+				return Config{CacheDir: dir, Offline: true}
+			},
+		},
+		{
+			name: "offline with extracted binaries",
+			setup: func(t *testing.T, dir string) Config {
+				t.Helper()
+				writePgCtl(t, filepath.Join(dir, string(PostgresVersion)))
+				return Config{CacheDir: dir, Offline: true}
+			},
+		},
+		{
+			name: "online without cached binaries",
+			setup: func(_ *testing.T, dir string) Config {
+				return Config{CacheDir: dir}
+			},
+		},
+		{
+			name: "local installation",
+			setup: func(t *testing.T, dir string) Config {
+				t.Helper()
+				writePgCtl(t, dir)
+				return Config{BinariesDir: dir, Offline: true}
+			},
+		},
+		{
+			name: "local installation without binaries",
+			setup: func(_ *testing.T, dir string) Config {
+				return Config{BinariesDir: dir}
+			},
+			wantErr: ErrBinariesNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := postgresConfig(tt.setup(t, t.TempDir()))
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestStart starts the database from the binaries in the default cache, it
+// runs only when MINDER_TEST_STANDALONE_POSTGRES is set since the binaries
+// are downloaded when they were never cached
+func TestStart(t *testing.T) {
+	t.Parallel()
+
+	if os.Getenv("MINDER_TEST_STANDALONE_POSTGRES") == "" {
+		t.Skip("set MINDER_TEST_STANDALONE_POSTGRES to run this test")
+	}
+
+	store, cancel, err := Start(Config{Offline: true})
+	if errors.Is(err, ErrBinariesNotFound) {
+		t.Skipf("postgres binaries are not cached: %v", err)
+	}
+	require.NoError(t, err)
+	t.Cleanup(cancel)
+
+	projects, err := store.ListAllRootProjects(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, projects)
+}
+
+func writePgCtl(t *testing.T, dir string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "bin"), 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bin", "pg_ctl"), nil, 0600))
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 )
 
 const createSubscription = `-- name: CreateSubscription :one
@@ -38,7 +39,7 @@ func (q *Queries) CreateSubscription(ctx context.Context, arg CreateSubscription
 }
 
 const getBundle = `-- name: GetBundle :one
-SELECT id, namespace, name FROM bundles WHERE namespace = $1 AND name = $2
+SELECT id, namespace, name, signature_verification FROM bundles WHERE namespace = $1 AND name = $2
 `
 
 type GetBundleParams struct {
@@ -49,7 +50,12 @@ type GetBundleParams struct {
 func (q *Queries) GetBundle(ctx context.Context, arg GetBundleParams) (Bundle, error) {
 	row := q.db.QueryRowContext(ctx, getBundle, arg.Namespace, arg.Name)
 	var i Bundle
-	err := row.Scan(&i.ID, &i.Namespace, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Namespace,
+		&i.Name,
+		&i.SignatureVerification,
+	)
 	return i, err
 }
 
@@ -77,6 +83,25 @@ func (q *Queries) GetSubscriptionByProjectBundle(ctx context.Context, arg GetSub
 	return i, err
 }
 
+const setBundleSignatureVerification = `-- name: SetBundleSignatureVerification :exec
+
+UPDATE bundles SET signature_verification = $1
+WHERE namespace = $2 AND name = $3
+`
+
+type SetBundleSignatureVerificationParams struct {
+	SignatureVerification pqtype.NullRawMessage `json:"signature_verification"`
+	Namespace             string                `json:"namespace"`
+	Name                  string                `json:"name"`
+}
+
+// SetBundleSignatureVerification records the result of the verification of
+// the signature of a bundle.
+func (q *Queries) SetBundleSignatureVerification(ctx context.Context, arg SetBundleSignatureVerificationParams) error {
+	_, err := q.db.ExecContext(ctx, setBundleSignatureVerification, arg.SignatureVerification, arg.Namespace, arg.Name)
+	return err
+}
+
 const setSubscriptionBundleVersion = `-- name: SetSubscriptionBundleVersion :exec
 UPDATE subscriptions SET current_version = $2 WHERE project_id = $1
 `
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// visibilityScope orders the visibilities from the narrowest to the widest
+var visibilityScope = map[Visibility]int{
+	VisibilityPrivate: 1,
+	VisibilitySubtree: 2,
+	VisibilityGlobal:  3,
+}
+
+// VisibleFrom reports whether a rule type or a data source with this
+// visibility, which belongs to the owner project, can be used from the
+// first project of the hierarchy. The hierarchy is expected in the order
+// returned by GetParentProjects, i.e. the project followed by its ancestors.
+func (v Visibility) VisibleFrom(owner uuid.UUID, hierarchy []uuid.UUID) bool {
+	if len(hierarchy) > 0 && hierarchy[0] == owner {
+		return true
+	}
+
+	switch v {
+	case VisibilityGlobal:
+		return true
+	case VisibilitySubtree:
+		return slices.Contains(hierarchy, owner)
+	default:
+		return false
+	}
+}
+
+// Narrows reports whether this visibility shares a rule type or a data
+// source with fewer projects than the other one.
+func (v Visibility) Narrows(other Visibility) bool {
+	scope, ok := visibilityScope[v]
+	if !ok {
+		return false
+	}
+	return scope < visibilityScope[other]
+}
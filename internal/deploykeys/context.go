@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploykeys
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/ssh"
+)
+
+// ErrDeployKeysUnavailable is returned when a deploy key is used outside of
+// the evaluation of a project
+var ErrDeployKeysUnavailable = errors.New("deploy keys are not available")
+
+type projectSignersKey struct{}
+
+type projectSigners struct {
+	resolver  SignerResolver
+	projectID uuid.UUID
+}
+
+// WithProjectSigners returns a context through which the ingesters evaluating
+// the rules of a project may use the deploy keys of the project, and only
+// those.
+func WithProjectSigners(ctx context.Context, resolver SignerResolver, projectID uuid.UUID) context.Context {
+	return context.WithValue(ctx, projectSignersKey{}, projectSigners{
+		resolver:  resolver,
+		projectID: projectID,
+	})
+}
+
+// SignerFromContext returns the signer of the deploy key with the given name
+// of the project being evaluated.
+func SignerFromContext(ctx context.Context, name string) (ssh.Signer, error) {
+	signers, ok := ctx.Value(projectSignersKey{}).(projectSigners)
+	if !ok || signers.resolver == nil {
+		return nil, ErrDeployKeysUnavailable
+	}
+	return signers.resolver.Signer(ctx, signers.projectID, name)
+}
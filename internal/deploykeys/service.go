@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deploykeys manages the SSH deploy keys of the projects. Minder
+// generates the keys and stores their private half encrypted, which never
+// leaves the server; users install the public half on their repositories, so
+// that git ingestions can clone them over SSH where token-based access over
+// HTTPS is not permitted.
+package deploykeys
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"database/sql"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+)
+
+var (
+	// ErrDeployKeyNotFound is returned when a referenced deploy key does not exist
+	ErrDeployKeyNotFound = errors.New("deploy key not found")
+	// ErrDeployKeyExists is returned when creating a deploy key whose name is taken
+	ErrDeployKeyExists = errors.New("deploy key already exists")
+	// ErrInvalidDeployKeyName is returned when a deploy key name is not valid
+	ErrInvalidDeployKeyName = errors.New("invalid deploy key name")
+)
+
+var deployKeyNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,62}$`)
+
+// SignerResolver returns the SSH signers of the deploy keys of the projects
+type SignerResolver interface {
+	// Signer returns the signer of the deploy key of the project with the given name.
+	Signer(ctx context.Context, projectID uuid.UUID, name string) (ssh.Signer, error)
+}
+
+// Service manages the deploy keys of the projects
+type Service interface {
+	SignerResolver
+
+	// CreateDeployKey generates a new deploy key in the project
+	CreateDeployKey(ctx context.Context, projectID uuid.UUID, name string) (*db.ProjectDeployKey, error)
+	// ListDeployKeys returns the deploy keys of the project. Private keys are never returned.
+	ListDeployKeys(ctx context.Context, projectID uuid.UUID) ([]db.ProjectDeployKey, error)
+	// DeleteDeployKey deletes a deploy key from the project
+	DeleteDeployKey(ctx context.Context, projectID uuid.UUID, name string) error
+}
+
+type deployKeyService struct {
+	store        db.ProjectDeployKeysStore
+	cryptoEngine crypto.Engine
+}
+
+// NewDeployKeyService creates a new deploy key service
+func NewDeployKeyService(store db.ProjectDeployKeysStore, cryptoEngine crypto.Engine) Service {
+	return &deployKeyService{
+		store:        store,
+		cryptoEngine: cryptoEngine,
+	}
+}
+
+// ValidateName checks that a deploy key name is valid
+func ValidateName(name string) error {
+	if !deployKeyNameRegex.MatchString(name) {
+		return fmt.Errorf("%w: %q", ErrInvalidDeployKeyName, name)
+	}
+	return nil
+}
+
+func (s *deployKeyService) CreateDeployKey(
+	ctx context.Context,
+	projectID uuid.UUID,
+	name string,
+) (*db.ProjectDeployKey, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("error generating deploy key: %w", err)
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding public key: %w", err)
+	}
+	// the comment tells users which key to remove from their repositories
+	comment := fmt.Sprintf("minder-%s-%s", projectID, name)
+	block, err := ssh.MarshalPrivateKey(priv, comment)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding private key: %w", err)
+	}
+
+	encrypted, err := s.cryptoEngine.EncryptString(string(pem.EncodeToMemory(block)))
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting private key: %w", err)
+	}
+	serialized, err := encrypted.Serialize()
+	if err != nil {
+		return nil, fmt.Errorf("error serializing private key: %w", err)
+	}
+
+	authorizedKey := strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(sshPub)), "\n")
+	key, err := s.store.CreateProjectDeployKey(ctx, db.CreateProjectDeployKeyParams{
+		ProjectID:           projectID,
+		Name:                name,
+		PublicKey:           authorizedKey + " " + comment,
+		Fingerprint:         ssh.FingerprintSHA256(sshPub),
+		EncryptedPrivateKey: serialized,
+	})
+	if err != nil {
+		if db.ErrIsUniqueViolation(err) {
+			return nil, fmt.Errorf("%w: %s", ErrDeployKeyExists, name)
+		}
+		return nil, fmt.Errorf("error storing deploy key: %w", err)
+	}
+	return &key, nil
+}
+
+func (s *deployKeyService) ListDeployKeys(ctx context.Context, projectID uuid.UUID) ([]db.ProjectDeployKey, error) {
+	keys, err := s.store.ListProjectDeployKeys(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing deploy keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (s *deployKeyService) DeleteDeployKey(ctx context.Context, projectID uuid.UUID, name string) error {
+	deleted, err := s.store.DeleteProjectDeployKey(ctx, db.DeleteProjectDeployKeyParams{
+		ProjectID: projectID,
+		Name:      name,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting deploy key: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: %s", ErrDeployKeyNotFound, name)
+	}
+	return nil
+}
+
+func (s *deployKeyService) Signer(ctx context.Context, projectID uuid.UUID, name string) (ssh.Signer, error) {
+	key, err := s.store.GetProjectDeployKeyByName(ctx, db.GetProjectDeployKeyByNameParams{
+		ProjectID: projectID,
+		Name:      name,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrDeployKeyNotFound, name)
+	} else if err != nil {
+		return nil, fmt.Errorf("error retrieving deploy key %s: %w", name, err)
+	}
+
+	encrypted, err := crypto.DeserializeEncryptedData(key.EncryptedPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing deploy key %s: %w", name, err)
+	}
+	privateKey, err := s.cryptoEngine.DecryptString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting deploy key %s: %w", name, err)
+	}
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing deploy key %s: %w", name, err)
+	}
+	return signer, nil
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploykeys
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/ssh"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestCreateDeployKeyAndSigner(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockProjectDeployKeysStore(ctrl)
+	svc := NewDeployKeyService(store, newTestEngine(t))
+	projectID := uuid.New()
+
+	var stored db.ProjectDeployKey
+	store.EXPECT().CreateProjectDeployKey(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.CreateProjectDeployKeyParams) (db.ProjectDeployKey, error) {
+			stored = db.ProjectDeployKey{
+				ProjectID:           arg.ProjectID,
+				Name:                arg.Name,
+				PublicKey:           arg.PublicKey,
+				Fingerprint:         arg.Fingerprint,
+				EncryptedPrivateKey: arg.EncryptedPrivateKey,
+			}
+			return stored, nil
+		})
+
+	key, err := svc.CreateDeployKey(context.Background(), projectID, "private-repos")
+	require.NoError(t, err)
+	require.Equal(t, projectID, key.ProjectID)
+	require.True(t, strings.HasPrefix(key.PublicKey, "ssh-ed25519 "))
+	require.True(t, strings.HasSuffix(key.PublicKey, " minder-"+projectID.String()+"-private-repos"))
+	require.NotContains(t, string(key.EncryptedPrivateKey), "PRIVATE KEY")
+
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key.PublicKey))
+	require.NoError(t, err)
+	require.Equal(t, ssh.FingerprintSHA256(pub), key.Fingerprint)
+
+	store.EXPECT().GetProjectDeployKeyByName(gomock.Any(), db.GetProjectDeployKeyByNameParams{
+		ProjectID: projectID,
+		Name:      "private-repos",
+	}).Return(stored, nil)
+
+	ctx := WithProjectSigners(context.Background(), svc, projectID)
+	signer, err := SignerFromContext(ctx, "private-repos")
+	require.NoError(t, err)
+	require.Equal(t, pub.Marshal(), signer.PublicKey().Marshal())
+}
+
+func TestCreateDeployKeyInvalidName(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	svc := NewDeployKeyService(mockdb.NewMockProjectDeployKeysStore(ctrl), newTestEngine(t))
+
+	_, err := svc.CreateDeployKey(context.Background(), uuid.New(), "not a name")
+	require.ErrorIs(t, err, ErrInvalidDeployKeyName)
+}
+
+func TestSignerNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockProjectDeployKeysStore(ctrl)
+	store.EXPECT().GetProjectDeployKeyByName(gomock.Any(), gomock.Any()).
+		Return(db.ProjectDeployKey{}, sql.ErrNoRows)
+	svc := NewDeployKeyService(store, newTestEngine(t))
+
+	_, err := svc.Signer(context.Background(), uuid.New(), "missing")
+	require.ErrorIs(t, err, ErrDeployKeyNotFound)
+
+	_, err = SignerFromContext(context.Background(), "missing")
+	require.ErrorIs(t, err, ErrDeployKeysUnavailable)
+}
+
+func newTestEngine(t *testing.T) crypto.Engine {
+	t.Helper()
+
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	keyPath := filepath.Join(t.TempDir(), "token_key")
+	require.NoError(t, os.WriteFile(keyPath, []byte(base64.StdEncoding.EncodeToString(key)), 0600))
+
+	engine, err := crypto.NewEngineFromConfig(&serverconfig.Config{
+		Auth: serverconfig.AuthConfig{TokenKey: keyPath},
+	})
+	require.NoError(t, err)
+	return engine
+}
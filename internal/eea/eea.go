@@ -199,6 +199,11 @@ func (e *EEA) FlushMessageHandler(msg *message.Message) error {
 
 	logger.Debug().Msg("re-publishing event because of flush")
 
+	// The flushed event stands for all the events queued while the entity
+	// was locked, which may have been triggered by other upstream events,
+	// so all the rules are evaluated.
+	inf.TriggerEvent = ""
+
 	// Now that we've flushed the event, let's try to publish it again
 	// which means, go through the locking process again.
 	if err := inf.Publish(e.evt); err != nil {
@@ -255,7 +260,7 @@ func (e *EEA) buildEntityWrapper(
 	case db.EntitiesPullRequest:
 		return e.buildPullRequestInfoWrapper(ctx, entityID, projID)
 	case db.EntitiesBuildEnvironment, db.EntitiesRelease,
-		db.EntitiesPipelineRun, db.EntitiesTaskRun, db.EntitiesBuild:
+		db.EntitiesPipelineRun, db.EntitiesTaskRun, db.EntitiesBuild, db.EntitiesOrganization:
 		return nil, fmt.Errorf("entity type %q not yet supported", entity)
 	default:
 		return nil, fmt.Errorf("unknown entity type: %q", entity)
@@ -6,10 +6,7 @@ package awsses
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
-	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/sesv2"
@@ -48,20 +45,7 @@ func New(ctx context.Context, sender, region string) (*awsSES, error) {
 
 // Register implements the Consumer interface.
 func (a *awsSES) Register(reg interfaces.Registrar) {
-	reg.Register(email.TopicQueueInviteEmail, func(msg *message.Message) error {
-		var e email.MailEventPayload
-
-		// Get the message context
-		msgCtx := msg.Context()
-
-		// Unmarshal the message payload
-		if err := json.Unmarshal(msg.Payload, &e); err != nil {
-			return fmt.Errorf("error unmarshalling invite email event: %w", err)
-		}
-
-		// Send the email
-		return a.sendEmail(msgCtx, e.Address, e.Subject, e.BodyHTML, e.BodyText)
-	})
+	email.RegisterHandlers(reg, a.sendEmail)
 }
 
 // SendEmail sends an email using AWS SES
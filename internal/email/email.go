@@ -21,6 +21,7 @@ import (
 
 	"github.com/mindersec/minder/internal/authz"
 	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
 )
 
 // ErrValidationFailed is returned when the template data source fails validation
@@ -34,6 +35,9 @@ func NewErrValidationFailed(fieldName, fieldValue string, err error) error {
 const (
 	// TopicQueueInviteEmail is the topic for sending invite emails
 	TopicQueueInviteEmail = "invite.email.event"
+	// TopicQueueOwnerNotificationEmail is the topic for sending the
+	// notifications of the alerts raised on an entity to its owner
+	TopicQueueOwnerNotificationEmail = "owner.notification.email.event"
 	// BodyMaxLength is the maximum length of the email body
 	BodyMaxLength = 10000
 	// MaxFieldLength is the maximum length of a string field
@@ -87,6 +91,30 @@ type MailEventPayload struct {
 	BodyText string `json:"body_text"`
 }
 
+// SendFunc sends an email
+type SendFunc func(ctx context.Context, to, subject, bodyHTML, bodyText string) error
+
+// RegisterHandlers registers a handler sending the emails of each email topic
+// with the given function
+func RegisterHandlers(reg interfaces.Registrar, send SendFunc) {
+	reg.Register(TopicQueueInviteEmail, newHandler("invite", send))
+	reg.Register(TopicQueueOwnerNotificationEmail, newHandler("owner notification", send))
+}
+
+func newHandler(kind string, send SendFunc) interfaces.Handler {
+	return func(msg *message.Message) error {
+		var e MailEventPayload
+
+		// Unmarshal the message payload
+		if err := json.Unmarshal(msg.Payload, &e); err != nil {
+			return fmt.Errorf("error unmarshalling %s email event: %w", kind, err)
+		}
+
+		// Send the email
+		return send(msg.Context(), e.Address, e.Subject, e.BodyHTML, e.BodyText)
+	}
+}
+
 type bodyData struct {
 	AdminName        string
 	OrganizationName string
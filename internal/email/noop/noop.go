@@ -5,10 +5,8 @@
 package noop
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
 
-	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/rs/zerolog"
 
 	"github.com/mindersec/minder/internal/email"
@@ -24,25 +22,17 @@ func New() *noop {
 }
 
 // Register implements the Consumer interface.
-func (*noop) Register(reg interfaces.Registrar) {
-	reg.Register(email.TopicQueueInviteEmail, func(msg *message.Message) error {
-		var e email.MailEventPayload
-
-		// Get the message context
-		msgCtx := msg.Context()
-
-		// Unmarshal the message payload
-		if err := json.Unmarshal(msg.Payload, &e); err != nil {
-			return fmt.Errorf("error unmarshalling invite email event: %w", err)
-		}
-
-		// Log the email
-		zerolog.Ctx(msgCtx).Info().
-			Str("email", e.Address).
-			Str("subject", e.Subject).
-			Str("body_text", e.BodyText).
-			Msg("Sending noop invite email")
-
-		return nil
-	})
+func (n *noop) Register(reg interfaces.Registrar) {
+	email.RegisterHandlers(reg, n.sendEmail)
+}
+
+// sendEmail logs the email instead of sending it
+func (*noop) sendEmail(ctx context.Context, to, subject, _, bodyText string) error {
+	zerolog.Ctx(ctx).Info().
+		Str("email", to).
+		Str("subject", subject).
+		Str("body_text", bodyText).
+		Msg("Sending noop email")
+
+	return nil
 }
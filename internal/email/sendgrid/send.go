@@ -6,11 +6,9 @@ package sendgrid
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
 
-	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/rs/zerolog"
 	"github.com/sendgrid/rest"
 	"github.com/sendgrid/sendgrid-go"
@@ -60,20 +58,7 @@ func New(cfg config.SendGrid) (*SendGrid, error) {
 
 // Register implements the Consumer interface.
 func (s *SendGrid) Register(reg interfaces.Registrar) {
-	reg.Register(email.TopicQueueInviteEmail, func(msg *message.Message) error {
-		var e email.MailEventPayload
-
-		// Get the message context
-		msgCtx := msg.Context()
-
-		// Unmarshal the message payload
-		if err := json.Unmarshal(msg.Payload, &e); err != nil {
-			return fmt.Errorf("error unmarshalling invite email event: %w", err)
-		}
-
-		// Send the email
-		return s.sendEmail(msgCtx, e.Address, e.Subject, e.BodyHTML, e.BodyText)
-	})
+	email.RegisterHandlers(reg, s.sendEmail)
 }
 
 // sendEmail sends an email using SendGrid
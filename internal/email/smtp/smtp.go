@@ -7,13 +7,11 @@ package smtp
 import (
 	"context"
 	"crypto/tls"
-	"encoding/json"
 	"fmt"
 	"net/mail"
 	"os"
 	"strings"
 
-	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/rs/zerolog"
 	gomail "github.com/wneessen/go-mail"
 
@@ -50,20 +48,7 @@ func New(cfg server.SMTP) (*SMTP, error) {
 
 // Register implements the Consumer interface.
 func (s *SMTP) Register(reg interfaces.Registrar) {
-	reg.Register(email.TopicQueueInviteEmail, func(msg *message.Message) error {
-		var e email.MailEventPayload
-
-		// Get the message context
-		msgCtx := msg.Context()
-
-		// Unmarshal the message payload
-		if err := json.Unmarshal(msg.Payload, &e); err != nil {
-			return fmt.Errorf("error unmarshalling invite email event: %w", err)
-		}
-
-		// Send the email
-		return s.sendEmail(msgCtx, e.Address, e.Subject, e.BodyHTML, e.BodyText)
-	})
+	email.RegisterHandlers(reg, s.sendEmail)
 }
 
 // sendEmail sends an email using SMTP via go-mail library
@@ -15,10 +15,14 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/actions/alert"
+	"github.com/mindersec/minder/internal/engine/actions/alert/routing"
 	"github.com/mindersec/minder/internal/engine/actions/remediate"
 	"github.com/mindersec/minder/internal/engine/actions/remediate/pull_request"
 	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/faults"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	enginerr "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
@@ -28,7 +32,38 @@ import (
 
 // RuleActionsEngine is the engine responsible for processing all actions i.e., remediation and alerts
 type RuleActionsEngine struct {
-	actions map[engif.ActionType]engif.Action
+	actions       map[engif.ActionType]engif.Action
+	ownerNotifier *routing.OwnerNotifier
+	faultInjector *faults.Injector
+	frozen        bool
+}
+
+// Option is a functional option for the rule actions engine
+type Option func(*RuleActionsEngine)
+
+// WithOwnerNotifier routes alerts that are turned on to the owner of the entity.
+// A nil notifier disables owner notifications.
+func WithOwnerNotifier(n *routing.OwnerNotifier) Option {
+	return func(rae *RuleActionsEngine) {
+		rae.ownerNotifier = n
+	}
+}
+
+// WithFaultInjector injects faults before executing the actions.
+// A nil injector disables fault injection.
+func WithFaultInjector(inj *faults.Injector) Option {
+	return func(rae *RuleActionsEngine) {
+		rae.faultInjector = inj
+	}
+}
+
+// WithFreeze defers the actions which would be executed, leaving the previous
+// action statuses intact, so that the actions are executed when the entity is
+// evaluated again after the freeze. Dry runs are not deferred.
+func WithFreeze(frozen bool) Option {
+	return func(rae *RuleActionsEngine) {
+		rae.frozen = frozen
+	}
 }
 
 // NewRuleActions creates a new rule actions engine
@@ -37,6 +72,7 @@ func NewRuleActions(
 	ruletype *minderv1.RuleType,
 	provider provinfv1.Provider,
 	actionConfig *models.ActionConfiguration,
+	opts ...Option,
 ) (*RuleActionsEngine, error) {
 	// Create the remediation engine
 	remEngine, err := remediate.NewRuleRemediator(ruletype, provider, actionConfig.Remediate)
@@ -50,12 +86,17 @@ func NewRuleActions(
 		return nil, fmt.Errorf("cannot create rule alerter: %w", err)
 	}
 
-	return &RuleActionsEngine{
+	rae := &RuleActionsEngine{
 		actions: map[engif.ActionType]engif.Action{
 			remEngine.Class():   remEngine,
 			alertEngine.Class(): alertEngine,
 		},
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(rae)
+	}
+
+	return rae, nil
 }
 
 // DoActions processes all actions i.e., remediation and alerts
@@ -106,22 +147,90 @@ func (rae *RuleActionsEngine) DoActions(
 	if !skipRemediate {
 		// Decide if we should remediate
 		cmd := shouldRemediate(prev, status)
-		// Run remediation
-		result.RemediateMeta, result.RemediateErr = rae.processAction(ctx, remediate.ActionType, cmd, ent, params,
-			getRemediationMeta(prev))
+		if rae.defers(remediate.ActionType, cmd) {
+			result.RemediateErr = deferredRemediationErr(params.GetEvalStatusFromDb())
+			result.RemediateMeta = deferredMeta(prev, result.RemediateMeta, getRemediationMeta)
+			result.Deferred = true
+		} else {
+			// Run remediation
+			result.RemediateMeta, result.RemediateErr = rae.processAction(ctx, remediate.ActionType, cmd, ent, params,
+				getRemediationMeta(prev))
+		}
 	}
 
 	// Try alerting
 	if !skipAlert {
 		// Decide if we should alert
 		cmd := shouldAlert(prev, status, result.RemediateErr, remediateEngine.Type())
-		// Run alerting
-		result.AlertMeta, result.AlertErr = rae.processAction(ctx, alert.ActionType, cmd, ent, params,
-			getAlertMeta(prev))
+		if rae.defers(alert.ActionType, cmd) {
+			result.AlertErr = deferredAlertErr(params.GetEvalStatusFromDb())
+			result.AlertMeta = deferredMeta(prev, result.AlertMeta, getAlertMeta)
+			result.Deferred = true
+		} else {
+			// Run alerting
+			result.AlertMeta, result.AlertErr = rae.processAction(ctx, alert.ActionType, cmd, ent, params,
+				getAlertMeta(prev))
+			// Route newly raised alerts to the team owning the entity. Alerts in
+			// dry run are not raised, so the owner isn't notified either.
+			if cmd == engif.ActionCmdOn && result.AlertErr == nil &&
+				rae.actions[alert.ActionType].GetOnOffState() == models.ActionOptOn {
+				rae.notifyOwner(ctx, ent, params)
+			}
+		}
 	}
 	return result
 }
 
+// defers returns whether the action command must be deferred because the
+// actions are frozen
+func (rae *RuleActionsEngine) defers(actionType engif.ActionType, cmd engif.ActionCmd) bool {
+	if !rae.frozen || cmd == engif.ActionCmdDoNothing {
+		return false
+	}
+	action, ok := rae.actions[actionType]
+	return ok && action.GetOnOffState() == models.ActionOptOn
+}
+
+// deferredRemediationErr returns the previous remediation status of a
+// deferred remediation
+func deferredRemediationErr(row *db.ListRuleEvaluationsByProfileIdRow) error {
+	return dbadapter.RemediationStatusAsError(row)
+}
+
+// deferredAlertErr returns the previous alert status of a deferred alert
+func deferredAlertErr(row *db.ListRuleEvaluationsByProfileIdRow) error {
+	if row == nil {
+		return enginerr.ErrActionSkipped
+	}
+	return dbadapter.AlertStatusAsError(row)
+}
+
+// deferredMeta returns the previous metadata of a deferred action, or the
+// default one if there is none
+func deferredMeta(
+	prev *previousEval, def json.RawMessage, get func(*previousEval) *json.RawMessage,
+) json.RawMessage {
+	if meta := get(prev); meta != nil && len(*meta) > 0 {
+		return *meta
+	}
+	return def
+}
+
+// notifyOwner sends a notification for a raised alert to the entity owner.
+// Failing to notify is logged, but does not fail the alert action itself.
+func (rae *RuleActionsEngine) notifyOwner(
+	ctx context.Context,
+	ent protoreflect.ProtoMessage,
+	params engif.ActionsParams,
+) {
+	if rae.ownerNotifier == nil {
+		return
+	}
+	if _, err := rae.ownerNotifier.Notify(ctx, ent, params); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error routing alert notification to owner")
+	}
+}
+
 // processAction runs the action engine for the given action type, and also sanity checks the result of the action
 func (rae *RuleActionsEngine) processAction(
 	ctx context.Context,
@@ -140,6 +249,9 @@ func (rae *RuleActionsEngine) processAction(
 		}
 	}()
 	zerolog.Ctx(ctx).Debug().Str("action", string(actionType)).Str("cmd", string(cmd)).Msg("invoking action")
+	if err := rae.faultInjector.Inject(ctx, string(actionType)); err != nil {
+		return nil, err
+	}
 	// Get action engine
 	action := rae.actions[actionType]
 	// Return the result of the action
@@ -160,9 +272,9 @@ func shouldRemediate(prevEval *previousEval, evalStatus EvalStatus) engif.Action
 
 	// Proceed with use cases where the evaluation changed
 	switch evalStatus {
-	case EvalStatusError, EvalStatusSuccess:
+	case EvalStatusError, EvalStatusSuccess, EvalStatusExcepted, EvalStatusSuppressed:
 		// Case 2 - Evaluation changed from something else to ERROR -> Remediation should be OFF
-		// Case 3 - Evaluation changed from something else to PASSING -> Remediation should be OFF
+		// Case 3 - Evaluation changed from something else to PASSING, EXCEPTED or SUPPRESSED -> Remediation should be OFF
 		// The Remediation should be OFF (if it wasn't already)
 		if RemediationStatusSkipped != prevRemediation {
 			return engif.ActionCmdOff
@@ -223,8 +335,8 @@ func shouldAlert(
 		}
 		// We should do nothing if alert was already turned on
 		return engif.ActionCmdDoNothing
-	case EvalStatusSuccess:
-		// Case 5 - Evaluation changed from something else to PASSING -> Alert should be OFF
+	case EvalStatusSuccess, EvalStatusExcepted, EvalStatusSuppressed:
+		// Case 5 - Evaluation changed from something else to PASSING, EXCEPTED or SUPPRESSED -> Alert should be OFF
 		// The Alert should be turned OFF (if it wasn't already)
 		if AlertStatusOff != prevAlert {
 			return engif.ActionCmdOff
@@ -323,6 +435,14 @@ func mapEvalStatus(err error) EvalStatus {
 		return EvalStatusSkipped
 	}
 
+	if errors.Is(err, enginerr.ErrEvaluationExcepted) {
+		return EvalStatusExcepted
+	}
+
+	if errors.Is(err, enginerr.ErrEvaluationSuppressed) {
+		return EvalStatusSuppressed
+	}
+
 	if errors.Is(err, interfaces.ErrEvaluationFailed) {
 		return EvalStatusFailure
 	}
@@ -4,14 +4,31 @@
 package actions
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
 
+	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/email"
+	"github.com/mindersec/minder/internal/engine/actions/alert"
+	"github.com/mindersec/minder/internal/engine/actions/alert/routing"
+	"github.com/mindersec/minder/internal/engine/actions/remediate"
 	"github.com/mindersec/minder/internal/engine/actions/remediate/pull_request"
 	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
 	enginerr "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	mockevents "github.com/mindersec/minder/pkg/eventer/interfaces/mock"
+	"github.com/mindersec/minder/pkg/profiles/models"
 )
 
 func TestShouldRemediate(t *testing.T) {
@@ -91,6 +108,21 @@ func TestShouldRemediate(t *testing.T) {
 			evalErr:    errors.New("random error"),
 			expected:   engif.ActionCmdOff,
 		},
+		{
+			// Stop remediating once the failure is excepted
+			name:       "eval excepted, prev pending -> off",
+			prevStatus: RemediationStatusPending,
+			hasPrev:    true,
+			evalErr:    enginerr.NewErrEvaluationExcepted("excepted"),
+			expected:   engif.ActionCmdOff,
+		},
+		{
+			name:       "eval suppressed, prev pending -> off",
+			prevStatus: RemediationStatusPending,
+			hasPrev:    true,
+			evalErr:    enginerr.NewErrEvaluationSuppressed("suppressed"),
+			expected:   engif.ActionCmdOff,
+		},
 		// Edge cases
 		{
 			name:       "eval skipped -> do nothing",
@@ -198,6 +230,25 @@ func TestShouldAlert(t *testing.T) {
 			remType:   "some-other-type",
 			expected:  engif.ActionCmdDoNothing,
 		},
+		// Excepted and suppressed failures close the alert
+		{
+			name:      "eval excepted, alert on -> off",
+			prevAlert: AlertStatusOn,
+			hasPrev:   true,
+			evalErr:   enginerr.NewErrEvaluationExcepted("excepted"),
+			remErr:    enginerr.ErrActionSkipped,
+			remType:   pull_request.RemediateType,
+			expected:  engif.ActionCmdOff,
+		},
+		{
+			name:      "eval suppressed, alert on -> off",
+			prevAlert: AlertStatusOn,
+			hasPrev:   true,
+			evalErr:   enginerr.NewErrEvaluationSuppressed("suppressed"),
+			remErr:    enginerr.ErrActionSkipped,
+			remType:   pull_request.RemediateType,
+			expected:  engif.ActionCmdOff,
+		},
 		// Expected errors
 		{
 			name:      "eval error, alert off -> on",
@@ -240,3 +291,160 @@ func TestShouldAlert(t *testing.T) {
 		})
 	}
 }
+
+// fakeAction records the commands it is asked to execute
+type fakeAction struct {
+	class engif.ActionType
+	state models.ActionOpt
+	cmds  []engif.ActionCmd
+}
+
+func (f *fakeAction) Class() engif.ActionType         { return f.class }
+func (*fakeAction) Type() string                      { return "fake" }
+func (f *fakeAction) GetOnOffState() models.ActionOpt { return f.state }
+func (f *fakeAction) Do(
+	_ context.Context, cmd engif.ActionCmd, _ protoreflect.ProtoMessage, _ engif.ActionsParams, _ *json.RawMessage,
+) (json.RawMessage, error) {
+	f.cmds = append(f.cmds, cmd)
+	return json.RawMessage(`{"done":true}`), nil
+}
+
+func TestDoActionsFrozen(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		state           models.ActionOpt
+		frozen          bool
+		prev            *db.ListRuleEvaluationsByProfileIdRow
+		wantCmds        int
+		wantDeferred    bool
+		wantRemediation db.RemediationStatusTypes
+		wantAlert       db.AlertStatusTypes
+	}{
+		{
+			name:            "not frozen",
+			state:           models.ActionOptOn,
+			wantCmds:        1,
+			wantRemediation: db.RemediationStatusTypesSuccess,
+			wantAlert:       db.AlertStatusTypesOn,
+		},
+		{
+			name:            "frozen without previous evaluation",
+			state:           models.ActionOptOn,
+			frozen:          true,
+			wantDeferred:    true,
+			wantRemediation: db.RemediationStatusTypesSkipped,
+			wantAlert:       db.AlertStatusTypesSkipped,
+		},
+		{
+			name:   "frozen keeps the previous statuses",
+			state:  models.ActionOptOn,
+			frozen: true,
+			prev: &db.ListRuleEvaluationsByProfileIdRow{
+				RemStatus:     db.RemediationStatusTypesSkipped,
+				RemMetadata:   json.RawMessage(`{"pr_number":"1"}`),
+				AlertStatus:   db.AlertStatusTypesOff,
+				AlertMetadata: json.RawMessage(`{"ghsa_id":"GHSA-1"}`),
+			},
+			wantDeferred:    true,
+			wantRemediation: db.RemediationStatusTypesSkipped,
+			wantAlert:       db.AlertStatusTypesOff,
+		},
+		{
+			name:            "dry runs are not deferred",
+			state:           models.ActionOptDryRun,
+			frozen:          true,
+			wantCmds:        1,
+			wantRemediation: db.RemediationStatusTypesSuccess,
+			wantAlert:       db.AlertStatusTypesOn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem := &fakeAction{class: remediate.ActionType, state: tt.state}
+			alrt := &fakeAction{class: alert.ActionType, state: tt.state}
+			rae := &RuleActionsEngine{
+				actions: map[engif.ActionType]engif.Action{
+					rem.Class():  rem,
+					alrt.Class(): alrt,
+				},
+			}
+			WithFreeze(tt.frozen)(rae)
+
+			params := &engif.EvalStatusParams{EvalStatusFromDb: tt.prev}
+			params.SetEvalErr(enginerr.NewErrEvaluationFailed("failed"))
+
+			result := rae.DoActions(context.Background(), nil, params)
+			assert.Len(t, rem.cmds, tt.wantCmds)
+			assert.Len(t, alrt.cmds, tt.wantCmds)
+			assert.Equal(t, tt.wantDeferred, result.Deferred)
+			assert.Equal(t, tt.wantRemediation, dbadapter.ErrorAsRemediationStatus(result.RemediateErr))
+			assert.Equal(t, tt.wantAlert, dbadapter.ErrorAsAlertStatus(result.AlertErr))
+			if tt.prev != nil {
+				assert.Equal(t, tt.prev.RemMetadata, result.RemediateMeta)
+				assert.Equal(t, tt.prev.AlertMetadata, result.AlertMeta)
+			}
+		})
+	}
+}
+
+func TestDoActionsNotifiesOwner(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		state      models.ActionOpt
+		wantNotify bool
+	}{
+		{
+			name:       "alert turned on",
+			state:      models.ActionOptOn,
+			wantNotify: true,
+		},
+		{
+			name:  "alert in dry run",
+			state: models.ActionOptDryRun,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			pub := mockevents.NewMockPublisher(ctrl)
+			if tt.wantNotify {
+				pub.EXPECT().Publish(email.TopicQueueOwnerNotificationEmail, gomock.Any()).Return(nil)
+			}
+
+			rem := &fakeAction{class: remediate.ActionType, state: models.ActionOptOff}
+			alrt := &fakeAction{class: alert.ActionType, state: tt.state}
+			rae := &RuleActionsEngine{
+				actions: map[engif.ActionType]engif.Action{
+					rem.Class():  rem,
+					alrt.Class(): alrt,
+				},
+			}
+			WithOwnerNotifier(routing.NewOwnerNotifier(pub, &serverconfig.AlertRoutingConfig{}))(rae)
+
+			props, err := structpb.NewStruct(map[string]any{
+				properties.PropertyName:         "foo/bar",
+				properties.PropertyOwnerContact: "payments@example.com",
+			})
+			require.NoError(t, err)
+
+			params := &engif.EvalStatusParams{
+				Rule:    &models.RuleInstance{Name: "branch_protection"},
+				Profile: &models.ProfileAggregate{Name: "baseline"},
+			}
+			params.SetEvalErr(enginerr.NewErrEvaluationFailed("failed"))
+
+			rae.DoActions(context.Background(), &pb.Repository{Properties: props}, params)
+			assert.Len(t, alrt.cmds, 1)
+		})
+	}
+}
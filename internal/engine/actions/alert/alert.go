@@ -11,6 +11,7 @@ import (
 
 	"github.com/rs/zerolog"
 
+	"github.com/mindersec/minder/internal/engine/actions/alert/code_scanning"
 	"github.com/mindersec/minder/internal/engine/actions/alert/noop"
 	"github.com/mindersec/minder/internal/engine/actions/alert/pull_request_comment"
 	"github.com/mindersec/minder/internal/engine/actions/alert/security_advisory"
@@ -61,6 +62,15 @@ func NewRuleAlert(
 		}
 		return pull_request_comment.NewPullRequestCommentAlert(
 			ActionType, alertCfg.GetPullRequestComment(), client, setting)
+	case code_scanning.AlertType:
+		client, err := provinfv1.As[provinfv1.CodeScanningUploader](provider)
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Str("rule-type", ruletype.GetName()).
+				Msg("provider does not support uploading code scanning results. Silently skipping alerts.")
+			return noop.NewNoopAlert(ActionType)
+		}
+		return code_scanning.NewCodeScanningAlert(
+			ActionType, ruletype, alertCfg.GetCodeScanning(), client, setting)
 	}
 
 	return nil, fmt.Errorf("unknown alert type: %s", alertCfg.GetType())
@@ -0,0 +1,355 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package code_scanning provides necessary interfaces and implementations for
+// creating alerts of type code scanning, which upload the findings of the
+// failed evaluations to the code scanning API of the repository.
+package code_scanning
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/db"
+	actionmeta "github.com/mindersec/minder/internal/engine/actions/metadata"
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	enginerr "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// AlertType is the type of the code scanning alert engine
+	AlertType = "code_scanning"
+
+	// categoryPrefix prefixes the categories of the analyses uploaded by
+	// Minder, which are followed by the profile and the rule names
+	categoryPrefix = "minder"
+)
+
+// levels are the SARIF levels of the findings of the rule type severities
+var levels = map[string]string{
+	"critical": "error",
+	"high":     "error",
+	"medium":   "warning",
+	"low":      "note",
+	"info":     "note",
+	"unknown":  "note",
+}
+
+// securitySeverities are the scores GitHub maps to the same security
+// severities as the rule type ones
+var securitySeverities = map[string]string{
+	"critical": "9.5",
+	"high":     "8.0",
+	"medium":   "5.5",
+	"low":      "2.0",
+}
+
+// Alert is the structure backing the code scanning alert action
+type Alert struct {
+	actionType interfaces.ActionType
+	cli        provifv1.CodeScanningUploader
+	ruleType   *pb.RuleType
+	csCfg      *pb.RuleType_Definition_Alert_AlertTypeCodeScanning
+	setting    models.ActionOpt
+}
+
+type paramsCS struct {
+	Owner     string
+	Repo      string
+	Ref       string
+	CommitSHA string
+	Category  string
+	Findings  []finding
+	Metadata  *alertMetadata
+
+	// branch is resolved to the commit lazily, since turning the alert off
+	// doesn't need it if nothing was uploaded
+	branch     string
+	prevStatus *db.ListRuleEvaluationsByProfileIdRow
+}
+
+type alertMetadata = actionmeta.CodeScanningAlert
+
+// NewCodeScanningAlert creates a new code scanning alert action
+func NewCodeScanningAlert(
+	actionType interfaces.ActionType,
+	ruleType *pb.RuleType,
+	csCfg *pb.RuleType_Definition_Alert_AlertTypeCodeScanning,
+	cli provifv1.CodeScanningUploader,
+	setting models.ActionOpt,
+) (*Alert, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	return &Alert{
+		actionType: actionType,
+		cli:        cli,
+		ruleType:   ruleType,
+		csCfg:      csCfg,
+		setting:    setting,
+	}, nil
+}
+
+// Class returns the action type of the code scanning alert engine
+func (alert *Alert) Class() interfaces.ActionType {
+	return alert.actionType
+}
+
+// Type returns the action subtype of the code scanning alert engine
+func (*Alert) Type() string {
+	return AlertType
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (alert *Alert) GetOnOffState() models.ActionOpt {
+	return models.ActionOptOrDefault(alert.setting, models.ActionOptOff)
+}
+
+// Do uploads the findings of the evaluation to the code scanning API
+func (alert *Alert) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	repo, ok := entity.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("expected repository, got %T", entity)
+	}
+
+	csParams := alert.getParamsForCodeScanning(ctx, repo, params, metadata)
+
+	// Process the command based on the action setting
+	switch alert.setting {
+	case models.ActionOptOn:
+		return alert.run(ctx, csParams, cmd)
+	case models.ActionOptDryRun:
+		return alert.runDry(ctx, csParams, cmd)
+	case models.ActionOptOff, models.ActionOptUnknown:
+		return nil, fmt.Errorf("unexpected action setting: %w", enginerr.ErrActionFailed)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// run runs the code scanning action. The analyses of a rule share a
+// category, so that each upload replaces the previous one: GitHub updates the
+// alerts of the findings uploaded again, matched by their fingerprints, and
+// closes those of the findings left out. Turning the alert off uploads an
+// analysis without findings, which closes all of them.
+func (alert *Alert) run(ctx context.Context, params *paramsCS, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("owner", params.Owner).
+		Str("repo", params.Repo).
+		Str("category", params.Category).
+		Logger()
+
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		meta, err := alert.upload(ctx, params, params.Findings)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info().Str("sarif_id", meta.SarifID).Int("findings", len(params.Findings)).
+			Msg("code scanning analysis uploaded")
+		return json.Marshal(meta)
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.SarifID == "" {
+			// Nothing was uploaded, so there is no alert to close
+			return nil, fmt.Errorf("no code scanning analysis uploaded: %w", enginerr.ErrActionTurnedOff)
+		}
+		meta, err := alert.upload(ctx, params, nil)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info().Str("sarif_id", meta.SarifID).Msg("code scanning alerts closed")
+		return nil, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	case interfaces.ActionCmdDoNothing:
+		// Return the previous alert status.
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// runDry runs the code scanning action in dry run mode, which logs the
+// analysis that would be uploaded
+func (alert *Alert) runDry(ctx context.Context, params *paramsCS, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		log := alert.buildSarif(params, params.Findings)
+		body, err := json.Marshal(log)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling SARIF: %w", err)
+		}
+		logger.Info().Msgf("dry run: upload the following code scanning analysis to repo %s/%s: %s",
+			params.Owner, params.Repo, body)
+		return nil, nil
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.SarifID == "" {
+			return nil, fmt.Errorf("no code scanning analysis uploaded: %w", enginerr.ErrActionTurnedOff)
+		}
+		logger.Info().Msgf("dry run: upload an empty code scanning analysis of category %s to repo %s/%s",
+			params.Category, params.Owner, params.Repo)
+	case interfaces.ActionCmdDoNothing:
+		// Return the previous alert status.
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// upload uploads an analysis of the commit with the given findings
+func (alert *Alert) upload(ctx context.Context, params *paramsCS, findings []finding) (*alertMetadata, error) {
+	if params.CommitSHA == "" {
+		sha, err := alert.cli.GetBranchHead(ctx, params.Owner, params.Repo, params.branch)
+		if err != nil {
+			return nil, fmt.Errorf("error getting the commit to upload the analysis for: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		params.CommitSHA = sha
+	}
+
+	sarif, err := encodeSarif(alert.buildSarif(params, findings))
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := alert.cli.UploadSarif(ctx, params.Owner, params.Repo, &github.SarifAnalysis{
+		CommitSHA: github.String(params.CommitSHA),
+		Ref:       github.String(params.Ref),
+		Sarif:     github.String(sarif),
+		StartedAt: &github.Timestamp{Time: time.Now()},
+		ToolName:  github.String(toolName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error uploading code scanning analysis: %w, %w", err, enginerr.ErrActionFailed)
+	}
+
+	return &alertMetadata{
+		SarifID:   id.GetID(),
+		Category:  params.Category,
+		Ref:       params.Ref,
+		CommitSHA: params.CommitSHA,
+	}, nil
+}
+
+func (alert *Alert) buildSarif(params *paramsCS, findings []finding) *sarifLog {
+	severity := alert.ruleType.GetSeverity().GetValue().Enum().AsString()
+	level := cmp.Or(alert.csCfg.GetLevel(), levels[severity], "warning")
+	ruleID := alert.ruleType.GetName()
+
+	results := make([]sarifResult, 0, len(findings))
+	for _, f := range findings {
+		results = append(results, f.toResult(ruleID, level))
+	}
+
+	return &sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{{
+			Tool: sarifTool{
+				Driver: sarifDriver{
+					Name:           toolName,
+					InformationURI: toolURI,
+					Rules: []sarifRule{{
+						ID:               ruleID,
+						Name:             ruleID,
+						ShortDescription: sarifMessage{Text: cmp.Or(alert.ruleType.GetShortFailureMessage(), ruleID)},
+						FullDescription:  sarifMessage{Text: cmp.Or(alert.ruleType.GetDescription(), ruleID)},
+						Help: sarifMessage{
+							Text:     alert.ruleType.GetGuidance(),
+							Markdown: alert.ruleType.GetGuidance(),
+						},
+						DefaultConfiguration: sarifRuleConfig{Level: level},
+						Properties: sarifRuleProperties{
+							Tags:             []string{"security", categoryPrefix},
+							SecuritySeverity: securitySeverities[severity],
+						},
+					}},
+				},
+			},
+			// the trailing slash makes the whole ID the category
+			AutomationDetails: sarifAutomationDetails{ID: params.Category + "/"},
+			Results:           results,
+		}},
+	}
+}
+
+// getParamsForCodeScanning extracts the details from the entity. The
+// analysis is uploaded for the commit the rule was evaluated on, if the
+// ingester reports it, or else for the head of the default branch.
+func (alert *Alert) getParamsForCodeScanning(
+	ctx context.Context,
+	repo *pb.Repository,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) *paramsCS {
+	logger := zerolog.Ctx(ctx)
+	result := &paramsCS{
+		Owner:      repo.GetOwner(),
+		Repo:       repo.GetName(),
+		Category:   fmt.Sprintf("%s/%s/%s", categoryPrefix, params.GetProfile().Name, params.GetRule().Name),
+		prevStatus: params.GetEvalStatusFromDb(),
+	}
+
+	checkpoint := params.GetIngestResult().GetCheckpoint()
+	result.branch = cmp.Or(checkpoint.GetBranch(), repo.GetDefaultBranch(), "main")
+	result.Ref = "refs/heads/" + result.branch
+	if checkpoint.GetBranch() != "" {
+		result.CommitSHA = checkpoint.GetCommitHash()
+	}
+
+	message := cmp.Or(
+		dbadapter.ErrorAsEvalDetails(params.GetEvalErr()),
+		alert.ruleType.GetShortFailureMessage(),
+		fmt.Sprintf("rule %s failed", params.GetRule().Name),
+	)
+	if params.GetEvalResult() != nil {
+		result.Findings = findingsFromOutput(params.GetEvalResult().Output, message)
+	}
+	if len(result.Findings) == 0 {
+		result.Findings = []finding{{Path: noFileLocation, Message: message}}
+	}
+
+	// Unmarshal the existing alert metadata, if any
+	if metadata != nil {
+		meta := &alertMetadata{}
+		err := json.Unmarshal(*metadata, meta)
+		if err != nil {
+			// There's nothing saved apparently, so no need to fail here, but do log the error
+			logger.Debug().Msgf("error unmarshalling alert metadata: %v", err)
+		} else {
+			result.Metadata = meta
+		}
+	}
+
+	return result
+}
+
+// runDoNothing returns the previous alert status
+func (*Alert) runDoNothing(ctx context.Context, params *paramsCS) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx).With().Str("repo", params.Repo).Logger()
+
+	logger.Debug().Msg("Running do nothing")
+
+	// Return the previous alert status.
+	err := dbadapter.AlertStatusAsError(params.prevStatus)
+	// If there is a valid alert metadata, return it too
+	if params.prevStatus != nil {
+		return params.prevStatus.AlertMetadata, err
+	}
+	// If there is no alert metadata, return nil as the metadata and the error
+	return nil, err
+}
@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package code_scanning
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	github "github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/db"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	enginerr "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	mock_provifv1 "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+var TestActionTypeValid engif.ActionType = "alert-test"
+
+const (
+	evaluationFailureDetails = "evaluation failure reason"
+	sarifID                  = "47177e22-5596-11eb-80a1-c1e54ef945c6"
+	commitSHA                = "6dcb09b5b57875f334f61aebed695e2e4193db5e"
+)
+
+func TestCodeScanningAlert(t *testing.T) {
+	t.Parallel()
+
+	uploadedMetadata := json.RawMessage(fmt.Sprintf(`{"sarif_id":"%s","category":"minder/profile/test-rule"}`, sarifID))
+
+	tests := []struct {
+		name          string
+		cmd           engif.ActionCmd
+		output        any
+		checkpoint    bool
+		inputMetadata *json.RawMessage
+		mockSetup     func(*testing.T, *mock_provifv1.MockCodeScanningUploader)
+		expectedErr   error
+		expectMeta    bool
+	}{
+		{
+			name:       "upload the findings of the output",
+			cmd:        engif.ActionCmdOn,
+			checkpoint: true,
+			output: []any{
+				map[string]any{"path": "Dockerfile", "line": float64(3), "message": "base image not pinned"},
+				map[string]any{"file": "deploy/api.yaml"},
+				map[string]any{"message": "no path"},
+			},
+			mockSetup: func(t *testing.T, cli *mock_provifv1.MockCodeScanningUploader) {
+				t.Helper()
+				cli.EXPECT().
+					UploadSarif(gomock.Any(), "owner", "repo", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, analysis *github.SarifAnalysis) (*github.SarifID, error) {
+						require.Equal(t, commitSHA, analysis.GetCommitSHA())
+						require.Equal(t, "refs/heads/release", analysis.GetRef())
+
+						log := decodeSarif(t, analysis.GetSarif())
+						run := log.Runs[0]
+						require.Equal(t, "minder/profile/test-rule/", run.AutomationDetails.ID)
+						require.Equal(t, "error", run.Tool.Driver.Rules[0].DefaultConfiguration.Level)
+						require.Equal(t, "8.0", run.Tool.Driver.Rules[0].Properties.SecuritySeverity)
+						require.Len(t, run.Results, 2)
+						require.Equal(t, "base image not pinned", run.Results[0].Message.Text)
+						require.Equal(t, 3, run.Results[0].Locations[0].PhysicalLocation.Region.StartLine)
+						require.Equal(t, "deploy/api.yaml", run.Results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+						require.Nil(t, run.Results[1].Locations[0].PhysicalLocation.Region)
+						require.Equal(t, evaluationFailureDetails, run.Results[1].Message.Text)
+						require.NotEmpty(t, run.Results[0].PartialFingerprints[fingerprintKey])
+						return &github.SarifID{ID: github.String(sarifID)}, nil
+					})
+			},
+			expectMeta: true,
+		},
+		{
+			name: "upload a finding without file for the head of the default branch",
+			cmd:  engif.ActionCmdOn,
+			mockSetup: func(t *testing.T, cli *mock_provifv1.MockCodeScanningUploader) {
+				t.Helper()
+				cli.EXPECT().
+					GetBranchHead(gomock.Any(), "owner", "repo", "main").
+					Return(commitSHA, nil)
+				cli.EXPECT().
+					UploadSarif(gomock.Any(), "owner", "repo", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, analysis *github.SarifAnalysis) (*github.SarifID, error) {
+						require.Equal(t, commitSHA, analysis.GetCommitSHA())
+						require.Equal(t, "refs/heads/main", analysis.GetRef())
+
+						results := decodeSarif(t, analysis.GetSarif()).Runs[0].Results
+						require.Len(t, results, 1)
+						require.Equal(t, noFileLocation, results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+						require.Equal(t, evaluationFailureDetails, results[0].Message.Text)
+						return &github.SarifID{ID: github.String(sarifID)}, nil
+					})
+			},
+			expectMeta: true,
+		},
+		{
+			name:       "error from provider uploading the analysis",
+			cmd:        engif.ActionCmdOn,
+			checkpoint: true,
+			mockSetup: func(_ *testing.T, cli *mock_provifv1.MockCodeScanningUploader) {
+				cli.EXPECT().
+					UploadSarif(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("failed to upload"))
+			},
+			expectedErr: enginerr.ErrActionFailed,
+		},
+		{
+			name:          "close the alerts with an empty analysis",
+			cmd:           engif.ActionCmdOff,
+			checkpoint:    true,
+			inputMetadata: &uploadedMetadata,
+			mockSetup: func(t *testing.T, cli *mock_provifv1.MockCodeScanningUploader) {
+				t.Helper()
+				cli.EXPECT().
+					UploadSarif(gomock.Any(), "owner", "repo", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, analysis *github.SarifAnalysis) (*github.SarifID, error) {
+						run := decodeSarif(t, analysis.GetSarif()).Runs[0]
+						require.Equal(t, "minder/profile/test-rule/", run.AutomationDetails.ID)
+						require.Empty(t, run.Results)
+						return &github.SarifID{ID: github.String(sarifID)}, nil
+					})
+			},
+			expectedErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:        "nothing to close without a previous upload",
+			cmd:         engif.ActionCmdOff,
+			mockSetup:   func(_ *testing.T, _ *mock_provifv1.MockCodeScanningUploader) {},
+			expectedErr: enginerr.ErrActionTurnedOff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockClient := mock_provifv1.NewMockCodeScanningUploader(ctrl)
+			tt.mockSetup(t, mockClient)
+
+			ruleType := &pb.RuleType{
+				Name:                "test-rule",
+				ShortFailureMessage: "test rule failed",
+				Severity:            &pb.Severity{Value: pb.Severity_VALUE_HIGH},
+			}
+			csAlert, err := NewCodeScanningAlert(TestActionTypeValid, ruleType, nil, mockClient, models.ActionOptOn)
+			require.NoError(t, err)
+
+			evalParams := &engif.EvalStatusParams{
+				EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{},
+				Profile:          &models.ProfileAggregate{Name: "profile"},
+				Rule:             &models.RuleInstance{Name: "test-rule"},
+			}
+			evalParams.SetEvalErr(enginerr.NewErrEvaluationFailed(evaluationFailureDetails))
+			evalParams.SetEvalResult(&interfaces.EvaluationResult{Output: tt.output})
+			if tt.checkpoint {
+				evalParams.SetIngestResult(&interfaces.Ingested{
+					Checkpoint: checkpoints.NewCheckpointV1Now().WithBranch("release").WithCommitHash(commitSHA),
+				})
+			}
+
+			retMeta, err := csAlert.Do(
+				context.Background(),
+				tt.cmd,
+				&pb.Repository{Owner: "owner", Name: "repo", DefaultBranch: "main"},
+				evalParams,
+				tt.inputMetadata,
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if !tt.expectMeta {
+				require.Nil(t, retMeta)
+				return
+			}
+
+			meta := &alertMetadata{}
+			require.NoError(t, json.Unmarshal(retMeta, meta))
+			require.Equal(t, sarifID, meta.SarifID)
+			require.Equal(t, "minder/profile/test-rule", meta.Category)
+			require.Equal(t, commitSHA, meta.CommitSHA)
+		})
+	}
+}
+
+func TestCodeScanningAlertNotRepository(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	csAlert, err := NewCodeScanningAlert(
+		TestActionTypeValid, &pb.RuleType{Name: "test-rule"}, nil,
+		mock_provifv1.NewMockCodeScanningUploader(ctrl), models.ActionOptOn)
+	require.NoError(t, err)
+
+	_, err = csAlert.Do(context.Background(), engif.ActionCmdOn, &pbinternal.PullRequest{}, &engif.EvalStatusParams{}, nil)
+	require.Error(t, err)
+}
+
+func decodeSarif(t *testing.T, encoded string) *sarifLog {
+	t.Helper()
+
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+
+	log := &sarifLog{}
+	require.NoError(t, json.NewDecoder(zr).Decode(log))
+	require.Equal(t, sarifVersion, log.Version)
+	require.Len(t, log.Runs, 1)
+	return log
+}
@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package code_scanning
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	toolName     = "Minder"
+	toolURI      = "https://github.com/mindersec/minder"
+
+	// fingerprintKey is the key of the fingerprints identifying the findings
+	// across the uploads, which GitHub uses to match them to the open alerts
+	fingerprintKey = "minderFinding/v1"
+	// noFileLocation is the location of the findings which aren't about a
+	// file, since code scanning requires findings to have one
+	noFileLocation = "no file associated with this finding"
+
+	// maxFindings is the maximum number of findings uploaded for an
+	// evaluation
+	maxFindings = 1000
+)
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool              sarifTool              `json:"tool"`
+	AutomationDetails sarifAutomationDetails `json:"automationDetails"`
+	Results           []sarifResult          `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string              `json:"id"`
+	Name                 string              `json:"name"`
+	ShortDescription     sarifMessage        `json:"shortDescription"`
+	FullDescription      sarifMessage        `json:"fullDescription"`
+	Help                 sarifMessage        `json:"help"`
+	DefaultConfiguration sarifRuleConfig     `json:"defaultConfiguration"`
+	Properties           sarifRuleProperties `json:"properties"`
+}
+
+type sarifRuleConfig struct {
+	Level string `json:"level"`
+}
+
+type sarifRuleProperties struct {
+	Tags []string `json:"tags"`
+	// SecuritySeverity is the score GitHub derives the security severity
+	// of the alerts from
+	SecuritySeverity string `json:"security-severity,omitempty"`
+}
+
+type sarifAutomationDetails struct {
+	// ID is the category of the analysis, followed by a slash
+	ID string `json:"id"`
+}
+
+type sarifMessage struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID              string            `json:"ruleId"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations"`
+	PartialFingerprints map[string]string `json:"partialFingerprints"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// finding is a finding of a failed evaluation, which is uploaded as a
+// code scanning result
+type finding struct {
+	Path    string
+	Line    int
+	Message string
+}
+
+// findingsFromOutput returns the findings listed in the output of an
+// evaluation, which are the objects with a "path" (or "file"), and optionally
+// a "line" and a "message" (or "msg"). Findings without a message have the
+// given default one.
+func findingsFromOutput(output any, defaultMessage string) []finding {
+	list, ok := output.([]any)
+	if !ok {
+		return nil
+	}
+
+	var out []finding
+	for _, item := range list {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		f := finding{
+			Path:    firstString(obj, "path", "file"),
+			Line:    toInt(obj["line"]),
+			Message: firstString(obj, "message", "msg"),
+		}
+		if f.Path == "" {
+			continue
+		}
+		if f.Message == "" {
+			f.Message = defaultMessage
+		}
+		out = append(out, f)
+		if len(out) == maxFindings {
+			break
+		}
+	}
+	return out
+}
+
+func firstString(obj map[string]any, keys ...string) string {
+	for _, k := range keys {
+		if s, ok := obj[k].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+func toInt(v any) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case int64:
+		return int(n)
+	case float64:
+		return int(n)
+	case json.Number:
+		i, _ := n.Int64()
+		return int(i)
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	}
+	return 0
+}
+
+func (f *finding) toResult(ruleID, level string) sarifResult {
+	loc := sarifPhysicalLocation{
+		ArtifactLocation: sarifArtifactLocation{URI: f.Path},
+	}
+	if f.Line > 0 {
+		loc.Region = &sarifRegion{StartLine: f.Line}
+	}
+
+	// the line is left out of the fingerprint, so that a finding moving
+	// around in its file doesn't open a new alert
+	sum := sha256.Sum256([]byte(ruleID + "\x00" + f.Path + "\x00" + f.Message))
+
+	return sarifResult{
+		RuleID:    ruleID,
+		Level:     level,
+		Message:   sarifMessage{Text: f.Message},
+		Locations: []sarifLocation{{PhysicalLocation: loc}},
+		PartialFingerprints: map[string]string{
+			fingerprintKey: hex.EncodeToString(sum[:]),
+		},
+	}
+}
+
+// encodeSarif encodes the SARIF log the way the upload API expects it,
+// gzipped and base64-encoded
+func encodeSarif(log *sarifLog) (string, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(log); err != nil {
+		return "", fmt.Errorf("error encoding SARIF: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return "", fmt.Errorf("error compressing SARIF: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
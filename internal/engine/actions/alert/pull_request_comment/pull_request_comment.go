@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	htmltemplate "html/template"
 	"math"
 	"strconv"
 	"strings"
@@ -22,6 +23,8 @@ import (
 
 	dbadapter "github.com/mindersec/minder/internal/adapters/db"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
+	actionmeta "github.com/mindersec/minder/internal/engine/actions/metadata"
 	"github.com/mindersec/minder/internal/engine/interfaces"
 	pbinternal "github.com/mindersec/minder/internal/proto"
 	"github.com/mindersec/minder/internal/util"
@@ -68,11 +71,7 @@ type paramsPR struct {
 	prevStatus *db.ListRuleEvaluationsByProfileIdRow
 }
 
-type alertMetadata struct {
-	ReviewID       string     `json:"review_id,omitempty"`
-	SubmittedAt    *time.Time `json:"submitted_at,omitempty"`
-	PullRequestUrl *string    `json:"pull_request_url,omitempty"`
-}
+type alertMetadata = actionmeta.PullRequestCommentAlert
 
 // NewPullRequestCommentAlert creates a new pull request comment alert action
 func NewPullRequestCommentAlert(
@@ -276,6 +275,35 @@ func (*Alert) runDoNothing(ctx context.Context, params *paramsPR) (json.RawMessa
 	return nil, err
 }
 
+// applyProjectTemplate renders the review with the alert templates of the
+// project, if it has some, including the rendered review message
+func (alert *Alert) applyProjectTemplate(
+	ctx context.Context,
+	pr *pbinternal.PullRequest,
+	params interfaces.ActionsParams,
+	message string,
+) (string, error) {
+	tmpls, err := templates.FromContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error getting the alert templates of the project: %w", err)
+	}
+	if tmpls == nil || tmpls.PullRequestComment == nil {
+		return message, nil
+	}
+
+	comment, err := tmpls.PullRequestComment.Render(ctx, templates.PullRequestCommentData{
+		Profile:    params.GetProfile().Name,
+		Name:       params.GetRule().Name,
+		Repository: fmt.Sprintf("%s/%s", pr.GetRepoOwner(), pr.GetRepoName()),
+		// nolint:gosec // the message is rendered by an HTML template
+		Message: htmltemplate.HTML(message),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error rendering the pull request comment template of the project: %w", err)
+	}
+	return comment, nil
+}
+
 // getParamsForPRComment extracts the details from the entity
 func (alert *Alert) getParamsForPRComment(
 	ctx context.Context,
@@ -318,6 +346,11 @@ func (alert *Alert) getParamsForPRComment(
 
 	result.RuleName = params.GetRule().Name
 
+	comment, err = alert.applyProjectTemplate(ctx, pr, params, comment)
+	if err != nil {
+		return nil, err
+	}
+
 	action := cmp.Or(alert.reviewCfg.GetAction(), "comment")
 	if strings.ToLower(action) == "request_changes" {
 		result.Event = "REQUEST_CHANGES"
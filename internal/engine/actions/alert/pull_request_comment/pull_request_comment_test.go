@@ -11,10 +11,12 @@ import (
 	"testing"
 
 	github "github.com/google/go-github/v63/github"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
 	engif "github.com/mindersec/minder/internal/engine/interfaces"
 	pbinternal "github.com/mindersec/minder/internal/proto"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -43,6 +45,7 @@ func TestPullRequestCommentAlert(t *testing.T) {
 		actionType    engif.ActionType
 		cmd           engif.ActionCmd
 		reviewMsg     string
+		templates     *templates.Templates
 		inputMetadata *json.RawMessage
 		mockSetup     func(*mock_provifv1.MockReviewPublisher)
 		expectedErr   error
@@ -64,6 +67,31 @@ func TestPullRequestCommentAlert(t *testing.T) {
 			},
 			expectMeta: true,
 		},
+		{
+			name:       "create a PR comment with the project templates",
+			actionType: TestActionTypeValid,
+			reviewMsg:  "Don't merge",
+			templates: &templates.Templates{
+				PullRequestComment: &templates.PullRequestComment{Body: "**{{.Name}}**: {{.Message}}"},
+			},
+			cmd: engif.ActionCmdOn,
+			mockSetup: func(mockPublisher *mock_provifv1.MockReviewPublisher) {
+				mockPublisher.EXPECT().
+					ListReviews(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+				mockPublisher.EXPECT().
+					CreateReview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, req *github.PullRequestReviewRequest) (*github.PullRequestReview, error) {
+						// the rendered review message is not escaped again
+						expectedBody := "**test-rule**: Don't merge\n\n<!-- minder-rule: test-rule -->"
+						if req.GetBody() != expectedBody {
+							return nil, fmt.Errorf("expected %q, got %q", expectedBody, req.GetBody())
+						}
+						return &github.PullRequestReview{ID: github.Int64(reviewID)}, nil
+					})
+			},
+			expectMeta: true,
+		},
 		{
 			name:       "create a PR comment with eval error details template",
 			actionType: TestActionTypeValid,
@@ -224,8 +252,12 @@ func TestPullRequestCommentAlert(t *testing.T) {
 				Output: exampleOutput{ViolationMsg: violationMsg},
 			})
 
+			ctx := context.Background()
+			if tt.templates != nil {
+				ctx = templates.WithProjectTemplates(ctx, staticTemplates{tt.templates}, uuid.New())
+			}
 			retMeta, err := prCommentAlert.Do(
-				context.Background(),
+				ctx,
 				tt.cmd,
 				&pbinternal.PullRequest{},
 				evalParams,
@@ -244,3 +276,11 @@ func TestPullRequestCommentAlert(t *testing.T) {
 type exampleOutput struct {
 	ViolationMsg string
 }
+
+type staticTemplates struct {
+	templates *templates.Templates
+}
+
+func (s staticTemplates) AlertTemplates(context.Context, uuid.UUID) (*templates.Templates, error) {
+	return s.templates, nil
+}
@@ -0,0 +1,326 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package routing resolves the owner of an entity and routes alert
+// notifications to the owning team instead of a single global sink.
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"path"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/email"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+// Source describes where the owner used for routing came from
+type Source string
+
+const (
+	// SourceEntity means the owner was set on the entity itself
+	SourceEntity Source = "entity"
+	// SourceRepositoryGroup means the entity had no owner and the owner of
+	// the configured group of its repository was used
+	SourceRepositoryGroup Source = "repository_group"
+	// SourceDefault means the entity had no owner and the configured fallback was used
+	SourceDefault Source = "default"
+	// SourceNone means neither the entity nor the configuration provide an owner
+	SourceNone Source = "none"
+)
+
+// Owner is the ownership metadata attached to an entity
+type Owner struct {
+	Team              string `json:"team,omitempty"`
+	Contact           string `json:"contact,omitempty"`
+	EscalationChannel string `json:"escalation_channel,omitempty"`
+}
+
+// IsZero returns true if no ownership information is set
+func (o Owner) IsZero() bool {
+	return o.Team == "" && o.Contact == "" && o.EscalationChannel == ""
+}
+
+// withDefaults fills in the contact and escalation channel the owner
+// leaves empty from the defaults, or returns the defaults if no ownership
+// information is set. The team is kept, as it names the owner.
+func (o Owner) withDefaults(defaults Owner) Owner {
+	if o.IsZero() {
+		return defaults
+	}
+	if o.Contact == "" {
+		o.Contact = defaults.Contact
+	}
+	if o.EscalationChannel == "" {
+		o.EscalationChannel = defaults.EscalationChannel
+	}
+	return o
+}
+
+// Destination is the result of routing an alert
+type Destination struct {
+	Owner  Owner  `json:"owner"`
+	Source Source `json:"source"`
+	// Group is the name of the repository group, for SourceRepositoryGroup
+	Group string `json:"group,omitempty"`
+}
+
+// entityWithProperties is implemented by the protobuf entities which carry properties
+type entityWithProperties interface {
+	GetProperties() *structpb.Struct
+}
+
+// OwnerFromEntity extracts the owner metadata from the properties of an entity.
+// A zero Owner is returned if the entity carries no ownership properties.
+func OwnerFromEntity(ent protoreflect.ProtoMessage) Owner {
+	withProps, ok := ent.(entityWithProperties)
+	if !ok || withProps.GetProperties() == nil {
+		return Owner{}
+	}
+
+	fields := withProps.GetProperties().GetFields()
+	return Owner{
+		Team:              fields[properties.PropertyOwnerTeam].GetStringValue(),
+		Contact:           fields[properties.PropertyOwnerContact].GetStringValue(),
+		EscalationChannel: fields[properties.PropertyOwnerEscalationChannel].GetStringValue(),
+	}
+}
+
+// repositoryGroup is a group of repositories owned by the same team
+type repositoryGroup struct {
+	name     string
+	patterns []string
+	owner    Owner
+}
+
+// Router resolves the destination of alerts for an entity
+type Router struct {
+	groups   []repositoryGroup
+	fallback Owner
+}
+
+// NewRouter creates a new router using the configured repository groups,
+// and the configured defaults as fallback. The patterns of the groups are
+// expected to be validated with the configuration.
+func NewRouter(cfg *serverconfig.AlertRoutingConfig) *Router {
+	groups := make([]repositoryGroup, 0, len(cfg.RepositoryGroups))
+	for _, group := range cfg.RepositoryGroups {
+		groups = append(groups, repositoryGroup{
+			name:     group.Name,
+			patterns: group.Repositories,
+			owner: Owner{
+				Team:              group.Team,
+				Contact:           group.Contact,
+				EscalationChannel: group.EscalationChannel,
+			},
+		})
+	}
+
+	return &Router{
+		groups: groups,
+		fallback: Owner{
+			Team:              cfg.DefaultTeam,
+			Contact:           cfg.DefaultContact,
+			EscalationChannel: cfg.DefaultEscalationChannel,
+		},
+	}
+}
+
+// Route returns the destination for alerts raised on the given entity. The
+// owner of the entity is used first, then the owner of the first repository
+// group matching the repository of the entity, then the fallback. Fields an
+// owner leaves empty are filled in from the next ones, so that a team without
+// a contact address still gets escalated.
+func (r *Router) Route(ent protoreflect.ProtoMessage) Destination {
+	inherited := Destination{Owner: r.fallback, Source: SourceDefault}
+	if group := r.repositoryGroup(ent); group != nil {
+		inherited = Destination{
+			Owner:  group.owner.withDefaults(r.fallback),
+			Source: SourceRepositoryGroup,
+			Group:  group.name,
+		}
+	}
+
+	owner := OwnerFromEntity(ent)
+	if owner.IsZero() {
+		if inherited.Owner.IsZero() {
+			return Destination{Source: SourceNone}
+		}
+		return inherited
+	}
+	return Destination{Owner: owner.withDefaults(inherited.Owner), Source: SourceEntity}
+}
+
+// repositoryGroup returns the first group matching the repository of the
+// entity, or nil if there is none
+func (r *Router) repositoryGroup(ent protoreflect.ProtoMessage) *repositoryGroup {
+	repo := repositoryFullName(ent)
+	if repo == "" {
+		return nil
+	}
+	for i, group := range r.groups {
+		for _, pattern := range group.patterns {
+			if ok, _ := path.Match(pattern, repo); ok {
+				return &r.groups[i]
+			}
+		}
+	}
+	return nil
+}
+
+// repositoryFullName returns the owner/name of the repository of the entity,
+// or an empty string if the entity doesn't belong to a repository
+func repositoryFullName(ent protoreflect.ProtoMessage) string {
+	switch e := ent.(type) {
+	case *pb.Repository:
+		if e.GetOwner() != "" && e.GetName() != "" {
+			return e.GetOwner() + "/" + e.GetName()
+		}
+	case *pb.Artifact:
+		if e.GetOwner() != "" && e.GetRepository() != "" {
+			return e.GetOwner() + "/" + e.GetRepository()
+		}
+	}
+	return ""
+}
+
+// OwnerNotifier sends a notification to the owning team when an alert is raised
+type OwnerNotifier struct {
+	router *Router
+	pub    interfaces.Publisher
+}
+
+// NewOwnerNotifier creates a new owner notifier which publishes email events
+// on the given publisher, on their own topic
+func NewOwnerNotifier(pub interfaces.Publisher, cfg *serverconfig.AlertRoutingConfig) *OwnerNotifier {
+	return &OwnerNotifier{
+		router: NewRouter(cfg),
+		pub:    pub,
+	}
+}
+
+// Notify routes an alert raised on the entity to its owner. Entities without an
+// owner and without a configured fallback contact are silently skipped.
+// Nothing is sent for the profiles grouping their alerts: the failures are
+// sent together by NotifyGroup instead.
+func (n *OwnerNotifier) Notify(
+	ctx context.Context,
+	ent protoreflect.ProtoMessage,
+	params engif.ActionsParams,
+) (*Destination, error) {
+	dest := n.router.Route(ent)
+	logger := zerolog.Ctx(ctx).With().
+		Str("owner_team", dest.Owner.Team).
+		Str("routing_source", string(dest.Source)).
+		Str("repository_group", dest.Group).
+		Logger()
+
+	if dest.Owner.Contact == "" {
+		logger.Debug().Msg("no owner contact to route alert to, skipping notification")
+		return &dest, nil
+	}
+
+	if params.GetProfile().AlertGroupingWindow > 0 {
+		logger.Debug().Msg("profile groups its alerts, skipping notification")
+		return &dest, nil
+	}
+
+	if err := n.send(dest.Owner, ent, params.GetProfile().Name, []string{params.GetRule().Name}); err != nil {
+		return nil, err
+	}
+
+	logger.Info().Msg("routed alert notification to owner")
+	return &dest, nil
+}
+
+// NotifyGroup sends a single notification listing the failed rules of a
+// profile grouping its alerts to the owner of the entity
+func (n *OwnerNotifier) NotifyGroup(
+	ctx context.Context,
+	ent protoreflect.ProtoMessage,
+	profileName string,
+	rules []string,
+) error {
+	dest := n.router.Route(ent)
+	logger := zerolog.Ctx(ctx).With().
+		Str("owner_team", dest.Owner.Team).
+		Str("routing_source", string(dest.Source)).
+		Str("repository_group", dest.Group).
+		Logger()
+
+	if dest.Owner.Contact == "" {
+		logger.Debug().Msg("no owner contact to route alert to, skipping notification")
+		return nil
+	}
+
+	if err := n.send(dest.Owner, ent, profileName, rules); err != nil {
+		return err
+	}
+
+	logger.Info().Int("rules", len(rules)).Msg("routed grouped alert notification to owner")
+	return nil
+}
+
+func (n *OwnerNotifier) send(owner Owner, ent protoreflect.ProtoMessage, profileName string, rules []string) error {
+	msg, err := newOwnerMessage(owner, entityName(ent), profileName, rules)
+	if err != nil {
+		return fmt.Errorf("error creating owner notification: %w", err)
+	}
+
+	if err := n.pub.Publish(email.TopicQueueOwnerNotificationEmail, msg); err != nil {
+		return fmt.Errorf("error publishing owner notification: %w", err)
+	}
+	return nil
+}
+
+func entityName(ent protoreflect.ProtoMessage) string {
+	withProps, ok := ent.(entityWithProperties)
+	if !ok {
+		return ""
+	}
+	return withProps.GetProperties().GetFields()[properties.PropertyName].GetStringValue()
+}
+
+func newOwnerMessage(owner Owner, entName, profileName string, rules []string) (*message.Message, error) {
+	var subject, text string
+	if len(rules) == 1 {
+		subject = fmt.Sprintf("Minder alert: rule %s failed for %s", rules[0], entName)
+		text = fmt.Sprintf("Rule %q in profile %q failed for %s.\n", rules[0], profileName, entName)
+	} else {
+		subject = fmt.Sprintf("Minder alert: %d rules failed for %s", len(rules), entName)
+		text = fmt.Sprintf("The following rules in profile %q failed for %s:\n", profileName, entName)
+		for _, rule := range rules {
+			text += fmt.Sprintf("  - %s\n", rule)
+		}
+	}
+	if owner.Team != "" {
+		text += fmt.Sprintf("Owning team: %s\n", owner.Team)
+	}
+	if owner.EscalationChannel != "" {
+		text += fmt.Sprintf("Escalation channel: %s\n", owner.EscalationChannel)
+	}
+
+	payload, err := json.Marshal(email.MailEventPayload{
+		Address:  owner.Contact,
+		Subject:  subject,
+		BodyText: text,
+		BodyHTML: "<pre>" + template.HTMLEscapeString(text) + "</pre>",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload for email event: %w", err)
+	}
+
+	return message.NewMessage(uuid.New().String(), payload), nil
+}
@@ -0,0 +1,331 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/email"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	mockevents "github.com/mindersec/minder/pkg/eventer/interfaces/mock"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+func repoWithProps(t *testing.T, props map[string]any) *pb.Repository {
+	t.Helper()
+	st, err := structpb.NewStruct(props)
+	require.NoError(t, err)
+	return &pb.Repository{Properties: st}
+}
+
+func TestRouterRoute(t *testing.T) {
+	t.Parallel()
+
+	fallbackCfg := &serverconfig.AlertRoutingConfig{
+		DefaultTeam:              "security",
+		DefaultContact:           "security@example.com",
+		DefaultEscalationChannel: "#security",
+	}
+
+	tests := []struct {
+		name     string
+		cfg      *serverconfig.AlertRoutingConfig
+		props    map[string]any
+		expected Destination
+	}{
+		{
+			name: "entity owner is used",
+			cfg:  fallbackCfg,
+			props: map[string]any{
+				properties.PropertyOwnerTeam:              "payments",
+				properties.PropertyOwnerContact:           "payments@example.com",
+				properties.PropertyOwnerEscalationChannel: "#payments-oncall",
+			},
+			expected: Destination{
+				Owner: Owner{
+					Team:              "payments",
+					Contact:           "payments@example.com",
+					EscalationChannel: "#payments-oncall",
+				},
+				Source: SourceEntity,
+			},
+		},
+		{
+			name: "missing owner fields fall back to defaults",
+			cfg:  fallbackCfg,
+			props: map[string]any{
+				properties.PropertyOwnerTeam: "payments",
+			},
+			expected: Destination{
+				Owner: Owner{
+					Team:              "payments",
+					Contact:           "security@example.com",
+					EscalationChannel: "#security",
+				},
+				Source: SourceEntity,
+			},
+		},
+		{
+			name:  "no owner uses the default",
+			cfg:   fallbackCfg,
+			props: map[string]any{properties.PropertyName: "foo/bar"},
+			expected: Destination{
+				Owner: Owner{
+					Team:              "security",
+					Contact:           "security@example.com",
+					EscalationChannel: "#security",
+				},
+				Source: SourceDefault,
+			},
+		},
+		{
+			name:     "no owner and no default",
+			cfg:      &serverconfig.AlertRoutingConfig{},
+			props:    map[string]any{properties.PropertyName: "foo/bar"},
+			expected: Destination{Source: SourceNone},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewRouter(tt.cfg)
+			require.Equal(t, tt.expected, r.Route(repoWithProps(t, tt.props)))
+		})
+	}
+}
+
+func TestRouterRouteRepositoryGroups(t *testing.T) {
+	t.Parallel()
+
+	cfg := &serverconfig.AlertRoutingConfig{
+		DefaultTeam:              "security",
+		DefaultContact:           "security@example.com",
+		DefaultEscalationChannel: "#security",
+		RepositoryGroups: []serverconfig.RepositoryGroupConfig{
+			{
+				Name:         "platform",
+				Repositories: []string{"acme/platform-*", "acme/infra"},
+				Team:         "platform",
+				Contact:      "platform@example.com",
+			},
+			{
+				Name:              "acme",
+				Repositories:      []string{"acme/*"},
+				Team:              "acme",
+				Contact:           "acme@example.com",
+				EscalationChannel: "#acme",
+			},
+		},
+	}
+
+	groupRepo := func(t *testing.T, name string, props map[string]any) *pb.Repository {
+		t.Helper()
+		repo := repoWithProps(t, props)
+		repo.Owner = "acme"
+		repo.Name = name
+		return repo
+	}
+
+	tests := []struct {
+		name     string
+		entity   func(t *testing.T) protoreflect.ProtoMessage
+		expected Destination
+	}{
+		{
+			name: "repository without owner uses the owner of its group",
+			entity: func(t *testing.T) protoreflect.ProtoMessage {
+				t.Helper()
+				return groupRepo(t, "platform-api", nil)
+			},
+			expected: Destination{
+				Owner: Owner{
+					Team:              "platform",
+					Contact:           "platform@example.com",
+					EscalationChannel: "#security",
+				},
+				Source: SourceRepositoryGroup,
+				Group:  "platform",
+			},
+		},
+		{
+			name: "first matching group is used",
+			entity: func(t *testing.T) protoreflect.ProtoMessage {
+				t.Helper()
+				return groupRepo(t, "infra", nil)
+			},
+			expected: Destination{
+				Owner: Owner{
+					Team:              "platform",
+					Contact:           "platform@example.com",
+					EscalationChannel: "#security",
+				},
+				Source: SourceRepositoryGroup,
+				Group:  "platform",
+			},
+		},
+		{
+			name: "entity owner takes precedence over its group",
+			entity: func(t *testing.T) protoreflect.ProtoMessage {
+				t.Helper()
+				return groupRepo(t, "billing", map[string]any{
+					properties.PropertyOwnerTeam: "payments",
+				})
+			},
+			expected: Destination{
+				Owner: Owner{
+					Team:              "payments",
+					Contact:           "acme@example.com",
+					EscalationChannel: "#acme",
+				},
+				Source: SourceEntity,
+			},
+		},
+		{
+			name: "repository outside of the groups falls back to the default",
+			entity: func(t *testing.T) protoreflect.ProtoMessage {
+				t.Helper()
+				repo := repoWithProps(t, nil)
+				repo.Owner = "other"
+				repo.Name = "platform-api"
+				return repo
+			},
+			expected: Destination{
+				Owner: Owner{
+					Team:              "security",
+					Contact:           "security@example.com",
+					EscalationChannel: "#security",
+				},
+				Source: SourceDefault,
+			},
+		},
+		{
+			name: "artifact uses the group of its repository",
+			entity: func(_ *testing.T) protoreflect.ProtoMessage {
+				return &pb.Artifact{Owner: "acme", Name: "image", Repository: "billing"}
+			},
+			expected: Destination{
+				Owner: Owner{
+					Team:              "acme",
+					Contact:           "acme@example.com",
+					EscalationChannel: "#acme",
+				},
+				Source: SourceRepositoryGroup,
+				Group:  "acme",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewRouter(cfg)
+			require.Equal(t, tt.expected, r.Route(tt.entity(t)))
+		})
+	}
+}
+
+func TestOwnerNotifierNotify(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	pub := mockevents.NewMockPublisher(ctrl)
+
+	var published *message.Message
+	pub.EXPECT().Publish(email.TopicQueueOwnerNotificationEmail, gomock.Any()).
+		DoAndReturn(func(_ string, msgs ...*message.Message) error {
+			published = msgs[0]
+			return nil
+		})
+
+	n := NewOwnerNotifier(pub, &serverconfig.AlertRoutingConfig{})
+	params := &engif.EvalStatusParams{
+		Rule:    &models.RuleInstance{Name: "branch_protection"},
+		Profile: &models.ProfileAggregate{Name: "baseline"},
+	}
+	ent := repoWithProps(t, map[string]any{
+		properties.PropertyName:         "foo/bar",
+		properties.PropertyOwnerTeam:    "payments",
+		properties.PropertyOwnerContact: "payments@example.com",
+	})
+
+	dest, err := n.Notify(context.Background(), ent, params)
+	require.NoError(t, err)
+	require.Equal(t, SourceEntity, dest.Source)
+	require.NotNil(t, published)
+
+	var payload email.MailEventPayload
+	require.NoError(t, json.Unmarshal(published.Payload, &payload))
+	require.Equal(t, "payments@example.com", payload.Address)
+	require.Contains(t, payload.Subject, "branch_protection")
+	require.Contains(t, payload.BodyText, "payments")
+}
+
+func TestOwnerNotifierSkipsWithoutContact(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	pub := mockevents.NewMockPublisher(ctrl)
+
+	n := NewOwnerNotifier(pub, &serverconfig.AlertRoutingConfig{})
+	dest, err := n.Notify(context.Background(), repoWithProps(t, map[string]any{}), &engif.EvalStatusParams{})
+	require.NoError(t, err)
+	require.Equal(t, SourceNone, dest.Source)
+}
+
+func TestOwnerNotifierGroupsFailures(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	pub := mockevents.NewMockPublisher(ctrl)
+
+	var published []*message.Message
+	pub.EXPECT().Publish(email.TopicQueueOwnerNotificationEmail, gomock.Any()).
+		DoAndReturn(func(_ string, msgs ...*message.Message) error {
+			published = append(published, msgs...)
+			return nil
+		})
+
+	n := NewOwnerNotifier(pub, &serverconfig.AlertRoutingConfig{})
+	profile := &models.ProfileAggregate{Name: "baseline", AlertGroupingWindow: time.Hour}
+	ent := repoWithProps(t, map[string]any{
+		properties.PropertyName:         "foo/bar",
+		properties.PropertyOwnerContact: "payments@example.com",
+	})
+
+	// The alerts of the rules of a grouping profile are not sent one by one
+	for _, rule := range []string{"branch_protection", "secret_scanning"} {
+		_, err := n.Notify(context.Background(), ent, &engif.EvalStatusParams{
+			Rule:    &models.RuleInstance{Name: rule},
+			Profile: profile,
+		})
+		require.NoError(t, err)
+	}
+	require.Empty(t, published)
+
+	require.NoError(t, n.NotifyGroup(context.Background(), ent, profile.Name,
+		[]string{"branch_protection", "secret_scanning"}))
+	require.Len(t, published, 1)
+
+	var payload email.MailEventPayload
+	require.NoError(t, json.Unmarshal(published[0].Payload, &payload))
+	require.Equal(t, "payments@example.com", payload.Address)
+	require.Contains(t, payload.Subject, "2 rules failed")
+	require.Contains(t, payload.BodyText, "branch_protection")
+	require.Contains(t, payload.BodyText, "secret_scanning")
+}
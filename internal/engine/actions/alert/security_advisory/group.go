@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package security_advisory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	enginerr "github.com/mindersec/minder/pkg/engine/errors"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// severityRank orders the severities of security advisories
+var severityRank = map[string]int{
+	"low":      1,
+	"medium":   2,
+	"high":     3,
+	"critical": 4,
+}
+
+// GroupAdvisor opens a single security advisory listing all the failing rules
+// of a profile for a repository, for the profiles grouping their alerts
+type GroupAdvisor struct {
+	cli provifv1.GitHub
+}
+
+// NewGroupAdvisor creates a new advisor of grouped alerts
+func NewGroupAdvisor(cli provifv1.GitHub) *GroupAdvisor {
+	return &GroupAdvisor{cli: cli}
+}
+
+// OpenGroupAdvisory opens the consolidated security advisory for the failing
+// rules, mapped to the severity of their alert, and returns its GHSA ID. The
+// advisory has the highest severity of the rules.
+func (a *GroupAdvisor) OpenGroupAdvisory(
+	ctx context.Context,
+	entity protoreflect.ProtoMessage,
+	profileName string,
+	rules []string,
+	severities map[string]string,
+) (string, error) {
+	owner, repo, err := repositoryOf(entity)
+	if err != nil {
+		return "", err
+	}
+
+	severity := "low"
+	for _, sev := range severities {
+		if severityRank[sev] > severityRank[severity] {
+			severity = sev
+		}
+	}
+
+	repoSlug := fmt.Sprintf("%s/%s", owner, repo)
+	summary := fmt.Sprintf("minder: profile %s failed - %d rules failed", profileName, len(rules))
+	if len(rules) == 1 {
+		summary = fmt.Sprintf("minder: profile %s failed - rule %s failed", profileName, rules[0])
+	}
+
+	var description strings.Builder
+	fmt.Fprintf(&description,
+		"Minder has detected potential security exposures in your repository - **%s**.\n\n", repoSlug)
+	fmt.Fprintf(&description,
+		"The following rules of the **%s** profile failed, and their alerts are grouped in this advisory:\n\n",
+		profileName)
+	for _, rule := range rules {
+		fmt.Fprintf(&description, "- %s (severity: %s)\n", rule, severities[rule])
+	}
+	description.WriteString("\nThis advisory will be automatically closed once all the rules pass, " +
+		"or replaced by a new one when other rules fail.\n")
+
+	return a.cli.CreateSecurityAdvisory(ctx, owner, repo, severity, summary, description.String(),
+		vulnerabilitiesFor(repoSlug))
+}
+
+// CloseGroupAdvisory closes a consolidated security advisory. Advisories which
+// were already closed are ignored.
+func (a *GroupAdvisor) CloseGroupAdvisory(ctx context.Context, entity protoreflect.ProtoMessage, id string) error {
+	owner, repo, err := repositoryOf(entity)
+	if err != nil {
+		return err
+	}
+
+	err = a.cli.CloseSecurityAdvisory(ctx, owner, repo, id)
+	if errors.Is(err, enginerr.ErrNotFound) {
+		return nil
+	}
+	return err
+}
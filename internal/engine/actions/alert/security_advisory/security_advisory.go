@@ -19,6 +19,8 @@ import (
 
 	dbadapter "github.com/mindersec/minder/internal/adapters/db"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
+	actionmeta "github.com/mindersec/minder/internal/engine/actions/metadata"
 	"github.com/mindersec/minder/internal/engine/interfaces"
 	pbinternal "github.com/mindersec/minder/internal/proto"
 	"github.com/mindersec/minder/internal/util"
@@ -121,9 +123,7 @@ type templateParamsSA struct {
 	EvaluationError string
 }
 
-type alertMetadata struct {
-	ID string `json:"ghsa_id,omitempty"`
-}
+type alertMetadata = actionmeta.SecurityAdvisoryAlert
 
 // NewSecurityAdvisoryAlert creates a new security-advisory alert action
 func NewSecurityAdvisoryAlert(
@@ -196,6 +196,12 @@ func (alert *Alert) Do(
 		return nil, fmt.Errorf("error extracting details: %w", err)
 	}
 
+	// The profile groups its alerts in a single security advisory, opened
+	// once all the rules of the profile are evaluated
+	if params.GetProfile().AlertGroupingWindow > 0 {
+		return alert.runGrouped(ctx, p, cmd)
+	}
+
 	// Process the command based on the action setting
 	switch alert.setting {
 	case models.ActionOptOn:
@@ -258,6 +264,28 @@ func (alert *Alert) run(ctx context.Context, params *paramsSA, cmd interfaces.Ac
 	return nil, enginerr.ErrActionSkipped
 }
 
+// runGrouped runs the security advisory action for a profile grouping its
+// alerts. The rule only records that it is part of the consolidated advisory;
+// an advisory opened for the rule before the grouping was set is still closed.
+func (alert *Alert) runGrouped(ctx context.Context, params *paramsSA, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		newMeta, err := json.Marshal(alertMetadata{Grouped: true})
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+		}
+		return newMeta, nil
+	case interfaces.ActionCmdOff:
+		if params.Metadata != nil && params.Metadata.ID != "" && alert.setting == models.ActionOptOn {
+			return alert.run(ctx, params, cmd)
+		}
+		return nil, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	case interfaces.ActionCmdDoNothing:
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
 // runDry runs the security advisory action in dry run mode
 func (alert *Alert) runDry(ctx context.Context, params *paramsSA, cmd interfaces.ActionCmd) (json.RawMessage, error) {
 	logger := zerolog.Ctx(ctx)
@@ -309,29 +337,13 @@ func (alert *Alert) getParamsForSecurityAdvisory(
 	}
 
 	// Get the owner and repo from the entity
-	switch entity := entity.(type) {
-	case *pb.Repository:
-		result.Owner = entity.GetOwner()
-		result.Repo = entity.GetName()
-	case *pbinternal.PullRequest:
-		result.Owner = entity.GetRepoOwner()
-		result.Repo = entity.GetRepoName()
-	case *pb.Artifact:
-		result.Owner = entity.GetOwner()
-		result.Repo = entity.GetRepository()
-	default:
-		return nil, fmt.Errorf("expected repository, pull request or artifact, got %T", entity)
+	var err error
+	result.Owner, result.Repo, err = repositoryOf(entity)
+	if err != nil {
+		return nil, err
 	}
 	result.Template.Repository = fmt.Sprintf("%s/%s", result.Owner, result.Repo)
-	ecosystem := "other"
-	result.Vulnerabilities = []*github.AdvisoryVulnerability{
-		{
-			Package: &github.VulnerabilityPackage{
-				Name:      &result.Template.Repository,
-				Ecosystem: &ecosystem,
-			},
-		},
-	}
+	result.Vulnerabilities = vulnerabilitiesFor(result.Template.Repository)
 	// Unmarshal the existing alert metadata, if any
 	if metadata != nil {
 		meta := &alertMetadata{}
@@ -362,7 +374,7 @@ func (alert *Alert) getParamsForSecurityAdvisory(
 		result.Template.RuleRemediation = "not available yet"
 	}
 	var summaryStr strings.Builder
-	err := alert.summaryTmpl.Execute(&summaryStr, result.Template)
+	err = alert.summaryTmpl.Execute(&summaryStr, result.Template)
 	if err != nil {
 		return nil, fmt.Errorf("error executing summary template: %w", err)
 	}
@@ -381,19 +393,104 @@ func (alert *Alert) getParamsForSecurityAdvisory(
 		return nil, fmt.Errorf("error executing description template: %w", err)
 	}
 	result.Description = descriptionStr.String()
+
+	if err := alert.applyProjectTemplates(ctx, result, params); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
+// applyProjectTemplates renders the summary and the description with the
+// alert templates of the project, if it has some
+func (alert *Alert) applyProjectTemplates(
+	ctx context.Context,
+	result *paramsSA,
+	params interfaces.ActionsParams,
+) error {
+	tmpls, err := templates.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting the alert templates of the project: %w", err)
+	}
+	if tmpls == nil || tmpls.SecurityAdvisory == nil {
+		return nil
+	}
+
+	var failureMessage strings.Builder
+	failureTmpl, err := htmltemplate.New("failure_message").
+		Option("missingkey=error").
+		Parse(alert.ruleType.ShortFailureMessage)
+	if err != nil {
+		return fmt.Errorf("cannot parse failure message template: %w", err)
+	}
+	if err := failureTmpl.Execute(&failureMessage, result.Template); err != nil {
+		return fmt.Errorf("error executing failure message template: %w", err)
+	}
+
+	data := templates.SecurityAdvisoryData{
+		Profile:         result.Template.Profile,
+		Rule:            result.Template.Rule,
+		Name:            result.Template.Name,
+		Repository:      result.Template.Repository,
+		Severity:        result.Template.Severity,
+		Guidance:        result.Template.Guidance,
+		RuleRemediation: result.Template.RuleRemediation,
+		EvaluationError: result.Template.EvaluationError,
+		// nolint:gosec // the failure message is rendered by an HTML template
+		FailureMessage: htmltemplate.HTML(failureMessage.String()),
+		Remediate:      params.GetProfile().ActionConfig.Remediate == models.ActionOptOn,
+	}
+	result.Summary, result.Description, err = tmpls.SecurityAdvisory.Render(ctx, data, result.Summary, result.Description)
+	if err != nil {
+		return fmt.Errorf("error rendering the security advisory template of the project: %w", err)
+	}
+	return nil
+}
+
 func (alert *Alert) getSeverityString() string {
-	if alert.saCfg.Severity == "" {
-		ruleSev := alert.ruleType.Severity.GetValue().Enum().AsString()
-		if ruleSev == "info" || ruleSev == "unknown" {
-			return "low"
-		}
-		return ruleSev
+	if alert.saCfg.Severity != "" {
+		return alert.saCfg.Severity
+	}
+	return Severity(alert.ruleType)
+}
+
+// Severity returns the severity of the security advisories opened for the
+// rule type: the one set in its alert definition, or else its own severity
+func Severity(ruleType *pb.RuleType) string {
+	if sev := ruleType.GetDef().GetAlert().GetSecurityAdvisory().GetSeverity(); sev != "" {
+		return sev
 	}
 
-	return alert.saCfg.Severity
+	ruleSev := ruleType.GetSeverity().GetValue().Enum().AsString()
+	if ruleSev == "info" || ruleSev == "unknown" {
+		return "low"
+	}
+	return ruleSev
+}
+
+// repositoryOf returns the owner and the name of the repository an entity belongs to
+func repositoryOf(entity protoreflect.ProtoMessage) (string, string, error) {
+	switch entity := entity.(type) {
+	case *pb.Repository:
+		return entity.GetOwner(), entity.GetName(), nil
+	case *pbinternal.PullRequest:
+		return entity.GetRepoOwner(), entity.GetRepoName(), nil
+	case *pb.Artifact:
+		return entity.GetOwner(), entity.GetRepository(), nil
+	default:
+		return "", "", fmt.Errorf("expected repository, pull request or artifact, got %T", entity)
+	}
+}
+
+func vulnerabilitiesFor(repoSlug string) []*github.AdvisoryVulnerability {
+	ecosystem := "other"
+	return []*github.AdvisoryVulnerability{
+		{
+			Package: &github.VulnerabilityPackage{
+				Name:      &repoSlug,
+				Ecosystem: &ecosystem,
+			},
+		},
+	}
 }
 
 // runDoNothing returns the previous alert status
@@ -11,11 +11,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
 	"github.com/mindersec/minder/internal/engine/interfaces"
 	pbinternal "github.com/mindersec/minder/internal/proto"
 	mockghclient "github.com/mindersec/minder/internal/providers/github/mock"
@@ -34,6 +37,7 @@ func TestSecurityAdvisoryAlert(t *testing.T) {
 	tests := []struct {
 		name             string
 		actionType       interfaces.ActionType
+		templates        *templates.Templates
 		mockSetup        func(*mockghclient.MockGitHub)
 		expectedErr      error
 		expectedMetadata json.RawMessage
@@ -50,6 +54,25 @@ func TestSecurityAdvisoryAlert(t *testing.T) {
 			expectedErr:      nil,
 			expectedMetadata: json.RawMessage(fmt.Sprintf(`{"ghsa_id":"%s"}`, saID)),
 		},
+		{
+			name:       "create a security advisory with the project templates",
+			actionType: TestActionTypeValid,
+			templates: &templates.Templates{
+				SecurityAdvisory: &templates.SecurityAdvisory{
+					Title:  "{{.Rule}}: {{.FailureMessage}}",
+					Body:   "Severity {{.Severity}}{{if .Remediate}}, remediated{{end}}",
+					Labels: []string{"triage"},
+				},
+			},
+			mockSetup: func(mockGitHub *mockghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					CreateSecurityAdvisory(gomock.Any(), gomock.Any(), gomock.Any(), pb.Severity_VALUE_HIGH.String(),
+						"[triage] rule_type_1: This is a failure message", "Severity VALUE_HIGH, remediated", gomock.Any()).
+					Return(saID, nil)
+			},
+			expectedErr:      nil,
+			expectedMetadata: json.RawMessage(fmt.Sprintf(`{"ghsa_id":"%s"}`, saID)),
+		},
 		{
 			name:       "error from provider creating security advisory",
 			actionType: TestActionTypeValid,
@@ -96,12 +119,18 @@ func TestSecurityAdvisoryAlert(t *testing.T) {
 
 			evalParams := &interfaces.EvalStatusParams{
 				EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{},
-				Profile:          &models.ProfileAggregate{},
-				Rule:             &models.RuleInstance{},
+				Profile: &models.ProfileAggregate{
+					ActionConfig: models.ActionConfiguration{Remediate: models.ActionOptOn},
+				},
+				Rule: &models.RuleInstance{},
 			}
 
+			ctx := context.Background()
+			if tt.templates != nil {
+				ctx = templates.WithProjectTemplates(ctx, staticTemplates{tt.templates}, uuid.New())
+			}
 			retMeta, err := saAlert.Do(
-				context.Background(),
+				ctx,
 				interfaces.ActionCmdOn,
 				&pbinternal.PullRequest{},
 				evalParams,
@@ -112,3 +141,70 @@ func TestSecurityAdvisoryAlert(t *testing.T) {
 		})
 	}
 }
+
+type staticTemplates struct {
+	templates *templates.Templates
+}
+
+func (s staticTemplates) AlertTemplates(context.Context, uuid.UUID) (*templates.Templates, error) {
+	return s.templates, nil
+}
+
+func TestSecurityAdvisoryAlertGrouped(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	// No advisory is opened for the rule itself
+	mockClient := mockghclient.NewMockGitHub(ctrl)
+
+	ruleType := pb.RuleType{
+		Name: "rule_type_1",
+		Def: &pb.RuleType_Definition{
+			Alert: &pb.RuleType_Definition_Alert{},
+		},
+	}
+	saAlert, err := NewSecurityAdvisoryAlert(TestActionTypeValid, &ruleType,
+		&pb.RuleType_Definition_Alert_AlertTypeSA{}, mockClient, models.ActionOptOn)
+	require.NoError(t, err)
+
+	evalParams := &interfaces.EvalStatusParams{
+		EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{},
+		Profile:          &models.ProfileAggregate{AlertGroupingWindow: time.Hour},
+		Rule:             &models.RuleInstance{},
+	}
+
+	retMeta, err := saAlert.Do(context.Background(), interfaces.ActionCmdOn, &pb.Repository{}, evalParams, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"grouped":true}`, string(retMeta))
+
+	_, err = saAlert.Do(context.Background(), interfaces.ActionCmdOff, &pb.Repository{}, evalParams, &retMeta)
+	require.ErrorIs(t, err, enginerr.ErrActionTurnedOff)
+}
+
+func TestGroupAdvisor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockClient := mockghclient.NewMockGitHub(ctrl)
+	mockClient.EXPECT().
+		CreateSecurityAdvisory(gomock.Any(), "acme", "api", "critical",
+			"minder: profile baseline failed - 2 rules failed", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _, _, description string, _ any) (string, error) {
+			require.Contains(t, description, "- branch_protection (severity: critical)")
+			require.Contains(t, description, "- secret_scanning (severity: medium)")
+			return "GHSA-1", nil
+		})
+	mockClient.EXPECT().CloseSecurityAdvisory(gomock.Any(), "acme", "api", "GHSA-0").
+		Return(enginerr.ErrNotFound)
+
+	repo := &pb.Repository{Owner: "acme", Name: "api"}
+	advisor := NewGroupAdvisor(mockClient)
+	id, err := advisor.OpenGroupAdvisory(context.Background(), repo, "baseline",
+		[]string{"branch_protection", "secret_scanning"},
+		map[string]string{"branch_protection": "critical", "secret_scanning": "medium"})
+	require.NoError(t, err)
+	require.Equal(t, "GHSA-1", id)
+
+	// Advisories closed manually are ignored
+	require.NoError(t, advisor.CloseGroupAdvisory(context.Background(), repo, "GHSA-0"))
+}
@@ -0,0 +1,294 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package templates provides the custom templates projects set for the
+// content of their alerts, instead of the built-in wording.
+package templates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// MaxTitleLength is the maximum length of a rendered advisory title,
+	// which is the limit of the GitHub API
+	MaxTitleLength = 1024
+	// MaxBodyLength is the maximum length of a rendered alert body, which
+	// is the limit of the GitHub API
+	MaxBodyLength = 65536
+	// maxTemplateLength is the maximum length of a template
+	maxTemplateLength = 20000
+	// maxLabels is the maximum number of labels of an advisory
+	maxLabels = 10
+	// maxLabelLength is the maximum length of a label
+	maxLabelLength = 50
+)
+
+// ErrInvalidTemplates is returned when the alert templates are invalid
+var ErrInvalidTemplates = errors.New("invalid alert templates")
+
+// Templates are the custom templates of the alerts of a project, by alert
+// type. The alert types without a template use the built-in wording.
+type Templates struct {
+	SecurityAdvisory   *SecurityAdvisory   `json:"security_advisory,omitempty" yaml:"security_advisory,omitempty"`
+	PullRequestComment *PullRequestComment `json:"pull_request_comment,omitempty" yaml:"pull_request_comment,omitempty"`
+}
+
+// SecurityAdvisory is the template of the security advisories opened by the
+// security_advisory alerts
+type SecurityAdvisory struct {
+	// Title is the template of the summary of the advisory
+	Title string `json:"title,omitempty" yaml:"title,omitempty"`
+	// Body is the template of the description of the advisory
+	Body string `json:"body,omitempty" yaml:"body,omitempty"`
+	// Labels are added to the summary of the advisory, as GitHub security
+	// advisories have no labels, e.g. "[triage] minder: ..."
+	Labels []string `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+// PullRequestComment is the template of the reviews left by the
+// pull_request_comment alerts
+type PullRequestComment struct {
+	// Body is the template of the review, which includes the message of the
+	// rule type with {{.Message}}
+	Body string `json:"body" yaml:"body"`
+}
+
+// SecurityAdvisoryData is the data the security advisory templates are
+// rendered with
+type SecurityAdvisoryData struct {
+	Profile         string
+	Rule            string
+	Name            string
+	Repository      string
+	Severity        string
+	Guidance        string
+	RuleRemediation string
+	EvaluationError string
+	// FailureMessage is the rendered short failure message of the rule
+	// type, which is already escaped
+	FailureMessage htmltemplate.HTML
+	// Remediate is true if the profile remediates the rule automatically
+	Remediate bool
+}
+
+// PullRequestCommentData is the data the pull request comment templates
+// are rendered with
+type PullRequestCommentData struct {
+	Profile    string
+	Name       string
+	Repository string
+	// Message is the rendered review message of the rule type, which is
+	// already escaped
+	Message htmltemplate.HTML
+}
+
+// SampleSecurityAdvisoryData is the data the security advisory templates are
+// validated and previewed with
+func SampleSecurityAdvisoryData() SecurityAdvisoryData {
+	return SecurityAdvisoryData{
+		Profile:         "acme-github-profile",
+		Rule:            "secret_scanning",
+		Name:            "secret_scanning",
+		Repository:      "acme/widgets",
+		Severity:        "high",
+		Guidance:        "Enable secret scanning in the security settings of the repository.",
+		RuleRemediation: "already available",
+		FailureMessage:  "Secret scanning is disabled",
+	}
+}
+
+// SamplePullRequestCommentData is the data the pull request comment
+// templates are validated and previewed with
+func SamplePullRequestCommentData() PullRequestCommentData {
+	return PullRequestCommentData{
+		Profile:    "acme-github-profile",
+		Name:       "pr_vulnerability_check",
+		Repository: "acme/widgets",
+		Message:    "This pull request adds a dependency with a known vulnerability, CVE-2024-1234.",
+	}
+}
+
+// Parse parses the templates from YAML or JSON
+func Parse(content []byte) (*Templates, error) {
+	var t Templates
+	dec := yaml.NewDecoder(strings.NewReader(string(content)))
+	dec.KnownFields(true)
+	if err := dec.Decode(&t); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTemplates, err)
+	}
+	return &t, nil
+}
+
+// IsEmpty returns true if no template is set
+func (t *Templates) IsEmpty() bool {
+	return t == nil || (t.SecurityAdvisory == nil && t.PullRequestComment == nil)
+}
+
+// Validate checks that the templates parse, and render with sample data
+func (t *Templates) Validate() error {
+	if t.SecurityAdvisory != nil {
+		sa := t.SecurityAdvisory
+		if sa.Title == "" && sa.Body == "" && len(sa.Labels) == 0 {
+			return fmt.Errorf("%w: security advisory template is empty", ErrInvalidTemplates)
+		}
+		if len(sa.Labels) > maxLabels {
+			return fmt.Errorf("%w: at most %d labels are allowed", ErrInvalidTemplates, maxLabels)
+		}
+		for _, label := range sa.Labels {
+			if label == "" || len(label) > maxLabelLength || strings.ContainsAny(label, "[]\n") {
+				return fmt.Errorf("%w: invalid label %q", ErrInvalidTemplates, label)
+			}
+		}
+		if _, _, err := sa.Render(context.Background(), SampleSecurityAdvisoryData(), "", ""); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidTemplates, err)
+		}
+	}
+	if t.PullRequestComment != nil {
+		if t.PullRequestComment.Body == "" {
+			return fmt.Errorf("%w: pull request comment template is empty", ErrInvalidTemplates)
+		}
+		if _, err := t.PullRequestComment.Render(context.Background(), SamplePullRequestCommentData()); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidTemplates, err)
+		}
+	}
+	return nil
+}
+
+// Render renders the summary and the description of an advisory. The
+// default title and body are used if the template doesn't set them.
+func (sa *SecurityAdvisory) Render(
+	ctx context.Context, data SecurityAdvisoryData, defaultTitle, defaultBody string,
+) (string, string, error) {
+	title, body := defaultTitle, defaultBody
+	var err error
+	if sa.Title != "" {
+		title, err = render(ctx, "title", sa.Title, data, MaxTitleLength)
+		if err != nil {
+			return "", "", err
+		}
+		title = strings.TrimSpace(title)
+	}
+	if sa.Body != "" {
+		body, err = render(ctx, "body", sa.Body, data, MaxBodyLength)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	var prefix strings.Builder
+	for _, label := range sa.Labels {
+		fmt.Fprintf(&prefix, "[%s] ", label)
+	}
+	title = prefix.String() + title
+	if len(title) > MaxTitleLength {
+		return "", "", fmt.Errorf("title: %w", util.ErrExceededSizeLimit)
+	}
+	return title, body, nil
+}
+
+// Render renders the review of a pull request
+func (prc *PullRequestComment) Render(ctx context.Context, data PullRequestCommentData) (string, error) {
+	return render(ctx, "body", prc.Body, data, MaxBodyLength)
+}
+
+func render(ctx context.Context, name, tmpl string, data any, limit int) (string, error) {
+	if len(tmpl) > maxTemplateLength {
+		return "", fmt.Errorf("%s template is longer than %d characters", name, maxTemplateLength)
+	}
+	t, err := util.NewSafeHTMLTemplate(&tmpl, name)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	out, err := t.Render(ctx, data, limit)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	return out, nil
+}
+
+// FromPB converts the protobuf templates, validating them. Empty templates
+// are converted to nil.
+func FromPB(in *minderv1.ProjectAlertTemplates) (*Templates, error) {
+	out := &Templates{}
+	if sa := in.GetSecurityAdvisory(); sa != nil {
+		out.SecurityAdvisory = &SecurityAdvisory{
+			Title:  sa.GetTitle(),
+			Body:   sa.GetBody(),
+			Labels: sa.GetLabels(),
+		}
+	}
+	if prc := in.GetPullRequestComment(); prc != nil {
+		out.PullRequestComment = &PullRequestComment{Body: prc.GetBody()}
+	}
+	if out.IsEmpty() {
+		return nil, nil
+	}
+
+	if err := out.Validate(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ToPB converts the templates to their protobuf representation
+func (t *Templates) ToPB() *minderv1.ProjectAlertTemplates {
+	if t.IsEmpty() {
+		return nil
+	}
+	out := &minderv1.ProjectAlertTemplates{}
+	if sa := t.SecurityAdvisory; sa != nil {
+		out.SecurityAdvisory = &minderv1.SecurityAdvisoryAlertTemplate{
+			Title:  sa.Title,
+			Body:   sa.Body,
+			Labels: sa.Labels,
+		}
+	}
+	if prc := t.PullRequestComment; prc != nil {
+		out.PullRequestComment = &minderv1.PullRequestCommentAlertTemplate{Body: prc.Body}
+	}
+	return out
+}
+
+// Resolver returns the alert templates of the projects
+type Resolver interface {
+	// AlertTemplates returns the alert templates of the project, or nil if
+	// the project uses the built-in wording.
+	AlertTemplates(ctx context.Context, projectID uuid.UUID) (*Templates, error)
+}
+
+type projectTemplatesKey struct{}
+
+type projectTemplates struct {
+	resolver  Resolver
+	projectID uuid.UUID
+}
+
+// WithProjectTemplates returns a context through which the alerts of the
+// evaluated project are rendered with the templates of the project.
+func WithProjectTemplates(ctx context.Context, resolver Resolver, projectID uuid.UUID) context.Context {
+	return context.WithValue(ctx, projectTemplatesKey{}, projectTemplates{
+		resolver:  resolver,
+		projectID: projectID,
+	})
+}
+
+// FromContext returns the alert templates of the project being evaluated,
+// or nil if the built-in wording is to be used.
+func FromContext(ctx context.Context) (*Templates, error) {
+	pt, ok := ctx.Value(projectTemplatesKey{}).(projectTemplates)
+	if !ok || pt.resolver == nil {
+		return nil, nil
+	}
+	return pt.resolver.AlertTemplates(ctx, pt.projectID)
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package templates
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tmpls, err := Parse([]byte(`
+security_advisory:
+  title: "{{.Rule}} failed"
+  labels: [triage, minder]
+pull_request_comment:
+  body: "{{.Message}}"
+`))
+	require.NoError(t, err)
+	require.Equal(t, &Templates{
+		SecurityAdvisory: &SecurityAdvisory{
+			Title:  "{{.Rule}} failed",
+			Labels: []string{"triage", "minder"},
+		},
+		PullRequestComment: &PullRequestComment{Body: "{{.Message}}"},
+	}, tmpls)
+	require.NoError(t, tmpls.Validate())
+
+	_, err = Parse([]byte(`issue: {title: "unknown alert type"}`))
+	require.ErrorIs(t, err, ErrInvalidTemplates)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		templates Templates
+		wantErr   bool
+	}{
+		{
+			name: "valid templates",
+			templates: Templates{
+				SecurityAdvisory: &SecurityAdvisory{
+					Title: "{{.Severity}}: {{.FailureMessage}}",
+					Body:  "{{if .Remediate}}Remediated{{else}}{{.Guidance}}{{end}}",
+				},
+			},
+		},
+		{
+			name:      "empty security advisory template",
+			templates: Templates{SecurityAdvisory: &SecurityAdvisory{}},
+			wantErr:   true,
+		},
+		{
+			name:      "unparseable title",
+			templates: Templates{SecurityAdvisory: &SecurityAdvisory{Title: "{{.Rule"}},
+			wantErr:   true,
+		},
+		{
+			name:      "unknown field",
+			templates: Templates{SecurityAdvisory: &SecurityAdvisory{Body: "{{.Owner}}"}},
+			wantErr:   true,
+		},
+		{
+			name:      "label with brackets",
+			templates: Templates{SecurityAdvisory: &SecurityAdvisory{Labels: []string{"[triage]"}}},
+			wantErr:   true,
+		},
+		{
+			name:      "too many labels",
+			templates: Templates{SecurityAdvisory: &SecurityAdvisory{Labels: strings.Split("a,b,c,d,e,f,g,h,i,j,k", ",")}},
+			wantErr:   true,
+		},
+		{
+			name:      "empty pull request comment template",
+			templates: Templates{PullRequestComment: &PullRequestComment{}},
+			wantErr:   true,
+		},
+		{
+			name:      "pull request comment with a security advisory field",
+			templates: Templates{PullRequestComment: &PullRequestComment{Body: "{{.Severity}}"}},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.templates.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidTemplates)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSecurityAdvisoryRender(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	data := SampleSecurityAdvisoryData()
+
+	// the built-in wording is kept for what the template doesn't set
+	sa := &SecurityAdvisory{Labels: []string{"triage", "security"}}
+	title, body, err := sa.Render(ctx, data, "minder: profile failed", "built-in body")
+	require.NoError(t, err)
+	require.Equal(t, "[triage] [security] minder: profile failed", title)
+	require.Equal(t, "built-in body", body)
+
+	sa = &SecurityAdvisory{
+		Title: " {{.Repository}}: {{.FailureMessage}}\n",
+		Body:  "Severity: {{.Severity}}",
+	}
+	title, body, err = sa.Render(ctx, data, "minder: profile failed", "built-in body")
+	require.NoError(t, err)
+	require.Equal(t, "acme/widgets: Secret scanning is disabled", title)
+	require.Equal(t, "Severity: high", body)
+
+	sa = &SecurityAdvisory{Title: strings.Repeat("x", MaxTitleLength-5), Labels: []string{"triage"}}
+	_, _, err = sa.Render(ctx, data, "", "")
+	require.Error(t, err, "expected the labels to exceed the title limit")
+}
+
+func TestFromPB(t *testing.T) {
+	t.Parallel()
+
+	in := &minderv1.ProjectAlertTemplates{
+		SecurityAdvisory: &minderv1.SecurityAdvisoryAlertTemplate{
+			Title:  "{{.Rule}} failed",
+			Labels: []string{"triage"},
+		},
+		PullRequestComment: &minderv1.PullRequestCommentAlertTemplate{Body: "{{.Message}}"},
+	}
+	tmpls, err := FromPB(in)
+	require.NoError(t, err)
+	require.Equal(t, &Templates{
+		SecurityAdvisory:   &SecurityAdvisory{Title: "{{.Rule}} failed", Labels: []string{"triage"}},
+		PullRequestComment: &PullRequestComment{Body: "{{.Message}}"},
+	}, tmpls)
+	require.Equal(t, in, tmpls.ToPB())
+
+	// empty templates remove them
+	removed, err := FromPB(&minderv1.ProjectAlertTemplates{})
+	require.NoError(t, err)
+	require.Nil(t, removed)
+	require.Nil(t, removed.ToPB())
+
+	_, err = FromPB(&minderv1.ProjectAlertTemplates{
+		PullRequestComment: &minderv1.PullRequestCommentAlertTemplate{Body: "{{.Unknown}}"},
+	})
+	require.ErrorIs(t, err, ErrInvalidTemplates)
+}
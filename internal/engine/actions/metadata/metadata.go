@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metadata defines the metadata the remediation and alert engines
+// store about the objects they create in the providers, such as the pull
+// requests opened by remediations, so that they can be linked to.
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+const githubURL = "https://github.com"
+
+var validRepoSlugRe = regexp.MustCompile(`(?i)^[-a-z0-9_\.]+\/[-a-z0-9_\.]+$`)
+
+// Kind is the kind of object an action created in the provider
+type Kind string
+
+const (
+	// KindPullRequest is a pull request opened by a remediation
+	KindPullRequest Kind = "pull_request"
+	// KindIssue is an issue opened by a remediation
+	KindIssue Kind = "issue"
+	// KindSecurityAdvisory is a security advisory opened by an alert
+	KindSecurityAdvisory Kind = "security_advisory"
+	// KindPullRequestReview is a pull request review left by an alert
+	KindPullRequestReview Kind = "pull_request_review"
+	// KindCodeScanningAnalysis is a code scanning analysis uploaded by an alert
+	KindCodeScanningAnalysis Kind = "code_scanning_analysis"
+)
+
+// PullRequestRemediation is the metadata of the pull_request remediations
+type PullRequestRemediation struct {
+	Number int `json:"pr_number,omitempty"`
+	// AutoMerge is the merge method requested for auto-merging the pull request
+	AutoMerge string `json:"auto_merge,omitempty"`
+	// MergeStatus tracks the outcome of auto-merging the pull request
+	MergeStatus string `json:"merge_status,omitempty"`
+	// Refreshes counts how many times the pull request was regenerated
+	// because it went stale or conflicted with the base branch
+	Refreshes int `json:"refreshes,omitempty"`
+	// Contents are the expected contents of the files of the remediation, by
+	// path, which are the base of the merge of the next remediation
+	Contents map[string]string `json:"contents,omitempty"`
+}
+
+// IssueRemediation is the metadata of the issue remediations
+type IssueRemediation struct {
+	Number int `json:"issue_number,omitempty"`
+}
+
+// SecurityAdvisoryAlert is the metadata of the security_advisory alerts
+type SecurityAdvisoryAlert struct {
+	ID string `json:"ghsa_id,omitempty"`
+	// Grouped is set when the alert is part of the consolidated security
+	// advisory of the profile, instead of having its own
+	Grouped bool `json:"grouped,omitempty"`
+}
+
+// PullRequestCommentAlert is the metadata of the pull_request_comment alerts
+type PullRequestCommentAlert struct {
+	ReviewID       string     `json:"review_id,omitempty"`
+	SubmittedAt    *time.Time `json:"submitted_at,omitempty"`
+	PullRequestUrl *string    `json:"pull_request_url,omitempty"`
+}
+
+// CodeScanningAlert is the metadata of the code_scanning alerts
+type CodeScanningAlert struct {
+	SarifID string `json:"sarif_id,omitempty"`
+	// Category identifies the analyses of the rule, which replace each other
+	Category  string `json:"category,omitempty"`
+	Ref       string `json:"ref,omitempty"`
+	CommitSHA string `json:"commit_sha,omitempty"`
+}
+
+// Link references an object an action created in the provider
+type Link struct {
+	Kind Kind
+	// Ref is the short reference of the object, e.g. #42
+	Ref string
+	// URL is the link to the object, which is empty if the repository of
+	// the object is unknown
+	URL string
+}
+
+// RemediationLink returns the link to the object created by a remediation,
+// given its metadata and the owner/name slug of the repository it was
+// created in. Nil is returned if the remediation didn't create any.
+func RemediationLink(data []byte, repoSlug string) (*Link, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var meta struct {
+		PullRequestRemediation
+		IssueRemediation
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("unmarshalling remediation metadata: %w", err)
+	}
+
+	switch {
+	case meta.PullRequestRemediation.Number != 0:
+		return &Link{
+			Kind: KindPullRequest,
+			Ref:  fmt.Sprintf("#%d", meta.PullRequestRemediation.Number),
+			URL:  repoURL(repoSlug, "pull/"+strconv.Itoa(meta.PullRequestRemediation.Number)),
+		}, nil
+	case meta.IssueRemediation.Number != 0:
+		return &Link{
+			Kind: KindIssue,
+			Ref:  fmt.Sprintf("#%d", meta.IssueRemediation.Number),
+			URL:  repoURL(repoSlug, "issues/"+strconv.Itoa(meta.IssueRemediation.Number)),
+		}, nil
+	}
+	return nil, nil
+}
+
+// AlertLink returns the link to the object created by an alert, given its
+// metadata and the owner/name slug of the repository it was created in. Nil
+// is returned if the alert didn't create any.
+func AlertLink(data []byte, repoSlug string) (*Link, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var meta struct {
+		SecurityAdvisoryAlert
+		PullRequestCommentAlert
+		CodeScanningAlert
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("unmarshalling alert metadata: %w", err)
+	}
+
+	switch {
+	case meta.ID != "":
+		return &Link{
+			Kind: KindSecurityAdvisory,
+			Ref:  meta.ID,
+			URL:  repoURL(repoSlug, "security/advisories/"+meta.ID),
+		}, nil
+	case meta.ReviewID != "":
+		link := &Link{
+			Kind: KindPullRequestReview,
+			Ref:  "review " + meta.ReviewID,
+		}
+		// the review URL is stored as returned by GitHub
+		if meta.PullRequestUrl != nil {
+			link.URL = *meta.PullRequestUrl
+		}
+		return link, nil
+	case meta.SarifID != "":
+		return &Link{
+			Kind: KindCodeScanningAnalysis,
+			Ref:  meta.Category,
+			URL:  repoURL(repoSlug, "security/code-scanning"),
+		}, nil
+	}
+	return nil, nil
+}
+
+// ValidRepoSlug returns true if the slug is a valid owner/name slug of a
+// GitHub repository
+func ValidRepoSlug(repoSlug string) bool {
+	return validRepoSlugRe.MatchString(repoSlug)
+}
+
+func repoURL(repoSlug, path string) string {
+	if !ValidRepoSlug(repoSlug) {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s", githubURL, repoSlug, path)
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemediationLink(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		data    string
+		repo    string
+		want    *Link
+		wantErr bool
+	}{
+		{
+			name: "pull request",
+			data: `{"pr_number": 18, "auto_merge": "squash"}`,
+			repo: "example/test",
+			want: &Link{Kind: KindPullRequest, Ref: "#18", URL: "https://github.com/example/test/pull/18"},
+		},
+		{
+			name: "issue",
+			data: `{"issue_number": 7}`,
+			repo: "example/test",
+			want: &Link{Kind: KindIssue, Ref: "#7", URL: "https://github.com/example/test/issues/7"},
+		},
+		{
+			name: "unknown repository",
+			data: `{"pr_number": 18}`,
+			want: &Link{Kind: KindPullRequest, Ref: "#18"},
+		},
+		{
+			name: "no object",
+			data: `{}`,
+			repo: "example/test",
+		},
+		{
+			name: "no metadata",
+			repo: "example/test",
+		},
+		{
+			name:    "invalid metadata",
+			data:    `Yo!`,
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			link, err := RemediationLink([]byte(tc.data), tc.repo)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, link)
+		})
+	}
+}
+
+func TestAlertLink(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		data    string
+		repo    string
+		want    *Link
+		wantErr bool
+	}{
+		{
+			name: "security advisory",
+			data: `{"ghsa_id": "GHSA-xxxx-xxxx-xxxx"}`,
+			repo: "example/test",
+			want: &Link{
+				Kind: KindSecurityAdvisory,
+				Ref:  "GHSA-xxxx-xxxx-xxxx",
+				URL:  "https://github.com/example/test/security/advisories/GHSA-xxxx-xxxx-xxxx",
+			},
+		},
+		{
+			name: "pull request review",
+			data: `{"review_id": "456", "pull_request_url": "https://github.com/example/test/pull/3#pullrequestreview-456"}`,
+			want: &Link{
+				Kind: KindPullRequestReview,
+				Ref:  "review 456",
+				URL:  "https://github.com/example/test/pull/3#pullrequestreview-456",
+			},
+		},
+		{
+			name: "code scanning analysis",
+			data: `{"sarif_id": "47177e22-5596-11eb-80a1-c1e54ef945c6", "category": "minder/profile/rule"}`,
+			repo: "example/test",
+			want: &Link{
+				Kind: KindCodeScanningAnalysis,
+				Ref:  "minder/profile/rule",
+				URL:  "https://github.com/example/test/security/code-scanning",
+			},
+		},
+		{
+			name: "invalid repository",
+			data: `{"ghsa_id": "GHSA-xxxx-xxxx-xxxx"}`,
+			repo: "invalid slug",
+			want: &Link{Kind: KindSecurityAdvisory, Ref: "GHSA-xxxx-xxxx-xxxx"},
+		},
+		{
+			name: "no object",
+			data: `{"ghsa_id": ""}`,
+			repo: "example/test",
+		},
+		{
+			name:    "invalid metadata",
+			data:    `invalid _`,
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			link, err := AlertLink([]byte(tc.data), tc.repo)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, link)
+		})
+	}
+}
@@ -111,7 +111,7 @@ func (r *GhBranchProtectRemediator) Do(
 	retp := &PatchTemplateParams{
 		Entity:  ent,
 		Profile: params.GetRule().Def,
-		Params:  params.GetRule().Params,
+		Params:  params.GetRuleParams(),
 	}
 
 	repo, ok := ent.(*pb.Repository)
@@ -119,7 +119,7 @@ func (r *GhBranchProtectRemediator) Do(
 		return nil, fmt.Errorf("expected repository, got %T", ent)
 	}
 
-	branch, err := util.JQReadFrom[string](ctx, ".branch", params.GetRule().Params)
+	branch, err := util.JQReadFrom[string](ctx, ".branch", params.GetRuleParams())
 	if err != nil && !errors.Is(err, util.ErrNoValueFound) {
 		return nil, fmt.Errorf("error reading branch from params: %w", err)
 	}
@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gh_collaborator_permissions provides the remediation engine which
+// downgrades the permissions of the collaborators and teams of a repository
+package gh_collaborator_permissions
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// RemediateType is the type of the collaborator permissions remediation engine
+	RemediateType = "gh_collaborator_permissions"
+
+	defaultPermission = "read"
+
+	kindUser = "user"
+	kindTeam = "team"
+
+	affiliationDirect = "direct"
+)
+
+// apiPermissions maps the role names to the permission names of the GitHub API
+var apiPermissions = map[string]string{
+	"read":     "pull",
+	"triage":   "triage",
+	"write":    "push",
+	"maintain": "maintain",
+}
+
+// principal is a collaborator or team listed in the output of the evaluation
+type principal struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// GhCollaboratorPermissionsRemediator keeps the status for a rule type that
+// downgrades the permissions of collaborators and teams
+type GhCollaboratorPermissionsRemediator struct {
+	actionType interfaces.ActionType
+	cli        provifv1.GitHub
+	permission string
+	setting    models.ActionOpt
+}
+
+// NewGhCollaboratorPermissionsRemediator creates a new remediation engine
+// that uses the GitHub API to downgrade the permissions of collaborators and teams
+func NewGhCollaboratorPermissionsRemediator(
+	actionType interfaces.ActionType,
+	cfg *pb.RuleType_Definition_Remediate_GhCollaboratorPermissionsType,
+	cli provifv1.GitHub,
+	setting models.ActionOpt,
+) (*GhCollaboratorPermissionsRemediator, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	role := cmp.Or(cfg.GetPermission(), defaultPermission)
+	permission, ok := apiPermissions[role]
+	if !ok {
+		return nil, fmt.Errorf("invalid permission: %s", role)
+	}
+
+	return &GhCollaboratorPermissionsRemediator{
+		actionType: actionType,
+		cli:        cli,
+		permission: permission,
+		setting:    setting,
+	}, nil
+}
+
+// Class returns the action type of the remediation engine
+func (r *GhCollaboratorPermissionsRemediator) Class() interfaces.ActionType {
+	return r.actionType
+}
+
+// Type returns the action subtype of the remediation engine
+func (*GhCollaboratorPermissionsRemediator) Type() string {
+	return RemediateType
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (r *GhCollaboratorPermissionsRemediator) GetOnOffState() models.ActionOpt {
+	return models.ActionOptOrDefault(r.setting, models.ActionOptOff)
+}
+
+// Do perform the remediation, setting the permission of every principal
+// listed in the output of the evaluation. Only the direct collaborators of
+// the repository are updated: users having access through a team or the
+// organization base permission are skipped, since setting their permission
+// would invite them as collaborators and grant them direct access.
+func (r *GhCollaboratorPermissionsRemediator) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	ent protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	_ *json.RawMessage,
+) (json.RawMessage, error) {
+	// Permissions which were downgraded can't be restored, so only proceed
+	// with the remediation if the command is to turn on the action
+	if cmd != interfaces.ActionCmdOn {
+		return nil, engerrors.ErrActionSkipped
+	}
+
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("expected repository, got %T", ent)
+	}
+
+	var output any
+	if params.GetEvalResult() != nil {
+		output = params.GetEvalResult().Output
+	}
+	principals, err := principalsFromOutput(output)
+	if err != nil {
+		return nil, err
+	}
+	if len(principals) == 0 {
+		return nil, fmt.Errorf("no principals in evaluation output: %w", engerrors.ErrActionSkipped)
+	}
+
+	principals, err = r.directPrincipals(ctx, repo, principals)
+	if err != nil {
+		return nil, err
+	}
+	if len(principals) == 0 {
+		return nil, fmt.Errorf("no direct collaborators or teams to downgrade: %w", engerrors.ErrActionSkipped)
+	}
+
+	for _, p := range principals {
+		zerolog.Ctx(ctx).Debug().
+			Str("kind", p.Kind).
+			Str("name", p.Name).
+			Str("permission", r.permission).
+			Msg("downgrading permission")
+
+		switch r.setting {
+		case models.ActionOptOn:
+			err = r.update(ctx, repo, p)
+		case models.ActionOptDryRun:
+			err = r.dryRun(ctx, repo, p)
+		case models.ActionOptOff, models.ActionOptUnknown:
+			err = errors.New("unexpected action")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// directPrincipals returns the teams and the users which are direct
+// collaborators of the repository among the given principals
+func (r *GhCollaboratorPermissionsRemediator) directPrincipals(
+	ctx context.Context, repo *pb.Repository, principals []principal,
+) ([]principal, error) {
+	if !slices.ContainsFunc(principals, func(p principal) bool { return p.Kind == kindUser }) {
+		return principals, nil
+	}
+
+	users, err := r.cli.ListCollaborators(ctx, repo.Owner, repo.Name, affiliationDirect)
+	if err != nil {
+		return nil, fmt.Errorf("error listing direct collaborators: %w", err)
+	}
+
+	direct := make([]principal, 0, len(principals))
+	for _, p := range principals {
+		// GitHub logins are case-insensitive
+		if p.Kind == kindUser && !slices.ContainsFunc(users, func(u *github.User) bool {
+			return strings.EqualFold(u.GetLogin(), p.Name)
+		}) {
+			zerolog.Ctx(ctx).Debug().
+				Str("name", p.Name).
+				Msg("skipping user which is not a direct collaborator")
+			continue
+		}
+		direct = append(direct, p)
+	}
+	return direct, nil
+}
+
+func (r *GhCollaboratorPermissionsRemediator) update(ctx context.Context, repo *pb.Repository, p principal) error {
+	if p.Kind == kindTeam {
+		// Repositories can only be shared with the teams of the organization
+		// owning them.
+		if err := r.cli.UpdateTeamRepoPermission(
+			ctx, repo.Owner, p.Name, repo.Owner, repo.Name, r.permission,
+		); err != nil {
+			return fmt.Errorf("error updating permission of team %s: %w", p.Name, err)
+		}
+		return nil
+	}
+
+	if err := r.cli.UpdateCollaboratorPermission(ctx, repo.Owner, repo.Name, p.Name, r.permission); err != nil {
+		return fmt.Errorf("error updating permission of collaborator %s: %w", p.Name, err)
+	}
+	return nil
+}
+
+func (r *GhCollaboratorPermissionsRemediator) dryRun(ctx context.Context, repo *pb.Repository, p principal) error {
+	endpoint := fmt.Sprintf("repos/%v/%v/collaborators/%v", repo.Owner, repo.Name, p.Name)
+	if p.Kind == kindTeam {
+		endpoint = fmt.Sprintf("orgs/%v/teams/%v/repos/%v/%v", repo.Owner, p.Name, repo.Owner, repo.Name)
+	}
+
+	body := fmt.Sprintf(`{"permission":%q}`, r.permission)
+	curlCmd, err := util.GenerateCurlCommand(ctx, http.MethodPut, r.cli.GetBaseURL(), endpoint, body)
+	if err != nil {
+		return fmt.Errorf("cannot generate curl command: %w", err)
+	}
+
+	log.Printf("run the following curl command: \n%s\n", curlCmd)
+	return nil
+}
+
+// principalsFromOutput reads the principals from the output of the
+// evaluation, which must be a list of objects with their "kind" and "name".
+func principalsFromOutput(output any) ([]principal, error) {
+	if output == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(output)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal evaluation output: %w", err)
+	}
+
+	var principals []principal
+	if err := json.Unmarshal(raw, &principals); err != nil {
+		return nil, fmt.Errorf("evaluation output is not a list of principals: %w", err)
+	}
+
+	for _, p := range principals {
+		if p.Name == "" {
+			return nil, errors.New("evaluation output contains a principal without name")
+		}
+		if p.Kind != kindUser && p.Kind != kindTeam {
+			return nil, fmt.Errorf("evaluation output contains a principal of unknown kind %q", p.Kind)
+		}
+	}
+
+	return principals, nil
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gh_collaborator_permissions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	mock_ghclient "github.com/mindersec/minder/internal/providers/github/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	engif "github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+const (
+	repoOwner = "stacklok"
+	repoName  = "minder"
+)
+
+var testActionType interfaces.ActionType = "remediate-test"
+
+func expectDirectCollaborators(m *mock_ghclient.MockGitHub, logins ...string) {
+	users := make([]*github.User, 0, len(logins))
+	for _, login := range logins {
+		users = append(users, &github.User{Login: github.String(login)})
+	}
+	m.EXPECT().
+		ListCollaborators(gomock.Any(), repoOwner, repoName, "direct").
+		Return(users, nil)
+}
+
+func TestCollaboratorPermissionsRemediate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		cfg        *pb.RuleType_Definition_Remediate_GhCollaboratorPermissionsType
+		setting    models.ActionOpt
+		output     any
+		mockSetup  func(*mock_ghclient.MockGitHub)
+		wantSkip   bool
+		wantErr    bool
+		wantNewErr bool
+	}{
+		{
+			name:    "users and teams are downgraded to read by default",
+			setting: models.ActionOptOn,
+			output: []any{
+				map[string]any{"kind": "user", "name": "contractor", "role": "admin"},
+				map[string]any{"kind": "team", "name": "devs", "role": "maintain"},
+			},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				expectDirectCollaborators(m, "contractor")
+				m.EXPECT().
+					UpdateCollaboratorPermission(gomock.Any(), repoOwner, repoName, "contractor", "pull").
+					Return(nil)
+				m.EXPECT().
+					UpdateTeamRepoPermission(gomock.Any(), repoOwner, "devs", repoOwner, repoName, "pull").
+					Return(nil)
+			},
+		},
+		{
+			name: "users are downgraded to the configured permission",
+			cfg: &pb.RuleType_Definition_Remediate_GhCollaboratorPermissionsType{
+				Permission: "write",
+			},
+			setting: models.ActionOptOn,
+			output:  []any{map[string]any{"kind": "user", "name": "contractor"}},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				expectDirectCollaborators(m, "Contractor")
+				m.EXPECT().
+					UpdateCollaboratorPermission(gomock.Any(), repoOwner, repoName, "contractor", "push").
+					Return(nil)
+			},
+		},
+		{
+			name:    "users with access only through a team are not invited",
+			setting: models.ActionOptOn,
+			output: []any{
+				map[string]any{"kind": "user", "name": "team-member", "role": "maintain"},
+				map[string]any{"kind": "team", "name": "devs", "role": "maintain"},
+			},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				expectDirectCollaborators(m, "contractor")
+				m.EXPECT().
+					UpdateTeamRepoPermission(gomock.Any(), repoOwner, "devs", repoOwner, repoName, "pull").
+					Return(nil)
+			},
+		},
+		{
+			name:    "no direct collaborator is skipped",
+			setting: models.ActionOptOn,
+			output:  []any{map[string]any{"kind": "user", "name": "team-member"}},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				expectDirectCollaborators(m)
+			},
+			wantSkip: true,
+		},
+		{
+			name:    "listing direct collaborators fails",
+			setting: models.ActionOptOn,
+			output:  []any{map[string]any{"kind": "user", "name": "contractor"}},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				m.EXPECT().
+					ListCollaborators(gomock.Any(), repoOwner, repoName, "direct").
+					Return(nil, errors.New("forbidden"))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "dry run doesn't update permissions",
+			setting: models.ActionOptDryRun,
+			output:  []any{map[string]any{"kind": "team", "name": "devs"}},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				m.EXPECT().GetBaseURL().Return("https://api.github.com/")
+			},
+		},
+		{
+			name:     "no output is skipped",
+			setting:  models.ActionOptOn,
+			wantSkip: true,
+		},
+		{
+			name:    "unknown principal kind",
+			setting: models.ActionOptOn,
+			output:  []any{map[string]any{"kind": "bot", "name": "dependabot"}},
+			wantErr: true,
+		},
+		{
+			name:    "update failure",
+			setting: models.ActionOptOn,
+			output:  []any{map[string]any{"kind": "user", "name": "contractor"}},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				expectDirectCollaborators(m, "contractor")
+				m.EXPECT().
+					UpdateCollaboratorPermission(gomock.Any(), repoOwner, repoName, "contractor", "pull").
+					Return(errors.New("forbidden"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "admin is not a valid target permission",
+			cfg: &pb.RuleType_Definition_Remediate_GhCollaboratorPermissionsType{
+				Permission: "admin",
+			},
+			setting:    models.ActionOptOn,
+			wantNewErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockClient := mock_ghclient.NewMockGitHub(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockClient)
+			}
+
+			engine, err := NewGhCollaboratorPermissionsRemediator(testActionType, tt.cfg, mockClient, tt.setting)
+			if tt.wantNewErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			evalParams := &interfaces.EvalStatusParams{}
+			evalParams.SetEvalResult(&engif.EvaluationResult{Output: tt.output})
+
+			ent := &pb.Repository{Owner: repoOwner, Name: repoName}
+			_, err = engine.Do(context.Background(), interfaces.ActionCmdOn, ent, evalParams, nil)
+			switch {
+			case tt.wantSkip:
+				require.ErrorIs(t, err, engerrors.ErrActionSkipped)
+			case tt.wantErr:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gh_deploy_key_removal provides the remediation engine which removes
+// the deploy keys of a repository
+package gh_deploy_key_removal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// RemediateType is the type of the deploy key removal remediation engine
+	RemediateType = "gh_deploy_key_removal"
+)
+
+// deployKey is a deploy key listed in the output of the evaluation
+type deployKey struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+// GhDeployKeyRemovalRemediator keeps the status for a rule type that removes
+// deploy keys
+type GhDeployKeyRemovalRemediator struct {
+	actionType interfaces.ActionType
+	cli        provifv1.GitHub
+	setting    models.ActionOpt
+}
+
+// NewGhDeployKeyRemovalRemediator creates a new remediation engine that uses
+// the GitHub API to remove deploy keys
+func NewGhDeployKeyRemovalRemediator(
+	actionType interfaces.ActionType,
+	cli provifv1.GitHub,
+	setting models.ActionOpt,
+) (*GhDeployKeyRemovalRemediator, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	return &GhDeployKeyRemovalRemediator{
+		actionType: actionType,
+		cli:        cli,
+		setting:    setting,
+	}, nil
+}
+
+// Class returns the action type of the remediation engine
+func (r *GhDeployKeyRemovalRemediator) Class() interfaces.ActionType {
+	return r.actionType
+}
+
+// Type returns the action subtype of the remediation engine
+func (*GhDeployKeyRemovalRemediator) Type() string {
+	return RemediateType
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (r *GhDeployKeyRemovalRemediator) GetOnOffState() models.ActionOpt {
+	return models.ActionOptOrDefault(r.setting, models.ActionOptOff)
+}
+
+// Do perform the remediation, removing every deploy key listed in the output
+// of the evaluation
+func (r *GhDeployKeyRemovalRemediator) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	ent protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	_ *json.RawMessage,
+) (json.RawMessage, error) {
+	// Removed deploy keys can't be restored, so only proceed with the
+	// remediation if the command is to turn on the action
+	if cmd != interfaces.ActionCmdOn {
+		return nil, engerrors.ErrActionSkipped
+	}
+
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("expected repository, got %T", ent)
+	}
+
+	var output any
+	if params.GetEvalResult() != nil {
+		output = params.GetEvalResult().Output
+	}
+	keys, err := deployKeysFromOutput(output)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no deploy keys in evaluation output: %w", engerrors.ErrActionSkipped)
+	}
+
+	for _, k := range keys {
+		zerolog.Ctx(ctx).Debug().
+			Int64("id", k.ID).
+			Str("title", k.Title).
+			Msg("removing deploy key")
+
+		switch r.setting {
+		case models.ActionOptOn:
+			if err := r.cli.DeleteDeployKey(ctx, repo.Owner, repo.Name, k.ID); err != nil {
+				return nil, fmt.Errorf("error removing deploy key %d: %w", k.ID, err)
+			}
+		case models.ActionOptDryRun:
+			if err := dryRun(ctx, r.cli.GetBaseURL(), repo.Owner, repo.Name, k.ID); err != nil {
+				return nil, err
+			}
+		case models.ActionOptOff, models.ActionOptUnknown:
+			return nil, errors.New("unexpected action")
+		}
+	}
+
+	return nil, nil
+}
+
+func dryRun(ctx context.Context, baseUrl, owner, repo string, id int64) error {
+	endpoint := fmt.Sprintf("repos/%v/%v/keys/%v", owner, repo, id)
+	curlCmd, err := util.GenerateCurlCommand(ctx, http.MethodDelete, baseUrl, endpoint, "")
+	if err != nil {
+		return fmt.Errorf("cannot generate curl command: %w", err)
+	}
+
+	log.Printf("run the following curl command: \n%s\n", curlCmd)
+	return nil
+}
+
+// deployKeysFromOutput reads the deploy keys from the output of the
+// evaluation, which must be a list of objects with their "id".
+func deployKeysFromOutput(output any) ([]deployKey, error) {
+	if output == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(output)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal evaluation output: %w", err)
+	}
+
+	var keys []deployKey
+	if err := json.Unmarshal(raw, &keys); err != nil {
+		return nil, fmt.Errorf("evaluation output is not a list of deploy keys: %w", err)
+	}
+
+	for _, k := range keys {
+		if k.ID == 0 {
+			return nil, errors.New("evaluation output contains a deploy key without id")
+		}
+	}
+
+	return keys, nil
+}
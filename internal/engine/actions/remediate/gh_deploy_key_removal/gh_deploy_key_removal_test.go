@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gh_deploy_key_removal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	mock_ghclient "github.com/mindersec/minder/internal/providers/github/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	engif "github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+const (
+	repoOwner = "stacklok"
+	repoName  = "minder"
+)
+
+var testActionType interfaces.ActionType = "remediate-test"
+
+func TestDeployKeyRemovalRemediate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setting   models.ActionOpt
+		output    any
+		mockSetup func(*mock_ghclient.MockGitHub)
+		wantSkip  bool
+		wantErr   bool
+	}{
+		{
+			name:    "listed deploy keys are removed",
+			setting: models.ActionOptOn,
+			output: []any{
+				// rego numbers are decoded as json.Number
+				map[string]any{"id": json.Number("42"), "title": "deploy"},
+				map[string]any{"id": json.Number("43")},
+			},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				m.EXPECT().DeleteDeployKey(gomock.Any(), repoOwner, repoName, int64(42)).Return(nil)
+				m.EXPECT().DeleteDeployKey(gomock.Any(), repoOwner, repoName, int64(43)).Return(nil)
+			},
+		},
+		{
+			name:    "dry run doesn't remove deploy keys",
+			setting: models.ActionOptDryRun,
+			output:  []any{map[string]any{"id": json.Number("42")}},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				m.EXPECT().GetBaseURL().Return("https://api.github.com/")
+			},
+		},
+		{
+			name:     "no output is skipped",
+			setting:  models.ActionOptOn,
+			wantSkip: true,
+		},
+		{
+			name:    "output without ids",
+			setting: models.ActionOptOn,
+			output:  "always fail",
+			wantErr: true,
+		},
+		{
+			name:    "removal failure",
+			setting: models.ActionOptOn,
+			output:  []any{map[string]any{"id": json.Number("42")}},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				m.EXPECT().DeleteDeployKey(gomock.Any(), repoOwner, repoName, int64(42)).
+					Return(errors.New("forbidden"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockClient := mock_ghclient.NewMockGitHub(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockClient)
+			}
+
+			engine, err := NewGhDeployKeyRemovalRemediator(testActionType, mockClient, tt.setting)
+			require.NoError(t, err)
+
+			evalParams := &interfaces.EvalStatusParams{}
+			evalParams.SetEvalResult(&engif.EvaluationResult{Output: tt.output})
+
+			ent := &pb.Repository{Owner: repoOwner, Name: repoName}
+			_, err = engine.Do(context.Background(), interfaces.ActionCmdOn, ent, evalParams, nil)
+			switch {
+			case tt.wantSkip:
+				require.ErrorIs(t, err, engerrors.ErrActionSkipped)
+			case tt.wantErr:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
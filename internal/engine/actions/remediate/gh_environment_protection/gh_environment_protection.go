@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gh_environment_protection provides the remediation engine which
+// configures the protection rules of the deployment environments of a repository
+package gh_environment_protection
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/google/go-github/v63/github"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// RemediateType is the type of the environment protection remediation engine
+	RemediateType = "gh_environment_protection"
+
+	// PatchTemplateLimit is the maximum number of bytes for the patch template
+	PatchTemplateLimit = 2048
+
+	ruleTypeRequiredReviewers = "required_reviewers"
+	ruleTypeWaitTimer         = "wait_timer"
+)
+
+// GhEnvironmentProtectionRemediator keeps the status for a rule type that
+// uses the GitHub API to configure the protection rules of environments
+type GhEnvironmentProtectionRemediator struct {
+	actionType    interfaces.ActionType
+	cli           provifv1.GitHub
+	patchTemplate *util.SafeTemplate
+	setting       models.ActionOpt
+}
+
+// NewGhEnvironmentProtectionRemediator creates a new remediation engine that
+// uses the GitHub API to configure the protection rules of environments
+func NewGhEnvironmentProtectionRemediator(
+	actionType interfaces.ActionType,
+	cfg *pb.RuleType_Definition_Remediate_GhEnvironmentProtectionType,
+	cli provifv1.GitHub,
+	setting models.ActionOpt,
+) (*GhEnvironmentProtectionRemediator, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	patch := cfg.GetPatch()
+	patchTemplate, err := util.NewSafeTextTemplate(&patch, "patch")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse patch template: %w", err)
+	}
+
+	return &GhEnvironmentProtectionRemediator{
+		actionType:    actionType,
+		cli:           cli,
+		patchTemplate: patchTemplate,
+		setting:       setting,
+	}, nil
+}
+
+// PatchTemplateParams is the parameters for the patch template
+type PatchTemplateParams struct {
+	// Entity is the entity to be evaluated
+	Entity any
+	// Profile are the parameters to be used in the template
+	Profile map[string]any
+	// Params are the rule parameters to be used in the template
+	Params map[string]any
+	// Environment is the name of the environment being configured
+	Environment string
+}
+
+// Class returns the action type of the remediation engine
+func (r *GhEnvironmentProtectionRemediator) Class() interfaces.ActionType {
+	return r.actionType
+}
+
+// Type returns the action subtype of the remediation engine
+func (*GhEnvironmentProtectionRemediator) Type() string {
+	return RemediateType
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (r *GhEnvironmentProtectionRemediator) GetOnOffState() models.ActionOpt {
+	return models.ActionOptOrDefault(r.setting, models.ActionOptOff)
+}
+
+// Do perform the remediation, merging the patch with the current protection
+// rules of every environment listed in the output of the evaluation.
+// Environments which don't exist are created.
+func (r *GhEnvironmentProtectionRemediator) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	ent protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	_ *json.RawMessage,
+) (json.RawMessage, error) {
+	// Remediating through REST calls doesn't really have a turn-off behavior so
+	// only proceed with the remediation if the command is to turn on the action
+	if cmd != interfaces.ActionCmdOn {
+		return nil, engerrors.ErrActionSkipped
+	}
+
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("expected repository, got %T", ent)
+	}
+
+	var output any
+	if params.GetEvalResult() != nil {
+		output = params.GetEvalResult().Output
+	}
+	names, err := environmentsFromOutput(output)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no environments in evaluation output: %w", engerrors.ErrActionSkipped)
+	}
+
+	envs, err := r.cli.ListEnvironments(ctx, repo.Owner, repo.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error listing environments: %w", err)
+	}
+	current := make(map[string]*github.Environment, len(envs))
+	for _, env := range envs {
+		current[env.GetName()] = env
+	}
+
+	for _, name := range names {
+		var patch bytes.Buffer
+		err := r.patchTemplate.Execute(ctx, &patch, &PatchTemplateParams{
+			Entity:      ent,
+			Profile:     params.GetRule().Def,
+			Params:      params.GetRuleParams(),
+			Environment: name,
+		}, PatchTemplateLimit)
+		if err != nil {
+			return nil, fmt.Errorf("cannot execute patch template: %w", err)
+		}
+
+		zerolog.Ctx(ctx).Debug().Str("environment", name).Str("patch", patch.String()).Msg("patch")
+
+		req, err := patchRequest(environmentToRequest(current[name]), patch.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("error patching request: %w", err)
+		}
+
+		switch r.setting {
+		case models.ActionOptOn:
+			err = r.cli.UpdateEnvironment(ctx, repo.Owner, repo.Name, name, req)
+		case models.ActionOptDryRun:
+			err = dryRun(ctx, r.cli.GetBaseURL(), repo.Owner, repo.Name, name, req)
+		case models.ActionOptOff, models.ActionOptUnknown:
+			err = errors.New("unexpected action")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error updating environment %s: %w", name, err)
+		}
+	}
+
+	return nil, nil
+}
+
+func dryRun(ctx context.Context, baseUrl, owner, repo, environment string, req *github.CreateUpdateEnvironment) error {
+	jsonReq, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("error marshalling data: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("repos/%v/%v/environments/%v", owner, repo, url.PathEscape(environment))
+	curlCmd, err := util.GenerateCurlCommand(ctx, http.MethodPut, baseUrl, endpoint, string(jsonReq))
+	if err != nil {
+		return fmt.Errorf("cannot generate curl command: %w", err)
+	}
+
+	log.Printf("run the following curl command: \n%s\n", curlCmd)
+	return nil
+}
+
+// environmentToRequest returns the request keeping the current protection
+// rules of the environment, or an empty request if it doesn't exist.
+func environmentToRequest(env *github.Environment) *github.CreateUpdateEnvironment {
+	if env == nil {
+		return &github.CreateUpdateEnvironment{}
+	}
+
+	req := &github.CreateUpdateEnvironment{
+		CanAdminsBypass:        env.CanAdminsBypass,
+		DeploymentBranchPolicy: env.DeploymentBranchPolicy,
+	}
+
+	for _, rule := range env.ProtectionRules {
+		switch rule.GetType() {
+		case ruleTypeWaitTimer:
+			req.WaitTimer = rule.WaitTimer
+		case ruleTypeRequiredReviewers:
+			req.PreventSelfReview = rule.PreventSelfReview
+			for _, rev := range rule.Reviewers {
+				switch reviewer := rev.Reviewer.(type) {
+				case *github.User:
+					req.Reviewers = append(req.Reviewers, &github.EnvReviewers{
+						Type: github.String("User"),
+						ID:   reviewer.ID,
+					})
+				case *github.Team:
+					req.Reviewers = append(req.Reviewers, &github.EnvReviewers{
+						Type: github.String("Team"),
+						ID:   reviewer.ID,
+					})
+				}
+			}
+		}
+	}
+
+	return req
+}
+
+func patchRequest(
+	req *github.CreateUpdateEnvironment,
+	patch []byte,
+) (*github.CreateUpdateEnvironment, error) {
+	jReq, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling request: %w", err)
+	}
+
+	mergedBytes, err := jsonpatch.MergePatch(jReq, patch)
+	if err != nil {
+		return nil, fmt.Errorf("error merging patch: %w", err)
+	}
+
+	merged := &github.CreateUpdateEnvironment{}
+	err = json.Unmarshal(mergedBytes, merged)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling merged request: %w", err)
+	}
+
+	return merged, nil
+}
+
+// environmentsFromOutput reads the names of the environments from the output
+// of the evaluation, which must be a list of objects with their "name".
+func environmentsFromOutput(output any) ([]string, error) {
+	if output == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(output)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal evaluation output: %w", err)
+	}
+
+	var envs []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(raw, &envs); err != nil {
+		return nil, fmt.Errorf("evaluation output is not a list of environments: %w", err)
+	}
+
+	names := make([]string, 0, len(envs))
+	for _, env := range envs {
+		if env.Name == "" {
+			return nil, errors.New("evaluation output contains an environment without name")
+		}
+		names = append(names, env.Name)
+	}
+
+	return names, nil
+}
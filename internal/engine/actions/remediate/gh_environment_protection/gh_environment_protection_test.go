@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gh_environment_protection
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	mock_ghclient "github.com/mindersec/minder/internal/providers/github/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	engif "github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+const (
+	repoOwner = "stacklok"
+	repoName  = "minder"
+
+	reviewersPatch = `{"reviewers": [{"type": "Team", "id": {{ .Profile.team_id }}}], "prevent_self_review": true}`
+)
+
+var testActionType interfaces.ActionType = "remediate-test"
+
+func TestEnvironmentProtectionRemediate(t *testing.T) {
+	t.Parallel()
+
+	production := &github.Environment{
+		Name:            github.String("production"),
+		CanAdminsBypass: github.Bool(false),
+		ProtectionRules: []*github.ProtectionRule{
+			{Type: github.String("wait_timer"), WaitTimer: github.Int(30)},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		patch     string
+		setting   models.ActionOpt
+		output    any
+		mockSetup func(*mock_ghclient.MockGitHub)
+		wantSkip  bool
+		wantErr   bool
+	}{
+		{
+			name:    "existing environment keeps its protection rules",
+			patch:   reviewersPatch,
+			setting: models.ActionOptOn,
+			output:  []any{map[string]any{"name": "production"}},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				m.EXPECT().ListEnvironments(gomock.Any(), repoOwner, repoName).
+					Return([]*github.Environment{production}, nil)
+				m.EXPECT().UpdateEnvironment(gomock.Any(), repoOwner, repoName, "production", &github.CreateUpdateEnvironment{
+					WaitTimer:         github.Int(30),
+					CanAdminsBypass:   github.Bool(false),
+					PreventSelfReview: github.Bool(true),
+					Reviewers: []*github.EnvReviewers{
+						{Type: github.String("Team"), ID: github.Int64(1234)},
+					},
+				}).Return(nil)
+			},
+		},
+		{
+			name:    "missing environment is created",
+			patch:   `{"wait_timer": 10}`,
+			setting: models.ActionOptOn,
+			output:  []any{map[string]any{"name": "staging"}},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				m.EXPECT().ListEnvironments(gomock.Any(), repoOwner, repoName).
+					Return([]*github.Environment{production}, nil)
+				m.EXPECT().UpdateEnvironment(gomock.Any(), repoOwner, repoName, "staging", &github.CreateUpdateEnvironment{
+					WaitTimer:       github.Int(10),
+					CanAdminsBypass: github.Bool(true),
+				}).Return(nil)
+			},
+		},
+		{
+			name:    "dry run doesn't update environments",
+			patch:   reviewersPatch,
+			setting: models.ActionOptDryRun,
+			output:  []any{map[string]any{"name": "production"}},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				m.EXPECT().ListEnvironments(gomock.Any(), repoOwner, repoName).
+					Return([]*github.Environment{production}, nil)
+				m.EXPECT().GetBaseURL().Return("https://api.github.com/")
+			},
+		},
+		{
+			name:     "no output is skipped",
+			patch:    reviewersPatch,
+			setting:  models.ActionOptOn,
+			wantSkip: true,
+		},
+		{
+			name:    "output without names",
+			patch:   reviewersPatch,
+			setting: models.ActionOptOn,
+			output:  []any{map[string]any{"id": 1}},
+			wantErr: true,
+		},
+		{
+			name:    "update failure",
+			patch:   reviewersPatch,
+			setting: models.ActionOptOn,
+			output:  []any{map[string]any{"name": "production"}},
+			mockSetup: func(m *mock_ghclient.MockGitHub) {
+				m.EXPECT().ListEnvironments(gomock.Any(), repoOwner, repoName).
+					Return([]*github.Environment{production}, nil)
+				m.EXPECT().UpdateEnvironment(gomock.Any(), repoOwner, repoName, "production", gomock.Any()).
+					Return(errors.New("forbidden"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockClient := mock_ghclient.NewMockGitHub(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockClient)
+			}
+
+			engine, err := NewGhEnvironmentProtectionRemediator(
+				testActionType,
+				&pb.RuleType_Definition_Remediate_GhEnvironmentProtectionType{Patch: tt.patch},
+				mockClient,
+				tt.setting,
+			)
+			require.NoError(t, err)
+
+			evalParams := &interfaces.EvalStatusParams{
+				Rule: &models.RuleInstance{
+					Def: map[string]any{"team_id": 1234},
+				},
+			}
+			evalParams.SetEvalResult(&engif.EvaluationResult{Output: tt.output})
+
+			ent := &pb.Repository{Owner: repoOwner, Name: repoName}
+			_, err = engine.Do(context.Background(), interfaces.ActionCmdOn, ent, evalParams, nil)
+			switch {
+			case tt.wantSkip:
+				require.ErrorIs(t, err, engerrors.ErrActionSkipped)
+			case tt.wantErr:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	dbadapter "github.com/mindersec/minder/internal/adapters/db"
 	"github.com/mindersec/minder/internal/db"
+	actionmeta "github.com/mindersec/minder/internal/engine/actions/metadata"
 	"github.com/mindersec/minder/internal/engine/interfaces"
 	"github.com/mindersec/minder/internal/util"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -37,9 +38,7 @@ const (
 	BodyMaxLength = 65536
 )
 
-type issueMetadata struct {
-	Number int `json:"issue_number,omitempty"`
-}
+type issueMetadata = actionmeta.IssueRemediation
 
 // Remediator implements the issue remediation engine.
 type Remediator struct {
@@ -186,7 +185,7 @@ func (r *Remediator) getParamsForIssueRemediation(
 	tmplParams := &TemplateParams{
 		Entity:  ent,
 		Profile: params.GetRule().Def,
-		Params:  params.GetRule().Params,
+		Params:  params.GetRuleParams(),
 	}
 
 	if params.GetEvalResult() != nil {
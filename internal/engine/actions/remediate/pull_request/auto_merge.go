@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pull_request
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/rs/zerolog"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// autoMergeParam is the key in the pull request params selecting the
+	// merge method used to auto-merge the remediation pull request
+	autoMergeParam = "auto_merge"
+
+	autoMergeMethodMerge  = "merge"
+	autoMergeMethodSquash = "squash"
+	autoMergeMethodRebase = "rebase"
+)
+
+const (
+	// mergeStatusEnabled means auto-merge was enabled on the pull request
+	mergeStatusEnabled = "auto_merge_enabled"
+	// mergeStatusNotAllowed means the repository settings do not allow
+	// auto-merging with the requested method
+	mergeStatusNotAllowed = "auto_merge_not_allowed"
+	// mergeStatusFailed means enabling auto-merge failed
+	mergeStatusFailed = "auto_merge_failed"
+	// mergeStatusMerged means the pull request was merged
+	mergeStatusMerged = "merged"
+)
+
+// getAutoMergeMethod returns the auto-merge method configured for the
+// remediation, or an empty string if auto-merge is disabled
+func getAutoMergeMethod(prCfg *pb.RuleType_Definition_Remediate_PullRequestRemediation) (string, error) {
+	method := prCfg.GetParams().GetFields()[autoMergeParam].GetStringValue()
+	switch method {
+	case "", autoMergeMethodMerge, autoMergeMethodSquash, autoMergeMethodRebase:
+		return method, nil
+	default:
+		return "", fmt.Errorf("unknown auto-merge method: %s", method)
+	}
+}
+
+// autoMergeAllowed checks the repository settings to see if the pull request
+// can be auto-merged with the given method
+func autoMergeAllowed(repo *github.Repository, method string) bool {
+	if !repo.GetAllowAutoMerge() {
+		return false
+	}
+	switch method {
+	case autoMergeMethodMerge:
+		return repo.GetAllowMergeCommit()
+	case autoMergeMethodSquash:
+		return repo.GetAllowSquashMerge()
+	case autoMergeMethodRebase:
+		return repo.GetAllowRebaseMerge()
+	}
+	return false
+}
+
+// enableAutoMerge enables auto-merge on a newly created pull request, if the
+// repository allows it. Failures do not fail the remediation, since the pull
+// request can still be merged by hand, but are recorded in the returned status.
+func (r *Remediator) enableAutoMerge(
+	ctx context.Context,
+	repo *pb.Repository,
+	pr *github.PullRequest,
+) string {
+	logger := zerolog.Ctx(ctx).With().
+		Str("repo", repo.String()).
+		Int("pr_number", pr.GetNumber()).
+		Str("merge_method", r.autoMergeMethod).
+		Logger()
+
+	ghRepo, err := r.ghCli.GetRepository(ctx, repo.GetOwner(), repo.GetName())
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot get repository settings for auto-merge")
+		return mergeStatusFailed
+	}
+	if !autoMergeAllowed(ghRepo, r.autoMergeMethod) {
+		logger.Info().Msg("repository settings do not allow auto-merge")
+		return mergeStatusNotAllowed
+	}
+
+	if err := r.ghCli.EnablePullRequestAutoMerge(ctx, pr.GetNodeID(), r.autoMergeMethod); err != nil {
+		logger.Error().Err(err).Msg("cannot enable auto-merge")
+		return mergeStatusFailed
+	}
+
+	logger.Info().Msg("auto-merge enabled")
+	return mergeStatusEnabled
+}
+
+// isMerged checks whether an auto-merged pull request has been merged already
+func (r *Remediator) isMerged(ctx context.Context, p *paramsPR) (bool, error) {
+	if p.metadata.AutoMerge == "" {
+		return false, nil
+	}
+	if p.metadata.MergeStatus == mergeStatusMerged {
+		return true, nil
+	}
+	pr, err := r.ghCli.GetPullRequest(ctx, p.repo.GetOwner(), p.repo.GetName(), p.metadata.Number)
+	if err != nil {
+		return false, fmt.Errorf("cannot get pull request %d: %w", p.metadata.Number, err)
+	}
+	return pr.GetMerged(), nil
+}
@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pull_request
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	// contentStrategyParam is the key in the pull request params selecting
+	// how the minder.content method applies the expected content
+	contentStrategyParam = "strategy"
+	// contentStrategyReplace overwrites the whole file with the expected content
+	contentStrategyReplace = "replace"
+	// contentStrategyMerge merges the expected content into the existing file,
+	// preserving lines added by users
+	contentStrategyMerge = "merge"
+	// contentRemoveLinesParam is the key in the pull request params listing
+	// regular expressions of the lines the merge strategy removes from the
+	// existing file, rather than preserves
+	contentRemoveLinesParam = "remove_lines"
+)
+
+// mergeContent merges the expected content into the current content of a file.
+//
+// This is a three-way merge (diff3) of the current file and the expected
+// content, whose common ancestor is the expected content of the previous
+// remediation of the file, given as base. Each region where the files differ
+// from the base is resolved as follows:
+//   - regions only changed in the expected content take the expected content,
+//     so that lines the rule no longer requires are removed
+//   - regions only changed in the current file are preserved, as they are
+//     customizations made by the users, except for the lines matching one of
+//     the remove patterns
+//   - regions changed on both sides take the expected content, since this is
+//     what the rule requires, preceded by the lines the users inserted there
+//     when the base is known; the lines they modified or removed are lost
+//
+// When the file was never remediated, the lines common to both files stand
+// for the base: lines only present in the current file are preserved, and the
+// ones only present in the expected content are inserted. A line missing from
+// the expected content can't be told apart from a line added by the users
+// then, so lines the rule requires to be gone must be matched by the remove
+// patterns.
+//
+// This keeps the diff of the remediation minimal, so that e.g. comments or
+// extra entries in a dependabot.yml file survive the remediation.
+func mergeContent(current, expected string, base *string, remove []*regexp.Regexp) string {
+	currentLines := splitLines(current)
+	expectedLines := splitLines(expected)
+
+	if len(currentLines) == 0 {
+		return expected
+	}
+
+	var baseLines []string
+	if base != nil {
+		baseLines = splitLines(*base)
+	} else {
+		for _, m := range diffMatches(currentLines, expectedLines) {
+			baseLines = append(baseLines, currentLines[m.a])
+		}
+	}
+
+	var out strings.Builder
+	for _, c := range diff3(baseLines, currentLines, expectedLines) {
+		var lines []string
+		switch {
+		case slices.Equal(c.current, c.base), slices.Equal(c.current, c.expected):
+			lines = c.expected
+		case slices.Equal(c.expected, c.base):
+			// only the current file changed these lines, keep them
+			lines = c.current
+		case base != nil:
+			// both files changed these lines: the lines inserted by the users
+			// are kept, followed by the expected content
+			lines = append(insertedLines(c.base, c.current), c.expected...)
+		default:
+			// without a base every line of the current file would look inserted
+			lines = c.expected
+		}
+		for _, l := range lines {
+			if !slices.Contains(c.expected, l) && matchesAny(remove, l) {
+				continue
+			}
+			out.WriteString(l)
+		}
+	}
+	return out.String()
+}
+
+// insertedLines returns the lines of current inserted between the lines of
+// base, leaving out the ones replacing lines of base
+func insertedLines(base, current []string) []string {
+	var out []string
+	a, b := 0, 0
+	for _, m := range append(diffMatches(base, current), lineMatch{len(base), len(current)}) {
+		if m.a == a {
+			out = append(out, current[b:m.b]...)
+		}
+		a, b = m.a+1, m.b+1
+	}
+	return out
+}
+
+// matchesAny reports whether the line, without its newline, matches any of the patterns
+func matchesAny(patterns []*regexp.Regexp, line string) bool {
+	line = strings.TrimSuffix(line, "\n")
+	for _, re := range patterns {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitLines splits a string into lines, each terminated by a newline
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if last := lines[len(lines)-1]; !strings.HasSuffix(last, "\n") {
+		lines[len(lines)-1] = last + "\n"
+	}
+	return lines
+}
+
+// mergeChunk is a region of the merged files: either lines equal in the
+// three files, or lines differing in at least one of them
+type mergeChunk struct {
+	base, current, expected []string
+}
+
+// diff3 splits the current and expected files into the regions which are
+// unchanged from the base in both files, and the regions in between.
+func diff3(base, current, expected []string) []mergeChunk {
+	// currentOf[i] and expectedOf[i] are the lines matching base[i] in the
+	// current and expected files, or -1
+	currentOf := matchIndexes(base, current)
+	expectedOf := matchIndexes(base, expected)
+
+	var chunks []mergeChunk
+	o, a, b := 0, 0, 0
+	for o < len(base) || a < len(current) || b < len(expected) {
+		// lines unchanged in both files
+		n := 0
+		for o+n < len(base) && currentOf[o+n] == a+n && expectedOf[o+n] == b+n {
+			n++
+		}
+		if n > 0 {
+			chunks = append(chunks, mergeChunk{base[o : o+n], current[a : a+n], expected[b : b+n]})
+			o, a, b = o+n, a+n, b+n
+			continue
+		}
+
+		// changed lines, up to the next base line both files kept
+		next := o
+		for next < len(base) && (currentOf[next] < 0 || expectedOf[next] < 0) {
+			next++
+		}
+		nextA, nextB := len(current), len(expected)
+		if next < len(base) {
+			nextA, nextB = currentOf[next], expectedOf[next]
+		}
+		chunks = append(chunks, mergeChunk{base[o:next], current[a:nextA], expected[b:nextB]})
+		o, a, b = next, nextA, nextB
+	}
+	return chunks
+}
+
+// matchIndexes returns, for each line of a, the index of the matching line of
+// b along their diff, or -1
+func matchIndexes(a, b []string) []int {
+	out := make([]int, len(a))
+	for i := range out {
+		out[i] = -1
+	}
+	for _, m := range diffMatches(a, b) {
+		out[m.a] = m.b
+	}
+	return out
+}
+
+// lineMatch pairs equal lines of two files
+type lineMatch struct {
+	a, b int
+}
+
+// diffMatches returns the lines a and b have in common along a shortest edit
+// script, found with the linear space variant of the Myers diff algorithm.
+func diffMatches(a, b []string) []lineMatch {
+	d := &differ{a: a, b: b}
+	d.compare(0, len(a), 0, len(b))
+	return d.matches
+}
+
+type differ struct {
+	a, b    []string
+	matches []lineMatch
+}
+
+// compare appends the matches of a[aLo:aHi] and b[bLo:bHi]
+func (d *differ) compare(aLo, aHi, bLo, bHi int) {
+	for aLo < aHi && bLo < bHi && d.a[aLo] == d.b[bLo] {
+		d.matches = append(d.matches, lineMatch{aLo, bLo})
+		aLo++
+		bLo++
+	}
+	suffix := 0
+	for aHi > aLo && bHi > bLo && d.a[aHi-1] == d.b[bHi-1] {
+		aHi--
+		bHi--
+		suffix++
+	}
+
+	switch {
+	case aLo == aHi || bLo == bHi:
+	case aHi-aLo == 1:
+		if i := slices.Index(d.b[bLo:bHi], d.a[aLo]); i >= 0 {
+			d.matches = append(d.matches, lineMatch{aLo, bLo + i})
+		}
+	case bHi-bLo == 1:
+		if i := slices.Index(d.a[aLo:aHi], d.b[bLo]); i >= 0 {
+			d.matches = append(d.matches, lineMatch{aLo + i, bLo})
+		}
+	default:
+		if x, y, ok := d.bisect(aLo, aHi, bLo, bHi); ok {
+			d.compare(aLo, x, bLo, y)
+			d.compare(x, aHi, y, bHi)
+		}
+	}
+
+	for i := 0; i < suffix; i++ {
+		d.matches = append(d.matches, lineMatch{aHi + i, bHi + i})
+	}
+}
+
+// bisect finds the middle snake of a[aLo:aHi] and b[bLo:bHi], by running the
+// Myers algorithm forward and backward at the same time until the paths
+// meet, and returns the point splitting the edit script in two. It returns
+// false if the ranges have no line in common.
+func (d *differ) bisect(aLo, aHi, bLo, bHi int) (int, int, bool) {
+	n, m := aHi-aLo, bHi-bLo
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	// forward[k] and backward[k] are the furthest x reached on diagonal k
+	forward := make([]int, 2*maxD)
+	backward := make([]int, 2*maxD)
+	for i := range forward {
+		forward[i] = -1
+		backward[i] = -1
+	}
+	forward[offset+1] = 0
+	backward[offset+1] = 0
+	delta := n - m
+	// with an odd delta the forward path meets the backward one, otherwise
+	// the backward path meets the forward one
+	front := delta%2 != 0
+	kStartF, kEndF, kStartB, kEndB := 0, 0, 0, 0
+
+	for step := 0; step < maxD; step++ {
+		for k := -step + kStartF; k <= step-kEndF; k += 2 {
+			ki := offset + k
+			var x int
+			if k == -step || (k != step && forward[ki-1] < forward[ki+1]) {
+				x = forward[ki+1]
+			} else {
+				x = forward[ki-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.a[aLo+x] == d.b[bLo+y] {
+				x++
+				y++
+			}
+			forward[ki] = x
+			switch {
+			case x > n:
+				kEndF += 2
+			case y > m:
+				kStartF += 2
+			case front:
+				bi := offset + delta - k
+				if bi >= 0 && bi < len(backward) && backward[bi] != -1 && x >= n-backward[bi] {
+					return aLo + x, bLo + y, true
+				}
+			}
+		}
+
+		for k := -step + kStartB; k <= step-kEndB; k += 2 {
+			ki := offset + k
+			var x int
+			if k == -step || (k != step && backward[ki-1] < backward[ki+1]) {
+				x = backward[ki+1]
+			} else {
+				x = backward[ki-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.a[aHi-x-1] == d.b[bHi-y-1] {
+				x++
+				y++
+			}
+			backward[ki] = x
+			switch {
+			case x > n:
+				kEndB += 2
+			case y > m:
+				kStartB += 2
+			case !front:
+				fi := offset + delta - k
+				if fi >= 0 && fi < len(forward) && forward[fi] != -1 {
+					fx := forward[fi]
+					fy := offset + fx - fi
+					if fx >= n-x {
+						return aLo + fx, bLo + fy, true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pull_request
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+func TestMergeContent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		current  string
+		expected string
+		base     *string
+		remove   []string
+		want     string
+	}{
+		{
+			name:     "empty current file",
+			current:  "",
+			expected: "a\nb\n",
+			want:     "a\nb\n",
+		},
+		{
+			name:     "identical content",
+			current:  "a\nb\n",
+			expected: "a\nb\n",
+			want:     "a\nb\n",
+		},
+		{
+			name:     "user lines are preserved",
+			current:  "# managed by the team\nversion: 2\nupdates:\n  - package-ecosystem: npm\n",
+			expected: "version: 2\nupdates:\n",
+			want:     "# managed by the team\nversion: 2\nupdates:\n  - package-ecosystem: npm\n",
+		},
+		{
+			name:     "missing lines are added",
+			current:  "version: 2\n# comment\n",
+			expected: "version: 2\n# comment\nupdates:\n",
+			want:     "version: 2\n# comment\nupdates:\n",
+		},
+		{
+			name:     "conflicting lines take the expected content",
+			current:  "version: 2\ninterval: monthly\nend\n",
+			expected: "version: 2\ninterval: weekly\nend\n",
+			want:     "version: 2\ninterval: weekly\nend\n",
+		},
+		{
+			name:     "user lines matching a remove pattern are removed",
+			current:  "version: 2\nregistries: legacy\n# keep me\nupdates:\n",
+			expected: "version: 2\nupdates:\n",
+			remove:   []string{`^registries:`},
+			want:     "version: 2\n# keep me\nupdates:\n",
+		},
+		{
+			name:     "expected lines matching a remove pattern are kept",
+			current:  "version: 2\n",
+			expected: "version: 2\nregistries: new\n",
+			remove:   []string{`^registries:`},
+			want:     "version: 2\nregistries: new\n",
+		},
+		{
+			name:     "lines dropped from the expected content are removed",
+			current:  "version: 2\nregistries: legacy\n# keep me\nupdates:\n",
+			expected: "version: 2\nupdates:\n",
+			base:     ptr("version: 2\nregistries: legacy\nupdates:\n"),
+			want:     "version: 2\n# keep me\nupdates:\n",
+		},
+		{
+			name:     "user changes to the previous remediation are kept",
+			current:  "version: 2\nupdates:\n  - package-ecosystem: npm\n",
+			expected: "version: 2\nupdates:\n  - package-ecosystem: gomod\n",
+			base:     ptr("version: 2\nupdates:\n"),
+			want:     "version: 2\nupdates:\n  - package-ecosystem: npm\n  - package-ecosystem: gomod\n",
+		},
+		{
+			name:     "changes on both sides take the expected content",
+			current:  "version: 2\ninterval: monthly\n",
+			expected: "version: 2\ninterval: weekly\n",
+			base:     ptr("version: 2\ninterval: daily\n"),
+			want:     "version: 2\ninterval: weekly\n",
+		},
+		{
+			name:     "unchanged expected content keeps the current file",
+			current:  "version: 2\n# mine\n",
+			expected: "version: 2\n",
+			base:     ptr("version: 2\n"),
+			want:     "version: 2\n# mine\n",
+		},
+		{
+			name:     "missing trailing newline",
+			current:  "a\nc",
+			expected: "a\nb\nc\n",
+			want:     "a\nb\nc\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var remove []*regexp.Regexp
+			for _, pattern := range tt.remove {
+				remove = append(remove, regexp.MustCompile(pattern))
+			}
+			require.Equal(t, tt.want, mergeContent(tt.current, tt.expected, tt.base, remove))
+		})
+	}
+}
+
+func TestMergeContentLargeFile(t *testing.T) {
+	t.Parallel()
+
+	var current, expected strings.Builder
+	for i := 0; i < 20000; i++ {
+		fmt.Fprintf(&current, "line %d\n", i)
+		if i%1000 != 0 {
+			fmt.Fprintf(&expected, "line %d\n", i)
+		}
+		if i%1000 == 500 {
+			fmt.Fprintf(&expected, "new %d\n", i)
+		}
+	}
+
+	got := mergeContent(current.String(), expected.String(), nil, nil)
+	lines := splitLines(got)
+	require.Len(t, lines, 20020)
+	require.Contains(t, got, "line 0\n")
+	require.Contains(t, got, "line 500\nnew 500\nline 501\n")
+}
+
+func TestDiffMatches(t *testing.T) {
+	t.Parallel()
+
+	a := splitLines("a\nb\nc\na\nb\nb\na\n")
+	b := splitLines("c\nb\na\nb\na\nc\n")
+	matches := diffMatches(a, b)
+	// the shortest edit script of the Myers paper example keeps 4 lines
+	require.Len(t, matches, 4)
+	for i, m := range matches {
+		require.Equal(t, a[m.a], b[m.b])
+		if i > 0 {
+			require.Less(t, matches[i-1].a, m.a)
+			require.Less(t, matches[i-1].b, m.b)
+		}
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func TestContentModificationMergeStrategy(t *testing.T) {
+	t.Parallel()
+
+	params := &modificationConstructorParams{
+		prCfg: &minderv1.RuleType_Definition_Remediate_PullRequestRemediation{
+			Contents: []*minderv1.RuleType_Definition_Remediate_PullRequestRemediation_Content{
+				{Path: "existing.yml", Content: "version: 2\nupdates:\n"},
+				{Path: "new.yml", Content: "new content\n"},
+			},
+			Params: &structpb.Struct{Fields: map[string]*structpb.Value{
+				contentStrategyParam: structpb.NewStringValue(contentStrategyMerge),
+			}},
+		},
+		bfs: newTestFS(t, withFile("existing.yml", "# keep me\nversion: 2\n")),
+	}
+
+	m, err := newContentModification(params)
+	require.NoError(t, err)
+	ifParams := interfaces.EvalStatusParams{
+		Rule: &models.RuleInstance{},
+	}
+	require.NoError(t, m.createFsModEntries(context.Background(), nil, &ifParams))
+
+	_, err = m.modifyFs()
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{
+		"existing.yml": "version: 2\nupdates:\n",
+		"new.yml":      "new content\n",
+	}, m.(mergeBaseRecorder).mergeBases(), "the merge base is the expected content")
+
+	for path, want := range map[string]string{
+		"existing.yml": "# keep me\nversion: 2\nupdates:\n",
+		"new.yml":      "new content\n",
+	} {
+		f, err := params.bfs.Open(path)
+		require.NoError(t, err)
+		got, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		require.Equal(t, want, string(got))
+	}
+}
+
+func TestContentModificationRemoveLines(t *testing.T) {
+	t.Parallel()
+
+	params := &modificationConstructorParams{
+		prCfg: &minderv1.RuleType_Definition_Remediate_PullRequestRemediation{
+			Contents: []*minderv1.RuleType_Definition_Remediate_PullRequestRemediation_Content{
+				{Path: "CODEOWNERS", Content: "* @org/maintainers\n"},
+			},
+			Params: &structpb.Struct{Fields: map[string]*structpb.Value{
+				contentStrategyParam: structpb.NewStringValue(contentStrategyMerge),
+				contentRemoveLinesParam: structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+					structpb.NewStringValue(`@former-employee\b`),
+				}}),
+			}},
+		},
+		bfs: newTestFS(t, withFile("CODEOWNERS", "# owners\n* @org/maintainers\n/docs @former-employee\n")),
+	}
+
+	m, err := newContentModification(params)
+	require.NoError(t, err)
+	ifParams := interfaces.EvalStatusParams{
+		Rule: &models.RuleInstance{},
+	}
+	require.NoError(t, m.createFsModEntries(context.Background(), nil, &ifParams))
+
+	_, err = m.modifyFs()
+	require.NoError(t, err)
+
+	f, err := params.bfs.Open("CODEOWNERS")
+	require.NoError(t, err)
+	got, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, "# owners\n* @org/maintainers\n", string(got))
+}
+
+func TestContentModificationRemoveLinesRequiresMerge(t *testing.T) {
+	t.Parallel()
+
+	params := newModificationParams(withParams(map[string]any{
+		contentRemoveLinesParam: []any{"^foo"},
+	}))
+	params.prCfg.Contents = []*minderv1.RuleType_Definition_Remediate_PullRequestRemediation_Content{
+		{Path: "new.yml", Content: "new content\n"},
+	}
+
+	_, err := newContentModification(params)
+	require.ErrorContains(t, err, "requires the merge strategy")
+}
+
+func TestContentModificationUnknownStrategy(t *testing.T) {
+	t.Parallel()
+
+	params := newModificationParams(withParams(map[string]any{contentStrategyParam: "rewrite"}))
+	params.prCfg.Contents = []*minderv1.RuleType_Definition_Remediate_PullRequestRemediation_Content{
+		{Path: "new.yml", Content: "new content\n"},
+	}
+
+	_, err := newContentModification(params)
+	require.ErrorContains(t, err, "unknown content strategy")
+}
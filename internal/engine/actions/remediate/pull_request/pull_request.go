@@ -25,6 +25,7 @@ import (
 
 	dbadapter "github.com/mindersec/minder/internal/adapters/db"
 	"github.com/mindersec/minder/internal/db"
+	actionmeta "github.com/mindersec/minder/internal/engine/actions/metadata"
 	"github.com/mindersec/minder/internal/engine/interfaces"
 	"github.com/mindersec/minder/internal/util"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -58,9 +59,7 @@ const (
 	BodyMaxLength = 5120
 )
 
-type pullRequestMetadata struct {
-	Number int `json:"pr_number,omitempty"`
-}
+type pullRequestMetadata = actionmeta.PullRequestRemediation
 
 // Remediator is the remediation engine for the Pull Request remediation type
 type Remediator struct {
@@ -70,6 +69,7 @@ type Remediator struct {
 
 	prCfg                *pb.RuleType_Definition_Remediate_PullRequestRemediation
 	modificationRegistry modificationRegistry
+	autoMergeMethod      string
 
 	titleTemplate *util.SafeTemplate
 	bodyTemplate  *util.SafeTemplate
@@ -84,6 +84,8 @@ type paramsPR struct {
 	body       string
 	metadata   *pullRequestMetadata
 	prevStatus *db.ListRuleEvaluationsByProfileIdRow
+	// refresh forces pushing the remediation to an already existing pull request
+	refresh bool
 }
 
 // NewPullRequestRemediate creates a new PR remediation engine
@@ -108,6 +110,11 @@ func NewPullRequestRemediate(
 		return nil, fmt.Errorf("cannot parse body template: %w", err)
 	}
 
+	autoMergeMethod, err := getAutoMergeMethod(prCfg)
+	if err != nil {
+		return nil, fmt.Errorf("pull request remediation config is invalid: %w", err)
+	}
+
 	modRegistry := newModificationRegistry()
 	modRegistry.registerBuiltIn()
 
@@ -116,6 +123,7 @@ func NewPullRequestRemediate(
 		prCfg:                prCfg,
 		actionType:           actionType,
 		modificationRegistry: modRegistry,
+		autoMergeMethod:      autoMergeMethod,
 		setting:              setting,
 
 		titleTemplate: titleTmpl,
@@ -190,7 +198,7 @@ func (r *Remediator) getParamsForPRRemediation(
 	tmplParams := &PrTemplateParams{
 		Entity:  ent,
 		Profile: params.GetRule().Def,
-		Params:  params.GetRule().Params,
+		Params:  params.GetRuleParams(),
 	}
 
 	if params.GetEvalResult() != nil {
@@ -207,11 +215,22 @@ func (r *Remediator) getParamsForPRRemediation(
 		return nil, fmt.Errorf("cannot execute title template: %w", err)
 	}
 
+	// Unmarshal the existing remediation metadata, if any
+	meta := &pullRequestMetadata{}
+	if metadata != nil {
+		err := json.Unmarshal(*metadata, meta)
+		if err != nil {
+			// There's nothing saved apparently, so no need to fail here, but do log the error
+			logger.Debug().Msgf("error unmarshalling remediation metadata: %v", err)
+		}
+	}
+
 	modification, err := r.modificationRegistry.getModification(getMethod(r.prCfg), &modificationConstructorParams{
-		prCfg: r.prCfg,
-		ghCli: r.ghCli,
-		bfs:   ingested.Fs,
-		def:   params.GetRule().Def,
+		prCfg:    r.prCfg,
+		ghCli:    r.ghCli,
+		bfs:      ingested.Fs,
+		def:      params.GetRule().Def,
+		contents: meta.Contents,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("cannot get modification: %w", err)
@@ -227,15 +246,6 @@ func (r *Remediator) getParamsForPRRemediation(
 		return nil, fmt.Errorf("cannot create PR full body text: %w", err)
 	}
 
-	// Unmarshal the existing remediation metadata, if any
-	meta := &pullRequestMetadata{}
-	if metadata != nil {
-		err := json.Unmarshal(*metadata, meta)
-		if err != nil {
-			// There's nothing saved apparently, so no need to fail here, but do log the error
-			logger.Debug().Msgf("error unmarshalling remediation metadata: %v", err)
-		}
-	}
 	return &paramsPR{
 		ingested:   ingested,
 		repo:       repo,
@@ -355,6 +365,10 @@ func (r *Remediator) runOn(
 
 	// Check if a PR already exists for this branch
 	prNumber := getPRNumberFromBranch(ctx, r.ghCli, p.repo, branchBaseName(p.title, p.ruleName))
+	newMeta := pullRequestMetadata{Number: prNumber}
+	if recorder, ok := p.modifier.(mergeBaseRecorder); ok {
+		newMeta.Contents = recorder.mergeBases()
+	}
 
 	// If no PR exists, push the branch and create a PR
 	if prNumber == 0 {
@@ -374,18 +388,38 @@ func (r *Remediator) runOn(
 		}
 		// Return the new PR number
 		prNumber = pr.GetNumber()
+		newMeta.Number = prNumber
+		if r.autoMergeMethod != "" {
+			newMeta.AutoMerge = r.autoMergeMethod
+			newMeta.MergeStatus = r.enableAutoMerge(ctx, p.repo, pr)
+		}
 		l = l.With().Str("pr_origin", "newly_created").Logger()
 	} else {
+		if p.metadata.Number == prNumber {
+			// Keep tracking the auto-merge and refreshes of the existing pull request
+			newMeta.AutoMerge = p.metadata.AutoMerge
+			newMeta.MergeStatus = p.metadata.MergeStatus
+			newMeta.Refreshes = p.metadata.Refreshes
+		}
+		if p.refresh {
+			// Replace the stale branch with the remediation rebuilt on top of the base branch
+			err = pushBranch(ctx, repo, refspec, r.ghCli)
+			if err != nil {
+				return nil, fmt.Errorf("cannot push refreshed branch: %w", err)
+			}
+			newMeta.Refreshes++
+			l = l.With().Int("refreshes", newMeta.Refreshes).Logger()
+		}
 		l = l.With().Str("pr_origin", "already_existed").Logger()
 	}
 
-	newMeta, err := json.Marshal(pullRequestMetadata{Number: prNumber})
+	meta, err := json.Marshal(newMeta)
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling pull request remediation metadata json: %w", err)
 	}
 	// Success - return the new metadata for storing the pull request number
 	l.Info().Int("pr_number", prNumber).Msg("pull request remediation completed")
-	return newMeta, enginerr.ErrActionPending
+	return meta, enginerr.ErrActionPending
 }
 
 func getPRNumberFromBranch(
@@ -422,6 +456,21 @@ func (r *Remediator) runOff(
 		return nil, fmt.Errorf("no pull request number provided: %w", enginerr.ErrActionSkipped)
 	}
 
+	// An auto-merged pull request is what fixed the rule, there is nothing to close
+	merged, err := r.isMerged(ctx, p)
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot check if pull request was merged")
+	} else if merged {
+		meta := *p.metadata
+		meta.MergeStatus = mergeStatusMerged
+		newMeta, err := json.Marshal(meta)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling pull request remediation metadata json: %w", err)
+		}
+		logger.Info().Int("pr_number", meta.Number).Msg("pull request was auto-merged")
+		return newMeta, enginerr.ErrActionSkipped
+	}
+
 	pr, err := r.ghCli.ClosePullRequest(ctx, p.repo.GetOwner(), p.repo.GetName(), p.metadata.Number)
 	if err != nil {
 		return nil, fmt.Errorf("error closing pull request %d: %w, %w", p.metadata.Number, err, enginerr.ErrActionFailed)
@@ -442,7 +491,7 @@ func (r *Remediator) run(
 	case interfaces.ActionCmdOff:
 		return r.runOff(ctx, p)
 	case interfaces.ActionCmdDoNothing:
-		return r.runDoNothing(ctx, p)
+		return r.runRefresh(ctx, p)
 	}
 	return nil, enginerr.ErrActionSkipped
 }
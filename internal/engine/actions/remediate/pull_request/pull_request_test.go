@@ -120,6 +120,16 @@ func dependabotPrRem() *pb.RuleType_Definition_Remediate_PullRequestRemediation
 	}
 }
 
+func dependabotPrRemWithAutoMerge(method string) *pb.RuleType_Definition_Remediate_PullRequestRemediation {
+	prRem := dependabotPrRem()
+	prRem.Params = &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			autoMergeParam: structpb.NewStringValue(method),
+		},
+	}
+	return prRem
+}
+
 func frizbeePrRem() *pb.RuleType_Definition_Remediate_PullRequestRemediation {
 	return &pb.RuleType_Definition_Remediate_PullRequestRemediation{
 		Method: "minder.actions.replace_tags_with_sha",
@@ -665,6 +675,74 @@ func TestPullRequestRemediate(t *testing.T) {
 			expectedErr:      errors.ErrActionPending,
 			expectedMetadata: json.RawMessage(`{"pr_number":46}`),
 		},
+		{
+			name: "open a PR with auto-merge enabled",
+			newRemArgs: &newPullRequestRemediateArgs{
+				prRem:      dependabotPrRemWithAutoMerge(autoMergeMethodSquash),
+				actionType: TestActionTypeValid,
+			},
+			remArgs:   createTestRemArgs(),
+			repoSetup: defaultMockRepoSetup,
+			mockSetup: func(_ *testing.T, mockGitHub *mockghclient.MockGitHub) {
+				happyPathMockSetup(mockGitHub)
+
+				mockGitHub.EXPECT().
+					CreatePullRequest(
+						gomock.Any(),
+						repoOwner, repoName,
+						commitTitle, prBody,
+						refFromBranch(branchBaseName(commitTitle, "")), dflBranchTo).
+					Return(&github.PullRequest{Number: github.Int(47), NodeID: github.String("PR_node")}, nil)
+				mockGitHub.EXPECT().
+					GetRepository(gomock.Any(), repoOwner, repoName).
+					Return(&github.Repository{
+						AllowAutoMerge:   github.Bool(true),
+						AllowSquashMerge: github.Bool(true),
+					}, nil)
+				mockGitHub.EXPECT().
+					EnablePullRequestAutoMerge(gomock.Any(), "PR_node", autoMergeMethodSquash).
+					Return(nil)
+			},
+			expectedErr:      errors.ErrActionPending,
+			expectedMetadata: json.RawMessage(`{"pr_number":47,"auto_merge":"squash","merge_status":"auto_merge_enabled"}`),
+		},
+		{
+			name: "open a PR when the repository does not allow auto-merge",
+			newRemArgs: &newPullRequestRemediateArgs{
+				prRem:      dependabotPrRemWithAutoMerge(autoMergeMethodRebase),
+				actionType: TestActionTypeValid,
+			},
+			remArgs:   createTestRemArgs(),
+			repoSetup: defaultMockRepoSetup,
+			mockSetup: func(_ *testing.T, mockGitHub *mockghclient.MockGitHub) {
+				happyPathMockSetup(mockGitHub)
+
+				mockGitHub.EXPECT().
+					CreatePullRequest(
+						gomock.Any(),
+						repoOwner, repoName,
+						commitTitle, prBody,
+						refFromBranch(branchBaseName(commitTitle, "")), dflBranchTo).
+					Return(&github.PullRequest{Number: github.Int(48), NodeID: github.String("PR_node")}, nil)
+				mockGitHub.EXPECT().
+					GetRepository(gomock.Any(), repoOwner, repoName).
+					Return(&github.Repository{
+						AllowAutoMerge:   github.Bool(true),
+						AllowRebaseMerge: github.Bool(false),
+					}, nil)
+			},
+			expectedErr:      errors.ErrActionPending,
+			expectedMetadata: json.RawMessage(`{"pr_number":48,"auto_merge":"rebase","merge_status":"auto_merge_not_allowed"}`),
+		},
+		{
+			name: "invalid auto-merge method",
+			newRemArgs: &newPullRequestRemediateArgs{
+				prRem:      dependabotPrRemWithAutoMerge("fast-forward"),
+				actionType: TestActionTypeValid,
+			},
+			remArgs:     createTestRemArgs(),
+			wantInitErr: true,
+		},
 	}
 
 	for _, tt := range tests {
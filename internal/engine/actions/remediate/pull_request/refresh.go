@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pull_request
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+)
+
+const (
+	// maxPRRefreshes bounds how many times a remediation pull request is
+	// refreshed, so that a base branch which keeps conflicting with the
+	// remediation does not cause endless force-pushes
+	maxPRRefreshes = 5
+
+	// mergeableStateDirty is reported by GitHub when the pull request has conflicts
+	mergeableStateDirty = "dirty"
+	// mergeableStateBehind is reported by GitHub when the head branch is out of date
+	mergeableStateBehind = "behind"
+)
+
+// needsRefresh reports whether an open remediation pull request conflicts
+// with, or is out of date with, its base branch
+func needsRefresh(pr *github.PullRequest) bool {
+	if pr.GetState() != "open" || pr.GetMerged() {
+		return false
+	}
+	switch pr.GetMergeableState() {
+	case mergeableStateDirty, mergeableStateBehind:
+		return true
+	}
+	return false
+}
+
+// runRefresh is run instead of doing nothing while a remediation pull request
+// is pending. Re-evaluations are triggered both by webhooks (e.g. a push to the
+// base branch) and by the reminder service, so this polls the state of the pull
+// request and, if it became stale or conflicting, regenerates the remediation
+// on top of the current base branch and force-pushes it to the existing branch.
+func (r *Remediator) runRefresh(ctx context.Context, p *paramsPR) (json.RawMessage, error) {
+	if p.prevStatus == nil || p.prevStatus.RemStatus != db.RemediationStatusTypesPending ||
+		p.metadata.Number == 0 || p.metadata.MergeStatus == mergeStatusMerged ||
+		p.metadata.Refreshes >= maxPRRefreshes {
+		return r.runDoNothing(ctx, p)
+	}
+
+	logger := zerolog.Ctx(ctx).With().
+		Str("repo", p.repo.String()).
+		Int("pr_number", p.metadata.Number).
+		Logger()
+
+	pr, err := r.ghCli.GetPullRequest(ctx, p.repo.GetOwner(), p.repo.GetName(), p.metadata.Number)
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot get pull request to check if it needs a refresh")
+		return r.runDoNothing(ctx, p)
+	}
+	if !needsRefresh(pr) {
+		return r.runDoNothing(ctx, p)
+	}
+
+	logger.Info().Str("mergeable_state", pr.GetMergeableState()).Msg("refreshing stale remediation pull request")
+	p.refresh = true
+	return r.runOn(ctx, p)
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pull_request
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/db"
+	mockghclient "github.com/mindersec/minder/internal/providers/github/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	enginerr "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+func TestNeedsRefresh(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		pr   *github.PullRequest
+		want bool
+	}{
+		{
+			name: "conflicting",
+			pr:   &github.PullRequest{State: github.String("open"), MergeableState: github.String("dirty")},
+			want: true,
+		},
+		{
+			name: "behind the base branch",
+			pr:   &github.PullRequest{State: github.String("open"), MergeableState: github.String("behind")},
+			want: true,
+		},
+		{
+			name: "clean",
+			pr:   &github.PullRequest{State: github.String("open"), MergeableState: github.String("clean")},
+			want: false,
+		},
+		{
+			name: "mergeability not computed yet",
+			pr:   &github.PullRequest{State: github.String("open"), MergeableState: github.String("unknown")},
+			want: false,
+		},
+		{
+			name: "closed",
+			pr:   &github.PullRequest{State: github.String("closed"), MergeableState: github.String("dirty")},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, needsRefresh(tt.pr))
+		})
+	}
+}
+
+func TestRunRefreshUpToDatePullRequest(t *testing.T) {
+	t.Parallel()
+
+	prevMeta := json.RawMessage(`{"pr_number":42}`)
+
+	tests := []struct {
+		name      string
+		metadata  *pullRequestMetadata
+		remStatus db.RemediationStatusTypes
+		mockSetup func(*mockghclient.MockGitHub)
+	}{
+		{
+			name:      "pull request is clean",
+			metadata:  &pullRequestMetadata{Number: 42},
+			remStatus: db.RemediationStatusTypesPending,
+			mockSetup: func(mockGitHub *mockghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, 42).
+					Return(&github.PullRequest{
+						State:          github.String("open"),
+						MergeableState: github.String("clean"),
+					}, nil)
+			},
+		},
+		{
+			name:      "too many refreshes",
+			metadata:  &pullRequestMetadata{Number: 42, Refreshes: maxPRRefreshes},
+			remStatus: db.RemediationStatusTypesPending,
+			mockSetup: func(*mockghclient.MockGitHub) {},
+		},
+		{
+			name:      "remediation is not pending",
+			metadata:  &pullRequestMetadata{Number: 42},
+			remStatus: db.RemediationStatusTypesFailure,
+			mockSetup: func(*mockghclient.MockGitHub) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockGitHub := mockghclient.NewMockGitHub(ctrl)
+			tt.mockSetup(mockGitHub)
+
+			r := &Remediator{ghCli: mockGitHub}
+			meta, err := r.runRefresh(context.Background(), &paramsPR{
+				repo:     &pb.Repository{Owner: repoOwner, Name: repoName},
+				metadata: tt.metadata,
+				prevStatus: &db.ListRuleEvaluationsByProfileIdRow{
+					RemStatus:   tt.remStatus,
+					RemMetadata: prevMeta,
+				},
+			})
+
+			if tt.remStatus == db.RemediationStatusTypesPending {
+				require.ErrorIs(t, err, enginerr.ErrActionPending)
+			} else {
+				require.ErrorIs(t, err, enginerr.ErrActionFailed)
+			}
+			require.Equal(t, prevMeta, meta)
+		})
+	}
+}
@@ -7,7 +7,11 @@ package pull_request
 import (
 	"bytes"
 	"context" // #nosec G505 - we're not using sha1 for crypto, only to quickly compare contents
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"regexp"
 
 	"google.golang.org/protobuf/proto"
 
@@ -24,6 +28,8 @@ const (
 	minderFrizbeeTagResolve = "minder.actions.replace_tags_with_sha"
 	// minderYQEvaluate evaluates a yq expression
 	minderYQEvaluate = "minder.yq.evaluate"
+	// minderSBOMWorkflow adds a workflow generating the SBOM of the repository on release
+	minderSBOMWorkflow = "minder.sbom.workflow"
 
 	// ContentBytesLimit is the maximum number of bytes for the content
 	ContentBytesLimit = 5120
@@ -37,6 +43,15 @@ var _ fsModifier = (*contentModification)(nil)
 type contentModification struct {
 	fsChangeSet
 	prCfg *pb.RuleType_Definition_Remediate_PullRequestRemediation
+	merge bool
+	// removeLines match the existing lines removed by the merge strategy
+	removeLines []*regexp.Regexp
+	// previous are the expected contents of the previous remediation, the
+	// base of the merge
+	previous map[string]string
+	// expected are the expected contents of this remediation, before the
+	// merge
+	expected map[string]string
 }
 
 var _ modificationConstructor = newContentModification
@@ -62,13 +77,29 @@ func newContentModification(
 		}
 	}
 
+	strategy := params.prCfg.GetParams().GetFields()[contentStrategyParam].GetStringValue()
+	if strategy != "" && strategy != contentStrategyReplace && strategy != contentStrategyMerge {
+		return nil, fmt.Errorf("unknown content strategy: %s", strategy)
+	}
+
+	removeLines, err := contentRemoveLines(params.prCfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(removeLines) > 0 && strategy != contentStrategyMerge {
+		return nil, fmt.Errorf("%s requires the %s strategy", contentRemoveLinesParam, contentStrategyMerge)
+	}
+
 	entries, err := prConfigToEntries(params.prCfg)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create PR entries: %w", err)
 	}
 
 	return &contentModification{
-		prCfg: params.prCfg,
+		prCfg:       params.prCfg,
+		merge:       strategy == contentStrategyMerge,
+		removeLines: removeLines,
+		previous:    params.contents,
 		fsChangeSet: fsChangeSet{
 			entries: entries,
 			fs:      params.bfs,
@@ -76,6 +107,21 @@ func newContentModification(
 	}, nil
 }
 
+// contentRemoveLines compiles the regular expressions of the lines removed
+// by the merge strategy
+func contentRemoveLines(prCfg *pb.RuleType_Definition_Remediate_PullRequestRemediation) ([]*regexp.Regexp, error) {
+	values := prCfg.GetParams().GetFields()[contentRemoveLinesParam].GetListValue().GetValues()
+	patterns := make([]*regexp.Regexp, 0, len(values))
+	for _, v := range values {
+		re, err := regexp.Compile(v.GetStringValue())
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s pattern %q: %w", contentRemoveLinesParam, v.GetStringValue(), err)
+		}
+		patterns = append(patterns, re)
+	}
+	return patterns, nil
+}
+
 func prConfigToEntries(prCfg *pb.RuleType_Definition_Remediate_PullRequestRemediation) ([]*fsEntry, error) {
 	entries := make([]*fsEntry, len(prCfg.Contents))
 	for i, cnt := range prCfg.Contents {
@@ -111,7 +157,7 @@ func (ca *contentModification) createFsModEntries(
 ) error {
 	data := map[string]interface{}{
 		"Entity":  ent,
-		"Params":  params.GetRule().Params,
+		"Params":  params.GetRuleParams(),
 		"Profile": params.GetRule().Def,
 	}
 	if params.GetEvalResult() != nil {
@@ -138,6 +184,12 @@ func (ca *contentModification) createFsModEntries(
 }
 
 func (ca *contentModification) modifyFs() ([]*fsEntry, error) {
+	if ca.merge {
+		if err := ca.mergeExistingContent(); err != nil {
+			return nil, fmt.Errorf("cannot merge existing content: %w", err)
+		}
+	}
+
 	err := ca.writeEntries()
 	if err != nil {
 		return nil, fmt.Errorf("cannot write entries: %w", err)
@@ -145,3 +197,40 @@ func (ca *contentModification) modifyFs() ([]*fsEntry, error) {
 
 	return ca.entries, nil
 }
+
+// mergeExistingContent merges the expected content of each entry with the
+// content of the file in the repository, if the file exists
+func (ca *contentModification) mergeExistingContent() error {
+	ca.expected = make(map[string]string, len(ca.entries))
+	for _, entry := range ca.entries {
+		ca.expected[entry.Path] = entry.Content
+
+		f, err := ca.fs.Open(entry.Path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("cannot open %s: %w", entry.Path, err)
+		}
+
+		current, err := io.ReadAll(f)
+		if closeErr := f.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("cannot read %s: %w", entry.Path, err)
+		}
+
+		var base *string
+		if previous, ok := ca.previous[entry.Path]; ok {
+			base = &previous
+		}
+		entry.Content = mergeContent(string(current), entry.Content, base, ca.removeLines)
+	}
+
+	return nil
+}
+
+// mergeBases implements mergeBaseRecorder
+func (ca *contentModification) mergeBases() map[string]string {
+	return ca.expected
+}
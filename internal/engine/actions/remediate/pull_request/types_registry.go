@@ -23,11 +23,21 @@ type fsModifier interface {
 	modifyFs() ([]*fsEntry, error)
 }
 
+// mergeBaseRecorder is implemented by the modifications merging their content
+// with the existing files, which record the expected contents the merge of the
+// next remediation is based on
+type mergeBaseRecorder interface {
+	mergeBases() map[string]string
+}
+
 type modificationConstructorParams struct {
 	prCfg *pb.RuleType_Definition_Remediate_PullRequestRemediation
 	ghCli v1.GitHub
 	bfs   billy.Filesystem
 	def   map[string]any
+	// contents are the expected contents of the files of the previous
+	// remediation, by path
+	contents map[string]string
 }
 
 type modificationConstructor func(*modificationConstructorParams) (fsModifier, error)
@@ -46,6 +56,7 @@ func (mr modificationRegistry) registerBuiltIn() {
 	mr.register(minderContentModification, newContentModification)
 	mr.register(minderFrizbeeTagResolve, newFrizbeeTagResolveModification)
 	mr.register(minderYQEvaluate, newYqExecute)
+	mr.register(minderSBOMWorkflow, newSBOMWorkflow)
 }
 
 func (mr modificationRegistry) getModification(
@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pull_request
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"text/template"
+
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/internal/engine/interfaces"
+)
+
+const (
+	defaultSBOMWorkflowPath = ".github/workflows/sbom.yml"
+	defaultSBOMFormat       = "spdx-json"
+)
+
+var sbomFormatExtensions = map[string]string{
+	"spdx-json":      "spdx.json",
+	"cyclonedx-json": "cdx.json",
+}
+
+// sbomEcosystem is a language ecosystem whose dependencies are resolved
+// before the SBOM is generated, so that it lists them all
+type sbomEcosystem struct {
+	Name string
+	// manifests are the files at the root of the repository which denote
+	// the ecosystem
+	manifests []string
+	// Steps are the workflow steps setting up the ecosystem, indented as
+	// steps of the job
+	Steps string
+}
+
+var sbomEcosystems = []sbomEcosystem{
+	{
+		Name:      "go",
+		manifests: []string{"go.mod"},
+		Steps: `      - uses: actions/setup-go@v5
+        with:
+          go-version-file: go.mod
+      - run: go mod download`,
+	},
+	{
+		Name:      "npm",
+		manifests: []string{"package-lock.json", "package.json"},
+		Steps: `      - uses: actions/setup-node@v4
+        with:
+          node-version: lts/*
+      - run: npm ci --ignore-scripts`,
+	},
+	{
+		Name:      "pypi",
+		manifests: []string{"pyproject.toml", "requirements.txt", "setup.py"},
+		Steps: `      - uses: actions/setup-python@v5
+        with:
+          python-version: "3.x"`,
+	},
+	{
+		Name:      "maven",
+		manifests: []string{"pom.xml"},
+		Steps: `      - uses: actions/setup-java@v4
+        with:
+          distribution: temurin
+          java-version: "21"
+      - run: mvn --batch-mode dependency:resolve`,
+	},
+	{
+		Name:      "gradle",
+		manifests: []string{"build.gradle", "build.gradle.kts"},
+		Steps: `      - uses: actions/setup-java@v4
+        with:
+          distribution: temurin
+          java-version: "21"
+      - uses: gradle/actions/setup-gradle@v4`,
+	},
+	{
+		Name:      "cargo",
+		manifests: []string{"Cargo.toml"},
+		Steps:     `      - run: cargo fetch`,
+	},
+	{
+		Name:      "rubygems",
+		manifests: []string{"Gemfile"},
+		Steps: `      - uses: ruby/setup-ruby@v1
+        with:
+          bundler-cache: true`,
+	},
+}
+
+const sbomWorkflowContent = `# Generates the SBOM of the repository and attaches it to its releases.
+# Added by Minder.
+name: SBOM
+
+on:
+  release:
+    types:
+      - published
+
+permissions:
+  contents: read
+
+jobs:
+  sbom:
+    runs-on: ubuntu-latest
+    permissions:
+      # to attach the SBOM to the release
+      contents: write
+    steps:
+      - uses: actions/checkout@v4
+        with:
+          persist-credentials: false
+{{- range .Ecosystems }}
+      # {{ .Name }}
+{{ .Steps }}
+{{- end }}
+      - uses: anchore/sbom-action@v0
+        with:
+          path: .
+          format: {{ .Format }}
+          artifact-name: sbom.{{ .Extension }}
+          upload-release-assets: true
+`
+
+var sbomWorkflowTemplate = template.Must(template.New("sbom").Parse(sbomWorkflowContent))
+
+type sbomWorkflowConfig struct {
+	// Path is the path of the workflow
+	Path string `json:"path"`
+	// Format is the format of the SBOM, one of spdx-json or cyclonedx-json
+	Format string `json:"format"`
+	// Ecosystems are the ecosystems to set up, instead of the ecosystems
+	// detected in the repository
+	Ecosystems []string `json:"ecosystems"`
+}
+
+var _ fsModifier = (*sbomWorkflow)(nil)
+
+type sbomWorkflow struct {
+	fsChangeSet
+
+	config sbomWorkflowConfig
+}
+
+var _ modificationConstructor = newSBOMWorkflow
+
+func newSBOMWorkflow(
+	params *modificationConstructorParams,
+) (fsModifier, error) {
+	confMap := make(map[string]any)
+	if params.prCfg.GetParams() != nil {
+		confMap = params.prCfg.Params.AsMap()
+	}
+
+	rawConfig, err := json.Marshal(confMap)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal config: %w", err)
+	}
+
+	var conf sbomWorkflowConfig
+	if err := json.Unmarshal(rawConfig, &conf); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal config: %w", err)
+	}
+
+	if conf.Path == "" {
+		conf.Path = defaultSBOMWorkflowPath
+	}
+	if len(conf.Path) > PathBytesLimit {
+		return nil, fmt.Errorf("workflow path is longer than %d bytes", PathBytesLimit)
+	}
+	if conf.Format == "" {
+		conf.Format = defaultSBOMFormat
+	}
+	if _, ok := sbomFormatExtensions[conf.Format]; !ok {
+		return nil, fmt.Errorf("unknown SBOM format: %s", conf.Format)
+	}
+	for _, name := range conf.Ecosystems {
+		if !slices.ContainsFunc(sbomEcosystems, func(e sbomEcosystem) bool { return e.Name == name }) {
+			return nil, fmt.Errorf("unknown ecosystem: %s", name)
+		}
+	}
+
+	return &sbomWorkflow{
+		fsChangeSet: fsChangeSet{
+			fs: params.bfs,
+		},
+		config: conf,
+	}, nil
+}
+
+func (sw *sbomWorkflow) createFsModEntries(_ context.Context, _ proto.Message, _ interfaces.ActionsParams) error {
+	ecosystems, err := sw.ecosystems()
+	if err != nil {
+		return err
+	}
+
+	content := new(bytes.Buffer)
+	if err := sbomWorkflowTemplate.Execute(content, map[string]any{
+		"Ecosystems": ecosystems,
+		"Format":     sw.config.Format,
+		"Extension":  sbomFormatExtensions[sw.config.Format],
+	}); err != nil {
+		return fmt.Errorf("cannot render workflow: %w", err)
+	}
+
+	sw.entries = []*fsEntry{{
+		Path:    sw.config.Path,
+		Content: content.String(),
+		Mode:    filemode.Regular.String(),
+	}}
+	return nil
+}
+
+// ecosystems returns the configured ecosystems, or else the ecosystems
+// whose manifests are at the root of the repository
+func (sw *sbomWorkflow) ecosystems() ([]sbomEcosystem, error) {
+	var out []sbomEcosystem
+	for _, ecosystem := range sbomEcosystems {
+		if len(sw.config.Ecosystems) > 0 {
+			if slices.Contains(sw.config.Ecosystems, ecosystem.Name) {
+				out = append(out, ecosystem)
+			}
+			continue
+		}
+
+		for _, manifest := range ecosystem.manifests {
+			_, err := sw.fs.Stat(manifest)
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return nil, fmt.Errorf("cannot stat %s: %w", manifest, err)
+			}
+			out = append(out, ecosystem)
+			break
+		}
+	}
+	return out, nil
+}
+
+func (sw *sbomWorkflow) modifyFs() ([]*fsEntry, error) {
+	if err := sw.writeEntries(); err != nil {
+		return nil, fmt.Errorf("cannot write entries: %w", err)
+	}
+	return sw.entries, nil
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pull_request
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSBOMWorkflow(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		name        string
+		params      *modificationConstructorParams
+		files       []string
+		path        string
+		contains    []string
+		notContains []string
+		newErr      string
+	}{
+		{
+			name:   "detected ecosystems",
+			params: newModificationParams(),
+			files:  []string{"go.mod", "package.json", "docs/requirements.txt"},
+			path:   defaultSBOMWorkflowPath,
+			contains: []string{
+				"actions/setup-go@v5",
+				"actions/setup-node@v4",
+				"format: spdx-json",
+				"artifact-name: sbom.spdx.json",
+				"upload-release-assets: true",
+			},
+			// manifests outside the root of the repository are not detected
+			notContains: []string{"actions/setup-python@v5"},
+		},
+		{
+			name:        "no ecosystem",
+			params:      newModificationParams(),
+			path:        defaultSBOMWorkflowPath,
+			contains:    []string{"anchore/sbom-action@v0"},
+			notContains: []string{"actions/setup-go@v5"},
+		},
+		{
+			name: "configured ecosystems and format",
+			params: newModificationParams(withParams(map[string]any{
+				"path":       ".github/workflows/release-sbom.yaml",
+				"format":     "cyclonedx-json",
+				"ecosystems": []any{"cargo"},
+			})),
+			files: []string{"go.mod"},
+			path:  ".github/workflows/release-sbom.yaml",
+			contains: []string{
+				"cargo fetch",
+				"format: cyclonedx-json",
+				"artifact-name: sbom.cdx.json",
+			},
+			notContains: []string{"actions/setup-go@v5"},
+		},
+		{
+			name:   "unknown format",
+			params: newModificationParams(withParams(map[string]any{"format": "swid"})),
+			newErr: "unknown SBOM format: swid",
+		},
+		{
+			name:   "unknown ecosystem",
+			params: newModificationParams(withParams(map[string]any{"ecosystems": []any{"cobol"}})),
+			newErr: "unknown ecosystem: cobol",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := make([]fsConstructorOpt, 0, len(scenario.files))
+			for _, file := range scenario.files {
+				opts = append(opts, withFile(file, ""))
+			}
+			scenario.params.bfs = newTestFS(t, opts...)
+
+			sw, err := newSBOMWorkflow(scenario.params)
+			if scenario.newErr != "" {
+				require.ErrorContains(t, err, scenario.newErr)
+				return
+			}
+			require.NoError(t, err)
+
+			err = sw.createFsModEntries(context.Background(), nil, nil)
+			require.NoError(t, err)
+
+			entries, err := sw.modifyFs()
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			require.Equal(t, scenario.path, entries[0].Path)
+
+			written, err := util.ReadFile(scenario.params.bfs, scenario.path)
+			require.NoError(t, err)
+			require.Equal(t, entries[0].Content, string(written))
+			for _, s := range scenario.contains {
+				require.Contains(t, entries[0].Content, s)
+			}
+			for _, s := range scenario.notContains {
+				require.NotContains(t, entries[0].Content, s)
+			}
+		})
+	}
+}
@@ -121,7 +121,7 @@ func (yq *yqExecute) createFsModEntries(ctx context.Context, ent proto.Message,
 	templateData := templateParams{
 		Entity:  ent,
 		Profile: params.GetRule().Def,
-		Params:  params.GetRule().Params,
+		Params:  params.GetRuleParams(),
 	}
 	if params.GetEvalResult() != nil {
 		templateData.EvalResultOutput = params.GetEvalResult().Output
@@ -11,6 +11,9 @@ import (
 
 	"github.com/mindersec/minder/internal/engine/actions/alert/pull_request_comment"
 	"github.com/mindersec/minder/internal/engine/actions/remediate/gh_branch_protect"
+	"github.com/mindersec/minder/internal/engine/actions/remediate/gh_collaborator_permissions"
+	"github.com/mindersec/minder/internal/engine/actions/remediate/gh_deploy_key_removal"
+	"github.com/mindersec/minder/internal/engine/actions/remediate/gh_environment_protection"
 	"github.com/mindersec/minder/internal/engine/actions/remediate/issue"
 	"github.com/mindersec/minder/internal/engine/actions/remediate/noop"
 	"github.com/mindersec/minder/internal/engine/actions/remediate/pull_request"
@@ -58,6 +61,32 @@ func NewRuleRemediator(
 		return gh_branch_protect.NewGhBranchProtectRemediator(
 			ActionType, remediate.GetGhBranchProtection(), client, setting)
 
+	case gh_collaborator_permissions.RemediateType:
+		client, err := provinfv1.As[provinfv1.GitHub](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement github trait")
+		}
+		return gh_collaborator_permissions.NewGhCollaboratorPermissionsRemediator(
+			ActionType, remediate.GetGhCollaboratorPermissions(), client, setting)
+
+	case gh_deploy_key_removal.RemediateType:
+		client, err := provinfv1.As[provinfv1.GitHub](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement github trait")
+		}
+		return gh_deploy_key_removal.NewGhDeployKeyRemovalRemediator(ActionType, client, setting)
+
+	case gh_environment_protection.RemediateType:
+		client, err := provinfv1.As[provinfv1.GitHub](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement github trait")
+		}
+		if remediate.GetGhEnvironmentProtection() == nil {
+			return nil, fmt.Errorf("remediations engine missing gh_environment_protection configuration")
+		}
+		return gh_environment_protection.NewGhEnvironmentProtectionRemediator(
+			ActionType, remediate.GetGhEnvironmentProtection(), client, setting)
+
 	case pull_request.RemediateType:
 		client, err := provinfv1.As[provinfv1.GitHub](provider)
 		if err != nil {
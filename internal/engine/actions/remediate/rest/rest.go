@@ -134,7 +134,7 @@ func (r *Remediator) Do(
 	retp := &EndpointTemplateParams{
 		Entity:  entity,
 		Profile: params.GetRule().Def,
-		Params:  params.GetRule().Params,
+		Params:  params.GetRuleParams(),
 	}
 	if params.GetEvalResult() != nil {
 		retp.EvalResultOutput = params.GetEvalResult().Output
@@ -35,11 +35,13 @@ type EvalStatus string
 
 // EvalStatus constants represent evaluation statuses.
 const (
-	EvalStatusSuccess EvalStatus = "success"
-	EvalStatusFailure EvalStatus = "failure"
-	EvalStatusError   EvalStatus = "error"
-	EvalStatusSkipped EvalStatus = "skipped"
-	EvalStatusPending EvalStatus = "pending"
+	EvalStatusSuccess    EvalStatus = "success"
+	EvalStatusFailure    EvalStatus = "failure"
+	EvalStatusError      EvalStatus = "error"
+	EvalStatusSkipped    EvalStatus = "skipped"
+	EvalStatusPending    EvalStatus = "pending"
+	EvalStatusExcepted   EvalStatus = "excepted"
+	EvalStatusSuppressed EvalStatus = "suppressed"
 )
 
 // previousEval captures previous remediation and alert state.
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alertgroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+// Flusher publishes the re-evaluation of the entities whose alert groups have
+// pending failures and whose grouping window elapsed. The re-evaluation sends
+// the grouped alerts, without waiting for the next event on the entity.
+type Flusher struct {
+	store db.AlertGroupsStore
+	evt   interfaces.Publisher
+	cfg   *serverconfig.AlertGroupingConfig
+}
+
+// NewFlusher creates a new flusher of alert groups
+func NewFlusher(
+	store db.AlertGroupsStore,
+	evt interfaces.Publisher,
+	cfg *serverconfig.AlertGroupingConfig,
+) *Flusher {
+	return &Flusher{
+		store: store,
+		evt:   evt,
+		cfg:   cfg,
+	}
+}
+
+// Run flushes the alert groups at regular intervals until the context is
+// cancelled
+func (f *Flusher) Run(ctx context.Context) error {
+	if f.cfg.FlushInterval <= 0 {
+		return fmt.Errorf("invalid alert grouping flush interval: %s", f.cfg.FlushInterval)
+	}
+
+	ticker := time.NewTicker(f.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := f.Flush(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error flushing alert groups")
+			}
+		}
+	}
+}
+
+// Flush publishes the re-evaluation of a batch of entities whose alert groups
+// are due
+func (f *Flusher) Flush(ctx context.Context) error {
+	groups, err := f.store.PopDueAlertGroups(ctx, f.cfg.BatchSize)
+	if err != nil {
+		return fmt.Errorf("error getting due alert groups: %w", err)
+	}
+
+	// An entity is evaluated against all its profiles at once
+	flushed := make(map[uuid.UUID]bool, len(groups))
+	for _, g := range groups {
+		if flushed[g.EntityInstanceID] {
+			continue
+		}
+		flushed[g.EntityInstanceID] = true
+
+		logger := zerolog.Ctx(ctx).With().
+			Str("entity_id", g.EntityInstanceID.String()).
+			Str("project_id", g.ProjectID.String()).
+			Logger()
+
+		m := message.NewMessage(uuid.New().String(), nil)
+		m.SetContext(ctx)
+
+		entRefresh := entityMessage.NewEntityRefreshAndDoMessage().
+			WithEntityID(g.EntityInstanceID)
+		if err := entRefresh.ToMessage(m); err != nil {
+			// The next evaluation of the entity sends the grouped alerts
+			logger.Error().Err(err).Msg("error marshalling message")
+			continue
+		}
+
+		if err := f.evt.Publish(constants.TopicQueueRefreshEntityByIDAndEvaluate, m); err != nil {
+			return fmt.Errorf("error publishing message: %w", err)
+		}
+
+		logger.Info().Strs("rules", g.PendingRules).Msg("flushing grouped alerts")
+	}
+
+	return nil
+}
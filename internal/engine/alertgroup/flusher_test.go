@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alertgroup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/events/stubs"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func TestFlusherFlush(t *testing.T) {
+	t.Parallel()
+
+	entityID := uuid.New()
+	otherEntityID := uuid.New()
+	cfg := &serverconfig.AlertGroupingConfig{BatchSize: 10}
+
+	tests := []struct {
+		name    string
+		setup   func(store *mockdb.MockAlertGroupsStore)
+		wantErr bool
+		wantIDs []uuid.UUID
+	}{
+		{
+			name: "re-evaluates the entities of due groups once",
+			setup: func(store *mockdb.MockAlertGroupsStore) {
+				store.EXPECT().PopDueAlertGroups(gomock.Any(), int64(10)).
+					Return([]db.AlertGroup{
+						{EntityInstanceID: entityID, ProfileID: uuid.New(), PendingRules: []string{"rule_a"}},
+						{EntityInstanceID: entityID, ProfileID: uuid.New(), PendingRules: []string{"rule_b"}},
+						{EntityInstanceID: otherEntityID, ProfileID: uuid.New(), PendingRules: []string{"rule_a"}},
+					}, nil)
+			},
+			wantIDs: []uuid.UUID{entityID, otherEntityID},
+		},
+		{
+			name: "nothing due",
+			setup: func(store *mockdb.MockAlertGroupsStore) {
+				store.EXPECT().PopDueAlertGroups(gomock.Any(), int64(10)).Return(nil, nil)
+			},
+		},
+		{
+			name: "error getting due groups",
+			setup: func(store *mockdb.MockAlertGroupsStore) {
+				store.EXPECT().PopDueAlertGroups(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("boom"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockAlertGroupsStore(ctrl)
+			tt.setup(store)
+			evt := &stubs.StubEventer{}
+
+			err := NewFlusher(store, evt, cfg).Flush(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, evt.Sent, len(tt.wantIDs))
+			for i, msg := range evt.Sent {
+				require.Equal(t, []string{constants.TopicQueueRefreshEntityByIDAndEvaluate}, evt.Topics)
+				var refresh entityMessage.HandleEntityAndDoMessage
+				require.NoError(t, json.Unmarshal(msg.Payload, &refresh))
+				require.Equal(t, tt.wantIDs[i], refresh.Entity.EntityID)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package alertgroup groups the alerts raised by the rules of a profile for
+// the same entity, for the profiles setting an alert grouping window. The
+// failures are kept in the database, and sent in a single owner notification
+// and a single security advisory at most once per window.
+package alertgroup
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions/alert/routing"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+// Outcome is the outcome of the evaluation of a rule whose alerts are grouped
+type Outcome struct {
+	// Rule is the name of the rule
+	Rule string
+	// Failed is true if the rule failed, and false if it passed
+	Failed bool
+	// AdvisorySeverity is the severity of the security advisory the rule
+	// alerts with, empty if the rule doesn't alert with security advisories
+	AdvisorySeverity string
+}
+
+// Advisor opens and closes the consolidated security advisory of a group
+type Advisor interface {
+	OpenGroupAdvisory(
+		ctx context.Context, entity protoreflect.ProtoMessage, profileName string,
+		rules []string, severities map[string]string,
+	) (string, error)
+	CloseGroupAdvisory(ctx context.Context, entity protoreflect.ProtoMessage, id string) error
+}
+
+// groupMetadata is the metadata of the consolidated alert of a group
+type groupMetadata struct {
+	// AdvisoryID is the GHSA ID of the open consolidated security advisory
+	AdvisoryID string `json:"ghsa_id,omitempty"`
+	// AdvisoryRules maps the failing rules alerting with a security
+	// advisory to their severity
+	AdvisoryRules map[string]string `json:"advisory_rules,omitempty"`
+}
+
+// Grouper records the outcomes of the rules of the profiles grouping their
+// alerts, and sends the grouped alerts once the window of the profile elapsed
+type Grouper struct {
+	store    db.AlertGroupsStore
+	notifier *routing.OwnerNotifier
+	now      func() time.Time
+}
+
+// NewGrouper creates a new grouper. A nil notifier disables the grouped owner
+// notifications.
+func NewGrouper(store db.AlertGroupsStore, notifier *routing.OwnerNotifier) *Grouper {
+	return &Grouper{
+		store:    store,
+		notifier: notifier,
+		now:      time.Now,
+	}
+}
+
+// Flush records the outcomes of the rules of the profile evaluated for the
+// entity, and sends the failures raised since the last alert if the grouping
+// window of the profile elapsed. Otherwise, the group is flushed later by the
+// Flusher. The consolidated security advisory is only handled when an advisor
+// is given, as the provider of the entity may not support them.
+func (g *Grouper) Flush(
+	ctx context.Context,
+	ent protoreflect.ProtoMessage,
+	entityID uuid.UUID,
+	projectID uuid.UUID,
+	profile *models.ProfileAggregate,
+	outcomes []Outcome,
+	advisor Advisor,
+) error {
+	if profile.AlertGroupingWindow <= 0 {
+		return nil
+	}
+
+	logger := zerolog.Ctx(ctx).With().
+		Str("profile", profile.Name).
+		Str("entity_id", entityID.String()).
+		Logger()
+
+	if profile.ActionConfig.Alert != models.ActionOptOn {
+		// Alerts in dry run are not raised, and grouping them would leave
+		// failures pending once the alerts are turned on
+		logger.Debug().Msg("alerts are not turned on, skipping grouped alerts")
+		return nil
+	}
+
+	group, err := g.store.GetAlertGroup(ctx, db.GetAlertGroupParams{
+		EntityInstanceID: entityID,
+		ProfileID:        profile.ID,
+	})
+	exists := err == nil
+	if errors.Is(err, sql.ErrNoRows) {
+		group = db.AlertGroup{
+			EntityInstanceID: entityID,
+			ProfileID:        profile.ID,
+			ProjectID:        projectID,
+		}
+	} else if err != nil {
+		return fmt.Errorf("error getting alert group: %w", err)
+	}
+
+	meta := groupMetadata{}
+	if group.AlertMetadata.Valid {
+		if err := json.Unmarshal(group.AlertMetadata.RawMessage, &meta); err != nil {
+			logger.Warn().Err(err).Msg("error unmarshalling alert group metadata, ignoring it")
+		}
+	}
+	if meta.AdvisoryRules == nil {
+		meta.AdvisoryRules = make(map[string]string)
+	}
+
+	// Only the failures raised since the last alert are pending: rules which
+	// keep failing are not alerted again
+	// pending is never nil, as a nil slice is stored as NULL
+	failing := group.FailingRules
+	pending := append([]string{}, group.PendingRules...)
+	for _, o := range outcomes {
+		if o.Failed {
+			if !slices.Contains(failing, o.Rule) {
+				failing = append(failing, o.Rule)
+				pending = append(pending, o.Rule)
+			}
+			if o.AdvisorySeverity != "" {
+				meta.AdvisoryRules[o.Rule] = o.AdvisorySeverity
+			} else {
+				delete(meta.AdvisoryRules, o.Rule)
+			}
+		} else {
+			failing = slices.DeleteFunc(failing, func(r string) bool { return r == o.Rule })
+			pending = slices.DeleteFunc(pending, func(r string) bool { return r == o.Rule })
+			delete(meta.AdvisoryRules, o.Rule)
+		}
+	}
+
+	// The consolidated advisory is closed once none of its rules fail
+	if meta.AdvisoryID != "" && len(meta.AdvisoryRules) == 0 && advisor != nil {
+		if err := advisor.CloseGroupAdvisory(ctx, ent, meta.AdvisoryID); err != nil {
+			return fmt.Errorf("error closing grouped security advisory: %w", err)
+		}
+		logger.Info().Str("ghsa_id", meta.AdvisoryID).Msg("grouped security advisory closed")
+		meta.AdvisoryID = ""
+	}
+
+	if len(failing) == 0 {
+		if !exists {
+			return nil
+		}
+		return g.store.DeleteAlertGroup(ctx, db.DeleteAlertGroupParams{
+			EntityInstanceID: entityID,
+			ProfileID:        profile.ID,
+		})
+	}
+
+	var flushAfter sql.NullTime
+	if len(pending) > 0 {
+		last := group.LastNotifiedAt
+		if last.Valid && g.now().Sub(last.Time) < profile.AlertGroupingWindow {
+			flushAfter = sql.NullTime{Time: last.Time.Add(profile.AlertGroupingWindow), Valid: true}
+		} else {
+			if err := g.send(ctx, ent, profile, pending, &meta, advisor); err != nil {
+				return err
+			}
+			logger.Info().Strs("rules", pending).Msg("sent grouped alerts")
+			pending = []string{}
+			group.LastNotifiedAt = sql.NullTime{Time: g.now(), Valid: true}
+		}
+	}
+
+	rawMeta, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("error marshalling alert group metadata: %w", err)
+	}
+
+	_, err = g.store.UpsertAlertGroup(ctx, db.UpsertAlertGroupParams{
+		EntityInstanceID: entityID,
+		ProfileID:        profile.ID,
+		ProjectID:        group.ProjectID,
+		FailingRules:     failing,
+		PendingRules:     pending,
+		AlertMetadata:    pqtype.NullRawMessage{RawMessage: rawMeta, Valid: true},
+		LastNotifiedAt:   group.LastNotifiedAt,
+		FlushAfter:       flushAfter,
+	})
+	if err != nil {
+		return fmt.Errorf("error storing alert group: %w", err)
+	}
+	return nil
+}
+
+// send replaces the consolidated security advisory by one listing the rules
+// failing now, and notifies the owner of the entity of the pending failures.
+// An error is only returned if the advisory could not be replaced, in which
+// case the failures are kept pending.
+func (g *Grouper) send(
+	ctx context.Context,
+	ent protoreflect.ProtoMessage,
+	profile *models.ProfileAggregate,
+	pending []string,
+	meta *groupMetadata,
+	advisor Advisor,
+) error {
+	if advisor != nil && len(meta.AdvisoryRules) > 0 {
+		if meta.AdvisoryID != "" {
+			if err := advisor.CloseGroupAdvisory(ctx, ent, meta.AdvisoryID); err != nil {
+				return fmt.Errorf("error closing grouped security advisory: %w", err)
+			}
+			meta.AdvisoryID = ""
+		}
+
+		rules := maps.Keys(meta.AdvisoryRules)
+		slices.Sort(rules)
+		id, err := advisor.OpenGroupAdvisory(ctx, ent, profile.Name, rules, meta.AdvisoryRules)
+		if err != nil {
+			return fmt.Errorf("error opening grouped security advisory: %w", err)
+		}
+		meta.AdvisoryID = id
+		zerolog.Ctx(ctx).Info().Str("ghsa_id", id).Msg("grouped security advisory opened")
+	}
+
+	// As for the alerts of single rules, failing to notify the owner is
+	// logged, but does not fail the alert
+	if g.notifier != nil {
+		if err := g.notifier.NotifyGroup(ctx, ent, profile.Name, pending); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("error routing grouped alert notification to owner")
+		}
+	}
+	return nil
+}
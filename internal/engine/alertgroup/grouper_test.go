@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alertgroup
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/email"
+	"github.com/mindersec/minder/internal/engine/actions/alert/routing"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	mockevents "github.com/mindersec/minder/pkg/eventer/interfaces/mock"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+// fakeAdvisor records the consolidated advisories opened and closed
+type fakeAdvisor struct {
+	opened [][]string
+	closed []string
+}
+
+func (f *fakeAdvisor) OpenGroupAdvisory(
+	_ context.Context, _ protoreflect.ProtoMessage, _ string, rules []string, _ map[string]string,
+) (string, error) {
+	f.opened = append(f.opened, rules)
+	return "GHSA-new", nil
+}
+
+func (f *fakeAdvisor) CloseGroupAdvisory(_ context.Context, _ protoreflect.ProtoMessage, id string) error {
+	f.closed = append(f.closed, id)
+	return nil
+}
+
+func groupMeta(t *testing.T, meta groupMetadata) pqtype.NullRawMessage {
+	t.Helper()
+	raw, err := json.Marshal(meta)
+	require.NoError(t, err)
+	return pqtype.NullRawMessage{RawMessage: raw, Valid: true}
+}
+
+func TestGrouperFlush(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	entityID := uuid.New()
+	projectID := uuid.New()
+	profile := &models.ProfileAggregate{
+		ID:                  uuid.New(),
+		Name:                "baseline",
+		ActionConfig:        models.ActionConfiguration{Alert: models.ActionOptOn},
+		AlertGroupingWindow: time.Hour,
+	}
+	dryRunProfile := *profile
+	dryRunProfile.ActionConfig.Alert = models.ActionOptDryRun
+
+	tests := []struct {
+		name       string
+		profile    *models.ProfileAggregate
+		outcomes   []Outcome
+		setup      func(t *testing.T, store *mockdb.MockAlertGroupsStore)
+		wantEmails int
+		wantOpened [][]string
+		wantClosed []string
+	}{
+		{
+			name:    "first failures are sent right away",
+			profile: profile,
+			outcomes: []Outcome{
+				{Rule: "branch_protection", Failed: true, AdvisorySeverity: "high"},
+				{Rule: "secret_scanning", Failed: true},
+			},
+			setup: func(t *testing.T, store *mockdb.MockAlertGroupsStore) {
+				t.Helper()
+				store.EXPECT().GetAlertGroup(gomock.Any(), gomock.Any()).Return(db.AlertGroup{}, sql.ErrNoRows)
+				store.EXPECT().UpsertAlertGroup(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpsertAlertGroupParams) (db.AlertGroup, error) {
+						require.Equal(t, projectID, arg.ProjectID)
+						require.Equal(t, []string{"branch_protection", "secret_scanning"}, arg.FailingRules)
+						require.Empty(t, arg.PendingRules)
+						require.NotNil(t, arg.PendingRules)
+						require.Equal(t, now, arg.LastNotifiedAt.Time)
+						require.False(t, arg.FlushAfter.Valid)
+						require.JSONEq(t,
+							`{"ghsa_id":"GHSA-new","advisory_rules":{"branch_protection":"high"}}`,
+							string(arg.AlertMetadata.RawMessage))
+						return db.AlertGroup{}, nil
+					})
+			},
+			wantEmails: 1,
+			wantOpened: [][]string{{"branch_protection"}},
+		},
+		{
+			name:    "failures within the window are flushed once it elapses",
+			profile: profile,
+			outcomes: []Outcome{
+				{Rule: "branch_protection", Failed: true, AdvisorySeverity: "high"},
+				{Rule: "dependabot", Failed: true, AdvisorySeverity: "medium"},
+			},
+			setup: func(t *testing.T, store *mockdb.MockAlertGroupsStore) {
+				t.Helper()
+				store.EXPECT().GetAlertGroup(gomock.Any(), gomock.Any()).Return(db.AlertGroup{
+					ProjectID:      projectID,
+					FailingRules:   []string{"branch_protection"},
+					AlertMetadata:  groupMeta(t, groupMetadata{AdvisoryID: "GHSA-old"}),
+					LastNotifiedAt: sql.NullTime{Time: now.Add(-10 * time.Minute), Valid: true},
+				}, nil)
+				store.EXPECT().UpsertAlertGroup(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpsertAlertGroupParams) (db.AlertGroup, error) {
+						require.Equal(t, []string{"branch_protection", "dependabot"}, arg.FailingRules)
+						require.Equal(t, []string{"dependabot"}, arg.PendingRules)
+						require.Equal(t, now.Add(50*time.Minute), arg.FlushAfter.Time)
+						return db.AlertGroup{}, nil
+					})
+			},
+		},
+		{
+			name:    "pending failures replace the consolidated advisory",
+			profile: profile,
+			outcomes: []Outcome{
+				{Rule: "branch_protection", Failed: true, AdvisorySeverity: "high"},
+				{Rule: "dependabot", Failed: true, AdvisorySeverity: "medium"},
+			},
+			setup: func(t *testing.T, store *mockdb.MockAlertGroupsStore) {
+				t.Helper()
+				store.EXPECT().GetAlertGroup(gomock.Any(), gomock.Any()).Return(db.AlertGroup{
+					ProjectID:    projectID,
+					FailingRules: []string{"branch_protection", "dependabot"},
+					PendingRules: []string{"dependabot"},
+					AlertMetadata: groupMeta(t, groupMetadata{
+						AdvisoryID:    "GHSA-old",
+						AdvisoryRules: map[string]string{"branch_protection": "high"},
+					}),
+					LastNotifiedAt: sql.NullTime{Time: now.Add(-2 * time.Hour), Valid: true},
+				}, nil)
+				store.EXPECT().UpsertAlertGroup(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpsertAlertGroupParams) (db.AlertGroup, error) {
+						require.Empty(t, arg.PendingRules)
+						require.False(t, arg.FlushAfter.Valid)
+						return db.AlertGroup{}, nil
+					})
+			},
+			wantEmails: 1,
+			wantOpened: [][]string{{"branch_protection", "dependabot"}},
+			wantClosed: []string{"GHSA-old"},
+		},
+		{
+			name:    "the group is closed once all rules pass",
+			profile: profile,
+			outcomes: []Outcome{
+				{Rule: "branch_protection"},
+			},
+			setup: func(t *testing.T, store *mockdb.MockAlertGroupsStore) {
+				t.Helper()
+				store.EXPECT().GetAlertGroup(gomock.Any(), gomock.Any()).Return(db.AlertGroup{
+					FailingRules: []string{"branch_protection"},
+					AlertMetadata: groupMeta(t, groupMetadata{
+						AdvisoryID:    "GHSA-old",
+						AdvisoryRules: map[string]string{"branch_protection": "high"},
+					}),
+					LastNotifiedAt: sql.NullTime{Time: now.Add(-10 * time.Minute), Valid: true},
+				}, nil)
+				store.EXPECT().DeleteAlertGroup(gomock.Any(), db.DeleteAlertGroupParams{
+					EntityInstanceID: entityID,
+					ProfileID:        profile.ID,
+				}).Return(nil)
+			},
+			wantClosed: []string{"GHSA-old"},
+		},
+		{
+			name:     "alerts in dry run are not grouped",
+			profile:  &dryRunProfile,
+			outcomes: []Outcome{{Rule: "branch_protection", Failed: true}},
+			setup:    func(*testing.T, *mockdb.MockAlertGroupsStore) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockAlertGroupsStore(ctrl)
+			tt.setup(t, store)
+
+			var emails []*message.Message
+			pub := mockevents.NewMockPublisher(ctrl)
+			pub.EXPECT().Publish(email.TopicQueueOwnerNotificationEmail, gomock.Any()).
+				DoAndReturn(func(_ string, msgs ...*message.Message) error {
+					emails = append(emails, msgs...)
+					return nil
+				}).AnyTimes()
+
+			props, err := structpb.NewStruct(map[string]any{
+				properties.PropertyName:         "acme/api",
+				properties.PropertyOwnerContact: "payments@example.com",
+			})
+			require.NoError(t, err)
+			ent := &pb.Repository{Owner: "acme", Name: "api", Properties: props}
+
+			g := NewGrouper(store, routing.NewOwnerNotifier(pub, &serverconfig.AlertRoutingConfig{}))
+			g.now = func() time.Time { return now }
+			advisor := &fakeAdvisor{}
+
+			err = g.Flush(context.Background(), ent, entityID, projectID, tt.profile, tt.outcomes, advisor)
+			require.NoError(t, err)
+			require.Len(t, emails, tt.wantEmails)
+			require.Equal(t, tt.wantOpened, advisor.opened)
+			require.Equal(t, tt.wantClosed, advisor.closed)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/internal/db"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+// previousCheckpoint returns the checkpoint of the last evaluation of the
+// rule for the entity, for rule types which skip entities that did not
+// change since then. The checkpoint is only reused when the last evaluation
+// had a result and was made with the same rule type, definition and
+// parameters, which is tracked by the rule digest recorded in the
+// checkpoint. The digest of the rule being evaluated is set on the
+// evaluation parameters so that it is recorded in turn.
+func (e *executor) previousCheckpoint(
+	ctx context.Context,
+	ruleType *pb.RuleType,
+	params *engif.EvalStatusParams,
+) (*checkpoints.CheckpointEnvelopeV1, error) {
+	if !ruleType.GetDef().GetIngest().GetGit().GetSkipUnchanged() {
+		return nil, nil
+	}
+
+	digest, err := ruleDigest(ruleType, params.Rule)
+	if err != nil {
+		return nil, fmt.Errorf("error computing rule digest: %w", err)
+	}
+	params.RuleDigest = digest
+
+	latest, err := e.querier.GetLatestEvalCheckpointForRuleEntity(ctx, db.GetLatestEvalCheckpointForRuleEntityParams{
+		RuleID:           params.Rule.ID,
+		EntityInstanceID: params.EntityID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting latest evaluation checkpoint: %w", err)
+	}
+
+	if latest.Status != db.EvalStatusTypesSuccess && latest.Status != db.EvalStatusTypesFailure {
+		return nil, nil
+	}
+
+	previous, err := checkpoints.FromJSON(latest.Checkpoint)
+	if err != nil {
+		// the entity is simply evaluated again
+		zerolog.Ctx(ctx).Debug().Err(err).Msg("ignoring unreadable evaluation checkpoint")
+		return nil, nil
+	}
+	if previous.GetRuleDigest() != digest {
+		return nil, nil
+	}
+
+	return previous, nil
+}
+
+// ruleDigest identifies the rule type, definition and parameters of a rule,
+// so that a checkpoint is not reused once any of them changes.
+func ruleDigest(ruleType *pb.RuleType, rule *models.RuleInstance) (string, error) {
+	rt, err := proto.MarshalOptions{Deterministic: true}.Marshal(ruleType)
+	if err != nil {
+		return "", err
+	}
+	def, err := json.Marshal(rule.Def)
+	if err != nil {
+		return "", err
+	}
+	params, err := json.Marshal(rule.Params)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, part := range [][]byte{rt, def, params} {
+		h.Write(part)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -51,6 +51,8 @@ func EntityTypeFromDB(entity db.Entities) minderv1.Entity {
 		return minderv1.Entity_ENTITY_TASK_RUN
 	case db.EntitiesBuild:
 		return minderv1.Entity_ENTITY_BUILD
+	case db.EntitiesOrganization:
+		return minderv1.Entity_ENTITY_ORGANIZATION
 	default:
 		return minderv1.Entity_ENTITY_UNSPECIFIED
 	}
@@ -77,6 +79,8 @@ func EntityTypeToDB(entity minderv1.Entity) db.Entities {
 		dbEnt = db.EntitiesTaskRun
 	case minderv1.Entity_ENTITY_BUILD:
 		dbEnt = db.EntitiesBuild
+	case minderv1.Entity_ENTITY_ORGANIZATION:
+		dbEnt = db.EntitiesOrganization
 	case minderv1.Entity_ENTITY_UNSPECIFIED:
 		// This shouldn't happen
 	}
@@ -39,6 +39,9 @@ type EntityInfoWrapper struct {
 	OwnershipData map[string]string
 	ExecutionID   *uuid.UUID
 	ActionEvent   string
+	// TriggerEvent is the type of the upstream event which caused the
+	// evaluation, if any. Only the rules triggered by it are evaluated.
+	TriggerEvent string
 }
 
 const (
@@ -60,6 +63,9 @@ const (
 	pullRequestIDEventKey = "pull_request_id"
 	// ExecutionIDKey is the key for the execution ID. This is set when acquiring a lock.
 	ExecutionIDKey = "execution_id"
+	// TriggerEventKey is the key for the type of the upstream event which
+	// caused the evaluation
+	TriggerEventKey = "trigger_event"
 )
 
 // NewEntityInfoWrapper creates a new EntityInfoWrapper
@@ -138,6 +144,13 @@ func (eiw *EntityInfoWrapper) WithExecutionID(id uuid.UUID) *EntityInfoWrapper {
 	return eiw
 }
 
+// WithTriggerEvent sets the type of the upstream event causing the evaluation
+func (eiw *EntityInfoWrapper) WithTriggerEvent(event string) *EntityInfoWrapper {
+	eiw.TriggerEvent = event
+
+	return eiw
+}
+
 // AsRepository sets the entity type to a repository
 func (eiw *EntityInfoWrapper) AsRepository() *EntityInfoWrapper {
 	eiw.Type = minderv1.Entity_ENTITY_REPOSITORIES
@@ -215,6 +228,10 @@ func (eiw *EntityInfoWrapper) ToMessage(msg *message.Message) error {
 		msg.Metadata.Set(ExecutionIDKey, eiw.ExecutionID.String())
 	}
 
+	if eiw.TriggerEvent != "" {
+		msg.Metadata.Set(TriggerEventKey, eiw.TriggerEvent)
+	}
+
 	if eiw.Type == minderv1.Entity_ENTITY_UNSPECIFIED {
 		return fmt.Errorf("entity type is required")
 	}
@@ -463,5 +480,7 @@ func ParseEntityEvent(msg *message.Message) (*EntityInfoWrapper, error) {
 		return nil, fmt.Errorf("error unmarshalling payload: %w", err)
 	}
 
+	out.TriggerEvent = msg.Metadata.Get(TriggerEventKey)
+
 	return out, nil
 }
@@ -316,6 +316,24 @@ func TestEntityInfoWrapper_ToMessage(t *testing.T) {
 				EntityIDEventKey:   repoID.String(),
 			},
 		},
+		{
+			name: "repository event triggered by a webhook event",
+			eiw: NewEntityInfoWrapper().
+				WithProviderID(providerID).
+				WithProjectID(projectID).
+				WithRepository(&pb.Repository{
+					Owner:  "test",
+					RepoId: 123,
+				}).WithID(repoID).
+				WithTriggerEvent("branch_protection_rule"),
+			expected: map[string]string{
+				ProviderIDEventKey: providerID.String(),
+				EntityTypeEventKey: pb.Entity_ENTITY_REPOSITORIES.ToString(),
+				ProjectIDEventKey:  projectID.String(),
+				EntityIDEventKey:   repoID.String(),
+				TriggerEventKey:    "branch_protection_rule",
+			},
+		},
 		{
 			name: "artifact event",
 			eiw: NewEntityInfoWrapper().
@@ -389,6 +407,10 @@ func TestEntityInfoWrapper_ToMessage(t *testing.T) {
 			for key, expectedValue := range tt.expected {
 				assert.Equal(t, expectedValue, msg.Metadata.Get(key), key+" mismatch")
 			}
+
+			parsed, err := ParseEntityEvent(msg)
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, tt.eiw.TriggerEvent, parsed.TriggerEvent, "trigger event mismatch")
 		})
 	}
 }
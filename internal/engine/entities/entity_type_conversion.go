@@ -30,6 +30,8 @@ func EntityTypeToDBType(entityType pb.Entity) (db.Entities, error) {
 		return db.EntitiesTaskRun, nil
 	case pb.Entity_ENTITY_BUILD:
 		return db.EntitiesBuild, nil
+	case pb.Entity_ENTITY_ORGANIZATION:
+		return db.EntitiesOrganization, nil
 	case pb.Entity_ENTITY_UNSPECIFIED:
 		return db.Entities(""), fmt.Errorf("invalid entity type: ENTITY_UNSPECIFIED is not a valid entity type")
 	default:
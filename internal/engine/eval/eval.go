@@ -19,6 +19,21 @@ import (
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 )
 
+// jqEvalType is the eval type of the rule types evaluated with jq
+const jqEvalType = "jq"
+
+// SupportedEvalTypes returns the eval types of the rule types which can be
+// evaluated
+func SupportedEvalTypes() []string {
+	return []string{
+		jqEvalType,
+		rego.RegoEvalType,
+		vulncheck.VulncheckEvalType,
+		trusty.TrustyEvalType,
+		application.HomoglyphsEvalType,
+	}
+}
+
 // NewRuleEvaluator creates a new rule data evaluator
 func NewRuleEvaluator(
 	ctx context.Context,
@@ -34,7 +49,7 @@ func NewRuleEvaluator(
 	// TODO: make this more generic and/or use constants
 	// Note that the JQ and Rego evaluators get the data through ingestion.
 	switch ruletype.Def.Eval.Type {
-	case "jq":
+	case jqEvalType:
 		if ruletype.Def.Eval.GetJq() == nil {
 			return nil, fmt.Errorf("rule type engine missing jq configuration")
 		}
@@ -7,6 +7,7 @@ package eval_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/open-policy-agent/opa/v1/ast"
@@ -26,6 +27,8 @@ func TestNewRuleEvaluatorWorks(t *testing.T) {
 		name string
 		eval *pb.RuleType_Definition_Eval
 		out  *interfaces.EvaluationResult
+		// outJSON is the expected output as JSON, for outputs of unexported types
+		outJSON string
 	}{
 		{
 			name: "JQ",
@@ -42,7 +45,8 @@ func TestNewRuleEvaluatorWorks(t *testing.T) {
 					},
 				},
 			},
-			out: nil,
+			outJSON: `[{"name":"","path":".","comparator":"equals","expected":{"data":"nothing"},` +
+				`"actual":{"data":"foo"},"passed":false}]`,
 		},
 		{
 			name: "Rego defaults",
@@ -109,6 +113,12 @@ func TestNewRuleEvaluatorWorks(t *testing.T) {
 			}
 			result, err := got.Eval(context.Background(), profileData, nil, data)
 			assert.Error(t, err, "expected failure during evaluation")
+			if tt.outJSON != "" {
+				out, err := json.Marshal(result.Output)
+				assert.NoError(t, err)
+				assert.JSONEq(t, tt.outJSON, string(out))
+				return
+			}
 			assert.Equal(t, tt.out, result)
 		})
 	}
@@ -24,6 +24,7 @@ const (
 
 	invisibleCharacters = "invisible_characters"
 	mixedScript         = "mixed_scripts"
+	typosquatting       = "typosquatting"
 )
 
 // NewHomoglyphsEvaluator creates a new homoglyphs evaluator
@@ -45,6 +46,8 @@ func NewHomoglyphsEvaluator(
 		return NewInvisibleCharactersEvaluator(ctx, ghClient, opts...)
 	case mixedScript:
 		return NewMixedScriptEvaluator(ctx, ghClient, opts...)
+	case typosquatting:
+		return NewTyposquattingEvaluator(ctx, reh.GetPopularPackages(), ghClient, opts...)
 	default:
 		return nil, fmt.Errorf("unsupported homoglyphs type: %s", reh.Type)
 	}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mindersec/minder/internal/engine/eval/homoglyphs/communication"
+	"github.com/mindersec/minder/internal/engine/eval/homoglyphs/domain"
+	"github.com/mindersec/minder/internal/engine/eval/homoglyphs/util"
+	"github.com/mindersec/minder/internal/engine/eval/templates"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+// TyposquattingEvaluator is the evaluator for the typosquatting rule type. It
+// checks the names of the dependencies added by a pull request against the
+// names of popular packages, as well as for invisible characters and mixed
+// scripts.
+type TyposquattingEvaluator struct {
+	popularPackages string
+	datasources     *v1datasources.DataSourceRegistry
+	processors      []domain.HomoglyphProcessor
+	reviewHandler   *communication.GhReviewPrHandler
+}
+
+// NewTyposquattingEvaluator creates a new typosquatting evaluator, comparing
+// dependency names with the popular packages returned by the given data
+// source function.
+func NewTyposquattingEvaluator(
+	ctx context.Context,
+	popularPackages string,
+	ghClient interfaces.GitHubIssuePRClient,
+	opts ...interfaces.Option,
+) (*TyposquattingEvaluator, error) {
+	if popularPackages == "" {
+		return nil, errors.New("typosquatting requires a popular packages data source function")
+	}
+
+	msProcessor, err := domain.NewMixedScriptsProcessor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not create mixed scripts processor: %w", err)
+	}
+
+	evaluator := &TyposquattingEvaluator{
+		popularPackages: popularPackages,
+		processors:      []domain.HomoglyphProcessor{domain.NewInvisibleCharactersProcessor(), msProcessor},
+		reviewHandler:   communication.NewGhReviewPrHandler(ghClient),
+	}
+
+	for _, opt := range opts {
+		if err := opt(evaluator); err != nil {
+			return nil, err
+		}
+	}
+
+	return evaluator, nil
+}
+
+// RegisterDataSources implements the SupportsDataSources interface.
+func (te *TyposquattingEvaluator) RegisterDataSources(dsr *v1datasources.DataSourceRegistry) {
+	te.datasources = dsr
+}
+
+// Eval evaluates the typosquatting rule type
+func (te *TyposquattingEvaluator) Eval(
+	ctx context.Context,
+	_ map[string]any,
+	_ protoreflect.ProtoMessage,
+	res *interfaces.Ingested,
+) (*interfaces.EvaluationResult, error) {
+	if res == nil {
+		return nil, fmt.Errorf("result is nil")
+	}
+
+	prDeps, ok := res.Object.(*pbinternal.PrDependencies)
+	if !ok {
+		return nil, fmt.Errorf("invalid object type for typosquatting evaluator")
+	}
+	if prDeps.Pr == nil {
+		return nil, fmt.Errorf("invalid prDependencies fields: %v", prDeps.Pr)
+	}
+
+	if len(prDeps.Deps) == 0 {
+		return &interfaces.EvaluationResult{}, nil
+	}
+
+	violations, err := te.findViolations(ctx, res, prDeps.Deps)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(violations) == 0 {
+		return &interfaces.EvaluationResult{}, nil
+	}
+
+	te.reviewHandler.Hydrate(ctx, prDeps.Pr)
+	if err := te.reviewHandler.SubmitReview(ctx, reviewText(violations)); err != nil {
+		return nil, err
+	}
+
+	return nil, evalerrors.NewDetailedErrEvaluationFailed(
+		templates.TyposquattingTemplate,
+		map[string]any{"violations": violations},
+		"found suspicious dependency names",
+	)
+}
+
+func (te *TyposquattingEvaluator) findViolations(
+	ctx context.Context,
+	res *interfaces.Ingested,
+	deps []*pbinternal.PrDependencies_ContextualDependency,
+) ([]*domain.Violation, error) {
+	popularByEcosystem := make(map[string][]string)
+
+	var violations []*domain.Violation
+	for _, dep := range deps {
+		if dep.GetDep().GetName() == "" {
+			continue
+		}
+		name := dep.Dep.Name
+
+		for _, processor := range te.processors {
+			violations = append(violations, processor.FindViolations(name)...)
+		}
+
+		ecosystem := dep.Dep.Ecosystem.AsString()
+		if ecosystem == "" {
+			continue
+		}
+		popular, ok := popularByEcosystem[ecosystem]
+		if !ok {
+			var err error
+			popular, err = te.getPopularPackages(ctx, res, ecosystem)
+			if err != nil {
+				return nil, err
+			}
+			popularByEcosystem[ecosystem] = popular
+		}
+
+		if v := domain.FindTyposquat(name, ecosystem, popular); v != nil {
+			violations = append(violations, v)
+		}
+	}
+
+	return violations, nil
+}
+
+// getPopularPackages calls the popular packages data source function for an
+// ecosystem. The function may return the list of names, or an object holding
+// it under the ecosystem or, as REST data sources do, under the body.
+func (te *TyposquattingEvaluator) getPopularPackages(
+	ctx context.Context, res *interfaces.Ingested, ecosystem string,
+) ([]string, error) {
+	if te.datasources == nil {
+		return nil, errors.New("no data sources registered")
+	}
+
+	dsf, ok := te.datasources.GetFuncs()[v1datasources.DataSourceFuncKey(te.popularPackages)]
+	if !ok {
+		return nil, fmt.Errorf("data source function %q not found", te.popularPackages)
+	}
+
+	args := map[string]any{"ecosystem": ecosystem}
+	if err := dsf.ValidateArgs(args); err != nil {
+		return nil, fmt.Errorf("invalid arguments for %q: %w", te.popularPackages, err)
+	}
+
+	out, err := dsf.Call(ctx, res, args)
+	if err != nil {
+		return nil, fmt.Errorf("could not get popular packages: %w", err)
+	}
+
+	names, ok := packageNames(out, ecosystem)
+	if !ok {
+		return nil, fmt.Errorf("data source function %q did not return a list of package names", te.popularPackages)
+	}
+	return names, nil
+}
+
+func packageNames(out any, ecosystem string) ([]string, bool) {
+	switch v := out.(type) {
+	case []string:
+		return v, true
+	case []any:
+		names := make([]string, 0, len(v))
+		for _, item := range v {
+			name, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			names = append(names, name)
+		}
+		return names, true
+	case map[string]any:
+		if inner, ok := v[ecosystem]; ok {
+			return packageNames(inner, ecosystem)
+		}
+		if body, ok := v["body"]; ok {
+			return packageNames(body, ecosystem)
+		}
+	}
+	return nil, false
+}
+
+func reviewText(violations []*domain.Violation) string {
+	var text strings.Builder
+	text.WriteString(util.TyposquatsFoundText)
+	text.WriteString("\n\n")
+	for _, v := range violations {
+		switch {
+		case v.Typosquat != nil:
+			fmt.Fprintf(&text, "- `%s` (%s) is similar to the popular package `%s`\n",
+				v.Typosquat.Name, v.Typosquat.Ecosystem, v.Typosquat.SimilarTo)
+		case v.MixedScript != nil:
+			fmt.Fprintf(&text, "- `%s` mixes the scripts %v\n", v.MixedScript.Text, v.MixedScript.ScriptsFound)
+		default:
+			fmt.Fprintf(&text, "- a dependency name contains the invisible character `%U`\n", v.InvisibleChar)
+		}
+	}
+	return text.String()
+}
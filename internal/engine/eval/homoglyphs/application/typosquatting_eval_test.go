@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/engine/eval/homoglyphs/domain"
+	"github.com/mindersec/minder/internal/engine/eval/templates"
+	"github.com/mindersec/minder/internal/engine/options"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	mockghclient "github.com/mindersec/minder/internal/providers/github/mock"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	v1mockds "github.com/mindersec/minder/pkg/datasources/v1/mock"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+func TestTyposquattingEvaluationDetailRendering(t *testing.T) {
+	t.Parallel()
+
+	err := evalerrors.NewDetailedErrEvaluationFailed(
+		templates.TyposquattingTemplate,
+		map[string]any{
+			"violations": []*domain.Violation{
+				{
+					Typosquat: &domain.TyposquatInfo{Name: "reqests", Ecosystem: "PyPI", SimilarTo: "requests"},
+				},
+				{
+					MixedScript: &domain.MixedScriptInfo{Text: "dj\u0430ngo", ScriptsFound: []string{"Cyrillic", "Latin"}},
+				},
+				{
+					InvisibleChar: '\u200B',
+				},
+			},
+		},
+		"this is the message",
+	)
+
+	require.Equal(t, "evaluation failure: this is the message", err.Error())
+	evalErr, ok := err.(*evalerrors.EvaluationError)
+	require.True(t, ok)
+	require.Equal(t, "Suspicious dependency names found:\n"+
+		"* `reqests` (PyPI) is similar to the popular package `requests`\n"+
+		"* Text: `dj\u0430ngo`, Scripts: [Cyrillic, Latin]\n"+
+		"* Invisible character U+200B", evalErr.Details())
+}
+
+func TestTyposquattingEval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		popular     any
+		deps        []string
+		wantReview  bool
+		wantFailure bool
+		wantErr     bool
+	}{
+		{
+			name:    "no suspicious dependencies",
+			popular: []any{"requests", "django"},
+			deps:    []string{"requests", "flask"},
+		},
+		{
+			name:        "typosquatted dependency",
+			popular:     []any{"requests", "django"},
+			deps:        []string{"reqests"},
+			wantReview:  true,
+			wantFailure: true,
+		},
+		{
+			name: "popular packages in a rest response",
+			popular: map[string]any{
+				"status_code": 200,
+				"body":        []any{"requests", "django"},
+			},
+			deps:        []string{"djnago"},
+			wantReview:  true,
+			wantFailure: true,
+		},
+		{
+			name:    "unexpected data source output",
+			popular: map[string]any{"packages": 1},
+			deps:    []string{"requests"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			fds := v1mockds.NewMockDataSource(ctrl)
+			fdsf := v1mockds.NewMockDataSourceFuncDef(ctrl)
+			fds.EXPECT().GetFuncs().Return(map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef{
+				"packages": fdsf,
+			}).AnyTimes()
+			fdsf.EXPECT().ValidateArgs(map[string]any{"ecosystem": "PyPI"}).Return(nil)
+			fdsf.EXPECT().Call(gomock.Any(), gomock.Any(), map[string]any{"ecosystem": "PyPI"}).Return(tt.popular, nil)
+
+			fdsr := v1datasources.NewDataSourceRegistry()
+			require.NoError(t, fdsr.RegisterDataSource("popular", fds))
+
+			ghClient := mockghclient.NewMockGitHub(ctrl)
+			if tt.wantReview {
+				ghClient.EXPECT().ListReviews(gomock.Any(), "owner", "repo", 1, gomock.Any()).Return(nil, nil)
+				ghClient.EXPECT().CreateReview(gomock.Any(), "owner", "repo", 1, gomock.Any()).
+					Return(&github.PullRequestReview{}, nil)
+			}
+
+			e, err := NewTyposquattingEvaluator(context.Background(), "popular.packages", ghClient,
+				options.WithDataSources(fdsr))
+			require.NoError(t, err)
+
+			prDeps := &pbinternal.PrDependencies{
+				Pr: &pbinternal.PullRequest{RepoOwner: "owner", RepoName: "repo", Number: 1},
+			}
+			for _, name := range tt.deps {
+				prDeps.Deps = append(prDeps.Deps, &pbinternal.PrDependencies_ContextualDependency{
+					Dep: &pbinternal.Dependency{
+						Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_PYPI,
+						Name:      name,
+						Version:   "1.0.0",
+					},
+				})
+			}
+
+			_, err = e.Eval(context.Background(), nil, nil, &interfaces.Ingested{Object: prDeps})
+			switch {
+			case tt.wantErr:
+				require.Error(t, err)
+				require.NotErrorIs(t, err, interfaces.ErrEvaluationFailed)
+			case tt.wantFailure:
+				require.ErrorIs(t, err, interfaces.ErrEvaluationFailed)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewTyposquattingEvaluatorRequiresDataSource(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	_, err := NewTyposquattingEvaluator(context.Background(), "", mockghclient.NewMockGitHub(ctrl))
+	require.Error(t, err)
+}
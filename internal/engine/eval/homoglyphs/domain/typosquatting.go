@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package domain
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// minTyposquatLength is the length under which names are only compared
+	// by their confusable skeleton, since short names are commonly one edit
+	// away from each other.
+	minTyposquatLength = 5
+)
+
+// TyposquatInfo contains information about a dependency name which is
+// similar to the name of a popular package
+type TyposquatInfo struct {
+	Name      string
+	Ecosystem string
+	SimilarTo string
+}
+
+// confusableSequences are the sequences of characters commonly used in place
+// of one another in package names
+var confusableSequences = strings.NewReplacer(
+	"rn", "m",
+	"vv", "w",
+	"0", "o",
+	"1", "l",
+	"i", "l",
+	"5", "s",
+)
+
+// FindTyposquat returns a violation if the given dependency name is not one
+// of the popular packages but is similar to one of them: either both names
+// read the same once confusable characters are replaced, or they are one
+// edit away from each other.
+func FindTyposquat(name, ecosystem string, popular []string) *Violation {
+	normalized := normalizePackageName(name)
+	for _, p := range popular {
+		if normalizePackageName(p) == normalized {
+			return nil
+		}
+	}
+
+	skeleton := confusableSequences.Replace(normalized)
+	for _, p := range popular {
+		np := normalizePackageName(p)
+		if confusableSequences.Replace(np) == skeleton ||
+			(utf8.RuneCountInString(np) >= minTyposquatLength && withinOneEdit(normalized, np)) {
+			return &Violation{
+				Typosquat: &TyposquatInfo{
+					Name:      name,
+					Ecosystem: ecosystem,
+					SimilarTo: p,
+				},
+			}
+		}
+	}
+
+	return nil
+}
+
+// normalizePackageName lowercases the name and folds the separators which
+// package registries consider equivalent
+func normalizePackageName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == '.' {
+			return '-'
+		}
+		return r
+	}, strings.ToLower(name))
+}
+
+// withinOneEdit returns whether the two strings are at most one insertion,
+// deletion, substitution or transposition of adjacent characters apart.
+func withinOneEdit(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) > len(rb) {
+		ra, rb = rb, ra
+	}
+	if len(rb)-len(ra) > 1 {
+		return false
+	}
+
+	i := 0
+	for i < len(ra) && ra[i] == rb[i] {
+		i++
+	}
+	if i == len(ra) {
+		return true
+	}
+
+	if len(ra) == len(rb) {
+		// a substitution, or a transposition of the next two characters
+		if string(ra[i+1:]) == string(rb[i+1:]) {
+			return true
+		}
+		return i+1 < len(ra) && ra[i] == rb[i+1] && ra[i+1] == rb[i] && string(ra[i+2:]) == string(rb[i+2:])
+	}
+
+	// an insertion in the longer string
+	return string(ra[i:]) == string(rb[i+1:])
+}
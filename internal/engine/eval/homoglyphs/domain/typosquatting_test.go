@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindTyposquat(t *testing.T) {
+	t.Parallel()
+
+	popular := []string{"requests", "django", "python-dateutil", "six"}
+
+	tests := []struct {
+		description string
+		name        string
+		expected    *Violation
+	}{
+		{
+			description: "Popular package",
+			name:        "requests",
+		},
+		{
+			description: "Popular package with equivalent separators",
+			name:        "Python_Dateutil",
+		},
+		{
+			description: "Unrelated package",
+			name:        "flask",
+		},
+		{
+			description: "Missing character",
+			name:        "reqests",
+			expected:    &Violation{Typosquat: &TyposquatInfo{Name: "reqests", Ecosystem: "PyPI", SimilarTo: "requests"}},
+		},
+		{
+			description: "Transposed characters",
+			name:        "djnago",
+			expected:    &Violation{Typosquat: &TyposquatInfo{Name: "djnago", Ecosystem: "PyPI", SimilarTo: "django"}},
+		},
+		{
+			description: "Extra character",
+			name:        "python-dateutils",
+			expected: &Violation{
+				Typosquat: &TyposquatInfo{Name: "python-dateutils", Ecosystem: "PyPI", SimilarTo: "python-dateutil"},
+			},
+		},
+		{
+			description: "Substituted character",
+			name:        "dj4ngo",
+			expected:    &Violation{Typosquat: &TyposquatInfo{Name: "dj4ngo", Ecosystem: "PyPI", SimilarTo: "django"}},
+		},
+		{
+			description: "Confusable characters",
+			name:        "reque5t5",
+			expected:    &Violation{Typosquat: &TyposquatInfo{Name: "reque5t5", Ecosystem: "PyPI", SimilarTo: "requests"}},
+		},
+		{
+			description: "Confusable sequence in a short name",
+			name:        "5ix",
+			expected:    &Violation{Typosquat: &TyposquatInfo{Name: "5ix", Ecosystem: "PyPI", SimilarTo: "six"}},
+		},
+		{
+			description: "Short names are not compared by edit distance",
+			name:        "sox",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			result := FindTyposquat(tt.name, "PyPI", popular)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("FindTyposquat(%q) = %v, want %v", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
@@ -10,4 +10,7 @@ type Violation struct {
 
 	// mixedScript is a mixed script found in a line.
 	MixedScript *MixedScriptInfo
+
+	// Typosquat is a dependency name similar to the name of a popular package.
+	Typosquat *TyposquatInfo
 }
@@ -33,6 +33,12 @@ const (
 		"Please review the content carefully to ensure its integrity and safety."
 	// NoMixedScriptsFoundText is the text to display when no mixed scripts are found
 	NoMixedScriptsFoundText = "### :white_check_mark: No Mixed Scripts Detected."
+
+	// TyposquatsFoundText is the text to display when suspicious dependency names are found
+	TyposquatsFoundText = "### :warning: Minder Has Identified Potentially Typosquatted Dependencies\n\n" +
+		"These dependency names are similar to the names of popular packages, or contain invisible\n" +
+		"or mixed-script characters, which is a common way of publishing malicious packages.\n" +
+		"Please review the dependencies to ensure they are the intended ones."
 )
 
 // CreateReviewBody creates a review body for a PR review
@@ -5,11 +5,15 @@
 package jq
 
 import (
+	"cmp"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 
@@ -20,11 +24,30 @@ import (
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 )
 
+// Comparators supported by the assertions
+const (
+	comparatorEquals   = "equals"
+	comparatorContains = "contains"
+	comparatorRegex    = "regex"
+	comparatorGte      = "gte"
+)
+
 // Evaluator is an Evaluator that uses the jq library to evaluate rules
 type Evaluator struct {
 	assertions []*pb.RuleType_Definition_Eval_JQComparison
 }
 
+// assertionResult is the result of a single assertion, as reported in the
+// output and the details of the evaluation
+type assertionResult struct {
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	Comparator string `json:"comparator"`
+	Expected   any    `json:"expected"`
+	Actual     any    `json:"actual"`
+	Passed     bool   `json:"passed"`
+}
+
 // NewJQEvaluator creates a new JQ rule data evaluator
 func NewJQEvaluator(
 	assertions []*pb.RuleType_Definition_Eval_JQComparison,
@@ -34,11 +57,25 @@ func NewJQEvaluator(
 		return nil, fmt.Errorf("missing jq assertions")
 	}
 
+	names := make(map[string]struct{}, len(assertions))
 	for idx := range assertions {
 		a := assertions[idx]
 		if err := a.Validate(); err != nil {
 			return nil, fmt.Errorf("invalid jq assertion: %w", err)
 		}
+
+		if a.GetName() != "" {
+			if _, ok := names[a.GetName()]; ok {
+				return nil, fmt.Errorf("invalid jq assertion: duplicate name %q", a.GetName())
+			}
+			names[a.GetName()] = struct{}{}
+		}
+
+		if a.GetComparator() == comparatorRegex && a.GetConstant() != nil {
+			if _, err := regexp.Compile(a.GetConstant().GetStringValue()); err != nil {
+				return nil, fmt.Errorf("invalid jq assertion: invalid regex: %w", err)
+			}
+		}
 	}
 
 	evaluator := &Evaluator{
@@ -54,7 +91,9 @@ func NewJQEvaluator(
 	return evaluator, nil
 }
 
-// Eval calls the jq library to evaluate the rule
+// Eval calls the jq library to evaluate the rule. All the assertions are
+// evaluated; if any of them does not hold, the evaluation fails and the
+// result of each assertion is reported in the output of the evaluation.
 func (jqe *Evaluator) Eval(
 	ctx context.Context,
 	pol map[string]any,
@@ -66,6 +105,8 @@ func (jqe *Evaluator) Eval(
 	}
 	obj := res.Object
 
+	results := make([]assertionResult, 0, len(jqe.assertions))
+	var failures []string
 	for idx := range jqe.assertions {
 		var expectedVal, dataVal any
 		var err error
@@ -93,30 +134,120 @@ func (jqe *Evaluator) Eval(
 			return nil, fmt.Errorf("cannot get values from data accessor: %w", err)
 		}
 
-		// Deep compare
-		if !reflect.DeepEqual(standardizeNumbers(expectedVal), standardizeNumbers(dataVal)) {
-			msg := fmt.Sprintf("data does not match profile: for assertion %d, got %v, want %v",
-				idx, dataVal, expectedVal)
+		comparator := cmp.Or(a.GetComparator(), comparatorEquals)
+		passed, err := compare(comparator, expectedVal, dataVal)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compare values for assertion %s: %w", assertionLabel(a, idx), err)
+		}
 
-			marshalledAssertion, err := json.MarshalIndent(a, "", "  ")
-			if err == nil {
-				msg = fmt.Sprintf("%s\nassertion: %s", msg, string(marshalledAssertion))
-			}
+		results = append(results, assertionResult{
+			Name:       a.GetName(),
+			Path:       a.Ingested.Def,
+			Comparator: comparator,
+			Expected:   expectedVal,
+			Actual:     dataVal,
+			Passed:     passed,
+		})
+		if !passed {
+			failures = append(failures, fmt.Sprintf("for assertion %s, got %v, want %v",
+				assertionLabel(a, idx), dataVal, expectedVal))
+		}
+	}
+
+	if len(failures) == 0 {
+		return &interfaces.EvaluationResult{}, nil
+	}
 
-			return nil, evalerrors.NewDetailedErrEvaluationFailed(
-				templates.JqTemplate,
-				map[string]any{
-					"path":     a.Ingested.Def,
-					"expected": expectedVal,
-					"actual":   dataVal,
-				},
-				"%s",
-				msg,
-			)
+	return &interfaces.EvaluationResult{Output: results}, evalerrors.NewDetailedErrEvaluationFailed(
+		templates.JqTemplate,
+		map[string]any{
+			"assertions": templateArgs(results),
+		},
+		"data does not match profile: %s",
+		strings.Join(failures, "; "),
+	)
+}
+
+// assertionLabel identifies an assertion by its name, or by its position
+// when it has none.
+func assertionLabel(a *pb.RuleType_Definition_Eval_JQComparison, idx int) string {
+	if a.GetName() != "" {
+		return a.GetName()
+	}
+	return strconv.Itoa(idx)
+}
+
+// templateArgs turns the failed assertions into the arguments of the
+// details template.
+func templateArgs(results []assertionResult) []map[string]any {
+	args := make([]map[string]any, 0, len(results))
+	for _, r := range results {
+		if r.Passed {
+			continue
 		}
+		args = append(args, map[string]any{
+			"name":       r.Name,
+			"path":       r.Path,
+			"comparison": comparisonVerb(r.Comparator),
+			"expected":   r.Expected,
+			"actual":     r.Actual,
+		})
+	}
+	return args
+}
+
+func comparisonVerb(comparator string) string {
+	switch comparator {
+	case comparatorContains:
+		return "contain"
+	case comparatorRegex:
+		return "match"
+	case comparatorGte:
+		return "be at least"
+	default:
+		return "equal"
 	}
+}
 
-	return &interfaces.EvaluationResult{}, nil
+// compare compares the ingested value to the expected value with the given
+// comparator. Values of the wrong type for the comparator do not match.
+func compare(comparator string, expected, actual any) (bool, error) {
+	switch comparator {
+	case comparatorEquals:
+		return reflect.DeepEqual(standardizeNumbers(expected), standardizeNumbers(actual)), nil
+	case comparatorContains:
+		switch act := actual.(type) {
+		case string:
+			exp, ok := expected.(string)
+			return ok && strings.Contains(act, exp), nil
+		case []any:
+			return slices.ContainsFunc(act, func(item any) bool {
+				return reflect.DeepEqual(standardizeNumbers(expected), standardizeNumbers(item))
+			}), nil
+		default:
+			return false, nil
+		}
+	case comparatorRegex:
+		exp, ok := expected.(string)
+		if !ok {
+			return false, fmt.Errorf("expected value %v is not a regular expression", expected)
+		}
+		re, err := regexp.Compile(exp)
+		if err != nil {
+			return false, fmt.Errorf("invalid regex: %w", err)
+		}
+		act, ok := actual.(string)
+		return ok && re.MatchString(act), nil
+	case comparatorGte:
+		exp, ok := standardizeNumbers(expected).(float64)
+		if !ok {
+			return false, fmt.Errorf("expected value %v is not a number", expected)
+		}
+		act, ok := standardizeNumbers(actual).(float64)
+		return ok && act >= exp, nil
+	default:
+		return false, fmt.Errorf("unsupported comparator %q", comparator)
+	}
 }
 
 // Convert numeric types to float64
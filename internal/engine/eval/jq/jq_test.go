@@ -151,6 +151,55 @@ func TestNewJQEvaluatorInvalid(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "unsupported comparator",
+			args: args{
+				assertions: []*pb.RuleType_Definition_Eval_JQComparison{
+					{
+						Constant:   structpb.NewStringValue("a"),
+						Comparator: "lte",
+						Ingested: &pb.RuleType_Definition_Eval_JQComparison_Operator{
+							Def: ".",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid constant regex",
+			args: args{
+				assertions: []*pb.RuleType_Definition_Eval_JQComparison{
+					{
+						Constant:   structpb.NewStringValue("(unclosed"),
+						Comparator: "regex",
+						Ingested: &pb.RuleType_Definition_Eval_JQComparison_Operator{
+							Def: ".",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate assertion names",
+			args: args{
+				assertions: []*pb.RuleType_Definition_Eval_JQComparison{
+					{
+						Name:     "same",
+						Constant: structpb.NewStringValue("a"),
+						Ingested: &pb.RuleType_Definition_Eval_JQComparison_Operator{
+							Def: ".a",
+						},
+					},
+					{
+						Name:     "same",
+						Constant: structpb.NewStringValue("b"),
+						Ingested: &pb.RuleType_Definition_Eval_JQComparison_Operator{
+							Def: ".b",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "one valid accessor and one invalid",
 			args: args{
@@ -578,6 +627,110 @@ func TestValidJQEvalsFailed(t *testing.T) {
 	}
 }
 
+func TestJQEvalComparators(t *testing.T) {
+	t.Parallel()
+
+	obj := map[string]any{
+		"name":    "minder-server",
+		"tags":    []any{"latest", "v1.0.0"},
+		"reviews": 2,
+	}
+
+	tests := []struct {
+		name       string
+		comparator string
+		path       string
+		constant   *structpb.Value
+		passed     bool
+	}{
+		{name: "equals", comparator: "equals", path: ".name", constant: structpb.NewStringValue("minder-server"), passed: true},
+		{name: "equals by default", path: ".reviews", constant: structpb.NewNumberValue(2), passed: true},
+		{name: "not equals", comparator: "equals", path: ".name", constant: structpb.NewStringValue("minder"), passed: false},
+		{name: "string contains", comparator: "contains", path: ".name", constant: structpb.NewStringValue("server"), passed: true},
+		{name: "no substring", comparator: "contains", path: ".name", constant: structpb.NewStringValue("client"), passed: false},
+		{name: "list contains", comparator: "contains", path: ".tags", constant: structpb.NewStringValue("latest"), passed: true},
+		{name: "list does not contain", comparator: "contains", path: ".tags", constant: structpb.NewStringValue("v2"), passed: false},
+		{name: "regex matches", comparator: "regex", path: ".name", constant: structpb.NewStringValue("^minder-"), passed: true},
+		{name: "regex mismatch", comparator: "regex", path: ".name", constant: structpb.NewStringValue("^trusty-"), passed: false},
+		{name: "regex on missing", comparator: "regex", path: ".missing", constant: structpb.NewStringValue(".*"), passed: false},
+		{name: "gte equal", comparator: "gte", path: ".reviews", constant: structpb.NewNumberValue(2), passed: true},
+		{name: "gte lower", comparator: "gte", path: ".reviews", constant: structpb.NewNumberValue(3), passed: false},
+		{name: "gte on string", comparator: "gte", path: ".name", constant: structpb.NewNumberValue(1), passed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			jqe, err := jq.NewJQEvaluator([]*pb.RuleType_Definition_Eval_JQComparison{
+				{
+					Name:       "check",
+					Comparator: tt.comparator,
+					Constant:   tt.constant,
+					Ingested: &pb.RuleType_Definition_Eval_JQComparison_Operator{
+						Def: tt.path,
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			_, err = jqe.Eval(context.Background(), map[string]any{}, nil, &interfaces.Ingested{Object: obj})
+			if tt.passed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, interfaces.ErrEvaluationFailed)
+			}
+		})
+	}
+}
+
+func TestJQEvalReportsEveryAssertion(t *testing.T) {
+	t.Parallel()
+
+	jqe, err := jq.NewJQEvaluator([]*pb.RuleType_Definition_Eval_JQComparison{
+		{
+			Name:     "visibility",
+			Constant: structpb.NewStringValue("private"),
+			Ingested: &pb.RuleType_Definition_Eval_JQComparison_Operator{
+				Def: ".visibility",
+			},
+		},
+		{
+			Name:       "reviews",
+			Comparator: "gte",
+			Constant:   structpb.NewNumberValue(2),
+			Ingested: &pb.RuleType_Definition_Eval_JQComparison_Operator{
+				Def: ".reviews",
+			},
+		},
+		{
+			Name:       "branch",
+			Comparator: "regex",
+			Constant:   structpb.NewStringValue("^release/"),
+			Ingested: &pb.RuleType_Definition_Eval_JQComparison_Operator{
+				Def: ".branch",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	res, err := jqe.Eval(context.Background(), map[string]any{}, nil, &interfaces.Ingested{Object: map[string]any{
+		"visibility": "public",
+		"reviews":    3,
+		"branch":     "main",
+	}})
+	require.ErrorIs(t, err, interfaces.ErrEvaluationFailed)
+	require.NotNil(t, res)
+	require.NotNil(t, res.Output)
+
+	evalErr, ok := err.(*evalerrors.EvaluationError)
+	require.True(t, ok)
+	require.Equal(t, "The detected configuration does not match the desired configuration:\n"+
+		"visibility: Expected \".visibility\" to equal private, but was public.\n"+
+		"branch: Expected \".branch\" to match ^release/, but was main.",
+		evalErr.Details())
+}
+
 func TestInvalidJQEvals(t *testing.T) {
 	t.Parallel()
 
@@ -663,9 +816,15 @@ func TestEvaluationDetailRendering(t *testing.T) {
 			msg:  "this is the message",
 			tmpl: templates.JqTemplate,
 			args: map[string]any{
-				"path":     ".simple",
-				"expected": true,
-				"actual":   false,
+				"assertions": []map[string]any{
+					{
+						"name":       "",
+						"path":       ".simple",
+						"comparison": "equal",
+						"expected":   true,
+						"actual":     false,
+					},
+				},
 			},
 			error: "evaluation failure: this is the message",
 			details: "The detected configuration does not match the desired configuration:\n" +
@@ -676,9 +835,15 @@ func TestEvaluationDetailRendering(t *testing.T) {
 			msg:  "this is the message",
 			tmpl: templates.JqTemplate,
 			args: map[string]any{
-				"path":     ".simple",
-				"expected": 5,
-				"actual":   nil,
+				"assertions": []map[string]any{
+					{
+						"name":       "",
+						"path":       ".simple",
+						"comparison": "equal",
+						"expected":   5,
+						"actual":     nil,
+					},
+				},
 			},
 			error: "evaluation failure: this is the message",
 			details: "The detected configuration does not match the desired configuration:\n" +
@@ -22,14 +22,18 @@ func (e *Evaluator) RegisterDataSources(dsr *v1datasources.DataSourceRegistry) {
 
 // buildDataSourceOptions creates an options set from the functions available in
 // a data source registry.
-func buildDataSourceOptions(res *interfaces.Ingested, dsr *v1datasources.DataSourceRegistry) []func(*rego.Rego) {
+// Calls to the functions and their results are accounted for in the given
+// evaluation budget.
+func buildDataSourceOptions(
+	res *interfaces.Ingested, dsr *v1datasources.DataSourceRegistry, budget *evalBudget,
+) []func(*rego.Rego) {
 	opts := []func(*rego.Rego){}
 	if dsr == nil {
 		return opts
 	}
 
 	for key, dsf := range dsr.GetFuncs() {
-		opts = append(opts, buildFromDataSource(res, key, dsf))
+		opts = append(opts, buildFromDataSource(res, key, dsf, budget))
 	}
 
 	return opts
@@ -40,6 +44,7 @@ func buildDataSourceOptions(res *interfaces.Ingested, dsr *v1datasources.DataSou
 // register the function with the rego engine.
 func buildFromDataSource(
 	res *interfaces.Ingested, key v1datasources.DataSourceFuncKey, dsf v1datasources.DataSourceFuncDef,
+	budget *evalBudget,
 ) func(*rego.Rego) {
 	k := normalizeKey(key)
 	return rego.Function1(
@@ -59,11 +64,19 @@ func buildFromDataSource(
 				return nil, err
 			}
 
+			if err := budget.addDataSourceCall(); err != nil {
+				return nil, err
+			}
+
 			ret, err := dsf.Call(bctx.Context, res, jsonObj)
 			if err != nil {
 				return nil, err
 			}
 
+			if err := budget.addData(ret); err != nil {
+				return nil, err
+			}
+
 			val, err := ast.InterfaceToValue(ret)
 			if err != nil {
 				return nil, err
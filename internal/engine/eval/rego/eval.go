@@ -18,6 +18,7 @@ import (
 	eoptions "github.com/mindersec/minder/internal/engine/options"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 	"github.com/mindersec/minder/pkg/flags"
 )
@@ -46,6 +47,7 @@ type Evaluator struct {
 	reseval      resultEvaluator
 	datasources  *v1datasources.DataSourceRegistry
 	regoVersion  ast.RegoVersion
+	limits       interfaces.Limits
 }
 
 // Input is the input for the rego evaluator
@@ -112,6 +114,7 @@ func NewRegoEvaluator(
 }
 
 var _ eoptions.SupportsFlags = (*Evaluator)(nil)
+var _ eoptions.SupportsLimits = (*Evaluator)(nil)
 
 func (e *Evaluator) newRegoFromOptions(opts ...func(*rego.Rego)) *rego.Rego {
 	return rego.New(append(e.regoOpts, opts...)...)
@@ -148,8 +151,11 @@ func (e *Evaluator) Eval(
 	// Initialize the built-in minder library rego functions
 	regoFuncOptions = append(regoFuncOptions, instantiateRegoLib(res)...)
 
+	// Track the data handled by this evaluation against the rule type limits
+	budget := newEvalBudget(e.limits)
+
 	// If the evaluator has data sources defined, expose their functions
-	regoFuncOptions = append(regoFuncOptions, buildDataSourceOptions(res, e.datasources)...)
+	regoFuncOptions = append(regoFuncOptions, buildDataSourceOptions(res, e.datasources, budget)...)
 
 	// Create the rego object
 	r := e.newRegoFromOptions(
@@ -158,7 +164,7 @@ func (e *Evaluator) Eval(
 
 	pq, err := r.PrepareForEval(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("could not prepare Rego: %w", err)
+		return nil, fmt.Errorf("%w: could not prepare Rego: %w", engerrors.ErrPolicyCompile, err)
 	}
 
 	input := &Input{
@@ -168,7 +174,16 @@ func (e *Evaluator) Eval(
 	}
 
 	enrichInputWithEntityProps(input, entity)
+	if err := budget.addData(input); err != nil {
+		return nil, err
+	}
+
 	rs, err := pq.Eval(ctx, rego.EvalInput(input), rego.EvalHTTPRoundTripper(LimitedDialer))
+	// A policy may recover from a failed data source call, so a limit
+	// breach is reported even if the evaluation itself succeeded.
+	if limitErr := budget.exceeded(); limitErr != nil {
+		return nil, limitErr
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error evaluating profile. Might be wrong input: %w", err)
 	}
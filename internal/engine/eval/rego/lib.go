@@ -58,6 +58,12 @@ var MinderRegoLib = []func(res *interfaces.Ingested) func(*rego.Rego){
 	BaseListGithubActions,
 	DependencyExtract,
 	BaseDependencyExtract,
+	SPDXValid,
+	SPDXParse,
+	SPDXLicenses,
+	SPDXCategory,
+	SPDXSatisfies,
+	SPDXAvoids,
 }
 
 func instantiateRegoLib(res *interfaces.Ingested) []func(*rego.Rego) {
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rego
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+// SetLimits implements the SupportsLimits interface.
+func (e *Evaluator) SetLimits(limits interfaces.Limits) {
+	e.limits = limits
+}
+
+// evalBudget tracks the resources used by a single rego evaluation against
+// the limits of the rule type. Once a limit is exceeded, the budget keeps
+// the error so that it can be reported even if the policy swallowed the
+// failure of the data source call.
+type evalBudget struct {
+	limits interfaces.Limits
+
+	mu              sync.Mutex
+	memoryBytes     int64
+	dataSourceCalls int64
+	err             error
+}
+
+func newEvalBudget(limits interfaces.Limits) *evalBudget {
+	return &evalBudget{limits: limits}
+}
+
+// addData accounts for the JSON-encoded size of the given data
+func (b *evalBudget) addData(data any) error {
+	if b.limits.MaxRegoMemoryBytes <= 0 {
+		return nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("could not measure evaluation data: %w", err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.memoryBytes += int64(len(raw))
+	if b.memoryBytes > b.limits.MaxRegoMemoryBytes && b.err == nil {
+		b.err = engerrors.NewErrEvaluationLimitExceeded(
+			"evaluation data exceeds the maximum of %d bytes", b.limits.MaxRegoMemoryBytes)
+	}
+	return b.err
+}
+
+// addDataSourceCall accounts for a data source call
+func (b *evalBudget) addDataSourceCall() error {
+	if b.limits.MaxDataSourceCalls <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.dataSourceCalls++
+	if b.dataSourceCalls > b.limits.MaxDataSourceCalls && b.err == nil {
+		b.err = engerrors.NewErrEvaluationLimitExceeded(
+			"evaluation exceeds the maximum of %d data source calls", b.limits.MaxDataSourceCalls)
+	}
+	return b.err
+}
+
+// exceeded returns the error of the first exceeded limit, if any
+func (b *evalBudget) exceeded() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.err
+}
@@ -884,6 +884,86 @@ allow if {
 	require.ErrorIs(t, err, interfaces.ErrEvaluationFailed, "should have failed the evaluation")
 }
 
+func TestEvaluatorLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		limits  interfaces.Limits
+		wantErr bool
+	}{
+		{
+			name:   "no limits",
+			limits: interfaces.Limits{},
+		},
+		{
+			name:   "within limits",
+			limits: interfaces.Limits{MaxDataSourceCalls: 2, MaxRegoMemoryBytes: 1024},
+		},
+		{
+			name:    "too many data source calls",
+			limits:  interfaces.Limits{MaxDataSourceCalls: 1},
+			wantErr: true,
+		},
+		{
+			name:    "too much data",
+			limits:  interfaces.Limits{MaxRegoMemoryBytes: 16},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			fds := v1mockds.NewMockDataSource(ctrl)
+			fdsf := v1mockds.NewMockDataSourceFuncDef(ctrl)
+
+			fds.EXPECT().GetFuncs().Return(map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef{
+				"source": fdsf,
+			}).AnyTimes()
+			fdsf.EXPECT().ValidateArgs(gomock.Any()).Return(nil).AnyTimes()
+			fdsf.EXPECT().Call(gomock.Any(), gomock.Any(), gomock.Any()).Return("foo", nil).AnyTimes()
+
+			fdsr := v1datasources.NewDataSourceRegistry()
+			require.NoError(t, fdsr.RegisterDataSource("fake", fds), "could not register data source")
+
+			e, err := rego.NewRegoEvaluator(
+				&minderv1.RuleType_Definition_Eval_Rego{
+					Type: rego.DenyByDefaultEvaluationType.String(),
+					Def: `package minder
+
+import rego.v1
+
+default allow := false
+
+allow if {
+	minder.datasource.fake.source({"call": 1}) == "foo"
+	minder.datasource.fake.source({"call": 2}) == "foo"
+}
+`,
+				},
+				options.WithDataSources(fdsr),
+				options.WithLimits(tt.limits),
+			)
+			require.NoError(t, err, "could not create evaluator")
+
+			_, err = e.Eval(context.Background(), map[string]any{}, nil, &interfaces.Ingested{
+				Object: map[string]any{
+					"data": "foo",
+				},
+			})
+			if tt.wantErr {
+				require.ErrorIs(t, err, engerrors.ErrEvaluationLimitExceeded)
+				return
+			}
+			require.NoError(t, err, "could not evaluate")
+		})
+	}
+}
+
 func TestDenyByDefaultWithShortFailureMessage(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rego
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/open-policy-agent/opa/v1/types"
+
+	"github.com/mindersec/minder/internal/util/spdx"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+// licenseListType is an array or set of license identifiers or categories
+var licenseListType = types.NewAny(types.NewArray(nil, types.S), types.NewSet(types.S))
+
+// SPDXValid adds the `spdx.valid` function to the Rego engine.
+func SPDXValid(_ *interfaces.Ingested) func(*rego.Rego) {
+	return rego.Function1(
+		&rego.Function{
+			Name: "spdx.valid",
+			Description: `spdx.valid checks whether a string is a valid SPDX license
+			expression. It takes one argument: the license expression, and returns
+			a boolean. The other spdx functions are undefined for invalid
+			expressions, so use this to tell free-form license fields apart.`,
+			Decl: types.NewFunction(types.Args(types.S), types.B),
+		},
+		spdxValid,
+	)
+}
+
+func spdxValid(_ rego.BuiltinContext, expr *ast.Term) (*ast.Term, error) {
+	var exprStr string
+	if err := ast.As(expr.Value, &exprStr); err != nil {
+		return nil, err
+	}
+
+	_, err := spdx.Parse(exprStr)
+	return ast.BooleanTerm(err == nil), nil
+}
+
+// SPDXParse adds the `spdx.parse` function to the Rego engine.
+func SPDXParse(_ *interfaces.Ingested) func(*rego.Rego) {
+	return rego.Function1(
+		&rego.Function{
+			Name: "spdx.parse",
+			Description: `spdx.parse parses a SPDX license expression. It takes one
+			argument: the license expression, and returns it as a tree of objects.
+			Compound expressions have an "op" ("AND" or "OR") and "operands", while
+			single licenses have a "license" and, optionally, an "exception".`,
+			Decl: types.NewFunction(types.Args(types.S), types.A),
+		},
+		spdxParse,
+	)
+}
+
+func spdxParse(_ rego.BuiltinContext, expr *ast.Term) (*ast.Term, error) {
+	parsed, err := parseSPDXTerm(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := ast.InterfaceToValue(spdxExpressionToMap(parsed))
+	if err != nil {
+		return nil, fmt.Errorf("error converting to AST value: %w", err)
+	}
+
+	return ast.NewTerm(value), nil
+}
+
+// SPDXLicenses adds the `spdx.licenses` function to the Rego engine.
+func SPDXLicenses(_ *interfaces.Ingested) func(*rego.Rego) {
+	return rego.Function1(
+		&rego.Function{
+			Name: "spdx.licenses",
+			Description: `spdx.licenses lists the licenses referenced by a SPDX license
+			expression. It takes one argument: the license expression, and returns
+			a sorted array of the license identifiers, without exceptions.`,
+			Decl: types.NewFunction(types.Args(types.S), types.NewArray(nil, types.S)),
+		},
+		spdxLicenses,
+	)
+}
+
+func spdxLicenses(_ rego.BuiltinContext, expr *ast.Term) (*ast.Term, error) {
+	parsed, err := parseSPDXTerm(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	licenses := parsed.Licenses()
+	terms := make([]*ast.Term, 0, len(licenses))
+	for _, l := range licenses {
+		terms = append(terms, ast.StringTerm(l))
+	}
+
+	return ast.ArrayTerm(terms...), nil
+}
+
+// SPDXCategory adds the `spdx.category` function to the Rego engine.
+func SPDXCategory(_ *interfaces.Ingested) func(*rego.Rego) {
+	return rego.Function1(
+		&rego.Function{
+			Name: "spdx.category",
+			Description: `spdx.category classifies a single license. It takes one
+			argument: the license identifier, optionally with an exception (e.g.
+			"GPL-2.0-only WITH Classpath-exception-2.0"), and returns one of
+			"permissive", "weak-copyleft", "strong-copyleft", "network-copyleft"
+			or "unknown".`,
+			Decl: types.NewFunction(types.Args(types.S), types.S),
+		},
+		spdxCategory,
+	)
+}
+
+func spdxCategory(_ rego.BuiltinContext, license *ast.Term) (*ast.Term, error) {
+	parsed, err := parseSPDXTerm(license)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Op != "" {
+		return nil, fmt.Errorf("expected a single license, got %q", parsed.String())
+	}
+
+	return ast.StringTerm(spdx.Category(parsed.License, parsed.Exception)), nil
+}
+
+// SPDXSatisfies adds the `spdx.satisfies` function to the Rego engine.
+func SPDXSatisfies(_ *interfaces.Ingested) func(*rego.Rego) {
+	return rego.Function2(
+		&rego.Function{
+			Name: "spdx.satisfies",
+			Description: `spdx.satisfies checks a SPDX license expression against an
+			allow list. It takes two arguments: the license expression, and an array
+			or set of allowed license identifiers or categories (e.g. "MIT" or
+			"permissive"). It returns true if the licenses can be complied with by
+			only using allowed licenses, choosing among the operands of "OR".`,
+			Decl: types.NewFunction(types.Args(types.S, licenseListType), types.B),
+		},
+		spdxSatisfies,
+	)
+}
+
+func spdxSatisfies(_ rego.BuiltinContext, expr *ast.Term, allowed *ast.Term) (*ast.Term, error) {
+	parsed, err := parseSPDXTerm(expr)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := licenseMatcher(allowed)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.BooleanTerm(parsed.Satisfies(matches)), nil
+}
+
+// SPDXAvoids adds the `spdx.avoids` function to the Rego engine.
+func SPDXAvoids(_ *interfaces.Ingested) func(*rego.Rego) {
+	return rego.Function2(
+		&rego.Function{
+			Name: "spdx.avoids",
+			Description: `spdx.avoids checks a SPDX license expression against a deny
+			list. It takes two arguments: the license expression, and an array or
+			set of denied license identifiers or categories (e.g. "AGPL-3.0-only"
+			or "network-copyleft"). It returns true if the licenses can be
+			complied with without using any denied license, choosing among the
+			operands of "OR".`,
+			Decl: types.NewFunction(types.Args(types.S, licenseListType), types.B),
+		},
+		spdxAvoids,
+	)
+}
+
+func spdxAvoids(_ rego.BuiltinContext, expr *ast.Term, denied *ast.Term) (*ast.Term, error) {
+	parsed, err := parseSPDXTerm(expr)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := licenseMatcher(denied)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.BooleanTerm(parsed.Satisfies(func(license, exception string) bool {
+		return !matches(license, exception)
+	})), nil
+}
+
+func parseSPDXTerm(expr *ast.Term) (*spdx.Expression, error) {
+	var exprStr string
+	if err := ast.As(expr.Value, &exprStr); err != nil {
+		return nil, err
+	}
+
+	return spdx.Parse(exprStr)
+}
+
+// licenseMatcher builds a function matching a license against a list of
+// license identifiers, license identifiers with exceptions, and categories.
+// Matching ignores case, as license identifiers do per the SPDX spec.
+func licenseMatcher(list *ast.Term) (func(license, exception string) bool, error) {
+	// sets don't convert to slices with ast.As, so the entries are
+	// converted one at a time
+	var terms []*ast.Term
+	switch v := list.Value.(type) {
+	case *ast.Array:
+		v.Foreach(func(t *ast.Term) { terms = append(terms, t) })
+	case ast.Set:
+		terms = v.Slice()
+	default:
+		return nil, fmt.Errorf("expected a list of licenses, got %s", ast.ValueName(list.Value))
+	}
+
+	set := make(map[string]bool, len(terms))
+	for _, t := range terms {
+		var e string
+		if err := ast.As(t.Value, &e); err != nil {
+			return nil, fmt.Errorf("expected a list of licenses: %w", err)
+		}
+		set[strings.ToLower(e)] = true
+	}
+
+	return func(license, exception string) bool {
+		license = strings.ToLower(license)
+		if exception != "" && set[license+" with "+strings.ToLower(exception)] {
+			return true
+		}
+		return set[license] || set[spdx.Category(license, exception)]
+	}, nil
+}
+
+func spdxExpressionToMap(e *spdx.Expression) map[string]any {
+	if e.Op == "" {
+		out := map[string]any{"license": e.License}
+		if e.Exception != "" {
+			out["exception"] = e.Exception
+		}
+		return out
+	}
+
+	operands := make([]any, 0, len(e.Operands))
+	for _, o := range e.Operands {
+		operands = append(operands, spdxExpressionToMap(o))
+	}
+	return map[string]any{
+		"op":       e.Op,
+		"operands": operands,
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rego_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+func TestSPDXFunctions(t *testing.T) {
+	t.Parallel()
+
+	scenario := []struct {
+		name      string
+		condition string
+		wantFail  bool
+	}{
+		{
+			name:      "valid expression",
+			condition: `spdx.valid("MIT OR Apache-2.0")`,
+		},
+		{
+			name:      "invalid expression",
+			condition: `not spdx.valid("SEE LICENSE IN LICENSE")`,
+		},
+		{
+			name: "parse",
+			condition: `spdx.parse("MIT OR GPL-2.0-only WITH Classpath-exception-2.0") == {
+	"op": "OR",
+	"operands": [
+		{"license": "MIT"},
+		{"license": "GPL-2.0-only", "exception": "Classpath-exception-2.0"},
+	],
+}`,
+		},
+		{
+			name:      "licenses",
+			condition: `spdx.licenses("(MIT OR ISC) AND MIT") == ["ISC", "MIT"]`,
+		},
+		{
+			name:      "category",
+			condition: `spdx.category("AGPL-3.0-only") == "network-copyleft"`,
+		},
+		{
+			name:      "category with exception",
+			condition: `spdx.category("GPL-2.0-only WITH Classpath-exception-2.0") == "weak-copyleft"`,
+		},
+		{
+			name:      "category of a compound expression",
+			condition: `spdx.category("MIT OR ISC")`,
+			wantFail:  true,
+		},
+		{
+			name:      "allow list with choice",
+			condition: `spdx.satisfies("GPL-3.0-only OR MIT", ["MIT", "ISC"])`,
+		},
+		{
+			name:      "allow list by category",
+			condition: `spdx.satisfies("MPL-2.0 AND BSD-3-Clause", {"permissive", "weak-copyleft"})`,
+		},
+		{
+			name:      "allow list not satisfied",
+			condition: `spdx.satisfies("GPL-3.0-only AND MIT", ["permissive"])`,
+			wantFail:  true,
+		},
+		{
+			name:      "deny list with choice",
+			condition: `spdx.avoids("AGPL-3.0-only OR Apache-2.0", ["network-copyleft"])`,
+		},
+		{
+			name:      "deny list not avoidable",
+			condition: `spdx.avoids("AGPL-3.0-only AND Apache-2.0", ["agpl-3.0-only"])`,
+			wantFail:  true,
+		},
+		{
+			name:      "invalid expression is undefined",
+			condition: `spdx.satisfies("MIT OR", ["MIT"])`,
+			wantFail:  true,
+		},
+	}
+
+	for _, s := range scenario {
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := rego.NewRegoEvaluator(
+				&minderv1.RuleType_Definition_Eval_Rego{
+					Type: rego.DenyByDefaultEvaluationType.String(),
+					Def: `package minder
+
+import rego.v1
+
+default allow := false
+
+allow if {
+	` + s.condition + `
+}`,
+				},
+			)
+			require.NoError(t, err, "could not create evaluator")
+
+			_, err = e.Eval(context.Background(), map[string]any{}, nil, &interfaces.Ingested{})
+			if s.wantFail {
+				require.ErrorIs(t, err, interfaces.ErrEvaluationFailed)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -59,9 +59,18 @@ var MixedScriptsTemplate string
 //go:embed invisibleCharactersTemplate.tmpl
 var InvisibleCharactersTemplate string
 
+// TyposquattingTemplate is the template for details of the `homoglyphs`
+// evaluation engine of type `typosquatting`.
+//
+// This template expects a list of Violations named `violations`.
+//
+//go:embed typosquattingTemplate.tmpl
+var TyposquattingTemplate string
+
 // JqTemplate is the template for details of the `jq` evaluation engine.
 //
-// This template expects three parameters, `path`, `expected`, and `actual`, which are strings.
+// This template expects a list of failed `assertions`, each with a `name`,
+// a `path`, a `comparison` verb, and the `expected` and `actual` values.
 //
 //go:embed jq.tmpl
 var JqTemplate string
@@ -29,14 +29,16 @@ func newPrStatusHandler(
 	action pr_actions.Action,
 	pr *pbinternal.PullRequest,
 	client GitHubRESTAndPRClient,
+	opts ...reviewPrHandlerOption,
 ) (prStatusHandler, error) {
 	switch action {
 	case pr_actions.ActionReviewPr:
-		return newReviewPrHandler(ctx, pr, client)
+		return newReviewPrHandler(ctx, pr, client, opts...)
 	case pr_actions.ActionCommitStatus:
-		return newCommitStatusPrHandler(ctx, pr, client)
+		return newCommitStatusPrHandler(ctx, pr, client, opts...)
 	case pr_actions.ActionComment:
-		return newReviewPrHandler(ctx, pr, client, withVulnsFoundReviewStatus(github.String("COMMENT")))
+		opts = append(opts, withVulnsFoundReviewStatus(github.String("COMMENT")))
+		return newReviewPrHandler(ctx, pr, client, opts...)
 	case pr_actions.ActionProfileOnly:
 		return newProfileOnlyPrHandler(), nil
 	case pr_actions.ActionSummary:
@@ -68,10 +68,27 @@ type ecosystemConfig struct {
 	SumRepository     packageRepository `json:"sum_repository" mapstructure:"sum_repository" validate:"required"`
 }
 
+// allowlistEntry is a vulnerability which is accepted until it expires
+type allowlistEntry struct {
+	// ID is the identifier of the vulnerability or one of its aliases, e.g. its CVE
+	ID string `json:"id" mapstructure:"id" validate:"required"`
+	// Expires is the date, in the YYYY-MM-DD format, from which the vulnerability is reported again
+	Expires string `json:"expires" mapstructure:"expires" validate:"required,datetime=2006-01-02"`
+	// Reason documents why the vulnerability is accepted
+	Reason string `json:"reason" mapstructure:"reason"`
+}
+
 // config is the configuration for the vulncheck evaluator
 type config struct {
 	Action          pr_actions.Action `json:"action" mapstructure:"action" validate:"required"`
 	EcosystemConfig []ecosystemConfig `json:"ecosystem_config" mapstructure:"ecosystem_config" validate:"required"`
+	// MinSeverity is the lowest severity of the vulnerabilities which are reported
+	MinSeverity string `json:"min_severity" mapstructure:"min_severity" validate:"omitempty,oneof=low medium moderate high critical"`
+	// Allowlist holds the vulnerabilities which are not reported
+	Allowlist []allowlistEntry `json:"allowlist" mapstructure:"allowlist" validate:"dive"`
+	// AggregateComments reports all the vulnerable dependencies in the body of a
+	// single review rather than with a review comment on each of them
+	AggregateComments bool `json:"aggregate_comments" mapstructure:"aggregate_comments"`
 }
 
 func populateDefaultsIfEmpty(ruleCfg map[string]any) {
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package vulncheck provides the vulnerability check evaluator
+package vulncheck
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+const allowlistDateFormat = "2006-01-02"
+
+// severityRanks orders the severities reported by the vulnerability
+// databases. Unknown severities rank zero.
+var severityRanks = map[string]int{
+	"low":      1,
+	"medium":   2,
+	"moderate": 2,
+	"high":     3,
+	"critical": 4,
+}
+
+// filterVulnerabilities returns the vulnerabilities which are to be reported
+// at the given time. Vulnerabilities below the minimum severity are dropped,
+// as are those matching an allowlist entry which did not expire yet.
+// Vulnerabilities whose severity is unknown are always kept, since there is
+// no telling whether they are above the minimum severity.
+func (c *config) filterVulnerabilities(vulns []Vulnerability, now time.Time) []Vulnerability {
+	var kept []Vulnerability
+	for _, vuln := range vulns {
+		if c.belowMinSeverity(vuln) || c.allowlisted(vuln, now) {
+			continue
+		}
+		kept = append(kept, vuln)
+	}
+	return kept
+}
+
+func (c *config) belowMinSeverity(vuln Vulnerability) bool {
+	minRank := severityRanks[c.MinSeverity]
+	rank, known := severityRanks[strings.ToLower(vuln.Severity)]
+	return known && rank < minRank
+}
+
+func (c *config) allowlisted(vuln Vulnerability, now time.Time) bool {
+	for _, entry := range c.Allowlist {
+		if entry.ID != vuln.ID && !slices.Contains(vuln.Aliases, entry.ID) {
+			continue
+		}
+		expires, err := time.Parse(allowlistDateFormat, entry.Expires)
+		if err != nil {
+			// the configuration is validated, so this is not expected
+			continue
+		}
+		if now.Before(expires) {
+			return true
+		}
+	}
+	return false
+}
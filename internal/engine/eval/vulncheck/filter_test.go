@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vulncheck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterVulnerabilities(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	vulns := []Vulnerability{
+		{ID: "GHSA-low", Severity: "low"},
+		{ID: "GHSA-moderate", Severity: "moderate"},
+		{ID: "GHSA-high", Severity: "high", Aliases: []string{"CVE-2024-0001"}},
+		{ID: "GHSA-critical", Severity: "critical"},
+		{ID: "MAL-2024-1"},
+	}
+
+	tests := []struct {
+		name    string
+		cfg     map[string]any
+		wantIDs []string
+	}{
+		{
+			name:    "no filters",
+			cfg:     map[string]any{},
+			wantIDs: []string{"GHSA-low", "GHSA-moderate", "GHSA-high", "GHSA-critical", "MAL-2024-1"},
+		},
+		{
+			name:    "minimum severity keeps unknown severities",
+			cfg:     map[string]any{"min_severity": "high"},
+			wantIDs: []string{"GHSA-high", "GHSA-critical", "MAL-2024-1"},
+		},
+		{
+			name:    "medium is moderate",
+			cfg:     map[string]any{"min_severity": "medium"},
+			wantIDs: []string{"GHSA-moderate", "GHSA-high", "GHSA-critical", "MAL-2024-1"},
+		},
+		{
+			name: "allowlist matches ids and aliases",
+			cfg: map[string]any{
+				"allowlist": []any{
+					map[string]any{"id": "GHSA-low", "expires": "2024-07-01"},
+					map[string]any{"id": "CVE-2024-0001", "expires": "2024-07-01", "reason": "not reachable"},
+				},
+			},
+			wantIDs: []string{"GHSA-moderate", "GHSA-critical", "MAL-2024-1"},
+		},
+		{
+			name: "expired allowlist entries are ignored",
+			cfg: map[string]any{
+				"allowlist": []any{
+					map[string]any{"id": "GHSA-critical", "expires": "2024-06-01"},
+				},
+			},
+			wantIDs: []string{"GHSA-low", "GHSA-moderate", "GHSA-high", "GHSA-critical", "MAL-2024-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, err := parseConfig(tt.cfg)
+			require.NoError(t, err)
+
+			var gotIDs []string
+			for _, vuln := range cfg.filterVulnerabilities(vulns, now) {
+				gotIDs = append(gotIDs, vuln.ID)
+			}
+			require.Equal(t, tt.wantIDs, gotIDs)
+		})
+	}
+}
+
+func TestParseConfigRejectsInvalidFilters(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  map[string]any
+	}{
+		{
+			name: "unknown severity",
+			cfg:  map[string]any{"min_severity": "severe"},
+		},
+		{
+			name: "allowlist entry without expiry",
+			cfg: map[string]any{
+				"allowlist": []any{map[string]any{"id": "CVE-2024-0001"}},
+			},
+		},
+		{
+			name: "allowlist entry with malformed expiry",
+			cfg: map[string]any{
+				"allowlist": []any{map[string]any{"id": "CVE-2024-0001", "expires": "next year"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseConfig(tt.cfg)
+			require.Error(t, err)
+		})
+	}
+}
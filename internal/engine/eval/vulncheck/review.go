@@ -123,6 +123,10 @@ type reviewPrHandler struct {
 	text       *string
 	failStatus *string
 
+	// aggregate reports the vulnerable dependencies in the review body
+	// rather than with a review comment on each of them
+	aggregate bool
+
 	logger zerolog.Logger
 }
 
@@ -135,6 +139,14 @@ func withVulnsFoundReviewStatus(status *string) reviewPrHandlerOption {
 	}
 }
 
+// withAggregatedComments is an option to report all the vulnerable dependencies
+// in the body of a single review.
+func withAggregatedComments() reviewPrHandlerOption {
+	return func(r *reviewPrHandler) {
+		r.aggregate = true
+	}
+}
+
 func newReviewPrHandler(
 	ctx context.Context,
 	pr *pbinternal.PullRequest,
@@ -224,6 +236,20 @@ func (ra *reviewPrHandler) trackVulnerableDep(
 	vulnResp *VulnerabilityResponse,
 	patch patchLocatorFormatter,
 ) error {
+	tracked := dependencyVulnerabilities{
+		Dependency:      dep.Dep,
+		Vulnerabilities: vulnResp.Vulns,
+		PatchVersion:    patch.GetPatchedVersion(),
+	}
+
+	if ra.aggregate {
+		ra.logger.Debug().
+			Str("dep-name", dep.Dep.Name).
+			Msg("vulnerable dependency found")
+		ra.trackedDeps = append(ra.trackedDeps, tracked)
+		return nil
+	}
+
 	location, err := locateDepInPr(ctx, ra.cli, dep, patch)
 	if err != nil {
 		return fmt.Errorf("could not locate dependency in PR: %w", err)
@@ -268,11 +294,7 @@ func (ra *reviewPrHandler) trackVulnerableDep(
 		Str("dep-name", dep.Dep.Name).
 		Msg("vulnerable dependency found")
 
-	ra.trackedDeps = append(ra.trackedDeps, dependencyVulnerabilities{
-		Dependency:      dep.Dep,
-		Vulnerabilities: vulnResp.Vulns,
-		PatchVersion:    patch.GetPatchedVersion(),
-	})
+	ra.trackedDeps = append(ra.trackedDeps, tracked)
 
 	return nil
 }
@@ -303,13 +325,13 @@ func (ra *reviewPrHandler) submit(ctx context.Context) error {
 }
 
 func (ra *reviewPrHandler) setStatus() {
-	if len(ra.comments) > 0 {
-		// if this pass produced comments, request changes
+	if ra.foundVulnerabilities() {
+		// if this pass found vulnerable dependencies, request changes
 		ra.text = github.String(vulnsFoundText)
 		ra.status = ra.failStatus
 		ra.logger.Debug().Msg("vulnerabilities found")
 	} else {
-		// if this pass produced no comments, resolve the minder review
+		// if this pass found nothing, resolve the minder review
 		ra.status = github.String("COMMENT")
 		ra.text = github.String(noVulsFoundText)
 		ra.logger.Debug().Msg("no vulnerabilities found")
@@ -318,6 +340,15 @@ func (ra *reviewPrHandler) setStatus() {
 	ra.logger.Debug().Str("status", *ra.status).Msg("will set review status")
 }
 
+// foundVulnerabilities returns whether vulnerable dependencies were reported
+// by this pass, either as review comments or in the aggregated review body.
+func (ra *reviewPrHandler) foundVulnerabilities() bool {
+	if ra.aggregate {
+		return len(ra.trackedDeps) > 0
+	}
+	return len(ra.comments) > 0
+}
+
 func (ra *reviewPrHandler) findPreviousStatusComment(ctx context.Context) error {
 	comments, err := ra.cli.ListIssueComments(ctx, ra.pr.RepoOwner, ra.pr.RepoName, int(ra.pr.Number),
 		&github.IssueListCommentsOptions{
@@ -373,7 +404,7 @@ func (ra *reviewPrHandler) submitReview(ctx context.Context, mci magicCommentInf
 func (ra *reviewPrHandler) createReview(ctx context.Context) (int64, error) {
 	var err error
 
-	if len(ra.comments) == 0 {
+	if !ra.foundVulnerabilities() {
 		return 0, nil
 	}
 
@@ -383,6 +414,16 @@ func (ra *reviewPrHandler) createReview(ctx context.Context) (int64, error) {
 		Comments: ra.comments,
 	}
 
+	if ra.aggregate {
+		summary := &vulnSummaryReport{TrackedDependencies: ra.trackedDeps}
+		body, err := summary.render()
+		if err != nil {
+			return 0, fmt.Errorf("could not render review summary: %w", err)
+		}
+		review.Body = github.String(body)
+		review.Comments = nil
+	}
+
 	r, err := ra.cli.CreateReview(
 		ctx,
 		ra.pr.RepoOwner,
@@ -477,14 +518,11 @@ func newCommitStatusPrHandler(
 	ctx context.Context,
 	pr *pbinternal.PullRequest,
 	client GitHubRESTAndPRClient,
+	opts ...reviewPrHandlerOption,
 ) (prStatusHandler, error) {
 	// create a reviewPrHandler and embed it in the commitStatusPrHandler
-	rph, err := newReviewPrHandler(
-		ctx,
-		pr,
-		client,
-		withVulnsFoundReviewStatus(github.String("COMMENT")),
-	)
+	opts = append(opts, withVulnsFoundReviewStatus(github.String("COMMENT")))
+	rph, err := newReviewPrHandler(ctx, pr, client, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("could not create review handler: %w", err)
 	}
@@ -517,7 +555,7 @@ func (csh *commitStatusPrHandler) setCommitStatus(
 		Context: github.String(commitStatusContext),
 	}
 
-	if len(csh.comments) > 0 {
+	if csh.foundVulnerabilities() {
 		commitStatus.State = github.String("failure")
 		commitStatus.Description = github.String(vulnsFoundTextShort)
 	} else {
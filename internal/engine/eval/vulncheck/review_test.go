@@ -741,6 +741,72 @@ func TestReviewPrHandlerVulnerabilitiesAndNoPriorReview(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestReviewPrHandlerAggregatedComments(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock_ghclient.NewMockGitHub(ctrl)
+	pr := &pbinternal.PullRequest{
+		Url:       "https://api.github.com/repos/jakubtestorg/bad-npm/pulls/43",
+		CommitSha: commitSHA,
+		Number:    43,
+		RepoOwner: "jakubtestorg",
+		RepoName:  "bad-npm",
+		AuthorId:  githubSubmitterID,
+	}
+
+	mockClient.EXPECT().GetUserId(gomock.Any()).Return(int64(githubMinderID), nil)
+	handler, err := newReviewPrHandler(context.TODO(), pr, mockClient, withAggregatedComments())
+	require.NoError(t, err)
+	require.NotNil(t, handler)
+
+	dep := &pbinternal.PrDependencies_ContextualDependency{
+		Dep: &pbinternal.Dependency{
+			Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			Name:      "mongodb",
+			Version:   "0.5.0",
+		},
+		File: &pbinternal.PrDependencies_ContextualDependency_FilePatch{
+			Name:     "package-lock.json",
+			PatchUrl: "https://example.com/patch",
+		},
+	}
+	vulnResp := VulnerabilityResponse{
+		[]Vulnerability{
+			{ID: "mongodb", Fixed: "0.6.0"},
+		},
+	}
+
+	// the dependency is not located in the PR, so no request is expected
+	err = handler.trackVulnerableDep(context.TODO(), dep, &vulnResp, &packageJson{Name: "mongodb", Version: "0.6.0"})
+	require.NoError(t, err)
+	require.Empty(t, handler.comments)
+
+	summary := &vulnSummaryReport{TrackedDependencies: handler.trackedDeps}
+	expSummary, err := summary.render()
+	require.NoError(t, err)
+
+	mockClient.EXPECT().
+		ListIssueComments(gomock.Any(), pr.RepoOwner, pr.RepoName, int(pr.Number), gomock.Any()).
+		Return([]*github.IssueComment{}, nil)
+
+	mockClient.EXPECT().
+		CreateReview(gomock.Any(), pr.RepoOwner, pr.RepoName, int(pr.Number), &github.PullRequestReviewRequest{
+			CommitID: github.String(commitSHA),
+			Event:    github.String("REQUEST_CHANGES"),
+			Body:     github.String(expSummary),
+		}).Return(&github.PullRequestReview{ID: github.Int64(minderReviewID)}, nil)
+
+	mockClient.EXPECT().
+		CreateIssueComment(gomock.Any(), pr.RepoOwner, pr.RepoName, int(pr.Number), gomock.Any()).
+		Return(&github.IssueComment{ID: github.Int64(123)}, nil)
+
+	err = handler.submit(context.Background())
+	require.NoError(t, err)
+}
+
 func TestReviewPrHandlerReviewAlreadyExistsOnSHA(t *testing.T) {
 	t.Parallel()
 
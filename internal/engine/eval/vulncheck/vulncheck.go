@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/rs/zerolog"
@@ -109,7 +110,12 @@ func (e *Evaluator) getVulnerableDependencies(
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
-	prReplyHandler, err := newPrStatusHandler(ctx, ruleConfig.Action, prdeps.Pr, e.cli)
+	var handlerOpts []reviewPrHandlerOption
+	if ruleConfig.AggregateComments {
+		handlerOpts = append(handlerOpts, withAggregatedComments())
+	}
+
+	prReplyHandler, err := newPrStatusHandler(ctx, ruleConfig.Action, prdeps.Pr, e.cli, handlerOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create pr action: %w", err)
 	}
@@ -221,9 +227,17 @@ func (e *Evaluator) checkVulnerabilities(
 		return false, fmt.Errorf("failed to query vulncheck db: %w", err)
 	}
 
-	if len(response.Vulns) == 0 {
+	vulns := cfg.filterVulnerabilities(response.Vulns, time.Now())
+	if len(vulns) < len(response.Vulns) {
+		zerolog.Ctx(ctx).Debug().
+			Str("dependency", dep.Dep.Name).
+			Int("ignored", len(response.Vulns)-len(vulns)).
+			Msg("ignoring vulnerabilities below the minimum severity or in the allowlist")
+	}
+	if len(vulns) == 0 {
 		return false, nil
 	}
+	response = &VulnerabilityResponse{Vulns: vulns}
 
 	pkgRepo, err := cache.newRepository(ecoConfig)
 	if err != nil {
@@ -27,6 +27,10 @@ type Vulnerability struct {
 	Introduced string `json:"introduced,omitempty"`
 	Fixed      string `json:"fixed,omitempty"`
 	Type       string `json:"type"`
+	// Aliases are the other identifiers of the vulnerability, e.g. its CVE
+	Aliases []string `json:"aliases,omitempty"`
+	// Severity is the severity reported by the vulnerability database, if any
+	Severity string `json:"severity,omitempty"`
 }
 
 // VulnerabilityResponse is a response from the vulnerability database
@@ -90,9 +94,11 @@ func toVulnerabilityResponse(osvResp *OSVResponse, dep *pbinternal.Dependency) *
 
 	for _, osvVuln := range osvResp.Vulns {
 		vuln := Vulnerability{
-			ID:      osvVuln.ID,
-			Summary: osvVuln.Summary,
-			Details: osvVuln.Details,
+			ID:       osvVuln.ID,
+			Summary:  osvVuln.Summary,
+			Details:  osvVuln.Details,
+			Aliases:  osvVuln.Aliases,
+			Severity: strings.ToLower(osvVuln.DatabaseSpecific.Severity),
 		}
 
 	affectedLoop:
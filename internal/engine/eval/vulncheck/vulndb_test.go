@@ -233,6 +233,7 @@ func TestGoVulnDb(t *testing.T) {
 						Introduced: "1.13.0",
 						Fixed:      "1.13.7",
 						Type:       "SEMVER",
+						Aliases:    []string{"CVE-2023-39347"},
 					},
 				},
 			},
@@ -257,6 +258,7 @@ func TestGoVulnDb(t *testing.T) {
 						Introduced: "commitHash1",
 						Fixed:      "commitHash2",
 						Type:       "GIT",
+						Aliases:    []string{"CVE-2023-39347"},
 					},
 				},
 			},
@@ -281,6 +283,7 @@ func TestGoVulnDb(t *testing.T) {
 						Introduced: "0",
 						Fixed:      "",
 						Type:       "SEMVER",
+						Aliases:    []string{"CVE-2023-39347"},
 					},
 				},
 			},
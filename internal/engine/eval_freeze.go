@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/engine/freeze"
+)
+
+// deferActions records the entity for re-evaluation once the freeze has
+// ended if some of its actions were deferred by the freeze
+func (e *executor) deferActions(
+	ctx context.Context,
+	inf *entities.EntityInfoWrapper,
+	state *freeze.State,
+) {
+	if !state.Deferred() {
+		return
+	}
+
+	logger := zerolog.Ctx(ctx)
+	entityID, err := inf.GetID()
+	if err != nil {
+		logger.Error().Err(err).Msg("error getting entity id")
+		return
+	}
+
+	if err := e.querier.DeferActions(ctx, db.DeferActionsParams{
+		EntityInstanceID: entityID,
+		ProjectID:        inf.ProjectID,
+	}); err != nil {
+		logger.Error().Err(err).Msg("error deferring actions")
+		return
+	}
+
+	logger.Info().Str("reason", state.Reason).Msg("deferred actions until the freeze ends")
+}
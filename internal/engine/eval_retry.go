@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/engine/retry"
+)
+
+// evaluationRetryState returns the retry state of the evaluation of the
+// entity, i.e. whether it is a retry of a previous evaluation which ended in
+// a transient error.
+func (e *executor) evaluationRetryState(ctx context.Context, inf *entities.EntityInfoWrapper) *retry.State {
+	state := &retry.State{}
+	if !e.retryPolicy.Enabled() {
+		return state
+	}
+
+	entityID, err := inf.GetID()
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error getting entity id")
+		return state
+	}
+
+	pending, err := e.querier.GetEvaluationRetry(ctx, entityID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return state
+	} else if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error getting evaluation retry")
+		return state
+	}
+
+	state.Attempt = pending.Attempt
+	return state
+}
+
+// scheduleEvaluationRetry schedules the re-evaluation of the entity with
+// exponential backoff if one of its rule evaluations ended in a transient
+// error, and clears the pending retry of the entity otherwise.
+func (e *executor) scheduleEvaluationRetry(
+	ctx context.Context,
+	inf *entities.EntityInfoWrapper,
+	state *retry.State,
+) {
+	if !e.retryPolicy.Enabled() || (state.Attempt == 0 && state.Err() == nil) {
+		return
+	}
+
+	logger := zerolog.Ctx(ctx)
+	entityID, err := inf.GetID()
+	if err != nil {
+		logger.Error().Err(err).Msg("error getting entity id")
+		return
+	}
+
+	if state.Err() == nil || state.Attempt >= e.retryPolicy.MaxAttempts {
+		if state.Err() != nil {
+			logger.Warn().Int32("attempt", state.Attempt).Err(state.Err()).
+				Msg("giving up retrying transient evaluation error")
+		}
+		if err := e.querier.DeleteEvaluationRetry(ctx, entityID); err != nil {
+			logger.Error().Err(err).Msg("error deleting evaluation retry")
+		}
+		return
+	}
+
+	attempt := state.Attempt + 1
+	retryAt := e.retryPolicy.NextRetryAt(time.Now(), attempt, state.Err())
+	_, err = e.querier.UpsertEvaluationRetry(ctx, db.UpsertEvaluationRetryParams{
+		EntityInstanceID: entityID,
+		ProjectID:        inf.ProjectID,
+		Attempt:          attempt,
+		ErrorCode:        state.ErrorCode(),
+		NextRetryAt:      retryAt,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("error scheduling evaluation retry")
+		return
+	}
+
+	logger.Info().Int32("attempt", attempt).Time("retry_at", retryAt).
+		Str("error_code", string(state.ErrorCode())).Msg("scheduled evaluation retry")
+}
@@ -110,6 +110,12 @@ func (e *executor) createOrUpdateEvalStatus(
 	e.metrics.CountAlertStatus(ctx, alertStatus)
 
 	chckpoint := params.GetIngestResult().GetCheckpoint()
+	if chckpoint != nil && params.RuleDigest != "" {
+		// the ingested data may be shared by several rules, so the digest
+		// is recorded on a copy of its checkpoint
+		cp := *chckpoint
+		chckpoint = cp.WithRuleDigest(params.RuleDigest)
+	}
 	chkpjs, err := chckpoint.ToJSONorDefault(json.RawMessage(`{}`))
 	if err != nil {
 		logger.Err(err).Msg("error marshalling checkpoint")
@@ -132,6 +138,7 @@ func (e *executor) createOrUpdateEvalStatus(
 			params.GetEvalErr(),
 			chkpjs,
 			evalOutput,
+			params.RetryAttempt,
 		)
 		if err != nil {
 			return err
@@ -5,6 +5,8 @@ package engine
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,25 +14,42 @@ import (
 	"github.com/rs/zerolog"
 
 	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/anomaly"
 	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/deploykeys"
 	"github.com/mindersec/minder/internal/engine/actions"
 	"github.com/mindersec/minder/internal/engine/actions/alert"
+	"github.com/mindersec/minder/internal/engine/actions/alert/routing"
+	"github.com/mindersec/minder/internal/engine/actions/alert/security_advisory"
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
 	"github.com/mindersec/minder/internal/engine/actions/remediate"
+	"github.com/mindersec/minder/internal/engine/alertgroup"
 	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/engine/freeze"
 	"github.com/mindersec/minder/internal/engine/ingestcache"
 	engif "github.com/mindersec/minder/internal/engine/interfaces"
 	eoptions "github.com/mindersec/minder/internal/engine/options"
+	"github.com/mindersec/minder/internal/engine/retry"
 	"github.com/mindersec/minder/internal/engine/rtengine"
 	"github.com/mindersec/minder/internal/entities/properties/service"
+	"github.com/mindersec/minder/internal/evalexport"
+	"github.com/mindersec/minder/internal/faults"
 	"github.com/mindersec/minder/internal/history"
 	minderlogger "github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/pipeline"
+	"github.com/mindersec/minder/internal/projects"
 	"github.com/mindersec/minder/internal/providers/manager"
 	provsel "github.com/mindersec/minder/internal/providers/selectors"
+	providerusage "github.com/mindersec/minder/internal/providers/usage"
+	"github.com/mindersec/minder/internal/secrets"
+	"github.com/mindersec/minder/internal/usage"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/selectors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
 	"github.com/mindersec/minder/pkg/flags"
 	"github.com/mindersec/minder/pkg/profiles"
 	"github.com/mindersec/minder/pkg/profiles/models"
@@ -53,6 +72,135 @@ type executor struct {
 	profileStore    profiles.ProfileStore
 	selBuilder      selectors.SelectionBuilder
 	propService     service.PropertiesService
+	ownerNotifier   *routing.OwnerNotifier
+	alertGrouper    *alertgroup.Grouper
+	secretResolver  secrets.ParamResolver
+	deployKeys      deploykeys.SignerResolver
+	trustRoots      sigstore.TrustRootResolver
+	alertTemplates  templates.Resolver
+	usageTracker    *usage.Tracker
+	providerUsage   *providerusage.Recorder
+	actionFaults    *faults.Injector
+	ruleLimits      interfaces.Limits
+	retryPolicy     retry.Policy
+	freezer         *freeze.Freezer
+	pipelineMonitor *pipeline.Monitor
+	anomalies       *anomaly.Detector
+	evalExporter    *evalexport.Exporter
+}
+
+// ExecutorOption is a functional option for the executor
+type ExecutorOption func(*executor)
+
+// WithOwnerNotifier routes the alerts of the evaluations to the owners of the entities.
+// A nil notifier disables owner notifications.
+func WithOwnerNotifier(n *routing.OwnerNotifier) ExecutorOption {
+	return func(e *executor) {
+		e.ownerNotifier = n
+	}
+}
+
+// WithSecretResolver resolves the project secrets referenced by the rule parameters.
+// A nil resolver leaves the parameters as is.
+func WithSecretResolver(r secrets.ParamResolver) ExecutorOption {
+	return func(e *executor) {
+		e.secretResolver = r
+	}
+}
+
+// WithDeployKeys resolves the deploy keys of the projects, with which the ingesters
+// clone the repositories over SSH. A nil resolver disables deploy keys.
+func WithDeployKeys(r deploykeys.SignerResolver) ExecutorOption {
+	return func(e *executor) {
+		e.deployKeys = r
+	}
+}
+
+// WithTrustRoots resolves the sigstore trust roots of the projects used to verify
+// the artifacts. A nil resolver uses the default trust roots.
+func WithTrustRoots(r sigstore.TrustRootResolver) ExecutorOption {
+	return func(e *executor) {
+		e.trustRoots = r
+	}
+}
+
+// WithAlertTemplates resolves the alert templates customized by the projects. A nil
+// resolver uses the default templates.
+func WithAlertTemplates(r templates.Resolver) ExecutorOption {
+	return func(e *executor) {
+		e.alertTemplates = r
+	}
+}
+
+// WithUsageTracker records the evaluation time of the projects and throttles the
+// projects over their budget. A nil tracker disables throttling.
+func WithUsageTracker(t *usage.Tracker) ExecutorOption {
+	return func(e *executor) {
+		e.usageTracker = t
+	}
+}
+
+// WithProviderUsage records the evaluations of each provider. A nil recorder
+// records nothing.
+func WithProviderUsage(r *providerusage.Recorder) ExecutorOption {
+	return func(e *executor) {
+		e.providerUsage = r
+	}
+}
+
+// WithActionFaults injects faults before executing the actions. A nil injector
+// disables fault injection.
+func WithActionFaults(inj *faults.Injector) ExecutorOption {
+	return func(e *executor) {
+		e.actionFaults = inj
+	}
+}
+
+// WithRuleLimits bounds the resources used by the evaluation of each rule.
+func WithRuleLimits(l interfaces.Limits) ExecutorOption {
+	return func(e *executor) {
+		e.ruleLimits = l
+	}
+}
+
+// WithRetryPolicy retries the evaluations failing with transient errors. The zero
+// policy disables retries.
+func WithRetryPolicy(p retry.Policy) ExecutorOption {
+	return func(e *executor) {
+		e.retryPolicy = p
+	}
+}
+
+// WithFreezer defers the actions of the evaluations while the projects are
+// frozen. A nil freezer never freezes.
+func WithFreezer(f *freeze.Freezer) ExecutorOption {
+	return func(e *executor) {
+		e.freezer = f
+	}
+}
+
+// WithPipelineMonitor tracks the stages of each evaluation. A nil monitor tracks
+// nothing.
+func WithPipelineMonitor(m *pipeline.Monitor) ExecutorOption {
+	return func(e *executor) {
+		e.pipelineMonitor = m
+	}
+}
+
+// WithAnomalyDetector records the duration of the evaluations of each rule type to
+// detect anomalies. A nil detector records nothing.
+func WithAnomalyDetector(d *anomaly.Detector) ExecutorOption {
+	return func(e *executor) {
+		e.anomalies = d
+	}
+}
+
+// WithEvalExporter exports the evaluation results. A nil exporter exports
+// nothing.
+func WithEvalExporter(x *evalexport.Exporter) ExecutorOption {
+	return func(e *executor) {
+		e.evalExporter = x
+	}
 }
 
 // NewExecutor creates a new executor
@@ -65,8 +213,9 @@ func NewExecutor(
 	profileStore profiles.ProfileStore,
 	selBuilder selectors.SelectionBuilder,
 	propService service.PropertiesService,
+	opts ...ExecutorOption,
 ) Executor {
-	return &executor{
+	e := &executor{
 		querier:         querier,
 		providerManager: providerManager,
 		metrics:         metrics,
@@ -76,6 +225,11 @@ func NewExecutor(
 		selBuilder:      selBuilder,
 		propService:     propService,
 	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	e.alertGrouper = alertgroup.NewGrouper(querier, e.ownerNotifier)
+	return e
 }
 
 // EvalEntityEvent evaluates the entity specified in the EntityInfoWrapper
@@ -95,6 +249,26 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 	entityStartTime := time.Now()
 	defer e.metrics.TimeProfileEvaluation(ctx, entityStartTime)
 
+	if e.usageTracker != nil {
+		if e.usageTracker.ShouldThrottle(ctx, inf.ProjectID) {
+			logger.Warn().Msg("entity evaluation - throttled, project is over its share of the instance")
+			e.deferThrottledEvaluation(ctx, inf)
+			e.releaseLockAndFlush(ctx, inf)
+			return nil
+		}
+		defer func() {
+			e.usageTracker.RecordEvaluation(ctx, inf.ProjectID, inf.ProviderID, time.Since(entityStartTime))
+		}()
+	}
+
+	// account the evaluation and the API calls it makes to the provider
+	e.providerUsage.RecordEvaluation(inf.ProviderID)
+	ctx = providerusage.WithProvider(ctx, inf.ProviderID)
+
+	// report the progress of the evaluation to the pipeline monitor, if enabled
+	tracker := e.pipelineMonitor.StartEvaluation(*inf.ExecutionID, inf.ProjectID, inf.Type.ToString(), inf.EntityID)
+	defer tracker.Done()
+
 	provider, err := e.providerManager.InstantiateFromID(ctx, inf.ProviderID)
 	if err != nil {
 		return fmt.Errorf("could not instantiate provider: %w", err)
@@ -115,6 +289,10 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 
 	defer e.releaseLockAndFlush(ctx, inf)
 
+	// the secrets referenced by the rules are fetched and decrypted once
+	// for all the rules evaluated for the entity
+	ctx = secrets.WithBatchCache(ctx)
+
 	dssvc := datasourceservice.NewDataSourceService(e.querier)
 
 	entityType := entities.EntityTypeToDB(inf.Type)
@@ -128,6 +306,7 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 		e.featureFlags,
 		ingestCache,
 		dssvc,
+		e.ruleLimits,
 		eoptions.WithFlagsClient(e.featureFlags),
 	)
 	if err != nil {
@@ -141,23 +320,105 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 		return fmt.Errorf("error while retrieving profiles and rule instances: %w", err)
 	}
 
+	// The actions policies of the project hierarchy are merged with the
+	// action settings of each profile
+	actionsPolicies, err := projects.GetActionsPolicies(ctx, e.querier, inf.ProjectID)
+	if err != nil {
+		return fmt.Errorf("error while retrieving project actions policies: %w", err)
+	}
+
+	retryState := e.evaluationRetryState(ctx, inf)
+	freezeState := e.freezer.State(ctx)
+	if freezeState.Frozen {
+		logger.Info().Str("reason", freezeState.Reason).Msg("actions are frozen and will be deferred")
+	}
+
+	// The alerts of the profiles grouping them are consolidated in a single
+	// security advisory, for the providers supporting them
+	var advisor alertgroup.Advisor
+	if cli, err := provinfv1.As[provinfv1.GitHub](provider); err == nil {
+		advisor = security_advisory.NewGroupAdvisor(cli)
+	}
+
 	// For each profile, get the profileEvalStatus first. Then, if the profileEvalStatus is nil
 	// evaluate each rule and store the outcome in the database. If profileEvalStatus is non-nil,
 	// just store it for all rules without evaluation.
 	for _, profile := range profileAggregates {
+		profile.ActionConfig = actionsPolicies.Apply(profile.ActionConfig)
 
 		profileEvalStatus := e.profileEvalStatus(ctx, inf, profile)
 
+		var groupOutcomes *[]alertgroup.Outcome
+		if profile.AlertGroupingWindow > 0 {
+			groupOutcomes = &[]alertgroup.Outcome{}
+		}
+
 		for _, rule := range profile.Rules {
-			if err := e.evaluateRule(ctx, inf, provider, &profile, &rule, ruleEngineCache, profileEvalStatus); err != nil {
+			if err := e.evaluateRule(
+				ctx, inf, provider, &profile, &rule, ruleEngineCache, profileEvalStatus, retryState, freezeState, tracker,
+				groupOutcomes,
+			); err != nil {
 				return fmt.Errorf("error evaluating entity event: %w", err)
 			}
 		}
+
+		if groupOutcomes != nil && !freezeState.Frozen {
+			e.flushAlertGroup(ctx, inf, &profile, *groupOutcomes, advisor)
+		}
 	}
 
+	e.scheduleEvaluationRetry(ctx, inf, retryState)
+	e.deferActions(ctx, inf, freezeState)
+
 	return nil
 }
 
+// flushAlertGroup records the outcomes of the rules of a profile grouping its
+// alerts once they have all been evaluated for the entity, and sends the
+// grouped alerts if the grouping window elapsed. While actions are frozen,
+// nothing is recorded: the entity is evaluated again after the freeze.
+func (e *executor) flushAlertGroup(
+	ctx context.Context,
+	inf *entities.EntityInfoWrapper,
+	profile *models.ProfileAggregate,
+	outcomes []alertgroup.Outcome,
+	advisor alertgroup.Advisor,
+) {
+	entityID, err := inf.GetID()
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error getting entity id")
+		return
+	}
+
+	if err := e.alertGrouper.Flush(ctx, inf.Entity, entityID, inf.ProjectID, profile, outcomes, advisor); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("profile", profile.Name).
+			Msg("error sending grouped alerts")
+	}
+}
+
+// groupOutcome returns the outcome of a rule for the alert grouping of its
+// profile. Rules without alerts, and rules which were neither evaluated as
+// passing nor as failing, are left out and keep their previous outcome.
+func groupOutcome(ruleType *pb.RuleType, ruleName string, evalErr error) (alertgroup.Outcome, bool) {
+	alertDef := ruleType.GetDef().GetAlert()
+	if alertDef == nil {
+		return alertgroup.Outcome{}, false
+	}
+
+	outcome := alertgroup.Outcome{Rule: ruleName}
+	switch {
+	case evalErr == nil:
+	case errors.Is(evalErr, interfaces.ErrEvaluationFailed):
+		outcome.Failed = true
+		if alertDef.GetType() == security_advisory.AlertType {
+			outcome.AdvisorySeverity = security_advisory.Severity(ruleType)
+		}
+	default:
+		return alertgroup.Outcome{}, false
+	}
+	return outcome, true
+}
+
 func (e *executor) evaluateRule(
 	ctx context.Context,
 	inf *entities.EntityInfoWrapper,
@@ -166,22 +427,44 @@ func (e *executor) evaluateRule(
 	rule *models.RuleInstance,
 	ruleEngineCache rtengine.Cache,
 	profileEvalStatus error,
+	retryState *retry.State,
+	freezeState *freeze.State,
+	tracker *pipeline.EvaluationTracker,
+	groupOutcomes *[]alertgroup.Outcome,
 ) error {
-	// Create eval status params
-	evalParams, err := e.createEvalStatusParams(ctx, inf, profile, rule)
-	if err != nil {
-		return fmt.Errorf("error creating eval status params: %w", err)
-	}
-
 	// retrieve the rule type engine from the cache
 	ruleEngine, err := ruleEngineCache.GetRuleEngine(ctx, rule.RuleTypeID)
 	if err != nil {
 		return fmt.Errorf("error creating rule type engine: %w", err)
 	}
 
+	// Rule types can restrict the upstream events they are evaluated on.
+	// The last evaluation of the rule stays current otherwise.
+	if !ruleEngine.GetRuleType().IsTriggeredBy(inf.TriggerEvent) {
+		zerolog.Ctx(ctx).Debug().
+			Str("rule_type", ruleEngine.GetRuleType().Name).
+			Str("trigger_event", inf.TriggerEvent).
+			Msg("rule not triggered by the event, skipping evaluation")
+		return nil
+	}
+
+	// Create eval status params
+	evalParams, err := e.createEvalStatusParams(ctx, inf, profile, rule)
+	if err != nil {
+		return fmt.Errorf("error creating eval status params: %w", err)
+	}
+	evalParams.RetryAttempt = retryState.Attempt
+
 	// create the action engine for this rule instance
 	// unlike the rule type engine, this cannot be cached
-	actionEngine, err := actions.NewRuleActions(ctx, ruleEngine.GetRuleType(), provider, &profile.ActionConfig)
+	actionConfig := profile.ActionConfig
+	if rule.IsCanary() {
+		// canary rules are being validated, so they never remediate
+		actionConfig.Remediate = models.ActionOptOff
+	}
+	actionEngine, err := actions.NewRuleActions(ctx, ruleEngine.GetRuleType(), provider, &actionConfig,
+		actions.WithOwnerNotifier(e.ownerNotifier), actions.WithFaultInjector(e.actionFaults),
+		actions.WithFreeze(freezeState.Frozen))
 	if err != nil {
 		return fmt.Errorf("cannot create rule actions engine: %w", err)
 	}
@@ -190,32 +473,155 @@ func (e *executor) evaluateRule(
 	defer e.updateLockLease(ctx, *inf.ExecutionID, evalParams)
 
 	// Evaluate the rule
+	tracker.Stage(pipeline.StageEval)
 	var evalErr error
 	var result *interfaces.EvaluationResult
+	var previous *checkpoints.CheckpointEnvelopeV1
+	var resolved *secrets.ResolvedParams
 	if profileEvalStatus != nil {
 		evalErr = profileEvalStatus
+	} else if rule.Disabled {
+		evalErr = evalerrors.NewErrEvaluationSkipped("rule is disabled: %s", rule.DisabledReason)
+	} else if !rule.Samples(evalParams.EntityID) {
+		evalErr = evalerrors.NewErrEvaluationSkipped("entity not sampled by canary rule (%d%%)", rule.CanaryPercentage)
+	} else if err := e.resolveRuleOverrides(ctx, evalParams); err != nil {
+		evalErr = err
+	} else if previous, err = e.previousCheckpoint(ctx, ruleEngine.GetRuleType(), evalParams); err != nil {
+		evalErr = err
+	} else if resolved, err = e.resolveSecretParams(ctx, inf.ProjectID, evalParams); err != nil {
+		evalErr = err
 	} else {
 		// enrich the logger with the entity type and execution ID
 		ctx := zerolog.Ctx(ctx).With().
 			Str("entity_type", inf.Type.ToString()).
 			Str("execution_id", inf.ExecutionID.String()).
-			Logger().WithContext(ctx)
-		result, evalErr = ruleEngine.Eval(ctx, inf.Entity, evalParams.GetRule().Def, evalParams.GetRule().Params, evalParams)
+			Logger().WithContext(checkpoints.WithPrevious(ctx, previous))
+		if e.deployKeys != nil {
+			ctx = deploykeys.WithProjectSigners(ctx, e.deployKeys, inf.ProjectID)
+		}
+		if e.trustRoots != nil {
+			ctx = sigstore.WithProjectTrustRoot(ctx, e.trustRoots, inf.ProjectID)
+		}
+		evalStart := time.Now()
+		result, evalErr = ruleEngine.Eval(ctx, inf.Entity, evalParams.GetRule().Def, evalParams.GetRuleParams(), evalParams)
+		e.anomalies.RecordEvaluation(ruleEngine.GetRuleType().Name, time.Since(evalStart))
 		evalParams.SetEvalResult(result)
+		evalErr = resolved.RedactError(evalErr)
+		evalErr = e.applyRuleException(ctx, evalParams, evalErr)
+		evalErr = applyRuleSuppression(evalParams, evalErr)
 	}
 	evalParams.SetEvalErr(evalErr)
+	retryState.Record(evalErr)
+	if groupOutcomes != nil {
+		if outcome, ok := groupOutcome(ruleEngine.GetRuleType(), rule.Name, evalErr); ok {
+			*groupOutcomes = append(*groupOutcomes, outcome)
+		}
+	}
 
 	// Perform actionEngine, if any
-	actionsErr := actionEngine.DoActions(ctx, inf.Entity, evalParams)
+	tracker.Stage(pipeline.StageActions)
+	actionsCtx := ctx
+	if e.alertTemplates != nil {
+		actionsCtx = templates.WithProjectTemplates(ctx, e.alertTemplates, inf.ProjectID)
+	}
+	actionsErr := actionEngine.DoActions(actionsCtx, inf.Entity, evalParams)
+	actionsErr.RemediateErr = resolved.RedactError(actionsErr.RemediateErr)
+	actionsErr.AlertErr = resolved.RedactError(actionsErr.AlertErr)
 	evalParams.SetActionsErr(ctx, actionsErr)
+	freezeState.Record(actionsErr)
 
 	// Log the evaluation
 	logEval(ctx, inf, evalParams, ruleEngine.GetRuleType().Name)
+	e.evalExporter.RecordEvaluation(ctx, &evalexport.Evaluation{
+		Params:       evalParams,
+		ProviderID:   inf.ProviderID,
+		RuleTypeName: ruleEngine.GetRuleType().Name,
+	})
 
 	// Create or update the evaluation status
+	tracker.Stage(pipeline.StageStatus)
 	return e.createOrUpdateEvalStatus(ctx, evalParams)
 }
 
+// applyRuleException turns a failed evaluation into an excepted one when an
+// approved and unexpired exception covers the rule for the evaluated entity.
+func (e *executor) applyRuleException(
+	ctx context.Context,
+	params *engif.EvalStatusParams,
+	evalErr error,
+) error {
+	if !errors.Is(evalErr, interfaces.ErrEvaluationFailed) {
+		return evalErr
+	}
+
+	exception, err := e.querier.GetActiveRuleException(ctx, db.GetActiveRuleExceptionParams{
+		RuleID:           params.Rule.ID,
+		EntityInstanceID: params.EntityID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return evalErr
+	} else if err != nil {
+		// keep reporting the failure rather than hiding it
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error getting rule exception")
+		return evalErr
+	}
+
+	return evalerrors.NewErrEvaluationExcepted("failure excepted until %s by exception %s: %s",
+		exception.ExpiresAt.Format(time.RFC3339), exception.ID, evalErr)
+}
+
+// applyRuleSuppression turns a failed evaluation into a suppressed one when
+// the overrides of the rule suppress its failures for the evaluated entity.
+// Exceptions take precedence, so that approved failures are reported as such.
+func applyRuleSuppression(params *engif.EvalStatusParams, evalErr error) error {
+	if !params.Rule.Suppressed || !errors.Is(evalErr, interfaces.ErrEvaluationFailed) {
+		return evalErr
+	}
+	return evalerrors.NewErrEvaluationSuppressed("failure suppressed by rule override: %s", evalErr)
+}
+
+// resolveRuleOverrides applies the overrides of the rule for the evaluated
+// entity. The resolved rule only lives in the evaluation parameters.
+func (e *executor) resolveRuleOverrides(
+	ctx context.Context,
+	params *engif.EvalStatusParams,
+) error {
+	if len(params.Rule.Overrides) == 0 {
+		return nil
+	}
+
+	ent, err := e.querier.GetEntityByID(ctx, params.EntityID)
+	if err != nil {
+		return fmt.Errorf("unable to get entity to resolve rule overrides: %w", err)
+	}
+
+	params.Rule = params.Rule.ForEntity(ent.Name)
+	return nil
+}
+
+// resolveSecretParams resolves the secret references in the rule parameters.
+// The resolved parameters are only handed to the rule evaluation and actions,
+// while the rule keeps its references, so that the values are never
+// persisted, exported or returned by the API.
+func (e *executor) resolveSecretParams(
+	ctx context.Context,
+	projectID uuid.UUID,
+	params *engif.EvalStatusParams,
+) (*secrets.ResolvedParams, error) {
+	if e.secretResolver == nil || len(params.Rule.Params) == 0 {
+		return nil, nil
+	}
+
+	resolved, err := e.secretResolver.ResolveParams(ctx, projectID, params.Rule.Params)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to resolve secrets in rule parameters: %w",
+			evalerrors.ErrInvalidRuleParameters, err)
+	}
+
+	params.SetRuleParams(resolved.Params)
+	return resolved, nil
+}
+
 func (e *executor) profileEvalStatus(
 	ctx context.Context,
 	eiw *entities.EntityInfoWrapper,
@@ -282,6 +688,28 @@ func (e *executor) updateLockLease(
 	logger.Info().Msg("lock lease updated")
 }
 
+// deferThrottledEvaluation records the entity to be evaluated again by the
+// requeuer once the usage window ends, so the event is not lost. A failure
+// is only logged, as the entity is still evaluated on its next event.
+func (e *executor) deferThrottledEvaluation(ctx context.Context, inf *entities.EntityInfoWrapper) {
+	logger := zerolog.Ctx(ctx)
+
+	entityID, err := inf.GetID()
+	if err != nil {
+		logger.Error().Err(err).Msg("error getting entity id of throttled evaluation")
+		return
+	}
+
+	err = e.querier.DeferThrottledEvaluation(ctx, db.DeferThrottledEvaluationParams{
+		EntityInstanceID: entityID,
+		ProjectID:        inf.ProjectID,
+		EvaluateAfter:    e.usageTracker.ThrottledUntil(),
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("error deferring throttled evaluation")
+	}
+}
+
 func (e *executor) releaseLockAndFlush(
 	ctx context.Context,
 	inf *entities.EntityInfoWrapper,
@@ -150,7 +150,7 @@ func TestExecutor_handleEntityEvent(t *testing.T) {
 	historyService := mockhistory.NewMockEvaluationHistoryService(ctrl)
 	historyService.EXPECT().
 		StoreEvaluationStatus(
-			gomock.Any(), gomock.Any(), ruleInstanceID, profileID, db.EntitiesRepository, repositoryID, gomock.Any(), gomock.Any(), gomock.Any()).
+			gomock.Any(), gomock.Any(), ruleInstanceID, profileID, db.EntitiesRepository, repositoryID, gomock.Any(), gomock.Any(), gomock.Any(), int32(0)).
 		Return(evaluationID, nil)
 
 	mockStore.EXPECT().
@@ -175,7 +175,16 @@ func TestExecutor_handleEntityEvent(t *testing.T) {
 	mockStore.EXPECT().
 		GetParentProjects(gomock.Any(), projectID).
 		Return([]uuid.UUID{projectID}, nil).
-		Times(2)
+		Times(3)
+
+	// the project has no actions policy
+	mockStore.EXPECT().
+		GetProjectByID(gomock.Any(), projectID).
+		Return(db.Project{
+			ID:       projectID,
+			Name:     "test-project",
+			Metadata: emptyJSON,
+		}, nil)
 
 	// list one profile
 	mockStore.EXPECT().
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package freeze
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+// Flusher publishes the re-evaluation of the entities whose actions were
+// deferred, once the freeze has ended. The re-evaluation executes the
+// actions, as the deferred actions left the previous action statuses intact.
+type Flusher struct {
+	store   db.DeferredActionsStore
+	evt     interfaces.Publisher
+	freezer *Freezer
+	cfg     *serverconfig.ActionFreezeConfig
+}
+
+// NewFlusher creates a new flusher of deferred actions
+func NewFlusher(
+	store db.DeferredActionsStore,
+	evt interfaces.Publisher,
+	freezer *Freezer,
+	cfg *serverconfig.ActionFreezeConfig,
+) *Flusher {
+	return &Flusher{
+		store:   store,
+		evt:     evt,
+		freezer: freezer,
+		cfg:     cfg,
+	}
+}
+
+// Run flushes the deferred actions at regular intervals until the context is
+// cancelled
+func (f *Flusher) Run(ctx context.Context) error {
+	if f.cfg.FlushInterval <= 0 {
+		return fmt.Errorf("invalid action freeze flush interval: %s", f.cfg.FlushInterval)
+	}
+
+	ticker := time.NewTicker(f.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := f.Flush(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error flushing deferred actions")
+			}
+		}
+	}
+}
+
+// Flush publishes the re-evaluation of a batch of entities whose actions were
+// deferred and whose project is no longer frozen
+func (f *Flusher) Flush(ctx context.Context) error {
+	if frozen, _ := f.freezer.Frozen(ctx); frozen {
+		return nil
+	}
+
+	deferred, err := f.store.PopDeferredActions(ctx, f.cfg.BatchSize)
+	if err != nil {
+		return fmt.Errorf("error getting deferred actions: %w", err)
+	}
+
+	for _, d := range deferred {
+		logger := zerolog.Ctx(ctx).With().
+			Str("entity_id", d.EntityInstanceID.String()).
+			Str("project_id", d.ProjectID.String()).
+			Logger()
+
+		// The freeze flag may still be on for the project of the entity
+		projCtx := engcontext.WithEntityContext(ctx, &engcontext.EntityContext{
+			Project: engcontext.Project{ID: d.ProjectID},
+		})
+		if frozen, reason := f.freezer.Frozen(projCtx); frozen {
+			logger.Debug().Str("reason", reason).Msg("project is still frozen, deferring actions again")
+			if err := f.store.DeferActions(ctx, db.DeferActionsParams{
+				EntityInstanceID: d.EntityInstanceID,
+				ProjectID:        d.ProjectID,
+			}); err != nil {
+				logger.Error().Err(err).Msg("error deferring actions")
+			}
+			continue
+		}
+
+		m := message.NewMessage(uuid.New().String(), nil)
+		m.SetContext(ctx)
+
+		entRefresh := entityMessage.NewEntityRefreshAndDoMessage().
+			WithEntityID(d.EntityInstanceID)
+		if err := entRefresh.ToMessage(m); err != nil {
+			// The next evaluation of the entity executes the actions
+			logger.Error().Err(err).Msg("error marshalling message")
+			continue
+		}
+
+		if err := f.evt.Publish(constants.TopicQueueRefreshEntityByIDAndEvaluate, m); err != nil {
+			return fmt.Errorf("error publishing message: %w", err)
+		}
+
+		logger.Info().Time("deferred_at", d.DeferredAt).Msg("flushing deferred actions")
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package freeze
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/events/stubs"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// projectFlags turns the flags on for the given projects only
+type projectFlags map[string]bool
+
+func (p projectFlags) Boolean(
+	_ context.Context, _ string, defaultValue bool, ec openfeature.EvaluationContext, _ ...openfeature.Option,
+) bool {
+	if v, ok := p[ec.TargetingKey()]; ok {
+		return v
+	}
+	return defaultValue
+}
+
+func TestFlusherFlush(t *testing.T) {
+	t.Parallel()
+
+	entityID := uuid.New()
+	frozenEntityID := uuid.New()
+	frozenProjectID := uuid.New()
+	cfg := &serverconfig.ActionFreezeConfig{BatchSize: 10}
+
+	tests := []struct {
+		name    string
+		flags   projectFlags
+		setup   func(store *mockdb.MockDeferredActionsStore)
+		wantErr bool
+		wantIDs []uuid.UUID
+	}{
+		{
+			name:  "publishes deferred actions",
+			flags: projectFlags{},
+			setup: func(store *mockdb.MockDeferredActionsStore) {
+				store.EXPECT().PopDeferredActions(gomock.Any(), int64(10)).
+					Return([]db.DeferredAction{{
+						EntityInstanceID: entityID,
+						ProjectID:        uuid.New(),
+					}}, nil)
+			},
+			wantIDs: []uuid.UUID{entityID},
+		},
+		{
+			name:  "defers again the actions of frozen projects",
+			flags: projectFlags{frozenProjectID.String(): true},
+			setup: func(store *mockdb.MockDeferredActionsStore) {
+				store.EXPECT().PopDeferredActions(gomock.Any(), int64(10)).
+					Return([]db.DeferredAction{{
+						EntityInstanceID: frozenEntityID,
+						ProjectID:        frozenProjectID,
+					}, {
+						EntityInstanceID: entityID,
+						ProjectID:        uuid.New(),
+					}}, nil)
+				store.EXPECT().DeferActions(gomock.Any(), db.DeferActionsParams{
+					EntityInstanceID: frozenEntityID,
+					ProjectID:        frozenProjectID,
+				}).Return(nil)
+			},
+			wantIDs: []uuid.UUID{entityID},
+		},
+		{
+			name:  "nothing flushed while frozen",
+			flags: projectFlags{uuid.Nil.String(): true},
+			setup: func(_ *mockdb.MockDeferredActionsStore) {},
+		},
+		{
+			name:  "error getting deferred actions",
+			flags: projectFlags{},
+			setup: func(store *mockdb.MockDeferredActionsStore) {
+				store.EXPECT().PopDeferredActions(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("boom"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockDeferredActionsStore(ctrl)
+			tt.setup(store)
+			evt := &stubs.StubEventer{}
+
+			freezer, err := NewFreezer(cfg, tt.flags)
+			require.NoError(t, err)
+
+			err = NewFlusher(store, evt, freezer, cfg).Flush(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, evt.Sent, len(tt.wantIDs))
+			for i, msg := range evt.Sent {
+				require.Equal(t, []string{constants.TopicQueueRefreshEntityByIDAndEvaluate}, evt.Topics)
+				var refresh entityMessage.HandleEntityAndDoMessage
+				require.NoError(t, json.Unmarshal(msg.Payload, &refresh))
+				require.Equal(t, tt.wantIDs[i], refresh.Entity.EntityID)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package freeze handles the freezes of the remediations and alerts, during
+// which the actions are deferred rather than executed, and the flush of the
+// deferred actions once the freeze has ended.
+package freeze
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/flags"
+)
+
+// Freezer decides whether the actions are frozen, either by a scheduled
+// freeze window or by the action_freeze feature flag. A nil Freezer never
+// freezes the actions.
+type Freezer struct {
+	windows []window
+	flags   flags.Interface
+	// now is replaceable for testing
+	now func() time.Time
+}
+
+type window struct {
+	name     string
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+// NewFreezer creates a freezer from the configured freeze windows
+func NewFreezer(cfg *serverconfig.ActionFreezeConfig, featureFlags flags.Interface) (*Freezer, error) {
+	windows := make([]window, 0, len(cfg.Windows))
+	for _, w := range cfg.Windows {
+		schedule, err := cron.ParseStandard(w.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule for freeze window %q: %w", w.Name, err)
+		}
+		if w.Duration <= 0 {
+			return nil, fmt.Errorf("invalid duration for freeze window %q: %s", w.Name, w.Duration)
+		}
+		windows = append(windows, window{
+			name:     w.Name,
+			schedule: schedule,
+			duration: w.Duration,
+		})
+	}
+
+	return &Freezer{
+		windows: windows,
+		flags:   featureFlags,
+		now:     time.Now,
+	}, nil
+}
+
+// Frozen returns whether the actions are frozen, and the reason why. The
+// feature flag is evaluated for the project in the context, if any.
+func (f *Freezer) Frozen(ctx context.Context) (bool, string) {
+	if f == nil {
+		return false, ""
+	}
+
+	if flags.Bool(ctx, f.flags, flags.ActionFreeze) {
+		return true, "action freeze flag"
+	}
+
+	now := f.now()
+	for _, w := range f.windows {
+		if w.activeAt(now) {
+			return true, fmt.Sprintf("freeze window %q", w.name)
+		}
+	}
+
+	return false, ""
+}
+
+// activeAt returns whether the window started less than its duration before t
+func (w window) activeAt(t time.Time) bool {
+	return !w.schedule.Next(t.Add(-w.duration)).After(t)
+}
+
+// State tracks the actions of the evaluation of an entity deferred by a freeze
+type State struct {
+	// Frozen is whether the actions are frozen during the evaluation
+	Frozen bool
+	// Reason is why the actions are frozen
+	Reason string
+
+	deferred bool
+}
+
+// State returns the freeze state of an evaluation starting now
+func (f *Freezer) State(ctx context.Context) *State {
+	frozen, reason := f.Frozen(ctx)
+	return &State{
+		Frozen: frozen,
+		Reason: reason,
+	}
+}
+
+// Record records the outcome of the actions of a rule evaluation
+func (s *State) Record(actionsErr evalerrors.ActionsError) {
+	s.deferred = s.deferred || actionsErr.Deferred
+}
+
+// Deferred returns whether the actions of a rule evaluation were deferred
+func (s *State) Deferred() bool {
+	return s.deferred
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package freeze
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/flags"
+)
+
+func TestFreezerFrozen(t *testing.T) {
+	t.Parallel()
+
+	cfg := &serverconfig.ActionFreezeConfig{
+		Windows: []serverconfig.FreezeWindowConfig{{
+			Name: "weekend",
+			// Fridays at 18:00 for 2 days and 14 hours
+			Schedule: "CRON_TZ=UTC 0 18 * * FRI",
+			Duration: 62 * time.Hour,
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		now        string
+		flag       bool
+		wantFrozen bool
+		wantReason string
+	}{
+		{
+			name: "before the window",
+			// a Friday
+			now: "2024-10-04T17:59:00Z",
+		},
+		{
+			name:       "start of the window",
+			now:        "2024-10-04T18:00:00Z",
+			wantFrozen: true,
+			wantReason: `freeze window "weekend"`,
+		},
+		{
+			name:       "during the window",
+			now:        "2024-10-06T12:00:00Z",
+			wantFrozen: true,
+			wantReason: `freeze window "weekend"`,
+		},
+		{
+			name: "end of the window",
+			now:  "2024-10-07T08:00:00Z",
+		},
+		{
+			name:       "flag freezes outside of the windows",
+			now:        "2024-10-08T12:00:00Z",
+			flag:       true,
+			wantFrozen: true,
+			wantReason: "action freeze flag",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			featureFlags := &flags.FakeClient{Data: map[string]any{
+				string(flags.ActionFreeze): tt.flag,
+			}}
+			f, err := NewFreezer(cfg, featureFlags)
+			require.NoError(t, err)
+			now, err := time.Parse(time.RFC3339, tt.now)
+			require.NoError(t, err)
+			f.now = func() time.Time { return now }
+
+			frozen, reason := f.Frozen(context.Background())
+			require.Equal(t, tt.wantFrozen, frozen)
+			require.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+func TestNewFreezerInvalidWindow(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewFreezer(&serverconfig.ActionFreezeConfig{
+		Windows: []serverconfig.FreezeWindowConfig{{Name: "bad", Schedule: "not a cron", Duration: time.Hour}},
+	}, nil)
+	require.ErrorContains(t, err, `freeze window "bad"`)
+
+	_, err = NewFreezer(&serverconfig.ActionFreezeConfig{
+		Windows: []serverconfig.FreezeWindowConfig{{Name: "empty", Schedule: "@daily"}},
+	}, nil)
+	require.ErrorContains(t, err, `freeze window "empty"`)
+}
+
+func TestNilFreezer(t *testing.T) {
+	t.Parallel()
+
+	var f *Freezer
+	state := f.State(context.Background())
+	require.False(t, state.Frozen)
+	require.False(t, state.Deferred())
+}
+
+func TestStateRecord(t *testing.T) {
+	t.Parallel()
+
+	state := &State{Frozen: true}
+	state.Record(evalerrors.ActionsError{})
+	require.False(t, state.Deferred())
+	state.Record(evalerrors.ActionsError{Deferred: true})
+	state.Record(evalerrors.ActionsError{})
+	require.True(t, state.Deferred())
+}
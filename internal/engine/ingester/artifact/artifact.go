@@ -16,6 +16,7 @@ import (
 
 	artif "github.com/mindersec/minder/internal/providers/artifact"
 	"github.com/mindersec/minder/internal/verifier"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
 	"github.com/mindersec/minder/internal/verifier/sigstore/container"
 	"github.com/mindersec/minder/internal/verifier/verifyif"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -38,11 +39,16 @@ const (
 // Ingest is the engine for a rule type that uses artifact data ingest
 // Implements enginer.ingester.Ingester
 type Ingest struct {
+	cfg  *pb.ArtifactType
 	prov interfaces.Provider
 
 	// artifactVerifier is the verifier for sigstore. It's only used in the Ingest method
 	// but we store it in the Ingest structure to allow tests to set a custom artifactVerifier
 	artifactVerifier verifyif.ArtifactVerifier
+
+	// imageFetcher fetches the image config of the artifact versions. As the
+	// artifactVerifier, it is only stored here to allow tests to set a custom one
+	imageFetcher imageFetcher
 }
 
 type verification struct {
@@ -53,7 +59,12 @@ type verification struct {
 	SignerIdentity    string               `json:"signer_identity"`
 	RunnerEnvironment string               `json:"runner_environment"`
 	CertIssuer        string               `json:"cert_issuer"`
+	Platform          string               `json:"platform,omitempty"`
+	SignedDigest      string               `json:"signed_digest,omitempty"`
 	Attestation       *verifiedAttestation `json:"attestation,omitempty"`
+
+	// digest is the digest of the verified artifact version
+	digest string
 }
 
 type verifiedAttestation struct {
@@ -62,8 +73,13 @@ type verifiedAttestation struct {
 }
 
 // NewArtifactDataIngest creates a new artifact rule data ingest engine
-func NewArtifactDataIngest(prov interfaces.Provider) (*Ingest, error) {
+func NewArtifactDataIngest(cfg *pb.ArtifactType, prov interfaces.Provider) (*Ingest, error) {
+	if cfg == nil {
+		cfg = &pb.ArtifactType{}
+	}
+
 	return &Ingest{
+		cfg:  cfg,
 		prov: prov,
 	}, nil
 }
@@ -74,8 +90,8 @@ func (*Ingest) GetType() string {
 }
 
 // GetConfig returns the config for the artifact rule data ingest engine
-func (*Ingest) GetConfig() proto.Message {
-	return nil
+func (i *Ingest) GetConfig() proto.Message {
+	return i.cfg
 }
 
 // Ingest checks the passed in artifact, makes sure it is applicable to the current rule
@@ -92,7 +108,7 @@ func (i *Ingest) Ingest(
 
 	artifact, ok := ent.(*pb.Artifact)
 	if !ok {
-		return nil, fmt.Errorf("expected Artifact, got %T", ent)
+		return nil, fmt.Errorf("%w: expected Artifact, got %T", evalerrors.ErrIngestionNotApplicable, ent)
 	}
 
 	// Filter the versions of the artifact that are applicable to this rule
@@ -124,6 +140,10 @@ func (i *Ingest) getApplicableArtifactVersions(
 		return nil, err
 	}
 
+	if cfg.Type.isPackage() {
+		return i.getApplicablePackageVersions(ctx, artifact, cfg)
+	}
+
 	vers, err := interfaces.As[provifv1.ArtifactProvider](i.prov)
 	if err != nil {
 		return nil, err
@@ -141,12 +161,26 @@ func (i *Ingest) getApplicableArtifactVersions(
 		return nil, err
 	}
 
-	// Build the result to be returned to the rule engine as a slice of map["Verification"]any
+	// Get the image config of the artifact versions, if the rule type asked for it
+	var images map[string]*imageMetadata
+	if i.cfg.GetImageConfig() {
+		images, err = i.getImageMetadata(ctx, artifact, checksums)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Build the result to be returned to the rule engine as a slice of map["Verification"]any,
+	// along with map["ImageConfig"]any when the image config was fetched
 	result := make([]map[string]any, 0, len(verificationResults))
 	for _, item := range verificationResults {
-		result = append(result, map[string]any{
+		res := map[string]any{
 			"Verification": item,
-		})
+		}
+		if images != nil {
+			res["ImageConfig"] = images[item.digest]
+		}
+		result = append(result, res)
 	}
 
 	zerolog.Ctx(ctx).Debug().Any("result", result).Msg("ingestion result")
@@ -164,8 +198,8 @@ func validateConfiguration(
 		return evalerrors.NewErrEvaluationSkipSilently("artifact type mismatch")
 	}
 
-	if cfg.Type != artifactTypeContainer {
-		return evalerrors.NewErrEvaluationSkipSilently("only container artifacts are supported at the moment")
+	if cfg.Type != artifactTypeContainer && !cfg.Type.isPackage() {
+		return evalerrors.NewErrEvaluationSkipSilently("only container and package artifacts are supported at the moment")
 	}
 
 	// If a name is specified, make sure it matches
@@ -184,7 +218,7 @@ func (i *Ingest) getVerificationResult(
 ) ([]verification, error) {
 	var versionResults []verification
 	// Get the verifier for sigstore
-	artifactVerifier, err := getVerifier(i, cfg)
+	artifactVerifier, err := getVerifier(ctx, i, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("error getting verifier: %w", err)
 	}
@@ -208,32 +242,7 @@ func (i *Ingest) getVerificationResult(
 				zerolog.Ctx(ctx).Debug().Str("name", artifactName).Msg("failed to find or verify signature information")
 			}
 
-			// Begin building the verification result
-			verResult := &verification{
-				IsSigned:   res.IsSigned,
-				IsVerified: res.IsVerified,
-			}
-
-			// If we got verified provenance info for the artifact version, populate the rest of the verification result
-			if res.IsVerified {
-				siIdentity, err := signerIdentityFromCertificate(res.Signature.Certificate)
-				if err != nil {
-					zerolog.Ctx(ctx).Err(err).Msg("error parsing signer identity")
-				}
-
-				verResult.Repository = res.Signature.Certificate.SourceRepositoryURI
-				verResult.Branch = branchFromRef(res.Signature.Certificate.SourceRepositoryRef)
-				verResult.SignerIdentity = siIdentity
-				verResult.RunnerEnvironment = res.Signature.Certificate.RunnerEnvironment
-				verResult.CertIssuer = res.Signature.Certificate.Issuer
-			}
-
-			if res.Statement != nil {
-				verResult.Attestation = &verifiedAttestation{
-					PredicateType: res.Statement.PredicateType,
-					Predicate:     res.Statement.Predicate,
-				}
-			}
+			verResult := newVerification(ctx, &res, artifactChecksum)
 			// Append the verification result to the list
 			versionResults = append(versionResults, *verResult)
 		}
@@ -241,7 +250,41 @@ func (i *Ingest) getVerificationResult(
 	return versionResults, nil
 }
 
-func getVerifier(i *Ingest, cfg *ingesterConfig) (verifyif.ArtifactVerifier, error) {
+// newVerification builds the verification result of a version of an artifact
+// from the result of the verifier
+func newVerification(ctx context.Context, res *verifyif.Result, digest string) *verification {
+	verResult := &verification{
+		IsSigned:     res.IsSigned,
+		IsVerified:   res.IsVerified,
+		Platform:     res.Platform,
+		SignedDigest: res.SignedDigest,
+		digest:       digest,
+	}
+
+	// If we got verified provenance info for the artifact version, populate the rest of the verification result
+	if res.IsVerified {
+		siIdentity, err := signerIdentityFromCertificate(res.Signature.Certificate)
+		if err != nil {
+			zerolog.Ctx(ctx).Err(err).Msg("error parsing signer identity")
+		}
+
+		verResult.Repository = res.Signature.Certificate.SourceRepositoryURI
+		verResult.Branch = branchFromRef(res.Signature.Certificate.SourceRepositoryRef)
+		verResult.SignerIdentity = siIdentity
+		verResult.RunnerEnvironment = res.Signature.Certificate.RunnerEnvironment
+		verResult.CertIssuer = res.Signature.Certificate.Issuer
+	}
+
+	if res.Statement != nil {
+		verResult.Attestation = &verifiedAttestation{
+			PredicateType: res.Statement.PredicateType,
+			Predicate:     res.Statement.Predicate,
+		}
+	}
+	return verResult
+}
+
+func getVerifier(ctx context.Context, i *Ingest, cfg *ingesterConfig) (verifyif.ArtifactVerifier, error) {
 	if i.artifactVerifier != nil {
 		return i.artifactVerifier, nil
 	}
@@ -258,6 +301,22 @@ func getVerifier(i *Ingest, cfg *ingesterConfig) (verifyif.ArtifactVerifier, err
 			container.WithAuthenticator(cauthn))
 	}
 
+	// The trust root of the project is used unless the rule selects a
+	// sigstore instance explicitly
+	if cfg.Sigstore == "" {
+		trustRoot, err := sigstore.TrustRootFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error getting project sigstore trust root: %w", err)
+		}
+		if trustRoot != nil {
+			artifactVerifier, err := sigstore.NewWithTrustRoot(trustRoot, verifieropts...)
+			if err != nil {
+				return nil, fmt.Errorf("error getting sigstore verifier for project trust root: %w", err)
+			}
+			return artifactVerifier, nil
+		}
+	}
+
 	artifactVerifier, err := verifier.NewVerifier(
 		verifier.VerifierSigstore,
 		cfg.Sigstore,
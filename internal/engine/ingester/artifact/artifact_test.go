@@ -222,7 +222,7 @@ func TestArtifactIngestMatching(t *testing.T) {
 
 			prov, err := testGithubProvider()
 			require.NoError(t, err)
-			ing, err := NewArtifactDataIngest(prov)
+			ing, err := NewArtifactDataIngest(&pb.ArtifactType{}, prov)
 			require.NoError(t, err, "expected no error")
 
 			ing.prov = mockGhClient
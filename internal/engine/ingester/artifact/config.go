@@ -15,6 +15,8 @@ type artifactType string
 
 const (
 	artifactTypeContainer artifactType = "container"
+	artifactTypeNpm       artifactType = "npm"
+	artifactTypePyPI      artifactType = "pypi"
 	artifactTypeUnknown   artifactType = "unknown"
 )
 
@@ -22,6 +24,10 @@ func newArtifactIngestType(s string) artifactType {
 	switch strings.ToLower(s) {
 	case "container":
 		return artifactTypeContainer
+	case "npm":
+		return artifactTypeNpm
+	case "pypi":
+		return artifactTypePyPI
 	default:
 		return artifactTypeUnknown
 	}
@@ -47,3 +53,9 @@ func configFromParams(params map[string]any) (*ingesterConfig, error) {
 
 	return cfg, nil
 }
+
+// isPackage returns whether the artifact type is a package of a package
+// registry, rather than a container image
+func (t artifactType) isPackage() bool {
+	return t == artifactTypeNpm || t == artifactTypePyPI
+}
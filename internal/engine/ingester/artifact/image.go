@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// maxImageConfigBytes is the maximum size of the image configs we're
+	// willing to fetch. Image configs are usually a few kilobytes.
+	maxImageConfigBytes = 1024 * 1024
+
+	// imageMetadataCacheSize is the number of image versions whose metadata
+	// is kept in memory. Image versions are immutable, so entries never
+	// need to be refreshed.
+	imageMetadataCacheSize = 1024
+
+	// annotationBaseImageName is the OCI annotation of the manifest holding
+	// the reference of the base image
+	annotationBaseImageName = "org.opencontainers.image.base.name"
+	// annotationBaseImageDigest is the OCI annotation of the manifest
+	// holding the digest of the base image
+	annotationBaseImageDigest = "org.opencontainers.image.base.digest"
+
+	defaultImageRegistry = "ghcr.io"
+)
+
+// imageMetadata is the metadata of a container image version taken from
+// its manifest and config
+type imageMetadata struct {
+	User            string            `json:"user"`
+	ExposedPorts    []string          `json:"exposed_ports"`
+	Env             []string          `json:"env"`
+	Entrypoint      []string          `json:"entrypoint"`
+	Cmd             []string          `json:"cmd"`
+	Labels          map[string]string `json:"labels"`
+	OS              string            `json:"os"`
+	Architecture    string            `json:"architecture"`
+	BaseImageName   string            `json:"base_image_name"`
+	BaseImageDigest string            `json:"base_image_digest"`
+	Layers          []imageLayer      `json:"layers"`
+}
+
+type imageLayer struct {
+	Digest    string `json:"digest"`
+	MediaType string `json:"media_type"`
+	Size      int64  `json:"size"`
+	CreatedBy string `json:"created_by,omitempty"`
+}
+
+// imageFetcher fetches the metadata of a version of a container image
+type imageFetcher interface {
+	Fetch(ctx context.Context, owner, artifact, checksum string) (*imageMetadata, error)
+}
+
+// registryImageFetcher fetches image metadata from a container registry
+type registryImageFetcher struct {
+	registry string
+	auth     authn.Authenticator
+}
+
+// Fetch fetches the manifest and config of an image version, going through
+// the process-wide cache of image metadata.
+func (f *registryImageFetcher) Fetch(ctx context.Context, owner, artifact, checksum string) (*imageMetadata, error) {
+	imageRef := container.BuildImageRef(f.registry, owner, artifact, checksum)
+	if meta, ok := imageMetadataCache.get(imageRef); ok {
+		return meta, nil
+	}
+
+	ref, err := name.NewDigest(imageRef)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing image reference: %w", err)
+	}
+
+	img, err := remote.Image(ref, remote.WithAuth(f.auth), remote.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error getting image: %w", err)
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("error getting image manifest: %w", err)
+	}
+	if manifest.Config.Size > maxImageConfigBytes {
+		return nil, fmt.Errorf("image config is %d bytes, over the limit of %d bytes",
+			manifest.Config.Size, maxImageConfigBytes)
+	}
+
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("error getting image config: %w", err)
+	}
+
+	meta := imageMetadataFromImage(manifest, cfg)
+	imageMetadataCache.add(imageRef, meta)
+	return meta, nil
+}
+
+func (i *Ingest) getImageMetadata(
+	ctx context.Context,
+	artifact *pb.Artifact,
+	checksums []string,
+) (map[string]*imageMetadata, error) {
+	fetcher, err := getImageFetcher(i, artifact.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("error getting image fetcher: %w", err)
+	}
+
+	images := make(map[string]*imageMetadata, len(checksums))
+	for _, checksum := range checksums {
+		meta, err := fetcher.Fetch(ctx, artifact.Owner, artifact.Name, checksum)
+		if err != nil {
+			return nil, fmt.Errorf("failed getting image config: %w", err)
+		}
+		images[checksum] = meta
+	}
+	return images, nil
+}
+
+func getImageFetcher(i *Ingest, owner string) (imageFetcher, error) {
+	if i.imageFetcher != nil {
+		return i.imageFetcher, nil
+	}
+
+	if ghcli, err := interfaces.As[provifv1.GitHub](i.prov); err == nil {
+		return &registryImageFetcher{
+			registry: defaultImageRegistry,
+			auth:     ghcli.GetCredential().GetAsContainerAuthenticator(owner),
+		}, nil
+	} else if ocicli, err := interfaces.As[provifv1.OCI](i.prov); err == nil {
+		cauthn, err := ocicli.GetAuthenticator()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get oci authenticator: %w", err)
+		}
+		return &registryImageFetcher{
+			registry: ocicli.GetRegistry(),
+			auth:     cauthn,
+		}, nil
+	}
+
+	return &registryImageFetcher{
+		registry: defaultImageRegistry,
+		auth:     authn.Anonymous,
+	}, nil
+}
+
+// imageMetadataFromImage extracts the metadata exposed to rules from the
+// manifest and config of an image. Layers are matched with the history
+// entries of the config which created them.
+func imageMetadataFromImage(manifest *v1.Manifest, cfg *v1.ConfigFile) *imageMetadata {
+	meta := &imageMetadata{
+		User:            cfg.Config.User,
+		Env:             cfg.Config.Env,
+		Entrypoint:      cfg.Config.Entrypoint,
+		Cmd:             cfg.Config.Cmd,
+		Labels:          cfg.Config.Labels,
+		OS:              cfg.OS,
+		Architecture:    cfg.Architecture,
+		BaseImageName:   manifest.Annotations[annotationBaseImageName],
+		BaseImageDigest: manifest.Annotations[annotationBaseImageDigest],
+		ExposedPorts:    make([]string, 0, len(cfg.Config.ExposedPorts)),
+		Layers:          make([]imageLayer, 0, len(manifest.Layers)),
+	}
+
+	for port := range cfg.Config.ExposedPorts {
+		meta.ExposedPorts = append(meta.ExposedPorts, port)
+	}
+	slices.Sort(meta.ExposedPorts)
+
+	createdBy := make([]string, 0, len(manifest.Layers))
+	for _, h := range cfg.History {
+		if !h.EmptyLayer {
+			createdBy = append(createdBy, h.CreatedBy)
+		}
+	}
+
+	for idx, layer := range manifest.Layers {
+		l := imageLayer{
+			Digest:    layer.Digest.String(),
+			MediaType: string(layer.MediaType),
+			Size:      layer.Size,
+		}
+		if idx < len(createdBy) {
+			l.CreatedBy = createdBy[idx]
+		}
+		meta.Layers = append(meta.Layers, l)
+	}
+
+	return meta
+}
+
+// imageMetadataCache is the process-wide cache of image metadata, keyed by
+// the digest reference of the image version.
+var imageMetadataCache = newMetadataCache(imageMetadataCacheSize)
+
+// metadataCache is a least recently used cache of image metadata
+type metadataCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type metadataCacheEntry struct {
+	key  string
+	meta *imageMetadata
+}
+
+func newMetadataCache(size int) *metadataCache {
+	return &metadataCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+	}
+}
+
+func (c *metadataCache) get(key string) (*imageMetadata, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*metadataCacheEntry).meta, true
+}
+
+func (c *metadataCache) add(key string, meta *imageMetadata) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*metadataCacheEntry).meta = meta
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&metadataCacheEntry{key: key, meta: meta})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*metadataCacheEntry).key)
+	}
+}
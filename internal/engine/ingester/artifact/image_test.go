@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mockghclient "github.com/mindersec/minder/internal/providers/github/mock"
+	"github.com/mindersec/minder/internal/verifier/verifyif"
+	mockverify "github.com/mindersec/minder/internal/verifier/verifyif/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+type fakeImageFetcher struct {
+	images map[string]*imageMetadata
+}
+
+func (f *fakeImageFetcher) Fetch(_ context.Context, _, _, checksum string) (*imageMetadata, error) {
+	meta, ok := f.images[checksum]
+	if !ok {
+		return nil, errors.New("image not found")
+	}
+	return meta, nil
+}
+
+func TestImageMetadataFromImage(t *testing.T) {
+	t.Parallel()
+
+	manifest := &v1.Manifest{
+		Annotations: map[string]string{
+			annotationBaseImageName:   "docker.io/library/alpine:3.20",
+			annotationBaseImageDigest: "sha256:abcd",
+		},
+		Layers: []v1.Descriptor{
+			{MediaType: types.OCILayer, Size: 100, Digest: v1.Hash{Algorithm: "sha256", Hex: "1111"}},
+			{MediaType: types.OCILayer, Size: 200, Digest: v1.Hash{Algorithm: "sha256", Hex: "2222"}},
+		},
+	}
+	cfg := &v1.ConfigFile{
+		OS:           "linux",
+		Architecture: "amd64",
+		Config: v1.Config{
+			User:         "65532",
+			Env:          []string{"PATH=/usr/bin"},
+			Entrypoint:   []string{"/app"},
+			ExposedPorts: map[string]struct{}{"8080/tcp": {}, "443/tcp": {}},
+			Labels:       map[string]string{"org.opencontainers.image.source": "https://github.com/stacklok/minder"},
+		},
+		History: []v1.History{
+			{CreatedBy: "ADD rootfs.tar.gz /"},
+			{CreatedBy: "ENV PATH=/usr/bin", EmptyLayer: true},
+			{CreatedBy: "COPY app /app"},
+		},
+	}
+
+	meta := imageMetadataFromImage(manifest, cfg)
+	require.Equal(t, &imageMetadata{
+		User:            "65532",
+		ExposedPorts:    []string{"443/tcp", "8080/tcp"},
+		Env:             []string{"PATH=/usr/bin"},
+		Entrypoint:      []string{"/app"},
+		Labels:          map[string]string{"org.opencontainers.image.source": "https://github.com/stacklok/minder"},
+		OS:              "linux",
+		Architecture:    "amd64",
+		BaseImageName:   "docker.io/library/alpine:3.20",
+		BaseImageDigest: "sha256:abcd",
+		Layers: []imageLayer{
+			{Digest: "sha256:1111", MediaType: string(types.OCILayer), Size: 100, CreatedBy: "ADD rootfs.tar.gz /"},
+			{Digest: "sha256:2222", MediaType: string(types.OCILayer), Size: 200, CreatedBy: "COPY app /app"},
+		},
+	}, meta)
+}
+
+func TestMetadataCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	c := newMetadataCache(2)
+	c.add("a", &imageMetadata{User: "a"})
+	c.add("b", &imageMetadata{User: "b"})
+
+	// a is now the most recently used entry
+	_, ok := c.get("a")
+	require.True(t, ok)
+
+	c.add("c", &imageMetadata{User: "c"})
+
+	_, ok = c.get("b")
+	require.False(t, ok)
+	meta, ok := c.get("a")
+	require.True(t, ok)
+	require.Equal(t, "a", meta.User)
+	meta, ok = c.get("c")
+	require.True(t, ok)
+	require.Equal(t, "c", meta.User)
+}
+
+func TestArtifactIngestImageConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		cfg       *pb.ArtifactType
+		images    map[string]*imageMetadata
+		wantErr   bool
+		wantImage bool
+		wantMeta  *imageMetadata
+	}{
+		{
+			name: "image config not requested",
+			cfg:  &pb.ArtifactType{},
+		},
+		{
+			name: "image config requested",
+			cfg:  &pb.ArtifactType{ImageConfig: true},
+			images: map[string]*imageMetadata{
+				"sha256:1234": {User: "root"},
+			},
+			wantImage: true,
+			wantMeta:  &imageMetadata{User: "root"},
+		},
+		{
+			name:    "image config cannot be fetched",
+			cfg:     &pb.ArtifactType{ImageConfig: true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockGhClient := mockghclient.NewMockGitHub(ctrl)
+			mockVerifier := mockverify.NewMockArtifactVerifier(ctrl)
+
+			mockGhClient.EXPECT().
+				GetArtifactVersions(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]*pb.ArtifactVersion{
+					{
+						Sha:       "sha256:1234",
+						Tags:      []string{"latest"},
+						CreatedAt: timestamppb.New(time.Now()),
+					},
+				}, nil)
+			mockVerifier.EXPECT().
+				Verify(gomock.Any(), verifyif.ArtifactTypeContainer, "stacklok", "minder", "sha256:1234").
+				Return([]verifyif.Result{{}}, nil)
+
+			ing, err := NewArtifactDataIngest(tt.cfg, mockGhClient)
+			require.NoError(t, err)
+			ing.artifactVerifier = mockVerifier
+			ing.imageFetcher = &fakeImageFetcher{images: tt.images}
+
+			got, err := ing.Ingest(context.Background(), &pb.Artifact{
+				Type:  "container",
+				Name:  "minder",
+				Owner: "stacklok",
+			}, map[string]any{})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			result, ok := got.Object.([]map[string]any)
+			require.True(t, ok)
+			require.Len(t, result, 1)
+			image, ok := result[0]["ImageConfig"]
+			require.Equal(t, tt.wantImage, ok)
+			if tt.wantImage {
+				require.Equal(t, tt.wantMeta, image)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+
+	artif "github.com/mindersec/minder/internal/providers/artifact"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// defaultPackageTags are the tags the versions of a package are filtered by
+// when the rule doesn't set any: only the latest version is evaluated
+var defaultPackageTags = []string{"latest"}
+
+// packageVersion is the metadata of a version of a package, as returned along
+// with its verification
+type packageVersion struct {
+	Name                      string   `json:"name"`
+	Version                   string   `json:"version"`
+	Tags                      []string `json:"tags"`
+	PublishedAt               string   `json:"published_at,omitempty"`
+	Publisher                 string   `json:"publisher,omitempty"`
+	Maintainers               []string `json:"maintainers"`
+	Deprecated                bool     `json:"deprecated"`
+	RegistrySigned            bool     `json:"registry_signed"`
+	RegistrySignatureVerified bool     `json:"registry_signature_verified"`
+	File                      string   `json:"file,omitempty"`
+}
+
+// getApplicablePackageVersions returns the verification of the attestations
+// of the versions of a package which apply to the rule, along with their
+// registry metadata. Each version is matched against the tags by its version
+// and its dist-tags.
+func (i *Ingest) getApplicablePackageVersions(
+	ctx context.Context,
+	artifact *pb.Artifact,
+	cfg *ingesterConfig,
+) ([]map[string]any, error) {
+	registry, err := interfaces.As[provifv1.PackageRegistry](i.prov)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := cfg.Tags
+	if len(tags) == 0 && cfg.TagRegex == "" {
+		tags = defaultPackageTags
+	}
+	filter, err := artif.BuildFilter(tags, cfg.TagRegex)
+	if err != nil {
+		return nil, fmt.Errorf("error building filter from artifact ingester config: %w", err)
+	}
+
+	pkg, err := registry.GetPackage(ctx, artifact.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving package: %w", err)
+	}
+
+	var versions []*provifv1.PackageVersion
+	for _, v := range pkg.Versions {
+		if filter.MatchTag(append([]string{v.Version}, v.Tags...)...) {
+			versions = append(versions, v)
+		}
+	}
+	if len(versions) == 0 {
+		return nil, evalerrors.NewErrEvaluationFailed("no applicable package versions found")
+	}
+
+	artifactVerifier, err := getVerifier(ctx, i, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error getting verifier: %w", err)
+	}
+
+	var result []map[string]any
+	for _, v := range versions {
+		integrity, err := registry.GetPackageIntegrity(ctx, pkg.Name, v)
+		if err != nil {
+			return nil, fmt.Errorf("failed getting integrity of %s@%s: %w", pkg.Name, v.Version, err)
+		}
+
+		meta := newPackageVersion(pkg, v, integrity)
+		if len(integrity.Attestations) == 0 {
+			zerolog.Ctx(ctx).Debug().Str("name", pkg.Name).Str("version", v.Version).
+				Msg("no attestations found for package version")
+			result = append(result, map[string]any{
+				"Verification": verification{digest: v.Version},
+				"Package":      meta,
+			})
+			continue
+		}
+
+		for _, att := range integrity.Attestations {
+			sigBundle := &bundle.Bundle{}
+			if err := sigBundle.UnmarshalJSON(att.Bundle); err != nil {
+				zerolog.Ctx(ctx).Debug().Err(err).Str("name", pkg.Name).Str("version", v.Version).
+					Msg("error parsing attestation bundle")
+				result = append(result, map[string]any{
+					"Verification": verification{IsSigned: true, digest: v.Version},
+					"Package":      meta.withFile(att.File),
+				})
+				continue
+			}
+
+			res := artifactVerifier.VerifyBundle(ctx, sigBundle, att.DigestAlgorithm, att.Digest)
+			verResult := newVerification(ctx, &res, v.Version)
+			verResult.SignedDigest = att.DigestAlgorithm + ":" + hex.EncodeToString(att.Digest)
+			result = append(result, map[string]any{
+				"Verification": *verResult,
+				"Package":      meta.withFile(att.File),
+			})
+		}
+	}
+
+	zerolog.Ctx(ctx).Debug().Any("result", result).Msg("ingestion result")
+
+	return result, nil
+}
+
+func newPackageVersion(
+	pkg *provifv1.Package, v *provifv1.PackageVersion, integrity *provifv1.PackageIntegrity,
+) *packageVersion {
+	out := &packageVersion{
+		Name:                      pkg.Name,
+		Version:                   v.Version,
+		Tags:                      slices.Clone(v.Tags),
+		Publisher:                 v.Publisher,
+		Maintainers:               slices.Clone(pkg.Maintainers),
+		Deprecated:                v.Deprecated,
+		RegistrySigned:            integrity.RegistrySigned,
+		RegistrySignatureVerified: integrity.RegistrySignatureVerified,
+	}
+	if !v.PublishedAt.IsZero() {
+		out.PublishedAt = v.PublishedAt.Format(time.RFC3339)
+	}
+	// rego handles null lists poorly, always return a list
+	if out.Tags == nil {
+		out.Tags = []string{}
+	}
+	if out.Maintainers == nil {
+		out.Maintainers = []string{}
+	}
+	return out
+}
+
+// withFile returns a copy of the metadata for the given file of the version
+func (p *packageVersion) withFile(file string) *packageVersion {
+	out := *p
+	out.File = file
+	return &out
+}
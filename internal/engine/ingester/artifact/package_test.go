@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sigstore/sigstore-go/pkg/fulcio/certificate"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/verifier/verifyif"
+	mockverify "github.com/mindersec/minder/internal/verifier/verifyif/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+	mockprov "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+// testBundle is a structurally valid sigstore bundle, the mock verifier
+// doesn't check the signature
+const testBundle = `{
+  "mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json",
+  "verificationMaterial": {"publicKey": {"hint": "test"}},
+  "messageSignature": {
+    "messageDigest": {"algorithm": "SHA2_256", "digest": "AAAA"},
+    "signature": "AAAA"
+  }
+}`
+
+func testPackage() *provifv1.Package {
+	return &provifv1.Package{
+		Name:        "pkg",
+		Maintainers: []string{"alice"},
+		Versions: []*provifv1.PackageVersion{
+			{
+				Version:     "1.1.0",
+				Tags:        []string{"latest"},
+				PublishedAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				Publisher:   "alice",
+				Files:       []*provifv1.PackageFile{{Name: "pkg-1.1.0.tgz", DigestAlgorithm: "sha512", Digest: []byte{1}}},
+			},
+			{
+				Version:     "1.0.0",
+				PublishedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Deprecated:  true,
+			},
+		},
+	}
+}
+
+func TestIngestPackage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		params    map[string]any
+		artifact  *pb.Artifact
+		mockSetup func(*mockprov.MockPackageRegistry, *mockverify.MockArtifactVerifier)
+		check     func(t *testing.T, got []map[string]any)
+		errType   error
+	}{
+		{
+			name:     "latest version with verified attestation",
+			params:   map[string]any{"type": "npm"},
+			artifact: &pb.Artifact{Name: "pkg", Type: "npm"},
+			mockSetup: func(reg *mockprov.MockPackageRegistry, ver *mockverify.MockArtifactVerifier) {
+				reg.EXPECT().GetPackage(gomock.Any(), "pkg").Return(testPackage(), nil)
+				reg.EXPECT().GetPackageIntegrity(gomock.Any(), "pkg", gomock.Any()).
+					Return(&provifv1.PackageIntegrity{
+						RegistrySigned:            true,
+						RegistrySignatureVerified: true,
+						Attestations: []*provifv1.PackageAttestation{{
+							File:            "pkg-1.1.0.tgz",
+							DigestAlgorithm: "sha512",
+							Digest:          []byte{1},
+							Bundle:          []byte(testBundle),
+						}},
+					}, nil)
+				ver.EXPECT().VerifyBundle(gomock.Any(), gomock.Any(), "sha512", []byte{1}).
+					Return(verifyif.Result{
+						IsSigned:   true,
+						IsVerified: true,
+						VerificationResult: verify.VerificationResult{
+							Signature: &verify.SignatureVerificationResult{
+								Certificate: &certificate.Summary{
+									SubjectAlternativeName: "https://github.com/example/pkg/.github/workflows/release.yaml@refs/heads/main",
+									Extensions: certificate.Extensions{
+										Issuer:              githubTokenIssuer,
+										SourceRepositoryURI: "https://github.com/example/pkg",
+										SourceRepositoryRef: "refs/heads/main",
+									},
+								},
+							},
+						},
+					})
+			},
+			check: func(t *testing.T, got []map[string]any) {
+				t.Helper()
+				require.Len(t, got, 1)
+
+				v, ok := got[0]["Verification"].(verification)
+				require.True(t, ok)
+				require.True(t, v.IsVerified)
+				require.Equal(t, "https://github.com/example/pkg", v.Repository)
+				require.Equal(t, "main", v.Branch)
+				require.Equal(t, "/.github/workflows/release.yaml", v.SignerIdentity)
+				require.Equal(t, "sha512:01", v.SignedDigest)
+
+				p, ok := got[0]["Package"].(*packageVersion)
+				require.True(t, ok)
+				require.Equal(t, "1.1.0", p.Version)
+				require.Equal(t, "2024-02-01T00:00:00Z", p.PublishedAt)
+				require.Equal(t, []string{"alice"}, p.Maintainers)
+				require.Equal(t, "pkg-1.1.0.tgz", p.File)
+				require.True(t, p.RegistrySignatureVerified)
+			},
+		},
+		{
+			name:     "version without attestations",
+			params:   map[string]any{"type": "npm", "tags": []string{"1.0.0"}},
+			artifact: &pb.Artifact{Name: "pkg", Type: "npm"},
+			mockSetup: func(reg *mockprov.MockPackageRegistry, _ *mockverify.MockArtifactVerifier) {
+				reg.EXPECT().GetPackage(gomock.Any(), "pkg").Return(testPackage(), nil)
+				reg.EXPECT().GetPackageIntegrity(gomock.Any(), "pkg", gomock.Any()).
+					Return(&provifv1.PackageIntegrity{}, nil)
+			},
+			check: func(t *testing.T, got []map[string]any) {
+				t.Helper()
+				require.Len(t, got, 1)
+
+				v, ok := got[0]["Verification"].(verification)
+				require.True(t, ok)
+				require.False(t, v.IsSigned)
+
+				p, ok := got[0]["Package"].(*packageVersion)
+				require.True(t, ok)
+				require.Equal(t, "1.0.0", p.Version)
+				require.True(t, p.Deprecated)
+				require.Empty(t, p.Tags)
+			},
+		},
+		{
+			name:     "no matching version",
+			params:   map[string]any{"type": "npm", "tags": []string{"next"}},
+			artifact: &pb.Artifact{Name: "pkg", Type: "npm"},
+			mockSetup: func(reg *mockprov.MockPackageRegistry, _ *mockverify.MockArtifactVerifier) {
+				reg.EXPECT().GetPackage(gomock.Any(), "pkg").Return(testPackage(), nil)
+			},
+			errType: interfaces.ErrEvaluationFailed,
+		},
+		{
+			name:      "type mismatch",
+			params:    map[string]any{"type": "pypi"},
+			artifact:  &pb.Artifact{Name: "pkg", Type: "npm"},
+			mockSetup: func(*mockprov.MockPackageRegistry, *mockverify.MockArtifactVerifier) {},
+			errType:   evalerrors.ErrEvaluationSkipSilently,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			reg := mockprov.NewMockPackageRegistry(ctrl)
+			ver := mockverify.NewMockArtifactVerifier(ctrl)
+			tt.mockSetup(reg, ver)
+
+			ing, err := NewArtifactDataIngest(&pb.ArtifactType{}, reg)
+			require.NoError(t, err)
+			ing.artifactVerifier = ver
+
+			got, err := ing.Ingest(context.Background(), tt.artifact, tt.params)
+			if tt.errType != nil {
+				require.ErrorIs(t, err, tt.errType)
+				return
+			}
+			require.NoError(t, err)
+
+			res, ok := got.Object.([]map[string]any)
+			require.True(t, ok)
+			tt.check(t, res)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package collaborators provides the collaborators rule data ingest engine,
+// which collects the collaborators and teams having access to a repository.
+package collaborators
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v63/github"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	// CollaboratorsRuleDataIngestType is the type of the collaborators rule data ingest engine
+	CollaboratorsRuleDataIngestType = "collaborators"
+
+	affiliationAll     = "all"
+	affiliationOutside = "outside"
+
+	// KindUser is the kind of the principals which are users
+	KindUser = "user"
+	// KindTeam is the kind of the principals which are teams
+	KindTeam = "team"
+)
+
+// rolesByPrivilege are the repository roles, from the most to the least
+// privileged, along with the legacy permission names GitHub reports for them.
+var rolesByPrivilege = []struct {
+	role       string
+	permission string
+}{
+	{role: "admin", permission: "admin"},
+	{role: "maintain", permission: "maintain"},
+	{role: "write", permission: "push"},
+	{role: "triage", permission: "triage"},
+	{role: "read", permission: "pull"},
+}
+
+// Collaborators is the engine for a rule type that uses collaborators data ingest
+type Collaborators struct {
+	cfg    *pb.CollaboratorsType
+	lister interfaces.GitHubCollaboratorLister
+}
+
+// NewCollaboratorsIngester creates a new collaborators rule data ingest engine
+func NewCollaboratorsIngester(
+	cfg *pb.CollaboratorsType, lister interfaces.GitHubCollaboratorLister,
+) (*Collaborators, error) {
+	if lister == nil {
+		return nil, fmt.Errorf("provider is nil")
+	}
+
+	if cfg == nil {
+		cfg = &pb.CollaboratorsType{}
+	}
+
+	return &Collaborators{
+		cfg:    cfg,
+		lister: lister,
+	}, nil
+}
+
+// GetType returns the type of the collaborators rule data ingest engine
+func (*Collaborators) GetType() string {
+	return CollaboratorsRuleDataIngestType
+}
+
+// GetConfig returns the config for the collaborators rule data ingest engine
+func (c *Collaborators) GetConfig() protoreflect.ProtoMessage {
+	return c.cfg
+}
+
+// Ingest lists the collaborators and teams of the repository. The ingested
+// object contains the "collaborators", each with its "login", "id", "role"
+// and whether it is an "outside" collaborator of the organization, the
+// "teams", each with its "slug", "name", "id" and "role", and the
+// "principals", which combine both with their "kind" ("user" or "team"),
+// "name" and "role", so that rules can report per-principal findings.
+// Roles are normalized to read, triage, write, maintain or admin.
+func (c *Collaborators) Ingest(
+	ctx context.Context, ent protoreflect.ProtoMessage, _ map[string]any,
+) (*interfaces.Ingested, error) {
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("%w: collaborators is only supported for repositories",
+			evalerrors.ErrIngestionNotApplicable)
+	}
+
+	owner, name := repo.GetOwner(), repo.GetName()
+	affiliation := cmp.Or(c.cfg.GetAffiliation(), affiliationAll)
+
+	users, err := c.lister.ListCollaborators(ctx, owner, name, affiliation)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list collaborators of %s/%s: %w", owner, name, err)
+	}
+
+	outside := make(map[int64]bool)
+	if affiliation != affiliationOutside {
+		outsideUsers, err := c.lister.ListCollaborators(ctx, owner, name, affiliationOutside)
+		if err != nil {
+			return nil, fmt.Errorf("cannot list outside collaborators of %s/%s: %w", owner, name, err)
+		}
+		for _, u := range outsideUsers {
+			outside[u.GetID()] = true
+		}
+	}
+
+	teams, err := c.lister.ListRepositoryTeams(ctx, owner, name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list teams of %s/%s: %w", owner, name, err)
+	}
+
+	collaborators := make([]any, 0, len(users))
+	teamList := make([]any, 0, len(teams))
+	principals := make([]any, 0, len(users)+len(teams))
+	for _, u := range users {
+		role := userRole(u)
+		isOutside := affiliation == affiliationOutside || outside[u.GetID()]
+		collaborators = append(collaborators, map[string]any{
+			"login":   u.GetLogin(),
+			"id":      u.GetID(),
+			"role":    role,
+			"outside": isOutside,
+		})
+		principals = append(principals, map[string]any{
+			"kind":    KindUser,
+			"name":    u.GetLogin(),
+			"role":    role,
+			"outside": isOutside,
+		})
+	}
+	for _, t := range teams {
+		role := teamRole(t)
+		teamList = append(teamList, map[string]any{
+			"slug": t.GetSlug(),
+			"name": t.GetName(),
+			"id":   t.GetID(),
+			"role": role,
+		})
+		principals = append(principals, map[string]any{
+			"kind":    KindTeam,
+			"name":    t.GetSlug(),
+			"role":    role,
+			"outside": false,
+		})
+	}
+
+	return &interfaces.Ingested{
+		Object: map[string]any{
+			"collaborators": collaborators,
+			"teams":         teamList,
+			"principals":    principals,
+		},
+	}, nil
+}
+
+// userRole returns the role of a collaborator. Custom roles are reported as
+// is; otherwise the role is derived from the permissions, since the role name
+// isn't always set.
+func userRole(u *github.User) string {
+	if name := u.GetRoleName(); name != "" {
+		return normalizeRole(name)
+	}
+	return roleFromPermissions(u.GetPermissions())
+}
+
+// teamRole returns the role of a team.
+func teamRole(t *github.Team) string {
+	if perms := t.GetPermissions(); len(perms) > 0 {
+		return roleFromPermissions(perms)
+	}
+	return normalizeRole(t.GetPermission())
+}
+
+// roleFromPermissions returns the most privileged role granted by the
+// permissions.
+func roleFromPermissions(perms map[string]bool) string {
+	for _, r := range rolesByPrivilege {
+		if perms[r.permission] {
+			return r.role
+		}
+	}
+	return ""
+}
+
+// normalizeRole maps the legacy permission names to the role names.
+func normalizeRole(permission string) string {
+	for _, r := range rolesByPrivilege {
+		if permission == r.permission {
+			return r.role
+		}
+	}
+	return permission
+}
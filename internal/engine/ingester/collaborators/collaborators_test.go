@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collaborators
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/util/ptr"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+type fakeLister struct {
+	users map[string][]*github.User
+	teams []*github.Team
+	err   error
+}
+
+func (f *fakeLister) ListCollaborators(_ context.Context, _, _, affiliation string) ([]*github.User, error) {
+	return f.users[affiliation], f.err
+}
+
+func (f *fakeLister) ListRepositoryTeams(_ context.Context, _, _ string) ([]*github.Team, error) {
+	return f.teams, f.err
+}
+
+func TestIngest(t *testing.T) {
+	t.Parallel()
+
+	maintainer := &github.User{
+		Login:    ptr.Ptr("maintainer"),
+		ID:       ptr.Ptr(int64(1)),
+		RoleName: ptr.Ptr("maintain"),
+	}
+	contractor := &github.User{
+		Login:       ptr.Ptr("contractor"),
+		ID:          ptr.Ptr(int64(2)),
+		Permissions: map[string]bool{"admin": true, "push": true, "pull": true},
+	}
+	lister := &fakeLister{
+		users: map[string][]*github.User{
+			"all":     {maintainer, contractor},
+			"outside": {contractor},
+		},
+		teams: []*github.Team{{
+			Slug:       ptr.Ptr("devs"),
+			Name:       ptr.Ptr("Developers"),
+			ID:         ptr.Ptr(int64(3)),
+			Permission: ptr.Ptr("push"),
+		}},
+	}
+
+	ing, err := NewCollaboratorsIngester(nil, lister)
+	require.NoError(t, err)
+
+	got, err := ing.Ingest(context.Background(), &pb.Repository{Owner: "mindersec", Name: "minder"}, nil)
+	require.NoError(t, err)
+
+	obj := got.Object.(map[string]any)
+	require.Equal(t, []any{
+		map[string]any{"login": "maintainer", "id": int64(1), "role": "maintain", "outside": false},
+		map[string]any{"login": "contractor", "id": int64(2), "role": "admin", "outside": true},
+	}, obj["collaborators"])
+	require.Equal(t, []any{
+		map[string]any{"slug": "devs", "name": "Developers", "id": int64(3), "role": "write"},
+	}, obj["teams"])
+	require.Equal(t, []any{
+		map[string]any{"kind": KindUser, "name": "maintainer", "role": "maintain", "outside": false},
+		map[string]any{"kind": KindUser, "name": "contractor", "role": "admin", "outside": true},
+		map[string]any{"kind": KindTeam, "name": "devs", "role": "write", "outside": false},
+	}, obj["principals"])
+}
+
+func TestIngestOutsideOnly(t *testing.T) {
+	t.Parallel()
+
+	lister := &fakeLister{
+		users: map[string][]*github.User{
+			"outside": {{Login: ptr.Ptr("contractor"), RoleName: ptr.Ptr("read")}},
+		},
+	}
+
+	ing, err := NewCollaboratorsIngester(&pb.CollaboratorsType{Affiliation: "outside"}, lister)
+	require.NoError(t, err)
+
+	got, err := ing.Ingest(context.Background(), &pb.Repository{Owner: "mindersec", Name: "minder"}, nil)
+	require.NoError(t, err)
+
+	obj := got.Object.(map[string]any)
+	require.Equal(t, []any{
+		map[string]any{"login": "contractor", "id": int64(0), "role": "read", "outside": true},
+	}, obj["collaborators"])
+	require.Empty(t, obj["teams"])
+}
+
+func TestIngestErrors(t *testing.T) {
+	t.Parallel()
+
+	ing, err := NewCollaboratorsIngester(nil, &fakeLister{err: errors.New("boom")})
+	require.NoError(t, err)
+
+	_, err = ing.Ingest(context.Background(), &pb.Artifact{}, nil)
+	require.ErrorIs(t, err, evalerrors.ErrIngestionNotApplicable)
+
+	_, err = ing.Ingest(context.Background(), &pb.Repository{Owner: "mindersec", Name: "minder"}, nil)
+	require.ErrorContains(t, err, "boom")
+
+	_, err = NewCollaboratorsIngester(nil, nil)
+	require.Error(t, err)
+}
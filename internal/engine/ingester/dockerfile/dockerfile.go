@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dockerfile provides the dockerfile rule data ingest engine, which
+// locates and parses the Dockerfiles of a repository.
+package dockerfile
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-viper/mapstructure/v2"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	gitingester "github.com/mindersec/minder/internal/engine/ingester/git"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	// DockerfileRuleDataIngestType is the type of the dockerfile rule data ingest engine
+	DockerfileRuleDataIngestType = "dockerfile"
+
+	// MaxFileBytes is the maximum size of the Dockerfiles parsed. Larger
+	// files are reported with an error.
+	MaxFileBytes int64 = 256 << 10
+
+	// MaxFiles is the maximum number of Dockerfiles parsed in a repository
+	MaxFiles = 100
+
+	defaultBranch = "main"
+)
+
+// skipDirs are the directories which are not searched for Dockerfiles
+var skipDirs = []string{".git", "node_modules", "vendor"}
+
+// IngesterConfig is the profile-provided configuration for the dockerfile
+// ingester
+type IngesterConfig struct {
+	Branch string `json:"branch" yaml:"branch" mapstructure:"branch"`
+	// BuildArgs override the default values of the ARG instructions, which
+	// are substituted in the FROM instructions
+	BuildArgs map[string]string `json:"build_args" yaml:"build_args" mapstructure:"build_args"`
+}
+
+// Dockerfile is the engine for a rule type that uses dockerfile data ingest
+type Dockerfile struct {
+	cfg     *pb.DockerfileType
+	gitprov interfaces.GitProvider
+}
+
+// NewDockerfileIngester creates a new dockerfile rule data ingest engine
+func NewDockerfileIngester(cfg *pb.DockerfileType, gitprov interfaces.GitProvider) (*Dockerfile, error) {
+	if gitprov == nil {
+		return nil, fmt.Errorf("provider is nil")
+	}
+
+	if cfg == nil {
+		cfg = &pb.DockerfileType{}
+	}
+
+	return &Dockerfile{
+		cfg:     cfg,
+		gitprov: gitprov,
+	}, nil
+}
+
+// GetType returns the type of the dockerfile rule data ingest engine
+func (*Dockerfile) GetType() string {
+	return DockerfileRuleDataIngestType
+}
+
+// GetConfig returns the config for the dockerfile rule data ingest engine
+func (d *Dockerfile) GetConfig() protoreflect.ProtoMessage {
+	return d.cfg
+}
+
+// Ingest clones the repository and parses its Dockerfiles. The ingested
+// object contains the parsed "files", each with its "path", its "stages" and
+// the "error" if it can't be parsed, and the flattened list of the external
+// "base_images" of all the files, each with the "path" and "line" of its FROM
+// instruction. The cloned filesystem is exposed as well, so rules can still
+// use the file functions.
+func (d *Dockerfile) Ingest(
+	ctx context.Context, ent protoreflect.ProtoMessage, params map[string]any,
+) (*interfaces.Ingested, error) {
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("%w: dockerfile is only supported for repositories",
+			evalerrors.ErrIngestionNotApplicable)
+	}
+
+	userCfg := &IngesterConfig{}
+	if err := mapstructure.Decode(params, userCfg); err != nil {
+		return nil, fmt.Errorf("failed to read dockerfile ingester configuration from params: %w", err)
+	}
+
+	if repo.GetCloneUrl() == "" {
+		return nil, fmt.Errorf("could not get clone url")
+	}
+
+	branch := cmp.Or(userCfg.Branch, d.cfg.GetBranch(), repo.GetDefaultBranch(), defaultBranch)
+	ingested, err := gitingester.Checkout(ctx, d.gitprov, repo.GetCloneUrl(), branch)
+	if err != nil {
+		return nil, err
+	}
+
+	ingested.Object, err = collect(ingested.Fs, d.cfg.GetPaths(), userCfg.BuildArgs)
+	if err != nil {
+		return nil, err
+	}
+	return ingested, nil
+}
+
+// collect finds and parses the Dockerfiles of the filesystem and returns the
+// normalized ingested object
+func collect(fs billy.Filesystem, patterns []string, buildArgs map[string]string) (map[string]any, error) {
+	paths, err := findDockerfiles(fs, patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*File, 0, len(paths))
+	baseImages := make([]*BaseImageRef, 0)
+	for _, p := range paths {
+		f := parseFile(fs, p, buildArgs)
+		files = append(files, f)
+		for _, st := range f.Stages {
+			if st.Base == nil || st.Base.Scratch {
+				continue
+			}
+			baseImages = append(baseImages, &BaseImageRef{
+				BaseImage: *st.Base,
+				Path:      f.Path,
+				Stage:     st.Index,
+				StageName: st.Name,
+				Line:      st.Line,
+				Final:     st.Final,
+			})
+		}
+	}
+
+	return map[string]any{
+		"files":       files,
+		"base_images": baseImages,
+	}, nil
+}
+
+// findDockerfiles walks the filesystem for the Dockerfiles, optionally
+// restricted to the paths matching the patterns, in lexical order
+func findDockerfiles(fs billy.Filesystem, patterns []string) ([]string, error) {
+	var out []string
+	err := gitingester.WalkFiles(fs, skipDirs, func(p string) bool {
+		if isDockerfile(path.Base(p)) && gitingester.MatchesAny(p, patterns) {
+			out = append(out, p)
+		}
+		return len(out) >= MaxFiles
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// isDockerfile returns whether the file name is one of the usual names of
+// Dockerfiles: Dockerfile, Dockerfile.<suffix>, <prefix>.Dockerfile, and the
+// same for Containerfile
+func isDockerfile(filename string) bool {
+	filename = strings.ToLower(filename)
+	for _, base := range []string{"dockerfile", "containerfile"} {
+		if filename == base || strings.HasPrefix(filename, base+".") || strings.HasSuffix(filename, "."+base) {
+			return true
+		}
+	}
+	return false
+}
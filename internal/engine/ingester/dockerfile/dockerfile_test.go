@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerfile
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/engine/ingester/ingestertest"
+	"github.com/mindersec/minder/internal/providers/credentials"
+	"github.com/mindersec/minder/internal/providers/testproviders"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+const goDigest = "sha256:254db0fb64a77d55007f54b1cfb8c3dc722afb404e3dce28e3b46899bce3aacf"
+
+const multiStage = `# syntax=docker/dockerfile:1
+ARG GO_VERSION=1.22
+ARG DISTROLESS
+FROM --platform=$BUILDPLATFORM golang:${GO_VERSION}@` + goDigest + ` AS builder
+USER build
+RUN go build ./...
+
+FROM builder AS test
+RUN go test ./...
+
+FROM gcr.io/distroless/static${DISTROLESS}
+COPY --from=builder /app /app
+USER 65534:65534
+USER nonroot
+`
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"Dockerfile":                  multiStage,
+		"build/Containerfile.release": "FROM scratch\nCOPY app /app\n",
+		"docs/prod.dockerfile":        "FROM localhost:5000/tools/lint:v1\n",
+		"node_modules/x/Dockerfile":   "FROM node\n",
+		"README.md":                   "FROM ubuntu\n",
+	})
+
+	got, err := collect(fs, nil, nil)
+	require.NoError(t, err)
+
+	files := got["files"].([]*File)
+	require.Len(t, files, 3)
+	require.Equal(t, "Dockerfile", files[0].Path)
+	require.Equal(t, "build/Containerfile.release", files[1].Path)
+	require.Equal(t, "docs/prod.dockerfile", files[2].Path)
+
+	stages := files[0].Stages
+	require.Len(t, stages, 3)
+
+	builder := stages[0]
+	require.Equal(t, "builder", builder.Name)
+	require.Equal(t, 4, builder.Line)
+	require.Equal(t, "$BUILDPLATFORM", builder.Platform)
+	require.Equal(t, "golang:1.22@"+goDigest, builder.From)
+	require.Equal(t, &BaseImage{
+		Ref:        "golang:1.22@" + goDigest,
+		Name:       "docker.io/library/golang",
+		Registry:   "docker.io",
+		Repository: "library/golang",
+		Tag:        "1.22",
+		Digest:     goDigest,
+		Pinned:     true,
+	}, builder.Base)
+	require.Equal(t, "build", builder.User)
+	require.False(t, builder.Final)
+
+	test := stages[1]
+	require.Equal(t, "builder", test.FromStage)
+	require.Nil(t, test.Base)
+	require.Same(t, builder.Base, test.Root)
+	require.Equal(t, "build", test.User)
+	require.Empty(t, test.Users)
+
+	final := stages[2]
+	require.True(t, final.Final)
+	require.Equal(t, "gcr.io/distroless/static", final.Base.Name)
+	require.False(t, final.Base.Pinned)
+	require.True(t, final.Base.Unresolved)
+	require.Equal(t, "nonroot", final.User)
+	require.Equal(t, []*UserInstruction{
+		{User: "65534:65534", Line: 13},
+		{User: "nonroot", Line: 14},
+	}, final.Users)
+
+	require.True(t, files[1].Stages[0].Base.Scratch)
+
+	baseImages := got["base_images"].([]*BaseImageRef)
+	require.Len(t, baseImages, 3)
+	require.Equal(t, "Dockerfile", baseImages[0].Path)
+	require.Equal(t, "builder", baseImages[0].StageName)
+	require.Equal(t, "gcr.io/distroless/static", baseImages[1].Name)
+	require.Equal(t, 2, baseImages[1].Stage)
+	require.True(t, baseImages[1].Final)
+	require.Equal(t, &BaseImageRef{
+		BaseImage: BaseImage{
+			Ref:        "localhost:5000/tools/lint:v1",
+			Name:       "localhost:5000/tools/lint",
+			Registry:   "localhost:5000",
+			Repository: "tools/lint",
+			Tag:        "v1",
+		},
+		Path:  "docs/prod.dockerfile",
+		Line:  1,
+		Final: true,
+	}, baseImages[2])
+}
+
+func TestCollectPaths(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"Dockerfile":            "FROM alpine\n",
+		"images/api/Dockerfile": "FROM alpine\n",
+		"images/web/Dockerfile": "FROM alpine\n",
+	})
+
+	got, err := collect(fs, []string{"images/*/Dockerfile"}, nil)
+	require.NoError(t, err)
+
+	files := got["files"].([]*File)
+	require.Len(t, files, 2)
+	require.Equal(t, "images/api/Dockerfile", files[0].Path)
+	require.Equal(t, "images/web/Dockerfile", files[1].Path)
+}
+
+func TestCollectBuildArgs(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"Dockerfile": "ARG BASE=debian:12\nFROM ${BASE}\nARG IGNORED=ubuntu\nFROM $IGNORED\n",
+	})
+
+	got, err := collect(fs, nil, map[string]string{"BASE": "alpine:3.20", "IGNORED": "busybox"})
+	require.NoError(t, err)
+
+	stages := got["files"].([]*File)[0].Stages
+	require.Equal(t, "docker.io/library/alpine", stages[0].Base.Name)
+	require.Equal(t, "3.20", stages[0].Base.Tag)
+	require.False(t, stages[0].Base.Unresolved)
+	// ARG instructions after the first FROM don't apply to FROM
+	require.True(t, stages[1].Base.Unresolved)
+}
+
+func TestCollectErrors(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"Dockerfile":     "FROM alpine\nRUN echo \\",
+		"big.Dockerfile": "FROM alpine\n" + strings.Repeat("# padding\n", int(MaxFileBytes)/10),
+		"ok.Dockerfile":  "FROM alpine\n",
+	})
+
+	got, err := collect(fs, nil, nil)
+	require.NoError(t, err)
+
+	files := got["files"].([]*File)
+	require.Len(t, files, 3)
+	require.NotEmpty(t, files[1].Error)
+	require.Empty(t, files[1].Stages)
+	require.Empty(t, files[2].Error)
+	require.Len(t, got["base_images"], 2)
+}
+
+func TestParseImageRef(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ref        string
+		name       string
+		tag        string
+		digest     string
+		pinned     bool
+		repository string
+	}{
+		{ref: "ubuntu", name: "docker.io/library/ubuntu", repository: "library/ubuntu"},
+		{ref: "bitnami/redis:7", name: "docker.io/bitnami/redis", tag: "7", repository: "bitnami/redis"},
+		{ref: "index.docker.io/library/node:20", name: "docker.io/library/node", tag: "20", repository: "library/node"},
+		{ref: "ghcr.io/org/app:v1@" + goDigest, name: "ghcr.io/org/app", tag: "v1", digest: goDigest, pinned: true, repository: "org/app"},
+		{ref: "quay.io/org/app@sha256:abc", name: "quay.io/org/app", digest: "sha256:abc", repository: "org/app"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			t.Parallel()
+
+			img := parseImageRef(tt.ref)
+			require.Equal(t, tt.name, img.Name)
+			require.Equal(t, tt.repository, img.Repository)
+			require.Equal(t, tt.tag, img.Tag)
+			require.Equal(t, tt.digest, img.Digest)
+			require.Equal(t, tt.pinned, img.Pinned)
+		})
+	}
+}
+
+func TestIngestNotApplicable(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewDockerfileIngester(nil, testproviders.NewGitProvider(credentials.NewEmptyCredential()))
+	require.NoError(t, err)
+	require.Equal(t, DockerfileRuleDataIngestType, d.GetType())
+
+	_, err = d.Ingest(context.Background(), &pb.Artifact{Name: "image"}, nil)
+	require.ErrorIs(t, err, evalerrors.ErrIngestionNotApplicable)
+
+	_, err = NewDockerfileIngester(nil, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,270 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerfile
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+	"github.com/moby/buildkit/frontend/dockerfile/shell"
+
+	gitingester "github.com/mindersec/minder/internal/engine/ingester/git"
+)
+
+const (
+	// dockerHubRegistry is the registry of the images without one
+	dockerHubRegistry = "docker.io"
+	// scratchImage is the empty image, which is not pulled
+	scratchImage = "scratch"
+)
+
+// digestRegex matches the digests which pin an image
+var digestRegex = regexp.MustCompile(`^sha(256:[a-f0-9]{64}|384:[a-f0-9]{96}|512:[a-f0-9]{128})$`)
+
+// File is a parsed Dockerfile
+type File struct {
+	// Path is the path of the Dockerfile in the repository
+	Path string `json:"path"`
+	// Error is the reason the Dockerfile couldn't be parsed, if any
+	Error string `json:"error"`
+	// Stages are the build stages, in order
+	Stages []*Stage `json:"stages"`
+}
+
+// Stage is a build stage of a Dockerfile, starting at a FROM instruction
+type Stage struct {
+	Index int `json:"index"`
+	// Name is the name given to the stage with AS, if any
+	Name string `json:"name"`
+	// Line is the line of the FROM instruction
+	Line int `json:"line"`
+	// From is the argument of the FROM instruction, after the substitution
+	// of the ARG values
+	From     string `json:"from"`
+	Platform string `json:"platform"`
+	// FromStage is the name of the earlier stage the stage is built from, if
+	// any. Base is nil for these stages.
+	FromStage string `json:"from_stage"`
+	// Base is the image the stage is built from
+	Base *BaseImage `json:"base"`
+	// Root is the image the chain of stages the stage is built from starts
+	// with
+	Root *BaseImage `json:"root"`
+	// User is the user set by the last USER instruction of the stage, or of
+	// the stages it is built from. It is empty if no USER instruction sets
+	// it, and the user of the base image applies.
+	User string `json:"user"`
+	// Users are the USER instructions of the stage
+	Users []*UserInstruction `json:"users"`
+	// Final is whether the stage is the last one, which is the one built
+	// unless a target is selected
+	Final bool `json:"final"`
+}
+
+// UserInstruction is a USER instruction of a stage
+type UserInstruction struct {
+	User string `json:"user"`
+	Line int    `json:"line"`
+}
+
+// BaseImage is a normalized image reference of a FROM instruction
+type BaseImage struct {
+	// Ref is the image reference, as written
+	Ref string `json:"ref"`
+	// Name is the full name of the image, e.g. docker.io/library/golang
+	Name       string `json:"name"`
+	Registry   string `json:"registry"`
+	Repository string `json:"repository"`
+	Tag        string `json:"tag"`
+	Digest     string `json:"digest"`
+	// Pinned is whether the image is referenced by a valid digest
+	Pinned bool `json:"pinned"`
+	// Scratch is whether the image is the empty scratch image
+	Scratch bool `json:"scratch"`
+	// Unresolved is whether the reference uses ARG values which have no
+	// default and aren't set in the build_args parameter
+	Unresolved bool `json:"unresolved"`
+}
+
+// BaseImageRef is an external base image of a Dockerfile, with the location
+// of the FROM instruction referencing it
+type BaseImageRef struct {
+	BaseImage
+	Path      string `json:"path"`
+	Stage     int    `json:"stage"`
+	StageName string `json:"stage_name"`
+	Line      int    `json:"line"`
+	Final     bool   `json:"final"`
+}
+
+// argEnv holds the values of the ARG instructions, for their substitution
+type argEnv map[string]string
+
+func (e argEnv) Get(key string) (string, bool) {
+	v, ok := e[key]
+	return v, ok
+}
+
+func (e argEnv) Keys() []string {
+	keys := make([]string, 0, len(e))
+	for k := range e {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// parseFile parses a Dockerfile. Errors are reported in the file, so that a
+// broken Dockerfile doesn't prevent the evaluation of the others.
+func parseFile(fs billy.Filesystem, p string, buildArgs map[string]string) *File {
+	f := &File{
+		Path:   p,
+		Stages: []*Stage{},
+	}
+
+	content, err := gitingester.ReadFile(fs, p, MaxFileBytes)
+	if err != nil {
+		f.Error = err.Error()
+		return f
+	}
+
+	res, err := parser.Parse(bytes.NewReader(content))
+	if err != nil {
+		f.Error = err.Error()
+		return f
+	}
+
+	lex := shell.NewLex(res.EscapeToken)
+	// only the ARG instructions before the first FROM apply to the FROM
+	// instructions
+	globalArgs := argEnv{}
+	stages := map[string]*Stage{}
+	var cur *Stage
+
+	for _, node := range res.AST.Children {
+		switch strings.ToLower(node.Value) {
+		case "arg":
+			if cur != nil {
+				continue
+			}
+			for arg := node.Next; arg != nil; arg = arg.Next {
+				name, value, hasDefault := strings.Cut(arg.Value, "=")
+				if override, ok := buildArgs[name]; ok {
+					globalArgs[name] = override
+					continue
+				}
+				if !hasDefault {
+					continue
+				}
+				if expanded, _, err := lex.ProcessWord(value, globalArgs); err == nil {
+					globalArgs[name] = expanded
+				}
+			}
+		case "from":
+			cur = parseFrom(lex, node, globalArgs, stages, len(f.Stages))
+			f.Stages = append(f.Stages, cur)
+			if cur.Name != "" {
+				stages[strings.ToLower(cur.Name)] = cur
+			}
+		case "user":
+			if cur == nil || node.Next == nil {
+				continue
+			}
+			cur.Users = append(cur.Users, &UserInstruction{
+				User: node.Next.Value,
+				Line: node.StartLine,
+			})
+			cur.User = node.Next.Value
+		}
+	}
+
+	if len(f.Stages) > 0 {
+		f.Stages[len(f.Stages)-1].Final = true
+	}
+
+	return f
+}
+
+func parseFrom(lex *shell.Lex, node *parser.Node, args argEnv, stages map[string]*Stage, index int) *Stage {
+	st := &Stage{
+		Index: index,
+		Line:  node.StartLine,
+		Users: []*UserInstruction{},
+	}
+
+	for _, flag := range node.Flags {
+		if platform, ok := strings.CutPrefix(flag, "--platform="); ok {
+			st.Platform = platform
+		}
+	}
+
+	if node.Next == nil {
+		return st
+	}
+
+	// FROM <image> [AS <name>]
+	from := node.Next.Value
+	if as := node.Next.Next; as != nil && strings.EqualFold(as.Value, "as") && as.Next != nil {
+		st.Name = as.Next.Value
+	}
+
+	res, err := lex.ProcessWordWithMatches(from, args)
+	unresolved := err != nil || len(res.Unmatched) > 0
+	if err == nil {
+		from = res.Result
+	}
+	st.From = from
+
+	if parent, ok := stages[strings.ToLower(from)]; ok {
+		st.FromStage = parent.Name
+		st.Root = parent.Root
+		st.User = parent.User
+		return st
+	}
+
+	st.Base = parseImageRef(from)
+	st.Base.Unresolved = unresolved
+	st.Root = st.Base
+	return st
+}
+
+// parseImageRef normalizes an image reference the way Docker does, e.g.
+// golang:1.22 is docker.io/library/golang with the tag 1.22
+func parseImageRef(ref string) *BaseImage {
+	img := &BaseImage{Ref: ref}
+	if strings.EqualFold(ref, scratchImage) {
+		img.Name = scratchImage
+		img.Scratch = true
+		return img
+	}
+
+	rest := ref
+	if before, digest, ok := strings.Cut(rest, "@"); ok {
+		rest = before
+		img.Digest = digest
+		img.Pinned = digestRegex.MatchString(digest)
+	}
+	if i := strings.LastIndex(rest, ":"); i > strings.LastIndex(rest, "/") {
+		img.Tag = rest[i+1:]
+		rest = rest[:i]
+	}
+
+	img.Registry = dockerHubRegistry
+	img.Repository = rest
+	if first, repo, ok := strings.Cut(rest, "/"); ok && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		img.Registry = first
+		img.Repository = repo
+	}
+	if img.Registry == "index.docker.io" {
+		img.Registry = dockerHubRegistry
+	}
+	if img.Registry == dockerHubRegistry && !strings.Contains(img.Repository, "/") {
+		img.Repository = "library/" + img.Repository
+	}
+	img.Name = img.Registry + "/" + img.Repository
+
+	return img
+}
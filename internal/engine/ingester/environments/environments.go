@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package environments provides the environments rule data ingest engine,
+// which collects the deployment environments of a repository and their
+// protection rules.
+package environments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v63/github"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	// EnvironmentsRuleDataIngestType is the type of the environments rule data ingest engine
+	EnvironmentsRuleDataIngestType = "environments"
+
+	// Branch policies of the environments
+	branchPolicyAll               = "all"
+	branchPolicyProtectedBranches = "protected_branches"
+	branchPolicyCustom            = "custom"
+
+	// Types of the protection rules
+	ruleTypeRequiredReviewers = "required_reviewers"
+	ruleTypeWaitTimer         = "wait_timer"
+)
+
+// Environments is the engine for a rule type that uses environments data ingest
+type Environments struct {
+	cfg    *pb.EnvironmentsType
+	lister interfaces.GitHubEnvironmentLister
+}
+
+// NewEnvironmentsIngester creates a new environments rule data ingest engine
+func NewEnvironmentsIngester(
+	cfg *pb.EnvironmentsType, lister interfaces.GitHubEnvironmentLister,
+) (*Environments, error) {
+	if lister == nil {
+		return nil, fmt.Errorf("provider is nil")
+	}
+
+	if cfg == nil {
+		cfg = &pb.EnvironmentsType{}
+	}
+
+	return &Environments{
+		cfg:    cfg,
+		lister: lister,
+	}, nil
+}
+
+// GetType returns the type of the environments rule data ingest engine
+func (*Environments) GetType() string {
+	return EnvironmentsRuleDataIngestType
+}
+
+// GetConfig returns the config for the environments rule data ingest engine
+func (e *Environments) GetConfig() protoreflect.ProtoMessage {
+	return e.cfg
+}
+
+// Ingest lists the deployment environments of the repository. The ingested
+// object contains the "environments", each with its "name", "id",
+// "html_url", whether admins can bypass the protection rules
+// ("can_admins_bypass"), its "wait_timer" in minutes, its
+// "required_reviewers", each with its "type" ("user" or "team"), "id" and
+// "name", whether reviewers can approve their own deployments
+// ("prevent_self_review"), which branches can deploy to it ("branch_policy",
+// one of "all", "protected_branches" or "custom"), the custom
+// "branch_policies", each with its "name" pattern and "type" ("branch" or
+// "tag"), and whether it is "protected" by any of these rules.
+func (e *Environments) Ingest(
+	ctx context.Context, ent protoreflect.ProtoMessage, _ map[string]any,
+) (*interfaces.Ingested, error) {
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("%w: environments is only supported for repositories",
+			evalerrors.ErrIngestionNotApplicable)
+	}
+
+	owner, name := repo.GetOwner(), repo.GetName()
+
+	envs, err := e.lister.ListEnvironments(ctx, owner, name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list environments of %s/%s: %w", owner, name, err)
+	}
+
+	out := make([]any, 0, len(envs))
+	for _, env := range envs {
+		obj, err := e.environment(ctx, owner, name, env)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, obj)
+	}
+
+	return &interfaces.Ingested{
+		Object: map[string]any{
+			"environments": out,
+		},
+	}, nil
+}
+
+func (e *Environments) environment(
+	ctx context.Context, owner, repo string, env *github.Environment,
+) (map[string]any, error) {
+	waitTimer := 0
+	preventSelfReview := false
+	reviewers := []any{}
+	for _, rule := range env.ProtectionRules {
+		switch rule.GetType() {
+		case ruleTypeWaitTimer:
+			waitTimer = rule.GetWaitTimer()
+		case ruleTypeRequiredReviewers:
+			preventSelfReview = rule.GetPreventSelfReview()
+			for _, r := range rule.Reviewers {
+				reviewers = append(reviewers, reviewer(r))
+			}
+		}
+	}
+
+	branchPolicy := branchPolicyAll
+	branchPolicies := []any{}
+	switch {
+	case env.GetDeploymentBranchPolicy().GetProtectedBranches():
+		branchPolicy = branchPolicyProtectedBranches
+	case env.GetDeploymentBranchPolicy().GetCustomBranchPolicies():
+		branchPolicy = branchPolicyCustom
+		policies, err := e.lister.ListDeploymentBranchPolicies(ctx, owner, repo, env.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("cannot list branch policies of environment %s: %w", env.GetName(), err)
+		}
+		for _, p := range policies {
+			branchPolicies = append(branchPolicies, map[string]any{
+				"name": p.GetName(),
+				"type": p.GetType(),
+			})
+		}
+	}
+
+	return map[string]any{
+		"name":                env.GetName(),
+		"id":                  env.GetID(),
+		"html_url":            env.GetHTMLURL(),
+		"can_admins_bypass":   env.GetCanAdminsBypass(),
+		"wait_timer":          int64(waitTimer),
+		"required_reviewers":  reviewers,
+		"prevent_self_review": preventSelfReview,
+		"branch_policy":       branchPolicy,
+		"branch_policies":     branchPolicies,
+		"protected":           waitTimer > 0 || len(reviewers) > 0 || branchPolicy != branchPolicyAll,
+	}, nil
+}
+
+func reviewer(r *github.RequiredReviewer) map[string]any {
+	switch rev := r.Reviewer.(type) {
+	case *github.User:
+		return map[string]any{"type": "user", "id": rev.GetID(), "name": rev.GetLogin()}
+	case *github.Team:
+		return map[string]any{"type": "team", "id": rev.GetID(), "name": rev.GetSlug()}
+	default:
+		return map[string]any{"type": "", "id": int64(0), "name": ""}
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environments
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/util/ptr"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+type fakeLister struct {
+	envs     []*github.Environment
+	policies map[string][]*github.DeploymentBranchPolicy
+	err      error
+}
+
+func (f *fakeLister) ListEnvironments(_ context.Context, _, _ string) ([]*github.Environment, error) {
+	return f.envs, f.err
+}
+
+func (f *fakeLister) ListDeploymentBranchPolicies(
+	_ context.Context, _, _, environment string,
+) ([]*github.DeploymentBranchPolicy, error) {
+	return f.policies[environment], nil
+}
+
+func TestIngest(t *testing.T) {
+	t.Parallel()
+
+	lister := &fakeLister{
+		envs: []*github.Environment{
+			{
+				Name:            ptr.Ptr("production"),
+				ID:              ptr.Ptr(int64(1)),
+				CanAdminsBypass: ptr.Ptr(false),
+				ProtectionRules: []*github.ProtectionRule{
+					{Type: ptr.Ptr("wait_timer"), WaitTimer: ptr.Ptr(30)},
+					{
+						Type:              ptr.Ptr("required_reviewers"),
+						PreventSelfReview: ptr.Ptr(true),
+						Reviewers: []*github.RequiredReviewer{
+							{Type: ptr.Ptr("User"), Reviewer: &github.User{ID: ptr.Ptr(int64(2)), Login: ptr.Ptr("jdoe")}},
+							{Type: ptr.Ptr("Team"), Reviewer: &github.Team{ID: ptr.Ptr(int64(3)), Slug: ptr.Ptr("release")}},
+						},
+					},
+				},
+				DeploymentBranchPolicy: &github.BranchPolicy{
+					ProtectedBranches:    ptr.Ptr(false),
+					CustomBranchPolicies: ptr.Ptr(true),
+				},
+			},
+			{
+				Name:            ptr.Ptr("staging"),
+				ID:              ptr.Ptr(int64(4)),
+				CanAdminsBypass: ptr.Ptr(true),
+			},
+		},
+		policies: map[string][]*github.DeploymentBranchPolicy{
+			"production": {{Name: ptr.Ptr("release/*"), Type: ptr.Ptr("branch")}},
+		},
+	}
+
+	ing, err := NewEnvironmentsIngester(nil, lister)
+	require.NoError(t, err)
+
+	got, err := ing.Ingest(context.Background(), &pb.Repository{Owner: "mindersec", Name: "minder"}, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]any{
+		"environments": []any{
+			map[string]any{
+				"name":              "production",
+				"id":                int64(1),
+				"html_url":          "",
+				"can_admins_bypass": false,
+				"wait_timer":        int64(30),
+				"required_reviewers": []any{
+					map[string]any{"type": "user", "id": int64(2), "name": "jdoe"},
+					map[string]any{"type": "team", "id": int64(3), "name": "release"},
+				},
+				"prevent_self_review": true,
+				"branch_policy":       "custom",
+				"branch_policies": []any{
+					map[string]any{"name": "release/*", "type": "branch"},
+				},
+				"protected": true,
+			},
+			map[string]any{
+				"name":                "staging",
+				"id":                  int64(4),
+				"html_url":            "",
+				"can_admins_bypass":   true,
+				"wait_timer":          int64(0),
+				"required_reviewers":  []any{},
+				"prevent_self_review": false,
+				"branch_policy":       "all",
+				"branch_policies":     []any{},
+				"protected":           false,
+			},
+		},
+	}, got.Object)
+}
+
+func TestIngestErrors(t *testing.T) {
+	t.Parallel()
+
+	ing, err := NewEnvironmentsIngester(nil, &fakeLister{err: errors.New("boom")})
+	require.NoError(t, err)
+
+	_, err = ing.Ingest(context.Background(), &pb.Artifact{}, nil)
+	require.ErrorIs(t, err, evalerrors.ErrIngestionNotApplicable)
+
+	_, err = ing.Ingest(context.Background(), &pb.Repository{Owner: "mindersec", Name: "minder"}, nil)
+	require.ErrorContains(t, err, "boom")
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Checkout clones a branch of a repository with the provider, for the
+// ingesters reading the files of the repositories. The ingested result has
+// the cloned filesystem and the checkpoint of the head of the branch, the
+// ingester sets its object.
+func Checkout(
+	ctx context.Context, gitprov interfaces.GitProvider, cloneURL, branch string,
+) (*interfaces.Ingested, error) {
+	r, err := gitprov.Clone(ctx, cloneURL, branch)
+	if err != nil {
+		if cerr := cloneError(err, branch); cerr != nil {
+			return nil, cerr
+		}
+		return nil, fmt.Errorf("failed to clone %s from %s: %w", branch, cloneURL, err)
+	}
+
+	wt, err := r.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get worktree: %w", err)
+	}
+
+	head, err := r.Head()
+	if err != nil {
+		return nil, fmt.Errorf("could not get head: %w", err)
+	}
+
+	return &interfaces.Ingested{
+		Fs:     wt.Filesystem,
+		Storer: r.Storer,
+		Checkpoint: checkpoints.NewCheckpointV1Now().
+			WithBranch(branch).
+			WithCommitHash(head.Hash().String()),
+	}, nil
+}
+
+// cloneError returns the evaluation error of a missing branch or an empty
+// repository, or nil for the other clone errors
+func cloneError(err error, branch string) error {
+	if errors.Is(err, provifv1.ErrProviderGitBranchNotFound) {
+		return fmt.Errorf("%w: %s: branch %s", interfaces.ErrEvaluationFailed,
+			provifv1.ErrProviderGitBranchNotFound, branch)
+	} else if errors.Is(err, provifv1.ErrRepositoryEmpty) {
+		return fmt.Errorf("%w: %s", interfaces.ErrEvaluationSkipped, provifv1.ErrRepositoryEmpty)
+	}
+	return nil
+}
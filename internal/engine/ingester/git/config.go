@@ -8,6 +8,7 @@ package git
 // This allows for users to pass in configuration to the ingester
 // in different calls as opposed to having to set it in the rule type.
 type IngesterConfig struct {
-	Branch   string `json:"branch" yaml:"branch" mapstructure:"branch"`
-	CloneURL string `json:"clone_url" yaml:"clone_url" mapstructure:"clone_url"`
+	Branch    string `json:"branch" yaml:"branch" mapstructure:"branch"`
+	CloneURL  string `json:"clone_url" yaml:"clone_url" mapstructure:"clone_url"`
+	DeployKey string `json:"deploy_key" yaml:"deploy_key" mapstructure:"deploy_key"`
 }
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+
+	"github.com/go-git/go-billy/v5"
+)
+
+// ErrFileTooLarge is returned by ReadFile for the files larger than the limit
+var ErrFileTooLarge = errors.New("file is too large")
+
+// WalkFiles walks the regular files of the filesystem in lexical order,
+// skipping the directories named in skipDirs, until the visit function
+// returns true
+func WalkFiles(fs billy.Filesystem, skipDirs []string, visit func(p string) bool) error {
+	var walk func(dir string) (bool, error)
+	walk = func(dir string) (bool, error) {
+		entries, err := fs.ReadDir(cmp.Or(dir, "."))
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		} else if err != nil {
+			return false, fmt.Errorf("cannot read directory %q: %w", dir, err)
+		}
+
+		for _, e := range entries {
+			p := path.Join(dir, e.Name())
+			if e.IsDir() {
+				if slices.Contains(skipDirs, e.Name()) {
+					continue
+				}
+				if done, err := walk(p); done || err != nil {
+					return done, err
+				}
+				continue
+			}
+			if e.Mode().IsRegular() && visit(p) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	_, err := walk("")
+	return err
+}
+
+// MatchesAny returns whether the path matches any of the patterns, or true if
+// there is no pattern
+func MatchesAny(p string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		// patterns are validated with the rule type
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadFile reads a file of the filesystem. The first maxBytes of the larger
+// files are returned along with ErrFileTooLarge.
+func ReadFile(fs billy.Filesystem, name string, maxBytes int64) ([]byte, error) {
+	f, err := fs.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open %q: %w", name, err)
+	}
+	defer f.Close()
+
+	buf, err := io.ReadAll(io.LimitReader(f, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %q: %w", name, err)
+	}
+
+	if int64(len(buf)) > maxBytes {
+		return buf[:maxBytes], fmt.Errorf("%w: %q is larger than %d bytes", ErrFileTooLarge, name, maxBytes)
+	}
+	return buf, nil
+}
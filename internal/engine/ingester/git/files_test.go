@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/engine/ingester/ingestertest"
+)
+
+func TestWalkFiles(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"b.txt":              "",
+		"a/c.txt":            "",
+		"a/b.txt":            "",
+		"vendor/x/d.txt":     "",
+		"node_modules/e.txt": "",
+	})
+
+	var got []string
+	require.NoError(t, WalkFiles(fs, []string{"vendor", "node_modules"}, func(p string) bool {
+		got = append(got, p)
+		return false
+	}))
+	require.Equal(t, []string{"a/b.txt", "a/c.txt", "b.txt"}, got)
+
+	got = nil
+	require.NoError(t, WalkFiles(fs, nil, func(p string) bool {
+		got = append(got, p)
+		return len(got) == 2
+	}))
+	require.Equal(t, []string{"a/b.txt", "a/c.txt"}, got, "the walk stops when the visit returns true")
+}
+
+func TestMatchesAny(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, MatchesAny("a/Dockerfile", nil))
+	require.True(t, MatchesAny("a/Dockerfile", []string{"b/*", "a/*"}))
+	require.False(t, MatchesAny("a/b/Dockerfile", []string{"a/*"}))
+}
+
+func TestReadFile(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"small.txt": "abc",
+		"large.txt": "abcdef",
+	})
+
+	got, err := ReadFile(fs, "small.txt", 3)
+	require.NoError(t, err)
+	require.Equal(t, "abc", string(got))
+
+	got, err = ReadFile(fs, "large.txt", 3)
+	require.ErrorIs(t, err, ErrFileTooLarge)
+	require.Equal(t, "abc", string(got))
+
+	_, err = ReadFile(fs, "missing.txt", 3)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrFileTooLarge)
+}
@@ -9,15 +9,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	neturl "net/url"
 
 	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/storage"
 	"github.com/go-viper/mapstructure/v2"
+	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"github.com/mindersec/minder/internal/deploykeys"
 	pbinternal "github.com/mindersec/minder/internal/proto"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
 	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
@@ -69,7 +74,8 @@ func (gi *Git) Ingest(ctx context.Context, ent protoreflect.ProtoMessage, params
 	case *pbinternal.PullRequest:
 		return gi.ingestPullRequest(ctx, entity, params)
 	default:
-		return nil, fmt.Errorf("git is only supported for repositories and pull requests")
+		return nil, fmt.Errorf("%w: git is only supported for repositories and pull requests",
+			evalerrors.ErrIngestionNotApplicable)
 	}
 }
 
@@ -84,8 +90,18 @@ func (gi *Git) ingestRepository(ctx context.Context, repo *pb.Repository, params
 		return nil, fmt.Errorf("could not get clone url")
 	}
 
+	deployKey := cmp.Or(userCfg.DeployKey, gi.cfg.GetDeployKey())
+	if deployKey != "" {
+		url = sshCloneURL(url)
+	}
+
 	branch := cmp.Or(userCfg.Branch, gi.cfg.Branch, repo.GetDefaultBranch(), defaultBranch)
-	fs, storer, head, err := gi.fetchClone(ctx, url, branch)
+	if deployKey == "" && gi.unchangedSinceCheckpoint(ctx, url, branch) {
+		return nil, evalerrors.NewErrEvaluationSkipSilently(
+			"branch %s of %s is unchanged since the last evaluation", branch, url)
+	}
+
+	fs, storer, head, err := gi.fetchClone(ctx, url, branch, deployKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to clone %s from %s: %w", branch, url, err)
 	}
@@ -104,6 +120,36 @@ func (gi *Git) ingestRepository(ctx context.Context, repo *pb.Repository, params
 	}, nil
 }
 
+// unchangedSinceCheckpoint returns true when the rule type opted into
+// skipping unchanged branches and the head of the branch is still the commit
+// of the checkpoint of the last evaluation. Resolving the head only lists the
+// references of the remote, so the clone is avoided altogether. Any failure
+// to resolve the head falls back to cloning.
+func (gi *Git) unchangedSinceCheckpoint(ctx context.Context, url, branch string) bool {
+	if !gi.cfg.GetSkipUnchanged() {
+		return false
+	}
+
+	previous := checkpoints.PreviousFromContext(ctx)
+	if previous.GetCommitHash() == "" || previous.GetBranch() != branch {
+		return false
+	}
+
+	resolver, ok := gi.gitprov.(interfaces.GitBranchResolver)
+	if !ok {
+		return false
+	}
+
+	head, err := resolver.ResolveBranch(ctx, url, branch)
+	if err != nil {
+		zerolog.Ctx(ctx).Debug().Err(err).Str("branch", branch).
+			Msg("could not resolve branch head, cloning instead")
+		return false
+	}
+
+	return head == previous.GetCommitHash()
+}
+
 func (gi *Git) ingestPullRequest(
 	ctx context.Context, ent *pbinternal.PullRequest, params map[string]any) (*interfaces.Ingested, error) {
 	// TODO: we don't actually have any configuration here.  Do we need to read the configuration?
@@ -119,13 +165,19 @@ func (gi *Git) ingestPullRequest(
 		return nil, fmt.Errorf("could not get PR target branch %q from %q", ent.GetTargetRef(), ent.GetTargetCloneUrl())
 	}
 
-	baseFs, _, _, err := gi.fetchClone(ctx, ent.GetBaseCloneUrl(), ent.GetBaseRef())
+	baseURL, targetURL := ent.GetBaseCloneUrl(), ent.GetTargetCloneUrl()
+	deployKey := cmp.Or(userCfg.DeployKey, gi.cfg.GetDeployKey())
+	if deployKey != "" {
+		baseURL, targetURL = sshCloneURL(baseURL), sshCloneURL(targetURL)
+	}
+
+	baseFs, _, _, err := gi.fetchClone(ctx, baseURL, ent.GetBaseRef(), deployKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to clone base branch %s from %s: %w", ent.GetBaseRef(), ent.GetBaseCloneUrl(), err)
+		return nil, fmt.Errorf("failed to clone base branch %s from %s: %w", ent.GetBaseRef(), baseURL, err)
 	}
-	targetFs, storer, head, err := gi.fetchClone(ctx, ent.GetTargetCloneUrl(), ent.GetTargetRef())
+	targetFs, storer, head, err := gi.fetchClone(ctx, targetURL, ent.GetTargetRef(), deployKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to clone target branch %s from %s: %w", ent.GetTargetRef(), ent.GetTargetCloneUrl(), err)
+		return nil, fmt.Errorf("failed to clone target branch %s from %s: %w", ent.GetTargetRef(), targetURL, err)
 	}
 
 	checkpoint := checkpoints.NewCheckpointV1Now().WithBranch(ent.GetTargetRef()).WithCommitHash(head.Hash().String())
@@ -140,18 +192,15 @@ func (gi *Git) ingestPullRequest(
 }
 
 func (gi *Git) fetchClone(
-	ctx context.Context, url, branch string) (billy.Filesystem, storage.Storer, *plumbing.Reference, error) {
+	ctx context.Context, url, branch, deployKey string) (billy.Filesystem, storage.Storer, *plumbing.Reference, error) {
 	// We clone to the memfs go-billy filesystem driver, which doesn't
 	// allow for direct access to the underlying filesystem. This is
 	// because we want to be able to run this in a sandboxed environment
 	// where we don't have access to the underlying filesystem.
-	r, err := gi.gitprov.Clone(ctx, url, branch)
+	r, err := gi.clone(ctx, url, branch, deployKey)
 	if err != nil {
-		if errors.Is(err, provifv1.ErrProviderGitBranchNotFound) {
-			return nil, nil, nil, fmt.Errorf("%w: %s: branch %s", interfaces.ErrEvaluationFailed,
-				provifv1.ErrProviderGitBranchNotFound, branch)
-		} else if errors.Is(err, provifv1.ErrRepositoryEmpty) {
-			return nil, nil, nil, fmt.Errorf("%w: %s", interfaces.ErrEvaluationSkipped, provifv1.ErrRepositoryEmpty)
+		if cerr := cloneError(err, branch); cerr != nil {
+			return nil, nil, nil, cerr
 		}
 		return nil, nil, nil, err
 	}
@@ -168,3 +217,58 @@ func (gi *Git) fetchClone(
 
 	return wt.Filesystem, r.Storer, head, err
 }
+
+// clone clones the branch with the credential of the provider, or over SSH
+// with the given deploy key of the project
+func (gi *Git) clone(ctx context.Context, url, branch, deployKey string) (*git.Repository, error) {
+	if filter := gi.cloneFilter(); filter != nil {
+		ctx = provifv1.WithCloneFilter(ctx, filter)
+	}
+
+	if deployKey == "" {
+		return gi.gitprov.Clone(ctx, url, branch)
+	}
+
+	cloner, ok := gi.gitprov.(interfaces.GitSSHCloner)
+	if !ok {
+		return nil, fmt.Errorf("%w: the provider can't clone over SSH with deploy keys",
+			evalerrors.ErrIngestionNotApplicable)
+	}
+	signer, err := deploykeys.SignerFromContext(ctx, deployKey)
+	if errors.Is(err, deploykeys.ErrDeployKeyNotFound) {
+		return nil, fmt.Errorf("%w: %w", evalerrors.ErrInvalidRuleParameters, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get deploy key %s: %w", deployKey, err)
+	}
+	return cloner.CloneWithSSHKey(ctx, url, branch, signer)
+}
+
+// cloneFilter returns the filter narrowing down the clones of the rule
+// type, or nil when the whole repository is cloned.
+func (gi *Git) cloneFilter() *provifv1.CloneFilter {
+	if gi.cfg.GetMaxBytes() == 0 && !gi.cfg.GetSkipBinaries() && len(gi.cfg.GetSparsePaths()) == 0 {
+		return nil
+	}
+	return &provifv1.CloneFilter{
+		MaxBytes:     gi.cfg.GetMaxBytes(),
+		SkipBinaries: gi.cfg.GetSkipBinaries(),
+		SparsePaths:  gi.cfg.GetSparsePaths(),
+	}
+}
+
+// sshCloneURL returns the SSH URL of a repository given its HTTP(S) clone
+// URL, e.g. ssh://git@github.com/owner/repo.git for
+// https://github.com/owner/repo.git. Other URLs are returned as is, so that
+// rules and profiles may give the SSH URL of the repository.
+func sshCloneURL(cloneURL string) string {
+	u, err := neturl.Parse(cloneURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return cloneURL
+	}
+	return (&neturl.URL{
+		Scheme: "ssh",
+		User:   neturl.User("git"),
+		Host:   u.Hostname(),
+		Path:   u.Path,
+	}).String()
+}
@@ -6,9 +6,14 @@ package git_test
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/mindersec/minder/internal/deploykeys"
 
 	gitengine "github.com/mindersec/minder/internal/engine/ingester/git"
 	"github.com/mindersec/minder/internal/providers/credentials"
@@ -16,7 +21,9 @@ import (
 	"github.com/mindersec/minder/internal/providers/testproviders"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/config/server"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
 	v1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
@@ -170,6 +177,62 @@ func TestGitIngestWithUnexistentBranchFromParams(t *testing.T) {
 	require.Nil(t, got, "expected non-nil result")
 }
 
+func TestGitIngestSkipsUnchangedBranch(t *testing.T) {
+	t.Parallel()
+
+	gi, err := gitengine.NewGitIngester(
+		&pb.GitType{Branch: "master", SkipUnchanged: true},
+		testproviders.NewGitProvider(credentials.NewEmptyCredential()),
+	)
+	require.NoError(t, err, "expected no error")
+
+	repo := &pb.Repository{CloneUrl: "https://github.com/octocat/Hello-World.git"}
+
+	// without a previous checkpoint the repository is cloned
+	first, err := gi.Ingest(context.Background(), repo, map[string]any{})
+	require.NoError(t, err, "expected no error")
+	require.NotNil(t, first.Fs, "expected non-nil fs")
+
+	// the branch did not move since the previous checkpoint
+	ctx := checkpoints.WithPrevious(context.Background(), first.Checkpoint)
+	got, err := gi.Ingest(ctx, repo, map[string]any{})
+	require.ErrorIs(t, err, evalerrors.ErrEvaluationSkipSilently, "expected silent skip")
+	require.Nil(t, got, "expected nil result")
+
+	// the previous checkpoint is for another commit
+	ctx = checkpoints.WithPrevious(context.Background(),
+		checkpoints.NewCheckpointV1Now().WithBranch("master").WithCommitHash("0000000"))
+	got, err = gi.Ingest(ctx, repo, map[string]any{})
+	require.NoError(t, err, "expected no error")
+	require.NotNil(t, got.Fs, "expected non-nil fs")
+
+	// the previous checkpoint is for another branch
+	ctx = checkpoints.WithPrevious(context.Background(),
+		checkpoints.NewCheckpointV1Now().WithBranch("test").WithCommitHash(first.Checkpoint.GetCommitHash()))
+	got, err = gi.Ingest(ctx, repo, map[string]any{})
+	require.NoError(t, err, "expected no error")
+	require.NotNil(t, got.Fs, "expected non-nil fs")
+}
+
+func TestGitIngestDoesNotSkipUnlessConfigured(t *testing.T) {
+	t.Parallel()
+
+	gi, err := gitengine.NewGitIngester(
+		&pb.GitType{Branch: "master"},
+		testproviders.NewGitProvider(credentials.NewEmptyCredential()),
+	)
+	require.NoError(t, err, "expected no error")
+
+	repo := &pb.Repository{CloneUrl: "https://github.com/octocat/Hello-World.git"}
+	first, err := gi.Ingest(context.Background(), repo, map[string]any{})
+	require.NoError(t, err, "expected no error")
+
+	ctx := checkpoints.WithPrevious(context.Background(), first.Checkpoint)
+	got, err := gi.Ingest(ctx, repo, map[string]any{})
+	require.NoError(t, err, "expected no error")
+	require.NotNil(t, got.Fs, "expected non-nil fs")
+}
+
 func TestGitIngestFailsBecauseOfAuthorization(t *testing.T) {
 	t.Parallel()
 
@@ -257,3 +320,32 @@ func TestGitIngestFailsWhenRepoHasTooManyFiles(t *testing.T) {
 	require.ErrorIs(t, err, v1.ErrRepositoryTooLarge, "expected ErrRepositoryTooLarge")
 	require.Nil(t, got, "expected non-nil result")
 }
+
+func TestGitIngestFailsWithUnknownDeployKey(t *testing.T) {
+	t.Parallel()
+
+	gi, err := gitengine.NewGitIngester(
+		&pb.GitType{Branch: "master", DeployKey: "private-repos"},
+		testproviders.NewGitProvider(credentials.NewEmptyCredential()),
+	)
+	require.NoError(t, err, "expected no error")
+
+	repo := &pb.Repository{CloneUrl: "https://github.com/octocat/Hello-World.git"}
+
+	// the key is looked up among the deploy keys of the evaluated project
+	ctx := deploykeys.WithProjectSigners(context.Background(), noDeployKeys{}, uuid.New())
+	got, err := gi.Ingest(ctx, repo, map[string]any{})
+	require.ErrorIs(t, err, evalerrors.ErrInvalidRuleParameters, "expected invalid rule parameters")
+	require.Nil(t, got, "expected nil result")
+
+	// deploy keys are not available outside of the evaluation of a project
+	got, err = gi.Ingest(context.Background(), repo, map[string]any{})
+	require.ErrorIs(t, err, deploykeys.ErrDeployKeysUnavailable, "expected deploy keys to be unavailable")
+	require.Nil(t, got, "expected nil result")
+}
+
+type noDeployKeys struct{}
+
+func (noDeployKeys) Signer(_ context.Context, _ uuid.UUID, name string) (ssh.Signer, error) {
+	return nil, fmt.Errorf("%w: %s", deploykeys.ErrDeployKeyNotFound, name)
+}
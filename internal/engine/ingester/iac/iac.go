@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package iac provides the iac rule data ingest engine, which parses the
+// Terraform configurations and Kubernetes manifests of a repository.
+package iac
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-viper/mapstructure/v2"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	gitingester "github.com/mindersec/minder/internal/engine/ingester/git"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	// IaCRuleDataIngestType is the type of the iac rule data ingest engine
+	IaCRuleDataIngestType = "iac"
+
+	// FormatTerraform is the format of the Terraform configurations
+	FormatTerraform = "terraform"
+	// FormatKubernetes is the format of the Kubernetes manifests
+	FormatKubernetes = "kubernetes"
+
+	// MaxFileBytes is the maximum size of the files parsed. Larger files are
+	// reported with an error.
+	MaxFileBytes int64 = 1 << 20
+
+	// MaxFiles is the maximum number of files parsed in a repository, for
+	// each format
+	MaxFiles = 200
+
+	defaultBranch = "main"
+)
+
+// skipDirs are the directories which are not searched for files
+var skipDirs = []string{".git", ".terraform", "node_modules", "vendor"}
+
+// IngesterConfig is the profile-provided configuration for the iac ingester
+type IngesterConfig struct {
+	Branch string `json:"branch" yaml:"branch" mapstructure:"branch"`
+}
+
+// FileResult is a file parsed by the ingester
+type FileResult struct {
+	Path string `json:"path"`
+	// Error is the reason the file couldn't be parsed, if any
+	Error string `json:"error"`
+}
+
+// IaC is the engine for a rule type that uses iac data ingest
+type IaC struct {
+	cfg     *pb.IaCType
+	gitprov interfaces.GitProvider
+}
+
+// NewIaCIngester creates a new iac rule data ingest engine
+func NewIaCIngester(cfg *pb.IaCType, gitprov interfaces.GitProvider) (*IaC, error) {
+	if gitprov == nil {
+		return nil, fmt.Errorf("provider is nil")
+	}
+
+	if cfg == nil {
+		cfg = &pb.IaCType{}
+	}
+
+	return &IaC{
+		cfg:     cfg,
+		gitprov: gitprov,
+	}, nil
+}
+
+// GetType returns the type of the iac rule data ingest engine
+func (*IaC) GetType() string {
+	return IaCRuleDataIngestType
+}
+
+// GetConfig returns the config for the iac rule data ingest engine
+func (i *IaC) GetConfig() protoreflect.ProtoMessage {
+	return i.cfg
+}
+
+// Ingest clones the repository and parses its infrastructure as code files.
+// The ingested object has a "terraform" and a "kubernetes" object, with the
+// parsed "files" and the resources they declare, each with the "path" and
+// "line" it is declared at. The cloned filesystem is exposed as well, so
+// rules can still use the file functions.
+func (i *IaC) Ingest(
+	ctx context.Context, ent protoreflect.ProtoMessage, params map[string]any,
+) (*interfaces.Ingested, error) {
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("%w: iac is only supported for repositories",
+			evalerrors.ErrIngestionNotApplicable)
+	}
+
+	userCfg := &IngesterConfig{}
+	if err := mapstructure.Decode(params, userCfg); err != nil {
+		return nil, fmt.Errorf("failed to read iac ingester configuration from params: %w", err)
+	}
+
+	if repo.GetCloneUrl() == "" {
+		return nil, fmt.Errorf("could not get clone url")
+	}
+
+	branch := cmp.Or(userCfg.Branch, i.cfg.GetBranch(), repo.GetDefaultBranch(), defaultBranch)
+	ingested, err := gitingester.Checkout(ctx, i.gitprov, repo.GetCloneUrl(), branch)
+	if err != nil {
+		return nil, err
+	}
+
+	ingested.Object, err = collect(ingested.Fs, i.cfg.GetPaths(), i.cfg.GetFormats())
+	if err != nil {
+		return nil, err
+	}
+	return ingested, nil
+}
+
+// collect finds and parses the files of the enabled formats and returns the
+// ingested object. Disabled formats are reported without any file.
+func collect(fs billy.Filesystem, patterns []string, formats []string) (map[string]any, error) {
+	enabled := func(format string) bool {
+		return len(formats) == 0 || slices.Contains(formats, format)
+	}
+
+	var tfPaths, k8sPaths []string
+	err := gitingester.WalkFiles(fs, skipDirs, func(p string) bool {
+		if !gitingester.MatchesAny(p, patterns) {
+			return false
+		}
+		switch {
+		case enabled(FormatTerraform) && isTerraformFile(p) && len(tfPaths) < MaxFiles:
+			tfPaths = append(tfPaths, p)
+		case enabled(FormatKubernetes) && isYAMLFile(p) && len(k8sPaths) < MaxFiles:
+			k8sPaths = append(k8sPaths, p)
+		}
+		return len(tfPaths) >= MaxFiles && len(k8sPaths) >= MaxFiles
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		FormatTerraform:  collectTerraform(fs, tfPaths),
+		FormatKubernetes: collectKubernetes(fs, k8sPaths),
+	}, nil
+}
+
+func isTerraformFile(p string) bool {
+	return strings.HasSuffix(p, ".tf")
+}
+
+func isYAMLFile(p string) bool {
+	return strings.HasSuffix(p, ".yaml") || strings.HasSuffix(p, ".yml")
+}
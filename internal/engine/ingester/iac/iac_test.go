@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/engine/ingester/ingestertest"
+	"github.com/mindersec/minder/internal/providers/credentials"
+	"github.com/mindersec/minder/internal/providers/testproviders"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+const mainTf = `provider "aws" {
+  region = var.region
+}
+
+locals {
+  prefix = "${var.env}-minder"
+  tags   = { Env = var.env, Name = local.prefix }
+}
+
+resource "aws_s3_bucket" "logs" {
+  bucket = "${local.prefix}-logs"
+  acl    = "public-read"
+  tags   = local.tags
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_policy" "logs" {
+  bucket = aws_s3_bucket.logs.id
+  policy = data.aws_iam_policy_document.logs.json
+}
+
+data "aws_iam_policy_document" "logs" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["${aws_s3_bucket.logs.arn}/*", "arn:aws:s3:::static"]
+  }
+}
+
+module "vpc" {
+  source = "terraform-aws-modules/vpc/aws"
+  cidr   = "10.0.0.0/16"
+}
+`
+
+const variablesTf = `variable "env" {
+  default = "prod"
+}
+
+variable "region" {}
+`
+
+const deployment = `# the API server
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+  namespace: minder
+spec:
+  template:
+    spec:
+      initContainers:
+        - name: migrate
+          image: ghcr.io/mindersec/minder:v1
+      containers:
+        - name: api
+          image: ghcr.io/mindersec/minder:v1
+          resources:
+            limits:
+              memory: 512Mi
+          securityContext:
+            runAsNonRoot: true
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: api
+`
+
+func TestCollectTerraform(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"infra/main.tf":      mainTf,
+		"infra/variables.tf": variablesTf,
+		"broken/main.tf":     `resource "aws_s3_bucket" "b" {`,
+		".terraform/m/x.tf":  `resource "aws_s3_bucket" "vendored" {}`,
+	})
+
+	got, err := collect(fs, nil, nil)
+	require.NoError(t, err)
+	tf := got[FormatTerraform].(map[string]any)
+
+	files := tf["files"].([]*FileResult)
+	require.Len(t, files, 3)
+	require.Equal(t, "broken/main.tf", files[0].Path)
+	require.NotEmpty(t, files[0].Error)
+	require.Empty(t, files[1].Error)
+
+	resources := tf["resources"].([]*TerraformResource)
+	require.Len(t, resources, 3)
+
+	bucket := resources[0]
+	require.Equal(t, "aws_s3_bucket.logs", bucket.Address)
+	require.Equal(t, modeManaged, bucket.Mode)
+	require.Equal(t, "infra/main.tf", bucket.Path)
+	require.Equal(t, 10, bucket.Line)
+	require.Equal(t, map[string]any{
+		"bucket": "prod-minder-logs",
+		"acl":    "public-read",
+		"tags":   map[string]any{"Env": "prod", "Name": "prod-minder"},
+		"versioning": []any{
+			map[string]any{"enabled": true},
+		},
+	}, bucket.Config)
+
+	require.Equal(t, map[string]any{
+		"bucket": "${aws_s3_bucket.logs.id}",
+		"policy": "${data.aws_iam_policy_document.logs.json}",
+	}, resources[1].Config)
+
+	doc := resources[2]
+	require.Equal(t, "data.aws_iam_policy_document.logs", doc.Address)
+	require.Equal(t, modeData, doc.Mode)
+	statement := doc.Config["statement"].([]any)[0].(map[string]any)
+	require.Equal(t, []any{"${aws_s3_bucket.logs.arn}/*", "arn:aws:s3:::static"}, statement["resources"])
+
+	modules := tf["modules"].([]*TerraformModule)
+	require.Len(t, modules, 1)
+	require.Equal(t, "vpc", modules[0].Name)
+	require.Equal(t, "terraform-aws-modules/vpc/aws", modules[0].Source)
+
+	providers := tf["providers"].([]*TerraformProvider)
+	require.Len(t, providers, 1)
+	require.Equal(t, "aws", providers[0].Name)
+	// variables without a default can't be computed
+	require.Equal(t, "${var.region}", providers[0].Config["region"])
+}
+
+func TestCollectKubernetes(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"deploy/api.yaml":            deployment,
+		"deploy/broken.yml":          "apiVersion: v1\nkind: [",
+		".github/workflows/ci.yml":   "on: push\njobs: {}\n",
+		"deploy/cronjob.yaml":        "apiVersion: batch/v1\nkind: CronJob\nmetadata:\n  name: gc\nspec:\n  jobTemplate:\n    spec:\n      template:\n        spec:\n          containers:\n            - name: gc\n              image: busybox\n",
+		"deploy/config/values.yaml":  "replicas: 2\n",
+		"deploy/kustomization.yaml":  "apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\nresources: [api.yaml]\n",
+		"node_modules/x/deploy.yaml": deployment,
+	})
+
+	got, err := collect(fs, nil, nil)
+	require.NoError(t, err)
+	k8s := got[FormatKubernetes].(map[string]any)
+
+	files := k8s["files"].([]*FileResult)
+	require.Len(t, files, 4)
+	require.Equal(t, "deploy/api.yaml", files[0].Path)
+	require.Equal(t, "deploy/broken.yml", files[1].Path)
+	require.NotEmpty(t, files[1].Error)
+
+	resources := k8s["resources"].([]*KubernetesResource)
+	require.Len(t, resources, 4)
+	require.Equal(t, "Deployment", resources[0].Kind)
+	require.Equal(t, "api", resources[0].Name)
+	require.Equal(t, "minder", resources[0].Namespace)
+	require.Equal(t, 2, resources[0].Line)
+	require.Equal(t, "Service", resources[1].Kind)
+	require.Equal(t, 1, resources[1].Document)
+	require.Equal(t, 22, resources[1].Line)
+	require.Equal(t, "CronJob", resources[2].Kind)
+	require.Equal(t, "Kustomization", resources[3].Kind)
+
+	containers := k8s["containers"].([]*KubernetesContainer)
+	require.Len(t, containers, 3)
+	require.Equal(t, "migrate", containers[0].Container)
+	require.True(t, containers[0].Init)
+	require.Empty(t, containers[0].Resources)
+	require.Equal(t, &KubernetesContainer{
+		Kind:      "Deployment",
+		Name:      "api",
+		Namespace: "minder",
+		Path:      "deploy/api.yaml",
+		Line:      2,
+		Container: "api",
+		Image:     "ghcr.io/mindersec/minder:v1",
+		Resources: map[string]any{
+			"limits": map[string]any{"memory": "512Mi"},
+		},
+		SecurityContext: map[string]any{"runAsNonRoot": true},
+	}, containers[1])
+	require.Equal(t, "CronJob", containers[2].Kind)
+	require.Equal(t, "busybox", containers[2].Image)
+}
+
+func TestCollectFilters(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"main.tf":         `resource "null_resource" "a" {}`,
+		"prod/main.tf":    `resource "null_resource" "b" {}`,
+		"prod/api.yaml":   deployment,
+		"staging/api.yml": deployment,
+	})
+
+	got, err := collect(fs, []string{"prod/*"}, nil)
+	require.NoError(t, err)
+	require.Len(t, got[FormatTerraform].(map[string]any)["files"], 1)
+	require.Len(t, got[FormatKubernetes].(map[string]any)["files"], 1)
+
+	got, err = collect(fs, nil, []string{FormatKubernetes})
+	require.NoError(t, err)
+	require.Empty(t, got[FormatTerraform].(map[string]any)["files"])
+	require.Len(t, got[FormatKubernetes].(map[string]any)["files"], 2)
+}
+
+func TestIngestNotApplicable(t *testing.T) {
+	t.Parallel()
+
+	i, err := NewIaCIngester(nil, testproviders.NewGitProvider(credentials.NewEmptyCredential()))
+	require.NoError(t, err)
+	require.Equal(t, IaCRuleDataIngestType, i.GetType())
+
+	_, err = i.Ingest(context.Background(), &pb.Artifact{Name: "image"}, nil)
+	require.ErrorIs(t, err, evalerrors.ErrIngestionNotApplicable)
+
+	_, err = NewIaCIngester(nil, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iac
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-git/go-billy/v5"
+	"gopkg.in/yaml.v3"
+
+	gitingester "github.com/mindersec/minder/internal/engine/ingester/git"
+)
+
+// KubernetesResource is an object of a Kubernetes manifest
+type KubernetesResource struct {
+	APIVersion string `json:"api_version"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+	Path       string `json:"path"`
+	// Document is the index of the YAML document of the object in the file
+	Document int `json:"document"`
+	Line     int `json:"line"`
+	// Manifest is the object, as written
+	Manifest map[string]any `json:"manifest"`
+}
+
+// KubernetesContainer is a container of the pod template of a workload
+type KubernetesContainer struct {
+	// Kind, Name and Namespace are those of the workload
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Path      string `json:"path"`
+	Document  int    `json:"document"`
+	Line      int    `json:"line"`
+	Container string `json:"container"`
+	// Init is whether the container is an init container
+	Init            bool           `json:"init"`
+	Image           string         `json:"image"`
+	Resources       map[string]any `json:"resources"`
+	SecurityContext map[string]any `json:"security_context"`
+}
+
+// podSpecPaths are the paths of the pod specs of the built-in workloads
+var podSpecPaths = map[string][]string{
+	"Pod":                   {"spec"},
+	"Deployment":            {"spec", "template", "spec"},
+	"StatefulSet":           {"spec", "template", "spec"},
+	"DaemonSet":             {"spec", "template", "spec"},
+	"ReplicaSet":            {"spec", "template", "spec"},
+	"ReplicationController": {"spec", "template", "spec"},
+	"Job":                   {"spec", "template", "spec"},
+	"CronJob":               {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// collectKubernetes parses the Kubernetes manifests among the YAML files.
+// Files without any Kubernetes object, e.g. CI workflows, are left out.
+func collectKubernetes(fs billy.Filesystem, paths []string) map[string]any {
+	files := make([]*FileResult, 0)
+	resources := make([]*KubernetesResource, 0)
+	containers := make([]*KubernetesContainer, 0)
+
+	for _, p := range paths {
+		objs, err := parseManifests(fs, p)
+		if err != nil {
+			files = append(files, &FileResult{Path: p, Error: err.Error()})
+			continue
+		}
+		if len(objs) == 0 {
+			continue
+		}
+
+		files = append(files, &FileResult{Path: p})
+		resources = append(resources, objs...)
+		for _, obj := range objs {
+			containers = append(containers, podContainers(obj)...)
+		}
+	}
+
+	return map[string]any{
+		"files":      files,
+		"resources":  resources,
+		"containers": containers,
+	}
+}
+
+// parseManifests parses the Kubernetes objects of a YAML file, which are the
+// documents with an apiVersion and a kind. Errors are only reported for the
+// files mentioning an apiVersion, to leave out the other YAML files.
+func parseManifests(fs billy.Filesystem, p string) ([]*KubernetesResource, error) {
+	src, err := gitingester.ReadFile(fs, p, MaxFileBytes)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Contains(src, []byte("apiVersion")) {
+		return nil, nil
+	}
+
+	var out []*KubernetesResource
+	dec := yaml.NewDecoder(bytes.NewReader(src))
+	for doc := 0; ; doc++ {
+		var node yaml.Node
+		err := dec.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("cannot parse document %d: %w", doc, err)
+		}
+
+		var manifest any
+		if err := node.Decode(&manifest); err != nil {
+			return nil, fmt.Errorf("cannot parse document %d: %w", doc, err)
+		}
+		obj, ok := normalizeYAML(manifest).(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiVersion, _ := obj["apiVersion"].(string)
+		kind, _ := obj["kind"].(string)
+		if apiVersion == "" || kind == "" {
+			continue
+		}
+
+		res := &KubernetesResource{
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Path:       p,
+			Document:   doc,
+			Line:       node.Line,
+			Manifest:   obj,
+		}
+		if len(node.Content) > 0 {
+			// the line of the document node is the one of the separator
+			res.Line = node.Content[0].Line
+		}
+		if metadata, ok := obj["metadata"].(map[string]any); ok {
+			res.Name, _ = metadata["name"].(string)
+			res.Namespace, _ = metadata["namespace"].(string)
+		}
+		out = append(out, res)
+	}
+
+	return out, nil
+}
+
+// podContainers returns the containers of the pod spec of a workload
+func podContainers(obj *KubernetesResource) []*KubernetesContainer {
+	specPath, ok := podSpecPaths[obj.Kind]
+	if !ok {
+		return nil
+	}
+
+	spec := lookupMap(obj.Manifest, specPath...)
+	if spec == nil {
+		return nil
+	}
+
+	var out []*KubernetesContainer
+	for _, field := range []string{"initContainers", "containers"} {
+		list, _ := spec[field].([]any)
+		for _, item := range list {
+			c, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			container := &KubernetesContainer{
+				Kind:            obj.Kind,
+				Name:            obj.Name,
+				Namespace:       obj.Namespace,
+				Path:            obj.Path,
+				Document:        obj.Document,
+				Line:            obj.Line,
+				Init:            field == "initContainers",
+				Resources:       lookupMap(c, "resources"),
+				SecurityContext: lookupMap(c, "securityContext"),
+			}
+			container.Container, _ = c["name"].(string)
+			container.Image, _ = c["image"].(string)
+			if container.Resources == nil {
+				container.Resources = map[string]any{}
+			}
+			if container.SecurityContext == nil {
+				container.SecurityContext = map[string]any{}
+			}
+			out = append(out, container)
+		}
+	}
+	return out
+}
+
+func lookupMap(obj map[string]any, keys ...string) map[string]any {
+	for _, k := range keys {
+		next, ok := obj[k].(map[string]any)
+		if !ok {
+			return nil
+		}
+		obj = next
+	}
+	return obj
+}
+
+// normalizeYAML converts the maps with non-string keys, which YAML allows,
+// to maps with string keys, which can be converted to JSON
+func normalizeYAML(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, elem := range v {
+			v[k] = normalizeYAML(elem)
+		}
+		return v
+	case map[any]any:
+		out := make(map[string]any, len(v))
+		for k, elem := range v {
+			out[fmt.Sprint(k)] = normalizeYAML(elem)
+		}
+		return out
+	case []any:
+		for i, elem := range v {
+			v[i] = normalizeYAML(elem)
+		}
+		return v
+	default:
+		return v
+	}
+}
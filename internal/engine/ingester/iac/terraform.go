@@ -0,0 +1,306 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iac
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	gitingester "github.com/mindersec/minder/internal/engine/ingester/git"
+)
+
+const (
+	// modeManaged is the mode of the resources managed by Terraform
+	modeManaged = "managed"
+	// modeData is the mode of the data sources
+	modeData = "data"
+)
+
+// TerraformResource is a resource or data source of a Terraform configuration
+type TerraformResource struct {
+	// Address is the address of the resource in its module, e.g.
+	// aws_s3_bucket.logs or data.aws_iam_policy_document.read
+	Address string `json:"address"`
+	// Mode is "managed" for resources and "data" for data sources
+	Mode string `json:"mode"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Line int    `json:"line"`
+	// Config is the body of the block. Nested blocks are lists under their
+	// type, since blocks may be repeated. Values which can't be computed
+	// statically are their expression, e.g. "${aws_iam_role.ci.arn}".
+	Config map[string]any `json:"config"`
+}
+
+// TerraformModule is a module call of a Terraform configuration
+type TerraformModule struct {
+	Name   string         `json:"name"`
+	Source string         `json:"source"`
+	Path   string         `json:"path"`
+	Line   int            `json:"line"`
+	Config map[string]any `json:"config"`
+}
+
+// TerraformProvider is a provider configuration of a Terraform configuration
+type TerraformProvider struct {
+	Name   string         `json:"name"`
+	Alias  string         `json:"alias"`
+	Path   string         `json:"path"`
+	Line   int            `json:"line"`
+	Config map[string]any `json:"config"`
+}
+
+type terraformFile struct {
+	path string
+	src  []byte
+	body *hclsyntax.Body
+}
+
+// collectTerraform parses the Terraform files. The variables and locals of
+// each directory, which is a Terraform module, are substituted in the
+// values when they can be computed statically.
+func collectTerraform(fs billy.Filesystem, paths []string) map[string]any {
+	files := make([]*FileResult, 0, len(paths))
+	resources := make([]*TerraformResource, 0)
+	modules := make([]*TerraformModule, 0)
+	providers := make([]*TerraformProvider, 0)
+
+	var dirs []string
+	byDir := map[string][]*terraformFile{}
+	for _, p := range paths {
+		res := &FileResult{Path: p}
+		files = append(files, res)
+
+		tf, err := parseTerraformFile(fs, p)
+		if err != nil {
+			res.Error = err.Error()
+			continue
+		}
+		dir := path.Dir(p)
+		if _, ok := byDir[dir]; !ok {
+			dirs = append(dirs, dir)
+		}
+		byDir[dir] = append(byDir[dir], tf)
+	}
+
+	for _, dir := range dirs {
+		ctx := moduleEvalContext(byDir[dir])
+		for _, tf := range byDir[dir] {
+			for _, b := range tf.body.Blocks {
+				line := b.TypeRange.Start.Line
+				switch {
+				case b.Type == "resource" && len(b.Labels) == 2:
+					resources = append(resources, &TerraformResource{
+						Address: b.Labels[0] + "." + b.Labels[1],
+						Mode:    modeManaged,
+						Type:    b.Labels[0],
+						Name:    b.Labels[1],
+						Path:    tf.path,
+						Line:    line,
+						Config:  bodyToMap(b.Body, ctx, tf.src),
+					})
+				case b.Type == "data" && len(b.Labels) == 2:
+					resources = append(resources, &TerraformResource{
+						Address: "data." + b.Labels[0] + "." + b.Labels[1],
+						Mode:    modeData,
+						Type:    b.Labels[0],
+						Name:    b.Labels[1],
+						Path:    tf.path,
+						Line:    line,
+						Config:  bodyToMap(b.Body, ctx, tf.src),
+					})
+				case b.Type == "module" && len(b.Labels) == 1:
+					config := bodyToMap(b.Body, ctx, tf.src)
+					source, _ := config["source"].(string)
+					modules = append(modules, &TerraformModule{
+						Name:   b.Labels[0],
+						Source: source,
+						Path:   tf.path,
+						Line:   line,
+						Config: config,
+					})
+				case b.Type == "provider" && len(b.Labels) == 1:
+					config := bodyToMap(b.Body, ctx, tf.src)
+					alias, _ := config["alias"].(string)
+					providers = append(providers, &TerraformProvider{
+						Name:   b.Labels[0],
+						Alias:  alias,
+						Path:   tf.path,
+						Line:   line,
+						Config: config,
+					})
+				}
+			}
+		}
+	}
+
+	return map[string]any{
+		"files":     files,
+		"resources": resources,
+		"modules":   modules,
+		"providers": providers,
+	}
+}
+
+func parseTerraformFile(fs billy.Filesystem, p string) (*terraformFile, error) {
+	src, err := gitingester.ReadFile(fs, p, MaxFileBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	f, diags := hclsyntax.ParseConfig(src, p, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	return &terraformFile{
+		path: p,
+		src:  src,
+		// the native syntax parser always returns a native syntax body
+		body: f.Body.(*hclsyntax.Body),
+	}, nil
+}
+
+// moduleEvalContext returns the context evaluating the expressions of a
+// module, with the default values of its variables and its locals. Values
+// which can't be computed statically are unknown.
+func moduleEvalContext(files []*terraformFile) *hcl.EvalContext {
+	vars := map[string]cty.Value{}
+	pending := map[string]hcl.Expression{}
+	for _, tf := range files {
+		for _, b := range tf.body.Blocks {
+			switch {
+			case b.Type == "variable" && len(b.Labels) == 1:
+				vars[b.Labels[0]] = cty.DynamicVal
+				if attr, ok := b.Body.Attributes["default"]; ok {
+					if v, diags := attr.Expr.Value(nil); !diags.HasErrors() {
+						vars[b.Labels[0]] = v
+					}
+				}
+			case b.Type == "locals":
+				for name, attr := range b.Body.Attributes {
+					pending[name] = attr.Expr
+				}
+			}
+		}
+	}
+
+	locals := map[string]cty.Value{}
+	ctx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{
+			"var":   cty.ObjectVal(vars),
+			"local": cty.ObjectVal(locals),
+		},
+	}
+
+	// locals may reference each other, evaluate them until no more can be
+	for progress := true; progress; {
+		progress = false
+		for name, expr := range pending {
+			v, diags := expr.Value(ctx)
+			if diags.HasErrors() || !v.IsWhollyKnown() {
+				continue
+			}
+			locals[name] = v
+			delete(pending, name)
+			ctx.Variables["local"] = cty.ObjectVal(locals)
+			progress = true
+		}
+	}
+	for name := range pending {
+		locals[name] = cty.DynamicVal
+	}
+	ctx.Variables["local"] = cty.ObjectVal(locals)
+
+	return ctx
+}
+
+// bodyToMap converts the body of a block. Dynamic blocks are converted to
+// the blocks they generate, with their content.
+func bodyToMap(body *hclsyntax.Body, ctx *hcl.EvalContext, src []byte) map[string]any {
+	out := make(map[string]any, len(body.Attributes)+len(body.Blocks))
+	for name, attr := range body.Attributes {
+		out[name] = exprValue(attr.Expr, ctx, src)
+	}
+
+	for _, b := range body.Blocks {
+		blockType, blockBody := b.Type, b.Body
+		if b.Type == "dynamic" && len(b.Labels) == 1 {
+			blockType = b.Labels[0]
+			for _, content := range b.Body.Blocks {
+				if content.Type == "content" {
+					blockBody = content.Body
+				}
+			}
+		}
+		blocks, _ := out[blockType].([]any)
+		out[blockType] = append(blocks, bodyToMap(blockBody, ctx, src))
+	}
+
+	return out
+}
+
+// exprValue returns the value of an expression, or its source if it can't be
+// computed statically. The elements of lists and objects are converted one by
+// one, so that the static ones are still available.
+func exprValue(expr hclsyntax.Expression, ctx *hcl.EvalContext, src []byte) any {
+	switch e := expr.(type) {
+	case *hclsyntax.TupleConsExpr:
+		out := make([]any, 0, len(e.Exprs))
+		for _, elem := range e.Exprs {
+			out = append(out, exprValue(elem, ctx, src))
+		}
+		return out
+	case *hclsyntax.ObjectConsExpr:
+		out := make(map[string]any, len(e.Items))
+		for _, item := range e.Items {
+			key, diags := item.KeyExpr.Value(ctx)
+			if diags.HasErrors() || !key.IsKnown() || key.IsNull() || key.Type() != cty.String {
+				out[sourceOf(item.KeyExpr, src)] = exprValue(item.ValueExpr, ctx, src)
+				continue
+			}
+			out[key.AsString()] = exprValue(item.ValueExpr, ctx, src)
+		}
+		return out
+	}
+
+	v, diags := expr.Value(ctx)
+	if diags.HasErrors() || !v.IsWhollyKnown() {
+		if _, ok := expr.(*hclsyntax.TemplateExpr); ok {
+			// templates are already interpolated strings
+			return strings.TrimSuffix(strings.TrimPrefix(sourceOf(expr, src), `"`), `"`)
+		}
+		return "${" + sourceOf(expr, src) + "}"
+	}
+
+	return ctyToGo(v)
+}
+
+func sourceOf(expr hclsyntax.Expression, src []byte) string {
+	return string(expr.Range().SliceBytes(src))
+}
+
+func ctyToGo(v cty.Value) any {
+	if v.IsNull() {
+		return nil
+	}
+
+	buf, err := ctyjson.Marshal(v, v.Type())
+	if err != nil {
+		return nil
+	}
+	var out any
+	if err := json.Unmarshal(buf, &out); err != nil {
+		return nil
+	}
+	return out
+}
@@ -11,10 +11,19 @@ import (
 
 	"github.com/mindersec/minder/internal/engine/ingester/artifact"
 	"github.com/mindersec/minder/internal/engine/ingester/builtin"
+	"github.com/mindersec/minder/internal/engine/ingester/collaborators"
 	"github.com/mindersec/minder/internal/engine/ingester/deps"
 	"github.com/mindersec/minder/internal/engine/ingester/diff"
+	"github.com/mindersec/minder/internal/engine/ingester/dockerfile"
+	"github.com/mindersec/minder/internal/engine/ingester/environments"
 	"github.com/mindersec/minder/internal/engine/ingester/git"
+	"github.com/mindersec/minder/internal/engine/ingester/iac"
+	"github.com/mindersec/minder/internal/engine/ingester/multi"
+	"github.com/mindersec/minder/internal/engine/ingester/repocredentials"
 	"github.com/mindersec/minder/internal/engine/ingester/rest"
+	"github.com/mindersec/minder/internal/engine/ingester/runners"
+	"github.com/mindersec/minder/internal/engine/ingester/scorecard"
+	"github.com/mindersec/minder/internal/engine/ingester/securityinsights"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 )
@@ -27,15 +36,26 @@ var _ interfaces.Ingester = (*rest.Ingestor)(nil)
 var _ interfaces.Ingester = (*git.Git)(nil)
 var _ interfaces.Ingester = (*diff.Diff)(nil)
 var _ interfaces.Ingester = (*deps.Deps)(nil)
+var _ interfaces.Ingester = (*scorecard.Scorecard)(nil)
+var _ interfaces.Ingester = (*securityinsights.SecurityInsights)(nil)
+var _ interfaces.Ingester = (*dockerfile.Dockerfile)(nil)
+var _ interfaces.Ingester = (*iac.IaC)(nil)
+var _ interfaces.Ingester = (*collaborators.Collaborators)(nil)
+var _ interfaces.Ingester = (*repocredentials.RepoCredentials)(nil)
+var _ interfaces.Ingester = (*runners.Runners)(nil)
+var _ interfaces.Ingester = (*environments.Environments)(nil)
+var _ interfaces.Ingester = (*multi.Multi)(nil)
 
 // NewRuleDataIngest creates a new rule data ingest based no the given rule
 // type definition.
 func NewRuleDataIngest(rt *pb.RuleType, provider interfaces.Provider) (interfaces.Ingester, error) {
-	ing := rt.Def.GetIngest()
+	return newIngester(rt.Def.GetIngest(), provider)
+}
 
+func newIngester(ing *pb.RuleType_Definition_Ingest, provider interfaces.Provider) (interfaces.Ingester, error) {
 	switch ing.GetType() {
 	case rest.RestRuleDataIngestType:
-		if rt.Def.Ingest.GetRest() == nil {
+		if ing.GetRest() == nil {
 			return nil, fmt.Errorf("rule type engine missing rest configuration")
 		}
 		client, err := interfaces.As[interfaces.RESTProvider](provider)
@@ -45,16 +65,16 @@ func NewRuleDataIngest(rt *pb.RuleType, provider interfaces.Provider) (interface
 
 		return rest.NewRestRuleDataIngest(ing.GetRest(), client)
 	case builtin.BuiltinRuleDataIngestType:
-		if rt.Def.Ingest.GetBuiltin() == nil {
+		if ing.GetBuiltin() == nil {
 			return nil, fmt.Errorf("rule type engine missing internal configuration")
 		}
 		return builtin.NewRuleDataIngest(ing.GetBuiltin())
 
 	case artifact.ArtifactRuleDataIngestType:
-		if rt.Def.Ingest.GetArtifact() == nil {
+		if ing.GetArtifact() == nil {
 			return nil, fmt.Errorf("rule type engine missing artifact configuration")
 		}
-		return artifact.NewArtifactDataIngest(provider)
+		return artifact.NewArtifactDataIngest(ing.GetArtifact(), provider)
 
 	case git.GitRuleDataIngestType:
 		client, err := interfaces.As[interfaces.GitProvider](provider)
@@ -74,7 +94,72 @@ func NewRuleDataIngest(rt *pb.RuleType, provider interfaces.Provider) (interface
 			return nil, errors.New("provider does not implement git trait")
 		}
 		return deps.NewDepsIngester(ing.GetDeps(), client)
+	case scorecard.ScorecardRuleDataIngestType:
+		return scorecard.NewScorecardIngester(ing.GetScorecard())
+	case securityinsights.SecurityInsightsRuleDataIngestType:
+		client, err := interfaces.As[interfaces.GitProvider](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement git trait")
+		}
+		return securityinsights.NewSecurityInsightsIngester(ing.GetSecurityInsights(), client)
+	case dockerfile.DockerfileRuleDataIngestType:
+		client, err := interfaces.As[interfaces.GitProvider](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement git trait")
+		}
+		return dockerfile.NewDockerfileIngester(ing.GetDockerfile(), client)
+	case iac.IaCRuleDataIngestType:
+		client, err := interfaces.As[interfaces.GitProvider](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement git trait")
+		}
+		return iac.NewIaCIngester(ing.GetIac(), client)
+	case collaborators.CollaboratorsRuleDataIngestType:
+		client, err := interfaces.As[interfaces.GitHubCollaboratorLister](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement github trait")
+		}
+		return collaborators.NewCollaboratorsIngester(ing.GetCollaborators(), client)
+	case repocredentials.RepoCredentialsRuleDataIngestType:
+		client, err := interfaces.As[interfaces.GitHubRepoCredentialsLister](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement github trait")
+		}
+		return repocredentials.NewRepoCredentialsIngester(ing.GetRepoCredentials(), client)
+	case runners.RunnersRuleDataIngestType:
+		client, err := interfaces.As[interfaces.GitHubRunnerLister](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement github trait")
+		}
+		return runners.NewRunnersIngester(ing.GetRunners(), client)
+	case environments.EnvironmentsRuleDataIngestType:
+		client, err := interfaces.As[interfaces.GitHubEnvironmentLister](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement github trait")
+		}
+		return environments.NewEnvironmentsIngester(ing.GetEnvironments(), client)
+	case multi.MultiRuleDataIngestType:
+		return newMultiIngester(ing.GetMulti(), provider)
 	default:
-		return nil, fmt.Errorf("unsupported rule type engine: %s", rt.Def.Ingest.Type)
+		return nil, fmt.Errorf("unsupported rule type engine: %s", ing.GetType())
+	}
+}
+
+func newMultiIngester(cfg *pb.MultiType, provider interfaces.Provider) (interfaces.Ingester, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("rule type engine missing multi configuration")
+	}
+
+	steps := make([]multi.Step, 0, len(cfg.GetSteps()))
+	for _, step := range cfg.GetSteps() {
+		if step.GetIngest().GetType() == multi.MultiRuleDataIngestType {
+			return nil, fmt.Errorf("multi ingest step %s can't be of type multi", step.GetName())
+		}
+		ing, err := newIngester(step.GetIngest(), provider)
+		if err != nil {
+			return nil, fmt.Errorf("multi ingest step %s: %w", step.GetName(), err)
+		}
+		steps = append(steps, multi.Step{Name: step.GetName(), Ingester: ing})
 	}
+	return multi.NewMultiIngester(cfg, steps)
 }
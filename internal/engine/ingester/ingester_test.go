@@ -12,8 +12,13 @@ import (
 
 	"github.com/mindersec/minder/internal/engine/ingester/artifact"
 	"github.com/mindersec/minder/internal/engine/ingester/builtin"
+	"github.com/mindersec/minder/internal/engine/ingester/dockerfile"
 	"github.com/mindersec/minder/internal/engine/ingester/git"
+	"github.com/mindersec/minder/internal/engine/ingester/iac"
+	"github.com/mindersec/minder/internal/engine/ingester/multi"
 	"github.com/mindersec/minder/internal/engine/ingester/rest"
+	"github.com/mindersec/minder/internal/engine/ingester/scorecard"
+	"github.com/mindersec/minder/internal/engine/ingester/securityinsights"
 	"github.com/mindersec/minder/internal/providers/credentials"
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	"github.com/mindersec/minder/internal/providers/github/properties"
@@ -128,6 +133,106 @@ func TestNewRuleDataIngest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "scorecard",
+			args: args{
+				rt: &pb.RuleType{
+					Def: &pb.RuleType_Definition{
+						Ingest: &pb.RuleType_Definition_Ingest{
+							Type: scorecard.ScorecardRuleDataIngestType,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "security insights",
+			args: args{
+				rt: &pb.RuleType{
+					Def: &pb.RuleType_Definition{
+						Ingest: &pb.RuleType_Definition_Ingest{
+							Type: securityinsights.SecurityInsightsRuleDataIngestType,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "dockerfile",
+			args: args{
+				rt: &pb.RuleType{
+					Def: &pb.RuleType_Definition{
+						Ingest: &pb.RuleType_Definition_Ingest{
+							Type: dockerfile.DockerfileRuleDataIngestType,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "iac",
+			args: args{
+				rt: &pb.RuleType{
+					Def: &pb.RuleType_Definition{
+						Ingest: &pb.RuleType_Definition_Ingest{
+							Type: iac.IaCRuleDataIngestType,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "multi",
+			args: args{
+				rt: &pb.RuleType{
+					Def: &pb.RuleType_Definition{
+						Ingest: &pb.RuleType_Definition_Ingest{
+							Type: multi.MultiRuleDataIngestType,
+							Multi: &pb.MultiType{
+								Steps: []*pb.MultiType_Step{
+									{
+										Name: "repo",
+										Ingest: &pb.RuleType_Definition_Ingest{
+											Type: rest.RestRuleDataIngestType,
+											Rest: &pb.RestType{Endpoint: "/repos/Foo/Bar"},
+										},
+									},
+									{
+										Name: "scorecard",
+										Ingest: &pb.RuleType_Definition_Ingest{
+											Type: scorecard.ScorecardRuleDataIngestType,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "multi with invalid step",
+			args: args{
+				rt: &pb.RuleType{
+					Def: &pb.RuleType_Definition{
+						Ingest: &pb.RuleType_Definition_Ingest{
+							Type: multi.MultiRuleDataIngestType,
+							Multi: &pb.MultiType{
+								Steps: []*pb.MultiType_Step{
+									{
+										Name: "repo",
+										Ingest: &pb.RuleType_Definition_Ingest{
+											Type: rest.RestRuleDataIngestType,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "unsupported",
 			args: args{
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ingestertest contains utilities for testing the ingesters
+package ingestertest
+
+import (
+	"testing"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/stretchr/testify/require"
+)
+
+// NewFs returns an in-memory filesystem with the given files, by path
+func NewFs(t *testing.T, files map[string]string) billy.Filesystem {
+	t.Helper()
+
+	fs := memfs.New()
+	for name, content := range files {
+		require.NoError(t, util.WriteFile(fs, name, []byte(content), 0o644))
+	}
+	return fs
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package multi provides the multi rule data ingest engine, which runs several
+// data ingestions and exposes their results to the evaluator under the name of
+// each step.
+package multi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	// MultiRuleDataIngestType is the type of the multi rule data ingest engine
+	MultiRuleDataIngestType = "multi"
+)
+
+// Step is a named data ingestion of a multi ingest
+type Step struct {
+	Name     string
+	Ingester interfaces.Ingester
+}
+
+// Multi is the engine for a rule type that combines several data ingestions
+type Multi struct {
+	cfg   *pb.MultiType
+	steps []Step
+}
+
+// NewMultiIngester creates a new multi rule data ingest engine. The ingesters
+// of the steps are created by the caller, in the order of the configuration.
+func NewMultiIngester(cfg *pb.MultiType, steps []Step) (*Multi, error) {
+	if cfg == nil {
+		return nil, errors.New("multi configuration is nil")
+	}
+	if len(steps) == 0 {
+		return nil, errors.New("multi ingest has no steps")
+	}
+
+	return &Multi{
+		cfg:   cfg,
+		steps: steps,
+	}, nil
+}
+
+// GetType returns the type of the multi rule data ingest engine
+func (*Multi) GetType() string {
+	return MultiRuleDataIngestType
+}
+
+// GetConfig returns the config for the multi rule data ingest engine
+func (m *Multi) GetConfig() protoreflect.ProtoMessage {
+	return m.cfg
+}
+
+// Ingest runs the steps in order. The ingested object maps the name of each
+// step to the object it ingested, so that e.g. the jq evaluator reads the
+// result of a step named "branches" with `.branches`. The filesystem, storer
+// and checkpoint are those of the first step which ingested them. An error of
+// any step fails the whole ingestion.
+func (m *Multi) Ingest(
+	ctx context.Context, ent protoreflect.ProtoMessage, params map[string]any,
+) (*interfaces.Ingested, error) {
+	obj := make(map[string]any, len(m.steps))
+	res := &interfaces.Ingested{Object: obj}
+
+	for _, step := range m.steps {
+		ingested, err := step.Ingester.Ingest(ctx, ent, params)
+		if err != nil {
+			return nil, fmt.Errorf("ingest step %s: %w", step.Name, err)
+		}
+		if ingested == nil {
+			obj[step.Name] = nil
+			continue
+		}
+
+		obj[step.Name] = ingested.Object
+		if res.Fs == nil {
+			res.Fs = ingested.Fs
+			res.BaseFs = ingested.BaseFs
+			res.Storer = ingested.Storer
+		}
+		if res.Checkpoint == nil {
+			res.Checkpoint = ingested.Checkpoint
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+type fakeIngester struct {
+	res *interfaces.Ingested
+	err error
+}
+
+func (*fakeIngester) GetType() string {
+	return "fake"
+}
+
+func (*fakeIngester) GetConfig() protoreflect.ProtoMessage {
+	return nil
+}
+
+func (f *fakeIngester) Ingest(context.Context, protoreflect.ProtoMessage, map[string]any) (*interfaces.Ingested, error) {
+	return f.res, f.err
+}
+
+func TestMultiIngest(t *testing.T) {
+	t.Parallel()
+
+	fs := memfs.New()
+	m, err := NewMultiIngester(&pb.MultiType{}, []Step{
+		{Name: "repo", Ingester: &fakeIngester{res: &interfaces.Ingested{
+			Object: map[string]any{"private": false},
+		}}},
+		{Name: "files", Ingester: &fakeIngester{res: &interfaces.Ingested{Fs: fs}}},
+		{Name: "protection", Ingester: &fakeIngester{res: &interfaces.Ingested{
+			Object: map[string]any{"enforce_admins": true},
+		}}},
+	})
+	require.NoError(t, err)
+
+	res, err := m.Ingest(context.Background(), &pb.Repository{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"repo":       map[string]any{"private": false},
+		"files":      nil,
+		"protection": map[string]any{"enforce_admins": true},
+	}, res.Object)
+	require.Equal(t, fs, res.Fs)
+}
+
+func TestMultiIngestStepError(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewMultiIngester(&pb.MultiType{}, []Step{
+		{Name: "repo", Ingester: &fakeIngester{res: &interfaces.Ingested{}}},
+		{Name: "protection", Ingester: &fakeIngester{
+			err: evalerrors.NewErrEvaluationFailed("branch not found"),
+		}},
+	})
+	require.NoError(t, err)
+
+	_, err = m.Ingest(context.Background(), &pb.Repository{}, nil)
+	require.ErrorContains(t, err, "ingest step protection")
+	require.True(t, errors.Is(err, interfaces.ErrEvaluationFailed))
+}
+
+func TestNewMultiIngesterWithoutSteps(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewMultiIngester(&pb.MultiType{}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package repocredentials provides the repo_credentials rule data ingest
+// engine, which collects the deploy keys and the Actions secrets metadata of a
+// repository.
+package repocredentials
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v63/github"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	// RepoCredentialsRuleDataIngestType is the type of the repo credentials rule data ingest engine
+	RepoCredentialsRuleDataIngestType = "repo_credentials"
+)
+
+// RepoCredentials is the engine for a rule type that uses repo credentials data ingest
+type RepoCredentials struct {
+	cfg    *pb.RepoCredentialsType
+	lister interfaces.GitHubRepoCredentialsLister
+	now    func() time.Time
+}
+
+// NewRepoCredentialsIngester creates a new repo credentials rule data ingest engine
+func NewRepoCredentialsIngester(
+	cfg *pb.RepoCredentialsType, lister interfaces.GitHubRepoCredentialsLister,
+) (*RepoCredentials, error) {
+	if lister == nil {
+		return nil, fmt.Errorf("provider is nil")
+	}
+
+	if cfg == nil {
+		cfg = &pb.RepoCredentialsType{}
+	}
+
+	return &RepoCredentials{
+		cfg:    cfg,
+		lister: lister,
+		now:    time.Now,
+	}, nil
+}
+
+// GetType returns the type of the repo credentials rule data ingest engine
+func (*RepoCredentials) GetType() string {
+	return RepoCredentialsRuleDataIngestType
+}
+
+// GetConfig returns the config for the repo credentials rule data ingest engine
+func (rc *RepoCredentials) GetConfig() protoreflect.ProtoMessage {
+	return rc.cfg
+}
+
+// Ingest lists the deploy keys and the Actions secrets of the repository. The
+// ingested object contains the "deploy_keys", each with its "id", "title",
+// whether it is "read_only" and "verified", when it was "created_at" and
+// "last_used" (empty if never used), its "age_days" and
+// "days_since_last_used" (-1 if never used), and the "secrets", each with its
+// "name", when it was "created_at" and "updated_at", and its
+// "days_since_update". Times are formatted as RFC 3339.
+func (rc *RepoCredentials) Ingest(
+	ctx context.Context, ent protoreflect.ProtoMessage, _ map[string]any,
+) (*interfaces.Ingested, error) {
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("%w: repo_credentials is only supported for repositories",
+			evalerrors.ErrIngestionNotApplicable)
+	}
+
+	owner, name := repo.GetOwner(), repo.GetName()
+
+	keys, err := rc.lister.ListDeployKeys(ctx, owner, name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list deploy keys of %s/%s: %w", owner, name, err)
+	}
+
+	secrets, err := rc.lister.ListRepoSecrets(ctx, owner, name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list secrets of %s/%s: %w", owner, name, err)
+	}
+
+	now := rc.now()
+
+	deployKeys := make([]any, 0, len(keys))
+	for _, k := range keys {
+		deployKeys = append(deployKeys, map[string]any{
+			"id":                   k.GetID(),
+			"title":                k.GetTitle(),
+			"read_only":            k.GetReadOnly(),
+			"verified":             k.GetVerified(),
+			"created_at":           formatTime(k.GetCreatedAt()),
+			"last_used":            formatTime(k.GetLastUsed()),
+			"age_days":             daysSince(now, k.GetCreatedAt()),
+			"days_since_last_used": daysSince(now, k.GetLastUsed()),
+		})
+	}
+
+	secretList := make([]any, 0, len(secrets))
+	for _, s := range secrets {
+		secretList = append(secretList, map[string]any{
+			"name":              s.Name,
+			"created_at":        formatTime(s.CreatedAt),
+			"updated_at":        formatTime(s.UpdatedAt),
+			"days_since_update": daysSince(now, s.UpdatedAt),
+		})
+	}
+
+	return &interfaces.Ingested{
+		Object: map[string]any{
+			"deploy_keys": deployKeys,
+			"secrets":     secretList,
+		},
+	}, nil
+}
+
+func formatTime(ts github.Timestamp) string {
+	if ts.IsZero() {
+		return ""
+	}
+	return ts.UTC().Format(time.RFC3339)
+}
+
+// daysSince returns the number of whole days elapsed since the timestamp, or
+// -1 if it is not set.
+func daysSince(now time.Time, ts github.Timestamp) int64 {
+	if ts.IsZero() {
+		return -1
+	}
+	return int64(now.Sub(ts.Time) / (24 * time.Hour))
+}
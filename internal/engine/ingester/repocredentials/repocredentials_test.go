@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repocredentials
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/util/ptr"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+type fakeLister struct {
+	keys    []*github.Key
+	secrets []*github.Secret
+	err     error
+}
+
+func (f *fakeLister) ListDeployKeys(_ context.Context, _, _ string) ([]*github.Key, error) {
+	return f.keys, f.err
+}
+
+func (f *fakeLister) ListRepoSecrets(_ context.Context, _, _ string) ([]*github.Secret, error) {
+	return f.secrets, f.err
+}
+
+func TestIngest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	lister := &fakeLister{
+		keys: []*github.Key{{
+			ID:        ptr.Ptr(int64(42)),
+			Title:     ptr.Ptr("deploy"),
+			ReadOnly:  ptr.Ptr(false),
+			Verified:  ptr.Ptr(true),
+			CreatedAt: &github.Timestamp{Time: now.AddDate(0, 0, -400)},
+		}},
+		secrets: []*github.Secret{{
+			Name:      "NPM_TOKEN",
+			CreatedAt: github.Timestamp{Time: now.AddDate(-1, 0, 0)},
+			UpdatedAt: github.Timestamp{Time: now.AddDate(0, 0, -30).Add(-time.Hour)},
+		}},
+	}
+
+	ing, err := NewRepoCredentialsIngester(nil, lister)
+	require.NoError(t, err)
+	ing.now = func() time.Time { return now }
+
+	got, err := ing.Ingest(context.Background(), &pb.Repository{Owner: "mindersec", Name: "minder"}, nil)
+	require.NoError(t, err)
+
+	obj := got.Object.(map[string]any)
+	require.Equal(t, []any{map[string]any{
+		"id":                   int64(42),
+		"title":                "deploy",
+		"read_only":            false,
+		"verified":             true,
+		"created_at":           "2023-08-28T12:00:00Z",
+		"last_used":            "",
+		"age_days":             int64(400),
+		"days_since_last_used": int64(-1),
+	}}, obj["deploy_keys"])
+	require.Equal(t, []any{map[string]any{
+		"name":              "NPM_TOKEN",
+		"created_at":        "2023-10-01T12:00:00Z",
+		"updated_at":        "2024-09-01T11:00:00Z",
+		"days_since_update": int64(30),
+	}}, obj["secrets"])
+}
+
+func TestIngestErrors(t *testing.T) {
+	t.Parallel()
+
+	ing, err := NewRepoCredentialsIngester(nil, &fakeLister{err: errors.New("boom")})
+	require.NoError(t, err)
+
+	_, err = ing.Ingest(context.Background(), &pb.Artifact{}, nil)
+	require.ErrorIs(t, err, evalerrors.ErrIngestionNotApplicable)
+
+	_, err = ing.Ingest(context.Background(), &pb.Repository{Owner: "mindersec", Name: "minder"}, nil)
+	require.ErrorContains(t, err, "boom")
+
+	_, err = NewRepoCredentialsIngester(nil, nil)
+	require.Error(t, err)
+}
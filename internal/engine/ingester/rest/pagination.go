@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// paginationTypeLink follows the rel="next" URL of the Link header
+	paginationTypeLink = "link"
+	// paginationTypeCursor passes the cursor of a page as a query parameter
+	paginationTypeCursor = "cursor"
+
+	// defaultMaxPages is the number of pages fetched if the rule type doesn't
+	// set a maximum
+	defaultMaxPages = 10
+)
+
+// pageItems returns the items of a page, which are found at the given path of
+// the page, or are the page itself if the path is empty
+func pageItems(page any, path string) ([]any, error) {
+	val, ok := lookupPath(page, path)
+	if !ok {
+		return nil, fmt.Errorf("page has no items at %q", path)
+	}
+	items, ok := val.([]any)
+	if !ok {
+		return nil, fmt.Errorf("items at %q are not an array", path)
+	}
+	return items, nil
+}
+
+// combinePages returns the first page with its items replaced by the items of
+// all the pages, which keeps the other fields of the page available to the
+// evaluator
+func combinePages(first any, path string, items []any) any {
+	if path == "" {
+		return items
+	}
+
+	keys := strings.Split(path, ".")
+	obj, ok := first.(map[string]any)
+	if !ok {
+		return items
+	}
+	for _, key := range keys[:len(keys)-1] {
+		if obj, ok = obj[key].(map[string]any); !ok {
+			return items
+		}
+	}
+	obj[keys[len(keys)-1]] = items
+	return first
+}
+
+// lookupPath returns the value at a dot-separated path of a decoded JSON
+// document
+func lookupPath(doc any, path string) (any, bool) {
+	if path == "" {
+		return doc, true
+	}
+	val := doc
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if val, ok = obj[key]; !ok {
+			return nil, false
+		}
+	}
+	return val, true
+}
+
+// nextPageRequest returns the request for the page following the page
+// returned for req, or nil if it was the last page
+func nextPageRequest(
+	cfg *pb.RestType_Pagination, req *http.Request, header http.Header, page any,
+) (*http.Request, error) {
+	var next *url.URL
+	switch cfg.GetType() {
+	case paginationTypeLink:
+		link := nextLink(header)
+		if link == "" {
+			return nil, nil
+		}
+		u, err := req.URL.Parse(link)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse next page link: %w", err)
+		}
+		// the request carries the credentials of the provider, which must
+		// not be sent anywhere else
+		if u.Scheme != req.URL.Scheme || u.Host != req.URL.Host {
+			return nil, fmt.Errorf("next page %s is not on the host of the endpoint", u.Redacted())
+		}
+		next = u
+	case paginationTypeCursor:
+		cursor, ok := lookupPath(page, cfg.GetCursorPath())
+		if !ok || cursor == nil {
+			return nil, nil
+		}
+		var value string
+		switch c := cursor.(type) {
+		case string:
+			value = c
+		case float64:
+			value = strconv.FormatFloat(c, 'f', -1, 64)
+		default:
+			return nil, fmt.Errorf("cursor at %q is not a string or a number", cfg.GetCursorPath())
+		}
+		if value == "" {
+			return nil, nil
+		}
+		u := *req.URL
+		query := u.Query()
+		query.Set(cfg.GetCursorParam(), value)
+		u.RawQuery = query.Encode()
+		next = &u
+	default:
+		return nil, fmt.Errorf("unknown pagination type %q", cfg.GetType())
+	}
+
+	nextReq := req.Clone(req.Context())
+	nextReq.URL = next
+	nextReq.Host = next.Host
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("cannot rewind request body: %w", err)
+		}
+		nextReq.Body = body
+	}
+	return nextReq, nil
+}
+
+// nextLink returns the URL of the rel="next" link of a Link header (RFC 8288),
+// or an empty string if there is none
+func nextLink(header http.Header) string {
+	for _, value := range header.Values("Link") {
+		for _, link := range strings.Split(value, ",") {
+			segments := strings.Split(link, ";")
+			target := strings.TrimSpace(segments[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range segments[1:] {
+				name, val, ok := strings.Cut(strings.TrimSpace(param), "=")
+				if !ok || !strings.EqualFold(strings.TrimSpace(name), "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(val), `"`)) {
+					if strings.EqualFold(rel, "next") {
+						return strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
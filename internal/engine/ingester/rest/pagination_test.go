@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextLink(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		header []string
+		want   string
+	}{
+		{
+			name: "github style",
+			header: []string{
+				`<https://api.github.com/repositories/1/issues?page=2>; rel="next", ` +
+					`<https://api.github.com/repositories/1/issues?page=5>; rel="last"`,
+			},
+			want: "https://api.github.com/repositories/1/issues?page=2",
+		},
+		{
+			name:   "several relations",
+			header: []string{`</items?page=3>; title="more"; rel="last next"`},
+			want:   "/items?page=3",
+		},
+		{
+			name: "several headers",
+			header: []string{
+				`<https://example.com/items?page=1>; rel="prev"`,
+				`<https://example.com/items?page=3>; rel=next`,
+			},
+			want: "https://example.com/items?page=3",
+		},
+		{
+			name:   "last page",
+			header: []string{`<https://example.com/items?page=1>; rel="first"`},
+			want:   "",
+		},
+		{
+			name: "no header",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			header := http.Header{}
+			for _, h := range tt.header {
+				header.Add("Link", h)
+			}
+			require.Equal(t, tt.want, nextLink(header))
+		})
+	}
+}
@@ -18,10 +18,13 @@ import (
 	"github.com/google/go-github/v63/github"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/santhosh-tekuri/jsonschema/v6"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/schemavalidate"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
 )
@@ -56,6 +59,8 @@ type Ingestor struct {
 	bodyTemplate     *util.SafeTemplate
 	methodTemplate   *util.SafeTemplate
 	fallback         []ingestorFallback
+	retry            retryPolicy
+	responseSchema   *jsonschema.Schema
 }
 
 // NewRestRuleDataIngest creates a new REST rule data ingest engine
@@ -94,6 +99,26 @@ func NewRestRuleDataIngest(
 		})
 	}
 
+	retry, err := newRetryPolicy(restCfg.GetRetry())
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse retry policy: %w", err)
+	}
+
+	if restCfg.GetPagination() != nil && restCfg.GetParse() != "json" {
+		return nil, fmt.Errorf("pagination requires parsing the response as json")
+	}
+
+	var responseSchema *jsonschema.Schema
+	if restCfg.GetResponseSchema() != nil {
+		if restCfg.GetParse() != "json" {
+			return nil, fmt.Errorf("response schema requires parsing the response as json")
+		}
+		responseSchema, err = schemavalidate.CompileSchemaFromPB(restCfg.GetResponseSchema())
+		if err != nil {
+			return nil, fmt.Errorf("cannot compile response schema: %w", err)
+		}
+	}
+
 	return &Ingestor{
 		restCfg:          restCfg,
 		cli:              cli,
@@ -101,6 +126,8 @@ func NewRestRuleDataIngest(
 		bodyTemplate:     bodyTmpl,
 		methodTemplate:   methodTmpl,
 		fallback:         fallback,
+		retry:            retry,
+		responseSchema:   responseSchema,
 	}, nil
 }
 
@@ -160,9 +187,34 @@ func (rdi *Ingestor) Ingest(
 		return nil, fmt.Errorf("cannot create request: %w", err)
 	}
 
-	respRdr, err := rdi.doRequest(ctx, req)
+	var data any
+	if rdi.restCfg.GetPagination() != nil {
+		data, err = rdi.fetchPages(ctx, req)
+	} else {
+		data, _, err = rdi.fetchPage(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if rdi.responseSchema != nil {
+		if err := rdi.validateResponse(data); err != nil {
+			return nil, err
+		}
+	}
+
+	return &interfaces.Ingested{
+		Object:     data,
+		Checkpoint: checkpoints.NewCheckpointV1Now().WithHTTP(endpoint, method),
+	}, nil
+}
+
+// fetchPage does the request and parses the page it returns, along with the
+// headers of the response
+func (rdi *Ingestor) fetchPage(ctx context.Context, req *http.Request) (any, http.Header, error) {
+	respRdr, header, err := rdi.doRequest(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("cannot do request: %w", err)
+		return nil, nil, fmt.Errorf("cannot do request: %w", err)
 	}
 
 	defer func() {
@@ -173,30 +225,97 @@ func (rdi *Ingestor) Ingest(
 
 	data, err := rdi.parseBody(respRdr)
 	if err != nil {
-		return nil, fmt.Errorf("cannot parse body: %w", err)
+		return nil, nil, fmt.Errorf("cannot parse body: %w", err)
 	}
 
-	return &interfaces.Ingested{
-		Object:     data,
-		Checkpoint: checkpoints.NewCheckpointV1Now().WithHTTP(endpoint, method),
-	}, nil
+	return data, header, nil
+}
+
+// fetchPages fetches the pages of a paginated response, up to the maximum
+// number of pages, and combines their items
+func (rdi *Ingestor) fetchPages(ctx context.Context, req *http.Request) (any, error) {
+	cfg := rdi.restCfg.GetPagination()
+	maxPages := int(cfg.GetMaxPages())
+	if maxPages == 0 {
+		maxPages = defaultMaxPages
+	}
+
+	var first any
+	var items []any
+	for page := 1; ; page++ {
+		data, header, err := rdi.fetchPage(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", page, err)
+		}
+		if page == 1 {
+			first = data
+		}
+
+		pgItems, err := pageItems(data, cfg.GetItemsPath())
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", page, err)
+		}
+		items = append(items, pgItems...)
+
+		next, err := nextPageRequest(cfg, req, header, data)
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", page, err)
+		}
+		if next == nil {
+			break
+		}
+		if page >= maxPages {
+			zerolog.Ctx(ctx).Warn().Int("max_pages", maxPages).
+				Msg("REST ingestion reached the maximum number of pages, ignoring the next ones")
+			break
+		}
+		req = next
+	}
+
+	return combinePages(first, cfg.GetItemsPath(), items), nil
+}
+
+// validateResponse checks the parsed response against the response schema of
+// the rule type, so that a change of the upstream API fails with a clear error
+// rather than with a confusing evaluation result
+func (rdi *Ingestor) validateResponse(data any) error {
+	err := schemavalidate.ValidateAgainstSchema(rdi.responseSchema, data)
+	if err == nil {
+		return nil
+	}
+
+	var verr *schemavalidate.ValidationError
+	if !errors.As(err, &verr) {
+		return fmt.Errorf("cannot validate response: %w", err)
+	}
+	problems := make([]string, 0, len(verr.Fields))
+	for _, f := range verr.Fields {
+		problems = append(problems, f.String())
+	}
+	return fmt.Errorf("response does not match the response schema: %s", strings.Join(problems, "; "))
 }
 
-func (rdi *Ingestor) doRequest(ctx context.Context, req *http.Request) (io.ReadCloser, error) {
-	resp, err := rdi.cli.Do(ctx, req)
+func (rdi *Ingestor) doRequest(ctx context.Context, req *http.Request) (io.ReadCloser, http.Header, error) {
+	resp, err := rdi.retry.do(ctx, req, rdi.cli.Do)
 	if err == nil {
 		// Early-exit on success
-		return resp.Body, nil
+		return resp.Body, resp.Header, nil
 	}
 
 	if fallbackBody := errorToFallback(err, rdi.fallback); fallbackBody != nil {
 		// the go-github REST API has a funny way of returning HTTP status codes,
 		// on a non-200 status it will return a github.ErrorResponse
 		// whereas the standard library will return nil error and the HTTP status code in the response
-		return fallbackBody, nil
+		return fallbackBody, nil, nil
+	}
+
+	var respErr *github.ErrorResponse
+	if errors.As(err, &respErr) && respErr.Response != nil &&
+		errors.Is(engerrors.HTTPErrorCodeToErr(respErr.Response.StatusCode), engerrors.ErrServerError) {
+		return nil, nil, fmt.Errorf("%w: cannot make request: %w", engerrors.ErrProviderUnavailable, err)
 	}
 
-	return nil, fmt.Errorf("cannot make request: %w", err)
+	return nil, nil, fmt.Errorf("cannot make request: %w", err)
 }
 
 func errorToFallback(err error, fallback []ingestorFallback) io.ReadCloser {
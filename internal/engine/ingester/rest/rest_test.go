@@ -12,12 +12,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/mindersec/minder/internal/providers/credentials"
 	"github.com/mindersec/minder/internal/providers/github/clients"
@@ -167,6 +169,16 @@ func TestRestIngest(t *testing.T) {
 	graphQLExpected := `{"query":"query { repository(owner: \"OwnerVar\", name: \"NameVar\") { id } }"}` + "\n"
 	graphQLReply := []byte(`{"data": {"repository": {"id": 456}}}`)
 
+	var flakyAttempts, exhaustedAttempts atomic.Int32
+	runsSchema, err := structpb.NewStruct(map[string]any{
+		"type":     "object",
+		"required": []any{"workflow_runs"},
+		"properties": map[string]any{
+			"workflow_runs": map[string]any{"type": "array"},
+		},
+	})
+	require.NoError(t, err)
+
 	type ingestArgs struct {
 		ent    protoreflect.ProtoMessage
 		params map[string]any
@@ -333,6 +345,203 @@ func TestRestIngest(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "link pagination",
+			newIngArgs: newRestIngestArgs{
+				restCfg: &pb.RestType{
+					Endpoint: `/repos/{{.Entity.Owner}}/{{.Entity.Name}}/actions/runs`,
+					Parse:    "json",
+					Pagination: &pb.RestType_Pagination{
+						Type:      "link",
+						ItemsPath: "workflow_runs",
+					},
+				},
+			},
+			ingArgs: ingestArgs{
+				ent: &pb.Repository{Owner: "OwnerVar", Name: "NameVar"},
+			},
+			testHandler: func(writer http.ResponseWriter, request *http.Request) {
+				assert.Equal(t, "/repos/OwnerVar/NameVar/actions/runs", request.URL.Path, "unexpected path")
+				if request.URL.Query().Get("page") == "2" {
+					_, err := writer.Write([]byte(`{"total_count": 3, "workflow_runs": [{"id": 3}]}`))
+					assert.NoError(t, err, "unexpected error writing response")
+					return
+				}
+				next := "http://" + request.Host + request.URL.Path + "?page=2"
+				writer.Header().Set("Link", `<`+next+`>; rel="next", <`+next+`>; rel="last"`)
+				_, err := writer.Write([]byte(`{"total_count": 3, "workflow_runs": [{"id": 1}, {"id": 2}]}`))
+				assert.NoError(t, err, "unexpected error writing response")
+			},
+			ingResultFn: func() *interfaces.Ingested {
+				return &interfaces.Ingested{
+					Object: map[string]any{
+						"total_count": float64(3),
+						"workflow_runs": []any{
+							map[string]any{"id": float64(1)},
+							map[string]any{"id": float64(2)},
+							map[string]any{"id": float64(3)},
+						},
+					},
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "link pagination to another host",
+			newIngArgs: newRestIngestArgs{
+				restCfg: &pb.RestType{
+					Endpoint: `/repos/{{.Entity.Owner}}/{{.Entity.Name}}/actions/runs`,
+					Parse:    "json",
+					Pagination: &pb.RestType_Pagination{
+						Type:      "link",
+						ItemsPath: "workflow_runs",
+					},
+				},
+			},
+			ingArgs: ingestArgs{
+				ent: &pb.Repository{Owner: "OwnerVar", Name: "NameVar"},
+			},
+			testHandler: func(writer http.ResponseWriter, _ *http.Request) {
+				writer.Header().Set("Link", `<https://attacker.example.com/runs?page=2>; rel="next"`)
+				_, err := writer.Write([]byte(`{"workflow_runs": [{"id": 1}]}`))
+				assert.NoError(t, err, "unexpected error writing response")
+			},
+			wantErr: true,
+		},
+		{
+			name: "cursor pagination",
+			newIngArgs: newRestIngestArgs{
+				restCfg: &pb.RestType{
+					Endpoint: `/repos/{{.Entity.Owner}}/{{.Entity.Name}}/alerts`,
+					Parse:    "json",
+					Pagination: &pb.RestType_Pagination{
+						Type:        "cursor",
+						ItemsPath:   "items",
+						CursorPath:  "meta.next",
+						CursorParam: "after",
+						MaxPages:    2,
+					},
+				},
+			},
+			ingArgs: ingestArgs{
+				ent: &pb.Repository{Owner: "OwnerVar", Name: "NameVar"},
+			},
+			testHandler: func(writer http.ResponseWriter, request *http.Request) {
+				var reply string
+				switch request.URL.Query().Get("after") {
+				case "":
+					reply = `{"items": ["a", "b"], "meta": {"next": "c1"}}`
+				case "c1":
+					reply = `{"items": ["c"], "meta": {"next": "c2"}}`
+				default:
+					t.Errorf("unexpected page after the maximum number of pages")
+				}
+				_, err := writer.Write([]byte(reply))
+				assert.NoError(t, err, "unexpected error writing response")
+			},
+			ingResultFn: func() *interfaces.Ingested {
+				return &interfaces.Ingested{
+					Object: map[string]any{
+						"items": []any{"a", "b", "c"},
+						"meta":  map[string]any{"next": "c1"},
+					},
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "retry transient error",
+			newIngArgs: newRestIngestArgs{
+				restCfg: &pb.RestType{
+					Endpoint: `/repos/{{.Entity.Owner}}/{{.Entity.Name}}`,
+					Parse:    "json",
+					Retry: &pb.RestType_Retry{
+						MaxAttempts:    3,
+						InitialBackoff: "1ms",
+					},
+				},
+			},
+			ingArgs: ingestArgs{
+				ent: &pb.Repository{Owner: "OwnerVar", Name: "NameVar"},
+			},
+			testHandler: func(writer http.ResponseWriter, _ *http.Request) {
+				if flakyAttempts.Add(1) < 3 {
+					writer.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				_, err := writer.Write([]byte(`{"delete_branch_on_merge": true}`))
+				assert.NoError(t, err, "unexpected error writing response")
+			},
+			ingResultFn: func() *interfaces.Ingested {
+				return &interfaces.Ingested{
+					Object: map[string]any{"delete_branch_on_merge": true},
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "retries exhausted",
+			newIngArgs: newRestIngestArgs{
+				restCfg: &pb.RestType{
+					Endpoint: `/repos/{{.Entity.Owner}}/{{.Entity.Name}}`,
+					Parse:    "json",
+					Retry: &pb.RestType_Retry{
+						MaxAttempts:    2,
+						InitialBackoff: "1ms",
+						RetryOn:        []int32{http.StatusTooManyRequests},
+					},
+				},
+			},
+			ingArgs: ingestArgs{
+				ent: &pb.Repository{Owner: "OwnerVar", Name: "NameVar"},
+			},
+			testHandler: func(writer http.ResponseWriter, _ *http.Request) {
+				assert.LessOrEqual(t, exhaustedAttempts.Add(1), int32(2), "unexpected attempt")
+				writer.WriteHeader(http.StatusTooManyRequests)
+			},
+			wantErr: true,
+		},
+		{
+			name: "response matching schema",
+			newIngArgs: newRestIngestArgs{
+				restCfg: &pb.RestType{
+					Endpoint:       `/repos/{{.Entity.Owner}}/{{.Entity.Name}}/actions/runs`,
+					Parse:          "json",
+					ResponseSchema: runsSchema,
+				},
+			},
+			ingArgs: ingestArgs{
+				ent: &pb.Repository{Owner: "OwnerVar", Name: "NameVar"},
+			},
+			testHandler: func(writer http.ResponseWriter, _ *http.Request) {
+				_, err := writer.Write([]byte(`{"workflow_runs": []}`))
+				assert.NoError(t, err, "unexpected error writing response")
+			},
+			ingResultFn: func() *interfaces.Ingested {
+				return &interfaces.Ingested{
+					Object: map[string]any{"workflow_runs": []any{}},
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "response not matching schema",
+			newIngArgs: newRestIngestArgs{
+				restCfg: &pb.RestType{
+					Endpoint:       `/repos/{{.Entity.Owner}}/{{.Entity.Name}}/actions/runs`,
+					Parse:          "json",
+					ResponseSchema: runsSchema,
+				},
+			},
+			ingArgs: ingestArgs{
+				ent: &pb.Repository{Owner: "OwnerVar", Name: "NameVar"},
+			},
+			testHandler: func(writer http.ResponseWriter, _ *http.Request) {
+				_, err := writer.Write([]byte(`{"runs": []}`))
+				assert.NoError(t, err, "unexpected error writing response")
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// defaultInitialBackoff is the delay before the first retry of a request
+	defaultInitialBackoff = time.Second
+	// maxBackoff caps the delay between two attempts of a request, including
+	// the delays requested by the server with the Retry-After header
+	maxBackoff = 30 * time.Second
+)
+
+// defaultRetryOn are the HTTP status codes retried if the rule type doesn't
+// list any: rate limits and transient server errors
+var defaultRetryOn = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// retryPolicy decides whether and when failed requests are attempted again.
+// The zero value never retries.
+type retryPolicy struct {
+	maxAttempts    int
+	initialBackoff time.Duration
+	retryOn        []int
+}
+
+func newRetryPolicy(cfg *pb.RestType_Retry) (retryPolicy, error) {
+	if cfg == nil {
+		return retryPolicy{maxAttempts: 1}, nil
+	}
+
+	initialBackoff := defaultInitialBackoff
+	if cfg.GetInitialBackoff() != "" {
+		var err error
+		initialBackoff, err = time.ParseDuration(cfg.GetInitialBackoff())
+		if err != nil {
+			return retryPolicy{}, fmt.Errorf("invalid initial backoff: %w", err)
+		}
+		if initialBackoff <= 0 {
+			return retryPolicy{}, fmt.Errorf("initial backoff must be positive")
+		}
+	}
+
+	retryOn := defaultRetryOn
+	if len(cfg.GetRetryOn()) > 0 {
+		retryOn = make([]int, 0, len(cfg.GetRetryOn()))
+		for _, code := range cfg.GetRetryOn() {
+			retryOn = append(retryOn, int(code))
+		}
+	}
+
+	return retryPolicy{
+		maxAttempts:    max(int(cfg.GetMaxAttempts()), 1),
+		initialBackoff: initialBackoff,
+		retryOn:        retryOn,
+	}, nil
+}
+
+// shouldRetry returns whether the given attempt, counted from 1, is followed
+// by another one after the response it got
+func (p retryPolicy) shouldRetry(attempt int, resp *http.Response) bool {
+	if attempt >= p.maxAttempts || resp == nil {
+		return false
+	}
+	return slices.Contains(p.retryOn, resp.StatusCode)
+}
+
+// backoff returns the delay before the attempt following the given one. The
+// delay doubles with each attempt, unless the server asked for a longer one.
+func (p retryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	delay := p.initialBackoff << (attempt - 1)
+	if delay <= 0 || delay > maxBackoff {
+		delay = maxBackoff
+	}
+
+	if resp != nil {
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs > 0 {
+			delay = max(delay, min(time.Duration(secs)*time.Second, maxBackoff))
+		}
+	}
+
+	return delay
+}
+
+// do sends the request, and sends it again while it fails with one of the
+// status codes to retry. The response and error of the last attempt are
+// returned.
+func (p retryPolicy) do(
+	ctx context.Context, req *http.Request,
+	send func(context.Context, *http.Request) (*http.Response, error),
+) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := send(ctx, req)
+		if !p.shouldRetry(attempt, resp) {
+			return resp, err
+		}
+
+		delay := p.backoff(attempt, resp)
+		zerolog.Ctx(ctx).Debug().
+			Int("status", resp.StatusCode).
+			Int("attempt", attempt).
+			Dur("backoff", delay).
+			Msg("retrying REST ingestion request")
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+
+		// the body of the previous attempt has been consumed
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("cannot rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package runners provides the runners rule data ingest engine, which
+// collects the self-hosted GitHub Actions runners and runner groups of
+// repositories and organizations.
+package runners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v63/github"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// RunnersRuleDataIngestType is the type of the runners rule data ingest engine
+	RunnersRuleDataIngestType = "runners"
+)
+
+// Runners is the engine for a rule type that uses runners data ingest
+type Runners struct {
+	cfg    *pb.RunnersType
+	lister interfaces.GitHubRunnerLister
+}
+
+// NewRunnersIngester creates a new runners rule data ingest engine
+func NewRunnersIngester(
+	cfg *pb.RunnersType, lister interfaces.GitHubRunnerLister,
+) (*Runners, error) {
+	if lister == nil {
+		return nil, fmt.Errorf("provider is nil")
+	}
+
+	if cfg == nil {
+		cfg = &pb.RunnersType{}
+	}
+
+	return &Runners{
+		cfg:    cfg,
+		lister: lister,
+	}, nil
+}
+
+// GetType returns the type of the runners rule data ingest engine
+func (*Runners) GetType() string {
+	return RunnersRuleDataIngestType
+}
+
+// GetConfig returns the config for the runners rule data ingest engine
+func (r *Runners) GetConfig() protoreflect.ProtoMessage {
+	return r.cfg
+}
+
+// Ingest lists the self-hosted runners available to the entity. For
+// repositories, the ingested object contains the "runners" registered on the
+// repository and the "runner_groups" of the organization the repository can
+// use. For organizations, it contains all the "runner_groups" of the
+// organization and all their "runners". Each runner has its "id", "name",
+// "os", "status", whether it is "busy" and its "labels". Each runner group has
+// its "id", "name", "visibility", whether it is the "default" group, whether
+// it is "inherited" from the enterprise, whether it "allows_public_repositories",
+// whether it is "restricted_to_workflows" and to which "selected_workflows",
+// and its "runners".
+func (r *Runners) Ingest(
+	ctx context.Context, ent protoreflect.ProtoMessage, _ map[string]any,
+) (*interfaces.Ingested, error) {
+	var obj map[string]any
+	var err error
+
+	switch e := ent.(type) {
+	case *pb.Repository:
+		obj, err = r.ingestRepository(ctx, e)
+	case *pb.EntityInstance:
+		if e.GetType() != pb.Entity_ENTITY_ORGANIZATION {
+			return nil, fmt.Errorf("%w: runners is only supported for repositories and organizations",
+				evalerrors.ErrIngestionNotApplicable)
+		}
+		obj, err = r.ingestOrganization(ctx, e.GetName())
+	default:
+		return nil, fmt.Errorf("%w: runners is only supported for repositories and organizations",
+			evalerrors.ErrIngestionNotApplicable)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &interfaces.Ingested{
+		Object: obj,
+	}, nil
+}
+
+func (r *Runners) ingestRepository(ctx context.Context, repo *pb.Repository) (map[string]any, error) {
+	owner, name := repo.GetOwner(), repo.GetName()
+
+	runners, err := r.lister.ListRepoRunners(ctx, owner, name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list runners of %s/%s: %w", owner, name, err)
+	}
+
+	// Repositories owned by users have no runner groups.
+	groups, _, err := r.runnerGroups(ctx, owner, name)
+	if errors.Is(err, provifv1.ErrEntityNotFound) {
+		groups = []any{}
+	} else if err != nil {
+		return nil, fmt.Errorf("cannot list runner groups of %s/%s: %w", owner, name, err)
+	}
+
+	return map[string]any{
+		"runners":       runnerList(runners),
+		"runner_groups": groups,
+	}, nil
+}
+
+func (r *Runners) ingestOrganization(ctx context.Context, org string) (map[string]any, error) {
+	groups, runners, err := r.runnerGroups(ctx, org, "")
+	if err != nil {
+		return nil, fmt.Errorf("cannot list runner groups of %s: %w", org, err)
+	}
+
+	return map[string]any{
+		"runners":       runners,
+		"runner_groups": groups,
+	}, nil
+}
+
+// runnerGroups lists the runner groups of the organization, with their
+// runners, and returns them along with the runners of all the groups. If
+// visibleToRepo is set, only the groups the repository can use are listed.
+func (r *Runners) runnerGroups(ctx context.Context, org, visibleToRepo string) ([]any, []any, error) {
+	groups, err := r.lister.ListOrgRunnerGroups(ctx, org, visibleToRepo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]any, 0, len(groups))
+	allRunners := []any{}
+	for _, g := range groups {
+		runners, err := r.lister.ListRunnerGroupRunners(ctx, org, g.GetID())
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot list runners of group %s: %w", g.GetName(), err)
+		}
+		groupRunners := runnerList(runners)
+		allRunners = append(allRunners, groupRunners...)
+
+		workflows := make([]any, 0, len(g.SelectedWorkflows))
+		for _, w := range g.SelectedWorkflows {
+			workflows = append(workflows, w)
+		}
+
+		out = append(out, map[string]any{
+			"id":                         g.GetID(),
+			"name":                       g.GetName(),
+			"visibility":                 g.GetVisibility(),
+			"default":                    g.GetDefault(),
+			"inherited":                  g.GetInherited(),
+			"allows_public_repositories": g.GetAllowsPublicRepositories(),
+			"restricted_to_workflows":    g.GetRestrictedToWorkflows(),
+			"selected_workflows":         workflows,
+			"runners":                    groupRunners,
+		})
+	}
+
+	return out, allRunners, nil
+}
+
+func runnerList(runners []*github.Runner) []any {
+	out := make([]any, 0, len(runners))
+	for _, r := range runners {
+		labels := make([]any, 0, len(r.Labels))
+		for _, l := range r.Labels {
+			labels = append(labels, l.GetName())
+		}
+
+		out = append(out, map[string]any{
+			"id":     r.GetID(),
+			"name":   r.GetName(),
+			"os":     r.GetOS(),
+			"status": r.GetStatus(),
+			"busy":   r.GetBusy(),
+			"labels": labels,
+		})
+	}
+	return out
+}
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package runners
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/util/ptr"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+type fakeLister struct {
+	repoRunners  []*github.Runner
+	groups       []*github.RunnerGroup
+	groupRunners map[int64][]*github.Runner
+	groupsErr    error
+	visibleTo    string
+}
+
+func (f *fakeLister) ListRepoRunners(_ context.Context, _, _ string) ([]*github.Runner, error) {
+	return f.repoRunners, nil
+}
+
+func (f *fakeLister) ListOrgRunnerGroups(_ context.Context, _, visibleToRepo string) ([]*github.RunnerGroup, error) {
+	f.visibleTo = visibleToRepo
+	return f.groups, f.groupsErr
+}
+
+func (f *fakeLister) ListRunnerGroupRunners(_ context.Context, _ string, groupID int64) ([]*github.Runner, error) {
+	return f.groupRunners[groupID], nil
+}
+
+func newRunner(id int64, name string) *github.Runner {
+	return &github.Runner{
+		ID:     ptr.Ptr(id),
+		Name:   ptr.Ptr(name),
+		OS:     ptr.Ptr("linux"),
+		Status: ptr.Ptr("online"),
+		Busy:   ptr.Ptr(false),
+		Labels: []*github.RunnerLabels{{Name: ptr.Ptr("self-hosted")}, {Name: ptr.Ptr("linux")}},
+	}
+}
+
+func runnerObject(id int64, name string) map[string]any {
+	return map[string]any{
+		"id":     id,
+		"name":   name,
+		"os":     "linux",
+		"status": "online",
+		"busy":   false,
+		"labels": []any{"self-hosted", "linux"},
+	}
+}
+
+func TestIngestRepository(t *testing.T) {
+	t.Parallel()
+
+	lister := &fakeLister{
+		repoRunners: []*github.Runner{newRunner(1, "repo-runner")},
+		groups: []*github.RunnerGroup{{
+			ID:                       ptr.Ptr(int64(10)),
+			Name:                     ptr.Ptr("Default"),
+			Visibility:               ptr.Ptr("all"),
+			Default:                  ptr.Ptr(true),
+			AllowsPublicRepositories: ptr.Ptr(true),
+			SelectedWorkflows:        []string{"mindersec/minder/.github/workflows/build.yml@main"},
+		}},
+		groupRunners: map[int64][]*github.Runner{10: {newRunner(2, "org-runner")}},
+	}
+
+	ing, err := NewRunnersIngester(nil, lister)
+	require.NoError(t, err)
+
+	got, err := ing.Ingest(context.Background(), &pb.Repository{Owner: "mindersec", Name: "minder"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "minder", lister.visibleTo)
+
+	obj := got.Object.(map[string]any)
+	require.Equal(t, []any{runnerObject(1, "repo-runner")}, obj["runners"])
+	require.Equal(t, []any{map[string]any{
+		"id":                         int64(10),
+		"name":                       "Default",
+		"visibility":                 "all",
+		"default":                    true,
+		"inherited":                  false,
+		"allows_public_repositories": true,
+		"restricted_to_workflows":    false,
+		"selected_workflows":         []any{"mindersec/minder/.github/workflows/build.yml@main"},
+		"runners":                    []any{runnerObject(2, "org-runner")},
+	}}, obj["runner_groups"])
+}
+
+func TestIngestUserRepository(t *testing.T) {
+	t.Parallel()
+
+	lister := &fakeLister{groupsErr: provifv1.ErrEntityNotFound}
+
+	ing, err := NewRunnersIngester(nil, lister)
+	require.NoError(t, err)
+
+	got, err := ing.Ingest(context.Background(), &pb.Repository{Owner: "jdoe", Name: "dotfiles"}, nil)
+	require.NoError(t, err)
+
+	obj := got.Object.(map[string]any)
+	require.Empty(t, obj["runners"])
+	require.Empty(t, obj["runner_groups"])
+}
+
+func TestIngestOrganization(t *testing.T) {
+	t.Parallel()
+
+	lister := &fakeLister{
+		groups: []*github.RunnerGroup{
+			{ID: ptr.Ptr(int64(10)), Name: ptr.Ptr("Default")},
+			{ID: ptr.Ptr(int64(11)), Name: ptr.Ptr("Deploy")},
+		},
+		groupRunners: map[int64][]*github.Runner{
+			10: {newRunner(1, "build")},
+			11: {newRunner(2, "deploy")},
+		},
+	}
+
+	ing, err := NewRunnersIngester(nil, lister)
+	require.NoError(t, err)
+
+	got, err := ing.Ingest(context.Background(), &pb.EntityInstance{
+		Type: pb.Entity_ENTITY_ORGANIZATION,
+		Name: "mindersec",
+	}, nil)
+	require.NoError(t, err)
+	require.Empty(t, lister.visibleTo)
+
+	obj := got.Object.(map[string]any)
+	require.Equal(t, []any{runnerObject(1, "build"), runnerObject(2, "deploy")}, obj["runners"])
+	require.Len(t, obj["runner_groups"], 2)
+}
+
+func TestIngestNotApplicable(t *testing.T) {
+	t.Parallel()
+
+	ing, err := NewRunnersIngester(nil, &fakeLister{})
+	require.NoError(t, err)
+
+	_, err = ing.Ingest(context.Background(), &pb.Artifact{}, nil)
+	require.ErrorIs(t, err, evalerrors.ErrIngestionNotApplicable)
+
+	_, err = ing.Ingest(context.Background(), &pb.EntityInstance{Type: pb.Entity_ENTITY_RELEASE}, nil)
+	require.ErrorIs(t, err, evalerrors.ErrIngestionNotApplicable)
+}
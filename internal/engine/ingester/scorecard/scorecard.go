@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scorecard provides the OpenSSF Scorecard rule data ingest engine
+package scorecard
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	"github.com/mindersec/minder/internal/util/cache"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
+)
+
+const (
+	// ScorecardRuleDataIngestType is the type of the scorecard rule data ingest engine
+	ScorecardRuleDataIngestType = "scorecard"
+
+	// DefaultEndpoint is the base URL of the public OpenSSF Scorecard API
+	DefaultEndpoint = "https://api.scorecard.dev"
+
+	// MaxBytesLimit is the maximum number of bytes to read from the response body
+	MaxBytesLimit int64 = 1 << 20
+
+	// Scorecard results are published weekly, so there's no point in
+	// fetching them for every evaluation.
+	resultsCacheExpiration = time.Hour
+	// minRequestInterval spaces out the requests to the Scorecard API, which
+	// is shared by all the projects of the server.
+	minRequestInterval = 100 * time.Millisecond
+	requestTimeout     = 10 * time.Second
+)
+
+var (
+	resultsCacheOnce sync.Once
+	resultsCache     *cache.ExpiringCache[*result]
+
+	requests = &limiter{interval: minRequestInterval}
+)
+
+// Scorecard is the engine for a rule type that uses OpenSSF Scorecard data ingest
+type Scorecard struct {
+	cfg      *pb.ScorecardType
+	endpoint string
+	cli      *http.Client
+	results  cache.Cacher[*result]
+}
+
+// NewScorecardIngester creates a new scorecard rule data ingest engine
+func NewScorecardIngester(cfg *pb.ScorecardType) (*Scorecard, error) {
+	if cfg == nil {
+		cfg = &pb.ScorecardType{}
+	}
+
+	resultsCacheOnce.Do(func() {
+		resultsCache = cache.NewExpiringCache[*result](context.Background(), &cache.ExpiringCacheConfig{
+			EvictionTime: resultsCacheExpiration,
+		})
+	})
+
+	return &Scorecard{
+		cfg:      cfg,
+		endpoint: strings.TrimSuffix(cmp.Or(cfg.GetEndpoint(), DefaultEndpoint), "/"),
+		cli: &http.Client{
+			Timeout: requestTimeout,
+			// The endpoint is set by the rule type, so don't allow
+			// calling non-public addresses.
+			Transport: rego.LimitedDialer(nil),
+		},
+		results: resultsCache,
+	}, nil
+}
+
+// GetType returns the type of the scorecard rule data ingest engine
+func (*Scorecard) GetType() string {
+	return ScorecardRuleDataIngestType
+}
+
+// GetConfig returns the config for the scorecard rule data ingest engine
+func (s *Scorecard) GetConfig() protoreflect.ProtoMessage {
+	return s.cfg
+}
+
+// Ingest fetches the latest OpenSSF Scorecard results of a repository. The
+// ingested object contains the overall "score", the "date" and "commit" the
+// results were computed on, and the "checks" indexed by name, each with its
+// "score" and "reason".
+func (s *Scorecard) Ingest(ctx context.Context, ent protoreflect.ProtoMessage, _ map[string]any) (*interfaces.Ingested, error) {
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("scorecard is only supported for repositories")
+	}
+
+	project, err := projectName(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.getResult(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, engerrors.NewErrEvaluationSkipped("no OpenSSF Scorecard results found for %s", project)
+	}
+
+	checks := make(map[string]any, len(res.Checks))
+	for _, c := range res.Checks {
+		checks[c.Name] = map[string]any{
+			"score":  c.Score,
+			"reason": c.Reason,
+		}
+	}
+
+	return &interfaces.Ingested{
+		Object: map[string]any{
+			"repository": project,
+			"date":       res.Date,
+			"commit":     res.Repo.Commit,
+			"score":      res.Score,
+			"checks":     checks,
+		},
+		Checkpoint: checkpoints.NewCheckpointV1Now().WithCommitHash(res.Repo.Commit),
+	}, nil
+}
+
+// getResult returns the Scorecard results of the project, or nil if the
+// project hasn't been scored.
+func (s *Scorecard) getResult(ctx context.Context, project string) (*result, error) {
+	key := s.endpoint + "/" + project
+	if res, ok := s.results.Get(key); ok {
+		return res, nil
+	}
+
+	if err := requests.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+"/projects/"+project, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create scorecard request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.cli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot fetch scorecard results: %w", engerrors.ErrIngestionFailed, err)
+	}
+	defer resp.Body.Close()
+
+	var res *result
+	switch resp.StatusCode {
+	case http.StatusOK:
+		res = &result{}
+		if err := json.NewDecoder(io.LimitReader(resp.Body, MaxBytesLimit)).Decode(res); err != nil {
+			return nil, fmt.Errorf("%w: cannot decode scorecard results: %w", engerrors.ErrIngestionFailed, err)
+		}
+	case http.StatusNotFound:
+		zerolog.Ctx(ctx).Debug().Str("project", project).Msg("project has no scorecard results")
+	default:
+		return nil, fmt.Errorf("%w: unexpected status code from scorecard API: %d",
+			engerrors.ErrIngestionFailed, resp.StatusCode)
+	}
+
+	// Cache misses as well, so unscored projects don't hit the API every time
+	s.results.Set(key, res)
+	return res, nil
+}
+
+// projectName returns the name of the repository as known by Scorecard,
+// e.g. "github.com/mindersec/minder".
+func projectName(repo *pb.Repository) (string, error) {
+	if repo.GetOwner() == "" || repo.GetName() == "" {
+		return "", fmt.Errorf("repository owner and name are required")
+	}
+
+	host := "github.com"
+	if repo.GetCloneUrl() != "" {
+		u, err := url.Parse(repo.GetCloneUrl())
+		if err != nil {
+			return "", fmt.Errorf("cannot parse clone url: %w", err)
+		}
+		host = cmp.Or(u.Hostname(), host)
+	}
+
+	return host + "/" + url.PathEscape(repo.GetOwner()) + "/" + url.PathEscape(repo.GetName()), nil
+}
+
+type result struct {
+	Date string `json:"date"`
+	Repo struct {
+		Name   string `json:"name"`
+		Commit string `json:"commit"`
+	} `json:"repo"`
+	Score  float64 `json:"score"`
+	Checks []struct {
+		Name   string  `json:"name"`
+		Score  float64 `json:"score"`
+		Reason string  `json:"reason"`
+	} `json:"checks"`
+}
+
+// limiter spaces out requests so they are at least interval apart
+type limiter struct {
+	mu       sync.Mutex
+	next     time.Time
+	interval time.Duration
+}
+
+func (l *limiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(start)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
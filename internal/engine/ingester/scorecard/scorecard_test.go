@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scorecard
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const minderResults = `{
+	"date": "2024-11-25",
+	"repo": {"name": "github.com/mindersec/minder", "commit": "2f1c4a5b"},
+	"score": 7.4,
+	"checks": [
+		{"name": "Maintained", "score": 10, "reason": "30 commit(s) and 12 issue activity found in the last 90 days"},
+		{"name": "Code-Review", "score": 8, "reason": "Found 24/30 approved changesets"}
+	]
+}`
+
+func newTestIngester(t *testing.T, handler http.HandlerFunc) (*Scorecard, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	sc, err := NewScorecardIngester(&pb.ScorecardType{Endpoint: srv.URL})
+	require.NoError(t, err)
+	// allow requests to the local test server
+	sc.cli.Transport = http.DefaultTransport
+
+	return sc, &calls
+}
+
+func TestScorecardIngest(t *testing.T) {
+	t.Parallel()
+
+	sc, calls := newTestIngester(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/github.com/mindersec/minder" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(minderResults))
+	})
+
+	repo := &pb.Repository{
+		Owner:    "mindersec",
+		Name:     "minder",
+		CloneUrl: "https://github.com/mindersec/minder.git",
+	}
+
+	got, err := sc.Ingest(context.Background(), repo, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"repository": "github.com/mindersec/minder",
+		"date":       "2024-11-25",
+		"commit":     "2f1c4a5b",
+		"score":      7.4,
+		"checks": map[string]any{
+			"Maintained": map[string]any{
+				"score":  10.0,
+				"reason": "30 commit(s) and 12 issue activity found in the last 90 days",
+			},
+			"Code-Review": map[string]any{
+				"score":  8.0,
+				"reason": "Found 24/30 approved changesets",
+			},
+		},
+	}, got.Object)
+	require.Equal(t, "2f1c4a5b", *got.Checkpoint.Checkpoint.CommitHash)
+
+	// The results are cached
+	_, err = sc.Ingest(context.Background(), repo, nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestScorecardIngestNotScored(t *testing.T) {
+	t.Parallel()
+
+	sc, calls := newTestIngester(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	repo := &pb.Repository{Owner: "acme", Name: "unscored"}
+	for range 2 {
+		_, err := sc.Ingest(context.Background(), repo, nil)
+		require.ErrorIs(t, err, interfaces.ErrEvaluationSkipped)
+	}
+	// Misses are cached as well
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestScorecardIngestErrors(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := newTestIngester(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/projects/github.com/acme/broken" {
+			_, _ = w.Write([]byte(`{not json`))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := sc.Ingest(context.Background(), &pb.Repository{Owner: "acme", Name: "broken"}, nil)
+	require.ErrorIs(t, err, engerrors.ErrIngestionFailed)
+	require.ErrorContains(t, err, "cannot decode scorecard results")
+
+	_, err = sc.Ingest(context.Background(), &pb.Repository{Owner: "acme", Name: "down"}, nil)
+	require.ErrorIs(t, err, engerrors.ErrIngestionFailed)
+	require.ErrorContains(t, err, "unexpected status code from scorecard API: 500")
+
+	_, err = sc.Ingest(context.Background(), &pb.Artifact{Name: "image"}, nil)
+	require.ErrorContains(t, err, "scorecard is only supported for repositories")
+}
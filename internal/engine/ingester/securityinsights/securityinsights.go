@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package securityinsights provides the security_insights rule data ingest
+// engine, which collects the security health files of a repository.
+package securityinsights
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+
+	gitingester "github.com/mindersec/minder/internal/engine/ingester/git"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	// SecurityInsightsRuleDataIngestType is the type of the security insights rule data ingest engine
+	SecurityInsightsRuleDataIngestType = "security_insights"
+
+	// MaxFileBytes is the maximum number of bytes read from each file.
+	// Longer files are truncated.
+	MaxFileBytes int64 = 256 << 10
+
+	defaultBranch = "main"
+)
+
+// searchDirs are the directories searched for the files, in order of
+// precedence. This is the same lookup GitHub does for community health files.
+var searchDirs = []string{".github", "", "docs"}
+
+// fileKind describes a security health file, matched by its name without
+// extension, case-insensitively.
+type fileKind struct {
+	key        string
+	names      []string
+	extensions []string
+}
+
+var documentExtensions = []string{"", ".md", ".markdown", ".rst", ".txt", ".adoc"}
+
+// securityInsightsKey is the key of the security insights file, which is
+// parsed in addition to being collected.
+const securityInsightsKey = "security_insights"
+
+var fileKinds = []fileKind{
+	{key: "security_policy", names: []string{"security"}, extensions: documentExtensions},
+	{key: securityInsightsKey, names: []string{"security-insights"}, extensions: []string{".yml", ".yaml"}},
+	{key: "code_of_conduct", names: []string{"code_of_conduct", "code-of-conduct"}, extensions: documentExtensions},
+	{key: "governance", names: []string{"governance"}, extensions: documentExtensions},
+	{key: "contributing", names: []string{"contributing"}, extensions: documentExtensions},
+	{key: "maintainers", names: []string{"maintainers"}, extensions: documentExtensions},
+	{key: "codeowners", names: []string{"codeowners"}, extensions: []string{""}},
+}
+
+// IngesterConfig is the profile-provided configuration for the security
+// insights ingester
+type IngesterConfig struct {
+	Branch string `json:"branch" yaml:"branch" mapstructure:"branch"`
+}
+
+// SecurityInsights is the engine for a rule type that uses security insights data ingest
+type SecurityInsights struct {
+	cfg     *pb.SecurityInsightsType
+	gitprov interfaces.GitProvider
+}
+
+// NewSecurityInsightsIngester creates a new security insights rule data ingest engine
+func NewSecurityInsightsIngester(
+	cfg *pb.SecurityInsightsType, gitprov interfaces.GitProvider,
+) (*SecurityInsights, error) {
+	if gitprov == nil {
+		return nil, fmt.Errorf("provider is nil")
+	}
+
+	if cfg == nil {
+		cfg = &pb.SecurityInsightsType{}
+	}
+
+	return &SecurityInsights{
+		cfg:     cfg,
+		gitprov: gitprov,
+	}, nil
+}
+
+// GetType returns the type of the security insights rule data ingest engine
+func (*SecurityInsights) GetType() string {
+	return SecurityInsightsRuleDataIngestType
+}
+
+// GetConfig returns the config for the security insights rule data ingest engine
+func (si *SecurityInsights) GetConfig() protoreflect.ProtoMessage {
+	return si.cfg
+}
+
+// Ingest clones the repository and collects its security health files. The
+// ingested object contains the "files" indexed by kind (e.g.
+// "security_policy" or "code_of_conduct"), each with whether it is "present",
+// its "path" and "content", and the parsed "security_insights" document, if
+// any, or the "security_insights_error" if it can't be parsed. The cloned filesystem is exposed as well, so rules can still use the
+// file functions.
+func (si *SecurityInsights) Ingest(
+	ctx context.Context, ent protoreflect.ProtoMessage, params map[string]any,
+) (*interfaces.Ingested, error) {
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("%w: security_insights is only supported for repositories",
+			evalerrors.ErrIngestionNotApplicable)
+	}
+
+	userCfg := &IngesterConfig{}
+	if err := mapstructure.Decode(params, userCfg); err != nil {
+		return nil, fmt.Errorf("failed to read security insights ingester configuration from params: %w", err)
+	}
+
+	if repo.GetCloneUrl() == "" {
+		return nil, fmt.Errorf("could not get clone url")
+	}
+
+	branch := cmp.Or(userCfg.Branch, si.cfg.GetBranch(), repo.GetDefaultBranch(), defaultBranch)
+	ingested, err := gitingester.Checkout(ctx, si.gitprov, repo.GetCloneUrl(), branch)
+	if err != nil {
+		return nil, err
+	}
+
+	ingested.Object, err = collect(ctx, ingested.Fs)
+	if err != nil {
+		return nil, err
+	}
+	return ingested, nil
+}
+
+// collect looks up every kind of security health file in the filesystem and
+// returns the normalized ingested object.
+func collect(ctx context.Context, fs billy.Filesystem) (map[string]any, error) {
+	files := make(map[string]any, len(fileKinds))
+	out := map[string]any{
+		"files":                   files,
+		"security_insights":       nil,
+		"security_insights_error": "",
+	}
+
+	for _, kind := range fileKinds {
+		found, err := findFile(fs, kind)
+		if err != nil {
+			return nil, err
+		}
+		if found == "" {
+			files[kind.key] = map[string]any{
+				"present":   false,
+				"path":      "",
+				"content":   "",
+				"truncated": false,
+			}
+			continue
+		}
+
+		buf, err := gitingester.ReadFile(fs, found, MaxFileBytes)
+		truncated := errors.Is(err, gitingester.ErrFileTooLarge)
+		if err != nil && !truncated {
+			return nil, err
+		}
+		content := string(buf)
+		files[kind.key] = map[string]any{
+			"present":   true,
+			"path":      found,
+			"content":   content,
+			"truncated": truncated,
+		}
+
+		if kind.key == securityInsightsKey && !truncated {
+			var doc map[string]any
+			if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+				// An invalid document is reported to the rules, which can
+				// decide whether that's a failure.
+				zerolog.Ctx(ctx).Debug().Err(err).Str("path", found).
+					Msg("cannot parse security insights file")
+				out["security_insights_error"] = err.Error()
+				continue
+			}
+			out["security_insights"] = doc
+		}
+	}
+
+	return out, nil
+}
+
+// findFile returns the path of the first file matching the kind, or an empty
+// string if there is none.
+func findFile(fs billy.Filesystem, kind fileKind) (string, error) {
+	for _, dir := range searchDirs {
+		entries, err := fs.ReadDir(cmp.Or(dir, "."))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return "", fmt.Errorf("cannot read directory %q: %w", dir, err)
+		}
+
+		for _, e := range entries {
+			if e.IsDir() || !kind.matches(e.Name()) {
+				continue
+			}
+			return path.Join(dir, e.Name()), nil
+		}
+	}
+
+	return "", nil
+}
+
+func (k fileKind) matches(filename string) bool {
+	filename = strings.ToLower(filename)
+	ext := path.Ext(filename)
+	stem := strings.TrimSuffix(filename, ext)
+	return slices.Contains(k.names, stem) && slices.Contains(k.extensions, ext)
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package securityinsights
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/engine/ingester/ingestertest"
+	"github.com/mindersec/minder/internal/providers/credentials"
+	"github.com/mindersec/minder/internal/providers/testproviders"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+const securityInsights = `header:
+  schema-version: 1.0.0
+  last-updated: '2024-10-01'
+  project-url: https://github.com/mindersec/minder
+vulnerability-reporting:
+  accepts-vulnerability-reports: true
+  security-policy: https://github.com/mindersec/minder/blob/main/SECURITY.md
+`
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"README.md":                       "# minder",
+		".github/SECURITY.md":             "Report vulnerabilities privately",
+		"SECURITY.md":                     "shadowed by .github",
+		"docs/Code-Of-Conduct.md":         "Be nice",
+		"SECURITY-INSIGHTS.yml":           securityInsights,
+		"GOVERNANCE":                      "Maintainers decide",
+		"docs/contributing/CONTRIBUTING":  "nested too deep",
+		".github/CODEOWNERS":              "* @mindersec/maintainers",
+		"docs/security-insights-tool.yml": "not a security insights file",
+	})
+
+	got, err := collect(context.Background(), fs)
+	require.NoError(t, err)
+
+	files := got["files"].(map[string]any)
+	require.Equal(t, map[string]any{
+		"present":   true,
+		"path":      ".github/SECURITY.md",
+		"content":   "Report vulnerabilities privately",
+		"truncated": false,
+	}, files["security_policy"])
+	require.Equal(t, "docs/Code-Of-Conduct.md", files["code_of_conduct"].(map[string]any)["path"])
+	require.Equal(t, "GOVERNANCE", files["governance"].(map[string]any)["path"])
+	require.Equal(t, ".github/CODEOWNERS", files["codeowners"].(map[string]any)["path"])
+	require.Equal(t, "SECURITY-INSIGHTS.yml", files["security_insights"].(map[string]any)["path"])
+	for _, missing := range []string{"contributing", "maintainers"} {
+		require.Equal(t, map[string]any{
+			"present":   false,
+			"path":      "",
+			"content":   "",
+			"truncated": false,
+		}, files[missing], missing)
+	}
+
+	doc := got["security_insights"].(map[string]any)
+	require.Equal(t, "1.0.0", doc["header"].(map[string]any)["schema-version"])
+	require.Equal(t, true, doc["vulnerability-reporting"].(map[string]any)["accepts-vulnerability-reports"])
+	require.Empty(t, got["security_insights_error"])
+}
+
+func TestCollectInvalidSecurityInsights(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"security-insights.yaml": "header: [unterminated",
+	})
+
+	got, err := collect(context.Background(), fs)
+	require.NoError(t, err)
+	require.Nil(t, got["security_insights"])
+	require.NotEmpty(t, got["security_insights_error"])
+	require.True(t, got["files"].(map[string]any)["security_insights"].(map[string]any)["present"].(bool))
+}
+
+func TestCollectTruncated(t *testing.T) {
+	t.Parallel()
+
+	fs := ingestertest.NewFs(t, map[string]string{
+		"CONTRIBUTING.md": strings.Repeat("a", int(MaxFileBytes)+10),
+	})
+
+	got, err := collect(context.Background(), fs)
+	require.NoError(t, err)
+
+	contributing := got["files"].(map[string]any)["contributing"].(map[string]any)
+	require.True(t, contributing["truncated"].(bool))
+	require.Len(t, contributing["content"], int(MaxFileBytes))
+}
+
+func TestCollectEmpty(t *testing.T) {
+	t.Parallel()
+
+	got, err := collect(context.Background(), ingestertest.NewFs(t, nil))
+	require.NoError(t, err)
+	for _, kind := range fileKinds {
+		require.False(t, got["files"].(map[string]any)[kind.key].(map[string]any)["present"].(bool), kind.key)
+	}
+}
+
+func TestIngestNotApplicable(t *testing.T) {
+	t.Parallel()
+
+	si, err := NewSecurityInsightsIngester(nil, testproviders.NewGitProvider(credentials.NewEmptyCredential()))
+	require.NoError(t, err)
+	require.Equal(t, SecurityInsightsRuleDataIngestType, si.GetType())
+
+	_, err = si.Ingest(context.Background(), &pb.Artifact{Name: "image"}, nil)
+	require.ErrorIs(t, err, evalerrors.ErrIngestionNotApplicable)
+
+	_, err = NewSecurityInsightsIngester(nil, nil)
+	require.Error(t, err)
+}
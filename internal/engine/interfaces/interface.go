@@ -61,8 +61,16 @@ type EvalStatusParams struct {
 	EvalStatusFromDb *db.ListRuleEvaluationsByProfileIdRow
 	evalErr          error
 	evalResult       *interfaces.EvaluationResult
+	ruleParams       map[string]any
 	actionsErr       evalerrors.ActionsError
 	ExecutionID      uuid.UUID
+	// RetryAttempt is the number of the automatic retry of the evaluation,
+	// or 0 if the evaluation is not a retry
+	RetryAttempt int32
+	// RuleDigest identifies the rule type, definition and parameters which
+	// were evaluated. It is recorded in the checkpoint of the evaluation for
+	// rule types which skip entities unchanged since their last evaluation.
+	RuleDigest string
 }
 
 // Ensure EvalStatusParams implements the necessary interfaces
@@ -127,6 +135,22 @@ func (e *EvalStatusParams) GetRule() *models.RuleInstance {
 	return e.Rule
 }
 
+// SetRuleParams sets the parameters the rule is evaluated with, once its
+// secret references are resolved. Unlike the parameters of the rule, they
+// are never persisted or exported.
+func (e *EvalStatusParams) SetRuleParams(params map[string]any) {
+	e.ruleParams = params
+}
+
+// GetRuleParams returns the parameters the rule is evaluated with, which
+// are the parameters of the rule unless their secrets have been resolved
+func (e *EvalStatusParams) GetRuleParams() map[string]any {
+	if e.ruleParams != nil {
+		return e.ruleParams
+	}
+	return e.GetRule().Params
+}
+
 // GetEvalStatusFromDb returns the evaluation status from the database
 // Returns nil if there is no previous state for this rule/entity
 func (e *EvalStatusParams) GetEvalStatusFromDb() *db.ListRuleEvaluationsByProfileIdRow {
@@ -138,6 +162,11 @@ func (e *EvalStatusParams) GetProfile() *models.ProfileAggregate {
 	return e.Profile
 }
 
+// GetEntityID returns the ID of the evaluated entity
+func (e *EvalStatusParams) GetEntityID() uuid.UUID {
+	return e.EntityID
+}
+
 // SetIngestResult sets the result of the ingestion for use later on in the actions
 func (e *EvalStatusParams) SetIngestResult(res *interfaces.Ingested) {
 	e.Result = res
@@ -177,4 +206,6 @@ type ActionsParams interface {
 	GetEvalResult() *interfaces.EvaluationResult
 	GetEvalStatusFromDb() *db.ListRuleEvaluationsByProfileIdRow
 	GetProfile() *models.ProfileAggregate
+	GetEntityID() uuid.UUID
+	GetRuleParams() map[string]any
 }
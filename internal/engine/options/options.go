@@ -53,3 +53,23 @@ func WithDataSources(ds *v1datasources.DataSourceRegistry) interfaces.Option {
 		return nil
 	}
 }
+
+// SupportsLimits interface advertises the fact that the implementer
+// enforces execution limits during evaluation.
+type SupportsLimits interface {
+	SetLimits(limits interfaces.Limits)
+}
+
+// WithLimits provides the evaluation engine with the execution limits to
+// enforce. In case the given evaluator does not support limits, WithLimits
+// silently ignores them.
+func WithLimits(limits interfaces.Limits) interfaces.Option {
+	return func(e interfaces.Evaluator) error {
+		inner, ok := e.(SupportsLimits)
+		if !ok {
+			return nil
+		}
+		inner.SetLimits(limits)
+		return nil
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package retry handles the automatic re-evaluation of entities whose
+// evaluation ended in a transient error, such as a rate limit or an
+// unavailable provider.
+package retry
+
+import (
+	"errors"
+	"time"
+
+	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+// Policy is the policy for retrying transient evaluation errors. The zero
+// value disables retries.
+type Policy struct {
+	// MaxAttempts is the maximum number of retries after a transient error
+	MaxAttempts int32
+	// InitialBackoff is the delay before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between two retries
+	MaxBackoff time.Duration
+}
+
+// NewPolicy creates the retry policy from the server configuration
+func NewPolicy(cfg *serverconfig.EvaluationRetryConfig) Policy {
+	if !cfg.Enabled {
+		return Policy{}
+	}
+	return Policy{
+		MaxAttempts:    cfg.MaxAttempts,
+		InitialBackoff: cfg.InitialBackoff,
+		MaxBackoff:     cfg.MaxBackoff,
+	}
+}
+
+// Enabled returns whether transient evaluation errors are retried
+func (p Policy) Enabled() bool {
+	return p.MaxAttempts > 0
+}
+
+// Backoff returns the delay before the given retry, starting at 1. The delay
+// doubles for each retry, up to the maximum backoff.
+func (p Policy) Backoff(attempt int32) time.Duration {
+	backoff := p.InitialBackoff
+	for i := int32(1); i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	return backoff
+}
+
+// NextRetryAt returns when the given retry of an evaluation which ended in
+// evalErr is due. Rate limited evaluations are not retried before the rate
+// limit resets.
+func (p Policy) NextRetryAt(now time.Time, attempt int32, evalErr error) time.Time {
+	next := now.Add(p.Backoff(attempt))
+
+	var rateLimitErr *evalerrors.RateLimitError
+	if errors.As(evalErr, &rateLimitErr) && rateLimitErr.ResetTime.After(next) {
+		next = rateLimitErr.ResetTime
+	}
+	return next
+}
+
+// IsTransient returns whether evaluations which ended in an error with the
+// given code may succeed when retried
+func IsTransient(code db.EvalErrorCodes) bool {
+	switch code {
+	case db.EvalErrorCodesRateLimited, db.EvalErrorCodesProviderUnavailable:
+		return true
+	default:
+		return false
+	}
+}
+
+// State tracks the retries of the evaluation of an entity
+type State struct {
+	// Attempt is the number of the retry being evaluated, or 0 if the
+	// evaluation is not a retry
+	Attempt int32
+
+	err  error
+	code db.EvalErrorCodes
+}
+
+// Record records the outcome of a rule evaluation of the entity. Only the
+// first transient error is kept.
+func (s *State) Record(evalErr error) {
+	if s.err != nil {
+		return
+	}
+
+	code := dbadapter.ErrorAsEvalErrorCode(evalErr)
+	if code.Valid && IsTransient(code.EvalErrorCodes) {
+		s.err = evalErr
+		s.code = code.EvalErrorCodes
+	}
+}
+
+// Err returns the first transient error of the rule evaluations of the
+// entity, or nil if none ended in a transient error
+func (s *State) Err() error {
+	return s.err
+}
+
+// ErrorCode returns the error code of the first transient error
+func (s *State) ErrorCode() db.EvalErrorCodes {
+	return s.code
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package retry
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+func TestNewPolicy(t *testing.T) {
+	t.Parallel()
+
+	cfg := serverconfig.EvaluationRetryConfig{
+		Enabled:        true,
+		MaxAttempts:    3,
+		InitialBackoff: time.Minute,
+		MaxBackoff:     time.Hour,
+	}
+	require.Equal(t, Policy{MaxAttempts: 3, InitialBackoff: time.Minute, MaxBackoff: time.Hour}, NewPolicy(&cfg))
+	require.True(t, NewPolicy(&cfg).Enabled())
+
+	cfg.Enabled = false
+	require.False(t, NewPolicy(&cfg).Enabled())
+}
+
+func TestPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	p := Policy{MaxAttempts: 10, InitialBackoff: time.Minute, MaxBackoff: 5 * time.Minute}
+
+	tests := []struct {
+		attempt int32
+		want    time.Duration
+	}{
+		{attempt: 1, want: time.Minute},
+		{attempt: 2, want: 2 * time.Minute},
+		{attempt: 3, want: 4 * time.Minute},
+		{attempt: 4, want: 5 * time.Minute},
+		{attempt: 100, want: 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("attempt %d", tt.attempt), func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, p.Backoff(tt.attempt))
+		})
+	}
+}
+
+func TestPolicyNextRetryAt(t *testing.T) {
+	t.Parallel()
+
+	p := Policy{MaxAttempts: 5, InitialBackoff: time.Minute, MaxBackoff: time.Hour}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.Equal(t, now.Add(2*time.Minute), p.NextRetryAt(now, 2, evalerrors.ErrProviderUnavailable))
+
+	rateLimited := evalerrors.NewRateLimitError(errors.New("slow down"), 5000, 0, now.Add(30*time.Minute))
+	require.Equal(t, now.Add(30*time.Minute), p.NextRetryAt(now, 1, rateLimited))
+
+	resetSoon := evalerrors.NewRateLimitError(errors.New("slow down"), 5000, 0, now.Add(time.Second))
+	require.Equal(t, now.Add(time.Minute), p.NextRetryAt(now, 1, resetSoon))
+}
+
+func TestStateRecord(t *testing.T) {
+	t.Parallel()
+
+	rateLimited := evalerrors.NewRateLimitError(errors.New("slow down"), 5000, 0, time.Now())
+
+	tests := []struct {
+		name     string
+		errs     []error
+		wantErr  error
+		wantCode db.EvalErrorCodes
+	}{
+		{
+			name: "no transient error",
+			errs: []error{
+				nil,
+				interfaces.ErrEvaluationFailed,
+				fmt.Errorf("%w: bad params", evalerrors.ErrInvalidRuleParameters),
+			},
+		},
+		{
+			name:     "provider unavailable",
+			errs:     []error{nil, evalerrors.ErrProviderUnavailable},
+			wantErr:  evalerrors.ErrProviderUnavailable,
+			wantCode: db.EvalErrorCodesProviderUnavailable,
+		},
+		{
+			name:     "keeps the first transient error",
+			errs:     []error{rateLimited, evalerrors.ErrProviderUnavailable},
+			wantErr:  rateLimited,
+			wantCode: db.EvalErrorCodesRateLimited,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := &State{}
+			for _, err := range tt.errs {
+				state.Record(err)
+			}
+			require.Equal(t, tt.wantErr, state.Err())
+			require.Equal(t, tt.wantCode, state.ErrorCode())
+		})
+	}
+}
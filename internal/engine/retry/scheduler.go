@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package retry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+// LeaseDuration is how long a claimed retry is not claimed again, so that
+// the entity is not re-evaluated twice. It exceeds the execution timeout of
+// an entity evaluation, so a retry whose re-evaluation was lost is claimed
+// again once its lease expires.
+const LeaseDuration = 10 * time.Minute
+
+// Scheduler publishes the re-evaluation of the entities whose retry is due
+type Scheduler struct {
+	store db.EvalRetriesStore
+	evt   interfaces.Publisher
+	cfg   *serverconfig.EvaluationRetryConfig
+}
+
+// NewScheduler creates a new retry scheduler
+func NewScheduler(store db.EvalRetriesStore, evt interfaces.Publisher, cfg *serverconfig.EvaluationRetryConfig) *Scheduler {
+	return &Scheduler{
+		store: store,
+		evt:   evt,
+		cfg:   cfg,
+	}
+}
+
+// Run schedules the due retries at regular intervals until the context is
+// cancelled
+func (s *Scheduler) Run(ctx context.Context) error {
+	if s.cfg.Interval <= 0 {
+		return fmt.Errorf("invalid evaluation retry interval: %s", s.cfg.Interval)
+	}
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.ScheduleDue(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error scheduling evaluation retries")
+			}
+		}
+	}
+}
+
+// ScheduleDue publishes the re-evaluation of a batch of entities whose
+// retry is due
+func (s *Scheduler) ScheduleDue(ctx context.Context) error {
+	retries, err := s.store.ClaimDueEvaluationRetries(ctx, db.ClaimDueEvaluationRetriesParams{
+		Lease: fmt.Sprintf("%d", int64(LeaseDuration.Seconds())),
+		Size:  s.cfg.BatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error claiming due evaluation retries: %w", err)
+	}
+
+	for _, retry := range retries {
+		logger := zerolog.Ctx(ctx).With().
+			Str("entity_id", retry.EntityInstanceID.String()).
+			Str("project_id", retry.ProjectID.String()).
+			Int32("attempt", retry.Attempt).
+			Logger()
+
+		m := message.NewMessage(uuid.New().String(), nil)
+		m.SetContext(ctx)
+
+		entRefresh := entityMessage.NewEntityRefreshAndDoMessage().
+			WithEntityID(retry.EntityInstanceID)
+		if err := entRefresh.ToMessage(m); err != nil {
+			logger.Error().Err(err).Msg("error marshalling message")
+			// Skip this entity, it will be claimed again once its lease expires
+			continue
+		}
+
+		if err := s.evt.Publish(constants.TopicQueueRefreshEntityByIDAndEvaluate, m); err != nil {
+			return fmt.Errorf("error publishing message: %w", err)
+		}
+
+		logger.Info().Str("error_code", string(retry.ErrorCode)).Msg("scheduled evaluation retry")
+	}
+
+	return nil
+}
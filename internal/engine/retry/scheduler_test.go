@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package retry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/events/stubs"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func TestSchedulerScheduleDue(t *testing.T) {
+	t.Parallel()
+
+	entityID := uuid.New()
+	cfg := &serverconfig.EvaluationRetryConfig{BatchSize: 10}
+
+	tests := []struct {
+		name    string
+		setup   func(store *mockdb.MockEvalRetriesStore)
+		wantErr bool
+		wantIDs []uuid.UUID
+	}{
+		{
+			name: "publishes due retries",
+			setup: func(store *mockdb.MockEvalRetriesStore) {
+				store.EXPECT().ClaimDueEvaluationRetries(gomock.Any(), db.ClaimDueEvaluationRetriesParams{
+					Lease: "600",
+					Size:  10,
+				}).Return([]db.EvaluationRetry{{
+					EntityInstanceID: entityID,
+					ProjectID:        uuid.New(),
+					Attempt:          2,
+					ErrorCode:        db.EvalErrorCodesRateLimited,
+				}}, nil)
+			},
+			wantIDs: []uuid.UUID{entityID},
+		},
+		{
+			name: "nothing due",
+			setup: func(store *mockdb.MockEvalRetriesStore) {
+				store.EXPECT().ClaimDueEvaluationRetries(gomock.Any(), gomock.Any()).
+					Return([]db.EvaluationRetry{}, nil)
+			},
+		},
+		{
+			name: "error claiming retries",
+			setup: func(store *mockdb.MockEvalRetriesStore) {
+				store.EXPECT().ClaimDueEvaluationRetries(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("boom"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockEvalRetriesStore(ctrl)
+			tt.setup(store)
+			evt := &stubs.StubEventer{}
+
+			err := NewScheduler(store, evt, cfg).ScheduleDue(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, evt.Sent, len(tt.wantIDs))
+			for i, msg := range evt.Sent {
+				require.Equal(t, []string{constants.TopicQueueRefreshEntityByIDAndEvaluate}, evt.Topics)
+				var refresh entityMessage.HandleEntityAndDoMessage
+				require.NoError(t, json.Unmarshal(msg.Payload, &refresh))
+				require.Equal(t, tt.wantIDs[i], refresh.Entity.EntityID)
+			}
+		})
+	}
+}
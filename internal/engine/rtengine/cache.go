@@ -36,6 +36,7 @@ type ruleEngineCache struct {
 	provider     provinfv1.Provider
 	featureFlags flags.Interface
 	ingestCache  ingestcache.Cache
+	limits       interfaces.Limits
 	engines      cacheType
 	dssvc        datasourceservice.DataSourcesService
 	opts         []interfaces.Option
@@ -53,6 +54,7 @@ func NewRuleEngineCache(
 	featureFlags flags.Interface,
 	ingestCache ingestcache.Cache,
 	dssvc datasourceservice.DataSourcesService,
+	limits interfaces.Limits,
 	opts ...interfaces.Option,
 ) (Cache, error) {
 	// Get the full project hierarchy
@@ -75,7 +77,7 @@ func NewRuleEngineCache(
 	engines := make(cacheType, len(ruleTypes))
 	for _, ruleType := range ruleTypes {
 		ruleEngine, err := cacheRuleEngine(
-			ctx, &ruleType, provider, featureFlags, ingestCache, engines, dssvc, opts...)
+			ctx, &ruleType, provider, featureFlags, ingestCache, limits, engines, dssvc, opts...)
 		if err != nil {
 			return nil, err
 		}
@@ -87,6 +89,7 @@ func NewRuleEngineCache(
 		provider:     provider,
 		featureFlags: featureFlags,
 		ingestCache:  ingestCache,
+		limits:       limits,
 		engines:      engines,
 		opts:         opts,
 		dssvc:        dssvc,
@@ -118,7 +121,7 @@ func (r *ruleEngineCache) GetRuleEngine(ctx context.Context, ruleTypeID uuid.UUI
 
 	// If we find the rule type, insert into the cache and return.
 	ruleTypeEngine, err := cacheRuleEngine(
-		ctx, &ruleType, r.provider, r.featureFlags, r.ingestCache, r.engines, r.dssvc, r.opts...)
+		ctx, &ruleType, r.provider, r.featureFlags, r.ingestCache, r.limits, r.engines, r.dssvc, r.opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error while caching rule type engine: %w", err)
 	}
@@ -131,6 +134,7 @@ func cacheRuleEngine(
 	provider provinfv1.Provider,
 	featureFlags flags.Interface,
 	ingestCache ingestcache.Cache,
+	limits interfaces.Limits,
 	engineCache cacheType,
 	dssvc datasourceservice.DataSourcesService,
 	opts ...interfaces.Option,
@@ -174,7 +178,7 @@ func cacheRuleEngine(
 	}
 
 	// Add the rule type engine to the cache
-	ruleEngine = ruleEngine.WithIngesterCache(ingestCache)
+	ruleEngine = ruleEngine.WithIngesterCache(ingestCache).WithLimits(limits)
 	engineCache[ruleType.ID] = ruleEngine
 	return ruleEngine, nil
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/mindersec/minder/internal/engine/ingestcache"
 	"github.com/mindersec/minder/internal/providers/testproviders"
 	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 	rtengine2 "github.com/mindersec/minder/pkg/engine/v1/rtengine"
 )
 
@@ -121,7 +122,7 @@ func TestNewRuleTypeEngineCacheConstructor(t *testing.T) {
 			cache, err := NewRuleEngineCache(
 				ctx, store, db.EntitiesRepository, uuid.New(),
 				testproviders.NewGitProvider(nil), nil, ingestcache.NewNoopCache(),
-				dssvc)
+				dssvc, interfaces.Limits{})
 			if scenario.ExpectedError != "" {
 				require.ErrorContains(t, err, scenario.ExpectedError)
 				require.Nil(t, cache)
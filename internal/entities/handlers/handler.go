@@ -9,9 +9,11 @@ import (
 	"errors"
 
 	watermill "github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/entities"
 	"github.com/mindersec/minder/internal/entities/handlers/message"
 	"github.com/mindersec/minder/internal/entities/handlers/strategies"
 	entStrategies "github.com/mindersec/minder/internal/entities/handlers/strategies/entity"
@@ -76,7 +78,11 @@ func (b *handleEntityAndDoBase) handleRefreshEntityAndDo(msg *watermill.Message)
 
 	// call refreshEntity
 	ewp, err := b.refreshEntity.GetEntity(ctx, entMsg)
-	if err != nil {
+	if entMsg.DeleteIfNotFound && errors.Is(err, propertyService.ErrEntityNotFound) {
+		l.Info().Err(err).Msg("entity not found upstream, deleting it")
+		b.forwardToDelete(entMsg, l)
+		return nil
+	} else if err != nil {
 		l.Error().Err(err).Msg("error refreshing entity")
 		// do not return error in the handler, just log it
 		// we might want to special-case retrying /some/ errors specifically those from the
@@ -109,6 +115,9 @@ func (b *handleEntityAndDoBase) handleRefreshEntityAndDo(msg *watermill.Message)
 
 	// If nextMsg is nil, it means we don't need to publish anything (entity not found)
 	if nextMsg != nil {
+		if entMsg.TriggerEvent != "" {
+			nextMsg.Metadata.Set(entities.TriggerEventKey, entMsg.TriggerEvent)
+		}
 		l.Debug().Msg("publishing message")
 		if err := b.evt.Publish(b.forwardHandlerName, nextMsg); err != nil {
 			l.Error().Err(err).Msg("error publishing message")
@@ -121,6 +130,23 @@ func (b *handleEntityAndDoBase) handleRefreshEntityAndDo(msg *watermill.Message)
 	return nil
 }
 
+// forwardToDelete hands the entity of the message over to the handler which
+// deletes entities.
+func (b *handleEntityAndDoBase) forwardToDelete(entMsg *message.HandleEntityAndDoMessage, l zerolog.Logger) {
+	// the delete handler must not forward the message again
+	entMsg.DeleteIfNotFound = false
+
+	delMsg := watermill.NewMessage(uuid.New().String(), nil)
+	if err := entMsg.ToMessage(delMsg); err != nil {
+		l.Error().Err(err).Msg("error creating delete message")
+		return
+	}
+
+	if err := b.evt.Publish(constants.TopicQueueGetEntityAndDelete, delMsg); err != nil {
+		l.Error().Err(err).Msg("error publishing delete message")
+	}
+}
+
 func (b *handleEntityAndDoBase) forwardEntityCheck(
 	ctx context.Context,
 	entMsg *message.HandleEntityAndDoMessage,
@@ -278,17 +304,19 @@ func NewAddOriginatingEntityHandler(
 }
 
 // NewRemoveOriginatingEntityHandler creates a new handler that removes an originating entity.
+// If retainClosed is set, the entity is marked as closed instead of being deleted.
 func NewRemoveOriginatingEntityHandler(
 	evt interfaces.Publisher,
 	store db.Store,
 	propSvc propertyService.PropertiesService,
 	provMgr manager.ProviderManager,
+	retainClosed bool,
 	handlerMiddleware ...watermill.HandlerMiddleware,
 ) interfaces.Consumer {
 	return &handleEntityAndDoBase{
 		evt: evt,
 
-		refreshEntity: entStrategies.NewDelOriginatingEntityStrategy(propSvc, provMgr, store),
+		refreshEntity: entStrategies.NewDelOriginatingEntityStrategy(propSvc, provMgr, store, retainClosed),
 		createMessage: msgStrategies.NewCreateEmpty(),
 
 		handlerName: constants.TopicQueueOriginatingEntityDelete,
@@ -147,6 +147,16 @@ func checkRepoMessage(t *testing.T, msg *watermill.Message) {
 	assert.Equal(t, repoPropMap[properties.RepoPropertyIsFork].(bool), pbrepo.IsFork)
 }
 
+func checkDeleteMessage(t *testing.T, msg *watermill.Message) {
+	t.Helper()
+
+	entMsg, err := message.ToEntityRefreshAndDo(msg)
+	require.NoError(t, err)
+	assert.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, entMsg.Entity.Type)
+	assert.Equal(t, "123", entMsg.Entity.GetByProps[properties.PropertyUpstreamID])
+	assert.False(t, entMsg.DeleteIfNotFound)
+}
+
 type handlerBuilder func(
 	evt interfaces.Publisher,
 	store db.Store,
@@ -178,7 +188,16 @@ func removeOriginatingEntityHandlerBuilder(
 	propSvc service.PropertiesService,
 	provMgr manager.ProviderManager,
 ) interfaces.Consumer {
-	return NewRemoveOriginatingEntityHandler(evt, store, propSvc, provMgr)
+	return NewRemoveOriginatingEntityHandler(evt, store, propSvc, provMgr, false)
+}
+
+func closeOriginatingEntityHandlerBuilder(
+	evt interfaces.Publisher,
+	store db.Store,
+	propSvc service.PropertiesService,
+	provMgr manager.ProviderManager,
+) interfaces.Consumer {
+	return NewRemoveOriginatingEntityHandler(evt, store, propSvc, provMgr, true)
 }
 
 func getAndDeleteEntityHandlerBuilder(
@@ -275,6 +294,44 @@ func TestRefreshEntityAndDoHandler_HandleRefreshEntityAndEval(t *testing.T) {
 			topic:           constants.TopicQueueEntityEvaluate,
 			checkWmMsg:      checkRepoMessage,
 		},
+		{
+			name:             "NewRefreshEntityAndEvaluateHandler: the trigger event is forwarded",
+			handlerBuilderFn: refreshEntityHandlerBuilder,
+			messageBuilder: func() *message.HandleEntityAndDoMessage {
+				getByProps := properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "123",
+				})
+
+				return message.NewEntityRefreshAndDoMessage().
+					WithEntity(minderv1.Entity_ENTITY_REPOSITORIES, getByProps).
+					WithProviderImplementsHint("github").
+					WithTriggerEvent("branch_protection_rule")
+			},
+			setupPropSvcMocks: func() fixtures.MockPropertyServiceBuilder {
+				ewp := buildEwp(t, repoEwp, repoPropMap)
+				protoEnt, err := ghprops.RepoV1FromProperties(ewp.Properties)
+				require.NoError(t, err)
+
+				return fixtures.NewMockPropertiesService(
+					fixtures.WithSuccessfulEntityByUpstreamHint(ewp, githubHint),
+					fixtures.WithSuccessfulRetrieveAllPropertiesForEntity(),
+					fixtures.WithSuccessfulEntityWithPropertiesAsProto(protoEnt),
+				)
+			},
+			mockStoreFunc: df.NewMockStore(
+				df.WithTransaction(),
+			),
+			expectedPublish: true,
+			topic:           constants.TopicQueueEntityEvaluate,
+			checkWmMsg: func(t *testing.T, msg *watermill.Message) {
+				t.Helper()
+				checkRepoMessage(t, msg)
+
+				eiw, err := entities.ParseEntityEvent(msg)
+				require.NoError(t, err)
+				assert.Equal(t, "branch_protection_rule", eiw.TriggerEvent)
+			},
+		},
 		{
 			name:             "NewRefreshEntityAndEvaluateHandler: if match_props match, publish",
 			handlerBuilderFn: refreshEntityHandlerBuilder,
@@ -476,6 +533,33 @@ func TestRefreshEntityAndDoHandler_HandleRefreshEntityAndEval(t *testing.T) {
 			),
 			expectedPublish: false,
 		},
+		{
+			name:             "NewRefreshEntityAndEvaluateHandler: entity not found upstream is deleted if requested",
+			handlerBuilderFn: refreshEntityHandlerBuilder,
+			messageBuilder: func() *message.HandleEntityAndDoMessage {
+				getByProps := properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "123",
+				})
+
+				return message.NewEntityRefreshAndDoMessage().
+					WithEntity(minderv1.Entity_ENTITY_REPOSITORIES, getByProps).
+					WithProviderImplementsHint("github").
+					WithForceRefresh().
+					WithDeleteIfNotFound()
+			},
+			setupPropSvcMocks: func() fixtures.MockPropertyServiceBuilder {
+				return fixtures.NewMockPropertiesService(
+					fixtures.WithSuccessfulEntityByUpstreamHint(&repoEwp, githubHint),
+					fixtures.WithFailedRetrieveAllPropertiesForEntity(service.ErrEntityNotFound),
+				)
+			},
+			mockStoreFunc: df.NewMockStore(
+				df.WithRollbackTransaction(),
+			),
+			expectedPublish: true,
+			topic:           constants.TopicQueueGetEntityAndDelete,
+			checkWmMsg:      checkDeleteMessage,
+		},
 		{
 			name:             "NewRefreshEntityAndEvaluateHandler: Failure to convert entity to proto doesn't publish",
 			handlerBuilderFn: refreshEntityHandlerBuilder,
@@ -615,6 +699,42 @@ func TestRefreshEntityAndDoHandler_HandleRefreshEntityAndEval(t *testing.T) {
 			},
 			expectedPublish: false,
 		},
+		{
+			name:             "NewRemoveOriginatingEntityHandler: Closed entity is retained",
+			handlerBuilderFn: closeOriginatingEntityHandlerBuilder,
+			messageBuilder: func() *message.HandleEntityAndDoMessage {
+				prProps := properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "789",
+					ghprops.PullPropertyNumber:    int64(789),
+				})
+				originatorProps := properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "123",
+				})
+
+				return message.NewEntityRefreshAndDoMessage().
+					WithEntity(minderv1.Entity_ENTITY_PULL_REQUESTS, prProps).
+					WithOriginator(minderv1.Entity_ENTITY_REPOSITORIES, originatorProps).
+					WithProviderImplementsHint("github")
+			},
+			setupPropSvcMocks: func() fixtures.MockPropertyServiceBuilder {
+				repoPropsEwp := buildEwp(t, repoEwp, repoPropMap)
+				pullPropsEwp := buildEwp(t, pullRequestEwp, pullRequestPropMap)
+
+				return fixtures.NewMockPropertiesService(
+					fixtures.WithSuccessfulEntityByUpstreamHint(repoPropsEwp, githubHint),
+					fixtures.WithSuccessfulEntityByUpstreamHint(pullPropsEwp, githubHint),
+				)
+			},
+			mockStoreFunc: df.NewMockStore(
+				df.WithTransaction(),
+				df.WithSuccessfulCloseEntity(pullRequestID, projectID),
+			),
+			providerSetup: newProviderMock(),
+			providerManagerSetup: func(_ provifv1.Provider) provManFixtures.ProviderManagerMockBuilder {
+				return provManFixtures.NewProviderManagerMock()
+			},
+			expectedPublish: false,
+		},
 		{
 			name:             "NewGetEntityAndDeleteHandler: happy path publishes",
 			handlerBuilderFn: getAndDeleteEntityHandlerBuilder,
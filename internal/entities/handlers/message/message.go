@@ -41,6 +41,18 @@ type HandleEntityAndDoMessage struct {
 	// use-case is to include the hook ID in the MatchProps to match against
 	// the entity's hook ID to avoid forwading the message to the wrong entity.
 	MatchProps map[string]any `json:"match_props"`
+	// ForceRefresh makes the entity handler fetch the properties from the
+	// provider even if the cached ones are still valid. It is used when the
+	// entity is known to have changed upstream, e.g. it was renamed.
+	ForceRefresh bool `json:"force_refresh"`
+	// DeleteIfNotFound makes the entity handler delete the entity if it
+	// can't be found upstream anymore, e.g. after a repository was
+	// transferred to an owner the provider has no access to.
+	DeleteIfNotFound bool `json:"delete_if_not_found"`
+	// TriggerEvent is the type of the upstream event, e.g. a webhook event,
+	// which caused the message. It is forwarded to the evaluation, so that
+	// only the rules triggered by it are evaluated.
+	TriggerEvent string `json:"trigger_event,omitempty"`
 }
 
 // NewEntityRefreshAndDoMessage creates a new HandleEntityAndDoMessage struct.
@@ -117,3 +129,21 @@ func (e *HandleEntityAndDoMessage) WithMatchProps(matchProps *properties.Propert
 	e.MatchProps = matchProps.ToProtoStruct().AsMap()
 	return e
 }
+
+// WithForceRefresh makes the handler refresh the entity properties from the provider.
+func (e *HandleEntityAndDoMessage) WithForceRefresh() *HandleEntityAndDoMessage {
+	e.ForceRefresh = true
+	return e
+}
+
+// WithDeleteIfNotFound makes the handler delete the entity if it is not found upstream.
+func (e *HandleEntityAndDoMessage) WithDeleteIfNotFound() *HandleEntityAndDoMessage {
+	e.DeleteIfNotFound = true
+	return e
+}
+
+// WithTriggerEvent sets the type of the upstream event which caused the message.
+func (e *HandleEntityAndDoMessage) WithTriggerEvent(event string) *HandleEntityAndDoMessage {
+	e.TriggerEvent = event
+	return e
+}
@@ -5,8 +5,11 @@ package entity
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/entities/handlers/message"
 	"github.com/mindersec/minder/internal/entities/handlers/strategies"
@@ -55,6 +58,30 @@ func (a *addOriginatingEntityStrategy) GetEntity(
 		return nil, fmt.Errorf("error getting parent entity: %w", err)
 	}
 
+	// The child might have been created before, e.g. by an earlier event for
+	// the same artifact, or it might be a pull request which was closed and is
+	// now reopened. In that case only make sure it's linked to the parent and
+	// no longer considered closed, so that it is evaluated again.
+	childEwp, err := getEntityInner(
+		ctx,
+		entMsg.Entity.Type, entMsg.Entity.GetByProps, entMsg.Hint,
+		a.propSvc,
+		nil)
+	if err == nil {
+		if err := a.propSvc.RetrieveAllPropertiesForEntity(ctx, childEwp, a.provMgr, nil); err != nil {
+			return nil, fmt.Errorf("error refreshing entity: %w", err)
+		}
+		if err := a.ensureOriginatedFrom(ctx, childEwp, parentEwp); err != nil {
+			return nil, fmt.Errorf("error linking entity to its originator: %w", err)
+		}
+		if err := a.store.ReopenEntity(ctx, childEwp.Entity.ID); err != nil {
+			return nil, fmt.Errorf("error reopening entity: %w", err)
+		}
+		return childEwp, nil
+	} else if !errors.Is(err, propertyService.ErrEntityNotFound) {
+		return nil, fmt.Errorf("error getting entity: %w", err)
+	}
+
 	// Get provider from DB
 	// Note: These reads are outside the transaction boundary in EntityCreator.CreateEntity
 	// because they read stable data (parent entity and provider configuration).
@@ -70,7 +97,7 @@ func (a *addOriginatingEntityStrategy) GetEntity(
 	// This matches existing behavior and avoids potential loops since this code runs
 	// from a message handler. The parent repository's reconciliation handles the
 	// evaluation of child entities through the entity evaluation graph.
-	childEwp, err := a.entityCreator.CreateEntity(ctx, &provider,
+	childEwp, err = a.entityCreator.CreateEntity(ctx, &provider,
 		parentEwp.Entity.ProjectID, entMsg.Entity.Type, childProps,
 		&entityService.EntityCreationOptions{
 			OriginatingEntityID:        &parentEwp.Entity.ID,
@@ -84,6 +111,31 @@ func (a *addOriginatingEntityStrategy) GetEntity(
 	return childEwp, nil
 }
 
+// ensureOriginatedFrom persists the link between an existing child entity and
+// its parent, which is what lets users navigate e.g. from an artifact to the
+// repository that built it.
+func (a *addOriginatingEntityStrategy) ensureOriginatedFrom(
+	ctx context.Context, childEwp, parentEwp *models.EntityWithProperties,
+) error {
+	if childEwp.Entity.OriginatedFrom == parentEwp.Entity.ID {
+		return nil
+	}
+
+	if err := a.store.UpdateEntityOriginatedFrom(ctx, db.UpdateEntityOriginatedFromParams{
+		ID:        childEwp.Entity.ID,
+		ProjectID: childEwp.Entity.ProjectID,
+		OriginatedFrom: uuid.NullUUID{
+			UUID:  parentEwp.Entity.ID,
+			Valid: true,
+		},
+	}); err != nil {
+		return err
+	}
+
+	childEwp.Entity.OriginatedFrom = parentEwp.Entity.ID
+	return nil
+}
+
 // GetName returns the name of the strategy. Used for debugging
 func (*addOriginatingEntityStrategy) GetName() string {
 	return "addOriginatingEntityStrategy"
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/entities/handlers/message"
 	"github.com/mindersec/minder/internal/entities/models"
 	propertyService "github.com/mindersec/minder/internal/entities/properties/service"
@@ -52,3 +53,13 @@ func getEntityInner(
 
 	return ewp, nil
 }
+
+// refreshReadOptions returns the options to refresh the properties of the
+// entity of the message with.
+func refreshReadOptions(entMsg *message.HandleEntityAndDoMessage, t db.ExtendQuerier) *propertyService.ReadOptions {
+	opts := propertyService.ReadBuilder().WithStoreOrTransaction(t)
+	if entMsg.ForceRefresh {
+		opts = opts.ForceRefresh()
+	}
+	return opts
+}
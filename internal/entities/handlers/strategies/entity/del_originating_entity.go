@@ -18,25 +18,30 @@ import (
 )
 
 type delOriginatingEntityStrategy struct {
-	propSvc propertyService.PropertiesService
-	provMgr manager.ProviderManager
-	store   db.Store
+	propSvc      propertyService.PropertiesService
+	provMgr      manager.ProviderManager
+	store        db.Store
+	retainClosed bool
 }
 
 // NewDelOriginatingEntityStrategy creates a new delOriginatingEntityStrategy.
+// When retainClosed is set, the entity is only marked as closed, so that its
+// evaluation history is kept until it is purged.
 func NewDelOriginatingEntityStrategy(
 	propSvc propertyService.PropertiesService,
 	provMgr manager.ProviderManager,
 	store db.Store,
+	retainClosed bool,
 ) strategies.GetEntityStrategy {
 	return &delOriginatingEntityStrategy{
-		propSvc: propSvc,
-		provMgr: provMgr,
-		store:   store,
+		propSvc:      propSvc,
+		provMgr:      provMgr,
+		store:        store,
+		retainClosed: retainClosed,
 	}
 }
 
-// GetEntity deletes the originating entity.
+// GetEntity deletes, or marks as closed, the originating entity.
 func (d *delOriginatingEntityStrategy) GetEntity(
 	ctx context.Context, entMsg *message.HandleEntityAndDoMessage,
 ) (*models.EntityWithProperties, error) {
@@ -71,10 +76,17 @@ func (d *delOriginatingEntityStrategy) GetEntity(
 		return nil, fmt.Errorf("error getting parent entity: %w", err)
 	}
 
-	err = txq.DeleteEntity(ctx, db.DeleteEntityParams{
-		ID:        childEwp.Entity.ID,
-		ProjectID: childEwp.Entity.ProjectID,
-	})
+	if d.retainClosed {
+		err = txq.CloseEntity(ctx, db.CloseEntityParams{
+			EntityInstanceID: childEwp.Entity.ID,
+			ProjectID:        childEwp.Entity.ProjectID,
+		})
+	} else {
+		err = txq.DeleteEntity(ctx, db.DeleteEntityParams{
+			ID:        childEwp.Entity.ID,
+			ProjectID: childEwp.Entity.ProjectID,
+		})
+	}
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
 	}
@@ -53,8 +53,7 @@ func (r *refreshEntityByIDStrategy) GetEntity(
 		}
 
 		err = r.propSvc.RetrieveAllPropertiesForEntity(
-			ctx, ewp, r.provMgr,
-			propertyService.ReadBuilder().WithStoreOrTransaction(t))
+			ctx, ewp, r.provMgr, refreshReadOptions(entMsg, t))
 		if err != nil {
 			return nil, fmt.Errorf("error retrieving properties for entity: %w", err)
 		}
@@ -48,8 +48,7 @@ func (r *refreshEntityByUpstreamIDStrategy) GetEntity(
 		}
 
 		err = r.propSvc.RetrieveAllPropertiesForEntity(
-			ctx, ewp, r.provMgr,
-			propertyService.ReadBuilder().WithStoreOrTransaction(t))
+			ctx, ewp, r.provMgr, refreshReadOptions(entMsg, t))
 		if err != nil {
 			return nil, fmt.Errorf("error fetching entity: %w", err)
 		}
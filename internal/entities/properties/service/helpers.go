@@ -67,6 +67,9 @@ func (ps *propertiesService) retrieveAllPropertiesForEntity(
 		return refreshedProps, nil
 	}
 
+	// the ownership properties are set by users, not by the provider
+	refreshedProps = refreshedProps.Merge(modelProps.FilteredCopy(properties.IsOwnerProperty))
+
 	// save updated properties to db, thus making sure that the updatedAt are bumped
 	err = ps.ReplaceAllProperties(ctx, entID, refreshedProps, opts.getPropertiesServiceCallOptions())
 	if err != nil {
@@ -76,6 +79,41 @@ func (ps *propertiesService) retrieveAllPropertiesForEntity(
 	return refreshedProps, nil
 }
 
+// syncEntityName renames a repository when the name formed from its refreshed
+// properties differs from the stored one, e.g. after it was renamed or
+// transferred upstream. The entity keeps its ID, and thus its history.
+func (ps *propertiesService) syncEntityName(
+	ctx context.Context, provider provifv1.Provider, efp *models.EntityWithProperties,
+	props *properties.Properties, opts *ReadOptions, l zerolog.Logger,
+) error {
+	if efp.Entity.ID == uuid.Nil || efp.Entity.Type != minderv1.Entity_ENTITY_REPOSITORIES {
+		return nil
+	}
+
+	name, err := provider.GetEntityName(efp.Entity.Type, props)
+	if err != nil {
+		// not being able to form the name is not a reason to fail the refresh
+		l.Debug().Err(err).Msg("cannot get entity name from properties")
+		return nil
+	}
+	if name == "" || name == efp.Entity.Name {
+		return nil
+	}
+
+	qtx := ps.getStoreOrTransaction(opts)
+	if err := qtx.UpdateEntityName(ctx, db.UpdateEntityNameParams{
+		ID:        efp.Entity.ID,
+		ProjectID: efp.Entity.ProjectID,
+		Name:      name,
+	}); err != nil {
+		return err
+	}
+
+	l.Info().Str("newEntityName", name).Msg("entity was renamed upstream")
+	efp.Entity.Name = name
+	return nil
+}
+
 func getEntityIdByProperties(
 	ctx context.Context, projectId uuid.UUID,
 	providerID uuid.UUID,
@@ -297,7 +335,7 @@ func (ps *propertiesService) areDatabasePropertiesValid(
 
 func (ps *propertiesService) isDatabasePropertyValid(
 	dbProp db.Property, opts *ReadOptions) bool {
-	if ps.entityTimeout == bypassCacheTimeout {
+	if ps.entityTimeout == bypassCacheTimeout || opts.mustRefresh() {
 		return false
 	}
 	return time.Since(dbProp.UpdatedAt) < ps.entityTimeout || opts.canTolerateStaleData()
@@ -40,6 +40,7 @@ func (psco *CallOptions) getStoreOrTransaction() db.ExtendQuerier {
 type ReadOptions struct {
 	CallOptions
 	tolerateStaleData bool
+	forceRefresh      bool
 }
 
 // ReadBuilder is a function that returns a new ReadOptions struct
@@ -56,6 +57,16 @@ func (psco *ReadOptions) TolerateStaleData() *ReadOptions {
 	return psco
 }
 
+// ForceRefresh is a function that sets the ForceRefresh field in the ReadOptions struct
+// When set, the properties are fetched from the provider even if the cached ones are still valid.
+func (psco *ReadOptions) ForceRefresh() *ReadOptions {
+	if psco == nil {
+		return nil
+	}
+	psco.forceRefresh = true
+	return psco
+}
+
 // WithStoreOrTransaction is a function that sets the StoreOrTransaction field in the CallOptions struct
 func (psco *ReadOptions) WithStoreOrTransaction(storeOrTransaction db.ExtendQuerier) *ReadOptions {
 	if psco == nil {
@@ -72,6 +83,13 @@ func (psco *ReadOptions) canTolerateStaleData() bool {
 	return psco.tolerateStaleData
 }
 
+func (psco *ReadOptions) mustRefresh() bool {
+	if psco == nil {
+		return false
+	}
+	return psco.forceRefresh
+}
+
 func (psco *ReadOptions) getStoreOrTransaction() db.ExtendQuerier {
 	if psco == nil {
 		return nil
@@ -169,6 +169,10 @@ func (ps *propertiesService) RetrieveAllPropertiesForEntity(
 		return fmt.Errorf("error fetching properties for entity: %w", err)
 	}
 
+	if err := ps.syncEntityName(ctx, propClient, efp, props, opts, l); err != nil {
+		return fmt.Errorf("error updating entity name: %w", err)
+	}
+
 	efp.UpdateProperties(props)
 	return nil
 }
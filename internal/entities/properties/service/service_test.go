@@ -686,14 +686,15 @@ func TestPropertiesService_RetrieveAllProperties(t *testing.T) {
 	seed := time.Now().UnixNano()
 
 	scenarios := []struct {
-		name        string
-		dbSetup     func(t *testing.T, store db.Store, params fetchParams)
-		githubSetup func(t *testing.T, params fetchParams) githubMockBuilder
-		params      fetchParams
-		lookupProps map[string]any
-		expectErr   string
-		checkResult func(t *testing.T, props *properties.Properties)
-		opts        []propertiesServiceOption
+		name         string
+		dbSetup      func(t *testing.T, store db.Store, params fetchParams)
+		githubSetup  func(t *testing.T, params fetchParams) githubMockBuilder
+		params       fetchParams
+		lookupProps  map[string]any
+		expectErr    string
+		checkResult  func(t *testing.T, props *properties.Properties)
+		opts         []propertiesServiceOption
+		forceRefresh bool
 	}{
 		{
 			name: "No cache, fetch from provider",
@@ -854,6 +855,54 @@ func TestPropertiesService_RetrieveAllProperties(t *testing.T) {
 				require.Equal(t, props.GetProperty(ghprop.RepoPropertyId).GetInt64(), int64(456))
 			},
 		},
+		{
+			name: "Cache hit, forced refresh fetches from provider",
+			dbSetup: func(t *testing.T, store db.Store, params fetchParams) {
+				t.Helper()
+
+				ent, err := store.CreateEntity(context.TODO(), db.CreateEntityParams{
+					EntityType: entities.EntityTypeToDB(params.entType),
+					Name:       params.entName,
+					ProjectID:  params.projectID,
+					ProviderID: params.providerID,
+				})
+				require.NoError(t, err)
+
+				propMap := map[string]any{
+					properties.PropertyUpstreamID:    "789",
+					properties.RepoPropertyIsPrivate: true,
+					ghprop.RepoPropertyId:            int64(789),
+				}
+				props := properties.NewProperties(propMap)
+				insertProperties(context.TODO(), t, store, ent.ID, props)
+			},
+			githubSetup: func(t *testing.T, params fetchParams) githubMockBuilder {
+				t.Helper()
+
+				propMap := map[string]any{
+					properties.PropertyUpstreamID:    "789",
+					properties.RepoPropertyIsPrivate: false,
+					ghprop.RepoPropertyId:            int64(789),
+				}
+				return newGithubMock(
+					withUpstreamRepoProperties(propMap, params.entType),
+				)
+			},
+			params: fetchParams{
+				entType: minderv1.Entity_ENTITY_REPOSITORIES,
+				entName: "testorg/testrepo3",
+			},
+			lookupProps: map[string]any{
+				properties.PropertyUpstreamID: "789",
+			},
+			checkResult: func(t *testing.T, props *properties.Properties) {
+				t.Helper()
+
+				require.Equal(t, props.GetProperty(properties.RepoPropertyIsPrivate).GetBool(), false)
+				require.Equal(t, props.GetProperty(ghprop.RepoPropertyId).GetInt64(), int64(789))
+			},
+			forceRefresh: true,
+		},
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -880,9 +929,14 @@ func TestPropertiesService_RetrieveAllProperties(t *testing.T) {
 
 			getByProps := properties.NewProperties(tt.lookupProps)
 
+			readOpts := ReadBuilder().WithStoreOrTransaction(tctx.testQueries)
+			if tt.forceRefresh {
+				readOpts = readOpts.ForceRefresh()
+			}
+
 			gotProps, err := propSvc.RetrieveAllProperties(
 				ctx, githubMock, tctx.dbProj.ID, tctx.ghAppProvider.ID, getByProps, tt.params.entType,
-				ReadBuilder().WithStoreOrTransaction(tctx.testQueries))
+				readOpts)
 
 			if tt.expectErr != "" {
 				require.Contains(t, err.Error(), tt.expectErr)
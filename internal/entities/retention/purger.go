@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package retention deletes the closed entities, such as closed pull
+// requests, once their retention period is over.
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// Purger deletes the closed entities whose retention period is over
+type Purger struct {
+	store db.EntitiesStore
+	cfg   *serverconfig.PullRequestRetentionConfig
+}
+
+// NewPurger creates a new purger of closed entities
+func NewPurger(store db.EntitiesStore, cfg *serverconfig.PullRequestRetentionConfig) *Purger {
+	return &Purger{
+		store: store,
+		cfg:   cfg,
+	}
+}
+
+// Run purges the expired entities at regular intervals until the context is
+// cancelled
+func (p *Purger) Run(ctx context.Context) error {
+	if p.cfg.Interval <= 0 {
+		return fmt.Errorf("invalid pull request retention interval: %s", p.cfg.Interval)
+	}
+
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := p.PurgeExpired(ctx, time.Now()); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error purging closed entities")
+			}
+		}
+	}
+}
+
+// PurgeExpired deletes a batch of entities which were closed for longer
+// than the retention period at the given time
+func (p *Purger) PurgeExpired(ctx context.Context, now time.Time) error {
+	closed, err := p.store.ListClosedEntitiesBefore(ctx, db.ListClosedEntitiesBeforeParams{
+		ClosedBefore: now.Add(-p.cfg.ClosedRetention),
+		Size:         p.cfg.BatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing closed entities: %w", err)
+	}
+
+	for _, ent := range closed {
+		// Deleting the entity cascades to its closed record and its
+		// evaluation history
+		err := p.store.DeleteEntity(ctx, db.DeleteEntityParams{
+			ID:        ent.EntityInstanceID,
+			ProjectID: ent.ProjectID,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error deleting closed entity %s: %w", ent.EntityInstanceID, err)
+		}
+
+		zerolog.Ctx(ctx).Info().
+			Str("entity_id", ent.EntityInstanceID.String()).
+			Str("project_id", ent.ProjectID.String()).
+			Msg("purged closed entity")
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestPurgerPurgeExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	cfg := &serverconfig.PullRequestRetentionConfig{
+		ClosedRetention: 24 * time.Hour,
+		BatchSize:       10,
+	}
+	closed := db.ClosedEntity{
+		EntityInstanceID: uuid.New(),
+		ProjectID:        uuid.New(),
+		ClosedAt:         now.Add(-48 * time.Hour),
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(store *mockdb.MockEntitiesStore)
+		wantErr bool
+	}{
+		{
+			name: "deletes expired entities",
+			setup: func(store *mockdb.MockEntitiesStore) {
+				store.EXPECT().ListClosedEntitiesBefore(gomock.Any(), db.ListClosedEntitiesBeforeParams{
+					ClosedBefore: now.Add(-24 * time.Hour),
+					Size:         10,
+				}).Return([]db.ClosedEntity{closed}, nil)
+				store.EXPECT().DeleteEntity(gomock.Any(), db.DeleteEntityParams{
+					ID:        closed.EntityInstanceID,
+					ProjectID: closed.ProjectID,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "entity already deleted",
+			setup: func(store *mockdb.MockEntitiesStore) {
+				store.EXPECT().ListClosedEntitiesBefore(gomock.Any(), gomock.Any()).
+					Return([]db.ClosedEntity{closed}, nil)
+				store.EXPECT().DeleteEntity(gomock.Any(), gomock.Any()).
+					Return(sql.ErrNoRows)
+			},
+		},
+		{
+			name: "nothing expired",
+			setup: func(store *mockdb.MockEntitiesStore) {
+				store.EXPECT().ListClosedEntitiesBefore(gomock.Any(), gomock.Any()).
+					Return([]db.ClosedEntity{}, nil)
+			},
+		},
+		{
+			name: "error listing closed entities",
+			setup: func(store *mockdb.MockEntitiesStore) {
+				store.EXPECT().ListClosedEntitiesBefore(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("boom"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "error deleting entity",
+			setup: func(store *mockdb.MockEntitiesStore) {
+				store.EXPECT().ListClosedEntitiesBefore(gomock.Any(), gomock.Any()).
+					Return([]db.ClosedEntity{closed}, nil)
+				store.EXPECT().DeleteEntity(gomock.Any(), gomock.Any()).
+					Return(errors.New("boom"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockEntitiesStore(ctrl)
+			tt.setup(store)
+
+			err := NewPurger(store, cfg).PurgeExpired(context.Background(), now)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -62,5 +62,5 @@ func (v *RepositoryValidator) Validate(
 		return ErrPrivateRepoForbidden
 	}
 
-	return nil
+	return features.CheckRepositoryLimit(ctx, v.store, projectID)
 }
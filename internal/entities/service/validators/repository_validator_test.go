@@ -5,6 +5,7 @@ package validators_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 
 	"github.com/google/uuid"
@@ -13,6 +14,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/entities/service/validators"
 	"github.com/mindersec/minder/pkg/entities/properties"
 )
@@ -37,8 +39,32 @@ func TestRepositoryValidator_Validate(t *testing.T) {
 				properties.RepoPropertyIsPrivate:  false,
 			}),
 			// No feature flag check needed for public repos
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEffectiveProjectTier(gomock.Any(), projectID).
+					Return(db.ProjectTier{}, sql.ErrNoRows)
+			},
 			wantErr: false,
 		},
+		{
+			name: "rejects repository exceeding the limit of the project tier",
+			props: properties.NewProperties(map[string]any{
+				properties.RepoPropertyIsArchived: false,
+				properties.RepoPropertyIsPrivate:  false,
+			}),
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEffectiveProjectTier(gomock.Any(), projectID).
+					Return(db.ProjectTier{
+						Name:            "free",
+						MaxRepositories: sql.NullInt32{Int32: 5, Valid: true},
+					}, nil)
+				store.EXPECT().CountEntitiesByTypeAndProject(gomock.Any(), db.CountEntitiesByTypeAndProjectParams{
+					EntityType: db.EntitiesRepository,
+					ProjectID:  projectID,
+				}).Return(int64(5), nil)
+			},
+			wantErr:     true,
+			errContains: `requires upgrade: tier "free" allows at most 5 repositories`,
+		},
 		// Note: Testing private repository feature flag logic is complex
 		// as it involves multiple database calls. This is better tested
 		// via integration tests.
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package evalexport exports the evaluation and remediation events as
+// OpenTelemetry logs to an OTLP collector. Observability stacks can then
+// correlate the activity of Minder with the rest of the platform telemetry,
+// without reading the evaluation history from the database. The events are
+// emitted with the context of the evaluation, so that they carry its trace.
+package evalexport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+
+	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/constants"
+	"github.com/mindersec/minder/internal/db"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// scopeName is the instrumentation scope of the exported events
+const scopeName = "github.com/mindersec/minder/internal/evalexport"
+
+const (
+	// EventEvaluation is the name of the event emitted for each rule evaluation
+	EventEvaluation = "minder.evaluation"
+	// EventRemediation is the name of the event emitted for each attempted
+	// remediation. Evaluations which didn't remediate don't emit it.
+	EventRemediation = "minder.remediation"
+)
+
+// Evaluation describes an evaluation, beyond its status parameters
+type Evaluation struct {
+	Params       *engif.EvalStatusParams
+	ProviderID   uuid.UUID
+	RuleTypeName string
+}
+
+// Exporter emits the evaluation events to an OpenTelemetry logger
+type Exporter struct {
+	logger   log.Logger
+	shutdown func(context.Context) error
+}
+
+// NewExporter creates an exporter sending the events to the OTLP/HTTP
+// collector of the configuration. The events are buffered and exported in
+// batches until the exporter is shut down.
+func NewExporter(ctx context.Context, cfg *serverconfig.EvaluationLogExportConfig) (*Exporter, error) {
+	opts := []otlploghttp.Option{
+		otlploghttp.WithEndpoint(cfg.Endpoint),
+		otlploghttp.WithURLPath(cfg.URLPath),
+		otlploghttp.WithTimeout(cfg.Timeout),
+	}
+	if cfg.Insecure {
+		opts = append(opts, otlploghttp.WithInsecure())
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlploghttp.WithHeaders(cfg.Headers))
+	}
+
+	exp, err := otlploghttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OTLP log exporter: %w", err)
+	}
+
+	res := resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName("minder"),
+		semconv.ServiceVersion(constants.CLIVersion),
+	)
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithResource(res),
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(exp,
+			sdklog.WithExportInterval(cfg.ExportInterval),
+			sdklog.WithMaxQueueSize(cfg.MaxQueueSize),
+		)),
+	)
+
+	return newExporter(provider, provider.Shutdown), nil
+}
+
+func newExporter(provider log.LoggerProvider, shutdown func(context.Context) error) *Exporter {
+	return &Exporter{
+		logger:   provider.Logger(scopeName, log.WithInstrumentationVersion(constants.CLIVersion)),
+		shutdown: shutdown,
+	}
+}
+
+// RecordEvaluation emits the events of a completed evaluation. A nil
+// exporter does nothing, so that callers don't need to check whether the
+// export is enabled.
+func (e *Exporter) RecordEvaluation(ctx context.Context, eval *Evaluation) {
+	if e == nil {
+		return
+	}
+
+	params := eval.Params
+	attrs := evaluationAttributes(eval)
+	evalStatus := dbadapter.ErrorAsEvalStatus(params.GetEvalErr())
+	remediationStatus := dbadapter.ErrorAsRemediationStatus(params.GetActionsErr().RemediateErr)
+	alertStatus := dbadapter.ErrorAsAlertStatus(params.GetActionsErr().AlertErr)
+
+	var rec log.Record
+	rec.SetEventName(EventEvaluation)
+	rec.SetSeverity(evalSeverity(evalStatus))
+	rec.SetBody(log.StringValue(fmt.Sprintf("rule %s evaluated with status %s", params.GetRule().Name, evalStatus)))
+	rec.AddAttributes(attrs...)
+	rec.AddAttributes(
+		log.String("minder.evaluation.status", string(evalStatus)),
+		log.String("minder.evaluation.details", dbadapter.ErrorAsEvalDetails(params.GetEvalErr())),
+		log.Int("minder.evaluation.retry_attempt", int(params.RetryAttempt)),
+		log.String("minder.remediation.status", string(remediationStatus)),
+		log.String("minder.alert.status", string(alertStatus)),
+	)
+	e.logger.Emit(ctx, rec)
+
+	if !remediationAttempted(remediationStatus) {
+		return
+	}
+
+	var remRec log.Record
+	remRec.SetEventName(EventRemediation)
+	remRec.SetSeverity(remediationSeverity(remediationStatus))
+	remRec.SetBody(log.StringValue(
+		fmt.Sprintf("rule %s remediated with status %s", params.GetRule().Name, remediationStatus)))
+	remRec.AddAttributes(attrs...)
+	remRec.AddAttributes(
+		log.String("minder.remediation.status", string(remediationStatus)),
+		log.String("minder.remediation.details", dbadapter.RemediationErrorAsString(params.GetActionsErr().RemediateErr)),
+	)
+	e.logger.Emit(ctx, remRec)
+}
+
+// Shutdown exports the buffered events and stops the exporter
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	if e == nil || e.shutdown == nil {
+		return nil
+	}
+	return e.shutdown(ctx)
+}
+
+// evaluationAttributes returns the attributes identifying the evaluation,
+// shared by all its events
+func evaluationAttributes(eval *Evaluation) []log.KeyValue {
+	params := eval.Params
+	return []log.KeyValue{
+		log.String("minder.project_id", params.ProjectID.String()),
+		log.String("minder.provider_id", eval.ProviderID.String()),
+		log.String("minder.execution_id", params.ExecutionID.String()),
+		log.String("minder.entity.type", string(params.EntityType)),
+		log.String("minder.entity.id", params.EntityID.String()),
+		log.String("minder.profile.id", params.GetProfile().ID.String()),
+		log.String("minder.profile.name", params.GetProfile().Name),
+		log.String("minder.rule.name", params.GetRule().Name),
+		log.String("minder.rule_type.id", params.GetRule().RuleTypeID.String()),
+		log.String("minder.rule_type.name", eval.RuleTypeName),
+	}
+}
+
+func evalSeverity(status db.EvalStatusTypes) log.Severity {
+	switch status {
+	case db.EvalStatusTypesFailure:
+		return log.SeverityWarn
+	case db.EvalStatusTypesError:
+		return log.SeverityError
+	default:
+		return log.SeverityInfo
+	}
+}
+
+func remediationSeverity(status db.RemediationStatusTypes) log.Severity {
+	switch status {
+	case db.RemediationStatusTypesFailure:
+		return log.SeverityWarn
+	case db.RemediationStatusTypesError:
+		return log.SeverityError
+	default:
+		return log.SeverityInfo
+	}
+}
+
+// remediationAttempted returns whether the remediation of the rule was
+// attempted, rather than turned off or not supported by the rule type
+func remediationAttempted(status db.RemediationStatusTypes) bool {
+	return status != db.RemediationStatusTypesSkipped && status != db.RemediationStatusTypesNotAvailable
+}
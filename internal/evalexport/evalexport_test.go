@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package evalexport
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"github.com/mindersec/minder/internal/db"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+type event struct {
+	name     string
+	severity log.Severity
+	attrs    map[string]string
+}
+
+// memoryExporter keeps the exported records in memory
+type memoryExporter struct {
+	events []event
+}
+
+func (m *memoryExporter) Export(_ context.Context, records []sdklog.Record) error {
+	for _, r := range records {
+		ev := event{
+			name:     r.EventName(),
+			severity: r.Severity(),
+			attrs:    make(map[string]string),
+		}
+		r.WalkAttributes(func(kv log.KeyValue) bool {
+			ev.attrs[kv.Key] = kv.Value.String()
+			return true
+		})
+		m.events = append(m.events, ev)
+	}
+	return nil
+}
+
+func (*memoryExporter) Shutdown(context.Context) error   { return nil }
+func (*memoryExporter) ForceFlush(context.Context) error { return nil }
+
+func TestRecordEvaluation(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	entityID := uuid.New()
+
+	tests := []struct {
+		name         string
+		evalErr      error
+		remediateErr error
+		alertErr     error
+		wantEvents   []string
+		wantSeverity log.Severity
+		wantStatus   map[string]string
+	}{
+		{
+			name:         "passing evaluation",
+			remediateErr: evalerrors.ErrActionSkipped,
+			alertErr:     evalerrors.ErrActionSkipped,
+			wantEvents:   []string{EventEvaluation},
+			wantSeverity: log.SeverityInfo,
+			wantStatus: map[string]string{
+				"minder.evaluation.status":  string(db.EvalStatusTypesSuccess),
+				"minder.remediation.status": string(db.RemediationStatusTypesSkipped),
+				"minder.alert.status":       string(db.AlertStatusTypesSkipped),
+			},
+		},
+		{
+			name:         "failing evaluation remediated",
+			evalErr:      evalerrors.NewErrEvaluationFailed("branch protection is disabled"),
+			alertErr:     evalerrors.ErrActionSkipped,
+			wantEvents:   []string{EventEvaluation, EventRemediation},
+			wantSeverity: log.SeverityWarn,
+			wantStatus: map[string]string{
+				"minder.evaluation.status":  string(db.EvalStatusTypesFailure),
+				"minder.evaluation.details": "branch protection is disabled",
+				"minder.remediation.status": string(db.RemediationStatusTypesSuccess),
+			},
+		},
+		{
+			name:         "failing evaluation with failed remediation",
+			evalErr:      evalerrors.NewErrEvaluationFailed("branch protection is disabled"),
+			remediateErr: evalerrors.NewErrActionFailed("pull request could not be opened"),
+			alertErr:     evalerrors.ErrActionSkipped,
+			wantEvents:   []string{EventEvaluation, EventRemediation},
+			wantSeverity: log.SeverityWarn,
+			wantStatus: map[string]string{
+				"minder.remediation.status": string(db.RemediationStatusTypesFailure),
+			},
+		},
+		{
+			name:         "evaluation error",
+			evalErr:      errors.New("upstream API unavailable"),
+			remediateErr: evalerrors.ErrActionSkipped,
+			alertErr:     evalerrors.ErrActionSkipped,
+			wantEvents:   []string{EventEvaluation},
+			wantSeverity: log.SeverityError,
+			wantStatus: map[string]string{
+				"minder.evaluation.status":  string(db.EvalStatusTypesError),
+				"minder.evaluation.details": "upstream API unavailable",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			exp := &memoryExporter{}
+			provider := sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewSimpleProcessor(exp)))
+			exporter := newExporter(provider, provider.Shutdown)
+
+			params := &engif.EvalStatusParams{
+				Profile:     &models.ProfileAggregate{ID: uuid.New(), Name: "security-baseline"},
+				Rule:        &models.RuleInstance{ID: uuid.New(), Name: "branch-protection", RuleTypeID: uuid.New()},
+				ProjectID:   projectID,
+				EntityType:  db.EntitiesRepository,
+				EntityID:    entityID,
+				ExecutionID: uuid.New(),
+			}
+			params.SetEvalErr(tt.evalErr)
+			params.SetActionsErr(context.Background(), evalerrors.ActionsError{
+				RemediateErr: tt.remediateErr,
+				AlertErr:     tt.alertErr,
+			})
+
+			exporter.RecordEvaluation(context.Background(), &Evaluation{
+				Params:       params,
+				ProviderID:   uuid.New(),
+				RuleTypeName: "branch_protection_enabled",
+			})
+			require.NoError(t, exporter.Shutdown(context.Background()))
+
+			var names []string
+			for _, ev := range exp.events {
+				names = append(names, ev.name)
+				require.Equal(t, projectID.String(), ev.attrs["minder.project_id"])
+				require.Equal(t, entityID.String(), ev.attrs["minder.entity.id"])
+				require.Equal(t, "repository", ev.attrs["minder.entity.type"])
+				require.Equal(t, "security-baseline", ev.attrs["minder.profile.name"])
+				require.Equal(t, "branch-protection", ev.attrs["minder.rule.name"])
+				require.Equal(t, "branch_protection_enabled", ev.attrs["minder.rule_type.name"])
+			}
+			require.Equal(t, tt.wantEvents, names)
+
+			evalEvent := exp.events[0]
+			require.Equal(t, tt.wantSeverity, evalEvent.severity)
+			for k, v := range tt.wantStatus {
+				require.Equal(t, v, evalEvent.attrs[k], k)
+			}
+		})
+	}
+}
+
+func TestNilExporter(t *testing.T) {
+	t.Parallel()
+
+	var exporter *Exporter
+	exporter.RecordEvaluation(context.Background(), &Evaluation{Params: &engif.EvalStatusParams{}})
+	require.NoError(t, exporter.Shutdown(context.Background()))
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/rs/zerolog"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// topicConsumers maps the topics to the configuration of their consumers
+func topicConsumers(cfg *serverconfig.ConsumersConfig) map[string]serverconfig.ConsumerConfig {
+	return map[string]serverconfig.ConsumerConfig{
+		constants.TopicQueueEntityEvaluate:               cfg.Evaluate,
+		constants.TopicQueueEntityFlush:                  cfg.Evaluate,
+		constants.TopicQueueRefreshEntityAndEvaluate:     cfg.Evaluate,
+		constants.TopicQueueRefreshEntityByIDAndEvaluate: cfg.Evaluate,
+		constants.TopicQueueReconcileRepoInit:            cfg.Reconcile,
+		constants.TopicQueueReconcileProfileInit:         cfg.Reconcile,
+		constants.TopicQueueReconcileEntityAdd:           cfg.Reconcile,
+		constants.TopicQueueOriginatingEntityAdd:         cfg.Reconcile,
+		constants.TopicQueueReconcileInstallation:        cfg.Reconcile,
+		constants.TopicQueueReconcileEntityDelete:        cfg.Delete,
+		constants.TopicQueueOriginatingEntityDelete:      cfg.Delete,
+		constants.TopicQueueGetEntityAndDelete:           cfg.Delete,
+		constants.TopicQueueRepoReminder:                 cfg.Reminders,
+	}
+}
+
+// concurrentSubscriber decorates the subscriber of a driver so that the
+// messages of a topic are handled by several workers at once, and received
+// ahead of a worker being available.
+//
+// The drivers only deliver the next message of a topic once the previous one
+// was acknowledged, so the messages are acknowledged to the driver as soon as
+// they are queued for a worker, and re-published if their handler fails.
+type concurrentSubscriber struct {
+	message.Subscriber
+	pub       message.Publisher
+	consumers map[string]serverconfig.ConsumerConfig
+}
+
+func newConcurrentSubscriber(
+	sub message.Subscriber,
+	pub message.Publisher,
+	cfg *serverconfig.ConsumersConfig,
+) message.Subscriber {
+	return &concurrentSubscriber{
+		Subscriber: sub,
+		pub:        pub,
+		consumers:  topicConsumers(cfg),
+	}
+}
+
+// Subscribe implements message.Subscriber
+func (s *concurrentSubscriber) Subscribe(ctx context.Context, topic string) (<-chan *message.Message, error) {
+	cfg, ok := s.consumers[topic]
+	if !ok || (cfg.Workers <= 1 && cfg.Prefetch <= 0) {
+		return s.Subscriber.Subscribe(ctx, topic)
+	}
+
+	in, err := s.Subscriber.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	prefetched := make(chan *message.Message, max(cfg.Prefetch, 0))
+	out := make(chan *message.Message)
+	go prefetch(ctx, in, prefetched)
+	go s.dispatch(ctx, topic, max(cfg.Workers, 1), prefetched, out)
+	return out, nil
+}
+
+// prefetch receives the messages from the driver and acknowledges them once
+// they are queued for a worker
+func prefetch(ctx context.Context, in <-chan *message.Message, prefetched chan<- *message.Message) {
+	defer close(prefetched)
+
+	for msg := range in {
+		queued := msg.Copy()
+		queued.SetContext(msg.Context())
+
+		select {
+		case prefetched <- queued:
+			msg.Ack()
+		case <-ctx.Done():
+			msg.Nack()
+			return
+		}
+	}
+}
+
+// dispatch hands the queued messages to the router as long as there are
+// workers available
+func (s *concurrentSubscriber) dispatch(
+	ctx context.Context,
+	topic string,
+	workers int,
+	prefetched <-chan *message.Message,
+	out chan<- *message.Message,
+) {
+	defer close(out)
+
+	slots := make(chan struct{}, workers)
+	for {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			s.requeueAll(ctx, topic, prefetched)
+			return
+		}
+
+		var msg *message.Message
+		select {
+		case m, ok := <-prefetched:
+			if !ok {
+				return
+			}
+			msg = m
+		case <-ctx.Done():
+			s.requeueAll(ctx, topic, prefetched)
+			return
+		}
+
+		select {
+		case out <- msg:
+			go s.release(ctx, topic, msg, slots)
+		case <-ctx.Done():
+			s.requeue(ctx, topic, msg)
+			s.requeueAll(ctx, topic, prefetched)
+			return
+		}
+	}
+}
+
+// release frees the worker of a message once it was handled, and re-publishes
+// the message if its handler failed
+func (s *concurrentSubscriber) release(ctx context.Context, topic string, msg *message.Message, slots <-chan struct{}) {
+	defer func() { <-slots }()
+
+	select {
+	case <-msg.Acked():
+	case <-msg.Nacked():
+		s.requeue(ctx, topic, msg)
+	}
+}
+
+// requeueAll re-publishes the messages which were acknowledged to the driver,
+// but not handled before the subscription was closed
+func (s *concurrentSubscriber) requeueAll(ctx context.Context, topic string, prefetched <-chan *message.Message) {
+	for {
+		select {
+		case msg, ok := <-prefetched:
+			if !ok {
+				return
+			}
+			s.requeue(ctx, topic, msg)
+		default:
+			return
+		}
+	}
+}
+
+func (s *concurrentSubscriber) requeue(ctx context.Context, topic string, msg *message.Message) {
+	if err := s.pub.Publish(topic, msg.Copy()); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).
+			Str("message_uuid", msg.UUID).
+			Str("topic", topic).
+			Msg("error re-publishing message")
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/stretchr/testify/require"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func receive(t *testing.T, out <-chan *message.Message) *message.Message {
+	t.Helper()
+	select {
+	case msg := <-out:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for message")
+		return nil
+	}
+}
+
+func requireNoMessage(t *testing.T, out <-chan *message.Message) {
+	t.Helper()
+	select {
+	case msg := <-out:
+		t.Fatalf("unexpected message %s", msg.UUID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestConcurrentSubscriber(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	pubsub := gochannel.NewGoChannel(gochannel.Config{}, watermill.NopLogger{})
+	t.Cleanup(func() { _ = pubsub.Close() })
+
+	sub := newConcurrentSubscriber(pubsub, pubsub, &serverconfig.ConsumersConfig{
+		Evaluate: serverconfig.ConsumerConfig{Workers: 2},
+	})
+
+	out, err := sub.Subscribe(ctx, constants.TopicQueueEntityEvaluate)
+	require.NoError(t, err)
+
+	for _, id := range []string{"1", "2", "3"} {
+		require.NoError(t, pubsub.Publish(constants.TopicQueueEntityEvaluate, message.NewMessage(id, nil)))
+	}
+
+	// Two messages are handled at once, the third waits for a worker
+	first := receive(t, out)
+	second := receive(t, out)
+	requireNoMessage(t, out)
+
+	// A failed message is re-published once its worker is released
+	first.Nack()
+	third := receive(t, out)
+	require.ElementsMatch(t, []string{"1", "2", "3"}, []string{first.UUID, second.UUID, third.UUID})
+	requireNoMessage(t, out)
+
+	second.Ack()
+	retried := receive(t, out)
+	require.Equal(t, first.UUID, retried.UUID)
+	third.Ack()
+	retried.Ack()
+}
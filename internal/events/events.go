@@ -27,6 +27,7 @@ import (
 	"github.com/mindersec/minder/internal/events/common"
 	"github.com/mindersec/minder/internal/events/gochannel"
 	"github.com/mindersec/minder/internal/events/nats"
+	"github.com/mindersec/minder/internal/events/schema"
 	eventersql "github.com/mindersec/minder/internal/events/sql"
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/eventer/constants"
@@ -49,6 +50,9 @@ type eventer struct {
 	webhookSubscriber message.Subscriber
 	// TODO: We'll have a Final publisher that will publish to the final topic
 	msgInstruments *messageInstruments
+	// schemas are the schemas of the message payloads, checked when
+	// publishing and consuming messages
+	schemas *schema.Registry
 
 	closer common.DriverCloser
 }
@@ -56,6 +60,8 @@ type eventer struct {
 type messageInstruments struct {
 	// message processing time duration histogram
 	messageProcessingTimeHistogram metric.Int64Histogram
+	// stuck messages counter, incremented by the watchdog
+	stuckMessagesCounter metric.Int64Counter
 }
 
 const (
@@ -100,14 +106,24 @@ func NewEventer(
 	if err != nil {
 		return nil, fmt.Errorf("failed instantiating driver: %w", err)
 	}
+	sub = newConcurrentSubscriber(sub, pub, &cfg.Consumers)
 
 	poisonQueueMiddleware, err := middleware.PoisonQueue(pub, constants.DeadLetterQueueTopic)
 	if err != nil {
 		return nil, fmt.Errorf("failed instantiating poison queue: %w", err)
 	}
 	// Router level middleware are executed for every message sent to the router
+	router.AddMiddleware(recordMetrics(metricInstruments))
+	if cfg.Watchdog.Enabled {
+		wd, err := newWatchdog(pub, &cfg.Watchdog, metricInstruments)
+		if err != nil {
+			return nil, fmt.Errorf("failed instantiating watchdog: %w", err)
+		}
+		// The watchdog sits outside of the poison queue, so that nacked
+		// messages are redelivered rather than poisoned
+		router.AddMiddleware(wd.Middleware)
+	}
 	router.AddMiddleware(
-		recordMetrics(metricInstruments),
 		poisonQueueMiddleware,
 		middleware.Retry{
 			MaxRetries:      3,
@@ -141,6 +157,7 @@ func NewEventer(
 			cl()
 		},
 		msgInstruments: metricInstruments,
+		schemas:        newPayloadSchemas(),
 	}, nil
 }
 
@@ -188,6 +205,12 @@ func (e *eventer) Running() chan struct{} {
 
 // Publish implements message.Publisher
 func (e *eventer) Publish(topic string, messages ...*message.Message) error {
+	for _, msg := range messages {
+		if err := e.schemas.Stamp(topic, msg); err != nil {
+			return fmt.Errorf("error publishing message %s: %w", msg.UUID, err)
+		}
+	}
+
 	pc, _, _, ok := runtime.Caller(1)
 	details := runtime.FuncForPC(pc)
 
@@ -221,6 +244,21 @@ func (e *eventer) Register(
 		topic,
 		e.webhookSubscriber,
 		func(msg *message.Message) error {
+			// Messages which can't be handled by this server, e.g. because
+			// they were published by a newer one, end up in the dead letter
+			// queue, from which they can be replayed.
+			if err := e.schemas.Check(topic, msg); err != nil {
+				e.router.Logger().Error("Rejected message payload", err, watermill.LogFields{
+					"message_uuid":   msg.UUID,
+					"topic":          topic,
+					"handler":        funcName,
+					"component":      "eventer",
+					"schema_version": msg.Metadata.Get(constants.SchemaVersionKey),
+				})
+
+				return err
+			}
+
 			if err := handler(msg); err != nil {
 				e.router.Logger().Error("Found error handling message", err, watermill.LogFields{
 					"message_uuid": msg.UUID,
@@ -11,11 +11,17 @@ import (
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 
+	"github.com/mindersec/minder/internal/events/schema"
+	"github.com/mindersec/minder/internal/reconcilers/messages"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/entities/properties"
 	"github.com/mindersec/minder/pkg/eventer"
 	"github.com/mindersec/minder/pkg/eventer/constants"
 	"github.com/mindersec/minder/pkg/eventer/interfaces"
@@ -305,3 +311,37 @@ func checkEventCounts(t *testing.T, reader *metric.ManualReader, expectedOk uint
 		t.Errorf("Expected largest bucket to be at least 5 minutes, was %f", largestBucket)
 	}
 }
+
+func TestPublishVersionedPayloads(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	evt, _, err := setupEventerWithMetricReader(ctx)
+	require.NoError(t, err)
+
+	out := make(chan eventPair, 1)
+	evt.Register(constants.TopicQueueReconcileEntityAdd, fakeHandler(constants.TopicQueueReconcileEntityAdd, out))
+
+	go evt.Run(ctx)
+	defer evt.Close()
+	<-evt.Running()
+
+	invalid := message.NewMessage(uuid.New().String(), []byte(`{"provider_id": "not a uuid"}`))
+	err = evt.Publish(constants.TopicQueueReconcileEntityAdd, invalid)
+	require.ErrorIs(t, err, schema.ErrInvalidPayload)
+
+	msg := message.NewMessage(uuid.New().String(), nil)
+	require.NoError(t, messages.NewMinderEvent().
+		WithProviderID(uuid.New()).
+		WithProjectID(uuid.New()).
+		WithEntityType(minderv1.Entity_ENTITY_REPOSITORIES).
+		WithProperties(properties.NewProperties(map[string]any{"name": "repo"})).
+		ToMessage(msg))
+	require.NoError(t, evt.Publish(constants.TopicQueueReconcileEntityAdd, msg))
+
+	got := <-out
+	require.Equal(t, msg.UUID, got.msg.UUID)
+	require.Equal(t, "1", got.msg.Metadata.Get(constants.SchemaVersionKey))
+}
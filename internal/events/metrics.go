@@ -49,8 +49,16 @@ func initMetricsInstruments(meter metric.Meter) (*messageInstruments, error) {
 		return nil, err
 	}
 
+	stuckCounter, err := meter.Int64Counter("messages.stuck",
+		metric.WithDescription("Number of messages whose handler did not return within the watchdog threshold"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stuck messages counter: %w", err)
+	}
+
 	return &messageInstruments{
 		messageProcessingTimeHistogram: histogram,
+		stuckMessagesCounter:           stuckCounter,
 	}, nil
 }
 
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package schema contains a registry of the versioned schemas of the payloads
+// of the internal messages.
+//
+// Each message published to a topic with a registered schema carries the
+// version of its payload in its metadata. Consumers check that they are able
+// to handle the version before handling the message, so that servers running
+// different releases can share the event bus during a rolling upgrade.
+//
+// Payload changes which older servers can't consume are rolled out in two
+// releases: the first one accepts the new version (MaxVersion) while still
+// publishing the old one (Version), and the second one publishes the new
+// version.
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// LegacyVersion is the version of the payloads of the messages published
+// before the payloads were versioned, which don't carry a version.
+const LegacyVersion = 1
+
+var (
+	// ErrIncompatibleVersion is returned for messages whose payload version
+	// can't be handled by this server.
+	ErrIncompatibleVersion = errors.New("incompatible payload version")
+	// ErrInvalidPayload is returned for messages whose payload doesn't match
+	// the schema of its version.
+	ErrInvalidPayload = errors.New("invalid payload")
+)
+
+// Schema describes the payloads of the messages of one or more topics.
+type Schema struct {
+	// Name identifies the payload, e.g. in errors
+	Name string
+	// Version is the version of the payloads published by this server
+	Version int
+	// MinVersion is the oldest version of the payloads this server consumes
+	MinVersion int
+	// MaxVersion is the newest version of the payloads this server consumes.
+	// It is at least Version.
+	MaxVersion int
+	// Validate checks that the message holds a valid payload of the given
+	// version. It is optional.
+	Validate func(version int, msg *message.Message) error
+}
+
+// Accepts returns true if this server consumes the payloads of the version.
+func (s *Schema) Accepts(version int) bool {
+	return version >= s.MinVersion && version <= s.MaxVersion
+}
+
+// Registry maps topics to the schemas of their payloads.
+type Registry struct {
+	mu      sync.RWMutex
+	schemas map[string]*Schema
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		schemas: make(map[string]*Schema),
+	}
+}
+
+// Register sets the schema of the payloads of the topics. It panics if the
+// versions of the schema are inconsistent or a topic already has a schema,
+// as both are programming errors.
+func (r *Registry) Register(s *Schema, topics ...string) {
+	if s.MinVersion < LegacyVersion || s.Version < s.MinVersion || s.MaxVersion < s.Version {
+		panic(fmt.Sprintf("schema %s: versions must satisfy %d <= min (%d) <= version (%d) <= max (%d)",
+			s.Name, LegacyVersion, s.MinVersion, s.Version, s.MaxVersion))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, topic := range topics {
+		if existing, ok := r.schemas[topic]; ok {
+			panic(fmt.Sprintf("topic %s already has schema %s", topic, existing.Name))
+		}
+		r.schemas[topic] = s
+	}
+}
+
+// Get returns the schema of the payloads of the topic, if any.
+func (r *Registry) Get(topic string) (*Schema, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.schemas[topic]
+	return s, ok
+}
+
+// Stamp prepares a message to be published to the topic: the version of the
+// published payloads is set, unless the message already carries one, e.g.
+// because it is redelivered, and the payload is validated against it.
+// Messages of topics without a schema are left untouched.
+func (r *Registry) Stamp(topic string, msg *message.Message) error {
+	s, ok := r.Get(topic)
+	if !ok {
+		return nil
+	}
+
+	if msg.Metadata.Get(constants.SchemaVersionKey) == "" {
+		msg.Metadata.Set(constants.SchemaVersionKey, strconv.Itoa(s.Version))
+	}
+	return r.check(topic, s, msg)
+}
+
+// Check verifies that this server is able to consume a message of the topic:
+// the version of its payload must be one the server accepts, and the payload
+// must be valid for it. Messages of topics without a schema are always
+// accepted.
+func (r *Registry) Check(topic string, msg *message.Message) error {
+	s, ok := r.Get(topic)
+	if !ok {
+		return nil
+	}
+	return r.check(topic, s, msg)
+}
+
+func (*Registry) check(topic string, s *Schema, msg *message.Message) error {
+	version, err := Version(msg)
+	if err != nil {
+		return err
+	}
+	if !s.Accepts(version) {
+		return fmt.Errorf("%w: %s version %d on topic %s, accepted versions are %d to %d",
+			ErrIncompatibleVersion, s.Name, version, topic, s.MinVersion, s.MaxVersion)
+	}
+	if s.Validate == nil {
+		return nil
+	}
+	if err := s.Validate(version, msg); err != nil {
+		return fmt.Errorf("%w: %s version %d on topic %s: %w", ErrInvalidPayload, s.Name, version, topic, err)
+	}
+	return nil
+}
+
+// Version returns the version of the payload of a message. Messages without
+// a version hold a LegacyVersion payload.
+func Version(msg *message.Message) (int, error) {
+	raw := msg.Metadata.Get(constants.SchemaVersionKey)
+	if raw == "" {
+		return LegacyVersion, nil
+	}
+	version, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%w: malformed version %q", ErrIncompatibleVersion, raw)
+	}
+	return version, nil
+}
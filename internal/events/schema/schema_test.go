@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/events/schema"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func newRegistry() *schema.Registry {
+	r := schema.NewRegistry()
+	r.Register(&schema.Schema{
+		Name:       "test_payload",
+		Version:    2,
+		MinVersion: 1,
+		MaxVersion: 3,
+		Validate: func(version int, msg *message.Message) error {
+			if version > 1 && len(msg.Payload) == 0 {
+				return errors.New("empty payload")
+			}
+			return nil
+		},
+	}, "topic.a", "topic.b")
+	return r
+}
+
+func newMessage(version string, payload string) *message.Message {
+	msg := message.NewMessage("id", []byte(payload))
+	if version != "" {
+		msg.Metadata.Set(constants.SchemaVersionKey, version)
+	}
+	return msg
+}
+
+func TestRegistryStamp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		topic           string
+		msg             *message.Message
+		expectedVersion string
+		expectedErr     error
+	}{
+		{
+			name:            "current version is set",
+			topic:           "topic.b",
+			msg:             newMessage("", "{}"),
+			expectedVersion: "2",
+		},
+		{
+			name:            "existing version is kept",
+			topic:           "topic.a",
+			msg:             newMessage("3", "{}"),
+			expectedVersion: "3",
+		},
+		{
+			name:        "invalid payload",
+			topic:       "topic.a",
+			msg:         newMessage("", ""),
+			expectedErr: schema.ErrInvalidPayload,
+		},
+		{
+			name:  "topic without schema",
+			topic: "topic.c",
+			msg:   newMessage("", ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := newRegistry().Stamp(tt.topic, tt.msg)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedVersion, tt.msg.Metadata.Get(constants.SchemaVersionKey))
+		})
+	}
+}
+
+func TestRegistryCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		topic       string
+		msg         *message.Message
+		expectedErr error
+	}{
+		{
+			name:  "legacy message without version",
+			topic: "topic.a",
+			msg:   newMessage("", ""),
+		},
+		{
+			name:  "newer accepted version",
+			topic: "topic.a",
+			msg:   newMessage("3", "{}"),
+		},
+		{
+			name:        "version too new",
+			topic:       "topic.a",
+			msg:         newMessage("4", "{}"),
+			expectedErr: schema.ErrIncompatibleVersion,
+		},
+		{
+			name:        "version too old",
+			topic:       "topic.b",
+			msg:         newMessage("0", "{}"),
+			expectedErr: schema.ErrIncompatibleVersion,
+		},
+		{
+			name:        "malformed version",
+			topic:       "topic.b",
+			msg:         newMessage("v2", "{}"),
+			expectedErr: schema.ErrIncompatibleVersion,
+		},
+		{
+			name:        "invalid payload",
+			topic:       "topic.b",
+			msg:         newMessage("2", ""),
+			expectedErr: schema.ErrInvalidPayload,
+		},
+		{
+			name:  "topic without schema",
+			topic: "topic.c",
+			msg:   newMessage("42", ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := newRegistry().Check(tt.topic, tt.msg)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRegisterInvalidSchema(t *testing.T) {
+	t.Parallel()
+
+	r := newRegistry()
+	require.Panics(t, func() {
+		r.Register(&schema.Schema{Name: "other", Version: 1, MinVersion: 1, MaxVersion: 1}, "topic.a")
+	})
+	require.Panics(t, func() {
+		r.Register(&schema.Schema{Name: "other", Version: 2, MinVersion: 1, MaxVersion: 1}, "topic.c")
+	})
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"encoding/json"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/mindersec/minder/internal/engine/entities"
+	entmessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/events/schema"
+	"github.com/mindersec/minder/internal/reconcilers/messages"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// newPayloadSchemas registers the schemas of the payloads of the internal
+// topics. See the schema package on how to introduce a new version.
+func newPayloadSchemas() *schema.Registry {
+	r := schema.NewRegistry()
+
+	// The entities to evaluate, set by entities.EntityInfoWrapper
+	r.Register(&schema.Schema{
+		Name:       "entity_evaluate",
+		Version:    1,
+		MinVersion: 1,
+		MaxVersion: 1,
+		Validate:   validateEntityEvaluate,
+	}, constants.TopicQueueEntityEvaluate)
+
+	// The entities to refresh before acting on them, set by
+	// message.HandleEntityAndDoMessage
+	r.Register(&schema.Schema{
+		Name:       "entity_refresh_and_do",
+		Version:    1,
+		MinVersion: 1,
+		MaxVersion: 1,
+		Validate:   validateHandleEntityAndDo,
+	},
+		constants.TopicQueueOriginatingEntityAdd,
+		constants.TopicQueueOriginatingEntityDelete,
+		constants.TopicQueueGetEntityAndDelete,
+		constants.TopicQueueRefreshEntityByIDAndEvaluate,
+		constants.TopicQueueRefreshEntityAndEvaluate,
+	)
+
+	// The entities to add or delete, set by messages.MinderEvent
+	r.Register(&schema.Schema{
+		Name:       "minder_event",
+		Version:    1,
+		MinVersion: 1,
+		MaxVersion: 1,
+		Validate:   validateMinderEvent,
+	},
+		constants.TopicQueueReconcileEntityAdd,
+		constants.TopicQueueReconcileEntityDelete,
+	)
+
+	return r
+}
+
+func validateEntityEvaluate(_ int, msg *message.Message) error {
+	_, err := entities.ParseEntityEvent(msg)
+	return err
+}
+
+func validateHandleEntityAndDo(_ int, msg *message.Message) error {
+	_, err := entmessage.ToEntityRefreshAndDo(msg)
+	return err
+}
+
+func validateMinderEvent(_ int, msg *message.Message) error {
+	var event messages.MinderEvent
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		return err
+	}
+	return validator.New().Struct(&event)
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+const (
+	// WatchdogActionNack nacks a stuck message, so that it is redelivered
+	WatchdogActionNack = "nack"
+	// WatchdogActionQuarantine moves a stuck message to the dead letter queue
+	WatchdogActionQuarantine = "quarantine"
+
+	// reasonStuck is the reason recorded on messages quarantined by the watchdog
+	reasonStuck = "handler did not return within the watchdog threshold"
+)
+
+// watchdog detects the messages whose handler didn't return within the
+// threshold. The stuck handler keeps running in the background, but the
+// message is released so that it doesn't stall the topic.
+type watchdog struct {
+	pub         message.Publisher
+	threshold   time.Duration
+	action      string
+	instruments *messageInstruments
+}
+
+func newWatchdog(
+	pub message.Publisher,
+	cfg *serverconfig.WatchdogConfig,
+	instruments *messageInstruments,
+) (*watchdog, error) {
+	if cfg.Threshold <= 0 {
+		return nil, fmt.Errorf("invalid watchdog threshold: %s", cfg.Threshold)
+	}
+	switch cfg.Action {
+	case WatchdogActionNack, WatchdogActionQuarantine:
+	default:
+		return nil, fmt.Errorf("unknown watchdog action %q", cfg.Action)
+	}
+
+	return &watchdog{
+		pub:         pub,
+		threshold:   cfg.Threshold,
+		action:      cfg.Action,
+		instruments: instruments,
+	}, nil
+}
+
+type handlerResult struct {
+	msgs []*message.Message
+	err  error
+}
+
+// Middleware is the router middleware watching over the handlers
+func (w *watchdog) Middleware(h message.HandlerFunc) message.HandlerFunc {
+	return func(msg *message.Message) ([]*message.Message, error) {
+		done := make(chan handlerResult, 1)
+		gid := make(chan uint64, 1)
+		go func() {
+			gid <- currentGoroutineID()
+			msgs, err := h(msg)
+			done <- handlerResult{msgs: msgs, err: err}
+		}()
+
+		timer := time.NewTimer(w.threshold)
+		defer timer.Stop()
+
+		select {
+		case res := <-done:
+			return res.msgs, res.err
+		case <-timer.C:
+			return w.onStuck(msg, <-gid)
+		}
+	}
+}
+
+func (w *watchdog) onStuck(msg *message.Message, gid uint64) ([]*message.Message, error) {
+	ctx := msg.Context()
+	topic := message.SubscribeTopicFromCtx(ctx)
+	handler := message.HandlerNameFromCtx(ctx)
+
+	zerolog.Ctx(ctx).Error().
+		Str("message_uuid", msg.UUID).
+		Str("topic", topic).
+		Str("handler", handler).
+		Str("action", w.action).
+		Dur("threshold", w.threshold).
+		Str("stack", goroutineStacks(gid)).
+		Msg("message handler is stuck")
+
+	w.instruments.stuckMessagesCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("topic", topic),
+		attribute.String("handler", handler),
+		attribute.String("action", w.action),
+	))
+
+	if w.action == WatchdogActionNack {
+		return nil, fmt.Errorf("message handler did not return within %s", w.threshold)
+	}
+
+	// Quarantine a copy: the stuck handler may still be using the original message
+	quarantined := msg.Copy()
+	quarantined.Metadata.Set(middleware.ReasonForPoisonedKey, reasonStuck)
+	quarantined.Metadata.Set(middleware.PoisonedTopicKey, topic)
+	quarantined.Metadata.Set(middleware.PoisonedHandlerKey, handler)
+	quarantined.Metadata.Set(middleware.PoisonedSubscriberKey, message.SubscriberNameFromCtx(ctx))
+	if err := w.pub.Publish(constants.DeadLetterQueueTopic, quarantined); err != nil {
+		return nil, fmt.Errorf("error quarantining stuck message: %w", err)
+	}
+	// Let the metrics know the message ended up in the dead letter queue
+	msg.Metadata.Set(middleware.ReasonForPoisonedKey, reasonStuck)
+	return nil, nil
+}
+
+// currentGoroutineID parses the ID of the calling goroutine from the header of
+// its stack trace, e.g. "goroutine 42 [running]:"
+func currentGoroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i > 0 {
+		buf = buf[:i]
+	}
+	id, err := strconv.ParseUint(string(buf), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// goroutineStacks returns the stack traces of the goroutine with the given
+// ID and of the goroutines it created
+func goroutineStacks(gid uint64) string {
+	buf := make([]byte, 1<<20)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	header := fmt.Sprintf("goroutine %d [", gid)
+	creator := fmt.Sprintf(" in goroutine %d\n", gid)
+
+	var stacks []string
+	for _, stack := range strings.Split(string(buf), "\n\n") {
+		if strings.HasPrefix(stack, header) || strings.Contains(stack+"\n", creator) {
+			stacks = append(stacks, stack)
+		}
+	}
+	return strings.Join(stacks, "\n\n")
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+type recordingPublisher struct {
+	mu        sync.Mutex
+	published map[string][]*message.Message
+}
+
+func (p *recordingPublisher) Publish(topic string, messages ...*message.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.published == nil {
+		p.published = make(map[string][]*message.Message)
+	}
+	p.published[topic] = append(p.published[topic], messages...)
+	return nil
+}
+
+func (*recordingPublisher) Close() error {
+	return nil
+}
+
+func TestWatchdog(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	stuckHandler := func(*message.Message) ([]*message.Message, error) {
+		<-release
+		return nil, nil
+	}
+	failingHandler := func(*message.Message) ([]*message.Message, error) {
+		return nil, errors.New("handler failed")
+	}
+
+	tests := []struct {
+		name          string
+		action        string
+		handler       message.HandlerFunc
+		expectedErr   string
+		quarantined   bool
+		poisonedInMsg bool
+	}{
+		{
+			name:    "handler returning in time",
+			action:  WatchdogActionQuarantine,
+			handler: failingHandler,
+			// the error of the handler is passed through
+			expectedErr: "handler failed",
+		},
+		{
+			name:        "stuck handler is nacked",
+			action:      WatchdogActionNack,
+			handler:     stuckHandler,
+			expectedErr: "message handler did not return within",
+		},
+		{
+			name:          "stuck handler is quarantined",
+			action:        WatchdogActionQuarantine,
+			handler:       stuckHandler,
+			quarantined:   true,
+			poisonedInMsg: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			instruments, err := initMetricsInstruments(noop.NewMeterProvider().Meter("test"))
+			require.NoError(t, err)
+
+			pub := &recordingPublisher{}
+			wd, err := newWatchdog(pub, &serverconfig.WatchdogConfig{
+				Threshold: 50 * time.Millisecond,
+				Action:    tt.action,
+			}, instruments)
+			require.NoError(t, err)
+
+			msg := message.NewMessage("test", []byte("payload"))
+			_, err = wd.Middleware(tt.handler)(msg)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			dlq := pub.published[constants.DeadLetterQueueTopic]
+			if tt.quarantined {
+				require.Len(t, dlq, 1)
+				require.Equal(t, reasonStuck, dlq[0].Metadata.Get(middleware.ReasonForPoisonedKey))
+				require.Equal(t, "payload", string(dlq[0].Payload))
+			} else {
+				require.Empty(t, dlq)
+			}
+			require.Equal(t, tt.poisonedInMsg, msg.Metadata.Get(middleware.ReasonForPoisonedKey) != "")
+		})
+	}
+}
+
+func TestNewWatchdogInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := newWatchdog(&recordingPublisher{}, &serverconfig.WatchdogConfig{
+		Threshold: time.Minute,
+		Action:    "drop",
+	}, nil)
+	require.ErrorContains(t, err, "unknown watchdog action")
+
+	_, err = newWatchdog(&recordingPublisher{}, &serverconfig.WatchdogConfig{
+		Action: WatchdogActionNack,
+	}, nil)
+	require.ErrorContains(t, err, "invalid watchdog threshold")
+}
+
+func TestGoroutineStacks(t *testing.T) {
+	t.Parallel()
+
+	gid := make(chan uint64)
+	release := make(chan struct{})
+	defer close(release)
+	go func() {
+		gid <- currentGoroutineID()
+		<-release
+	}()
+
+	id := <-gid
+	require.NotZero(t, id)
+	stacks := goroutineStacks(id)
+	require.Contains(t, stacks, "TestGoroutineStacks")
+}
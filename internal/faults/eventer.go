@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package faults
+
+import (
+	"github.com/ThreeDotsLabs/watermill/message"
+
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+// faultyEventer registers every handler with a middleware injecting faults
+// before the message is handled. Handler middlewares run inside the router
+// ones, so injected failures go through the retries and the poison queue.
+type faultyEventer struct {
+	interfaces.Interface
+	injector *Injector
+}
+
+// WrapEventer returns an eventer injecting faults in the handling of the
+// messages of the targeted topics
+func WrapEventer(evt interfaces.Interface, injector *Injector) interfaces.Interface {
+	return &faultyEventer{
+		Interface: evt,
+		injector:  injector,
+	}
+}
+
+// Register implements interfaces.Registrar
+func (f *faultyEventer) Register(topic string, handler interfaces.Handler, mdw ...message.HandlerMiddleware) {
+	mdw = append(mdw, f.middleware(topic))
+	f.Interface.Register(topic, handler, mdw...)
+}
+
+// ConsumeEvents implements interfaces.Service, registering the consumers
+// with the wrapper rather than with the wrapped eventer
+func (f *faultyEventer) ConsumeEvents(consumers ...interfaces.Consumer) {
+	for _, c := range consumers {
+		c.Register(f)
+	}
+}
+
+func (f *faultyEventer) middleware(topic string) message.HandlerMiddleware {
+	return func(h message.HandlerFunc) message.HandlerFunc {
+		return func(msg *message.Message) ([]*message.Message, error) {
+			if err := f.injector.Inject(msg.Context(), topic); err != nil {
+				return nil, err
+			}
+			return h(msg)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package faults injects failures and delays at the boundaries of the events
+// and actions pipeline, so that the resilience behaviors of the server can be
+// exercised in tests. It must never be enabled in production.
+package faults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// ErrInjected is the error returned by injected failures
+var ErrInjected = errors.New("injected fault")
+
+// Injector decides, for each call at a boundary, whether to delay it and
+// whether to fail it, according to the configured rates
+type Injector struct {
+	cfg serverconfig.FaultConfig
+
+	mu   sync.Mutex
+	rand *rand.Rand
+	// sleep is replaceable for testing
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewInjector creates an injector for a boundary. The seed makes the
+// decisions reproducible; a zero seed picks a random one.
+func NewInjector(cfg serverconfig.FaultConfig, seed int64) *Injector {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &Injector{
+		cfg: cfg,
+		// nolint:gosec // fault decisions don't need a secure random source
+		rand:  rand.New(rand.NewSource(seed)),
+		sleep: sleepCtx,
+	}
+}
+
+// Inject delays and fails the call to the given target according to the
+// configuration. It returns an error wrapping ErrInjected if the call must
+// fail, and is a no-op on a nil injector.
+func (i *Injector) Inject(ctx context.Context, target string) error {
+	if i == nil || !i.targets(target) {
+		return nil
+	}
+
+	i.mu.Lock()
+	delay := i.rand.Float64() < i.cfg.DelayRate
+	fail := i.rand.Float64() < i.cfg.ErrorRate
+	i.mu.Unlock()
+
+	if delay && i.cfg.Delay > 0 {
+		zerolog.Ctx(ctx).Warn().Str("target", target).Dur("delay", i.cfg.Delay).Msg("injecting delay")
+		if err := i.sleep(ctx, i.cfg.Delay); err != nil {
+			return err
+		}
+	}
+	if fail {
+		zerolog.Ctx(ctx).Warn().Str("target", target).Msg("injecting failure")
+		return fmt.Errorf("%w in %s", ErrInjected, target)
+	}
+	return nil
+}
+
+func (i *Injector) targets(target string) bool {
+	return len(i.cfg.Targets) == 0 || slices.Contains(i.cfg.Targets, target)
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
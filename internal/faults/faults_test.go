@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package faults
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/require"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+func TestInjector(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		cfg       serverconfig.FaultConfig
+		target    string
+		wantErr   bool
+		wantDelay time.Duration
+	}{
+		{
+			name:   "no faults",
+			cfg:    serverconfig.FaultConfig{},
+			target: "remediate",
+		},
+		{
+			name:    "always fails",
+			cfg:     serverconfig.FaultConfig{ErrorRate: 1},
+			target:  "remediate",
+			wantErr: true,
+		},
+		{
+			name:      "always delayed",
+			cfg:       serverconfig.FaultConfig{DelayRate: 1, Delay: time.Minute},
+			target:    "alert",
+			wantDelay: time.Minute,
+		},
+		{
+			name:   "other target",
+			cfg:    serverconfig.FaultConfig{ErrorRate: 1, DelayRate: 1, Delay: time.Minute, Targets: []string{"alert"}},
+			target: "remediate",
+		},
+		{
+			name:      "matching target",
+			cfg:       serverconfig.FaultConfig{ErrorRate: 1, DelayRate: 1, Delay: time.Minute, Targets: []string{"alert"}},
+			target:    "alert",
+			wantErr:   true,
+			wantDelay: time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			inj := NewInjector(tt.cfg, 42)
+			var slept time.Duration
+			inj.sleep = func(_ context.Context, d time.Duration) error {
+				slept += d
+				return nil
+			}
+
+			err := inj.Inject(context.Background(), tt.target)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInjected)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantDelay, slept)
+		})
+	}
+}
+
+func TestNilInjector(t *testing.T) {
+	t.Parallel()
+
+	var inj *Injector
+	require.NoError(t, inj.Inject(context.Background(), "remediate"))
+}
+
+func TestInjectorSeedIsReproducible(t *testing.T) {
+	t.Parallel()
+
+	run := func() []bool {
+		inj := NewInjector(serverconfig.FaultConfig{ErrorRate: 0.5}, 1234)
+		var out []bool
+		for range 20 {
+			out = append(out, inj.Inject(context.Background(), "alert") != nil)
+		}
+		return out
+	}
+	require.Equal(t, run(), run())
+}
+
+type recordingRegistrar struct {
+	interfaces.Interface
+	handlers map[string]message.HandlerFunc
+}
+
+func (r *recordingRegistrar) Register(topic string, handler interfaces.Handler, mdw ...message.HandlerMiddleware) {
+	h := func(msg *message.Message) ([]*message.Message, error) {
+		return nil, handler(msg)
+	}
+	for i := len(mdw) - 1; i >= 0; i-- {
+		h = mdw[i](h)
+	}
+	r.handlers[topic] = h
+}
+
+type consumerFunc func(interfaces.Registrar)
+
+func (f consumerFunc) Register(r interfaces.Registrar) {
+	f(r)
+}
+
+func TestWrapEventer(t *testing.T) {
+	t.Parallel()
+
+	rec := &recordingRegistrar{handlers: make(map[string]message.HandlerFunc)}
+	evt := WrapEventer(rec, NewInjector(serverconfig.FaultConfig{
+		ErrorRate: 1,
+		Targets:   []string{"execute.entity.event"},
+	}, 1))
+
+	handled := 0
+	handler := func(*message.Message) error {
+		handled++
+		return nil
+	}
+	evt.ConsumeEvents(consumerFunc(func(r interfaces.Registrar) {
+		r.Register("execute.entity.event", handler)
+		r.Register("repo.reminder.event", handler)
+	}))
+
+	msg := message.NewMessage("id", nil)
+	_, err := rec.handlers["execute.entity.event"](msg)
+	require.ErrorIs(t, err, ErrInjected)
+	_, err = rec.handlers["repo.reminder.event"](msg)
+	require.NoError(t, err)
+	require.Equal(t, 1, handled)
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// CleanupReport summarizes the stale evaluations purged by a cleanup, or
+// which would be purged by a dry run
+type CleanupReport struct {
+	// RuleEntities is the number of rule and entity pairs
+	RuleEntities int64
+	// Evaluations is the number of evaluations in the history of the pairs
+	Evaluations int64
+	// DryRun is true if nothing was deleted
+	DryRun bool
+}
+
+// Cleaner purges the rule and entity pairs which were not evaluated for
+// longer than the maximum age, along with their evaluation history. These
+// are left behind when a rule stops applying to an entity, e.g. when the
+// entity is no longer selected by the profile, and the history purge keeps
+// their latest evaluation forever.
+type Cleaner struct {
+	store db.EvalHistoryStore
+	cfg   *serverconfig.EvaluationCleanupConfig
+}
+
+// NewCleaner creates a new cleaner of stale evaluations
+func NewCleaner(store db.EvalHistoryStore, cfg *serverconfig.EvaluationCleanupConfig) *Cleaner {
+	return &Cleaner{
+		store: store,
+		cfg:   cfg,
+	}
+}
+
+// Run purges the stale evaluations of all projects at regular intervals
+// until the context is cancelled
+func (c *Cleaner) Run(ctx context.Context) error {
+	if c.cfg.Interval <= 0 {
+		return fmt.Errorf("invalid evaluation cleanup interval: %s", c.cfg.Interval)
+	}
+
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			report, err := c.Cleanup(ctx, uuid.Nil, time.Now().Add(-c.cfg.MaxAge), false)
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error purging stale evaluations")
+				continue
+			}
+			zerolog.Ctx(ctx).Info().
+				Int64("rule_entities", report.RuleEntities).
+				Int64("evaluations", report.Evaluations).
+				Msg("purged stale evaluations")
+		}
+	}
+}
+
+// Cleanup purges the rule and entity pairs which were not evaluated since
+// the threshold, along with their history. The cleanup is restricted to a
+// project unless projectID is nil. A dry run only reports what would be
+// purged.
+func (c *Cleaner) Cleanup(
+	ctx context.Context,
+	projectID uuid.UUID,
+	threshold time.Time,
+	dryRun bool,
+) (*CleanupReport, error) {
+	project := uuid.NullUUID{UUID: projectID, Valid: projectID != uuid.Nil}
+
+	if dryRun {
+		counts, err := c.store.CountStaleEvaluationRuleEntities(ctx, db.CountStaleEvaluationRuleEntitiesParams{
+			Threshold: threshold,
+			ProjectID: project,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error counting stale evaluations: %w", err)
+		}
+		return &CleanupReport{
+			RuleEntities: counts.RuleEntities,
+			Evaluations:  counts.Evaluations,
+			DryRun:       true,
+		}, nil
+	}
+
+	if c.cfg.BatchSize <= 0 {
+		return nil, errors.New("invalid evaluation cleanup batch size")
+	}
+
+	report := &CleanupReport{}
+	for {
+		stale, err := c.store.ListStaleEvaluationRuleEntities(ctx, db.ListStaleEvaluationRuleEntitiesParams{
+			Threshold: threshold,
+			ProjectID: project,
+			Size:      c.cfg.BatchSize,
+		})
+		if err != nil {
+			return report, fmt.Errorf("error listing stale evaluations: %w", err)
+		}
+		if len(stale) == 0 {
+			return report, nil
+		}
+
+		ids := make([]uuid.UUID, 0, len(stale))
+		var evaluations int64
+		for _, s := range stale {
+			ids = append(ids, s.ID)
+			evaluations += s.Evaluations
+		}
+
+		// Deleting the pairs cascades to their evaluation history
+		deleted, err := c.store.DeleteEvaluationRuleEntitiesByIDs(ctx, ids)
+		if err != nil {
+			return report, fmt.Errorf("error deleting stale evaluations: %w", err)
+		}
+		report.RuleEntities += deleted
+		report.Evaluations += evaluations
+
+		if len(stale) < int(c.cfg.BatchSize) {
+			return report, nil
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestCleanerCleanup(t *testing.T) {
+	t.Parallel()
+
+	threshold := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	projectID := uuid.New()
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name           string
+		projectID      uuid.UUID
+		dryRun         bool
+		setup          func(store *mockdb.MockEvalHistoryStore)
+		expectedReport *CleanupReport
+		expectedError  bool
+	}{
+		{
+			name:      "dry run only counts",
+			projectID: projectID,
+			dryRun:    true,
+			setup: func(store *mockdb.MockEvalHistoryStore) {
+				store.EXPECT().CountStaleEvaluationRuleEntities(gomock.Any(), db.CountStaleEvaluationRuleEntitiesParams{
+					Threshold: threshold,
+					ProjectID: uuid.NullUUID{UUID: projectID, Valid: true},
+				}).Return(db.CountStaleEvaluationRuleEntitiesRow{RuleEntities: 3, Evaluations: 42}, nil)
+			},
+			expectedReport: &CleanupReport{RuleEntities: 3, Evaluations: 42, DryRun: true},
+		},
+		{
+			name: "deletes in batches across projects",
+			setup: func(store *mockdb.MockEvalHistoryStore) {
+				params := db.ListStaleEvaluationRuleEntitiesParams{
+					Threshold: threshold,
+					Size:      2,
+				}
+				gomock.InOrder(
+					store.EXPECT().ListStaleEvaluationRuleEntities(gomock.Any(), params).
+						Return([]db.ListStaleEvaluationRuleEntitiesRow{
+							{ID: first, Evaluations: 10},
+							{ID: second, Evaluations: 5},
+						}, nil),
+					store.EXPECT().DeleteEvaluationRuleEntitiesByIDs(gomock.Any(), []uuid.UUID{first, second}).
+						Return(int64(2), nil),
+					store.EXPECT().ListStaleEvaluationRuleEntities(gomock.Any(), params).
+						Return([]db.ListStaleEvaluationRuleEntitiesRow{
+							{ID: third, Evaluations: 1},
+						}, nil),
+					store.EXPECT().DeleteEvaluationRuleEntitiesByIDs(gomock.Any(), []uuid.UUID{third}).
+						Return(int64(1), nil),
+				)
+			},
+			expectedReport: &CleanupReport{RuleEntities: 3, Evaluations: 16},
+		},
+		{
+			name:      "nothing to delete",
+			projectID: projectID,
+			setup: func(store *mockdb.MockEvalHistoryStore) {
+				store.EXPECT().ListStaleEvaluationRuleEntities(gomock.Any(), gomock.Any()).
+					Return([]db.ListStaleEvaluationRuleEntitiesRow{}, nil)
+			},
+			expectedReport: &CleanupReport{},
+		},
+		{
+			name: "delete error",
+			setup: func(store *mockdb.MockEvalHistoryStore) {
+				store.EXPECT().ListStaleEvaluationRuleEntities(gomock.Any(), gomock.Any()).
+					Return([]db.ListStaleEvaluationRuleEntitiesRow{{ID: first}}, nil)
+				store.EXPECT().DeleteEvaluationRuleEntitiesByIDs(gomock.Any(), gomock.Any()).
+					Return(int64(0), errors.New("boom"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockEvalHistoryStore(ctrl)
+			tt.setup(store)
+
+			cleaner := NewCleaner(store, &serverconfig.EvaluationCleanupConfig{BatchSize: 2})
+			report, err := cleaner.Cleanup(context.Background(), tt.projectID, threshold, tt.dryRun)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedReport, report)
+		})
+	}
+}
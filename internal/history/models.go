@@ -41,13 +41,19 @@ var (
 	allowedEntityTypes        = []string{"repository", "build_environment", "artifact", "pull_request"}
 	allowedEvaluationStatuses = []actions.EvalStatus{
 		actions.EvalStatusSuccess, actions.EvalStatusFailure, actions.EvalStatusError,
-		actions.EvalStatusSkipped, actions.EvalStatusPending}
+		actions.EvalStatusSkipped, actions.EvalStatusPending, actions.EvalStatusExcepted,
+		actions.EvalStatusSuppressed}
 	allowedRemediationStatuses = []actions.RemediationStatus{
 		actions.RemediationStatusSuccess, actions.RemediationStatusFailure, actions.RemediationStatusError,
 		actions.RemediationStatusSkipped, actions.RemediationStatusNotAvailable, actions.RemediationStatusPending}
 	allowedAlertStatuses = []actions.AlertStatus{
 		actions.AlertStatusOn, actions.AlertStatusOff, actions.AlertStatusError,
 		actions.AlertStatusSkipped, actions.AlertStatusNotAvailable}
+	allowedErrorCodes = []db.EvalErrorCodes{
+		db.EvalErrorCodesUnknown, db.EvalErrorCodesInternal, db.EvalErrorCodesProviderUnavailable,
+		db.EvalErrorCodesRateLimited, db.EvalErrorCodesPolicyCompileError,
+		db.EvalErrorCodesIngestionNotApplicable, db.EvalErrorCodesIngestionFailed,
+		db.EvalErrorCodesInvalidRuleParameters, db.EvalErrorCodesLimitExceeded}
 )
 
 // Direction enumerates the direction of the Cursor.
@@ -209,6 +215,18 @@ type StatusFilter interface {
 	ExcludedStatuses() []string
 }
 
+// ErrorCodeFilter interface should be implemented by types
+// implementing a filter on evaluation error codes.
+type ErrorCodeFilter interface {
+	// AddErrorCode adds an error code for inclusion/exclusion in
+	// the filter.
+	AddErrorCode(string) error
+	// IncludedErrorCodes returns the list of included error codes.
+	IncludedErrorCodes() []string
+	// ExcludedErrorCodes returns the list of excluded error codes.
+	ExcludedErrorCodes() []string
+}
+
 // RemediationFilter interface should be implemented by types
 // implementing a filter on remediation statuses.
 type RemediationFilter interface {
@@ -248,6 +266,17 @@ type TimeRangeFilter interface {
 	GetTo() *time.Time
 }
 
+// SearchFilter interface should be implemented by types implementing
+// a full-text search over the details of the evaluations and of their
+// remediations.
+type SearchFilter interface {
+	// SetSearch sets the search query, in the web search syntax of
+	// Postgres, e.g. `CVE-2024-1234 -fixed`.
+	SetSearch(string) error
+	// GetSearch retrieves the search query, empty if not set.
+	GetSearch() string
+}
+
 // ListEvaluationFilter is a filter to be used when listing historical
 // evaluations.
 type ListEvaluationFilter interface {
@@ -257,9 +286,11 @@ type ListEvaluationFilter interface {
 	ProfileNameFilter
 	LabelFilter
 	StatusFilter
+	ErrorCodeFilter
 	RemediationFilter
 	AlertFilter
 	TimeRangeFilter
+	SearchFilter
 }
 
 type listEvaluationFilter struct {
@@ -285,6 +316,10 @@ type listEvaluationFilter struct {
 	includedStatuses []string
 	// List of statuses to exclude from the selection
 	excludedStatuses []string
+	// List of error codes to include in the selection
+	includedErrorCodes []string
+	// List of error codes to exclude from the selection
+	excludedErrorCodes []string
 	// List of remediations to include in the selection
 	includedRemediations []string
 	// List of remediations to exclude from the selection
@@ -297,6 +332,8 @@ type listEvaluationFilter struct {
 	from *time.Time
 	// Upper bound of the time range, exclusive
 	to *time.Time
+	// Full-text search query over the details
+	search string
 }
 
 func (filter *listEvaluationFilter) AddProjectID(projectID uuid.UUID) error {
@@ -430,6 +467,32 @@ func (filter *listEvaluationFilter) ExcludedStatuses() []string {
 	return filter.excludedStatuses
 }
 
+func (filter *listEvaluationFilter) AddErrorCode(errorCode string) error {
+	if strings.HasPrefix(errorCode, "!") {
+		errorCode = strings.Split(errorCode, "!")[1] // guaranteed to exist
+		filter.excludedErrorCodes = append(filter.excludedErrorCodes, errorCode)
+	} else {
+		filter.includedErrorCodes = append(filter.includedErrorCodes, errorCode)
+	}
+	if !slices.Contains(allowedErrorCodes, db.EvalErrorCodes(errorCode)) {
+		return fmt.Errorf("%w: error code", ErrInvalidIdentifier)
+	}
+
+	// Prevent filtering for both inclusion and exclusion
+	if len(filter.includedErrorCodes) > 0 &&
+		len(filter.excludedErrorCodes) > 0 {
+		return fmt.Errorf("%w: error code", ErrInclusionExclusion)
+	}
+
+	return nil
+}
+func (filter *listEvaluationFilter) IncludedErrorCodes() []string {
+	return filter.includedErrorCodes
+}
+func (filter *listEvaluationFilter) ExcludedErrorCodes() []string {
+	return filter.excludedErrorCodes
+}
+
 func (filter *listEvaluationFilter) AddRemediation(remediation string) error {
 	if strings.HasPrefix(remediation, "!") {
 		remediation = strings.Split(remediation, "!")[1] // guaranteed to exist
@@ -497,6 +560,14 @@ func (filter *listEvaluationFilter) GetTo() *time.Time {
 	return filter.to
 }
 
+func (filter *listEvaluationFilter) SetSearch(search string) error {
+	filter.search = search
+	return nil
+}
+func (filter *listEvaluationFilter) GetSearch() string {
+	return filter.search
+}
+
 var _ Filter = (*listEvaluationFilter)(nil)
 var _ ListEvaluationFilter = (*listEvaluationFilter)(nil)
 
@@ -608,6 +679,22 @@ func WithStatus(status string) FilterOpt {
 	}
 }
 
+// WithErrorCode adds an error code string to the filter. The error
+// code is added for inclusion unless it starts with a `!` characters,
+// in which case it is added for exclusion.
+func WithErrorCode(errorCode string) FilterOpt {
+	return func(filter Filter) error {
+		if errorCode == "" || errorCode == "!" {
+			return fmt.Errorf("%w: error code", ErrInvalidIdentifier)
+		}
+		inner, ok := filter.(ErrorCodeFilter)
+		if !ok {
+			return fmt.Errorf("%w: wrong filter type", ErrInvalidIdentifier)
+		}
+		return inner.AddErrorCode(errorCode)
+	}
+}
+
 // WithRemediation adds a remediation string to the filter. The
 // remediation is added for inclusion unless it starts with a `!`
 // characters, in which case it is added for exclusion.
@@ -662,6 +749,21 @@ func WithTo(to time.Time) FilterOpt {
 	}
 }
 
+// WithSearch sets the full-text search query over the details of the
+// evaluations and of their remediations.
+func WithSearch(search string) FilterOpt {
+	return func(filter Filter) error {
+		if strings.TrimSpace(search) == "" {
+			return fmt.Errorf("%w: search", ErrInvalidIdentifier)
+		}
+		inner, ok := filter.(SearchFilter)
+		if !ok {
+			return fmt.Errorf("%w: wrong filter type", ErrInvalidIdentifier)
+		}
+		return inner.SetSearch(search)
+	}
+}
+
 // NewListEvaluationFilter is a constructor routine for
 // ListEvaluationFilter objects.
 //
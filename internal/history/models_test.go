@@ -294,6 +294,17 @@ func TestListEvaluationFilter(t *testing.T) {
 			},
 			err: true,
 		},
+		{
+			name: "inclusion exclusion error code",
+			filter: func(t *testing.T) (ListEvaluationFilter, error) {
+				t.Helper()
+				return NewListEvaluationFilter(
+					WithErrorCode("rate_limited"),
+					WithErrorCode("!internal"),
+				)
+			},
+			err: true,
+		},
 		{
 			name: "inclusion exclusion remediation status",
 			filter: func(t *testing.T) (ListEvaluationFilter, error) {
@@ -803,6 +814,80 @@ func TestFilterOptions(t *testing.T) {
 			err: true,
 		},
 
+		// error code
+		{
+			name: "error code in filter",
+			option: func(t *testing.T) FilterOpt {
+				t.Helper()
+				return WithErrorCode("rate_limited")
+			},
+			filter: func(t *testing.T) Filter {
+				t.Helper()
+				return &listEvaluationFilter{}
+			},
+			check: func(t *testing.T, filter Filter) {
+				t.Helper()
+				f := filter.(ErrorCodeFilter)
+				require.NotNil(t, f.IncludedErrorCodes())
+				require.Equal(t, []string{"rate_limited"}, f.IncludedErrorCodes())
+				require.Nil(t, f.ExcludedErrorCodes())
+			},
+		},
+		{
+			name: "error code not in filter",
+			option: func(t *testing.T) FilterOpt {
+				t.Helper()
+				return WithErrorCode("!rate_limited")
+			},
+			filter: func(t *testing.T) Filter {
+				t.Helper()
+				return &listEvaluationFilter{}
+			},
+			check: func(t *testing.T, filter Filter) {
+				t.Helper()
+				f := filter.(ErrorCodeFilter)
+				require.Nil(t, f.IncludedErrorCodes())
+				require.NotNil(t, f.ExcludedErrorCodes())
+				require.Equal(t, []string{"rate_limited"}, f.ExcludedErrorCodes())
+			},
+		},
+		{
+			name: "empty error code",
+			option: func(t *testing.T) FilterOpt {
+				t.Helper()
+				return WithErrorCode("")
+			},
+			filter: func(t *testing.T) Filter {
+				t.Helper()
+				return &listEvaluationFilter{}
+			},
+			err: true,
+		},
+		{
+			name: "bogus error code",
+			option: func(t *testing.T) FilterOpt {
+				t.Helper()
+				return WithErrorCode("foo")
+			},
+			filter: func(t *testing.T) Filter {
+				t.Helper()
+				return &listEvaluationFilter{}
+			},
+			err: true,
+		},
+		{
+			name: "wrong error code filter",
+			option: func(t *testing.T) FilterOpt {
+				t.Helper()
+				return WithErrorCode("rate_limited")
+			},
+			filter: func(t *testing.T) Filter {
+				t.Helper()
+				return foo
+			},
+			err: true,
+		},
+
 		// remediation
 		{
 			name: "remediation in filter",
@@ -1012,6 +1097,47 @@ func TestFilterOptions(t *testing.T) {
 			},
 			err: true,
 		},
+		// search
+		{
+			name: "search in filter",
+			option: func(t *testing.T) FilterOpt {
+				t.Helper()
+				return WithSearch("CVE-2024-1234")
+			},
+			filter: func(t *testing.T) Filter {
+				t.Helper()
+				return &listEvaluationFilter{}
+			},
+			check: func(t *testing.T, filter Filter) {
+				t.Helper()
+				f := filter.(SearchFilter)
+				require.Equal(t, "CVE-2024-1234", f.GetSearch())
+			},
+		},
+		{
+			name: "empty search",
+			option: func(t *testing.T) FilterOpt {
+				t.Helper()
+				return WithSearch("  ")
+			},
+			filter: func(t *testing.T) Filter {
+				t.Helper()
+				return &listEvaluationFilter{}
+			},
+			err: true,
+		},
+		{
+			name: "wrong search filter",
+			option: func(t *testing.T) FilterOpt {
+				t.Helper()
+				return WithSearch("CVE-2024-1234")
+			},
+			filter: func(t *testing.T) Filter {
+				t.Helper()
+				return foo
+			},
+			err: true,
+		},
 	}
 
 	for _, tt := range tests {
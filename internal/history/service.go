@@ -29,6 +29,8 @@ type EvaluationHistoryService interface {
 	// Returns the UUID of the evaluation status, and the UUID of the rule-entity.
 	// If output is non-nil, it is JSON-encoded and persisted in the evaluation_outputs table.
 	// output should be a Go struct suitable for JSON encoding.
+	// retryAttempt is the number of the automatic retry of the evaluation, or
+	// 0 if the evaluation is not a retry.
 	StoreEvaluationStatus(
 		ctx context.Context,
 		qtx db.Querier,
@@ -39,6 +41,7 @@ type EvaluationHistoryService interface {
 		evalError error,
 		marshaledCheckpoint []byte,
 		output any,
+		retryAttempt int32,
 	) (uuid.UUID, error)
 	// ListEvaluationHistory returns a list of evaluations stored
 	// in the history table.
@@ -90,10 +93,12 @@ func (e *evaluationHistoryService) StoreEvaluationStatus(
 	evalError error,
 	marshaledCheckpoint []byte,
 	output any,
+	retryAttempt int32,
 ) (uuid.UUID, error) {
 	var ruleEntityID uuid.UUID
 	status := dbadapter.ErrorAsEvalStatus(evalError)
 	details := dbadapter.ErrorAsEvalDetails(evalError)
+	errorCode := dbadapter.ErrorAsEvalErrorCode(evalError)
 
 	params := paramsFromEntity(ruleID, entityID)
 
@@ -124,7 +129,7 @@ func (e *evaluationHistoryService) StoreEvaluationStatus(
 		ruleEntityID = latestRecord.RuleEntityID
 	}
 
-	evaluationID, err := e.createNewStatus(ctx, qtx, ruleEntityID, profileID, status, details, marshaledCheckpoint)
+	evaluationID, err := e.createNewStatus(ctx, qtx, ruleEntityID, profileID, status, details, errorCode, retryAttempt, marshaledCheckpoint)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("error while creating new evaluation status for rule/entity %s: %w", ruleEntityID, err)
 	}
@@ -152,6 +157,8 @@ func (*evaluationHistoryService) createNewStatus(
 	profileID uuid.UUID,
 	status db.EvalStatusTypes,
 	details string,
+	errorCode db.NullEvalErrorCodes,
+	retryAttempt int32,
 	marshaledCheckpoint []byte,
 ) (uuid.UUID, error) {
 	newEvaluationID, err := qtx.InsertEvaluationStatus(ctx,
@@ -159,6 +166,8 @@ func (*evaluationHistoryService) createNewStatus(
 			RuleEntityID: ruleEntityID,
 			Status:       status,
 			Details:      details,
+			ErrorCode:    errorCode,
+			RetryAttempt: retryAttempt,
 			Checkpoint:   marshaledCheckpoint,
 		},
 	)
@@ -330,9 +339,28 @@ func toSQLFilter(
 	if err := paramsFromStatusFilter(filter, params); err != nil {
 		return err
 	}
+	if err := paramsFromErrorCodeFilter(filter, params); err != nil {
+		return err
+	}
+	if err := paramsFromSearchFilter(filter, params); err != nil {
+		return err
+	}
 	return paramsFromTimeRangeFilter(filter, params)
 }
 
+func paramsFromSearchFilter(
+	filter SearchFilter,
+	params *db.ListEvaluationHistoryParams,
+) error {
+	if filter.GetSearch() != "" {
+		params.Search = sql.NullString{
+			String: filter.GetSearch(),
+			Valid:  true,
+		}
+	}
+	return nil
+}
+
 func paramsFromProjectFilter(
 	filter ProjectFilter,
 	params *db.ListEvaluationHistoryParams,
@@ -488,6 +516,33 @@ func paramsFromStatusFilter(
 	return nil
 }
 
+func paramsFromErrorCodeFilter(
+	filter ErrorCodeFilter,
+	params *db.ListEvaluationHistoryParams,
+) error {
+	if len(filter.IncludedErrorCodes()) != 0 {
+		errorCodes, err := convert(
+			filter.IncludedErrorCodes(),
+			mapEvalErrorCodes,
+		)
+		if err != nil {
+			return err
+		}
+		params.Errorcodes = errorCodes
+	}
+	if len(filter.ExcludedErrorCodes()) != 0 {
+		errorCodes, err := convert(
+			filter.ExcludedErrorCodes(),
+			mapEvalErrorCodes,
+		)
+		if err != nil {
+			return err
+		}
+		params.Noterrorcodes = errorCodes
+	}
+	return nil
+}
+
 func paramsFromTimeRangeFilter(
 	filter TimeRangeFilter,
 	params *db.ListEvaluationHistoryParams,
@@ -511,7 +566,8 @@ func convert[
 	T db.Entities |
 		db.RemediationStatusTypes |
 		db.AlertStatusTypes |
-		db.EvalStatusTypes,
+		db.EvalStatusTypes |
+		db.EvalErrorCodes,
 ](
 	values []string,
 	mapf func(string) (T, error),
@@ -602,8 +658,22 @@ func mapEvalStatusTypes(
 		return db.EvalStatusTypesSkipped, nil
 	case "pending":
 		return db.EvalStatusTypesPending, nil
+	case "excepted":
+		return db.EvalStatusTypesExcepted, nil
+	case "suppressed":
+		return db.EvalStatusTypesSuppressed, nil
 	default:
 		return db.EvalStatusTypes("invalid"),
 			fmt.Errorf("invalid evaluation status: %s", value)
 	}
 }
+
+func mapEvalErrorCodes(
+	value string,
+) (db.EvalErrorCodes, error) {
+	if !slices.Contains(allowedErrorCodes, db.EvalErrorCodes(value)) {
+		return db.EvalErrorCodes("invalid"),
+			fmt.Errorf("invalid evaluation error code: %s", value)
+	}
+	return db.EvalErrorCodes(value), nil
+}
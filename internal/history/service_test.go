@@ -110,7 +110,7 @@ func TestStoreEvaluationStatus(t *testing.T) {
 			// provider manager is not used by this function
 			service := NewEvaluationHistoryService(nil)
 			id, err := service.StoreEvaluationStatus(
-				ctx, store, ruleID, profileID, scenario.EntityType, entityID, errTest, []byte("{}"), nil)
+				ctx, store, ruleID, profileID, scenario.EntityType, entityID, errTest, []byte("{}"), nil, 0)
 			if scenario.ExpectedError == "" {
 				require.Equal(t, evaluationID, id)
 				require.NoError(t, err)
@@ -490,6 +490,23 @@ func TestListEvaluationHistory(t *testing.T) {
 			},
 		},
 
+		// full-text search
+		{
+			name: "search",
+			dbSetup: dbf.NewDBMock(
+				withListEvaluationHistory(
+					&db.ListEvaluationHistoryParams{
+						Size:   0,
+						Search: sql.NullString{String: "CVE-2024-1234", Valid: true},
+					},
+					nil,
+				),
+			),
+			filter: &listEvaluationFilter{
+				search: "CVE-2024-1234",
+			},
+		},
+
 		// filter remediations
 		{
 			name: "included remediations",
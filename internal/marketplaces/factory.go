@@ -4,10 +4,15 @@
 package marketplaces
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 
+	"github.com/rs/zerolog"
+
 	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
 	sub "github.com/mindersec/minder/internal/marketplaces/subscriptions"
 	"github.com/mindersec/minder/pkg/config/server"
@@ -23,6 +28,7 @@ import (
 // implementation of Marketplace. Otherwise, it loads the bundle specified in
 // the service config and builds a single-source Marketplace for it.
 func NewMarketplaceFromServiceConfig(
+	ctx context.Context,
 	config server.MarketplaceConfig,
 	profile profiles.ProfileService,
 	ruleType ruletypes.RuleTypeService,
@@ -39,7 +45,20 @@ func NewMarketplaceFromServiceConfig(
 		return nil, errors.New("no sources defined in marketplace config")
 	}
 
+	var checker *SignatureChecker
+	if config.Signatures.Enabled() {
+		var err error
+		checker, err = NewSignatureChecker(&config.Signatures)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create bundle signature checker: %w", err)
+		}
+	}
+
 	newSources := make([]src.BundleSource, len(cfgSources))
+	var signatures map[mindpak.BundleID]*SignatureVerification
+	if checker != nil {
+		signatures = make(map[mindpak.BundleID]*SignatureVerification)
+	}
 	for i, cfgSource := range cfgSources {
 		// This is just used for validation
 		// TODO: support other sources
@@ -47,25 +66,62 @@ func NewMarketplaceFromServiceConfig(
 			return nil, fmt.Errorf("unexpected source type: %s", cfgSource.Type)
 		}
 
+		// The archive is read once, so that the signature is verified
+		// against the bytes the bundle is loaded from
 		tarPath := filepath.Clean(cfgSource.Location)
-		source, err := src.NewSourceFromTarGZ(tarPath)
+		archive, err := os.ReadFile(tarPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read tar from path %s: %w", tarPath, err)
+		}
+		source, err := src.NewSourceFromTarGZReader(bytes.NewReader(archive))
 		if err != nil {
 			return nil, fmt.Errorf("unable to load tar from path %s: %w", tarPath, err)
 		}
 
 		newSources[i] = source
+
+		if checker != nil {
+			verification := checker.Verify(archive, cfgSource.GetSignatureLocation())
+			if !verification.Verified {
+				zerolog.Ctx(ctx).Warn().Str("bundle", tarPath).Str("reason", verification.Error).
+					Msg("bundle signature could not be verified")
+			}
+			bundles, err := source.ListBundles()
+			if err != nil {
+				return nil, fmt.Errorf("error while listing bundles: %w", err)
+			}
+			for _, id := range bundles {
+				signatures[id] = verification
+			}
+		}
 	}
 
 	subscription := sub.NewSubscriptionService(profile, ruleType, dataSource)
-	marketplace, err := NewMarketplace(newSources, subscription)
+	marketplace, err := NewMarketplace(newSources, subscription,
+		WithSignatures(signatures, config.Signatures.Required))
 	if err != nil {
 		return nil, fmt.Errorf("error while creating marketplace: %w", err)
 	}
 	return marketplace, nil
 }
 
+// Option is a functional option for NewMarketplace
+type Option func(*marketplace)
+
+// WithSignatures sets the verification of the signature of each bundle. If
+// the signatures are required, the projects can only subscribe to the bundles
+// with a verified signature.
+func WithSignatures(signatures map[mindpak.BundleID]*SignatureVerification, required bool) Option {
+	return func(m *marketplace) {
+		m.signatures = signatures
+		m.requireSignatures = required
+	}
+}
+
 // NewMarketplace creates an instance of Marketplace with a single source
-func NewMarketplace(sources []src.BundleSource, subscriptions sub.SubscriptionService) (Marketplace, error) {
+func NewMarketplace(
+	sources []src.BundleSource, subscriptions sub.SubscriptionService, opts ...Option,
+) (Marketplace, error) {
 	sourceMapping := make(map[mindpak.BundleID]src.BundleSource)
 	for _, source := range sources {
 		bundles, err := source.ListBundles()
@@ -76,10 +132,14 @@ func NewMarketplace(sources []src.BundleSource, subscriptions sub.SubscriptionSe
 			sourceMapping[id] = source
 		}
 	}
-	return &marketplace{
+	m := &marketplace{
 		sources:       sourceMapping,
 		subscriptions: subscriptions,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m, nil
 }
 
 // NewNoopMarketplace returns an instance of Marketplace which does nothing
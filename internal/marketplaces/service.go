@@ -7,9 +7,11 @@ package marketplaces
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 
 	"github.com/mindersec/minder/internal/db"
 	sub "github.com/mindersec/minder/internal/marketplaces/subscriptions"
@@ -47,6 +49,10 @@ type marketplace struct {
 	// dynamically by customers.
 	sources       map[mindpak.BundleID]sources.BundleSource
 	subscriptions sub.SubscriptionService
+	// signatures holds the verification of the signature of each bundle, and
+	// is nil if the signatures are not verified
+	signatures        map[mindpak.BundleID]*SignatureVerification
+	requireSignatures bool
 }
 
 func (s *marketplace) Subscribe(
@@ -59,10 +65,13 @@ func (s *marketplace) Subscribe(
 	if err != nil {
 		return err
 	}
+	if err := s.checkSignature(bundleID); err != nil {
+		return err
+	}
 	if err = s.subscriptions.Subscribe(ctx, projectID, bundle, qtx); err != nil {
 		return fmt.Errorf("error while creating subscription: %w", err)
 	}
-	return nil
+	return s.recordSignature(ctx, bundleID, qtx)
 }
 
 func (s *marketplace) AddProfile(
@@ -76,11 +85,52 @@ func (s *marketplace) AddProfile(
 	if err != nil {
 		return err
 	}
+	if err := s.checkSignature(bundleID); err != nil {
+		return err
+	}
 
 	if err = s.subscriptions.CreateProfile(ctx, projectID, bundle, profileName, qtx); err != nil {
 		return fmt.Errorf("error while creating profile in project: %w", err)
 	}
 
+	return s.recordSignature(ctx, bundleID, qtx)
+}
+
+// checkSignature rejects the bundles which are not signed by a trusted
+// identity, if signatures are required
+func (s *marketplace) checkSignature(bundleID mindpak.BundleID) error {
+	if !s.requireSignatures {
+		return nil
+	}
+	verification := s.signatures[bundleID]
+	if verification == nil {
+		return fmt.Errorf("%w: %s", ErrBundleNotSigned, bundleID)
+	}
+	if !verification.Verified {
+		return fmt.Errorf("%w: %s: %s", ErrBundleNotSigned, bundleID, verification.Error)
+	}
+	return nil
+}
+
+// recordSignature records the signature verification in the bundle metadata
+func (s *marketplace) recordSignature(ctx context.Context, bundleID mindpak.BundleID, qtx db.Querier) error {
+	verification, ok := s.signatures[bundleID]
+	if !ok {
+		return nil
+	}
+
+	serialized, err := json.Marshal(verification)
+	if err != nil {
+		return fmt.Errorf("error while serializing signature verification: %w", err)
+	}
+	err = qtx.SetBundleSignatureVerification(ctx, db.SetBundleSignatureVerificationParams{
+		SignatureVerification: pqtype.NullRawMessage{RawMessage: serialized, Valid: true},
+		Namespace:             bundleID.Namespace,
+		Name:                  bundleID.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("error while recording signature verification: %w", err)
+	}
 	return nil
 }
 
@@ -5,12 +5,14 @@ package marketplaces_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/mindersec/minder/internal/db"
 	dbf "github.com/mindersec/minder/internal/db/fixtures"
 	"github.com/mindersec/minder/internal/marketplaces"
 	mockbundle "github.com/mindersec/minder/internal/marketplaces/bundles/mock"
@@ -73,6 +75,85 @@ func TestMarketplace_AddProfile(t *testing.T) {
 	})
 }
 
+func TestMarketplace_Signatures(t *testing.T) {
+	t.Parallel()
+
+	verified := &marketplaces.SignatureVerification{Verified: true, Subject: "release@example.com"}
+	unverified := &marketplaces.SignatureVerification{Error: "unable to load signature"}
+
+	tests := []struct {
+		name          string
+		signatures    map[mindpak.BundleID]*marketplaces.SignatureVerification
+		required      bool
+		subscribes    bool
+		records       bool
+		expectedError error
+	}{
+		{
+			name:       "verified signature is recorded",
+			signatures: map[mindpak.BundleID]*marketplaces.SignatureVerification{bundleID: verified},
+			required:   true,
+			subscribes: true,
+			records:    true,
+		},
+		{
+			name:          "required signature rejects unverified bundle",
+			signatures:    map[mindpak.BundleID]*marketplaces.SignatureVerification{bundleID: unverified},
+			required:      true,
+			expectedError: marketplaces.ErrBundleNotSigned,
+		},
+		{
+			name:          "required signature rejects unknown bundle",
+			signatures:    map[mindpak.BundleID]*marketplaces.SignatureVerification{},
+			required:      true,
+			expectedError: marketplaces.ErrBundleNotSigned,
+		},
+		{
+			name:       "optional signature records unverified bundle",
+			signatures: map[mindpak.BundleID]*marketplaces.SignatureVerification{bundleID: unverified},
+			subscribes: true,
+			records:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			source := bsf.NewBundleSourceMock(bsf.WithSuccessfulGetBundle(bundleReader))(ctrl)
+			bsf.WithListBundles(bundleID)(source)
+			var subSvc subscriptions.SubscriptionService
+			if tt.subscribes {
+				subSvc = ssf.NewSubscriptionServiceMock(ssf.WithSuccessfulSubscribe)(ctrl)
+			}
+			store := dbf.NewDBMock()(ctrl)
+			if tt.records {
+				store.EXPECT().SetBundleSignatureVerification(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.SetBundleSignatureVerificationParams) error {
+						require.Equal(t, bundleID.Namespace, arg.Namespace)
+						require.Equal(t, bundleID.Name, arg.Name)
+						var got marketplaces.SignatureVerification
+						require.NoError(t, json.Unmarshal(arg.SignatureVerification.RawMessage, &got))
+						require.Equal(t, *tt.signatures[bundleID], got)
+						return nil
+					})
+			}
+
+			marketplace, err := marketplaces.NewMarketplace([]sources.BundleSource{source}, subSvc,
+				marketplaces.WithSignatures(tt.signatures, tt.required))
+			require.NoError(t, err)
+
+			err = marketplace.Subscribe(context.Background(), projectID, bundleID, store)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func testHarness(t *testing.T, method testMethod, scenarios []testScenario) {
 	t.Helper()
 	for _, scenario := range scenarios {
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package marketplaces
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+
+	"github.com/mindersec/minder/internal/verifier/sigstore"
+	"github.com/mindersec/minder/pkg/config/server"
+)
+
+// ErrBundleNotSigned is returned when signatures are required and a bundle
+// is not signed by any of the trusted identities
+var ErrBundleNotSigned = errors.New("bundle is not signed by a trusted identity")
+
+// SignatureVerification is the result of the verification of the signature
+// of a bundle. It is recorded in the bundle metadata.
+type SignatureVerification struct {
+	// Verified is true if the bundle is signed by one of the trusted identities
+	Verified bool `json:"verified"`
+	// Issuer is the OIDC issuer of the signer
+	Issuer string `json:"issuer,omitempty"`
+	// Subject is the subject of the signer
+	Subject string `json:"subject,omitempty"`
+	// Error is the reason why the signature could not be verified
+	Error string `json:"error,omitempty"`
+	// VerifiedAt is the time of the verification
+	VerifiedAt time.Time `json:"verified_at"`
+}
+
+// BlobVerifier verifies the sigstore signature of a blob
+type BlobVerifier interface {
+	VerifyBlob(blob io.Reader, sigBundle *bundle.Bundle,
+		identities []verify.CertificateIdentity) (*verify.VerificationResult, error)
+}
+
+// SignatureChecker verifies the signatures of the bundle archives against
+// the trusted identities
+type SignatureChecker struct {
+	verifier   BlobVerifier
+	identities []verify.CertificateIdentity
+	now        func() time.Time
+}
+
+// NewSignatureChecker creates a SignatureChecker from the marketplace
+// configuration, using the sigstore trusted root of the configured TUF
+// repository.
+func NewSignatureChecker(cfg *server.BundleSignaturesConfig) (*SignatureChecker, error) {
+	verifier, err := sigstore.New(cfg.TUFRepo)
+	if err != nil {
+		return nil, fmt.Errorf("error creating sigstore verifier: %w", err)
+	}
+	return NewSignatureCheckerWithVerifier(cfg, verifier)
+}
+
+// NewSignatureCheckerWithVerifier creates a SignatureChecker using the
+// given verifier
+func NewSignatureCheckerWithVerifier(
+	cfg *server.BundleSignaturesConfig, verifier BlobVerifier,
+) (*SignatureChecker, error) {
+	identities := make([]verify.CertificateIdentity, 0, len(cfg.Identities))
+	for _, id := range cfg.Identities {
+		certID, err := verify.NewShortCertificateIdentity(id.Issuer, id.IssuerRegexp, id.Subject, id.SubjectRegexp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer identity: %w", err)
+		}
+		identities = append(identities, certID)
+	}
+
+	return &SignatureChecker{
+		verifier:   verifier,
+		identities: identities,
+		now:        time.Now,
+	}, nil
+}
+
+// Verify verifies the signature of the content of a bundle archive, read
+// from the signature file. The content must be the exact bytes which are
+// loaded afterwards, so that the archive can't change in between. Failures
+// are reported in the verification result rather than as errors, so that
+// they can be recorded.
+func (c *SignatureChecker) Verify(content []byte, signaturePath string) *SignatureVerification {
+	sigBundle, err := bundle.LoadJSONFromPath(filepath.Clean(signaturePath))
+	if err != nil {
+		return &SignatureVerification{
+			Error:      fmt.Sprintf("unable to load signature from %s: %v", signaturePath, err),
+			VerifiedAt: c.now(),
+		}
+	}
+	return c.verify(content, sigBundle)
+}
+
+// VerifyJSON verifies the signature of some content, given as a sigstore
+// bundle in JSON, such as the one written by cosign sign-blob --bundle.
+func (c *SignatureChecker) VerifyJSON(content []byte, sigBundleJSON []byte) *SignatureVerification {
+	var sigBundle bundle.Bundle
+	if err := sigBundle.UnmarshalJSON(sigBundleJSON); err != nil {
+		return &SignatureVerification{
+			Error:      fmt.Sprintf("invalid signature: %v", err),
+			VerifiedAt: c.now(),
+		}
+	}
+	return c.verify(content, &sigBundle)
+}
+
+func (c *SignatureChecker) verify(content []byte, sigBundle *bundle.Bundle) *SignatureVerification {
+	result := &SignatureVerification{VerifiedAt: c.now()}
+
+	res, err := c.verifier.VerifyBlob(bytes.NewReader(content), sigBundle, c.identities)
+	if err != nil {
+		result.Error = fmt.Sprintf("signature verification failed: %v", err)
+		return result
+	}
+
+	result.Verified = true
+	if res.Signature != nil && res.Signature.Certificate != nil {
+		result.Issuer = res.Signature.Certificate.Issuer
+		result.Subject = res.Signature.Certificate.SubjectAlternativeName
+	}
+	return result
+}
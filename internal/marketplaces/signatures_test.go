@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package marketplaces
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/fulcio/certificate"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/config/server"
+)
+
+// sigBundle is a structurally valid sigstore bundle, the fake verifier
+// doesn't check the signature
+const sigBundle = `{
+  "mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json",
+  "verificationMaterial": {"publicKey": {"hint": "test"}},
+  "messageSignature": {
+    "messageDigest": {"algorithm": "SHA2_256", "digest": "AAAA"},
+    "signature": "AAAA"
+  }
+}`
+
+type fakeVerifier struct {
+	content    string
+	identities int
+	err        error
+}
+
+func (f *fakeVerifier) VerifyBlob(
+	blob io.Reader, _ *bundle.Bundle, identities []verify.CertificateIdentity,
+) (*verify.VerificationResult, error) {
+	content, err := io.ReadAll(blob)
+	if err != nil {
+		return nil, err
+	}
+	f.content = string(content)
+	f.identities = len(identities)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &verify.VerificationResult{
+		Signature: &verify.SignatureVerificationResult{
+			Certificate: &certificate.Summary{
+				SubjectAlternativeName: "https://github.com/mindersec/minder-rules-and-profiles/.github/workflows/release.yml@refs/heads/main",
+				Extensions: certificate.Extensions{
+					Issuer: "https://token.actions.githubusercontent.com",
+				},
+			},
+		},
+	}, nil
+}
+
+var signersConfig = &server.BundleSignaturesConfig{
+	Required: true,
+	Identities: []server.SignerIdentityConfig{{
+		Issuer:        "https://token.actions.githubusercontent.com",
+		SubjectRegexp: "^https://github.com/mindersec/",
+	}},
+}
+
+func writeSignatureFile(t *testing.T, withSignature bool) string {
+	t.Helper()
+
+	signature := filepath.Join(t.TempDir(), "bundle.tar.gz.sigstore.json")
+	if withSignature {
+		require.NoError(t, os.WriteFile(signature, []byte(sigBundle), 0o600))
+	}
+	return signature
+}
+
+func TestSignatureCheckerVerify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		withSignature bool
+		verifyErr     error
+		want          *SignatureVerification
+		wantErr       string
+	}{
+		{
+			name:          "verified",
+			withSignature: true,
+			want: &SignatureVerification{
+				Verified:   true,
+				Issuer:     "https://token.actions.githubusercontent.com",
+				Subject:    "https://github.com/mindersec/minder-rules-and-profiles/.github/workflows/release.yml@refs/heads/main",
+				VerifiedAt: now,
+			},
+		},
+		{
+			name:          "untrusted signer",
+			withSignature: true,
+			verifyErr:     errors.New("no matching certificate identity"),
+			wantErr:       "signature verification failed: no matching certificate identity",
+		},
+		{
+			name:    "missing signature",
+			wantErr: "unable to load signature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			verifier := &fakeVerifier{err: tt.verifyErr}
+			checker, err := NewSignatureCheckerWithVerifier(signersConfig, verifier)
+			require.NoError(t, err)
+			checker.now = func() time.Time { return now }
+
+			signature := writeSignatureFile(t, tt.withSignature)
+			got := checker.Verify([]byte("archive"), signature)
+			if tt.wantErr != "" {
+				require.False(t, got.Verified)
+				require.Contains(t, got.Error, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+			require.Equal(t, "archive", verifier.content)
+			require.Equal(t, 1, verifier.identities)
+		})
+	}
+}
+
+func TestSignatureCheckerVerifyJSON(t *testing.T) {
+	t.Parallel()
+
+	verifier := &fakeVerifier{}
+	checker, err := NewSignatureCheckerWithVerifier(signersConfig, verifier)
+	require.NoError(t, err)
+
+	got := checker.VerifyJSON([]byte("rule type"), []byte(sigBundle))
+	require.True(t, got.Verified, got.Error)
+	require.Equal(t, "rule type", verifier.content)
+
+	got = checker.VerifyJSON([]byte("rule type"), []byte("not a bundle"))
+	require.False(t, got.Verified)
+	require.Contains(t, got.Error, "invalid signature")
+}
+
+func TestNewSignatureCheckerInvalidIdentity(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSignatureCheckerWithVerifier(&server.BundleSignaturesConfig{
+		Identities: []server.SignerIdentityConfig{{Subject: "someone@example.com"}},
+	}, &fakeVerifier{})
+	require.Error(t, err)
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"github.com/ThreeDotsLabs/watermill/message"
+
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+// monitoredEventer registers every handler with a middleware accounting the
+// messages being handled for each topic
+type monitoredEventer struct {
+	interfaces.Interface
+	monitor *Monitor
+}
+
+// WrapEventer returns an eventer reporting the depth of the queues of its
+// handlers to the monitor
+func WrapEventer(evt interfaces.Interface, monitor *Monitor) interfaces.Interface {
+	return &monitoredEventer{
+		Interface: evt,
+		monitor:   monitor,
+	}
+}
+
+// Register implements interfaces.Registrar
+func (m *monitoredEventer) Register(topic string, handler interfaces.Handler, mdw ...message.HandlerMiddleware) {
+	mdw = append(mdw, m.middleware(topic))
+	m.Interface.Register(topic, handler, mdw...)
+}
+
+// ConsumeEvents implements interfaces.Service, registering the consumers
+// with the wrapper rather than with the wrapped eventer
+func (m *monitoredEventer) ConsumeEvents(consumers ...interfaces.Consumer) {
+	for _, c := range consumers {
+		c.Register(m)
+	}
+}
+
+func (m *monitoredEventer) middleware(topic string) message.HandlerMiddleware {
+	return func(h message.HandlerFunc) message.HandlerFunc {
+		return func(msg *message.Message) ([]*message.Message, error) {
+			m.monitor.startHandling(topic)
+			defer m.monitor.doneHandling(topic)
+			return h(msg)
+		}
+	}
+}
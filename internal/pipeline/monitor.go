@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pipeline tracks the work in progress in the evaluation pipeline,
+// so that operators can diagnose slowdowns of a running server without
+// attaching a debugger.
+package pipeline
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Stage is a stage of the evaluation of an entity
+type Stage string
+
+const (
+	// StageLoad loads the provider, the rule types and the profiles
+	// evaluated against the entity
+	StageLoad Stage = "load"
+	// StageEval ingests the entity data and evaluates a rule
+	StageEval Stage = "eval"
+	// StageActions runs the remediations and alerts of a rule
+	StageActions Stage = "actions"
+	// StageStatus stores the evaluation status of a rule
+	StageStatus Stage = "status"
+)
+
+// stages lists the stages in the order they run, which is also the order
+// of the report
+var stages = []Stage{StageLoad, StageEval, StageActions, StageStatus}
+
+// Evaluation is the evaluation of an entity in progress
+type Evaluation struct {
+	ExecutionID uuid.UUID `json:"execution_id"`
+	ProjectID   uuid.UUID `json:"project_id"`
+	EntityType  string    `json:"entity_type"`
+	EntityID    uuid.UUID `json:"entity_id"`
+	Started     time.Time `json:"started"`
+	Elapsed     string    `json:"elapsed"`
+	Stage       Stage     `json:"stage"`
+	// StageElapsed is the time spent in the current stage so far
+	StageElapsed string `json:"stage_elapsed"`
+}
+
+// StageStats are the durations of a stage since the server started
+type StageStats struct {
+	Stage   Stage  `json:"stage"`
+	Count   int64  `json:"count"`
+	Total   string `json:"total"`
+	Average string `json:"average"`
+	Max     string `json:"max"`
+	Last    string `json:"last"`
+}
+
+// QueueDepth is the number of messages of a topic being handled
+type QueueDepth struct {
+	Topic string `json:"topic"`
+	// InFlight is the number of messages of the topic currently handled
+	InFlight int64 `json:"in_flight"`
+	// Handled is the number of messages of the topic handled since the
+	// server started
+	Handled int64 `json:"handled"`
+}
+
+// Report is a snapshot of the evaluation pipeline
+type Report struct {
+	Time        time.Time    `json:"time"`
+	Evaluations []Evaluation `json:"evaluations"`
+	Stages      []StageStats `json:"stages"`
+	Queues      []QueueDepth `json:"queues"`
+}
+
+type evaluation struct {
+	info         Evaluation
+	stageStarted time.Time
+}
+
+type stageDurations struct {
+	count int64
+	total time.Duration
+	max   time.Duration
+	last  time.Duration
+}
+
+type queue struct {
+	inFlight int64
+	handled  int64
+}
+
+// Monitor tracks the in-flight evaluations, the durations of their stages
+// and the depth of the event queues. The state is kept in memory, so each
+// server instance reports on the work it performs itself.
+type Monitor struct {
+	mu          sync.Mutex
+	evaluations map[uuid.UUID]*evaluation
+	stages      map[Stage]*stageDurations
+	queues      map[string]*queue
+	now         func() time.Time
+}
+
+// NewMonitor creates a new pipeline monitor
+func NewMonitor() *Monitor {
+	return &Monitor{
+		evaluations: make(map[uuid.UUID]*evaluation),
+		stages:      make(map[Stage]*stageDurations),
+		queues:      make(map[string]*queue),
+		now:         time.Now,
+	}
+}
+
+// EvaluationTracker follows an evaluation through its stages. A nil tracker
+// does nothing, so that callers don't need to check whether the monitor is
+// enabled.
+type EvaluationTracker struct {
+	m           *Monitor
+	executionID uuid.UUID
+}
+
+// StartEvaluation records the start of the evaluation of an entity, in the
+// load stage. Done must be called on the returned tracker once the
+// evaluation is over.
+func (m *Monitor) StartEvaluation(
+	executionID, projectID uuid.UUID,
+	entityType string,
+	entityID uuid.UUID,
+) *EvaluationTracker {
+	if m == nil {
+		return nil
+	}
+
+	now := m.now()
+	m.mu.Lock()
+	m.evaluations[executionID] = &evaluation{
+		info: Evaluation{
+			ExecutionID: executionID,
+			ProjectID:   projectID,
+			EntityType:  entityType,
+			EntityID:    entityID,
+			Started:     now,
+			Stage:       StageLoad,
+		},
+		stageStarted: now,
+	}
+	m.mu.Unlock()
+
+	return &EvaluationTracker{m: m, executionID: executionID}
+}
+
+// Stage moves the evaluation to the given stage, recording the duration of
+// the stage it leaves
+func (t *EvaluationTracker) Stage(stage Stage) {
+	if t == nil {
+		return
+	}
+	t.m.transition(t.executionID, stage, false)
+}
+
+// Done records the end of the evaluation and of its current stage
+func (t *EvaluationTracker) Done() {
+	if t == nil {
+		return
+	}
+	t.m.transition(t.executionID, "", true)
+}
+
+func (m *Monitor) transition(executionID uuid.UUID, stage Stage, done bool) {
+	now := m.now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.evaluations[executionID]
+	if !ok {
+		return
+	}
+	m.recordStage(e.info.Stage, now.Sub(e.stageStarted))
+
+	if done {
+		delete(m.evaluations, executionID)
+		return
+	}
+	e.info.Stage = stage
+	e.stageStarted = now
+}
+
+// recordStage accounts a duration of the stage.
+// Must be called with the lock held.
+func (m *Monitor) recordStage(stage Stage, d time.Duration) {
+	s, ok := m.stages[stage]
+	if !ok {
+		s = &stageDurations{}
+		m.stages[stage] = s
+	}
+	s.count++
+	s.total += d
+	s.last = d
+	if d > s.max {
+		s.max = d
+	}
+}
+
+// startHandling records a message of the topic being handled
+func (m *Monitor) startHandling(topic string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	q, ok := m.queues[topic]
+	if !ok {
+		q = &queue{}
+		m.queues[topic] = q
+	}
+	q.inFlight++
+}
+
+// doneHandling records the end of the handling of a message of the topic
+func (m *Monitor) doneHandling(topic string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	q := m.queues[topic]
+	q.inFlight--
+	q.handled++
+}
+
+// Report returns a snapshot of the pipeline, with the longest running
+// evaluations first
+func (m *Monitor) Report() Report {
+	now := m.now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	report := Report{
+		Time:        now,
+		Evaluations: make([]Evaluation, 0, len(m.evaluations)),
+		Stages:      make([]StageStats, 0, len(m.stages)),
+		Queues:      make([]QueueDepth, 0, len(m.queues)),
+	}
+
+	for _, e := range m.evaluations {
+		info := e.info
+		info.Elapsed = now.Sub(info.Started).String()
+		info.StageElapsed = now.Sub(e.stageStarted).String()
+		report.Evaluations = append(report.Evaluations, info)
+	}
+	sort.Slice(report.Evaluations, func(i, j int) bool {
+		return report.Evaluations[i].Started.Before(report.Evaluations[j].Started)
+	})
+
+	for _, stage := range stages {
+		s, ok := m.stages[stage]
+		if !ok {
+			continue
+		}
+		report.Stages = append(report.Stages, StageStats{
+			Stage:   stage,
+			Count:   s.count,
+			Total:   s.total.String(),
+			Average: (s.total / time.Duration(s.count)).String(),
+			Max:     s.max.String(),
+			Last:    s.last.String(),
+		})
+	}
+
+	for topic, q := range m.queues {
+		report.Queues = append(report.Queues, QueueDepth{
+			Topic:    topic,
+			InFlight: q.inFlight,
+			Handled:  q.handled,
+		})
+	}
+	sort.Slice(report.Queues, func(i, j int) bool {
+		return report.Queues[i].Topic < report.Queues[j].Topic
+	})
+
+	return report
+}
+
+// Handler returns an HTTP handler serving the pipeline report as JSON
+func (m *Monitor) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(m.Report()); err != nil {
+			http.Error(w, "error encoding pipeline report", http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+func newTestMonitor() (*Monitor, *time.Time) {
+	m := NewMonitor()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.now = func() time.Time { return now }
+	return m, &now
+}
+
+func TestMonitorEvaluations(t *testing.T) {
+	t.Parallel()
+
+	m, now := newTestMonitor()
+
+	first, second := uuid.New(), uuid.New()
+	projectID, entityID := uuid.New(), uuid.New()
+
+	firstTracker := m.StartEvaluation(first, projectID, "repository", entityID)
+	*now = now.Add(time.Second)
+	secondTracker := m.StartEvaluation(second, projectID, "artifact", entityID)
+
+	*now = now.Add(2 * time.Second)
+	firstTracker.Stage(StageEval)
+	*now = now.Add(4 * time.Second)
+
+	report := m.Report()
+	require.Len(t, report.Evaluations, 2)
+	require.Equal(t, first, report.Evaluations[0].ExecutionID)
+	require.Equal(t, StageEval, report.Evaluations[0].Stage)
+	require.Equal(t, "7s", report.Evaluations[0].Elapsed)
+	require.Equal(t, "4s", report.Evaluations[0].StageElapsed)
+	require.Equal(t, second, report.Evaluations[1].ExecutionID)
+	require.Equal(t, StageLoad, report.Evaluations[1].Stage)
+
+	firstTracker.Stage(StageActions)
+	*now = now.Add(time.Second)
+	firstTracker.Done()
+	secondTracker.Done()
+
+	report = m.Report()
+	require.Empty(t, report.Evaluations)
+	require.Equal(t, []StageStats{
+		{Stage: StageLoad, Count: 2, Total: "10s", Average: "5s", Max: "7s", Last: "7s"},
+		{Stage: StageEval, Count: 1, Total: "4s", Average: "4s", Max: "4s", Last: "4s"},
+		{Stage: StageActions, Count: 1, Total: "1s", Average: "1s", Max: "1s", Last: "1s"},
+	}, report.Stages)
+}
+
+func TestNilMonitor(t *testing.T) {
+	t.Parallel()
+
+	var m *Monitor
+	tracker := m.StartEvaluation(uuid.New(), uuid.New(), "repository", uuid.New())
+	require.Nil(t, tracker)
+	require.NotPanics(t, func() {
+		tracker.Stage(StageEval)
+		tracker.Done()
+	})
+}
+
+type recordingRegistrar struct {
+	interfaces.Interface
+	handlers map[string]message.HandlerFunc
+}
+
+func (r *recordingRegistrar) Register(topic string, handler interfaces.Handler, mdw ...message.HandlerMiddleware) {
+	h := func(msg *message.Message) ([]*message.Message, error) {
+		return nil, handler(msg)
+	}
+	for i := len(mdw) - 1; i >= 0; i-- {
+		h = mdw[i](h)
+	}
+	r.handlers[topic] = h
+}
+
+type consumerFunc func(interfaces.Registrar)
+
+func (f consumerFunc) Register(r interfaces.Registrar) {
+	f(r)
+}
+
+func TestWrapEventer(t *testing.T) {
+	t.Parallel()
+
+	m, _ := newTestMonitor()
+	rec := &recordingRegistrar{handlers: make(map[string]message.HandlerFunc)}
+	evt := WrapEventer(rec, m)
+
+	var during Report
+	evt.ConsumeEvents(consumerFunc(func(r interfaces.Registrar) {
+		r.Register("execute.entity.event", func(*message.Message) error {
+			during = m.Report()
+			return nil
+		})
+	}))
+
+	_, err := rec.handlers["execute.entity.event"](message.NewMessage("id", nil))
+	require.NoError(t, err)
+	require.Equal(t, []QueueDepth{{Topic: "execute.entity.event", InFlight: 1}}, during.Queues)
+	require.Equal(t, []QueueDepth{{Topic: "execute.entity.event", Handled: 1}}, m.Report().Queues)
+}
+
+func TestMonitorHandler(t *testing.T) {
+	t.Parallel()
+
+	m, _ := newTestMonitor()
+	m.StartEvaluation(uuid.New(), uuid.New(), "repository", uuid.New())
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/pipeline", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var report Report
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+	require.Len(t, report.Evaluations, 1)
+
+	rec = httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/pipeline", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
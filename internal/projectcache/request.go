@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectcache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// requestCache holds the lookups made during a request. It is dropped when
+// the cache of the store is invalidated, even if the request is in flight.
+type requestCache struct {
+	mu          sync.Mutex
+	generation  uint64
+	hierarchies map[uuid.UUID][]uuid.UUID
+	features    map[featureKey]cachedFeature
+}
+
+type requestCacheKey struct{}
+
+// WithRequestCache returns a context caching the project hierarchies and
+// entitlements looked up through it, until the context is done.
+func WithRequestCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestCacheKey{}, &requestCache{
+		hierarchies: make(map[uuid.UUID][]uuid.UUID),
+		features:    make(map[featureKey]cachedFeature),
+	})
+}
+
+// Interceptor caches the project hierarchies and entitlements looked up
+// while handling a request.
+func Interceptor(
+	ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (any, error) {
+	return handler(WithRequestCache(ctx), req)
+}
+
+func requestCacheFromContext(ctx context.Context) *requestCache {
+	rc, _ := ctx.Value(requestCacheKey{}).(*requestCache)
+	return rc
+}
+
+// syncLocked drops the lookups cached before the cache of the store was
+// invalidated. It returns false if the given generation is older than the
+// cached lookups, which happens when the store was invalidated during the
+// lookup.
+func (rc *requestCache) syncLocked(generation uint64) bool {
+	if generation > rc.generation {
+		rc.generation = generation
+		clear(rc.hierarchies)
+		clear(rc.features)
+	}
+	return generation == rc.generation
+}
+
+func (rc *requestCache) hierarchy(generation uint64, id uuid.UUID) ([]uuid.UUID, bool) {
+	if rc == nil {
+		return nil, false
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if !rc.syncLocked(generation) {
+		return nil, false
+	}
+	hierarchy, ok := rc.hierarchies[id]
+	return hierarchy, ok
+}
+
+func (rc *requestCache) setHierarchy(generation uint64, id uuid.UUID, hierarchy []uuid.UUID) {
+	if rc == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.syncLocked(generation) {
+		rc.hierarchies[id] = hierarchy
+	}
+}
+
+func (rc *requestCache) feature(generation uint64, key featureKey) (cachedFeature, bool) {
+	if rc == nil {
+		return cachedFeature{}, false
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if !rc.syncLocked(generation) {
+		return cachedFeature{}, false
+	}
+	feature, ok := rc.features[key]
+	return feature, ok
+}
+
+func (rc *requestCache) setFeature(generation uint64, key featureKey, feature cachedFeature) {
+	if rc == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.syncLocked(generation) {
+		rc.features[key] = feature
+	}
+}
@@ -0,0 +1,388 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package projectcache caches the project hierarchy and the entitlements of
+// the projects, which are read by most requests, in front of the database.
+package projectcache
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+const (
+	lookupHierarchy = "hierarchy"
+	lookupFeature   = "feature"
+
+	resultRequest = "request"
+	resultShared  = "shared"
+	resultMiss    = "miss"
+)
+
+type featureKey struct {
+	projectID uuid.UUID
+	feature   string
+}
+
+// cachedFeature is the result of a feature lookup. Features the project
+// isn't entitled to are cached too, as they are the common case.
+type cachedFeature struct {
+	settings json.RawMessage
+	found    bool
+}
+
+func (f cachedFeature) result() (json.RawMessage, error) {
+	if !f.found {
+		return nil, sql.ErrNoRows
+	}
+	return slices.Clone(f.settings), nil
+}
+
+type sharedEntry[T any] struct {
+	value      T
+	generation uint64
+	expires    time.Time
+}
+
+// Store is a db.Store caching the project hierarchies and the features the
+// projects are entitled to. Lookups are cached for the duration of the
+// request, see WithRequestCache, and shared across requests for the
+// configured TTL.
+//
+// The cache is invalidated when projects, entitlements or tiers are
+// modified through the store, once the modification is committed. Reads
+// within transactions are never cached, so that they see the uncommitted
+// modifications of the transaction.
+type Store struct {
+	db.Store
+	ttl     time.Duration
+	now     func() time.Time
+	lookups metric.Int64Counter
+
+	mu          sync.Mutex
+	generation  uint64
+	hierarchies map[uuid.UUID]sharedEntry[[]uuid.UUID]
+	features    map[featureKey]sharedEntry[cachedFeature]
+	// dirty holds the transactions which modified projects, entitlements
+	// or tiers, and invalidate the cache when committed
+	dirty map[*sql.Tx]struct{}
+}
+
+var _ db.Store = (*Store)(nil)
+
+// NewStore wraps the store with the project cache
+func NewStore(store db.Store, cfg *serverconfig.ProjectCacheConfig) (*Store, error) {
+	lookups, err := otel.Meter("project_cache").Int64Counter("project_cache_lookups",
+		metric.WithDescription("Lookups of project hierarchies and entitlements, by kind and cache result"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project cache lookups counter: %w", err)
+	}
+
+	return &Store{
+		Store:       store,
+		ttl:         cfg.TTL,
+		now:         time.Now,
+		lookups:     lookups,
+		hierarchies: make(map[uuid.UUID]sharedEntry[[]uuid.UUID]),
+		features:    make(map[featureKey]sharedEntry[cachedFeature]),
+		dirty:       make(map[*sql.Tx]struct{}),
+	}, nil
+}
+
+// Invalidate drops all the cached lookups, including the ones cached for
+// the requests in flight.
+func (s *Store) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.invalidateLocked()
+}
+
+func (s *Store) invalidateLocked() {
+	s.generation++
+	clear(s.hierarchies)
+	clear(s.features)
+}
+
+// GetParentProjects returns the project and its ancestors, from the cache
+// if possible
+func (s *Store) GetParentProjects(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	generation := s.currentGeneration()
+
+	rc := requestCacheFromContext(ctx)
+	if hierarchy, ok := rc.hierarchy(generation, id); ok {
+		s.record(ctx, lookupHierarchy, resultRequest)
+		return slices.Clone(hierarchy), nil
+	}
+
+	s.mu.Lock()
+	entry, ok := s.hierarchies[id]
+	s.mu.Unlock()
+	if ok && entry.generation == generation && s.now().Before(entry.expires) {
+		s.record(ctx, lookupHierarchy, resultShared)
+		rc.setHierarchy(generation, id, entry.value)
+		return slices.Clone(entry.value), nil
+	}
+
+	s.record(ctx, lookupHierarchy, resultMiss)
+	hierarchy, err := s.Store.GetParentProjects(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rc.setHierarchy(generation, id, hierarchy)
+	if s.ttl > 0 {
+		s.mu.Lock()
+		if s.generation == generation {
+			s.dropExpiredLocked()
+			s.hierarchies[id] = sharedEntry[[]uuid.UUID]{
+				value:      hierarchy,
+				generation: generation,
+				expires:    s.now().Add(s.ttl),
+			}
+		}
+		s.mu.Unlock()
+	}
+	return slices.Clone(hierarchy), nil
+}
+
+// GetFeatureInProject returns the settings of the feature if the project is
+// entitled to it, from the cache if possible
+func (s *Store) GetFeatureInProject(ctx context.Context, arg db.GetFeatureInProjectParams) (json.RawMessage, error) {
+	generation := s.currentGeneration()
+	key := featureKey{projectID: arg.ProjectID, feature: arg.Feature}
+
+	rc := requestCacheFromContext(ctx)
+	if feature, ok := rc.feature(generation, key); ok {
+		s.record(ctx, lookupFeature, resultRequest)
+		return feature.result()
+	}
+
+	s.mu.Lock()
+	entry, ok := s.features[key]
+	s.mu.Unlock()
+	if ok && entry.generation == generation && s.now().Before(entry.expires) {
+		s.record(ctx, lookupFeature, resultShared)
+		rc.setFeature(generation, key, entry.value)
+		return entry.value.result()
+	}
+
+	s.record(ctx, lookupFeature, resultMiss)
+	settings, err := s.Store.GetFeatureInProject(ctx, arg)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	feature := cachedFeature{settings: settings, found: err == nil}
+
+	rc.setFeature(generation, key, feature)
+	if s.ttl > 0 {
+		s.mu.Lock()
+		if s.generation == generation {
+			s.dropExpiredLocked()
+			s.features[key] = sharedEntry[cachedFeature]{
+				value:      feature,
+				generation: generation,
+				expires:    s.now().Add(s.ttl),
+			}
+		}
+		s.mu.Unlock()
+	}
+	return feature.result()
+}
+
+func (s *Store) currentGeneration() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.generation
+}
+
+// dropExpiredLocked drops the expired entries, so that the cache doesn't
+// grow with every project that ever made a request
+func (s *Store) dropExpiredLocked() {
+	now := s.now()
+	for id, entry := range s.hierarchies {
+		if !now.Before(entry.expires) {
+			delete(s.hierarchies, id)
+		}
+	}
+	for key, entry := range s.features {
+		if !now.Before(entry.expires) {
+			delete(s.features, key)
+		}
+	}
+}
+
+func (s *Store) record(ctx context.Context, kind, result string) {
+	s.lookups.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("kind", kind),
+		attribute.String("result", result),
+	))
+}
+
+// GetQuerierWithTransaction returns a querier running in the transaction,
+// which tracks whether the transaction modifies projects, entitlements or
+// tiers.
+func (s *Store) GetQuerierWithTransaction(tx *sql.Tx) db.ExtendQuerier {
+	return &txQuerier{
+		ExtendQuerier: s.Store.GetQuerierWithTransaction(tx),
+		markDirty: func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.dirty[tx] = struct{}{}
+		},
+	}
+}
+
+// Commit commits the transaction, invalidating the cache if the transaction
+// modified projects, entitlements or tiers
+func (s *Store) Commit(tx *sql.Tx) error {
+	err := s.Store.Commit(tx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.dirty[tx]; ok {
+		delete(s.dirty, tx)
+		// The transaction may have been committed even if an error is returned
+		s.invalidateLocked()
+	}
+	return err
+}
+
+// Rollback rolls back the transaction
+func (s *Store) Rollback(tx *sql.Tx) error {
+	s.mu.Lock()
+	delete(s.dirty, tx)
+	s.mu.Unlock()
+	return s.Store.Rollback(tx)
+}
+
+// WithTransactionErr wraps an operation in a transaction of the store, so
+// that its modifications are tracked.
+func (s *Store) WithTransactionErr(fn func(querier db.ExtendQuerier) error) error {
+	_, err := db.WithTransaction(s, func(querier db.ExtendQuerier) (struct{}, error) {
+		return struct{}{}, fn(querier)
+	})
+	return err
+}
+
+// The following modify projects, entitlements or tiers outside of
+// transactions, and invalidate the cache right away.
+
+// CreateProject creates a project and invalidates the cache
+func (s *Store) CreateProject(ctx context.Context, arg db.CreateProjectParams) (db.Project, error) {
+	defer s.Invalidate()
+	return s.Store.CreateProject(ctx, arg)
+}
+
+// CreateProjectWithID creates a project and invalidates the cache
+func (s *Store) CreateProjectWithID(ctx context.Context, arg db.CreateProjectWithIDParams) (db.Project, error) {
+	defer s.Invalidate()
+	return s.Store.CreateProjectWithID(ctx, arg)
+}
+
+// DeleteProject deletes a project and invalidates the cache
+func (s *Store) DeleteProject(ctx context.Context, id uuid.UUID) ([]db.DeleteProjectRow, error) {
+	defer s.Invalidate()
+	return s.Store.DeleteProject(ctx, id)
+}
+
+// OrphanProject detaches a project from its parent and invalidates the cache
+func (s *Store) OrphanProject(ctx context.Context, arg db.OrphanProjectParams) (db.Project, error) {
+	defer s.Invalidate()
+	return s.Store.OrphanProject(ctx, arg)
+}
+
+// CreateEntitlements entitles a project to features and invalidates the cache
+func (s *Store) CreateEntitlements(ctx context.Context, arg db.CreateEntitlementsParams) error {
+	defer s.Invalidate()
+	return s.Store.CreateEntitlements(ctx, arg)
+}
+
+// AssignProjectTier assigns a tier to a project and invalidates the cache
+func (s *Store) AssignProjectTier(ctx context.Context, arg db.AssignProjectTierParams) error {
+	defer s.Invalidate()
+	return s.Store.AssignProjectTier(ctx, arg)
+}
+
+// UnassignProjectTier unassigns the tier of a project and invalidates the cache
+func (s *Store) UnassignProjectTier(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	defer s.Invalidate()
+	return s.Store.UnassignProjectTier(ctx, projectID)
+}
+
+// UpsertProjectTier creates or updates a tier and invalidates the cache
+func (s *Store) UpsertProjectTier(ctx context.Context, arg db.UpsertProjectTierParams) (db.ProjectTier, error) {
+	defer s.Invalidate()
+	return s.Store.UpsertProjectTier(ctx, arg)
+}
+
+// DeleteProjectTier deletes a tier and invalidates the cache
+func (s *Store) DeleteProjectTier(ctx context.Context, name string) (int64, error) {
+	defer s.Invalidate()
+	return s.Store.DeleteProjectTier(ctx, name)
+}
+
+// txQuerier is a querier running in a transaction, which marks the
+// transaction as dirty when it modifies projects, entitlements or tiers.
+type txQuerier struct {
+	db.ExtendQuerier
+	markDirty func()
+}
+
+func (q *txQuerier) CreateProject(ctx context.Context, arg db.CreateProjectParams) (db.Project, error) {
+	q.markDirty()
+	return q.ExtendQuerier.CreateProject(ctx, arg)
+}
+
+func (q *txQuerier) CreateProjectWithID(ctx context.Context, arg db.CreateProjectWithIDParams) (db.Project, error) {
+	q.markDirty()
+	return q.ExtendQuerier.CreateProjectWithID(ctx, arg)
+}
+
+func (q *txQuerier) DeleteProject(ctx context.Context, id uuid.UUID) ([]db.DeleteProjectRow, error) {
+	q.markDirty()
+	return q.ExtendQuerier.DeleteProject(ctx, id)
+}
+
+func (q *txQuerier) OrphanProject(ctx context.Context, arg db.OrphanProjectParams) (db.Project, error) {
+	q.markDirty()
+	return q.ExtendQuerier.OrphanProject(ctx, arg)
+}
+
+func (q *txQuerier) CreateEntitlements(ctx context.Context, arg db.CreateEntitlementsParams) error {
+	q.markDirty()
+	return q.ExtendQuerier.CreateEntitlements(ctx, arg)
+}
+
+func (q *txQuerier) AssignProjectTier(ctx context.Context, arg db.AssignProjectTierParams) error {
+	q.markDirty()
+	return q.ExtendQuerier.AssignProjectTier(ctx, arg)
+}
+
+func (q *txQuerier) UnassignProjectTier(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	q.markDirty()
+	return q.ExtendQuerier.UnassignProjectTier(ctx, projectID)
+}
+
+func (q *txQuerier) UpsertProjectTier(ctx context.Context, arg db.UpsertProjectTierParams) (db.ProjectTier, error) {
+	q.markDirty()
+	return q.ExtendQuerier.UpsertProjectTier(ctx, arg)
+}
+
+func (q *txQuerier) DeleteProjectTier(ctx context.Context, name string) (int64, error) {
+	q.markDirty()
+	return q.ExtendQuerier.DeleteProjectTier(ctx, name)
+}
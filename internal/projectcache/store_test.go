@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectcache
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func newTestStore(t *testing.T, ttl time.Duration) (*Store, *mockdb.MockStore) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockStore := mockdb.NewMockStore(ctrl)
+	store, err := NewStore(mockStore, &serverconfig.ProjectCacheConfig{TTL: ttl})
+	require.NoError(t, err)
+	return store, mockStore
+}
+
+func TestHierarchyCachedForRequest(t *testing.T) {
+	t.Parallel()
+
+	store, mockStore := newTestStore(t, 0)
+	projectID, parentID := uuid.New(), uuid.New()
+	mockStore.EXPECT().GetParentProjects(gomock.Any(), projectID).
+		Return([]uuid.UUID{projectID, parentID}, nil).Times(2)
+
+	ctx := WithRequestCache(context.Background())
+	for range 3 {
+		hierarchy, err := store.GetParentProjects(ctx, projectID)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{projectID, parentID}, hierarchy)
+		// callers can't alter the cached hierarchy
+		hierarchy[0] = uuid.Nil
+	}
+
+	// without sharing across requests, the next request looks it up again
+	_, err := store.GetParentProjects(WithRequestCache(context.Background()), projectID)
+	require.NoError(t, err)
+}
+
+func TestHierarchySharedAcrossRequests(t *testing.T) {
+	t.Parallel()
+
+	store, mockStore := newTestStore(t, 10*time.Second)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	projectID := uuid.New()
+	mockStore.EXPECT().GetParentProjects(gomock.Any(), projectID).
+		Return([]uuid.UUID{projectID}, nil).Times(2)
+
+	for range 3 {
+		_, err := store.GetParentProjects(WithRequestCache(context.Background()), projectID)
+		require.NoError(t, err)
+	}
+
+	// once expired, the hierarchy is looked up again
+	now = now.Add(11 * time.Second)
+	_, err := store.GetParentProjects(context.Background(), projectID)
+	require.NoError(t, err)
+}
+
+func TestFeatureCached(t *testing.T) {
+	t.Parallel()
+
+	store, mockStore := newTestStore(t, time.Minute)
+	projectID := uuid.New()
+	enabled := db.GetFeatureInProjectParams{ProjectID: projectID, Feature: "enabled"}
+	disabled := db.GetFeatureInProjectParams{ProjectID: projectID, Feature: "disabled"}
+	mockStore.EXPECT().GetFeatureInProject(gomock.Any(), enabled).
+		Return(json.RawMessage(`{"rate": 50}`), nil)
+	mockStore.EXPECT().GetFeatureInProject(gomock.Any(), disabled).
+		Return(nil, sql.ErrNoRows)
+
+	for range 2 {
+		settings, err := store.GetFeatureInProject(context.Background(), enabled)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"rate": 50}`, string(settings))
+
+		_, err = store.GetFeatureInProject(context.Background(), disabled)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	}
+}
+
+func TestInvalidatedByCommittedTransaction(t *testing.T) {
+	t.Parallel()
+
+	store, mockStore := newTestStore(t, time.Minute)
+	projectID := uuid.New()
+	mockStore.EXPECT().GetParentProjects(gomock.Any(), projectID).
+		Return([]uuid.UUID{projectID}, nil).Times(2)
+	mockStore.EXPECT().BeginTransaction().Return(nil, nil).Times(2)
+	mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore).Times(2)
+	mockStore.EXPECT().CreateEntitlements(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mockStore.EXPECT().Commit(gomock.Any()).Return(nil)
+	mockStore.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+
+	ctx := WithRequestCache(context.Background())
+	_, err := store.GetParentProjects(ctx, projectID)
+	require.NoError(t, err)
+
+	// a rolled back modification doesn't invalidate the cache
+	err = store.WithTransactionErr(func(qtx db.ExtendQuerier) error {
+		require.NoError(t, qtx.CreateEntitlements(ctx, db.CreateEntitlementsParams{ProjectID: projectID}))
+		return sql.ErrTxDone
+	})
+	require.ErrorIs(t, err, sql.ErrTxDone)
+	_, err = store.GetParentProjects(ctx, projectID)
+	require.NoError(t, err)
+
+	// a committed one does, including the cache of the request
+	err = store.WithTransactionErr(func(qtx db.ExtendQuerier) error {
+		return qtx.CreateEntitlements(ctx, db.CreateEntitlementsParams{ProjectID: projectID})
+	})
+	require.NoError(t, err)
+	_, err = store.GetParentProjects(ctx, projectID)
+	require.NoError(t, err)
+}
+
+func TestInvalidatedByModification(t *testing.T) {
+	t.Parallel()
+
+	store, mockStore := newTestStore(t, time.Minute)
+	projectID := uuid.New()
+	mockStore.EXPECT().GetParentProjects(gomock.Any(), projectID).
+		Return([]uuid.UUID{projectID}, nil).Times(2)
+	mockStore.EXPECT().OrphanProject(gomock.Any(), gomock.Any()).Return(db.Project{}, nil)
+
+	_, err := store.GetParentProjects(context.Background(), projectID)
+	require.NoError(t, err)
+	_, err = store.OrphanProject(context.Background(), db.OrphanProjectParams{ID: projectID})
+	require.NoError(t, err)
+	_, err = store.GetParentProjects(context.Background(), projectID)
+	require.NoError(t, err)
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+// ActionsPolicy is the project-level policy for the actions of the profiles
+// evaluated in the project. It is stored in the project metadata.
+type ActionsPolicy struct {
+	Remediate *ActionPolicy `json:"remediate,omitempty"`
+	Alert     *ActionPolicy `json:"alert,omitempty"`
+}
+
+// ActionPolicy is the policy for a single kind of action. The value
+// replaces the setting of the profiles if Override is set, and is otherwise
+// only used by the profiles which don't set the action.
+type ActionPolicy struct {
+	Value    string `json:"value"`
+	Override bool   `json:"override,omitempty"`
+}
+
+// IsEmpty returns true if the policy doesn't affect any action
+func (p *ActionsPolicy) IsEmpty() bool {
+	return p == nil || (p.Remediate == nil && p.Alert == nil)
+}
+
+// ActionsPolicyFromPB converts the protobuf actions policy to the metadata
+// representation. An empty policy is converted to nil.
+func ActionsPolicyFromPB(in *minderv1.ProjectActionsPolicy) *ActionsPolicy {
+	out := &ActionsPolicy{
+		Remediate: actionPolicyFromPB(in.GetRemediate()),
+		Alert:     actionPolicyFromPB(in.GetAlert()),
+	}
+	if out.IsEmpty() {
+		return nil
+	}
+	return out
+}
+
+func actionPolicyFromPB(in *minderv1.ProjectActionsPolicy_Action) *ActionPolicy {
+	if in.GetValue() == "" {
+		return nil
+	}
+	return &ActionPolicy{
+		Value:    in.GetValue(),
+		Override: in.GetOverride(),
+	}
+}
+
+// ToPB converts the actions policy to its protobuf representation
+func (p *ActionsPolicy) ToPB() *minderv1.ProjectActionsPolicy {
+	if p.IsEmpty() {
+		return nil
+	}
+	return &minderv1.ProjectActionsPolicy{
+		Remediate: p.Remediate.toPB(),
+		Alert:     p.Alert.toPB(),
+	}
+}
+
+func (p *ActionPolicy) toPB() *minderv1.ProjectActionsPolicy_Action {
+	if p == nil {
+		return nil
+	}
+	return &minderv1.ProjectActionsPolicy_Action{
+		Value:    p.Value,
+		Override: p.Override,
+	}
+}
+
+// ActionsPolicies are the actions policies of a project hierarchy, ordered
+// from the project up to its root project.
+type ActionsPolicies []*ActionsPolicy
+
+// GetActionsPolicies returns the actions policies of the project and its
+// ancestors, ordered from the project up to the root project.
+func GetActionsPolicies(ctx context.Context, qtx db.Querier, projectID uuid.UUID) (ActionsPolicies, error) {
+	hierarchy, err := qtx.GetParentProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project hierarchy: %w", err)
+	}
+
+	policies := make(ActionsPolicies, 0, len(hierarchy))
+	for _, id := range hierarchy {
+		project, err := qtx.GetProjectByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting project %s: %w", id, err)
+		}
+		meta, err := ParseMetadata(&project)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing metadata of project %s: %w", id, err)
+		}
+		if !meta.ActionsPolicy.IsEmpty() {
+			policies = append(policies, meta.ActionsPolicy)
+		}
+	}
+
+	return policies, nil
+}
+
+// Apply merges the policies with the action configuration of a profile. An
+// override closer to the root project wins, so a policy of a parent project
+// can't be undone by its sub-projects, while the default closest to the
+// project is used for the actions the profile doesn't set.
+func (ps ActionsPolicies) Apply(cfg models.ActionConfiguration) models.ActionConfiguration {
+	cfg.Remediate = ps.apply(cfg.Remediate, func(p *ActionsPolicy) *ActionPolicy { return p.Remediate })
+	cfg.Alert = ps.apply(cfg.Alert, func(p *ActionsPolicy) *ActionPolicy { return p.Alert })
+	return cfg
+}
+
+func (ps ActionsPolicies) apply(
+	setting models.ActionOpt, get func(*ActionsPolicy) *ActionPolicy,
+) models.ActionOpt {
+	for i := len(ps) - 1; i >= 0; i-- {
+		if p := get(ps[i]); p != nil && p.Override {
+			return actionOptFromString(p.Value, setting)
+		}
+	}
+
+	if setting != models.ActionOptUnknown {
+		return setting
+	}
+
+	for _, policy := range ps {
+		if p := get(policy); p != nil {
+			return actionOptFromString(p.Value, setting)
+		}
+	}
+
+	return setting
+}
+
+func actionOptFromString(value string, fallback models.ActionOpt) models.ActionOpt {
+	switch value {
+	case models.ActionOptOn.String():
+		return models.ActionOptOn
+	case models.ActionOptOff.String():
+		return models.ActionOptOff
+	case models.ActionOptDryRun.String():
+		return models.ActionOptDryRun
+	default:
+		return fallback
+	}
+}
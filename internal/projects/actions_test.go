@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+func TestActionsPoliciesApply(t *testing.T) {
+	t.Parallel()
+
+	profileCfg := models.ActionConfiguration{
+		Remediate: models.ActionOptOn,
+		Alert:     models.ActionOptUnknown,
+	}
+
+	tests := []struct {
+		name     string
+		policies ActionsPolicies
+		want     models.ActionConfiguration
+	}{
+		{
+			name: "no policies",
+			want: profileCfg,
+		},
+		{
+			name: "defaults only apply to unset actions",
+			policies: ActionsPolicies{{
+				Remediate: &ActionPolicy{Value: "off"},
+				Alert:     &ActionPolicy{Value: "dry_run"},
+			}},
+			want: models.ActionConfiguration{
+				Remediate: models.ActionOptOn,
+				Alert:     models.ActionOptDryRun,
+			},
+		},
+		{
+			name: "override replaces the profile setting",
+			policies: ActionsPolicies{{
+				Remediate: &ActionPolicy{Value: "off", Override: true},
+			}},
+			want: models.ActionConfiguration{
+				Remediate: models.ActionOptOff,
+				Alert:     models.ActionOptUnknown,
+			},
+		},
+		{
+			name: "default closest to the project wins",
+			policies: ActionsPolicies{
+				{Alert: &ActionPolicy{Value: "off"}},
+				{Alert: &ActionPolicy{Value: "on"}},
+			},
+			want: models.ActionConfiguration{
+				Remediate: models.ActionOptOn,
+				Alert:     models.ActionOptOff,
+			},
+		},
+		{
+			name: "override closest to the root wins",
+			policies: ActionsPolicies{
+				{Remediate: &ActionPolicy{Value: "on", Override: true}},
+				{Remediate: &ActionPolicy{Value: "dry_run", Override: true}},
+			},
+			want: models.ActionConfiguration{
+				Remediate: models.ActionOptDryRun,
+				Alert:     models.ActionOptUnknown,
+			},
+		},
+		{
+			name: "parent override wins over sub-project default",
+			policies: ActionsPolicies{
+				{Alert: &ActionPolicy{Value: "on"}},
+				{Alert: &ActionPolicy{Value: "off", Override: true}},
+			},
+			want: models.ActionConfiguration{
+				Remediate: models.ActionOptOn,
+				Alert:     models.ActionOptOff,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, tt.policies.Apply(profileCfg))
+		})
+	}
+}
+
+func TestActionsPolicyPB(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, ActionsPolicyFromPB(nil))
+	require.Nil(t, ActionsPolicyFromPB(&minderv1.ProjectActionsPolicy{
+		Alert: &minderv1.ProjectActionsPolicy_Action{Override: true},
+	}))
+
+	in := &minderv1.ProjectActionsPolicy{
+		Remediate: &minderv1.ProjectActionsPolicy_Action{Value: "off", Override: true},
+	}
+	policy := ActionsPolicyFromPB(in)
+	require.Equal(t, &ActionsPolicy{Remediate: &ActionPolicy{Value: "off", Override: true}}, policy)
+	require.Equal(t, in.String(), policy.ToPB().String())
+
+	var empty *ActionsPolicy
+	require.Nil(t, empty.ToPB())
+}
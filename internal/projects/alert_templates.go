@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
+)
+
+// GetAlertTemplates returns the alert templates of the project, which are the
+// ones of the closest project of its hierarchy having some. Nil is returned if
+// none has, meaning that the built-in wording is used.
+func GetAlertTemplates(ctx context.Context, qtx db.Querier, projectID uuid.UUID) (*templates.Templates, error) {
+	hierarchy, err := qtx.GetParentProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project hierarchy: %w", err)
+	}
+
+	for _, id := range hierarchy {
+		project, err := qtx.GetProjectByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting project %s: %w", id, err)
+		}
+		meta, err := ParseMetadata(&project)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing metadata of project %s: %w", id, err)
+		}
+		if !meta.AlertTemplates.IsEmpty() {
+			return meta.AlertTemplates, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type alertTemplatesResolver struct {
+	store db.Querier
+}
+
+// NewAlertTemplatesResolver returns a resolver of the alert templates of the
+// projects, as configured in the project hierarchy.
+func NewAlertTemplatesResolver(store db.Querier) templates.Resolver {
+	return &alertTemplatesResolver{store: store}
+}
+
+func (r *alertTemplatesResolver) AlertTemplates(ctx context.Context, projectID uuid.UUID) (*templates.Templates, error) {
+	return GetAlertTemplates(ctx, r.store, projectID)
+}
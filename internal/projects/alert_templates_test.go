@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
+)
+
+func TestGetAlertTemplates(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	child, parent, root := uuid.New(), uuid.New(), uuid.New()
+	tmpls := &templates.Templates{
+		PullRequestComment: &templates.PullRequestComment{Body: "{{.Message}}"},
+	}
+	parentMeta, err := json.Marshal(Metadata{AlertTemplates: tmpls})
+	require.NoError(t, err)
+
+	store.EXPECT().GetParentProjects(gomock.Any(), child).Return([]uuid.UUID{child, parent, root}, nil)
+	store.EXPECT().GetProjectByID(gomock.Any(), child).Return(db.Project{ID: child, Metadata: []byte(`{}`)}, nil)
+	store.EXPECT().GetProjectByID(gomock.Any(), parent).Return(db.Project{ID: parent, Metadata: parentMeta}, nil)
+
+	// the closest templates are used, the root project isn't looked up
+	got, err := NewAlertTemplatesResolver(store).AlertTemplates(context.Background(), child)
+	require.NoError(t, err)
+	require.Equal(t, tmpls, got)
+
+	store.EXPECT().GetParentProjects(gomock.Any(), root).Return([]uuid.UUID{root}, nil)
+	store.EXPECT().GetProjectByID(gomock.Any(), root).Return(db.Project{ID: root, Metadata: []byte(`{}`)}, nil)
+
+	got, err = NewAlertTemplatesResolver(store).AlertTemplates(context.Background(), root)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// DefaultApprovalWindow is the time a destructive operation waits for
+	// the confirmation of a second admin, unless the project sets another one.
+	DefaultApprovalWindow = 24 * time.Hour
+
+	minApprovalWindow = 5 * time.Minute
+	maxApprovalWindow = 7 * 24 * time.Hour
+)
+
+// OperationApproval requires destructive operations on a project, such as
+// deleting the project or its providers, to be confirmed by a second admin
+// within a time window. It is stored in the project metadata.
+type OperationApproval struct {
+	Window time.Duration `json:"window"`
+}
+
+// OperationApprovalFromPB converts the protobuf operation approval to the
+// metadata representation. A disabled approval is converted to nil.
+func OperationApprovalFromPB(in *minderv1.ProjectOperationApproval) (*OperationApproval, error) {
+	if !in.GetEnabled() {
+		return nil, nil
+	}
+
+	window := DefaultApprovalWindow
+	if in.GetWindow() != nil {
+		window = in.GetWindow().AsDuration()
+	}
+	if window < minApprovalWindow || window > maxApprovalWindow {
+		return nil, fmt.Errorf("%w: approval window must be between %s and %s",
+			ErrValidationFailed, minApprovalWindow, maxApprovalWindow)
+	}
+	return &OperationApproval{Window: window}, nil
+}
+
+// ToPB converts the operation approval to its protobuf representation
+func (a *OperationApproval) ToPB() *minderv1.ProjectOperationApproval {
+	if a == nil {
+		return nil
+	}
+	return &minderv1.ProjectOperationApproval{
+		Enabled: true,
+		Window:  durationpb.New(a.Window),
+	}
+}
+
+// GetOperationApproval returns the operation approval applying to the
+// project, which is the one of the project or of its closest ancestor
+// requiring approvals, if any. Sub-projects can't opt out of the approvals
+// required by their ancestors.
+func GetOperationApproval(ctx context.Context, qtx db.Querier, projectID uuid.UUID) (*OperationApproval, error) {
+	hierarchy, err := qtx.GetParentProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project hierarchy: %w", err)
+	}
+
+	for _, id := range hierarchy {
+		project, err := qtx.GetProjectByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting project %s: %w", id, err)
+		}
+		meta, err := ParseMetadata(&project)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing metadata of project %s: %w", id, err)
+		}
+		if meta.OperationApproval != nil {
+			return meta.OperationApproval, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestOperationApprovalFromPB(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      *minderv1.ProjectOperationApproval
+		want    *OperationApproval
+		wantErr bool
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name: "disabled",
+			in:   &minderv1.ProjectOperationApproval{Window: durationpb.New(time.Hour)},
+		},
+		{
+			name: "default window",
+			in:   &minderv1.ProjectOperationApproval{Enabled: true},
+			want: &OperationApproval{Window: DefaultApprovalWindow},
+		},
+		{
+			name: "custom window",
+			in:   &minderv1.ProjectOperationApproval{Enabled: true, Window: durationpb.New(time.Hour)},
+			want: &OperationApproval{Window: time.Hour},
+		},
+		{
+			name:    "window too short",
+			in:      &minderv1.ProjectOperationApproval{Enabled: true, Window: durationpb.New(time.Minute)},
+			wantErr: true,
+		},
+		{
+			name:    "window too long",
+			in:      &minderv1.ProjectOperationApproval{Enabled: true, Window: durationpb.New(30 * 24 * time.Hour)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := OperationApprovalFromPB(tt.in)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrValidationFailed)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			if got != nil {
+				require.Equal(t, tt.want.Window, got.ToPB().GetWindow().AsDuration())
+			}
+		})
+	}
+}
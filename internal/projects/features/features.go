@@ -18,6 +18,7 @@ import (
 const (
 	privateReposEnabledFlag               = "private_repositories_enabled"
 	projectHierarchyOperationsEnabledFlag = "project_hierarchy_operations_enabled"
+	globalVisibilityEnabledFlag           = "global_visibility_enabled"
 )
 
 // ProjectAllowsPrivateRepos checks if the project allows private repositories
@@ -30,9 +31,16 @@ func ProjectAllowsProjectHierarchyOperations(ctx context.Context, store db.Store
 	return featureEnabled(ctx, store, projectID, projectHierarchyOperationsEnabledFlag)
 }
 
+// ProjectAllowsGlobalVisibility checks if the project allows sharing its rule
+// types and data sources with every project. It takes a querier so that it
+// can be checked within a transaction.
+func ProjectAllowsGlobalVisibility(ctx context.Context, qtx db.Querier, projectID uuid.UUID) bool {
+	return featureEnabled(ctx, qtx, projectID, globalVisibilityEnabledFlag)
+}
+
 // Is a simple helper function to check if a feature is enabled for a project.
 // This does not check the feature's configuration, if any, just that it's enabled.
-func featureEnabled(ctx context.Context, store db.Store, projectID uuid.UUID, featureFlag string) bool {
+func featureEnabled(ctx context.Context, store db.Querier, projectID uuid.UUID, featureFlag string) bool {
 	// we're throwing away the result because we're really not interested in what the feature
 	// sets, just that it's enabled
 	_, err := store.GetFeatureInProject(ctx, db.GetFeatureInProjectParams{
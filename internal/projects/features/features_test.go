@@ -103,3 +103,44 @@ func TestProjectAllowsProjectHierarchyOperations(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectAllowsGlobalVisibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		sqlData []byte
+		sqlErr  error
+		want    bool
+	}{
+		{
+			name:    "enabled",
+			sqlData: []byte(`{}`),
+			want:    true,
+		},
+		{
+			name:   "disabled when feature not found",
+			sqlErr: sql.ErrNoRows,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+			projectID := uuid.New()
+			store.EXPECT().
+				GetFeatureInProject(gomock.Any(), db.GetFeatureInProjectParams{
+					ProjectID: projectID,
+					Feature:   globalVisibilityEnabledFlag,
+				}).
+				Return(tt.sqlData, tt.sqlErr)
+			if got := ProjectAllowsGlobalVisibility(context.Background(), store, projectID); got != tt.want {
+				t.Errorf("ProjectAllowsGlobalVisibility() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package features
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/util"
+)
+
+// advancedEngines are the rule evaluation engines which are only available to
+// the projects whose tier allows advanced engines, as they rely on external
+// services or on expensive analysis.
+var advancedEngines = []string{"vulncheck", "trusty", "homoglyphs"}
+
+// RequiresUpgradeError is returned when an operation is not allowed by the
+// tier of the project. It is surfaced to the users as a FAILED_PRECONDITION
+// error, whichever the service returning it.
+type RequiresUpgradeError struct {
+	// Tier is the name of the tier of the project
+	Tier string
+	// Reason describes what the tier does not allow
+	Reason string
+}
+
+// Error implements the error interface
+func (e *RequiresUpgradeError) Error() string {
+	return fmt.Sprintf("requires upgrade: tier %q %s", e.Tier, e.Reason)
+}
+
+// UpgradeStatus returns the user visible status of the RequiresUpgradeError
+// wrapped by err, or nil if err does not wrap one. The services return the
+// RequiresUpgradeError as is, and the API handlers surface it with this
+// function so that users get the same error for every operation.
+func UpgradeStatus(err error) *util.NiceStatus {
+	var upgradeErr *RequiresUpgradeError
+	if errors.As(err, &upgradeErr) {
+		return util.UserVisibleError(codes.FailedPrecondition, "%s", upgradeErr.Error())
+	}
+	return nil
+}
+
+// ProjectTier returns the tier of the project, which is the one assigned to
+// the project or to its closest ancestor. It returns nil if the project has no
+// tier, in which case the project is not limited.
+func ProjectTier(ctx context.Context, qtx db.Querier, projectID uuid.UUID) (*db.ProjectTier, error) {
+	tier, err := qtx.GetEffectiveProjectTier(ctx, projectID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting project tier: %w", err)
+	}
+	return &tier, nil
+}
+
+// CheckRepositoryLimit checks that the tier of the project allows registering
+// one more repository.
+func CheckRepositoryLimit(ctx context.Context, qtx db.Querier, projectID uuid.UUID) error {
+	tier, err := ProjectTier(ctx, qtx, projectID)
+	if err != nil || tier == nil || !tier.MaxRepositories.Valid {
+		return err
+	}
+
+	count, err := qtx.CountEntitiesByTypeAndProject(ctx, db.CountEntitiesByTypeAndProjectParams{
+		EntityType: db.EntitiesRepository,
+		ProjectID:  projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("error counting repositories: %w", err)
+	}
+	if count >= int64(tier.MaxRepositories.Int32) {
+		return &RequiresUpgradeError{
+			Tier:   tier.Name,
+			Reason: fmt.Sprintf("allows at most %d repositories", tier.MaxRepositories.Int32),
+		}
+	}
+	return nil
+}
+
+// CheckProviderLimit checks that the tier of the project allows creating one
+// more provider.
+func CheckProviderLimit(ctx context.Context, qtx db.Querier, projectID uuid.UUID) error {
+	tier, err := ProjectTier(ctx, qtx, projectID)
+	if err != nil || tier == nil || !tier.MaxProviders.Valid {
+		return err
+	}
+
+	count, err := qtx.CountProvidersByProjectID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("error counting providers: %w", err)
+	}
+	if count >= int64(tier.MaxProviders.Int32) {
+		return &RequiresUpgradeError{
+			Tier:   tier.Name,
+			Reason: fmt.Sprintf("allows at most %d providers", tier.MaxProviders.Int32),
+		}
+	}
+	return nil
+}
+
+// CheckRuleEngine checks that the tier of the project allows the rule
+// evaluation engine.
+func CheckRuleEngine(ctx context.Context, qtx db.Querier, projectID uuid.UUID, engine string) error {
+	if !slices.Contains(advancedEngines, engine) {
+		return nil
+	}
+
+	tier, err := ProjectTier(ctx, qtx, projectID)
+	if err != nil || tier == nil || tier.AdvancedEngines {
+		return err
+	}
+	return &RequiresUpgradeError{
+		Tier:   tier.Name,
+		Reason: fmt.Sprintf("does not allow the %s evaluation engine", engine),
+	}
+}
+
+// RuleLimit checks the rules instantiated by the profiles of a project
+// against the limit of its tier.
+type RuleLimit struct {
+	tier     *db.ProjectTier
+	previous int64
+}
+
+// NewRuleLimit records the rules of the project before they are changed. It
+// returns nil if the tier of the project does not limit the rules.
+func NewRuleLimit(ctx context.Context, qtx db.Querier, projectID uuid.UUID) (*RuleLimit, error) {
+	tier, err := ProjectTier(ctx, qtx, projectID)
+	if err != nil || tier == nil || !tier.MaxRules.Valid {
+		return nil, err
+	}
+
+	count, err := qtx.CountRuleInstancesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error counting rules: %w", err)
+	}
+	return &RuleLimit{tier: tier, previous: count}, nil
+}
+
+// Check checks the rules of the project once changed. Projects exceeding the
+// limit, e.g. after their tier was changed, are still allowed to reduce their
+// rules.
+func (l *RuleLimit) Check(ctx context.Context, qtx db.Querier, projectID uuid.UUID) error {
+	if l == nil {
+		return nil
+	}
+
+	count, err := qtx.CountRuleInstancesByProjectID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("error counting rules: %w", err)
+	}
+	if count > int64(l.tier.MaxRules.Int32) && count > l.previous {
+		return &RequiresUpgradeError{
+			Tier:   l.tier.Name,
+			Reason: fmt.Sprintf("allows at most %d rules", l.tier.MaxRules.Int32),
+		}
+	}
+	return nil
+}
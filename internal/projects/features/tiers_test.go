@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package features
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+)
+
+func TestCheckProviderLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		tier      db.ProjectTier
+		tierErr   error
+		providers int64
+		wantErr   string
+	}{
+		{
+			name:    "project without tier",
+			tierErr: sql.ErrNoRows,
+		},
+		{
+			name: "tier without provider limit",
+			tier: db.ProjectTier{Name: "enterprise"},
+		},
+		{
+			name:      "below the limit",
+			tier:      db.ProjectTier{Name: "free", MaxProviders: sql.NullInt32{Int32: 2, Valid: true}},
+			providers: 1,
+		},
+		{
+			name:      "at the limit",
+			tier:      db.ProjectTier{Name: "free", MaxProviders: sql.NullInt32{Int32: 2, Valid: true}},
+			providers: 2,
+			wantErr:   `requires upgrade: tier "free" allows at most 2 providers`,
+		},
+		{
+			name:    "store error",
+			tierErr: sql.ErrConnDone,
+			wantErr: "error getting project tier",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+			projectID := uuid.New()
+
+			store.EXPECT().GetEffectiveProjectTier(gomock.Any(), projectID).Return(tt.tier, tt.tierErr)
+			if tt.tier.MaxProviders.Valid {
+				store.EXPECT().CountProvidersByProjectID(gomock.Any(), projectID).Return(tt.providers, nil)
+			}
+
+			err := CheckProviderLimit(context.Background(), store, projectID)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCheckRuleEngine(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+	projectID := uuid.New()
+
+	// basic engines don't need the tier
+	require.NoError(t, CheckRuleEngine(context.Background(), store, projectID, "jq"))
+
+	store.EXPECT().GetEffectiveProjectTier(gomock.Any(), projectID).
+		Return(db.ProjectTier{Name: "free"}, nil)
+	err := CheckRuleEngine(context.Background(), store, projectID, "vulncheck")
+	require.EqualError(t, err, `requires upgrade: tier "free" does not allow the vulncheck evaluation engine`)
+
+	store.EXPECT().GetEffectiveProjectTier(gomock.Any(), projectID).
+		Return(db.ProjectTier{Name: "pro", AdvancedEngines: true}, nil)
+	require.NoError(t, CheckRuleEngine(context.Background(), store, projectID, "vulncheck"))
+}
+
+func TestRuleLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		previous int64
+		current  int64
+		wantErr  bool
+	}{
+		{name: "below the limit", previous: 3, current: 5},
+		{name: "above the limit", previous: 3, current: 6, wantErr: true},
+		{name: "reducing rules above the limit", previous: 9, current: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+			projectID := uuid.New()
+
+			store.EXPECT().GetEffectiveProjectTier(gomock.Any(), projectID).
+				Return(db.ProjectTier{Name: "free", MaxRules: sql.NullInt32{Int32: 5, Valid: true}}, nil)
+			gomock.InOrder(
+				store.EXPECT().CountRuleInstancesByProjectID(gomock.Any(), projectID).Return(tt.previous, nil),
+				store.EXPECT().CountRuleInstancesByProjectID(gomock.Any(), projectID).Return(tt.current, nil),
+			)
+
+			limit, err := NewRuleLimit(context.Background(), store, projectID)
+			require.NoError(t, err)
+			err = limit.Check(context.Background(), store, projectID)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			var upgradeErr *RequiresUpgradeError
+			require.ErrorAs(t, err, &upgradeErr)
+			require.Equal(t, "free", upgradeErr.Tier)
+		})
+	}
+}
+
+func TestUpgradeStatus(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, UpgradeStatus(sql.ErrNoRows))
+
+	err := fmt.Errorf("error creating repository: %w", &RequiresUpgradeError{
+		Tier:   "free",
+		Reason: "allows at most 5 repositories",
+	})
+	st := UpgradeStatus(err)
+	require.NotNil(t, st)
+	require.Equal(t, codes.FailedPrecondition, st.Code)
+	require.Equal(t, `requires upgrade: tier "free" allows at most 5 repositories`, st.Details)
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions/alert/templates"
 )
 
 const (
@@ -43,6 +44,26 @@ type Metadata struct {
 	// Public is a field that is meant to be read by other systems.
 	// It will be exposed to the public, e.g. via a UI.
 	Public PublicMetadataV1 `json:"public"`
+
+	// ActionsPolicy is the project-level policy for the actions of the
+	// profiles evaluated in the project.
+	ActionsPolicy *ActionsPolicy `json:"actions_policy,omitempty"`
+
+	// ValidationWebhook is the external webhook approving the changes to
+	// the profiles and rule types of the project.
+	ValidationWebhook *ValidationWebhook `json:"validation_webhook,omitempty"`
+
+	// OperationApproval requires the destructive operations on the project
+	// to be confirmed by a second admin.
+	OperationApproval *OperationApproval `json:"operation_approval,omitempty"`
+
+	// SigstoreTrustRoot is the trust root of the private sigstore
+	// deployment the artifacts of the project are verified against.
+	SigstoreTrustRoot *SigstoreTrustRoot `json:"sigstore_trust_root,omitempty"`
+
+	// AlertTemplates are the templates of the alerts of the project,
+	// instead of the built-in wording.
+	AlertTemplates *templates.Templates `json:"alert_templates,omitempty"`
 }
 
 // PublicMetadataV1 contains public metadata relevant for a project.
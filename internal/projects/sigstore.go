@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// SigstoreTrustRoot is the trust root of the private sigstore deployment
+// against which the artifacts of a project are verified. It is stored in the
+// project metadata and inherited by the child projects.
+type SigstoreTrustRoot struct {
+	TUFURL      string `json:"tuf_url,omitempty"`
+	TUFRoot     string `json:"tuf_root,omitempty"`
+	TrustedRoot string `json:"trusted_root,omitempty"`
+}
+
+// IsEmpty returns true if no trust root is configured
+func (r *SigstoreTrustRoot) IsEmpty() bool {
+	return r == nil || (r.TUFURL == "" && r.TrustedRoot == "")
+}
+
+// SigstoreTrustRootFromPB converts the protobuf trust root to the metadata
+// representation, validating it. An empty trust root is converted to nil.
+func SigstoreTrustRootFromPB(in *minderv1.ProjectSigstoreTrustRoot) (*SigstoreTrustRoot, error) {
+	out := &SigstoreTrustRoot{
+		TUFURL:      in.GetTufUrl(),
+		TUFRoot:     in.GetTufRoot(),
+		TrustedRoot: in.GetTrustedRoot(),
+	}
+	if out.IsEmpty() && out.TUFRoot == "" {
+		return nil, nil
+	}
+
+	if err := out.Verifier().Validate(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ToPB converts the trust root to its protobuf representation
+func (r *SigstoreTrustRoot) ToPB() *minderv1.ProjectSigstoreTrustRoot {
+	if r.IsEmpty() {
+		return nil
+	}
+	return &minderv1.ProjectSigstoreTrustRoot{
+		TufUrl:      r.TUFURL,
+		TufRoot:     r.TUFRoot,
+		TrustedRoot: r.TrustedRoot,
+	}
+}
+
+// Verifier returns the trust root as used by the sigstore verifier
+func (r *SigstoreTrustRoot) Verifier() *sigstore.TrustRoot {
+	return &sigstore.TrustRoot{
+		TUFURL:      r.TUFURL,
+		TUFRoot:     []byte(r.TUFRoot),
+		TrustedRoot: []byte(r.TrustedRoot),
+	}
+}
+
+// GetSigstoreTrustRoot returns the trust root of the project, which is the
+// one of the closest project of its hierarchy having one. Nil is returned if
+// none has, meaning that the public sigstore instance is used.
+func GetSigstoreTrustRoot(ctx context.Context, qtx db.Querier, projectID uuid.UUID) (*SigstoreTrustRoot, error) {
+	hierarchy, err := qtx.GetParentProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project hierarchy: %w", err)
+	}
+
+	for _, id := range hierarchy {
+		project, err := qtx.GetProjectByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting project %s: %w", id, err)
+		}
+		meta, err := ParseMetadata(&project)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing metadata of project %s: %w", id, err)
+		}
+		if !meta.SigstoreTrustRoot.IsEmpty() {
+			return meta.SigstoreTrustRoot, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type sigstoreTrustRootResolver struct {
+	store db.Querier
+}
+
+// NewSigstoreTrustRootResolver returns a resolver of the sigstore trust roots
+// of the projects, as configured in the project hierarchy.
+func NewSigstoreTrustRootResolver(store db.Querier) sigstore.TrustRootResolver {
+	return &sigstoreTrustRootResolver{store: store}
+}
+
+func (r *sigstoreTrustRootResolver) TrustRoot(ctx context.Context, projectID uuid.UUID) (*sigstore.TrustRoot, error) {
+	trustRoot, err := GetSigstoreTrustRoot(ctx, r.store, projectID)
+	if err != nil || trustRoot == nil {
+		return nil, err
+	}
+	return trustRoot.Verifier(), nil
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestSigstoreTrustRootFromPB(t *testing.T) {
+	t.Parallel()
+
+	trustRoot, err := SigstoreTrustRootFromPB(&minderv1.ProjectSigstoreTrustRoot{
+		TufUrl:  "https://tuf.example.com",
+		TufRoot: `{"signed": {}, "signatures": []}`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &SigstoreTrustRoot{
+		TUFURL:  "https://tuf.example.com",
+		TUFRoot: `{"signed": {}, "signatures": []}`,
+	}, trustRoot)
+	require.Equal(t, &minderv1.ProjectSigstoreTrustRoot{
+		TufUrl:  "https://tuf.example.com",
+		TufRoot: `{"signed": {}, "signatures": []}`,
+	}, trustRoot.ToPB())
+
+	// an empty trust root removes it
+	removed, err := SigstoreTrustRootFromPB(&minderv1.ProjectSigstoreTrustRoot{})
+	require.NoError(t, err)
+	require.Nil(t, removed)
+	require.Nil(t, removed.ToPB())
+
+	_, err = SigstoreTrustRootFromPB(&minderv1.ProjectSigstoreTrustRoot{
+		TufRoot: `{"signed": {}, "signatures": []}`,
+	})
+	require.ErrorIs(t, err, sigstore.ErrInvalidTrustRoot)
+
+	_, err = SigstoreTrustRootFromPB(&minderv1.ProjectSigstoreTrustRoot{
+		TrustedRoot: `{"mediaType": "unknown"}`,
+	})
+	require.ErrorIs(t, err, sigstore.ErrInvalidTrustRoot)
+}
+
+func TestGetSigstoreTrustRoot(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	child, parent, root := uuid.New(), uuid.New(), uuid.New()
+	trustRoot := &SigstoreTrustRoot{TUFURL: "https://tuf.example.com", TUFRoot: `{}`}
+	parentMeta, err := json.Marshal(Metadata{SigstoreTrustRoot: trustRoot})
+	require.NoError(t, err)
+
+	store.EXPECT().GetParentProjects(gomock.Any(), child).Return([]uuid.UUID{child, parent, root}, nil)
+	store.EXPECT().GetProjectByID(gomock.Any(), child).Return(db.Project{ID: child, Metadata: []byte(`{}`)}, nil)
+	store.EXPECT().GetProjectByID(gomock.Any(), parent).Return(db.Project{ID: parent, Metadata: parentMeta}, nil)
+
+	// the closest trust root is used, the root project isn't looked up
+	got, err := NewSigstoreTrustRootResolver(store).TrustRoot(context.Background(), child)
+	require.NoError(t, err)
+	require.Equal(t, trustRoot.Verifier(), got)
+
+	store.EXPECT().GetParentProjects(gomock.Any(), root).Return([]uuid.UUID{root}, nil)
+	store.EXPECT().GetProjectByID(gomock.Any(), root).Return(db.Project{ID: root, Metadata: []byte(`{}`)}, nil)
+
+	got, err = NewSigstoreTrustRootResolver(store).TrustRoot(context.Background(), root)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
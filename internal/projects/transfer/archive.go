@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package transfer exports project subtrees as encrypted archives, and imports
+// them into another Minder instance, e.g. to migrate tenants between
+// deployments.
+package transfer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/crypto/algorithms"
+	"github.com/mindersec/minder/internal/db"
+)
+
+// FormatVersion is the version of the archive format, which is bumped on
+// incompatible changes.
+const FormatVersion = 1
+
+// maxArchiveSize limits the size of decompressed archives
+const maxArchiveSize = 512 * 1024 * 1024
+
+// The keys of the validation webhook and its secret in the project metadata,
+// see projects.Metadata
+const (
+	webhookMetadataKey = "validation_webhook"
+	webhookSecretKey   = "secret"
+)
+
+// ErrUnsupportedFormat is returned when importing an archive of another
+// format version.
+var ErrUnsupportedFormat = errors.New("unsupported archive format")
+
+// Archive is the content of an exported project subtree
+type Archive struct {
+	FormatVersion int       `json:"format_version"`
+	ExportedAt    time.Time `json:"exported_at"`
+	// Projects lists the exported projects, the root of the subtree first
+	Projects []Project `json:"projects"`
+}
+
+// Project is an exported project. Data sources, rule types and profiles are
+// stored as their API representation, so that they are validated again on
+// import.
+type Project struct {
+	ID       uuid.UUID       `json:"id"`
+	ParentID uuid.NullUUID   `json:"parent_id"`
+	Name     string          `json:"name"`
+	Metadata json.RawMessage `json:"metadata"`
+	// WebhookSecretRemoved is set when the secret of the validation webhook
+	// was removed from the metadata, as it is encrypted with the key of the
+	// exporting deployment. It must be configured again after the import.
+	WebhookSecretRemoved bool              `json:"webhook_secret_removed,omitempty"`
+	DataSources          []json.RawMessage `json:"data_sources"`
+	RuleTypes            []json.RawMessage `json:"rule_types"`
+	Profiles             []json.RawMessage `json:"profiles"`
+	Providers            []Provider        `json:"providers"`
+	Entities             []Entity          `json:"entities"`
+	History              []Evaluation      `json:"history"`
+}
+
+// Provider is an exported provider. Credentials are not exported, so the
+// imported providers are placeholders which must be enrolled again.
+type Provider struct {
+	ID         uuid.UUID              `json:"id"`
+	Name       string                 `json:"name"`
+	Class      db.ProviderClass       `json:"class"`
+	Implements []db.ProviderType      `json:"implements"`
+	AuthFlows  []db.AuthorizationFlow `json:"auth_flows"`
+	Definition json.RawMessage        `json:"definition"`
+}
+
+// Entity is an exported entity along with its properties
+type Entity struct {
+	ID             uuid.UUID                  `json:"id"`
+	Type           db.Entities                `json:"type"`
+	Name           string                     `json:"name"`
+	ProviderID     uuid.UUID                  `json:"provider_id"`
+	OriginatedFrom uuid.NullUUID              `json:"originated_from"`
+	Properties     map[string]json.RawMessage `json:"properties"`
+}
+
+// Evaluation summarizes the latest evaluation of a rule for an entity. The
+// history is kept for reference, it isn't imported.
+type Evaluation struct {
+	Profile     string             `json:"profile"`
+	Rule        string             `json:"rule"`
+	RuleType    string             `json:"rule_type"`
+	EntityType  db.Entities        `json:"entity_type"`
+	Entity      string             `json:"entity"`
+	Status      db.EvalStatusTypes `json:"status"`
+	Details     string             `json:"details"`
+	EvaluatedAt time.Time          `json:"evaluated_at"`
+}
+
+// removeWebhookSecret removes the secret of the validation webhook from project
+// metadata, returning whether there was one. The other fields are kept as is.
+func removeWebhookSecret(metadata json.RawMessage) (json.RawMessage, bool, error) {
+	if len(metadata) == 0 {
+		return metadata, false, nil
+	}
+	var meta map[string]json.RawMessage
+	if err := json.Unmarshal(metadata, &meta); err != nil {
+		return nil, false, fmt.Errorf("error decoding project metadata: %w", err)
+	}
+	var webhook map[string]json.RawMessage
+	if data, ok := meta[webhookMetadataKey]; !ok || string(data) == "null" {
+		return metadata, false, nil
+	} else if err := json.Unmarshal(data, &webhook); err != nil {
+		return nil, false, fmt.Errorf("error decoding validation webhook: %w", err)
+	}
+	if secret, ok := webhook[webhookSecretKey]; !ok || string(secret) == "null" {
+		return metadata, false, nil
+	}
+
+	delete(webhook, webhookSecretKey)
+	data, err := json.Marshal(webhook)
+	if err != nil {
+		return nil, false, fmt.Errorf("error encoding validation webhook: %w", err)
+	}
+	meta[webhookMetadataKey] = data
+	if metadata, err = json.Marshal(meta); err != nil {
+		return nil, false, fmt.Errorf("error encoding project metadata: %w", err)
+	}
+	return metadata, true, nil
+}
+
+// Seal compresses and encrypts an archive using AES-256-GCM. The key is
+// base64 encoded, as the other encryption keys of Minder.
+func Seal(archive *Archive, key []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(archive); err != nil {
+		return nil, fmt.Errorf("error encoding archive: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("error compressing archive: %w", err)
+	}
+
+	alg := &algorithms.AES256GCMAlgorithm{}
+	sealed, err := alg.Encrypt(buf.Bytes(), key)
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting archive: %w", err)
+	}
+	return sealed, nil
+}
+
+// Open decrypts and decompresses an archive sealed with Seal
+func Open(sealed []byte, key []byte) (*Archive, error) {
+	alg := &algorithms.AES256GCMAlgorithm{}
+	compressed, err := alg.Decrypt(sealed, key)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting archive: %w", err)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing archive: %w", err)
+	}
+	defer zr.Close()
+
+	var archive Archive
+	if err := json.NewDecoder(io.LimitReader(zr, maxArchiveSize)).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("error decoding archive: %w", err)
+	}
+	if archive.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("%w: version %d", ErrUnsupportedFormat, archive.FormatVersion)
+	}
+	return &archive, nil
+}
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/db"
+)
+
+func TestSealOpen(t *testing.T) {
+	t.Parallel()
+
+	key := []byte(base64.StdEncoding.EncodeToString([]byte("2hcGLimy2i7LAknby2AFqYx87CaaCAtj")))
+	otherKey := []byte(base64.StdEncoding.EncodeToString([]byte("G5MNtX6lhXnsWQL4YmkLgBcK43bSZAHz")))
+
+	archive := &Archive{
+		FormatVersion: FormatVersion,
+		ExportedAt:    time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+		Projects: []Project{{
+			ID:        uuid.New(),
+			Name:      "acme",
+			Metadata:  json.RawMessage(`{"public":{"display_name":"acme"}}`),
+			RuleTypes: []json.RawMessage{json.RawMessage(`{"name":"secret_scanning"}`)},
+			Entities: []Entity{{
+				ID:         uuid.New(),
+				Type:       db.EntitiesRepository,
+				Name:       "acme/widgets",
+				ProviderID: uuid.New(),
+				Properties: map[string]json.RawMessage{"is_private": json.RawMessage(`true`)},
+			}},
+		}},
+	}
+
+	tests := []struct {
+		name    string
+		archive *Archive
+		openKey []byte
+		wantErr error
+		errMsg  string
+	}{
+		{
+			name:    "roundtrip",
+			archive: archive,
+			openKey: key,
+		},
+		{
+			name:    "wrong key",
+			archive: archive,
+			openKey: otherKey,
+			errMsg:  "error decrypting archive",
+		},
+		{
+			name:    "unsupported format",
+			archive: &Archive{FormatVersion: FormatVersion + 1},
+			openKey: key,
+			wantErr: ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sealed, err := Seal(tt.archive, key)
+			require.NoError(t, err)
+			require.NotContains(t, string(sealed), "acme")
+
+			got, err := Open(sealed, tt.openKey)
+			switch {
+			case tt.wantErr != nil:
+				require.ErrorIs(t, err, tt.wantErr)
+			case tt.errMsg != "":
+				require.ErrorContains(t, err, tt.errMsg)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tt.archive, got)
+			}
+		})
+	}
+}
+
+func TestRemoveWebhookSecret(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		metadata    string
+		want        string
+		wantRemoved bool
+	}{
+		{
+			name:     "no metadata",
+			metadata: ``,
+			want:     ``,
+		},
+		{
+			name:     "no webhook",
+			metadata: `{"public":{"display_name":"acme"}}`,
+			want:     `{"public":{"display_name":"acme"}}`,
+		},
+		{
+			name:     "webhook without secret",
+			metadata: `{"validation_webhook":{"url":"https://hooks.example.com"}}`,
+			want:     `{"validation_webhook":{"url":"https://hooks.example.com"}}`,
+		},
+		{
+			name: "webhook with secret",
+			metadata: `{"public":{"display_name":"acme"},"validation_webhook":` +
+				`{"url":"https://hooks.example.com","secret":{"Algorithm":"aes-256-gcm","EncodedData":"c2VjcmV0"},"fail_open":true}}`,
+			want: `{"public":{"display_name":"acme"},"validation_webhook":` +
+				`{"fail_open":true,"url":"https://hooks.example.com"}}`,
+			wantRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, removed, err := removeWebhookSecret(json.RawMessage(tt.metadata))
+			require.NoError(t, err)
+			require.Equal(t, tt.wantRemoved, removed)
+			if tt.want == "" {
+				require.Empty(t, got)
+				return
+			}
+			require.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
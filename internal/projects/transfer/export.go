@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+// Exporter exports project subtrees
+type Exporter struct {
+	store       db.Store
+	dataSources datasourceservice.DataSourcesService
+}
+
+// NewExporter creates an Exporter
+func NewExporter(store db.Store, dataSources datasourceservice.DataSourcesService) *Exporter {
+	return &Exporter{
+		store:       store,
+		dataSources: dataSources,
+	}
+}
+
+// Export exports a project and its sub-projects. Rule types, profiles and
+// data sources from bundle subscriptions are left out, as the importing
+// projects subscribe to the bundles on their own.
+func (e *Exporter) Export(ctx context.Context, projectID uuid.UUID) (*Archive, error) {
+	projects, err := e.store.GetChildrenProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing projects: %w", err)
+	}
+	if len(projects) == 0 {
+		return nil, fmt.Errorf("project %s not found", projectID)
+	}
+	// the root of the subtree goes first, so that it is imported first
+	slices.SortStableFunc(projects, func(a, b db.GetChildrenProjectsRow) int {
+		return compareBool(a.ID != projectID, b.ID != projectID)
+	})
+
+	archive := &Archive{
+		FormatVersion: FormatVersion,
+		ExportedAt:    time.Now().UTC(),
+	}
+	providers := make(map[uuid.UUID]bool)
+	for _, p := range projects {
+		exported, err := e.exportProject(ctx, p)
+		if err != nil {
+			return nil, fmt.Errorf("error exporting project %s: %w", p.ID, err)
+		}
+		for _, prov := range exported.Providers {
+			providers[prov.ID] = true
+		}
+		archive.Projects = append(archive.Projects, *exported)
+	}
+
+	// entities can only be imported along with their provider
+	for _, p := range archive.Projects {
+		for _, ent := range p.Entities {
+			if !providers[ent.ProviderID] {
+				return nil, fmt.Errorf("entity %s of project %s uses provider %s from outside the exported projects",
+					ent.Name, p.ID, ent.ProviderID)
+			}
+		}
+	}
+	return archive, nil
+}
+
+func (e *Exporter) exportProject(ctx context.Context, p db.GetChildrenProjectsRow) (*Project, error) {
+	metadata, secretRemoved, err := removeWebhookSecret(p.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	exported := &Project{
+		ID:                   p.ID,
+		ParentID:             p.ParentID,
+		Name:                 p.Name,
+		Metadata:             metadata,
+		WebhookSecretRemoved: secretRemoved,
+	}
+
+	dataSources, err := e.dataSources.List(ctx, p.ID, datasourceservice.ReadBuilder())
+	if err != nil {
+		return nil, fmt.Errorf("error listing data sources: %w", err)
+	}
+	for _, ds := range dataSources {
+		if fromSubscription(ds.GetName()) {
+			continue
+		}
+		ds.Id = ""
+		ds.Context = nil
+		if exported.DataSources, err = appendProto(exported.DataSources, ds); err != nil {
+			return nil, err
+		}
+	}
+
+	ruleTypes, err := e.store.ListRuleTypesByProject(ctx, p.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing rule types: %w", err)
+	}
+	for _, rt := range ruleTypes {
+		if rt.SubscriptionID.Valid {
+			continue
+		}
+		pb, err := ruletypes.RuleTypePBFromDB(&rt)
+		if err != nil {
+			return nil, fmt.Errorf("error converting rule type %s: %w", rt.Name, err)
+		}
+		pb.Id = nil
+		pb.Context = nil
+		if exported.RuleTypes, err = appendProto(exported.RuleTypes, pb); err != nil {
+			return nil, err
+		}
+	}
+
+	if exported.Profiles, err = e.exportProfiles(ctx, p.ID); err != nil {
+		return nil, err
+	}
+
+	providers, err := e.store.ListProvidersByProjectID(ctx, []uuid.UUID{p.ID})
+	if err != nil {
+		return nil, fmt.Errorf("error listing providers: %w", err)
+	}
+	for _, prov := range providers {
+		exported.Providers = append(exported.Providers, Provider{
+			ID:         prov.ID,
+			Name:       prov.Name,
+			Class:      prov.Class,
+			Implements: prov.Implements,
+			AuthFlows:  prov.AuthFlows,
+			Definition: prov.Definition,
+		})
+	}
+
+	entities, err := e.store.GetEntitiesByProjectHierarchy(ctx, []uuid.UUID{p.ID})
+	if err != nil {
+		return nil, fmt.Errorf("error listing entities: %w", err)
+	}
+	for _, ent := range entities {
+		props, err := e.store.GetAllPropertiesForEntity(ctx, ent.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting properties of entity %s: %w", ent.ID, err)
+		}
+		exportedEnt := Entity{
+			ID:             ent.ID,
+			Type:           ent.EntityType,
+			Name:           ent.Name,
+			ProviderID:     ent.ProviderID,
+			OriginatedFrom: ent.OriginatedFrom,
+			Properties:     make(map[string]json.RawMessage, len(props)),
+		}
+		for _, prop := range props {
+			exportedEnt.Properties[prop.Key] = prop.Value
+		}
+		exported.Entities = append(exported.Entities, exportedEnt)
+	}
+
+	evaluations, err := e.store.ListLatestEvaluationsByProject(ctx, p.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing evaluations: %w", err)
+	}
+	for _, ev := range evaluations {
+		exported.History = append(exported.History, Evaluation{
+			Profile:     ev.ProfileName,
+			Rule:        ev.RuleName,
+			RuleType:    ev.RuleTypeName,
+			EntityType:  ev.EntityType,
+			Entity:      ev.EntityName,
+			Status:      ev.Status,
+			Details:     ev.Details,
+			EvaluatedAt: ev.EvaluationTime,
+		})
+	}
+
+	return exported, nil
+}
+
+// exportProfiles exports the profiles of a project, each after the profiles
+// it extends.
+func (e *Exporter) exportProfiles(ctx context.Context, projectID uuid.UUID) ([]json.RawMessage, error) {
+	rows, err := e.store.ListProfilesByProjectIDAndLabel(ctx, db.ListProfilesByProjectIDAndLabelParams{
+		ProjectID:     projectID,
+		IncludeLabels: []string{"*"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing profiles: %w", err)
+	}
+	labels := make(map[string][]string, len(rows))
+	for _, row := range rows {
+		if !row.Profile.SubscriptionID.Valid {
+			labels[row.Profile.ID.String()] = row.Profile.Labels
+		}
+	}
+
+	var pending []*minderv1.Profile
+	for id, p := range profiles.MergeDatabaseListIntoProfiles(rows) {
+		if l, ok := labels[id]; ok {
+			p.Labels = l
+			pending = append(pending, p)
+		}
+	}
+	if err := profiles.PopulateExtends(ctx, e.store, pending...); err != nil {
+		return nil, err
+	}
+	for _, p := range pending {
+		p.Id = nil
+		p.Context = nil
+	}
+	slices.SortFunc(pending, func(a, b *minderv1.Profile) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	var out []json.RawMessage
+	exported := make(map[string]bool, len(pending))
+	for len(pending) > 0 {
+		var next []*minderv1.Profile
+		for _, p := range pending {
+			if !extendsExported(p, exported, pending) {
+				next = append(next, p)
+				continue
+			}
+			if out, err = appendProto(out, p); err != nil {
+				return nil, err
+			}
+			exported[p.GetName()] = true
+		}
+		if len(next) == len(pending) {
+			// the profiles extend each other in a loop, export them as is
+			// and let the import report it
+			for _, p := range next {
+				if out, err = appendProto(out, p); err != nil {
+					return nil, err
+				}
+			}
+			break
+		}
+		pending = next
+	}
+	return out, nil
+}
+
+// extendsExported returns whether the profiles extended by a profile which
+// are part of the pending ones were exported.
+func extendsExported(p *minderv1.Profile, exported map[string]bool, pending []*minderv1.Profile) bool {
+	for _, base := range p.GetExtends() {
+		if exported[base] {
+			continue
+		}
+		if slices.ContainsFunc(pending, func(o *minderv1.Profile) bool { return o.GetName() == base }) {
+			return false
+		}
+	}
+	return true
+}
+
+func appendProto(out []json.RawMessage, m proto.Message) ([]json.RawMessage, error) {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding %T: %w", m, err)
+	}
+	return append(out, data), nil
+}
+
+// fromSubscription returns whether a rule type, profile or data source comes
+// from a bundle subscription, which is the case of namespaced names.
+func fromSubscription(name string) bool {
+	return strings.Contains(name, "/")
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/mindersec/minder/internal/authz"
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/projects/features"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+// Result summarizes an import
+type Result struct {
+	// Projects maps the exported project IDs to the imported ones
+	Projects map[uuid.UUID]uuid.UUID
+	// Providers lists the imported providers, which must be enrolled again
+	// before the entities are reconciled
+	Providers []db.Provider
+	// Webhooks lists the imported projects whose validation webhook must be
+	// configured again with its secret
+	Webhooks []uuid.UUID
+	// Entities counts the imported entities
+	Entities int
+	// Evaluations counts the evaluations in the exported history, which
+	// aren't imported
+	Evaluations int
+}
+
+// Importer imports archives created by an Exporter
+type Importer struct {
+	store       db.Store
+	authzClient authz.Client
+	ruleTypes   ruletypes.RuleTypeService
+	profiles    profiles.ProfileService
+	dataSources datasourceservice.DataSourcesService
+}
+
+// NewImporter creates an Importer
+func NewImporter(
+	store db.Store,
+	authzClient authz.Client,
+	ruleTypes ruletypes.RuleTypeService,
+	profileService profiles.ProfileService,
+	dataSources datasourceservice.DataSourcesService,
+) *Importer {
+	return &Importer{
+		store:       store,
+		authzClient: authzClient,
+		ruleTypes:   ruleTypes,
+		profiles:    profileService,
+		dataSources: dataSources,
+	}
+}
+
+// Import imports an archive in a single transaction. The root project of the
+// archive is imported into an existing project, and its sub-projects are
+// created below it.
+func (i *Importer) Import(ctx context.Context, archive *Archive, targetProjectID uuid.UUID) (*Result, error) {
+	if len(archive.Projects) == 0 {
+		return nil, errors.New("archive contains no projects")
+	}
+
+	var adopted []adoption
+	res, err := db.WithTransaction(i.store, func(qtx db.ExtendQuerier) (*Result, error) {
+		target, err := qtx.GetProjectByID(ctx, targetProjectID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, fmt.Errorf("project %s not found", targetProjectID)
+			}
+			return nil, fmt.Errorf("error getting project: %w", err)
+		}
+
+		res := &Result{
+			Projects: map[uuid.UUID]uuid.UUID{archive.Projects[0].ID: target.ID},
+		}
+		var adoptions []adoption
+		providers := make(map[uuid.UUID]uuid.UUID)
+		entities := make(map[uuid.UUID]uuid.UUID)
+		for _, p := range archive.Projects {
+			projectID, ok := res.Projects[p.ID]
+			if !ok {
+				created, err := i.createProject(ctx, qtx, p, res)
+				if err != nil {
+					return nil, err
+				}
+				projectID = created.child
+				res.Projects[p.ID] = projectID
+				adoptions = append(adoptions, created)
+			}
+			if err := i.importProject(ctx, qtx, p, projectID, providers, entities, res); err != nil {
+				return nil, fmt.Errorf("error importing project %s: %w", p.Name, err)
+			}
+			res.Evaluations += len(p.History)
+		}
+
+		// entities may originate from entities of other projects, so they
+		// are linked once all of them were imported
+		for _, p := range archive.Projects {
+			for _, ent := range p.Entities {
+				if !ent.OriginatedFrom.Valid {
+					continue
+				}
+				origin, ok := entities[ent.OriginatedFrom.UUID]
+				if !ok {
+					continue
+				}
+				if err := qtx.UpdateEntityOriginatedFrom(ctx, db.UpdateEntityOriginatedFromParams{
+					ID:             entities[ent.ID],
+					ProjectID:      res.Projects[p.ID],
+					OriginatedFrom: uuid.NullUUID{UUID: origin, Valid: true},
+				}); err != nil {
+					return nil, fmt.Errorf("error linking entity %s: %w", ent.Name, err)
+				}
+			}
+		}
+
+		// The sub-projects are adopted in the authorization store last, so
+		// that nothing but the commit can fail afterwards. The adoptions are
+		// undone if it does.
+		for _, a := range adoptions {
+			if err := i.authzClient.Adopt(ctx, a.parent, a.child); err != nil {
+				return nil, fmt.Errorf("error adopting project %s: %w", a.child, err)
+			}
+			adopted = append(adopted, a)
+		}
+		return res, nil
+	})
+	if err != nil {
+		i.orphan(ctx, adopted)
+		return nil, err
+	}
+	return res, nil
+}
+
+// adoption is a sub-project created by an import, which is adopted by its
+// parent in the authorization store
+type adoption struct {
+	parent uuid.UUID
+	child  uuid.UUID
+}
+
+// orphan undoes the adoptions of a failed import
+func (i *Importer) orphan(ctx context.Context, adopted []adoption) {
+	for _, a := range slices.Backward(adopted) {
+		if err := i.authzClient.Orphan(ctx, a.parent, a.child); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).
+				Str("parent", a.parent.String()).
+				Str("project", a.child.String()).
+				Msg("error orphaning project of failed import")
+		}
+	}
+}
+
+func (i *Importer) createProject(
+	ctx context.Context,
+	qtx db.ExtendQuerier,
+	p Project,
+	res *Result,
+) (adoption, error) {
+	parentID, ok := res.Projects[p.ParentID.UUID]
+	if !p.ParentID.Valid || !ok {
+		return adoption{}, fmt.Errorf("parent of project %s is not part of the archive", p.Name)
+	}
+
+	// archives created before the secrets were removed on export may still
+	// hold one, which can't be decrypted here anyway
+	metadata, secretRemoved, err := removeWebhookSecret(p.Metadata)
+	if err != nil {
+		return adoption{}, fmt.Errorf("error importing project %s: %w", p.Name, err)
+	}
+	project, err := qtx.CreateProject(ctx, db.CreateProjectParams{
+		Name:     p.Name,
+		ParentID: uuid.NullUUID{UUID: parentID, Valid: true},
+		Metadata: metadata,
+	})
+	if err != nil {
+		if db.ErrIsUniqueViolation(err) {
+			return adoption{}, fmt.Errorf("project named %s already exists", p.Name)
+		}
+		return adoption{}, fmt.Errorf("error creating project %s: %w", p.Name, err)
+	}
+	if secretRemoved || p.WebhookSecretRemoved {
+		res.Webhooks = append(res.Webhooks, project.ID)
+	}
+	return adoption{parent: parentID, child: project.ID}, nil
+}
+
+func (i *Importer) importProject(
+	ctx context.Context,
+	qtx db.ExtendQuerier,
+	p Project,
+	projectID uuid.UUID,
+	providers map[uuid.UUID]uuid.UUID,
+	entities map[uuid.UUID]uuid.UUID,
+	res *Result,
+) error {
+	for _, data := range p.DataSources {
+		ds := &minderv1.DataSource{}
+		if err := protojson.Unmarshal(data, ds); err != nil {
+			return fmt.Errorf("error decoding data source: %w", err)
+		}
+		if _, err := i.dataSources.Create(ctx, projectID, uuid.Nil, ds,
+			datasourceservice.OptionsBuilder().WithTransaction(qtx)); err != nil {
+			return fmt.Errorf("error creating data source %s: %w", ds.GetName(), err)
+		}
+	}
+
+	for _, data := range p.RuleTypes {
+		rt := &minderv1.RuleType{}
+		if err := protojson.Unmarshal(data, rt); err != nil {
+			return fmt.Errorf("error decoding rule type: %w", err)
+		}
+		if _, err := i.ruleTypes.CreateRuleType(ctx, projectID, uuid.Nil, rt, qtx); err != nil {
+			return fmt.Errorf("error creating rule type %s: %w", rt.GetName(), err)
+		}
+	}
+
+	// profiles were exported after the profiles they extend
+	for _, data := range p.Profiles {
+		profile := &minderv1.Profile{}
+		if err := protojson.Unmarshal(data, profile); err != nil {
+			return fmt.Errorf("error decoding profile: %w", err)
+		}
+		if _, err := i.profiles.CreateProfile(ctx, projectID, uuid.Nil, profile, qtx); err != nil {
+			return fmt.Errorf("error creating profile %s: %w", profile.GetName(), err)
+		}
+	}
+
+	for _, prov := range p.Providers {
+		imported, err := importProvider(ctx, qtx, prov, projectID)
+		if err != nil {
+			return err
+		}
+		providers[prov.ID] = imported.ID
+		res.Providers = append(res.Providers, imported)
+	}
+
+	for _, ent := range p.Entities {
+		if ent.Type == db.EntitiesRepository {
+			if err := features.CheckRepositoryLimit(ctx, qtx, projectID); err != nil {
+				return err
+			}
+		}
+		imported, err := qtx.CreateEntity(ctx, db.CreateEntityParams{
+			EntityType: ent.Type,
+			Name:       ent.Name,
+			ProjectID:  projectID,
+			ProviderID: providers[ent.ProviderID],
+		})
+		if err != nil {
+			if db.ErrIsUniqueViolation(err) {
+				return fmt.Errorf("%s %s already exists", ent.Type, ent.Name)
+			}
+			return fmt.Errorf("error creating %s %s: %w", ent.Type, ent.Name, err)
+		}
+		for key, value := range ent.Properties {
+			if _, err := qtx.UpsertProperty(ctx, db.UpsertPropertyParams{
+				EntityID: imported.ID,
+				Key:      key,
+				Value:    value,
+			}); err != nil {
+				return fmt.Errorf("error storing property %s of %s %s: %w", key, ent.Type, ent.Name, err)
+			}
+		}
+		entities[ent.ID] = imported.ID
+		res.Entities++
+	}
+	return nil
+}
+
+// importProvider reuses the provider of the project with the same name, or
+// creates a placeholder without credentials.
+func importProvider(ctx context.Context, qtx db.ExtendQuerier, prov Provider, projectID uuid.UUID) (db.Provider, error) {
+	existing, err := qtx.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:     prov.Name,
+		Projects: []uuid.UUID{projectID},
+	})
+	if err == nil && existing.ProjectID == projectID {
+		return existing, nil
+	} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return db.Provider{}, fmt.Errorf("error getting provider %s: %w", prov.Name, err)
+	}
+
+	if err := features.CheckProviderLimit(ctx, qtx, projectID); err != nil {
+		return db.Provider{}, err
+	}
+	definition := prov.Definition
+	if len(definition) == 0 {
+		definition = json.RawMessage(`{}`)
+	}
+	created, err := qtx.CreateProvider(ctx, db.CreateProviderParams{
+		Name:       prov.Name,
+		ProjectID:  projectID,
+		Class:      prov.Class,
+		Implements: prov.Implements,
+		Definition: definition,
+		AuthFlows:  prov.AuthFlows,
+	})
+	if err != nil {
+		return db.Provider{}, fmt.Errorf("error creating provider %s: %w", prov.Name, err)
+	}
+	return created, nil
+}
@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mockauthz "github.com/mindersec/minder/internal/authz/mock"
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/db/embedded"
+	stubeventer "github.com/mindersec/minder/internal/events/stubs"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/selectors"
+	"github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+// sourceProjects is the project subtree exported by TestExportImport
+type sourceProjects struct {
+	root       uuid.UUID
+	child      uuid.UUID
+	provider   uuid.UUID
+	repository uuid.UUID
+	pull       uuid.UUID
+}
+
+func TestExportImport(t *testing.T) {
+	t.Parallel()
+
+	// We can't use mockdb.NewMockStore because the import runs in a
+	// transaction, which can't be mocked.
+	dbStore, cancelFunc, err := embedded.GetFakeStore()
+	if cancelFunc != nil {
+		t.Cleanup(cancelFunc)
+	}
+	if err != nil {
+		t.Fatalf("Error creating fake store: %v", err)
+	}
+
+	ctx := context.Background()
+	ruleTypeSvc := ruletypes.NewRuleTypeService(nil)
+	profileSvc := profiles.NewProfileService(&stubeventer.StubEventer{}, selectors.NewEnv())
+	dataSourceSvc := datasourceservice.NewDataSourceService(dbStore)
+	src := createSourceProjects(ctx, t, dbStore, ruleTypeSvc, profileSvc)
+
+	key := []byte(base64.StdEncoding.EncodeToString([]byte("2hcGLimy2i7LAknby2AFqYx87CaaCAtj")))
+	archive, err := NewExporter(dbStore, dataSourceSvc).Export(ctx, src.root)
+	require.NoError(t, err)
+	sealed, err := Seal(archive, key)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		// tamper changes the archive after it was opened
+		tamper func(t *testing.T, archive *Archive)
+		errMsg string
+	}{
+		{
+			name: "roundtrip",
+		},
+		{
+			name: "invalid rule type",
+			tamper: func(t *testing.T, archive *Archive) {
+				t.Helper()
+				archive.Projects[1].RuleTypes[0] = json.RawMessage(
+					`{"name":"pr_check","def":{"inEntity":"nonsense","ruleSchema":{},"ingest":{},"eval":{}}}`)
+			},
+			errMsg: "error creating rule type pr_check",
+		},
+		{
+			name: "invalid profile",
+			tamper: func(t *testing.T, archive *Archive) {
+				t.Helper()
+				archive.Projects[1].Profiles[0] = json.RawMessage(
+					`{"name":"pr_profile","pullRequest":[{"type":"missing_check","def":{}}]}`)
+			},
+			errMsg: "error creating profile pr_profile",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opened, err := Open(sealed, key)
+			require.NoError(t, err)
+			if tt.tamper != nil {
+				tt.tamper(t, opened)
+			}
+
+			target, err := dbStore.CreateProject(ctx, db.CreateProjectParams{
+				Name:     "globex-" + uuid.NewString(),
+				Metadata: json.RawMessage(`{}`),
+			})
+			require.NoError(t, err)
+
+			authzClient := &mockauthz.SimpleClient{}
+			importer := NewImporter(dbStore, authzClient, ruleTypeSvc, profileSvc, dataSourceSvc)
+			res, err := importer.Import(ctx, opened, target.ID)
+
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				requireEmptyProject(ctx, t, dbStore, target.ID)
+				require.Empty(t, authzClient.Adoptions)
+				return
+			}
+			require.NoError(t, err)
+			requireImported(ctx, t, dbStore, src, target.ID, res)
+			require.Equal(t, target.ID, authzClient.Adoptions[res.Projects[src.child]])
+		})
+	}
+}
+
+func createSourceProjects(
+	ctx context.Context,
+	t *testing.T,
+	store db.Store,
+	ruleTypeSvc ruletypes.RuleTypeService,
+	profileSvc profiles.ProfileService,
+) sourceProjects {
+	t.Helper()
+
+	root, err := store.CreateProject(ctx, db.CreateProjectParams{
+		Name:     "acme",
+		Metadata: json.RawMessage(`{"public":{"display_name":"Acme"}}`),
+	})
+	require.NoError(t, err)
+	child, err := store.CreateProject(ctx, db.CreateProjectParams{
+		Name:     "widgets",
+		ParentID: uuid.NullUUID{UUID: root.ID, Valid: true},
+		Metadata: json.RawMessage(`{}`),
+	})
+	require.NoError(t, err)
+
+	for projectID, entity := range map[uuid.UUID]minderv1.Entity{
+		root.ID:  minderv1.Entity_ENTITY_REPOSITORIES,
+		child.ID: minderv1.Entity_ENTITY_PULL_REQUESTS,
+	} {
+		name := "repo_check"
+		profile := &minderv1.Profile{
+			Name:       "repo_profile",
+			Repository: []*minderv1.Profile_Rule{{Type: name, Def: &structpb.Struct{}}},
+		}
+		if entity == minderv1.Entity_ENTITY_PULL_REQUESTS {
+			name = "pr_check"
+			profile = &minderv1.Profile{
+				Name:        "pr_profile",
+				PullRequest: []*minderv1.Profile_Rule{{Type: name, Def: &structpb.Struct{}}},
+			}
+		}
+		_, err := ruleTypeSvc.CreateRuleType(ctx, projectID, uuid.Nil, &minderv1.RuleType{
+			Name: name,
+			Def: &minderv1.RuleType_Definition{
+				InEntity:   entity.ToString(),
+				RuleSchema: &structpb.Struct{},
+				Ingest:     &minderv1.RuleType_Definition_Ingest{},
+				Eval:       &minderv1.RuleType_Definition_Eval{},
+			},
+			Severity: &minderv1.Severity{Value: minderv1.Severity_VALUE_HIGH},
+		}, store)
+		require.NoError(t, err)
+		_, err = profileSvc.CreateProfile(ctx, projectID, uuid.Nil, profile, store)
+		require.NoError(t, err)
+	}
+
+	provider, err := store.CreateProvider(ctx, db.CreateProviderParams{
+		Name:       "github-app-acme",
+		ProjectID:  root.ID,
+		Class:      db.ProviderClassGithubApp,
+		Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeGit},
+		Definition: json.RawMessage(`{}`),
+		AuthFlows:  []db.AuthorizationFlow{db.AuthorizationFlowGithubAppFlow},
+	})
+	require.NoError(t, err)
+
+	repo, err := store.CreateEntity(ctx, db.CreateEntityParams{
+		EntityType: db.EntitiesRepository,
+		Name:       "acme/widgets",
+		ProjectID:  root.ID,
+		ProviderID: provider.ID,
+	})
+	require.NoError(t, err)
+	for key, value := range map[string]string{"is_private": `true`, "repo_id": `42`} {
+		_, err := store.UpsertProperty(ctx, db.UpsertPropertyParams{
+			EntityID: repo.ID,
+			Key:      key,
+			Value:    json.RawMessage(value),
+		})
+		require.NoError(t, err)
+	}
+
+	// the pull request lives in the sub-project, and originates from the
+	// repository of the root project
+	pull, err := store.CreateEntity(ctx, db.CreateEntityParams{
+		EntityType:     db.EntitiesPullRequest,
+		Name:           "acme/widgets/1",
+		ProjectID:      child.ID,
+		ProviderID:     provider.ID,
+		OriginatedFrom: uuid.NullUUID{UUID: repo.ID, Valid: true},
+	})
+	require.NoError(t, err)
+
+	return sourceProjects{
+		root:       root.ID,
+		child:      child.ID,
+		provider:   provider.ID,
+		repository: repo.ID,
+		pull:       pull.ID,
+	}
+}
+
+func requireImported(
+	ctx context.Context,
+	t *testing.T,
+	store db.Store,
+	src sourceProjects,
+	targetID uuid.UUID,
+	res *Result,
+) {
+	t.Helper()
+
+	require.Equal(t, targetID, res.Projects[src.root])
+	childID := res.Projects[src.child]
+	require.NotEqual(t, uuid.Nil, childID)
+	require.NotEqual(t, src.child, childID)
+	child, err := store.GetProjectByID(ctx, childID)
+	require.NoError(t, err)
+	require.Equal(t, "widgets", child.Name)
+	require.Equal(t, uuid.NullUUID{UUID: targetID, Valid: true}, child.ParentID)
+
+	require.Len(t, res.Providers, 1)
+	provider := res.Providers[0]
+	require.NotEqual(t, src.provider, provider.ID)
+	require.Equal(t, targetID, provider.ProjectID)
+	require.Equal(t, 2, res.Entities)
+
+	for projectID, names := range map[uuid.UUID][2]string{
+		targetID: {"repo_check", "repo_profile"},
+		childID:  {"pr_check", "pr_profile"},
+	} {
+		_, err := store.GetRuleTypeByName(ctx, db.GetRuleTypeByNameParams{
+			Name:     names[0],
+			Projects: []uuid.UUID{projectID},
+		})
+		require.NoError(t, err)
+		_, err = store.GetProfileByName(ctx, db.GetProfileByNameParams{
+			ProjectID: projectID,
+			Name:      names[1],
+		})
+		require.NoError(t, err)
+	}
+
+	repos, err := store.GetEntitiesByProjectHierarchy(ctx, []uuid.UUID{targetID})
+	require.NoError(t, err)
+	require.Len(t, repos, 1)
+	repo := repos[0]
+	require.NotEqual(t, src.repository, repo.ID)
+	require.Equal(t, "acme/widgets", repo.Name)
+	require.Equal(t, provider.ID, repo.ProviderID)
+
+	props, err := store.GetAllPropertiesForEntity(ctx, repo.ID)
+	require.NoError(t, err)
+	got := make(map[string]string, len(props))
+	for _, prop := range props {
+		got[prop.Key] = string(prop.Value)
+	}
+	require.Len(t, got, 2)
+	require.JSONEq(t, `true`, got["is_private"])
+	require.JSONEq(t, `42`, got["repo_id"])
+
+	pulls, err := store.GetEntitiesByProjectHierarchy(ctx, []uuid.UUID{childID})
+	require.NoError(t, err)
+	require.Len(t, pulls, 1)
+	pull := pulls[0]
+	require.NotEqual(t, src.pull, pull.ID)
+	require.Equal(t, db.EntitiesPullRequest, pull.EntityType)
+	require.Equal(t, provider.ID, pull.ProviderID)
+	require.Equal(t, uuid.NullUUID{UUID: repo.ID, Valid: true}, pull.OriginatedFrom)
+}
+
+// requireEmptyProject checks that a failed import left nothing behind
+func requireEmptyProject(ctx context.Context, t *testing.T, store db.Store, projectID uuid.UUID) {
+	t.Helper()
+
+	projects, err := store.GetChildrenProjects(ctx, projectID)
+	require.NoError(t, err)
+	require.Len(t, projects, 1, "sub-projects were not rolled back")
+
+	ruleTypes, err := store.ListRuleTypesByProject(ctx, projectID)
+	require.NoError(t, err)
+	require.Empty(t, ruleTypes)
+	profileRows, err := store.ListProfilesByProjectIDAndLabel(ctx, db.ListProfilesByProjectIDAndLabelParams{
+		ProjectID:     projectID,
+		IncludeLabels: []string{"*"},
+	})
+	require.NoError(t, err)
+	require.Empty(t, profileRows)
+	providers, err := store.ListProvidersByProjectID(ctx, []uuid.UUID{projectID})
+	require.NoError(t, err)
+	require.Empty(t, providers)
+	entities, err := store.GetEntitiesByProjectHierarchy(ctx, []uuid.UUID{projectID})
+	require.NoError(t, err)
+	require.Empty(t, entities)
+}
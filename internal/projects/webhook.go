@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// PolicyChangeKindProfile is the kind of the changes to profiles
+	PolicyChangeKindProfile = "profile"
+	// PolicyChangeKindRuleType is the kind of the changes to rule types
+	PolicyChangeKindRuleType = "rule_type"
+
+	// PolicyChangeOperationCreate is the operation of the changes creating an object
+	PolicyChangeOperationCreate = "create"
+	// PolicyChangeOperationUpdate is the operation of the changes updating an object
+	PolicyChangeOperationUpdate = "update"
+	// PolicyChangeOperationPatch is the operation of the changes patching an
+	// object, whose proposed object only holds the patched fields
+	PolicyChangeOperationPatch = "patch"
+
+	// ValidationWebhookSignatureHeader is the header holding the signature of
+	// the requests sent to validation webhooks.
+	ValidationWebhookSignatureHeader = "X-Minder-Signature-256"
+
+	// maxValidationResponseSize is the maximum size of the response of a
+	// validation webhook.
+	maxValidationResponseSize = 64 * 1024
+)
+
+// ValidationWebhook is an external webhook approving the changes to the
+// profiles and rule types of a project. It is stored in the project metadata.
+type ValidationWebhook struct {
+	URL string `json:"url"`
+	// Secret is the encrypted secret signing the requests, if any.
+	Secret   *crypto.EncryptedData `json:"secret,omitempty"`
+	FailOpen bool                  `json:"fail_open,omitempty"`
+}
+
+// IsEmpty returns true if no webhook is configured
+func (w *ValidationWebhook) IsEmpty() bool {
+	return w == nil || w.URL == ""
+}
+
+// ValidationWebhookFromPB converts the protobuf validation webhook to the
+// metadata representation, encrypting its secret. The secret of the current
+// webhook is kept if no secret is given. A webhook without URL is converted
+// to nil.
+func ValidationWebhookFromPB(
+	in *minderv1.ProjectValidationWebhook, current *ValidationWebhook, engine crypto.Engine,
+) (*ValidationWebhook, error) {
+	if in.GetUrl() == "" {
+		return nil, nil
+	}
+
+	out := &ValidationWebhook{
+		URL:      in.GetUrl(),
+		FailOpen: in.GetFailOpen(),
+	}
+	if in.GetSecret() == "" {
+		if current != nil {
+			out.Secret = current.Secret
+		}
+		return out, nil
+	}
+
+	secret, err := engine.EncryptString(in.GetSecret())
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting webhook secret: %w", err)
+	}
+	out.Secret = &secret
+	return out, nil
+}
+
+// ToPB converts the validation webhook to its protobuf representation. The
+// secret is never returned.
+func (w *ValidationWebhook) ToPB() *minderv1.ProjectValidationWebhook {
+	if w.IsEmpty() {
+		return nil
+	}
+	return &minderv1.ProjectValidationWebhook{
+		Url:      w.URL,
+		FailOpen: w.FailOpen,
+	}
+}
+
+// GetValidationWebhooks returns the validation webhooks of the project and its
+// ancestors, ordered from the project up to the root project.
+func GetValidationWebhooks(ctx context.Context, qtx db.Querier, projectID uuid.UUID) ([]*ValidationWebhook, error) {
+	hierarchy, err := qtx.GetParentProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project hierarchy: %w", err)
+	}
+
+	webhooks := make([]*ValidationWebhook, 0, len(hierarchy))
+	for _, id := range hierarchy {
+		project, err := qtx.GetProjectByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting project %s: %w", id, err)
+		}
+		meta, err := ParseMetadata(&project)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing metadata of project %s: %w", id, err)
+		}
+		if !meta.ValidationWebhook.IsEmpty() {
+			webhooks = append(webhooks, meta.ValidationWebhook)
+		}
+	}
+
+	return webhooks, nil
+}
+
+// PolicyChange is a proposed change to a profile or rule type, as sent to the
+// validation webhooks.
+type PolicyChange struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	// Kind is one of PolicyChangeKindProfile or PolicyChangeKindRuleType
+	Kind string `json:"kind"`
+	// Operation is one of PolicyChangeOperationCreate, PolicyChangeOperationUpdate
+	// or PolicyChangeOperationPatch
+	Operation string `json:"operation"`
+	// ObjectID is the ID of the patched object
+	ObjectID string `json:"object_id,omitempty"`
+	// Object is the JSON representation of the proposed profile or rule type
+	Object json.RawMessage `json:"object"`
+}
+
+// ValidationResult is the decision of a validation webhook
+type ValidationResult struct {
+	Allowed bool   `json:"allowed"`
+	Message string `json:"message,omitempty"`
+}
+
+// Validate sends the proposed change to the webhook and returns its decision.
+// An error is returned if the webhook can't be reached or doesn't return a
+// valid decision.
+func (w *ValidationWebhook) Validate(
+	ctx context.Context, client *http.Client, engine crypto.Engine, change *PolicyChange,
+) (*ValidationResult, error) {
+	body, err := json.Marshal(change)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding policy change: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if w.Secret != nil {
+		secret, err := engine.DecryptString(*w.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting webhook secret: %w", err)
+		}
+		req.Header.Set(ValidationWebhookSignatureHeader, SignValidationPayload([]byte(secret), body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling validation webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("validation webhook returned status %d", resp.StatusCode)
+	}
+
+	var result ValidationResult
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxValidationResponseSize)).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding validation webhook response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// SignValidationPayload returns the value of the signature header of a
+// request to a validation webhook, the hex-encoded HMAC-SHA256 of the payload.
+func SignValidationPayload(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/crypto"
+	mockcrypto "github.com/mindersec/minder/internal/crypto/mock"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestValidationWebhookFromPB(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	engine := mockcrypto.NewMockEngine(ctrl)
+	encrypted := crypto.EncryptedData{EncodedData: "encrypted"}
+	engine.EXPECT().EncryptString("s3cr3t").Return(encrypted, nil)
+
+	webhook, err := ValidationWebhookFromPB(&minderv1.ProjectValidationWebhook{
+		Url:      "https://example.com/validate",
+		Secret:   "s3cr3t",
+		FailOpen: true,
+	}, nil, engine)
+	require.NoError(t, err)
+	require.Equal(t, &ValidationWebhook{
+		URL:      "https://example.com/validate",
+		Secret:   &encrypted,
+		FailOpen: true,
+	}, webhook)
+
+	// the secret is never returned
+	require.Equal(t, &minderv1.ProjectValidationWebhook{
+		Url:      "https://example.com/validate",
+		FailOpen: true,
+	}, webhook.ToPB())
+
+	// the current secret is kept when none is given
+	updated, err := ValidationWebhookFromPB(&minderv1.ProjectValidationWebhook{
+		Url: "https://example.com/other",
+	}, webhook, engine)
+	require.NoError(t, err)
+	require.Equal(t, &encrypted, updated.Secret)
+
+	// an empty URL removes the webhook
+	removed, err := ValidationWebhookFromPB(&minderv1.ProjectValidationWebhook{}, webhook, engine)
+	require.NoError(t, err)
+	require.Nil(t, removed)
+	require.Nil(t, removed.ToPB())
+}
+
+func TestValidationWebhookValidate(t *testing.T) {
+	t.Parallel()
+
+	change := &PolicyChange{
+		ProjectID: uuid.New(),
+		Kind:      PolicyChangeKindProfile,
+		Operation: PolicyChangeOperationCreate,
+		Object:    json.RawMessage(`{"name":"test"}`),
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    *ValidationResult
+		wantErr bool
+	}{
+		{
+			name:   "allowed",
+			status: http.StatusOK,
+			body:   `{"allowed": true}`,
+			want:   &ValidationResult{Allowed: true},
+		},
+		{
+			name:   "denied",
+			status: http.StatusOK,
+			body:   `{"allowed": false, "message": "profiles must be reviewed"}`,
+			want:   &ValidationResult{Message: "profiles must be reviewed"},
+		},
+		{
+			name:    "error status",
+			status:  http.StatusInternalServerError,
+			body:    `{"allowed": true}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid response",
+			status:  http.StatusOK,
+			body:    `allowed`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				payload, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, SignValidationPayload([]byte("s3cr3t"), payload),
+					r.Header.Get(ValidationWebhookSignatureHeader))
+
+				var got PolicyChange
+				require.NoError(t, json.Unmarshal(payload, &got))
+				require.Equal(t, change, &got)
+
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			ctrl := gomock.NewController(t)
+			engine := mockcrypto.NewMockEngine(ctrl)
+			secret := crypto.EncryptedData{EncodedData: "encrypted"}
+			engine.EXPECT().DecryptString(secret).Return("s3cr3t", nil)
+
+			webhook := &ValidationWebhook{URL: srv.URL, Secret: &secret}
+			got, err := webhook.Validate(context.Background(), srv.Client(), engine, change)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/go-git/go-git/v5"
+	"golang.org/x/crypto/ssh"
+
+	gitclient "github.com/mindersec/minder/internal/providers/git"
+)
+
+// Implements the Git interface
+func (c *azureDevOpsClient) Clone(ctx context.Context, cloneUrl string, branch string) (*git.Repository, error) {
+	g := gitclient.NewGit(c.GetCredential(), gitclient.WithConfig(c.gitConfig))
+	return g.Clone(ctx, cloneUrl, branch)
+}
+
+// CloneWithSSHKey clones a repository over SSH with the given key
+func (c *azureDevOpsClient) CloneWithSSHKey(
+	ctx context.Context, cloneUrl string, branch string, key ssh.Signer,
+) (*git.Repository, error) {
+	g := gitclient.NewGit(c.GetCredential(), gitclient.WithConfig(c.gitConfig))
+	return g.CloneWithSSHKey(ctx, cloneUrl, branch, key)
+}
+
+// ResolveBranch returns the hash of the commit at the head of a branch
+func (c *azureDevOpsClient) ResolveBranch(ctx context.Context, cloneUrl string, branch string) (string, error) {
+	g := gitclient.NewGit(c.GetCredential(), gitclient.WithConfig(c.gitConfig))
+	return g.ResolveBranch(ctx, cloneUrl, branch)
+}
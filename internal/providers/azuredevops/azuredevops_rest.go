@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// apiVersionParam is the query parameter selecting the version of the
+	// Azure DevOps REST API
+	apiVersionParam = "api-version"
+	// defaultAPIVersion is the version of the Azure DevOps REST API used
+	// when the request doesn't select one
+	defaultAPIVersion = "7.1"
+)
+
+// Do implements the REST provider interface
+func (c *azureDevOpsClient) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req = req.WithContext(ctx)
+	return c.cli.Do(req)
+}
+
+// GetBaseURL implements the REST provider interface. Requests are relative
+// to the organization, e.g. "<project>/_apis/policy/configurations".
+func (c *azureDevOpsClient) GetBaseURL() string {
+	u, err := url.JoinPath(c.adocfg.GetEndpoint(), url.PathEscape(c.adocfg.GetOrganization()))
+	if err != nil {
+		return ""
+	}
+	return u + "/"
+}
+
+// NewRequest implements the REST provider interface
+func (c *azureDevOpsClient) NewRequest(method, requestPath string, body any) (*http.Request, error) {
+	u, err := getParsedURL(c.GetBaseURL(), requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf io.ReadWriter
+	if body != nil {
+		buf = &bytes.Buffer{}
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+		err := enc.Encode(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequest(method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil || method == http.MethodPatch || method == http.MethodPost || method == http.MethodPut {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	req.Header.Set("Accept", "application/json")
+	// TODO: Get User-Agent from constants
+	req.Header.Set("User-Agent", "Minder")
+
+	c.cred.SetAuthorizationHeader(req)
+
+	return req, nil
+}
+
+type genericRESTClient interface {
+	// Do sends an HTTP request and returns an HTTP response
+	Do(ctx context.Context, req *http.Request) (*http.Response, error)
+	NewRequest(method, requestUrl string, body any) (*http.Request, error)
+}
+
+func adoRESTGet[T any](ctx context.Context, cli genericRESTClient, path string, out T) error {
+	// NewRequest already has the base URL configured, the path
+	// will get appended to it.
+	req, err := cli.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := cli.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to get resource '%s': %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return provifv1.ErrEntityNotFound
+		}
+		return fmt.Errorf("failed to get resource '%s': %s", path, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// getParsedURL joins the path to the endpoint, and selects the default
+// version of the REST API if the path doesn't select one.
+func getParsedURL(endpoint, path string) (*url.URL, error) {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	// Explicitly parse path and query parameters. This is to ensure that
+	// the path is properly escaped and that the query parameters are
+	// properly encoded.
+	parsedPathAndQuery, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path: %w", err)
+	}
+
+	u := base.JoinPath(parsedPathAndQuery.Path)
+
+	query := parsedPathAndQuery.Query()
+	if !query.Has(apiVersionParam) {
+		query.Set(apiVersionParam, defaultAPIVersion)
+	}
+	u.RawQuery = query.Encode()
+	u.Fragment = parsedPathAndQuery.Fragment
+
+	return u, nil
+}
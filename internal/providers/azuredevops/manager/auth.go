@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/microsoft"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/providers/credentials"
+	m "github.com/mindersec/minder/internal/providers/manager"
+	provv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// defaultTenant accepts the work and school accounts of any Microsoft
+	// Entra ID tenant
+	defaultTenant = "organizations"
+	// azureDevOpsResourceScope grants the permissions of the user to the
+	// Azure DevOps REST API. 499b84ac-... is the well-known ID of Azure DevOps.
+	azureDevOpsResourceScope = "499b84ac-1321-427f-aa17-267ca6975798/.default"
+	// offlineAccessScope grants the refresh tokens
+	offlineAccessScope = "offline_access"
+)
+
+// NewOAuthConfig implements the providerClassOAuthManager interface
+func (a *providerClassManager) NewOAuthConfig(_ db.ProviderClass, cli bool) (*oauth2.Config, error) {
+	oauthClientConfig := &a.adocfg.OAuthClientConfig
+	oauthConfig := getOauthConfig(oauthClientConfig.RedirectURI, cli, a.adocfg.Tenant, a.adocfg.Scopes)
+
+	clientId, err := oauthClientConfig.GetClientID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client ID: %w", err)
+	}
+
+	clientSecret, err := oauthClientConfig.GetClientSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client secret: %w", err)
+	}
+
+	// this is currently only used for testing as entra id uses well-known endpoints
+	if oauthClientConfig.Endpoint != nil && oauthClientConfig.Endpoint.TokenURL != "" {
+		oauthConfig.Endpoint = oauth2.Endpoint{
+			TokenURL: oauthClientConfig.Endpoint.TokenURL,
+		}
+	}
+
+	oauthConfig.ClientID = clientId
+	oauthConfig.ClientSecret = clientSecret
+	return oauthConfig, nil
+}
+
+// ValidateCredentials implements the providerClassOAuthManager interface
+func (*providerClassManager) ValidateCredentials(
+	_ context.Context, cred provv1.Credential, _ *m.CredentialVerifyParams,
+) error {
+	switch c := cred.(type) {
+	case provv1.OAuth2TokenCredential:
+		_, err := c.GetAsOAuth2TokenSource().Token()
+		if err != nil {
+			return fmt.Errorf("cannot get token from credential: %w", err)
+		}
+	case string:
+		if !credentials.IsAzureDevOpsPAT(c) {
+			return errors.New("expected an Azure DevOps Personal Access Token")
+		}
+	default:
+		return fmt.Errorf("invalid credential type: %T", cred)
+	}
+
+	return nil
+}
+
+func getOauthConfig(redirectUrlBase string, cli bool, tenant string, scopes []string) *oauth2.Config {
+	var redirectUrl string
+
+	if cli {
+		redirectUrl = fmt.Sprintf("%s/cli", redirectUrlBase)
+	} else {
+		redirectUrl = fmt.Sprintf("%s/web", redirectUrlBase)
+	}
+
+	if tenant == "" {
+		tenant = defaultTenant
+	}
+
+	if len(scopes) == 0 {
+		scopes = []string{azureDevOpsResourceScope, offlineAccessScope}
+	}
+
+	return &oauth2.Config{
+		RedirectURL: redirectUrl,
+		Scopes:      scopes,
+		Endpoint:    microsoft.AzureADEndpoint(tenant),
+	}
+}
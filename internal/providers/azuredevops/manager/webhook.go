@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/azuredevops"
+	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+const (
+	// MaxBytesLimit is the maximum number of bytes to read from the response body
+	// We limit to 1MB to prevent abuse
+	MaxBytesLimit int64 = 1 << 20
+)
+
+// triggerEvents maps the service hook events to the events rule types
+// select in their triggers, which use the names of the GitHub events.
+var triggerEvents = map[string]string{
+	azuredevops.EventTypePush:               "push",
+	azuredevops.EventTypePullRequestCreated: "pull_request",
+	azuredevops.EventTypePullRequestUpdated: "pull_request",
+}
+
+// serviceHookEvent is the part of the service hook events Minder uses. The
+// resources of the events Minder subscribes to all refer to their repository.
+type serviceHookEvent struct {
+	EventType string `json:"eventType"`
+	Resource  struct {
+		Repository struct {
+			ID string `json:"id"`
+		} `json:"repository"`
+	} `json:"resource"`
+}
+
+// GetWebhookHandler implements the ProviderManager interface
+// Note that this is where the whole service hook handler is defined and
+// will live.
+func (m *providerClassManager) GetWebhookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l := zerolog.Ctx(m.parentContext).With().
+			Str("webhook", "azure-devops").
+			Str("method", r.Method).
+			Str("path", r.URL.Path).
+			Str("remote", r.RemoteAddr).
+			Str("user-agent", r.UserAgent()).
+			Str("content-type", r.Header.Get("Content-Type")).
+			Logger()
+
+		// Validate the webhook secret
+		if err := m.validateRequest(r); err != nil {
+			l.Error().Err(err).Msg("invalid webhook request")
+			http.Error(w, "invalid webhook request", http.StatusUnauthorized)
+			return
+		}
+
+		event := serviceHookEvent{}
+		if err := decodeJSONSafe(r.Body, &event); err != nil {
+			l.Error().Err(err).Msg("error decoding service hook event")
+			http.Error(w, "error decoding service hook event", http.StatusBadRequest)
+			return
+		}
+
+		l = l.With().Str("event", event.EventType).Logger()
+
+		if err := m.handleEvent(l, &event); err != nil {
+			l.Error().Err(err).Msg("error handling webhook event")
+			http.Error(w, "error handling webhook event", http.StatusInternalServerError)
+			return
+		}
+
+		l.Debug().Msg("processed webhook event successfully")
+	})
+}
+
+// handleEvent refreshes and evaluates the repository of the event
+func (m *providerClassManager) handleEvent(l zerolog.Logger, event *serviceHookEvent) error {
+	trigger, ok := triggerEvents[event.EventType]
+	if !ok {
+		l.Debug().Msg("unhandled webhook event")
+		return nil
+	}
+
+	upstreamID := event.Resource.Repository.ID
+	if upstreamID == "" {
+		return fmt.Errorf("%s event missing repository ID", event.EventType)
+	}
+
+	// Form identifying properties
+	identifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: upstreamID,
+	})
+
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(minderv1.Entity_ENTITY_REPOSITORIES, identifyingProps)
+	outm.WithProviderClassHint(azuredevops.Class)
+	outm.WithTriggerEvent(trigger)
+
+	// Convert message for publishing
+	msgID := uuid.New().String()
+	msg := message.NewMessage(msgID, nil)
+	if err := outm.ToMessage(msg); err != nil {
+		return fmt.Errorf("error converting message to protobuf: %w", err)
+	}
+
+	// Publish message
+	l.Debug().Str("msg_id", msgID).Msg("publishing refresh and eval message")
+	if err := m.pub.Publish(constants.TopicQueueRefreshEntityAndEvaluate, msg); err != nil {
+		return fmt.Errorf("error publishing refresh and eval message: %w", err)
+	}
+
+	return nil
+}
+
+func (m *providerClassManager) validateRequest(r *http.Request) error {
+	// Validate the webhook secret
+	tok := r.Header.Get(azuredevops.WebhookTokenHeader)
+	if tok == "" {
+		return fmt.Errorf("missing %s header", azuredevops.WebhookTokenHeader)
+	}
+
+	if err := m.validateToken(tok, r); err != nil {
+		return fmt.Errorf("invalid %s header: %w", azuredevops.WebhookTokenHeader, err)
+	}
+
+	return nil
+}
+
+// validateToken validates the token of the incoming service hook request.
+// The expected token is derived from the secret and the last element of the
+// path of the URL, which is unique per repository.
+func (m *providerClassManager) validateToken(token string, req *http.Request) error {
+	// Extract the unique ID from the URL path
+	path := req.URL.Path
+	uniq := path[strings.LastIndex(path, "/")+1:]
+
+	// uniq must be a valid UUID
+	_, err := uuid.Parse(uniq)
+	if err != nil {
+		return errors.New("invalid unique ID")
+	}
+
+	if valid := webhooksecret.Verify(m.currentWebhookSecret, uniq, token); valid {
+		return nil
+	}
+
+	// Check the previous secrets
+	for _, prev := range m.previousWebhookSecrets {
+		if valid := webhooksecret.Verify(prev, uniq, token); valid {
+			return nil
+		}
+	}
+
+	return errors.New("invalid webhook token")
+}
+
+func decodeJSONSafe[T any](r io.ReadCloser, v *T) error {
+	defer r.Close()
+
+	dec := json.NewDecoder(io.LimitReader(r, MaxBytesLimit))
+	return dec.Decode(v)
+}
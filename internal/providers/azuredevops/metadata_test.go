@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestClassInfo(t *testing.T) {
+	t.Parallel()
+
+	info := ClassInfo()
+	require.NotNil(t, info)
+
+	assert.Equal(t, Class, info.Class)
+	assert.Equal(t, "Azure DevOps", info.DisplayName)
+	assert.NotEmpty(t, info.Description)
+	assert.Equal(t, providerDocsURL, info.DocumentationUrl)
+
+	assert.ElementsMatch(t, []minderv1.AuthorizationFlow{
+		minderv1.AuthorizationFlow_AUTHORIZATION_FLOW_USER_INPUT,
+		minderv1.AuthorizationFlow_AUTHORIZATION_FLOW_OAUTH2_AUTHORIZATION_CODE_FLOW,
+	}, info.SupportedAuthFlows)
+
+	assert.ElementsMatch(t, []minderv1.Entity{
+		minderv1.Entity_ENTITY_REPOSITORIES,
+	}, info.SupportedEntities)
+
+	assert.ElementsMatch(t, []minderv1.ProviderType{
+		minderv1.ProviderType_PROVIDER_TYPE_GIT,
+		minderv1.ProviderType_PROVIDER_TYPE_REST,
+		minderv1.ProviderType_PROVIDER_TYPE_REPO_LISTER,
+	}, info.SupportedProviderTypes)
+}
+
+func TestParseV1Config(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := ParseV1Config([]byte(`{"azure_devops": {"organization": "my-org"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "my-org", cfg.GetOrganization())
+
+	_, err = MarshalV1Config([]byte(`{"azure_devops": {"endpoint": "https://ado.example.com/"}}`))
+	assert.ErrorContains(t, err, "organization is required")
+
+	_, err = ParseV1Config([]byte(`{}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+// Repository Properties
+const (
+	// RepoPropertyProject represents the azure devops project name
+	RepoPropertyProject = "azuredevops/project"
+	// RepoPropertyProjectID represents the azure devops project ID
+	RepoPropertyProjectID = "azuredevops/project_id"
+	// RepoPropertyRepoName represents the azure devops repository name
+	RepoPropertyRepoName = "azuredevops/repo_name"
+	// RepoPropertyDefaultBranch represents the azure devops default branch
+	RepoPropertyDefaultBranch = "azuredevops/default_branch"
+	// RepoPropertyCloneURL represents the azure devops repo clone URL
+	RepoPropertyCloneURL = "azuredevops/clone_url"
+	// RepoPropertyWebURL represents the azure devops repo web URL
+	RepoPropertyWebURL = "azuredevops/web_url"
+	// RepoPropertySubscriptionIDs represents the IDs of the service hook
+	// subscriptions of the repo, separated by commas
+	RepoPropertySubscriptionIDs = "azuredevops/subscription_ids"
+	// RepoPropertyHookURL represents the URL the service hooks of the repo
+	// are sent to
+	RepoPropertyHookURL = "azuredevops/hook_url"
+)
+
+// FetchAllProperties implements the provider interface
+func (c *azureDevOpsClient) FetchAllProperties(
+	ctx context.Context, getByProps *properties.Properties, entType minderv1.Entity, _ *properties.Properties,
+) (*properties.Properties, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, fmt.Errorf("entity type %s not supported", entType)
+	}
+
+	return c.getPropertiesForRepo(ctx, getByProps)
+}
+
+// FetchProperty implements the provider interface. The properties all come
+// from the same request, so it is as costly as fetching all of them.
+func (c *azureDevOpsClient) FetchProperty(
+	ctx context.Context, getByProps *properties.Properties, entType minderv1.Entity, key string,
+) (*properties.Property, error) {
+	props, err := c.FetchAllProperties(ctx, getByProps, entType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching property %s for entity %s: %w", key, entType, err)
+	}
+	prop := props.GetProperty(key)
+	if prop == nil {
+		return nil, errors.New("requested property not found in result")
+	}
+	return prop, nil
+}
+
+// GetEntityName implements the provider interface
+func (c *azureDevOpsClient) GetEntityName(entityType minderv1.Entity, props *properties.Properties) (string, error) {
+	if props == nil {
+		return "", errors.New("properties are nil")
+	}
+
+	if !c.SupportsEntity(entityType) {
+		return "", fmt.Errorf("entity type %s not supported", entityType)
+	}
+
+	return getRepoNameFromProperties(props)
+}
+
+// PropertiesToProtoMessage implements the ProtoMessageConverter interface
+func (c *azureDevOpsClient) PropertiesToProtoMessage(
+	entType minderv1.Entity, props *properties.Properties,
+) (protoreflect.ProtoMessage, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, fmt.Errorf("entity type %s is not supported by the azure devops provider", entType)
+	}
+
+	return repoV1FromProperties(props)
+}
+
+func getStringProp(props *properties.Properties, key string) (string, error) {
+	value, err := props.GetProperty(key).AsString()
+	if err != nil {
+		return "", fmt.Errorf("property %s not found or not a string", key)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	testOrg       = "my-org"
+	testRepoID    = "5febef5a-833d-4e14-b9c0-14cb638f91e6"
+	testProjectID = "6ce954b1-ce1f-45d1-b94d-e6bf2464ba2c"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *azureDevOpsClient {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	c, err := New(
+		credentials.NewAzureDevOpsTokenCredential("test-pat"),
+		&minderv1.AzureDevOpsProviderConfig{Endpoint: srv.URL, Organization: testOrg},
+		"https://minder.example.com/api/v1/webhook/azure-devops",
+		"test-secret",
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func testRepository() *adoRepository {
+	return &adoRepository{
+		ID:   testRepoID,
+		Name: "my-repo",
+		Project: adoProject{
+			ID:         testProjectID,
+			Name:       "My Project",
+			Visibility: "private",
+		},
+		DefaultBranch: "refs/heads/main",
+		RemoteURL:     "https://my-org@dev.azure.com/my-org/My%20Project/_git/my-repo",
+		WebURL:        "https://dev.azure.com/my-org/My%20Project/_git/my-repo",
+	}
+}
+
+func TestGetEntityName(t *testing.T) {
+	t.Parallel()
+
+	c := &azureDevOpsClient{}
+
+	name, err := c.GetEntityName(minderv1.Entity_ENTITY_REPOSITORIES, properties.NewProperties(map[string]any{
+		RepoPropertyProject:  "My Project",
+		RepoPropertyRepoName: "my-repo",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "My Project/my-repo", name)
+
+	_, err = c.GetEntityName(minderv1.Entity_ENTITY_REPOSITORIES, properties.NewProperties(map[string]any{
+		RepoPropertyProject: "My Project",
+	}))
+	assert.Error(t, err)
+
+	_, err = c.GetEntityName(minderv1.Entity_ENTITY_REPOSITORIES, nil)
+	assert.Error(t, err)
+
+	_, err = c.GetEntityName(minderv1.Entity_ENTITY_PULL_REQUESTS, properties.NewProperties(map[string]any{}))
+	assert.Error(t, err)
+}
+
+func TestFetchAllProperties(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		getByProps   map[string]any
+		expectedPath string
+		status       int
+		wantErr      error
+	}{
+		{
+			name: "by upstream ID",
+			getByProps: map[string]any{
+				properties.PropertyUpstreamID: testRepoID,
+			},
+			expectedPath: "/" + testOrg + "/_apis/git/repositories/" + testRepoID,
+			status:       http.StatusOK,
+		},
+		{
+			name: "by name when the upstream ID is not a repository ID",
+			getByProps: map[string]any{
+				properties.PropertyUpstreamID: "0",
+				properties.PropertyName:       "My Project/my-repo",
+			},
+			expectedPath: "/" + testOrg + "/My Project/_apis/git/repositories/my-repo",
+			status:       http.StatusOK,
+		},
+		{
+			name: "not found",
+			getByProps: map[string]any{
+				properties.PropertyUpstreamID: testRepoID,
+			},
+			expectedPath: "/" + testOrg + "/_apis/git/repositories/" + testRepoID,
+			status:       http.StatusNotFound,
+			wantErr:      provifv1.ErrEntityNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.expectedPath, r.URL.Path)
+				assert.Equal(t, defaultAPIVersion, r.URL.Query().Get(apiVersionParam))
+				w.WriteHeader(tt.status)
+				if tt.status == http.StatusOK {
+					assert.NoError(t, json.NewEncoder(w).Encode(testRepository()))
+				}
+			})
+
+			props, err := c.FetchAllProperties(
+				context.Background(), properties.NewProperties(tt.getByProps), minderv1.Entity_ENTITY_REPOSITORIES, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, testRepoID, props.GetProperty(properties.PropertyUpstreamID).GetString())
+			assert.Equal(t, "My Project/my-repo", props.GetProperty(properties.PropertyName).GetString())
+			assert.True(t, props.GetProperty(properties.RepoPropertyIsPrivate).GetBool())
+			assert.Equal(t, testProjectID, props.GetProperty(RepoPropertyProjectID).GetString())
+			assert.Equal(t, "main", props.GetProperty(RepoPropertyDefaultBranch).GetString())
+			assert.Equal(t, "https://dev.azure.com/my-org/My%20Project/_git/my-repo",
+				props.GetProperty(RepoPropertyCloneURL).GetString())
+
+			prop, err := c.FetchProperty(
+				context.Background(), properties.NewProperties(tt.getByProps), minderv1.Entity_ENTITY_REPOSITORIES,
+				RepoPropertyDefaultBranch)
+			require.NoError(t, err)
+			assert.Equal(t, "main", prop.GetString())
+
+			repo, err := c.PropertiesToProtoMessage(minderv1.Entity_ENTITY_REPOSITORIES, props)
+			require.NoError(t, err)
+			pbRepo, ok := repo.(*minderv1.Repository)
+			require.True(t, ok)
+			assert.Equal(t, "My Project", pbRepo.GetOwner())
+			assert.Equal(t, "my-repo", pbRepo.GetName())
+		})
+	}
+}
+
+func TestListAllRepositories(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+testOrg+"/_apis/git/repositories", r.URL.Path)
+		assert.NoError(t, json.NewEncoder(w).Encode(adoList[*adoRepository]{
+			Count: 1,
+			Value: []*adoRepository{testRepository()},
+		}))
+	})
+
+	repos, err := c.ListAllRepositories(context.Background())
+	require.NoError(t, err)
+	require.Len(t, repos, 1)
+	assert.Equal(t, "my-repo", repos[0].GetName())
+	assert.Equal(t, testRepoID, repos[0].GetProperties().AsMap()[properties.PropertyUpstreamID])
+
+	_, _, err = c.ListRepositoriesPage(context.Background(), "2")
+	assert.Error(t, err)
+}
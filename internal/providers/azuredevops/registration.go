@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Service hook event types
+const (
+	// EventTypePush is sent when code is pushed to a repository
+	EventTypePush = "git.push"
+	// EventTypePullRequestCreated is sent when a pull request is created
+	EventTypePullRequestCreated = "git.pullrequest.created"
+	// EventTypePullRequestUpdated is sent when a pull request is updated,
+	// e.g. when commits are pushed to its source branch
+	EventTypePullRequestUpdated = "git.pullrequest.updated"
+)
+
+// WebhookTokenHeader is the header of the service hook requests holding the
+// secret which authenticates them
+const WebhookTokenHeader = "X-Minder-Webhook-Token"
+
+const (
+	// subscriptionsPath is the path of the service hook subscriptions
+	subscriptionsPath = "_apis/hooks/subscriptions"
+	// subscriptionIDsSeparator separates the subscription IDs stored in the
+	// RepoPropertySubscriptionIDs property
+	subscriptionIDsSeparator = ","
+)
+
+// serviceHookEvents are the events of the repositories Minder subscribes to
+var serviceHookEvents = []string{
+	EventTypePush,
+	EventTypePullRequestCreated,
+	EventTypePullRequestUpdated,
+}
+
+// adoSubscription is a service hook subscription, sending the events of a
+// repository to a web hook
+type adoSubscription struct {
+	ID               string            `json:"id,omitempty"`
+	PublisherID      string            `json:"publisherId"`
+	EventType        string            `json:"eventType"`
+	ResourceVersion  string            `json:"resourceVersion"`
+	ConsumerID       string            `json:"consumerId"`
+	ConsumerActionID string            `json:"consumerActionId"`
+	PublisherInputs  map[string]string `json:"publisherInputs"`
+	ConsumerInputs   map[string]string `json:"consumerInputs"`
+}
+
+// RegisterEntity implements the Provider interface
+func (c *azureDevOpsClient) RegisterEntity(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) (*properties.Properties, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, provifv1.ErrUnsupportedEntity
+	}
+
+	upstreamID := props.GetProperty(properties.PropertyUpstreamID).GetString()
+	if upstreamID == "" {
+		return nil, errors.New("missing upstream ID")
+	}
+
+	projectID := props.GetProperty(RepoPropertyProjectID).GetString()
+	if projectID == "" {
+		return nil, errors.New("missing project ID")
+	}
+
+	if err := c.cleanUpStaleSubscriptions(ctx, upstreamID); err != nil {
+		// This is a non-fatal error and may be transient. We log it and
+		// continue with the registration.
+		zerolog.Ctx(ctx).Error().
+			Str("upstreamID", upstreamID).
+			Str("provider-class", Class).
+			Err(err).Msg("failed to clean up stale service hooks")
+	}
+
+	whprops, err := c.createSubscriptions(ctx, projectID, upstreamID)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("upstreamID", upstreamID).
+			Str("provider-class", Class).
+			Err(err).Msg("failed to create service hooks")
+		return nil, errors.New("failed to create service hooks")
+	}
+
+	return props.Merge(whprops), nil
+}
+
+// DeregisterEntity implements the Provider interface
+func (c *azureDevOpsClient) DeregisterEntity(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) error {
+	if !c.SupportsEntity(entType) {
+		return errors.New("unsupported entity type")
+	}
+
+	subscriptionIDs := props.GetProperty(RepoPropertySubscriptionIDs).GetString()
+	if subscriptionIDs == "" {
+		return errors.New("missing subscription IDs")
+	}
+
+	for _, id := range strings.Split(subscriptionIDs, subscriptionIDsSeparator) {
+		if err := c.deleteSubscription(ctx, id); err != nil {
+			// There is already enough context in the error message
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createSubscriptions subscribes to the events of the repository. The
+// subscriptions share a unique URL, from which the secret authenticating
+// their requests is derived.
+func (c *azureDevOpsClient) createSubscriptions(
+	ctx context.Context, projectID, upstreamID string,
+) (*properties.Properties, error) {
+	hookUUID := uuid.New()
+	webhookUniqueURL, err := url.JoinPath(c.webhookURL, hookUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for webhook: %w", err)
+	}
+
+	sec, err := webhooksecret.New(c.currentWebhookSecret, hookUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook secret: %w", err)
+	}
+
+	ids := make([]string, 0, len(serviceHookEvents))
+	for _, event := range serviceHookEvents {
+		sub, err := c.doCreateSubscription(ctx, &adoSubscription{
+			PublisherID:      "tfs",
+			EventType:        event,
+			ResourceVersion:  "1.0",
+			ConsumerID:       "webHooks",
+			ConsumerActionID: "httpRequest",
+			PublisherInputs: map[string]string{
+				"projectId":  projectID,
+				"repository": upstreamID,
+			},
+			ConsumerInputs: map[string]string{
+				"url":         webhookUniqueURL,
+				"httpHeaders": WebhookTokenHeader + ":" + sec,
+			},
+		})
+		if err != nil {
+			// Don't leave the subscriptions already created behind
+			for _, id := range ids {
+				if delErr := c.deleteSubscription(ctx, id); delErr != nil {
+					zerolog.Ctx(ctx).Error().Err(delErr).Str("subscription", id).
+						Msg("failed to delete service hook subscription")
+				}
+			}
+			return nil, fmt.Errorf("failed to create subscription to %s: %w", event, err)
+		}
+		ids = append(ids, sub.ID)
+	}
+
+	outProps := properties.NewProperties(map[string]any{
+		RepoPropertySubscriptionIDs: strings.Join(ids, subscriptionIDsSeparator),
+		RepoPropertyHookURL:         webhookUniqueURL,
+	})
+
+	return outProps, nil
+}
+
+func (c *azureDevOpsClient) doCreateSubscription(
+	ctx context.Context, sreq *adoSubscription,
+) (*adoSubscription, error) {
+	req, err := c.NewRequest(http.MethodPost, subscriptionsPath, sreq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subscription: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	sub := &adoSubscription{}
+	if err := json.NewDecoder(resp.Body).Decode(sub); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return sub, nil
+}
+
+func (c *azureDevOpsClient) deleteSubscription(ctx context.Context, subscriptionID string) error {
+	deletePath, err := url.JoinPath(subscriptionsPath, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to join URL path for subscription: %w", err)
+	}
+
+	req, err := c.NewRequest(http.MethodDelete, deletePath, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to delete subscription: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to delete subscription: unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// cleanUpStaleSubscriptions deletes the subscriptions to the events of the
+// repository left behind by previous registrations
+func (c *azureDevOpsClient) cleanUpStaleSubscriptions(ctx context.Context, upstreamID string) error {
+	subs := adoList[*adoSubscription]{}
+	if err := adoRESTGet(ctx, c, subscriptionsPath, &subs); err != nil {
+		return fmt.Errorf("failed to get subscriptions: %w", err)
+	}
+
+	for _, sub := range subs.Value {
+		if sub.PublisherInputs["repository"] != upstreamID ||
+			!strings.HasPrefix(sub.ConsumerInputs["url"], c.webhookURL) {
+			continue
+		}
+		if err := c.deleteSubscription(ctx, sub.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
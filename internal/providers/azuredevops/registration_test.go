@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	testhelper "github.com/mindersec/minder/pkg/providers/v1/testing"
+)
+
+func TestRegistration(t *testing.T) {
+	t.Parallel()
+	// We don't need a full constructor here, so we're naughty
+	adoc := &azureDevOpsClient{}
+	testhelper.CheckRegistrationExcept(t, adoc, minderv1.Entity_ENTITY_REPOSITORIES)
+}
+
+func TestRegisterEntity(t *testing.T) {
+	t.Parallel()
+
+	subsPath := "/" + testOrg + "/" + subscriptionsPath
+
+	var mu sync.Mutex
+	var created []*adoSubscription
+	var deleted []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == subsPath:
+			// A stale subscription of the repository, and one of another
+			assert.NoError(t, json.NewEncoder(w).Encode(adoList[*adoSubscription]{
+				Value: []*adoSubscription{
+					{
+						ID:              "stale",
+						PublisherInputs: map[string]string{"repository": testRepoID},
+						ConsumerInputs:  map[string]string{"url": "https://minder.example.com/api/v1/webhook/azure-devops/old"},
+					},
+					{
+						ID:              "other",
+						PublisherInputs: map[string]string{"repository": "other-repo"},
+						ConsumerInputs:  map[string]string{"url": "https://minder.example.com/api/v1/webhook/azure-devops/old"},
+					},
+				},
+			}))
+		case r.Method == http.MethodPost && r.URL.Path == subsPath:
+			sub := &adoSubscription{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(sub))
+			sub.ID = fmt.Sprintf("sub-%d", len(created))
+			created = append(created, sub)
+			assert.NoError(t, json.NewEncoder(w).Encode(sub))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, subsPath+"/"):
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, subsPath+"/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	props, err := c.RegisterEntity(ctx, minderv1.Entity_ENTITY_REPOSITORIES, properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: testRepoID,
+		RepoPropertyProjectID:         testProjectID,
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"stale"}, deleted)
+	require.Len(t, created, len(serviceHookEvents))
+	hookURL := props.GetProperty(RepoPropertyHookURL).GetString()
+	assert.True(t, strings.HasPrefix(hookURL, c.webhookURL+"/"))
+	for i, sub := range created {
+		assert.Equal(t, serviceHookEvents[i], sub.EventType)
+		assert.Equal(t, testRepoID, sub.PublisherInputs["repository"])
+		assert.Equal(t, testProjectID, sub.PublisherInputs["projectId"])
+		assert.Equal(t, hookURL, sub.ConsumerInputs["url"])
+		assert.True(t, strings.HasPrefix(sub.ConsumerInputs["httpHeaders"], WebhookTokenHeader+":"))
+	}
+	assert.Equal(t, "sub-0,sub-1,sub-2", props.GetProperty(RepoPropertySubscriptionIDs).GetString())
+
+	deleted = nil
+	require.NoError(t, c.DeregisterEntity(ctx, minderv1.Entity_ENTITY_REPOSITORIES, props))
+	assert.Equal(t, []string{"sub-0", "sub-1", "sub-2"}, deleted)
+}
+
+func TestRegisterEntityFailure(t *testing.T) {
+	t.Parallel()
+
+	subsPath := "/" + testOrg + "/" + subscriptionsPath
+
+	var mu sync.Mutex
+	posts := 0
+	var deleted []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			// Failing to clean up doesn't fail the registration
+			w.WriteHeader(http.StatusInternalServerError)
+		case http.MethodPost:
+			posts++
+			if posts > 1 {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			assert.NoError(t, json.NewEncoder(w).Encode(&adoSubscription{ID: "sub-0"}))
+		case http.MethodDelete:
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, subsPath+"/"))
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	ctx := context.Background()
+	_, err := c.RegisterEntity(ctx, minderv1.Entity_ENTITY_REPOSITORIES, properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: testRepoID,
+		RepoPropertyProjectID:         testProjectID,
+	}))
+	require.Error(t, err)
+	// The subscriptions already created are deleted
+	assert.Equal(t, []string{"sub-0"}, deleted)
+
+	_, err = c.RegisterEntity(ctx, minderv1.Entity_ENTITY_REPOSITORIES, properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: testRepoID,
+	}))
+	require.ErrorContains(t, err, "missing project ID")
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func (c *azureDevOpsClient) ListAllRepositories(ctx context.Context) ([]*minderv1.Repository, error) {
+	repos, _, err := c.ListRepositoriesPage(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	zerolog.Ctx(ctx).Debug().Int("num_repos", len(repos)).Msg("found repositories in azure devops provider")
+
+	return repos, nil
+}
+
+// ListRepositoriesPage lists the repositories of the organization. The
+// Azure DevOps API returns all of them at once, so there is a single page.
+func (c *azureDevOpsClient) ListRepositoriesPage(
+	ctx context.Context, cursor string,
+) ([]*minderv1.Repository, string, error) {
+	if cursor != "" {
+		return nil, "", fmt.Errorf("invalid repository listing cursor: %q", cursor)
+	}
+
+	adoRepos := adoList[*adoRepository]{}
+	if err := adoRESTGet(ctx, c, "_apis/git/repositories", &adoRepos); err != nil {
+		return nil, "", fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	repos := make([]*minderv1.Repository, 0, len(adoRepos.Value))
+	for _, r := range adoRepos.Value {
+		outRep, err := repoV1FromProperties(repositoryToProperties(r))
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to convert properties to repository: %w", err)
+		}
+
+		repos = append(repos, outRep)
+	}
+
+	return repos, "", nil
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+const (
+	// visibilityPrivate is the visibility of private azure devops projects
+	visibilityPrivate = "private"
+	// branchRefPrefix is the prefix of the branch references
+	branchRefPrefix = "refs/heads/"
+)
+
+// adoProject is the project of an azure devops repository
+type adoProject struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Visibility string `json:"visibility"`
+}
+
+// adoRepository is an azure devops git repository
+type adoRepository struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Project       adoProject `json:"project"`
+	DefaultBranch string     `json:"defaultBranch"`
+	RemoteURL     string     `json:"remoteUrl"`
+	WebURL        string     `json:"webUrl"`
+	IsDisabled    bool       `json:"isDisabled"`
+	IsFork        bool       `json:"isFork"`
+}
+
+// adoList is the envelope of the lists returned by the azure devops API
+type adoList[T any] struct {
+	Count int `json:"count"`
+	Value []T `json:"value"`
+}
+
+func (c *azureDevOpsClient) getPropertiesForRepo(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	repo, err := c.getRepositoryByProps(ctx, getByProps)
+	if err != nil {
+		return nil, err
+	}
+
+	return getByProps.Merge(repositoryToProperties(repo)), nil
+}
+
+// getRepositoryByProps fetches the repository by its upstream ID, which is
+// the ID of the repository. Repositories registered by name, e.g. from the
+// repository listing whose numeric IDs don't apply to azure devops, are
+// fetched by their "<project>/<repository>" name instead.
+func (c *azureDevOpsClient) getRepositoryByProps(
+	ctx context.Context, getByProps *properties.Properties,
+) (*adoRepository, error) {
+	var repoPath string
+	var err error
+
+	upstreamID := getByProps.GetProperty(properties.PropertyUpstreamID).GetString()
+	if _, parseErr := uuid.Parse(upstreamID); parseErr == nil {
+		repoPath, err = url.JoinPath("_apis/git/repositories", upstreamID)
+	} else {
+		name := getByProps.GetProperty(properties.PropertyName).GetString()
+		project, repoName, ok := strings.Cut(name, "/")
+		if !ok || project == "" || repoName == "" {
+			return nil, errors.New("upstream ID or name in the <project>/<repository> format is required")
+		}
+		repoPath, err = url.JoinPath(url.PathEscape(project), "_apis/git/repositories", url.PathEscape(repoName))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for repository: %w", err)
+	}
+
+	repo := &adoRepository{}
+	if err := adoRESTGet(ctx, c, repoPath, repo); err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+func repositoryToProperties(repo *adoRepository) *properties.Properties {
+	return properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:     repo.ID,
+		properties.PropertyName:           formatRepoName(repo.Project.Name, repo.Name),
+		properties.RepoPropertyIsPrivate:  repo.Project.Visibility == visibilityPrivate,
+		properties.RepoPropertyIsArchived: repo.IsDisabled,
+		properties.RepoPropertyIsFork:     repo.IsFork,
+		RepoPropertyProject:               repo.Project.Name,
+		RepoPropertyProjectID:             repo.Project.ID,
+		RepoPropertyRepoName:              repo.Name,
+		RepoPropertyDefaultBranch:         strings.TrimPrefix(repo.DefaultBranch, branchRefPrefix),
+		RepoPropertyCloneURL:              cloneURL(repo.RemoteURL),
+		RepoPropertyWebURL:                repo.WebURL,
+	})
+}
+
+// cloneURL drops the user name azure devops adds to the remote URLs
+// (https://<organization>@dev.azure.com/...), as the credentials are
+// provided separately.
+func cloneURL(remoteURL string) string {
+	u, err := url.Parse(remoteURL)
+	if err != nil {
+		return remoteURL
+	}
+	u.User = nil
+	return u.String()
+}
+
+func repoV1FromProperties(repoProperties *properties.Properties) (*minderv1.Repository, error) {
+	name, err := repoProperties.GetProperty(RepoPropertyRepoName).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching repo_name property: %w", err)
+	}
+
+	project, err := repoProperties.GetProperty(RepoPropertyProject).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching project property: %w", err)
+	}
+
+	isPrivate, err := repoProperties.GetProperty(properties.RepoPropertyIsPrivate).AsBool()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching is_private property: %w", err)
+	}
+
+	isFork, err := repoProperties.GetProperty(properties.RepoPropertyIsFork).AsBool()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching is_fork property: %w", err)
+	}
+
+	// Azure DevOps repositories are identified by UUIDs, which don't fit
+	// in RepoId: the ID is available in the upstream_id property instead.
+	pbRepo := &minderv1.Repository{
+		Name:          name,
+		Owner:         project,
+		CloneUrl:      repoProperties.GetProperty(RepoPropertyCloneURL).GetString(),
+		IsPrivate:     isPrivate,
+		IsFork:        isFork,
+		DefaultBranch: repoProperties.GetProperty(RepoPropertyDefaultBranch).GetString(),
+		Properties:    repoProperties.ToProtoStruct(),
+	}
+
+	return pbRepo, nil
+}
+
+func getRepoNameFromProperties(props *properties.Properties) (string, error) {
+	project, err := getStringProp(props, RepoPropertyProject)
+	if err != nil {
+		return "", err
+	}
+
+	repoName, err := getStringProp(props, RepoPropertyRepoName)
+	if err != nil {
+		return "", err
+	}
+
+	return formatRepoName(project, repoName), nil
+}
+
+func formatRepoName(project, repoName string) string {
+	return project + "/" + repoName
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package credentials
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"golang.org/x/oauth2"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// AzureDevOpsTokenCredential is a credential that uses either an Azure DevOps
+// Personal Access Token or a Microsoft Entra ID access token
+type AzureDevOpsTokenCredential struct {
+	token string
+}
+
+// Ensure that the AzureDevOpsTokenCredential implements the AzureDevOpsCredential interface
+var _ provifv1.AzureDevOpsCredential = (*AzureDevOpsTokenCredential)(nil)
+
+// NewAzureDevOpsTokenCredential creates a new AzureDevOpsTokenCredential from the token
+func NewAzureDevOpsTokenCredential(token string) *AzureDevOpsTokenCredential {
+	return &AzureDevOpsTokenCredential{
+		token: token,
+	}
+}
+
+// IsAzureDevOpsPAT returns true if the token is an Azure DevOps Personal Access
+// Token rather than a Microsoft Entra ID access token. Entra ID access tokens
+// are JWTs, made of three dot-separated parts, while PATs are opaque strings.
+func IsAzureDevOpsPAT(token string) bool {
+	return token != "" && strings.Count(token, ".") != 2
+}
+
+// SetAuthorizationHeader sets the authorization header on the request.
+// PATs use basic authentication with an empty user name, and Entra ID access
+// tokens are bearer tokens.
+func (t *AzureDevOpsTokenCredential) SetAuthorizationHeader(req *http.Request) {
+	if IsAzureDevOpsPAT(t.token) {
+		req.SetBasicAuth("", t.token)
+		return
+	}
+	req.Header.Set("Authorization", "Bearer "+t.token)
+}
+
+// AddToPushOptions adds the credential to the git push options
+func (t *AzureDevOpsTokenCredential) AddToPushOptions(options *git.PushOptions, owner string) {
+	options.Auth = t.gitAuth(owner)
+}
+
+// AddToCloneOptions adds the credential to the git clone options
+func (t *AzureDevOpsTokenCredential) AddToCloneOptions(options *git.CloneOptions) {
+	// the username can be anything, but it can't be empty
+	options.Auth = t.gitAuth("minder-user")
+}
+
+func (t *AzureDevOpsTokenCredential) gitAuth(username string) githttp.AuthMethod {
+	if IsAzureDevOpsPAT(t.token) {
+		return &githttp.BasicAuth{
+			Username: username,
+			Password: t.token,
+		}
+	}
+	return &githttp.TokenAuth{
+		Token: t.token,
+	}
+}
+
+// GetAsOAuth2TokenSource returns the token as an OAuth2 token source
+func (t *AzureDevOpsTokenCredential) GetAsOAuth2TokenSource() oauth2.TokenSource {
+	return oauth2.StaticTokenSource(
+		&oauth2.Token{AccessToken: t.token},
+	)
+}
+
+// GetCredential implements the DirectCredential interface
+func (t *AzureDevOpsTokenCredential) GetCredential() string {
+	return t.token
+}
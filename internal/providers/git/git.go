@@ -8,13 +8,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"os"
 
+	"github.com/go-git/go-billy/v5"
 	"github.com/go-git/go-billy/v5/memfs"
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/transport"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
 	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/ssh"
 
 	"github.com/mindersec/minder/internal/providers/git/memboxfs"
 	"github.com/mindersec/minder/pkg/config/server"
@@ -28,6 +39,10 @@ type Git struct {
 	credential provifv1.GitCredential
 	maxFiles   int64
 	maxBytes   int64
+	mirrors    *MirrorCache
+	// knownHostsFile verifies the host keys of SSH remotes, the default
+	// known_hosts files are used when empty
+	knownHostsFile string
 }
 
 const maxCachedObjectSize = 100 * 1024 // 100KiB
@@ -51,41 +66,102 @@ func WithConfig(cfg server.GitConfig) Options {
 	return func(g *Git) {
 		g.maxFiles = cfg.MaxFiles
 		g.maxBytes = cfg.MaxBytes
+		g.knownHostsFile = cfg.SSHKnownHostsFile
+		if cfg.MirrorCache.Dir == "" {
+			return
+		}
+		mirrors, err := mirrorCacheFor(cfg.MirrorCache.Dir, cfg.MirrorCache.MaxBytes)
+		if err != nil {
+			// clones still work, they are just not shared
+			log.Err(err).Str("dir", cfg.MirrorCache.Dir).Msg("could not open git mirror cache")
+			return
+		}
+		g.mirrors = mirrors
 	}
 }
 
 // Clone clones a git repository
 func (g *Git) Clone(ctx context.Context, url, branch string) (*git.Repository, error) {
-	opts := &git.CloneOptions{
+	opts := cloneOptions(url, branch)
+	g.credential.AddToCloneOptions(opts)
+
+	return g.clone(ctx, opts)
+}
+
+// CloneWithSSHKey clones a git repository over SSH, authenticating with the
+// given key, such as a deploy key of a project, instead of the credential of
+// the provider. The host key of the remote must be in the known hosts.
+func (g *Git) CloneWithSSHKey(ctx context.Context, url, branch string, key ssh.Signer) (*git.Repository, error) {
+	hostKeyCallback, err := g.sshHostKeyCallback()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := cloneOptions(url, branch)
+	opts.Auth = &gitssh.PublicKeys{
+		User:   gitssh.DefaultUsername,
+		Signer: key,
+		HostKeyCallbackHelper: gitssh.HostKeyCallbackHelper{
+			HostKeyCallback: hostKeyCallback,
+		},
+	}
+
+	return g.clone(ctx, opts)
+}
+
+// cloneOptions returns the options of a shallow clone of a single branch
+func cloneOptions(url, branch string) *git.CloneOptions {
+	return &git.CloneOptions{
 		URL:           url,
 		SingleBranch:  true,
 		Depth:         1,
 		Tags:          git.NoTags,
 		ReferenceName: plumbing.NewBranchReferenceName(branch),
 	}
+}
 
-	g.credential.AddToCloneOptions(opts)
+func (g *Git) sshHostKeyCallback() (ssh.HostKeyCallback, error) {
+	var files []string
+	if g.knownHostsFile != "" {
+		files = append(files, g.knownHostsFile)
+	}
+	// without files, the SSH_KNOWN_HOSTS environment variable or the
+	// known_hosts files of the user and of the system are used
+	callback, err := gitssh.NewKnownHostsCallback(files...)
+	if err != nil {
+		return nil, fmt.Errorf("could not load ssh known hosts: %w", err)
+	}
+	return callback, nil
+}
 
+func (g *Git) clone(ctx context.Context, opts *git.CloneOptions) (*git.Repository, error) {
 	if err := opts.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid clone options: %w", err)
 	}
 
+	filter := provifv1.CloneFilterFromContext(ctx)
+	if filter.FiltersCheckout() {
+		// the files passing the filter are checked out once cloned
+		opts.NoCheckout = true
+	}
+	maxFiles, maxBytes := g.cloneLimits(filter)
+
 	// TODO(#3582): Switch this to use a tmpfs backed clone
 	memFS := memfs.New()
-	if g.maxFiles != 0 && g.maxBytes != 0 {
+	if maxFiles != 0 && maxBytes != 0 {
 		memFS = &memboxfs.LimitedFs{
 			Fs:            memFS,
-			MaxFiles:      g.maxFiles,
-			TotalFileSize: g.maxBytes,
+			MaxFiles:      maxFiles,
+			TotalFileSize: maxBytes,
 		}
 	}
 	// go-git seems to want separate filesystems for the storer and the checked out files
 	storerFs := memfs.New()
-	if g.maxFiles != 0 && g.maxBytes != 0 {
+	if maxFiles != 0 && maxBytes != 0 {
 		storerFs = &memboxfs.LimitedFs{
 			Fs:            storerFs,
-			MaxFiles:      g.maxFiles,
-			TotalFileSize: g.maxBytes,
+			MaxFiles:      maxFiles,
+			TotalFileSize: maxBytes,
 		}
 	}
 	storerCache := cache.NewObjectLRU(maxCachedObjectSize)
@@ -95,7 +171,13 @@ func (g *Git) Clone(ctx context.Context, url, branch string) (*git.Repository, e
 	// allow for direct access to the underlying filesystem. This is
 	// because we want to be able to run this in a sandboxed environment
 	// where we don't have access to the underlying filesystem.
-	r, err := git.CloneContext(ctx, storer, memFS, opts)
+	var r *git.Repository
+	var err error
+	if g.mirrors != nil {
+		r, err = g.mirrors.Clone(ctx, storer, memFS, opts)
+	} else {
+		r, err = git.CloneContext(ctx, storer, memFS, opts)
+	}
 	if err != nil {
 		var refspecerr git.NoMatchingRefSpecError
 		if errors.Is(err, git.ErrBranchNotFound) || refspecerr.Is(err) {
@@ -110,5 +192,128 @@ func (g *Git) Clone(ctx context.Context, url, branch string) (*git.Repository, e
 		return nil, fmt.Errorf("could not clone repo: %w", err)
 	}
 
+	if filter.FiltersCheckout() {
+		if err := checkoutFiltered(r, filter); err != nil {
+			if errors.Is(err, memboxfs.ErrTooManyFiles) || errors.Is(err, memboxfs.ErrTooBig) {
+				return nil, fmt.Errorf("%w: %w", provifv1.ErrRepositoryTooLarge, err)
+			}
+			return nil, err
+		}
+	}
+
 	return r, nil
 }
+
+// cloneLimits returns the limits on the number of files and bytes of a
+// clone, lowering the byte limit of the server to the one of the filter.
+func (g *Git) cloneLimits(filter *provifv1.CloneFilter) (int64, int64) {
+	maxFiles, maxBytes := g.maxFiles, g.maxBytes
+	if filter == nil || filter.MaxBytes == 0 {
+		return maxFiles, maxBytes
+	}
+	if maxBytes == 0 || filter.MaxBytes < maxBytes {
+		maxBytes = filter.MaxBytes
+	}
+	if maxFiles == 0 {
+		maxFiles = math.MaxInt64
+	}
+	return maxFiles, maxBytes
+}
+
+// checkoutFiltered checks out the files of the head commit of a repository
+// cloned without checkout which pass the filter. Unlike a sparse checkout of
+// go-git, single files can be selected and binary files are left out.
+func checkoutFiltered(r *git.Repository, filter *provifv1.CloneFilter) error {
+	head, err := r.Head()
+	if err != nil {
+		return fmt.Errorf("could not get head: %w", err)
+	}
+	commit, err := r.CommitObject(head.Hash())
+	if err != nil {
+		return fmt.Errorf("could not get head commit: %w", err)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return fmt.Errorf("could not get head tree: %w", err)
+	}
+	wt, err := r.Worktree()
+	if err != nil {
+		return fmt.Errorf("could not get worktree: %w", err)
+	}
+
+	return tree.Files().ForEach(func(f *object.File) error {
+		if !filter.InSparsePaths(f.Name) {
+			return nil
+		}
+		if filter.SkipBinaries {
+			binary, err := f.IsBinary()
+			if err != nil {
+				return fmt.Errorf("could not read %s: %w", f.Name, err)
+			}
+			if binary {
+				return nil
+			}
+		}
+		return checkoutFile(wt.Filesystem, f)
+	})
+}
+
+func checkoutFile(fs billy.Filesystem, f *object.File) error {
+	if f.Mode == filemode.Symlink {
+		target, err := f.Contents()
+		if err != nil {
+			return fmt.Errorf("could not read %s: %w", f.Name, err)
+		}
+		return fs.Symlink(target, f.Name)
+	}
+
+	mode, err := f.Mode.ToOSFileMode()
+	if err != nil {
+		return fmt.Errorf("invalid mode of %s: %w", f.Name, err)
+	}
+	reader, err := f.Reader()
+	if err != nil {
+		return fmt.Errorf("could not read %s: %w", f.Name, err)
+	}
+	defer reader.Close()
+
+	out, err := fs.OpenFile(f.Name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm())
+	if err != nil {
+		return fmt.Errorf("could not create %s: %w", f.Name, err)
+	}
+	if _, err := io.Copy(out, reader); err != nil {
+		_ = out.Close()
+		return fmt.Errorf("could not write %s: %w", f.Name, err)
+	}
+	return out.Close()
+}
+
+// ResolveBranch returns the hash of the commit at the head of a branch of a
+// git repository. Only the references of the remote are listed, so nothing
+// is cloned.
+func (g *Git) ResolveBranch(ctx context.Context, url, branch string) (string, error) {
+	// the credential only knows how to authorize clones, so its
+	// authorization is borrowed from a set of clone options
+	cloneOpts := &git.CloneOptions{URL: url}
+	g.credential.AddToCloneOptions(cloneOpts)
+
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{url},
+	})
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: cloneOpts.Auth})
+	if err != nil {
+		if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+			return "", provifv1.ErrRepositoryEmpty
+		}
+		return "", fmt.Errorf("could not list remote references: %w", err)
+	}
+
+	name := plumbing.NewBranchReferenceName(branch)
+	for _, ref := range refs {
+		if ref.Name() == name {
+			return ref.Hash().String(), nil
+		}
+	}
+	return "", provifv1.ErrProviderGitBranchNotFound
+}
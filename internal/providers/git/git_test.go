@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	"github.com/mindersec/minder/pkg/config/server"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// newTestRepository creates a repository with a workflow, a text file and
+// a binary file, and returns its url
+func newTestRepository(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	r, err := git.PlainInitWithOptions(dir, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: "refs/heads/main"},
+	})
+	require.NoError(t, err)
+
+	files := map[string][]byte{
+		".github/workflows/ci.yml": []byte("on: push\n"),
+		"README.md":                []byte("# test\n"),
+		"bin/tool":                 {0x7f, 'E', 'L', 'F', 0x00, 0x01},
+	}
+	wt, err := r.Worktree()
+	require.NoError(t, err)
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), content, 0o600))
+		_, err := wt.Add(name)
+		require.NoError(t, err)
+	}
+	_, err = wt.Commit("initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	return "file://" + dir
+}
+
+func TestCloneWithFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		filter     *provifv1.CloneFilter
+		mirrors    bool
+		wantFiles  []string
+		wantAbsent []string
+		wantErr    error
+	}{
+		{
+			name:      "no filter",
+			wantFiles: []string{".github/workflows/ci.yml", "README.md", "bin/tool"},
+		},
+		{
+			name:       "sparse paths",
+			filter:     &provifv1.CloneFilter{SparsePaths: []string{"/.github"}},
+			wantFiles:  []string{".github/workflows/ci.yml"},
+			wantAbsent: []string{"README.md", "bin/tool"},
+		},
+		{
+			name:       "sparse file",
+			filter:     &provifv1.CloneFilter{SparsePaths: []string{"README.md"}},
+			wantFiles:  []string{"README.md"},
+			wantAbsent: []string{".github/workflows/ci.yml", "bin/tool"},
+		},
+		{
+			name:       "skip binaries",
+			filter:     &provifv1.CloneFilter{SkipBinaries: true},
+			wantFiles:  []string{".github/workflows/ci.yml", "README.md"},
+			wantAbsent: []string{"bin/tool"},
+		},
+		{
+			name:       "skip binaries through the mirror cache",
+			filter:     &provifv1.CloneFilter{SkipBinaries: true},
+			mirrors:    true,
+			wantFiles:  []string{".github/workflows/ci.yml", "README.md"},
+			wantAbsent: []string{"bin/tool"},
+		},
+		{
+			name:    "too large for the filter",
+			filter:  &provifv1.CloneFilter{MaxBytes: 10},
+			wantErr: provifv1.ErrRepositoryTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := server.GitConfig{MaxFiles: 100, MaxBytes: 1_000_000}
+			if tt.mirrors {
+				cfg.MirrorCache = server.GitMirrorCacheConfig{Dir: t.TempDir(), MaxBytes: 10_000_000}
+			}
+			g := NewGit(credentials.NewEmptyCredential(), WithConfig(cfg))
+
+			ctx := context.Background()
+			if tt.filter != nil {
+				ctx = provifv1.WithCloneFilter(ctx, tt.filter)
+			}
+			r, err := g.Clone(ctx, newTestRepository(t), "main")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			head, err := r.Head()
+			require.NoError(t, err)
+			require.Equal(t, "refs/heads/main", head.Name().String())
+
+			wt, err := r.Worktree()
+			require.NoError(t, err)
+			for _, name := range tt.wantFiles {
+				_, err := wt.Filesystem.Stat(name)
+				require.NoError(t, err, "expected %s to be checked out", name)
+			}
+			for _, name := range tt.wantAbsent {
+				_, err := wt.Filesystem.Stat(name)
+				require.ErrorIs(t, err, os.ErrNotExist, "expected %s not to be checked out", name)
+			}
+		})
+	}
+}
@@ -0,0 +1,395 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/go-git/go-git/v5/storage"
+)
+
+// MirrorCache is a bounded on-disk cache of bare mirrors of remote
+// repositories. Clones are made from the mirror of the repository, which is
+// only fetched the commits it is missing, so that the rules evaluating a
+// repository share a single download of it rather than cloning it each.
+//
+// The mirror is always fetched with the credentials of the clone before it
+// is used, so a clone never reads from a mirror that its credentials could
+// not have fetched.
+type MirrorCache struct {
+	dir      string
+	maxBytes int64
+
+	mu      sync.Mutex
+	mirrors map[string]*mirror
+}
+
+// mirror is a bare repository of the cache. The lock of the mirror is held
+// while it is fetched into and cloned from, and uses counts the holders and
+// waiters of the lock, which keeps the mirror from being evicted.
+type mirror struct {
+	path     string
+	lock     chan struct{}
+	uses     int
+	lastUsed time.Time
+	size     int64
+}
+
+var (
+	mirrorCachesMu sync.Mutex
+	mirrorCaches   = map[string]*MirrorCache{}
+)
+
+// mirrorCacheFor returns the mirror cache held in the given directory. The
+// cache is shared by all the clients configured with the directory.
+func mirrorCacheFor(dir string, maxBytes int64) (*MirrorCache, error) {
+	mirrorCachesMu.Lock()
+	defer mirrorCachesMu.Unlock()
+
+	if c, ok := mirrorCaches[dir]; ok {
+		return c, nil
+	}
+	c, err := NewMirrorCache(dir, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	mirrorCaches[dir] = c
+	return c, nil
+}
+
+// NewMirrorCache creates a mirror cache in the given directory, bounded to
+// maxBytes on disk. Mirrors left in the directory by a previous process are
+// reused.
+func NewMirrorCache(dir string, maxBytes int64) (*MirrorCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("could not create mirror cache directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read mirror cache directory: %w", err)
+	}
+
+	c := &MirrorCache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		mirrors:  make(map[string]*mirror, len(entries)),
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		m := newMirror(filepath.Join(dir, entry.Name()))
+		m.lastUsed = info.ModTime()
+		m.size, _ = dirSize(m.path)
+		c.mirrors[entry.Name()] = m
+	}
+
+	c.mu.Lock()
+	c.evict()
+	c.mu.Unlock()
+
+	return c, nil
+}
+
+func newMirror(path string) *mirror {
+	return &mirror{
+		path: path,
+		lock: make(chan struct{}, 1),
+	}
+}
+
+// Clone clones the branch of the repository described by the options into
+// the given storer and worktree, going through the mirror of the repository.
+// Like a clone of depth one, only the head commit of the branch is copied.
+func (c *MirrorCache) Clone(
+	ctx context.Context, dst storage.Storer, worktree billy.Filesystem, opts *git.CloneOptions,
+) (*git.Repository, error) {
+	m, err := c.acquire(ctx, opts.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer c.release(m)
+
+	src, err := openMirror(m.path, opts.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	refSpec := config.RefSpec(fmt.Sprintf("+%s:%s", opts.ReferenceName, opts.ReferenceName))
+	err = src.FetchContext(ctx, &git.FetchOptions{
+		RemoteURL: opts.URL,
+		RefSpecs:  []config.RefSpec{refSpec},
+		Depth:     opts.Depth,
+		Tags:      git.NoTags,
+		Auth:      opts.Auth,
+		Force:     true,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return nil, err
+	}
+
+	head, err := src.Reference(opts.ReferenceName, true)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve mirrored branch: %w", err)
+	}
+
+	return checkoutFromMirror(src.Storer, dst, worktree, opts.URL, opts.ReferenceName, head.Hash(), opts.NoCheckout)
+}
+
+// acquire locks the mirror of the repository, creating it if needed.
+func (c *MirrorCache) acquire(ctx context.Context, url string) (*mirror, error) {
+	key := mirrorKey(url)
+
+	c.mu.Lock()
+	m, ok := c.mirrors[key]
+	if !ok {
+		m = newMirror(filepath.Join(c.dir, key))
+		c.mirrors[key] = m
+	}
+	m.uses++
+	c.mu.Unlock()
+
+	select {
+	case m.lock <- struct{}{}:
+		return m, nil
+	case <-ctx.Done():
+		c.mu.Lock()
+		m.uses--
+		c.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// release unlocks the mirror, recording its new size and evicting the least
+// recently used mirrors if the cache went beyond its bound.
+func (c *MirrorCache) release(m *mirror) {
+	size, sizeErr := dirSize(m.path)
+	<-m.lock
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m.uses--
+	m.lastUsed = time.Now()
+	if sizeErr == nil {
+		m.size = size
+	}
+	c.evict()
+}
+
+// evict removes the least recently used mirrors which are not in use until
+// the cache fits within its bound. It must be called with the cache locked.
+func (c *MirrorCache) evict() {
+	var total int64
+	idle := make([]string, 0, len(c.mirrors))
+	for key, m := range c.mirrors {
+		total += m.size
+		if m.uses == 0 {
+			idle = append(idle, key)
+		}
+	}
+	if total <= c.maxBytes {
+		return
+	}
+
+	slices.SortFunc(idle, func(a, b string) int {
+		return c.mirrors[a].lastUsed.Compare(c.mirrors[b].lastUsed)
+	})
+	for _, key := range idle {
+		if total <= c.maxBytes {
+			return
+		}
+		m := c.mirrors[key]
+		if err := os.RemoveAll(m.path); err != nil {
+			continue
+		}
+		delete(c.mirrors, key)
+		total -= m.size
+	}
+}
+
+func mirrorKey(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(sum[:])
+}
+
+// openMirror opens the bare repository of a mirror, initializing it when it
+// does not exist or cannot be read.
+func openMirror(path, url string) (*git.Repository, error) {
+	r, err := git.PlainOpen(path)
+	if err == nil {
+		return r, nil
+	}
+
+	if err := os.RemoveAll(path); err != nil {
+		return nil, fmt.Errorf("could not reset mirror: %w", err)
+	}
+	r, err = git.PlainInit(path, true)
+	if err != nil {
+		return nil, fmt.Errorf("could not create mirror: %w", err)
+	}
+	if _, err := r.CreateRemote(&config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{url},
+	}); err != nil {
+		return nil, fmt.Errorf("could not configure mirror: %w", err)
+	}
+	return r, nil
+}
+
+// checkoutFromMirror copies a commit and its tree from the mirror into the
+// storer of the clone, and checks the commit out into the worktree unless
+// noCheckout is set, leaving the repository as a shallow clone of the branch
+// would.
+func checkoutFromMirror(
+	src, dst storage.Storer, worktree billy.Filesystem,
+	url string, branch plumbing.ReferenceName, hash plumbing.Hash, noCheckout bool,
+) (*git.Repository, error) {
+	r, err := git.Init(dst, worktree)
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize clone: %w", err)
+	}
+	if _, err := r.CreateRemote(&config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{url},
+	}); err != nil {
+		return nil, fmt.Errorf("could not configure clone: %w", err)
+	}
+
+	if err := copyCommit(src, dst, hash); err != nil {
+		return nil, err
+	}
+	if err := dst.SetShallow([]plumbing.Hash{hash}); err != nil {
+		return nil, fmt.Errorf("could not set shallow commit: %w", err)
+	}
+
+	remoteRef := plumbing.NewRemoteReferenceName(git.DefaultRemoteName, branch.Short())
+	if err := dst.SetReference(plumbing.NewHashReference(remoteRef, hash)); err != nil {
+		return nil, fmt.Errorf("could not set remote reference: %w", err)
+	}
+	if err := dst.SetReference(plumbing.NewHashReference(branch, hash)); err != nil {
+		return nil, fmt.Errorf("could not set branch reference: %w", err)
+	}
+	if err := r.CreateBranch(&config.Branch{
+		Name:   branch.Short(),
+		Remote: git.DefaultRemoteName,
+		Merge:  branch,
+	}); err != nil {
+		return nil, fmt.Errorf("could not configure branch: %w", err)
+	}
+
+	if noCheckout {
+		if err := dst.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, branch)); err != nil {
+			return nil, fmt.Errorf("could not set head: %w", err)
+		}
+		return r, nil
+	}
+
+	wt, err := r.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get worktree: %w", err)
+	}
+	if err := wt.Checkout(&git.CheckoutOptions{Branch: branch, Force: true}); err != nil {
+		return nil, fmt.Errorf("could not check out branch: %w", err)
+	}
+
+	return r, nil
+}
+
+// copyCommit copies a commit, its tree and the objects of its tree from one
+// storer to another. Submodules are not part of the repository and are
+// skipped, as a clone would.
+func copyCommit(src, dst storer.EncodedObjectStorer, hash plumbing.Hash) error {
+	commit, err := object.GetCommit(src, hash)
+	if err != nil {
+		return fmt.Errorf("could not read mirrored commit: %w", err)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return fmt.Errorf("could not read mirrored tree: %w", err)
+	}
+
+	if err := copyObject(src, dst, commit.Hash); err != nil {
+		return err
+	}
+	if err := copyObject(src, dst, tree.Hash); err != nil {
+		return err
+	}
+
+	seen := map[plumbing.Hash]struct{}{}
+	walker := object.NewTreeWalker(tree, true, nil)
+	defer walker.Close()
+	for {
+		_, entry, err := walker.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("could not walk mirrored tree: %w", err)
+		}
+		if entry.Mode == filemode.Submodule {
+			continue
+		}
+		if _, ok := seen[entry.Hash]; ok {
+			continue
+		}
+		seen[entry.Hash] = struct{}{}
+		if err := copyObject(src, dst, entry.Hash); err != nil {
+			return err
+		}
+	}
+}
+
+func copyObject(src, dst storer.EncodedObjectStorer, hash plumbing.Hash) error {
+	obj, err := src.EncodedObject(plumbing.AnyObject, hash)
+	if err != nil {
+		return fmt.Errorf("could not read mirrored object %s: %w", hash, err)
+	}
+	if _, err := dst.SetEncodedObject(obj); err != nil {
+		return fmt.Errorf("could not copy object %s: %w", hash, err)
+	}
+	return nil
+}
+
+// dirSize returns the total size of the files under a directory.
+func dirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
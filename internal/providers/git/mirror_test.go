@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	"github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestNewMirrorCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := time.Now()
+	for i, name := range []string{"oldest", "older", "newest"} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.Mkdir(path, 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "pack"), make([]byte, 100), 0o600))
+		modTime := now.Add(time.Duration(i-3) * time.Hour)
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	c, err := NewMirrorCache(dir, 150)
+	require.NoError(t, err)
+
+	require.Len(t, c.mirrors, 1)
+	require.Contains(t, c.mirrors, "newest")
+	require.NoDirExists(t, filepath.Join(dir, "oldest"))
+	require.NoDirExists(t, filepath.Join(dir, "older"))
+	require.DirExists(t, filepath.Join(dir, "newest"))
+}
+
+func TestMirrorCacheDoesNotEvictMirrorsInUse(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	c, err := NewMirrorCache(dir, 0)
+	require.NoError(t, err)
+
+	m, err := c.acquire(context.Background(), "https://example.com/repo.git")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(m.path, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(m.path, "pack"), make([]byte, 100), 0o600))
+
+	c.mu.Lock()
+	m.size = 100
+	c.evict()
+	c.mu.Unlock()
+	require.DirExists(t, m.path)
+
+	c.release(m)
+	require.NoDirExists(t, m.path)
+	require.Empty(t, c.mirrors)
+}
+
+func TestMirrorCacheAcquireHonorsContext(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewMirrorCache(t.TempDir(), 1_000)
+	require.NoError(t, err)
+
+	m, err := c.acquire(context.Background(), "https://example.com/repo.git")
+	require.NoError(t, err)
+	defer c.release(m)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.acquire(ctx, "https://example.com/repo.git")
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, m.uses)
+}
+
+func TestCloneThroughMirrorCache(t *testing.T) {
+	t.Parallel()
+
+	cfg := server.GitConfig{
+		MaxFiles: 100,
+		MaxBytes: 1_000_000,
+		MirrorCache: server.GitMirrorCacheConfig{
+			Dir:      t.TempDir(),
+			MaxBytes: 10_000_000,
+		},
+	}
+	g := NewGit(credentials.NewEmptyCredential(), WithConfig(cfg))
+	require.NotNil(t, g.mirrors)
+
+	url := newTestRepository(t)
+	for range 2 {
+		r, err := g.Clone(context.Background(), url, "main")
+		require.NoError(t, err)
+
+		head, err := r.Head()
+		require.NoError(t, err)
+		require.Equal(t, "refs/heads/main", head.Name().String())
+
+		wt, err := r.Worktree()
+		require.NoError(t, err)
+		_, err = wt.Filesystem.Stat("README.md")
+		require.NoError(t, err)
+	}
+	require.Len(t, g.mirrors.mirrors, 1)
+}
@@ -244,31 +244,28 @@ func (g *GitHubAppDelegate) IsOrg() bool {
 
 // ListAllRepositories returns a list of all repositories accessible to the GitHub App installation
 func (g *GitHubAppDelegate) ListAllRepositories(ctx context.Context) ([]*minderv1.Repository, error) {
-	listOpt := &gogithub.ListOptions{
-		PerPage: 100,
-	}
-
-	// create a slice to hold the repositories
-	var allRepos []*gogithub.Repository
-	for {
-		var repos *gogithub.ListRepositories
-		var resp *gogithub.Response
-		var err error
-
-		repos, resp, err = g.client.Apps.ListRepos(ctx, listOpt)
+	return ghcommon.ListAllRepositories(ctx, g.ListRepositoriesPage)
+}
 
-		if err != nil {
-			return ghcommon.ConvertRepositories(allRepos), fmt.Errorf("error listing repositories: %w", err)
-		}
-		allRepos = append(allRepos, repos.Repositories...)
-		if resp.NextPage == 0 {
-			break
-		}
+// ListRepositoriesPage returns the page of the repositories accessible to the
+// GitHub App installation the cursor points to, and the cursor of the next page
+func (g *GitHubAppDelegate) ListRepositoriesPage(
+	ctx context.Context, cursor string,
+) ([]*minderv1.Repository, string, error) {
+	page, err := ghcommon.PageFromCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
 
-		listOpt.Page = resp.NextPage
+	repos, resp, err := g.client.Apps.ListRepos(ctx, &gogithub.ListOptions{
+		PerPage: 100,
+		Page:    page,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("error listing repositories: %w", err)
 	}
 
-	return ghcommon.ConvertRepositories(allRepos), nil
+	return ghcommon.ConvertRepositories(repos.Repositories), ghcommon.CursorFromPage(resp.NextPage), nil
 }
 
 // GetUserId returns the user id for the GitHub App user
@@ -150,48 +150,40 @@ func (o *GitHubOAuthDelegate) IsOrg() bool {
 // ListAllRepositories returns a list of all repositories for the authenticated user
 // Two APIs are available, contigent on whether the token is for a user or an organization
 func (o *GitHubOAuthDelegate) ListAllRepositories(ctx context.Context) ([]*minderv1.Repository, error) {
-	opt := &gogithub.RepositoryListByAuthenticatedUserOptions{
-		ListOptions: gogithub.ListOptions{
-			PerPage: 100,
-		},
-		Affiliation: "owner",
-	}
+	return ghcommon.ListAllRepositories(ctx, o.ListRepositoriesPage)
+}
 
-	orgOpt := &gogithub.RepositoryListByOrgOptions{
-		ListOptions: gogithub.ListOptions{
-			PerPage: 100,
-		},
+// ListRepositoriesPage returns the page of the repositories for the
+// authenticated user the cursor points to, and the cursor of the next page
+func (o *GitHubOAuthDelegate) ListRepositoriesPage(
+	ctx context.Context, cursor string,
+) ([]*minderv1.Repository, string, error) {
+	page, err := ghcommon.PageFromCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	listOpt := gogithub.ListOptions{
+		PerPage: 100,
+		Page:    page,
 	}
 
-	// create a slice to hold the repositories
-	var allRepos []*gogithub.Repository
-	for {
-		var repos []*gogithub.Repository
-		var resp *gogithub.Response
-		var err error
-
-		if o.owner != "" {
-			repos, resp, err = o.client.Repositories.ListByOrg(ctx, o.owner, orgOpt)
-		} else {
-			repos, resp, err = o.client.Repositories.ListByAuthenticatedUser(ctx, opt)
-		}
-
-		if err != nil {
-			return ghcommon.ConvertRepositories(allRepos), fmt.Errorf("error listing repositories: %w", err)
-		}
-		allRepos = append(allRepos, repos...)
-		if resp.NextPage == 0 {
-			break
-		}
-
-		if o.owner != "" {
-			orgOpt.Page = resp.NextPage
-		} else {
-			opt.Page = resp.NextPage
-		}
+	var repos []*gogithub.Repository
+	var resp *gogithub.Response
+	if o.owner != "" {
+		repos, resp, err = o.client.Repositories.ListByOrg(ctx, o.owner, &gogithub.RepositoryListByOrgOptions{
+			ListOptions: listOpt,
+		})
+	} else {
+		repos, resp, err = o.client.Repositories.ListByAuthenticatedUser(ctx, &gogithub.RepositoryListByAuthenticatedUserOptions{
+			ListOptions: listOpt,
+			Affiliation: "owner",
+		})
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("error listing repositories: %w", err)
 	}
 
-	return ghcommon.ConvertRepositories(allRepos), nil
+	return ghcommon.ConvertRepositories(repos), ghcommon.CursorFromPage(resp.NextPage), nil
 }
 
 // GetUserId returns the user id for the authenticated user
@@ -20,6 +20,7 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/google/go-github/v63/github"
 	"github.com/rs/zerolog"
+	"golang.org/x/crypto/ssh"
 	"golang.org/x/oauth2"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -88,6 +89,12 @@ var _ provifv1.ReviewPublisher = (*GitHub)(nil)
 // Ensure that the Github client implements the IssuePublisher interface
 var _ provifv1.IssuePublisher = (*GitHub)(nil)
 
+// Ensure that the GitHub client implements the CodeScanningUploader interface
+var _ provifv1.CodeScanningUploader = (*GitHub)(nil)
+
+// Ensure that the GitHub client implements the CredentialProber interface
+var _ provifv1.CredentialProber = (*GitHub)(nil)
+
 // ClientService is an interface for GitHub operations
 // It is used to mock GitHub operations in tests, but in order to generate
 // mocks, the interface must be exported
@@ -154,6 +161,7 @@ func (ClientServiceImplementation) GetOrgMembership(
 type Delegate interface {
 	GetCredential() provifv1.GitHubCredential
 	ListAllRepositories(context.Context) ([]*minderv1.Repository, error)
+	ListRepositoriesPage(ctx context.Context, cursor string) ([]*minderv1.Repository, string, error)
 	GetUserId(ctx context.Context) (int64, error)
 	GetName(ctx context.Context) (string, error)
 	GetLogin(ctx context.Context) (string, error)
@@ -504,6 +512,26 @@ func (c *GitHub) SetCommitStatus(
 	return status, nil
 }
 
+// UploadSarif is a wrapper for the GitHub API to upload code scanning results
+func (c *GitHub) UploadSarif(
+	ctx context.Context, owner, repo string, analysis *github.SarifAnalysis,
+) (*github.SarifID, error) {
+	id, _, err := c.client.CodeScanning.UploadSarif(ctx, owner, repo, analysis)
+	if err != nil {
+		return nil, fmt.Errorf("error uploading SARIF to %s/%s: %w", owner, repo, err)
+	}
+	return id, nil
+}
+
+// GetBranchHead returns the SHA of the commit at the head of the branch
+func (c *GitHub) GetBranchHead(ctx context.Context, owner, repo, branch string) (string, error) {
+	br, _, err := c.client.Repositories.GetBranch(ctx, owner, repo, branch, 1)
+	if err != nil {
+		return "", fmt.Errorf("error getting branch %s of %s/%s: %w", branch, owner, repo, err)
+	}
+	return br.GetCommit().GetSHA(), nil
+}
+
 // GetRepository returns a single repository for the authenticated user
 func (c *GitHub) GetRepository(ctx context.Context, owner string, name string) (*github.Repository, error) {
 	// create a slice to hold the repositories
@@ -547,6 +575,234 @@ func (c *GitHub) UpdateBranchProtection(
 	return err
 }
 
+// ListCollaborators lists the collaborators of a repository, with their
+// permissions. The affiliation filters them as the GitHub API does: "outside",
+// "direct" or "all" (the default).
+func (c *GitHub) ListCollaborators(ctx context.Context, owner, repo, affiliation string) ([]*github.User, error) {
+	opt := &github.ListCollaboratorsOptions{
+		Affiliation: affiliation,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var allUsers []*github.User
+	for {
+		users, resp, err := c.client.Repositories.ListCollaborators(ctx, owner, repo, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error listing collaborators: %w", err)
+		}
+		allUsers = append(allUsers, users...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return allUsers, nil
+}
+
+// ListRepositoryTeams lists the teams having access to a repository, with
+// their permissions
+func (c *GitHub) ListRepositoryTeams(ctx context.Context, owner, repo string) ([]*github.Team, error) {
+	opt := &github.ListOptions{PerPage: 100}
+
+	var allTeams []*github.Team
+	for {
+		teams, resp, err := c.client.Repositories.ListTeams(ctx, owner, repo, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error listing teams: %w", err)
+		}
+		allTeams = append(allTeams, teams...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return allTeams, nil
+}
+
+// UpdateCollaboratorPermission sets the permission of an existing direct
+// collaborator of a repository. Other users are invited as collaborators by
+// the GitHub API, which grants them direct access.
+func (c *GitHub) UpdateCollaboratorPermission(ctx context.Context, owner, repo, login, permission string) error {
+	_, _, err := c.client.Repositories.AddCollaborator(ctx, owner, repo, login,
+		&github.RepositoryAddCollaboratorOptions{Permission: permission})
+	return err
+}
+
+// UpdateTeamRepoPermission sets the permission a team of the organization has
+// on a repository
+func (c *GitHub) UpdateTeamRepoPermission(ctx context.Context, org, teamSlug, owner, repo, permission string) error {
+	_, err := c.client.Teams.AddTeamRepoBySlug(ctx, org, teamSlug, owner, repo,
+		&github.TeamAddTeamRepoOptions{Permission: permission})
+	return err
+}
+
+// ListDeployKeys lists the deploy keys of a repository
+func (c *GitHub) ListDeployKeys(ctx context.Context, owner, repo string) ([]*github.Key, error) {
+	opt := &github.ListOptions{PerPage: 100}
+
+	var allKeys []*github.Key
+	for {
+		keys, resp, err := c.client.Repositories.ListKeys(ctx, owner, repo, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error listing deploy keys: %w", err)
+		}
+		allKeys = append(allKeys, keys...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return allKeys, nil
+}
+
+// DeleteDeployKey deletes a deploy key of a repository
+func (c *GitHub) DeleteDeployKey(ctx context.Context, owner, repo string, id int64) error {
+	_, err := c.client.Repositories.DeleteKey(ctx, owner, repo, id)
+	return err
+}
+
+// ListRepoSecrets lists the metadata of the Actions secrets of a repository.
+// The values of the secrets are never returned by the GitHub API.
+func (c *GitHub) ListRepoSecrets(ctx context.Context, owner, repo string) ([]*github.Secret, error) {
+	opt := &github.ListOptions{PerPage: 100}
+
+	var allSecrets []*github.Secret
+	for {
+		secrets, resp, err := c.client.Actions.ListRepoSecrets(ctx, owner, repo, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error listing secrets: %w", err)
+		}
+		allSecrets = append(allSecrets, secrets.Secrets...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return allSecrets, nil
+}
+
+// ListRepoRunners lists the self-hosted runners registered on a repository
+func (c *GitHub) ListRepoRunners(ctx context.Context, owner, repo string) ([]*github.Runner, error) {
+	opt := &github.ListRunnersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+
+	var allRunners []*github.Runner
+	for {
+		runners, resp, err := c.client.Actions.ListRunners(ctx, owner, repo, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error listing runners: %w", err)
+		}
+		allRunners = append(allRunners, runners.Runners...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return allRunners, nil
+}
+
+// ListOrgRunnerGroups lists the self-hosted runner groups of an organization.
+// If visibleToRepo is set, only the groups the repository can use are listed.
+// If the owner is not an organization, provifv1.ErrEntityNotFound is returned.
+func (c *GitHub) ListOrgRunnerGroups(ctx context.Context, org, visibleToRepo string) ([]*github.RunnerGroup, error) {
+	opt := &github.ListOrgRunnerGroupOptions{
+		ListOptions:         github.ListOptions{PerPage: 100},
+		VisibleToRepository: visibleToRepo,
+	}
+
+	var allGroups []*github.RunnerGroup
+	for {
+		groups, resp, err := c.client.Actions.ListOrganizationRunnerGroups(ctx, org, opt)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, fmt.Errorf("organization %s not found: %w", org, provifv1.ErrEntityNotFound)
+			}
+			return nil, fmt.Errorf("error listing runner groups: %w", err)
+		}
+		allGroups = append(allGroups, groups.RunnerGroups...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return allGroups, nil
+}
+
+// ListRunnerGroupRunners lists the self-hosted runners of a runner group of
+// an organization
+func (c *GitHub) ListRunnerGroupRunners(ctx context.Context, org string, groupID int64) ([]*github.Runner, error) {
+	opt := &github.ListOptions{PerPage: 100}
+
+	var allRunners []*github.Runner
+	for {
+		runners, resp, err := c.client.Actions.ListRunnerGroupRunners(ctx, org, groupID, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error listing runner group runners: %w", err)
+		}
+		allRunners = append(allRunners, runners.Runners...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return allRunners, nil
+}
+
+// ListEnvironments lists the deployment environments of a repository
+func (c *GitHub) ListEnvironments(ctx context.Context, owner, repo string) ([]*github.Environment, error) {
+	opt := &github.EnvironmentListOptions{ListOptions: github.ListOptions{PerPage: 100}}
+
+	var allEnvs []*github.Environment
+	for {
+		envs, resp, err := c.client.Repositories.ListEnvironments(ctx, owner, repo, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error listing environments: %w", err)
+		}
+		allEnvs = append(allEnvs, envs.Environments...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return allEnvs, nil
+}
+
+// ListDeploymentBranchPolicies lists the custom deployment branch and tag
+// policies of an environment of a repository
+func (c *GitHub) ListDeploymentBranchPolicies(
+	ctx context.Context, owner, repo, environment string,
+) ([]*github.DeploymentBranchPolicy, error) {
+	policies, _, err := c.client.Repositories.ListDeploymentBranchPolicies(ctx, owner, repo, environment)
+	if err != nil {
+		return nil, fmt.Errorf("error listing deployment branch policies: %w", err)
+	}
+	return policies.BranchPolicies, nil
+}
+
+// UpdateEnvironment creates or updates the protection rules of an
+// environment of a repository
+func (c *GitHub) UpdateEnvironment(
+	ctx context.Context, owner, repo, environment string, req *github.CreateUpdateEnvironment,
+) error {
+	_, _, err := c.client.Repositories.CreateUpdateEnvironment(ctx, owner, repo, environment, req)
+	return err
+}
+
 // GetBaseURL returns the base URL for the REST API.
 func (c *GitHub) GetBaseURL() string {
 	return c.client.BaseURL.String()
@@ -587,6 +843,30 @@ func (c *GitHub) IsOrg() bool {
 	return c.delegate.IsOrg()
 }
 
+// ProbeCredential calls the rate limit endpoint, which does not count against
+// the rate limit, and returns the OAuth scopes and the token expiry reported
+// by GitHub. The installation tokens of GitHub Apps are minted from the App
+// JWT whenever the provider is instantiated, so their expiry is not reported.
+func (c *GitHub) ProbeCredential(ctx context.Context) (*provifv1.CredentialProbe, error) {
+	_, resp, err := c.client.RateLimit.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error calling the GitHub API: %w", err)
+	}
+
+	probe := &provifv1.CredentialProbe{}
+	for _, scope := range strings.Split(resp.Header.Get("X-OAuth-Scopes"), ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			probe.Scopes = append(probe.Scopes, scope)
+		}
+	}
+	sort.Strings(probe.Scopes)
+
+	if c.providerClass != db.ProviderClassGithubApp {
+		probe.ExpiresAt = resp.TokenExpiration.Time
+	}
+	return probe, nil
+}
+
 // ListHooks lists all Hooks for the specified repository.
 func (c *GitHub) ListHooks(ctx context.Context, owner, repo string) ([]*github.Hook, error) {
 	list, resp, err := c.client.Repositories.ListHooks(ctx, owner, repo, nil)
@@ -725,6 +1005,50 @@ func (c *GitHub) ClosePullRequest(ctx context.Context, owner, repo string, numbe
 	return pr, nil
 }
 
+// enableAutoMergeMutation is the GraphQL mutation enabling auto-merge on a pull request.
+// Auto-merge is not available through the REST API.
+const enableAutoMergeMutation = `mutation($id: ID!, $method: PullRequestMergeMethod!) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $id, mergeMethod: $method}) {
+    clientMutationId
+  }
+}`
+
+// EnablePullRequestAutoMerge enables auto-merge on a pull request, identified by its
+// GraphQL node ID. The merge method is one of "merge", "squash" or "rebase".
+func (c *GitHub) EnablePullRequestAutoMerge(ctx context.Context, nodeID, mergeMethod string) error {
+	body := map[string]any{
+		"query": enableAutoMergeMutation,
+		"variables": map[string]any{
+			"id":     nodeID,
+			"method": strings.ToUpper(mergeMethod),
+		},
+	}
+
+	// The GraphQL endpoint lives next to the REST API root, e.g. /api/graphql for
+	// GitHub Enterprise Server, or /graphql for github.com.
+	req, err := c.client.NewRequest(http.MethodPost, "../graphql", body)
+	if err != nil {
+		return fmt.Errorf("error creating auto-merge request: %w", err)
+	}
+
+	var resp struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if _, err := c.client.Do(ctx, req, &resp); err != nil {
+		return fmt.Errorf("error enabling auto-merge: %w", err)
+	}
+	if len(resp.Errors) > 0 {
+		msgs := make([]string, 0, len(resp.Errors))
+		for _, e := range resp.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return fmt.Errorf("error enabling auto-merge: %s", strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
 // ListPullRequests lists all pull requests in a repository.
 func (c *GitHub) ListPullRequests(
 	ctx context.Context,
@@ -852,6 +1176,20 @@ func (c *GitHub) Clone(ctx context.Context, cloneUrl string, branch string) (*gi
 	return delegator.Clone(ctx, cloneUrl, branch)
 }
 
+// CloneWithSSHKey clones a GitHub repository over SSH with the given key,
+// such as a deploy key of the project
+func (c *GitHub) CloneWithSSHKey(ctx context.Context, cloneUrl string, branch string, key ssh.Signer) (*git.Repository, error) {
+	delegator := gitclient.NewGit(c.delegate.GetCredential(), gitclient.WithConfig(c.gitConfig))
+	return delegator.CloneWithSSHKey(ctx, cloneUrl, branch, key)
+}
+
+// ResolveBranch returns the hash of the commit at the head of a branch of a
+// GitHub repository, without cloning it
+func (c *GitHub) ResolveBranch(ctx context.Context, cloneUrl string, branch string) (string, error) {
+	delegator := gitclient.NewGit(c.delegate.GetCredential(), gitclient.WithConfig(c.gitConfig))
+	return delegator.ResolveBranch(ctx, cloneUrl, branch)
+}
+
 // AddAuthToPushOptions adds authorization to the push options
 func (c *GitHub) AddAuthToPushOptions(ctx context.Context, pushOptions *git.PushOptions) error {
 	login, err := c.delegate.GetLogin(ctx)
@@ -867,6 +1205,12 @@ func (c *GitHub) ListAllRepositories(ctx context.Context) ([]*minderv1.Repositor
 	return c.delegate.ListAllRepositories(ctx)
 }
 
+// ListRepositoriesPage lists the page of the repositories the credential has
+// access to the cursor points to, and returns the cursor of the next page
+func (c *GitHub) ListRepositoriesPage(ctx context.Context, cursor string) ([]*minderv1.Repository, string, error) {
+	return c.delegate.ListRepositoriesPage(ctx, cursor)
+}
+
 // GetUserId returns the user id for the acting user
 func (c *GitHub) GetUserId(ctx context.Context) (int64, error) {
 	return c.delegate.GetUserId(ctx)
@@ -5,6 +5,10 @@
 package common
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+
 	gogithub "github.com/google/go-github/v63/github"
 	"google.golang.org/protobuf/types/known/structpb"
 
@@ -44,3 +48,48 @@ func ConvertRepository(repo *gogithub.Repository, props *structpb.Struct) *minde
 		Properties: props,
 	}
 }
+
+// PageFromCursor returns the page of a GitHub listing a repository listing
+// cursor points to. The empty cursor points to the first page.
+func PageFromCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 1, nil
+	}
+	page, err := strconv.Atoi(cursor)
+	if err != nil || page < 1 {
+		return 0, fmt.Errorf("invalid repository listing cursor: %q", cursor)
+	}
+	return page, nil
+}
+
+// CursorFromPage returns the repository listing cursor pointing to a page of
+// a GitHub listing. The last page of a listing has no next page, which is
+// represented by the empty cursor.
+func CursorFromPage(page int) string {
+	if page == 0 {
+		return ""
+	}
+	return strconv.Itoa(page)
+}
+
+// ListAllRepositories lists all the repositories by walking the pages
+// returned by listPage. The repositories listed before an error are returned
+// along with it.
+func ListAllRepositories(
+	ctx context.Context,
+	listPage func(context.Context, string) ([]*minderv1.Repository, string, error),
+) ([]*minderv1.Repository, error) {
+	var allRepos []*minderv1.Repository
+	cursor := ""
+	for {
+		repos, next, err := listPage(ctx, cursor)
+		if err != nil {
+			return allRepos, err
+		}
+		allRepos = append(allRepos, repos...)
+		if next == "" {
+			return allRepos, nil
+		}
+		cursor = next
+	}
+}
@@ -5,6 +5,8 @@
 package common
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	go_github "github.com/google/go-github/v63/github"
@@ -94,6 +96,52 @@ func TestConvertRepositories(t *testing.T) {
 }
 
 // function to get properties.GitHubRepoToMap as structpb.Struct
+func TestPageCursors(t *testing.T) {
+	t.Parallel()
+
+	page, err := PageFromCursor("")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, page)
+
+	page, err = PageFromCursor(CursorFromPage(7))
+	assert.NoError(t, err)
+	assert.Equal(t, 7, page)
+
+	assert.Equal(t, "", CursorFromPage(0))
+
+	_, err = PageFromCursor("next")
+	assert.Error(t, err)
+	_, err = PageFromCursor("0")
+	assert.Error(t, err)
+}
+
+func TestListAllRepositories(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string][]*minderv1.Repository{
+		"":  {{Name: "one"}},
+		"2": {{Name: "two"}, {Name: "three"}},
+	}
+	next := map[string]string{"": "2", "2": ""}
+
+	repos, err := ListAllRepositories(context.Background(),
+		func(_ context.Context, cursor string) ([]*minderv1.Repository, string, error) {
+			return pages[cursor], next[cursor], nil
+		})
+	assert.NoError(t, err)
+	assert.Len(t, repos, 3)
+
+	repos, err = ListAllRepositories(context.Background(),
+		func(_ context.Context, cursor string) ([]*minderv1.Repository, string, error) {
+			if cursor == "2" {
+				return nil, "", errors.New("rate limited")
+			}
+			return pages[cursor], next[cursor], nil
+		})
+	assert.Error(t, err)
+	assert.Len(t, repos, 1)
+}
+
 func gitHubRepoToMap(repo *go_github.Repository) *structpb.Struct {
 	propsMap := properties.GitHubRepoToMap(repo)
 	props, err := structpb.NewStruct(propsMap)
@@ -60,7 +60,7 @@ func (c *GitHub) CreationOptions(entType minderv1.Entity) *provifv1.EntityCreati
 		}
 	}
 
-	// Other entities (PRs, artifacts, releases) don't need registration or events
+	// Other entities (PRs, artifacts, releases, organizations) don't need registration or events
 	return &provifv1.EntityCreationOptions{
 		RegisterWithProvider:       false,
 		PublishReconciliationEvent: false,
@@ -82,6 +82,8 @@ func (c *GitHub) RegisterEntity(
 	case minderv1.Entity_ENTITY_ARTIFACTS:
 		fallthrough
 	case minderv1.Entity_ENTITY_RELEASE:
+		fallthrough
+	case minderv1.Entity_ENTITY_ORGANIZATION:
 		// Nothing to do, accept:
 		return props, nil
 	case minderv1.Entity_ENTITY_REPOSITORIES:
@@ -232,6 +234,8 @@ func (c *GitHub) PropertiesToProtoMessage(
 		return ghprop.PullRequestV1FromProperties(props)
 	case minderv1.Entity_ENTITY_RELEASE:
 		return ghprop.EntityInstanceV1FromReleaseProperties(props)
+	case minderv1.Entity_ENTITY_ORGANIZATION:
+		return ghprop.EntityInstanceV1FromOrganizationProperties(props)
 	}
 
 	return nil, fmt.Errorf("conversion of entity type %s is not handled by the github provider", entType)
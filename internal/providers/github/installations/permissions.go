@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"fmt"
+	"slices"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// permission levels granted to a GitHub App installation, from the lowest
+const (
+	permissionRead  = "read"
+	permissionWrite = "write"
+	permissionAdmin = "admin"
+)
+
+// The permissions needed by the ingesters and actions of a rule type. Calls
+// made by the "rest" ingester depend on the endpoint, so they only need the
+// metadata permission, which is always granted.
+var (
+	ingestPermissions = map[string]map[string]string{
+		"artifact":          {"packages": permissionRead},
+		"collaborators":     {"administration": permissionRead},
+		"deps":              {"contents": permissionRead, "pull_requests": permissionRead},
+		"diff":              {"contents": permissionRead, "pull_requests": permissionRead},
+		"dockerfile":        {"contents": permissionRead},
+		"environments":      {"environments": permissionRead},
+		"git":               {"contents": permissionRead},
+		"iac":               {"contents": permissionRead},
+		"repo_credentials":  {"administration": permissionRead},
+		"runners":           {"administration": permissionRead},
+		"security_insights": {"contents": permissionRead},
+	}
+	remediatePermissions = map[string]map[string]string{
+		"gh_branch_protection":        {"administration": permissionWrite},
+		"gh_collaborator_permissions": {"administration": permissionWrite},
+		"gh_deploy_key_removal":       {"administration": permissionWrite},
+		"gh_environment_protection":   {"administration": permissionWrite},
+		"issue":                       {"issues": permissionWrite},
+		"pull_request":                {"contents": permissionWrite, "pull_requests": permissionWrite},
+		"rest":                        {"administration": permissionWrite},
+	}
+	alertPermissions = map[string]map[string]string{
+		"code_scanning":        {"security_events": permissionWrite},
+		"pull_request_comment": {"pull_requests": permissionWrite},
+		"security_advisory":    {"repository_advisories": permissionWrite},
+	}
+)
+
+// RequiredPermissions returns the permissions a GitHub App installation needs
+// to evaluate a rule type and to run its remediation and alert
+func RequiredPermissions(def *pb.RuleType_Definition) map[string]string {
+	required := make(map[string]string)
+	add := func(perms map[string]string) {
+		for name, level := range perms {
+			if permissionRank(level) > permissionRank(required[name]) {
+				required[name] = level
+			}
+		}
+	}
+
+	add(ingestPermissions[def.GetIngest().GetType()])
+	for _, step := range def.GetIngest().GetMulti().GetSteps() {
+		add(ingestPermissions[step.GetIngest().GetType()])
+	}
+	add(remediatePermissions[def.GetRemediate().GetType()])
+	add(alertPermissions[def.GetAlert().GetType()])
+	return required
+}
+
+// MissingPermissions returns the required permissions which are not granted,
+// formatted as "name:level" and sorted
+func MissingPermissions(required, granted map[string]string) []string {
+	var missing []string
+	for name, level := range required {
+		if permissionRank(granted[name]) < permissionRank(level) {
+			missing = append(missing, fmt.Sprintf("%s:%s", name, level))
+		}
+	}
+	slices.Sort(missing)
+	return missing
+}
+
+func permissionRank(level string) int {
+	switch level {
+	case permissionRead:
+		return 1
+	case permissionWrite:
+		return 2
+	case permissionAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
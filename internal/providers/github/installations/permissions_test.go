@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestMissingPermissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		def      *pb.RuleType_Definition
+		granted  map[string]string
+		expected []string
+	}{
+		{
+			name: "all permissions granted",
+			def: &pb.RuleType_Definition{
+				Ingest:    &pb.RuleType_Definition_Ingest{Type: "git"},
+				Remediate: &pb.RuleType_Definition_Remediate{Type: "pull_request"},
+			},
+			granted: map[string]string{"contents": "write", "pull_requests": "write", "metadata": "read"},
+		},
+		{
+			name: "write needed but read granted",
+			def: &pb.RuleType_Definition{
+				Ingest:    &pb.RuleType_Definition_Ingest{Type: "git"},
+				Remediate: &pb.RuleType_Definition_Remediate{Type: "pull_request"},
+			},
+			granted:  map[string]string{"contents": "read"},
+			expected: []string{"contents:write", "pull_requests:write"},
+		},
+		{
+			name: "alert permission missing",
+			def: &pb.RuleType_Definition{
+				Ingest: &pb.RuleType_Definition_Ingest{Type: "rest"},
+				Alert:  &pb.RuleType_Definition_Alert{Type: "security_advisory"},
+			},
+			granted:  map[string]string{"administration": "admin"},
+			expected: []string{"repository_advisories:write"},
+		},
+		{
+			name: "multi ingester needs the permissions of its steps",
+			def: &pb.RuleType_Definition{
+				Ingest: &pb.RuleType_Definition_Ingest{
+					Type: "multi",
+					Multi: &pb.MultiType{Steps: []*pb.MultiType_Step{
+						{Name: "files", Ingest: &pb.RuleType_Definition_Ingest{Type: "git"}},
+						{Name: "environments", Ingest: &pb.RuleType_Definition_Ingest{Type: "environments"}},
+					}},
+				},
+			},
+			granted:  map[string]string{"contents": "read"},
+			expected: []string{"environments:read"},
+		},
+		{
+			name: "unknown ingester needs nothing",
+			def: &pb.RuleType_Definition{
+				Ingest: &pb.RuleType_Definition_Ingest{Type: "builtin"},
+			},
+			granted: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, MissingPermissions(RequiredPermissions(tt.def), tt.granted))
+		})
+	}
+}
@@ -261,3 +261,19 @@ func (mr *MockDelegateMockRecorder) ListAllRepositories(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRepositories", reflect.TypeOf((*MockDelegate)(nil).ListAllRepositories), arg0)
 }
+
+// ListRepositoriesPage mocks base method.
+func (m *MockDelegate) ListRepositoriesPage(ctx context.Context, cursor string) ([]*v1.Repository, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoriesPage", ctx, cursor)
+	ret0, _ := ret[0].([]*v1.Repository)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRepositoriesPage indicates an expected call of ListRepositoriesPage.
+func (mr *MockDelegateMockRecorder) ListRepositoriesPage(ctx, cursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoriesPage", reflect.TypeOf((*MockDelegate)(nil).ListRepositoriesPage), ctx, cursor)
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	go_github "github.com/google/go-github/v63/github"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Organization Properties
+const (
+	// OrganizationPropertyLogin represents the github login of the organization
+	OrganizationPropertyLogin = "github/org_login"
+	// OrganizationPropertyDefaultRepositoryPermission represents the base permission
+	// members of the organization have on its repositories (read, write, admin or none)
+	OrganizationPropertyDefaultRepositoryPermission = "github/default_repository_permission"
+	// OrganizationPropertyTwoFactorRequirementEnabled represents whether members
+	// of the organization are required to enable two-factor authentication
+	OrganizationPropertyTwoFactorRequirementEnabled = "github/two_factor_requirement_enabled"
+	// OrganizationPropertyMembersCanCreateRepositories represents whether members
+	// of the organization can create repositories
+	OrganizationPropertyMembersCanCreateRepositories = "github/members_can_create_repositories"
+	// OrganizationPropertyMembersCanCreatePublicRepositories represents whether
+	// members of the organization can create public repositories
+	OrganizationPropertyMembersCanCreatePublicRepositories = "github/members_can_create_public_repositories"
+)
+
+// OrganizationFetcher is a property fetcher for organizations
+type OrganizationFetcher struct {
+	propertyFetcherBase
+}
+
+// NewOrganizationFetcher creates a new OrganizationFetcher
+func NewOrganizationFetcher() *OrganizationFetcher {
+	return &OrganizationFetcher{
+		propertyFetcherBase: propertyFetcherBase{
+			propertyOrigins: []propertyOrigin{
+				{
+					keys: []string{
+						// general entity
+						properties.PropertyName,
+						properties.PropertyUpstreamID,
+						// github-specific
+						OrganizationPropertyLogin,
+						OrganizationPropertyDefaultRepositoryPermission,
+						OrganizationPropertyTwoFactorRequirementEnabled,
+						OrganizationPropertyMembersCanCreateRepositories,
+						OrganizationPropertyMembersCanCreatePublicRepositories,
+					},
+					wrapper: getOrganizationWrapper,
+				},
+			},
+			operationalProperties: []string{},
+		},
+	}
+}
+
+// GetName returns the name of the organization
+func (*OrganizationFetcher) GetName(props *properties.Properties) (string, error) {
+	login, err := props.GetProperty(OrganizationPropertyLogin).AsString()
+	if err != nil {
+		return "", fmt.Errorf("failed to get organization login: %w", err)
+	}
+
+	return login, nil
+}
+
+func getOrganizationWrapper(
+	ctx context.Context, ghCli *go_github.Client, _ bool, getByProps *properties.Properties,
+) (map[string]any, error) {
+	login := getByProps.GetProperty(OrganizationPropertyLogin).GetString()
+	if login == "" {
+		login = getByProps.GetProperty(properties.PropertyName).GetString()
+	}
+
+	var org *go_github.Organization
+	var result *go_github.Response
+	var fetchErr error
+	if login != "" {
+		org, result, fetchErr = ghCli.Organizations.Get(ctx, login)
+	} else {
+		upstreamID, err := getByProps.GetProperty(properties.PropertyUpstreamID).AsInt64()
+		if err != nil {
+			return nil, errors.New("organization login or upstream ID is required")
+		}
+		org, result, fetchErr = ghCli.Organizations.GetByID(ctx, upstreamID)
+	}
+	if fetchErr != nil {
+		if result != nil && result.StatusCode == http.StatusNotFound {
+			return nil, v1.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch organization: %w", fetchErr)
+	}
+
+	return organizationProperties(org), nil
+}
+
+// organizationProperties maps the settings of an organization to its
+// properties. GitHub only returns the settings to callers allowed to
+// administer the organization; otherwise they are left at their zero values.
+func organizationProperties(org *go_github.Organization) map[string]any {
+	return map[string]any{
+		properties.PropertyUpstreamID:                          properties.NumericalValueToUpstreamID(org.GetID()),
+		properties.PropertyName:                                org.GetLogin(),
+		OrganizationPropertyLogin:                              org.GetLogin(),
+		OrganizationPropertyDefaultRepositoryPermission:        org.GetDefaultRepoPermission(),
+		OrganizationPropertyTwoFactorRequirementEnabled:        org.GetTwoFactorRequirementEnabled(),
+		OrganizationPropertyMembersCanCreateRepositories:       org.GetMembersCanCreateRepos(),
+		OrganizationPropertyMembersCanCreatePublicRepositories: org.GetMembersCanCreatePublicRepos(),
+	}
+}
+
+// EntityInstanceV1FromOrganizationProperties creates a new EntityInstance from the given properties
+func EntityInstanceV1FromOrganizationProperties(props *properties.Properties) (*minderv1.EntityInstance, error) {
+	_, err := props.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	login, err := props.GetProperty(OrganizationPropertyLogin).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("login not found or invalid: %w", err)
+	}
+
+	return &minderv1.EntityInstance{
+		Type:       minderv1.Entity_ENTITY_ORGANIZATION,
+		Name:       login,
+		Properties: props.ToProtoStruct(),
+	}, nil
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	"testing"
+
+	go_github "github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+func TestNewOrganizationFetcher(t *testing.T) {
+	t.Parallel()
+	fetcher := NewOrganizationFetcher()
+	assert.NotNil(t, fetcher)
+	assert.Len(t, fetcher.propertyOrigins, 1)
+	// all entities should have these properties
+	assert.Contains(t, fetcher.propertyOrigins[0].keys, properties.PropertyName)
+	assert.Contains(t, fetcher.propertyOrigins[0].keys, properties.PropertyUpstreamID)
+	assert.Empty(t, fetcher.operationalProperties)
+}
+
+func TestOrganizationProperties(t *testing.T) {
+	t.Parallel()
+
+	org := &go_github.Organization{
+		ID:                          go_github.Int64(12345),
+		Login:                       go_github.String("stacklok"),
+		DefaultRepoPermission:       go_github.String("read"),
+		TwoFactorRequirementEnabled: go_github.Bool(true),
+		MembersCanCreatePublicRepos: go_github.Bool(false),
+	}
+
+	props := properties.NewProperties(organizationProperties(org))
+	assert.Equal(t, "12345", props.GetProperty(properties.PropertyUpstreamID).GetString())
+	assert.Equal(t, "stacklok", props.GetProperty(properties.PropertyName).GetString())
+	assert.Equal(t, "read", props.GetProperty(OrganizationPropertyDefaultRepositoryPermission).GetString())
+	assert.True(t, props.GetProperty(OrganizationPropertyTwoFactorRequirementEnabled).GetBool())
+	assert.False(t, props.GetProperty(OrganizationPropertyMembersCanCreatePublicRepositories).GetBool())
+	// settings GitHub did not return are left at their zero values
+	assert.False(t, props.GetProperty(OrganizationPropertyMembersCanCreateRepositories).GetBool())
+
+	name, err := NewOrganizationFetcher().GetName(props)
+	require.NoError(t, err)
+	assert.Equal(t, "stacklok", name)
+
+	ei, err := EntityInstanceV1FromOrganizationProperties(props)
+	require.NoError(t, err)
+	assert.Equal(t, minderv1.Entity_ENTITY_ORGANIZATION, ei.GetType())
+	assert.Equal(t, "stacklok", ei.GetName())
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	go_github "github.com/google/go-github/v63/github"
@@ -107,13 +108,22 @@ func getRepoWrapper(
 ) (map[string]any, error) {
 	_ = isOrg
 
-	name, owner, err := getNameOwnerFromProps(ctx, getByProps)
-	if err != nil {
-		return nil, fmt.Errorf("error getting name and owner from properties: %w", err)
+	var repo *go_github.Repository
+	var result *go_github.Response
+	var err error
+	// The upstream ID doesn't change when a repository is renamed or
+	// transferred, so prefer it over the name when it is known
+	if id, ok := getRepoIDFromProps(getByProps); ok {
+		zerolog.Ctx(ctx).Debug().Int64("id", id).Msg("Fetching repository by ID")
+		repo, result, err = ghCli.Repositories.GetByID(ctx, id)
+	} else {
+		name, owner, nameErr := getNameOwnerFromProps(ctx, getByProps)
+		if nameErr != nil {
+			return nil, fmt.Errorf("error getting name and owner from properties: %w", nameErr)
+		}
+		zerolog.Ctx(ctx).Debug().Str("name", name).Str("owner", owner).Msg("Fetching repository")
+		repo, result, err = ghCli.Repositories.Get(ctx, owner, name)
 	}
-	zerolog.Ctx(ctx).Debug().Str("name", name).Str("owner", owner).Msg("Fetching repository")
-
-	repo, result, err := ghCli.Repositories.Get(ctx, owner, name)
 	if err != nil {
 		if result != nil && result.StatusCode == http.StatusNotFound {
 			return nil, v1.ErrEntityNotFound
@@ -124,6 +134,19 @@ func getRepoWrapper(
 	return GitHubRepoToMap(repo), nil
 }
 
+func getRepoIDFromProps(props *properties.Properties) (int64, bool) {
+	upstreamID := props.GetProperty(properties.PropertyUpstreamID).GetString()
+	if upstreamID == "" {
+		return 0, false
+	}
+	// repositories registered by name only have a zero upstream ID
+	id, err := strconv.ParseInt(upstreamID, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
+
 func getNameOwnerFromProps(ctx context.Context, props *properties.Properties) (string, string, error) {
 	repoNameP := props.GetProperty(RepoPropertyName)
 	repoOwnerP := props.GetProperty(RepoPropertyOwner)
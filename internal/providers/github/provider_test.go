@@ -35,6 +35,7 @@ func TestProviderClassInfo(t *testing.T) {
 		minderv1.Entity_ENTITY_PULL_REQUESTS,
 		minderv1.Entity_ENTITY_ARTIFACTS,
 		minderv1.Entity_ENTITY_RELEASE,
+		minderv1.Entity_ENTITY_ORGANIZATION,
 	}
 	wantTypes := []minderv1.ProviderType{
 		minderv1.ProviderType_PROVIDER_TYPE_GITHUB,
@@ -23,6 +23,7 @@ import (
 	"github.com/mindersec/minder/internal/controlplane/metrics"
 	"github.com/mindersec/minder/internal/crypto"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/projects/features"
 	"github.com/mindersec/minder/internal/providers"
 	"github.com/mindersec/minder/internal/providers/credentials"
 	ghprov "github.com/mindersec/minder/internal/providers/github"
@@ -250,6 +251,10 @@ func createGitHubApp(
 		return db.Provider{}, fmt.Errorf("error converting provider class auth flows: %w", err)
 	}
 
+	if err := features.CheckProviderLimit(ctx, qtx, projectId); err != nil {
+		return db.Provider{}, err
+	}
+
 	// Save the installation ID and create a provider
 	savedProvider, err := qtx.CreateProvider(ctx, db.CreateProviderParams{
 		Name:       fmt.Sprintf("%s-%s", db.ProviderClassGithubApp, installationOwner.GetLogin()),
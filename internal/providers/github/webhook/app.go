@@ -90,7 +90,8 @@ func (i *installationRepositoriesEvent) GetInstallation() *installation {
 }
 
 type installation struct {
-	ID *int64 `json:"id,omitempty"`
+	ID          *int64            `json:"id,omitempty"`
+	Permissions map[string]string `json:"permissions,omitempty"`
 }
 
 func (i *installation) GetID() int64 {
@@ -100,6 +101,10 @@ func (i *installation) GetID() int64 {
 	return 0
 }
 
+func (i *installation) GetPermissions() map[string]string {
+	return i.Permissions
+}
+
 // HandleGitHubAppWebhook handles incoming GitHub App webhooks
 func HandleGitHubAppWebhook(
 	store db.Store,
@@ -157,10 +162,17 @@ func HandleGitHubAppWebhook(
 			processPingEvent(ctx, rawWBPayload)
 		case "installation":
 			wes.Accepted = true
-			results, processingErr = processInstallationAppEvent(ctx, rawWBPayload)
+			results, processingErr = processInstallationAppEvent(ctx, store, rawWBPayload)
 		case "installation_repositories":
 			wes.Accepted = true
 			results, processingErr = processInstallationRepositoriesAppEvent(ctx, store, rawWBPayload)
+		case "organization":
+			wes.Accepted = true
+			var res *processingResult
+			res, processingErr = processOrganizationEvent(ctx, rawWBPayload)
+			if res != nil {
+				results = append(results, res)
+			}
 		default:
 			l.Info().Msgf("webhook event %s not handled", wes.Typ)
 		}
@@ -209,9 +221,10 @@ func HandleGitHubAppWebhook(
 // the app itself as well as the list of accessible repositories.
 //
 // There are several possible actions, but in the current user flows
-// we only process deletion.
+// we only process deletion and the acceptance of new permissions.
 func processInstallationAppEvent(
-	_ context.Context,
+	ctx context.Context,
+	store db.Store,
 	payload []byte,
 ) ([]*processingResult, error) {
 	var event *installationEvent
@@ -223,7 +236,8 @@ func processInstallationAppEvent(
 	if event.GetAction() == "" {
 		return nil, errors.New("invalid event: action is nil")
 	}
-	if event.GetAction() != webhookActionEventDeleted {
+	if event.GetAction() != webhookActionEventDeleted &&
+		event.GetAction() != webhookActionEventNewPermissionsAccepted {
 		return nil, newErrNotHandled(`event "installation" with action %s not handled`,
 			event.GetAction(),
 		)
@@ -235,6 +249,16 @@ func processInstallationAppEvent(
 		return nil, errors.New("invalid installation: id is 0")
 	}
 
+	if event.GetAction() == webhookActionEventNewPermissionsAccepted {
+		installation, err := getInstallation(ctx, store, event.GetInstallation().GetID())
+		if err != nil {
+			return nil, err
+		}
+		return []*processingResult{
+			installationChanged(installation, event.GetInstallation(), true),
+		}, nil
+	}
+
 	payloadBytes, err := json.Marshal(
 		service.GitHubAppInstallationDeletedPayload{
 			InstallationID: event.GetInstallation().GetID(),
@@ -281,19 +305,9 @@ func processInstallationRepositoriesAppEvent(
 		return nil, errors.New("invalid installation: id is 0")
 	}
 
-	installationID := event.GetInstallation().GetID()
-	installation, err := store.GetInstallationIDByAppID(ctx, installationID)
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("no installation found for id %d", installationID)
-	}
+	installation, err := getInstallation(ctx, store, event.GetInstallation().GetID())
 	if err != nil {
-		return nil, fmt.Errorf("could not determine provider id: %v", err)
-	}
-	if !installation.ProviderID.Valid {
-		return nil, errors.New("invalid provider id")
-	}
-	if !installation.ProjectID.Valid {
-		return nil, errors.New("invalid project id")
+		return nil, err
 	}
 
 	dbProv, err := store.GetProviderByID(ctx, installation.ProviderID.UUID)
@@ -343,9 +357,52 @@ func processInstallationRepositoriesAppEvent(
 		results = append(results, res)
 	}
 
+	// Re-check the rule types in use against the permissions of the
+	// installation, since the new repositories may need them.
+	if len(event.GetInstallation().GetPermissions()) != 0 {
+		results = append(results, installationChanged(installation, event.GetInstallation(), false))
+	}
+
 	return results, nil
 }
 
+func getInstallation(
+	ctx context.Context,
+	store db.Store,
+	installationID int64,
+) (db.ProviderGithubAppInstallation, error) {
+	installation, err := store.GetInstallationIDByAppID(ctx, installationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.ProviderGithubAppInstallation{}, fmt.Errorf("no installation found for id %d", installationID)
+	}
+	if err != nil {
+		return db.ProviderGithubAppInstallation{}, fmt.Errorf("could not determine provider id: %v", err)
+	}
+	if !installation.ProviderID.Valid {
+		return db.ProviderGithubAppInstallation{}, errors.New("invalid provider id")
+	}
+	if !installation.ProjectID.Valid {
+		return db.ProviderGithubAppInstallation{}, errors.New("invalid project id")
+	}
+	return installation, nil
+}
+
+func installationChanged(
+	dbInst db.ProviderGithubAppInstallation,
+	inst *installation,
+	permissionsChanged bool,
+) *processingResult {
+	return &processingResult{
+		topic: constants.TopicQueueReconcileInstallation,
+		wrapper: &messages.InstallationReconcilerEvent{
+			ProviderID:         dbInst.ProviderID.UUID,
+			ProjectID:          dbInst.ProjectID.UUID,
+			Permissions:        inst.GetPermissions(),
+			PermissionsChanged: permissionsChanged,
+		},
+	}
+}
+
 func repositoryRemoved(repo *repo) *processingResult {
 	return sendEvaluateRepoMessage(repo, constants.TopicQueueGetEntityAndDelete)
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
+	"database/sql"
 	"encoding/hex"
 	"fmt"
 	"net/http"
@@ -16,7 +17,9 @@ import (
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/google/go-github/v63/github"
+	"go.uber.org/mock/gomock"
 
+	mockdb "github.com/mindersec/minder/database/mock"
 	"github.com/mindersec/minder/internal/controlplane/metrics"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/pkg/config/server"
@@ -114,10 +117,14 @@ func FuzzGitHubEventParsers(f *testing.F) {
 
 		ctx := context.Background()
 
+		store := mockdb.NewMockStore(gomock.NewController(t))
+		store.EXPECT().GetInstallationIDByAppID(gomock.Any(), gomock.Any()).
+			Return(db.ProviderGithubAppInstallation{}, sql.ErrNoRows).AnyTimes()
+
 		switch target % 6 {
 		case 0:
 			//nolint:gosec // The fuzzer does not validate the return values
-			processInstallationAppEvent(ctx, rawWHPayload)
+			processInstallationAppEvent(ctx, store, rawWHPayload)
 		case 1:
 			//nolint:gosec // The fuzzer does not validate the return values
 			processRelevantRepositoryEvent(ctx, rawWHPayload)
@@ -1255,6 +1255,7 @@ func (s *UnitTestSuite) TestHandleGitHubWebHook() {
 				require.Equal(t, v1.Entity_ENTITY_REPOSITORIES, evt.Entity.Type)
 				require.Equal(t, "12345", evt.Entity.GetByProps[properties.PropertyUpstreamID])
 				require.Equal(t, "github", evt.Hint.ProviderImplementsHint)
+				require.Equal(t, event, evt.TriggerEvent)
 
 				received = withTimeout(ch, timeout)
 				require.Nil(t, received)
@@ -1287,6 +1288,7 @@ func (s *UnitTestSuite) TestHandleGitHubWebHook() {
 				require.Equal(t, v1.Entity_ENTITY_REPOSITORIES, evt.Entity.Type)
 				require.Equal(t, "12345", evt.Entity.GetByProps[properties.PropertyUpstreamID])
 				require.Equal(t, "github", evt.Hint.ProviderImplementsHint)
+				require.Equal(t, event, evt.TriggerEvent)
 
 				received = withTimeout(ch, timeout)
 				require.Nil(t, received)
@@ -1484,6 +1486,8 @@ func (s *UnitTestSuite) TestHandleGitHubWebHook() {
 				require.Equal(t, v1.Entity_ENTITY_REPOSITORIES, evt.Entity.Type)
 				require.Equal(t, "12345", evt.Entity.GetByProps[properties.PropertyUpstreamID])
 				require.Equal(t, "github", evt.Hint.ProviderImplementsHint)
+				require.True(t, evt.ForceRefresh)
+				require.False(t, evt.DeleteIfNotFound)
 
 				received = withTimeout(ch, timeout)
 				require.Nil(t, received)
@@ -1503,7 +1507,7 @@ func (s *UnitTestSuite) TestHandleGitHubWebHook() {
 					"https://github.com/mindersec/minder",
 				),
 			},
-			topic:      constants.TopicQueueGetEntityAndDelete,
+			topic:      constants.TopicQueueRefreshEntityAndEvaluate,
 			statusCode: http.StatusOK,
 			queued: func(t *testing.T, _ string, ch <-chan *message.Message) {
 				t.Helper()
@@ -1515,6 +1519,8 @@ func (s *UnitTestSuite) TestHandleGitHubWebHook() {
 				require.Equal(t, v1.Entity_ENTITY_REPOSITORIES, evt.Entity.Type)
 				require.Equal(t, "12345", evt.Entity.GetByProps[properties.PropertyUpstreamID])
 				require.Equal(t, "github", evt.Hint.ProviderImplementsHint)
+				require.True(t, evt.ForceRefresh)
+				require.True(t, evt.DeleteIfNotFound)
 
 				received = withTimeout(ch, timeout)
 				require.Nil(t, received)
@@ -1569,7 +1575,7 @@ func (s *UnitTestSuite) TestHandleGitHubWebHook() {
 					Private:  github.Bool(true),
 				},
 			},
-			topic:      constants.TopicQueueGetEntityAndDelete,
+			topic:      constants.TopicQueueRefreshEntityAndEvaluate,
 			statusCode: http.StatusOK,
 			// the message is passed on to events.TopicQueueRefreshEntityAndEvaluate
 			// which should discard it (see test there)
@@ -2912,20 +2918,52 @@ func (s *UnitTestSuite) TestHandleGitHubAppWebHook() {
 						"https://github.com/mindersec/minder",
 					),
 				},
-				// Installation field is left blank on
-				// purpose, to attest the fact that
-				// this particolar event/action
-				// combination does not use it.
-				Installation: &github.Installation{},
+				Installation: &github.Installation{
+					ID: github.Int64(54321),
+					Permissions: &github.InstallationPermissions{
+						Contents:     github.String("write"),
+						PullRequests: github.String("read"),
+					},
+				},
 				Sender: &github.User{
 					Login:   github.String("stacklok"),
 					HTMLURL: github.String("https://github.com/apps"),
 				},
 			},
-			mockStoreFunc: df.NewMockStore(),
-			topic:         installations.ProviderInstallationTopic,
-			statusCode:    http.StatusOK,
-			queued:        nil,
+			mockStoreFunc: df.NewMockStore(
+				df.WithSuccessfulGetInstallationIDByAppID(
+					db.ProviderGithubAppInstallation{
+						ProjectID: uuid.NullUUID{
+							UUID:  projectID,
+							Valid: true,
+						},
+						ProviderID: uuid.NullUUID{
+							UUID:  providerID,
+							Valid: true,
+						},
+					},
+					54321),
+			),
+			topic:      constants.TopicQueueReconcileInstallation,
+			statusCode: http.StatusOK,
+			queued: func(t *testing.T, _ string, ch <-chan *message.Message) {
+				t.Helper()
+
+				var evt messages.InstallationReconcilerEvent
+
+				received := withTimeout(ch, timeout)
+				require.NotNilf(t, received, "no event received after waiting %s", timeout)
+
+				err := json.Unmarshal(received.Payload, &evt)
+				require.NoError(t, err)
+				require.Equal(t, providerID, evt.ProviderID)
+				require.Equal(t, projectID, evt.ProjectID)
+				require.True(t, evt.PermissionsChanged)
+				require.Equal(t, map[string]string{"contents": "write", "pull_requests": "read"}, evt.Permissions)
+
+				received = withTimeout(ch, timeout)
+				require.Nil(t, received)
+			},
 		},
 		{
 			name: "installation suspend",
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	ghprop "github.com/mindersec/minder/internal/providers/github/properties"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// organizationEvent represents any event related to an organization.
+type organizationEvent struct {
+	Action       *string       `json:"action,omitempty"`
+	Organization *organization `json:"organization,omitempty"`
+}
+
+func (o *organizationEvent) GetAction() string {
+	if o.Action != nil {
+		return *o.Action
+	}
+	return ""
+}
+
+func (o *organizationEvent) GetOrganization() *organization {
+	return o.Organization
+}
+
+type organization struct {
+	ID    *int64  `json:"id,omitempty"`
+	Login *string `json:"login,omitempty"`
+}
+
+func (o *organization) GetID() int64 {
+	if o.ID != nil {
+		return *o.ID
+	}
+	return 0
+}
+
+func (o *organization) GetLogin() string {
+	if o.Login != nil {
+		return *o.Login
+	}
+	return ""
+}
+
+// processOrganizationEvent processes events related to an organization,
+// refreshing the settings of the matching organization entity and
+// evaluating it.
+//
+// Deleted organizations are not handled here: deleting an organization
+// uninstalls the GitHub App, which removes the provider and its entities.
+func processOrganizationEvent(
+	ctx context.Context,
+	payload []byte,
+) (*processingResult, error) {
+	var event *organizationEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal organization event: %w", err)
+	}
+
+	if event.GetAction() == "" {
+		return nil, errors.New("organization event action not found")
+	}
+	if event.GetOrganization() == nil {
+		return nil, errors.New("organization event organization not found")
+	}
+	if event.GetOrganization().GetID() == 0 {
+		return nil, errors.New("invalid organization: id is 0")
+	}
+	if event.GetAction() == webhookActionEventDeleted {
+		return nil, newErrNotHandled(`event "organization" with action %s not handled`,
+			event.GetAction(),
+		)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Str("github-event-action", event.GetAction()).
+		Int64("github-organization-id", event.GetOrganization().GetID()).
+		Str("github-organization-login", event.GetOrganization().GetLogin()).
+		Msg("handling event for organization")
+
+	lookByProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:    properties.NumericalValueToUpstreamID(event.GetOrganization().GetID()),
+		ghprop.OrganizationPropertyLogin: event.GetOrganization().GetLogin(),
+	})
+
+	// GitHub sends no event when the settings of an organization change,
+	// so every event is used to pick up the current settings.
+	msg := entityMessage.NewEntityRefreshAndDoMessage().
+		WithEntity(pb.Entity_ENTITY_ORGANIZATION, lookByProps).
+		WithProviderImplementsHint(string(db.ProviderTypeGithub)).
+		WithForceRefresh()
+
+	return &processingResult{
+		topic:   constants.TopicQueueRefreshEntityAndEvaluate,
+		wrapper: msg,
+	}, nil
+}
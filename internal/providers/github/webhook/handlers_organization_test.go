@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	ghprop "github.com/mindersec/minder/internal/providers/github/properties"
+	"github.com/mindersec/minder/internal/util/ptr"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func TestProcessOrganizationEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		payload    *organizationEvent
+		notHandled bool
+		expectErr  bool
+	}{
+		{
+			name: "renamed organization is refreshed",
+			payload: &organizationEvent{
+				Action: ptr.Ptr("renamed"),
+				Organization: &organization{
+					ID:    ptr.Ptr(int64(12345)),
+					Login: ptr.Ptr("stacklok"),
+				},
+			},
+		},
+		{
+			name: "deleted organization is not handled",
+			payload: &organizationEvent{
+				Action: ptr.Ptr("deleted"),
+				Organization: &organization{
+					ID:    ptr.Ptr(int64(12345)),
+					Login: ptr.Ptr("stacklok"),
+				},
+			},
+			notHandled: true,
+			expectErr:  true,
+		},
+		{
+			name: "missing organization",
+			payload: &organizationEvent{
+				Action: ptr.Ptr("member_added"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "organization without id",
+			payload: &organizationEvent{
+				Action:       ptr.Ptr("member_added"),
+				Organization: &organization{Login: ptr.Ptr("stacklok")},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload, err := json.Marshal(tt.payload)
+			require.NoError(t, err)
+
+			res, err := processOrganizationEvent(context.Background(), payload)
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.notHandled {
+					require.ErrorIs(t, err, errNotHandled)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, constants.TopicQueueRefreshEntityAndEvaluate, res.topic)
+
+			msg, ok := res.wrapper.(*entityMessage.HandleEntityAndDoMessage)
+			require.True(t, ok)
+			require.Equal(t, pb.Entity_ENTITY_ORGANIZATION, msg.Entity.Type)
+			require.Equal(t, "12345", msg.Entity.GetByProps[properties.PropertyUpstreamID])
+			require.Equal(t, "stacklok", msg.Entity.GetByProps[ghprop.OrganizationPropertyLogin])
+			require.True(t, msg.ForceRefresh)
+		})
+	}
+}
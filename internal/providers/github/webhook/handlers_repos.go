@@ -195,12 +195,20 @@ func processRelevantRepositoryEvent(
 	// For all other events exept deletions we issue a refresh event.
 	topic := constants.TopicQueueRefreshEntityAndEvaluate
 
-	// For webhook deletions, repository deletions, and repository
-	// transfers, we issue a delete event with the correct message
-	// type.
-	if event.GetAction() == webhookActionEventDeleted ||
-		event.GetAction() == webhookActionEventTransferred {
+	switch event.GetAction() {
+	case webhookActionEventDeleted:
+		// For webhook deletions and repository deletions, we issue a
+		// delete event with the correct message type.
 		topic = constants.TopicQueueGetEntityAndDelete
+	case webhookActionEventRenamed:
+		// The cached properties still hold the old name, so they must
+		// be refreshed for the entity to be renamed.
+		msg = msg.WithForceRefresh()
+	case webhookActionEventTransferred:
+		// Transferred repositories keep their entity, and thus their
+		// history, as long as the provider can still access them.
+		// Otherwise, they are deleted.
+		msg = msg.WithForceRefresh().WithDeleteIfNotFound()
 	}
 
 	return &processingResult{
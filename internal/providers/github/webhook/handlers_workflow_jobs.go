@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// selfHostedLabel is the label GitHub sets on every self-hosted runner
+const selfHostedLabel = "self-hosted"
+
+// workflowJobEvent represents an event related to a job of a GitHub
+// Actions workflow run.
+type workflowJobEvent struct {
+	Action      *string      `json:"action,omitempty"`
+	WorkflowJob *workflowJob `json:"workflow_job,omitempty"`
+	Repo        *repo        `json:"repository,omitempty"`
+}
+
+func (w *workflowJobEvent) GetAction() string {
+	if w.Action != nil {
+		return *w.Action
+	}
+	return ""
+}
+
+func (w *workflowJobEvent) GetWorkflowJob() *workflowJob {
+	return w.WorkflowJob
+}
+
+func (w *workflowJobEvent) GetRepo() *repo {
+	return w.Repo
+}
+
+type workflowJob struct {
+	ID         *int64   `json:"id,omitempty"`
+	Labels     []string `json:"labels,omitempty"`
+	RunnerName *string  `json:"runner_name,omitempty"`
+}
+
+func (w *workflowJob) GetID() int64 {
+	if w.ID != nil {
+		return *w.ID
+	}
+	return 0
+}
+
+func (w *workflowJob) GetRunnerName() string {
+	if w.RunnerName != nil {
+		return *w.RunnerName
+	}
+	return ""
+}
+
+func (w *workflowJob) isSelfHosted() bool {
+	return slices.ContainsFunc(w.Labels, func(l string) bool {
+		return strings.EqualFold(l, selfHostedLabel)
+	})
+}
+
+// processWorkflowJobEvent processes events related to workflow jobs,
+// evaluating the repository when one of its jobs is picked up by a
+// self-hosted runner, so that rules on the runners available to the
+// repository are evaluated as soon as they are used. Jobs running on
+// GitHub-hosted runners are not handled.
+func processWorkflowJobEvent(
+	ctx context.Context,
+	payload []byte,
+) (*processingResult, error) {
+	var event *workflowJobEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	if event.GetRepo() == nil {
+		return nil, errRepoNotFound
+	}
+	if event.GetRepo().GetID() == 0 {
+		return nil, errors.New("invalid repo: id is 0")
+	}
+	if event.GetWorkflowJob() == nil {
+		return nil, errors.New("workflow job event workflow_job not found")
+	}
+
+	if event.GetAction() != webhookActionEventInProgress || !event.GetWorkflowJob().isSelfHosted() {
+		return nil, newErrNotHandled(`event "workflow_job" with action %s not handled`,
+			event.GetAction(),
+		)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Str("github-event-action", event.GetAction()).
+		Int64("github-repository-id", event.GetRepo().GetID()).
+		Str("github-repository-url", event.GetRepo().GetHTMLURL()).
+		Int64("github-workflow-job-id", event.GetWorkflowJob().GetID()).
+		Str("github-runner-name", event.GetWorkflowJob().GetRunnerName()).
+		Msg("handling workflow job event on self-hosted runner")
+
+	return sendEvaluateRepoMessage(event.GetRepo(), constants.TopicQueueRefreshEntityAndEvaluate), nil
+}
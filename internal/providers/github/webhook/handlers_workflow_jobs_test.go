@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/util/ptr"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func TestProcessWorkflowJobEvent(t *testing.T) {
+	t.Parallel()
+
+	testRepo := &repo{
+		ID:      ptr.Ptr(int64(12345)),
+		Name:    ptr.Ptr("minder"),
+		HTMLURL: ptr.Ptr("https://github.com/stacklok/minder"),
+	}
+
+	tests := []struct {
+		name       string
+		payload    *workflowJobEvent
+		notHandled bool
+		expectErr  bool
+	}{
+		{
+			name: "job picked up by a self-hosted runner",
+			payload: &workflowJobEvent{
+				Action: ptr.Ptr("in_progress"),
+				WorkflowJob: &workflowJob{
+					ID:         ptr.Ptr(int64(1)),
+					Labels:     []string{"Self-Hosted", "linux"},
+					RunnerName: ptr.Ptr("build-1"),
+				},
+				Repo: testRepo,
+			},
+		},
+		{
+			name: "job on a GitHub-hosted runner is not handled",
+			payload: &workflowJobEvent{
+				Action:      ptr.Ptr("in_progress"),
+				WorkflowJob: &workflowJob{Labels: []string{"ubuntu-latest"}},
+				Repo:        testRepo,
+			},
+			notHandled: true,
+			expectErr:  true,
+		},
+		{
+			name: "queued job is not handled",
+			payload: &workflowJobEvent{
+				Action:      ptr.Ptr("queued"),
+				WorkflowJob: &workflowJob{Labels: []string{"self-hosted"}},
+				Repo:        testRepo,
+			},
+			notHandled: true,
+			expectErr:  true,
+		},
+		{
+			name: "missing repository",
+			payload: &workflowJobEvent{
+				Action:      ptr.Ptr("in_progress"),
+				WorkflowJob: &workflowJob{Labels: []string{"self-hosted"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing workflow job",
+			payload: &workflowJobEvent{
+				Action: ptr.Ptr("in_progress"),
+				Repo:   testRepo,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload, err := json.Marshal(tt.payload)
+			require.NoError(t, err)
+
+			res, err := processWorkflowJobEvent(context.Background(), payload)
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.notHandled {
+					require.ErrorIs(t, err, errNotHandled)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, constants.TopicQueueRefreshEntityAndEvaluate, res.topic)
+
+			msg, ok := res.wrapper.(*entityMessage.HandleEntityAndDoMessage)
+			require.True(t, ok)
+			require.Equal(t, pb.Entity_ENTITY_REPOSITORIES, msg.Entity.Type)
+			require.Equal(t, "12345", msg.Entity.GetByProps[properties.PropertyUpstreamID])
+		})
+	}
+}
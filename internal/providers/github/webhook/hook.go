@@ -36,7 +36,11 @@ const (
 	webhookActionEventSynchronize = "synchronize"
 	webhookActionEventClosed      = "closed"
 	webhookActionEventPublished   = "published"
+	webhookActionEventRenamed     = "renamed"
 	webhookActionEventTransferred = "transferred"
+	webhookActionEventInProgress  = "in_progress"
+
+	webhookActionEventNewPermissionsAccepted = "new_permissions_accepted"
 )
 
 // toMessage interface ensures that payloads returned by processor
@@ -163,6 +167,12 @@ func HandleWebhookEvent(
 		case "release":
 			wes.Accepted = true
 			res, processingErr = processReleaseEvent(ctx, rawWBPayload)
+		case "organization":
+			wes.Accepted = true
+			res, processingErr = processOrganizationEvent(ctx, rawWBPayload)
+		case "workflow_job":
+			wes.Accepted = true
+			res, processingErr = processWorkflowJobEvent(ctx, rawWBPayload)
 		case "ping":
 			// For ping events, we do not set wes.Accepted
 			// to true because they're not relevant
@@ -186,6 +196,11 @@ func HandleWebhookEvent(
 
 		// res is null only when a ping event occurred.
 		if res != nil && res.wrapper != nil {
+			// Only the rules triggered by the event type are evaluated
+			if refresh, ok := res.wrapper.(*entMsg.HandleEntityAndDoMessage); ok {
+				refresh.WithTriggerEvent(wes.Typ)
+			}
+
 			if err := res.wrapper.ToMessage(m); err != nil {
 				wes.Error = true
 				l.Error().Err(err).Msg("Error creating event")
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/go-git/go-git/v5"
+	"golang.org/x/crypto/ssh"
 
 	gitclient "github.com/mindersec/minder/internal/providers/git"
 )
@@ -16,3 +17,17 @@ func (c *gitlabClient) Clone(ctx context.Context, cloneUrl string, branch string
 	g := gitclient.NewGit(c.GetCredential(), gitclient.WithConfig(c.gitConfig))
 	return g.Clone(ctx, cloneUrl, branch)
 }
+
+// CloneWithSSHKey clones a repository over SSH with the given key
+func (c *gitlabClient) CloneWithSSHKey(
+	ctx context.Context, cloneUrl string, branch string, key ssh.Signer,
+) (*git.Repository, error) {
+	g := gitclient.NewGit(c.GetCredential(), gitclient.WithConfig(c.gitConfig))
+	return g.CloneWithSSHKey(ctx, cloneUrl, branch, key)
+}
+
+// ResolveBranch returns the hash of the commit at the head of a branch
+func (c *gitlabClient) ResolveBranch(ctx context.Context, cloneUrl string, branch string) (string, error) {
+	g := gitclient.NewGit(c.GetCredential(), gitclient.WithConfig(c.gitConfig))
+	return g.ResolveBranch(ctx, cloneUrl, branch)
+}
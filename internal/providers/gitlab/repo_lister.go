@@ -6,6 +6,7 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/rs/zerolog"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -21,28 +22,69 @@ import (
 // 50 => "Owner"
 var minAccessLevelControl = 25 // "Security Manager"
 
+// reposPerPage is the number of projects requested per page when listing
+const reposPerPage = 100
+
 func (c *gitlabClient) ListAllRepositories(ctx context.Context) ([]*minderv1.Repository, error) {
+	var repos []*minderv1.Repository
+	cursor := ""
+	for {
+		page, next, err := c.ListRepositoriesPage(ctx, cursor)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, page...)
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+
+	zerolog.Ctx(ctx).Debug().Int("num_repos", len(repos)).Msg("found repositories in gitlab provider")
+
+	return repos, nil
+}
+
+// ListRepositoriesPage lists the page of the projects the cursor points to.
+// The cursor is the page number; a full page is assumed to be followed by
+// another one.
+func (c *gitlabClient) ListRepositoriesPage(
+	ctx context.Context, cursor string,
+) ([]*minderv1.Repository, string, error) {
+	page := 1
+	if cursor != "" {
+		var err error
+		page, err = strconv.Atoi(cursor)
+		if err != nil || page < 1 {
+			return nil, "", fmt.Errorf("invalid repository listing cursor: %q", cursor)
+		}
+	}
+
 	managedProjects := []*gitlab.Project{}
-	if err := glRESTGet(ctx, c, fmt.Sprintf("projects?min_access_level=%d", minAccessLevelControl), &managedProjects); err != nil {
-		return nil, fmt.Errorf("failed to get projects: %w", err)
+	path := fmt.Sprintf("projects?min_access_level=%d&per_page=%d&page=%d", minAccessLevelControl, reposPerPage, page)
+	if err := glRESTGet(ctx, c, path, &managedProjects); err != nil {
+		return nil, "", fmt.Errorf("failed to get projects: %w", err)
 	}
 
 	repos := make([]*minderv1.Repository, 0, len(managedProjects))
 	for _, p := range managedProjects {
 		props, err := gitlabProjectToProperties(p)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert project to properties: %w", err)
+			return nil, "", fmt.Errorf("failed to convert project to properties: %w", err)
 		}
 
 		outRep, err := repoV1FromProperties(props)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert properties to repository: %w", err)
+			return nil, "", fmt.Errorf("failed to convert properties to repository: %w", err)
 		}
 
 		repos = append(repos, outRep)
 	}
 
-	zerolog.Ctx(ctx).Debug().Int("num_repos", len(repos)).Msg("found repositories in gitlab provider")
+	next := ""
+	if len(managedProjects) == reposPerPage {
+		next = strconv.Itoa(page + 1)
+	}
 
-	return repos, nil
+	return repos, next, nil
 }
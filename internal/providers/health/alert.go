@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/email"
+)
+
+// alert alerts on a token which expires within the warning window, once per
+// token, and on credentials which became unhealthy since the previous check
+func (c *Checker) alert(
+	ctx context.Context,
+	prov *db.Provider,
+	previous *db.ProviderHealth,
+	health *db.ProviderHealth,
+) error {
+	logger := zerolog.Ctx(ctx)
+
+	if expiry := health.TokenExpiresAt; expiry.Valid &&
+		expiry.Time.Before(time.Now().Add(c.cfg.ExpiryWarning)) &&
+		!(health.AlertedExpiry.Valid && health.AlertedExpiry.Time.Equal(expiry.Time)) {
+		logger.Warn().Time("expires_at", expiry.Time).Msg("provider token expires soon")
+
+		subject := fmt.Sprintf("Minder alert: the token of provider %s expires soon", prov.Name)
+		text := fmt.Sprintf("The token of provider %q in project %s expires at %s.\n"+
+			"Re-enroll the provider before then to keep Minder working.\n",
+			prov.Name, prov.ProjectID, expiry.Time.Format(time.RFC3339))
+		if err := c.notify(subject, text); err != nil {
+			return err
+		}
+
+		if err := c.store.SetProviderHealthAlertedExpiry(ctx, db.SetProviderHealthAlertedExpiryParams{
+			ProviderID:    prov.ID,
+			AlertedExpiry: expiry,
+		}); err != nil {
+			return fmt.Errorf("error recording alerted token expiry: %w", err)
+		}
+	}
+
+	if !health.Healthy && (previous == nil || previous.Healthy) {
+		logger.Warn().Msg("provider credentials became unhealthy")
+
+		subject := fmt.Sprintf("Minder alert: the credentials of provider %s are failing", prov.Name)
+		text := fmt.Sprintf("The health checks of provider %q in project %s failed.\n"+
+			"Run `minder provider status --name %s --verbose` for details.\n",
+			prov.Name, prov.ProjectID, prov.Name)
+		if err := c.notify(subject, text); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Checker) notify(subject, text string) error {
+	if c.cfg.AlertContact == "" || c.pub == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(email.MailEventPayload{
+		Address:  c.cfg.AlertContact,
+		Subject:  subject,
+		BodyText: text,
+		BodyHTML: "<pre>" + template.HTMLEscapeString(text) + "</pre>",
+	})
+	if err != nil {
+		return fmt.Errorf("error marshalling payload for email event: %w", err)
+	}
+
+	if err := c.pub.Publish(email.TopicQueueInviteEmail, message.NewMessage(uuid.New().String(), payload)); err != nil {
+		return fmt.Errorf("error publishing provider health alert: %w", err)
+	}
+	return nil
+}
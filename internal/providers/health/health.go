@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package health checks the health of the credentials of the providers: the
+// last successful API call, the expiry of their tokens and changes of the
+// scopes granted to them, and alerts on tokens about to expire.
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/providers/manager"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// CheckCredentials verifies that the provider can be instantiated with its credentials
+	CheckCredentials = "credentials"
+	// CheckAPICall verifies that an authenticated API call succeeds
+	CheckAPICall = "api_call"
+	// CheckTokenExpiry verifies that the token is not expired or about to expire
+	CheckTokenExpiry = "token_expiry"
+	// CheckScopes reports changes of the scopes granted to the token
+	CheckScopes = "scopes"
+)
+
+// Status is the outcome of a single check
+type Status string
+
+const (
+	// StatusOK means the check passed
+	StatusOK Status = "ok"
+	// StatusWarning means the check needs attention, but the provider still works
+	StatusWarning Status = "warning"
+	// StatusFailed means the check failed
+	StatusFailed Status = "failed"
+	// StatusSkipped means the check does not apply to the provider
+	StatusSkipped Status = "skipped"
+)
+
+// Check is the result of a single check, as stored in the checks of the
+// provider health
+type Check struct {
+	Name    string `json:"name"`
+	Status  Status `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// Checker checks the health of the credentials of the providers
+type Checker struct {
+	store   db.ProvidersStore
+	manager manager.ProviderManager
+	pub     interfaces.Publisher
+	cfg     *serverconfig.ProviderHealthConfig
+}
+
+// NewChecker creates a new checker of the health of the provider credentials.
+// Alerts are published on pub, which may be nil if the checker is only used
+// for on-demand checks.
+func NewChecker(
+	store db.ProvidersStore,
+	provManager manager.ProviderManager,
+	pub interfaces.Publisher,
+	cfg *serverconfig.ProviderHealthConfig,
+) *Checker {
+	return &Checker{
+		store:   store,
+		manager: provManager,
+		pub:     pub,
+		cfg:     cfg,
+	}
+}
+
+// Run checks the credentials of all providers at regular intervals until the
+// context is cancelled, and alerts on expiring tokens and failing credentials
+func (c *Checker) Run(ctx context.Context) error {
+	if c.cfg.Interval <= 0 {
+		return fmt.Errorf("invalid provider health interval: %s", c.cfg.Interval)
+	}
+
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := c.CheckAll(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error checking provider health")
+			}
+		}
+	}
+}
+
+// CheckAll checks the credentials of all providers and alerts on the
+// unhealthy ones. A failure to check a provider doesn't stop the others from
+// being checked.
+func (c *Checker) CheckAll(ctx context.Context) error {
+	provs, err := c.store.GlobalListProviders(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing providers: %w", err)
+	}
+
+	for i := range provs {
+		prov := &provs[i]
+		logger := zerolog.Ctx(ctx).With().
+			Str("provider_id", prov.ID.String()).
+			Str("project_id", prov.ProjectID.String()).
+			Logger()
+
+		previous, err := c.getPrevious(ctx, prov)
+		if err != nil {
+			logger.Error().Err(err).Msg("error getting previous provider health")
+			continue
+		}
+		health, err := c.check(ctx, prov, previous)
+		if err != nil {
+			logger.Error().Err(err).Msg("error checking provider health")
+			continue
+		}
+		if err := c.alert(logger.WithContext(ctx), prov, previous, health); err != nil {
+			logger.Error().Err(err).Msg("error alerting on provider health")
+		}
+	}
+	return nil
+}
+
+// Check checks the credentials of the provider and stores the result
+func (c *Checker) Check(ctx context.Context, prov *db.Provider) (*db.ProviderHealth, error) {
+	previous, err := c.getPrevious(ctx, prov)
+	if err != nil {
+		return nil, err
+	}
+	return c.check(ctx, prov, previous)
+}
+
+func (c *Checker) check(ctx context.Context, prov *db.Provider, previous *db.ProviderHealth) (*db.ProviderHealth, error) {
+	now := time.Now()
+	var probe *provifv1.CredentialProbe
+	checks := make([]Check, 0, 4)
+
+	instance, err := c.manager.InstantiateFromID(ctx, prov.ID)
+	if err != nil {
+		checks = append(checks, Check{Name: CheckCredentials, Status: StatusFailed, Message: err.Error()})
+		checks = append(checks, Check{Name: CheckAPICall, Status: StatusSkipped, Message: "provider could not be instantiated"})
+	} else {
+		var apiCheck Check
+		apiCheck, probe = probeCredential(ctx, instance)
+		checks = append(checks, Check{Name: CheckCredentials, Status: StatusOK}, apiCheck)
+	}
+	checks = append(checks, c.checkTokenExpiry(probe, now))
+
+	var scopes []string
+	var scopesChangedAt sql.NullTime
+	if probe != nil {
+		scopes = probe.Scopes
+	} else if previous != nil {
+		// keep the known scopes until the provider can be probed again
+		scopes = previous.Scopes
+	}
+	scopesCheck := checkScopes(previous, probe)
+	if scopesCheck.Status == StatusWarning {
+		scopesChangedAt = sql.NullTime{Time: now, Valid: true}
+	}
+	checks = append(checks, scopesCheck)
+
+	healthy := !slices.ContainsFunc(checks, func(chk Check) bool {
+		return chk.Status == StatusFailed
+	})
+
+	checksJSON, err := json.Marshal(checks)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling provider health checks: %w", err)
+	}
+
+	params := db.UpsertProviderHealthParams{
+		ProviderID:      prov.ID,
+		Checks:          checksJSON,
+		Healthy:         healthy,
+		Scopes:          scopes,
+		ScopesChangedAt: scopesChangedAt,
+	}
+	if probe != nil {
+		params.LastSuccessAt = sql.NullTime{Time: now, Valid: true}
+		params.TokenExpiresAt = sql.NullTime{Time: probe.ExpiresAt, Valid: !probe.ExpiresAt.IsZero()}
+	}
+	if params.Scopes == nil {
+		params.Scopes = []string{}
+	}
+
+	health, err := c.store.UpsertProviderHealth(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("error storing provider health: %w", err)
+	}
+	return &health, nil
+}
+
+// Checks returns the individual checks of a stored provider health
+func Checks(health *db.ProviderHealth) ([]Check, error) {
+	var checks []Check
+	if err := json.Unmarshal(health.Checks, &checks); err != nil {
+		return nil, fmt.Errorf("error unmarshalling provider health checks: %w", err)
+	}
+	return checks, nil
+}
+
+func (c *Checker) getPrevious(ctx context.Context, prov *db.Provider) (*db.ProviderHealth, error) {
+	previous, err := c.store.GetProviderHealth(ctx, prov.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting provider health: %w", err)
+	}
+	return &previous, nil
+}
+
+func probeCredential(ctx context.Context, instance provifv1.Provider) (Check, *provifv1.CredentialProbe) {
+	prober, ok := instance.(provifv1.CredentialProber)
+	if !ok {
+		return Check{Name: CheckAPICall, Status: StatusSkipped, Message: "provider does not support credential checks"}, nil
+	}
+
+	probe, err := prober.ProbeCredential(ctx)
+	if err != nil {
+		return Check{Name: CheckAPICall, Status: StatusFailed, Message: err.Error()}, nil
+	}
+	return Check{Name: CheckAPICall, Status: StatusOK}, probe
+}
+
+func (c *Checker) checkTokenExpiry(probe *provifv1.CredentialProbe, now time.Time) Check {
+	switch {
+	case probe == nil:
+		return Check{Name: CheckTokenExpiry, Status: StatusSkipped, Message: "credential could not be probed"}
+	case probe.ExpiresAt.IsZero():
+		return Check{Name: CheckTokenExpiry, Status: StatusOK, Message: "token does not expire"}
+	case !probe.ExpiresAt.After(now):
+		return Check{
+			Name:    CheckTokenExpiry,
+			Status:  StatusFailed,
+			Message: fmt.Sprintf("token expired at %s", probe.ExpiresAt.Format(time.RFC3339)),
+		}
+	case probe.ExpiresAt.Before(now.Add(c.cfg.ExpiryWarning)):
+		return Check{
+			Name:    CheckTokenExpiry,
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("token expires at %s", probe.ExpiresAt.Format(time.RFC3339)),
+		}
+	default:
+		return Check{
+			Name:    CheckTokenExpiry,
+			Status:  StatusOK,
+			Message: fmt.Sprintf("token expires at %s", probe.ExpiresAt.Format(time.RFC3339)),
+		}
+	}
+}
+
+func checkScopes(previous *db.ProviderHealth, probe *provifv1.CredentialProbe) Check {
+	if probe == nil {
+		return Check{Name: CheckScopes, Status: StatusSkipped, Message: "credential could not be probed"}
+	}
+	if len(probe.Scopes) == 0 && (previous == nil || len(previous.Scopes) == 0) {
+		return Check{Name: CheckScopes, Status: StatusSkipped, Message: "provider does not report scopes"}
+	}
+	if previous != nil && previous.LastSuccessAt.Valid && !slices.Equal(previous.Scopes, probe.Scopes) {
+		return Check{
+			Name:   CheckScopes,
+			Status: StatusWarning,
+			Message: fmt.Sprintf("scopes changed from [%s] to [%s]",
+				strings.Join(previous.Scopes, ", "), strings.Join(probe.Scopes, ", ")),
+		}
+	}
+	return Check{Name: CheckScopes, Status: StatusOK, Message: strings.Join(probe.Scopes, ", ")}
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	mockmanager "github.com/mindersec/minder/internal/providers/manager/mock"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+	mockprov "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+// proberProvider is a provider whose credential can be probed
+type proberProvider struct {
+	*mockprov.MockProvider
+	probe *provifv1.CredentialProbe
+	err   error
+}
+
+func (p *proberProvider) ProbeCredential(context.Context) (*provifv1.CredentialProbe, error) {
+	return p.probe, p.err
+}
+
+func TestCheckerCheck(t *testing.T) {
+	t.Parallel()
+
+	providerID := uuid.New()
+	soon := time.Now().Add(24 * time.Hour)
+	later := time.Now().Add(30 * 24 * time.Hour)
+
+	tests := []struct {
+		name            string
+		previous        *db.ProviderHealth
+		instance        func(ctrl *gomock.Controller) provifv1.Provider
+		instanceErr     error
+		expectedHealthy bool
+		expectedChecks  map[string]Status
+		expectedScopes  []string
+		scopesChanged   bool
+	}{
+		{
+			name: "healthy token expiring later",
+			instance: func(ctrl *gomock.Controller) provifv1.Provider {
+				return &proberProvider{
+					MockProvider: mockprov.NewMockProvider(ctrl),
+					probe:        &provifv1.CredentialProbe{Scopes: []string{"repo"}, ExpiresAt: later},
+				}
+			},
+			expectedHealthy: true,
+			expectedChecks: map[string]Status{
+				CheckCredentials: StatusOK,
+				CheckAPICall:     StatusOK,
+				CheckTokenExpiry: StatusOK,
+				CheckScopes:      StatusOK,
+			},
+			expectedScopes: []string{"repo"},
+		},
+		{
+			name: "token expiring soon and scopes changed",
+			previous: &db.ProviderHealth{
+				Healthy:       true,
+				Scopes:        []string{"repo", "workflow"},
+				LastSuccessAt: sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true},
+			},
+			instance: func(ctrl *gomock.Controller) provifv1.Provider {
+				return &proberProvider{
+					MockProvider: mockprov.NewMockProvider(ctrl),
+					probe:        &provifv1.CredentialProbe{Scopes: []string{"repo"}, ExpiresAt: soon},
+				}
+			},
+			expectedHealthy: true,
+			expectedChecks: map[string]Status{
+				CheckCredentials: StatusOK,
+				CheckAPICall:     StatusOK,
+				CheckTokenExpiry: StatusWarning,
+				CheckScopes:      StatusWarning,
+			},
+			expectedScopes: []string{"repo"},
+			scopesChanged:  true,
+		},
+		{
+			name: "failing API call keeps the known scopes",
+			previous: &db.ProviderHealth{
+				Healthy: true,
+				Scopes:  []string{"repo"},
+			},
+			instance: func(ctrl *gomock.Controller) provifv1.Provider {
+				return &proberProvider{
+					MockProvider: mockprov.NewMockProvider(ctrl),
+					err:          errors.New("bad credentials"),
+				}
+			},
+			expectedChecks: map[string]Status{
+				CheckCredentials: StatusOK,
+				CheckAPICall:     StatusFailed,
+				CheckTokenExpiry: StatusSkipped,
+				CheckScopes:      StatusSkipped,
+			},
+			expectedScopes: []string{"repo"},
+		},
+		{
+			name: "provider without probing support",
+			instance: func(ctrl *gomock.Controller) provifv1.Provider {
+				return mockprov.NewMockProvider(ctrl)
+			},
+			expectedHealthy: true,
+			expectedChecks: map[string]Status{
+				CheckCredentials: StatusOK,
+				CheckAPICall:     StatusSkipped,
+				CheckTokenExpiry: StatusSkipped,
+				CheckScopes:      StatusSkipped,
+			},
+			expectedScopes: []string{},
+		},
+		{
+			name:        "provider can't be instantiated",
+			instanceErr: errors.New("no credentials"),
+			expectedChecks: map[string]Status{
+				CheckCredentials: StatusFailed,
+				CheckAPICall:     StatusSkipped,
+				CheckTokenExpiry: StatusSkipped,
+				CheckScopes:      StatusSkipped,
+			},
+			expectedScopes: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockProvidersStore(ctrl)
+			provManager := mockmanager.NewMockProviderManager(ctrl)
+
+			if tt.previous != nil {
+				store.EXPECT().GetProviderHealth(gomock.Any(), providerID).Return(*tt.previous, nil)
+			} else {
+				store.EXPECT().GetProviderHealth(gomock.Any(), providerID).Return(db.ProviderHealth{}, sql.ErrNoRows)
+			}
+
+			var instance provifv1.Provider
+			if tt.instance != nil {
+				instance = tt.instance(ctrl)
+			}
+			provManager.EXPECT().InstantiateFromID(gomock.Any(), providerID).Return(instance, tt.instanceErr)
+
+			store.EXPECT().UpsertProviderHealth(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, params db.UpsertProviderHealthParams) (db.ProviderHealth, error) {
+					require.Equal(t, tt.expectedHealthy, params.Healthy)
+					require.Equal(t, tt.expectedScopes, params.Scopes)
+					require.Equal(t, tt.scopesChanged, params.ScopesChangedAt.Valid)
+
+					var checks []Check
+					require.NoError(t, json.Unmarshal(params.Checks, &checks))
+					statuses := make(map[string]Status, len(checks))
+					for _, chk := range checks {
+						statuses[chk.Name] = chk.Status
+					}
+					require.Equal(t, tt.expectedChecks, statuses)
+
+					return db.ProviderHealth{
+						ProviderID: params.ProviderID,
+						Checks:     params.Checks,
+						Healthy:    params.Healthy,
+						Scopes:     params.Scopes,
+					}, nil
+				})
+
+			checker := NewChecker(store, provManager, nil, &serverconfig.ProviderHealthConfig{
+				ExpiryWarning: 7 * 24 * time.Hour,
+			})
+			health, err := checker.Check(context.Background(), &db.Provider{ID: providerID})
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedHealthy, health.Healthy)
+		})
+	}
+}
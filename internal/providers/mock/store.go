@@ -147,6 +147,50 @@ func (mr *MockProviderStoreMockRecorder) GetByTraitInHierarchy(ctx, projectID, n
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTraitInHierarchy", reflect.TypeOf((*MockProviderStore)(nil).GetByTraitInHierarchy), ctx, projectID, name, trait)
 }
 
+// ListShares mocks base method.
+func (m *MockProviderStore) ListShares(ctx context.Context, providerID uuid.UUID) ([]db.ProviderShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShares", ctx, providerID)
+	ret0, _ := ret[0].([]db.ProviderShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShares indicates an expected call of ListShares.
+func (mr *MockProviderStoreMockRecorder) ListShares(ctx, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShares", reflect.TypeOf((*MockProviderStore)(nil).ListShares), ctx, providerID)
+}
+
+// Share mocks base method.
+func (m *MockProviderStore) Share(ctx context.Context, provider *db.Provider, projectID uuid.UUID) (db.ProviderShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Share", ctx, provider, projectID)
+	ret0, _ := ret[0].(db.ProviderShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Share indicates an expected call of Share.
+func (mr *MockProviderStoreMockRecorder) Share(ctx, provider, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Share", reflect.TypeOf((*MockProviderStore)(nil).Share), ctx, provider, projectID)
+}
+
+// Unshare mocks base method.
+func (m *MockProviderStore) Unshare(ctx context.Context, provider *db.Provider, projectID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unshare", ctx, provider, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unshare indicates an expected call of Unshare.
+func (mr *MockProviderStoreMockRecorder) Unshare(ctx, provider, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unshare", reflect.TypeOf((*MockProviderStore)(nil).Unshare), ctx, provider, projectID)
+}
+
 // Update mocks base method.
 func (m *MockProviderStore) Update(ctx context.Context, providerID, projectID uuid.UUID, config json.RawMessage) error {
 	m.ctrl.T.Helper()
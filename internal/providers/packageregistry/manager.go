@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package packageregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// defaultTimeout bounds the requests to the registries, including the
+// download of the largest packuments
+const defaultTimeout = time.Minute
+
+type providerClassManager struct {
+	cli *http.Client
+}
+
+// NewPackageRegistryProviderClassManager creates a new provider class manager
+// for the npm and PyPI providers. A client with a default timeout is used if
+// cli is nil.
+func NewPackageRegistryProviderClassManager(cli *http.Client) *providerClassManager {
+	if cli == nil {
+		cli = &http.Client{Timeout: defaultTimeout}
+	}
+	return &providerClassManager{
+		cli: cli,
+	}
+}
+
+// GetSupportedClasses implements the ProviderClassManager interface
+func (*providerClassManager) GetSupportedClasses() []db.ProviderClass {
+	return Classes
+}
+
+// GetProviderClassInfo implements the ProviderClassManager interface
+func (m *providerClassManager) GetProviderClassInfo(class db.ProviderClass) (*minderv1.ProviderClassInfo, error) {
+	if !slices.Contains(m.GetSupportedClasses(), class) {
+		return nil, fmt.Errorf("provider does not implement %s", class)
+	}
+
+	return ClassInfo(string(class)), nil
+}
+
+// Build implements the ProviderClassManager interface
+func (m *providerClassManager) Build(_ context.Context, config *db.Provider) (v1.Provider, error) {
+	class := config.Class
+	// This should be validated by the caller, but let's check anyway
+	if !slices.Contains(m.GetSupportedClasses(), class) {
+		return nil, fmt.Errorf("provider does not implement %s", class)
+	}
+
+	if config.Version != v1.V1 {
+		return nil, fmt.Errorf("provider version not supported")
+	}
+
+	cfg, err := ParseV1Config(string(class), config.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s config: %w", class, err)
+	}
+
+	cli, err := New(string(class), cfg, m.cli)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s client: %w", class, err)
+	}
+	return cli, nil
+}
+
+// Delete implements the ProviderClassManager interface. The registries are
+// polled rather than sending webhooks and the providers store no credentials,
+// so there is nothing to clean up besides the database records.
+func (*providerClassManager) Delete(_ context.Context, _ *db.Provider) error {
+	return nil
+}
+
+// MarshallConfig implements the ProviderClassManager interface
+func (m *providerClassManager) MarshallConfig(
+	_ context.Context, class db.ProviderClass, config json.RawMessage,
+) (json.RawMessage, error) {
+	if !slices.Contains(m.GetSupportedClasses(), class) {
+		return nil, fmt.Errorf("provider does not implement %s", string(class))
+	}
+
+	return MarshalV1Config(string(class), config)
+}
+
+// GetWebhookHandler implements the ProviderClassManager interface. The
+// registries don't send webhooks, new versions are polled instead.
+func (*providerClassManager) GetWebhookHandler() http.Handler {
+	return nil
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package packageregistry
+
+import (
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	providerDocsBaseURL = "https://docs.mindersec.dev"
+	providerDocsURL     = providerDocsBaseURL + "/integrations/provider_integrations/package_registries"
+)
+
+var displayNames = map[string]string{
+	NpmClass:  "npm",
+	PyPIClass: "PyPI",
+}
+
+func (p *packageRegistry) ProviderClassInfo() *minderv1.ProviderClassInfo {
+	name := displayNames[p.class]
+	return &minderv1.ProviderClassInfo{
+		Class:                  p.class,
+		DisplayName:            name,
+		Description:            "Public " + name + " registry provider, for the provenance and maintainers of published packages.",
+		SupportedProviderTypes: provifv1.ProviderTypesFromImpl(p),
+		SupportedAuthFlows: []minderv1.AuthorizationFlow{
+			minderv1.AuthorizationFlow_AUTHORIZATION_FLOW_NONE,
+		},
+		SupportedEntities: []minderv1.Entity{
+			minderv1.Entity_ENTITY_ARTIFACTS,
+		},
+		DocumentationUrl: providerDocsURL,
+	}
+}
+
+// ClassInfo returns metadata for the given package registry provider class.
+// It uses a bare provider to avoid needing a live client instance.
+func ClassInfo(class string) *minderv1.ProviderClassInfo {
+	return (&packageRegistry{class: class}).ProviderClassInfo()
+}
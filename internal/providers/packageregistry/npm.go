@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package packageregistry
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	npmDefaultEndpoint = "https://registry.npmjs.org/"
+	// npmKeysPath lists the keys the registry signs the published versions with
+	npmKeysPath = "-/npm/v1/keys"
+	// npmAttestationsPath is the path of the attestations of a version,
+	// followed by <name>@<version>
+	npmAttestationsPath = "-/npm/v1/attestations/"
+	// slsaProvenancePrefix is the prefix of the predicate types of the SLSA
+	// provenance attestations. npm also publishes a publish attestation, signed
+	// by the registry itself rather than sigstore, which is left out.
+	slsaProvenancePrefix = "https://slsa.dev/provenance/"
+)
+
+// npmRegistry is the npm registry, or one implementing its API
+type npmRegistry struct {
+	p *packageRegistry
+
+	keysMu sync.Mutex
+	keys   []npmKey
+}
+
+type npmPerson struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+type npmPackument struct {
+	Name        string                `json:"name"`
+	DistTags    map[string]string     `json:"dist-tags"`
+	Versions    map[string]npmVersion `json:"versions"`
+	Time        map[string]any        `json:"time"`
+	Maintainers []npmPerson           `json:"maintainers"`
+	Repository  any                   `json:"repository"`
+	Homepage    string                `json:"homepage"`
+}
+
+type npmVersion struct {
+	Version    string     `json:"version"`
+	Deprecated any        `json:"deprecated"`
+	NpmUser    *npmPerson `json:"_npmUser"`
+	Dist       struct {
+		Integrity  string `json:"integrity"`
+		Shasum     string `json:"shasum"`
+		Tarball    string `json:"tarball"`
+		Signatures []struct {
+			KeyID string `json:"keyid"`
+			Sig   string `json:"sig"`
+		} `json:"signatures"`
+		Attestations *struct {
+			URL string `json:"url"`
+		} `json:"attestations"`
+	} `json:"dist"`
+}
+
+type npmKey struct {
+	Expires *time.Time `json:"expires"`
+	KeyID   string     `json:"keyid"`
+	KeyType string     `json:"keytype"`
+	Key     string     `json:"key"`
+}
+
+type npmAttestations struct {
+	Attestations []struct {
+		PredicateType string          `json:"predicateType"`
+		Bundle        json.RawMessage `json:"bundle"`
+	} `json:"attestations"`
+}
+
+func (*npmRegistry) defaultEndpoint() string {
+	return npmDefaultEndpoint
+}
+
+func (*npmRegistry) normalizeName(name string) string {
+	// npm names are case-sensitive for the older packages, keep them as is
+	return strings.TrimSpace(name)
+}
+
+func (r *npmRegistry) getPackage(ctx context.Context, name string) (*registryPackage, error) {
+	var doc npmPackument
+	// scoped packages are fetched as @scope%2Fname
+	if err := r.p.getJSON(ctx, url.PathEscape(name), "", &doc); err != nil {
+		return nil, err
+	}
+	if doc.Name == "" {
+		// unpublished packages are still served, without any version
+		return nil, provifv1.ErrEntityNotFound
+	}
+
+	pkg := &registryPackage{
+		Package: provifv1.Package{
+			Name: doc.Name,
+		},
+		createdAt:     npmTime(doc.Time, "created"),
+		repositoryURL: npmRepositoryURL(doc.Repository),
+		homepage:      doc.Homepage,
+	}
+	for _, m := range doc.Maintainers {
+		pkg.Maintainers = append(pkg.Maintainers, m.Name)
+	}
+
+	for v, ver := range doc.Versions {
+		pv := &provifv1.PackageVersion{
+			Version:     v,
+			PublishedAt: npmTime(doc.Time, v),
+			Deprecated:  npmDeprecated(ver.Deprecated),
+		}
+		for tag, tagged := range doc.DistTags {
+			if tagged == v {
+				pv.Tags = append(pv.Tags, tag)
+			}
+		}
+		slices.Sort(pv.Tags)
+		if ver.NpmUser != nil {
+			pv.Publisher = ver.NpmUser.Name
+		}
+		if file := npmFile(ver); file != nil {
+			pv.Files = append(pv.Files, file)
+		}
+		for _, sig := range ver.Dist.Signatures {
+			pv.Signatures = append(pv.Signatures, &provifv1.PackageSignature{
+				KeyID:     sig.KeyID,
+				Signature: sig.Sig,
+			})
+		}
+		pkg.Versions = append(pkg.Versions, pv)
+	}
+	sortVersions(pkg.Versions)
+
+	return pkg, nil
+}
+
+func (r *npmRegistry) getIntegrity(
+	ctx context.Context, name string, version *provifv1.PackageVersion,
+) (*provifv1.PackageIntegrity, error) {
+	out := &provifv1.PackageIntegrity{
+		RegistrySigned: len(version.Signatures) > 0,
+	}
+	if len(version.Files) == 0 {
+		return out, nil
+	}
+	tarball := version.Files[0]
+
+	if out.RegistrySigned {
+		verified, err := r.verifySignatures(ctx, name, version, tarball)
+		if err != nil {
+			return nil, err
+		}
+		out.RegistrySignatureVerified = verified
+	}
+
+	// The attestations are fetched from the registry the provider is
+	// configured with, regardless of the URL listed in the packument.
+	var atts npmAttestations
+	err := r.p.getJSON(ctx, npmAttestationsPath+url.PathEscape(name+"@"+version.Version), "", &atts)
+	if errors.Is(err, provifv1.ErrEntityNotFound) {
+		return out, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting attestations: %w", err)
+	}
+
+	for _, att := range atts.Attestations {
+		if !strings.HasPrefix(att.PredicateType, slsaProvenancePrefix) {
+			continue
+		}
+		out.Attestations = append(out.Attestations, &provifv1.PackageAttestation{
+			File:            tarball.Name,
+			DigestAlgorithm: tarball.DigestAlgorithm,
+			Digest:          tarball.Digest,
+			Bundle:          att.Bundle,
+		})
+	}
+
+	return out, nil
+}
+
+// verifySignatures verifies the signatures of the registry over
+// <name>@<version>:<integrity> with the public keys of the registry
+func (r *npmRegistry) verifySignatures(
+	ctx context.Context, name string, version *provifv1.PackageVersion, tarball *provifv1.PackageFile,
+) (bool, error) {
+	keys, err := r.getKeys(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	integrity := tarball.DigestAlgorithm + "-" + base64.StdEncoding.EncodeToString(tarball.Digest)
+	digest := sha256.Sum256([]byte(name + "@" + version.Version + ":" + integrity))
+
+	for _, sig := range version.Signatures {
+		idx := slices.IndexFunc(keys, func(k npmKey) bool {
+			return k.KeyID == sig.KeyID
+		})
+		if idx == -1 {
+			continue
+		}
+		key := keys[idx]
+		// expired keys are still valid for the versions published before
+		if key.Expires != nil && !version.PublishedAt.IsZero() && version.PublishedAt.After(*key.Expires) {
+			continue
+		}
+
+		pub, err := parseECDSAKey(key.Key)
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Err(err).Str("keyid", key.KeyID).Msg("error parsing registry key")
+			continue
+		}
+		rawSig, err := base64.StdEncoding.DecodeString(sig.Signature)
+		if err != nil {
+			continue
+		}
+		if ecdsa.VerifyASN1(pub, digest[:], rawSig) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getKeys gets the public keys of the registry, which are cached by the
+// provider instance
+func (r *npmRegistry) getKeys(ctx context.Context) ([]npmKey, error) {
+	r.keysMu.Lock()
+	defer r.keysMu.Unlock()
+
+	if r.keys != nil {
+		return r.keys, nil
+	}
+
+	var doc struct {
+		Keys []npmKey `json:"keys"`
+	}
+	// registries implementing the npm API don't necessarily sign versions
+	err := r.p.getJSON(ctx, npmKeysPath, "", &doc)
+	if err != nil && !errors.Is(err, provifv1.ErrEntityNotFound) {
+		return nil, fmt.Errorf("error getting registry keys: %w", err)
+	}
+	if doc.Keys == nil {
+		doc.Keys = []npmKey{}
+	}
+
+	r.keys = doc.Keys
+	return r.keys, nil
+}
+
+func parseECDSAKey(key string) (*ecdsa.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding key: %w", err)
+	}
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing key: %w", err)
+	}
+	ecPub, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("unexpected key type %T", pub)
+	}
+	return ecPub, nil
+}
+
+// npmFile returns the tarball of a version, with the digest of its
+// integrity, or of its SHA-1 shasum for the oldest versions
+func npmFile(ver npmVersion) *provifv1.PackageFile {
+	file := &provifv1.PackageFile{
+		Name: path.Base(ver.Dist.Tarball),
+	}
+
+	if alg, b64, ok := strings.Cut(ver.Dist.Integrity, "-"); ok {
+		if digest, err := base64.StdEncoding.DecodeString(b64); err == nil {
+			file.DigestAlgorithm = alg
+			file.Digest = digest
+			return file
+		}
+	}
+
+	if digest, err := hex.DecodeString(ver.Dist.Shasum); err == nil && len(digest) > 0 {
+		file.DigestAlgorithm = "sha1"
+		file.Digest = digest
+		return file
+	}
+
+	return nil
+}
+
+func npmTime(times map[string]any, key string) time.Time {
+	s, ok := times[key].(string)
+	if !ok {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// npmDeprecated returns whether a version is deprecated. The deprecation is
+// a message, and some tools set it to false or an empty string to undo it.
+func npmDeprecated(deprecated any) bool {
+	switch d := deprecated.(type) {
+	case string:
+		return d != ""
+	case bool:
+		return d
+	default:
+		return false
+	}
+}
+
+// npmRepositoryURL returns the URL of the source repository of the package,
+// which is either a string or a {type, url} object
+func npmRepositoryURL(repo any) string {
+	var u string
+	switch r := repo.(type) {
+	case string:
+		u = r
+	case map[string]any:
+		u, _ = r["url"].(string)
+	}
+
+	u = strings.TrimPrefix(u, "git+")
+	u = strings.TrimSuffix(u, ".git")
+	if strings.HasPrefix(u, "git://") {
+		u = "https://" + strings.TrimPrefix(u, "git://")
+	}
+	return u
+}
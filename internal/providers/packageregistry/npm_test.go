@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package packageregistry
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// newNpmServer serves a packument of @scope/pkg with two versions, the
+// latest signed by the registry, along with the registry keys and the
+// attestations of the latest version
+func newNpmServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	tarballDigest := sha512.Sum512([]byte("tarball"))
+	integrity := "sha512-" + base64.StdEncoding.EncodeToString(tarballDigest[:])
+	signed := sha256.Sum256([]byte("@scope/pkg@1.1.0:" + integrity))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, signed[:])
+	require.NoError(t, err)
+
+	packument := map[string]any{
+		"name":      "@scope/pkg",
+		"dist-tags": map[string]string{"latest": "1.1.0", "next": "1.1.0"},
+		"time": map[string]any{
+			"created": "2024-01-01T00:00:00Z",
+			"1.0.0":   "2024-01-01T00:00:00Z",
+			"1.1.0":   "2024-02-01T00:00:00Z",
+		},
+		"maintainers": []map[string]string{{"name": "alice"}, {"name": "bob"}},
+		"repository":  map[string]string{"type": "git", "url": "git+https://github.com/example/pkg.git"},
+		"homepage":    "https://example.com",
+		"versions": map[string]any{
+			"1.0.0": map[string]any{
+				"version":    "1.0.0",
+				"deprecated": "use 1.1.0",
+				"_npmUser":   map[string]string{"name": "alice"},
+				"dist": map[string]any{
+					"shasum":  "0123456789abcdef0123456789abcdef01234567",
+					"tarball": "https://registry.example.com/@scope/pkg/-/pkg-1.0.0.tgz",
+				},
+			},
+			"1.1.0": map[string]any{
+				"version":  "1.1.0",
+				"_npmUser": map[string]string{"name": "bob"},
+				"dist": map[string]any{
+					"integrity": integrity,
+					"tarball":   "https://registry.example.com/@scope/pkg/-/pkg-1.1.0.tgz",
+					"signatures": []map[string]string{
+						{"keyid": "SHA256:test", "sig": base64.StdEncoding.EncodeToString(sig)},
+					},
+				},
+			},
+		},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// scoped packages are requested with an escaped slash
+		switch r.URL.EscapedPath() {
+		case "/@scope%2Fpkg":
+			_ = json.NewEncoder(w).Encode(packument)
+		case "/-/npm/v1/keys":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"keys": []map[string]any{{
+					"keyid":   "SHA256:test",
+					"keytype": "ecdsa-sha2-nistp256",
+					"key":     base64.StdEncoding.EncodeToString(der),
+				}},
+			})
+		case "/-/npm/v1/attestations/@scope%2Fpkg@1.1.0":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"attestations": []map[string]any{
+					{"predicateType": "https://github.com/npm/attestation/tree/main/specs/publish/v0.1", "bundle": map[string]any{}},
+					{"predicateType": "https://slsa.dev/provenance/v1", "bundle": map[string]any{"mediaType": "slsa"}},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestRegistry(t *testing.T, class, endpoint string) *packageRegistry {
+	t.Helper()
+
+	p, err := New(class, &minderv1.PackageRegistryProviderConfig{Endpoint: &endpoint}, nil)
+	require.NoError(t, err)
+	return p
+}
+
+func TestNpmGetPackage(t *testing.T) {
+	t.Parallel()
+
+	srv := newNpmServer(t)
+	p := newTestRegistry(t, NpmClass, srv.URL)
+
+	pkg, err := p.GetPackage(context.Background(), "@scope/pkg")
+	require.NoError(t, err)
+
+	assert.Equal(t, "@scope/pkg", pkg.Name)
+	assert.Equal(t, []string{"alice", "bob"}, pkg.Maintainers)
+	require.Len(t, pkg.Versions, 2)
+
+	latest := pkg.Versions[0]
+	assert.Equal(t, "1.1.0", latest.Version)
+	assert.Equal(t, []string{"latest", "next"}, latest.Tags)
+	assert.Equal(t, "bob", latest.Publisher)
+	assert.False(t, latest.Deprecated)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), latest.PublishedAt)
+	require.Len(t, latest.Files, 1)
+	assert.Equal(t, "pkg-1.1.0.tgz", latest.Files[0].Name)
+	assert.Equal(t, "sha512", latest.Files[0].DigestAlgorithm)
+
+	old := pkg.Versions[1]
+	assert.Equal(t, "1.0.0", old.Version)
+	assert.Empty(t, old.Tags)
+	assert.True(t, old.Deprecated)
+	require.Len(t, old.Files, 1)
+	assert.Equal(t, "sha1", old.Files[0].DigestAlgorithm)
+
+	_, err = p.GetPackage(context.Background(), "missing")
+	require.ErrorIs(t, err, provifv1.ErrEntityNotFound)
+}
+
+func TestNpmGetPackageIntegrity(t *testing.T) {
+	t.Parallel()
+
+	srv := newNpmServer(t)
+	p := newTestRegistry(t, NpmClass, srv.URL)
+
+	pkg, err := p.GetPackage(context.Background(), "@scope/pkg")
+	require.NoError(t, err)
+
+	integrity, err := p.GetPackageIntegrity(context.Background(), pkg.Name, pkg.Versions[0])
+	require.NoError(t, err)
+	assert.True(t, integrity.RegistrySigned)
+	assert.True(t, integrity.RegistrySignatureVerified)
+	// the publish attestation is left out
+	require.Len(t, integrity.Attestations, 1)
+	assert.Equal(t, "pkg-1.1.0.tgz", integrity.Attestations[0].File)
+	assert.Equal(t, "sha512", integrity.Attestations[0].DigestAlgorithm)
+	assert.JSONEq(t, `{"mediaType": "slsa"}`, string(integrity.Attestations[0].Bundle))
+
+	// a tampered version fails the registry signature
+	tampered := *pkg.Versions[0]
+	tampered.Version = "1.1.1"
+	integrity, err = p.GetPackageIntegrity(context.Background(), pkg.Name, &tampered)
+	require.NoError(t, err)
+	assert.True(t, integrity.RegistrySigned)
+	assert.False(t, integrity.RegistrySignatureVerified)
+	assert.Empty(t, integrity.Attestations)
+
+	integrity, err = p.GetPackageIntegrity(context.Background(), pkg.Name, pkg.Versions[1])
+	require.NoError(t, err)
+	assert.False(t, integrity.RegistrySigned)
+	assert.Empty(t, integrity.Attestations)
+}
+
+func TestNpmFetchAllProperties(t *testing.T) {
+	t.Parallel()
+
+	srv := newNpmServer(t)
+	p := newTestRegistry(t, NpmClass, srv.URL)
+
+	props, err := p.FetchAllProperties(context.Background(), properties.NewProperties(map[string]any{
+		properties.PropertyName: "@scope/pkg",
+	}), minderv1.Entity_ENTITY_ARTIFACTS, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "@scope/pkg", props.GetProperty(properties.PropertyUpstreamID).GetString())
+	assert.Equal(t, NpmClass, props.GetProperty(properties.ArtifactPropertyType).GetString())
+	assert.Equal(t, "1.1.0", props.GetProperty(ArtifactPropertyLatestVersion).GetString())
+	assert.Equal(t, "2024-01-01T00:00:00Z", props.GetProperty(ArtifactPropertyCreatedAt).GetString())
+	assert.Equal(t, "https://github.com/example/pkg", props.GetProperty(ArtifactPropertyRepositoryURL).GetString())
+	assert.Equal(t, "https://example.com", props.GetProperty(ArtifactPropertyHomepage).GetString())
+	assert.Equal(t, "alice,bob", props.GetProperty(ArtifactPropertyMaintainers).GetString())
+
+	prop, err := p.FetchProperty(context.Background(), properties.NewProperties(map[string]any{
+		properties.PropertyName: "@scope/pkg",
+	}), minderv1.Entity_ENTITY_ARTIFACTS, ArtifactPropertyLatestVersion)
+	require.NoError(t, err)
+	assert.Equal(t, "1.1.0", prop.GetString())
+
+	_, err = p.FetchProperty(context.Background(), properties.NewProperties(map[string]any{
+		properties.PropertyName: "@scope/pkg",
+	}), minderv1.Entity_ENTITY_ARTIFACTS, "npm/unknown")
+	require.Error(t, err)
+
+	msg, err := p.PropertiesToProtoMessage(minderv1.Entity_ENTITY_ARTIFACTS, props)
+	require.NoError(t, err)
+	art, ok := msg.(*minderv1.Artifact)
+	require.True(t, ok)
+	assert.Equal(t, "@scope/pkg", art.Name)
+	assert.Equal(t, NpmClass, art.Type)
+	assert.Equal(t, "public", art.Visibility)
+	assert.Equal(t, "https://github.com/example/pkg", art.Repository)
+}
+
+func TestNpmRepositoryURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]any{
+		"https://github.com/example/pkg": "git+https://github.com/example/pkg.git",
+		"https://github.com/example/obj": map[string]any{"type": "git", "url": "git://github.com/example/obj.git"},
+		"":                               nil,
+	}
+	for want, repo := range tests {
+		assert.Equal(t, want, npmRepositoryURL(repo))
+	}
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package packageregistry provides the providers of the public package
+// registries, npm and PyPI. Their packages are registered as artifacts, whose
+// versions are evaluated against provenance, signature and maintainer
+// policies.
+package packageregistry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// NpmClass is the class of the npm provider
+	NpmClass = "npm"
+	// PyPIClass is the class of the PyPI provider
+	PyPIClass = "pypi"
+)
+
+// Implements is the list of provider types that the package registry providers implement
+var Implements = []db.ProviderType{
+	db.ProviderTypeRest,
+}
+
+// AuthorizationFlows is the list of authorization flows that the package
+// registry providers support. The public registries need no credentials.
+var AuthorizationFlows = []db.AuthorizationFlow{
+	db.AuthorizationFlowNone,
+}
+
+// Classes are the provider classes of the package registries
+var Classes = []db.ProviderClass{
+	db.ProviderClassNpm,
+	db.ProviderClassPypi,
+}
+
+// registry is the registry-specific part of a package registry provider
+type registry interface {
+	// defaultEndpoint is the base URL of the public registry
+	defaultEndpoint() string
+	// normalizeName returns the canonical name of a package
+	normalizeName(name string) string
+	// getPackage fetches a package, its versions and its metadata
+	getPackage(ctx context.Context, name string) (*registryPackage, error)
+	// getIntegrity fetches the registry signature and the attestations of a
+	// version of a package
+	getIntegrity(ctx context.Context, name string, version *provifv1.PackageVersion) (*provifv1.PackageIntegrity, error)
+}
+
+// registryPackage is a package, with the metadata stored in the properties
+// of its artifact
+type registryPackage struct {
+	provifv1.Package
+	createdAt     time.Time
+	repositoryURL string
+	homepage      string
+}
+
+// packageRegistry is the provider of a package registry
+type packageRegistry struct {
+	class    string
+	endpoint string
+	cli      *http.Client
+	reg      registry
+}
+
+// Ensure that the package registry provider implements the interfaces
+var _ provifv1.PackageRegistry = (*packageRegistry)(nil)
+var _ provifv1.REST = (*packageRegistry)(nil)
+
+// New creates a new package registry provider of the given class
+func New(class string, cfg *minderv1.PackageRegistryProviderConfig, cli *http.Client) (*packageRegistry, error) {
+	if cli == nil {
+		cli = http.DefaultClient
+	}
+
+	p := &packageRegistry{
+		class: class,
+		cli:   cli,
+	}
+
+	switch class {
+	case NpmClass:
+		p.reg = &npmRegistry{p: p}
+	case PyPIClass:
+		p.reg = &pypiRegistry{p: p}
+	default:
+		return nil, fmt.Errorf("unknown package registry class: %s", class)
+	}
+
+	p.endpoint = cfg.GetEndpoint()
+	if p.endpoint == "" {
+		p.endpoint = p.reg.defaultEndpoint()
+	}
+
+	return p, nil
+}
+
+// ParseV1Config parses the raw config of a provider of the given class. The
+// config is optional: the public registry is used without one.
+func ParseV1Config(class string, rawCfg json.RawMessage) (*minderv1.PackageRegistryProviderConfig, error) {
+	w := map[string]*minderv1.PackageRegistryProviderConfig{}
+	if len(rawCfg) > 0 {
+		if err := json.Unmarshal(rawCfg, &w); err != nil {
+			return nil, fmt.Errorf("error parsing v1 provider config: %w", err)
+		}
+	}
+
+	cfg := w[class]
+	if cfg == nil {
+		cfg = &minderv1.PackageRegistryProviderConfig{}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating %s v1 provider config: %w", class, err)
+	}
+
+	return cfg, nil
+}
+
+// MarshalV1Config validates the raw config of a provider of the given class
+// and marshals it, keeping only the config of the class
+func MarshalV1Config(class string, rawCfg json.RawMessage) (json.RawMessage, error) {
+	cfg, err := ParseV1Config(class, rawCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]*minderv1.PackageRegistryProviderConfig{class: cfg})
+}
+
+// CanImplement returns true if the provider can implement the specified trait
+func (*packageRegistry) CanImplement(trait minderv1.ProviderType) bool {
+	return trait == minderv1.ProviderType_PROVIDER_TYPE_REST
+}
+
+// SupportsEntity implements the Provider interface
+func (*packageRegistry) SupportsEntity(entType minderv1.Entity) bool {
+	return entType == minderv1.Entity_ENTITY_ARTIFACTS
+}
+
+// CreationOptions implements the Provider interface
+func (p *packageRegistry) CreationOptions(entType minderv1.Entity) *provifv1.EntityCreationOptions {
+	if !p.SupportsEntity(entType) {
+		return nil
+	}
+
+	return &provifv1.EntityCreationOptions{
+		// the registries have no webhooks to register, new versions are polled
+		RegisterWithProvider:       false,
+		PublishReconciliationEvent: true,
+	}
+}
+
+// RegisterEntity implements the Provider interface
+func (p *packageRegistry) RegisterEntity(
+	_ context.Context, entType minderv1.Entity, props *properties.Properties,
+) (*properties.Properties, error) {
+	if !p.SupportsEntity(entType) {
+		return nil, provifv1.ErrUnsupportedEntity
+	}
+	// we don't need to do any explicit registration
+	return props, nil
+}
+
+// DeregisterEntity implements the Provider interface
+func (*packageRegistry) DeregisterEntity(_ context.Context, _ minderv1.Entity, _ *properties.Properties) error {
+	return nil
+}
+
+// GetPackage implements the PackageRegistry interface
+func (p *packageRegistry) GetPackage(ctx context.Context, name string) (*provifv1.Package, error) {
+	pkg, err := p.reg.getPackage(ctx, p.reg.normalizeName(name))
+	if err != nil {
+		return nil, err
+	}
+	return &pkg.Package, nil
+}
+
+// GetPackageIntegrity implements the PackageRegistry interface
+func (p *packageRegistry) GetPackageIntegrity(
+	ctx context.Context, name string, version *provifv1.PackageVersion,
+) (*provifv1.PackageIntegrity, error) {
+	if version == nil {
+		return nil, errors.New("missing package version")
+	}
+	return p.reg.getIntegrity(ctx, p.reg.normalizeName(name), version)
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package packageregistry
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	testhelper "github.com/mindersec/minder/pkg/providers/v1/testing"
+)
+
+func TestRegistration(t *testing.T) {
+	t.Parallel()
+
+	p, err := New(NpmClass, nil, nil)
+	require.NoError(t, err)
+	testhelper.CheckRegistrationExcept(t, p)
+}
+
+func TestClassInfo(t *testing.T) {
+	t.Parallel()
+
+	for class, name := range map[string]string{NpmClass: "npm", PyPIClass: "PyPI"} {
+		info := ClassInfo(class)
+		require.NotNil(t, info)
+
+		assert.Equal(t, class, info.Class)
+		assert.Equal(t, name, info.DisplayName)
+		assert.Equal(t, providerDocsURL, info.DocumentationUrl)
+		assert.Equal(t, []minderv1.AuthorizationFlow{
+			minderv1.AuthorizationFlow_AUTHORIZATION_FLOW_NONE,
+		}, info.SupportedAuthFlows)
+		assert.Equal(t, []minderv1.Entity{minderv1.Entity_ENTITY_ARTIFACTS}, info.SupportedEntities)
+		assert.Equal(t, []minderv1.ProviderType{minderv1.ProviderType_PROVIDER_TYPE_REST}, info.SupportedProviderTypes)
+	}
+}
+
+func TestParseV1Config(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		class    string
+		raw      string
+		endpoint string
+		wantErr  bool
+	}{
+		{
+			name:  "empty config uses the public registry",
+			class: NpmClass,
+			raw:   "{}",
+		},
+		{
+			name:     "custom endpoint",
+			class:    PyPIClass,
+			raw:      `{"pypi": {"endpoint": "https://pypi.example.com/"}}`,
+			endpoint: "https://pypi.example.com/",
+		},
+		{
+			name:  "config of another class is ignored",
+			class: NpmClass,
+			raw:   `{"pypi": {"endpoint": "https://pypi.example.com/"}}`,
+		},
+		{
+			name:    "endpoint must be http",
+			class:   NpmClass,
+			raw:     `{"npm": {"endpoint": "file:///etc/passwd"}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, err := ParseV1Config(tt.class, json.RawMessage(tt.raw))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.endpoint, cfg.GetEndpoint())
+		})
+	}
+}
+
+func TestNewDefaultEndpoint(t *testing.T) {
+	t.Parallel()
+
+	npm, err := New(NpmClass, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, npmDefaultEndpoint, npm.GetBaseURL())
+
+	pypi, err := New(PyPIClass, &minderv1.PackageRegistryProviderConfig{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, pypiDefaultEndpoint, pypi.GetBaseURL())
+
+	_, err = New("cargo", nil, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package packageregistry
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/manager"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// PollTriggerEvent is the trigger event of the evaluations of the packages
+// with a new version
+const PollTriggerEvent = "package"
+
+// Poller polls the package registries for new versions of the registered
+// packages, since the registries don't send webhooks
+type Poller struct {
+	providers db.ProvidersStore
+	entities  db.EntitiesStore
+	manager   manager.ProviderManager
+	pub       interfaces.Publisher
+	cfg       *serverconfig.PackagePollingConfig
+}
+
+// NewPoller creates a new poller of the package registries
+func NewPoller(
+	providers db.ProvidersStore,
+	entities db.EntitiesStore,
+	provManager manager.ProviderManager,
+	pub interfaces.Publisher,
+	cfg *serverconfig.PackagePollingConfig,
+) *Poller {
+	return &Poller{
+		providers: providers,
+		entities:  entities,
+		manager:   provManager,
+		pub:       pub,
+		cfg:       cfg,
+	}
+}
+
+// Run polls the package registries at regular intervals until the context is
+// cancelled
+func (p *Poller) Run(ctx context.Context) error {
+	if p.cfg.Interval <= 0 {
+		return fmt.Errorf("invalid package polling interval: %s", p.cfg.Interval)
+	}
+
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := p.PollAll(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error polling package registries")
+			}
+		}
+	}
+}
+
+// PollAll checks the packages registered with all the package registry
+// providers, and refreshes and evaluates the ones with a new latest version.
+// A failure to poll a provider doesn't stop the others from being polled.
+func (p *Poller) PollAll(ctx context.Context) error {
+	for _, class := range Classes {
+		provs, err := p.providers.GlobalListProvidersByClass(ctx, class)
+		if err != nil {
+			return fmt.Errorf("error listing %s providers: %w", class, err)
+		}
+
+		for i := range provs {
+			prov := &provs[i]
+			logger := zerolog.Ctx(ctx).With().
+				Str("provider_id", prov.ID.String()).
+				Str("project_id", prov.ProjectID.String()).
+				Logger()
+
+			if err := p.poll(logger.WithContext(ctx), prov); err != nil {
+				logger.Error().Err(err).Msg("error polling package registry")
+			}
+		}
+	}
+	return nil
+}
+
+func (p *Poller) poll(ctx context.Context, prov *db.Provider) error {
+	instance, err := p.manager.InstantiateFromID(ctx, prov.ID)
+	if err != nil {
+		return fmt.Errorf("error instantiating provider: %w", err)
+	}
+	registry, err := provifv1.As[provifv1.PackageRegistry](instance)
+	if err != nil {
+		return fmt.Errorf("provider is not a package registry: %w", err)
+	}
+
+	ents, err := p.entities.GetEntitiesByProvider(ctx, prov.ID)
+	if err != nil {
+		return fmt.Errorf("error listing entities: %w", err)
+	}
+
+	for _, ent := range ents {
+		if ent.EntityType != db.EntitiesArtifact {
+			continue
+		}
+		logger := zerolog.Ctx(ctx).With().Str("entity_id", ent.ID.String()).Logger()
+
+		changed, err := p.latestVersionChanged(ctx, registry, &ent)
+		if err != nil {
+			logger.Error().Err(err).Str("package", ent.Name).Msg("error polling package")
+			continue
+		}
+		if !changed {
+			continue
+		}
+
+		if err := p.publishRefresh(ctx, ent.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// latestVersionChanged returns whether the latest version of the package
+// differs from the one stored in the properties of its artifact
+func (p *Poller) latestVersionChanged(
+	ctx context.Context, registry provifv1.PackageRegistry, ent *db.EntityInstance,
+) (bool, error) {
+	pkg, err := registry.GetPackage(ctx, ent.Name)
+	if err != nil {
+		return false, err
+	}
+
+	var latest string
+	for _, v := range pkg.Versions {
+		if slices.Contains(v.Tags, "latest") {
+			latest = v.Version
+			break
+		}
+	}
+
+	var stored string
+	prop, err := p.entities.GetProperty(ctx, db.GetPropertyParams{
+		EntityID: ent.ID,
+		Key:      ArtifactPropertyLatestVersion,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, fmt.Errorf("error getting latest version: %w", err)
+	} else if err == nil {
+		val, err := db.PropValueFromDbV1(prop.Value)
+		if err != nil {
+			return false, fmt.Errorf("error parsing latest version: %w", err)
+		}
+		stored, _ = val.(string)
+	}
+
+	return latest != stored, nil
+}
+
+func (p *Poller) publishRefresh(ctx context.Context, entityID uuid.UUID) error {
+	msg := message.NewMessage(uuid.New().String(), nil)
+	msg.SetContext(ctx)
+	// the refresh stores the new latest version, so that the next poll
+	// doesn't evaluate the package again
+	if err := entityMessage.NewEntityRefreshAndDoMessage().
+		WithEntityID(entityID).
+		WithForceRefresh().
+		WithTriggerEvent(PollTriggerEvent).
+		ToMessage(msg); err != nil {
+		return fmt.Errorf("error creating message: %w", err)
+	}
+	if err := p.pub.Publish(constants.TopicQueueRefreshEntityByIDAndEvaluate, msg); err != nil {
+		return fmt.Errorf("error publishing message: %w", err)
+	}
+	return nil
+}
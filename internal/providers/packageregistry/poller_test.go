@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package packageregistry
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/events/stubs"
+	mockmanager "github.com/mindersec/minder/internal/providers/manager/mock"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+	mockprov "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+func TestPollerPollAll(t *testing.T) {
+	t.Parallel()
+
+	providerID := uuid.New()
+	unchangedID := uuid.New()
+	changedID := uuid.New()
+	newID := uuid.New()
+
+	storedVersions := map[uuid.UUID]string{
+		unchangedID: "1.0.0",
+		changedID:   "1.0.0",
+	}
+	latestVersions := map[string]string{
+		"unchanged": "1.0.0",
+		"changed":   "1.1.0",
+		"new":       "1.0.0",
+	}
+
+	ctrl := gomock.NewController(t)
+	providers := mockdb.NewMockProvidersStore(ctrl)
+	entities := mockdb.NewMockEntitiesStore(ctrl)
+	provManager := mockmanager.NewMockProviderManager(ctrl)
+	registry := mockprov.NewMockPackageRegistry(ctrl)
+
+	providers.EXPECT().GlobalListProvidersByClass(gomock.Any(), db.ProviderClassNpm).
+		Return([]db.Provider{{ID: providerID, Class: db.ProviderClassNpm}}, nil)
+	providers.EXPECT().GlobalListProvidersByClass(gomock.Any(), db.ProviderClassPypi).
+		Return(nil, nil)
+	provManager.EXPECT().InstantiateFromID(gomock.Any(), providerID).Return(registry, nil)
+	entities.EXPECT().GetEntitiesByProvider(gomock.Any(), providerID).Return([]db.EntityInstance{
+		{ID: unchangedID, Name: "unchanged", EntityType: db.EntitiesArtifact},
+		{ID: changedID, Name: "changed", EntityType: db.EntitiesArtifact},
+		{ID: newID, Name: "new", EntityType: db.EntitiesArtifact},
+		{ID: uuid.New(), Name: "repo", EntityType: db.EntitiesRepository},
+	}, nil)
+	registry.EXPECT().GetPackage(gomock.Any(), gomock.Any()).Times(3).
+		DoAndReturn(func(_ context.Context, name string) (*provifv1.Package, error) {
+			return &provifv1.Package{
+				Name: name,
+				Versions: []*provifv1.PackageVersion{
+					{Version: latestVersions[name], Tags: []string{"latest"}, PublishedAt: time.Now()},
+				},
+			}, nil
+		})
+	entities.EXPECT().GetProperty(gomock.Any(), gomock.Any()).Times(3).
+		DoAndReturn(func(_ context.Context, arg db.GetPropertyParams) (db.Property, error) {
+			require.Equal(t, ArtifactPropertyLatestVersion, arg.Key)
+			v, ok := storedVersions[arg.EntityID]
+			if !ok {
+				return db.Property{}, sql.ErrNoRows
+			}
+			raw, err := db.PropValueToDbV1(v)
+			require.NoError(t, err)
+			return db.Property{EntityID: arg.EntityID, Key: arg.Key, Value: raw}, nil
+		})
+
+	evt := &stubs.StubEventer{}
+	poller := NewPoller(providers, entities, provManager, evt, &serverconfig.PackagePollingConfig{Interval: time.Hour})
+	require.NoError(t, poller.PollAll(context.Background()))
+
+	require.Equal(t, []string{constants.TopicQueueRefreshEntityByIDAndEvaluate}, evt.Topics)
+	require.Len(t, evt.Sent, 2)
+	for i, wantID := range []uuid.UUID{changedID, newID} {
+		var refresh entityMessage.HandleEntityAndDoMessage
+		require.NoError(t, json.Unmarshal(evt.Sent[i].Payload, &refresh))
+		require.Equal(t, wantID, refresh.Entity.EntityID)
+		require.True(t, refresh.ForceRefresh)
+		require.Equal(t, PollTriggerEvent, refresh.TriggerEvent)
+	}
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package packageregistry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Artifact Properties
+const (
+	// ArtifactPropertyLatestVersion represents the latest version of the package
+	ArtifactPropertyLatestVersion = "package/latest_version"
+	// ArtifactPropertyCreatedAt represents the time the package was first published
+	ArtifactPropertyCreatedAt = "package/created_at"
+	// ArtifactPropertyRepositoryURL represents the URL of the source repository
+	// of the package, as declared in its metadata
+	ArtifactPropertyRepositoryURL = "package/repository_url"
+	// ArtifactPropertyHomepage represents the homepage of the package
+	ArtifactPropertyHomepage = "package/homepage"
+	// ArtifactPropertyMaintainers represents the accounts allowed to publish
+	// the package, separated by commas
+	ArtifactPropertyMaintainers = "package/maintainers"
+)
+
+// FetchAllProperties implements the provider interface
+func (p *packageRegistry) FetchAllProperties(
+	ctx context.Context, getByProps *properties.Properties, entType minderv1.Entity, _ *properties.Properties,
+) (*properties.Properties, error) {
+	if !p.SupportsEntity(entType) {
+		return nil, fmt.Errorf("entity type %s not supported", entType)
+	}
+
+	name, err := packageNameFromProps(getByProps)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := p.reg.getPackage(ctx, p.reg.normalizeName(name))
+	if err != nil {
+		return nil, err
+	}
+
+	return p.packageToProperties(pkg)
+}
+
+// FetchProperty implements the provider interface. The properties all come
+// from the same request, so it is as costly as fetching all of them.
+func (p *packageRegistry) FetchProperty(
+	ctx context.Context, getByProps *properties.Properties, entType minderv1.Entity, key string,
+) (*properties.Property, error) {
+	props, err := p.FetchAllProperties(ctx, getByProps, entType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching property %s for entity %s: %w", key, entType, err)
+	}
+	prop := props.GetProperty(key)
+	if prop == nil {
+		return nil, errors.New("requested property not found in result")
+	}
+	return prop, nil
+}
+
+// GetEntityName implements the provider interface
+func (p *packageRegistry) GetEntityName(entityType minderv1.Entity, props *properties.Properties) (string, error) {
+	if props == nil {
+		return "", errors.New("properties are nil")
+	}
+
+	if !p.SupportsEntity(entityType) {
+		return "", fmt.Errorf("entity type %s not supported", entityType)
+	}
+
+	return props.GetProperty(properties.PropertyName).AsString()
+}
+
+// PropertiesToProtoMessage implements the ProtoMessageConverter interface
+func (p *packageRegistry) PropertiesToProtoMessage(
+	entType minderv1.Entity, props *properties.Properties,
+) (protoreflect.ProtoMessage, error) {
+	if !p.SupportsEntity(entType) {
+		return nil, fmt.Errorf("entity type %s is not supported by the %s provider", entType, p.class)
+	}
+
+	return artifactV1FromProperties(props)
+}
+
+func (p *packageRegistry) packageToProperties(pkg *registryPackage) (*properties.Properties, error) {
+	var latest string
+	for _, v := range pkg.Versions {
+		if slices.Contains(v.Tags, "latest") {
+			latest = v.Version
+			break
+		}
+	}
+
+	var createdAt string
+	if !pkg.createdAt.IsZero() {
+		createdAt = pkg.createdAt.Format(time.RFC3339)
+	}
+
+	return properties.NewProperties(map[string]any{
+		// general entity
+		properties.PropertyUpstreamID: pkg.Name,
+		properties.PropertyName:       pkg.Name,
+		// general artifact
+		properties.ArtifactPropertyType: p.class,
+		// package registry
+		ArtifactPropertyLatestVersion: latest,
+		ArtifactPropertyCreatedAt:     createdAt,
+		ArtifactPropertyRepositoryURL: pkg.repositoryURL,
+		ArtifactPropertyHomepage:      pkg.homepage,
+		ArtifactPropertyMaintainers:   strings.Join(pkg.Maintainers, ","),
+	}), nil
+}
+
+// packageNameFromProps returns the name of the package to look up. Packages
+// are identified by their name, which is also their upstream ID.
+func packageNameFromProps(props *properties.Properties) (string, error) {
+	if name := props.GetProperty(properties.PropertyName).GetString(); name != "" {
+		return name, nil
+	}
+	if name := props.GetProperty(properties.PropertyUpstreamID).GetString(); name != "" {
+		return name, nil
+	}
+	return "", errors.New("missing package name")
+}
+
+func artifactV1FromProperties(props *properties.Properties) (*minderv1.Artifact, error) {
+	name, err := props.GetProperty(properties.PropertyName).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get package name: %w", err)
+	}
+
+	art := &minderv1.Artifact{
+		ArtifactPk: props.GetProperty(properties.PropertyUpstreamID).GetString(),
+		Name:       name,
+		Type:       props.GetProperty(properties.ArtifactPropertyType).GetString(),
+		// the public registries only serve public packages
+		Visibility: "public",
+		Repository: props.GetProperty(ArtifactPropertyRepositoryURL).GetString(),
+	}
+
+	if createdAt := props.GetProperty(ArtifactPropertyCreatedAt).GetString(); createdAt != "" {
+		t, err := time.Parse(time.RFC3339, createdAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse created_at time: %w", err)
+		}
+		art.CreatedAt = timestamppb.New(t)
+	}
+
+	return art, nil
+}
+
+// sortVersions sorts the versions newest first. Versions published at the
+// same time are sorted by their version string, for a stable order.
+func sortVersions(versions []*provifv1.PackageVersion) {
+	slices.SortFunc(versions, func(a, b *provifv1.PackageVersion) int {
+		if c := b.PublishedAt.Compare(a.PublishedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(b.Version, a.Version)
+	})
+}
@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package packageregistry
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	pypiDefaultEndpoint = "https://pypi.org/"
+	// pypiIntegrityMediaType is the media type of the PEP 740 integrity API
+	pypiIntegrityMediaType = "application/vnd.pypi.integrity.v1+json"
+	// sigstoreBundleMediaType is the media type of the bundles the PEP 740
+	// attestations are converted to
+	sigstoreBundleMediaType = "application/vnd.dev.sigstore.bundle.v0.3+json"
+	// inTotoPayloadType is the payload type of the DSSE envelope of the
+	// PEP 740 attestations
+	inTotoPayloadType = "application/vnd.in-toto+json"
+)
+
+// pypiNameSeparators are the separators normalized by PEP 503
+var pypiNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// pypiRegistry is PyPI, or an index implementing its JSON API
+type pypiRegistry struct {
+	p *packageRegistry
+}
+
+type pypiProject struct {
+	Info struct {
+		Name        string            `json:"name"`
+		Version     string            `json:"version"`
+		HomePage    string            `json:"home_page"`
+		ProjectURLs map[string]string `json:"project_urls"`
+	} `json:"info"`
+	Releases  map[string][]pypiFile `json:"releases"`
+	Ownership *struct {
+		Roles []struct {
+			Role string `json:"role"`
+			User string `json:"user"`
+		} `json:"roles"`
+	} `json:"ownership"`
+}
+
+type pypiFile struct {
+	Filename   string            `json:"filename"`
+	Digests    map[string]string `json:"digests"`
+	UploadTime string            `json:"upload_time_iso_8601"`
+	Yanked     bool              `json:"yanked"`
+}
+
+// pypiProvenance is the provenance of a file, as defined by PEP 740
+type pypiProvenance struct {
+	AttestationBundles []struct {
+		Attestations []pypiAttestation `json:"attestations"`
+	} `json:"attestation_bundles"`
+}
+
+type pypiAttestation struct {
+	VerificationMaterial struct {
+		Certificate         string            `json:"certificate"`
+		TransparencyEntries []json.RawMessage `json:"transparency_entries"`
+	} `json:"verification_material"`
+	Envelope struct {
+		Statement string `json:"statement"`
+		Signature string `json:"signature"`
+	} `json:"envelope"`
+}
+
+func (*pypiRegistry) defaultEndpoint() string {
+	return pypiDefaultEndpoint
+}
+
+// normalizeName normalizes the name of a project, as defined by PEP 503
+func (*pypiRegistry) normalizeName(name string) string {
+	return strings.ToLower(pypiNameSeparators.ReplaceAllString(strings.TrimSpace(name), "-"))
+}
+
+func (r *pypiRegistry) getPackage(ctx context.Context, name string) (*registryPackage, error) {
+	var doc pypiProject
+	if err := r.p.getJSON(ctx, "pypi/"+url.PathEscape(name)+"/json", "", &doc); err != nil {
+		return nil, err
+	}
+
+	pkg := &registryPackage{
+		Package: provifv1.Package{
+			Name: r.normalizeName(doc.Info.Name),
+		},
+		repositoryURL: pypiRepositoryURL(doc.Info.ProjectURLs),
+		homepage:      doc.Info.HomePage,
+	}
+	if pkg.homepage == "" {
+		pkg.homepage = projectURL(doc.Info.ProjectURLs, "homepage", "home")
+	}
+	if doc.Ownership != nil {
+		for _, role := range doc.Ownership.Roles {
+			pkg.Maintainers = append(pkg.Maintainers, role.User)
+		}
+	}
+
+	for v, files := range doc.Releases {
+		if len(files) == 0 {
+			// releases without files can't be installed
+			continue
+		}
+
+		pv := &provifv1.PackageVersion{
+			Version:    v,
+			Deprecated: true,
+		}
+		if v == doc.Info.Version {
+			pv.Tags = []string{"latest"}
+		}
+		for _, f := range files {
+			uploaded, err := time.Parse(time.RFC3339, f.UploadTime)
+			if err == nil && (pv.PublishedAt.IsZero() || uploaded.Before(pv.PublishedAt)) {
+				pv.PublishedAt = uploaded
+			}
+			// a release is yanked when all its files are
+			pv.Deprecated = pv.Deprecated && f.Yanked
+
+			digest, err := hex.DecodeString(f.Digests["sha256"])
+			if err != nil || len(digest) == 0 {
+				continue
+			}
+			pv.Files = append(pv.Files, &provifv1.PackageFile{
+				Name:            f.Filename,
+				DigestAlgorithm: "sha256",
+				Digest:          digest,
+			})
+		}
+		if pkg.createdAt.IsZero() || (!pv.PublishedAt.IsZero() && pv.PublishedAt.Before(pkg.createdAt)) {
+			pkg.createdAt = pv.PublishedAt
+		}
+		pkg.Versions = append(pkg.Versions, pv)
+	}
+	sortVersions(pkg.Versions)
+
+	return pkg, nil
+}
+
+func (r *pypiRegistry) getIntegrity(
+	ctx context.Context, name string, version *provifv1.PackageVersion,
+) (*provifv1.PackageIntegrity, error) {
+	// PyPI doesn't sign the uploaded files itself
+	out := &provifv1.PackageIntegrity{}
+
+	for _, file := range version.Files {
+		var prov pypiProvenance
+		p := fmt.Sprintf("integrity/%s/%s/%s/provenance",
+			url.PathEscape(name), url.PathEscape(version.Version), url.PathEscape(file.Name))
+		err := r.p.getJSON(ctx, p, pypiIntegrityMediaType, &prov)
+		if errors.Is(err, provifv1.ErrEntityNotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("error getting provenance of %s: %w", file.Name, err)
+		}
+
+		for _, b := range prov.AttestationBundles {
+			for _, att := range b.Attestations {
+				sigBundle, err := att.toSigstoreBundle()
+				if err != nil {
+					zerolog.Ctx(ctx).Debug().Err(err).Str("file", file.Name).Msg("error converting attestation")
+					continue
+				}
+				out.Attestations = append(out.Attestations, &provifv1.PackageAttestation{
+					File:            file.Name,
+					DigestAlgorithm: file.DigestAlgorithm,
+					Digest:          file.Digest,
+					Bundle:          sigBundle,
+				})
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// toSigstoreBundle converts a PEP 740 attestation to the sigstore bundle it
+// was made from: a DSSE envelope of an in-toto statement, signed with a
+// Fulcio certificate and logged in Rekor.
+func (a *pypiAttestation) toSigstoreBundle() ([]byte, error) {
+	if a.VerificationMaterial.Certificate == "" || a.Envelope.Statement == "" || a.Envelope.Signature == "" {
+		return nil, errors.New("incomplete attestation")
+	}
+
+	tlogEntries := a.VerificationMaterial.TransparencyEntries
+	if tlogEntries == nil {
+		tlogEntries = []json.RawMessage{}
+	}
+
+	return json.Marshal(map[string]any{
+		"mediaType": sigstoreBundleMediaType,
+		"verificationMaterial": map[string]any{
+			"certificate": map[string]any{
+				"rawBytes": a.VerificationMaterial.Certificate,
+			},
+			"tlogEntries": tlogEntries,
+		},
+		"dsseEnvelope": map[string]any{
+			"payload":     a.Envelope.Statement,
+			"payloadType": inTotoPayloadType,
+			"signatures": []map[string]any{
+				{"sig": a.Envelope.Signature},
+			},
+		},
+	})
+}
+
+// pypiRepositoryURL returns the URL of the source repository among the
+// project URLs, whose labels are free-form
+func pypiRepositoryURL(urls map[string]string) string {
+	return projectURL(urls, "source", "source code", "repository", "code", "github")
+}
+
+func projectURL(urls map[string]string, labels ...string) string {
+	for _, label := range labels {
+		for k, v := range urls {
+			if strings.EqualFold(strings.TrimSpace(k), label) {
+				return v
+			}
+		}
+	}
+	return ""
+}
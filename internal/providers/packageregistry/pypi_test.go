@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package packageregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+const testSha256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+func newPyPIServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	project := map[string]any{
+		"info": map[string]any{
+			"name":      "My_Package",
+			"version":   "2.0.0",
+			"home_page": "",
+			"project_urls": map[string]string{
+				"Homepage": "https://example.com",
+				"Source":   "https://github.com/example/my-package",
+			},
+		},
+		"ownership": map[string]any{
+			"roles": []map[string]string{{"role": "Owner", "user": "alice"}},
+		},
+		"releases": map[string]any{
+			"1.0.0": []map[string]any{{
+				"filename":             "my_package-1.0.0.tar.gz",
+				"digests":              map[string]string{"sha256": testSha256},
+				"upload_time_iso_8601": "2024-01-01T00:00:00.000000Z",
+				"yanked":               true,
+			}},
+			"2.0.0": []map[string]any{
+				{
+					"filename":             "my_package-2.0.0.tar.gz",
+					"digests":              map[string]string{"sha256": testSha256},
+					"upload_time_iso_8601": "2024-02-01T00:00:00.000000Z",
+				},
+				{
+					"filename":             "my_package-2.0.0-py3-none-any.whl",
+					"digests":              map[string]string{"sha256": testSha256},
+					"upload_time_iso_8601": "2024-02-02T00:00:00.000000Z",
+				},
+			},
+			"3.0.0a1": []map[string]any{},
+		},
+	}
+
+	provenance := map[string]any{
+		"attestation_bundles": []map[string]any{{
+			"attestations": []map[string]any{{
+				"verification_material": map[string]any{
+					"certificate":          "Y2VydA==",
+					"transparency_entries": []map[string]any{{"logIndex": "1"}},
+				},
+				"envelope": map[string]any{
+					"statement": "c3RhdGVtZW50",
+					"signature": "c2ln",
+				},
+			}},
+		}},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pypi/my-package/json":
+			_ = json.NewEncoder(w).Encode(project)
+		case "/integrity/my-package/2.0.0/my_package-2.0.0-py3-none-any.whl/provenance":
+			if r.Header.Get("Accept") != pypiIntegrityMediaType {
+				http.Error(w, "unexpected media type", http.StatusNotAcceptable)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(provenance)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestPyPIGetPackage(t *testing.T) {
+	t.Parallel()
+
+	srv := newPyPIServer(t)
+	p := newTestRegistry(t, PyPIClass, srv.URL)
+
+	// the name is normalized
+	pkg, err := p.GetPackage(context.Background(), "My.Package")
+	require.NoError(t, err)
+
+	assert.Equal(t, "my-package", pkg.Name)
+	assert.Equal(t, []string{"alice"}, pkg.Maintainers)
+	// releases without files are left out
+	require.Len(t, pkg.Versions, 2)
+
+	latest := pkg.Versions[0]
+	assert.Equal(t, "2.0.0", latest.Version)
+	assert.Equal(t, []string{"latest"}, latest.Tags)
+	assert.False(t, latest.Deprecated)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), latest.PublishedAt)
+	assert.Len(t, latest.Files, 2)
+
+	assert.Equal(t, "1.0.0", pkg.Versions[1].Version)
+	assert.True(t, pkg.Versions[1].Deprecated)
+}
+
+func TestPyPIGetPackageIntegrity(t *testing.T) {
+	t.Parallel()
+
+	srv := newPyPIServer(t)
+	p := newTestRegistry(t, PyPIClass, srv.URL)
+
+	pkg, err := p.GetPackage(context.Background(), "my-package")
+	require.NoError(t, err)
+
+	integrity, err := p.GetPackageIntegrity(context.Background(), pkg.Name, pkg.Versions[0])
+	require.NoError(t, err)
+	assert.False(t, integrity.RegistrySigned)
+	// only the wheel has a provenance
+	require.Len(t, integrity.Attestations, 1)
+
+	att := integrity.Attestations[0]
+	assert.Equal(t, "my_package-2.0.0-py3-none-any.whl", att.File)
+	assert.Equal(t, "sha256", att.DigestAlgorithm)
+	assert.JSONEq(t, `{
+		"mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json",
+		"verificationMaterial": {
+			"certificate": {"rawBytes": "Y2VydA=="},
+			"tlogEntries": [{"logIndex": "1"}]
+		},
+		"dsseEnvelope": {
+			"payload": "c3RhdGVtZW50",
+			"payloadType": "application/vnd.in-toto+json",
+			"signatures": [{"sig": "c2ln"}]
+		}
+	}`, string(att.Bundle))
+}
+
+func TestPyPIFetchAllProperties(t *testing.T) {
+	t.Parallel()
+
+	srv := newPyPIServer(t)
+	p := newTestRegistry(t, PyPIClass, srv.URL)
+
+	props, err := p.FetchAllProperties(context.Background(), properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: "my_package",
+	}), minderv1.Entity_ENTITY_ARTIFACTS, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "my-package", props.GetProperty(properties.PropertyName).GetString())
+	assert.Equal(t, "2.0.0", props.GetProperty(ArtifactPropertyLatestVersion).GetString())
+	assert.Equal(t, "2024-01-01T00:00:00Z", props.GetProperty(ArtifactPropertyCreatedAt).GetString())
+	assert.Equal(t, "https://github.com/example/my-package", props.GetProperty(ArtifactPropertyRepositoryURL).GetString())
+	assert.Equal(t, "https://example.com", props.GetProperty(ArtifactPropertyHomepage).GetString())
+}
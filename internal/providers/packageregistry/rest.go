@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package packageregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// maxResponseSize limits the size of the responses of the registry. npm
+// packuments list all the versions of a package, and can reach tens of MBs.
+const maxResponseSize = 64 << 20
+
+// Do implements the REST provider interface
+func (p *packageRegistry) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req = req.WithContext(ctx)
+	return p.cli.Do(req)
+}
+
+// GetBaseURL implements the REST provider interface
+func (p *packageRegistry) GetBaseURL() string {
+	if strings.HasSuffix(p.endpoint, "/") {
+		return p.endpoint
+	}
+	return p.endpoint + "/"
+}
+
+// NewRequest implements the REST provider interface
+func (p *packageRegistry) NewRequest(method, requestPath string, body any) (*http.Request, error) {
+	base, err := url.Parse(p.GetBaseURL())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	u, err := base.Parse(requestPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path: %w", err)
+	}
+
+	var buf io.ReadWriter
+	if body != nil {
+		buf = &bytes.Buffer{}
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+		err := enc.Encode(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequest(method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	req.Header.Set("Accept", "application/json")
+	// TODO: Get User-Agent from constants
+	req.Header.Set("User-Agent", "Minder")
+
+	return req, nil
+}
+
+// getJSON gets the JSON document at the given path of the registry. A
+// missing document is reported as ErrEntityNotFound.
+func (p *packageRegistry) getJSON(ctx context.Context, path, accept string, out any) error {
+	req, err := p.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	resp, err := p.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to get resource '%s': %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return provifv1.ErrEntityNotFound
+		}
+		return fmt.Errorf("failed to get resource '%s': %s", path, resp.Status)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
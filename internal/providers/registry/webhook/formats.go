@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	// FormatHarbor is the format of the webhooks sent by Harbor
+	FormatHarbor = "harbor"
+	// FormatQuay is the format of the notifications sent by Quay
+	FormatQuay = "quay"
+)
+
+// harborEventPushArtifact is the type of the Harbor event sent when an
+// artifact is pushed
+const harborEventPushArtifact = "PUSH_ARTIFACT"
+
+// errNotHandled is returned for events which are not image pushes
+var errNotHandled = errors.New("event not handled")
+
+// imagePush is an image pushed to a registry
+type imagePush struct {
+	// Name is the name of the image, e.g. "library/nginx"
+	Name string
+	// Tags are the tags which were pushed
+	Tags []string
+}
+
+// parser parses the payload of a webhook into the image which was pushed
+type parser func(payload []byte) (*imagePush, error)
+
+var parsers = map[string]parser{
+	FormatHarbor: parseHarborEvent,
+	FormatQuay:   parseQuayEvent,
+}
+
+// harborEvent is the payload of a Harbor webhook in the default format.
+// See https://goharbor.io/docs/main/working-with-projects/project-configuration/configure-webhooks/
+type harborEvent struct {
+	Type      string `json:"type"`
+	EventData struct {
+		Resources []struct {
+			Digest string `json:"digest"`
+			Tag    string `json:"tag"`
+		} `json:"resources"`
+		Repository struct {
+			Name         string `json:"name"`
+			Namespace    string `json:"namespace"`
+			RepoFullName string `json:"repo_full_name"`
+		} `json:"repository"`
+	} `json:"event_data"`
+}
+
+func parseHarborEvent(payload []byte) (*imagePush, error) {
+	var event harborEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("error decoding Harbor event: %w", err)
+	}
+	if event.Type != harborEventPushArtifact {
+		return nil, errNotHandled
+	}
+
+	name := event.EventData.Repository.RepoFullName
+	if name == "" {
+		return nil, errors.New("invalid Harbor event: repository name is empty")
+	}
+
+	push := &imagePush{Name: name}
+	for _, res := range event.EventData.Resources {
+		if res.Tag != "" {
+			push.Tags = append(push.Tags, res.Tag)
+		}
+	}
+	return push, nil
+}
+
+// quayEvent is the payload of a Quay "repository push" notification.
+// See https://docs.projectquay.io/use_quay.html#repository-push
+type quayEvent struct {
+	Repository  string   `json:"repository"`
+	Namespace   string   `json:"namespace"`
+	Name        string   `json:"name"`
+	UpdatedTags []string `json:"updated_tags"`
+}
+
+func parseQuayEvent(payload []byte) (*imagePush, error) {
+	var event quayEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("error decoding Quay event: %w", err)
+	}
+	// Only the repository push notifications list the updated tags
+	if len(event.UpdatedTags) == 0 {
+		return nil, errNotHandled
+	}
+
+	name := event.Repository
+	if name == "" && event.Namespace != "" && event.Name != "" {
+		name = event.Namespace + "/" + event.Name
+	}
+	if name == "" {
+		return nil, errors.New("invalid Quay event: repository name is empty")
+	}
+
+	return &imagePush{Name: name, Tags: event.UpdatedTags}, nil
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhook implements the webhook ingress for container registries
+// other than GitHub, such as Harbor or Quay. Image pushes are mapped to the
+// artifact entities registered with the same name, which are evaluated again
+// so that artifact policies react immediately.
+package webhook
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/controlplane/metrics"
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+const (
+	// Path is the path the registry webhooks are served on. The format is
+	// one of the supported webhook formats, and the provider is the ID of the
+	// provider the artifacts are registered with.
+	Path = "/api/v1/webhook/registry/{format}/{provider}"
+
+	// maxBytesLimit is the maximum number of bytes read from the request body
+	maxBytesLimit int64 = 1 << 20
+
+	// tokenQueryParam is the query parameter carrying the shared secret, for
+	// registries which can't set headers on their webhooks, like Quay
+	tokenQueryParam = "token"
+)
+
+// HandleRegistryWebhook handles the webhooks sent by container registries
+// when an image is pushed
+func HandleRegistryWebhook(
+	store db.Store,
+	mt metrics.Metrics,
+	publisher interfaces.Publisher,
+	cfg *serverconfig.RegistryWebhookConfig,
+) (http.HandlerFunc, error) {
+	secret, err := cfg.GetSecret()
+	if err != nil {
+		return nil, err
+	}
+	secret = strings.TrimSpace(secret)
+	if secret == "" {
+		return nil, errors.New("registry webhook secret is empty")
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		format := r.PathValue("format")
+
+		wes := &metrics.WebhookEventState{
+			Typ:      "registry/" + format,
+			Accepted: false,
+			Error:    true,
+		}
+		defer func() {
+			mt.AddWebhookEventTypeCount(ctx, wes)
+		}()
+
+		l := zerolog.Ctx(ctx).With().
+			Str("webhook", "registry").
+			Str("format", format).
+			Str("provider_id", r.PathValue("provider")).
+			Logger()
+
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !validToken(r, secret) {
+			l.Info().Msg("invalid registry webhook token")
+			http.Error(w, "invalid webhook request", http.StatusUnauthorized)
+			return
+		}
+
+		parse, ok := parsers[format]
+		if !ok {
+			http.Error(w, "unknown webhook format", http.StatusNotFound)
+			return
+		}
+		providerID, err := uuid.Parse(r.PathValue("provider"))
+		if err != nil {
+			http.Error(w, "invalid provider ID", http.StatusBadRequest)
+			return
+		}
+
+		payload, err := io.ReadAll(io.LimitReader(r.Body, maxBytesLimit))
+		if err != nil {
+			l.Error().Err(err).Msg("error reading webhook payload")
+			http.Error(w, "error reading webhook payload", http.StatusBadRequest)
+			return
+		}
+
+		push, err := parse(payload)
+		if errors.Is(err, errNotHandled) {
+			l.Debug().Msg("registry webhook event not handled")
+			wes.Error = false
+			w.WriteHeader(http.StatusOK)
+			return
+		} else if err != nil {
+			l.Info().Err(err).Msg("error parsing registry webhook payload")
+			http.Error(w, "invalid webhook payload", http.StatusBadRequest)
+			return
+		}
+
+		l = l.With().Str("image", push.Name).Strs("tags", push.Tags).Logger()
+		wes.Accepted = true
+
+		entityID, err := findArtifact(r, store, providerID, push.Name)
+		if errors.Is(err, sql.ErrNoRows) {
+			l.Info().Msg("no artifact registered for pushed image")
+			wes.Error = false
+			w.WriteHeader(http.StatusOK)
+			return
+		} else if err != nil {
+			l.Error().Err(err).Msg("error looking up artifact")
+			http.Error(w, "error handling webhook event", http.StatusInternalServerError)
+			return
+		}
+
+		if err := publishRefresh(publisher, entityID); err != nil {
+			l.Error().Err(err).Msg("error publishing artifact evaluation")
+			http.Error(w, "error handling webhook event", http.StatusInternalServerError)
+			return
+		}
+
+		l.Info().Str("entity_id", entityID.String()).Msg("scheduled artifact evaluation for pushed image")
+		wes.Error = false
+		w.WriteHeader(http.StatusOK)
+	}, nil
+}
+
+// validToken checks the shared secret sent in the Authorization header,
+// optionally as a bearer token, or in the token query parameter
+func validToken(r *http.Request, secret string) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		token = r.URL.Query().Get(tokenQueryParam)
+	}
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
+
+// findArtifact returns the ID of the artifact registered with the provider
+// under the name of the pushed image
+func findArtifact(r *http.Request, store db.Store, providerID uuid.UUID, name string) (uuid.UUID, error) {
+	prov, err := store.GetProviderByID(r.Context(), providerID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	ent, err := store.GetEntityByName(r.Context(), db.GetEntityByNameParams{
+		ProjectID:  prov.ProjectID,
+		EntityType: db.EntitiesArtifact,
+		Name:       strings.ToLower(name),
+		ProviderID: prov.ID,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return ent.ID, nil
+}
+
+func publishRefresh(publisher interfaces.Publisher, entityID uuid.UUID) error {
+	msg := message.NewMessage(uuid.New().String(), nil)
+	if err := entityMessage.NewEntityRefreshAndDoMessage().
+		WithEntityID(entityID).
+		ToMessage(msg); err != nil {
+		return fmt.Errorf("error creating message: %w", err)
+	}
+	return publisher.Publish(constants.TopicQueueRefreshEntityByIDAndEvaluate, msg)
+}
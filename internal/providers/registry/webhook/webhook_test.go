@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/controlplane/metrics"
+	"github.com/mindersec/minder/internal/db"
+	stubeventer "github.com/mindersec/minder/internal/events/stubs"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+const (
+	harborPush = `{
+  "type": "PUSH_ARTIFACT",
+  "occur_at": 1680501893,
+  "operator": "admin",
+  "event_data": {
+    "resources": [{"digest": "sha256:954b3789", "tag": "v1.0.0"}],
+    "repository": {"name": "app", "namespace": "library", "repo_full_name": "library/app", "repo_type": "private"}
+  }
+}`
+	harborDelete = `{"type": "DELETE_ARTIFACT", "event_data": {"repository": {"repo_full_name": "library/app"}}}`
+	quayPush     = `{
+  "name": "app",
+  "repository": "acme/app",
+  "namespace": "acme",
+  "docker_url": "quay.io/acme/app",
+  "homepage": "https://quay.io/repository/acme/app",
+  "updated_tags": ["latest", "v2"]
+}`
+	quayBuild = `{"repository": "acme/app", "build_id": "296ec063", "trigger_kind": "github"}`
+)
+
+func TestParsers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		format   string
+		payload  string
+		expected *imagePush
+		notHandl bool
+		wantErr  bool
+	}{
+		{
+			name:     "harbor push",
+			format:   FormatHarbor,
+			payload:  harborPush,
+			expected: &imagePush{Name: "library/app", Tags: []string{"v1.0.0"}},
+		},
+		{
+			name:     "harbor delete is not handled",
+			format:   FormatHarbor,
+			payload:  harborDelete,
+			notHandl: true,
+		},
+		{
+			name:    "harbor push without repository",
+			format:  FormatHarbor,
+			payload: `{"type": "PUSH_ARTIFACT"}`,
+			wantErr: true,
+		},
+		{
+			name:     "quay push",
+			format:   FormatQuay,
+			payload:  quayPush,
+			expected: &imagePush{Name: "acme/app", Tags: []string{"latest", "v2"}},
+		},
+		{
+			name:     "quay push with namespace and name",
+			format:   FormatQuay,
+			payload:  `{"namespace": "acme", "name": "app", "updated_tags": ["latest"]}`,
+			expected: &imagePush{Name: "acme/app", Tags: []string{"latest"}},
+		},
+		{
+			name:     "quay build is not handled",
+			format:   FormatQuay,
+			payload:  quayBuild,
+			notHandl: true,
+		},
+		{
+			name:    "invalid json",
+			format:  FormatQuay,
+			payload: `{`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			push, err := parsers[tt.format]([]byte(tt.payload))
+			switch {
+			case tt.notHandl:
+				require.ErrorIs(t, err, errNotHandled)
+			case tt.wantErr:
+				require.Error(t, err)
+				require.NotErrorIs(t, err, errNotHandled)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, push)
+			}
+		})
+	}
+}
+
+func TestHandleRegistryWebhook(t *testing.T) {
+	t.Parallel()
+
+	const secret = "s3cr3t"
+	providerID := uuid.New()
+	projectID := uuid.New()
+	entityID := uuid.New()
+
+	tests := []struct {
+		name         string
+		path         string
+		auth         string
+		payload      string
+		setupDbMocks func(*mockdb.MockStore)
+		expectedCode int
+		numPublish   int
+	}{
+		{
+			name:    "harbor push of registered artifact",
+			path:    "/api/v1/webhook/registry/harbor/" + providerID.String(),
+			auth:    "Bearer " + secret,
+			payload: harborPush,
+			setupDbMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByID(gomock.Any(), providerID).
+					Return(db.Provider{ID: providerID, ProjectID: projectID}, nil)
+				store.EXPECT().GetEntityByName(gomock.Any(), db.GetEntityByNameParams{
+					ProjectID:  projectID,
+					EntityType: db.EntitiesArtifact,
+					Name:       "library/app",
+					ProviderID: providerID,
+				}).Return(db.EntityInstance{ID: entityID}, nil)
+			},
+			expectedCode: http.StatusOK,
+			numPublish:   1,
+		},
+		{
+			name:    "quay push with token in query",
+			path:    "/api/v1/webhook/registry/quay/" + providerID.String() + "?token=" + secret,
+			payload: quayPush,
+			setupDbMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByID(gomock.Any(), providerID).
+					Return(db.Provider{ID: providerID, ProjectID: projectID}, nil)
+				store.EXPECT().GetEntityByName(gomock.Any(), gomock.Any()).
+					Return(db.EntityInstance{ID: entityID}, nil)
+			},
+			expectedCode: http.StatusOK,
+			numPublish:   1,
+		},
+		{
+			name:    "unregistered artifact",
+			path:    "/api/v1/webhook/registry/harbor/" + providerID.String(),
+			auth:    secret,
+			payload: harborPush,
+			setupDbMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByID(gomock.Any(), providerID).
+					Return(db.Provider{ID: providerID, ProjectID: projectID}, nil)
+				store.EXPECT().GetEntityByName(gomock.Any(), gomock.Any()).
+					Return(db.EntityInstance{}, sql.ErrNoRows)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "event not handled",
+			path:         "/api/v1/webhook/registry/harbor/" + providerID.String(),
+			auth:         secret,
+			payload:      harborDelete,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "invalid secret",
+			path:         "/api/v1/webhook/registry/harbor/" + providerID.String(),
+			auth:         "Bearer wrong",
+			payload:      harborPush,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "missing secret",
+			path:         "/api/v1/webhook/registry/harbor/" + providerID.String(),
+			payload:      harborPush,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "unknown format",
+			path:         "/api/v1/webhook/registry/docker/" + providerID.String(),
+			auth:         secret,
+			payload:      harborPush,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "invalid provider",
+			path:         "/api/v1/webhook/registry/harbor/not-a-uuid",
+			auth:         secret,
+			payload:      harborPush,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid payload",
+			path:         "/api/v1/webhook/registry/harbor/" + providerID.String(),
+			auth:         secret,
+			payload:      `{"type": "PUSH_ARTIFACT"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:    "database error",
+			path:    "/api/v1/webhook/registry/harbor/" + providerID.String(),
+			auth:    secret,
+			payload: harborPush,
+			setupDbMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProviderByID(gomock.Any(), providerID).
+					Return(db.Provider{}, errors.New("boom"))
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			if tt.setupDbMocks != nil {
+				tt.setupDbMocks(mockStore)
+			}
+			stubEventer := &stubeventer.StubEventer{}
+
+			handler, err := HandleRegistryWebhook(mockStore, metrics.NewNoopMetrics(), stubEventer,
+				&serverconfig.RegistryWebhookConfig{Enabled: true, Secret: secret})
+			require.NoError(t, err)
+
+			mux := http.NewServeMux()
+			mux.Handle(Path, handler)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.payload))
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.expectedCode, rec.Code)
+			require.Len(t, stubEventer.Sent, tt.numPublish)
+			if tt.numPublish > 0 {
+				require.Equal(t, []string{constants.TopicQueueRefreshEntityByIDAndEvaluate}, stubEventer.Topics)
+			}
+		})
+	}
+}
+
+func TestHandleRegistryWebhookEmptySecret(t *testing.T) {
+	t.Parallel()
+
+	_, err := HandleRegistryWebhook(nil, metrics.NewNoopMetrics(), &stubeventer.StubEventer{},
+		&serverconfig.RegistryWebhookConfig{Enabled: true, Secret: "  "})
+	require.Error(t, err)
+}
@@ -7,7 +7,9 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -15,6 +17,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/projects/features"
 	"github.com/mindersec/minder/internal/util"
 )
 
@@ -63,6 +66,18 @@ type ProviderStore interface {
 	Delete(ctx context.Context, providerID uuid.UUID, projectID uuid.UUID) error
 	// Update updates the provider configuration in the database
 	Update(ctx context.Context, providerID uuid.UUID, projectID uuid.UUID, config json.RawMessage) error
+	// Share shares the provider with a project below the project the
+	// provider is enrolled in. Once a provider is shared, it can only be
+	// used by the projects it is shared with and their children, besides its
+	// own project, so it can't be shared while other projects have entities
+	// registered with it.
+	Share(ctx context.Context, provider *db.Provider, projectID uuid.UUID) (db.ProviderShare, error)
+	// Unshare stops sharing the provider with a project. The projects which
+	// can't use the provider anymore must not have entities registered with
+	// it.
+	Unshare(ctx context.Context, provider *db.Provider, projectID uuid.UUID) error
+	// ListShares returns the projects the provider is shared with
+	ListShares(ctx context.Context, providerID uuid.UUID) ([]db.ProviderShare, error)
 }
 
 // ErrProviderNotFoundBy is an error type which is returned when a provider is not found
@@ -105,6 +120,17 @@ func (p *providerStore) Create(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid arguments")
 	}
 
+	// Providers which already exist fail to be created as such below,
+	// rather than requiring an upgrade
+	if err := features.CheckProviderLimit(ctx, p.store, projectID); err != nil {
+		if _, getErr := p.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+			Name:     name,
+			Projects: []uuid.UUID{projectID},
+		}); getErr != nil {
+			return nil, err
+		}
+	}
+
 	provParams := db.CreateProviderParams{
 		Name:       name,
 		ProjectID:  projectID,
@@ -213,6 +239,137 @@ func (p *providerStore) Update(ctx context.Context, providerID uuid.UUID, projec
 	})
 }
 
+func (p *providerStore) Share(ctx context.Context, provider *db.Provider, projectID uuid.UUID) (db.ProviderShare, error) {
+	if projectID == provider.ProjectID {
+		return db.ProviderShare{}, util.UserVisibleError(codes.InvalidArgument, "cannot share a provider with its own project")
+	}
+
+	// Providers can only be shared down the project hierarchy
+	parents, err := p.store.GetParentProjects(ctx, projectID)
+	if err != nil {
+		return db.ProviderShare{}, status.Errorf(codes.InvalidArgument, "cannot retrieve parent projects: %s", err)
+	}
+	if !slices.Contains(parents, provider.ProjectID) {
+		return db.ProviderShare{}, util.UserVisibleError(codes.InvalidArgument,
+			"project %s is not a child of the project of provider %s", projectID, provider.Name)
+	}
+
+	shared, err := p.sharedProjects(ctx, provider.ID)
+	if err != nil {
+		return db.ProviderShare{}, err
+	}
+	if slices.Contains(shared, projectID) {
+		return db.ProviderShare{}, alreadySharedError(provider, projectID)
+	}
+	// The first share hides the provider from the projects it is not shared
+	// with, which must not have entities registered with it
+	if err := p.checkSharesCoverEntities(ctx, provider, append(shared, projectID)); err != nil {
+		return db.ProviderShare{}, err
+	}
+
+	share, err := p.store.CreateProviderShare(ctx, db.CreateProviderShareParams{
+		ProviderID: provider.ID,
+		ProjectID:  projectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// shared concurrently
+		return db.ProviderShare{}, alreadySharedError(provider, projectID)
+	} else if err != nil {
+		return db.ProviderShare{}, fmt.Errorf("error sharing provider: %w", err)
+	}
+	return share, nil
+}
+
+func (p *providerStore) Unshare(ctx context.Context, provider *db.Provider, projectID uuid.UUID) error {
+	shared, err := p.sharedProjects(ctx, provider.ID)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(shared, projectID) {
+		return util.UserVisibleError(codes.NotFound,
+			"provider %s is not shared with project %s", provider.Name, projectID)
+	}
+	// Entities are registered in the project which shared the provider, and
+	// would be left behind with a provider their project can't use.
+	remaining := slices.DeleteFunc(shared, func(id uuid.UUID) bool { return id == projectID })
+	if err := p.checkSharesCoverEntities(ctx, provider, remaining); err != nil {
+		return err
+	}
+
+	deleted, err := p.store.DeleteProviderShare(ctx, db.DeleteProviderShareParams{
+		ProviderID: provider.ID,
+		ProjectID:  projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("error unsharing provider: %w", err)
+	}
+	if deleted == 0 {
+		return util.UserVisibleError(codes.NotFound,
+			"provider %s is not shared with project %s", provider.Name, projectID)
+	}
+	return nil
+}
+
+// sharedProjects returns the IDs of the projects the provider is shared with
+func (p *providerStore) sharedProjects(ctx context.Context, providerID uuid.UUID) ([]uuid.UUID, error) {
+	shares, err := p.ListShares(ctx, providerID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(shares))
+	for _, share := range shares {
+		ids = append(ids, share.ProjectID)
+	}
+	return ids, nil
+}
+
+// checkSharesCoverEntities checks that all the projects which registered
+// entities with the provider can still use it once it is shared with the
+// given projects only. A provider which is not shared can be used by all the
+// projects below its own.
+func (p *providerStore) checkSharesCoverEntities(
+	ctx context.Context,
+	provider *db.Provider,
+	shared []uuid.UUID,
+) error {
+	if len(shared) == 0 {
+		return nil
+	}
+
+	projects, err := p.store.ListEntityProjectsByProvider(ctx, provider.ID)
+	if err != nil {
+		return fmt.Errorf("error listing projects with entities: %w", err)
+	}
+	for _, project := range projects {
+		if project == provider.ProjectID {
+			continue
+		}
+		parents, err := p.store.GetParentProjects(ctx, project)
+		if err != nil {
+			return fmt.Errorf("cannot retrieve parent projects: %w", err)
+		}
+		if !slices.ContainsFunc(parents, func(id uuid.UUID) bool { return slices.Contains(shared, id) }) {
+			return util.UserVisibleError(codes.FailedPrecondition,
+				"project %s has entities registered with provider %s, which it would not be able to use anymore",
+				project, provider.Name)
+		}
+	}
+	return nil
+}
+
+func alreadySharedError(provider *db.Provider, projectID uuid.UUID) error {
+	return util.UserVisibleError(codes.AlreadyExists,
+		"provider %s is already shared with project %s", provider.Name, projectID)
+}
+
+func (p *providerStore) ListShares(ctx context.Context, providerID uuid.UUID) ([]db.ProviderShare, error) {
+	shares, err := p.store.ListProviderShares(ctx, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing provider shares: %w", err)
+	}
+	return shares, nil
+}
+
 // builds an error message based on the given filters.
 func filteredResultNotFoundError(name sql.NullString, trait db.NullProviderType) error {
 	e := ErrProviderNotFoundBy{}
@@ -240,9 +397,10 @@ func (p *providerStore) findProvider(
 	}
 
 	provs, err := p.store.FindProviders(ctx, db.FindProvidersParams{
-		Projects: projectHierarchy,
-		Name:     name,
-		Trait:    trait,
+		Projects:  projectHierarchy,
+		Name:      name,
+		Trait:     trait,
+		ProjectID: uuid.NullUUID{UUID: projectID, Valid: true},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("cannot retrieve providers: %w", err)
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/providers/telemetry"
+)
+
+type clientMetrics struct {
+	telemetry.ProviderMetrics
+	recorder *Recorder
+}
+
+// WrapProviderMetrics wraps the metrics of the provider HTTP clients so that
+// their API calls are also accounted to the provider stored in the context
+// of each request. The metrics are returned unchanged by a nil Recorder.
+func (r *Recorder) WrapProviderMetrics(metrics telemetry.ProviderMetrics) telemetry.ProviderMetrics {
+	if r == nil {
+		return metrics
+	}
+	return &clientMetrics{ProviderMetrics: metrics, recorder: r}
+}
+
+// NewDurationRoundTripper implements the telemetry.HttpClientMetrics interface
+func (m *clientMetrics) NewDurationRoundTripper(
+	wrapped http.RoundTripper,
+	providerType db.ProviderType,
+) (http.RoundTripper, error) {
+	rt, err := m.ProviderMetrics.NewDurationRoundTripper(wrapped, providerType)
+	if err != nil {
+		return nil, err
+	}
+	return &countingRoundTripper{base: rt, recorder: m.recorder}, nil
+}
+
+type countingRoundTripper struct {
+	base     http.RoundTripper
+	recorder *Recorder
+}
+
+func (c *countingRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	c.recorder.RecordAPICall(r.Context())
+	return c.base.RoundTrip(r)
+}
+
+// installationPayload is the part of the GitHub webhook payloads identifying
+// the app installation they were delivered for
+type installationPayload struct {
+	Installation *struct {
+		ID int64 `json:"id"`
+	} `json:"installation"`
+}
+
+// WebhookMiddleware accounts the GitHub webhooks handled successfully by next
+// to the installation they were delivered for. Webhooks which don't identify
+// an installation are not accounted. Handlers are returned unchanged by a nil
+// Recorder.
+func (r *Recorder) WebhookMiddleware(next http.Handler) http.Handler {
+	if r == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, "error reading request body", http.StatusBadRequest)
+			return
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, req)
+
+		// only webhooks accepted by the handler have a valid signature
+		if sw.status >= http.StatusBadRequest {
+			return
+		}
+		var payload installationPayload
+		if err := json.Unmarshal(body, &payload); err != nil || payload.Installation == nil {
+			return
+		}
+		r.RecordInstallationWebhook(payload.Installation.ID)
+	})
+}
+
+// statusWriter records the status code written by a handler
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusWriter) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
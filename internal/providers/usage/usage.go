@@ -0,0 +1,282 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package usage records the API calls, webhooks and evaluations of each
+// provider over time, so that operators can tell which installations drive
+// the load of the instance and plan the rate-limit budgets of their GitHub Apps.
+package usage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// BucketSize is the period over which usage is accounted
+const BucketSize = time.Hour
+
+type key int
+
+const providerKey key = iota
+
+// WithProvider stores the ID of the provider on behalf of which the work of
+// the context is performed, so that the API calls made with the context are
+// accounted to the provider.
+func WithProvider(ctx context.Context, providerID uuid.UUID) context.Context {
+	return context.WithValue(ctx, providerKey, providerID)
+}
+
+// providerFromContext returns the provider stored in the context, if any
+func providerFromContext(ctx context.Context) (uuid.UUID, bool) {
+	providerID, ok := ctx.Value(providerKey).(uuid.UUID)
+	return providerID, ok
+}
+
+// Counts is the usage of a provider in a bucket
+type Counts struct {
+	APICalls    int64
+	Webhooks    int64
+	Evaluations int64
+}
+
+func (c *Counts) add(other *Counts) {
+	c.APICalls += other.APICalls
+	c.Webhooks += other.Webhooks
+	c.Evaluations += other.Evaluations
+}
+
+type providerBucket struct {
+	providerID uuid.UUID
+	bucket     time.Time
+}
+
+// GitHub webhooks identify the app installation they were delivered for,
+// which is only resolved to its provider when flushing
+type installationBucket struct {
+	installationID int64
+	bucket         time.Time
+}
+
+// Recorder accounts the usage of the providers in memory, and periodically
+// adds it to the usage stored in the database. All the methods of a nil
+// Recorder are no-ops, so callers don't need to check whether recording is
+// enabled.
+type Recorder struct {
+	store db.ProvidersStore
+	cfg   *serverconfig.ProviderUsageConfig
+	now   func() time.Time
+
+	mu            sync.Mutex
+	usage         map[providerBucket]*Counts
+	installations map[installationBucket]int64
+	lastPurge     time.Time
+}
+
+// NewRecorder creates a new recorder of the usage of the providers
+func NewRecorder(store db.ProvidersStore, cfg *serverconfig.ProviderUsageConfig) *Recorder {
+	return &Recorder{
+		store:         store,
+		cfg:           cfg,
+		now:           time.Now,
+		usage:         make(map[providerBucket]*Counts),
+		installations: make(map[installationBucket]int64),
+	}
+}
+
+// RecordAPICall accounts an API call to the provider stored in the context.
+// Calls made without a provider in the context are not accounted.
+func (r *Recorder) RecordAPICall(ctx context.Context) {
+	if r == nil {
+		return
+	}
+	providerID, ok := providerFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.countsFor(providerID).APICalls++
+}
+
+// RecordEvaluation accounts an entity evaluation of the provider
+func (r *Recorder) RecordEvaluation(providerID uuid.UUID) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.countsFor(providerID).Evaluations++
+}
+
+// RecordInstallationWebhook accounts a webhook delivered for a GitHub App
+// installation to the provider the installation was enrolled as
+func (r *Recorder) RecordInstallationWebhook(installationID int64) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.installations[installationBucket{installationID: installationID, bucket: r.bucket()}]++
+}
+
+// Run adds the recorded usage to the database at regular intervals until the
+// context is cancelled, and deletes the usage older than the retention period
+func (r *Recorder) Run(ctx context.Context) error {
+	if r.cfg.FlushInterval <= 0 {
+		return fmt.Errorf("invalid provider usage flush interval: %s", r.cfg.FlushInterval)
+	}
+
+	ticker := time.NewTicker(r.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// don't lose the usage recorded since the last flush
+			if err := r.Flush(context.WithoutCancel(ctx)); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error flushing provider usage")
+			}
+			return nil
+		case <-ticker.C:
+			if err := r.Flush(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error flushing provider usage")
+			}
+			if err := r.purge(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error deleting expired provider usage")
+			}
+		}
+	}
+}
+
+// Flush adds the usage recorded since the previous flush to the database.
+// The usage which could not be written is kept for the next flush.
+func (r *Recorder) Flush(ctx context.Context) error {
+	r.mu.Lock()
+	usage := r.usage
+	installations := r.installations
+	r.usage = make(map[providerBucket]*Counts)
+	r.installations = make(map[installationBucket]int64)
+	r.mu.Unlock()
+
+	// the webhooks of an installation span few buckets, so each
+	// installation is only resolved once per flush
+	resolved := make(map[int64]uuid.UUID)
+	for ib, webhooks := range installations {
+		providerID, err := r.resolveInstallation(ctx, resolved, ib.installationID)
+		if errors.Is(err, sql.ErrNoRows) {
+			// webhooks of installations which are not enrolled are not accounted
+			continue
+		} else if err != nil {
+			r.restore(nil, map[installationBucket]int64{ib: webhooks})
+			zerolog.Ctx(ctx).Error().Err(err).Int64("installation_id", ib.installationID).
+				Msg("error resolving the provider of an installation")
+			continue
+		}
+		pb := providerBucket{providerID: providerID, bucket: ib.bucket}
+		if _, ok := usage[pb]; !ok {
+			usage[pb] = &Counts{}
+		}
+		usage[pb].Webhooks += webhooks
+	}
+
+	var errs []error
+	for pb, c := range usage {
+		err := r.store.AddProviderUsage(ctx, db.AddProviderUsageParams{
+			ProviderID:  pb.providerID,
+			Bucket:      pb.bucket,
+			ApiCalls:    c.APICalls,
+			Webhooks:    c.Webhooks,
+			Evaluations: c.Evaluations,
+		})
+		if db.ErrIsForeignKeyViolation(err) {
+			// the provider was deleted since the usage was recorded
+			continue
+		} else if err != nil {
+			r.restore(map[providerBucket]*Counts{pb: c}, nil)
+			errs = append(errs, fmt.Errorf("error adding usage of provider %s: %w", pb.providerID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// purge deletes the usage older than the retention period, at most once per bucket
+func (r *Recorder) purge(ctx context.Context) error {
+	now := r.now()
+	if now.Sub(r.lastPurge) < BucketSize {
+		return nil
+	}
+
+	deleted, err := r.store.DeleteProviderUsageBefore(ctx, now.Add(-r.cfg.Retention))
+	if err != nil {
+		return err
+	}
+	r.lastPurge = now
+	zerolog.Ctx(ctx).Debug().Int64("deleted", deleted).Msg("deleted expired provider usage")
+	return nil
+}
+
+// resolveInstallation returns the provider the installation is enrolled as
+func (r *Recorder) resolveInstallation(
+	ctx context.Context,
+	resolved map[int64]uuid.UUID,
+	installationID int64,
+) (uuid.UUID, error) {
+	if providerID, ok := resolved[installationID]; ok {
+		return providerID, nil
+	}
+
+	installation, err := r.store.GetInstallationIDByAppID(ctx, installationID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !installation.ProviderID.Valid {
+		// the installation is not claimed by a project yet
+		return uuid.Nil, sql.ErrNoRows
+	}
+
+	resolved[installationID] = installation.ProviderID.UUID
+	return installation.ProviderID.UUID, nil
+}
+
+// restore adds back usage which could not be flushed
+func (r *Recorder) restore(usage map[providerBucket]*Counts, installations map[installationBucket]int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for pb, c := range usage {
+		if _, ok := r.usage[pb]; !ok {
+			r.usage[pb] = &Counts{}
+		}
+		r.usage[pb].add(c)
+	}
+	for ib, webhooks := range installations {
+		r.installations[ib] += webhooks
+	}
+}
+
+// countsFor returns the counts of the provider in the current bucket.
+// Must be called with the lock held.
+func (r *Recorder) countsFor(providerID uuid.UUID) *Counts {
+	pb := providerBucket{providerID: providerID, bucket: r.bucket()}
+	c, ok := r.usage[pb]
+	if !ok {
+		c = &Counts{}
+		r.usage[pb] = c
+	}
+	return c
+}
+
+func (r *Recorder) bucket() time.Time {
+	return r.now().UTC().Truncate(BucketSize)
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/providers/telemetry"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestRecorderFlush(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockProvidersStore(ctrl)
+
+	providerID := uuid.New()
+	now := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+	bucket := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	r := NewRecorder(store, &serverconfig.ProviderUsageConfig{})
+	r.now = func() time.Time { return now }
+
+	ctx := WithProvider(context.Background(), providerID)
+	r.RecordAPICall(ctx)
+	r.RecordAPICall(ctx)
+	// calls without a provider are not accounted
+	r.RecordAPICall(context.Background())
+	r.RecordEvaluation(providerID)
+	r.RecordInstallationWebhook(42)
+	r.RecordInstallationWebhook(42)
+	// installations which are not enrolled are not accounted
+	r.RecordInstallationWebhook(7)
+
+	store.EXPECT().GetInstallationIDByAppID(gomock.Any(), int64(42)).
+		Return(db.ProviderGithubAppInstallation{
+			AppInstallationID: 42,
+			ProviderID:        uuid.NullUUID{UUID: providerID, Valid: true},
+		}, nil)
+	store.EXPECT().GetInstallationIDByAppID(gomock.Any(), int64(7)).
+		Return(db.ProviderGithubAppInstallation{}, sql.ErrNoRows)
+	store.EXPECT().AddProviderUsage(gomock.Any(), db.AddProviderUsageParams{
+		ProviderID:  providerID,
+		Bucket:      bucket,
+		ApiCalls:    2,
+		Webhooks:    2,
+		Evaluations: 1,
+	}).Return(nil)
+
+	require.NoError(t, r.Flush(context.Background()))
+
+	// nothing is left to flush
+	require.NoError(t, r.Flush(context.Background()))
+}
+
+func TestRecorderFlushKeepsFailedUsage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockProvidersStore(ctrl)
+
+	providerID := uuid.New()
+	r := NewRecorder(store, &serverconfig.ProviderUsageConfig{})
+	r.RecordEvaluation(providerID)
+
+	store.EXPECT().AddProviderUsage(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+	require.Error(t, r.Flush(context.Background()))
+
+	r.RecordEvaluation(providerID)
+	store.EXPECT().AddProviderUsage(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.AddProviderUsageParams) error {
+			require.Equal(t, int64(2), arg.Evaluations)
+			return nil
+		})
+	require.NoError(t, r.Flush(context.Background()))
+}
+
+func TestRecorderHTTP(t *testing.T) {
+	t.Parallel()
+
+	providerID := uuid.New()
+	r := NewRecorder(nil, &serverconfig.ProviderUsageConfig{})
+
+	metrics := r.WrapProviderMetrics(telemetry.NewNoopMetrics())
+	rt, err := metrics.NewDurationRoundTripper(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), db.ProviderTypeGithub)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/acme/widgets", nil)
+	_, err = rt.RoundTrip(req.WithContext(WithProvider(req.Context(), providerID)))
+	require.NoError(t, err)
+
+	handler := r.WebhookMiddleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("X-Hub-Signature-256") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	for _, signature := range []string{"sha256=valid", "sha256=valid", ""} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/github/",
+			strings.NewReader(`{"action": "opened", "installation": {"id": 42}}`))
+		if signature != "" {
+			req.Header.Set("X-Hub-Signature-256", signature)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	require.Equal(t, int64(1), r.usage[providerBucket{providerID: providerID, bucket: r.bucket()}].APICalls)
+	require.Equal(t, int64(2), r.installations[installationBucket{installationID: 42, bucket: r.bucket()}])
+}
+
+func TestNilRecorder(t *testing.T) {
+	t.Parallel()
+
+	var r *Recorder
+	r.RecordAPICall(WithProvider(context.Background(), uuid.New()))
+	r.RecordEvaluation(uuid.New())
+	r.RecordInstallationWebhook(42)
+
+	metrics := telemetry.NewNoopMetrics()
+	require.Equal(t, metrics, r.WrapProviderMetrics(metrics))
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package quota enforces the per-project API request quotas
+package quota
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// Quota is the token bucket configuration of a project
+type Quota struct {
+	// Rate is the number of requests per second the project may sustain
+	Rate float64 `json:"rate"`
+	// Burst is the maximum number of requests the project may make at once
+	Burst int32 `json:"burst"`
+}
+
+type cachedQuota struct {
+	quota   Quota
+	expires time.Time
+}
+
+// Limiter enforces the API request quotas of the projects. The token buckets
+// are stored in the database, so that all the server instances share them,
+// while the quota of each project is cached for the configured TTL.
+type Limiter struct {
+	quotas       db.APIQuotasStore
+	entitlements db.EntitlementsStore
+	cfg          *serverconfig.APIQuotaConfig
+	now          func() time.Time
+
+	mu    sync.Mutex
+	cache map[uuid.UUID]cachedQuota
+}
+
+// NewLimiter creates a new quota limiter, which stores the token buckets in
+// quotas and looks up the tiers of the projects in entitlements
+func NewLimiter(
+	quotas db.APIQuotasStore,
+	entitlements db.EntitlementsStore,
+	cfg *serverconfig.APIQuotaConfig,
+) (*Limiter, error) {
+	if cfg.Rate <= 0 {
+		return nil, fmt.Errorf("api quota rate must be positive, got %v", cfg.Rate)
+	}
+	if cfg.Burst < 1 {
+		return nil, fmt.Errorf("api quota burst must be at least 1, got %d", cfg.Burst)
+	}
+
+	return &Limiter{
+		quotas:       quotas,
+		entitlements: entitlements,
+		cfg:          cfg,
+		now:          time.Now,
+		cache:        make(map[uuid.UUID]cachedQuota),
+	}, nil
+}
+
+// Allow takes a token from the bucket of the project. If the project has
+// exhausted its quota, it returns false and how long the caller should wait
+// before retrying.
+func (l *Limiter) Allow(ctx context.Context, projectID uuid.UUID) (bool, time.Duration, error) {
+	quota := l.quotaFor(ctx, projectID)
+
+	_, err := l.quotas.TakeAPIQuotaToken(ctx, db.TakeAPIQuotaTokenParams{
+		ProjectID: projectID,
+		Burst:     float64(quota.Burst),
+		Rate:      quota.Rate,
+	})
+	if err == nil {
+		return true, 0, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return false, 0, fmt.Errorf("error taking api quota token: %w", err)
+	}
+
+	secs, err := l.quotas.GetAPIQuotaRetryAfter(ctx, db.GetAPIQuotaRetryAfterParams{
+		Burst:     float64(quota.Burst),
+		Rate:      quota.Rate,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return false, 0, fmt.Errorf("error getting api quota retry delay: %w", err)
+	}
+
+	// Round up, so that a client retrying after the delay gets a token
+	return false, time.Duration(math.Ceil(secs)) * time.Second, nil
+}
+
+// quotaFor returns the quota of the project, which is the quota of the first
+// tier the project is entitled to, or the default one.
+func (l *Limiter) quotaFor(ctx context.Context, projectID uuid.UUID) Quota {
+	now := l.now()
+
+	l.mu.Lock()
+	cached, ok := l.cache[projectID]
+	l.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.quota
+	}
+
+	quota := l.lookupQuota(ctx, projectID)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// Drop the expired entries, so that the cache doesn't grow with every
+	// project that ever made a request
+	for id, c := range l.cache {
+		if !now.Before(c.expires) {
+			delete(l.cache, id)
+		}
+	}
+	l.cache[projectID] = cachedQuota{quota: quota, expires: now.Add(l.cfg.TierCacheTTL)}
+
+	return quota
+}
+
+func (l *Limiter) lookupQuota(ctx context.Context, projectID uuid.UUID) Quota {
+	quota := Quota{Rate: l.cfg.Rate, Burst: l.cfg.Burst}
+
+	for _, tier := range l.cfg.Tiers {
+		settings, err := l.entitlements.GetFeatureInProject(ctx, db.GetFeatureInProjectParams{
+			ProjectID: projectID,
+			Feature:   tier,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		} else if err != nil {
+			// Fall back to the default quota rather than failing the request
+			zerolog.Ctx(ctx).Error().Err(err).Str("feature", tier).
+				Msg("error checking api quota tier for project")
+			return quota
+		}
+
+		var tierQuota Quota
+		if err := json.Unmarshal(settings, &tierQuota); err != nil ||
+			tierQuota.Rate <= 0 || tierQuota.Burst < 1 {
+			zerolog.Ctx(ctx).Error().Err(err).Str("feature", tier).
+				Msg("invalid api quota tier settings")
+			continue
+		}
+		return tierQuota
+	}
+
+	return quota
+}
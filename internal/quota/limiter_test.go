@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func newTestLimiter(
+	t *testing.T,
+	quotas db.APIQuotasStore,
+	entitlements db.EntitlementsStore,
+	tiers ...string,
+) *Limiter {
+	t.Helper()
+
+	limiter, err := NewLimiter(quotas, entitlements, &serverconfig.APIQuotaConfig{
+		Enabled:      true,
+		Rate:         10,
+		Burst:        100,
+		Tiers:        tiers,
+		TierCacheTTL: time.Minute,
+	})
+	require.NoError(t, err)
+	return limiter
+}
+
+func TestNewLimiterInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewLimiter(nil, nil, &serverconfig.APIQuotaConfig{Rate: 0, Burst: 10})
+	require.Error(t, err)
+	_, err = NewLimiter(nil, nil, &serverconfig.APIQuotaConfig{Rate: 1, Burst: 0})
+	require.Error(t, err)
+}
+
+func TestAllow(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+
+	tests := []struct {
+		name       string
+		buildStubs func(quotas *mockdb.MockAPIQuotasStore)
+		allowed    bool
+		retryAfter time.Duration
+		wantErr    bool
+	}{
+		{
+			name: "token available",
+			buildStubs: func(quotas *mockdb.MockAPIQuotasStore) {
+				quotas.EXPECT().TakeAPIQuotaToken(gomock.Any(), db.TakeAPIQuotaTokenParams{
+					ProjectID: projectID, Burst: 100, Rate: 10,
+				}).Return(float64(99), nil)
+			},
+			allowed: true,
+		},
+		{
+			name: "quota exhausted",
+			buildStubs: func(quotas *mockdb.MockAPIQuotasStore) {
+				quotas.EXPECT().TakeAPIQuotaToken(gomock.Any(), gomock.Any()).
+					Return(float64(0), sql.ErrNoRows)
+				quotas.EXPECT().GetAPIQuotaRetryAfter(gomock.Any(), db.GetAPIQuotaRetryAfterParams{
+					Burst: 100, Rate: 10, ProjectID: projectID,
+				}).Return(0.05, nil)
+			},
+			allowed:    false,
+			retryAfter: time.Second,
+		},
+		{
+			name: "database error",
+			buildStubs: func(quotas *mockdb.MockAPIQuotasStore) {
+				quotas.EXPECT().TakeAPIQuotaToken(gomock.Any(), gomock.Any()).
+					Return(float64(0), errors.New("boom"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			quotas := mockdb.NewMockAPIQuotasStore(ctrl)
+			tt.buildStubs(quotas)
+
+			allowed, retryAfter, err := newTestLimiter(t, quotas, nil).Allow(context.Background(), projectID)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.allowed, allowed)
+			require.Equal(t, tt.retryAfter, retryAfter)
+		})
+	}
+}
+
+func TestAllowTiers(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	ctrl := gomock.NewController(t)
+	quotas := mockdb.NewMockAPIQuotasStore(ctrl)
+	entitlements := mockdb.NewMockEntitlementsStore(ctrl)
+
+	premium, err := json.Marshal(Quota{Rate: 50, Burst: 500})
+	require.NoError(t, err)
+
+	// The tiers are only looked up once, while the quota is cached
+	entitlements.EXPECT().GetFeatureInProject(gomock.Any(), db.GetFeatureInProjectParams{
+		ProjectID: projectID, Feature: "api_quota_enterprise",
+	}).Return(nil, sql.ErrNoRows)
+	entitlements.EXPECT().GetFeatureInProject(gomock.Any(), db.GetFeatureInProjectParams{
+		ProjectID: projectID, Feature: "api_quota_premium",
+	}).Return(json.RawMessage(premium), nil)
+	quotas.EXPECT().TakeAPIQuotaToken(gomock.Any(), db.TakeAPIQuotaTokenParams{
+		ProjectID: projectID, Burst: 500, Rate: 50,
+	}).Return(float64(499), nil).Times(2)
+
+	limiter := newTestLimiter(t, quotas, entitlements, "api_quota_enterprise", "api_quota_premium")
+	for range 2 {
+		allowed, _, err := limiter.Allow(context.Background(), projectID)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+}
+
+func TestAllowTierCacheExpires(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	ctrl := gomock.NewController(t)
+	quotas := mockdb.NewMockAPIQuotasStore(ctrl)
+	entitlements := mockdb.NewMockEntitlementsStore(ctrl)
+
+	entitlements.EXPECT().GetFeatureInProject(gomock.Any(), gomock.Any()).
+		Return(nil, sql.ErrNoRows).Times(2)
+	quotas.EXPECT().TakeAPIQuotaToken(gomock.Any(), db.TakeAPIQuotaTokenParams{
+		ProjectID: projectID, Burst: 100, Rate: 10,
+	}).Return(float64(99), nil).Times(2)
+
+	limiter := newTestLimiter(t, quotas, entitlements, "api_quota_premium")
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+
+	_, _, err := limiter.Allow(context.Background(), projectID)
+	require.NoError(t, err)
+
+	now = now.Add(2 * time.Minute)
+	_, _, err = limiter.Allow(context.Background(), projectID)
+	require.NoError(t, err)
+}
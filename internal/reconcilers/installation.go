@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcilers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/providers/github/installations"
+	"github.com/mindersec/minder/internal/reconcilers/messages"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+// installationEntityTypes are the types of the entities whose rule types are
+// checked against the permissions of an installation
+var installationEntityTypes = []db.Entities{
+	db.EntitiesRepository,
+	db.EntitiesArtifact,
+	db.EntitiesPullRequest,
+	db.EntitiesRelease,
+}
+
+// handleInstallationEvent handles changes of the permissions or the
+// repositories of a provider installation. The repositories added to or
+// removed from the installation are registered and unregistered by the
+// entity add and delete reconcilers, while this handler re-checks whether the
+// rule types in use can still be executed with the permissions granted to the
+// installation, and evaluates the entities of the provider again when the
+// permissions changed.
+func (r *Reconciler) handleInstallationEvent(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var evt messages.InstallationReconcilerEvent
+	if err := json.Unmarshal(msg.Payload, &evt); err != nil {
+		// We don't return the event since there's no use
+		// retrying it if it's invalid.
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error unmarshalling event")
+		return nil
+	}
+
+	// validate event
+	validate := validator.New()
+	if err := validate.Struct(&evt); err != nil {
+		// We don't return the event since there's no use
+		// retrying it if it's invalid.
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error validating event")
+		return nil
+	}
+
+	ctx = zerolog.Ctx(ctx).With().
+		Str("provider_id", evt.ProviderID.String()).
+		Str("project_id", evt.ProjectID.String()).
+		Logger().WithContext(ctx)
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).ProviderID = evt.ProviderID
+	logger.BusinessRecord(ctx).Project = evt.ProjectID
+
+	zerolog.Ctx(ctx).Info().Bool("permissions_changed", evt.PermissionsChanged).
+		Msg("handling installation event")
+
+	if err := r.checkRuleTypePermissions(ctx, &evt); err != nil {
+		return err
+	}
+
+	if !evt.PermissionsChanged {
+		return nil
+	}
+	return r.publishProviderEntityEvaluations(ctx, evt.ProviderID)
+}
+
+// checkRuleTypePermissions warns about the rule types in use in the project
+// which can't be executed with the permissions granted to the installation
+func (r *Reconciler) checkRuleTypePermissions(ctx context.Context, evt *messages.InstallationReconcilerEvent) error {
+	if len(evt.Permissions) == 0 {
+		zerolog.Ctx(ctx).Debug().Msg("no permissions in event, skipping rule type checks")
+		return nil
+	}
+
+	projects, err := r.store.GetParentProjects(ctx, evt.ProjectID)
+	if err != nil {
+		return fmt.Errorf("error getting parent projects: %w", err)
+	}
+
+	checked := make(map[uuid.UUID]bool)
+	for _, entType := range installationEntityTypes {
+		rts, err := r.store.GetRuleTypesByEntityInHierarchy(ctx, db.GetRuleTypesByEntityInHierarchyParams{
+			EntityType: entType,
+			Projects:   projects,
+		})
+		if err != nil {
+			return fmt.Errorf("error getting rule types: %w", err)
+		}
+
+		for i := range rts {
+			rt := &rts[i]
+			if checked[rt.ID] {
+				continue
+			}
+			checked[rt.ID] = true
+
+			def, err := ruletypes.RuleDefFromDB(rt)
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Str("rule_type", rt.Name).Msg("error parsing rule type definition")
+				continue
+			}
+
+			missing := installations.MissingPermissions(installations.RequiredPermissions(def), evt.Permissions)
+			if len(missing) > 0 {
+				zerolog.Ctx(ctx).Warn().
+					Str("rule_type", rt.Name).
+					Str("rule_type_id", rt.ID.String()).
+					Strs("missing_permissions", missing).
+					Msg("rule type can't be executed with the permissions of the installation")
+			}
+		}
+	}
+
+	return nil
+}
+
+// publishProviderEntityEvaluations evaluates all entities of the provider
+// again, so that their evaluation status reflects the new permissions
+func (r *Reconciler) publishProviderEntityEvaluations(ctx context.Context, providerID uuid.UUID) error {
+	ents, err := r.store.GetEntitiesByProvider(ctx, providerID)
+	if err != nil {
+		// we retry in case the database is having a bad day
+		return fmt.Errorf("cannot get entities: %w", err)
+	}
+
+	for _, ent := range ents {
+		entRefresh := entityMessage.NewEntityRefreshAndDoMessage().
+			WithEntityID(ent.ID)
+
+		m := message.NewMessage(uuid.New().String(), nil)
+		m.SetContext(ctx)
+
+		if err := entRefresh.ToMessage(m); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("error marshalling message")
+			// Skip this entity but continue processing the rest
+			continue
+		}
+
+		if err := r.evt.Publish(constants.TopicQueueRefreshEntityByIDAndEvaluate, m); err != nil {
+			// we retry in case watermill is having a bad day
+			return fmt.Errorf("error publishing message: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcilers
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	stubeventer "github.com/mindersec/minder/internal/events/stubs"
+	"github.com/mindersec/minder/internal/reconcilers/messages"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func Test_handleInstallationEvent(t *testing.T) {
+	t.Parallel()
+
+	providerID := uuid.New()
+	projectID := uuid.New()
+	ruleTypeID := uuid.New()
+
+	ruleType := db.RuleType{
+		ID:         ruleTypeID,
+		Name:       "pr_remediation",
+		Definition: json.RawMessage(`{"ingest": {"type": "git"}, "remediate": {"type": "pull_request"}}`),
+	}
+
+	scenarios := []struct {
+		name         string
+		event        messages.InstallationReconcilerEvent
+		setupDbMocks func(*mockdb.MockStore)
+		numPublish   int
+		expectedErr  bool
+	}{
+		{
+			name: "permissions changed",
+			event: messages.InstallationReconcilerEvent{
+				ProviderID:         providerID,
+				ProjectID:          projectID,
+				Permissions:        map[string]string{"contents": "read"},
+				PermissionsChanged: true,
+			},
+			setupDbMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetParentProjects(gomock.Any(), projectID).
+					Return([]uuid.UUID{projectID}, nil)
+				// the rule type is only checked once, whatever the number of
+				// entity types using it
+				store.EXPECT().GetRuleTypesByEntityInHierarchy(gomock.Any(), gomock.Any()).
+					Return([]db.RuleType{ruleType}, nil).Times(len(installationEntityTypes))
+				store.EXPECT().GetEntitiesByProvider(gomock.Any(), providerID).
+					Return([]db.EntityInstance{{ID: uuid.New()}, {ID: uuid.New()}}, nil)
+			},
+			numPublish: 2,
+		},
+		{
+			name: "repositories changed",
+			event: messages.InstallationReconcilerEvent{
+				ProviderID:  providerID,
+				ProjectID:   projectID,
+				Permissions: map[string]string{"contents": "write", "pull_requests": "write"},
+			},
+			setupDbMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetParentProjects(gomock.Any(), projectID).
+					Return([]uuid.UUID{projectID}, nil)
+				store.EXPECT().GetRuleTypesByEntityInHierarchy(gomock.Any(), gomock.Any()).
+					Return(nil, nil).Times(len(installationEntityTypes))
+			},
+		},
+		{
+			name: "no permissions",
+			event: messages.InstallationReconcilerEvent{
+				ProviderID: providerID,
+				ProjectID:  projectID,
+			},
+			setupDbMocks: func(*mockdb.MockStore) {},
+		},
+		{
+			name: "invalid event",
+			event: messages.InstallationReconcilerEvent{
+				Permissions: map[string]string{"contents": "read"},
+			},
+			setupDbMocks: func(*mockdb.MockStore) {},
+		},
+		{
+			name: "error getting rule types",
+			event: messages.InstallationReconcilerEvent{
+				ProviderID:         providerID,
+				ProjectID:          projectID,
+				Permissions:        map[string]string{"contents": "read"},
+				PermissionsChanged: true,
+			},
+			setupDbMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetParentProjects(gomock.Any(), projectID).
+					Return([]uuid.UUID{projectID}, nil)
+				store.EXPECT().GetRuleTypesByEntityInHierarchy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("boom"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			scenario.setupDbMocks(mockStore)
+			stubEventer := &stubeventer.StubEventer{}
+
+			reconciler, err := NewReconciler(mockStore, stubEventer, nil, nil, nil)
+			require.NoError(t, err)
+
+			msg := message.NewMessage(uuid.New().String(), nil)
+			require.NoError(t, scenario.event.ToMessage(msg))
+
+			err = reconciler.handleInstallationEvent(msg)
+			if scenario.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, scenario.numPublish, len(stubEventer.Sent))
+			if scenario.numPublish > 0 {
+				require.Contains(t, stubEventer.Topics, constants.TopicQueueRefreshEntityByIDAndEvaluate)
+			}
+		})
+	}
+}
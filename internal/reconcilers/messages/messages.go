@@ -43,6 +43,33 @@ func NewRepoReconcilerMessage(providerID uuid.UUID, entityID uuid.UUID, projectI
 	return msg, nil
 }
 
+// InstallationReconcilerEvent is an event that is sent to the installation
+// reconciler topic when the permissions or the repositories of a provider
+// installation change
+type InstallationReconcilerEvent struct {
+	ProviderID uuid.UUID `json:"provider_id" validate:"required"`
+	ProjectID  uuid.UUID `json:"project_id" validate:"required"`
+	// Permissions are the permissions granted to the installation
+	Permissions map[string]string `json:"permissions"`
+	// PermissionsChanged is set when new permissions were accepted for the
+	// installation, in which case the entities of the provider are evaluated again
+	PermissionsChanged bool `json:"permissions_changed"`
+}
+
+// ToMessage sets the event as the payload of the message
+func (e *InstallationReconcilerEvent) ToMessage(msg *message.Message) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("error marshalling event: %w", err)
+	}
+
+	msg.Payload = payload
+	msg.Metadata.Set("providerID", e.ProviderID.String())
+	msg.Metadata.Set("projectID", e.ProjectID.String())
+
+	return nil
+}
+
 // CoreContext contains information necessary to further process
 // events inside Minder Core.
 type CoreContext struct {
@@ -46,4 +46,5 @@ func (r *Reconciler) Register(reg interfaces.Registrar) {
 	reg.Register(constants.TopicQueueReconcileProfileInit, r.handleProfileInitEvent)
 	reg.Register(constants.TopicQueueReconcileEntityDelete, r.handleEntityDeleteEvent)
 	reg.Register(constants.TopicQueueReconcileEntityAdd, r.handleEntityAddEvent)
+	reg.Register(constants.TopicQueueReconcileInstallation, r.handleInstallationEvent)
 }
@@ -132,3 +132,17 @@ func (mr *MockRepositoryServiceMockRecorder) ListRepositories(ctx, projectID, pr
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositories", reflect.TypeOf((*MockRepositoryService)(nil).ListRepositories), ctx, projectID, providerID)
 }
+
+// RenameRepository mocks base method.
+func (m *MockRepositoryService) RenameRepository(ctx context.Context, repoID, projectID uuid.UUID, name string, upstreamProps *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameRepository", ctx, repoID, projectID, name, upstreamProps)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameRepository indicates an expected call of RenameRepository.
+func (mr *MockRepositoryServiceMockRecorder) RenameRepository(ctx, repoID, projectID, name, upstreamProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameRepository", reflect.TypeOf((*MockRepositoryService)(nil).RenameRepository), ctx, repoID, projectID, name, upstreamProps)
+}
@@ -62,6 +62,16 @@ type RepositoryService interface {
 		providerName string,
 	) error
 
+	// RenameRepository updates the name and properties of a registered
+	// repository which was renamed upstream.
+	RenameRepository(
+		ctx context.Context,
+		repoID uuid.UUID,
+		projectID uuid.UUID,
+		name string,
+		upstreamProps *properties.Properties,
+	) error
+
 	// ListRepositories retrieves all repositories for the
 	// specific provider and project.
 	ListRepositories(
@@ -395,6 +405,36 @@ func (r *repositoryService) DeleteByName(
 	return r.deleteRepository(ctx, prov, ent)
 }
 
+func (r *repositoryService) RenameRepository(
+	ctx context.Context,
+	repositoryID uuid.UUID,
+	projectID uuid.UUID,
+	name string,
+	upstreamProps *properties.Properties,
+) error {
+	logger.BusinessRecord(ctx).Project = projectID
+	logger.BusinessRecord(ctx).Repository = repositoryID
+
+	return r.store.WithTransactionErr(func(t db.ExtendQuerier) error {
+		if err := t.UpdateEntityName(ctx, db.UpdateEntityNameParams{
+			ID:        repositoryID,
+			ProjectID: projectID,
+			Name:      name,
+		}); err != nil {
+			return fmt.Errorf("error renaming repository: %w", err)
+		}
+
+		// Save rather than replace, so properties set at registration
+		// time (e.g. the webhook ID) are kept
+		if err := r.propSvc.SaveAllProperties(ctx, repositoryID, upstreamProps,
+			service.CallBuilder().WithStoreOrTransaction(t)); err != nil {
+			return fmt.Errorf("error saving repository properties: %w", err)
+		}
+
+		return nil
+	})
+}
+
 func (r *repositoryService) deleteRepository(
 	ctx context.Context, client provifv1.Provider, repo *models.EntityWithProperties,
 ) error {
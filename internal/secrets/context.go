@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+type batchCacheKey struct{}
+
+// batchCache holds the secret values decrypted during an evaluation batch
+type batchCache struct {
+	mu     sync.Mutex
+	values map[uuid.UUID]map[string]string
+}
+
+// WithBatchCache returns a context in which the secrets resolved by
+// ResolveParams are cached, so that the secrets referenced by all the rules
+// evaluated for an entity are fetched and decrypted once. The values are
+// dropped along with the context.
+func WithBatchCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, batchCacheKey{}, &batchCache{
+		values: make(map[uuid.UUID]map[string]string),
+	})
+}
+
+func batchCacheFromContext(ctx context.Context) *batchCache {
+	cache, _ := ctx.Value(batchCacheKey{}).(*batchCache)
+	return cache
+}
+
+func (c *batchCache) get(projectID uuid.UUID, name string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	val, ok := c.values[projectID][name]
+	return val, ok
+}
+
+func (c *batchCache) set(projectID uuid.UUID, name, value string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.values[projectID] == nil {
+		c.values[projectID] = make(map[string]string)
+	}
+	c.values[projectID][name] = value
+}
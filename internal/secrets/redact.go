@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"errors"
+	"strings"
+
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+// redactedValue replaces the secret values in the redacted outputs
+const redactedValue = "[REDACTED]"
+
+// Redact replaces the secret values in s
+func (r *ResolvedParams) Redact(s string) string {
+	if r == nil {
+		return s
+	}
+	for _, val := range r.values {
+		s = strings.ReplaceAll(s, val, redactedValue)
+	}
+	return s
+}
+
+// RedactError returns an error classified as err, whose messages don't
+// contain any secret value. Rule types may echo their parameters in the
+// details of a failure, which are stored in the evaluation history and
+// included in alerts.
+func (r *ResolvedParams) RedactError(err error) error {
+	if r == nil || err == nil {
+		return err
+	}
+
+	var evalErr *evalerrors.EvaluationError
+	if errors.As(err, &evalErr) {
+		details := evalErr.Details()
+		if r.Redact(details) == details && r.Redact(err.Error()) == err.Error() {
+			return err
+		}
+		return &evalerrors.EvaluationError{
+			Base: evalErr.Base,
+			Msg:  r.Redact(details),
+		}
+	}
+
+	if msg := r.Redact(err.Error()); msg != err.Error() {
+		return &redactedError{err: err, msg: msg}
+	}
+	return err
+}
+
+// redactedError keeps the classification of an error whose message has
+// been redacted
+type redactedError struct {
+	err error
+	msg string
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
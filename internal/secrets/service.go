@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package secrets provides a project-scoped secret store. Secrets can be
+// referenced from rule parameters as `{{ secret "name" }}` and are resolved
+// server-side at evaluation time. Secret values are never returned by the
+// service once stored.
+package secrets
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+)
+
+var (
+	// ErrSecretNotFound is returned when a referenced secret does not exist
+	ErrSecretNotFound = errors.New("secret not found")
+	// ErrInvalidSecretName is returned when a secret name is not valid
+	ErrInvalidSecretName = errors.New("invalid secret name")
+)
+
+var (
+	secretNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,62}$`)
+	// secretRefRegex matches the `{{ secret "name" }}` references in rule parameters
+	secretRefRegex = regexp.MustCompile(`\{\{\s*secret\s+"([^"]*)"\s*\}\}`)
+)
+
+// ParamResolver resolves secret references in rule parameters
+type ParamResolver interface {
+	// ResolveParams returns a copy of params in which all secret references
+	// have been replaced by the secret values of the project. Within a
+	// context returned by WithBatchCache, each secret is only fetched and
+	// decrypted once.
+	ResolveParams(ctx context.Context, projectID uuid.UUID, params map[string]any) (*ResolvedParams, error)
+}
+
+// ResolvedParams are rule parameters in which the secret references have
+// been replaced by the secret values. They must only be handed to the rule
+// evaluation and actions, never persisted or exported.
+type ResolvedParams struct {
+	// Params are the parameters with the secret values
+	Params map[string]any
+	// values are the secret values, to be redacted from the outputs
+	values []string
+}
+
+// Service manages project-scoped secrets
+type Service interface {
+	ParamResolver
+
+	// SetSecret creates or replaces a secret in the project
+	SetSecret(ctx context.Context, projectID uuid.UUID, name, value string) error
+	// DeleteSecret deletes a secret from the project
+	DeleteSecret(ctx context.Context, projectID uuid.UUID, name string) error
+	// ListSecrets returns the names of the secrets in the project. Values are never returned.
+	ListSecrets(ctx context.Context, projectID uuid.UUID) ([]string, error)
+}
+
+type secretService struct {
+	store        db.ProjectSecretsStore
+	cryptoEngine crypto.Engine
+}
+
+// NewSecretService creates a new secret service
+func NewSecretService(store db.ProjectSecretsStore, cryptoEngine crypto.Engine) Service {
+	return &secretService{
+		store:        store,
+		cryptoEngine: cryptoEngine,
+	}
+}
+
+// ValidateName checks that a secret name is valid
+func ValidateName(name string) error {
+	if !secretNameRegex.MatchString(name) {
+		return fmt.Errorf("%w: %q", ErrInvalidSecretName, name)
+	}
+	return nil
+}
+
+func (s *secretService) SetSecret(ctx context.Context, projectID uuid.UUID, name, value string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+
+	encrypted, err := s.cryptoEngine.EncryptString(value)
+	if err != nil {
+		return fmt.Errorf("error encrypting secret: %w", err)
+	}
+	serialized, err := encrypted.Serialize()
+	if err != nil {
+		return fmt.Errorf("error serializing secret: %w", err)
+	}
+
+	_, err = s.store.UpsertProjectSecret(ctx, db.UpsertProjectSecretParams{
+		ProjectID:      projectID,
+		Name:           name,
+		EncryptedValue: serialized,
+	})
+	if err != nil {
+		return fmt.Errorf("error storing secret: %w", err)
+	}
+	return nil
+}
+
+func (s *secretService) DeleteSecret(ctx context.Context, projectID uuid.UUID, name string) error {
+	deleted, err := s.store.DeleteProjectSecret(ctx, db.DeleteProjectSecretParams{
+		ProjectID: projectID,
+		Name:      name,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting secret: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: %s", ErrSecretNotFound, name)
+	}
+	return nil
+}
+
+func (s *secretService) ListSecrets(ctx context.Context, projectID uuid.UUID) ([]string, error) {
+	secrets, err := s.store.ListProjectSecrets(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing secrets: %w", err)
+	}
+
+	names := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		names = append(names, secret.Name)
+	}
+	return names, nil
+}
+
+func (s *secretService) ResolveParams(
+	ctx context.Context,
+	projectID uuid.UUID,
+	params map[string]any,
+) (*ResolvedParams, error) {
+	cache := batchCacheFromContext(ctx)
+	// keep track of the secrets so that a secret referenced several times
+	// is only decrypted once, and can be redacted
+	resolved := map[string]string{}
+	lookup := func(name string) (string, error) {
+		if val, ok := resolved[name]; ok {
+			return val, nil
+		}
+		val, ok := cache.get(projectID, name)
+		if !ok {
+			var err error
+			val, err = s.getSecretValue(ctx, projectID, name)
+			if err != nil {
+				return "", err
+			}
+			cache.set(projectID, name, val)
+		}
+		resolved[name] = val
+		return val, nil
+	}
+
+	out, err := resolveValue(params, lookup)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(resolved))
+	for _, val := range resolved {
+		if val != "" {
+			values = append(values, val)
+		}
+	}
+	return &ResolvedParams{
+		Params: out.(map[string]any),
+		values: values,
+	}, nil
+}
+
+func (s *secretService) getSecretValue(ctx context.Context, projectID uuid.UUID, name string) (string, error) {
+	secret, err := s.store.GetProjectSecretByName(ctx, db.GetProjectSecretByNameParams{
+		ProjectID: projectID,
+		Name:      name,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("%w: %s", ErrSecretNotFound, name)
+	} else if err != nil {
+		return "", fmt.Errorf("error retrieving secret %s: %w", name, err)
+	}
+
+	encrypted, err := crypto.DeserializeEncryptedData(secret.EncryptedValue)
+	if err != nil {
+		return "", fmt.Errorf("error deserializing secret %s: %w", name, err)
+	}
+	value, err := s.cryptoEngine.DecryptString(encrypted)
+	if err != nil {
+		return "", fmt.Errorf("error decrypting secret %s: %w", name, err)
+	}
+	return value, nil
+}
+
+// resolveValue walks a parameter value, replacing secret references in all strings
+func resolveValue(val any, lookup func(string) (string, error)) (any, error) {
+	switch v := val.(type) {
+	case string:
+		return resolveString(v, lookup)
+	case map[string]any:
+		if v == nil {
+			return v, nil
+		}
+		out := make(map[string]any, len(v))
+		for key, inner := range v {
+			res, err := resolveValue(inner, lookup)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = res
+		}
+		return out, nil
+	case []any:
+		out := make([]any, 0, len(v))
+		for _, inner := range v {
+			res, err := resolveValue(inner, lookup)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, res)
+		}
+		return out, nil
+	default:
+		return val, nil
+	}
+}
+
+func resolveString(s string, lookup func(string) (string, error)) (string, error) {
+	var lookupErr error
+	out := secretRefRegex.ReplaceAllStringFunc(s, func(ref string) string {
+		if lookupErr != nil {
+			return ref
+		}
+		name := secretRefRegex.FindStringSubmatch(ref)[1]
+		val, err := lookup(name)
+		if err != nil {
+			lookupErr = err
+			return ref
+		}
+		return val
+	})
+	if lookupErr != nil {
+		return "", lookupErr
+	}
+	return out, nil
+}
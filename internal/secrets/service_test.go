@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/crypto"
+	mockcrypto "github.com/mindersec/minder/internal/crypto/mock"
+	"github.com/mindersec/minder/internal/db"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+func TestResolveParams(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	encrypted := crypto.EncryptedData{EncodedData: "encrypted"}
+	serialized, err := json.Marshal(encrypted)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		params      map[string]any
+		setup       func(*mockdb.MockProjectSecretsStore, *mockcrypto.MockEngine)
+		expected    map[string]any
+		expectedErr error
+	}{
+		{
+			name:     "no references",
+			params:   map[string]any{"branch": "main", "count": 3},
+			setup:    func(*mockdb.MockProjectSecretsStore, *mockcrypto.MockEngine) {},
+			expected: map[string]any{"branch": "main", "count": 3},
+		},
+		{
+			name: "nested references are resolved once",
+			params: map[string]any{
+				"token":  `{{ secret "api-token" }}`,
+				"header": `Bearer {{secret "api-token"}}`,
+				"nested": map[string]any{"list": []any{`{{ secret "api-token" }}`, "plain"}},
+			},
+			setup: func(store *mockdb.MockProjectSecretsStore, engine *mockcrypto.MockEngine) {
+				store.EXPECT().GetProjectSecretByName(gomock.Any(), db.GetProjectSecretByNameParams{
+					ProjectID: projectID,
+					Name:      "api-token",
+				}).Return(db.ProjectSecret{Name: "api-token", EncryptedValue: serialized}, nil)
+				engine.EXPECT().DecryptString(encrypted).Return("s3cr3t", nil)
+			},
+			expected: map[string]any{
+				"token":  "s3cr3t",
+				"header": "Bearer s3cr3t",
+				"nested": map[string]any{"list": []any{"s3cr3t", "plain"}},
+			},
+		},
+		{
+			name:   "missing secret",
+			params: map[string]any{"token": `{{ secret "missing" }}`},
+			setup: func(store *mockdb.MockProjectSecretsStore, _ *mockcrypto.MockEngine) {
+				store.EXPECT().GetProjectSecretByName(gomock.Any(), gomock.Any()).
+					Return(db.ProjectSecret{}, sql.ErrNoRows)
+			},
+			expectedErr: ErrSecretNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockProjectSecretsStore(ctrl)
+			engine := mockcrypto.NewMockEngine(ctrl)
+			tt.setup(store, engine)
+
+			svc := NewSecretService(store, engine)
+			resolved, err := svc.ResolveParams(context.Background(), projectID, tt.params)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resolved.Params)
+		})
+	}
+}
+
+func TestResolveParamsBatchCache(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	encrypted := crypto.EncryptedData{EncodedData: "encrypted"}
+	serialized, err := json.Marshal(encrypted)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockProjectSecretsStore(ctrl)
+	engine := mockcrypto.NewMockEngine(ctrl)
+	// the secret is fetched and decrypted once per batch
+	store.EXPECT().GetProjectSecretByName(gomock.Any(), gomock.Any()).
+		Return(db.ProjectSecret{Name: "api-token", EncryptedValue: serialized}, nil).Times(2)
+	engine.EXPECT().DecryptString(encrypted).Return("s3cr3t", nil).Times(2)
+
+	svc := NewSecretService(store, engine)
+	params := map[string]any{"token": `{{ secret "api-token" }}`}
+
+	for range 2 {
+		ctx := WithBatchCache(context.Background())
+		for range 3 {
+			resolved, err := svc.ResolveParams(ctx, projectID, params)
+			require.NoError(t, err)
+			require.Equal(t, "s3cr3t", resolved.Params["token"])
+		}
+	}
+	// the references of the rule are left untouched
+	require.Equal(t, `{{ secret "api-token" }}`, params["token"])
+}
+
+func TestRedactError(t *testing.T) {
+	t.Parallel()
+
+	resolved := &ResolvedParams{
+		Params: map[string]any{"token": "s3cr3t"},
+		values: []string{"s3cr3t"},
+	}
+
+	evalErr := resolved.RedactError(evalerrors.NewErrEvaluationFailed("token s3cr3t is not allowed"))
+	require.ErrorIs(t, evalErr, interfaces.ErrEvaluationFailed)
+	require.NotContains(t, evalErr.Error(), "s3cr3t")
+
+	otherErr := resolved.RedactError(fmt.Errorf("%w: bad token s3cr3t", evalerrors.ErrInvalidRuleParameters))
+	require.ErrorIs(t, otherErr, evalerrors.ErrInvalidRuleParameters)
+	require.Equal(t, "invalid rule parameters: bad token [REDACTED]", otherErr.Error())
+
+	plainErr := errors.New("no secret here")
+	require.Equal(t, plainErr, resolved.RedactError(plainErr))
+
+	var none *ResolvedParams
+	require.Equal(t, plainErr, none.RedactError(plainErr))
+}
+
+func TestSetSecretValidatesName(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	svc := NewSecretService(mockdb.NewMockProjectSecretsStore(ctrl), mockcrypto.NewMockEngine(ctrl))
+
+	err := svc.SetSecret(context.Background(), uuid.New(), "not a valid name", "value")
+	require.ErrorIs(t, err, ErrInvalidSecretName)
+}
@@ -10,8 +10,10 @@ import (
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/open-feature/go-sdk/openfeature"
+	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/mindersec/minder/internal/anomaly"
 	"github.com/mindersec/minder/internal/auth"
 	"github.com/mindersec/minder/internal/auth/jwt"
 	"github.com/mindersec/minder/internal/authz"
@@ -20,22 +22,32 @@ import (
 	"github.com/mindersec/minder/internal/crypto"
 	datasourcessvc "github.com/mindersec/minder/internal/datasources/service"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/deploykeys"
 	"github.com/mindersec/minder/internal/eea"
 	"github.com/mindersec/minder/internal/email/awsses"
 	"github.com/mindersec/minder/internal/email/noop"
 	"github.com/mindersec/minder/internal/email/sendgrid"
 	"github.com/mindersec/minder/internal/email/smtp"
 	"github.com/mindersec/minder/internal/engine"
+	"github.com/mindersec/minder/internal/engine/actions/alert/routing"
+	"github.com/mindersec/minder/internal/engine/alertgroup"
+	"github.com/mindersec/minder/internal/engine/freeze"
+	"github.com/mindersec/minder/internal/engine/retry"
 	"github.com/mindersec/minder/internal/entities/handlers"
 	propService "github.com/mindersec/minder/internal/entities/properties/service"
+	"github.com/mindersec/minder/internal/entities/retention"
 	entityService "github.com/mindersec/minder/internal/entities/service"
 	"github.com/mindersec/minder/internal/entities/service/validators"
+	"github.com/mindersec/minder/internal/evalexport"
+	"github.com/mindersec/minder/internal/faults"
 	"github.com/mindersec/minder/internal/history"
 	"github.com/mindersec/minder/internal/invites"
 	"github.com/mindersec/minder/internal/marketplaces"
 	"github.com/mindersec/minder/internal/metrics/meters"
+	"github.com/mindersec/minder/internal/pipeline"
 	"github.com/mindersec/minder/internal/projects"
 	"github.com/mindersec/minder/internal/providers"
+	azuredevopsmanager "github.com/mindersec/minder/internal/providers/azuredevops/manager"
 	"github.com/mindersec/minder/internal/providers/dockerhub"
 	ghprov "github.com/mindersec/minder/internal/providers/github"
 	"github.com/mindersec/minder/internal/providers/github/clients"
@@ -43,17 +55,24 @@ import (
 	ghmanager "github.com/mindersec/minder/internal/providers/github/manager"
 	"github.com/mindersec/minder/internal/providers/github/service"
 	gitlabmanager "github.com/mindersec/minder/internal/providers/gitlab/manager"
+	"github.com/mindersec/minder/internal/providers/health"
 	"github.com/mindersec/minder/internal/providers/manager"
+	"github.com/mindersec/minder/internal/providers/packageregistry"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	"github.com/mindersec/minder/internal/providers/session"
 	provtelemetry "github.com/mindersec/minder/internal/providers/telemetry"
+	providerusage "github.com/mindersec/minder/internal/providers/usage"
+	"github.com/mindersec/minder/internal/quota"
 	"github.com/mindersec/minder/internal/reconcilers"
 	"github.com/mindersec/minder/internal/reminderprocessor"
 	"github.com/mindersec/minder/internal/repositories"
 	"github.com/mindersec/minder/internal/roles"
+	"github.com/mindersec/minder/internal/secrets"
+	"github.com/mindersec/minder/internal/usage"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/engine/selectors"
+	enginif "github.com/mindersec/minder/pkg/engine/v1/interfaces"
 	"github.com/mindersec/minder/pkg/eventer"
 	"github.com/mindersec/minder/pkg/eventer/interfaces"
 	"github.com/mindersec/minder/pkg/flags"
@@ -62,7 +81,8 @@ import (
 )
 
 // AllInOneServerService is a helper function that starts the gRPC and HTTP servers,
-// the eventer, aggregator, the executor, and the reconciler.
+// the eventer, aggregator, the executor, and the reconciler. The serverOpts are
+// applied to the gRPC server after the options built from the configuration.
 //
 //nolint:gocyclo // This function is expected to be large
 func AllInOneServerService(
@@ -78,6 +98,7 @@ func AllInOneServerService(
 	providerMetrics provtelemetry.ProviderMetrics,
 	executorMiddleware []message.HandlerMiddleware,
 	meterFactory meters.MeterFactory,
+	serverOpts ...controlplane.ServerOption,
 ) error {
 	errg, ctx := errgroup.WithContext(ctx)
 	flags.OpenFeatureProviderFromFlags(ctx, cfg.Flags)
@@ -88,6 +109,19 @@ func AllInOneServerService(
 		return fmt.Errorf("unable to setup eventer: %w", err)
 	}
 
+	var pipelineMonitor *pipeline.Monitor
+	if cfg.Profiling.Enabled {
+		pipelineMonitor = pipeline.NewMonitor()
+		evt = pipeline.WrapEventer(evt, pipelineMonitor)
+	}
+
+	var actionFaults *faults.Injector
+	if cfg.FaultInjection.Enabled {
+		zerolog.Ctx(ctx).Warn().Msg("fault injection is enabled, do not use in production")
+		evt = faults.WrapEventer(evt, faults.NewInjector(cfg.FaultInjection.Handlers, cfg.FaultInjection.Seed))
+		actionFaults = faults.NewInjector(cfg.FaultInjection.Actions, cfg.FaultInjection.Seed)
+	}
+
 	cryptoEngine, err := crypto.NewEngineFromConfig(cfg)
 	if err != nil {
 		return fmt.Errorf("failed to create crypto engine: %w", err)
@@ -102,13 +136,26 @@ func AllInOneServerService(
 	ruleSvc := ruletypes.NewRuleTypeService(featureFlagClient)
 	roleScv := roles.NewRoleService()
 	dataSourcesSvc := datasourcessvc.NewDataSourceService(store)
-	marketplace, err := marketplaces.NewMarketplaceFromServiceConfig(cfg.Marketplace, profileSvc, ruleSvc, dataSourcesSvc)
+	marketplace, err := marketplaces.NewMarketplaceFromServiceConfig(ctx, cfg.Marketplace, profileSvc, ruleSvc, dataSourcesSvc)
 	if err != nil {
 		return fmt.Errorf("failed to create marketplace: %w", err)
 	}
 
+	var ruleTypeSignatures *marketplaces.SignatureChecker
+	if cfg.RuleTypeSignatures.Enabled() {
+		ruleTypeSignatures, err = marketplaces.NewSignatureChecker(&cfg.RuleTypeSignatures)
+		if err != nil {
+			return fmt.Errorf("unable to create rule type signature checker: %w", err)
+		}
+	}
+
+	var providerUsage *providerusage.Recorder
+	if cfg.ProviderUsage.Enabled {
+		providerUsage = providerusage.NewRecorder(store, &cfg.ProviderUsage)
+	}
+
 	fallbackTokenClient := ghprov.NewFallbackTokenClient(cfg.Provider)
-	ghClientFactory := clients.NewGitHubClientFactory(providerMetrics)
+	ghClientFactory := clients.NewGitHubClientFactory(providerUsage.WrapProviderMetrics(providerMetrics))
 	providerStore := providers.NewProviderStore(store)
 	projectCreator := projects.NewProjectCreator(
 		authzClient,
@@ -170,6 +217,26 @@ func AllInOneServerService(
 		provmans = append(provmans, gitlabProviderManager)
 	}
 
+	if flags.Bool(ctx, featureFlagClient, flags.AzureDevOpsProvider) {
+		azureDevOpsProviderManager, err := azuredevopsmanager.NewAzureDevOpsProviderClassManager(
+			ctx,
+			cryptoEngine,
+			store,
+			evt,
+			cfg.Provider.AzureDevOps,
+			cfg.WebhookConfig,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create azure devops provider manager: %w", err)
+		}
+
+		provmans = append(provmans, azureDevOpsProviderManager)
+	}
+
+	if flags.Bool(ctx, featureFlagClient, flags.PackageRegistryProviders) {
+		provmans = append(provmans, packageregistry.NewPackageRegistryProviderClassManager(nil))
+	}
+
 	providerManager, closer, err := manager.NewProviderManager(ctx, providerStore,
 		provmans...)
 	if err != nil {
@@ -202,6 +269,29 @@ func AllInOneServerService(
 	sessionsService := session.NewProviderSessionService(providerManager, providerStore, store)
 	entSvc := entityService.NewEntityService(store, propSvc, providerManager)
 
+	var usageTracker *usage.Tracker
+	if cfg.Fairness.Enabled {
+		usageTracker, err = usage.NewTracker(&cfg.Fairness, meterFactory)
+		if err != nil {
+			return fmt.Errorf("unable to create usage tracker: %w", err)
+		}
+	}
+
+	var quotaLimiter *quota.Limiter
+	if cfg.APIQuota.Enabled {
+		quotaLimiter, err = quota.NewLimiter(store, store, &cfg.APIQuota)
+		if err != nil {
+			return fmt.Errorf("unable to create api quota limiter: %w", err)
+		}
+	}
+
+	serverOpts = append([]controlplane.ServerOption{
+		controlplane.WithUsageTracker(usageTracker),
+		controlplane.WithProviderUsage(providerUsage),
+		controlplane.WithQuotaLimiter(quotaLimiter),
+		controlplane.WithPipelineMonitor(pipelineMonitor),
+		controlplane.WithRuleTypeSignatures(ruleTypeSignatures),
+	}, serverOpts...)
 	s := controlplane.NewServer(
 		store,
 		evt,
@@ -230,6 +320,7 @@ func AllInOneServerService(
 		entSvc,
 		entityCreator,
 		featureFlagClient,
+		serverOpts...,
 	)
 
 	// Subscribe to events from the identity server
@@ -257,6 +348,42 @@ func AllInOneServerService(
 	profileStore := profiles.NewProfileStore(store)
 	selEnv := selectors.NewEnv()
 
+	var ownerNotifier *routing.OwnerNotifier
+	if cfg.AlertRouting.Enabled {
+		if err := cfg.AlertRouting.Validate(); err != nil {
+			return fmt.Errorf("invalid alert routing configuration: %w", err)
+		}
+		ownerNotifier = routing.NewOwnerNotifier(evt, &cfg.AlertRouting)
+	}
+
+	stores := db.NewStores(store)
+
+	freezer, err := freeze.NewFreezer(&cfg.ActionFreeze, featureFlagClient)
+	if err != nil {
+		return fmt.Errorf("failed to create action freezer: %w", err)
+	}
+
+	var anomalyDetector *anomaly.Detector
+	if cfg.AnomalyDetection.Enabled {
+		anomalyDetector = anomaly.NewDetector(stores.EvalHistory, evt, &cfg.AnomalyDetection)
+	}
+
+	var evalExporter *evalexport.Exporter
+	if cfg.EvaluationLogExport.Enabled {
+		evalExporter, err = evalexport.NewExporter(ctx, &cfg.EvaluationLogExport)
+		if err != nil {
+			return fmt.Errorf("unable to create evaluation log exporter: %w", err)
+		}
+		defer func() {
+			// Export the events still buffered once the server stops
+			shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cfg.EvaluationLogExport.Timeout)
+			defer cancel()
+			if err := evalExporter.Shutdown(shutdownCtx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error shutting down evaluation log exporter")
+			}
+		}()
+	}
+
 	// Register the executor to handle entity evaluations
 	exec := engine.NewExecutor(
 		store,
@@ -267,6 +394,24 @@ func AllInOneServerService(
 		profileStore,
 		selEnv,
 		propSvc,
+		engine.WithOwnerNotifier(ownerNotifier),
+		engine.WithSecretResolver(secrets.NewSecretService(stores.ProjectSecrets, cryptoEngine)),
+		engine.WithDeployKeys(deploykeys.NewDeployKeyService(stores.ProjectDeployKeys, cryptoEngine)),
+		engine.WithTrustRoots(projects.NewSigstoreTrustRootResolver(store)),
+		engine.WithAlertTemplates(projects.NewAlertTemplatesResolver(store)),
+		engine.WithUsageTracker(usageTracker),
+		engine.WithProviderUsage(providerUsage),
+		engine.WithActionFaults(actionFaults),
+		engine.WithRuleLimits(enginif.Limits{
+			Timeout:            cfg.RuleLimits.Timeout,
+			MaxRegoMemoryBytes: cfg.RuleLimits.MaxRegoMemoryBytes,
+			MaxDataSourceCalls: cfg.RuleLimits.MaxDataSourceCalls,
+		}),
+		engine.WithRetryPolicy(retry.NewPolicy(&cfg.EvaluationRetry)),
+		engine.WithFreezer(freezer),
+		engine.WithPipelineMonitor(pipelineMonitor),
+		engine.WithAnomalyDetector(anomalyDetector),
+		engine.WithEvalExporter(evalExporter),
 	)
 
 	handler := engine.NewExecutorEventHandler(
@@ -299,7 +444,8 @@ func AllInOneServerService(
 	addOriginatingEntity := handlers.NewAddOriginatingEntityHandler(evt, store, propSvc, providerManager, entityCreator)
 	evt.ConsumeEvents(addOriginatingEntity)
 
-	delOriginatingEntity := handlers.NewRemoveOriginatingEntityHandler(evt, store, propSvc, providerManager)
+	delOriginatingEntity := handlers.NewRemoveOriginatingEntityHandler(
+		evt, store, propSvc, providerManager, cfg.PullRequestRetention.ClosedRetention > 0)
 	evt.ConsumeEvents(delOriginatingEntity)
 
 	getAndDeleteEntity := handlers.NewGetEntityAndDeleteHandler(evt, store, propSvc)
@@ -346,6 +492,84 @@ func AllInOneServerService(
 		return evt.Run(ctx)
 	})
 
+	if cfg.EvaluationRetry.Enabled {
+		retryScheduler := retry.NewScheduler(stores.EvalRetries, evt, &cfg.EvaluationRetry)
+		errg.Go(func() error {
+			// Wait for event handlers to start running before publishing
+			<-evt.Running()
+			return retryScheduler.Run(ctx)
+		})
+	}
+
+	flusher := freeze.NewFlusher(stores.DeferredActions, evt, freezer, &cfg.ActionFreeze)
+	errg.Go(func() error {
+		// Wait for event handlers to start running before publishing
+		<-evt.Running()
+		return flusher.Run(ctx)
+	})
+
+	alertGroupFlusher := alertgroup.NewFlusher(stores.AlertGroups, evt, &cfg.AlertGrouping)
+	errg.Go(func() error {
+		// Wait for event handlers to start running before publishing
+		<-evt.Running()
+		return alertGroupFlusher.Run(ctx)
+	})
+
+	if cfg.Fairness.Enabled && cfg.Fairness.Throttle.Enabled {
+		requeuer := usage.NewRequeuer(stores.ThrottledEvaluations, evt, &cfg.Fairness.Throttle)
+		errg.Go(func() error {
+			// Wait for event handlers to start running before publishing
+			<-evt.Running()
+			return requeuer.Run(ctx)
+		})
+	}
+
+	if cfg.PullRequestRetention.ClosedRetention > 0 {
+		purger := retention.NewPurger(stores.Entities, &cfg.PullRequestRetention)
+		errg.Go(func() error {
+			return purger.Run(ctx)
+		})
+	}
+
+	if cfg.EvaluationCleanup.Enabled {
+		cleaner := history.NewCleaner(stores.EvalHistory, &cfg.EvaluationCleanup)
+		errg.Go(func() error {
+			return cleaner.Run(ctx)
+		})
+	}
+
+	if cfg.ProviderHealth.Enabled {
+		healthChecker := health.NewChecker(stores.Providers, providerManager, evt, &cfg.ProviderHealth)
+		errg.Go(func() error {
+			// Wait for event handlers to start running before publishing
+			<-evt.Running()
+			return healthChecker.Run(ctx)
+		})
+	}
+
+	if cfg.PackagePolling.Enabled && flags.Bool(ctx, featureFlagClient, flags.PackageRegistryProviders) {
+		poller := packageregistry.NewPoller(stores.Providers, stores.Entities, providerManager, evt, &cfg.PackagePolling)
+		errg.Go(func() error {
+			// Wait for event handlers to start running before publishing
+			<-evt.Running()
+			return poller.Run(ctx)
+		})
+	}
+
+	if anomalyDetector != nil {
+		errg.Go(func() error {
+			// Wait for event handlers to start running before publishing
+			<-evt.Running()
+			return anomalyDetector.Run(ctx)
+		})
+	}
+
+	if providerUsage != nil {
+		errg.Go(func() error {
+			return providerUsage.Run(ctx)
+		})
+	}
+
 	// Wait for event handlers to start running
 	<-evt.Running()
 
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+// Requeuer publishes the re-evaluation of the entities whose evaluation was
+// throttled, once the window they were throttled in has ended. Without it,
+// the events of throttled projects would be lost until the next event on the
+// entity.
+type Requeuer struct {
+	store db.ThrottledEvaluationsStore
+	evt   interfaces.Publisher
+	cfg   *serverconfig.ThrottleConfig
+}
+
+// NewRequeuer creates a new requeuer of throttled evaluations
+func NewRequeuer(
+	store db.ThrottledEvaluationsStore,
+	evt interfaces.Publisher,
+	cfg *serverconfig.ThrottleConfig,
+) *Requeuer {
+	return &Requeuer{
+		store: store,
+		evt:   evt,
+		cfg:   cfg,
+	}
+}
+
+// Run requeues the throttled evaluations at regular intervals until the
+// context is cancelled
+func (r *Requeuer) Run(ctx context.Context) error {
+	if r.cfg.RequeueInterval <= 0 {
+		return fmt.Errorf("invalid throttle requeue interval: %s", r.cfg.RequeueInterval)
+	}
+
+	ticker := time.NewTicker(r.cfg.RequeueInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.Requeue(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error requeueing throttled evaluations")
+			}
+		}
+	}
+}
+
+// Requeue publishes the re-evaluation of a batch of entities whose throttled
+// evaluation is due
+func (r *Requeuer) Requeue(ctx context.Context) error {
+	throttled, err := r.store.PopDueThrottledEvaluations(ctx, r.cfg.BatchSize)
+	if err != nil {
+		return fmt.Errorf("error getting due throttled evaluations: %w", err)
+	}
+
+	for _, t := range throttled {
+		logger := zerolog.Ctx(ctx).With().
+			Str("entity_id", t.EntityInstanceID.String()).
+			Str("project_id", t.ProjectID.String()).
+			Logger()
+
+		m := message.NewMessage(uuid.New().String(), nil)
+		m.SetContext(ctx)
+
+		entRefresh := entityMessage.NewEntityRefreshAndDoMessage().
+			WithEntityID(t.EntityInstanceID)
+		if err := entRefresh.ToMessage(m); err != nil {
+			logger.Error().Err(err).Msg("error marshalling message")
+			continue
+		}
+
+		if err := r.evt.Publish(constants.TopicQueueRefreshEntityByIDAndEvaluate, m); err != nil {
+			return fmt.Errorf("error publishing message: %w", err)
+		}
+
+		logger.Info().Time("throttled_at", t.ThrottledAt).Msg("requeued throttled evaluation")
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/events/stubs"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func TestRequeuerRequeue(t *testing.T) {
+	t.Parallel()
+
+	entityID := uuid.New()
+	otherEntityID := uuid.New()
+	cfg := &serverconfig.ThrottleConfig{BatchSize: 10}
+
+	tests := []struct {
+		name    string
+		setup   func(store *mockdb.MockThrottledEvaluationsStore)
+		wantErr bool
+		wantIDs []uuid.UUID
+	}{
+		{
+			name: "re-evaluates the due entities",
+			setup: func(store *mockdb.MockThrottledEvaluationsStore) {
+				store.EXPECT().PopDueThrottledEvaluations(gomock.Any(), int64(10)).
+					Return([]db.ThrottledEvaluation{
+						{EntityInstanceID: entityID, ProjectID: uuid.New(), ThrottledAt: time.Now()},
+						{EntityInstanceID: otherEntityID, ProjectID: uuid.New(), ThrottledAt: time.Now()},
+					}, nil)
+			},
+			wantIDs: []uuid.UUID{entityID, otherEntityID},
+		},
+		{
+			name: "nothing due",
+			setup: func(store *mockdb.MockThrottledEvaluationsStore) {
+				store.EXPECT().PopDueThrottledEvaluations(gomock.Any(), int64(10)).Return(nil, nil)
+			},
+		},
+		{
+			name: "error getting due evaluations",
+			setup: func(store *mockdb.MockThrottledEvaluationsStore) {
+				store.EXPECT().PopDueThrottledEvaluations(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("boom"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockThrottledEvaluationsStore(ctrl)
+			tt.setup(store)
+			evt := &stubs.StubEventer{}
+
+			err := NewRequeuer(store, evt, cfg).Requeue(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, evt.Sent, len(tt.wantIDs))
+			for i, msg := range evt.Sent {
+				require.Equal(t, []string{constants.TopicQueueRefreshEntityByIDAndEvaluate}, evt.Topics)
+				var refresh entityMessage.HandleEntityAndDoMessage
+				require.NoError(t, json.Unmarshal(msg.Payload, &refresh))
+				require.Equal(t, tt.wantIDs[i], refresh.Entity.EntityID)
+			}
+		})
+	}
+}
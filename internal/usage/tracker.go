@@ -0,0 +1,288 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package usage tracks the resources consumed by each project of a shared
+// Minder instance, so that operators can detect and throttle noisy neighbors.
+package usage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/mindersec/minder/internal/metrics/meters"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+type usageKey struct {
+	projectID  uuid.UUID
+	providerID uuid.UUID
+}
+
+type counters struct {
+	events   int64
+	evalTime time.Duration
+	apiCalls int64
+}
+
+// ProjectUsage is the usage of a project, for a given provider, in the current window.
+// API calls are not bound to a provider, and are reported with a nil provider ID.
+type ProjectUsage struct {
+	ProjectID      uuid.UUID     `json:"project_id"`
+	ProviderID     uuid.UUID     `json:"provider_id"`
+	Events         int64         `json:"events"`
+	EvaluationTime time.Duration `json:"evaluation_time"`
+	APICalls       int64         `json:"api_calls"`
+	// EventShare is the share of all the entity events of the window
+	// consumed by the project, across all its providers
+	EventShare float64 `json:"event_share"`
+	// Throttled is true if the evaluations of the project are being throttled
+	Throttled bool `json:"throttled"`
+}
+
+// Report is a snapshot of the usage of all projects in the current window
+type Report struct {
+	WindowStart time.Time      `json:"window_start"`
+	Window      time.Duration  `json:"window"`
+	TotalEvents int64          `json:"total_events"`
+	Projects    []ProjectUsage `json:"projects"`
+}
+
+// Tracker accounts the entity events, evaluation time and API calls of each
+// project over a fixed window. Usage is kept in memory, so each server
+// instance accounts for the work it performed itself.
+//
+// The per-project metrics carry the project ID as an attribute, which can
+// produce a large number of time series on big instances, which is why the
+// tracker is opt-in.
+type Tracker struct {
+	mu          sync.Mutex
+	window      time.Duration
+	windowStart time.Time
+	usage       map[usageKey]*counters
+	totalEvents int64
+	throttle    serverconfig.ThrottleConfig
+	now         func() time.Time
+
+	eventCounter     metric.Int64Counter
+	evalTimeCounter  metric.Int64Counter
+	apiCallCounter   metric.Int64Counter
+	throttledCounter metric.Int64Counter
+}
+
+// NewTracker creates a new usage tracker
+func NewTracker(cfg *serverconfig.FairnessConfig, meterFactory meters.MeterFactory) (*Tracker, error) {
+	meter := meterFactory.Build("usage")
+	eventCounter, err := meter.Int64Counter("project.events",
+		metric.WithDescription("Number of entity events evaluated per project"),
+		metric.WithUnit("events"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project events counter: %w", err)
+	}
+
+	evalTimeCounter, err := meter.Int64Counter("project.eval.duration",
+		metric.WithDescription("Time spent evaluating entities per project"),
+		metric.WithUnit("milliseconds"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project evaluation time counter: %w", err)
+	}
+
+	apiCallCounter, err := meter.Int64Counter("project.api.calls",
+		metric.WithDescription("Number of API calls per project"),
+		metric.WithUnit("calls"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project API calls counter: %w", err)
+	}
+
+	throttledCounter, err := meter.Int64Counter("project.throttled",
+		metric.WithDescription("Number of entity events skipped due to project throttling"),
+		metric.WithUnit("events"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project throttling counter: %w", err)
+	}
+
+	return &Tracker{
+		window:           cfg.Window,
+		usage:            make(map[usageKey]*counters),
+		throttle:         cfg.Throttle,
+		now:              time.Now,
+		eventCounter:     eventCounter,
+		evalTimeCounter:  evalTimeCounter,
+		apiCallCounter:   apiCallCounter,
+		throttledCounter: throttledCounter,
+	}, nil
+}
+
+// RecordEvaluation accounts an entity event evaluated for the project and
+// provider, and the time it took to evaluate it
+func (t *Tracker) RecordEvaluation(ctx context.Context, projectID, providerID uuid.UUID, duration time.Duration) {
+	t.mu.Lock()
+	c := t.countersFor(usageKey{projectID: projectID, providerID: providerID})
+	c.events++
+	c.evalTime += duration
+	t.totalEvents++
+	t.mu.Unlock()
+
+	attrs := metric.WithAttributes(
+		attribute.String("project_id", projectID.String()),
+		attribute.String("provider_id", providerID.String()),
+	)
+	t.eventCounter.Add(ctx, 1, attrs)
+	t.evalTimeCounter.Add(ctx, duration.Milliseconds(), attrs)
+}
+
+// RecordAPICall accounts an API call made on behalf of the project
+func (t *Tracker) RecordAPICall(ctx context.Context, projectID uuid.UUID) {
+	t.mu.Lock()
+	t.countersFor(usageKey{projectID: projectID}).apiCalls++
+	t.mu.Unlock()
+
+	t.apiCallCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("project_id", projectID.String()),
+	))
+}
+
+// ShouldThrottle reports whether the entity events of the project should be
+// skipped because it is using more than its share of the instance. This
+// always returns false if throttling is disabled.
+func (t *Tracker) ShouldThrottle(ctx context.Context, projectID uuid.UUID) bool {
+	if !t.throttle.Enabled {
+		return false
+	}
+
+	t.mu.Lock()
+	t.rollWindow()
+	throttled := t.isThrottled(t.projectEvents(projectID))
+	t.mu.Unlock()
+
+	if throttled {
+		t.throttledCounter.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("project_id", projectID.String()),
+		))
+	}
+	return throttled
+}
+
+// Report returns a snapshot of the usage in the current window, with the
+// projects consuming the most events first
+func (t *Tracker) Report() Report {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rollWindow()
+
+	projectEvents := make(map[uuid.UUID]int64)
+	for key, c := range t.usage {
+		projectEvents[key.projectID] += c.events
+	}
+
+	report := Report{
+		WindowStart: t.windowStart,
+		Window:      t.window,
+		TotalEvents: t.totalEvents,
+		Projects:    make([]ProjectUsage, 0, len(t.usage)),
+	}
+	for key, c := range t.usage {
+		events := projectEvents[key.projectID]
+		pu := ProjectUsage{
+			ProjectID:      key.projectID,
+			ProviderID:     key.providerID,
+			Events:         c.events,
+			EvaluationTime: c.evalTime,
+			APICalls:       c.apiCalls,
+			Throttled:      t.throttle.Enabled && t.isThrottled(events),
+		}
+		if t.totalEvents > 0 {
+			pu.EventShare = float64(events) / float64(t.totalEvents)
+		}
+		report.Projects = append(report.Projects, pu)
+	}
+
+	sort.Slice(report.Projects, func(i, j int) bool {
+		pi, pj := report.Projects[i], report.Projects[j]
+		if pi.Events != pj.Events {
+			return pi.Events > pj.Events
+		}
+		if pi.APICalls != pj.APICalls {
+			return pi.APICalls > pj.APICalls
+		}
+		return pi.ProjectID.String() < pj.ProjectID.String()
+	})
+	return report
+}
+
+// ProjectReport returns a snapshot of the usage of a single project in the
+// current window. The report only lists the usage of the project, while its
+// total is the one of the instance.
+func (t *Tracker) ProjectReport(projectID uuid.UUID) Report {
+	report := t.Report()
+	projects := make([]ProjectUsage, 0)
+	for _, pu := range report.Projects {
+		if pu.ProjectID == projectID {
+			projects = append(projects, pu)
+		}
+	}
+	report.Projects = projects
+	return report
+}
+
+// ThrottledUntil returns the end of the current window, after which the
+// entity events skipped because their project was throttled can be evaluated
+// again
+func (t *Tracker) ThrottledUntil() time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rollWindow()
+	return t.windowStart.Add(t.window)
+}
+
+// countersFor returns the counters of the key in the current window.
+// Must be called with the lock held.
+func (t *Tracker) countersFor(key usageKey) *counters {
+	t.rollWindow()
+	c, ok := t.usage[key]
+	if !ok {
+		c = &counters{}
+		t.usage[key] = c
+	}
+	return c
+}
+
+// rollWindow starts a new window if the current one elapsed.
+// Must be called with the lock held.
+func (t *Tracker) rollWindow() {
+	now := t.now()
+	if t.windowStart.IsZero() || now.Sub(t.windowStart) >= t.window {
+		t.windowStart = now
+		t.usage = make(map[usageKey]*counters)
+		t.totalEvents = 0
+	}
+}
+
+// projectEvents sums the events of the project across providers.
+// Must be called with the lock held.
+func (t *Tracker) projectEvents(projectID uuid.UUID) int64 {
+	var events int64
+	for key, c := range t.usage {
+		if key.projectID == projectID {
+			events += c.events
+		}
+	}
+	return events
+}
+
+// isThrottled decides whether a project with the given number of events in
+// the window is over its share. Must be called with the lock held.
+func (t *Tracker) isThrottled(events int64) bool {
+	if t.totalEvents < t.throttle.MinEvents {
+		return false
+	}
+	return float64(events)/float64(t.totalEvents) > t.throttle.MaxEventShare
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/metrics/meters"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func newTestTracker(t *testing.T, throttle serverconfig.ThrottleConfig) (*Tracker, *time.Time) {
+	t.Helper()
+
+	tracker, err := NewTracker(&serverconfig.FairnessConfig{
+		Enabled:  true,
+		Window:   10 * time.Minute,
+		Throttle: throttle,
+	}, &meters.NoopMeterFactory{})
+	require.NoError(t, err)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker.now = func() time.Time { return now }
+	return tracker, &now
+}
+
+func TestTrackerReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tracker, _ := newTestTracker(t, serverconfig.ThrottleConfig{})
+
+	noisy, quiet := uuid.New(), uuid.New()
+	providerA, providerB := uuid.New(), uuid.New()
+
+	for i := 0; i < 3; i++ {
+		tracker.RecordEvaluation(ctx, noisy, providerA, time.Second)
+	}
+	tracker.RecordEvaluation(ctx, noisy, providerB, time.Second)
+	tracker.RecordEvaluation(ctx, quiet, providerA, 2*time.Second)
+	tracker.RecordAPICall(ctx, quiet)
+
+	report := tracker.Report()
+	require.Equal(t, int64(5), report.TotalEvents)
+	require.Len(t, report.Projects, 4)
+
+	top := report.Projects[0]
+	require.Equal(t, noisy, top.ProjectID)
+	require.Equal(t, providerA, top.ProviderID)
+	require.Equal(t, int64(3), top.Events)
+	require.Equal(t, 3*time.Second, top.EvaluationTime)
+	// the share accounts for all the providers of the project
+	require.InDelta(t, 0.8, top.EventShare, 0.001)
+	require.False(t, top.Throttled)
+}
+
+func TestTrackerThrottling(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tracker, now := newTestTracker(t, serverconfig.ThrottleConfig{
+		Enabled:       true,
+		MaxEventShare: 0.5,
+		MinEvents:     10,
+	})
+
+	noisy, quiet := uuid.New(), uuid.New()
+	provider := uuid.New()
+
+	for i := 0; i < 8; i++ {
+		tracker.RecordEvaluation(ctx, noisy, provider, time.Millisecond)
+	}
+	// Not enough events in the window to throttle anybody
+	require.False(t, tracker.ShouldThrottle(ctx, noisy))
+
+	tracker.RecordEvaluation(ctx, quiet, provider, time.Millisecond)
+	tracker.RecordEvaluation(ctx, quiet, provider, time.Millisecond)
+	require.True(t, tracker.ShouldThrottle(ctx, noisy))
+	require.False(t, tracker.ShouldThrottle(ctx, quiet))
+
+	// A new window starts from scratch
+	*now = now.Add(10 * time.Minute)
+	require.False(t, tracker.ShouldThrottle(ctx, noisy))
+	require.Zero(t, tracker.Report().TotalEvents)
+}
+
+func TestTrackerProjectReport(t *testing.T) {
+	t.Parallel()
+
+	tracker, now := newTestTracker(t, serverconfig.ThrottleConfig{})
+	ctx := context.Background()
+	project := uuid.New()
+	provider := uuid.New()
+
+	tracker.RecordEvaluation(ctx, project, provider, time.Second)
+	tracker.RecordAPICall(ctx, project)
+	tracker.RecordEvaluation(ctx, uuid.New(), uuid.New(), time.Second)
+
+	report := tracker.ProjectReport(project)
+	require.Equal(t, int64(2), report.TotalEvents)
+	require.Len(t, report.Projects, 2)
+	for _, pu := range report.Projects {
+		require.Equal(t, project, pu.ProjectID)
+		require.InDelta(t, 0.5, pu.EventShare, 0.001)
+	}
+
+	require.Empty(t, tracker.ProjectReport(uuid.New()).Projects)
+	require.Equal(t, now.Add(10*time.Minute), tracker.ThrottledUntil())
+}
@@ -34,6 +34,17 @@ func (e *ErrWrappedCLIError) Error() string {
 	return e.Err.Error()
 }
 
+// ErrExitCode is returned by the commands which ran successfully but must
+// exit with a specific code, e.g. to gate CI pipelines on their result.
+type ErrExitCode struct {
+	Code    int
+	Message string
+}
+
+func (e *ErrExitCode) Error() string {
+	return e.Message
+}
+
 // PrintYesNoPrompt prints a yes/no prompt to the user and returns false if the user did not respond with yes or y
 func PrintYesNoPrompt(cmd *cobra.Command, promptMsg, confirmMsg, fallbackMsg string, defaultYes bool) bool {
 	// Print the warning banner with the prompt message
@@ -111,6 +122,12 @@ func ExitNicelyOnError(err error, userMsg string) {
 			// This handles the case where we want to print an explicit message before processing the error
 			fmt.Fprintf(os.Stderr, "Message: %s\n", userMsg)
 		}
+		// Check if the command requested a specific exit code
+		var exitErr *ErrExitCode
+		if errors.As(err, &exitErr) {
+			fmt.Fprintf(os.Stderr, "Message: %s\n", exitErr.Message)
+			os.Exit(exitErr.Code)
+		}
 		// Check if the error is wrapped
 		var wrappedErr *ErrWrappedCLIError
 		if errors.As(err, &wrappedErr) {
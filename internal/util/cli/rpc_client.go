@@ -65,6 +65,28 @@ func requestIDInterceptor(printer func(string, ...interface{})) grpc.UnaryClient
 	}
 }
 
+// warningInterceptor prints the warnings of the server, e.g. about deprecated
+// RPCs or about the version of the CLI
+func warningInterceptor(printer func(string, ...interface{})) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req any,
+		reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		var header metadata.MD
+		opts = append(opts, grpc.Header(&header))
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		for _, warning := range header.Get("minder-warning") {
+			printer("Warning: %s\n", warning)
+		}
+		return err
+	}
+}
+
 // GrpcForCommand is a helper for getting a testing connection from cobra flags
 func GrpcForCommand(cmd *cobra.Command, v *viper.Viper) (*grpc.ClientConn, error) {
 	clientConfig, err := config.ReadConfigFromViper[clientconfig.Config](v)
@@ -82,6 +104,7 @@ func GrpcForCommand(cmd *cobra.Command, v *viper.Viper) (*grpc.ClientConn, error
 	if viper.GetBool("verbose") {
 		opts = append(opts, grpc.WithUnaryInterceptor(requestIDInterceptor(cmd.PrintErrf)))
 	}
+	opts = append(opts, grpc.WithChainUnaryInterceptor(warningInterceptor(cmd.PrintErrf)))
 
 	conn, err := GetGrpcConnection(
 		cmd,
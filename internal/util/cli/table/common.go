@@ -7,6 +7,8 @@ package table
 import (
 	"strings"
 
+	"github.com/jedib0t/go-pretty/v6/text"
+
 	"github.com/mindersec/minder/internal/util/cli/table/layouts"
 )
 
@@ -16,6 +18,8 @@ const (
 	errorStatus        = "error"
 	skippedStatus      = "skipped"
 	pendingStatus      = "pending"
+	exceptedStatus     = "excepted"
+	suppressedStatus   = "suppressed"
 	notAvailableStatus = "not_available"
 	onStatus           = "on"
 	offStatus          = "off"
@@ -64,6 +68,16 @@ var statuses = map[string]statusDisplay{
 		Text:     "Skipped",
 		Severity: 2,
 	},
+	"excepted": {
+		Emoji:    "🛡️",
+		Text:     "Excepted",
+		Severity: 2,
+	},
+	"suppressed": {
+		Emoji:    "🔇",
+		Text:     "Suppressed",
+		Severity: 2,
+	},
 	"fail no fix": {
 		Emoji:    "⛔",
 		Text:     "Failed",
@@ -114,6 +128,10 @@ func GetStatusIcon(eval EvalStatus, emoji bool) layouts.ColoredColumn {
 		results = append(results, statuses["failed to evaluate"])
 	case skippedStatus:
 		results = append(results, statuses["skipped"])
+	case exceptedStatus:
+		results = append(results, statuses["excepted"])
+	case suppressedStatus:
+		results = append(results, statuses["suppressed"])
 	case failureStatus:
 		switch eval.GetRemediationStatus() {
 		case successStatus:
@@ -180,6 +198,7 @@ var entityTypeIcons = map[string]entityTypeDisplay{
 	"ENTITY_PIPELINE_RUN":       {Emoji: "🎬", Text: "Pipeline Run"},
 	"ENTITY_TASK_RUN":           {Emoji: "🔄", Text: "Task Run"},
 	"ENTITY_BUILD":              {Emoji: "🛠️", Text: "Build"},
+	"ENTITY_ORGANIZATION":       {Emoji: "🏢", Text: "Organization"},
 }
 
 // GetEntityTypeIcon returns a colored column representing the entity type.
@@ -195,3 +214,35 @@ func GetEntityTypeIcon(entityType string, emoji bool) layouts.ColoredColumn {
 	}
 	return layouts.NoColor(d.Text)
 }
+
+// ActionLink provides an abstract interface for reading the links to the
+// objects remediations and alerts created, such as pull requests.
+type ActionLink interface {
+	GetKind() string
+	GetRef() string
+	GetUrl() string
+}
+
+var actionLinkPrefixes = map[string]string{
+	"pull_request": "PR ",
+	"issue":        "Issue ",
+}
+
+// GetActionLink returns the text of a link to the object an action created,
+// e.g. "PR #42". On terminals the text is a clickable hyperlink, otherwise
+// the URL is shown on the following line. An empty string is returned if
+// the action created no object.
+func GetActionLink(link ActionLink) string {
+	if link == nil || link.GetRef() == "" {
+		return ""
+	}
+	label := actionLinkPrefixes[link.GetKind()] + link.GetRef()
+	url := link.GetUrl()
+	if url == "" {
+		return label
+	}
+	if isTerminal() {
+		return text.Hyperlink(url, label)
+	}
+	return label + "\n" + url
+}
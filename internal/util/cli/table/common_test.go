@@ -98,6 +98,26 @@ func TestGetStatusIcon(t *testing.T) {
 			expectedColor:    layouts.ColorYellow,
 			expectedSeverity: 2,
 		},
+		{
+			name: "rule eval excepted",
+			evalStatus: &testEvalStatus{
+				status: exceptedStatus,
+			},
+			expectedEmoji:    "🛡️",
+			expectedText:     "Excepted",
+			expectedColor:    layouts.ColorYellow,
+			expectedSeverity: 2,
+		},
+		{
+			name: "rule eval suppressed",
+			evalStatus: &testEvalStatus{
+				status: suppressedStatus,
+			},
+			expectedEmoji:    "🔇",
+			expectedText:     "Suppressed",
+			expectedColor:    layouts.ColorYellow,
+			expectedSeverity: 2,
+		},
 		{
 			name: "successfully remediated",
 			evalStatus: &testEvalStatus{
@@ -262,3 +282,65 @@ func TestGetStatusIcon_EdgeCases(t *testing.T) {
 		assert.Equal(t, layouts.Color(""), result.Color) // no color for unknown
 	})
 }
+
+// testActionLink implements the ActionLink interface for testing
+type testActionLink struct {
+	kind string
+	ref  string
+	url  string
+}
+
+func (l *testActionLink) GetKind() string {
+	return l.kind
+}
+
+func (l *testActionLink) GetRef() string {
+	return l.ref
+}
+
+func (l *testActionLink) GetUrl() string {
+	return l.url
+}
+
+func TestGetActionLink(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		link     ActionLink
+		expected string
+	}{
+		{
+			name:     "pull request",
+			link:     &testActionLink{kind: "pull_request", ref: "#42", url: "https://github.com/acme/widgets/pull/42"},
+			expected: "PR #42\nhttps://github.com/acme/widgets/pull/42",
+		},
+		{
+			name:     "issue",
+			link:     &testActionLink{kind: "issue", ref: "#7", url: "https://github.com/acme/widgets/issues/7"},
+			expected: "Issue #7\nhttps://github.com/acme/widgets/issues/7",
+		},
+		{
+			name:     "security advisory without URL",
+			link:     &testActionLink{kind: "security_advisory", ref: "GHSA-xxxx-xxxx-xxxx"},
+			expected: "GHSA-xxxx-xxxx-xxxx",
+		},
+		{
+			name:     "no object",
+			link:     &testActionLink{},
+			expected: "",
+		},
+		{
+			name:     "nil link",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// tests don't run on a terminal, so the URL is shown as text
+			assert.Equal(t, tt.expected, GetActionLink(tt.link))
+		})
+	}
+}
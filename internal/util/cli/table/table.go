@@ -126,13 +126,13 @@ func (g *goPrettyTable) AddRowWithColor(row ...layouts.ColoredColumn) {
 	r := make(table.Row, len(row))
 	rawRow := make([]string, len(row))
 
-	isTerminal := isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
+	terminal := isTerminal()
 
 	for i, cell := range row {
 		val := cell.Column
 		rawRow[i] = val
 
-		if isTerminal {
+		if terminal {
 			switch cell.Color {
 			case layouts.ColorRed:
 				val = text.FgRed.Sprint(val)
@@ -149,6 +149,10 @@ func (g *goPrettyTable) AddRowWithColor(row ...layouts.ColoredColumn) {
 	g.t.AppendRow(r)
 }
 
+func isTerminal() bool {
+	return isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
+}
+
 func (g *goPrettyTable) SeparateRows() Table {
 	g.t.Style().Options.SeparateRows = true
 	return g
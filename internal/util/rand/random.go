@@ -7,6 +7,7 @@
 package rand
 
 import (
+	"fmt"
 	"math/rand"
 	"net"
 	"sync/atomic"
@@ -72,3 +73,22 @@ func GetRandomPort() (int32, error) {
 	// nolint: gosec
 	return int32(port), nil
 }
+
+// GetRandomLocalPort returns a random available port on localhost, for the
+// servers started in-process, such as the embedded databases. The port type
+// is the one of their configuration.
+func GetRandomLocalPort() (uint32, error) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	if port < 0 {
+		return 0, fmt.Errorf("invalid port %d", port)
+	}
+	// largest TCP port is 2^16, overflow should not happen
+	// nolint: gosec
+	return uint32(port), nil
+}
@@ -11,6 +11,7 @@ import (
 	htmltemplate "html/template"
 	"io"
 	"reflect"
+	"strings"
 	"text/template"
 
 	"github.com/rs/zerolog"
@@ -23,12 +24,31 @@ var (
 
 var (
 	// TemplateFuncs is a map of functions that can be used in templates
-	// It introduces two custom functions:
+	// It introduces the following custom functions:
 	// - asMap: converts a structpb (or anything that implements the AsMap function call) to a map
 	// - mapGet: returns the value of a key in a map
+	// - regexMatch, regexFind, regexReplaceAll: regular expression helpers
+	// - semverCompare: checks a version against a constraint such as ">= 1.2.0"
+	// - toJson, fromJson, toYaml, fromYaml: (un)marshalling helpers
+	// - indent, nindent: indent a block of text, e.g. when embedding it in YAML
+	// - trim, lower, upper, default: string helpers
 	TemplateFuncs = template.FuncMap{
-		"asMap":  asMap,
-		"mapGet": mapGet,
+		"asMap":           asMap,
+		"mapGet":          mapGet,
+		"regexMatch":      regexMatch,
+		"regexFind":       regexFind,
+		"regexReplaceAll": regexReplaceAll,
+		"semverCompare":   semverCompare,
+		"toJson":          toJson,
+		"fromJson":        fromJson,
+		"toYaml":          toYaml,
+		"fromYaml":        fromYaml,
+		"indent":          indent,
+		"nindent":         nindent,
+		"trim":            strings.TrimSpace,
+		"lower":           strings.ToLower,
+		"upper":           strings.ToUpper,
+		"default":         defaultValue,
 	}
 )
 
@@ -6,12 +6,55 @@ package schemavalidate
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// FieldError is a violation of a JSON schema by a single value of an object
+type FieldError struct {
+	// Path is the JSON pointer to the invalid value, empty for the whole object
+	Path string
+	// Message describes the violation
+	Message string
+}
+
+// String implements fmt.Stringer
+func (e FieldError) String() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidationError is returned when an object does not conform to a JSON
+// schema. It lists each of the violations found in the object.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Error implements error.Error
+func (e *ValidationError) Error() string {
+	problems := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		problems = append(problems, f.String())
+	}
+	return fmt.Sprintf("invalid json schema: %s", strings.Join(problems, "; "))
+}
+
+// WithPrefix returns a copy of the error with the paths of the violations
+// prefixed by the given JSON pointer, for objects nested in a larger document
+func (e *ValidationError) WithPrefix(prefix string) *ValidationError {
+	fields := make([]FieldError, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		fields = append(fields, FieldError{Path: prefix + f.Path, Message: f.Message})
+	}
+	return &ValidationError{Fields: fields}
+}
+
 // CompileSchemaFromPB compiles a JSON schema from a protobuf Struct.
 func CompileSchemaFromPB(schemaData *structpb.Struct) (*jsonschema.Schema, error) {
 	if schemaData == nil {
@@ -30,24 +73,50 @@ func CompileSchemaFromMap(schemaData map[string]any) (*jsonschema.Schema, error)
 	return compiler.Compile("schema.json")
 }
 
-// ValidateAgainstSchema validates an object against a JSON schema.
-func ValidateAgainstSchema(schema *jsonschema.Schema, obj map[string]any) error {
+// ValidateAgainstSchema validates an object against a JSON schema. The object
+// is usually a map, but may be any value decoded from JSON. If the object does
+// not conform to the schema, a *ValidationError is returned.
+func ValidateAgainstSchema(schema *jsonschema.Schema, obj any) error {
 	if err := schema.Validate(obj); err != nil {
 		if verror, ok := err.(*jsonschema.ValidationError); ok {
-			return buildValidationError(verror.Causes)
+			return &ValidationError{Fields: collectFieldErrors(verror, nil)}
 		}
 		return fmt.Errorf("invalid json schema: %s", err)
 	}
 	return nil
 }
 
-func buildValidationError(errs []*jsonschema.ValidationError) error {
-	problems := make([]string, 0, len(errs))
-	for _, desc := range errs {
-		problems = append(problems, desc.Error())
+// collectFieldErrors flattens the tree of schema violations into the list
+// of its leaves, which point at the offending values
+func collectFieldErrors(verr *jsonschema.ValidationError, fields []FieldError) []FieldError {
+	if len(verr.Causes) > 0 {
+		for _, cause := range verr.Causes {
+			fields = collectFieldErrors(cause, fields)
+		}
+		return fields
 	}
 
-	return fmt.Errorf("invalid json schema: %s", strings.TrimSpace(strings.Join(problems, "\n")))
+	location := verr.InstanceLocation
+	// point at the missing property rather than at the object holding it
+	if req, ok := verr.ErrorKind.(*kind.Required); ok && len(req.Missing) == 1 {
+		location = append(slices.Clone(location), req.Missing[0])
+	}
+
+	return append(fields, FieldError{
+		Path:    jsonPointer(location),
+		Message: verr.BasicOutput().Error.String(),
+	})
+}
+
+// jsonPointer builds a JSON pointer (RFC 6901) from its reference tokens
+func jsonPointer(tokens []string) string {
+	var sb strings.Builder
+	for _, tok := range tokens {
+		tok = strings.ReplaceAll(tok, "~", "~0")
+		tok = strings.ReplaceAll(tok, "/", "~1")
+		sb.WriteString("/" + tok)
+	}
+	return sb.String()
 }
 
 // ApplyDefaults recursively applies default values from the schema to the object.
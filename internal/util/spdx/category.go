@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spdx
+
+import (
+	"strings"
+)
+
+const (
+	// CategoryPermissive is the category of licenses which allow
+	// redistribution under other terms, including public domain dedications
+	CategoryPermissive = "permissive"
+	// CategoryWeakCopyleft is the category of licenses which only require
+	// changes to the licensed files themselves to be shared
+	CategoryWeakCopyleft = "weak-copyleft"
+	// CategoryStrongCopyleft is the category of licenses which require
+	// derivative works to be distributed under the same license
+	CategoryStrongCopyleft = "strong-copyleft"
+	// CategoryNetworkCopyleft is the category of strong copyleft licenses
+	// which also apply when the software is offered over a network
+	CategoryNetworkCopyleft = "network-copyleft"
+	// CategoryUnknown is the category of licenses which aren't classified
+	CategoryUnknown = "unknown"
+)
+
+// categories classifies common licenses by their SPDX identifier, lowercased
+// and without the "-only" or "-or-later" suffixes.
+var categories = map[string]string{
+	"0bsd":               CategoryPermissive,
+	"apache-1.1":         CategoryPermissive,
+	"apache-2.0":         CategoryPermissive,
+	"artistic-2.0":       CategoryPermissive,
+	"blueoak-1.0.0":      CategoryPermissive,
+	"bsd-1-clause":       CategoryPermissive,
+	"bsd-2-clause":       CategoryPermissive,
+	"bsd-3-clause":       CategoryPermissive,
+	"bsd-3-clause-clear": CategoryPermissive,
+	"bsl-1.0":            CategoryPermissive,
+	"cc-by-3.0":          CategoryPermissive,
+	"cc-by-4.0":          CategoryPermissive,
+	"cc0-1.0":            CategoryPermissive,
+	"isc":                CategoryPermissive,
+	"mit":                CategoryPermissive,
+	"mit-0":              CategoryPermissive,
+	"ncsa":               CategoryPermissive,
+	"postgresql":         CategoryPermissive,
+	"psf-2.0":            CategoryPermissive,
+	"python-2.0":         CategoryPermissive,
+	"unicode-3.0":        CategoryPermissive,
+	"unicode-dfs-2016":   CategoryPermissive,
+	"unlicense":          CategoryPermissive,
+	"upl-1.0":            CategoryPermissive,
+	"wtfpl":              CategoryPermissive,
+	"x11":                CategoryPermissive,
+	"zlib":               CategoryPermissive,
+
+	"cddl-1.0": CategoryWeakCopyleft,
+	"cddl-1.1": CategoryWeakCopyleft,
+	"cpl-1.0":  CategoryWeakCopyleft,
+	"epl-1.0":  CategoryWeakCopyleft,
+	"epl-2.0":  CategoryWeakCopyleft,
+	"lgpl-2.0": CategoryWeakCopyleft,
+	"lgpl-2.1": CategoryWeakCopyleft,
+	"lgpl-3.0": CategoryWeakCopyleft,
+	"mpl-1.1":  CategoryWeakCopyleft,
+	"mpl-2.0":  CategoryWeakCopyleft,
+	"ms-rl":    CategoryWeakCopyleft,
+
+	"cc-by-sa-3.0": CategoryStrongCopyleft,
+	"cc-by-sa-4.0": CategoryStrongCopyleft,
+	"eupl-1.1":     CategoryStrongCopyleft,
+	"eupl-1.2":     CategoryStrongCopyleft,
+	"gpl-1.0":      CategoryStrongCopyleft,
+	"gpl-2.0":      CategoryStrongCopyleft,
+	"gpl-3.0":      CategoryStrongCopyleft,
+	"osl-3.0":      CategoryStrongCopyleft,
+
+	"agpl-1.0": CategoryNetworkCopyleft,
+	"agpl-3.0": CategoryNetworkCopyleft,
+	"sspl-1.0": CategoryNetworkCopyleft,
+}
+
+// linkingExceptions are the exceptions which allow linking a strong copyleft
+// library without the copyleft applying to the rest of the work.
+var linkingExceptions = map[string]bool{
+	"classpath-exception-2.0": true,
+	"gcc-exception-3.1":       true,
+	"llvm-exception":          true,
+}
+
+// Category returns the category of a license, given its identifier and
+// exception, if any. Licenses which aren't known are CategoryUnknown.
+func Category(license, exception string) string {
+	id := strings.ToLower(license)
+	id = strings.TrimSuffix(id, "+")
+	id = strings.TrimSuffix(id, "-only")
+	id = strings.TrimSuffix(id, "-or-later")
+
+	category, ok := categories[id]
+	if !ok {
+		return CategoryUnknown
+	}
+
+	if category == CategoryStrongCopyleft && linkingExceptions[strings.ToLower(exception)] {
+		return CategoryWeakCopyleft
+	}
+	return category
+}
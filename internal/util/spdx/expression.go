@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package spdx parses SPDX license expressions and evaluates them against
+// license policies.
+//
+// See https://spdx.github.io/spdx-spec/v2.3/SPDX-license-expressions/ for
+// the grammar of license expressions.
+package spdx
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// OpAnd is the conjunctive operator, all the operands apply
+	OpAnd = "AND"
+	// OpOr is the disjunctive operator, any of the operands may be chosen
+	OpOr = "OR"
+
+	opWith = "WITH"
+)
+
+// ErrInvalidExpression is returned when a license expression can't be parsed
+var ErrInvalidExpression = errors.New("invalid license expression")
+
+// Expression is a parsed SPDX license expression. It is either a compound
+// expression, with an operator and its operands, or a single license,
+// optionally with an exception.
+type Expression struct {
+	// Op is OpAnd or OpOr for compound expressions, and empty for a single license
+	Op string
+	// Operands are the sub-expressions of a compound expression
+	Operands []*Expression
+	// License is the license identifier of a single license, e.g. "MIT" or
+	// "GPL-2.0+"
+	License string
+	// Exception is the license exception of a single license, if any
+	Exception string
+}
+
+// Parse parses a SPDX license expression. Operators are matched without
+// regard to case, as they often appear lowercased in package metadata.
+func Parse(expr string) (*Expression, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%w: expression is empty", ErrInvalidExpression)
+	}
+
+	p := &parser{tokens: tokens}
+	out, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidExpression, p.peek())
+	}
+
+	return out, nil
+}
+
+// String returns the normalized representation of the expression
+func (e *Expression) String() string {
+	if e.Op == "" {
+		if e.Exception != "" {
+			return e.License + " " + opWith + " " + e.Exception
+		}
+		return e.License
+	}
+
+	parts := make([]string, 0, len(e.Operands))
+	for _, o := range e.Operands {
+		s := o.String()
+		// AND binds tighter than OR, so only nested ORs need parentheses.
+		if o.Op == OpOr && e.Op == OpAnd {
+			s = "(" + s + ")"
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, " "+e.Op+" ")
+}
+
+// Licenses returns the sorted, de-duplicated license identifiers referenced
+// by the expression.
+func (e *Expression) Licenses() []string {
+	var out []string
+	e.walk(func(leaf *Expression) {
+		out = append(out, leaf.License)
+	})
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// Satisfies returns whether the expression can be complied with by only
+// using licenses accepted by the given function. All the operands of an AND
+// expression must be accepted, while it's enough for one of the operands of
+// an OR expression to be accepted.
+func (e *Expression) Satisfies(accept func(license, exception string) bool) bool {
+	switch e.Op {
+	case OpAnd:
+		for _, o := range e.Operands {
+			if !o.Satisfies(accept) {
+				return false
+			}
+		}
+		return true
+	case OpOr:
+		for _, o := range e.Operands {
+			if o.Satisfies(accept) {
+				return true
+			}
+		}
+		return false
+	default:
+		return accept(e.License, e.Exception)
+	}
+}
+
+func (e *Expression) walk(fn func(leaf *Expression)) {
+	if e.Op == "" {
+		fn(e)
+		return
+	}
+	for _, o := range e.Operands {
+		o.walk(fn)
+	}
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *parser) peekOperator(op string) bool {
+	return strings.EqualFold(p.peek(), op)
+}
+
+// parseOr parses: and-expression { OR and-expression }
+func (p *parser) parseOr() (*Expression, error) {
+	return p.parseCompound(OpOr, p.parseAnd)
+}
+
+// parseAnd parses: simple-expression { AND simple-expression }
+func (p *parser) parseAnd() (*Expression, error) {
+	return p.parseCompound(OpAnd, p.parseSimple)
+}
+
+func (p *parser) parseCompound(op string, operand func() (*Expression, error)) (*Expression, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	if !p.peekOperator(op) {
+		return first, nil
+	}
+
+	out := &Expression{Op: op}
+	// Flatten nested expressions with the same operator,
+	// e.g. (MIT OR ISC) OR BSD-3-Clause.
+	add := func(o *Expression) {
+		if o.Op == op {
+			out.Operands = append(out.Operands, o.Operands...)
+		} else {
+			out.Operands = append(out.Operands, o)
+		}
+	}
+
+	add(first)
+	for p.peekOperator(op) {
+		p.next()
+		o, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		add(o)
+	}
+
+	return out, nil
+}
+
+// parseSimple parses: "(" expression ")" | license-id [ WITH exception-id ]
+func (p *parser) parseSimple() (*Expression, error) {
+	tok := p.next()
+	switch {
+	case tok == "":
+		return nil, fmt.Errorf("%w: unexpected end of expression", ErrInvalidExpression)
+	case tok == "(":
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("%w: missing closing parenthesis", ErrInvalidExpression)
+		}
+		return inner, nil
+	case tok == ")" || isOperator(tok):
+		return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidExpression, tok)
+	}
+
+	out := &Expression{License: tok}
+	if p.peekOperator(opWith) {
+		p.next()
+		exc := p.next()
+		if exc == "" || exc == "(" || exc == ")" || isOperator(exc) {
+			return nil, fmt.Errorf("%w: missing exception after WITH", ErrInvalidExpression)
+		}
+		out.Exception = exc
+	}
+	return out, nil
+}
+
+func isOperator(tok string) bool {
+	return strings.EqualFold(tok, OpAnd) || strings.EqualFold(tok, OpOr) || strings.EqualFold(tok, opWith)
+}
+
+func tokenize(expr string) ([]string, error) {
+	var tokens []string
+	var cur strings.Builder
+
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+
+	for _, r := range expr {
+		switch {
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			flush()
+		case isIDChar(r):
+			cur.WriteRune(r)
+		default:
+			return nil, fmt.Errorf("%w: unexpected character %q", ErrInvalidExpression, r)
+		}
+	}
+	flush()
+
+	return tokens, nil
+}
+
+// isIDChar returns whether the rune may be part of a license or exception
+// identifier. Besides the idstring characters, "+" denotes "or later" and ":"
+// separates DocumentRef from LicenseRef.
+func isIDChar(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') ||
+		r == '-' || r == '.' || r == '+' || r == ':'
+}
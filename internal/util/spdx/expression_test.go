@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spdx
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		expr         string
+		want         string
+		wantLicenses []string
+		wantErr      string
+	}{
+		{
+			name:         "single license",
+			expr:         "MIT",
+			want:         "MIT",
+			wantLicenses: []string{"MIT"},
+		},
+		{
+			name:         "or later and exception",
+			expr:         "GPL-2.0+ WITH Classpath-exception-2.0",
+			want:         "GPL-2.0+ WITH Classpath-exception-2.0",
+			wantLicenses: []string{"GPL-2.0+"},
+		},
+		{
+			name:         "AND binds tighter than OR",
+			expr:         "MIT OR Apache-2.0 AND BSD-3-Clause",
+			want:         "MIT OR Apache-2.0 AND BSD-3-Clause",
+			wantLicenses: []string{"Apache-2.0", "BSD-3-Clause", "MIT"},
+		},
+		{
+			name:         "parentheses and lowercase operators",
+			expr:         "(MIT or ISC) and (Apache-2.0 OR (BSD-2-Clause OR MIT))",
+			want:         "(MIT OR ISC) AND (Apache-2.0 OR BSD-2-Clause OR MIT)",
+			wantLicenses: []string{"Apache-2.0", "BSD-2-Clause", "ISC", "MIT"},
+		},
+		{
+			name:         "license refs",
+			expr:         "LicenseRef-acme OR DocumentRef-spdx:LicenseRef-internal",
+			want:         "LicenseRef-acme OR DocumentRef-spdx:LicenseRef-internal",
+			wantLicenses: []string{"DocumentRef-spdx:LicenseRef-internal", "LicenseRef-acme"},
+		},
+		{
+			name:    "empty",
+			expr:    "  ",
+			wantErr: "expression is empty",
+		},
+		{
+			name:    "free-form text",
+			expr:    "SEE LICENSE IN LICENSE.txt",
+			wantErr: `unexpected "LICENSE"`,
+		},
+		{
+			name:    "dangling operator",
+			expr:    "MIT OR",
+			wantErr: "unexpected end of expression",
+		},
+		{
+			name:    "unbalanced parentheses",
+			expr:    "(MIT OR ISC",
+			wantErr: "missing closing parenthesis",
+		},
+		{
+			name:    "missing exception",
+			expr:    "GPL-2.0-only WITH",
+			wantErr: "missing exception after WITH",
+		},
+		{
+			name:    "invalid character",
+			expr:    "MIT/X11",
+			wantErr: "unexpected character",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(tt.expr)
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrInvalidExpression)
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.String())
+			require.Equal(t, tt.wantLicenses, got.Licenses())
+		})
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	t.Parallel()
+
+	allowed := []string{"mit", "apache-2.0", "gpl-2.0-only with classpath-exception-2.0"}
+	accept := func(license, exception string) bool {
+		if exception != "" {
+			license += " with " + exception
+		}
+		return slices.Contains(allowed, strings.ToLower(license))
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{expr: "MIT", want: true},
+		{expr: "GPL-3.0-only", want: false},
+		{expr: "MIT OR GPL-3.0-only", want: true},
+		{expr: "MIT AND GPL-3.0-only", want: false},
+		{expr: "(MIT OR GPL-3.0-only) AND Apache-2.0", want: true},
+		{expr: "GPL-2.0-only WITH Classpath-exception-2.0", want: true},
+		{expr: "GPL-2.0-only", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := Parse(tt.expr)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, e.Satisfies(accept))
+		})
+	}
+}
+
+func TestCategory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		license   string
+		exception string
+		want      string
+	}{
+		{license: "MIT", want: CategoryPermissive},
+		{license: "apache-2.0", want: CategoryPermissive},
+		{license: "MPL-2.0", want: CategoryWeakCopyleft},
+		{license: "LGPL-2.1-or-later", want: CategoryWeakCopyleft},
+		{license: "GPL-3.0-only", want: CategoryStrongCopyleft},
+		{license: "GPL-2.0+", want: CategoryStrongCopyleft},
+		{license: "GPL-2.0-only", exception: "Classpath-exception-2.0", want: CategoryWeakCopyleft},
+		{license: "AGPL-3.0-or-later", want: CategoryNetworkCopyleft},
+		{license: "LicenseRef-acme", want: CategoryUnknown},
+		{license: "NOASSERTION", want: CategoryUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.license, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, Category(tt.license, tt.exception))
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+)
+
+// The functions below are a small, sprig-like library available through
+// TemplateFuncs for rendering remediation and alert content. They are kept
+// side-effect free so that templates stay safe to render server-side.
+
+// regexMatch reports whether the string s contains any match of the regular expression
+func regexMatch(pattern, s string) (bool, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false, fmt.Errorf("invalid regular expression: %w", err)
+	}
+	return re.MatchString(s), nil
+}
+
+// regexFind returns the first match of the regular expression in s
+func regexFind(pattern, s string) (string, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid regular expression: %w", err)
+	}
+	return re.FindString(s), nil
+}
+
+// regexReplaceAll replaces all matches of the regular expression in s with repl.
+// Inside repl, $ signs are interpreted as in regexp.Expand.
+func regexReplaceAll(pattern, s, repl string) (string, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid regular expression: %w", err)
+	}
+	return re.ReplaceAllString(s, repl), nil
+}
+
+// canonicalSemver adds the "v" prefix expected by golang.org/x/mod/semver
+func canonicalSemver(v string) (string, error) {
+	v = strings.TrimSpace(v)
+	if !strings.HasPrefix(v, "v") {
+		v = "v" + v
+	}
+	if !semver.IsValid(v) {
+		return "", fmt.Errorf("invalid semantic version: %s", v)
+	}
+	return v, nil
+}
+
+// semverCompare checks a version against a constraint such as ">= 1.2.0".
+// Supported operators are =, !=, >, >=, < and <=; a missing operator means =.
+func semverCompare(constraint, version string) (bool, error) {
+	constraint = strings.TrimSpace(constraint)
+	op := "="
+	for _, candidate := range []string{">=", "<=", "!=", ">", "<", "="} {
+		if strings.HasPrefix(constraint, candidate) {
+			op = candidate
+			constraint = strings.TrimPrefix(constraint, candidate)
+			break
+		}
+	}
+
+	want, err := canonicalSemver(constraint)
+	if err != nil {
+		return false, err
+	}
+	got, err := canonicalSemver(version)
+	if err != nil {
+		return false, err
+	}
+
+	cmp := semver.Compare(got, want)
+	switch op {
+	case ">=":
+		return cmp >= 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case "!=":
+		return cmp != 0, nil
+	case ">":
+		return cmp > 0, nil
+	case "<":
+		return cmp < 0, nil
+	default:
+		return cmp == 0, nil
+	}
+}
+
+// toJson marshals a value as compact JSON
+func toJson(v any) (string, error) {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("cannot marshal to JSON: %w", err)
+	}
+	return string(out), nil
+}
+
+// fromJson unmarshals a JSON document
+func fromJson(s string) (any, error) {
+	var out any
+	if err := json.Unmarshal([]byte(s), &out); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal JSON: %w", err)
+	}
+	return out, nil
+}
+
+// toYaml marshals a value as YAML, without the trailing newline
+func toYaml(v any) (string, error) {
+	out, err := yaml.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("cannot marshal to YAML: %w", err)
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+// fromYaml unmarshals a YAML document
+func fromYaml(s string) (any, error) {
+	var out any
+	if err := yaml.Unmarshal([]byte(s), &out); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal YAML: %w", err)
+	}
+	return out, nil
+}
+
+// maxIndent is the largest indentation accepted by indent and nindent, so
+// that templates can't blow up the size of the rendered content
+const maxIndent = 64
+
+// indent prefixes every line of s with the given number of spaces
+func indent(spaces int, s string) (string, error) {
+	if spaces < 0 || spaces > maxIndent {
+		return "", fmt.Errorf("indentation must be between 0 and %d spaces, got %d", maxIndent, spaces)
+	}
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.ReplaceAll(s, "\n", "\n"+pad), nil
+}
+
+// nindent is like indent, but starts with a newline. This is convenient
+// when embedding a block in YAML content.
+func nindent(spaces int, s string) (string, error) {
+	out, err := indent(spaces, s)
+	if err != nil {
+		return "", err
+	}
+	return "\n" + out, nil
+}
+
+// defaultValue returns def if val is empty, as sprig's default does: nil,
+// false, numeric zero, and empty strings, slices and maps are all empty.
+func defaultValue(def, val any) any {
+	if val == nil {
+		return def
+	}
+
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		if rv.Len() == 0 {
+			return def
+		}
+	case reflect.Bool:
+		if !rv.Bool() {
+			return def
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		if rv.IsZero() {
+			return def
+		}
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return def
+		}
+	default:
+	}
+	return val
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/util"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		tmpl     string
+		data     any
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "regexMatch",
+			tmpl:     `{{ regexMatch "^v[0-9]+" .Version }}`,
+			data:     map[string]any{"Version": "v1.2.3"},
+			expected: "true",
+		},
+		{
+			name:     "regexReplaceAll",
+			tmpl:     `{{ regexReplaceAll "@[a-f0-9]{40}" .Uses "@main" }}`,
+			data:     map[string]any{"Uses": "actions/checkout@8ade135a41bc03ea155e62e844d188df1ea18608"},
+			expected: "actions/checkout@main",
+		},
+		{
+			name:     "invalid regex",
+			tmpl:     `{{ regexMatch "(" "foo" }}`,
+			wantErr:  true,
+			expected: "",
+		},
+		{
+			name:     "semverCompare with constraint",
+			tmpl:     `{{ semverCompare ">= 1.2.0" .Version }} {{ semverCompare "< 1.2.0" .Version }}`,
+			data:     map[string]any{"Version": "1.10.0"},
+			expected: "true false",
+		},
+		{
+			name:     "semverCompare without operator",
+			tmpl:     `{{ semverCompare "v2.0.0" "2.0.0" }}`,
+			expected: "true",
+		},
+		{
+			name:     "toJson",
+			tmpl:     `{{ toJson .Obj }}`,
+			data:     map[string]any{"Obj": map[string]any{"a": []int{1, 2}}},
+			expected: `{"a":[1,2]}`,
+		},
+		{
+			name: "toYaml with nindent",
+			tmpl: `updates:{{ toYaml .Updates | nindent 2 }}`,
+			data: map[string]any{"Updates": []map[string]any{
+				{"package-ecosystem": "gomod"},
+			}},
+			expected: "updates:\n  - package-ecosystem: gomod",
+		},
+		{
+			name:     "fromYaml",
+			tmpl:     `{{ $doc := fromYaml .Doc }}{{ index $doc "version" }}`,
+			data:     map[string]any{"Doc": "version: 2\n"},
+			expected: "2",
+		},
+		{
+			name:     "nindent with too many spaces",
+			tmpl:     `{{ "foo" | nindent 65 }}`,
+			wantErr:  true,
+			expected: "",
+		},
+		{
+			name:     "indent with negative spaces",
+			tmpl:     `{{ "foo" | indent -1 }}`,
+			wantErr:  true,
+			expected: "",
+		},
+		{
+			name:     "default with numeric zero",
+			tmpl:     `{{ default 3 .Count }} {{ default 3 .Ratio }} {{ default 3 .Set }}`,
+			data:     map[string]any{"Count": 0, "Ratio": 0.0, "Set": 1},
+			expected: "3 3 1",
+		},
+		{
+			name:     "default with empty list",
+			tmpl:     `{{ default "none" .Items }}`,
+			data:     map[string]any{"Items": []string{}},
+			expected: "none",
+		},
+		{
+			name:     "default and upper",
+			tmpl:     `{{ default "main" .Branch | upper }}`,
+			data:     map[string]any{"Branch": ""},
+			expected: "MAIN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl, err := util.NewSafeTextTemplate(&tt.tmpl, tt.name)
+			require.NoError(t, err)
+
+			out, err := tmpl.Render(context.Background(), tt.data, 1024)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, out)
+		})
+	}
+}
@@ -47,6 +47,10 @@ var (
 
 const (
 	sigstoreBundleMediaType01 = "application/vnd.dev.sigstore.bundle+json;version=0.1"
+
+	// maxIndexPlatforms is the maximum number of platforms of an image index
+	// we're willing to verify, as each one takes a few registry requests
+	maxIndexPlatforms = 32
 )
 
 // AuthMethod is an option for containerAuth
@@ -111,6 +115,8 @@ func (c *containerAuth) getRegistry() string {
 // isSigned is true only if we were able to find a signature/attestation and it had everything needed to construct the
 // sigstore bundle.
 // isVerified is true only if we were able to verify the constructed bundle against the configured sigstore instance.
+// When the artifact is an image index (a multi-arch image), the image of each platform is verified as well, and the
+// results are reported per platform.
 func Verify(
 	ctx context.Context,
 	sev *verify.Verifier,
@@ -121,9 +127,45 @@ func Verify(
 
 	cauth := newContainerAuth(authOpts...)
 
+	imageRef := BuildImageRef(cauth.getRegistry(), owner, artifact, checksumref)
 	logger.Info().
-		Str("imageRef", BuildImageRef(cauth.getRegistry(), owner, artifact, checksumref)).
+		Str("imageRef", imageRef).
 		Msg("verifying container artifact")
+	results, err := verifyDigest(ctx, sev, owner, artifact, checksumref, cauth)
+	if err != nil {
+		return nil, err
+	}
+
+	platforms, err := getPlatformManifests(ctx, imageRef, cauth.getAuthenticator(owner))
+	if err != nil {
+		return nil, fmt.Errorf("error getting platforms of image: %w", err)
+	}
+	if len(platforms) == 0 {
+		// A single image, or an index without images of known platforms
+		return results, nil
+	}
+
+	logger.Info().Int("count", len(platforms)).Msg("verifying platforms of image index")
+	var platformResults []verifyif.Result
+	for _, p := range platforms {
+		own, err := verifyDigest(ctx, sev, owner, artifact, p.digest, cauth)
+		if err != nil {
+			return nil, fmt.Errorf("error verifying platform %s: %w", p.platform, err)
+		}
+		platformResults = append(platformResults, resultsForPlatform(p, own, results)...)
+	}
+	return platformResults, nil
+}
+
+// verifyDigest verifies the signatures of a single digest of a container artifact
+func verifyDigest(
+	ctx context.Context,
+	sev *verify.Verifier,
+	owner, artifact, checksumref string,
+	cauth *containerAuth,
+) ([]verifyif.Result, error) {
+	logger := zerolog.Ctx(ctx)
+
 	// Construct the bundle(s) - OCI image or GitHub's attestation endpoint
 	bundles, err := getSigstoreBundles(ctx, owner, artifact, checksumref, cauth)
 	if err != nil && !errors.Is(err, ErrProvenanceNotFoundOrIncomplete) {
@@ -142,7 +184,89 @@ func Verify(
 	}
 
 	// Construct the verification result for each bundle we managed to generate.
-	return getVerifiedResults(ctx, sev, bundles), nil
+	results := getVerifiedResults(ctx, sev, bundles)
+	for i := range results {
+		results[i].SignedDigest = checksumref
+	}
+	return results, nil
+}
+
+// platformManifest is the image of a platform of an image index
+type platformManifest struct {
+	platform string
+	digest   string
+}
+
+// getPlatformManifests returns the images of the platforms of an image index,
+// or nothing when the reference is a single image. The attestation manifests
+// which buildx adds to indexes under the unknown platform, and nested
+// indexes, are skipped.
+func getPlatformManifests(ctx context.Context, imageRef string, auth authn.Authenticator) ([]platformManifest, error) {
+	ref, err := name.ParseReference(imageRef)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing image reference: %w", err)
+	}
+
+	desc, err := remote.Get(ref, remote.WithAuth(auth), remote.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error getting image descriptor: %w", err)
+	}
+	if !desc.MediaType.IsIndex() {
+		return nil, nil
+	}
+
+	idx, err := desc.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("error getting image index: %w", err)
+	}
+	manifest, err := idx.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("error getting image index manifest: %w", err)
+	}
+
+	var platforms []platformManifest
+	for _, m := range manifest.Manifests {
+		if !m.MediaType.IsImage() || m.Platform == nil || m.Platform.OS == "unknown" {
+			continue
+		}
+		if len(platforms) == maxIndexPlatforms {
+			zerolog.Ctx(ctx).Warn().Str("imageRef", imageRef).Int("max", maxIndexPlatforms).
+				Msg("image index has too many platforms, verifying only the first ones")
+			break
+		}
+		platforms = append(platforms, platformManifest{
+			platform: m.Platform.String(),
+			digest:   m.Digest.String(),
+		})
+	}
+	return platforms, nil
+}
+
+// resultsForPlatform returns the results of the verification of the image of
+// a platform of an index. When the image has no signature of its own, the
+// signatures of the index stand for it, as the signed index pins the digest
+// of the image.
+func resultsForPlatform(p platformManifest, own, index []verifyif.Result) []verifyif.Result {
+	results := own
+	if !anySigned(own) && anySigned(index) {
+		results = index
+	}
+
+	platformResults := make([]verifyif.Result, 0, len(results))
+	for _, res := range results {
+		res.Platform = p.platform
+		platformResults = append(platformResults, res)
+	}
+	return platformResults
+}
+
+func anySigned(results []verifyif.Result) bool {
+	for _, res := range results {
+		if res.IsSigned {
+			return true
+		}
+	}
+	return false
 }
 
 // getVerifiedResults verifies the artifact using the bundles against the configured sigstore instance
@@ -7,16 +7,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
 	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/stretchr/testify/require"
 	"sigs.k8s.io/release-utils/tar"
+
+	"github.com/mindersec/minder/internal/verifier/verifyif"
 )
 
 // importLayouts is a utility function that reads OCI layouts from a
@@ -109,3 +120,111 @@ func TestBundleFromOCIImage(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyImageIndex(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(srv.Close)
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	var addenda []mutate.IndexAddendum
+	for _, platform := range []*v1.Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm64", Variant: "v8"},
+		// buildx stores the attestations of the images under the unknown platform
+		{OS: "unknown", Architecture: "unknown"},
+	} {
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		addenda = append(addenda, mutate.IndexAddendum{
+			Add:        img,
+			Descriptor: v1.Descriptor{Platform: platform},
+		})
+	}
+	idx := mutate.AppendManifests(empty.Index, addenda...)
+	idxDigest, err := idx.Digest()
+	require.NoError(t, err)
+
+	ref, err := name.ParseReference(host + "/owner/multiarch:latest")
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(ref, idx))
+
+	platforms, err := getPlatformManifests(context.Background(),
+		BuildImageRef(host, "owner", "multiarch", idxDigest.String()), authn.Anonymous)
+	require.NoError(t, err)
+	require.Len(t, platforms, 2)
+	require.Equal(t, "linux/amd64", platforms[0].platform)
+	require.Equal(t, "linux/arm64/v8", platforms[1].platform)
+
+	// none of the images is signed, each platform is reported on its own
+	results, err := Verify(context.Background(), nil, "owner", "multiarch", idxDigest.String(),
+		WithRegistry(host), WithAuthenticator(authn.Anonymous))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for i, res := range results {
+		require.Equal(t, platforms[i].platform, res.Platform)
+		require.False(t, res.IsSigned)
+	}
+
+	// a single image has no platforms
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	imgDigest, err := img.Digest()
+	require.NoError(t, err)
+	imgRef, err := name.ParseReference(host + "/owner/single:latest")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(imgRef, img))
+
+	platforms, err = getPlatformManifests(context.Background(),
+		BuildImageRef(host, "owner", "single", imgDigest.String()), authn.Anonymous)
+	require.NoError(t, err)
+	require.Empty(t, platforms)
+}
+
+func TestResultsForPlatform(t *testing.T) {
+	t.Parallel()
+
+	p := platformManifest{platform: "linux/amd64", digest: "sha256:platform"}
+	unsigned := []verifyif.Result{{}}
+	signedIndex := []verifyif.Result{{IsSigned: true, IsVerified: true, SignedDigest: "sha256:index"}}
+	signedPlatform := []verifyif.Result{{IsSigned: true, IsVerified: true, SignedDigest: "sha256:platform"}}
+
+	for _, tc := range []struct {
+		name         string
+		own          []verifyif.Result
+		index        []verifyif.Result
+		signed       bool
+		signedDigest string
+	}{
+		{
+			name:         "platform signed on its own",
+			own:          signedPlatform,
+			index:        signedIndex,
+			signed:       true,
+			signedDigest: "sha256:platform",
+		},
+		{
+			name:         "only the index is signed",
+			own:          unsigned,
+			index:        signedIndex,
+			signed:       true,
+			signedDigest: "sha256:index",
+		},
+		{
+			name:  "nothing is signed",
+			own:   unsigned,
+			index: unsigned,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			results := resultsForPlatform(p, tc.own, tc.index)
+			require.Len(t, results, 1)
+			require.Equal(t, "linux/amd64", results[0].Platform)
+			require.Equal(t, tc.signed, results[0].IsSigned)
+			require.Equal(t, tc.signedDigest, results[0].SignedDigest)
+		})
+	}
+}
@@ -7,11 +7,15 @@ package sigstore
 import (
 	"context"
 	"embed"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"path"
 	"strings"
 
+	"github.com/rs/zerolog"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
 	"github.com/sigstore/sigstore-go/pkg/root"
 	"github.com/sigstore/sigstore-go/pkg/tuf"
 	"github.com/sigstore/sigstore-go/pkg/verify"
@@ -160,6 +164,47 @@ func (s *Sigstore) VerifyContainer(ctx context.Context, owner, artifact, checksu
 	return container.Verify(ctx, s.verifier, owner, artifact, checksumref, s.authOpts...)
 }
 
+// VerifyBundle verifies a sigstore bundle against the digest of the artifact
+// it was made for. As for containers, the identity of the signer is not
+// checked here, but by the rules evaluating the result.
+func (s *Sigstore) VerifyBundle(
+	ctx context.Context, sigBundle *bundle.Bundle, digestAlgorithm string, digest []byte,
+) verifyif.Result {
+	res := verifyif.Result{
+		IsSigned:   true,
+		IsVerified: false,
+	}
+
+	verificationResult, err := s.verifier.Verify(sigBundle, verify.NewPolicy(
+		verify.WithArtifactDigest(digestAlgorithm, digest),
+		verify.WithoutIdentitiesUnsafe(),
+	))
+	if err != nil {
+		zerolog.Ctx(ctx).Debug().Err(err).Msg("error verifying bundle")
+		return res
+	}
+
+	res.IsVerified = true
+	res.VerificationResult = *verificationResult
+	return res
+}
+
+// VerifyBlob verifies the signature of a blob, held in a sigstore bundle. The
+// signature is valid if it was made by any of the given identities.
+func (s *Sigstore) VerifyBlob(
+	blob io.Reader, sigBundle *bundle.Bundle, identities []verify.CertificateIdentity,
+) (*verify.VerificationResult, error) {
+	if len(identities) == 0 {
+		return nil, errors.New("no identities to verify the signature against")
+	}
+
+	opts := make([]verify.PolicyOption, 0, len(identities))
+	for _, id := range identities {
+		opts = append(opts, verify.WithCertificateIdentity(id))
+	}
+	return s.verifier.Verify(sigBundle, verify.NewPolicy(verify.WithArtifact(blob), opts...))
+}
+
 // sanitizeInput sanitizes the input parameters
 func sanitizeInput(owner *string) {
 	// (jaosorior): The owner can't be upper-cased, normalize the owner.
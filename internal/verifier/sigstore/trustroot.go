@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sigstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/sigstore/sigstore-go/pkg/tuf"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
+)
+
+// ErrInvalidTrustRoot is returned when a trust root can't be used
+var ErrInvalidTrustRoot = errors.New("invalid sigstore trust root")
+
+// TrustRoot is the trust root of a private sigstore deployment, with its own
+// Fulcio and Rekor instances. It is either distributed through a TUF
+// repository, or given as a trusted root.
+type TrustRoot struct {
+	// TUFURL is the URL of the TUF repository distributing the trusted root
+	TUFURL string
+	// TUFRoot is the root.json verifying the metadata of the TUF repository
+	TUFRoot []byte
+	// TrustedRoot is a trusted_root.json used as is, without TUF
+	TrustedRoot []byte
+}
+
+// Validate checks that the trust root is complete and well-formed. The TUF
+// repository is not contacted.
+func (tr *TrustRoot) Validate() error {
+	switch {
+	case len(tr.TrustedRoot) > 0 && tr.TUFURL != "":
+		return fmt.Errorf("%w: a trusted root can't be set with a TUF repository", ErrInvalidTrustRoot)
+	case len(tr.TrustedRoot) > 0:
+		if _, err := root.NewTrustedRootFromJSON(tr.TrustedRoot); err != nil {
+			return fmt.Errorf("%w: trusted root: %w", ErrInvalidTrustRoot, err)
+		}
+	case tr.TUFURL != "":
+		u, err := url.Parse(tr.TUFURL)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("%w: the TUF repository must be an https URL", ErrInvalidTrustRoot)
+		}
+		if len(tr.TUFRoot) == 0 || !json.Valid(tr.TUFRoot) {
+			return fmt.Errorf("%w: the TUF repository requires its root.json", ErrInvalidTrustRoot)
+		}
+	default:
+		return fmt.Errorf("%w: either a TUF repository or a trusted root is required", ErrInvalidTrustRoot)
+	}
+	return nil
+}
+
+// NewWithTrustRoot creates a new Sigstore verifier trusting a private sigstore
+// deployment instead of the public one. Transparency log entries and signed
+// certificate timestamps are required when the trust root has the logs to
+// check them against.
+func NewWithTrustRoot(tr *TrustRoot, authOpts ...container.AuthMethod) (*Sigstore, error) {
+	if err := tr.Validate(); err != nil {
+		return nil, err
+	}
+
+	trustedMaterial, err := tr.trustedMaterial()
+	if err != nil {
+		return nil, err
+	}
+
+	sev, err := verify.NewVerifier(trustedMaterial, trustRootVerifierOptions(trustedMaterial)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sigstore{
+		verifier: sev,
+		authOpts: authOpts,
+	}, nil
+}
+
+func (tr *TrustRoot) trustedMaterial() (root.TrustedMaterial, error) {
+	if len(tr.TrustedRoot) > 0 {
+		return root.NewTrustedRootFromJSON(tr.TrustedRoot)
+	}
+
+	tufOpts := tuf.DefaultOptions()
+	tufOpts.DisableLocalCache = true
+	tufOpts.RepositoryBaseURL = tr.TUFURL
+	tufOpts.Root = tr.TUFRoot
+	trustedRoot, err := root.FetchTrustedRootWithOptions(tufOpts)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching trusted root from %s: %w", tr.TUFURL, err)
+	}
+	return trustedRoot, nil
+}
+
+func trustRootVerifierOptions(trustedMaterial root.TrustedMaterial) []verify.VerifierOption {
+	var opts []verify.VerifierOption
+	if len(trustedMaterial.CTLogs()) > 0 {
+		opts = append(opts, verify.WithSignedCertificateTimestamps(1))
+	}
+	if len(trustedMaterial.RekorLogs()) > 0 {
+		opts = append(opts, verify.WithTransparencyLog(1))
+	}
+	return append(opts, verify.WithObserverTimestamps(1))
+}
+
+// TrustRootResolver returns the sigstore trust roots of the projects
+type TrustRootResolver interface {
+	// TrustRoot returns the trust root of the project, or nil if the
+	// project uses the public sigstore instance.
+	TrustRoot(ctx context.Context, projectID uuid.UUID) (*TrustRoot, error)
+}
+
+type projectTrustRootKey struct{}
+
+type projectTrustRoot struct {
+	resolver  TrustRootResolver
+	projectID uuid.UUID
+}
+
+// WithProjectTrustRoot returns a context through which the artifacts of the
+// evaluated project are verified against the trust root of the project.
+func WithProjectTrustRoot(ctx context.Context, resolver TrustRootResolver, projectID uuid.UUID) context.Context {
+	return context.WithValue(ctx, projectTrustRootKey{}, projectTrustRoot{
+		resolver:  resolver,
+		projectID: projectID,
+	})
+}
+
+// TrustRootFromContext returns the trust root of the project being
+// evaluated, or nil if the public sigstore instance is to be used.
+func TrustRootFromContext(ctx context.Context) (*TrustRoot, error) {
+	tr, ok := ctx.Value(projectTrustRootKey{}).(projectTrustRoot)
+	if !ok || tr.resolver == nil {
+		return nil, nil
+	}
+	return tr.resolver.TrustRoot(ctx, tr.projectID)
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sigstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/sigstore/sigstore-go/pkg/testing/ca"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustRootValidate(t *testing.T) {
+	t.Parallel()
+
+	trustedRoot := newTestTrustedRoot(t, newTestVirtualSigstore(t))
+
+	tests := []struct {
+		name      string
+		trustRoot TrustRoot
+		wantErr   bool
+	}{
+		{
+			name:      "trusted root",
+			trustRoot: TrustRoot{TrustedRoot: trustedRoot},
+		},
+		{
+			name: "TUF repository",
+			trustRoot: TrustRoot{
+				TUFURL:  "https://tuf.example.com",
+				TUFRoot: []byte(`{"signed": {}, "signatures": []}`),
+			},
+		},
+		{
+			name:    "empty",
+			wantErr: true,
+		},
+		{
+			name: "both a trusted root and a TUF repository",
+			trustRoot: TrustRoot{
+				TUFURL:      "https://tuf.example.com",
+				TUFRoot:     []byte(`{}`),
+				TrustedRoot: trustedRoot,
+			},
+			wantErr: true,
+		},
+		{
+			name:      "TUF repository without root",
+			trustRoot: TrustRoot{TUFURL: "https://tuf.example.com"},
+			wantErr:   true,
+		},
+		{
+			name: "TUF repository over http",
+			trustRoot: TrustRoot{
+				TUFURL:  "http://tuf.example.com",
+				TUFRoot: []byte(`{}`),
+			},
+			wantErr: true,
+		},
+		{
+			name:      "malformed trusted root",
+			trustRoot: TrustRoot{TrustedRoot: []byte(`{"mediaType": "unknown"}`)},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.trustRoot.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidTrustRoot)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewWithTrustRoot(t *testing.T) {
+	t.Parallel()
+
+	vs := newTestVirtualSigstore(t)
+	s, err := NewWithTrustRoot(&TrustRoot{TrustedRoot: newTestTrustedRoot(t, vs)})
+	require.NoError(t, err)
+
+	artifact := []byte("artifact")
+	entity, err := vs.Sign("user@example.com", "https://issuer.example.com", artifact)
+	require.NoError(t, err)
+
+	identity, err := verify.NewShortCertificateIdentity("https://issuer.example.com", "", "user@example.com", "")
+	require.NoError(t, err)
+	_, err = s.verifier.Verify(entity, verify.NewPolicy(
+		verify.WithArtifact(bytes.NewReader(artifact)), verify.WithCertificateIdentity(identity)))
+	require.NoError(t, err)
+
+	// signatures of other sigstore deployments are rejected
+	other, err := newTestVirtualSigstore(t).Sign("user@example.com", "https://issuer.example.com", artifact)
+	require.NoError(t, err)
+	_, err = s.verifier.Verify(other, verify.NewPolicy(
+		verify.WithArtifact(bytes.NewReader(artifact)), verify.WithCertificateIdentity(identity)))
+	require.Error(t, err)
+}
+
+type staticTrustRoots map[uuid.UUID]*TrustRoot
+
+func (r staticTrustRoots) TrustRoot(_ context.Context, projectID uuid.UUID) (*TrustRoot, error) {
+	return r[projectID], nil
+}
+
+func TestTrustRootFromContext(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	trustRoot := &TrustRoot{TUFURL: "https://tuf.example.com"}
+	resolver := staticTrustRoots{projectID: trustRoot}
+
+	got, err := TrustRootFromContext(WithProjectTrustRoot(context.Background(), resolver, projectID))
+	require.NoError(t, err)
+	require.Equal(t, trustRoot, got)
+
+	got, err = TrustRootFromContext(WithProjectTrustRoot(context.Background(), resolver, uuid.New()))
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	got, err = TrustRootFromContext(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func newTestVirtualSigstore(t *testing.T) *ca.VirtualSigstore {
+	t.Helper()
+
+	vs, err := ca.NewVirtualSigstore()
+	require.NoError(t, err)
+	return vs
+}
+
+// newTestTrustedRoot returns the trusted root of a virtual sigstore
+// deployment. The certificates of the virtual deployment have no signed
+// certificate timestamps, so its CT logs are left out. The virtual
+// deployment holds the IDs of its logs hex-encoded, while trusted roots hold
+// them raw.
+func newTestTrustedRoot(t *testing.T, vs *ca.VirtualSigstore) []byte {
+	t.Helper()
+
+	rekorLogs := vs.RekorLogs()
+	for id, tlog := range rekorLogs {
+		rawID, err := hex.DecodeString(id)
+		require.NoError(t, err)
+		tlog.ID = rawID
+	}
+
+	trustedRoot, err := root.NewTrustedRoot(root.TrustedRootMediaType01,
+		vs.FulcioCertificateAuthorities(), nil, vs.TimestampingAuthorities(), rekorLogs)
+	require.NoError(t, err)
+	out, err := trustedRoot.MarshalJSON()
+	require.NoError(t, err)
+	return out
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	verifyif "github.com/mindersec/minder/internal/verifier/verifyif"
+	bundle "github.com/sigstore/sigstore-go/pkg/bundle"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -56,6 +57,20 @@ func (mr *MockArtifactVerifierMockRecorder) Verify(ctx, artifactType, owner, nam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockArtifactVerifier)(nil).Verify), ctx, artifactType, owner, name, checksumref)
 }
 
+// VerifyBundle mocks base method.
+func (m *MockArtifactVerifier) VerifyBundle(ctx context.Context, sigBundle *bundle.Bundle, digestAlgorithm string, digest []byte) verifyif.Result {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyBundle", ctx, sigBundle, digestAlgorithm, digest)
+	ret0, _ := ret[0].(verifyif.Result)
+	return ret0
+}
+
+// VerifyBundle indicates an expected call of VerifyBundle.
+func (mr *MockArtifactVerifierMockRecorder) VerifyBundle(ctx, sigBundle, digestAlgorithm, digest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyBundle", reflect.TypeOf((*MockArtifactVerifier)(nil).VerifyBundle), ctx, sigBundle, digestAlgorithm, digest)
+}
+
 // VerifyContainer mocks base method.
 func (m *MockArtifactVerifier) VerifyContainer(ctx context.Context, owner, artifact, checksumref string) ([]verifyif.Result, error) {
 	m.ctrl.T.Helper()
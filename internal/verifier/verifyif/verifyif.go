@@ -8,6 +8,7 @@ package verifyif
 import (
 	"context"
 
+	"github.com/sigstore/sigstore-go/pkg/bundle"
 	"github.com/sigstore/sigstore-go/pkg/verify"
 )
 
@@ -25,6 +26,14 @@ const (
 type Result struct {
 	IsSigned   bool `json:"is_signed"`
 	IsVerified bool `json:"is_verified"`
+	// Platform is the platform of the image the result is for, e.g.
+	// linux/amd64, when the verified artifact is an image index. It is
+	// empty for single images.
+	Platform string `json:"platform,omitempty"`
+	// SignedDigest is the digest the signature was made for. For the
+	// platforms of an image index signed as a whole, it is the digest of
+	// the index, which pins the digests of its platforms.
+	SignedDigest string `json:"signed_digest,omitempty"`
 	verify.VerificationResult
 }
 
@@ -34,4 +43,9 @@ type ArtifactVerifier interface {
 		owner, name, checksumref string) ([]Result, error)
 	VerifyContainer(ctx context.Context,
 		owner, artifact, checksumref string) ([]Result, error)
+	// VerifyBundle verifies a sigstore bundle fetched along with the
+	// artifact, e.g. from a package registry, against the digest of the
+	// artifact. A bundle which fails verification is signed, but not verified.
+	VerifyBundle(ctx context.Context, sigBundle *bundle.Bundle,
+		digestAlgorithm string, digest []byte) Result
 }
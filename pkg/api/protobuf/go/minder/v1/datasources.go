@@ -27,6 +27,8 @@ func (ds *DataSource) GetDriverType() string {
 		return v1datasources.DataSourceDriverRest
 	case *DataSource_Structured:
 		return v1datasources.DataSourceDriverStruct
+	case *DataSource_DepsDev:
+		return v1datasources.DataSourceDriverDepsDev
 	default:
 		return "unknown"
 	}
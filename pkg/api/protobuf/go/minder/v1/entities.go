@@ -26,6 +26,8 @@ const (
 	TaskRunEntity EntityType = "task_run"
 	// BuildEntity is an entity that represents a software build
 	BuildEntity EntityType = "build"
+	// OrganizationEntity is an entity that represents the settings of an organization
+	OrganizationEntity EntityType = "organization"
 	// UnknownEntity is an explicitly unknown entity
 	UnknownEntity EntityType = "unknown"
 )
@@ -46,6 +48,7 @@ var (
 		PipelineRunEntity:      Entity_ENTITY_PIPELINE_RUN,
 		TaskRunEntity:          Entity_ENTITY_TASK_RUN,
 		BuildEntity:            Entity_ENTITY_BUILD,
+		OrganizationEntity:     Entity_ENTITY_ORGANIZATION,
 		UnknownEntity:          Entity_ENTITY_UNSPECIFIED,
 	}
 	pbToEntityType = map[Entity]EntityType{
@@ -57,6 +60,7 @@ var (
 		Entity_ENTITY_PIPELINE_RUN:       PipelineRunEntity,
 		Entity_ENTITY_TASK_RUN:           TaskRunEntity,
 		Entity_ENTITY_BUILD:              BuildEntity,
+		Entity_ENTITY_ORGANIZATION:       OrganizationEntity,
 		Entity_ENTITY_UNSPECIFIED:        UnknownEntity,
 	}
 )
@@ -67,7 +71,8 @@ func (entity Entity) IsValid() bool {
 	case Entity_ENTITY_REPOSITORIES, Entity_ENTITY_BUILD_ENVIRONMENTS,
 		Entity_ENTITY_ARTIFACTS, Entity_ENTITY_PULL_REQUESTS,
 		Entity_ENTITY_RELEASE, Entity_ENTITY_PIPELINE_RUN,
-		Entity_ENTITY_TASK_RUN, Entity_ENTITY_BUILD:
+		Entity_ENTITY_TASK_RUN, Entity_ENTITY_BUILD,
+		Entity_ENTITY_ORGANIZATION:
 		return true
 	case Entity_ENTITY_UNSPECIFIED:
 		return false
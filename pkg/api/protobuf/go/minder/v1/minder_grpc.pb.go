@@ -22,8 +22,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HealthService_CheckHealth_FullMethodName = "/minder.v1.HealthService/CheckHealth"
-	HealthService_GetVersion_FullMethodName  = "/minder.v1.HealthService/GetVersion"
+	HealthService_CheckHealth_FullMethodName           = "/minder.v1.HealthService/CheckHealth"
+	HealthService_GetVersion_FullMethodName            = "/minder.v1.HealthService/GetVersion"
+	HealthService_GetServerMetadata_FullMethodName     = "/minder.v1.HealthService/GetServerMetadata"
+	HealthService_GetServerCapabilities_FullMethodName = "/minder.v1.HealthService/GetServerCapabilities"
 )
 
 // HealthServiceClient is the client API for HealthService service.
@@ -35,6 +37,8 @@ const (
 type HealthServiceClient interface {
 	CheckHealth(ctx context.Context, in *CheckHealthRequest, opts ...grpc.CallOption) (*CheckHealthResponse, error)
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	GetServerMetadata(ctx context.Context, in *GetServerMetadataRequest, opts ...grpc.CallOption) (*GetServerMetadataResponse, error)
+	GetServerCapabilities(ctx context.Context, in *GetServerCapabilitiesRequest, opts ...grpc.CallOption) (*GetServerCapabilitiesResponse, error)
 }
 
 type healthServiceClient struct {
@@ -65,6 +69,26 @@ func (c *healthServiceClient) GetVersion(ctx context.Context, in *GetVersionRequ
 	return out, nil
 }
 
+func (c *healthServiceClient) GetServerMetadata(ctx context.Context, in *GetServerMetadataRequest, opts ...grpc.CallOption) (*GetServerMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServerMetadataResponse)
+	err := c.cc.Invoke(ctx, HealthService_GetServerMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) GetServerCapabilities(ctx context.Context, in *GetServerCapabilitiesRequest, opts ...grpc.CallOption) (*GetServerCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServerCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, HealthService_GetServerCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HealthServiceServer is the server API for HealthService service.
 // All implementations must embed UnimplementedHealthServiceServer
 // for forward compatibility.
@@ -74,6 +98,8 @@ func (c *healthServiceClient) GetVersion(ctx context.Context, in *GetVersionRequ
 type HealthServiceServer interface {
 	CheckHealth(context.Context, *CheckHealthRequest) (*CheckHealthResponse, error)
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	GetServerMetadata(context.Context, *GetServerMetadataRequest) (*GetServerMetadataResponse, error)
+	GetServerCapabilities(context.Context, *GetServerCapabilitiesRequest) (*GetServerCapabilitiesResponse, error)
 	mustEmbedUnimplementedHealthServiceServer()
 }
 
@@ -90,6 +116,12 @@ func (UnimplementedHealthServiceServer) CheckHealth(context.Context, *CheckHealt
 func (UnimplementedHealthServiceServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetVersion not implemented")
 }
+func (UnimplementedHealthServiceServer) GetServerMetadata(context.Context, *GetServerMetadataRequest) (*GetServerMetadataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetServerMetadata not implemented")
+}
+func (UnimplementedHealthServiceServer) GetServerCapabilities(context.Context, *GetServerCapabilitiesRequest) (*GetServerCapabilitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetServerCapabilities not implemented")
+}
 func (UnimplementedHealthServiceServer) mustEmbedUnimplementedHealthServiceServer() {}
 func (UnimplementedHealthServiceServer) testEmbeddedByValue()                       {}
 
@@ -147,6 +179,42 @@ func _HealthService_GetVersion_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HealthService_GetServerMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).GetServerMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_GetServerMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).GetServerMetadata(ctx, req.(*GetServerMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_GetServerCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).GetServerCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_GetServerCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).GetServerCapabilities(ctx, req.(*GetServerCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HealthService_ServiceDesc is the grpc.ServiceDesc for HealthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -162,15 +230,24 @@ var HealthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVersion",
 			Handler:    _HealthService_GetVersion_Handler,
 		},
+		{
+			MethodName: "GetServerMetadata",
+			Handler:    _HealthService_GetServerMetadata_Handler,
+		},
+		{
+			MethodName: "GetServerCapabilities",
+			Handler:    _HealthService_GetServerCapabilities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
 }
 
 const (
-	ArtifactService_ListArtifacts_FullMethodName     = "/minder.v1.ArtifactService/ListArtifacts"
-	ArtifactService_GetArtifactById_FullMethodName   = "/minder.v1.ArtifactService/GetArtifactById"
-	ArtifactService_GetArtifactByName_FullMethodName = "/minder.v1.ArtifactService/GetArtifactByName"
+	ArtifactService_ListArtifacts_FullMethodName             = "/minder.v1.ArtifactService/ListArtifacts"
+	ArtifactService_GetArtifactById_FullMethodName           = "/minder.v1.ArtifactService/GetArtifactById"
+	ArtifactService_GetArtifactByName_FullMethodName         = "/minder.v1.ArtifactService/GetArtifactByName"
+	ArtifactService_ListArtifactsByRepository_FullMethodName = "/minder.v1.ArtifactService/ListArtifactsByRepository"
 )
 
 // ArtifactServiceClient is the client API for ArtifactService service.
@@ -180,6 +257,7 @@ type ArtifactServiceClient interface {
 	ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error)
 	GetArtifactById(ctx context.Context, in *GetArtifactByIdRequest, opts ...grpc.CallOption) (*GetArtifactByIdResponse, error)
 	GetArtifactByName(ctx context.Context, in *GetArtifactByNameRequest, opts ...grpc.CallOption) (*GetArtifactByNameResponse, error)
+	ListArtifactsByRepository(ctx context.Context, in *ListArtifactsByRepositoryRequest, opts ...grpc.CallOption) (*ListArtifactsByRepositoryResponse, error)
 }
 
 type artifactServiceClient struct {
@@ -220,6 +298,16 @@ func (c *artifactServiceClient) GetArtifactByName(ctx context.Context, in *GetAr
 	return out, nil
 }
 
+func (c *artifactServiceClient) ListArtifactsByRepository(ctx context.Context, in *ListArtifactsByRepositoryRequest, opts ...grpc.CallOption) (*ListArtifactsByRepositoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListArtifactsByRepositoryResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_ListArtifactsByRepository_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArtifactServiceServer is the server API for ArtifactService service.
 // All implementations must embed UnimplementedArtifactServiceServer
 // for forward compatibility.
@@ -227,6 +315,7 @@ type ArtifactServiceServer interface {
 	ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error)
 	GetArtifactById(context.Context, *GetArtifactByIdRequest) (*GetArtifactByIdResponse, error)
 	GetArtifactByName(context.Context, *GetArtifactByNameRequest) (*GetArtifactByNameResponse, error)
+	ListArtifactsByRepository(context.Context, *ListArtifactsByRepositoryRequest) (*ListArtifactsByRepositoryResponse, error)
 	mustEmbedUnimplementedArtifactServiceServer()
 }
 
@@ -246,6 +335,9 @@ func (UnimplementedArtifactServiceServer) GetArtifactById(context.Context, *GetA
 func (UnimplementedArtifactServiceServer) GetArtifactByName(context.Context, *GetArtifactByNameRequest) (*GetArtifactByNameResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetArtifactByName not implemented")
 }
+func (UnimplementedArtifactServiceServer) ListArtifactsByRepository(context.Context, *ListArtifactsByRepositoryRequest) (*ListArtifactsByRepositoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListArtifactsByRepository not implemented")
+}
 func (UnimplementedArtifactServiceServer) mustEmbedUnimplementedArtifactServiceServer() {}
 func (UnimplementedArtifactServiceServer) testEmbeddedByValue()                         {}
 
@@ -321,6 +413,24 @@ func _ArtifactService_GetArtifactByName_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArtifactService_ListArtifactsByRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtifactsByRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).ListArtifactsByRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_ListArtifactsByRepository_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).ListArtifactsByRepository(ctx, req.(*ListArtifactsByRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArtifactService_ServiceDesc is the grpc.ServiceDesc for ArtifactService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -340,6 +450,10 @@ var ArtifactService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetArtifactByName",
 			Handler:    _ArtifactService_GetArtifactByName_Handler,
 		},
+		{
+			MethodName: "ListArtifactsByRepository",
+			Handler:    _ArtifactService_ListArtifactsByRepository_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -576,6 +690,7 @@ const (
 	RepositoryService_GetRepositoryByName_FullMethodName                = "/minder.v1.RepositoryService/GetRepositoryByName"
 	RepositoryService_DeleteRepositoryById_FullMethodName               = "/minder.v1.RepositoryService/DeleteRepositoryById"
 	RepositoryService_DeleteRepositoryByName_FullMethodName             = "/minder.v1.RepositoryService/DeleteRepositoryByName"
+	RepositoryService_SyncRepositories_FullMethodName                   = "/minder.v1.RepositoryService/SyncRepositories"
 )
 
 // RepositoryServiceClient is the client API for RepositoryService service.
@@ -589,6 +704,7 @@ type RepositoryServiceClient interface {
 	GetRepositoryByName(ctx context.Context, in *GetRepositoryByNameRequest, opts ...grpc.CallOption) (*GetRepositoryByNameResponse, error)
 	DeleteRepositoryById(ctx context.Context, in *DeleteRepositoryByIdRequest, opts ...grpc.CallOption) (*DeleteRepositoryByIdResponse, error)
 	DeleteRepositoryByName(ctx context.Context, in *DeleteRepositoryByNameRequest, opts ...grpc.CallOption) (*DeleteRepositoryByNameResponse, error)
+	SyncRepositories(ctx context.Context, in *SyncRepositoriesRequest, opts ...grpc.CallOption) (*SyncRepositoriesResponse, error)
 }
 
 type repositoryServiceClient struct {
@@ -669,6 +785,16 @@ func (c *repositoryServiceClient) DeleteRepositoryByName(ctx context.Context, in
 	return out, nil
 }
 
+func (c *repositoryServiceClient) SyncRepositories(ctx context.Context, in *SyncRepositoriesRequest, opts ...grpc.CallOption) (*SyncRepositoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncRepositoriesResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_SyncRepositories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepositoryServiceServer is the server API for RepositoryService service.
 // All implementations must embed UnimplementedRepositoryServiceServer
 // for forward compatibility.
@@ -680,6 +806,7 @@ type RepositoryServiceServer interface {
 	GetRepositoryByName(context.Context, *GetRepositoryByNameRequest) (*GetRepositoryByNameResponse, error)
 	DeleteRepositoryById(context.Context, *DeleteRepositoryByIdRequest) (*DeleteRepositoryByIdResponse, error)
 	DeleteRepositoryByName(context.Context, *DeleteRepositoryByNameRequest) (*DeleteRepositoryByNameResponse, error)
+	SyncRepositories(context.Context, *SyncRepositoriesRequest) (*SyncRepositoriesResponse, error)
 	mustEmbedUnimplementedRepositoryServiceServer()
 }
 
@@ -711,6 +838,9 @@ func (UnimplementedRepositoryServiceServer) DeleteRepositoryById(context.Context
 func (UnimplementedRepositoryServiceServer) DeleteRepositoryByName(context.Context, *DeleteRepositoryByNameRequest) (*DeleteRepositoryByNameResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteRepositoryByName not implemented")
 }
+func (UnimplementedRepositoryServiceServer) SyncRepositories(context.Context, *SyncRepositoriesRequest) (*SyncRepositoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SyncRepositories not implemented")
+}
 func (UnimplementedRepositoryServiceServer) mustEmbedUnimplementedRepositoryServiceServer() {}
 func (UnimplementedRepositoryServiceServer) testEmbeddedByValue()                           {}
 
@@ -858,6 +988,24 @@ func _RepositoryService_DeleteRepositoryByName_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_SyncRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRepositoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).SyncRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_SyncRepositories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).SyncRepositories(ctx, req.(*SyncRepositoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RepositoryService_ServiceDesc is the grpc.ServiceDesc for RepositoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -893,6 +1041,10 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRepositoryByName",
 			Handler:    _RepositoryService_DeleteRepositoryByName_Handler,
 		},
+		{
+			MethodName: "SyncRepositories",
+			Handler:    _RepositoryService_SyncRepositories_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -1191,16 +1343,27 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	ProfileService_CreateProfile_FullMethodName             = "/minder.v1.ProfileService/CreateProfile"
-	ProfileService_UpdateProfile_FullMethodName             = "/minder.v1.ProfileService/UpdateProfile"
-	ProfileService_PatchProfile_FullMethodName              = "/minder.v1.ProfileService/PatchProfile"
-	ProfileService_DeleteProfile_FullMethodName             = "/minder.v1.ProfileService/DeleteProfile"
-	ProfileService_ListProfiles_FullMethodName              = "/minder.v1.ProfileService/ListProfiles"
-	ProfileService_GetProfileById_FullMethodName            = "/minder.v1.ProfileService/GetProfileById"
-	ProfileService_GetProfileByName_FullMethodName          = "/minder.v1.ProfileService/GetProfileByName"
-	ProfileService_GetProfileStatusByName_FullMethodName    = "/minder.v1.ProfileService/GetProfileStatusByName"
-	ProfileService_GetProfileStatusById_FullMethodName      = "/minder.v1.ProfileService/GetProfileStatusById"
-	ProfileService_GetProfileStatusByProject_FullMethodName = "/minder.v1.ProfileService/GetProfileStatusByProject"
+	ProfileService_CreateProfile_FullMethodName                = "/minder.v1.ProfileService/CreateProfile"
+	ProfileService_UpdateProfile_FullMethodName                = "/minder.v1.ProfileService/UpdateProfile"
+	ProfileService_PatchProfile_FullMethodName                 = "/minder.v1.ProfileService/PatchProfile"
+	ProfileService_DeleteProfile_FullMethodName                = "/minder.v1.ProfileService/DeleteProfile"
+	ProfileService_ListProfiles_FullMethodName                 = "/minder.v1.ProfileService/ListProfiles"
+	ProfileService_GetProfileById_FullMethodName               = "/minder.v1.ProfileService/GetProfileById"
+	ProfileService_GetProfileByName_FullMethodName             = "/minder.v1.ProfileService/GetProfileByName"
+	ProfileService_GetProfileStatusByName_FullMethodName       = "/minder.v1.ProfileService/GetProfileStatusByName"
+	ProfileService_GetProfileStatusById_FullMethodName         = "/minder.v1.ProfileService/GetProfileStatusById"
+	ProfileService_GetProfileStatusByProject_FullMethodName    = "/minder.v1.ProfileService/GetProfileStatusByProject"
+	ProfileService_CreateProfileStatusShareLink_FullMethodName = "/minder.v1.ProfileService/CreateProfileStatusShareLink"
+	ProfileService_RevokeProfileStatusShareLink_FullMethodName = "/minder.v1.ProfileService/RevokeProfileStatusShareLink"
+	ProfileService_GetSharedProfileStatus_FullMethodName       = "/minder.v1.ProfileService/GetSharedProfileStatus"
+	ProfileService_CreateRuleException_FullMethodName          = "/minder.v1.ProfileService/CreateRuleException"
+	ProfileService_ReviewRuleException_FullMethodName          = "/minder.v1.ProfileService/ReviewRuleException"
+	ProfileService_ListRuleExceptions_FullMethodName           = "/minder.v1.ProfileService/ListRuleExceptions"
+	ProfileService_DisableProfileRule_FullMethodName           = "/minder.v1.ProfileService/DisableProfileRule"
+	ProfileService_EnableProfileRule_FullMethodName            = "/minder.v1.ProfileService/EnableProfileRule"
+	ProfileService_ListDisabledProfileRules_FullMethodName     = "/minder.v1.ProfileService/ListDisabledProfileRules"
+	ProfileService_ListProfileVersions_FullMethodName          = "/minder.v1.ProfileService/ListProfileVersions"
+	ProfileService_RollbackProfile_FullMethodName              = "/minder.v1.ProfileService/RollbackProfile"
 )
 
 // ProfileServiceClient is the client API for ProfileService service.
@@ -1217,6 +1380,25 @@ type ProfileServiceClient interface {
 	GetProfileStatusByName(ctx context.Context, in *GetProfileStatusByNameRequest, opts ...grpc.CallOption) (*GetProfileStatusByNameResponse, error)
 	GetProfileStatusById(ctx context.Context, in *GetProfileStatusByIdRequest, opts ...grpc.CallOption) (*GetProfileStatusByIdResponse, error)
 	GetProfileStatusByProject(ctx context.Context, in *GetProfileStatusByProjectRequest, opts ...grpc.CallOption) (*GetProfileStatusByProjectResponse, error)
+	CreateProfileStatusShareLink(ctx context.Context, in *CreateProfileStatusShareLinkRequest, opts ...grpc.CallOption) (*CreateProfileStatusShareLinkResponse, error)
+	RevokeProfileStatusShareLink(ctx context.Context, in *RevokeProfileStatusShareLinkRequest, opts ...grpc.CallOption) (*RevokeProfileStatusShareLinkResponse, error)
+	GetSharedProfileStatus(ctx context.Context, in *GetSharedProfileStatusRequest, opts ...grpc.CallOption) (*GetSharedProfileStatusResponse, error)
+	CreateRuleException(ctx context.Context, in *CreateRuleExceptionRequest, opts ...grpc.CallOption) (*CreateRuleExceptionResponse, error)
+	ReviewRuleException(ctx context.Context, in *ReviewRuleExceptionRequest, opts ...grpc.CallOption) (*ReviewRuleExceptionResponse, error)
+	ListRuleExceptions(ctx context.Context, in *ListRuleExceptionsRequest, opts ...grpc.CallOption) (*ListRuleExceptionsResponse, error)
+	// DisableProfileRule disables a rule of a profile without removing it
+	// from the profile. Disabled rules are reported as skipped.
+	DisableProfileRule(ctx context.Context, in *DisableProfileRuleRequest, opts ...grpc.CallOption) (*DisableProfileRuleResponse, error)
+	// EnableProfileRule enables a previously disabled rule of a profile.
+	EnableProfileRule(ctx context.Context, in *EnableProfileRuleRequest, opts ...grpc.CallOption) (*EnableProfileRuleResponse, error)
+	ListDisabledProfileRules(ctx context.Context, in *ListDisabledProfileRulesRequest, opts ...grpc.CallOption) (*ListDisabledProfileRulesResponse, error)
+	// ListProfileVersions lists the previous definitions of a profile, which
+	// are recorded every time the profile is updated.
+	ListProfileVersions(ctx context.Context, in *ListProfileVersionsRequest, opts ...grpc.CallOption) (*ListProfileVersionsResponse, error)
+	// RollbackProfile restores a previous definition of a profile. The
+	// rollback is itself an update, so the definition it replaces is
+	// recorded as a new version.
+	RollbackProfile(ctx context.Context, in *RollbackProfileRequest, opts ...grpc.CallOption) (*RollbackProfileResponse, error)
 }
 
 type profileServiceClient struct {
@@ -1327,6 +1509,116 @@ func (c *profileServiceClient) GetProfileStatusByProject(ctx context.Context, in
 	return out, nil
 }
 
+func (c *profileServiceClient) CreateProfileStatusShareLink(ctx context.Context, in *CreateProfileStatusShareLinkRequest, opts ...grpc.CallOption) (*CreateProfileStatusShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProfileStatusShareLinkResponse)
+	err := c.cc.Invoke(ctx, ProfileService_CreateProfileStatusShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) RevokeProfileStatusShareLink(ctx context.Context, in *RevokeProfileStatusShareLinkRequest, opts ...grpc.CallOption) (*RevokeProfileStatusShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeProfileStatusShareLinkResponse)
+	err := c.cc.Invoke(ctx, ProfileService_RevokeProfileStatusShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetSharedProfileStatus(ctx context.Context, in *GetSharedProfileStatusRequest, opts ...grpc.CallOption) (*GetSharedProfileStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedProfileStatusResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetSharedProfileStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CreateRuleException(ctx context.Context, in *CreateRuleExceptionRequest, opts ...grpc.CallOption) (*CreateRuleExceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRuleExceptionResponse)
+	err := c.cc.Invoke(ctx, ProfileService_CreateRuleException_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ReviewRuleException(ctx context.Context, in *ReviewRuleExceptionRequest, opts ...grpc.CallOption) (*ReviewRuleExceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewRuleExceptionResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ReviewRuleException_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListRuleExceptions(ctx context.Context, in *ListRuleExceptionsRequest, opts ...grpc.CallOption) (*ListRuleExceptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRuleExceptionsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListRuleExceptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DisableProfileRule(ctx context.Context, in *DisableProfileRuleRequest, opts ...grpc.CallOption) (*DisableProfileRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableProfileRuleResponse)
+	err := c.cc.Invoke(ctx, ProfileService_DisableProfileRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) EnableProfileRule(ctx context.Context, in *EnableProfileRuleRequest, opts ...grpc.CallOption) (*EnableProfileRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnableProfileRuleResponse)
+	err := c.cc.Invoke(ctx, ProfileService_EnableProfileRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListDisabledProfileRules(ctx context.Context, in *ListDisabledProfileRulesRequest, opts ...grpc.CallOption) (*ListDisabledProfileRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDisabledProfileRulesResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListDisabledProfileRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListProfileVersions(ctx context.Context, in *ListProfileVersionsRequest, opts ...grpc.CallOption) (*ListProfileVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProfileVersionsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListProfileVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) RollbackProfile(ctx context.Context, in *RollbackProfileRequest, opts ...grpc.CallOption) (*RollbackProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackProfileResponse)
+	err := c.cc.Invoke(ctx, ProfileService_RollbackProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfileServiceServer is the server API for ProfileService service.
 // All implementations must embed UnimplementedProfileServiceServer
 // for forward compatibility.
@@ -1341,6 +1633,25 @@ type ProfileServiceServer interface {
 	GetProfileStatusByName(context.Context, *GetProfileStatusByNameRequest) (*GetProfileStatusByNameResponse, error)
 	GetProfileStatusById(context.Context, *GetProfileStatusByIdRequest) (*GetProfileStatusByIdResponse, error)
 	GetProfileStatusByProject(context.Context, *GetProfileStatusByProjectRequest) (*GetProfileStatusByProjectResponse, error)
+	CreateProfileStatusShareLink(context.Context, *CreateProfileStatusShareLinkRequest) (*CreateProfileStatusShareLinkResponse, error)
+	RevokeProfileStatusShareLink(context.Context, *RevokeProfileStatusShareLinkRequest) (*RevokeProfileStatusShareLinkResponse, error)
+	GetSharedProfileStatus(context.Context, *GetSharedProfileStatusRequest) (*GetSharedProfileStatusResponse, error)
+	CreateRuleException(context.Context, *CreateRuleExceptionRequest) (*CreateRuleExceptionResponse, error)
+	ReviewRuleException(context.Context, *ReviewRuleExceptionRequest) (*ReviewRuleExceptionResponse, error)
+	ListRuleExceptions(context.Context, *ListRuleExceptionsRequest) (*ListRuleExceptionsResponse, error)
+	// DisableProfileRule disables a rule of a profile without removing it
+	// from the profile. Disabled rules are reported as skipped.
+	DisableProfileRule(context.Context, *DisableProfileRuleRequest) (*DisableProfileRuleResponse, error)
+	// EnableProfileRule enables a previously disabled rule of a profile.
+	EnableProfileRule(context.Context, *EnableProfileRuleRequest) (*EnableProfileRuleResponse, error)
+	ListDisabledProfileRules(context.Context, *ListDisabledProfileRulesRequest) (*ListDisabledProfileRulesResponse, error)
+	// ListProfileVersions lists the previous definitions of a profile, which
+	// are recorded every time the profile is updated.
+	ListProfileVersions(context.Context, *ListProfileVersionsRequest) (*ListProfileVersionsResponse, error)
+	// RollbackProfile restores a previous definition of a profile. The
+	// rollback is itself an update, so the definition it replaces is
+	// recorded as a new version.
+	RollbackProfile(context.Context, *RollbackProfileRequest) (*RollbackProfileResponse, error)
 	mustEmbedUnimplementedProfileServiceServer()
 }
 
@@ -1381,6 +1692,39 @@ func (UnimplementedProfileServiceServer) GetProfileStatusById(context.Context, *
 func (UnimplementedProfileServiceServer) GetProfileStatusByProject(context.Context, *GetProfileStatusByProjectRequest) (*GetProfileStatusByProjectResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProfileStatusByProject not implemented")
 }
+func (UnimplementedProfileServiceServer) CreateProfileStatusShareLink(context.Context, *CreateProfileStatusShareLinkRequest) (*CreateProfileStatusShareLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProfileStatusShareLink not implemented")
+}
+func (UnimplementedProfileServiceServer) RevokeProfileStatusShareLink(context.Context, *RevokeProfileStatusShareLinkRequest) (*RevokeProfileStatusShareLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeProfileStatusShareLink not implemented")
+}
+func (UnimplementedProfileServiceServer) GetSharedProfileStatus(context.Context, *GetSharedProfileStatusRequest) (*GetSharedProfileStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSharedProfileStatus not implemented")
+}
+func (UnimplementedProfileServiceServer) CreateRuleException(context.Context, *CreateRuleExceptionRequest) (*CreateRuleExceptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRuleException not implemented")
+}
+func (UnimplementedProfileServiceServer) ReviewRuleException(context.Context, *ReviewRuleExceptionRequest) (*ReviewRuleExceptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewRuleException not implemented")
+}
+func (UnimplementedProfileServiceServer) ListRuleExceptions(context.Context, *ListRuleExceptionsRequest) (*ListRuleExceptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRuleExceptions not implemented")
+}
+func (UnimplementedProfileServiceServer) DisableProfileRule(context.Context, *DisableProfileRuleRequest) (*DisableProfileRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableProfileRule not implemented")
+}
+func (UnimplementedProfileServiceServer) EnableProfileRule(context.Context, *EnableProfileRuleRequest) (*EnableProfileRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnableProfileRule not implemented")
+}
+func (UnimplementedProfileServiceServer) ListDisabledProfileRules(context.Context, *ListDisabledProfileRulesRequest) (*ListDisabledProfileRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDisabledProfileRules not implemented")
+}
+func (UnimplementedProfileServiceServer) ListProfileVersions(context.Context, *ListProfileVersionsRequest) (*ListProfileVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProfileVersions not implemented")
+}
+func (UnimplementedProfileServiceServer) RollbackProfile(context.Context, *RollbackProfileRequest) (*RollbackProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackProfile not implemented")
+}
 func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
 func (UnimplementedProfileServiceServer) testEmbeddedByValue()                        {}
 
@@ -1582,73 +1926,315 @@ func _ProfileService_GetProfileStatusByProject_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
-// ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ProfileService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "minder.v1.ProfileService",
-	HandlerType: (*ProfileServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateProfile",
-			Handler:    _ProfileService_CreateProfile_Handler,
-		},
-		{
-			MethodName: "UpdateProfile",
-			Handler:    _ProfileService_UpdateProfile_Handler,
-		},
-		{
-			MethodName: "PatchProfile",
-			Handler:    _ProfileService_PatchProfile_Handler,
-		},
-		{
-			MethodName: "DeleteProfile",
-			Handler:    _ProfileService_DeleteProfile_Handler,
-		},
-		{
-			MethodName: "ListProfiles",
-			Handler:    _ProfileService_ListProfiles_Handler,
-		},
-		{
-			MethodName: "GetProfileById",
-			Handler:    _ProfileService_GetProfileById_Handler,
-		},
-		{
-			MethodName: "GetProfileByName",
-			Handler:    _ProfileService_GetProfileByName_Handler,
-		},
-		{
-			MethodName: "GetProfileStatusByName",
-			Handler:    _ProfileService_GetProfileStatusByName_Handler,
-		},
-		{
-			MethodName: "GetProfileStatusById",
-			Handler:    _ProfileService_GetProfileStatusById_Handler,
-		},
-		{
-			MethodName: "GetProfileStatusByProject",
-			Handler:    _ProfileService_GetProfileStatusByProject_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "minder/v1/minder.proto",
+func _ProfileService_CreateProfileStatusShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProfileStatusShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateProfileStatusShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_CreateProfileStatusShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateProfileStatusShareLink(ctx, req.(*CreateProfileStatusShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-const (
-	DataSourceService_CreateDataSource_FullMethodName       = "/minder.v1.DataSourceService/CreateDataSource"
-	DataSourceService_GetDataSourceById_FullMethodName      = "/minder.v1.DataSourceService/GetDataSourceById"
-	DataSourceService_GetDataSourceByName_FullMethodName    = "/minder.v1.DataSourceService/GetDataSourceByName"
-	DataSourceService_ListDataSources_FullMethodName        = "/minder.v1.DataSourceService/ListDataSources"
-	DataSourceService_UpdateDataSource_FullMethodName       = "/minder.v1.DataSourceService/UpdateDataSource"
-	DataSourceService_DeleteDataSourceById_FullMethodName   = "/minder.v1.DataSourceService/DeleteDataSourceById"
-	DataSourceService_DeleteDataSourceByName_FullMethodName = "/minder.v1.DataSourceService/DeleteDataSourceByName"
-)
-
-// DataSourceServiceClient is the client API for DataSourceService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type DataSourceServiceClient interface {
-	CreateDataSource(ctx context.Context, in *CreateDataSourceRequest, opts ...grpc.CallOption) (*CreateDataSourceResponse, error)
+func _ProfileService_RevokeProfileStatusShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeProfileStatusShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).RevokeProfileStatusShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_RevokeProfileStatusShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).RevokeProfileStatusShareLink(ctx, req.(*RevokeProfileStatusShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_GetSharedProfileStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedProfileStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetSharedProfileStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetSharedProfileStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetSharedProfileStatus(ctx, req.(*GetSharedProfileStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_CreateRuleException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateRuleException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_CreateRuleException_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateRuleException(ctx, req.(*CreateRuleExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ReviewRuleException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRuleExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ReviewRuleException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ReviewRuleException_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ReviewRuleException(ctx, req.(*ReviewRuleExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListRuleExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuleExceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListRuleExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListRuleExceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListRuleExceptions(ctx, req.(*ListRuleExceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_DisableProfileRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableProfileRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DisableProfileRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_DisableProfileRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DisableProfileRule(ctx, req.(*DisableProfileRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_EnableProfileRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableProfileRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).EnableProfileRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_EnableProfileRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).EnableProfileRule(ctx, req.(*EnableProfileRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListDisabledProfileRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDisabledProfileRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListDisabledProfileRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListDisabledProfileRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListDisabledProfileRules(ctx, req.(*ListDisabledProfileRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListProfileVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfileVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListProfileVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListProfileVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListProfileVersions(ctx, req.(*ListProfileVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_RollbackProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).RollbackProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_RollbackProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).RollbackProfile(ctx, req.(*RollbackProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProfileService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "minder.v1.ProfileService",
+	HandlerType: (*ProfileServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProfile",
+			Handler:    _ProfileService_CreateProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _ProfileService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "PatchProfile",
+			Handler:    _ProfileService_PatchProfile_Handler,
+		},
+		{
+			MethodName: "DeleteProfile",
+			Handler:    _ProfileService_DeleteProfile_Handler,
+		},
+		{
+			MethodName: "ListProfiles",
+			Handler:    _ProfileService_ListProfiles_Handler,
+		},
+		{
+			MethodName: "GetProfileById",
+			Handler:    _ProfileService_GetProfileById_Handler,
+		},
+		{
+			MethodName: "GetProfileByName",
+			Handler:    _ProfileService_GetProfileByName_Handler,
+		},
+		{
+			MethodName: "GetProfileStatusByName",
+			Handler:    _ProfileService_GetProfileStatusByName_Handler,
+		},
+		{
+			MethodName: "GetProfileStatusById",
+			Handler:    _ProfileService_GetProfileStatusById_Handler,
+		},
+		{
+			MethodName: "GetProfileStatusByProject",
+			Handler:    _ProfileService_GetProfileStatusByProject_Handler,
+		},
+		{
+			MethodName: "CreateProfileStatusShareLink",
+			Handler:    _ProfileService_CreateProfileStatusShareLink_Handler,
+		},
+		{
+			MethodName: "RevokeProfileStatusShareLink",
+			Handler:    _ProfileService_RevokeProfileStatusShareLink_Handler,
+		},
+		{
+			MethodName: "GetSharedProfileStatus",
+			Handler:    _ProfileService_GetSharedProfileStatus_Handler,
+		},
+		{
+			MethodName: "CreateRuleException",
+			Handler:    _ProfileService_CreateRuleException_Handler,
+		},
+		{
+			MethodName: "ReviewRuleException",
+			Handler:    _ProfileService_ReviewRuleException_Handler,
+		},
+		{
+			MethodName: "ListRuleExceptions",
+			Handler:    _ProfileService_ListRuleExceptions_Handler,
+		},
+		{
+			MethodName: "DisableProfileRule",
+			Handler:    _ProfileService_DisableProfileRule_Handler,
+		},
+		{
+			MethodName: "EnableProfileRule",
+			Handler:    _ProfileService_EnableProfileRule_Handler,
+		},
+		{
+			MethodName: "ListDisabledProfileRules",
+			Handler:    _ProfileService_ListDisabledProfileRules_Handler,
+		},
+		{
+			MethodName: "ListProfileVersions",
+			Handler:    _ProfileService_ListProfileVersions_Handler,
+		},
+		{
+			MethodName: "RollbackProfile",
+			Handler:    _ProfileService_RollbackProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "minder/v1/minder.proto",
+}
+
+const (
+	DataSourceService_CreateDataSource_FullMethodName       = "/minder.v1.DataSourceService/CreateDataSource"
+	DataSourceService_GetDataSourceById_FullMethodName      = "/minder.v1.DataSourceService/GetDataSourceById"
+	DataSourceService_GetDataSourceByName_FullMethodName    = "/minder.v1.DataSourceService/GetDataSourceByName"
+	DataSourceService_ListDataSources_FullMethodName        = "/minder.v1.DataSourceService/ListDataSources"
+	DataSourceService_UpdateDataSource_FullMethodName       = "/minder.v1.DataSourceService/UpdateDataSource"
+	DataSourceService_DeleteDataSourceById_FullMethodName   = "/minder.v1.DataSourceService/DeleteDataSourceById"
+	DataSourceService_DeleteDataSourceByName_FullMethodName = "/minder.v1.DataSourceService/DeleteDataSourceByName"
+)
+
+// DataSourceServiceClient is the client API for DataSourceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DataSourceServiceClient interface {
+	CreateDataSource(ctx context.Context, in *CreateDataSourceRequest, opts ...grpc.CallOption) (*CreateDataSourceResponse, error)
 	GetDataSourceById(ctx context.Context, in *GetDataSourceByIdRequest, opts ...grpc.CallOption) (*GetDataSourceByIdResponse, error)
 	GetDataSourceByName(ctx context.Context, in *GetDataSourceByNameRequest, opts ...grpc.CallOption) (*GetDataSourceByNameResponse, error)
 	ListDataSources(ctx context.Context, in *ListDataSourcesRequest, opts ...grpc.CallOption) (*ListDataSourcesResponse, error)
@@ -1965,12 +2551,13 @@ var DataSourceService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	RuleTypeService_ListRuleTypes_FullMethodName     = "/minder.v1.RuleTypeService/ListRuleTypes"
-	RuleTypeService_GetRuleTypeByName_FullMethodName = "/minder.v1.RuleTypeService/GetRuleTypeByName"
-	RuleTypeService_GetRuleTypeById_FullMethodName   = "/minder.v1.RuleTypeService/GetRuleTypeById"
-	RuleTypeService_CreateRuleType_FullMethodName    = "/minder.v1.RuleTypeService/CreateRuleType"
-	RuleTypeService_UpdateRuleType_FullMethodName    = "/minder.v1.RuleTypeService/UpdateRuleType"
-	RuleTypeService_DeleteRuleType_FullMethodName    = "/minder.v1.RuleTypeService/DeleteRuleType"
+	RuleTypeService_ListRuleTypes_FullMethodName       = "/minder.v1.RuleTypeService/ListRuleTypes"
+	RuleTypeService_GetRuleTypeByName_FullMethodName   = "/minder.v1.RuleTypeService/GetRuleTypeByName"
+	RuleTypeService_GetRuleTypeById_FullMethodName     = "/minder.v1.RuleTypeService/GetRuleTypeById"
+	RuleTypeService_CreateRuleType_FullMethodName      = "/minder.v1.RuleTypeService/CreateRuleType"
+	RuleTypeService_UpdateRuleType_FullMethodName      = "/minder.v1.RuleTypeService/UpdateRuleType"
+	RuleTypeService_DeleteRuleType_FullMethodName      = "/minder.v1.RuleTypeService/DeleteRuleType"
+	RuleTypeService_BulkUpdateRuleTypes_FullMethodName = "/minder.v1.RuleTypeService/BulkUpdateRuleTypes"
 )
 
 // RuleTypeServiceClient is the client API for RuleTypeService service.
@@ -1983,6 +2570,10 @@ type RuleTypeServiceClient interface {
 	CreateRuleType(ctx context.Context, in *CreateRuleTypeRequest, opts ...grpc.CallOption) (*CreateRuleTypeResponse, error)
 	UpdateRuleType(ctx context.Context, in *UpdateRuleTypeRequest, opts ...grpc.CallOption) (*UpdateRuleTypeResponse, error)
 	DeleteRuleType(ctx context.Context, in *DeleteRuleTypeRequest, opts ...grpc.CallOption) (*DeleteRuleTypeResponse, error)
+	// BulkUpdateRuleTypes updates many rule types of a project at once, e.g.
+	// from an upstream repository of rule types. Either all the rule types
+	// are updated or none is.
+	BulkUpdateRuleTypes(ctx context.Context, in *BulkUpdateRuleTypesRequest, opts ...grpc.CallOption) (*BulkUpdateRuleTypesResponse, error)
 }
 
 type ruleTypeServiceClient struct {
@@ -2053,6 +2644,16 @@ func (c *ruleTypeServiceClient) DeleteRuleType(ctx context.Context, in *DeleteRu
 	return out, nil
 }
 
+func (c *ruleTypeServiceClient) BulkUpdateRuleTypes(ctx context.Context, in *BulkUpdateRuleTypesRequest, opts ...grpc.CallOption) (*BulkUpdateRuleTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateRuleTypesResponse)
+	err := c.cc.Invoke(ctx, RuleTypeService_BulkUpdateRuleTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RuleTypeServiceServer is the server API for RuleTypeService service.
 // All implementations must embed UnimplementedRuleTypeServiceServer
 // for forward compatibility.
@@ -2063,6 +2664,10 @@ type RuleTypeServiceServer interface {
 	CreateRuleType(context.Context, *CreateRuleTypeRequest) (*CreateRuleTypeResponse, error)
 	UpdateRuleType(context.Context, *UpdateRuleTypeRequest) (*UpdateRuleTypeResponse, error)
 	DeleteRuleType(context.Context, *DeleteRuleTypeRequest) (*DeleteRuleTypeResponse, error)
+	// BulkUpdateRuleTypes updates many rule types of a project at once, e.g.
+	// from an upstream repository of rule types. Either all the rule types
+	// are updated or none is.
+	BulkUpdateRuleTypes(context.Context, *BulkUpdateRuleTypesRequest) (*BulkUpdateRuleTypesResponse, error)
 	mustEmbedUnimplementedRuleTypeServiceServer()
 }
 
@@ -2091,6 +2696,9 @@ func (UnimplementedRuleTypeServiceServer) UpdateRuleType(context.Context, *Updat
 func (UnimplementedRuleTypeServiceServer) DeleteRuleType(context.Context, *DeleteRuleTypeRequest) (*DeleteRuleTypeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteRuleType not implemented")
 }
+func (UnimplementedRuleTypeServiceServer) BulkUpdateRuleTypes(context.Context, *BulkUpdateRuleTypesRequest) (*BulkUpdateRuleTypesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkUpdateRuleTypes not implemented")
+}
 func (UnimplementedRuleTypeServiceServer) mustEmbedUnimplementedRuleTypeServiceServer() {}
 func (UnimplementedRuleTypeServiceServer) testEmbeddedByValue()                         {}
 
@@ -2220,6 +2828,24 @@ func _RuleTypeService_DeleteRuleType_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuleTypeService_BulkUpdateRuleTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateRuleTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleTypeServiceServer).BulkUpdateRuleTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleTypeService_BulkUpdateRuleTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleTypeServiceServer).BulkUpdateRuleTypes(ctx, req.(*BulkUpdateRuleTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RuleTypeService_ServiceDesc is the grpc.ServiceDesc for RuleTypeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2251,15 +2877,23 @@ var RuleTypeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRuleType",
 			Handler:    _RuleTypeService_DeleteRuleType_Handler,
 		},
+		{
+			MethodName: "BulkUpdateRuleTypes",
+			Handler:    _RuleTypeService_BulkUpdateRuleTypes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
 }
 
 const (
-	EvalResultsService_ListEvaluationResults_FullMethodName = "/minder.v1.EvalResultsService/ListEvaluationResults"
-	EvalResultsService_ListEvaluationHistory_FullMethodName = "/minder.v1.EvalResultsService/ListEvaluationHistory"
-	EvalResultsService_GetEvaluationHistory_FullMethodName  = "/minder.v1.EvalResultsService/GetEvaluationHistory"
+	EvalResultsService_ListEvaluationResults_FullMethodName      = "/minder.v1.EvalResultsService/ListEvaluationResults"
+	EvalResultsService_ListEvaluationHistory_FullMethodName      = "/minder.v1.EvalResultsService/ListEvaluationHistory"
+	EvalResultsService_GetEvaluationHistory_FullMethodName       = "/minder.v1.EvalResultsService/GetEvaluationHistory"
+	EvalResultsService_PurgeStaleEvaluations_FullMethodName      = "/minder.v1.EvalResultsService/PurgeStaleEvaluations"
+	EvalResultsService_CreateEvaluationAnnotation_FullMethodName = "/minder.v1.EvalResultsService/CreateEvaluationAnnotation"
+	EvalResultsService_ListEvaluationAnnotations_FullMethodName  = "/minder.v1.EvalResultsService/ListEvaluationAnnotations"
+	EvalResultsService_DeleteEvaluationAnnotation_FullMethodName = "/minder.v1.EvalResultsService/DeleteEvaluationAnnotation"
 )
 
 // EvalResultsServiceClient is the client API for EvalResultsService service.
@@ -2269,6 +2903,14 @@ type EvalResultsServiceClient interface {
 	ListEvaluationResults(ctx context.Context, in *ListEvaluationResultsRequest, opts ...grpc.CallOption) (*ListEvaluationResultsResponse, error)
 	ListEvaluationHistory(ctx context.Context, in *ListEvaluationHistoryRequest, opts ...grpc.CallOption) (*ListEvaluationHistoryResponse, error)
 	GetEvaluationHistory(ctx context.Context, in *GetEvaluationHistoryRequest, opts ...grpc.CallOption) (*GetEvaluationHistoryResponse, error)
+	PurgeStaleEvaluations(ctx context.Context, in *PurgeStaleEvaluationsRequest, opts ...grpc.CallOption) (*PurgeStaleEvaluationsResponse, error)
+	// CreateEvaluationAnnotation attaches an annotation, such as a triage
+	// state, a ticket link or a note, to an evaluation result.
+	CreateEvaluationAnnotation(ctx context.Context, in *CreateEvaluationAnnotationRequest, opts ...grpc.CallOption) (*CreateEvaluationAnnotationResponse, error)
+	// ListEvaluationAnnotations lists the annotations of the rule and entity
+	// pair of an evaluation result, across all its evaluations.
+	ListEvaluationAnnotations(ctx context.Context, in *ListEvaluationAnnotationsRequest, opts ...grpc.CallOption) (*ListEvaluationAnnotationsResponse, error)
+	DeleteEvaluationAnnotation(ctx context.Context, in *DeleteEvaluationAnnotationRequest, opts ...grpc.CallOption) (*DeleteEvaluationAnnotationResponse, error)
 }
 
 type evalResultsServiceClient struct {
@@ -2309,17 +2951,65 @@ func (c *evalResultsServiceClient) GetEvaluationHistory(ctx context.Context, in
 	return out, nil
 }
 
-// EvalResultsServiceServer is the server API for EvalResultsService service.
-// All implementations must embed UnimplementedEvalResultsServiceServer
-// for forward compatibility.
-type EvalResultsServiceServer interface {
-	ListEvaluationResults(context.Context, *ListEvaluationResultsRequest) (*ListEvaluationResultsResponse, error)
-	ListEvaluationHistory(context.Context, *ListEvaluationHistoryRequest) (*ListEvaluationHistoryResponse, error)
-	GetEvaluationHistory(context.Context, *GetEvaluationHistoryRequest) (*GetEvaluationHistoryResponse, error)
-	mustEmbedUnimplementedEvalResultsServiceServer()
+func (c *evalResultsServiceClient) PurgeStaleEvaluations(ctx context.Context, in *PurgeStaleEvaluationsRequest, opts ...grpc.CallOption) (*PurgeStaleEvaluationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeStaleEvaluationsResponse)
+	err := c.cc.Invoke(ctx, EvalResultsService_PurgeStaleEvaluations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedEvalResultsServiceServer must be embedded to have
+func (c *evalResultsServiceClient) CreateEvaluationAnnotation(ctx context.Context, in *CreateEvaluationAnnotationRequest, opts ...grpc.CallOption) (*CreateEvaluationAnnotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEvaluationAnnotationResponse)
+	err := c.cc.Invoke(ctx, EvalResultsService_CreateEvaluationAnnotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evalResultsServiceClient) ListEvaluationAnnotations(ctx context.Context, in *ListEvaluationAnnotationsRequest, opts ...grpc.CallOption) (*ListEvaluationAnnotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEvaluationAnnotationsResponse)
+	err := c.cc.Invoke(ctx, EvalResultsService_ListEvaluationAnnotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evalResultsServiceClient) DeleteEvaluationAnnotation(ctx context.Context, in *DeleteEvaluationAnnotationRequest, opts ...grpc.CallOption) (*DeleteEvaluationAnnotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEvaluationAnnotationResponse)
+	err := c.cc.Invoke(ctx, EvalResultsService_DeleteEvaluationAnnotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EvalResultsServiceServer is the server API for EvalResultsService service.
+// All implementations must embed UnimplementedEvalResultsServiceServer
+// for forward compatibility.
+type EvalResultsServiceServer interface {
+	ListEvaluationResults(context.Context, *ListEvaluationResultsRequest) (*ListEvaluationResultsResponse, error)
+	ListEvaluationHistory(context.Context, *ListEvaluationHistoryRequest) (*ListEvaluationHistoryResponse, error)
+	GetEvaluationHistory(context.Context, *GetEvaluationHistoryRequest) (*GetEvaluationHistoryResponse, error)
+	PurgeStaleEvaluations(context.Context, *PurgeStaleEvaluationsRequest) (*PurgeStaleEvaluationsResponse, error)
+	// CreateEvaluationAnnotation attaches an annotation, such as a triage
+	// state, a ticket link or a note, to an evaluation result.
+	CreateEvaluationAnnotation(context.Context, *CreateEvaluationAnnotationRequest) (*CreateEvaluationAnnotationResponse, error)
+	// ListEvaluationAnnotations lists the annotations of the rule and entity
+	// pair of an evaluation result, across all its evaluations.
+	ListEvaluationAnnotations(context.Context, *ListEvaluationAnnotationsRequest) (*ListEvaluationAnnotationsResponse, error)
+	DeleteEvaluationAnnotation(context.Context, *DeleteEvaluationAnnotationRequest) (*DeleteEvaluationAnnotationResponse, error)
+	mustEmbedUnimplementedEvalResultsServiceServer()
+}
+
+// UnimplementedEvalResultsServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
@@ -2335,6 +3025,18 @@ func (UnimplementedEvalResultsServiceServer) ListEvaluationHistory(context.Conte
 func (UnimplementedEvalResultsServiceServer) GetEvaluationHistory(context.Context, *GetEvaluationHistoryRequest) (*GetEvaluationHistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetEvaluationHistory not implemented")
 }
+func (UnimplementedEvalResultsServiceServer) PurgeStaleEvaluations(context.Context, *PurgeStaleEvaluationsRequest) (*PurgeStaleEvaluationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeStaleEvaluations not implemented")
+}
+func (UnimplementedEvalResultsServiceServer) CreateEvaluationAnnotation(context.Context, *CreateEvaluationAnnotationRequest) (*CreateEvaluationAnnotationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEvaluationAnnotation not implemented")
+}
+func (UnimplementedEvalResultsServiceServer) ListEvaluationAnnotations(context.Context, *ListEvaluationAnnotationsRequest) (*ListEvaluationAnnotationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEvaluationAnnotations not implemented")
+}
+func (UnimplementedEvalResultsServiceServer) DeleteEvaluationAnnotation(context.Context, *DeleteEvaluationAnnotationRequest) (*DeleteEvaluationAnnotationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEvaluationAnnotation not implemented")
+}
 func (UnimplementedEvalResultsServiceServer) mustEmbedUnimplementedEvalResultsServiceServer() {}
 func (UnimplementedEvalResultsServiceServer) testEmbeddedByValue()                            {}
 
@@ -2410,6 +3112,78 @@ func _EvalResultsService_GetEvaluationHistory_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EvalResultsService_PurgeStaleEvaluations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeStaleEvaluationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvalResultsServiceServer).PurgeStaleEvaluations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EvalResultsService_PurgeStaleEvaluations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvalResultsServiceServer).PurgeStaleEvaluations(ctx, req.(*PurgeStaleEvaluationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvalResultsService_CreateEvaluationAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEvaluationAnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvalResultsServiceServer).CreateEvaluationAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EvalResultsService_CreateEvaluationAnnotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvalResultsServiceServer).CreateEvaluationAnnotation(ctx, req.(*CreateEvaluationAnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvalResultsService_ListEvaluationAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEvaluationAnnotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvalResultsServiceServer).ListEvaluationAnnotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EvalResultsService_ListEvaluationAnnotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvalResultsServiceServer).ListEvaluationAnnotations(ctx, req.(*ListEvaluationAnnotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvalResultsService_DeleteEvaluationAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEvaluationAnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvalResultsServiceServer).DeleteEvaluationAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EvalResultsService_DeleteEvaluationAnnotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvalResultsServiceServer).DeleteEvaluationAnnotation(ctx, req.(*DeleteEvaluationAnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EvalResultsService_ServiceDesc is the grpc.ServiceDesc for EvalResultsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2429,6 +3203,22 @@ var EvalResultsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvaluationHistory",
 			Handler:    _EvalResultsService_GetEvaluationHistory_Handler,
 		},
+		{
+			MethodName: "PurgeStaleEvaluations",
+			Handler:    _EvalResultsService_PurgeStaleEvaluations_Handler,
+		},
+		{
+			MethodName: "CreateEvaluationAnnotation",
+			Handler:    _EvalResultsService_CreateEvaluationAnnotation_Handler,
+		},
+		{
+			MethodName: "ListEvaluationAnnotations",
+			Handler:    _EvalResultsService_ListEvaluationAnnotations_Handler,
+		},
+		{
+			MethodName: "DeleteEvaluationAnnotation",
+			Handler:    _EvalResultsService_DeleteEvaluationAnnotation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -2696,6 +3486,17 @@ const (
 	ProjectsService_UpdateProject_FullMethodName                  = "/minder.v1.ProjectsService/UpdateProject"
 	ProjectsService_PatchProject_FullMethodName                   = "/minder.v1.ProjectsService/PatchProject"
 	ProjectsService_CreateEntityReconciliationTask_FullMethodName = "/minder.v1.ProjectsService/CreateEntityReconciliationTask"
+	ProjectsService_ListPendingOperations_FullMethodName          = "/minder.v1.ProjectsService/ListPendingOperations"
+	ProjectsService_ConfirmPendingOperation_FullMethodName        = "/minder.v1.ProjectsService/ConfirmPendingOperation"
+	ProjectsService_CancelPendingOperation_FullMethodName         = "/minder.v1.ProjectsService/CancelPendingOperation"
+	ProjectsService_GetProjectTier_FullMethodName                 = "/minder.v1.ProjectsService/GetProjectTier"
+	ProjectsService_CreateDeployKey_FullMethodName                = "/minder.v1.ProjectsService/CreateDeployKey"
+	ProjectsService_ListDeployKeys_FullMethodName                 = "/minder.v1.ProjectsService/ListDeployKeys"
+	ProjectsService_DeleteDeployKey_FullMethodName                = "/minder.v1.ProjectsService/DeleteDeployKey"
+	ProjectsService_SetProjectSecret_FullMethodName               = "/minder.v1.ProjectsService/SetProjectSecret"
+	ProjectsService_ListProjectSecrets_FullMethodName             = "/minder.v1.ProjectsService/ListProjectSecrets"
+	ProjectsService_DeleteProjectSecret_FullMethodName            = "/minder.v1.ProjectsService/DeleteProjectSecret"
+	ProjectsService_GetProjectUsage_FullMethodName                = "/minder.v1.ProjectsService/GetProjectUsage"
 )
 
 // ProjectsServiceClient is the client API for ProjectsService service.
@@ -2709,6 +3510,39 @@ type ProjectsServiceClient interface {
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
 	PatchProject(ctx context.Context, in *PatchProjectRequest, opts ...grpc.CallOption) (*PatchProjectResponse, error)
 	CreateEntityReconciliationTask(ctx context.Context, in *CreateEntityReconciliationTaskRequest, opts ...grpc.CallOption) (*CreateEntityReconciliationTaskResponse, error)
+	// ListPendingOperations lists the destructive operations of the project
+	// waiting for, or which received, the confirmation of a second admin.
+	ListPendingOperations(ctx context.Context, in *ListPendingOperationsRequest, opts ...grpc.CallOption) (*ListPendingOperationsResponse, error)
+	// ConfirmPendingOperation performs a pending operation. Operations can't
+	// be confirmed by the user who requested them.
+	ConfirmPendingOperation(ctx context.Context, in *ConfirmPendingOperationRequest, opts ...grpc.CallOption) (*ConfirmPendingOperationResponse, error)
+	// CancelPendingOperation cancels a pending operation. Operations can be
+	// cancelled by the user who requested them, or by an admin.
+	CancelPendingOperation(ctx context.Context, in *CancelPendingOperationRequest, opts ...grpc.CallOption) (*CancelPendingOperationResponse, error)
+	// GetProjectTier returns the tier of the project, with its limits and
+	// the current usage of the project.
+	GetProjectTier(ctx context.Context, in *GetProjectTierRequest, opts ...grpc.CallOption) (*GetProjectTierResponse, error)
+	// CreateDeployKey generates an SSH deploy key for the project. The
+	// private key never leaves the server; the public key is returned to be
+	// installed on the repositories that git ingestions clone over SSH.
+	CreateDeployKey(ctx context.Context, in *CreateDeployKeyRequest, opts ...grpc.CallOption) (*CreateDeployKeyResponse, error)
+	// ListDeployKeys lists the SSH deploy keys of the project.
+	ListDeployKeys(ctx context.Context, in *ListDeployKeysRequest, opts ...grpc.CallOption) (*ListDeployKeysResponse, error)
+	// DeleteDeployKey deletes an SSH deploy key of the project.
+	DeleteDeployKey(ctx context.Context, in *DeleteDeployKeyRequest, opts ...grpc.CallOption) (*DeleteDeployKeyResponse, error)
+	// SetProjectSecret creates or replaces a secret of the project. Secrets
+	// can be referenced from rule parameters as {{ secret "name" }} and are
+	// resolved at evaluation time. Their values are never returned.
+	SetProjectSecret(ctx context.Context, in *SetProjectSecretRequest, opts ...grpc.CallOption) (*SetProjectSecretResponse, error)
+	// ListProjectSecrets lists the names of the secrets of the project.
+	ListProjectSecrets(ctx context.Context, in *ListProjectSecretsRequest, opts ...grpc.CallOption) (*ListProjectSecretsResponse, error)
+	// DeleteProjectSecret deletes a secret of the project.
+	DeleteProjectSecret(ctx context.Context, in *DeleteProjectSecretRequest, opts ...grpc.CallOption) (*DeleteProjectSecretResponse, error)
+	// GetProjectUsage returns the entity events, evaluation time and API
+	// calls of a project in the current usage window, and whether its
+	// evaluations are throttled. It requires per-project usage tracking to
+	// be enabled on the server.
+	GetProjectUsage(ctx context.Context, in *GetProjectUsageRequest, opts ...grpc.CallOption) (*GetProjectUsageResponse, error)
 }
 
 type projectsServiceClient struct {
@@ -2789,6 +3623,116 @@ func (c *projectsServiceClient) CreateEntityReconciliationTask(ctx context.Conte
 	return out, nil
 }
 
+func (c *projectsServiceClient) ListPendingOperations(ctx context.Context, in *ListPendingOperationsRequest, opts ...grpc.CallOption) (*ListPendingOperationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingOperationsResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_ListPendingOperations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) ConfirmPendingOperation(ctx context.Context, in *ConfirmPendingOperationRequest, opts ...grpc.CallOption) (*ConfirmPendingOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmPendingOperationResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_ConfirmPendingOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) CancelPendingOperation(ctx context.Context, in *CancelPendingOperationRequest, opts ...grpc.CallOption) (*CancelPendingOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPendingOperationResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_CancelPendingOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) GetProjectTier(ctx context.Context, in *GetProjectTierRequest, opts ...grpc.CallOption) (*GetProjectTierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectTierResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_GetProjectTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) CreateDeployKey(ctx context.Context, in *CreateDeployKeyRequest, opts ...grpc.CallOption) (*CreateDeployKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDeployKeyResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_CreateDeployKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) ListDeployKeys(ctx context.Context, in *ListDeployKeysRequest, opts ...grpc.CallOption) (*ListDeployKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeployKeysResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_ListDeployKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) DeleteDeployKey(ctx context.Context, in *DeleteDeployKeyRequest, opts ...grpc.CallOption) (*DeleteDeployKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDeployKeyResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_DeleteDeployKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) SetProjectSecret(ctx context.Context, in *SetProjectSecretRequest, opts ...grpc.CallOption) (*SetProjectSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProjectSecretResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_SetProjectSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) ListProjectSecrets(ctx context.Context, in *ListProjectSecretsRequest, opts ...grpc.CallOption) (*ListProjectSecretsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectSecretsResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_ListProjectSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) DeleteProjectSecret(ctx context.Context, in *DeleteProjectSecretRequest, opts ...grpc.CallOption) (*DeleteProjectSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProjectSecretResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_DeleteProjectSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) GetProjectUsage(ctx context.Context, in *GetProjectUsageRequest, opts ...grpc.CallOption) (*GetProjectUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectUsageResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_GetProjectUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectsServiceServer is the server API for ProjectsService service.
 // All implementations must embed UnimplementedProjectsServiceServer
 // for forward compatibility.
@@ -2800,6 +3744,39 @@ type ProjectsServiceServer interface {
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
 	PatchProject(context.Context, *PatchProjectRequest) (*PatchProjectResponse, error)
 	CreateEntityReconciliationTask(context.Context, *CreateEntityReconciliationTaskRequest) (*CreateEntityReconciliationTaskResponse, error)
+	// ListPendingOperations lists the destructive operations of the project
+	// waiting for, or which received, the confirmation of a second admin.
+	ListPendingOperations(context.Context, *ListPendingOperationsRequest) (*ListPendingOperationsResponse, error)
+	// ConfirmPendingOperation performs a pending operation. Operations can't
+	// be confirmed by the user who requested them.
+	ConfirmPendingOperation(context.Context, *ConfirmPendingOperationRequest) (*ConfirmPendingOperationResponse, error)
+	// CancelPendingOperation cancels a pending operation. Operations can be
+	// cancelled by the user who requested them, or by an admin.
+	CancelPendingOperation(context.Context, *CancelPendingOperationRequest) (*CancelPendingOperationResponse, error)
+	// GetProjectTier returns the tier of the project, with its limits and
+	// the current usage of the project.
+	GetProjectTier(context.Context, *GetProjectTierRequest) (*GetProjectTierResponse, error)
+	// CreateDeployKey generates an SSH deploy key for the project. The
+	// private key never leaves the server; the public key is returned to be
+	// installed on the repositories that git ingestions clone over SSH.
+	CreateDeployKey(context.Context, *CreateDeployKeyRequest) (*CreateDeployKeyResponse, error)
+	// ListDeployKeys lists the SSH deploy keys of the project.
+	ListDeployKeys(context.Context, *ListDeployKeysRequest) (*ListDeployKeysResponse, error)
+	// DeleteDeployKey deletes an SSH deploy key of the project.
+	DeleteDeployKey(context.Context, *DeleteDeployKeyRequest) (*DeleteDeployKeyResponse, error)
+	// SetProjectSecret creates or replaces a secret of the project. Secrets
+	// can be referenced from rule parameters as {{ secret "name" }} and are
+	// resolved at evaluation time. Their values are never returned.
+	SetProjectSecret(context.Context, *SetProjectSecretRequest) (*SetProjectSecretResponse, error)
+	// ListProjectSecrets lists the names of the secrets of the project.
+	ListProjectSecrets(context.Context, *ListProjectSecretsRequest) (*ListProjectSecretsResponse, error)
+	// DeleteProjectSecret deletes a secret of the project.
+	DeleteProjectSecret(context.Context, *DeleteProjectSecretRequest) (*DeleteProjectSecretResponse, error)
+	// GetProjectUsage returns the entity events, evaluation time and API
+	// calls of a project in the current usage window, and whether its
+	// evaluations are throttled. It requires per-project usage tracking to
+	// be enabled on the server.
+	GetProjectUsage(context.Context, *GetProjectUsageRequest) (*GetProjectUsageResponse, error)
 	mustEmbedUnimplementedProjectsServiceServer()
 }
 
@@ -2831,149 +3808,380 @@ func (UnimplementedProjectsServiceServer) PatchProject(context.Context, *PatchPr
 func (UnimplementedProjectsServiceServer) CreateEntityReconciliationTask(context.Context, *CreateEntityReconciliationTaskRequest) (*CreateEntityReconciliationTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateEntityReconciliationTask not implemented")
 }
-func (UnimplementedProjectsServiceServer) mustEmbedUnimplementedProjectsServiceServer() {}
-func (UnimplementedProjectsServiceServer) testEmbeddedByValue()                         {}
+func (UnimplementedProjectsServiceServer) ListPendingOperations(context.Context, *ListPendingOperationsRequest) (*ListPendingOperationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPendingOperations not implemented")
+}
+func (UnimplementedProjectsServiceServer) ConfirmPendingOperation(context.Context, *ConfirmPendingOperationRequest) (*ConfirmPendingOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmPendingOperation not implemented")
+}
+func (UnimplementedProjectsServiceServer) CancelPendingOperation(context.Context, *CancelPendingOperationRequest) (*CancelPendingOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelPendingOperation not implemented")
+}
+func (UnimplementedProjectsServiceServer) GetProjectTier(context.Context, *GetProjectTierRequest) (*GetProjectTierResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProjectTier not implemented")
+}
+func (UnimplementedProjectsServiceServer) CreateDeployKey(context.Context, *CreateDeployKeyRequest) (*CreateDeployKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDeployKey not implemented")
+}
+func (UnimplementedProjectsServiceServer) ListDeployKeys(context.Context, *ListDeployKeysRequest) (*ListDeployKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeployKeys not implemented")
+}
+func (UnimplementedProjectsServiceServer) DeleteDeployKey(context.Context, *DeleteDeployKeyRequest) (*DeleteDeployKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDeployKey not implemented")
+}
+func (UnimplementedProjectsServiceServer) SetProjectSecret(context.Context, *SetProjectSecretRequest) (*SetProjectSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProjectSecret not implemented")
+}
+func (UnimplementedProjectsServiceServer) ListProjectSecrets(context.Context, *ListProjectSecretsRequest) (*ListProjectSecretsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProjectSecrets not implemented")
+}
+func (UnimplementedProjectsServiceServer) DeleteProjectSecret(context.Context, *DeleteProjectSecretRequest) (*DeleteProjectSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProjectSecret not implemented")
+}
+func (UnimplementedProjectsServiceServer) GetProjectUsage(context.Context, *GetProjectUsageRequest) (*GetProjectUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProjectUsage not implemented")
+}
+func (UnimplementedProjectsServiceServer) mustEmbedUnimplementedProjectsServiceServer() {}
+func (UnimplementedProjectsServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeProjectsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProjectsServiceServer will
+// result in compilation errors.
+type UnsafeProjectsServiceServer interface {
+	mustEmbedUnimplementedProjectsServiceServer()
+}
+
+func RegisterProjectsServiceServer(s grpc.ServiceRegistrar, srv ProjectsServiceServer) {
+	// If the following call panics, it indicates UnimplementedProjectsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProjectsService_ServiceDesc, srv)
+}
+
+func _ProjectsService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_ListProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_CreateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_ListChildProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).ListChildProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_ListChildProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).ListChildProjects(ctx, req.(*ListChildProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_DeleteProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_UpdateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_PatchProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).PatchProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_PatchProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).PatchProject(ctx, req.(*PatchProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_CreateEntityReconciliationTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEntityReconciliationTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).CreateEntityReconciliationTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_CreateEntityReconciliationTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).CreateEntityReconciliationTask(ctx, req.(*CreateEntityReconciliationTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_ListPendingOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).ListPendingOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_ListPendingOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).ListPendingOperations(ctx, req.(*ListPendingOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_ConfirmPendingOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPendingOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).ConfirmPendingOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_ConfirmPendingOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).ConfirmPendingOperation(ctx, req.(*ConfirmPendingOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// UnsafeProjectsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProjectsServiceServer will
-// result in compilation errors.
-type UnsafeProjectsServiceServer interface {
-	mustEmbedUnimplementedProjectsServiceServer()
+func _ProjectsService_CancelPendingOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPendingOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).CancelPendingOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_CancelPendingOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).CancelPendingOperation(ctx, req.(*CancelPendingOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterProjectsServiceServer(s grpc.ServiceRegistrar, srv ProjectsServiceServer) {
-	// If the following call panics, it indicates UnimplementedProjectsServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ProjectsService_GetProjectTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ProjectsService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).GetProjectTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_GetProjectTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).GetProjectTier(ctx, req.(*GetProjectTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectsService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectsRequest)
+func _ProjectsService_CreateDeployKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeployKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectsServiceServer).ListProjects(ctx, in)
+		return srv.(ProjectsServiceServer).CreateDeployKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectsService_ListProjects_FullMethodName,
+		FullMethod: ProjectsService_CreateDeployKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectsServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+		return srv.(ProjectsServiceServer).CreateDeployKey(ctx, req.(*CreateDeployKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectsService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
+func _ProjectsService_ListDeployKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeployKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectsServiceServer).CreateProject(ctx, in)
+		return srv.(ProjectsServiceServer).ListDeployKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectsService_CreateProject_FullMethodName,
+		FullMethod: ProjectsService_ListDeployKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectsServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+		return srv.(ProjectsServiceServer).ListDeployKeys(ctx, req.(*ListDeployKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectsService_ListChildProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListChildProjectsRequest)
+func _ProjectsService_DeleteDeployKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeployKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectsServiceServer).ListChildProjects(ctx, in)
+		return srv.(ProjectsServiceServer).DeleteDeployKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectsService_ListChildProjects_FullMethodName,
+		FullMethod: ProjectsService_DeleteDeployKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectsServiceServer).ListChildProjects(ctx, req.(*ListChildProjectsRequest))
+		return srv.(ProjectsServiceServer).DeleteDeployKey(ctx, req.(*DeleteDeployKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectsService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProjectRequest)
+func _ProjectsService_SetProjectSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectsServiceServer).DeleteProject(ctx, in)
+		return srv.(ProjectsServiceServer).SetProjectSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectsService_DeleteProject_FullMethodName,
+		FullMethod: ProjectsService_SetProjectSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectsServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+		return srv.(ProjectsServiceServer).SetProjectSecret(ctx, req.(*SetProjectSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectsService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProjectRequest)
+func _ProjectsService_ListProjectSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectSecretsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectsServiceServer).UpdateProject(ctx, in)
+		return srv.(ProjectsServiceServer).ListProjectSecrets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectsService_UpdateProject_FullMethodName,
+		FullMethod: ProjectsService_ListProjectSecrets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectsServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+		return srv.(ProjectsServiceServer).ListProjectSecrets(ctx, req.(*ListProjectSecretsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectsService_PatchProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PatchProjectRequest)
+func _ProjectsService_DeleteProjectSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectsServiceServer).PatchProject(ctx, in)
+		return srv.(ProjectsServiceServer).DeleteProjectSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectsService_PatchProject_FullMethodName,
+		FullMethod: ProjectsService_DeleteProjectSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectsServiceServer).PatchProject(ctx, req.(*PatchProjectRequest))
+		return srv.(ProjectsServiceServer).DeleteProjectSecret(ctx, req.(*DeleteProjectSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectsService_CreateEntityReconciliationTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateEntityReconciliationTaskRequest)
+func _ProjectsService_GetProjectUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectsServiceServer).CreateEntityReconciliationTask(ctx, in)
+		return srv.(ProjectsServiceServer).GetProjectUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectsService_CreateEntityReconciliationTask_FullMethodName,
+		FullMethod: ProjectsService_GetProjectUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectsServiceServer).CreateEntityReconciliationTask(ctx, req.(*CreateEntityReconciliationTaskRequest))
+		return srv.(ProjectsServiceServer).GetProjectUsage(ctx, req.(*GetProjectUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3013,6 +4221,50 @@ var ProjectsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateEntityReconciliationTask",
 			Handler:    _ProjectsService_CreateEntityReconciliationTask_Handler,
 		},
+		{
+			MethodName: "ListPendingOperations",
+			Handler:    _ProjectsService_ListPendingOperations_Handler,
+		},
+		{
+			MethodName: "ConfirmPendingOperation",
+			Handler:    _ProjectsService_ConfirmPendingOperation_Handler,
+		},
+		{
+			MethodName: "CancelPendingOperation",
+			Handler:    _ProjectsService_CancelPendingOperation_Handler,
+		},
+		{
+			MethodName: "GetProjectTier",
+			Handler:    _ProjectsService_GetProjectTier_Handler,
+		},
+		{
+			MethodName: "CreateDeployKey",
+			Handler:    _ProjectsService_CreateDeployKey_Handler,
+		},
+		{
+			MethodName: "ListDeployKeys",
+			Handler:    _ProjectsService_ListDeployKeys_Handler,
+		},
+		{
+			MethodName: "DeleteDeployKey",
+			Handler:    _ProjectsService_DeleteDeployKey_Handler,
+		},
+		{
+			MethodName: "SetProjectSecret",
+			Handler:    _ProjectsService_SetProjectSecret_Handler,
+		},
+		{
+			MethodName: "ListProjectSecrets",
+			Handler:    _ProjectsService_ListProjectSecrets_Handler,
+		},
+		{
+			MethodName: "DeleteProjectSecret",
+			Handler:    _ProjectsService_DeleteProjectSecret_Handler,
+		},
+		{
+			MethodName: "GetProjectUsage",
+			Handler:    _ProjectsService_GetProjectUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -3021,6 +4273,11 @@ var ProjectsService_ServiceDesc = grpc.ServiceDesc{
 const (
 	ProvidersService_PatchProvider_FullMethodName               = "/minder.v1.ProvidersService/PatchProvider"
 	ProvidersService_GetProvider_FullMethodName                 = "/minder.v1.ProvidersService/GetProvider"
+	ProvidersService_GetProviderStatus_FullMethodName           = "/minder.v1.ProvidersService/GetProviderStatus"
+	ProvidersService_ShareProvider_FullMethodName               = "/minder.v1.ProvidersService/ShareProvider"
+	ProvidersService_UnshareProvider_FullMethodName             = "/minder.v1.ProvidersService/UnshareProvider"
+	ProvidersService_ListProviderShares_FullMethodName          = "/minder.v1.ProvidersService/ListProviderShares"
+	ProvidersService_GetProviderUsage_FullMethodName            = "/minder.v1.ProvidersService/GetProviderUsage"
 	ProvidersService_ListProviders_FullMethodName               = "/minder.v1.ProvidersService/ListProviders"
 	ProvidersService_CreateProvider_FullMethodName              = "/minder.v1.ProvidersService/CreateProvider"
 	ProvidersService_DeleteProvider_FullMethodName              = "/minder.v1.ProvidersService/DeleteProvider"
@@ -3035,6 +4292,20 @@ const (
 type ProvidersServiceClient interface {
 	PatchProvider(ctx context.Context, in *PatchProviderRequest, opts ...grpc.CallOption) (*PatchProviderResponse, error)
 	GetProvider(ctx context.Context, in *GetProviderRequest, opts ...grpc.CallOption) (*GetProviderResponse, error)
+	// GetProviderStatus checks the health of the credentials of a provider
+	// and returns the result along with the previously recorded health.
+	GetProviderStatus(ctx context.Context, in *GetProviderStatusRequest, opts ...grpc.CallOption) (*GetProviderStatusResponse, error)
+	// ShareProvider shares a provider with a child project. Once a provider
+	// is shared, it can only be used by the projects it is shared with,
+	// besides its own project.
+	ShareProvider(ctx context.Context, in *ShareProviderRequest, opts ...grpc.CallOption) (*ShareProviderResponse, error)
+	// UnshareProvider stops sharing a provider with a child project.
+	UnshareProvider(ctx context.Context, in *UnshareProviderRequest, opts ...grpc.CallOption) (*UnshareProviderResponse, error)
+	// ListProviderShares lists the child projects a provider is shared with.
+	ListProviderShares(ctx context.Context, in *ListProviderSharesRequest, opts ...grpc.CallOption) (*ListProviderSharesResponse, error)
+	// GetProviderUsage returns the API calls, webhooks and evaluations of the
+	// providers of a project over time.
+	GetProviderUsage(ctx context.Context, in *GetProviderUsageRequest, opts ...grpc.CallOption) (*GetProviderUsageResponse, error)
 	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
 	CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*CreateProviderResponse, error)
 	DeleteProvider(ctx context.Context, in *DeleteProviderRequest, opts ...grpc.CallOption) (*DeleteProviderResponse, error)
@@ -3071,6 +4342,56 @@ func (c *providersServiceClient) GetProvider(ctx context.Context, in *GetProvide
 	return out, nil
 }
 
+func (c *providersServiceClient) GetProviderStatus(ctx context.Context, in *GetProviderStatusRequest, opts ...grpc.CallOption) (*GetProviderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProviderStatusResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_GetProviderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ShareProvider(ctx context.Context, in *ShareProviderRequest, opts ...grpc.CallOption) (*ShareProviderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareProviderResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ShareProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) UnshareProvider(ctx context.Context, in *UnshareProviderRequest, opts ...grpc.CallOption) (*UnshareProviderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnshareProviderResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_UnshareProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ListProviderShares(ctx context.Context, in *ListProviderSharesRequest, opts ...grpc.CallOption) (*ListProviderSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProviderSharesResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ListProviderShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) GetProviderUsage(ctx context.Context, in *GetProviderUsageRequest, opts ...grpc.CallOption) (*GetProviderUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProviderUsageResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_GetProviderUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providersServiceClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProvidersResponse)
@@ -3137,6 +4458,20 @@ func (c *providersServiceClient) ReconcileEntityRegistration(ctx context.Context
 type ProvidersServiceServer interface {
 	PatchProvider(context.Context, *PatchProviderRequest) (*PatchProviderResponse, error)
 	GetProvider(context.Context, *GetProviderRequest) (*GetProviderResponse, error)
+	// GetProviderStatus checks the health of the credentials of a provider
+	// and returns the result along with the previously recorded health.
+	GetProviderStatus(context.Context, *GetProviderStatusRequest) (*GetProviderStatusResponse, error)
+	// ShareProvider shares a provider with a child project. Once a provider
+	// is shared, it can only be used by the projects it is shared with,
+	// besides its own project.
+	ShareProvider(context.Context, *ShareProviderRequest) (*ShareProviderResponse, error)
+	// UnshareProvider stops sharing a provider with a child project.
+	UnshareProvider(context.Context, *UnshareProviderRequest) (*UnshareProviderResponse, error)
+	// ListProviderShares lists the child projects a provider is shared with.
+	ListProviderShares(context.Context, *ListProviderSharesRequest) (*ListProviderSharesResponse, error)
+	// GetProviderUsage returns the API calls, webhooks and evaluations of the
+	// providers of a project over time.
+	GetProviderUsage(context.Context, *GetProviderUsageRequest) (*GetProviderUsageResponse, error)
 	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
 	CreateProvider(context.Context, *CreateProviderRequest) (*CreateProviderResponse, error)
 	DeleteProvider(context.Context, *DeleteProviderRequest) (*DeleteProviderResponse, error)
@@ -3159,6 +4494,21 @@ func (UnimplementedProvidersServiceServer) PatchProvider(context.Context, *Patch
 func (UnimplementedProvidersServiceServer) GetProvider(context.Context, *GetProviderRequest) (*GetProviderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProvider not implemented")
 }
+func (UnimplementedProvidersServiceServer) GetProviderStatus(context.Context, *GetProviderStatusRequest) (*GetProviderStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProviderStatus not implemented")
+}
+func (UnimplementedProvidersServiceServer) ShareProvider(context.Context, *ShareProviderRequest) (*ShareProviderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareProvider not implemented")
+}
+func (UnimplementedProvidersServiceServer) UnshareProvider(context.Context, *UnshareProviderRequest) (*UnshareProviderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnshareProvider not implemented")
+}
+func (UnimplementedProvidersServiceServer) ListProviderShares(context.Context, *ListProviderSharesRequest) (*ListProviderSharesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProviderShares not implemented")
+}
+func (UnimplementedProvidersServiceServer) GetProviderUsage(context.Context, *GetProviderUsageRequest) (*GetProviderUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProviderUsage not implemented")
+}
 func (UnimplementedProvidersServiceServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListProviders not implemented")
 }
@@ -3234,6 +4584,96 @@ func _ProvidersService_GetProvider_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProvidersService_GetProviderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).GetProviderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_GetProviderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).GetProviderStatus(ctx, req.(*GetProviderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ShareProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ShareProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ShareProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ShareProvider(ctx, req.(*ShareProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_UnshareProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).UnshareProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_UnshareProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).UnshareProvider(ctx, req.(*UnshareProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ListProviderShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProviderSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ListProviderShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ListProviderShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ListProviderShares(ctx, req.(*ListProviderSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_GetProviderUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).GetProviderUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_GetProviderUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).GetProviderUsage(ctx, req.(*GetProviderUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProvidersService_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProvidersRequest)
 	if err := dec(in); err != nil {
@@ -3357,6 +4797,26 @@ var ProvidersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProvider",
 			Handler:    _ProvidersService_GetProvider_Handler,
 		},
+		{
+			MethodName: "GetProviderStatus",
+			Handler:    _ProvidersService_GetProviderStatus_Handler,
+		},
+		{
+			MethodName: "ShareProvider",
+			Handler:    _ProvidersService_ShareProvider_Handler,
+		},
+		{
+			MethodName: "UnshareProvider",
+			Handler:    _ProvidersService_UnshareProvider_Handler,
+		},
+		{
+			MethodName: "ListProviderShares",
+			Handler:    _ProvidersService_ListProviderShares_Handler,
+		},
+		{
+			MethodName: "GetProviderUsage",
+			Handler:    _ProvidersService_GetProviderUsage_Handler,
+		},
 		{
 			MethodName: "ListProviders",
 			Handler:    _ProvidersService_ListProviders_Handler,
@@ -3489,11 +4949,13 @@ var InviteService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	EntityInstanceService_ListEntities_FullMethodName     = "/minder.v1.EntityInstanceService/ListEntities"
-	EntityInstanceService_GetEntityById_FullMethodName    = "/minder.v1.EntityInstanceService/GetEntityById"
-	EntityInstanceService_GetEntityByName_FullMethodName  = "/minder.v1.EntityInstanceService/GetEntityByName"
-	EntityInstanceService_DeleteEntityById_FullMethodName = "/minder.v1.EntityInstanceService/DeleteEntityById"
-	EntityInstanceService_RegisterEntity_FullMethodName   = "/minder.v1.EntityInstanceService/RegisterEntity"
+	EntityInstanceService_ListEntities_FullMethodName       = "/minder.v1.EntityInstanceService/ListEntities"
+	EntityInstanceService_GetEntityById_FullMethodName      = "/minder.v1.EntityInstanceService/GetEntityById"
+	EntityInstanceService_GetEntityByName_FullMethodName    = "/minder.v1.EntityInstanceService/GetEntityByName"
+	EntityInstanceService_DeleteEntityById_FullMethodName   = "/minder.v1.EntityInstanceService/DeleteEntityById"
+	EntityInstanceService_RegisterEntity_FullMethodName     = "/minder.v1.EntityInstanceService/RegisterEntity"
+	EntityInstanceService_SetEntityOwner_FullMethodName     = "/minder.v1.EntityInstanceService/SetEntityOwner"
+	EntityInstanceService_ListEntityTimeline_FullMethodName = "/minder.v1.EntityInstanceService/ListEntityTimeline"
 )
 
 // EntityInstanceServiceClient is the client API for EntityInstanceService service.
@@ -3512,6 +4974,12 @@ type EntityInstanceServiceClient interface {
 	DeleteEntityById(ctx context.Context, in *DeleteEntityByIdRequest, opts ...grpc.CallOption) (*DeleteEntityByIdResponse, error)
 	// RegisterEntity creates a new entity instance
 	RegisterEntity(ctx context.Context, in *RegisterEntityRequest, opts ...grpc.CallOption) (*RegisterEntityResponse, error)
+	// SetEntityOwner sets the team owning an entity, which alerts raised on
+	// the entity are routed to. Empty fields are cleared.
+	SetEntityOwner(ctx context.Context, in *SetEntityOwnerRequest, opts ...grpc.CallOption) (*SetEntityOwnerResponse, error)
+	// ListEntityTimeline returns the events of an entity, newest first:
+	// its registration, property refreshes, evaluations, remediations and alerts
+	ListEntityTimeline(ctx context.Context, in *ListEntityTimelineRequest, opts ...grpc.CallOption) (*ListEntityTimelineResponse, error)
 }
 
 type entityInstanceServiceClient struct {
@@ -3572,6 +5040,26 @@ func (c *entityInstanceServiceClient) RegisterEntity(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *entityInstanceServiceClient) SetEntityOwner(ctx context.Context, in *SetEntityOwnerRequest, opts ...grpc.CallOption) (*SetEntityOwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEntityOwnerResponse)
+	err := c.cc.Invoke(ctx, EntityInstanceService_SetEntityOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityInstanceServiceClient) ListEntityTimeline(ctx context.Context, in *ListEntityTimelineRequest, opts ...grpc.CallOption) (*ListEntityTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEntityTimelineResponse)
+	err := c.cc.Invoke(ctx, EntityInstanceService_ListEntityTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EntityInstanceServiceServer is the server API for EntityInstanceService service.
 // All implementations must embed UnimplementedEntityInstanceServiceServer
 // for forward compatibility.
@@ -3588,6 +5076,12 @@ type EntityInstanceServiceServer interface {
 	DeleteEntityById(context.Context, *DeleteEntityByIdRequest) (*DeleteEntityByIdResponse, error)
 	// RegisterEntity creates a new entity instance
 	RegisterEntity(context.Context, *RegisterEntityRequest) (*RegisterEntityResponse, error)
+	// SetEntityOwner sets the team owning an entity, which alerts raised on
+	// the entity are routed to. Empty fields are cleared.
+	SetEntityOwner(context.Context, *SetEntityOwnerRequest) (*SetEntityOwnerResponse, error)
+	// ListEntityTimeline returns the events of an entity, newest first:
+	// its registration, property refreshes, evaluations, remediations and alerts
+	ListEntityTimeline(context.Context, *ListEntityTimelineRequest) (*ListEntityTimelineResponse, error)
 	mustEmbedUnimplementedEntityInstanceServiceServer()
 }
 
@@ -3613,6 +5107,12 @@ func (UnimplementedEntityInstanceServiceServer) DeleteEntityById(context.Context
 func (UnimplementedEntityInstanceServiceServer) RegisterEntity(context.Context, *RegisterEntityRequest) (*RegisterEntityResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RegisterEntity not implemented")
 }
+func (UnimplementedEntityInstanceServiceServer) SetEntityOwner(context.Context, *SetEntityOwnerRequest) (*SetEntityOwnerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEntityOwner not implemented")
+}
+func (UnimplementedEntityInstanceServiceServer) ListEntityTimeline(context.Context, *ListEntityTimelineRequest) (*ListEntityTimelineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEntityTimeline not implemented")
+}
 func (UnimplementedEntityInstanceServiceServer) mustEmbedUnimplementedEntityInstanceServiceServer() {}
 func (UnimplementedEntityInstanceServiceServer) testEmbeddedByValue()                               {}
 
@@ -3724,6 +5224,42 @@ func _EntityInstanceService_RegisterEntity_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EntityInstanceService_SetEntityOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEntityOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityInstanceServiceServer).SetEntityOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityInstanceService_SetEntityOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityInstanceServiceServer).SetEntityOwner(ctx, req.(*SetEntityOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityInstanceService_ListEntityTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntityTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityInstanceServiceServer).ListEntityTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityInstanceService_ListEntityTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityInstanceServiceServer).ListEntityTimeline(ctx, req.(*ListEntityTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EntityInstanceService_ServiceDesc is the grpc.ServiceDesc for EntityInstanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3751,6 +5287,14 @@ var EntityInstanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterEntity",
 			Handler:    _EntityInstanceService_RegisterEntity_Handler,
 		},
+		{
+			MethodName: "SetEntityOwner",
+			Handler:    _EntityInstanceService_SetEntityOwner_Handler,
+		},
+		{
+			MethodName: "ListEntityTimeline",
+			Handler:    _EntityInstanceService_ListEntityTimeline_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -102,6 +102,26 @@ func (mr *MockArtifactServiceClientMockRecorder) ListArtifacts(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArtifacts", reflect.TypeOf((*MockArtifactServiceClient)(nil).ListArtifacts), varargs...)
 }
 
+// ListArtifactsByRepository mocks base method.
+func (m *MockArtifactServiceClient) ListArtifactsByRepository(ctx context.Context, in *v1.ListArtifactsByRepositoryRequest, opts ...grpc.CallOption) (*v1.ListArtifactsByRepositoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListArtifactsByRepository", varargs...)
+	ret0, _ := ret[0].(*v1.ListArtifactsByRepositoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArtifactsByRepository indicates an expected call of ListArtifactsByRepository.
+func (mr *MockArtifactServiceClientMockRecorder) ListArtifactsByRepository(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArtifactsByRepository", reflect.TypeOf((*MockArtifactServiceClient)(nil).ListArtifactsByRepository), varargs...)
+}
+
 // MockDataSourceServiceClient is a mock of DataSourceServiceClient interface.
 type MockDataSourceServiceClient struct {
 	ctrl     *gomock.Controller
@@ -370,6 +390,26 @@ func (mr *MockEntityInstanceServiceClientMockRecorder) ListEntities(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntities", reflect.TypeOf((*MockEntityInstanceServiceClient)(nil).ListEntities), varargs...)
 }
 
+// ListEntityTimeline mocks base method.
+func (m *MockEntityInstanceServiceClient) ListEntityTimeline(ctx context.Context, in *v1.ListEntityTimelineRequest, opts ...grpc.CallOption) (*v1.ListEntityTimelineResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEntityTimeline", varargs...)
+	ret0, _ := ret[0].(*v1.ListEntityTimelineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntityTimeline indicates an expected call of ListEntityTimeline.
+func (mr *MockEntityInstanceServiceClientMockRecorder) ListEntityTimeline(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntityTimeline", reflect.TypeOf((*MockEntityInstanceServiceClient)(nil).ListEntityTimeline), varargs...)
+}
+
 // RegisterEntity mocks base method.
 func (m *MockEntityInstanceServiceClient) RegisterEntity(ctx context.Context, in *v1.RegisterEntityRequest, opts ...grpc.CallOption) (*v1.RegisterEntityResponse, error) {
 	m.ctrl.T.Helper()
@@ -390,6 +430,26 @@ func (mr *MockEntityInstanceServiceClientMockRecorder) RegisterEntity(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterEntity", reflect.TypeOf((*MockEntityInstanceServiceClient)(nil).RegisterEntity), varargs...)
 }
 
+// SetEntityOwner mocks base method.
+func (m *MockEntityInstanceServiceClient) SetEntityOwner(ctx context.Context, in *v1.SetEntityOwnerRequest, opts ...grpc.CallOption) (*v1.SetEntityOwnerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetEntityOwner", varargs...)
+	ret0, _ := ret[0].(*v1.SetEntityOwnerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEntityOwner indicates an expected call of SetEntityOwner.
+func (mr *MockEntityInstanceServiceClientMockRecorder) SetEntityOwner(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEntityOwner", reflect.TypeOf((*MockEntityInstanceServiceClient)(nil).SetEntityOwner), varargs...)
+}
+
 // MockProfileServiceClient is a mock of ProfileServiceClient interface.
 type MockProfileServiceClient struct {
 	ctrl     *gomock.Controller
@@ -434,6 +494,46 @@ func (mr *MockProfileServiceClientMockRecorder) CreateProfile(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProfile", reflect.TypeOf((*MockProfileServiceClient)(nil).CreateProfile), varargs...)
 }
 
+// CreateProfileStatusShareLink mocks base method.
+func (m *MockProfileServiceClient) CreateProfileStatusShareLink(ctx context.Context, in *v1.CreateProfileStatusShareLinkRequest, opts ...grpc.CallOption) (*v1.CreateProfileStatusShareLinkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateProfileStatusShareLink", varargs...)
+	ret0, _ := ret[0].(*v1.CreateProfileStatusShareLinkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProfileStatusShareLink indicates an expected call of CreateProfileStatusShareLink.
+func (mr *MockProfileServiceClientMockRecorder) CreateProfileStatusShareLink(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProfileStatusShareLink", reflect.TypeOf((*MockProfileServiceClient)(nil).CreateProfileStatusShareLink), varargs...)
+}
+
+// CreateRuleException mocks base method.
+func (m *MockProfileServiceClient) CreateRuleException(ctx context.Context, in *v1.CreateRuleExceptionRequest, opts ...grpc.CallOption) (*v1.CreateRuleExceptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRuleException", varargs...)
+	ret0, _ := ret[0].(*v1.CreateRuleExceptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRuleException indicates an expected call of CreateRuleException.
+func (mr *MockProfileServiceClientMockRecorder) CreateRuleException(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRuleException", reflect.TypeOf((*MockProfileServiceClient)(nil).CreateRuleException), varargs...)
+}
+
 // DeleteProfile mocks base method.
 func (m *MockProfileServiceClient) DeleteProfile(ctx context.Context, in *v1.DeleteProfileRequest, opts ...grpc.CallOption) (*v1.DeleteProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -454,6 +554,46 @@ func (mr *MockProfileServiceClientMockRecorder) DeleteProfile(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProfile", reflect.TypeOf((*MockProfileServiceClient)(nil).DeleteProfile), varargs...)
 }
 
+// DisableProfileRule mocks base method.
+func (m *MockProfileServiceClient) DisableProfileRule(ctx context.Context, in *v1.DisableProfileRuleRequest, opts ...grpc.CallOption) (*v1.DisableProfileRuleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableProfileRule", varargs...)
+	ret0, _ := ret[0].(*v1.DisableProfileRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableProfileRule indicates an expected call of DisableProfileRule.
+func (mr *MockProfileServiceClientMockRecorder) DisableProfileRule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableProfileRule", reflect.TypeOf((*MockProfileServiceClient)(nil).DisableProfileRule), varargs...)
+}
+
+// EnableProfileRule mocks base method.
+func (m *MockProfileServiceClient) EnableProfileRule(ctx context.Context, in *v1.EnableProfileRuleRequest, opts ...grpc.CallOption) (*v1.EnableProfileRuleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableProfileRule", varargs...)
+	ret0, _ := ret[0].(*v1.EnableProfileRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableProfileRule indicates an expected call of EnableProfileRule.
+func (mr *MockProfileServiceClientMockRecorder) EnableProfileRule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableProfileRule", reflect.TypeOf((*MockProfileServiceClient)(nil).EnableProfileRule), varargs...)
+}
+
 // GetProfileById mocks base method.
 func (m *MockProfileServiceClient) GetProfileById(ctx context.Context, in *v1.GetProfileByIdRequest, opts ...grpc.CallOption) (*v1.GetProfileByIdResponse, error) {
 	m.ctrl.T.Helper()
@@ -554,6 +694,66 @@ func (mr *MockProfileServiceClientMockRecorder) GetProfileStatusByProject(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileStatusByProject", reflect.TypeOf((*MockProfileServiceClient)(nil).GetProfileStatusByProject), varargs...)
 }
 
+// GetSharedProfileStatus mocks base method.
+func (m *MockProfileServiceClient) GetSharedProfileStatus(ctx context.Context, in *v1.GetSharedProfileStatusRequest, opts ...grpc.CallOption) (*v1.GetSharedProfileStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSharedProfileStatus", varargs...)
+	ret0, _ := ret[0].(*v1.GetSharedProfileStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedProfileStatus indicates an expected call of GetSharedProfileStatus.
+func (mr *MockProfileServiceClientMockRecorder) GetSharedProfileStatus(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedProfileStatus", reflect.TypeOf((*MockProfileServiceClient)(nil).GetSharedProfileStatus), varargs...)
+}
+
+// ListDisabledProfileRules mocks base method.
+func (m *MockProfileServiceClient) ListDisabledProfileRules(ctx context.Context, in *v1.ListDisabledProfileRulesRequest, opts ...grpc.CallOption) (*v1.ListDisabledProfileRulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDisabledProfileRules", varargs...)
+	ret0, _ := ret[0].(*v1.ListDisabledProfileRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisabledProfileRules indicates an expected call of ListDisabledProfileRules.
+func (mr *MockProfileServiceClientMockRecorder) ListDisabledProfileRules(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisabledProfileRules", reflect.TypeOf((*MockProfileServiceClient)(nil).ListDisabledProfileRules), varargs...)
+}
+
+// ListProfileVersions mocks base method.
+func (m *MockProfileServiceClient) ListProfileVersions(ctx context.Context, in *v1.ListProfileVersionsRequest, opts ...grpc.CallOption) (*v1.ListProfileVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProfileVersions", varargs...)
+	ret0, _ := ret[0].(*v1.ListProfileVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProfileVersions indicates an expected call of ListProfileVersions.
+func (mr *MockProfileServiceClientMockRecorder) ListProfileVersions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfileVersions", reflect.TypeOf((*MockProfileServiceClient)(nil).ListProfileVersions), varargs...)
+}
+
 // ListProfiles mocks base method.
 func (m *MockProfileServiceClient) ListProfiles(ctx context.Context, in *v1.ListProfilesRequest, opts ...grpc.CallOption) (*v1.ListProfilesResponse, error) {
 	m.ctrl.T.Helper()
@@ -574,6 +774,26 @@ func (mr *MockProfileServiceClientMockRecorder) ListProfiles(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfiles", reflect.TypeOf((*MockProfileServiceClient)(nil).ListProfiles), varargs...)
 }
 
+// ListRuleExceptions mocks base method.
+func (m *MockProfileServiceClient) ListRuleExceptions(ctx context.Context, in *v1.ListRuleExceptionsRequest, opts ...grpc.CallOption) (*v1.ListRuleExceptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRuleExceptions", varargs...)
+	ret0, _ := ret[0].(*v1.ListRuleExceptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuleExceptions indicates an expected call of ListRuleExceptions.
+func (mr *MockProfileServiceClientMockRecorder) ListRuleExceptions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleExceptions", reflect.TypeOf((*MockProfileServiceClient)(nil).ListRuleExceptions), varargs...)
+}
+
 // PatchProfile mocks base method.
 func (m *MockProfileServiceClient) PatchProfile(ctx context.Context, in *v1.PatchProfileRequest, opts ...grpc.CallOption) (*v1.PatchProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -594,6 +814,66 @@ func (mr *MockProfileServiceClientMockRecorder) PatchProfile(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchProfile", reflect.TypeOf((*MockProfileServiceClient)(nil).PatchProfile), varargs...)
 }
 
+// ReviewRuleException mocks base method.
+func (m *MockProfileServiceClient) ReviewRuleException(ctx context.Context, in *v1.ReviewRuleExceptionRequest, opts ...grpc.CallOption) (*v1.ReviewRuleExceptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReviewRuleException", varargs...)
+	ret0, _ := ret[0].(*v1.ReviewRuleExceptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewRuleException indicates an expected call of ReviewRuleException.
+func (mr *MockProfileServiceClientMockRecorder) ReviewRuleException(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewRuleException", reflect.TypeOf((*MockProfileServiceClient)(nil).ReviewRuleException), varargs...)
+}
+
+// RevokeProfileStatusShareLink mocks base method.
+func (m *MockProfileServiceClient) RevokeProfileStatusShareLink(ctx context.Context, in *v1.RevokeProfileStatusShareLinkRequest, opts ...grpc.CallOption) (*v1.RevokeProfileStatusShareLinkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeProfileStatusShareLink", varargs...)
+	ret0, _ := ret[0].(*v1.RevokeProfileStatusShareLinkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeProfileStatusShareLink indicates an expected call of RevokeProfileStatusShareLink.
+func (mr *MockProfileServiceClientMockRecorder) RevokeProfileStatusShareLink(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeProfileStatusShareLink", reflect.TypeOf((*MockProfileServiceClient)(nil).RevokeProfileStatusShareLink), varargs...)
+}
+
+// RollbackProfile mocks base method.
+func (m *MockProfileServiceClient) RollbackProfile(ctx context.Context, in *v1.RollbackProfileRequest, opts ...grpc.CallOption) (*v1.RollbackProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackProfile", varargs...)
+	ret0, _ := ret[0].(*v1.RollbackProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackProfile indicates an expected call of RollbackProfile.
+func (mr *MockProfileServiceClientMockRecorder) RollbackProfile(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackProfile", reflect.TypeOf((*MockProfileServiceClient)(nil).RollbackProfile), varargs...)
+}
+
 // UpdateProfile mocks base method.
 func (m *MockProfileServiceClient) UpdateProfile(ctx context.Context, in *v1.UpdateProfileRequest, opts ...grpc.CallOption) (*v1.UpdateProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -638,6 +918,66 @@ func (m *MockProjectsServiceClient) EXPECT() *MockProjectsServiceClientMockRecor
 	return m.recorder
 }
 
+// CancelPendingOperation mocks base method.
+func (m *MockProjectsServiceClient) CancelPendingOperation(ctx context.Context, in *v1.CancelPendingOperationRequest, opts ...grpc.CallOption) (*v1.CancelPendingOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelPendingOperation", varargs...)
+	ret0, _ := ret[0].(*v1.CancelPendingOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPendingOperation indicates an expected call of CancelPendingOperation.
+func (mr *MockProjectsServiceClientMockRecorder) CancelPendingOperation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPendingOperation", reflect.TypeOf((*MockProjectsServiceClient)(nil).CancelPendingOperation), varargs...)
+}
+
+// ConfirmPendingOperation mocks base method.
+func (m *MockProjectsServiceClient) ConfirmPendingOperation(ctx context.Context, in *v1.ConfirmPendingOperationRequest, opts ...grpc.CallOption) (*v1.ConfirmPendingOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfirmPendingOperation", varargs...)
+	ret0, _ := ret[0].(*v1.ConfirmPendingOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmPendingOperation indicates an expected call of ConfirmPendingOperation.
+func (mr *MockProjectsServiceClientMockRecorder) ConfirmPendingOperation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPendingOperation", reflect.TypeOf((*MockProjectsServiceClient)(nil).ConfirmPendingOperation), varargs...)
+}
+
+// CreateDeployKey mocks base method.
+func (m *MockProjectsServiceClient) CreateDeployKey(ctx context.Context, in *v1.CreateDeployKeyRequest, opts ...grpc.CallOption) (*v1.CreateDeployKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDeployKey", varargs...)
+	ret0, _ := ret[0].(*v1.CreateDeployKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeployKey indicates an expected call of CreateDeployKey.
+func (mr *MockProjectsServiceClientMockRecorder) CreateDeployKey(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeployKey", reflect.TypeOf((*MockProjectsServiceClient)(nil).CreateDeployKey), varargs...)
+}
+
 // CreateEntityReconciliationTask mocks base method.
 func (m *MockProjectsServiceClient) CreateEntityReconciliationTask(ctx context.Context, in *v1.CreateEntityReconciliationTaskRequest, opts ...grpc.CallOption) (*v1.CreateEntityReconciliationTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -678,6 +1018,26 @@ func (mr *MockProjectsServiceClientMockRecorder) CreateProject(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectsServiceClient)(nil).CreateProject), varargs...)
 }
 
+// DeleteDeployKey mocks base method.
+func (m *MockProjectsServiceClient) DeleteDeployKey(ctx context.Context, in *v1.DeleteDeployKeyRequest, opts ...grpc.CallOption) (*v1.DeleteDeployKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDeployKey", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteDeployKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDeployKey indicates an expected call of DeleteDeployKey.
+func (mr *MockProjectsServiceClientMockRecorder) DeleteDeployKey(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeployKey", reflect.TypeOf((*MockProjectsServiceClient)(nil).DeleteDeployKey), varargs...)
+}
+
 // DeleteProject mocks base method.
 func (m *MockProjectsServiceClient) DeleteProject(ctx context.Context, in *v1.DeleteProjectRequest, opts ...grpc.CallOption) (*v1.DeleteProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -698,6 +1058,66 @@ func (mr *MockProjectsServiceClientMockRecorder) DeleteProject(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectsServiceClient)(nil).DeleteProject), varargs...)
 }
 
+// DeleteProjectSecret mocks base method.
+func (m *MockProjectsServiceClient) DeleteProjectSecret(ctx context.Context, in *v1.DeleteProjectSecretRequest, opts ...grpc.CallOption) (*v1.DeleteProjectSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteProjectSecret", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteProjectSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectSecret indicates an expected call of DeleteProjectSecret.
+func (mr *MockProjectsServiceClientMockRecorder) DeleteProjectSecret(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectSecret", reflect.TypeOf((*MockProjectsServiceClient)(nil).DeleteProjectSecret), varargs...)
+}
+
+// GetProjectTier mocks base method.
+func (m *MockProjectsServiceClient) GetProjectTier(ctx context.Context, in *v1.GetProjectTierRequest, opts ...grpc.CallOption) (*v1.GetProjectTierResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectTier", varargs...)
+	ret0, _ := ret[0].(*v1.GetProjectTierResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectTier indicates an expected call of GetProjectTier.
+func (mr *MockProjectsServiceClientMockRecorder) GetProjectTier(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectTier", reflect.TypeOf((*MockProjectsServiceClient)(nil).GetProjectTier), varargs...)
+}
+
+// GetProjectUsage mocks base method.
+func (m *MockProjectsServiceClient) GetProjectUsage(ctx context.Context, in *v1.GetProjectUsageRequest, opts ...grpc.CallOption) (*v1.GetProjectUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectUsage", varargs...)
+	ret0, _ := ret[0].(*v1.GetProjectUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectUsage indicates an expected call of GetProjectUsage.
+func (mr *MockProjectsServiceClientMockRecorder) GetProjectUsage(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectUsage", reflect.TypeOf((*MockProjectsServiceClient)(nil).GetProjectUsage), varargs...)
+}
+
 // ListChildProjects mocks base method.
 func (m *MockProjectsServiceClient) ListChildProjects(ctx context.Context, in *v1.ListChildProjectsRequest, opts ...grpc.CallOption) (*v1.ListChildProjectsResponse, error) {
 	m.ctrl.T.Helper()
@@ -718,6 +1138,66 @@ func (mr *MockProjectsServiceClientMockRecorder) ListChildProjects(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChildProjects", reflect.TypeOf((*MockProjectsServiceClient)(nil).ListChildProjects), varargs...)
 }
 
+// ListDeployKeys mocks base method.
+func (m *MockProjectsServiceClient) ListDeployKeys(ctx context.Context, in *v1.ListDeployKeysRequest, opts ...grpc.CallOption) (*v1.ListDeployKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeployKeys", varargs...)
+	ret0, _ := ret[0].(*v1.ListDeployKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployKeys indicates an expected call of ListDeployKeys.
+func (mr *MockProjectsServiceClientMockRecorder) ListDeployKeys(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployKeys", reflect.TypeOf((*MockProjectsServiceClient)(nil).ListDeployKeys), varargs...)
+}
+
+// ListPendingOperations mocks base method.
+func (m *MockProjectsServiceClient) ListPendingOperations(ctx context.Context, in *v1.ListPendingOperationsRequest, opts ...grpc.CallOption) (*v1.ListPendingOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPendingOperations", varargs...)
+	ret0, _ := ret[0].(*v1.ListPendingOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingOperations indicates an expected call of ListPendingOperations.
+func (mr *MockProjectsServiceClientMockRecorder) ListPendingOperations(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingOperations", reflect.TypeOf((*MockProjectsServiceClient)(nil).ListPendingOperations), varargs...)
+}
+
+// ListProjectSecrets mocks base method.
+func (m *MockProjectsServiceClient) ListProjectSecrets(ctx context.Context, in *v1.ListProjectSecretsRequest, opts ...grpc.CallOption) (*v1.ListProjectSecretsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectSecrets", varargs...)
+	ret0, _ := ret[0].(*v1.ListProjectSecretsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectSecrets indicates an expected call of ListProjectSecrets.
+func (mr *MockProjectsServiceClientMockRecorder) ListProjectSecrets(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectSecrets", reflect.TypeOf((*MockProjectsServiceClient)(nil).ListProjectSecrets), varargs...)
+}
+
 // ListProjects mocks base method.
 func (m *MockProjectsServiceClient) ListProjects(ctx context.Context, in *v1.ListProjectsRequest, opts ...grpc.CallOption) (*v1.ListProjectsResponse, error) {
 	m.ctrl.T.Helper()
@@ -758,6 +1238,26 @@ func (mr *MockProjectsServiceClientMockRecorder) PatchProject(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchProject", reflect.TypeOf((*MockProjectsServiceClient)(nil).PatchProject), varargs...)
 }
 
+// SetProjectSecret mocks base method.
+func (m *MockProjectsServiceClient) SetProjectSecret(ctx context.Context, in *v1.SetProjectSecretRequest, opts ...grpc.CallOption) (*v1.SetProjectSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetProjectSecret", varargs...)
+	ret0, _ := ret[0].(*v1.SetProjectSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProjectSecret indicates an expected call of SetProjectSecret.
+func (mr *MockProjectsServiceClientMockRecorder) SetProjectSecret(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectSecret", reflect.TypeOf((*MockProjectsServiceClient)(nil).SetProjectSecret), varargs...)
+}
+
 // UpdateProject mocks base method.
 func (m *MockProjectsServiceClient) UpdateProject(ctx context.Context, in *v1.UpdateProjectRequest, opts ...grpc.CallOption) (*v1.UpdateProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -942,6 +1442,26 @@ func (mr *MockRepositoryServiceClientMockRecorder) RegisterRepository(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRepository", reflect.TypeOf((*MockRepositoryServiceClient)(nil).RegisterRepository), varargs...)
 }
 
+// SyncRepositories mocks base method.
+func (m *MockRepositoryServiceClient) SyncRepositories(ctx context.Context, in *v1.SyncRepositoriesRequest, opts ...grpc.CallOption) (*v1.SyncRepositoriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncRepositories", varargs...)
+	ret0, _ := ret[0].(*v1.SyncRepositoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncRepositories indicates an expected call of SyncRepositories.
+func (mr *MockRepositoryServiceClientMockRecorder) SyncRepositories(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncRepositories", reflect.TypeOf((*MockRepositoryServiceClient)(nil).SyncRepositories), varargs...)
+}
+
 // MockRuleTypeServiceClient is a mock of RuleTypeServiceClient interface.
 type MockRuleTypeServiceClient struct {
 	ctrl     *gomock.Controller
@@ -966,6 +1486,26 @@ func (m *MockRuleTypeServiceClient) EXPECT() *MockRuleTypeServiceClientMockRecor
 	return m.recorder
 }
 
+// BulkUpdateRuleTypes mocks base method.
+func (m *MockRuleTypeServiceClient) BulkUpdateRuleTypes(ctx context.Context, in *v1.BulkUpdateRuleTypesRequest, opts ...grpc.CallOption) (*v1.BulkUpdateRuleTypesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BulkUpdateRuleTypes", varargs...)
+	ret0, _ := ret[0].(*v1.BulkUpdateRuleTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdateRuleTypes indicates an expected call of BulkUpdateRuleTypes.
+func (mr *MockRuleTypeServiceClientMockRecorder) BulkUpdateRuleTypes(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdateRuleTypes", reflect.TypeOf((*MockRuleTypeServiceClient)(nil).BulkUpdateRuleTypes), varargs...)
+}
+
 // CreateRuleType mocks base method.
 func (m *MockRuleTypeServiceClient) CreateRuleType(ctx context.Context, in *v1.CreateRuleTypeRequest, opts ...grpc.CallOption) (*v1.CreateRuleTypeResponse, error) {
 	m.ctrl.T.Helper()
@@ -6,6 +6,7 @@ package v1
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"google.golang.org/protobuf/proto"
 )
@@ -15,6 +16,15 @@ const (
 	IngestTypeDiff = "diff"
 	// IngestTypeRest is the ingest type for a rest API request
 	IngestTypeRest = "rest"
+	// IngestTypeGit is the ingest type for a git clone
+	IngestTypeGit = "git"
+	// IngestTypeMulti is the ingest type combining several ingestions
+	IngestTypeMulti = "multi"
+	// IngestTypeDockerfile is the ingest type for the Dockerfiles of a repository
+	IngestTypeDockerfile = "dockerfile"
+	// IngestTypeIaC is the ingest type for the infrastructure as code files of
+	// a repository
+	IngestTypeIaC = "iac"
 )
 
 const (
@@ -54,6 +64,14 @@ func (r *RuleType) WithDefaultShortFailureMessage() *RuleType {
 	return r
 }
 
+// IsTriggeredBy returns true if the rule type is evaluated again when the
+// upstream event occurs. An empty event stands for evaluations which aren't
+// caused by an upstream event, which evaluate all the rule types.
+func (r *RuleType) IsTriggeredBy(event string) bool {
+	events := r.GetDef().GetTriggers().GetEvents()
+	return event == "" || len(events) == 0 || slices.Contains(events, event)
+}
+
 // GetContext returns the context from the nested RuleType
 func (r *CreateRuleTypeRequest) GetContext() *Context {
 	if r != nil && r.RuleType != nil {
@@ -236,3 +236,54 @@ func TestRuleTypeState_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleType_IsTriggeredBy(t *testing.T) {
+	t.Parallel()
+
+	withTriggers := &minderv1.RuleType{
+		Def: &minderv1.RuleType_Definition{
+			Triggers: &minderv1.RuleType_Definition_Triggers{
+				Events: []string{"branch_protection_rule", "repository"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		r     *minderv1.RuleType
+		event string
+		want  bool
+	}{
+		{
+			name:  "no triggers",
+			r:     &minderv1.RuleType{Def: &minderv1.RuleType_Definition{}},
+			event: "push",
+			want:  true,
+		},
+		{
+			name:  "triggering event",
+			r:     withTriggers,
+			event: "branch_protection_rule",
+			want:  true,
+		},
+		{
+			name:  "other event",
+			r:     withTriggers,
+			event: "push",
+			want:  false,
+		},
+		{
+			name:  "no event",
+			r:     withTriggers,
+			event: "",
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.r.IsTriggeredBy(tt.event))
+		})
+	}
+}
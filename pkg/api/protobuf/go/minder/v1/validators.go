@@ -6,14 +6,19 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"path"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/itchyny/gojq"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/schemavalidate"
 )
 
 var (
@@ -31,6 +36,9 @@ var (
 	)
 
 	dataSourceAliasRegex = regexp.MustCompile(`^[a-z][-_[:word:]]*$`)
+
+	// multi ingest step names are usable as keys of jq ingested definitions
+	multiStepNameRegex = regexp.MustCompile(`^[a-zA-Z_]{1,63}$`)
 )
 
 var (
@@ -113,6 +121,38 @@ func (d *DockerHubProviderConfig) Validate() error {
 	return nil
 }
 
+// Ensure AzureDevOpsProviderConfig implements the Validator interface
+var _ Validator = (*AzureDevOpsProviderConfig)(nil)
+
+// Validate is a utility function which allows for the validation of a struct.
+func (a *AzureDevOpsProviderConfig) Validate() error {
+	if a.GetOrganization() == "" {
+		return fmt.Errorf("organization is required")
+	}
+
+	return nil
+}
+
+// Ensure PackageRegistryProviderConfig implements the Validator interface
+var _ Validator = (*PackageRegistryProviderConfig)(nil)
+
+// Validate is a utility function which allows for the validation of a struct.
+func (p *PackageRegistryProviderConfig) Validate() error {
+	if p.GetEndpoint() == "" {
+		return nil
+	}
+
+	u, err := url.Parse(p.GetEndpoint())
+	if err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("endpoint must be an http or https URL")
+	}
+
+	return nil
+}
+
 // Ensure Entity implements the Validator interface
 var _ Validator = (*Entity)(nil)
 
@@ -194,9 +234,41 @@ func (def *RuleType_Definition) Validate() error {
 		}
 	}
 
+	if err := def.Limits.Validate(); err != nil {
+		return err
+	}
+
 	return def.Eval.Validate()
 }
 
+// Validate validates the limits of a rule type definition
+func (limits *RuleType_Definition_Limits) Validate() error {
+	// Limits are not required and can be nil
+	if limits == nil {
+		return nil
+	}
+
+	if limits.Timeout != "" {
+		timeout, err := time.ParseDuration(limits.Timeout)
+		if err != nil {
+			return fmt.Errorf("%w: invalid timeout: %s", ErrInvalidRuleTypeDefinition, err)
+		}
+		if timeout <= 0 {
+			return fmt.Errorf("%w: timeout must be positive", ErrInvalidRuleTypeDefinition)
+		}
+	}
+
+	if limits.MaxRegoMemoryBytes < 0 {
+		return fmt.Errorf("%w: max_rego_memory_bytes cannot be negative", ErrInvalidRuleTypeDefinition)
+	}
+
+	if limits.MaxDataSourceCalls < 0 {
+		return fmt.Errorf("%w: max_data_source_calls cannot be negative", ErrInvalidRuleTypeDefinition)
+	}
+
+	return nil
+}
+
 // Validate validates a rule type definition eval
 func (ev *RuleType_Definition_Eval) Validate() error {
 	if ev == nil {
@@ -279,6 +351,12 @@ func (jq *RuleType_Definition_Eval_JQComparison) Validate() error {
 		}
 	}
 
+	switch jq.GetComparator() {
+	case "", "equals", "contains", "regex", "gte":
+	default:
+		return fmt.Errorf("%w: jq comparator %q is not supported", ErrInvalidRuleTypeDefinition, jq.GetComparator())
+	}
+
 	return nil
 }
 
@@ -346,12 +424,73 @@ func (ing *RuleType_Definition_Ingest) Validate() error {
 		if err := ing.GetRest().Validate(); err != nil {
 			return err
 		}
+	case IngestTypeGit:
+		if err := ing.GetGit().Validate(); err != nil {
+			return err
+		}
+	case IngestTypeMulti:
+		if err := ing.GetMulti().Validate(); err != nil {
+			return err
+		}
+	case IngestTypeDockerfile:
+		if err := ing.GetDockerfile().Validate(); err != nil {
+			return err
+		}
+	case IngestTypeIaC:
+		if err := ing.GetIac().Validate(); err != nil {
+			return err
+		}
 		// TODO: we don't have a default here, and a bunch of tests don't set type
 	}
 
 	return nil
 }
 
+// Validate validates a multi ingest
+func (m *MultiType) Validate() error {
+	if m == nil || len(m.GetSteps()) == 0 {
+		return fmt.Errorf("%w: multi ingest has no steps", ErrInvalidRuleTypeDefinition)
+	}
+
+	names := make(map[string]struct{}, len(m.GetSteps()))
+	for _, step := range m.GetSteps() {
+		if !multiStepNameRegex.MatchString(step.GetName()) {
+			return fmt.Errorf("%w: multi ingest step name %q is invalid", ErrInvalidRuleTypeDefinition, step.GetName())
+		}
+		if _, ok := names[step.GetName()]; ok {
+			return fmt.Errorf("%w: multi ingest step %q is defined twice", ErrInvalidRuleTypeDefinition, step.GetName())
+		}
+		names[step.GetName()] = struct{}{}
+
+		if step.GetIngest().GetType() == IngestTypeMulti {
+			return fmt.Errorf("%w: multi ingest step %q can't be of type multi", ErrInvalidRuleTypeDefinition, step.GetName())
+		}
+		if err := step.GetIngest().Validate(); err != nil {
+			return fmt.Errorf("multi ingest step %q: %w", step.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// Validate validates a dockerfile ingest. The config is optional.
+func (d *DockerfileType) Validate() error {
+	return validatePathPatterns(IngestTypeDockerfile, d.GetPaths())
+}
+
+// Validate validates an iac ingest. The config is optional.
+func (i *IaCType) Validate() error {
+	return validatePathPatterns(IngestTypeIaC, i.GetPaths())
+}
+
+func validatePathPatterns(ingestType string, patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("%w: %s ingest path %q is invalid: %w", ErrInvalidRuleTypeDefinition, ingestType, pattern, err)
+		}
+	}
+	return nil
+}
+
 // Validate validates a rule type definition alert
 func (alert *RuleType_Definition_Alert) Validate() error {
 	if alert == nil {
@@ -368,6 +507,8 @@ func (alert *RuleType_Definition_Alert) Validate() error {
 		if err := alert.GetPullRequestComment().Validate(); err != nil {
 			return err
 		}
+	case "code_scanning":
+		// the configuration is optional
 	default:
 		return fmt.Errorf("%w: alert type cannot be empty", ErrInvalidRuleTypeDefinition)
 	}
@@ -470,6 +611,84 @@ func (rest *RestType) Validate() error {
 		}
 	}
 
+	if err := rest.GetPagination().Validate(); err != nil {
+		return err
+	}
+	if rest.GetPagination() != nil && rest.Parse != "json" {
+		return fmt.Errorf("%w: rest pagination requires json parsing", ErrInvalidRuleTypeDefinition)
+	}
+
+	if err := rest.GetRetry().Validate(); err != nil {
+		return err
+	}
+
+	if rest.GetResponseSchema() != nil {
+		if rest.Parse != "json" {
+			return fmt.Errorf("%w: rest response schema requires json parsing", ErrInvalidRuleTypeDefinition)
+		}
+		if _, err := schemavalidate.CompileSchemaFromPB(rest.GetResponseSchema()); err != nil {
+			return fmt.Errorf("%w: rest response schema is invalid: %w", ErrInvalidRuleTypeDefinition, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate validates a git ingest, which may be empty
+func (g *GitType) Validate() error {
+	for _, p := range g.GetSparsePaths() {
+		if slices.Contains(strings.Split(p, "/"), "..") {
+			return fmt.Errorf("%w: git sparse path %q can't contain ..", ErrInvalidRuleTypeDefinition, p)
+		}
+	}
+	return nil
+}
+
+// Validate validates the pagination of a rest ingest
+func (p *RestType_Pagination) Validate() error {
+	// Pagination is not required and can be nil
+	if p == nil {
+		return nil
+	}
+
+	switch p.Type {
+	case "link":
+	case "cursor":
+		if p.CursorPath == "" || p.CursorParam == "" {
+			return fmt.Errorf("%w: cursor pagination requires a cursor path and parameter", ErrInvalidRuleTypeDefinition)
+		}
+	default:
+		return fmt.Errorf("%w: unknown pagination type %q", ErrInvalidRuleTypeDefinition, p.Type)
+	}
+
+	if p.MaxPages < 0 {
+		return fmt.Errorf("%w: max_pages cannot be negative", ErrInvalidRuleTypeDefinition)
+	}
+
+	return nil
+}
+
+// Validate validates the retry policy of a rest ingest
+func (r *RestType_Retry) Validate() error {
+	// Retries are not required and can be nil
+	if r == nil {
+		return nil
+	}
+
+	if r.MaxAttempts < 1 {
+		return fmt.Errorf("%w: max_attempts must be at least 1", ErrInvalidRuleTypeDefinition)
+	}
+
+	if r.InitialBackoff != "" {
+		backoff, err := time.ParseDuration(r.InitialBackoff)
+		if err != nil {
+			return fmt.Errorf("%w: invalid initial_backoff: %s", ErrInvalidRuleTypeDefinition, err)
+		}
+		if backoff <= 0 {
+			return fmt.Errorf("%w: initial_backoff must be positive", ErrInvalidRuleTypeDefinition)
+		}
+	}
+
 	return nil
 }
 
@@ -614,9 +833,32 @@ func (p *Profile) Validate() error {
 		}
 	}
 
+	if _, err := p.GetAlertGrouping().ParseWindow(); err != nil {
+		return fmt.Errorf("%w: alert grouping is invalid: %w", ErrValidationFailed, err)
+	}
+
 	return nil
 }
 
+// MaxAlertGroupingWindow is the longest window the alerts of a profile can be grouped for
+const MaxAlertGroupingWindow = 7 * 24 * time.Hour
+
+// ParseWindow returns the grouping window of the alerts. Zero is returned
+// when the grouping is not set, which means that alerts are not grouped.
+func (g *Profile_AlertGrouping) ParseWindow() (time.Duration, error) {
+	if g.GetWindow() == "" {
+		return 0, nil
+	}
+	window, err := time.ParseDuration(g.GetWindow())
+	if err != nil {
+		return 0, fmt.Errorf("invalid window %q: %w", g.GetWindow(), err)
+	}
+	if window < time.Minute || window > MaxAlertGroupingWindow {
+		return 0, fmt.Errorf("window must be between 1m and %s", MaxAlertGroupingWindow)
+	}
+	return window, nil
+}
+
 func validateRule(r *Profile_Rule) error {
 	if r.GetType() == "" {
 		return fmt.Errorf("%w: rule type cannot be empty", ErrValidationFailed)
@@ -719,6 +961,28 @@ func (rest *RestDataSource) Validate() error {
 	return nil
 }
 
+// Validate is the entrypoint for the deps.dev driver's validation
+func (dsDepsDevDriver *DataSource_DepsDev) Validate() error {
+	if dsDepsDevDriver == nil || dsDepsDevDriver.DepsDev == nil {
+		return fmt.Errorf("%w: deps_dev driver is nil", ErrValidationFailed)
+	}
+
+	if len(dsDepsDevDriver.DepsDev.GetDef()) == 0 {
+		return fmt.Errorf("%w: deps_dev definition is empty", ErrValidationFailed)
+	}
+
+	for name, def := range dsDepsDevDriver.DepsDev.GetDef() {
+		if name == "" {
+			return fmt.Errorf("%w: deps_dev function name is empty", ErrValidationFailed)
+		}
+		if def == nil {
+			return fmt.Errorf("%w: deps_dev function %s is nil", ErrValidationFailed, name)
+		}
+	}
+
+	return nil
+}
+
 // Validate validates a rest function
 func (rest *RestDataSource_Def) Validate() error {
 	if rest == nil {
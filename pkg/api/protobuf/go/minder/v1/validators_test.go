@@ -5,6 +5,7 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	"buf.build/go/protovalidate"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -66,6 +67,27 @@ func TestRuleType_Definition_Ingest_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid git ingest",
+			ingest: &RuleType_Definition_Ingest{
+				Type: IngestTypeGit,
+				Git: &GitType{
+					SkipBinaries: true,
+					SparsePaths:  []string{"/.github", "README.md"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "git ingest with sparse path out of the repository",
+			ingest: &RuleType_Definition_Ingest{
+				Type: IngestTypeGit,
+				Git: &GitType{
+					SparsePaths: []string{".github/../../etc"},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name:    "nil ingest",
 			ingest:  nil,
@@ -89,6 +111,119 @@ func TestRuleType_Definition_Ingest_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid multi ingest",
+			ingest: &RuleType_Definition_Ingest{
+				Type: IngestTypeMulti,
+				Multi: &MultiType{
+					Steps: []*MultiType_Step{
+						{Name: "repo", Ingest: &RuleType_Definition_Ingest{
+							Type: IngestTypeRest,
+							Rest: &RestType{Endpoint: "/repos/{{.Entity.Owner}}/{{.Entity.Name}}"},
+						}},
+						{Name: "branch_protection", Ingest: &RuleType_Definition_Ingest{
+							Type: IngestTypeRest,
+							Rest: &RestType{Endpoint: "/repos/{{.Entity.Owner}}/{{.Entity.Name}}/branches/main/protection"},
+						}},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "multi ingest without steps",
+			ingest: &RuleType_Definition_Ingest{
+				Type:  IngestTypeMulti,
+				Multi: &MultiType{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "multi ingest with duplicate step names",
+			ingest: &RuleType_Definition_Ingest{
+				Type: IngestTypeMulti,
+				Multi: &MultiType{
+					Steps: []*MultiType_Step{
+						{Name: "repo", Ingest: &RuleType_Definition_Ingest{Type: "builtin"}},
+						{Name: "repo", Ingest: &RuleType_Definition_Ingest{Type: "builtin"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "multi ingest with invalid step name",
+			ingest: &RuleType_Definition_Ingest{
+				Type: IngestTypeMulti,
+				Multi: &MultiType{
+					Steps: []*MultiType_Step{
+						{Name: "branch-protection", Ingest: &RuleType_Definition_Ingest{Type: "builtin"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "nested multi ingest",
+			ingest: &RuleType_Definition_Ingest{
+				Type: IngestTypeMulti,
+				Multi: &MultiType{
+					Steps: []*MultiType_Step{
+						{Name: "nested", Ingest: &RuleType_Definition_Ingest{
+							Type:  IngestTypeMulti,
+							Multi: &MultiType{},
+						}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "multi ingest with invalid step",
+			ingest: &RuleType_Definition_Ingest{
+				Type: IngestTypeMulti,
+				Multi: &MultiType{
+					Steps: []*MultiType_Step{
+						{Name: "repo", Ingest: &RuleType_Definition_Ingest{
+							Type: IngestTypeRest,
+							Rest: &RestType{},
+						}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dockerfile ingest without config",
+			ingest: &RuleType_Definition_Ingest{
+				Type: IngestTypeDockerfile,
+			},
+			wantErr: false,
+		},
+		{
+			name: "dockerfile ingest with invalid path",
+			ingest: &RuleType_Definition_Ingest{
+				Type:       IngestTypeDockerfile,
+				Dockerfile: &DockerfileType{Paths: []string{"docker/["}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "iac ingest with paths",
+			ingest: &RuleType_Definition_Ingest{
+				Type: IngestTypeIaC,
+				Iac:  &IaCType{Paths: []string{"deploy/*.yaml", "*.tf"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "iac ingest with invalid path",
+			ingest: &RuleType_Definition_Ingest{
+				Type: IngestTypeIaC,
+				Iac:  &IaCType{Paths: []string{"deploy/["}},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -437,6 +572,13 @@ func TestRuleType_Definition_Alert_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "code scanning without configuration",
+			alert: &RuleType_Definition_Alert{
+				Type: "code_scanning",
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -451,6 +593,112 @@ func TestRuleType_Definition_Alert_Validate(t *testing.T) {
 	}
 }
 
+func TestRuleType_Definition_Limits_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		limits  *RuleType_Definition_Limits
+		wantErr bool
+	}{
+		{
+			name: "valid limits",
+			limits: &RuleType_Definition_Limits{
+				Timeout:            "30s",
+				MaxRegoMemoryBytes: 1 << 20,
+				MaxDataSourceCalls: 10,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "nil limits are valid",
+			limits:  nil,
+			wantErr: false,
+		},
+		{
+			name: "invalid timeout",
+			limits: &RuleType_Definition_Limits{
+				Timeout: "30 seconds",
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-positive timeout",
+			limits: &RuleType_Definition_Limits{
+				Timeout: "0s",
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative data source calls",
+			limits: &RuleType_Definition_Limits{
+				MaxDataSourceCalls: -1,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.limits.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProfile_AlertGrouping_ParseWindow(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		grouping *Profile_AlertGrouping
+		want     time.Duration
+		wantErr  bool
+	}{
+		{
+			name:     "nil grouping disables it",
+			grouping: nil,
+			want:     0,
+		},
+		{
+			name:     "valid window",
+			grouping: &Profile_AlertGrouping{Window: "4h"},
+			want:     4 * time.Hour,
+		},
+		{
+			name:     "window too short",
+			grouping: &Profile_AlertGrouping{Window: "10s"},
+			wantErr:  true,
+		},
+		{
+			name:     "window too long",
+			grouping: &Profile_AlertGrouping{Window: "200h"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid window",
+			grouping: &Profile_AlertGrouping{Window: "4 hours"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.grouping.ParseWindow()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseWindow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseWindow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestRuleType_Definition_Alert_AlertTypePRComment_Validate(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -625,6 +873,84 @@ func TestRestType_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "cursor pagination",
+			rest: &RestType{
+				Endpoint: "https://example.com/api",
+				Parse:    "json",
+				Pagination: &RestType_Pagination{
+					Type:        "cursor",
+					ItemsPath:   "items",
+					CursorPath:  "meta.next",
+					CursorParam: "after",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "cursor pagination without cursor path",
+			rest: &RestType{
+				Endpoint: "https://example.com/api",
+				Parse:    "json",
+				Pagination: &RestType_Pagination{
+					Type:        "cursor",
+					CursorParam: "after",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "pagination without json parsing",
+			rest: &RestType{
+				Endpoint:   "https://example.com/api",
+				Pagination: &RestType_Pagination{Type: "link"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "retry",
+			rest: &RestType{
+				Endpoint: "https://example.com/api",
+				Retry: &RestType_Retry{
+					MaxAttempts:    3,
+					InitialBackoff: "500ms",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "retry with invalid backoff",
+			rest: &RestType{
+				Endpoint: "https://example.com/api",
+				Retry: &RestType_Retry{
+					MaxAttempts:    3,
+					InitialBackoff: "soon",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "response schema",
+			rest: &RestType{
+				Endpoint: "https://example.com/api",
+				Parse:    "json",
+				ResponseSchema: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"type": structpb.NewStringValue("object"),
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid response schema",
+			rest: &RestType{
+				Endpoint: "https://example.com/api",
+				Parse:    "json",
+				ResponseSchema: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"type": structpb.NewStringValue("not-a-type"),
+				}},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
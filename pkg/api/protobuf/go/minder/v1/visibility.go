@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// InitializedStringValue returns the string value of the visibility
+// with initialization done.
+func (v *Visibility) InitializedStringValue() (string, error) {
+	return v.EnsureDefault().Enum().AsString()
+}
+
+// EnsureDefault ensures the visibility has a default value
+func (v *Visibility) EnsureDefault() *Visibility {
+	if v == nil {
+		return Visibility_VISIBILITY_SUBTREE.Enum()
+	}
+	if *v == Visibility_VISIBILITY_UNSPECIFIED {
+		*v = Visibility_VISIBILITY_SUBTREE
+	}
+	return v
+}
+
+// AsString returns a human-readable string for the visibility value
+func (v *Visibility) AsString() (string, error) {
+	if v == nil {
+		return "subtree", nil
+	}
+
+	val := v.Descriptor().Values().ByNumber(v.Number())
+	if val == nil {
+		return "", fmt.Errorf("unknown visibility value: %d", v)
+	}
+	extension := proto.GetExtension(val.Options(), E_Name)
+	n, ok := extension.(string)
+	if !ok {
+		return "", fmt.Errorf("unknown visibility value: %d", v)
+	}
+
+	return n, nil
+}
+
+// FromString sets the visibility from a string
+func (v *Visibility) FromString(str string) error {
+	vals := v.Descriptor().Values()
+	for i := 0; i < vals.Len(); i++ {
+		val := vals.Get(i)
+		extension := proto.GetExtension(val.Options(), E_Name)
+		n, ok := extension.(string)
+		if !ok {
+			continue
+		}
+
+		if n == str {
+			*v = Visibility(val.Number())
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown visibility value: %s", str)
+}
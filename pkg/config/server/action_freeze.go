@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// ActionFreezeConfig is the configuration for the freeze windows during which
+// the remediations and alerts are not executed, e.g. around releases and
+// holidays. The actions which would have run are executed once the freeze
+// ends. Besides the windows, the action_freeze feature flag freezes the
+// actions manually.
+type ActionFreezeConfig struct {
+	// Windows are the scheduled freeze windows
+	Windows []FreezeWindowConfig `mapstructure:"windows"`
+	// FlushInterval is how often the actions deferred by a freeze are
+	// flushed once the freeze has ended
+	FlushInterval time.Duration `mapstructure:"flush_interval" default:"1m"`
+	// BatchSize is the maximum number of entities re-evaluated at each
+	// flush interval
+	BatchSize int64 `mapstructure:"batch_size" default:"100"`
+}
+
+// FreezeWindowConfig is a recurring freeze window
+type FreezeWindowConfig struct {
+	// Name identifies the window in the logs
+	Name string `mapstructure:"name"`
+	// Schedule is the cron expression of the start of the window, e.g.
+	// "0 18 * * FRI". A CRON_TZ= prefix sets its time zone, which is the
+	// local time zone of the server otherwise.
+	Schedule string `mapstructure:"schedule"`
+	// Duration is how long the window lasts
+	Duration time.Duration `mapstructure:"duration"`
+}
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// AlertGroupingConfig is the configuration for sending the alerts grouped by
+// the profiles setting an alert grouping window. The window itself is part
+// of each profile.
+type AlertGroupingConfig struct {
+	// FlushInterval is how often the groups whose window elapsed are
+	// flushed, by evaluating their entity again
+	FlushInterval time.Duration `mapstructure:"flush_interval" default:"1m"`
+	// BatchSize is the maximum number of entities re-evaluated at each
+	// flush interval
+	BatchSize int64 `mapstructure:"batch_size" default:"100"`
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"path"
+)
+
+// AlertRoutingConfig is the configuration for routing alert notifications
+// to the teams owning an entity
+type AlertRoutingConfig struct {
+	// Enabled controls whether owner notifications are sent when an alert is raised
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// DefaultTeam is the team name used when an entity has no owner set
+	DefaultTeam string `mapstructure:"default_team"`
+	// DefaultContact is the email address notified when an entity has no owner set
+	DefaultContact string `mapstructure:"default_contact"`
+	// DefaultEscalationChannel is the escalation channel used when an entity has no owner set
+	DefaultEscalationChannel string `mapstructure:"default_escalation_channel"`
+	// RepositoryGroups own the repositories, and their artifacts, which have
+	// no owner set. The first matching group is used, before the defaults.
+	RepositoryGroups []RepositoryGroupConfig `mapstructure:"repository_groups"`
+}
+
+// RepositoryGroupConfig is a group of repositories owned by the same team
+type RepositoryGroupConfig struct {
+	// Name identifies the group in the logs
+	Name string `mapstructure:"name"`
+	// Repositories are the patterns matching the owner/name of the
+	// repositories of the group, e.g. mindersec/*, in the syntax of path.Match
+	Repositories []string `mapstructure:"repositories"`
+	// Team is the team owning the repositories of the group
+	Team string `mapstructure:"team"`
+	// Contact is the email address notified for the repositories of the group
+	Contact string `mapstructure:"contact"`
+	// EscalationChannel is the escalation channel of the repositories of the group
+	EscalationChannel string `mapstructure:"escalation_channel"`
+}
+
+// Validate validates the alert routing configuration
+func (a *AlertRoutingConfig) Validate() error {
+	for _, group := range a.RepositoryGroups {
+		if len(group.Repositories) == 0 {
+			return fmt.Errorf("repository group %q has no repositories", group.Name)
+		}
+		if group.Team == "" && group.Contact == "" && group.EscalationChannel == "" {
+			return fmt.Errorf("repository group %q has no owner", group.Name)
+		}
+		for _, pattern := range group.Repositories {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("repository group %q: invalid pattern %q: %w", group.Name, pattern, err)
+			}
+		}
+	}
+	return nil
+}
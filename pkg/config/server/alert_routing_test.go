@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestAlertRoutingConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		group   serverconfig.RepositoryGroupConfig
+		wantErr bool
+	}{
+		{
+			name: "valid group",
+			group: serverconfig.RepositoryGroupConfig{
+				Name:         "platform",
+				Repositories: []string{"mindersec/*", "stacklok/minder"},
+				Contact:      "platform@example.com",
+			},
+		},
+		{
+			name: "group without repositories",
+			group: serverconfig.RepositoryGroupConfig{
+				Name:    "platform",
+				Contact: "platform@example.com",
+			},
+			wantErr: true,
+		},
+		{
+			name: "group without owner",
+			group: serverconfig.RepositoryGroupConfig{
+				Name:         "platform",
+				Repositories: []string{"mindersec/*"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid pattern",
+			group: serverconfig.RepositoryGroupConfig{
+				Name:         "platform",
+				Repositories: []string{"mindersec/[*"},
+				Contact:      "platform@example.com",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := serverconfig.AlertRoutingConfig{
+				RepositoryGroups: []serverconfig.RepositoryGroupConfig{tt.group},
+			}
+			err := cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// AnomalyDetectionConfig is the configuration for detecting anomalies in the
+// evaluations, such as a sudden spike of the failures of a rule type across
+// many entities, or a regression of its evaluation latency
+type AnomalyDetectionConfig struct {
+	// Enabled controls whether the evaluations are analyzed in the background
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// Interval is how often the evaluations are analyzed, and the window
+	// the latencies are compared over
+	Interval time.Duration `mapstructure:"interval" default:"15m"`
+	// Window is the recent period whose failures are compared to the baseline
+	Window time.Duration `mapstructure:"window" default:"1h"`
+	// Baseline is the period preceding the window the failures are compared to
+	Baseline time.Duration `mapstructure:"baseline" default:"24h"`
+	// FailureSpikeFactor is how many times more entities must fail a rule
+	// type in the window than in the baseline to be reported
+	FailureSpikeFactor float64 `mapstructure:"failure_spike_factor" default:"3"`
+	// MinFailingEntities is the number of entities which must fail a rule
+	// type in the window to be reported, so that a few failures are not
+	// reported as a spike
+	MinFailingEntities int64 `mapstructure:"min_failing_entities" default:"10"`
+	// LatencyRegressionFactor is how many times slower than its baseline
+	// the evaluation of a rule type must be to be reported
+	LatencyRegressionFactor float64 `mapstructure:"latency_regression_factor" default:"2"`
+	// MinLatencySamples is the number of evaluations of a rule type in an
+	// interval needed to compare its latency to the baseline
+	MinLatencySamples int64 `mapstructure:"min_latency_samples" default:"20"`
+	// AlertCooldown is how long an anomaly is not reported again after it
+	// was reported
+	AlertCooldown time.Duration `mapstructure:"alert_cooldown" default:"6h"`
+	// AlertContact is the email address notified of the anomalies.
+	// Anomalies are only logged if it is empty.
+	AlertContact string `mapstructure:"alert_contact"`
+}
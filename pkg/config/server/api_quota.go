@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// APIQuotaConfig is the configuration for the per-project API request quotas.
+// Each project has a token bucket, stored in the database so that the quota
+// is shared by all the server instances.
+type APIQuotaConfig struct {
+	// Enabled controls whether the API requests of the projects are limited
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// Rate is the number of requests per second a project may sustain
+	Rate float64 `mapstructure:"rate" default:"10"`
+	// Burst is the maximum number of requests a project may make at once
+	Burst int32 `mapstructure:"burst" default:"100"`
+	// Tiers are the names of the features, in order of precedence, whose
+	// settings replace the default quota for the projects entitled to them,
+	// e.g. {"rate": 50, "burst": 500}
+	Tiers []string `mapstructure:"tiers"`
+	// TierCacheTTL is how long the quota of a project is cached before its
+	// entitlements are checked again
+	TierCacheTTL time.Duration `mapstructure:"tier_cache_ttl" default:"1m"`
+}
@@ -4,6 +4,7 @@
 package server
 
 import (
+	"errors"
 	"os"
 
 	"github.com/go-playground/validator/v10"
@@ -11,13 +12,15 @@ import (
 
 // AuthzConfig is the configuration for minder's authorization
 type AuthzConfig struct {
-	// ApiUrl is the URL to the authorization server
-	ApiUrl string `mapstructure:"api_url" validate:"required"`
+	// ApiUrl is the URL to the authorization server. It is required unless
+	// the embedded authorization server is enabled.
+	ApiUrl string `mapstructure:"api_url"`
 	// StoreName is the name of the store to use for authorization
 	StoreName string `mapstructure:"store_name" default:"minder" validate:"required_without=StoreID"`
 	// StoreID is the ID of the store to use for authorization
 	StoreID string `mapstructure:"store_id" default:"" validate:"required_without=StoreName"`
-	// ModelID is the ID of the model to use for authorization
+	// ModelID is the ID of the model to use for authorization. The latest
+	// model of the store is used if empty.
 	ModelID string `mapstructure:"model_id" default:""`
 	// Auth is the authentication configuration for the authorization server
 	Auth OpenFGAAuth `mapstructure:"auth" validate:"required"`
@@ -25,6 +28,19 @@ type AuthzConfig struct {
 	// AdminDeleters are a list of user IDs in the authz system which are
 	// permitted to delete resources from the system.
 	AdminDeleters []string `mapstructure:"admin_deleters" default:""`
+
+	// Embedded is the configuration for running the authorization server
+	// in-process instead of as a separate service
+	Embedded EmbeddedAuthzConfig `mapstructure:"embedded" validate:"-"`
+}
+
+// GetApiUrl returns the URL to the authorization server, which is the
+// embedded one if enabled
+func (a *AuthzConfig) GetApiUrl() string {
+	if a.Embedded.Enabled {
+		return "http://" + a.Embedded.HTTPAddress
+	}
+	return a.ApiUrl
 }
 
 // Validate validates the Authz configuration
@@ -34,9 +50,41 @@ func (a *AuthzConfig) Validate() error {
 		return err
 	}
 
+	if a.Embedded.Enabled {
+		if err := validate.Struct(&a.Embedded); err != nil {
+			return err
+		}
+	} else if a.ApiUrl == "" {
+		return errors.New("api_url is required unless the embedded authorization server is enabled")
+	}
+
 	return a.Auth.Validate()
 }
 
+// EmbeddedAuthzConfig is the configuration for running OpenFGA in-process,
+// so that small deployments don't need to operate a separate authorization
+// service
+type EmbeddedAuthzConfig struct {
+	// Enabled runs the authorization server in-process
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// GRPCAddress is the address the embedded server listens on for gRPC
+	GRPCAddress string `mapstructure:"grpc_address" default:"127.0.0.1:8091" validate:"required"`
+	// HTTPAddress is the address the embedded server listens on for HTTP
+	HTTPAddress string `mapstructure:"http_address" default:"127.0.0.1:8092" validate:"required"`
+	// Datastore is where the embedded server keeps the authorization data
+	Datastore EmbeddedAuthzDatastoreConfig `mapstructure:"datastore"`
+}
+
+// EmbeddedAuthzDatastoreConfig is the datastore of the embedded authorization server
+type EmbeddedAuthzDatastoreConfig struct {
+	// Engine is the datastore engine. The memory engine loses all the role
+	// assignments on restart, and is only suitable for demos and tests.
+	Engine string `mapstructure:"engine" default:"memory" validate:"oneof=memory postgres sqlite"`
+	// URI is the connection string of the datastore, required unless the
+	// engine is memory
+	URI string `mapstructure:"uri" default:"" validate:"required_unless=Engine memory"`
+}
+
 // OpenFGAAuth contains the authentication configuration for OpenFGA
 type OpenFGAAuth struct {
 	// Method is the authentication method to use
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestAuthzConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	embedded := func(engine, uri string) serverconfig.EmbeddedAuthzConfig {
+		return serverconfig.EmbeddedAuthzConfig{
+			Enabled:     true,
+			GRPCAddress: "127.0.0.1:8091",
+			HTTPAddress: "127.0.0.1:8092",
+			Datastore: serverconfig.EmbeddedAuthzDatastoreConfig{
+				Engine: engine,
+				URI:    uri,
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		cfg     serverconfig.AuthzConfig
+		wantURL string
+		wantErr bool
+	}{
+		{
+			name: "external server",
+			cfg: serverconfig.AuthzConfig{
+				ApiUrl:    "http://openfga:8080",
+				StoreName: "minder",
+				Auth:      serverconfig.OpenFGAAuth{Method: "none"},
+			},
+			wantURL: "http://openfga:8080",
+		},
+		{
+			name: "external server without URL",
+			cfg: serverconfig.AuthzConfig{
+				StoreName: "minder",
+				Auth:      serverconfig.OpenFGAAuth{Method: "none"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "embedded in-memory server",
+			cfg: serverconfig.AuthzConfig{
+				StoreName: "minder",
+				Auth:      serverconfig.OpenFGAAuth{Method: "none"},
+				Embedded:  embedded("memory", ""),
+			},
+			wantURL: "http://127.0.0.1:8092",
+		},
+		{
+			name: "embedded persistent server",
+			cfg: serverconfig.AuthzConfig{
+				StoreName: "minder",
+				Auth:      serverconfig.OpenFGAAuth{Method: "none"},
+				Embedded:  embedded("sqlite", "file:/var/lib/minder/authz.db"),
+			},
+			wantURL: "http://127.0.0.1:8092",
+		},
+		{
+			name: "embedded persistent server without URI",
+			cfg: serverconfig.AuthzConfig{
+				StoreName: "minder",
+				Auth:      serverconfig.OpenFGAAuth{Method: "none"},
+				Embedded:  embedded("postgres", ""),
+			},
+			wantErr: true,
+		},
+		{
+			name: "embedded server with unknown engine",
+			cfg: serverconfig.AuthzConfig{
+				StoreName: "minder",
+				Auth:      serverconfig.OpenFGAAuth{Method: "none"},
+				Embedded:  embedded("mysql", "user:pass@tcp(mysql:3306)/openfga"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantURL, tt.cfg.GetApiUrl())
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+// AzureDevOpsConfig is the configuration for the Azure DevOps providers
+type AzureDevOpsConfig struct {
+	// OAuthClientConfig is the configuration of the Microsoft Entra ID
+	// application used to enroll Azure DevOps providers with OAuth
+	OAuthClientConfig `mapstructure:",squash"`
+
+	// WebhookSecrets is the configuration for the secrets of the Azure DevOps
+	// service hooks. These are used to verify incoming service hook requests
+	// from Azure DevOps.
+	WebhookSecrets `mapstructure:",squash"`
+
+	// Tenant is the Microsoft Entra ID tenant the OAuth application belongs
+	// to. When empty, "organizations" is used, which accepts the work and school
+	// accounts of any tenant.
+	Tenant string `mapstructure:"tenant"`
+
+	// Scopes is the list of scopes to request from Microsoft Entra ID
+	Scopes []string `mapstructure:"scopes"`
+}
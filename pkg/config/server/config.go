@@ -18,25 +18,45 @@ import (
 
 // Config is the top-level configuration structure.
 type Config struct {
-	HTTPServer      HTTPServerConfig      `mapstructure:"http_server"`
-	GRPCServer      GRPCServerConfig      `mapstructure:"grpc_server"`
-	MetricServer    MetricServerConfig    `mapstructure:"metric_server"`
-	LoggingConfig   LoggingConfig         `mapstructure:"logging"`
-	Tracing         TracingConfig         `mapstructure:"tracing"`
-	Metrics         MetricsConfig         `mapstructure:"metrics"`
-	Flags           FlagsConfig           `mapstructure:"flags"`
-	Database        config.DatabaseConfig `mapstructure:"database"`
-	Identity        IdentityConfigWrapper `mapstructure:"identity"`
-	Auth            AuthConfig            `mapstructure:"auth"`
-	WebhookConfig   WebhookConfig         `mapstructure:"webhook-config"`
-	Events          EventConfig           `mapstructure:"events"`
-	Features        FeaturesConfig        `mapstructure:"features"`
-	Authz           AuthzConfig           `mapstructure:"authz"`
-	Provider        ProviderConfig        `mapstructure:"provider"`
-	Marketplace     MarketplaceConfig     `mapstructure:"marketplace"`
-	DefaultProfiles DefaultProfilesConfig `mapstructure:"default_profiles"`
-	Crypto          CryptoConfig          `mapstructure:"crypto"`
-	Email           EmailConfig           `mapstructure:"email"`
+	HTTPServer           HTTPServerConfig           `mapstructure:"http_server"`
+	GRPCServer           GRPCServerConfig           `mapstructure:"grpc_server"`
+	MetricServer         MetricServerConfig         `mapstructure:"metric_server"`
+	LoggingConfig        LoggingConfig              `mapstructure:"logging"`
+	Tracing              TracingConfig              `mapstructure:"tracing"`
+	Metrics              MetricsConfig              `mapstructure:"metrics"`
+	Flags                FlagsConfig                `mapstructure:"flags"`
+	Database             config.DatabaseConfig      `mapstructure:"database"`
+	Identity             IdentityConfigWrapper      `mapstructure:"identity"`
+	Auth                 AuthConfig                 `mapstructure:"auth"`
+	WebhookConfig        WebhookConfig              `mapstructure:"webhook-config"`
+	Events               EventConfig                `mapstructure:"events"`
+	Features             FeaturesConfig             `mapstructure:"features"`
+	Authz                AuthzConfig                `mapstructure:"authz"`
+	Provider             ProviderConfig             `mapstructure:"provider"`
+	Marketplace          MarketplaceConfig          `mapstructure:"marketplace"`
+	RuleTypeSignatures   BundleSignaturesConfig     `mapstructure:"rule_type_signatures"`
+	DefaultProfiles      DefaultProfilesConfig      `mapstructure:"default_profiles"`
+	Crypto               CryptoConfig               `mapstructure:"crypto"`
+	Email                EmailConfig                `mapstructure:"email"`
+	AlertRouting         AlertRoutingConfig         `mapstructure:"alert_routing"`
+	AlertGrouping        AlertGroupingConfig        `mapstructure:"alert_grouping"`
+	Fairness             FairnessConfig             `mapstructure:"fairness"`
+	FaultInjection       FaultInjectionConfig       `mapstructure:"fault_injection"`
+	RuleLimits           RuleLimitsConfig           `mapstructure:"rule_limits"`
+	EvaluationRetry      EvaluationRetryConfig      `mapstructure:"evaluation_retry"`
+	EvaluationCleanup    EvaluationCleanupConfig    `mapstructure:"evaluation_cleanup"`
+	EvaluationLogExport  EvaluationLogExportConfig  `mapstructure:"evaluation_log_export"`
+	PullRequestRetention PullRequestRetentionConfig `mapstructure:"pull_request_retention"`
+	ProviderHealth       ProviderHealthConfig       `mapstructure:"provider_health"`
+	PackagePolling       PackagePollingConfig       `mapstructure:"package_polling"`
+	AnomalyDetection     AnomalyDetectionConfig     `mapstructure:"anomaly_detection"`
+	ProviderUsage        ProviderUsageConfig        `mapstructure:"provider_usage"`
+	ProfileStatusSharing ProfileStatusSharingConfig `mapstructure:"profile_status_sharing"`
+	ActionFreeze         ActionFreezeConfig         `mapstructure:"action_freeze"`
+	APIQuota             APIQuotaConfig             `mapstructure:"api_quota"`
+	MTLS                 MTLSConfig                 `mapstructure:"mtls"`
+	Profiling            ProfilingConfig            `mapstructure:"profiling"`
+	ProjectCache         ProjectCacheConfig         `mapstructure:"project_cache"`
 }
 
 // DefaultConfigForTest returns a configuration with all the struct defaults set,
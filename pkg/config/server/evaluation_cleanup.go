@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// EvaluationCleanupConfig is the configuration for purging the evaluations of
+// the rule and entity pairs which are no longer evaluated, e.g. because the
+// entity is no longer selected by the profile
+type EvaluationCleanupConfig struct {
+	// Enabled controls whether the stale evaluations are purged in the background
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// MaxAge is how long after its last evaluation a rule and entity pair
+	// is considered stale, and purged along with its evaluation history
+	MaxAge time.Duration `mapstructure:"max_age" default:"720h"`
+	// Interval is how often the stale evaluations are purged
+	Interval time.Duration `mapstructure:"interval" default:"1h"`
+	// BatchSize is the number of rule and entity pairs deleted per transaction
+	BatchSize int32 `mapstructure:"batch_size" default:"1000"`
+}
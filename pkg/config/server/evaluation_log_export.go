@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// EvaluationLogExportConfig is the configuration for exporting the
+// evaluation and remediation events as OpenTelemetry logs to an OTLP
+// collector, so that they can be correlated with the rest of the platform
+// telemetry
+type EvaluationLogExportConfig struct {
+	// Enabled controls whether the events are exported
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// Endpoint is the host and port of the OTLP/HTTP collector
+	Endpoint string `mapstructure:"endpoint" default:"localhost:4318"`
+	// URLPath is the path the logs are sent to on the collector
+	URLPath string `mapstructure:"url_path" default:"/v1/logs"`
+	// Insecure disables TLS, for collectors running alongside the server
+	Insecure bool `mapstructure:"insecure" default:"false"`
+	// Headers are sent with each export request, for example to
+	// authenticate to the collector
+	Headers map[string]string `mapstructure:"headers"`
+	// Timeout is the timeout of each export request
+	Timeout time.Duration `mapstructure:"timeout" default:"10s"`
+	// ExportInterval is how often the buffered events are exported
+	ExportInterval time.Duration `mapstructure:"export_interval" default:"5s"`
+	// MaxQueueSize is the number of events buffered between exports. Events
+	// are dropped when the collector can't keep up.
+	MaxQueueSize int `mapstructure:"max_queue_size" default:"2048"`
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// EvaluationRetryConfig is the configuration for the automatic re-evaluation
+// of entities whose evaluation ended in a transient error, such as a rate
+// limit or an unavailable provider
+type EvaluationRetryConfig struct {
+	// Enabled controls whether transient evaluation errors are retried
+	Enabled bool `mapstructure:"enabled" default:"true"`
+	// MaxAttempts is the maximum number of retries after a transient error
+	MaxAttempts int32 `mapstructure:"max_attempts" default:"5"`
+	// InitialBackoff is the delay before the first retry. The delay doubles
+	// for each following retry.
+	InitialBackoff time.Duration `mapstructure:"initial_backoff" default:"1m"`
+	// MaxBackoff is the maximum delay between two retries
+	MaxBackoff time.Duration `mapstructure:"max_backoff" default:"1h"`
+	// Interval is how often the due retries are scheduled
+	Interval time.Duration `mapstructure:"interval" default:"30s"`
+	// BatchSize is the maximum number of retries scheduled at each interval
+	BatchSize int64 `mapstructure:"batch_size" default:"100"`
+}
@@ -26,6 +26,50 @@ type EventConfig struct {
 	Aggregator AggregatorConfig `mapstructure:"aggregator"`
 	// Nats is the configuration when using NATS as the event driver
 	Nats NatsConfig `mapstructure:"nats"`
+	// Watchdog is the configuration for detecting stuck message handlers
+	Watchdog WatchdogConfig `mapstructure:"watchdog"`
+	// Consumers is the configuration for the consumers of each group of topics
+	Consumers ConsumersConfig `mapstructure:"consumers"`
+}
+
+// ConsumersConfig is the configuration for the consumers of each group of
+// topics, so that slow consumers don't delay the others
+type ConsumersConfig struct {
+	// Evaluate is the configuration for the consumers of the entity
+	// evaluation and refresh topics
+	Evaluate ConsumerConfig `mapstructure:"evaluate"`
+	// Reconcile is the configuration for the consumers of the repository,
+	// profile and entity reconciliation topics
+	Reconcile ConsumerConfig `mapstructure:"reconcile"`
+	// Delete is the configuration for the consumers of the entity deletion topics
+	Delete ConsumerConfig `mapstructure:"delete"`
+	// Reminders is the configuration for the consumers of the reminder topics
+	Reminders ConsumerConfig `mapstructure:"reminders"`
+}
+
+// ConsumerConfig is the configuration for consuming the messages of a topic.
+// When more than one worker or any prefetch is configured, the messages are
+// acknowledged to the driver once received by the server and re-published if
+// their handler fails, so the messages in-flight may be lost if the server stops.
+type ConsumerConfig struct {
+	// Workers is the number of messages of a topic which are handled concurrently
+	Workers int `mapstructure:"workers" default:"1"`
+	// Prefetch is the number of messages of a topic which are received ahead
+	// of a worker being available
+	Prefetch int `mapstructure:"prefetch" default:"0"`
+}
+
+// WatchdogConfig is the configuration for detecting the messages whose
+// handler doesn't return in time, so that a hung handler can't stall a topic
+type WatchdogConfig struct {
+	// Enabled controls whether the messages in-flight for too long are detected
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// Threshold is how long a message can be in-flight before its handler is
+	// considered stuck
+	Threshold time.Duration `mapstructure:"threshold" default:"10m"`
+	// Action is what is done with a stuck message: "nack" to have it
+	// redelivered, or "quarantine" to move it to the dead letter queue
+	Action string `mapstructure:"action" default:"quarantine"`
 }
 
 // GoChannelEventConfig is the configuration for the go channel event driver
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// FairnessConfig is the configuration for tracking the resources consumed by
+// each project, so that operators of shared instances can spot noisy neighbors
+type FairnessConfig struct {
+	// Enabled controls whether per-project usage is tracked
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// Window is the period over which usage is accounted
+	Window time.Duration `mapstructure:"window" default:"10m"`
+	// Throttle configures the automatic throttling of projects using more
+	// than their share of the instance
+	Throttle ThrottleConfig `mapstructure:"throttle"`
+}
+
+// ThrottleConfig is the configuration for throttling noisy projects
+type ThrottleConfig struct {
+	// Enabled controls whether entity evaluations of noisy projects are skipped
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// MaxEventShare is the maximum share (between 0 and 1) of the entity
+	// events in the window a single project may use before being throttled
+	MaxEventShare float64 `mapstructure:"max_event_share" default:"0.5"`
+	// MinEvents is the number of events in the window below which no project
+	// is throttled, so that quiet or single-tenant instances are not affected
+	MinEvents int64 `mapstructure:"min_events" default:"1000"`
+	// RequeueInterval is how often the entities whose evaluation was
+	// throttled are evaluated again, once the window they were throttled
+	// in has ended
+	RequeueInterval time.Duration `mapstructure:"requeue_interval" default:"1m"`
+	// BatchSize is the maximum number of throttled entities evaluated again
+	// at each requeue interval
+	BatchSize int64 `mapstructure:"batch_size" default:"100"`
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// FaultInjectionConfig is the configuration for injecting failures and delays
+// in the events and actions pipeline, to exercise the resilience behaviors
+// (retries, dead letter queue, lock leases) in tests. It must never be
+// enabled in production.
+type FaultInjectionConfig struct {
+	// Enabled controls whether faults are injected at all
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// Seed seeds the random decisions, so that a faulty run can be reproduced.
+	// A zero seed picks a random one.
+	Seed int64 `mapstructure:"seed" default:"0"`
+	// Handlers configures the faults injected before handling event messages
+	Handlers FaultConfig `mapstructure:"handlers"`
+	// Actions configures the faults injected before executing actions
+	Actions FaultConfig `mapstructure:"actions"`
+}
+
+// FaultConfig is the configuration of the faults injected at a boundary
+type FaultConfig struct {
+	// ErrorRate is the probability (between 0 and 1) of failing with an error
+	ErrorRate float64 `mapstructure:"error_rate" default:"0"`
+	// DelayRate is the probability (between 0 and 1) of being delayed
+	DelayRate float64 `mapstructure:"delay_rate" default:"0"`
+	// Delay is how long delayed calls wait
+	Delay time.Duration `mapstructure:"delay" default:"0s"`
+	// Targets restricts the faults to the given event topics or action
+	// types (remediate, alert). All of them are targeted if empty.
+	Targets []string `mapstructure:"targets"`
+}
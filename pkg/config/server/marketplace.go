@@ -27,12 +27,59 @@ var (
 type MarketplaceConfig struct {
 	Enabled bool                 `mapstructure:"enabled" default:"false"`
 	Sources []BundleSourceConfig `mapstructure:"sources"`
+	// Signatures is the configuration for verifying the signatures of the bundles
+	Signatures BundleSignaturesConfig `mapstructure:"signatures"`
 }
 
 // BundleSourceConfig holds details about where the bundle gets loaded from
 type BundleSourceConfig struct {
 	Type     string `mapstructure:"type"`
 	Location string `mapstructure:"location"`
+	// SignatureLocation is the path to the sigstore bundle holding the
+	// signature of the bundle. It defaults to the location of the bundle
+	// with the ".sigstore.json" suffix.
+	SignatureLocation string `mapstructure:"signature_location"`
+}
+
+// GetSignatureLocation returns the path to the signature of the bundle
+func (b *BundleSourceConfig) GetSignatureLocation() string {
+	if b.SignatureLocation != "" {
+		return b.SignatureLocation
+	}
+	return b.Location + ".sigstore.json"
+}
+
+// BundleSignaturesConfig holds the configuration for verifying the
+// sigstore signatures of the bundles, or of the rule types created and
+// updated through the API
+type BundleSignaturesConfig struct {
+	// Required rejects the subscriptions to the bundles, or the rule types,
+	// which are not signed by one of the identities
+	Required bool `mapstructure:"required" default:"false"`
+	// TUFRepo is the sigstore TUF repository holding the trusted root. It
+	// defaults to the public sigstore instance.
+	TUFRepo string `mapstructure:"tuf_repo"`
+	// Identities are the identities trusted to sign the bundles
+	Identities []SignerIdentityConfig `mapstructure:"identities"`
+}
+
+// Enabled returns true if the signatures of the bundles are verified
+func (b *BundleSignaturesConfig) Enabled() bool {
+	return b.Required || len(b.Identities) > 0
+}
+
+// SignerIdentityConfig is an identity trusted to sign the bundles, matched
+// against the signing certificate. Either the exact value or the regular
+// expression of the issuer and subject must be set.
+type SignerIdentityConfig struct {
+	// Issuer is the OIDC issuer of the signer, e.g. https://token.actions.githubusercontent.com
+	Issuer string `mapstructure:"issuer"`
+	// IssuerRegexp is a regular expression matching the OIDC issuer
+	IssuerRegexp string `mapstructure:"issuer_regexp"`
+	// Subject is the subject of the signer, e.g. an email address or a workflow URL
+	Subject string `mapstructure:"subject"`
+	// SubjectRegexp is a regular expression matching the subject
+	SubjectRegexp string `mapstructure:"subject_regexp"`
 }
 
 // GetType returns the source as an enum type, or error if invalid
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+// MTLSConfig is the configuration for mutual TLS on the gRPC and HTTP
+// listeners. Callers presenting a client certificate signed by one of the
+// client CAs are authenticated as the service account the certificate maps
+// to, without an OIDC token. Callers without a certificate, such as the
+// users and the webhooks, are still accepted.
+type MTLSConfig struct {
+	// Enabled controls whether the listeners serve TLS and verify the client certificates
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// CertFile is the path to the PEM-encoded server certificate chain
+	CertFile string `mapstructure:"cert_file"`
+	// KeyFile is the path to the PEM-encoded server private key
+	KeyFile string `mapstructure:"key_file"`
+	// ClientCAFile is the path to the PEM-encoded CAs which sign the client certificates
+	ClientCAFile string `mapstructure:"client_ca_file"`
+	// ServiceAccounts maps the client certificates to service accounts
+	ServiceAccounts []ServiceAccountConfig `mapstructure:"service_accounts"`
+}
+
+// ServiceAccountConfig maps a client certificate to a service account
+type ServiceAccountConfig struct {
+	// Name is the name of the service account. Roles are granted to the
+	// service account as "serviceaccount/<name>".
+	Name string `mapstructure:"name"`
+	// Subject is matched against the URI (e.g. a SPIFFE ID), DNS and email
+	// SANs of the client certificate, then against its common name
+	Subject string `mapstructure:"subject"`
+}
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// PackagePollingConfig is the configuration for polling the package
+// registries for new versions of the registered packages
+type PackagePollingConfig struct {
+	// Enabled controls whether the package registries are polled in the background
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// Interval is how often the registries are polled
+	Interval time.Duration `mapstructure:"interval" default:"1h"`
+}
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// ProfileStatusSharingConfig is the configuration for the links which share
+// the status of a profile without authentication
+type ProfileStatusSharingConfig struct {
+	// DefaultExpiration is how long a link is valid when the request doesn't
+	// set it
+	DefaultExpiration time.Duration `mapstructure:"default_expiration" default:"24h"`
+	// MaxExpiration is the longest a link can be valid
+	MaxExpiration time.Duration `mapstructure:"max_expiration" default:"720h"`
+	// BaseURL is the base URL of the Minder API used to build the links,
+	// e.g. https://api.example.com. Only the token is returned if unset.
+	BaseURL string `mapstructure:"base_url"`
+}
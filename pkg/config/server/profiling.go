@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+// ProfilingConfig is the configuration for the diagnostics endpoints of the
+// metric server: the Go pprof profiles and the report of the evaluation
+// pipeline. The endpoints expose the internals of the server, so they
+// require a bearer token.
+type ProfilingConfig struct {
+	// Enabled controls whether the diagnostics endpoints are served
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// Token is the bearer token required to call the endpoints
+	Token string `mapstructure:"token"`
+	// TokenFile is the location of the file containing the bearer token
+	TokenFile string `mapstructure:"token_file"`
+}
+
+// GetToken returns the bearer token required to call the diagnostics endpoints
+func (pc *ProfilingConfig) GetToken() (string, error) {
+	return fileOrArg(pc.TokenFile, pc.Token, "profiling token")
+}
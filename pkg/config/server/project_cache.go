@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// ProjectCacheConfig is the configuration for caching the project hierarchy
+// and the entitlements of the projects, which are read by most requests
+type ProjectCacheConfig struct {
+	// TTL is how long the hierarchy and the entitlements of a project are
+	// shared across requests. They are always cached for the duration of a
+	// request; zero disables the sharing across requests. Changes made by
+	// other server instances are seen once the TTL has passed.
+	TTL time.Duration `mapstructure:"ttl" default:"10s"`
+}
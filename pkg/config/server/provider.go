@@ -5,14 +5,32 @@ package server
 
 // ProviderConfig is the configuration for the providers
 type ProviderConfig struct {
-	GitHubApp *GitHubAppConfig `mapstructure:"github-app"`
-	GitHub    *GitHubConfig    `mapstructure:"github"`
-	Git       GitConfig        `mapstructure:"git"`
-	GitLab    *GitLabConfig    `mapstructure:"gitlab"`
+	GitHubApp   *GitHubAppConfig   `mapstructure:"github-app"`
+	GitHub      *GitHubConfig      `mapstructure:"github"`
+	Git         GitConfig          `mapstructure:"git"`
+	GitLab      *GitLabConfig      `mapstructure:"gitlab"`
+	AzureDevOps *AzureDevOpsConfig `mapstructure:"azure_devops"`
 }
 
 // GitConfig provides server-side configuration for Git operations like "clone"
 type GitConfig struct {
 	MaxFiles int64 `mapstructure:"max_files" default:"10000"`
 	MaxBytes int64 `mapstructure:"max_bytes" default:"100_000_000"`
+	// MirrorCache configures the on-disk mirrors which clones of the same
+	// repository are made from
+	MirrorCache GitMirrorCacheConfig `mapstructure:"mirror_cache"`
+	// SSHKnownHostsFile is the known_hosts file verifying the host keys of
+	// the remotes cloned over SSH with deploy keys. The default known_hosts
+	// files of the system are used when empty.
+	SSHKnownHostsFile string `mapstructure:"ssh_known_hosts_file" default:""`
+}
+
+// GitMirrorCacheConfig provides server-side configuration for the cache of
+// repository mirrors shared by the clones of a repository
+type GitMirrorCacheConfig struct {
+	// Dir is the directory holding the mirrors. The cache is disabled when empty.
+	Dir string `mapstructure:"dir" default:""`
+	// MaxBytes bounds the total size of the mirrors; the least recently
+	// used mirrors are evicted beyond it.
+	MaxBytes int64 `mapstructure:"max_bytes" default:"2_000_000_000"`
 }
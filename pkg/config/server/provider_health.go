@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// ProviderHealthConfig is the configuration for monitoring the health of the
+// credentials of the providers
+type ProviderHealthConfig struct {
+	// Enabled controls whether the credentials of all providers are checked in the background
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// Interval is how often the credentials are checked
+	Interval time.Duration `mapstructure:"interval" default:"6h"`
+	// ExpiryWarning is how long before its expiry a token is reported as
+	// expiring and alerted on
+	ExpiryWarning time.Duration `mapstructure:"expiry_warning" default:"168h"`
+	// AlertContact is the email address notified of expiring tokens and of
+	// failing credentials. Alerts are only logged if it is empty.
+	AlertContact string `mapstructure:"alert_contact"`
+}
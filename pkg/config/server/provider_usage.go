@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// ProviderUsageConfig is the configuration for recording the API calls,
+// webhooks and evaluations of each provider over time
+type ProviderUsageConfig struct {
+	// Enabled controls whether the usage of the providers is recorded
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// FlushInterval is how often the usage recorded in memory is written to
+	// the database
+	FlushInterval time.Duration `mapstructure:"flush_interval" default:"1m"`
+	// Retention is how long the recorded usage is kept
+	Retention time.Duration `mapstructure:"retention" default:"720h"`
+}
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// PullRequestRetentionConfig is the configuration for keeping the pull
+// requests, and their evaluation history, after they are closed
+type PullRequestRetentionConfig struct {
+	// ClosedRetention is how long a closed pull request is kept. A pull
+	// request reopened within this window is evaluated again. Zero deletes
+	// pull requests as soon as they are closed.
+	ClosedRetention time.Duration `mapstructure:"closed_retention" default:"168h"`
+	// Interval is how often the expired pull requests are purged
+	Interval time.Duration `mapstructure:"interval" default:"1h"`
+	// BatchSize is the maximum number of pull requests purged at each interval
+	BatchSize int64 `mapstructure:"batch_size" default:"100"`
+}
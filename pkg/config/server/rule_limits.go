@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// RuleLimitsConfig is the configuration for the default execution limits of
+// rule evaluations. Rule types may set lower limits, but never higher ones.
+type RuleLimitsConfig struct {
+	// Timeout is the maximum wall-clock time of a rule evaluation, including
+	// data ingestion
+	Timeout time.Duration `mapstructure:"timeout" default:"5m"`
+	// MaxRegoMemoryBytes is the maximum size of the data a rego evaluation may
+	// hold, i.e. its input and the results of its data source calls
+	MaxRegoMemoryBytes int64 `mapstructure:"max_rego_memory_bytes" default:"104857600"`
+	// MaxDataSourceCalls is the maximum number of data source calls of a rego
+	// evaluation
+	MaxDataSourceCalls int64 `mapstructure:"max_data_source_calls" default:"100"`
+}
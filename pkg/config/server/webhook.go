@@ -19,6 +19,25 @@ type WebhookConfig struct {
 	ExternalWebhookURL string `mapstructure:"external_webhook_url"`
 	// ExternalPingURL is the URL that we will send our ping to
 	ExternalPingURL string `mapstructure:"external_ping_url"`
+	// Registry is the configuration for the webhooks sent by container registries
+	Registry RegistryWebhookConfig `mapstructure:"registry"`
+}
+
+// RegistryWebhookConfig is the configuration for the webhooks sent by
+// container registries other than GitHub, such as Harbor or Quay, when an
+// image is pushed
+type RegistryWebhookConfig struct {
+	// Enabled controls whether the registry webhook endpoint is served
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// Secret is the shared secret the registries send with their webhooks
+	Secret string `mapstructure:"secret"`
+	// SecretFile is the location of the file containing the shared secret
+	SecretFile string `mapstructure:"secret_file"`
+}
+
+// GetSecret returns the shared secret of the registry webhooks
+func (rc *RegistryWebhookConfig) GetSecret() (string, error) {
+	return fileOrArg(rc.SecretFile, rc.Secret, "registry webhook secret")
 }
 
 // WebhookSecrets is the configuration for the webhook secrets. this is useful
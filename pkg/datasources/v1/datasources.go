@@ -19,6 +19,9 @@ const (
 	DataSourceDriverStruct = "structured"
 	// DataSourceDriverRest is the driver type for a REST data source.
 	DataSourceDriverRest = "rest"
+	// DataSourceDriverDepsDev is the driver type for the deps.dev package
+	// reputation data source.
+	DataSourceDriverDepsDev = "deps_dev"
 )
 
 // DataSourceFuncKey is the key that uniquely identifies a data source function.
@@ -164,6 +164,56 @@ func NewErrEvaluationSkipSilently(sfmt string, args ...any) error {
 	return fmt.Errorf("%w: %s", ErrEvaluationSkipSilently, msg)
 }
 
+// ErrEvaluationExcepted specifies that the rule failed, but the failure is
+// covered by an approved exception for the entity.
+var ErrEvaluationExcepted = errors.New("evaluation excepted")
+
+// NewErrEvaluationExcepted creates a new evaluation error
+func NewErrEvaluationExcepted(sfmt string, args ...any) error {
+	msg := fmt.Sprintf(sfmt, args...)
+	return fmt.Errorf("%w: %s", ErrEvaluationExcepted, msg)
+}
+
+// ErrEvaluationSuppressed specifies that the rule failed, but the failure is
+// suppressed by an override of the rule for the entity.
+var ErrEvaluationSuppressed = errors.New("evaluation suppressed")
+
+// NewErrEvaluationSuppressed creates a new evaluation error
+func NewErrEvaluationSuppressed(sfmt string, args ...any) error {
+	msg := fmt.Sprintf(sfmt, args...)
+	return fmt.Errorf("%w: %s", ErrEvaluationSuppressed, msg)
+}
+
+// ErrEvaluationLimitExceeded specifies that the evaluation was stopped because
+// it exceeded one of the execution limits of the rule type.
+var ErrEvaluationLimitExceeded = errors.New("limit exceeded")
+
+// NewErrEvaluationLimitExceeded creates a new evaluation error
+func NewErrEvaluationLimitExceeded(sfmt string, args ...any) error {
+	msg := fmt.Sprintf(sfmt, args...)
+	return fmt.Errorf("%w: %s", ErrEvaluationLimitExceeded, msg)
+}
+
+// ErrProviderUnavailable specifies that the provider needed to evaluate the
+// rule could not be reached or failed to serve the request.
+var ErrProviderUnavailable = errors.New("provider unavailable")
+
+// ErrPolicyCompile specifies that the policy of the rule type could not be
+// compiled.
+var ErrPolicyCompile = errors.New("policy compile error")
+
+// ErrIngestionNotApplicable specifies that the data ingestion of the rule
+// type does not apply to the evaluated entity.
+var ErrIngestionNotApplicable = errors.New("ingestion not applicable")
+
+// ErrIngestionFailed specifies that the data needed for the evaluation could
+// not be ingested.
+var ErrIngestionFailed = errors.New("error ingesting data")
+
+// ErrInvalidRuleParameters specifies that the definition or parameters of the
+// rule are not valid for the rule type.
+var ErrInvalidRuleParameters = errors.New("invalid rule parameters")
+
 // ErrActionSkipped is an error code that indicates that the action was not performed at all because
 // the evaluation passed and the action was not needed
 var ErrActionSkipped = errors.New("action skipped")
@@ -205,6 +255,9 @@ type ActionsError struct {
 	RemediateMeta json.RawMessage
 	AlertErr      error
 	AlertMeta     json.RawMessage
+	// Deferred is true if an action was not executed because the actions
+	// are frozen. The errors and metadata are then the previous ones.
+	Deferred bool
 }
 
 var (
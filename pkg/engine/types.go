@@ -20,6 +20,14 @@ const (
 
 	// EvalStatusSkipped indicates the evaluation was skipped.
 	EvalStatusSkipped EvalStatus = "skipped"
+
+	// EvalStatusExcepted indicates the evaluation failed, but the failure is
+	// covered by an approved exception.
+	EvalStatusExcepted EvalStatus = "excepted"
+
+	// EvalStatusSuppressed indicates the evaluation failed, but the failure
+	// is suppressed by an override of the rule.
+	EvalStatusSuppressed EvalStatus = "suppressed"
 )
 
 // EvaluationSnapshot represents a database-independent snapshot of evaluation state.
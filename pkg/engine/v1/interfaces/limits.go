@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import "time"
+
+// Limits bound the resources used by a single rule evaluation. A zero value
+// means that the resource is not limited.
+type Limits struct {
+	// Timeout is the maximum wall-clock time of an evaluation, including
+	// data ingestion
+	Timeout time.Duration
+	// MaxRegoMemoryBytes is the maximum size of the data a rego evaluation
+	// may hold, i.e. its input and the results of its data source calls
+	MaxRegoMemoryBytes int64
+	// MaxDataSourceCalls is the maximum number of data source calls of a
+	// rego evaluation
+	MaxDataSourceCalls int64
+}
+
+// Tighten returns the lowest of both limits for each resource
+func (l Limits) Tighten(other Limits) Limits {
+	return Limits{
+		Timeout:            lowestLimit(l.Timeout, other.Timeout),
+		MaxRegoMemoryBytes: lowestLimit(l.MaxRegoMemoryBytes, other.MaxRegoMemoryBytes),
+		MaxDataSourceCalls: lowestLimit(l.MaxDataSourceCalls, other.MaxDataSourceCalls),
+	}
+}
+
+func lowestLimit[T ~int64](a, b T) T {
+	if a <= 0 {
+		return b
+	}
+	if b <= 0 || a < b {
+		return a
+	}
+	return b
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-git/go-git/v5"
 	"github.com/google/go-github/v63/github"
+	"golang.org/x/crypto/ssh"
 )
 
 // Provider is a slice of the github.com/mindersec/minder/pkg/providers/v1.Provider
@@ -28,6 +29,21 @@ type GitProvider interface {
 	//	FSAtRef(ctx context.Context, url string, ref string) (billy.Filesystem, plumbing.Hash, error)
 }
 
+// GitBranchResolver is implemented by git providers which can resolve the
+// head of a branch of a remote repository without cloning it.
+type GitBranchResolver interface {
+	// ResolveBranch returns the hash of the commit at the head of the branch.
+	ResolveBranch(ctx context.Context, url string, branch string) (string, error)
+}
+
+// GitSSHCloner is implemented by git providers which can clone repositories
+// over SSH with a deploy key of the project, for repositories which can't be
+// cloned with the credential of the provider.
+type GitSSHCloner interface {
+	// CloneWithSSHKey clones a git repository over SSH, authenticating with the given key.
+	CloneWithSSHKey(ctx context.Context, url string, branch string, key ssh.Signer) (*git.Repository, error)
+}
+
 // RESTProvider is a subset of the Provider interface used for REST API ingestion.
 type RESTProvider interface {
 	GetBaseURL() string
@@ -67,6 +83,36 @@ type GitHubListAndClone interface {
 	Clone(ctx context.Context, repoURL, ref string) (*git.Repository, error)
 }
 
+// GitHubCollaboratorLister is a subset of the Provider interface that is used to
+// list the collaborators and teams having access to a repository.
+type GitHubCollaboratorLister interface {
+	ListCollaborators(ctx context.Context, owner, repo, affiliation string) ([]*github.User, error)
+	ListRepositoryTeams(ctx context.Context, owner, repo string) ([]*github.Team, error)
+}
+
+// GitHubRepoCredentialsLister is a subset of the Provider interface that is used to
+// list the deploy keys and the Actions secrets metadata of a repository.
+type GitHubRepoCredentialsLister interface {
+	ListDeployKeys(ctx context.Context, owner, repo string) ([]*github.Key, error)
+	ListRepoSecrets(ctx context.Context, owner, repo string) ([]*github.Secret, error)
+}
+
+// GitHubRunnerLister is a subset of the Provider interface that is used to
+// list the self-hosted runners and runner groups of repositories and organizations.
+type GitHubRunnerLister interface {
+	ListRepoRunners(ctx context.Context, owner, repo string) ([]*github.Runner, error)
+	ListOrgRunnerGroups(ctx context.Context, org, visibleToRepo string) ([]*github.RunnerGroup, error)
+	ListRunnerGroupRunners(ctx context.Context, org string, groupID int64) ([]*github.Runner, error)
+}
+
+// GitHubEnvironmentLister is a subset of the Provider interface that is used to
+// list the deployment environments of a repository and their protection rules.
+type GitHubEnvironmentLister interface {
+	ListEnvironments(ctx context.Context, owner, repo string) ([]*github.Environment, error)
+	ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string) (
+		[]*github.DeploymentBranchPolicy, error)
+}
+
 // As is a type-cast function for Providers
 func As[T any](provider Provider) (T, error) {
 	result, ok := provider.(T)
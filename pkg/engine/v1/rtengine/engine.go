@@ -6,8 +6,10 @@ package rtengine
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime/debug"
+	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -15,6 +17,7 @@ import (
 	"github.com/mindersec/minder/internal/engine/eval"
 	"github.com/mindersec/minder/internal/engine/ingestcache"
 	"github.com/mindersec/minder/internal/engine/ingester"
+	eoptions "github.com/mindersec/minder/internal/engine/options"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	enginerr "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
@@ -52,6 +55,8 @@ type RuleTypeEngine struct {
 	ruletype *minderv1.RuleType
 
 	ingestCache ingestcache.Cache
+
+	limits interfaces.Limits
 }
 
 // NewRuleTypeEngine creates a new rule type engine
@@ -92,7 +97,7 @@ func NewRuleTypeEngine(
 		ingestCache:   ingestcache.NewNoopCache(),
 	}
 
-	return rte, nil
+	return rte.WithLimits(interfaces.Limits{}), nil
 }
 
 // WithIngesterCache sets the ingester cache for the rule type engine
@@ -101,6 +106,37 @@ func (r *RuleTypeEngine) WithIngesterCache(ingestCache ingestcache.Cache) *RuleT
 	return r
 }
 
+// WithLimits sets the execution limits of the rule type engine. The limits
+// of the rule type are tightened by the given defaults, so a rule type can
+// lower a limit but never raise it above the default.
+func (r *RuleTypeEngine) WithLimits(defaults interfaces.Limits) *RuleTypeEngine {
+	r.limits = defaults.Tighten(limitsFromPB(r.ruletype.GetDef().GetLimits()))
+	// Setting limits never fails
+	_ = eoptions.WithLimits(r.limits)(r.ruleEvaluator)
+	return r
+}
+
+// GetLimits returns the execution limits enforced by the rule type engine.
+func (r *RuleTypeEngine) GetLimits() interfaces.Limits {
+	return r.limits
+}
+
+func limitsFromPB(pb *minderv1.RuleType_Definition_Limits) interfaces.Limits {
+	if pb == nil {
+		return interfaces.Limits{}
+	}
+
+	// The timeout has been validated when the rule type was created, an
+	// unparsable value leaves the timeout to the defaults.
+	timeout, _ := time.ParseDuration(pb.GetTimeout())
+
+	return interfaces.Limits{
+		Timeout:            timeout,
+		MaxRegoMemoryBytes: pb.GetMaxRegoMemoryBytes(),
+		MaxDataSourceCalls: pb.GetMaxDataSourceCalls(),
+	}
+}
+
 // GetID returns the ID of the rule type. The ID is meant to be
 // a serializable unique identifier for the rule type.
 func (r *RuleTypeEngine) GetID() string {
@@ -143,16 +179,23 @@ func (r *RuleTypeEngine) Eval(
 	// rule definition.
 	if ruleDef != nil {
 		if err := r.ruleValidator.ValidateRuleDefAgainstSchema(ruleDef); err != nil {
-			return nil, fmt.Errorf("rule definition validation failed: %w", err)
+			return nil, fmt.Errorf("%w: rule definition validation failed: %w", enginerr.ErrInvalidRuleParameters, err)
 		}
 	}
 
 	if ruleParams != nil {
 		if err := r.ruleValidator.ValidateParamsAgainstSchema(ruleParams); err != nil {
-			return nil, fmt.Errorf("rule parameters validation failed: %w", err)
+			return nil, fmt.Errorf("%w: rule parameters validation failed: %w", enginerr.ErrInvalidRuleParameters, err)
 		}
 	}
 
+	parentCtx := ctx
+	if r.limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.limits.Timeout)
+		defer cancel()
+	}
+
 	logger.Info().Msg("entity evaluation - ingest started")
 	// Try looking at the ingesting cache first
 	ingestData, ok := r.ingestCache.Get(r.ingester, entity, ruleParams)
@@ -161,9 +204,12 @@ func (r *RuleTypeEngine) Eval(
 		// Ingest the data needed for the rule evaluation
 		ingestData, err = r.ingester.Ingest(ctx, entity, ruleParams)
 		if err != nil {
+			if r.timedOut(ctx, parentCtx) {
+				return nil, r.timeoutError()
+			}
 			// Ingesting failed, so we can't evaluate the rule.
 			// Note that for some types of ingesting the evalErr can already be set from the ingester.
-			return nil, fmt.Errorf("error ingesting data: %w", err)
+			return nil, fmt.Errorf("%w: %w", enginerr.ErrIngestionFailed, err)
 		}
 		r.ingestCache.Set(r.ingester, entity, ruleParams, ingestData)
 	} else {
@@ -176,9 +222,22 @@ func (r *RuleTypeEngine) Eval(
 	logger.Info().Msg("entity evaluation - evaluation started")
 	res, err := r.ruleEvaluator.Eval(ctx, ruleDef, entity, ingestData)
 	logger.Info().Msg("entity evaluation - evaluation completed")
+	if err != nil && r.timedOut(ctx, parentCtx) {
+		return nil, r.timeoutError()
+	}
 	return res, err
 }
 
+// timedOut returns true if the evaluation context ran out of time because of
+// the timeout of the rule type, rather than because of its parent context.
+func (*RuleTypeEngine) timedOut(ctx, parentCtx context.Context) bool {
+	return errors.Is(ctx.Err(), context.DeadlineExceeded) && parentCtx.Err() == nil
+}
+
+func (r *RuleTypeEngine) timeoutError() error {
+	return enginerr.NewErrEvaluationLimitExceeded("evaluation exceeded the timeout of %s", r.limits.Timeout)
+}
+
 // WithCustomIngester sets a custom ingester for the rule type engine. This is handy for testing
 // but should not be used in production.
 func (r *RuleTypeEngine) WithCustomIngester(ing interfaces.Ingester) *RuleTypeEngine {
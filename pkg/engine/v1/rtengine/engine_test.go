@@ -7,6 +7,7 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/mindersec/minder/internal/util/ptr"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 	tkv1 "github.com/mindersec/minder/pkg/testkit/v1"
 )
 
@@ -156,3 +158,73 @@ allow if {
 		})
 	}
 }
+
+func TestWithLimits(t *testing.T) {
+	t.Parallel()
+
+	defaults := interfaces.Limits{
+		Timeout:            5 * time.Minute,
+		MaxRegoMemoryBytes: 1024,
+		MaxDataSourceCalls: 10,
+	}
+
+	tests := []struct {
+		name   string
+		limits *minderv1.RuleType_Definition_Limits
+		want   interfaces.Limits
+	}{
+		{
+			name: "no rule type limits",
+			want: defaults,
+		},
+		{
+			name: "lower rule type limits",
+			limits: &minderv1.RuleType_Definition_Limits{
+				Timeout:            "30s",
+				MaxRegoMemoryBytes: 512,
+				MaxDataSourceCalls: 2,
+			},
+			want: interfaces.Limits{
+				Timeout:            30 * time.Second,
+				MaxRegoMemoryBytes: 512,
+				MaxDataSourceCalls: 2,
+			},
+		},
+		{
+			name: "higher rule type limits",
+			limits: &minderv1.RuleType_Definition_Limits{
+				Timeout:            "1h",
+				MaxRegoMemoryBytes: 4096,
+				MaxDataSourceCalls: 100,
+			},
+			want: defaults,
+		},
+		{
+			name: "partial rule type limits",
+			limits: &minderv1.RuleType_Definition_Limits{
+				MaxDataSourceCalls: 2,
+			},
+			want: interfaces.Limits{
+				Timeout:            5 * time.Minute,
+				MaxRegoMemoryBytes: 1024,
+				MaxDataSourceCalls: 2,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rte := &RuleTypeEngine{
+				ruletype: &minderv1.RuleType{
+					Def: &minderv1.RuleType_Definition{
+						Limits: tt.limits,
+					},
+				},
+			}
+
+			require.Equal(t, tt.want, rte.WithLimits(defaults).GetLimits())
+		})
+	}
+}
@@ -56,3 +56,16 @@ const (
 	// ReleaseCommitSHA represents the commit SHA of the release
 	ReleaseCommitSHA = "commit_sha"
 )
+
+// Ownership property keys. These are set by users rather than providers and
+// are used to route alerts to the team owning the entity.
+const (
+	// OwnerPropertyPrefix is the prefix of the ownership property keys
+	OwnerPropertyPrefix = "owner."
+	// PropertyOwnerTeam represents the name of the team owning the entity
+	PropertyOwnerTeam = "owner.team"
+	// PropertyOwnerContact represents the email address used to reach the owning team
+	PropertyOwnerContact = "owner.contact"
+	// PropertyOwnerEscalationChannel represents the channel (e.g. a chat room) alerts escalate to
+	PropertyOwnerEscalationChannel = "owner.escalation_channel"
+)
@@ -341,6 +341,12 @@ func (p *Properties) FilteredCopy(filter PropertyFilter) *Properties {
 	}
 }
 
+// IsOwnerProperty returns whether the key is an ownership property. These are
+// set by users, and are kept when the properties are refreshed from the provider.
+func IsOwnerProperty(key string, _ *Property) bool {
+	return strings.HasPrefix(key, OwnerPropertyPrefix)
+}
+
 // Merge merges two Properties into a new one
 func (p *Properties) Merge(other *Properties) *Properties {
 	if p == nil {
@@ -5,6 +5,7 @@
 package checkpoints
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 )
@@ -43,6 +44,10 @@ type CheckpointV1 struct {
 
 	// HTTPMethod is the HTTP method that was used to verify the entity.
 	HTTPMethod *string `json:"httpMethod,omitempty" yaml:"httpMethod,omitempty"`
+
+	// RuleDigest is the digest of the rule type, definition and parameters
+	// of the rule that was evaluated at the checkpoint.
+	RuleDigest *string `json:"ruleDigest,omitempty" yaml:"ruleDigest,omitempty"`
 }
 
 // NewCheckpointV1Now creates a new CheckpointV1 with the current time.
@@ -91,6 +96,39 @@ func (c *CheckpointEnvelopeV1) WithHTTP(url, method string) *CheckpointEnvelopeV
 	return c
 }
 
+// WithRuleDigest sets the rule digest on the checkpoint.
+func (c *CheckpointEnvelopeV1) WithRuleDigest(digest string) *CheckpointEnvelopeV1 {
+	c.Checkpoint.RuleDigest = &digest
+	return c
+}
+
+// GetCommitHash returns the commit hash of the checkpoint, or an empty
+// string if it is not set.
+func (c *CheckpointEnvelopeV1) GetCommitHash() string {
+	if c == nil || c.Checkpoint.CommitHash == nil {
+		return ""
+	}
+	return *c.Checkpoint.CommitHash
+}
+
+// GetBranch returns the branch of the checkpoint, or an empty string if
+// it is not set.
+func (c *CheckpointEnvelopeV1) GetBranch() string {
+	if c == nil || c.Checkpoint.Branch == nil {
+		return ""
+	}
+	return *c.Checkpoint.Branch
+}
+
+// GetRuleDigest returns the rule digest of the checkpoint, or an empty
+// string if it is not set.
+func (c *CheckpointEnvelopeV1) GetRuleDigest() string {
+	if c == nil || c.Checkpoint.RuleDigest == nil {
+		return ""
+	}
+	return *c.Checkpoint.RuleDigest
+}
+
 // ToJSON marshals the checkpoint to JSON.
 func (c *CheckpointEnvelopeV1) ToJSON() (json.RawMessage, error) {
 	return json.Marshal(c)
@@ -109,3 +147,31 @@ func (c *CheckpointEnvelopeV1) ToJSONorDefault(def json.RawMessage) (json.RawMes
 
 	return js, nil
 }
+
+// FromJSON unmarshals a checkpoint from JSON. An empty or null message
+// yields a nil checkpoint.
+func FromJSON(js json.RawMessage) (*CheckpointEnvelopeV1, error) {
+	var c *CheckpointEnvelopeV1
+	if len(js) == 0 {
+		return nil, nil
+	}
+	if err := json.Unmarshal(js, &c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+type previousCheckpointKey struct{}
+
+// WithPrevious returns a context carrying the checkpoint of the last
+// evaluation of the rule that is about to be evaluated.
+func WithPrevious(ctx context.Context, c *CheckpointEnvelopeV1) context.Context {
+	return context.WithValue(ctx, previousCheckpointKey{}, c)
+}
+
+// PreviousFromContext returns the checkpoint of the last evaluation of the
+// rule being evaluated, or nil if there is none.
+func PreviousFromContext(ctx context.Context) *CheckpointEnvelopeV1 {
+	c, _ := ctx.Value(previousCheckpointKey{}).(*CheckpointEnvelopeV1)
+	return c
+}
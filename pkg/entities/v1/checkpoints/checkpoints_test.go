@@ -5,6 +5,7 @@
 package checkpoints
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 	"time"
@@ -121,3 +122,68 @@ func TestCheckpointEnvelopeV1_UnmarshalJson(t *testing.T) {
 		})
 	}
 }
+
+func TestFromJSON(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2023, 7, 31, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected *CheckpointEnvelopeV1
+		wantErr  bool
+	}{
+		{
+			name:  "rule digest set",
+			input: `{"version":"v1","checkpoint":{"timestamp":"2023-07-31T12:00:00Z","commitHash":"abc123","branch":"main","ruleDigest":"def456"}}`,
+			expected: NewCheckpointV1(timestamp).
+				WithCommitHash("abc123").
+				WithBranch("main").
+				WithRuleDigest("def456"),
+		},
+		{
+			name:     "empty",
+			input:    ``,
+			expected: nil,
+		},
+		{
+			name:     "null",
+			input:    `null`,
+			expected: nil,
+		},
+		{
+			name:    "invalid",
+			input:   `{"version":`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := FromJSON(json.RawMessage(tt.input))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, output)
+			assert.Equal(t, tt.expected.GetCommitHash(), output.GetCommitHash())
+			assert.Equal(t, tt.expected.GetRuleDigest(), output.GetRuleDigest())
+		})
+	}
+}
+
+func TestPreviousFromContext(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, PreviousFromContext(context.Background()))
+
+	cp := NewCheckpointV1Now().WithCommitHash("abc123").WithBranch("main")
+	ctx := WithPrevious(context.Background(), cp)
+	require.Equal(t, cp, PreviousFromContext(ctx))
+	require.Equal(t, "abc123", PreviousFromContext(ctx).GetCommitHash())
+	require.Equal(t, "main", PreviousFromContext(ctx).GetBranch())
+}
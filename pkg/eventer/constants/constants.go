@@ -18,6 +18,8 @@ const (
 
 	DeadLetterQueueTopic = "dead_letter_queue"
 	PublishedKey         = "published_at"
+	// SchemaVersionKey is the version of the schema of the message payload
+	SchemaVersionKey = "schema_version"
 )
 
 const (
@@ -43,6 +45,9 @@ const (
 	TopicQueueReconcileEntityDelete = "internal.entity.delete.event"
 	// TopicQueueReconcileEntityAdd is the topic for reconciling when an entity is added
 	TopicQueueReconcileEntityAdd = "internal.entity.add.event"
+	// TopicQueueReconcileInstallation is the topic for reconciling when the permissions or
+	// the repositories of a provider installation change
+	TopicQueueReconcileInstallation = "internal.installation.reconcile.event"
 	// TopicQueueRepoReminder is the topic for repo reminder events
 	TopicQueueRepoReminder = "repo.reminder.event"
 )
@@ -8,6 +8,10 @@ const (
 	DockerHubProvider Experiment = "dockerhub_provider"
 	// GitLabProvider enables the GitLab provider.
 	GitLabProvider Experiment = "gitlab_provider"
+	// AzureDevOpsProvider enables the Azure DevOps provider.
+	AzureDevOpsProvider Experiment = "azure_devops_provider"
+	// PackageRegistryProviders enables the npm and PyPI package registry providers.
+	PackageRegistryProviders Experiment = "package_registry_providers"
 	// AlternateMessageDriver enables an an alternate message driver.
 	AlternateMessageDriver Experiment = "alternate_message_driver"
 	// ProjectCreateDelete enables creating top-level projects and deleting them.
@@ -17,4 +21,21 @@ const (
 	// RegoV1RefuseV0 rejects V0-only Rego when creating or updating rule
 	// types.
 	RegoV1RefuseV0 Experiment = "rego_v1_refuse_v0"
+	// ActionFreeze freezes the remediations and alerts, which are executed
+	// once the flag is turned off again. This is the manual counterpart of the
+	// freeze windows.
+	ActionFreeze Experiment = "action_freeze"
 )
+
+// Experiments lists all the feature flags.
+var Experiments = []Experiment{
+	DockerHubProvider,
+	GitLabProvider,
+	AzureDevOpsProvider,
+	PackageRegistryProviders,
+	AlternateMessageDriver,
+	ProjectCreateDelete,
+	AuthenticatedDataSources,
+	RegoV1RefuseV0,
+	ActionFreeze,
+}
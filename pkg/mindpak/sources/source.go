@@ -7,6 +7,7 @@ package sources
 import (
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/mindersec/minder/pkg/mindpak"
 	"github.com/mindersec/minder/pkg/mindpak/reader"
@@ -35,6 +36,20 @@ func NewSourceFromTarGZ(path string) (BundleSource, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to load bundle from %s: %w", path, err)
 	}
+	return newSingleBundleSource(bundle)
+}
+
+// NewSourceFromTarGZReader creates a singleBundleSource from a .tar.gz
+// stream
+func NewSourceFromTarGZReader(r io.Reader) (BundleSource, error) {
+	bundle, err := mindpak.NewBundleFromTarGZReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load bundle: %w", err)
+	}
+	return newSingleBundleSource(bundle)
+}
+
+func newSingleBundleSource(bundle *mindpak.Bundle) (BundleSource, error) {
 	if err := bundle.Verify(); err != nil {
 		return nil, fmt.Errorf("bundle failed verification: %w", err)
 	}
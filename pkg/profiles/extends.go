@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// maxExtendsDepth is the maximum length of a chain of profiles extending
+// each other
+const maxExtendsDepth = 5
+
+// PopulateExtends sets the names of the profiles extended by each of the
+// given profiles, which must have their ID set
+func PopulateExtends(ctx context.Context, qtx db.Querier, profiles ...*minderv1.Profile) error {
+	byID := make(map[uuid.UUID]*minderv1.Profile, len(profiles))
+	for _, p := range profiles {
+		id, err := uuid.Parse(p.GetId())
+		if err != nil {
+			return fmt.Errorf("invalid profile ID %q: %w", p.GetId(), err)
+		}
+		p.Extends = nil
+		byID[id] = p
+	}
+	if len(byID) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(byID))
+	for id := range byID {
+		ids = append(ids, id)
+	}
+	bases, err := qtx.ListProfileBases(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("error listing extended profiles: %w", err)
+	}
+
+	for _, base := range bases {
+		if p, ok := byID[base.ProfileID]; ok {
+			p.Extends = append(p.Extends, base.Name)
+		}
+	}
+	return nil
+}
+
+// resolveExtends returns a copy of the profile whose rules are merged with
+// the rules of the profiles it extends, recursively, along with the IDs of
+// the extended profiles. The profile is returned as-is if it does not extend
+// any profile. The chain holds the names of the profiles being resolved, to
+// detect cycles.
+func resolveExtends(
+	ctx context.Context,
+	qtx db.Querier,
+	projectID uuid.UUID,
+	profile *minderv1.Profile,
+	chain []string,
+) (*minderv1.Profile, []uuid.UUID, error) {
+	if len(profile.GetExtends()) == 0 {
+		return profile, nil, nil
+	}
+	if len(chain) > maxExtendsDepth {
+		return nil, nil, util.UserVisibleError(codes.InvalidArgument,
+			"profile %q extends profiles more than %d levels deep", chain[0], maxExtendsDepth)
+	}
+
+	merged := &minderv1.Profile{}
+	baseIDs := make([]uuid.UUID, 0, len(profile.GetExtends()))
+	for _, name := range profile.GetExtends() {
+		if slices.Contains(chain, name) {
+			return nil, nil, util.UserVisibleError(codes.InvalidArgument,
+				"profile %q cannot extend itself: %s", name, strings.Join(append(slices.Clone(chain), name), " -> "))
+		}
+
+		base, baseID, err := getProfilePBByName(ctx, qtx, projectID, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		base, _, err = resolveExtends(ctx, qtx, projectID, base, append(slices.Clone(chain), name))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		mergeProfileRules(merged, base)
+		baseIDs = append(baseIDs, baseID)
+	}
+	mergeProfileRules(merged, profile)
+
+	effective := proto.Clone(profile).(*minderv1.Profile)
+	for entity, rules := range profileRuleLists(merged) {
+		*profileRuleLists(effective)[entity] = *rules
+	}
+
+	return effective, baseIDs, nil
+}
+
+// resolveEffectiveProfile merges the rules of the profiles extended by the
+// profile, whose rule names must already be populated, and validates the
+// result. It returns the profile with the merged rules, the IDs of the
+// extended profiles and the mapping of the merged rules.
+func (p *profileService) resolveEffectiveProfile(
+	ctx context.Context,
+	qtx db.Querier,
+	projectID uuid.UUID,
+	profile *minderv1.Profile,
+	rules RuleMapping,
+) (*minderv1.Profile, []uuid.UUID, RuleMapping, error) {
+	effective, baseIDs, err := resolveExtends(ctx, qtx, projectID, profile, []string{profile.GetName()})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(baseIDs) == 0 {
+		return profile, nil, rules, nil
+	}
+
+	effectiveRules, err := p.validator.ValidateAndExtractRules(ctx, qtx, projectID, effective)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return effective, baseIDs, effectiveRules, nil
+}
+
+// refreshExtendingProfiles updates the rule instances of the profiles which
+// extend the given profile, directly or not, after its rules changed
+func (p *profileService) refreshExtendingProfiles(
+	ctx context.Context,
+	qtx db.Querier,
+	base *db.Profile,
+	depth int,
+) error {
+	extending, err := qtx.ListProfilesExtending(ctx, base.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error fetching profiles extending the profile: %v", err)
+	}
+	if len(extending) > 0 && depth > maxExtendsDepth {
+		return util.UserVisibleError(codes.InvalidArgument,
+			"profile %q is extended more than %d levels deep", base.Name, maxExtendsDepth)
+	}
+
+	for _, id := range extending {
+		profile, err := getProfilePBFromDB(ctx, id, base.ProjectID, qtx)
+		if err != nil {
+			return status.Errorf(codes.Internal, "error fetching profile extending the profile: %v", err)
+		}
+
+		effective, _, rules, err := p.resolveEffectiveProfile(ctx, qtx, base.ProjectID, profile, nil)
+		var violation *util.NiceStatus
+		if errors.As(err, &violation) && violation.Code == codes.InvalidArgument {
+			return util.UserVisibleError(codes.InvalidArgument,
+				"profile %q extending this profile would be invalid: %s", profile.GetName(), violation.Details)
+		} else if err != nil {
+			return err
+		}
+
+		dependent := &db.Profile{ID: id, Name: profile.GetName(), ProjectID: base.ProjectID}
+		if err := syncRuleInstances(ctx, qtx, dependent, effective, rules); err != nil {
+			return err
+		}
+		if err := p.refreshExtendingProfiles(ctx, qtx, dependent, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getProfilePBByName(
+	ctx context.Context,
+	qtx db.Querier,
+	projectID uuid.UUID,
+	name string,
+) (*minderv1.Profile, uuid.UUID, error) {
+	rows, err := qtx.GetProfileByProjectAndName(ctx, db.GetProfileByProjectAndNameParams{
+		ProjectID: projectID,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, uuid.Nil, fmt.Errorf("error getting extended profile %q: %w", name, err)
+	}
+	if len(rows) == 0 {
+		return nil, uuid.Nil, util.UserVisibleError(codes.InvalidArgument, "extended profile %q not found", name)
+	}
+
+	profile, ok := MergeDatabaseGetByNameIntoProfiles(rows)[name]
+	if !ok {
+		return nil, uuid.Nil, util.UserVisibleError(codes.InvalidArgument, "extended profile %q not found", name)
+	}
+	if err := PopulateExtends(ctx, qtx, profile); err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	return profile, rows[0].Profile.ID, nil
+}
+
+// mergeProfileRules overlays the rules of the profile on top of the rules
+// already in the merged profile
+func mergeProfileRules(merged, profile *minderv1.Profile) {
+	overlay := profileRuleLists(profile)
+	for entity, rules := range profileRuleLists(merged) {
+		*rules = mergeRules(*rules, *overlay[entity])
+	}
+}
+
+// mergeRules overlays the rules on top of the base rules. A rule replaces the
+// base rule of the same type and name, other rules are appended.
+func mergeRules(base, overlay []*minderv1.Profile_Rule) []*minderv1.Profile_Rule {
+	merged := slices.Clone(base)
+	for _, rule := range overlay {
+		idx := slices.IndexFunc(merged, func(r *minderv1.Profile_Rule) bool {
+			return r.GetType() == rule.GetType() && strings.EqualFold(r.GetName(), rule.GetName())
+		})
+		if idx >= 0 {
+			merged[idx] = rule
+		} else {
+			merged = append(merged, rule)
+		}
+	}
+	return merged
+}
+
+// profileRuleLists returns references to the rule lists of the profile for
+// each entity
+func profileRuleLists(p *minderv1.Profile) map[minderv1.Entity]*[]*minderv1.Profile_Rule {
+	return map[minderv1.Entity]*[]*minderv1.Profile_Rule{
+		minderv1.Entity_ENTITY_REPOSITORIES:       &p.Repository,
+		minderv1.Entity_ENTITY_ARTIFACTS:          &p.Artifact,
+		minderv1.Entity_ENTITY_BUILD_ENVIRONMENTS: &p.BuildEnvironment,
+		minderv1.Entity_ENTITY_PULL_REQUESTS:      &p.PullRequest,
+		minderv1.Entity_ENTITY_RELEASE:            &p.Release,
+		minderv1.Entity_ENTITY_PIPELINE_RUN:       &p.PipelineRun,
+		minderv1.Entity_ENTITY_TASK_RUN:           &p.TaskRun,
+		minderv1.Entity_ENTITY_BUILD:              &p.Build,
+		minderv1.Entity_ENTITY_ORGANIZATION:       &p.Organization,
+	}
+}
+
+// storeBases records the profiles extended by the profile, replacing the
+// previous ones
+func storeBases(ctx context.Context, qtx db.Querier, profileID uuid.UUID, baseIDs []uuid.UUID) error {
+	if err := qtx.DeleteProfileBases(ctx, profileID); err != nil {
+		return fmt.Errorf("error deleting extended profiles: %w", err)
+	}
+	for i, baseID := range baseIDs {
+		if err := qtx.CreateProfileBase(ctx, db.CreateProfileBaseParams{
+			ProfileID:     profileID,
+			BaseProfileID: baseID,
+			//nolint:gosec // G115, the number of bases is bounded by validation
+			Position: int32(i),
+		}); err != nil {
+			return fmt.Errorf("error storing extended profile: %w", err)
+		}
+	}
+	return nil
+}
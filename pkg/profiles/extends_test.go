@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestMergeRules(t *testing.T) {
+	t.Parallel()
+
+	base := []*minderv1.Profile_Rule{
+		{Type: "secret_scanning"},
+		{Type: "branch_protection", Name: "main"},
+	}
+	overlay := []*minderv1.Profile_Rule{
+		{Type: "branch_protection", Name: "MAIN"},
+		{Type: "branch_protection", Name: "release"},
+	}
+
+	merged := mergeRules(base, overlay)
+	require.Equal(t, []*minderv1.Profile_Rule{base[0], overlay[0], overlay[1]}, merged)
+	// the base rules are left untouched
+	require.Equal(t, "main", base[1].GetName())
+}
+
+func TestResolveExtends(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	baseID := uuid.New()
+
+	tests := []struct {
+		name          string
+		profile       *minderv1.Profile
+		setup         func(store *mockdb.MockStore)
+		expectedRules []string
+		expectedError string
+	}{
+		{
+			name: "profile without extends is returned as-is",
+			profile: &minderv1.Profile{
+				Name:       "team",
+				Repository: []*minderv1.Profile_Rule{{Type: "a", Name: "a"}},
+			},
+			setup:         func(_ *mockdb.MockStore) {},
+			expectedRules: []string{"a"},
+		},
+		{
+			name: "rules override and extend the base rules",
+			profile: &minderv1.Profile{
+				Name:       "team",
+				Extends:    []string{"base"},
+				Repository: []*minderv1.Profile_Rule{{Type: "b", Name: "b"}, {Type: "c", Name: "c"}},
+			},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProfileByProjectAndName(gomock.Any(), db.GetProfileByProjectAndNameParams{
+					ProjectID: projectID,
+					Name:      "base",
+				}).Return(profileRows(t, baseID, "base", "a", "b"), nil)
+				store.EXPECT().ListProfileBases(gomock.Any(), []uuid.UUID{baseID}).
+					Return([]db.ListProfileBasesRow{}, nil)
+			},
+			expectedRules: []string{"a", "b", "c"},
+		},
+		{
+			name: "cycles are rejected",
+			profile: &minderv1.Profile{
+				Name:    "team",
+				Extends: []string{"base"},
+			},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProfileByProjectAndName(gomock.Any(), gomock.Any()).
+					Return(profileRows(t, baseID, "base", "a"), nil)
+				store.EXPECT().ListProfileBases(gomock.Any(), []uuid.UUID{baseID}).
+					Return([]db.ListProfileBasesRow{{ProfileID: baseID, Name: "team"}}, nil)
+			},
+			expectedError: `profile "team" cannot extend itself: team -> base -> team`,
+		},
+		{
+			name: "missing base profile",
+			profile: &minderv1.Profile{
+				Name:    "team",
+				Extends: []string{"base"},
+			},
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProfileByProjectAndName(gomock.Any(), gomock.Any()).
+					Return([]db.GetProfileByProjectAndNameRow{}, nil)
+			},
+			expectedError: `extended profile "base" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			tt.setup(store)
+
+			effective, _, err := resolveExtends(
+				context.Background(), store, projectID, tt.profile, []string{tt.profile.GetName()})
+			if tt.expectedError != "" {
+				require.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(effective.GetRepository()))
+			for _, rule := range effective.GetRepository() {
+				names = append(names, rule.GetName())
+			}
+			require.Equal(t, tt.expectedRules, names)
+			require.Equal(t, tt.profile.GetName(), effective.GetName())
+		})
+	}
+}
+
+func profileRows(t *testing.T, id uuid.UUID, name string, ruleNames ...string) []db.GetProfileByProjectAndNameRow {
+	t.Helper()
+
+	rules := make([]*minderv1.Profile_Rule, 0, len(ruleNames))
+	for _, ruleName := range ruleNames {
+		rules = append(rules, &minderv1.Profile_Rule{Type: ruleName, Name: ruleName})
+	}
+	contextualRules, err := json.Marshal(rules)
+	require.NoError(t, err)
+
+	return []db.GetProfileByProjectAndNameRow{{
+		Profile: db.Profile{ID: id, Name: name},
+		ProfilesWithEntityProfile: db.ProfilesWithEntityProfile{
+			Entity:          db.NullEntities{Entities: db.EntitiesRepository, Valid: true},
+			ContextualRules: pqtype.NullRawMessage{RawMessage: contextualRules, Valid: true},
+		},
+	}}
+}
@@ -7,6 +7,9 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"maps"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -22,6 +25,9 @@ type ProfileAggregate struct {
 	ActionConfig ActionConfiguration
 	Rules        []RuleInstance
 	Selectors    []ProfileSelector
+	// AlertGroupingWindow is the window within which the alerts raised for
+	// the same entity are grouped. Zero means that alerts are not grouped.
+	AlertGroupingWindow time.Duration
 }
 
 // ActionConfiguration stores the configuration state for a profile
@@ -37,6 +43,82 @@ type RuleInstance struct {
 	Def        map[string]any
 	Params     map[string]any
 	RuleTypeID uuid.UUID
+	Overrides  []RuleOverride
+	// Suppressed is set on rule instances resolved for an entity whose
+	// overrides suppress the failures of the rule.
+	Suppressed bool
+	// Disabled is set on rule instances which were disabled without being
+	// removed from the profile. Disabled rules are not evaluated.
+	Disabled bool
+	// DisabledReason is the reason given when disabling the rule instance
+	DisabledReason string
+	// CanaryPercentage is the share of the entities a canary rule is
+	// evaluated on. It is zero for rules which are not canaries.
+	CanaryPercentage int32
+}
+
+// IsCanary returns true if the rule instance is a canary
+func (r *RuleInstance) IsCanary() bool {
+	return r.CanaryPercentage > 0
+}
+
+// Samples returns true if a canary rule is evaluated on the entity. The
+// sample is deterministic: the same entities are evaluated each time, and
+// raising the percentage only adds entities to the sample. Rules which are
+// not canaries sample every entity.
+func (r *RuleInstance) Samples(entityID uuid.UUID) bool {
+	if !r.IsCanary() {
+		return true
+	}
+	h := fnv.New32a()
+	// writing to a hash never fails
+	_, _ = h.Write(r.ID[:])
+	_, _ = h.Write(entityID[:])
+	return int32(h.Sum32()%100) < r.CanaryPercentage
+}
+
+// RuleOverride is a domain-level model of the override of a rule instance
+// for a specific entity
+type RuleOverride struct {
+	Entity   string         `json:"entity"`
+	Def      map[string]any `json:"def,omitempty"`
+	Params   map[string]any `json:"params,omitempty"`
+	Suppress bool           `json:"suppress,omitempty"`
+}
+
+// ForEntity returns the rule instance to evaluate against the named entity,
+// with the matching overrides merged over its definition and parameters.
+// Top-level keys of the overrides replace the ones of the rule, and any
+// matching override with suppress set marks the resolved rule as Suppressed.
+// The rule instance is returned as-is if no override applies to the entity.
+func (r *RuleInstance) ForEntity(entityName string) *RuleInstance {
+	resolved := r
+	for _, o := range r.Overrides {
+		if o.Entity != entityName {
+			continue
+		}
+		if resolved == r {
+			cp := *r
+			cp.Def = maps.Clone(r.Def)
+			cp.Params = maps.Clone(r.Params)
+			resolved = &cp
+		}
+		resolved.Def = mergeOverride(resolved.Def, o.Def)
+		resolved.Params = mergeOverride(resolved.Params, o.Params)
+		resolved.Suppressed = resolved.Suppressed || o.Suppress
+	}
+	return resolved
+}
+
+func mergeOverride(base, override map[string]any) map[string]any {
+	if len(override) == 0 {
+		return base
+	}
+	if base == nil {
+		base = make(map[string]any, len(override))
+	}
+	maps.Copy(base, override)
+	return base
 }
 
 // ProfileSelector is a domain-level model of a profile selector
@@ -51,14 +133,30 @@ func RuleFromPB(
 	pbRule *minderv1.Profile_Rule,
 ) RuleInstance {
 	return RuleInstance{
-		ID:         uuid.Nil, // When converting from PB, we do not care about this value
-		Name:       pbRule.Name,
-		Def:        pbRule.Def.AsMap(),
-		Params:     pbRule.Params.AsMap(),
-		RuleTypeID: ruleTypeID,
+		ID:               uuid.Nil, // When converting from PB, we do not care about this value
+		Name:             pbRule.Name,
+		Def:              pbRule.Def.AsMap(),
+		Params:           pbRule.Params.AsMap(),
+		RuleTypeID:       ruleTypeID,
+		Overrides:        OverridesFromPB(pbRule.GetOverrides()),
+		CanaryPercentage: pbRule.GetCanary().GetPercentage(),
 	}
 }
 
+// OverridesFromPB converts the protobuf overrides of a rule to the domain model
+func OverridesFromPB(pbOverrides []*minderv1.Profile_Rule_Override) []RuleOverride {
+	overrides := make([]RuleOverride, 0, len(pbOverrides))
+	for _, o := range pbOverrides {
+		overrides = append(overrides, RuleOverride{
+			Entity:   o.GetEntity(),
+			Def:      o.GetDef().AsMap(),
+			Params:   o.GetParams().AsMap(),
+			Suppress: o.GetSuppress(),
+		})
+	}
+	return overrides
+}
+
 // RuleFromDB converts a DB schema rule instance to the domain model
 func RuleFromDB(rule db.RuleInstance) (RuleInstance, error) {
 	// deserialize the defs/params
@@ -72,12 +170,23 @@ func RuleFromDB(rule db.RuleInstance) (RuleInstance, error) {
 		return RuleInstance{}, fmt.Errorf("unable to deserialize rule params: %w", err)
 	}
 
+	var overrides []RuleOverride
+	if len(rule.Overrides) > 0 {
+		if err := json.Unmarshal(rule.Overrides, &overrides); err != nil {
+			return RuleInstance{}, fmt.Errorf("unable to deserialize rule overrides: %w", err)
+		}
+	}
+
 	return RuleInstance{
-		ID:         rule.ID,
-		Name:       rule.Name,
-		Def:        def,
-		Params:     params,
-		RuleTypeID: rule.RuleTypeID,
+		ID:               rule.ID,
+		Name:             rule.Name,
+		Def:              def,
+		Params:           params,
+		RuleTypeID:       rule.RuleTypeID,
+		Overrides:        overrides,
+		Disabled:         rule.DisabledAt.Valid,
+		DisabledReason:   rule.DisabledReason.String,
+		CanaryPercentage: rule.CanaryPercentage.Int32,
 	}, nil
 }
 
@@ -4,8 +4,10 @@
 package models_test
 
 import (
+	"database/sql"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -152,6 +154,49 @@ func TestRuleFromDB(t *testing.T) {
 				Params:     map[string]any{},
 			},
 		},
+		{
+			name: "valid rule with overrides",
+			dbRule: db.RuleInstance{
+				ID:         ruleID,
+				Name:       "overridden-rule",
+				RuleTypeID: ruleTypeID,
+				Def:        json.RawMessage(`{"count": 2}`),
+				Params:     json.RawMessage(`{}`),
+				Overrides:  json.RawMessage(`[{"entity": "acme/legacy", "def": {"count": 1}}]`),
+			},
+			expected: models.RuleInstance{
+				ID:         ruleID,
+				Name:       "overridden-rule",
+				RuleTypeID: ruleTypeID,
+				Def:        map[string]any{"count": float64(2)},
+				Params:     map[string]any{},
+				Overrides: []models.RuleOverride{
+					{Entity: "acme/legacy", Def: map[string]any{"count": float64(1)}},
+				},
+			},
+		},
+		{
+			name: "disabled rule",
+			dbRule: db.RuleInstance{
+				ID:             ruleID,
+				Name:           "disabled-rule",
+				RuleTypeID:     ruleTypeID,
+				Def:            json.RawMessage(`{}`),
+				Params:         json.RawMessage(`{}`),
+				DisabledAt:     sql.NullTime{Time: time.Now(), Valid: true},
+				DisabledBy:     sql.NullString{String: "alice", Valid: true},
+				DisabledReason: sql.NullString{String: "too noisy", Valid: true},
+			},
+			expected: models.RuleInstance{
+				ID:             ruleID,
+				Name:           "disabled-rule",
+				RuleTypeID:     ruleTypeID,
+				Def:            map[string]any{},
+				Params:         map[string]any{},
+				Disabled:       true,
+				DisabledReason: "too noisy",
+			},
+		},
 		{
 			name: "invalid def JSON returns error",
 			dbRule: db.RuleInstance{
@@ -174,6 +219,18 @@ func TestRuleFromDB(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "invalid overrides JSON returns error",
+			dbRule: db.RuleInstance{
+				ID:         ruleID,
+				Name:       "bad-overrides",
+				RuleTypeID: ruleTypeID,
+				Def:        json.RawMessage(`{}`),
+				Params:     json.RawMessage(`{}`),
+				Overrides:  json.RawMessage(`{}`),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -193,10 +250,116 @@ func TestRuleFromDB(t *testing.T) {
 			require.Equal(t, tt.expected.Def, result.Def)
 			require.Equal(t, tt.expected.Params, result.Params)
 			require.Equal(t, tt.expected.RuleTypeID, result.RuleTypeID)
+			require.Equal(t, tt.expected.Overrides, result.Overrides)
+			require.Equal(t, tt.expected.Disabled, result.Disabled)
+			require.Equal(t, tt.expected.DisabledReason, result.DisabledReason)
 		})
 	}
 }
 
+func TestRuleInstanceForEntity(t *testing.T) {
+	t.Parallel()
+
+	rule := models.RuleInstance{
+		Name:   "reviews",
+		Def:    map[string]any{"count": 2, "dismiss_stale": true},
+		Params: map[string]any{"branch": "main"},
+		Overrides: []models.RuleOverride{
+			{Entity: "acme/legacy", Def: map[string]any{"count": 1}},
+			{Entity: "acme/legacy", Params: map[string]any{"branch": "master"}},
+			{Entity: "acme/other", Def: map[string]any{"count": 3}},
+			{Entity: "acme/fork", Suppress: true},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		entity             string
+		expectedDef        map[string]any
+		expectedParams     map[string]any
+		expectedSuppressed bool
+	}{
+		{
+			name:           "entity without overrides",
+			entity:         "acme/widgets",
+			expectedDef:    map[string]any{"count": 2, "dismiss_stale": true},
+			expectedParams: map[string]any{"branch": "main"},
+		},
+		{
+			name:           "overrides are merged in order",
+			entity:         "acme/legacy",
+			expectedDef:    map[string]any{"count": 1, "dismiss_stale": true},
+			expectedParams: map[string]any{"branch": "master"},
+		},
+		{
+			name:           "only the overrides of the entity apply",
+			entity:         "acme/other",
+			expectedDef:    map[string]any{"count": 3, "dismiss_stale": true},
+			expectedParams: map[string]any{"branch": "main"},
+		},
+		{
+			name:               "overrides can suppress failures",
+			entity:             "acme/fork",
+			expectedDef:        map[string]any{"count": 2, "dismiss_stale": true},
+			expectedParams:     map[string]any{"branch": "main"},
+			expectedSuppressed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resolved := rule.ForEntity(tt.entity)
+			require.Equal(t, tt.expectedDef, resolved.Def)
+			require.Equal(t, tt.expectedParams, resolved.Params)
+			require.Equal(t, tt.expectedSuppressed, resolved.Suppressed)
+			require.Equal(t, rule.Name, resolved.Name)
+
+			// the rule instance itself is never modified
+			require.Equal(t, map[string]any{"count": 2, "dismiss_stale": true}, rule.Def)
+			require.Equal(t, map[string]any{"branch": "main"}, rule.Params)
+			require.False(t, rule.Suppressed)
+		})
+	}
+}
+
+func TestRuleInstanceSamples(t *testing.T) {
+	t.Parallel()
+
+	ruleID := uuid.New()
+	entityIDs := make([]uuid.UUID, 1000)
+	for i := range entityIDs {
+		entityIDs[i] = uuid.New()
+	}
+
+	sampled := func(percentage int32) map[uuid.UUID]bool {
+		rule := models.RuleInstance{ID: ruleID, CanaryPercentage: percentage}
+		out := make(map[uuid.UUID]bool)
+		for _, id := range entityIDs {
+			if rule.Samples(id) {
+				out[id] = true
+			}
+		}
+		return out
+	}
+
+	// rules which are not canaries are evaluated on every entity
+	require.Len(t, sampled(0), len(entityIDs))
+	require.Len(t, sampled(100), len(entityIDs))
+
+	// the sample is roughly the requested share of the entities
+	ten := sampled(10)
+	require.InDelta(t, 100, len(ten), 50)
+
+	// the sample is stable, and grows with the percentage
+	require.Equal(t, ten, sampled(10))
+	fifty := sampled(50)
+	for id := range ten {
+		require.True(t, fifty[id])
+	}
+}
+
 func TestActionOptFromDB(t *testing.T) {
 	t.Parallel()
 
@@ -4,9 +4,11 @@
 package profiles
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/mindersec/minder/internal/util/schemavalidate"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -48,14 +50,44 @@ func NewRuleValidator(rt *minderv1.RuleType) (*RuleValidator, error) {
 	}, nil
 }
 
+// ValidateRule validates the definition and the parameters of a profile rule
+// against the schemas of this rule type, and fills the schema defaults into
+// the rule so that they are stored along with it. The values violating the
+// schemas are reported with their JSON pointer, e.g. /def/branch.
+func (r *RuleValidator) ValidateRule(rule *minderv1.Profile_Rule) error {
+	def := rule.GetDef().AsMap()
+	if err := r.ValidateRuleDefAgainstSchema(def); err != nil {
+		return err
+	}
+
+	params := rule.GetParams().AsMap()
+	if err := r.ValidateParamsAgainstSchema(params); err != nil {
+		return err
+	}
+
+	defStruct, err := structpb.NewStruct(def)
+	if err != nil {
+		return fmt.Errorf("error converting rule definition: %w", err)
+	}
+	rule.Def = defStruct
+
+	// leave the params unset unless the rule or the schema defines some
+	if len(params) > 0 {
+		paramsStruct, err := structpb.NewStruct(params)
+		if err != nil {
+			return fmt.Errorf("error converting rule parameters: %w", err)
+		}
+		rule.Params = paramsStruct
+	}
+
+	return nil
+}
+
 // ValidateRuleDefAgainstSchema validates the given contextual profile against the
 // schema for this rule type
 func (r *RuleValidator) ValidateRuleDefAgainstSchema(contextualProfile map[string]any) error {
 	if err := schemavalidate.ValidateAgainstSchema(r.schema, contextualProfile); err != nil {
-		return &RuleValidationError{
-			RuleType: r.ruleTypeName,
-			Err:      err.Error(),
-		}
+		return r.validationError(err, "/def")
 	}
 	schemavalidate.ApplyDefaults(r.schema, contextualProfile)
 	return nil
@@ -68,11 +100,27 @@ func (r *RuleValidator) ValidateParamsAgainstSchema(params map[string]any) error
 		return nil
 	}
 	if err := schemavalidate.ValidateAgainstSchema(r.paramSchema, params); err != nil {
+		return r.validationError(err, "/params")
+	}
+	schemavalidate.ApplyDefaults(r.paramSchema, params)
+	return nil
+}
+
+// validationError attributes a schema validation error to the rule type,
+// pointing the violations at the given section of the rule
+func (r *RuleValidator) validationError(err error, section string) *RuleValidationError {
+	var verr *schemavalidate.ValidationError
+	if !errors.As(err, &verr) {
 		return &RuleValidationError{
 			RuleType: r.ruleTypeName,
 			Err:      err.Error(),
 		}
 	}
-	schemavalidate.ApplyDefaults(r.paramSchema, params)
-	return nil
+
+	verr = verr.WithPrefix(section)
+	return &RuleValidationError{
+		RuleType: r.ruleTypeName,
+		Err:      verr.Error(),
+		Fields:   verr.Fields,
+	}
 }
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/profiles"
@@ -149,3 +150,85 @@ func TestExampleRulesAreValidatedCorrectly(t *testing.T) {
 	})
 	require.NoError(t, err, "failed to walk rule types directory")
 }
+
+func TestValidateRule(t *testing.T) {
+	t.Parallel()
+
+	rtstr := `
+---
+version: v1
+type: rule-type
+name: foo
+context:
+  provider: github
+def:
+  in_entity: repository
+  rule_schema:
+    type: object
+    properties:
+      enabled:
+        type: boolean
+      retention_days:
+        type: integer
+        default: 5
+      branch/name:
+        type: string
+  param_schema:
+    type: object
+    properties:
+      branch:
+        type: string
+      depth:
+        type: integer
+        default: 1
+    required:
+      - branch
+`
+
+	rt := &minderv1.RuleType{}
+	require.NoError(t, minderv1.ParseResource(strings.NewReader(rtstr), rt), "failed to parse rule type")
+
+	rval, err := profiles.NewRuleValidator(rt)
+	require.NoError(t, err, "failed to create rule validator")
+
+	t.Run("defaults are filled into the rule", func(t *testing.T) {
+		t.Parallel()
+
+		def, err := structpb.NewStruct(map[string]any{"enabled": true})
+		require.NoError(t, err)
+		params, err := structpb.NewStruct(map[string]any{"branch": "main"})
+		require.NoError(t, err)
+		rule := &minderv1.Profile_Rule{Type: "foo", Def: def, Params: params}
+
+		require.NoError(t, rval.ValidateRule(rule))
+		require.Equal(t, map[string]any{"enabled": true, "retention_days": float64(5)}, rule.GetDef().AsMap())
+		require.Equal(t, map[string]any{"branch": "main", "depth": float64(1)}, rule.GetParams().AsMap())
+	})
+
+	t.Run("violations point at the invalid fields", func(t *testing.T) {
+		t.Parallel()
+
+		def, err := structpb.NewStruct(map[string]any{"enabled": "yes", "branch/name": 1})
+		require.NoError(t, err)
+		rule := &minderv1.Profile_Rule{Type: "foo", Def: def}
+
+		err = rval.ValidateRule(rule)
+		var violation *profiles.RuleValidationError
+		require.ErrorAs(t, err, &violation)
+		require.Equal(t, "foo", violation.RuleType)
+
+		paths := make([]string, 0, len(violation.Fields))
+		for _, f := range violation.Fields {
+			paths = append(paths, f.Path)
+		}
+		require.ElementsMatch(t, []string{"/def/enabled", "/def/branch~1name"}, paths)
+
+		// the definition is valid, so the params are checked
+		rule.Def = &structpb.Struct{}
+		err = rval.ValidateRule(rule)
+		require.ErrorAs(t, err, &violation)
+		require.Len(t, violation.Fields, 1)
+		require.Equal(t, "/params/branch", violation.Fields[0].Path)
+		require.Contains(t, violation.Err, "/params/branch: missing property 'branch'")
+	})
+}
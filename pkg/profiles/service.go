@@ -14,13 +14,16 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
+	"github.com/mindersec/minder/internal/auth"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/entities"
 	"github.com/mindersec/minder/internal/logger"
 	"github.com/mindersec/minder/internal/marketplaces/namespaces"
+	"github.com/mindersec/minder/internal/projects/features"
 	"github.com/mindersec/minder/internal/reconcilers"
 	"github.com/mindersec/minder/internal/util"
 	"github.com/mindersec/minder/internal/util/ptr"
@@ -28,6 +31,7 @@ import (
 	"github.com/mindersec/minder/pkg/engine/selectors"
 	"github.com/mindersec/minder/pkg/eventer/constants"
 	"github.com/mindersec/minder/pkg/eventer/interfaces"
+	"github.com/mindersec/minder/pkg/profiles/models"
 )
 
 //go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
@@ -111,6 +115,11 @@ func (p *profileService) CreateProfile(
 		return nil, err
 	}
 
+	ruleLimit, err := features.NewRuleLimit(ctx, qtx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error checking project tier: %v", err)
+	}
+
 	if err = namespaces.ValidateNamespacedNameRules(profile.GetName(), subscriptionID); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "name failed namespace validation: %v", err)
 	}
@@ -122,6 +131,13 @@ func (p *profileService) CreateProfile(
 	// Adds default rule names, if not present
 	PopulateRuleNames(profile, rulesInProf)
 
+	// The rules of the extended profiles are instantiated along with the
+	// rules of the profile
+	effective, baseIDs, effectiveRules, err := p.resolveEffectiveProfile(ctx, qtx, projectID, profile, rulesInProf)
+	if err != nil {
+		return nil, err
+	}
+
 	displayName := profile.GetDisplayName()
 
 	listParams := db.ListProfilesByProjectIDAndLabelParams{
@@ -151,6 +167,8 @@ func (p *profileService) CreateProfile(
 		Remediate:      db.ValidateRemediateType(profile.GetRemediate()),
 		Alert:          db.ValidateAlertType(profile.GetAlert()),
 		SubscriptionID: uuid.NullUUID{UUID: subscriptionID, Valid: subscriptionID != uuid.Nil},
+		// the window was checked when validating the profile
+		AlertGroupingWindow: alertGroupingWindowSeconds(profile),
 	}
 
 	// Create profile
@@ -164,6 +182,7 @@ func (p *profileService) CreateProfile(
 	}
 
 	// Create entity rules entries
+	effectiveLists := profileRuleLists(effective)
 	for ent, entRules := range map[minderv1.Entity][]*minderv1.Profile_Rule{
 		minderv1.Entity_ENTITY_REPOSITORIES:       profile.GetRepository(),
 		minderv1.Entity_ENTITY_ARTIFACTS:          profile.GetArtifact(),
@@ -173,16 +192,29 @@ func (p *profileService) CreateProfile(
 		minderv1.Entity_ENTITY_PIPELINE_RUN:       profile.GetPipelineRun(),
 		minderv1.Entity_ENTITY_TASK_RUN:           profile.GetTaskRun(),
 		minderv1.Entity_ENTITY_BUILD:              profile.GetBuild(),
+		minderv1.Entity_ENTITY_ORGANIZATION:       profile.GetOrganization(),
 	} {
-		if err := createProfileRulesForEntity(ctx, ent, &newProfile, qtx, entRules, rulesInProf); err != nil {
+		if err := createProfileRulesForEntity(
+			ctx, ent, &newProfile, qtx, entRules, *effectiveLists[ent], effectiveRules,
+		); err != nil {
 			return nil, err
 		}
 	}
 
+	if len(baseIDs) > 0 {
+		if err := storeBases(ctx, qtx, newProfile.ID, baseIDs); err != nil {
+			return nil, status.Errorf(codes.Internal, "error creating profile: %v", err)
+		}
+	}
+
 	if err := p.createSelectors(ctx, newProfile.ID, qtx, profile.GetSelection()); err != nil {
 		return nil, err
 	}
 
+	if err := checkRuleLimit(ctx, qtx, projectID, ruleLimit); err != nil {
+		return nil, err
+	}
+
 	logger.BusinessRecord(ctx).Profile = logger.Profile{Name: profile.Name, ID: newProfile.ID}
 	p.sendNewProfileEvent(ctx, projectID)
 
@@ -212,6 +244,11 @@ func (p *profileService) UpdateProfile(
 		return nil, err
 	}
 
+	ruleLimit, err := features.NewRuleLimit(ctx, qtx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error checking project tier: %v", err)
+	}
+
 	// Get object and ensure we lock it for update
 	oldDBProfile, err := getProfileFromPBForUpdateWithQuerier(ctx, profile, projectID, qtx)
 	if err != nil {
@@ -234,6 +271,19 @@ func (p *profileService) UpdateProfile(
 	// Adds default rule names, if not present
 	PopulateRuleNames(profile, rules)
 
+	// The rules of the extended profiles are instantiated along with the
+	// rules of the profile
+	effective, baseIDs, effectiveRules, err := p.resolveEffectiveProfile(ctx, qtx, projectID, profile, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the definition being replaced, so that the update can be
+	// investigated and rolled back
+	if err := recordProfileVersion(ctx, qtx, oldDBProfile.ID, projectID); err != nil {
+		return nil, status.Errorf(codes.Internal, "error recording profile version: %v", err)
+	}
+
 	displayName := profile.GetDisplayName()
 	// if empty use the name
 	if displayName == "" {
@@ -248,6 +298,8 @@ func (p *profileService) UpdateProfile(
 		Labels:      profile.GetLabels(),
 		Remediate:   db.ValidateRemediateType(profile.GetRemediate()),
 		Alert:       db.ValidateAlertType(profile.GetAlert()),
+		// the window was checked when validating the profile
+		AlertGroupingWindow: alertGroupingWindowSeconds(profile),
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error updating profile: %v", err)
@@ -263,40 +315,34 @@ func (p *profileService) UpdateProfile(
 		minderv1.Entity_ENTITY_PIPELINE_RUN:       profile.GetPipelineRun(),
 		minderv1.Entity_ENTITY_TASK_RUN:           profile.GetTaskRun(),
 		minderv1.Entity_ENTITY_BUILD:              profile.GetBuild(),
+		minderv1.Entity_ENTITY_ORGANIZATION:       profile.GetOrganization(),
 	} {
 		if err = updateProfileRulesForEntity(ctx, ent, &updatedProfile, qtx, entRules); err != nil {
 			return nil, err
 		}
+	}
 
-		updatedIDs, err := upsertRuleInstances(
-			ctx,
-			qtx,
-			updatedProfile.ID,
-			updatedProfile.ProjectID,
-			entRules,
-			entities.EntityTypeToDB(ent),
-			rules,
-		)
-		if err != nil {
-			return nil, err
-		}
+	if err := syncRuleInstances(ctx, qtx, &updatedProfile, effective, effectiveRules); err != nil {
+		return nil, err
+	}
 
-		// Any rule which was not updated was deleted from the profile.
-		// Remove from the database as well.
-		err = qtx.DeleteNonUpdatedRules(ctx, db.DeleteNonUpdatedRulesParams{
-			ProfileID:  updatedProfile.ID,
-			EntityType: entities.EntityTypeToDB(ent),
-			UpdatedIds: updatedIDs,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("error while cleaning up rule instances: %w", err)
-		}
+	if err := storeBases(ctx, qtx, updatedProfile.ID, baseIDs); err != nil {
+		return nil, status.Errorf(codes.Internal, "error updating profile: %v", err)
+	}
+
+	// the profiles extending this one inherit the updated rules
+	if err := p.refreshExtendingProfiles(ctx, qtx, &updatedProfile, 1); err != nil {
+		return nil, err
 	}
 
 	if err := p.updateSelectors(ctx, updatedProfile.ID, qtx, profile.GetSelection()); err != nil {
 		return nil, err
 	}
 
+	if err := checkRuleLimit(ctx, qtx, projectID, ruleLimit); err != nil {
+		return nil, err
+	}
+
 	logger.BusinessRecord(ctx).Profile = logger.Profile{Name: updatedProfile.Name, ID: updatedProfile.ID}
 
 	profile.Id = ptr.Ptr(updatedProfile.ID.String())
@@ -404,6 +450,15 @@ func (*profileService) DeleteProfile(
 		return nil, status.Errorf(codes.InvalidArgument, "cannot delete profile from bundle")
 	}
 
+	extending, err := qtx.ListProfilesExtending(ctx, dbProfile.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error fetching profiles extending the profile: %s", err)
+	}
+	if len(extending) > 0 {
+		return nil, util.UserVisibleError(codes.FailedPrecondition,
+			"profile %q is extended by %d other profiles", dbProfile.Name, len(extending))
+	}
+
 	err = qtx.DeleteProfile(ctx, db.DeleteProfileParams{
 		ProjectID: projectID,
 		ID:        dbProfile.ID,
@@ -416,6 +471,18 @@ func (*profileService) DeleteProfile(
 }
 
 // this is NOT a generic function, it only works because our Profiles only contain repeated or scalars.
+// checkRuleLimit checks the rules of the project, once the profile was
+// written, against the limit of the project tier
+func checkRuleLimit(ctx context.Context, qtx db.Querier, projectID uuid.UUID, ruleLimit *features.RuleLimit) error {
+	if err := ruleLimit.Check(ctx, qtx, projectID); err != nil {
+		if st := features.UpgradeStatus(err); st != nil {
+			return st
+		}
+		return status.Errorf(codes.Internal, "error checking rules of the project: %v", err)
+	}
+	return nil
+}
+
 func copyFieldValue(dstReflect, srcReflect protoreflect.Message, fieldDesc protoreflect.FieldDescriptor) {
 	if fieldDesc.Cardinality() == protoreflect.Repeated {
 		srcList := srcReflect.Get(fieldDesc).List()
@@ -440,23 +507,26 @@ func createProfileRulesForEntity(
 	profile *db.Profile,
 	qtx db.Querier,
 	rules []*minderv1.Profile_Rule,
+	instances []*minderv1.Profile_Rule,
 	rulesInProf RuleMapping,
 ) error {
-	if rules == nil {
-		return nil
+	if len(instances) > 0 {
+		_, err := upsertRuleInstances(
+			ctx,
+			qtx,
+			profile.ID,
+			profile.ProjectID,
+			instances,
+			entities.EntityTypeToDB(entity),
+			rulesInProf,
+		)
+		if err != nil {
+			return fmt.Errorf("error while creating rule instances: %w", err)
+		}
 	}
 
-	_, err := upsertRuleInstances(
-		ctx,
-		qtx,
-		profile.ID,
-		profile.ProjectID,
-		rules,
-		entities.EntityTypeToDB(entity),
-		rulesInProf,
-	)
-	if err != nil {
-		return fmt.Errorf("error while creating rule instances: %w", err)
+	if rules == nil {
+		return nil
 	}
 
 	marshalled, err := json.Marshal(rules)
@@ -566,6 +636,27 @@ func validateProfileUpdate(
 }
 
 // TODO: de-dupe
+// recordProfileVersion stores the current definition of the profile as a new
+// version, along with the identity of the user updating it
+func recordProfileVersion(ctx context.Context, qtx db.Querier, profileID uuid.UUID, projectID uuid.UUID) error {
+	current, err := getProfilePBFromDB(ctx, profileID, projectID, qtx)
+	if err != nil {
+		return fmt.Errorf("error getting profile: %w", err)
+	}
+
+	definition, err := protojson.Marshal(current)
+	if err != nil {
+		return fmt.Errorf("error marshalling profile: %w", err)
+	}
+
+	_, err = qtx.CreateProfileVersion(ctx, db.CreateProfileVersionParams{
+		ProfileID:  profileID,
+		Definition: definition,
+		Author:     auth.IdentityFromContext(ctx).String(),
+	})
+	return err
+}
+
 func getProfilePBFromDB(
 	ctx context.Context,
 	id uuid.UUID,
@@ -589,6 +680,9 @@ func getProfilePBFromDB(
 
 	// This should be only one profile
 	for _, profile := range pols {
+		if err := PopulateExtends(ctx, querier, profile); err != nil {
+			return nil, err
+		}
 		return profile, nil
 	}
 
@@ -627,6 +721,44 @@ func updateProfileRulesForEntity(
 	return err
 }
 
+// syncRuleInstances replaces the rule instances of the profile with the rules
+// of the given profile definition
+func syncRuleInstances(
+	ctx context.Context,
+	qtx db.Querier,
+	profile *db.Profile,
+	definition *minderv1.Profile,
+	rules RuleMapping,
+) error {
+	for ent, entRules := range profileRuleLists(definition) {
+		updatedIDs, err := upsertRuleInstances(
+			ctx,
+			qtx,
+			profile.ID,
+			profile.ProjectID,
+			*entRules,
+			entities.EntityTypeToDB(ent),
+			rules,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Any rule which was not updated was deleted from the profile.
+		// Remove from the database as well.
+		err = qtx.DeleteNonUpdatedRules(ctx, db.DeleteNonUpdatedRulesParams{
+			ProfileID:  profile.ID,
+			EntityType: entities.EntityTypeToDB(ent),
+			UpdatedIds: updatedIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("error while cleaning up rule instances: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func upsertRuleInstances(
 	ctx context.Context,
 	qtx db.Querier,
@@ -657,6 +789,11 @@ func upsertRuleInstances(
 			return nil, fmt.Errorf("unable to serialize rule params: %w", err)
 		}
 
+		overrides, err := json.Marshal(models.OverridesFromPB(rule.GetOverrides()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize rule overrides: %w", err)
+		}
+
 		id, err := qtx.UpsertRuleInstance(ctx, db.UpsertRuleInstanceParams{
 			ProfileID: profileID,
 			// TODO: Make non nullable in future PR
@@ -666,6 +803,11 @@ func upsertRuleInstances(
 			EntityType: entityType,
 			Def:        def,
 			Params:     params,
+			Overrides:  overrides,
+			CanaryPercentage: sql.NullInt32{
+				Int32: rule.GetCanary().GetPercentage(),
+				Valid: rule.GetCanary() != nil,
+			},
 		})
 		if err != nil {
 			return nil, fmt.Errorf("unable to insert new rule instance: %w", err)
@@ -6,6 +6,7 @@ package profiles
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/exp/maps"
@@ -90,8 +91,9 @@ func (p *profileStore) GetProfilesForEvaluation(
 				Remediate: models.ActionOptFromDB(profile.Profile.Remediate),
 				Alert:     models.ActionOptFromDB(profile.Profile.Alert),
 			},
-			Rules:     profileRules,
-			Selectors: models.SelectorSliceFromDB(profile.ProfilesWithSelectors),
+			Rules:               profileRules,
+			Selectors:           models.SelectorSliceFromDB(profile.ProfilesWithSelectors),
+			AlertGroupingWindow: time.Duration(profile.Profile.AlertGroupingWindow) * time.Second,
 		}
 		aggregates = append(aggregates, aggregate)
 	}
@@ -6,10 +6,12 @@ package profiles
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/sqlc-dev/pqtype"
@@ -18,6 +20,7 @@ import (
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/entities"
 	"github.com/mindersec/minder/internal/util/jsonyaml"
+	"github.com/mindersec/minder/internal/util/schemavalidate"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/fileconvert"
 )
@@ -34,6 +37,9 @@ type RuleValidationError struct {
 	Err string
 	// RuleType is a rule name
 	RuleType string
+	// Fields lists the values of the rule which violate the schemas of the
+	// rule type, as JSON pointers relative to the rule
+	Fields []schemavalidate.FieldError
 }
 
 // String implements fmt.Stringer
@@ -101,6 +107,8 @@ func GetRulesForEntity(p *pb.Profile, entity pb.Entity) ([]*pb.Profile_Rule, err
 		return p.TaskRun, nil
 	case pb.Entity_ENTITY_BUILD:
 		return p.Build, nil
+	case pb.Entity_ENTITY_ORGANIZATION:
+		return p.Organization, nil
 	case pb.Entity_ENTITY_UNSPECIFIED:
 		return nil, fmt.Errorf("entity type unspecified")
 	default:
@@ -119,6 +127,7 @@ func TraverseRuleTypesForEntities(p *pb.Profile, fn func(pb.Entity, *pb.Profile_
 		pb.Entity_ENTITY_PIPELINE_RUN:       p.PipelineRun,
 		pb.Entity_ENTITY_TASK_RUN:           p.TaskRun,
 		pb.Entity_ENTITY_BUILD:              p.Build,
+		pb.Entity_ENTITY_ORGANIZATION:       p.Organization,
 	}
 
 	for entity, rules := range pairs {
@@ -163,7 +172,13 @@ func TraverseAllRulesForPipeline(p *pb.Profile, fn func(*pb.Profile_Rule) error)
 func TraverseRules(rules []*pb.Profile_Rule, fn func(*pb.Profile_Rule) error) error {
 	for _, rule := range rules {
 		if err := fn(rule); err != nil {
-			return &RuleValidationError{err.Error(), rule.GetType()}
+			violation := &RuleValidationError{Err: err.Error(), RuleType: rule.GetType()}
+			// keep the schema violations of the rule, if any
+			var inner *RuleValidationError
+			if errors.As(err, &inner) {
+				violation.Fields = inner.Fields
+			}
+			return violation
 		}
 	}
 
@@ -214,6 +229,8 @@ func MergeDatabaseListIntoProfiles[T db.ProfileRow](ppl []T) map[string]*pb.Prof
 				newProfile.Alert = proto.String(string(db.ActionTypeOn))
 			}
 
+			newProfile.AlertGrouping = alertGroupingToPB(p.GetProfile().AlertGroupingWindow)
+
 			selectorsToProfile(newProfile, p.GetSelectors())
 
 			profiles[profileID] = newProfile
@@ -375,9 +392,32 @@ func dbProfileToPB(p db.Profile) *pb.Profile {
 		outprof.Alert = proto.String(string(db.ActionTypeOn))
 	}
 
+	outprof.AlertGrouping = alertGroupingToPB(p.AlertGroupingWindow)
+
 	return outprof
 }
 
+// alertGroupingToPB returns the alert grouping of a profile from the window
+// stored in the database, in seconds. Nil is returned if alerts are not grouped.
+func alertGroupingToPB(windowSeconds int64) *pb.Profile_AlertGrouping {
+	if windowSeconds <= 0 {
+		return nil
+	}
+	return &pb.Profile_AlertGrouping{
+		Window: (time.Duration(windowSeconds) * time.Second).String(),
+	}
+}
+
+// alertGroupingWindowSeconds returns the alert grouping window of a validated
+// profile in seconds, as stored in the database
+func alertGroupingWindowSeconds(profile *pb.Profile) int64 {
+	window, err := profile.GetAlertGrouping().ParseWindow()
+	if err != nil {
+		return 0
+	}
+	return int64(window / time.Second)
+}
+
 // rowInfoToProfileMap adds the database row information to the given map of
 // profiles. This assumes that the profiles belong to the same project.
 // Note that this function is thought to be called from scpecific Merge functions
@@ -425,6 +465,8 @@ func rowInfoToProfileMap(
 		profile.TaskRun = ruleset
 	case pb.Entity_ENTITY_BUILD:
 		profile.Build = ruleset
+	case pb.Entity_ENTITY_ORGANIZATION:
+		profile.Organization = ruleset
 	case pb.Entity_ENTITY_UNSPECIFIED:
 		// This shouldn't happen
 		log.Printf("unknown entity found in database: %s", entity)
@@ -20,6 +20,7 @@ import (
 	"github.com/mindersec/minder/internal/util"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/engine/selectors"
+	"github.com/mindersec/minder/pkg/profiles/models"
 	"github.com/mindersec/minder/pkg/ruletypes"
 )
 
@@ -95,7 +96,7 @@ func (*Validator) validateRuleParams(
 	// track them in the db later.
 	rulesInProfile := make(RuleMapping)
 
-	// Allows a profile to use rules from parent projects
+	// Allows a profile to use rules shared by parent projects, and global ones
 	projects, err := qtx.GetParentProjects(ctx, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting parent projects: %w", err)
@@ -103,8 +104,9 @@ func (*Validator) validateRuleParams(
 
 	err = TraverseAllRulesForPipeline(prof, func(profileRule *minderv1.Profile_Rule) error {
 		ruleType, err := qtx.GetRuleTypeByName(ctx, db.GetRuleTypeByNameParams{
-			Projects: projects,
-			Name:     profileRule.GetType(),
+			Projects:      projects,
+			Name:          profileRule.GetType(),
+			IncludeGlobal: true,
 		})
 
 		if err != nil {
@@ -128,12 +130,27 @@ func (*Validator) validateRuleParams(
 			return fmt.Errorf("error creating rule validator: %w", err)
 		}
 
-		if err := ruleValidator.ValidateRuleDefAgainstSchema(profileRule.Def.AsMap()); err != nil {
+		// this also fills the schema defaults into the rule, so that they
+		// are stored with the rule instance
+		if err := ruleValidator.ValidateRule(profileRule); err != nil {
 			return fmt.Errorf("error validating rule: %w", err)
 		}
 
-		if err := ruleValidator.ValidateParamsAgainstSchema(profileRule.GetParams().AsMap()); err != nil {
-			return fmt.Errorf("error validating rule params: %w", err)
+		// the overrides must yield a valid rule for their entity
+		base := models.RuleFromPB(ruleType.ID, profileRule)
+		for _, o := range base.Overrides {
+			resolved := base.ForEntity(o.Entity)
+			err := ruleValidator.ValidateRuleDefAgainstSchema(resolved.Def)
+			if err == nil {
+				err = ruleValidator.ValidateParamsAgainstSchema(resolved.Params)
+			}
+			var violation *RuleValidationError
+			if errors.As(err, &violation) {
+				violation.Err = fmt.Sprintf("override for entity %s: %s", o.Entity, violation.Err)
+			}
+			if err != nil {
+				return fmt.Errorf("error validating rule override: %w", err)
+			}
 		}
 
 		key := RuleTypeAndNamePair{
@@ -167,6 +184,7 @@ func validateRuleNames(profile *minderv1.Profile) error {
 		minderv1.Entity_ENTITY_PIPELINE_RUN:       profile.GetPipelineRun(),
 		minderv1.Entity_ENTITY_TASK_RUN:           profile.GetTaskRun(),
 		minderv1.Entity_ENTITY_BUILD:              profile.GetBuild(),
+		minderv1.Entity_ENTITY_ORGANIZATION:       profile.GetOrganization(),
 	} {
 		if err := validateRuleNamesForEntity(ent, entRules); err != nil {
 			return err
@@ -287,8 +305,9 @@ func validateEntities(
 	// validate that the entities in the profile match the entities in the project
 	err = TraverseRuleTypesForEntities(profile, func(entity minderv1.Entity, rule *minderv1.Profile_Rule) error {
 		ruleType, err := qtx.GetRuleTypeByName(ctx, db.GetRuleTypeByNameParams{
-			Projects: projects,
-			Name:     rule.GetType(),
+			Projects:      projects,
+			Name:          rule.GetType(),
+			IncludeGlobal: true,
 		})
 
 		if err != nil {
@@ -98,6 +98,12 @@ func TestValidatorScenarios(t *testing.T) {
 			DBSetup:       dbReturnsRuleType,
 			ExpectedError: "error validating rule",
 		},
+		{
+			Name:          "Validator reports the path of invalid rule params",
+			Profile:       makeProfile(withBasicProfileData, withRules(makeRule(withRuleDefs))),
+			DBSetup:       dbReturnsRuleType,
+			ExpectedError: "/params/branch: missing property 'branch'",
+		},
 		{
 			Name:           "Validator accepts well-formed profile",
 			Profile:        makeProfile(withBasicProfileData, withRules(makeRule(withRuleDefs, withRuleParams))),
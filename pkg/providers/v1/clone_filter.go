@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"path"
+	"strings"
+)
+
+// CloneFilter narrows down what the clones of a git provider fetch and check
+// out, for callers which only need part of a repository.
+type CloneFilter struct {
+	// MaxBytes limits the size of the clone below the limit of the provider.
+	// Zero means the limit of the provider.
+	MaxBytes int64
+	// SkipBinaries leaves binary files out of the checked out files.
+	SkipBinaries bool
+	// SparsePaths are the only directories or files which are checked out.
+	// All of them are checked out when empty.
+	SparsePaths []string
+}
+
+// FiltersCheckout returns true when the filter leaves files of the
+// repository out of the checkout.
+func (f *CloneFilter) FiltersCheckout() bool {
+	return f != nil && (f.SkipBinaries || len(f.SparsePaths) > 0)
+}
+
+// InSparsePaths returns true when the file with the given path, relative to
+// the root of the repository, is within the sparse paths of the filter.
+func (f *CloneFilter) InSparsePaths(name string) bool {
+	if f == nil || len(f.SparsePaths) == 0 {
+		return true
+	}
+	name = path.Clean("/" + name)
+	for _, p := range f.SparsePaths {
+		p = path.Clean("/" + p)
+		if p == "/" || name == p || strings.HasPrefix(name, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+type cloneFilterKey struct{}
+
+// WithCloneFilter returns a context through which the clones of git
+// providers are narrowed down with the given filter.
+func WithCloneFilter(ctx context.Context, f *CloneFilter) context.Context {
+	return context.WithValue(ctx, cloneFilterKey{}, f)
+}
+
+// CloneFilterFromContext returns the clone filter of the context, or nil if
+// there is none.
+func CloneFilterFromContext(ctx context.Context) *CloneFilter {
+	f, _ := ctx.Value(cloneFilterKey{}).(*CloneFilter)
+	return f
+}
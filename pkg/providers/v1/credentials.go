@@ -7,7 +7,9 @@
 package v1
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -60,3 +62,27 @@ type GitLabCredential interface {
 	GitCredential
 	OAuth2TokenCredential
 }
+
+// AzureDevOpsCredential is the interface for credentials used when interacting with Azure DevOps
+type AzureDevOpsCredential interface {
+	RestCredential
+	GitCredential
+	OAuth2TokenCredential
+}
+
+// CredentialProbe is the result of probing the credential of a provider
+type CredentialProbe struct {
+	// Scopes are the scopes granted to the credential, if reported by the provider
+	Scopes []string
+	// ExpiresAt is the time at which the credential expires, zero if it
+	// doesn't expire or the provider doesn't report it
+	ExpiresAt time.Time
+}
+
+// CredentialProber is implemented by the providers which can verify their
+// credential with a lightweight authenticated API call
+type CredentialProber interface {
+	// ProbeCredential makes an authenticated API call and returns what the
+	// provider reported about the credential
+	ProbeCredential(ctx context.Context) (*CredentialProbe, error)
+}
@@ -602,6 +602,22 @@ func (mr *MockRepoListerMockRecorder) ListAllRepositories(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRepositories", reflect.TypeOf((*MockRepoLister)(nil).ListAllRepositories), arg0)
 }
 
+// ListRepositoriesPage mocks base method.
+func (m *MockRepoLister) ListRepositoriesPage(ctx context.Context, cursor string) ([]*v10.Repository, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoriesPage", ctx, cursor)
+	ret0, _ := ret[0].([]*v10.Repository)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRepositoriesPage indicates an expected call of ListRepositoriesPage.
+func (mr *MockRepoListerMockRecorder) ListRepositoriesPage(ctx, cursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoriesPage", reflect.TypeOf((*MockRepoLister)(nil).ListRepositoriesPage), ctx, cursor)
+}
+
 // PropertiesToProtoMessage mocks base method.
 func (m *MockRepoLister) PropertiesToProtoMessage(entType v10.Entity, props *properties.Properties) (protoreflect.ProtoMessage, error) {
 	m.ctrl.T.Helper()
@@ -1307,6 +1323,176 @@ func (mr *MockIssuePublisherMockRecorder) SupportsEntity(entType any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockIssuePublisher)(nil).SupportsEntity), entType)
 }
 
+// MockCodeScanningUploader is a mock of CodeScanningUploader interface.
+type MockCodeScanningUploader struct {
+	ctrl     *gomock.Controller
+	recorder *MockCodeScanningUploaderMockRecorder
+	isgomock struct{}
+}
+
+// MockCodeScanningUploaderMockRecorder is the mock recorder for MockCodeScanningUploader.
+type MockCodeScanningUploaderMockRecorder struct {
+	mock *MockCodeScanningUploader
+}
+
+// NewMockCodeScanningUploader creates a new mock instance.
+func NewMockCodeScanningUploader(ctrl *gomock.Controller) *MockCodeScanningUploader {
+	mock := &MockCodeScanningUploader{ctrl: ctrl}
+	mock.recorder = &MockCodeScanningUploaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCodeScanningUploader) EXPECT() *MockCodeScanningUploaderMockRecorder {
+	return m.recorder
+}
+
+// CreationOptions mocks base method.
+func (m *MockCodeScanningUploader) CreationOptions(entType v10.Entity) *v11.EntityCreationOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreationOptions", entType)
+	ret0, _ := ret[0].(*v11.EntityCreationOptions)
+	return ret0
+}
+
+// CreationOptions indicates an expected call of CreationOptions.
+func (mr *MockCodeScanningUploaderMockRecorder) CreationOptions(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreationOptions", reflect.TypeOf((*MockCodeScanningUploader)(nil).CreationOptions), entType)
+}
+
+// DeregisterEntity mocks base method.
+func (m *MockCodeScanningUploader) DeregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterEntity indicates an expected call of DeregisterEntity.
+func (mr *MockCodeScanningUploaderMockRecorder) DeregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterEntity", reflect.TypeOf((*MockCodeScanningUploader)(nil).DeregisterEntity), ctx, entType, props)
+}
+
+// FetchAllProperties mocks base method.
+func (m *MockCodeScanningUploader) FetchAllProperties(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, cachedProps *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllProperties", ctx, getByProps, entType, cachedProps)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAllProperties indicates an expected call of FetchAllProperties.
+func (mr *MockCodeScanningUploaderMockRecorder) FetchAllProperties(ctx, getByProps, entType, cachedProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllProperties", reflect.TypeOf((*MockCodeScanningUploader)(nil).FetchAllProperties), ctx, getByProps, entType, cachedProps)
+}
+
+// GetBranchHead mocks base method.
+func (m *MockCodeScanningUploader) GetBranchHead(ctx context.Context, owner, repo, branch string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBranchHead", ctx, owner, repo, branch)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBranchHead indicates an expected call of GetBranchHead.
+func (mr *MockCodeScanningUploaderMockRecorder) GetBranchHead(ctx, owner, repo, branch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranchHead", reflect.TypeOf((*MockCodeScanningUploader)(nil).GetBranchHead), ctx, owner, repo, branch)
+}
+
+// GetEntityName mocks base method.
+func (m *MockCodeScanningUploader) GetEntityName(entType v10.Entity, props *properties.Properties) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityName", entType, props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityName indicates an expected call of GetEntityName.
+func (mr *MockCodeScanningUploaderMockRecorder) GetEntityName(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityName", reflect.TypeOf((*MockCodeScanningUploader)(nil).GetEntityName), entType, props)
+}
+
+// PropertiesToProtoMessage mocks base method.
+func (m *MockCodeScanningUploader) PropertiesToProtoMessage(entType v10.Entity, props *properties.Properties) (protoreflect.ProtoMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PropertiesToProtoMessage", entType, props)
+	ret0, _ := ret[0].(protoreflect.ProtoMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PropertiesToProtoMessage indicates an expected call of PropertiesToProtoMessage.
+func (mr *MockCodeScanningUploaderMockRecorder) PropertiesToProtoMessage(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropertiesToProtoMessage", reflect.TypeOf((*MockCodeScanningUploader)(nil).PropertiesToProtoMessage), entType, props)
+}
+
+// ProviderClassInfo mocks base method.
+func (m *MockCodeScanningUploader) ProviderClassInfo() *v10.ProviderClassInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProviderClassInfo")
+	ret0, _ := ret[0].(*v10.ProviderClassInfo)
+	return ret0
+}
+
+// ProviderClassInfo indicates an expected call of ProviderClassInfo.
+func (mr *MockCodeScanningUploaderMockRecorder) ProviderClassInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProviderClassInfo", reflect.TypeOf((*MockCodeScanningUploader)(nil).ProviderClassInfo))
+}
+
+// RegisterEntity mocks base method.
+func (m *MockCodeScanningUploader) RegisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterEntity indicates an expected call of RegisterEntity.
+func (mr *MockCodeScanningUploaderMockRecorder) RegisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterEntity", reflect.TypeOf((*MockCodeScanningUploader)(nil).RegisterEntity), ctx, entType, props)
+}
+
+// SupportsEntity mocks base method.
+func (m *MockCodeScanningUploader) SupportsEntity(entType v10.Entity) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsEntity", entType)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsEntity indicates an expected call of SupportsEntity.
+func (mr *MockCodeScanningUploaderMockRecorder) SupportsEntity(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockCodeScanningUploader)(nil).SupportsEntity), entType)
+}
+
+// UploadSarif mocks base method.
+func (m *MockCodeScanningUploader) UploadSarif(ctx context.Context, owner, repo string, analysis *github.SarifAnalysis) (*github.SarifID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadSarif", ctx, owner, repo, analysis)
+	ret0, _ := ret[0].(*github.SarifID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadSarif indicates an expected call of UploadSarif.
+func (mr *MockCodeScanningUploaderMockRecorder) UploadSarif(ctx, owner, repo, analysis any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadSarif", reflect.TypeOf((*MockCodeScanningUploader)(nil).UploadSarif), ctx, owner, repo, analysis)
+}
+
 // MockGitHub is a mock of GitHub interface.
 type MockGitHub struct {
 	ctrl     *gomock.Controller
@@ -1508,6 +1694,20 @@ func (mr *MockGitHubMockRecorder) CreationOptions(entType any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreationOptions", reflect.TypeOf((*MockGitHub)(nil).CreationOptions), entType)
 }
 
+// DeleteDeployKey mocks base method.
+func (m *MockGitHub) DeleteDeployKey(ctx context.Context, owner, repo string, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeployKey", ctx, owner, repo, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeployKey indicates an expected call of DeleteDeployKey.
+func (mr *MockGitHubMockRecorder) DeleteDeployKey(ctx, owner, repo, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeployKey", reflect.TypeOf((*MockGitHub)(nil).DeleteDeployKey), ctx, owner, repo, id)
+}
+
 // DeleteHook mocks base method.
 func (m *MockGitHub) DeleteHook(ctx context.Context, owner, repo string, id int64) error {
 	m.ctrl.T.Helper()
@@ -1581,6 +1781,20 @@ func (mr *MockGitHubMockRecorder) EditHook(ctx, owner, repo, id, hook any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditHook", reflect.TypeOf((*MockGitHub)(nil).EditHook), ctx, owner, repo, id, hook)
 }
 
+// EnablePullRequestAutoMerge mocks base method.
+func (m *MockGitHub) EnablePullRequestAutoMerge(ctx context.Context, nodeID, mergeMethod string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnablePullRequestAutoMerge", ctx, nodeID, mergeMethod)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnablePullRequestAutoMerge indicates an expected call of EnablePullRequestAutoMerge.
+func (mr *MockGitHubMockRecorder) EnablePullRequestAutoMerge(ctx, nodeID, mergeMethod any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnablePullRequestAutoMerge", reflect.TypeOf((*MockGitHub)(nil).EnablePullRequestAutoMerge), ctx, nodeID, mergeMethod)
+}
+
 // FetchAllProperties mocks base method.
 func (m *MockGitHub) FetchAllProperties(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, cachedProps *properties.Properties) (*properties.Properties, error) {
 	m.ctrl.T.Helper()
@@ -1847,6 +2061,66 @@ func (mr *MockGitHubMockRecorder) ListAllRepositories(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRepositories", reflect.TypeOf((*MockGitHub)(nil).ListAllRepositories), arg0)
 }
 
+// ListCollaborators mocks base method.
+func (m *MockGitHub) ListCollaborators(ctx context.Context, owner, repo, affiliation string) ([]*github.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCollaborators", ctx, owner, repo, affiliation)
+	ret0, _ := ret[0].([]*github.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCollaborators indicates an expected call of ListCollaborators.
+func (mr *MockGitHubMockRecorder) ListCollaborators(ctx, owner, repo, affiliation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollaborators", reflect.TypeOf((*MockGitHub)(nil).ListCollaborators), ctx, owner, repo, affiliation)
+}
+
+// ListDeployKeys mocks base method.
+func (m *MockGitHub) ListDeployKeys(ctx context.Context, owner, repo string) ([]*github.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployKeys", ctx, owner, repo)
+	ret0, _ := ret[0].([]*github.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployKeys indicates an expected call of ListDeployKeys.
+func (mr *MockGitHubMockRecorder) ListDeployKeys(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployKeys", reflect.TypeOf((*MockGitHub)(nil).ListDeployKeys), ctx, owner, repo)
+}
+
+// ListDeploymentBranchPolicies mocks base method.
+func (m *MockGitHub) ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string) ([]*github.DeploymentBranchPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeploymentBranchPolicies", ctx, owner, repo, environment)
+	ret0, _ := ret[0].([]*github.DeploymentBranchPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeploymentBranchPolicies indicates an expected call of ListDeploymentBranchPolicies.
+func (mr *MockGitHubMockRecorder) ListDeploymentBranchPolicies(ctx, owner, repo, environment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentBranchPolicies", reflect.TypeOf((*MockGitHub)(nil).ListDeploymentBranchPolicies), ctx, owner, repo, environment)
+}
+
+// ListEnvironments mocks base method.
+func (m *MockGitHub) ListEnvironments(ctx context.Context, owner, repo string) ([]*github.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments", ctx, owner, repo)
+	ret0, _ := ret[0].([]*github.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockGitHubMockRecorder) ListEnvironments(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockGitHub)(nil).ListEnvironments), ctx, owner, repo)
+}
+
 // ListFiles mocks base method.
 func (m *MockGitHub) ListFiles(ctx context.Context, owner, repo string, prNumber, perPage, pageNumber int) ([]*github.CommitFile, *github.Response, error) {
 	m.ctrl.T.Helper()
@@ -1908,6 +2182,21 @@ func (mr *MockGitHubMockRecorder) ListIssueComments(ctx, owner, repo, number, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueComments", reflect.TypeOf((*MockGitHub)(nil).ListIssueComments), ctx, owner, repo, number, opts)
 }
 
+// ListOrgRunnerGroups mocks base method.
+func (m *MockGitHub) ListOrgRunnerGroups(ctx context.Context, org, visibleToRepo string) ([]*github.RunnerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgRunnerGroups", ctx, org, visibleToRepo)
+	ret0, _ := ret[0].([]*github.RunnerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgRunnerGroups indicates an expected call of ListOrgRunnerGroups.
+func (mr *MockGitHubMockRecorder) ListOrgRunnerGroups(ctx, org, visibleToRepo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgRunnerGroups", reflect.TypeOf((*MockGitHub)(nil).ListOrgRunnerGroups), ctx, org, visibleToRepo)
+}
+
 // ListPackagesByRepository mocks base method.
 func (m *MockGitHub) ListPackagesByRepository(arg0 context.Context, arg1, arg2 string, arg3 int64, arg4, arg5 int) ([]*github.Package, error) {
 	m.ctrl.T.Helper()
@@ -1938,6 +2227,67 @@ func (mr *MockGitHubMockRecorder) ListPullRequests(ctx, owner, repo, opt any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequests", reflect.TypeOf((*MockGitHub)(nil).ListPullRequests), ctx, owner, repo, opt)
 }
 
+// ListRepoRunners mocks base method.
+func (m *MockGitHub) ListRepoRunners(ctx context.Context, owner, repo string) ([]*github.Runner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepoRunners", ctx, owner, repo)
+	ret0, _ := ret[0].([]*github.Runner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepoRunners indicates an expected call of ListRepoRunners.
+func (mr *MockGitHubMockRecorder) ListRepoRunners(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepoRunners", reflect.TypeOf((*MockGitHub)(nil).ListRepoRunners), ctx, owner, repo)
+}
+
+// ListRepoSecrets mocks base method.
+func (m *MockGitHub) ListRepoSecrets(ctx context.Context, owner, repo string) ([]*github.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepoSecrets", ctx, owner, repo)
+	ret0, _ := ret[0].([]*github.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepoSecrets indicates an expected call of ListRepoSecrets.
+func (mr *MockGitHubMockRecorder) ListRepoSecrets(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepoSecrets", reflect.TypeOf((*MockGitHub)(nil).ListRepoSecrets), ctx, owner, repo)
+}
+
+// ListRepositoriesPage mocks base method.
+func (m *MockGitHub) ListRepositoriesPage(ctx context.Context, cursor string) ([]*v10.Repository, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoriesPage", ctx, cursor)
+	ret0, _ := ret[0].([]*v10.Repository)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRepositoriesPage indicates an expected call of ListRepositoriesPage.
+func (mr *MockGitHubMockRecorder) ListRepositoriesPage(ctx, cursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoriesPage", reflect.TypeOf((*MockGitHub)(nil).ListRepositoriesPage), ctx, cursor)
+}
+
+// ListRepositoryTeams mocks base method.
+func (m *MockGitHub) ListRepositoryTeams(ctx context.Context, owner, repo string) ([]*github.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoryTeams", ctx, owner, repo)
+	ret0, _ := ret[0].([]*github.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepositoryTeams indicates an expected call of ListRepositoryTeams.
+func (mr *MockGitHubMockRecorder) ListRepositoryTeams(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoryTeams", reflect.TypeOf((*MockGitHub)(nil).ListRepositoryTeams), ctx, owner, repo)
+}
+
 // ListReviews mocks base method.
 func (m *MockGitHub) ListReviews(arg0 context.Context, arg1, arg2 string, arg3 int, arg4 *github.ListOptions) ([]*github.PullRequestReview, error) {
 	m.ctrl.T.Helper()
@@ -1953,6 +2303,21 @@ func (mr *MockGitHubMockRecorder) ListReviews(arg0, arg1, arg2, arg3, arg4 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviews", reflect.TypeOf((*MockGitHub)(nil).ListReviews), arg0, arg1, arg2, arg3, arg4)
 }
 
+// ListRunnerGroupRunners mocks base method.
+func (m *MockGitHub) ListRunnerGroupRunners(ctx context.Context, org string, groupID int64) ([]*github.Runner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRunnerGroupRunners", ctx, org, groupID)
+	ret0, _ := ret[0].([]*github.Runner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRunnerGroupRunners indicates an expected call of ListRunnerGroupRunners.
+func (mr *MockGitHubMockRecorder) ListRunnerGroupRunners(ctx, org, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRunnerGroupRunners", reflect.TypeOf((*MockGitHub)(nil).ListRunnerGroupRunners), ctx, org, groupID)
+}
+
 // NewRequest mocks base method.
 func (m *MockGitHub) NewRequest(method, url string, body any) (*http.Request, error) {
 	m.ctrl.T.Helper()
@@ -2100,6 +2465,34 @@ func (mr *MockGitHubMockRecorder) UpdateCheckRun(arg0, arg1, arg2, arg3, arg4 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCheckRun", reflect.TypeOf((*MockGitHub)(nil).UpdateCheckRun), arg0, arg1, arg2, arg3, arg4)
 }
 
+// UpdateCollaboratorPermission mocks base method.
+func (m *MockGitHub) UpdateCollaboratorPermission(ctx context.Context, owner, repo, login, permission string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollaboratorPermission", ctx, owner, repo, login, permission)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCollaboratorPermission indicates an expected call of UpdateCollaboratorPermission.
+func (mr *MockGitHubMockRecorder) UpdateCollaboratorPermission(ctx, owner, repo, login, permission any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollaboratorPermission", reflect.TypeOf((*MockGitHub)(nil).UpdateCollaboratorPermission), ctx, owner, repo, login, permission)
+}
+
+// UpdateEnvironment mocks base method.
+func (m *MockGitHub) UpdateEnvironment(ctx context.Context, owner, repo, environment string, req *github.CreateUpdateEnvironment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", ctx, owner, repo, environment, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockGitHubMockRecorder) UpdateEnvironment(ctx, owner, repo, environment, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockGitHub)(nil).UpdateEnvironment), ctx, owner, repo, environment, req)
+}
+
 // UpdateIssueComment mocks base method.
 func (m *MockGitHub) UpdateIssueComment(ctx context.Context, owner, repo string, number int64, comment string) error {
 	m.ctrl.T.Helper()
@@ -2129,6 +2522,20 @@ func (mr *MockGitHubMockRecorder) UpdateReview(arg0, arg1, arg2, arg3, arg4, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockGitHub)(nil).UpdateReview), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// UpdateTeamRepoPermission mocks base method.
+func (m *MockGitHub) UpdateTeamRepoPermission(ctx context.Context, org, teamSlug, owner, repo, permission string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamRepoPermission", ctx, org, teamSlug, owner, repo, permission)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamRepoPermission indicates an expected call of UpdateTeamRepoPermission.
+func (mr *MockGitHubMockRecorder) UpdateTeamRepoPermission(ctx, org, teamSlug, owner, repo, permission any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamRepoPermission", reflect.TypeOf((*MockGitHub)(nil).UpdateTeamRepoPermission), ctx, org, teamSlug, owner, repo, permission)
+}
+
 // MockImageLister is a mock of ImageLister interface.
 type MockImageLister struct {
 	ctrl     *gomock.Controller
@@ -2526,3 +2933,173 @@ func (mr *MockOCIMockRecorder) SupportsEntity(entType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockOCI)(nil).SupportsEntity), entType)
 }
+
+// MockPackageRegistry is a mock of PackageRegistry interface.
+type MockPackageRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockPackageRegistryMockRecorder
+	isgomock struct{}
+}
+
+// MockPackageRegistryMockRecorder is the mock recorder for MockPackageRegistry.
+type MockPackageRegistryMockRecorder struct {
+	mock *MockPackageRegistry
+}
+
+// NewMockPackageRegistry creates a new mock instance.
+func NewMockPackageRegistry(ctrl *gomock.Controller) *MockPackageRegistry {
+	mock := &MockPackageRegistry{ctrl: ctrl}
+	mock.recorder = &MockPackageRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPackageRegistry) EXPECT() *MockPackageRegistryMockRecorder {
+	return m.recorder
+}
+
+// CreationOptions mocks base method.
+func (m *MockPackageRegistry) CreationOptions(entType v10.Entity) *v11.EntityCreationOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreationOptions", entType)
+	ret0, _ := ret[0].(*v11.EntityCreationOptions)
+	return ret0
+}
+
+// CreationOptions indicates an expected call of CreationOptions.
+func (mr *MockPackageRegistryMockRecorder) CreationOptions(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreationOptions", reflect.TypeOf((*MockPackageRegistry)(nil).CreationOptions), entType)
+}
+
+// DeregisterEntity mocks base method.
+func (m *MockPackageRegistry) DeregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterEntity indicates an expected call of DeregisterEntity.
+func (mr *MockPackageRegistryMockRecorder) DeregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterEntity", reflect.TypeOf((*MockPackageRegistry)(nil).DeregisterEntity), ctx, entType, props)
+}
+
+// FetchAllProperties mocks base method.
+func (m *MockPackageRegistry) FetchAllProperties(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, cachedProps *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllProperties", ctx, getByProps, entType, cachedProps)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAllProperties indicates an expected call of FetchAllProperties.
+func (mr *MockPackageRegistryMockRecorder) FetchAllProperties(ctx, getByProps, entType, cachedProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllProperties", reflect.TypeOf((*MockPackageRegistry)(nil).FetchAllProperties), ctx, getByProps, entType, cachedProps)
+}
+
+// GetEntityName mocks base method.
+func (m *MockPackageRegistry) GetEntityName(entType v10.Entity, props *properties.Properties) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityName", entType, props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityName indicates an expected call of GetEntityName.
+func (mr *MockPackageRegistryMockRecorder) GetEntityName(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityName", reflect.TypeOf((*MockPackageRegistry)(nil).GetEntityName), entType, props)
+}
+
+// GetPackage mocks base method.
+func (m *MockPackageRegistry) GetPackage(ctx context.Context, name string) (*v11.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackage", ctx, name)
+	ret0, _ := ret[0].(*v11.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackage indicates an expected call of GetPackage.
+func (mr *MockPackageRegistryMockRecorder) GetPackage(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackage", reflect.TypeOf((*MockPackageRegistry)(nil).GetPackage), ctx, name)
+}
+
+// GetPackageIntegrity mocks base method.
+func (m *MockPackageRegistry) GetPackageIntegrity(ctx context.Context, name string, version *v11.PackageVersion) (*v11.PackageIntegrity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageIntegrity", ctx, name, version)
+	ret0, _ := ret[0].(*v11.PackageIntegrity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageIntegrity indicates an expected call of GetPackageIntegrity.
+func (mr *MockPackageRegistryMockRecorder) GetPackageIntegrity(ctx, name, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageIntegrity", reflect.TypeOf((*MockPackageRegistry)(nil).GetPackageIntegrity), ctx, name, version)
+}
+
+// PropertiesToProtoMessage mocks base method.
+func (m *MockPackageRegistry) PropertiesToProtoMessage(entType v10.Entity, props *properties.Properties) (protoreflect.ProtoMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PropertiesToProtoMessage", entType, props)
+	ret0, _ := ret[0].(protoreflect.ProtoMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PropertiesToProtoMessage indicates an expected call of PropertiesToProtoMessage.
+func (mr *MockPackageRegistryMockRecorder) PropertiesToProtoMessage(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropertiesToProtoMessage", reflect.TypeOf((*MockPackageRegistry)(nil).PropertiesToProtoMessage), entType, props)
+}
+
+// ProviderClassInfo mocks base method.
+func (m *MockPackageRegistry) ProviderClassInfo() *v10.ProviderClassInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProviderClassInfo")
+	ret0, _ := ret[0].(*v10.ProviderClassInfo)
+	return ret0
+}
+
+// ProviderClassInfo indicates an expected call of ProviderClassInfo.
+func (mr *MockPackageRegistryMockRecorder) ProviderClassInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProviderClassInfo", reflect.TypeOf((*MockPackageRegistry)(nil).ProviderClassInfo))
+}
+
+// RegisterEntity mocks base method.
+func (m *MockPackageRegistry) RegisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterEntity indicates an expected call of RegisterEntity.
+func (mr *MockPackageRegistryMockRecorder) RegisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterEntity", reflect.TypeOf((*MockPackageRegistry)(nil).RegisterEntity), ctx, entType, props)
+}
+
+// SupportsEntity mocks base method.
+func (m *MockPackageRegistry) SupportsEntity(entType v10.Entity) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsEntity", entType)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsEntity indicates an expected call of SupportsEntity.
+func (mr *MockPackageRegistryMockRecorder) SupportsEntity(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockPackageRegistry)(nil).SupportsEntity), entType)
+}
@@ -136,6 +136,12 @@ type RepoLister interface {
 	Provider
 
 	ListAllRepositories(context.Context) ([]*minderv1.Repository, error)
+
+	// ListRepositoriesPage lists the page of the repositories the cursor
+	// points to, starting with the empty cursor, and returns the cursor of
+	// the next page, which is empty after the last page. Cursors can be
+	// persisted to resume a listing.
+	ListRepositoriesPage(ctx context.Context, cursor string) ([]*minderv1.Repository, string, error)
 }
 
 var (
@@ -238,6 +244,19 @@ type IssuePublisher interface {
 	) (*github.Issue, error)
 }
 
+// CodeScanningUploader is the interface for providers that can upload code
+// scanning results to a repository
+type CodeScanningUploader interface {
+	Provider
+
+	// UploadSarif uploads the results of an analysis of the given commit, as
+	// a gzipped and base64-encoded SARIF log
+	UploadSarif(ctx context.Context, owner, repo string, analysis *github.SarifAnalysis) (*github.SarifID, error)
+
+	// GetBranchHead returns the SHA of the commit at the head of the branch
+	GetBranchHead(ctx context.Context, owner, repo, branch string) (string, error)
+}
+
 // GitHub is the interface for interacting with the GitHub REST API
 // Add methods here for interacting with the GitHub Rest API
 type GitHub interface {
@@ -252,6 +271,19 @@ type GitHub interface {
 	GetRepository(context.Context, string, string) (*github.Repository, error)
 	GetBranchProtection(context.Context, string, string, string) (*github.Protection, error)
 	UpdateBranchProtection(context.Context, string, string, string, *github.ProtectionRequest) error
+	ListCollaborators(ctx context.Context, owner, repo, affiliation string) ([]*github.User, error)
+	ListRepositoryTeams(ctx context.Context, owner, repo string) ([]*github.Team, error)
+	UpdateCollaboratorPermission(ctx context.Context, owner, repo, login, permission string) error
+	UpdateTeamRepoPermission(ctx context.Context, org, teamSlug, owner, repo, permission string) error
+	ListDeployKeys(ctx context.Context, owner, repo string) ([]*github.Key, error)
+	DeleteDeployKey(ctx context.Context, owner, repo string, id int64) error
+	ListRepoSecrets(ctx context.Context, owner, repo string) ([]*github.Secret, error)
+	ListRepoRunners(ctx context.Context, owner, repo string) ([]*github.Runner, error)
+	ListOrgRunnerGroups(ctx context.Context, org, visibleToRepo string) ([]*github.RunnerGroup, error)
+	ListRunnerGroupRunners(ctx context.Context, org string, groupID int64) ([]*github.Runner, error)
+	ListEnvironments(ctx context.Context, owner, repo string) ([]*github.Environment, error)
+	ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string) ([]*github.DeploymentBranchPolicy, error)
+	UpdateEnvironment(ctx context.Context, owner, repo, environment string, req *github.CreateUpdateEnvironment) error
 	ListPackagesByRepository(context.Context, string, string, int64, int, int) ([]*github.Package, error)
 	GetPackageByName(context.Context, string, string, string) (*github.Package, error)
 	GetPackageVersionById(context.Context, string, string, string, int64) (*github.PackageVersion, error)
@@ -274,6 +306,7 @@ type GitHub interface {
 	CloseSecurityAdvisory(ctx context.Context, owner, repo, id string) error
 	CreatePullRequest(ctx context.Context, owner, repo, title, body, head, base string) (*github.PullRequest, error)
 	ClosePullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error)
+	EnablePullRequestAutoMerge(ctx context.Context, nodeID, mergeMethod string) error
 	ListPullRequests(ctx context.Context, owner, repo string, opt *github.PullRequestListOptions) ([]*github.PullRequest, error)
 	CreateIssue(ctx context.Context, owner, repo string, title string, body string, labels []string,
 		assignees []string) (*github.Issue, error)
@@ -331,6 +364,91 @@ type OCI interface {
 	GetAuthenticator() (authn.Authenticator, error)
 }
 
+// Package is a package published to a package registry, such as npm or PyPI
+type Package struct {
+	// Name is the name of the package, e.g. lodash or @sigstore/sign
+	Name string
+	// Maintainers are the accounts allowed to publish versions of the package
+	Maintainers []string
+	// Versions are the versions of the package, newest first
+	Versions []*PackageVersion
+}
+
+// PackageVersion is a published version of a package
+type PackageVersion struct {
+	// Version is the version string, e.g. 1.2.3
+	Version string
+	// Tags are the tags pointing at the version, e.g. the npm dist-tags.
+	// The latest version is tagged "latest" in all registries.
+	Tags []string
+	// PublishedAt is the time the version was published
+	PublishedAt time.Time
+	// Publisher is the account which published the version, if known
+	Publisher string
+	// Deprecated is true if the version was deprecated or yanked
+	Deprecated bool
+	// Files are the distributions of the version, e.g. the npm tarball or
+	// the PyPI sdist and wheels
+	Files []*PackageFile
+	// Signatures are the signatures of the version by the registry itself,
+	// if the registry signs the published versions
+	Signatures []*PackageSignature
+}
+
+// PackageFile is a distribution of a version of a package
+type PackageFile struct {
+	// Name is the file name of the distribution
+	Name string
+	// DigestAlgorithm is the algorithm of Digest, e.g. sha256 or sha512
+	DigestAlgorithm string
+	// Digest is the digest of the distribution
+	Digest []byte
+}
+
+// PackageSignature is a signature of a version of a package by its registry
+type PackageSignature struct {
+	KeyID     string
+	Signature string
+}
+
+// PackageAttestation is a sigstore attestation of a distribution of a
+// version of a package, e.g. its SLSA provenance
+type PackageAttestation struct {
+	// File is the name of the attested distribution
+	File string
+	// DigestAlgorithm and Digest are the digest of the attested distribution
+	DigestAlgorithm string
+	Digest          []byte
+	// Bundle is the sigstore bundle of the attestation, in its JSON form
+	Bundle []byte
+}
+
+// PackageIntegrity is the integrity information of a version of a package:
+// its registry signature and its attestations
+type PackageIntegrity struct {
+	// RegistrySigned is true if the registry signed the version
+	RegistrySigned bool
+	// RegistrySignatureVerified is true if the signature of the registry
+	// was verified against the keys of the registry
+	RegistrySignatureVerified bool
+	// Attestations are the provenance attestations of the distributions of
+	// the version
+	Attestations []*PackageAttestation
+}
+
+// PackageRegistry is the interface for package registry providers, such as
+// npm or PyPI. Their packages are registered as artifacts.
+type PackageRegistry interface {
+	Provider
+
+	// GetPackage returns the package with the given name and its versions
+	GetPackage(ctx context.Context, name string) (*Package, error)
+
+	// GetPackageIntegrity returns the registry signature and the provenance
+	// attestations of the given version of the package
+	GetPackageIntegrity(ctx context.Context, name string, version *PackageVersion) (*PackageIntegrity, error)
+}
+
 // ParseAndValidate parses the given provider configuration and validates it.
 func ParseAndValidate(rawConfig json.RawMessage, to any) error {
 	if err := json.Unmarshal(rawConfig, to); err != nil {
@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cassette provides an HTTP record/replay layer for provider clients.
+//
+// Tests wrap the transport of a provider client with a Recorder. In record
+// mode, the requests go to the real API and the interactions are saved to a
+// cassette file once the test finishes; in replay mode, which is the default,
+// the responses are served from the cassette and no request leaves the
+// process. Record mode is enabled by setting the MINDER_RECORD_CASSETTES
+// environment variable, e.g. through `make test-record`.
+package cassette
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RecordEnvVar is the environment variable enabling record mode
+const RecordEnvVar = "MINDER_RECORD_CASSETTES"
+
+// Mode is the mode of a Recorder
+type Mode int
+
+const (
+	// ModeReplay serves the responses from the cassette
+	ModeReplay Mode = iota
+	// ModeRecord sends the requests to the real API and records the interactions
+	ModeRecord
+)
+
+// ErrNoInteraction is returned in replay mode when the cassette has no
+// recorded interaction left matching a request
+var ErrNoInteraction = errors.New("no recorded interaction matches the request")
+
+// defaultRedactedHeaders are the response headers never saved in cassettes,
+// as they may contain credentials
+var defaultRedactedHeaders = []string{"Set-Cookie", "Authorization"}
+
+// Request is a recorded request. Request headers are never recorded, as
+// they carry the credentials used to record the cassette.
+type Request struct {
+	Method string `yaml:"method"`
+	URL    string `yaml:"url"`
+	Body   string `yaml:"body,omitempty"`
+}
+
+// Response is a recorded response
+type Response struct {
+	StatusCode int               `yaml:"status_code"`
+	Headers    map[string]string `yaml:"headers,omitempty"`
+	Body       string            `yaml:"body,omitempty"`
+}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  Request  `yaml:"request"`
+	Response Response `yaml:"response"`
+}
+
+// Cassette is the list of interactions recorded for a test
+type Cassette struct {
+	Interactions []Interaction `yaml:"interactions"`
+}
+
+// Recorder is an http.RoundTripper recording or replaying interactions
+type Recorder struct {
+	mu              sync.Mutex
+	path            string
+	mode            Mode
+	transport       http.RoundTripper
+	redactedHeaders []string
+	cassette        Cassette
+	// used tracks the interactions already replayed, so that identical
+	// requests are answered with the recorded responses in order
+	used []bool
+}
+
+// Option is a functional option type for Recorder
+type Option func(*Recorder)
+
+// WithMode is a functional option to force the mode of the recorder,
+// ignoring the environment
+func WithMode(mode Mode) Option {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// WithTransport is a functional option to set the transport used to reach
+// the real API in record mode. Defaults to http.DefaultTransport.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// WithRedactedHeaders is a functional option to drop additional response
+// headers from the recorded interactions
+func WithRedactedHeaders(headers ...string) Option {
+	return func(r *Recorder) {
+		r.redactedHeaders = append(r.redactedHeaders, headers...)
+	}
+}
+
+// New creates a recorder for the cassette at path. In replay mode, the
+// cassette must exist.
+func New(path string, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:            path,
+		transport:       http.DefaultTransport,
+		redactedHeaders: slices.Clone(defaultRedactedHeaders),
+	}
+	if os.Getenv(RecordEnvVar) != "" {
+		r.mode = ModeRecord
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeReplay {
+		data, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, fmt.Errorf("error reading cassette, set %s to record it: %w", RecordEnvVar, err)
+		}
+		if err := yaml.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("error parsing cassette %s: %w", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// NewForTest creates a recorder for the cassette testdata/cassettes/<name>.yaml,
+// which is saved when the test finishes in record mode
+func NewForTest(t *testing.T, name string, opts ...Option) *Recorder {
+	t.Helper()
+
+	r, err := New(filepath.Join("testdata", "cassettes", name+".yaml"), opts...)
+	if err != nil {
+		t.Fatalf("unable to create cassette recorder: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := r.Stop(); err != nil {
+			t.Errorf("unable to save cassette: %v", err)
+		}
+	})
+	return r
+}
+
+// Mode returns the mode of the recorder
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Client returns an HTTP client using the recorder as transport
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+// Stop saves the cassette in record mode, and is a no-op in replay mode
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := yaml.Marshal(&r.cassette)
+	if err != nil {
+		return fmt.Errorf("error marshalling cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0750); err != nil {
+		return fmt.Errorf("error creating cassette directory: %w", err)
+	}
+	return os.WriteFile(r.path, data, 0600)
+}
+
+func (r *Recorder) record(req *http.Request, body string) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	headers := make(map[string]string, len(resp.Header))
+	for k := range resp.Header {
+		headers[k] = resp.Header.Get(k)
+	}
+	for _, h := range r.redactedHeaders {
+		delete(headers, http.CanonicalHeaderKey(h))
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Body:   body,
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       string(respBody),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body string) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	url := req.URL.String()
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || in.Request.Method != req.Method || in.Request.URL != url || in.Request.Body != body {
+			continue
+		}
+		r.used[i] = true
+
+		header := make(http.Header, len(in.Response.Headers))
+		for k, v := range in.Response.Headers {
+			header.Set(k, v)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader([]byte(in.Response.Body))),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, url)
+}
+
+func readRequestBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", nil
+	}
+	data, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return "", fmt.Errorf("error reading request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return string(data), nil
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cassette
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		_, _ = fmt.Fprintf(w, `{"call":%d,"path":%q,"body":%q}`, n, r.URL.Path, string(body))
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "repo.yaml")
+
+	// Record the interactions against the live server
+	rec, err := New(path, WithMode(ModeRecord))
+	require.NoError(t, err)
+	recorded := doRequests(t, rec.Client(), srv.URL)
+	require.NoError(t, rec.Stop())
+	require.Equal(t, int32(3), calls.Load())
+
+	// Replay them without reaching the server
+	replay, err := New(path, WithMode(ModeReplay))
+	require.NoError(t, err)
+	replayed := doRequests(t, replay.Client(), srv.URL)
+	require.Equal(t, int32(3), calls.Load())
+	require.Equal(t, recorded, replayed)
+
+	// Every interaction is replayed once
+	_, err = replay.Client().Get(srv.URL + "/repos/acme/widgets")
+	require.ErrorIs(t, err, ErrNoInteraction)
+}
+
+func TestRecordRedactsHeaders(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Header().Set("X-Custom-Token", "secret")
+		w.Header().Set("Etag", "abc")
+	}))
+	defer srv.Close()
+
+	rec, err := New(filepath.Join(t.TempDir(), "c.yaml"), WithMode(ModeRecord), WithRedactedHeaders("x-custom-token"))
+	require.NoError(t, err)
+	resp, err := rec.Client().Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	require.Len(t, rec.cassette.Interactions, 1)
+	headers := rec.cassette.Interactions[0].Response.Headers
+	require.Equal(t, "abc", headers["Etag"])
+	require.NotContains(t, headers, "Set-Cookie")
+	require.NotContains(t, headers, "X-Custom-Token")
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(filepath.Join(t.TempDir(), "missing.yaml"), WithMode(ModeReplay))
+	require.ErrorContains(t, err, RecordEnvVar)
+}
+
+func doRequests(t *testing.T, cli *http.Client, base string) []string {
+	t.Helper()
+
+	var out []string
+	for _, req := range []struct{ method, path, body string }{
+		{http.MethodGet, "/repos/acme/widgets", ""},
+		{http.MethodGet, "/repos/acme/widgets", ""},
+		{http.MethodPost, "/repos/acme/widgets/hooks", `{"active":true}`},
+	} {
+		r, err := http.NewRequest(req.method, base+req.path, strings.NewReader(req.body))
+		require.NoError(t, err)
+		resp, err := cli.Do(r)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		out = append(out, string(body))
+	}
+	return out
+}
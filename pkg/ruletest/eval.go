@@ -226,6 +226,8 @@ func mapToProto(entityType string, entityMap map[string]any) (proto.Message, err
 		msg = &minderv1.TaskRun{}
 	case minderv1.Entity_ENTITY_BUILD:
 		msg = &minderv1.Build{}
+	case minderv1.Entity_ENTITY_ORGANIZATION:
+		msg = &minderv1.EntityInstance{}
 	case minderv1.Entity_ENTITY_UNSPECIFIED,
 		minderv1.Entity_ENTITY_BUILD_ENVIRONMENTS,
 		minderv1.Entity_ENTITY_PULL_REQUESTS:
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ruletypes
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// identityFields are the fields identifying a rule type, rather than
+// defining it
+var identityFields = map[protoreflect.Name]bool{
+	"id":      true,
+	"name":    true,
+	"context": true,
+}
+
+// ChangedFields returns the paths of the fields which differ between two
+// versions of a rule type, e.g. "description" or "def.eval". The fields of
+// the definition are compared one by one, so that the changes to the
+// evaluation can be told apart from those to the remediation, for example.
+func ChangedFields(oldRuleType, newRuleType *pb.RuleType) []string {
+	var changed []string
+	oldMsg, newMsg := oldRuleType.ProtoReflect(), newRuleType.ProtoReflect()
+	fields := oldMsg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if identityFields[fd.Name()] {
+			continue
+		}
+		if fd.Name() == "def" {
+			changed = append(changed, changedMessageFields("def.", oldMsg.Get(fd).Message(), newMsg.Get(fd).Message())...)
+			continue
+		}
+		if !oldMsg.Get(fd).Equal(newMsg.Get(fd)) {
+			changed = append(changed, string(fd.Name()))
+		}
+	}
+	return changed
+}
+
+func changedMessageFields(prefix string, oldMsg, newMsg protoreflect.Message) []string {
+	var changed []string
+	fields := oldMsg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !oldMsg.Get(fd).Equal(newMsg.Get(fd)) {
+			changed = append(changed, prefix+string(fd.Name()))
+		}
+	}
+	return changed
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ruletypes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+func TestChangedFields(t *testing.T) {
+	t.Parallel()
+
+	id := "00000000-0000-0000-0000-000000000001"
+	project := "00000000-0000-0000-0000-000000000002"
+	base := &pb.RuleType{
+		Id:          &id,
+		Name:        "secret_scanning",
+		Context:     &pb.Context{Project: &project},
+		Description: "Verifies that secret scanning is enabled",
+		Def: &pb.RuleType_Definition{
+			InEntity: "repository",
+			Eval: &pb.RuleType_Definition_Eval{
+				Type: "jq",
+			},
+			Remediate: &pb.RuleType_Definition_Remediate{
+				Type: "rest",
+			},
+		},
+		Severity: &pb.Severity{Value: pb.Severity_VALUE_HIGH},
+	}
+
+	tests := []struct {
+		name     string
+		update   func(rt *pb.RuleType)
+		expected []string
+	}{
+		{
+			name:   "unchanged",
+			update: func(_ *pb.RuleType) {},
+		},
+		{
+			name: "identity is ignored",
+			update: func(rt *pb.RuleType) {
+				rt.Id = nil
+				rt.Context = nil
+			},
+		},
+		{
+			name: "metadata",
+			update: func(rt *pb.RuleType) {
+				rt.Description = "Secret scanning must be enabled"
+				rt.Severity.Value = pb.Severity_VALUE_CRITICAL
+			},
+			expected: []string{"description", "severity"},
+		},
+		{
+			name: "definition",
+			update: func(rt *pb.RuleType) {
+				rt.Def.Eval.Type = "rego"
+				rt.Def.Remediate = nil
+			},
+			expected: []string{"def.eval", "def.remediate"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			updated := proto.Clone(base).(*pb.RuleType)
+			tt.update(updated)
+			require.Equal(t, tt.expected, ruletypes.ChangedFields(base, updated))
+		})
+	}
+}
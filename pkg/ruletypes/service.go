@@ -19,6 +19,7 @@ import (
 	regoeval "github.com/mindersec/minder/internal/engine/eval/rego"
 	"github.com/mindersec/minder/internal/logger"
 	"github.com/mindersec/minder/internal/marketplaces/namespaces"
+	"github.com/mindersec/minder/internal/projects/features"
 	"github.com/mindersec/minder/internal/util"
 	"github.com/mindersec/minder/internal/util/schemaupdate"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -90,6 +91,10 @@ var (
 	// ErrRuleTypeInvalid is returned by both create and update if validation
 	// fails
 	ErrRuleTypeInvalid = errors.New("rule type validation failed")
+	// ErrVisibilityNotAllowed is returned by both create and update if the
+	// project is not allowed to share the rule type with the requested
+	// visibility
+	ErrVisibilityNotAllowed = errors.New("rule type visibility not allowed")
 	// Pattern for validating rule names do not collide with UUIDs
 	uuidLike = regexp.MustCompile(`^[[:alnum:]]{8}-([[:alnum:]]{4}-){3}[[:alnum:]]{12}$`)
 )
@@ -123,6 +128,10 @@ func (s *ruleTypeService) CreateRuleType(
 	ruleTypeName := ruleType.GetName()
 	ruleTypeDef := ruleType.GetDef()
 
+	if err := features.CheckRuleEngine(ctx, qtx, projectID, ruleTypeDef.GetEval().GetType()); err != nil {
+		return nil, err
+	}
+
 	projects, err := qtx.GetParentProjects(ctx, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get parent projects: %w", err)
@@ -154,6 +163,11 @@ func (s *ruleTypeService) CreateRuleType(
 		return nil, err
 	}
 
+	visibility, err := getRuleTypeVisibility(ctx, projectID, ruleType, qtx)
+	if err != nil {
+		return nil, err
+	}
+
 	ruleType = ruleType.WithDefaultDisplayName().WithDefaultShortFailureMessage()
 	newDBRecord, err := qtx.CreateRuleType(ctx, db.CreateRuleTypeParams{
 		Name:                ruleTypeName,
@@ -167,6 +181,7 @@ func (s *ruleTypeService) CreateRuleType(
 		SubscriptionID:      uuid.NullUUID{UUID: subscriptionID, Valid: subscriptionID != uuid.Nil},
 		ReleasePhase:        *releasePhase,
 		RegoVersion:         regoVersion,
+		Visibility:          visibility,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create rule type: %w", err)
@@ -212,6 +227,10 @@ func (s *ruleTypeService) UpdateRuleType(
 	ruleTypeName := ruleType.GetName()
 	ruleTypeDef := ruleType.GetDef()
 
+	if err := features.CheckRuleEngine(ctx, qtx, projectID, ruleTypeDef.GetEval().GetType()); err != nil {
+		return nil, err
+	}
+
 	oldRuleType, err := qtx.GetRuleTypeByName(ctx, db.GetRuleTypeByNameParams{
 		// we only need to check the project that the rule type is in
 		Projects: []uuid.UUID{projectID},
@@ -250,6 +269,14 @@ func (s *ruleTypeService) UpdateRuleType(
 		return nil, err
 	}
 
+	visibility, err := getRuleTypeVisibility(ctx, projectID, ruleType, qtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateVisibilityUpdate(ctx, &oldRuleType, visibility, qtx); err != nil {
+		return nil, err
+	}
+
 	ruleType = ruleType.WithDefaultDisplayName().WithDefaultShortFailureMessage()
 	updatedRuleType, err := qtx.UpdateRuleType(ctx, db.UpdateRuleTypeParams{
 		ID:                  oldRuleType.ID,
@@ -260,6 +287,7 @@ func (s *ruleTypeService) UpdateRuleType(
 		ShortFailureMessage: ruleType.GetShortFailureMessage(),
 		ReleasePhase:        *releasePhase,
 		RegoVersion:         regoVersion,
+		Visibility:          visibility,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update rule type: %w", err)
@@ -432,8 +460,9 @@ func getAvailableDataSources(
 
 	for _, datasource := range requiredDataSources {
 		qarg := db.GetDataSourceByNameParams{
-			Name:     datasource.Name,
-			Projects: projects,
+			Name:          datasource.Name,
+			Projects:      projects,
+			IncludeGlobal: true,
 		}
 		dbDataSource, err := qtx.GetDataSourceByName(ctx, qarg)
 		if err != nil && errors.Is(err, sql.ErrNoRows) {
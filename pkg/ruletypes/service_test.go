@@ -357,6 +357,34 @@ func TestRuleTypeService(t *testing.T) {
 				withSuccessfulCreateForRegoVersion("v0"), withSuccessfulDeleteRuleTypeDataSource),
 			TestMethod: create,
 		},
+		{
+			Name:          "CreateRuleType rejects global rule type when not enabled for the project",
+			RuleType:      newRuleType(withBasicStructure, withVisibility(pb.Visibility_VISIBILITY_GLOBAL)),
+			ExpectedError: ruletypes.ErrVisibilityNotAllowed.Error(),
+			DBSetup:       dbf.NewDBMock(withHierarchyGet, withNotFoundGet, withGlobalVisibility(false)),
+			TestMethod:    create,
+		},
+		{
+			Name:     "CreateRuleType creates global rule type when enabled for the project",
+			RuleType: newRuleType(withBasicStructure, withVisibility(pb.Visibility_VISIBILITY_GLOBAL)),
+			DBSetup: dbf.NewDBMock(withHierarchyGet, withNotFoundGet, withGlobalVisibility(true),
+				withSuccessfulCreate, withSuccessfulDeleteRuleTypeDataSource),
+			TestMethod: create,
+		},
+		{
+			Name:          "UpdateRuleType rejects making a rule type used by a child project private",
+			RuleType:      newRuleType(withBasicStructure, withVisibility(pb.Visibility_VISIBILITY_PRIVATE)),
+			ExpectedError: "it is used by profiles in project",
+			DBSetup:       dbf.NewDBMock(withSuccessfulGet, withRuleTypeUsedBy(childProjectID)),
+			TestMethod:    update,
+		},
+		{
+			Name:     "UpdateRuleType makes a rule type only used by its project private",
+			RuleType: newRuleType(withBasicStructure, withVisibility(pb.Visibility_VISIBILITY_PRIVATE)),
+			DBSetup: dbf.NewDBMock(withSuccessfulGet, withRuleTypeUsedBy(projectID),
+				withSuccessfulUpdate, withHierarchyGet, withSuccessfulDeleteRuleTypeDataSource),
+			TestMethod: update,
+		},
 		{
 			Name:          "CreateRuleType rejects invalid rego syntax",
 			RuleType:      newRuleType(withBasicStructure, withInvalidRego),
@@ -456,6 +484,7 @@ const (
 var (
 	ruleTypeID                          = uuid.New()
 	projectID                           = uuid.New()
+	childProjectID                      = uuid.New()
 	subscriptionID                      = uuid.New()
 	errDefault                          = errors.New("oh no")
 	oldRuleType                         = newDBRuleType("low", uuid.Nil, "")
@@ -517,6 +546,12 @@ func withRuleName(name string) func(ruleType *pb.RuleType) {
 	}
 }
 
+func withVisibility(visibility pb.Visibility) func(ruleType *pb.RuleType) {
+	return func(ruleType *pb.RuleType) {
+		ruleType.Visibility = visibility
+	}
+}
+
 func withIncompatibleDef(ruleType *pb.RuleType) {
 	ruleType.Def.RuleSchema = incompatibleSchema
 }
@@ -576,6 +611,36 @@ func withHierarchyGet(mock dbf.DBMock) {
 		Return([]uuid.UUID{uuid.New()}, nil)
 }
 
+func withGlobalVisibility(enabled bool) func(dbf.DBMock) {
+	return func(mock dbf.DBMock) {
+		var err error
+		if !enabled {
+			err = sql.ErrNoRows
+		}
+		mock.EXPECT().
+			GetFeatureInProject(gomock.Any(), gomock.Any()).
+			Return([]byte(`{}`), err)
+	}
+}
+
+// withRuleTypeUsedBy sets up the rule type to be used by profiles in the
+// given project, which is either the project of the rule type or one of its
+// children
+func withRuleTypeUsedBy(usingProject uuid.UUID) func(dbf.DBMock) {
+	return func(mock dbf.DBMock) {
+		hierarchy := []uuid.UUID{projectID}
+		if usingProject != projectID {
+			hierarchy = []uuid.UUID{usingProject, projectID}
+		}
+		mock.EXPECT().
+			ListProjectsUsingRuleType(gomock.Any(), ruleTypeID).
+			Return([]uuid.UUID{usingProject}, nil)
+		mock.EXPECT().
+			GetParentProjects(gomock.Any(), usingProject).
+			Return(hierarchy, nil)
+	}
+}
+
 func withSuccessfulGet(mock dbf.DBMock) {
 	mock.EXPECT().
 		GetRuleTypeByName(gomock.Any(), gomock.Any()).
@@ -735,10 +800,12 @@ func newDBRuleType(severity db.Severity, subscriptionID uuid.UUID, failureMessag
 	return db.RuleType{
 		ID:                  ruleTypeID,
 		Name:                name,
+		ProjectID:           projectID,
 		Definition:          []byte(`{}`),
 		SeverityValue:       severity,
 		Description:         description,
 		SubscriptionID:      uuid.NullUUID{Valid: subscriptionID != uuid.Nil, UUID: subscriptionID},
 		ShortFailureMessage: failureMessage,
+		Visibility:          db.VisibilitySubtree,
 	}
 }
@@ -50,6 +50,11 @@ func RuleTypePBFromDB(rt *db.RuleType) (*pb.RuleType, error) {
 		releasePhase = pb.RuleTypeReleasePhase_RULE_TYPE_RELEASE_PHASE_UNSPECIFIED
 	}
 
+	var visibility pb.Visibility
+	if err := visibility.FromString(string(rt.Visibility)); err != nil {
+		visibility = pb.Visibility_VISIBILITY_UNSPECIFIED
+	}
+
 	// TODO: (2024/03/28) this is for compatibility with old CLI versions that expect provider, remove this eventually
 	noProvider := ""
 	return &pb.RuleType{
@@ -68,6 +73,7 @@ func RuleTypePBFromDB(rt *db.RuleType) (*pb.RuleType, error) {
 			Value: seval,
 		},
 		ReleasePhase: releasePhase,
+		Visibility:   visibility,
 	}, nil
 }
 
@@ -101,3 +107,13 @@ func GetPBReleasePhaseFromDBReleaseStatus(s *db.ReleaseStatus) (pb.RuleTypeRelea
 
 	return rel, nil
 }
+
+// GetDBVisibilityFromPBVisibility converts a protobuf visibility to a database
+// visibility, defaulting to subtree
+func GetDBVisibilityFromPBVisibility(in pb.Visibility) (db.Visibility, error) {
+	vis, err := in.InitializedStringValue()
+	if err != nil {
+		return "", errors.Join(ErrRuleTypeInvalid, err)
+	}
+	return db.Visibility(vis), nil
+}
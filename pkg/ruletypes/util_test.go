@@ -135,3 +135,51 @@ func TestGetDBReleaseStatusFromPBReleasePhase(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDBVisibilityFromPBVisibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     pb.Visibility
+		expected  db.Visibility
+		expectErr bool
+	}{
+		{
+			name:     "private maps correctly",
+			input:    pb.Visibility_VISIBILITY_PRIVATE,
+			expected: db.VisibilityPrivate,
+		},
+		{
+			name:     "global maps correctly",
+			input:    pb.Visibility_VISIBILITY_GLOBAL,
+			expected: db.VisibilityGlobal,
+		},
+		{
+			name:     "unspecified defaults to subtree",
+			input:    pb.Visibility_VISIBILITY_UNSPECIFIED,
+			expected: db.VisibilitySubtree,
+		},
+		{
+			name:      "invalid enum value returns error",
+			input:     pb.Visibility(999),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := ruletypes.GetDBVisibilityFromPBVisibility(tt.input)
+
+			if tt.expectErr {
+				require.ErrorIs(t, err, ruletypes.ErrRuleTypeInvalid)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ruletypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/projects/features"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// getRuleTypeVisibility returns the database visibility of the rule type,
+// checking that the project is allowed to share it that widely.
+func getRuleTypeVisibility(
+	ctx context.Context,
+	projectID uuid.UUID,
+	ruleType *pb.RuleType,
+	qtx db.Querier,
+) (db.Visibility, error) {
+	visibility, err := GetDBVisibilityFromPBVisibility(ruleType.GetVisibility())
+	if err != nil {
+		return "", err
+	}
+
+	if visibility == db.VisibilityGlobal && !features.ProjectAllowsGlobalVisibility(ctx, qtx, projectID) {
+		return "", fmt.Errorf("%w: global rule types are not enabled for project %s",
+			ErrVisibilityNotAllowed, projectID)
+	}
+
+	return visibility, nil
+}
+
+// validateVisibilityUpdate checks that narrowing the visibility of a rule
+// type doesn't hide it from projects whose profiles already use it.
+func validateVisibilityUpdate(
+	ctx context.Context,
+	oldRuleType *db.RuleType,
+	visibility db.Visibility,
+	qtx db.Querier,
+) error {
+	if !visibility.Narrows(oldRuleType.Visibility) {
+		return nil
+	}
+
+	projects, err := qtx.ListProjectsUsingRuleType(ctx, oldRuleType.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list projects using rule type: %w", err)
+	}
+
+	for _, projectID := range projects {
+		hierarchy, err := qtx.GetParentProjects(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get parent projects: %w", err)
+		}
+		if !visibility.VisibleFrom(oldRuleType.ProjectID, hierarchy) {
+			return errors.Join(ErrRuleTypeInvalid, fmt.Errorf(
+				"cannot make rule type %s %s: it is used by profiles in project %s",
+				oldRuleType.Name, visibility, projectID))
+		}
+	}
+
+	return nil
+}
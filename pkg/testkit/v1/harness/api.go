@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package harness
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+type route struct {
+	method string
+	path   string
+}
+
+type response struct {
+	status  int
+	body    []byte
+	headers map[string]string
+}
+
+// FakeAPI serves scripted responses to the API requests made by the rules
+// under test. Requests without a scripted response get a 404, as a real
+// provider would answer for a missing resource.
+type FakeAPI struct {
+	mu        sync.Mutex
+	responses map[route]response
+	requests  []string
+}
+
+// NewFakeAPI creates a fake API without any scripted response
+func NewFakeAPI() *FakeAPI {
+	return &FakeAPI{
+		responses: make(map[route]response),
+	}
+}
+
+// Respond scripts the response to requests with the given method and path.
+// Scripting the same route again replaces the previous response.
+func (f *FakeAPI) Respond(method, path string, status int, body []byte, headers map[string]string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.responses[route{method: method, path: path}] = response{
+		status:  status,
+		body:    body,
+		headers: headers,
+	}
+}
+
+// RespondJSON scripts a JSON response to requests with the given method and path
+func (f *FakeAPI) RespondJSON(method, path string, status int, v any) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error marshalling response: %w", err)
+	}
+	f.Respond(method, path, status, body, map[string]string{"Content-Type": "application/json"})
+	return nil
+}
+
+// Requests returns the requests served so far, as "METHOD /path"
+func (f *FakeAPI) Requests() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string(nil), f.requests...)
+}
+
+// ServeHTTP implements http.Handler
+func (f *FakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+	resp, ok := f.responses[route{method: r.Method, path: r.URL.Path}]
+	f.mu.Unlock()
+
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	for k, v := range resp.headers {
+		w.Header().Set(k, v)
+	}
+	w.WriteHeader(resp.status)
+	_, _ = w.Write(resp.body)
+}
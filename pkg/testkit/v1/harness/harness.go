@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package harness provides an end-to-end test harness for profiles. It
+// evaluates every rule of a profile against scripted entities, served by a
+// fake provider, so that rule bundle authors and integrators can test their
+// profiles without real provider credentials.
+package harness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/engine/v1/rtengine"
+	"github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	tkv1 "github.com/mindersec/minder/pkg/testkit/v1"
+)
+
+// Status is the outcome of the evaluation of a rule
+type Status string
+
+const (
+	// StatusSuccess means the entity complies with the rule
+	StatusSuccess Status = "success"
+	// StatusFailure means the entity does not comply with the rule
+	StatusFailure Status = "failure"
+	// StatusSkipped means the rule does not apply to the entity
+	StatusSkipped Status = "skipped"
+	// StatusError means the rule could not be evaluated
+	StatusError Status = "error"
+)
+
+// Result is the evaluation result of a rule of a profile
+type Result struct {
+	Profile  string
+	RuleType string
+	RuleName string
+	Status   Status
+	// Details explains a failure, skip or error
+	Details string
+}
+
+type fakeRepository struct {
+	repo  *minderv1.Repository
+	files map[string]string
+}
+
+// Harness holds the rule types, profiles and scripted entities of a test
+type Harness struct {
+	mu           sync.Mutex
+	ruleTypes    map[string]*minderv1.RuleType
+	profiles     []*minderv1.Profile
+	repositories map[string]*fakeRepository
+	api          *FakeAPI
+}
+
+// Option is a functional option type for Harness
+type Option func(*Harness)
+
+// WithRuleTypes is a functional option to register the rule types referenced
+// by the profiles under test. Rule types without a project are assigned a
+// placeholder one.
+func WithRuleTypes(ruleTypes ...*minderv1.RuleType) Option {
+	return func(h *Harness) {
+		for _, rt := range ruleTypes {
+			if rt.GetContext().GetProject() == "" {
+				rt = proto.Clone(rt).(*minderv1.RuleType)
+				project := uuid.Nil.String()
+				rt.Context = &minderv1.Context{Project: &project}
+			}
+			h.ruleTypes[rt.GetName()] = rt
+		}
+	}
+}
+
+// WithProfiles is a functional option to apply the profiles under test
+func WithProfiles(profiles ...*minderv1.Profile) Option {
+	return func(h *Harness) {
+		h.profiles = append(h.profiles, profiles...)
+	}
+}
+
+// New creates a new harness
+func New(opts ...Option) *Harness {
+	h := &Harness{
+		ruleTypes:    make(map[string]*minderv1.RuleType),
+		repositories: make(map[string]*fakeRepository),
+		api:          NewFakeAPI(),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// API returns the fake API serving the provider requests of the rules,
+// to script its responses
+func (h *Harness) API() *FakeAPI {
+	return h.api
+}
+
+// AddRepository registers a repository with the given files in its default
+// branch. Repositories are identified by their owner and name.
+func (h *Harness) AddRepository(repo *minderv1.Repository, files map[string]string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.repositories[repoKey(repo.GetOwner(), repo.GetName())] = &fakeRepository{
+		repo:  repo,
+		files: files,
+	}
+}
+
+// Push replaces the files of a repository and evaluates the profiles
+// against it, as a push webhook would trigger on a real server
+func (h *Harness) Push(ctx context.Context, owner, name string, files map[string]string) ([]Result, error) {
+	h.mu.Lock()
+	fr, ok := h.repositories[repoKey(owner, name)]
+	if ok {
+		fr.files = files
+	}
+	h.mu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("repository %s not found", repoKey(owner, name))
+	}
+	return h.EvaluateRepository(ctx, owner, name)
+}
+
+// EvaluateRepository evaluates the repository rules of all the profiles
+// against the given repository. An error is returned if the harness is
+// misconfigured, e.g. a profile references an unknown rule type; errors
+// evaluating a rule are reported in its result instead.
+func (h *Harness) EvaluateRepository(ctx context.Context, owner, name string) ([]Result, error) {
+	h.mu.Lock()
+	fr, ok := h.repositories[repoKey(owner, name)]
+	var files map[string]string
+	if ok {
+		files = fr.files
+	}
+	h.mu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("repository %s not found", repoKey(owner, name))
+	}
+
+	tkOpts := []tkv1.Option{tkv1.WithHandlerFunc(h.api.ServeHTTP)}
+	if len(files) > 0 {
+		tkOpts = append(tkOpts, tkv1.WithGitFiles(files))
+	}
+	tk := tkv1.NewTestKit(tkOpts...)
+
+	var results []Result
+	for _, profile := range h.profiles {
+		rules, err := profiles.GetRulesForEntity(profile, minderv1.Entity_ENTITY_REPOSITORIES)
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range rules {
+			res, err := h.evalRule(ctx, tk, profile, rule, repoKey(owner, name), fr.repo)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, res)
+		}
+	}
+
+	return results, nil
+}
+
+func (h *Harness) evalRule(
+	ctx context.Context,
+	tk *tkv1.TestKit,
+	profile *minderv1.Profile,
+	rule *minderv1.Profile_Rule,
+	entityName string,
+	entity proto.Message,
+) (Result, error) {
+	rt, ok := h.ruleTypes[rule.GetType()]
+	if !ok {
+		return Result{}, fmt.Errorf("profile %s references unknown rule type %s", profile.GetName(), rule.GetType())
+	}
+
+	rte, err := rtengine.NewRuleTypeEngine(ctx, rt, tk)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to initialize rule type engine for %s: %w", rt.GetName(), err)
+	}
+	// Only git ingestion reads the scripted files, the other ingesters go
+	// through the fake API.
+	if rt.GetDef().GetIngest().GetType() == "git" && tk.ShouldOverrideIngest() {
+		rte.WithCustomIngester(tk)
+	}
+
+	res := Result{
+		Profile:  profile.GetName(),
+		RuleType: rt.GetName(),
+		RuleName: profiles.ComputeRuleName(rule, rt.GetDisplayName()),
+	}
+
+	// resolve the overrides of the rule as the engine does
+	instance := models.RuleFromPB(uuid.Nil, rule)
+	resolved := instance.ForEntity(entityName)
+	_, evalErr := rte.Eval(ctx, entity, resolved.Def, resolved.Params, tkv1.NewVoidResultSink())
+	res.Status, res.Details = statusFromError(evalErr)
+	return res, nil
+}
+
+func statusFromError(evalErr error) (Status, string) {
+	switch {
+	case evalErr == nil:
+		return StatusSuccess, ""
+	case errors.Is(evalErr, interfaces.ErrEvaluationFailed):
+		var details interfaces.EvalError
+		if errors.As(evalErr, &details) {
+			return StatusFailure, fmt.Sprintf("%s: %s", evalErr, details.Details())
+		}
+		return StatusFailure, evalErr.Error()
+	case errors.Is(evalErr, interfaces.ErrEvaluationSkipped):
+		return StatusSkipped, evalErr.Error()
+	default:
+		return StatusError, evalErr.Error()
+	}
+}
+
+func repoKey(owner, name string) string {
+	return owner + "/" + name
+}
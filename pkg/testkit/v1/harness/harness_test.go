@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package harness
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func readmeRuleType() *minderv1.RuleType {
+	return &minderv1.RuleType{
+		Name:        "readme_exists",
+		DisplayName: "README exists",
+		Def: &minderv1.RuleType_Definition{
+			InEntity:   minderv1.RepositoryEntity.String(),
+			RuleSchema: &structpb.Struct{},
+			Ingest: &minderv1.RuleType_Definition_Ingest{
+				Type: "git",
+			},
+			Eval: &minderv1.RuleType_Definition_Eval{
+				Type: "rego",
+				Rego: &minderv1.RuleType_Definition_Eval_Rego{
+					Type: "deny-by-default",
+					Def: `package minder
+
+import rego.v1
+
+default allow := false
+
+allow if {
+	file.exists("README.md")
+}
+`,
+				},
+			},
+		},
+	}
+}
+
+func secretScanningRuleType() *minderv1.RuleType {
+	return &minderv1.RuleType{
+		Name:        "secret_scanning",
+		DisplayName: "Secret scanning is enabled",
+		Def: &minderv1.RuleType_Definition{
+			InEntity:   minderv1.RepositoryEntity.String(),
+			RuleSchema: &structpb.Struct{},
+			Ingest: &minderv1.RuleType_Definition_Ingest{
+				Type: "rest",
+				Rest: &minderv1.RestType{
+					Endpoint: "/repos/{{.Entity.Owner}}/{{.Entity.Name}}",
+					Parse:    "json",
+				},
+			},
+			Eval: &minderv1.RuleType_Definition_Eval{
+				Type: "rego",
+				Rego: &minderv1.RuleType_Definition_Eval_Rego{
+					Type: "deny-by-default",
+					Def: `package minder
+
+import rego.v1
+
+default allow := false
+
+allow if {
+	input.ingested.security_and_analysis.secret_scanning.status == "enabled"
+}
+`,
+				},
+			},
+		},
+	}
+}
+
+func TestHarnessEvaluateRepository(t *testing.T) {
+	t.Parallel()
+
+	h := New(
+		WithRuleTypes(readmeRuleType(), secretScanningRuleType()),
+		WithProfiles(&minderv1.Profile{
+			Name: "baseline",
+			Repository: []*minderv1.Profile_Rule{
+				{Type: "readme_exists"},
+				{Type: "secret_scanning", Name: "secrets"},
+			},
+		}),
+	)
+	h.AddRepository(&minderv1.Repository{Owner: "acme", Name: "widgets"}, map[string]string{
+		"main.go": "package main",
+	})
+	require.NoError(t, h.API().RespondJSON(http.MethodGet, "/repos/acme/widgets", http.StatusOK, map[string]any{
+		"security_and_analysis": map[string]any{
+			"secret_scanning": map[string]any{"status": "enabled"},
+		},
+	}))
+
+	ctx := context.Background()
+	results, err := h.EvaluateRepository(ctx, "acme", "widgets")
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	require.Equal(t, "baseline", results[0].Profile)
+	require.Equal(t, "README exists", results[0].RuleName)
+	require.Equal(t, StatusFailure, results[0].Status)
+
+	require.Equal(t, "secrets", results[1].RuleName)
+	require.Equal(t, StatusSuccess, results[1].Status)
+	require.Equal(t, []string{"GET /repos/acme/widgets"}, h.API().Requests())
+
+	// Pushing a README fixes the repository
+	results, err = h.Push(ctx, "acme", "widgets", map[string]string{
+		"main.go":   "package main",
+		"README.md": "# widgets",
+	})
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, results[0].Status)
+}
+
+func TestHarnessMisconfiguration(t *testing.T) {
+	t.Parallel()
+
+	h := New(WithProfiles(&minderv1.Profile{
+		Name:       "baseline",
+		Repository: []*minderv1.Profile_Rule{{Type: "unknown"}},
+	}))
+
+	_, err := h.EvaluateRepository(context.Background(), "acme", "widgets")
+	require.ErrorContains(t, err, "repository acme/widgets not found")
+
+	h.AddRepository(&minderv1.Repository{Owner: "acme", Name: "widgets"}, nil)
+	_, err = h.EvaluateRepository(context.Background(), "acme", "widgets")
+	require.ErrorContains(t, err, "unknown rule type unknown")
+}